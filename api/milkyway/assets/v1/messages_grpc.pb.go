@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_RegisterAsset_FullMethodName   = "/milkyway.assets.v1.Msg/RegisterAsset"
-	Msg_DeregisterAsset_FullMethodName = "/milkyway.assets.v1.Msg/DeregisterAsset"
+	Msg_RegisterAsset_FullMethodName        = "/milkyway.assets.v1.Msg/RegisterAsset"
+	Msg_DeregisterAsset_FullMethodName      = "/milkyway.assets.v1.Msg/DeregisterAsset"
+	Msg_UpdateRedemptionRate_FullMethodName = "/milkyway.assets.v1.Msg/UpdateRedemptionRate"
+	Msg_ResetRedemptionRate_FullMethodName  = "/milkyway.assets.v1.Msg/ResetRedemptionRate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +36,13 @@ type MsgClient interface {
 	// DeregisterAsset defines the operation for de-registering an asset with
 	// its denomination.
 	DeregisterAsset(ctx context.Context, in *MsgDeregisterAsset, opts ...grpc.CallOption) (*MsgDeregisterAssetResponse, error)
+	// UpdateRedemptionRate defines the operation for updating the redemption
+	// rate of a liquid staking asset whose rate is set by the authority.
+	UpdateRedemptionRate(ctx context.Context, in *MsgUpdateRedemptionRate, opts ...grpc.CallOption) (*MsgUpdateRedemptionRateResponse, error)
+	// ResetRedemptionRate defines the operation for resetting the redemption
+	// rate of a liquid staking asset, regardless of its rate source and of the
+	// max rate change allowed.
+	ResetRedemptionRate(ctx context.Context, in *MsgResetRedemptionRate, opts ...grpc.CallOption) (*MsgResetRedemptionRateResponse, error)
 }
 
 type msgClient struct {
@@ -64,6 +73,26 @@ func (c *msgClient) DeregisterAsset(ctx context.Context, in *MsgDeregisterAsset,
 	return out, nil
 }
 
+func (c *msgClient) UpdateRedemptionRate(ctx context.Context, in *MsgUpdateRedemptionRate, opts ...grpc.CallOption) (*MsgUpdateRedemptionRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRedemptionRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetRedemptionRate(ctx context.Context, in *MsgResetRedemptionRate, opts ...grpc.CallOption) (*MsgResetRedemptionRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResetRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetRedemptionRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -75,6 +104,13 @@ type MsgServer interface {
 	// DeregisterAsset defines the operation for de-registering an asset with
 	// its denomination.
 	DeregisterAsset(context.Context, *MsgDeregisterAsset) (*MsgDeregisterAssetResponse, error)
+	// UpdateRedemptionRate defines the operation for updating the redemption
+	// rate of a liquid staking asset whose rate is set by the authority.
+	UpdateRedemptionRate(context.Context, *MsgUpdateRedemptionRate) (*MsgUpdateRedemptionRateResponse, error)
+	// ResetRedemptionRate defines the operation for resetting the redemption
+	// rate of a liquid staking asset, regardless of its rate source and of the
+	// max rate change allowed.
+	ResetRedemptionRate(context.Context, *MsgResetRedemptionRate) (*MsgResetRedemptionRateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -91,6 +127,12 @@ func (UnimplementedMsgServer) RegisterAsset(context.Context, *MsgRegisterAsset)
 func (UnimplementedMsgServer) DeregisterAsset(context.Context, *MsgDeregisterAsset) (*MsgDeregisterAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAsset not implemented")
 }
+func (UnimplementedMsgServer) UpdateRedemptionRate(context.Context, *MsgUpdateRedemptionRate) (*MsgUpdateRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionRate not implemented")
+}
+func (UnimplementedMsgServer) ResetRedemptionRate(context.Context, *MsgResetRedemptionRate) (*MsgResetRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRedemptionRate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -148,6 +190,42 @@ func _Msg_DeregisterAsset_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateRedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRedemptionRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRedemptionRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRedemptionRate(ctx, req.(*MsgUpdateRedemptionRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetRedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetRedemptionRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetRedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetRedemptionRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetRedemptionRate(ctx, req.(*MsgResetRedemptionRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,6 +241,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterAsset",
 			Handler:    _Msg_DeregisterAsset_Handler,
 		},
+		{
+			MethodName: "UpdateRedemptionRate",
+			Handler:    _Msg_UpdateRedemptionRate_Handler,
+		},
+		{
+			MethodName: "ResetRedemptionRate",
+			Handler:    _Msg_ResetRedemptionRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/assets/v1/messages.proto",
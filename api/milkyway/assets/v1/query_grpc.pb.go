@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Assets_FullMethodName = "/milkyway.assets.v1.Query/Assets"
-	Query_Asset_FullMethodName  = "/milkyway.assets.v1.Query/Asset"
+	Query_Assets_FullMethodName         = "/milkyway.assets.v1.Query/Assets"
+	Query_Asset_FullMethodName          = "/milkyway.assets.v1.Query/Asset"
+	Query_RedemptionRate_FullMethodName = "/milkyway.assets.v1.Query/RedemptionRate"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,10 @@ type QueryClient interface {
 	// Asset defines a gRPC query method that returns the asset associated with
 	// the given token denomination.
 	Asset(ctx context.Context, in *QueryAssetRequest, opts ...grpc.CallOption) (*QueryAssetResponse, error)
+	// RedemptionRate defines a gRPC query method that returns the redemption
+	// rate of the liquid staking asset associated with the given token
+	// denomination.
+	RedemptionRate(ctx context.Context, in *QueryRedemptionRateRequest, opts ...grpc.CallOption) (*QueryRedemptionRateResponse, error)
 }
 
 type queryClient struct {
@@ -64,6 +69,16 @@ func (c *queryClient) Asset(ctx context.Context, in *QueryAssetRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) RedemptionRate(ctx context.Context, in *QueryRedemptionRateRequest, opts ...grpc.CallOption) (*QueryRedemptionRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, Query_RedemptionRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -75,6 +90,10 @@ type QueryServer interface {
 	// Asset defines a gRPC query method that returns the asset associated with
 	// the given token denomination.
 	Asset(context.Context, *QueryAssetRequest) (*QueryAssetResponse, error)
+	// RedemptionRate defines a gRPC query method that returns the redemption
+	// rate of the liquid staking asset associated with the given token
+	// denomination.
+	RedemptionRate(context.Context, *QueryRedemptionRateRequest) (*QueryRedemptionRateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -91,6 +110,9 @@ func (UnimplementedQueryServer) Assets(context.Context, *QueryAssetsRequest) (*Q
 func (UnimplementedQueryServer) Asset(context.Context, *QueryAssetRequest) (*QueryAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Asset not implemented")
 }
+func (UnimplementedQueryServer) RedemptionRate(context.Context, *QueryRedemptionRateRequest) (*QueryRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRate not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -148,6 +170,24 @@ func _Query_Asset_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedemptionRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRate(ctx, req.(*QueryRedemptionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,6 +203,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Asset",
 			Handler:    _Query_Asset_Handler,
 		},
+		{
+			MethodName: "RedemptionRate",
+			Handler:    _Query_RedemptionRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/assets/v1/query.proto",
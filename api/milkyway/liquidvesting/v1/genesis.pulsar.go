@@ -115,11 +115,64 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*InsuranceFundUsage
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InsuranceFundUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InsuranceFundUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(InsuranceFundUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(InsuranceFundUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_params               protoreflect.FieldDescriptor
-	fd_GenesisState_burn_coins           protoreflect.FieldDescriptor
-	fd_GenesisState_user_insurance_funds protoreflect.FieldDescriptor
+	md_GenesisState                              protoreflect.MessageDescriptor
+	fd_GenesisState_params                       protoreflect.FieldDescriptor
+	fd_GenesisState_burn_coins                   protoreflect.FieldDescriptor
+	fd_GenesisState_user_insurance_funds         protoreflect.FieldDescriptor
+	fd_GenesisState_insurance_fund_usages        protoreflect.FieldDescriptor
+	fd_GenesisState_next_insurance_fund_usage_id protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -128,6 +181,8 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_burn_coins = md_GenesisState.Fields().ByName("burn_coins")
 	fd_GenesisState_user_insurance_funds = md_GenesisState.Fields().ByName("user_insurance_funds")
+	fd_GenesisState_insurance_fund_usages = md_GenesisState.Fields().ByName("insurance_fund_usages")
+	fd_GenesisState_next_insurance_fund_usage_id = md_GenesisState.Fields().ByName("next_insurance_fund_usage_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -213,6 +268,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.InsuranceFundUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.InsuranceFundUsages})
+		if !f(fd_GenesisState_insurance_fund_usages, value) {
+			return
+		}
+	}
+	if x.NextInsuranceFundUsageId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextInsuranceFundUsageId)
+		if !f(fd_GenesisState_next_insurance_fund_usage_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -234,6 +301,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.BurnCoins) != 0
 	case "milkyway.liquidvesting.v1.GenesisState.user_insurance_funds":
 		return len(x.UserInsuranceFunds) != 0
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		return len(x.InsuranceFundUsages) != 0
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		return x.NextInsuranceFundUsageId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -256,6 +327,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.BurnCoins = nil
 	case "milkyway.liquidvesting.v1.GenesisState.user_insurance_funds":
 		x.UserInsuranceFunds = nil
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		x.InsuranceFundUsages = nil
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		x.NextInsuranceFundUsageId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -287,6 +362,15 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.UserInsuranceFunds}
 		return protoreflect.ValueOfList(listValue)
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		if len(x.InsuranceFundUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.InsuranceFundUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		value := x.NextInsuranceFundUsageId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -317,6 +401,12 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.UserInsuranceFunds = *clv.list
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.InsuranceFundUsages = *clv.list
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		x.NextInsuranceFundUsageId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -354,6 +444,14 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.UserInsuranceFunds}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		if x.InsuranceFundUsages == nil {
+			x.InsuranceFundUsages = []*InsuranceFundUsage{}
+		}
+		value := &_GenesisState_4_list{list: &x.InsuranceFundUsages}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		panic(fmt.Errorf("field next_insurance_fund_usage_id of message milkyway.liquidvesting.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -376,6 +474,11 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "milkyway.liquidvesting.v1.GenesisState.user_insurance_funds":
 		list := []*UserInsuranceFundEntry{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages":
+		list := []*InsuranceFundUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "milkyway.liquidvesting.v1.GenesisState.next_insurance_fund_usage_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.GenesisState"))
@@ -461,6 +564,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.InsuranceFundUsages) > 0 {
+			for _, e := range x.InsuranceFundUsages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextInsuranceFundUsageId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextInsuranceFundUsageId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -490,6 +602,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NextInsuranceFundUsageId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextInsuranceFundUsageId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.InsuranceFundUsages) > 0 {
+			for iNdEx := len(x.InsuranceFundUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InsuranceFundUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.UserInsuranceFunds) > 0 {
 			for iNdEx := len(x.UserInsuranceFunds) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.UserInsuranceFunds[iNdEx])
@@ -689,6 +822,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundUsages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InsuranceFundUsages = append(x.InsuranceFundUsages, &InsuranceFundUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InsuranceFundUsages[len(x.InsuranceFundUsages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextInsuranceFundUsageId", wireType)
+				}
+				x.NextInsuranceFundUsageId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextInsuranceFundUsageId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -750,6 +936,12 @@ type GenesisState struct {
 	BurnCoins []*BurnCoins `protobuf:"bytes,2,rep,name=burn_coins,json=burnCoins,proto3" json:"burn_coins,omitempty"`
 	// UserInsuranceFunds represents the users' insurance fund.
 	UserInsuranceFunds []*UserInsuranceFundEntry `protobuf:"bytes,3,rep,name=user_insurance_funds,json=userInsuranceFunds,proto3" json:"user_insurance_funds,omitempty"`
+	// InsuranceFundUsages represents the usages of the users' insurance fund to
+	// cover the slashed locked representations.
+	InsuranceFundUsages []*InsuranceFundUsage `protobuf:"bytes,4,rep,name=insurance_fund_usages,json=insuranceFundUsages,proto3" json:"insurance_fund_usages,omitempty"`
+	// NextInsuranceFundUsageID represents the ID that will be assigned to the
+	// next insurance fund usage.
+	NextInsuranceFundUsageId uint64 `protobuf:"varint,5,opt,name=next_insurance_fund_usage_id,json=nextInsuranceFundUsageId,proto3" json:"next_insurance_fund_usage_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -793,6 +985,20 @@ func (x *GenesisState) GetUserInsuranceFunds() []*UserInsuranceFundEntry {
 	return nil
 }
 
+func (x *GenesisState) GetInsuranceFundUsages() []*InsuranceFundUsage {
+	if x != nil {
+		return x.InsuranceFundUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextInsuranceFundUsageId() uint64 {
+	if x != nil {
+		return x.NextInsuranceFundUsageId
+	}
+	return 0
+}
+
 var File_milkyway_liquidvesting_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_milkyway_liquidvesting_v1_genesis_proto_rawDesc = []byte{
@@ -806,7 +1012,7 @@ var file_milkyway_liquidvesting_v1_genesis_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x26, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x6c, 0x69, 0x71,
 	0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x02, 0x0a, 0x0c, 0x47,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x03, 0x0a, 0x0c, 0x47,
 	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69,
 	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
@@ -823,24 +1029,36 @@ var file_milkyway_liquidvesting_v1_genesis_proto_rawDesc = []byte{
 	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46,
 	0x75, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12,
 	0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75, 0x6e,
-	0x64, 0x73, 0x42, 0x88, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d,
-	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x4c, 0x58, 0xaa,
-	0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x4d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x1b, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x73, 0x12, 0x67, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x1c, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x75,
+	0x6e, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x18, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x73, 0x75, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52,
+	0x18, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75,
+	0x6e, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x42, 0x88, 0x02, 0x0a, 0x1d, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76,
+	0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x4c, 0x58, 0xaa, 0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x25, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -861,16 +1079,18 @@ var file_milkyway_liquidvesting_v1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),                 // 1: milkyway.liquidvesting.v1.Params
 	(*BurnCoins)(nil),              // 2: milkyway.liquidvesting.v1.BurnCoins
 	(*UserInsuranceFundEntry)(nil), // 3: milkyway.liquidvesting.v1.UserInsuranceFundEntry
+	(*InsuranceFundUsage)(nil),     // 4: milkyway.liquidvesting.v1.InsuranceFundUsage
 }
 var file_milkyway_liquidvesting_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: milkyway.liquidvesting.v1.GenesisState.params:type_name -> milkyway.liquidvesting.v1.Params
 	2, // 1: milkyway.liquidvesting.v1.GenesisState.burn_coins:type_name -> milkyway.liquidvesting.v1.BurnCoins
 	3, // 2: milkyway.liquidvesting.v1.GenesisState.user_insurance_funds:type_name -> milkyway.liquidvesting.v1.UserInsuranceFundEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: milkyway.liquidvesting.v1.GenesisState.insurance_fund_usages:type_name -> milkyway.liquidvesting.v1.InsuranceFundUsage
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_liquidvesting_v1_genesis_proto_init() }
@@ -2200,6 +2200,894 @@ func (x *fastReflection_UserInsuranceFundEntry) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_InsuranceFundUsage_6_list)(nil)
+
+type _InsuranceFundUsage_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_InsuranceFundUsage_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InsuranceFundUsage_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InsuranceFundUsage_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InsuranceFundUsage_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InsuranceFundUsage_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_InsuranceFundUsage_7_list)(nil)
+
+type _InsuranceFundUsage_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_InsuranceFundUsage_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InsuranceFundUsage_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InsuranceFundUsage_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InsuranceFundUsage_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InsuranceFundUsage_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InsuranceFundUsage_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_InsuranceFundUsage                protoreflect.MessageDescriptor
+	fd_InsuranceFundUsage_id             protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_user_address   protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_service_id     protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_operator_id    protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_height         protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_slashed_amount protoreflect.FieldDescriptor
+	fd_InsuranceFundUsage_covered_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_liquidvesting_v1_models_proto_init()
+	md_InsuranceFundUsage = File_milkyway_liquidvesting_v1_models_proto.Messages().ByName("InsuranceFundUsage")
+	fd_InsuranceFundUsage_id = md_InsuranceFundUsage.Fields().ByName("id")
+	fd_InsuranceFundUsage_user_address = md_InsuranceFundUsage.Fields().ByName("user_address")
+	fd_InsuranceFundUsage_service_id = md_InsuranceFundUsage.Fields().ByName("service_id")
+	fd_InsuranceFundUsage_operator_id = md_InsuranceFundUsage.Fields().ByName("operator_id")
+	fd_InsuranceFundUsage_height = md_InsuranceFundUsage.Fields().ByName("height")
+	fd_InsuranceFundUsage_slashed_amount = md_InsuranceFundUsage.Fields().ByName("slashed_amount")
+	fd_InsuranceFundUsage_covered_amount = md_InsuranceFundUsage.Fields().ByName("covered_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_InsuranceFundUsage)(nil)
+
+type fastReflection_InsuranceFundUsage InsuranceFundUsage
+
+func (x *InsuranceFundUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InsuranceFundUsage)(x)
+}
+
+func (x *InsuranceFundUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_liquidvesting_v1_models_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InsuranceFundUsage_messageType fastReflection_InsuranceFundUsage_messageType
+var _ protoreflect.MessageType = fastReflection_InsuranceFundUsage_messageType{}
+
+type fastReflection_InsuranceFundUsage_messageType struct{}
+
+func (x fastReflection_InsuranceFundUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InsuranceFundUsage)(nil)
+}
+func (x fastReflection_InsuranceFundUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_InsuranceFundUsage)
+}
+func (x fastReflection_InsuranceFundUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InsuranceFundUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InsuranceFundUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_InsuranceFundUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InsuranceFundUsage) Type() protoreflect.MessageType {
+	return _fastReflection_InsuranceFundUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InsuranceFundUsage) New() protoreflect.Message {
+	return new(fastReflection_InsuranceFundUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InsuranceFundUsage) Interface() protoreflect.ProtoMessage {
+	return (*InsuranceFundUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InsuranceFundUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_InsuranceFundUsage_id, value) {
+			return
+		}
+	}
+	if x.UserAddress != "" {
+		value := protoreflect.ValueOfString(x.UserAddress)
+		if !f(fd_InsuranceFundUsage_user_address, value) {
+			return
+		}
+	}
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_InsuranceFundUsage_service_id, value) {
+			return
+		}
+	}
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_InsuranceFundUsage_operator_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_InsuranceFundUsage_height, value) {
+			return
+		}
+	}
+	if len(x.SlashedAmount) != 0 {
+		value := protoreflect.ValueOfList(&_InsuranceFundUsage_6_list{list: &x.SlashedAmount})
+		if !f(fd_InsuranceFundUsage_slashed_amount, value) {
+			return
+		}
+	}
+	if len(x.CoveredAmount) != 0 {
+		value := protoreflect.ValueOfList(&_InsuranceFundUsage_7_list{list: &x.CoveredAmount})
+		if !f(fd_InsuranceFundUsage_covered_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InsuranceFundUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		return x.Id != uint64(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		return x.UserAddress != ""
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		return x.Height != int64(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		return len(x.SlashedAmount) != 0
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		return len(x.CoveredAmount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InsuranceFundUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		x.Id = uint64(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		x.UserAddress = ""
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		x.Height = int64(0)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		x.SlashedAmount = nil
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		x.CoveredAmount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InsuranceFundUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		value := x.UserAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		if len(x.SlashedAmount) == 0 {
+			return protoreflect.ValueOfList(&_InsuranceFundUsage_6_list{})
+		}
+		listValue := &_InsuranceFundUsage_6_list{list: &x.SlashedAmount}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		if len(x.CoveredAmount) == 0 {
+			return protoreflect.ValueOfList(&_InsuranceFundUsage_7_list{})
+		}
+		listValue := &_InsuranceFundUsage_7_list{list: &x.CoveredAmount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InsuranceFundUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		x.Id = value.Uint()
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		x.UserAddress = value.Interface().(string)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		x.Height = value.Int()
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		lv := value.List()
+		clv := lv.(*_InsuranceFundUsage_6_list)
+		x.SlashedAmount = *clv.list
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		lv := value.List()
+		clv := lv.(*_InsuranceFundUsage_7_list)
+		x.CoveredAmount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InsuranceFundUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		if x.SlashedAmount == nil {
+			x.SlashedAmount = []*v1beta1.Coin{}
+		}
+		value := &_InsuranceFundUsage_6_list{list: &x.SlashedAmount}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		if x.CoveredAmount == nil {
+			x.CoveredAmount = []*v1beta1.Coin{}
+		}
+		value := &_InsuranceFundUsage_7_list{list: &x.CoveredAmount}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		panic(fmt.Errorf("field id of message milkyway.liquidvesting.v1.InsuranceFundUsage is not mutable"))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		panic(fmt.Errorf("field user_address of message milkyway.liquidvesting.v1.InsuranceFundUsage is not mutable"))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.liquidvesting.v1.InsuranceFundUsage is not mutable"))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.liquidvesting.v1.InsuranceFundUsage is not mutable"))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		panic(fmt.Errorf("field height of message milkyway.liquidvesting.v1.InsuranceFundUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InsuranceFundUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.user_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_InsuranceFundUsage_6_list{list: &list})
+	case "milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_InsuranceFundUsage_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.liquidvesting.v1.InsuranceFundUsage"))
+		}
+		panic(fmt.Errorf("message milkyway.liquidvesting.v1.InsuranceFundUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InsuranceFundUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.liquidvesting.v1.InsuranceFundUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InsuranceFundUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InsuranceFundUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InsuranceFundUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InsuranceFundUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InsuranceFundUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.UserAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if len(x.SlashedAmount) > 0 {
+			for _, e := range x.SlashedAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CoveredAmount) > 0 {
+			for _, e := range x.CoveredAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InsuranceFundUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CoveredAmount) > 0 {
+			for iNdEx := len(x.CoveredAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CoveredAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.SlashedAmount) > 0 {
+			for iNdEx := len(x.SlashedAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashedAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.UserAddress) > 0 {
+			i -= len(x.UserAddress)
+			copy(dAtA[i:], x.UserAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UserAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InsuranceFundUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InsuranceFundUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InsuranceFundUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UserAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedAmount = append(x.SlashedAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashedAmount[len(x.SlashedAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoveredAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CoveredAmount = append(x.CoveredAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CoveredAmount[len(x.CoveredAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -2389,6 +3277,99 @@ func (x *UserInsuranceFundEntry) GetBalance() []*v1beta1.Coin {
 	return nil
 }
 
+// InsuranceFundUsage represents the usage of a user's insurance fund to cover
+// the locked representations that have been slashed from the user.
+type InsuranceFundUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is the unique identifier of the insurance fund usage.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of who owns the insurance fund.
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// ID of the service that requested the slash.
+	ServiceId uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// ID of the operator that has been slashed.
+	OperatorId uint32 `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Height at which the slash has been performed.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Amount of locked representations that have been slashed from the user.
+	SlashedAmount []*v1beta1.Coin `protobuf:"bytes,6,rep,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+	// Amount of coins that have been taken from the user's insurance fund to
+	// cover the slash.
+	CoveredAmount []*v1beta1.Coin `protobuf:"bytes,7,rep,name=covered_amount,json=coveredAmount,proto3" json:"covered_amount,omitempty"`
+}
+
+func (x *InsuranceFundUsage) Reset() {
+	*x = InsuranceFundUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_liquidvesting_v1_models_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsuranceFundUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsuranceFundUsage) ProtoMessage() {}
+
+// Deprecated: Use InsuranceFundUsage.ProtoReflect.Descriptor instead.
+func (*InsuranceFundUsage) Descriptor() ([]byte, []int) {
+	return file_milkyway_liquidvesting_v1_models_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InsuranceFundUsage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InsuranceFundUsage) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *InsuranceFundUsage) GetServiceId() uint32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *InsuranceFundUsage) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *InsuranceFundUsage) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *InsuranceFundUsage) GetSlashedAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.SlashedAmount
+	}
+	return nil
+}
+
+func (x *InsuranceFundUsage) GetCoveredAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.CoveredAmount
+	}
+	return nil
+}
+
 var File_milkyway_liquidvesting_v1_models_proto protoreflect.FileDescriptor
 
 var file_milkyway_liquidvesting_v1_models_proto_rawDesc = []byte{
@@ -2447,24 +3428,52 @@ var file_milkyway_liquidvesting_v1_models_proto_rawDesc = []byte{
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x42, 0x87, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
-	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x4c, 0x58, 0xaa, 0x02, 0x19,
-	0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x22, 0xc8, 0x03, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x75, 0x6e, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3b, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x72, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x0e, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x87, 0x02, 0x0a,
+	0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x4c, 0x58, 0xaa, 0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x25, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2479,26 +3488,29 @@ func file_milkyway_liquidvesting_v1_models_proto_rawDescGZIP() []byte {
 	return file_milkyway_liquidvesting_v1_models_proto_rawDescData
 }
 
-var file_milkyway_liquidvesting_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_milkyway_liquidvesting_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_milkyway_liquidvesting_v1_models_proto_goTypes = []interface{}{
 	(*UserInsuranceFund)(nil),      // 0: milkyway.liquidvesting.v1.UserInsuranceFund
 	(*BurnCoins)(nil),              // 1: milkyway.liquidvesting.v1.BurnCoins
 	(*BurnCoinsList)(nil),          // 2: milkyway.liquidvesting.v1.BurnCoinsList
 	(*UserInsuranceFundEntry)(nil), // 3: milkyway.liquidvesting.v1.UserInsuranceFundEntry
-	(*v1beta1.Coin)(nil),           // 4: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*InsuranceFundUsage)(nil),     // 4: milkyway.liquidvesting.v1.InsuranceFundUsage
+	(*v1beta1.Coin)(nil),           // 5: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
 }
 var file_milkyway_liquidvesting_v1_models_proto_depIdxs = []int32{
-	4, // 0: milkyway.liquidvesting.v1.UserInsuranceFund.balance:type_name -> cosmos.base.v1beta1.Coin
-	5, // 1: milkyway.liquidvesting.v1.BurnCoins.completion_time:type_name -> google.protobuf.Timestamp
-	4, // 2: milkyway.liquidvesting.v1.BurnCoins.amount:type_name -> cosmos.base.v1beta1.Coin
+	5, // 0: milkyway.liquidvesting.v1.UserInsuranceFund.balance:type_name -> cosmos.base.v1beta1.Coin
+	6, // 1: milkyway.liquidvesting.v1.BurnCoins.completion_time:type_name -> google.protobuf.Timestamp
+	5, // 2: milkyway.liquidvesting.v1.BurnCoins.amount:type_name -> cosmos.base.v1beta1.Coin
 	1, // 3: milkyway.liquidvesting.v1.BurnCoinsList.data:type_name -> milkyway.liquidvesting.v1.BurnCoins
-	4, // 4: milkyway.liquidvesting.v1.UserInsuranceFundEntry.balance:type_name -> cosmos.base.v1beta1.Coin
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 4: milkyway.liquidvesting.v1.UserInsuranceFundEntry.balance:type_name -> cosmos.base.v1beta1.Coin
+	5, // 5: milkyway.liquidvesting.v1.InsuranceFundUsage.slashed_amount:type_name -> cosmos.base.v1beta1.Coin
+	5, // 6: milkyway.liquidvesting.v1.InsuranceFundUsage.covered_amount:type_name -> cosmos.base.v1beta1.Coin
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_liquidvesting_v1_models_proto_init() }
@@ -2555,6 +3567,18 @@ func file_milkyway_liquidvesting_v1_models_proto_init() {
 				return nil
 			}
 		}
+		file_milkyway_liquidvesting_v1_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsuranceFundUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2562,7 +3586,7 @@ func file_milkyway_liquidvesting_v1_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_milkyway_liquidvesting_v1_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
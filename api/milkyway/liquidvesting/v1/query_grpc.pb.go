@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_UserInsuranceFund_FullMethodName    = "/milkyway.liquidvesting.v1.Query/UserInsuranceFund"
-	Query_UserInsuranceFunds_FullMethodName   = "/milkyway.liquidvesting.v1.Query/UserInsuranceFunds"
-	Query_UserRestakableAssets_FullMethodName = "/milkyway.liquidvesting.v1.Query/UserRestakableAssets"
-	Query_InsuranceFund_FullMethodName        = "/milkyway.liquidvesting.v1.Query/InsuranceFund"
-	Query_Params_FullMethodName               = "/milkyway.liquidvesting.v1.Query/Params"
+	Query_UserInsuranceFund_FullMethodName       = "/milkyway.liquidvesting.v1.Query/UserInsuranceFund"
+	Query_UserInsuranceFunds_FullMethodName      = "/milkyway.liquidvesting.v1.Query/UserInsuranceFunds"
+	Query_UserRestakableAssets_FullMethodName    = "/milkyway.liquidvesting.v1.Query/UserRestakableAssets"
+	Query_InsuranceFund_FullMethodName           = "/milkyway.liquidvesting.v1.Query/InsuranceFund"
+	Query_InsuranceFundUsages_FullMethodName     = "/milkyway.liquidvesting.v1.Query/InsuranceFundUsages"
+	Query_UserInsuranceFundUsages_FullMethodName = "/milkyway.liquidvesting.v1.Query/UserInsuranceFundUsages"
+	Query_Params_FullMethodName                  = "/milkyway.liquidvesting.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +47,12 @@ type QueryClient interface {
 	// InsuranceFund defines a gRPC query method that returns the amount of tokens
 	// that are in the insurance fund.
 	InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error)
+	// InsuranceFundUsages defines a gRPC query method that returns all the
+	// usages of the users' insurance funds to cover slashes.
+	InsuranceFundUsages(ctx context.Context, in *QueryInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundUsagesResponse, error)
+	// UserInsuranceFundUsages defines a gRPC query method that returns the
+	// usages of the user's insurance fund to cover slashes given their address.
+	UserInsuranceFundUsages(ctx context.Context, in *QueryUserInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryUserInsuranceFundUsagesResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -98,6 +106,26 @@ func (c *queryClient) InsuranceFund(ctx context.Context, in *QueryInsuranceFundR
 	return out, nil
 }
 
+func (c *queryClient) InsuranceFundUsages(ctx context.Context, in *QueryInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInsuranceFundUsagesResponse)
+	err := c.cc.Invoke(ctx, Query_InsuranceFundUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserInsuranceFundUsages(ctx context.Context, in *QueryUserInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryUserInsuranceFundUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUserInsuranceFundUsagesResponse)
+	err := c.cc.Invoke(ctx, Query_UserInsuranceFundUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -127,6 +155,12 @@ type QueryServer interface {
 	// InsuranceFund defines a gRPC query method that returns the amount of tokens
 	// that are in the insurance fund.
 	InsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error)
+	// InsuranceFundUsages defines a gRPC query method that returns all the
+	// usages of the users' insurance funds to cover slashes.
+	InsuranceFundUsages(context.Context, *QueryInsuranceFundUsagesRequest) (*QueryInsuranceFundUsagesResponse, error)
+	// UserInsuranceFundUsages defines a gRPC query method that returns the
+	// usages of the user's insurance fund to cover slashes given their address.
+	UserInsuranceFundUsages(context.Context, *QueryUserInsuranceFundUsagesRequest) (*QueryUserInsuranceFundUsagesResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -152,6 +186,12 @@ func (UnimplementedQueryServer) UserRestakableAssets(context.Context, *QueryUser
 func (UnimplementedQueryServer) InsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFund not implemented")
 }
+func (UnimplementedQueryServer) InsuranceFundUsages(context.Context, *QueryInsuranceFundUsagesRequest) (*QueryInsuranceFundUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundUsages not implemented")
+}
+func (UnimplementedQueryServer) UserInsuranceFundUsages(context.Context, *QueryUserInsuranceFundUsagesRequest) (*QueryUserInsuranceFundUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInsuranceFundUsages not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -248,6 +288,42 @@ func _Query_InsuranceFund_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InsuranceFundUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InsuranceFundUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundUsages(ctx, req.(*QueryInsuranceFundUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserInsuranceFundUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserInsuranceFundUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserInsuranceFundUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UserInsuranceFundUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserInsuranceFundUsages(ctx, req.(*QueryUserInsuranceFundUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +365,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InsuranceFund",
 			Handler:    _Query_InsuranceFund_Handler,
 		},
+		{
+			MethodName: "InsuranceFundUsages",
+			Handler:    _Query_InsuranceFundUsages_Handler,
+		},
+		{
+			MethodName: "UserInsuranceFundUsages",
+			Handler:    _Query_UserInsuranceFundUsages_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -26,6 +26,8 @@ const (
 	Msg_DeleteOperator_FullMethodName            = "/milkyway.operators.v1.Msg/DeleteOperator"
 	Msg_TransferOperatorOwnership_FullMethodName = "/milkyway.operators.v1.Msg/TransferOperatorOwnership"
 	Msg_SetOperatorParams_FullMethodName         = "/milkyway.operators.v1.Msg/SetOperatorParams"
+	Msg_GrantOperatorRole_FullMethodName         = "/milkyway.operators.v1.Msg/GrantOperatorRole"
+	Msg_RevokeOperatorRole_FullMethodName        = "/milkyway.operators.v1.Msg/RevokeOperatorRole"
 	Msg_UpdateParams_FullMethodName              = "/milkyway.operators.v1.Msg/UpdateParams"
 )
 
@@ -55,6 +57,12 @@ type MsgClient interface {
 	// SetOperatorParams defines the operation for setting a operator's
 	// parameters.
 	SetOperatorParams(ctx context.Context, in *MsgSetOperatorParams, opts ...grpc.CallOption) (*MsgSetOperatorParamsResponse, error)
+	// GrantOperatorRole defines the operation for granting a role of an
+	// operator to another account.
+	GrantOperatorRole(ctx context.Context, in *MsgGrantOperatorRole, opts ...grpc.CallOption) (*MsgGrantOperatorRoleResponse, error)
+	// RevokeOperatorRole defines the operation for revoking a role of an
+	// operator previously granted to another account.
+	RevokeOperatorRole(ctx context.Context, in *MsgRevokeOperatorRole, opts ...grpc.CallOption) (*MsgRevokeOperatorRoleResponse, error)
 	// UpdateParams defines a governance operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -139,6 +147,26 @@ func (c *msgClient) SetOperatorParams(ctx context.Context, in *MsgSetOperatorPar
 	return out, nil
 }
 
+func (c *msgClient) GrantOperatorRole(ctx context.Context, in *MsgGrantOperatorRole, opts ...grpc.CallOption) (*MsgGrantOperatorRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantOperatorRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeOperatorRole(ctx context.Context, in *MsgRevokeOperatorRole, opts ...grpc.CallOption) (*MsgRevokeOperatorRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeOperatorRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -175,6 +203,12 @@ type MsgServer interface {
 	// SetOperatorParams defines the operation for setting a operator's
 	// parameters.
 	SetOperatorParams(context.Context, *MsgSetOperatorParams) (*MsgSetOperatorParamsResponse, error)
+	// GrantOperatorRole defines the operation for granting a role of an
+	// operator to another account.
+	GrantOperatorRole(context.Context, *MsgGrantOperatorRole) (*MsgGrantOperatorRoleResponse, error)
+	// RevokeOperatorRole defines the operation for revoking a role of an
+	// operator previously granted to another account.
+	RevokeOperatorRole(context.Context, *MsgRevokeOperatorRole) (*MsgRevokeOperatorRoleResponse, error)
 	// UpdateParams defines a governance operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -210,6 +244,12 @@ func (UnimplementedMsgServer) TransferOperatorOwnership(context.Context, *MsgTra
 func (UnimplementedMsgServer) SetOperatorParams(context.Context, *MsgSetOperatorParams) (*MsgSetOperatorParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorParams not implemented")
 }
+func (UnimplementedMsgServer) GrantOperatorRole(context.Context, *MsgGrantOperatorRole) (*MsgGrantOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantOperatorRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeOperatorRole(context.Context, *MsgRevokeOperatorRole) (*MsgRevokeOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperatorRole not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -360,6 +400,42 @@ func _Msg_SetOperatorParams_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantOperatorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantOperatorRole(ctx, req.(*MsgGrantOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeOperatorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOperatorRole(ctx, req.(*MsgRevokeOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -413,6 +489,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetOperatorParams",
 			Handler:    _Msg_SetOperatorParams_Handler,
 		},
+		{
+			MethodName: "GrantOperatorRole",
+			Handler:    _Msg_GrantOperatorRole_Handler,
+		},
+		{
+			MethodName: "RevokeOperatorRole",
+			Handler:    _Msg_RevokeOperatorRole_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Operator_FullMethodName       = "/milkyway.operators.v1.Query/Operator"
-	Query_OperatorParams_FullMethodName = "/milkyway.operators.v1.Query/OperatorParams"
-	Query_Operators_FullMethodName      = "/milkyway.operators.v1.Query/Operators"
-	Query_Params_FullMethodName         = "/milkyway.operators.v1.Query/Params"
+	Query_Operator_FullMethodName           = "/milkyway.operators.v1.Query/Operator"
+	Query_OperatorParams_FullMethodName     = "/milkyway.operators.v1.Query/OperatorParams"
+	Query_Operators_FullMethodName          = "/milkyway.operators.v1.Query/Operators"
+	Query_OperatorRoleGrants_FullMethodName = "/milkyway.operators.v1.Query/OperatorRoleGrants"
+	Query_Params_FullMethodName             = "/milkyway.operators.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,6 +40,9 @@ type QueryClient interface {
 	OperatorParams(ctx context.Context, in *QueryOperatorParamsRequest, opts ...grpc.CallOption) (*QueryOperatorParamsResponse, error)
 	// Operators defines a gRPC query method that returns the list of operators.
 	Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error)
+	// OperatorRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the operator with the given id.
+	OperatorRoleGrants(ctx context.Context, in *QueryOperatorRoleGrantsRequest, opts ...grpc.CallOption) (*QueryOperatorRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -82,6 +86,16 @@ func (c *queryClient) Operators(ctx context.Context, in *QueryOperatorsRequest,
 	return out, nil
 }
 
+func (c *queryClient) OperatorRoleGrants(ctx context.Context, in *QueryOperatorRoleGrantsRequest, opts ...grpc.CallOption) (*QueryOperatorRoleGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOperatorRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorRoleGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -106,6 +120,9 @@ type QueryServer interface {
 	OperatorParams(context.Context, *QueryOperatorParamsRequest) (*QueryOperatorParamsResponse, error)
 	// Operators defines a gRPC query method that returns the list of operators.
 	Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error)
+	// OperatorRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the operator with the given id.
+	OperatorRoleGrants(context.Context, *QueryOperatorRoleGrantsRequest) (*QueryOperatorRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -128,6 +145,9 @@ func (UnimplementedQueryServer) OperatorParams(context.Context, *QueryOperatorPa
 func (UnimplementedQueryServer) Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Operators not implemented")
 }
+func (UnimplementedQueryServer) OperatorRoleGrants(context.Context, *QueryOperatorRoleGrantsRequest) (*QueryOperatorRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorRoleGrants not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -206,6 +226,24 @@ func _Query_Operators_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OperatorRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorRoleGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorRoleGrants(ctx, req.(*QueryOperatorRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -243,6 +281,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Operators",
 			Handler:    _Query_Operators_Handler,
 		},
+		{
+			MethodName: "OperatorRoleGrants",
+			Handler:    _Query_OperatorRoleGrants_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
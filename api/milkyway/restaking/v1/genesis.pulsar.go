@@ -2570,15 +2570,380 @@ func (x *_GenesisState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*Redelegation
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Redelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Redelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(Redelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(Redelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*ServiceSnapshot
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceSnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceSnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ServiceSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*OperatorJailRecord
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorJailRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorJailRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(OperatorJailRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(OperatorJailRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*SubmittedEvidence
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubmittedEvidence)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SubmittedEvidence)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(SubmittedEvidence)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(SubmittedEvidence)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*PendingSlash
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingSlash)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingSlash)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(PendingSlash)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(PendingSlash)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*OperatorServiceKey
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorServiceKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorServiceKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(OperatorServiceKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(OperatorServiceKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*OperatorServiceKey
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorServiceKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OperatorServiceKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(OperatorServiceKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(OperatorServiceKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                            protoreflect.MessageDescriptor
-	fd_GenesisState_params                     protoreflect.FieldDescriptor
-	fd_GenesisState_operators_joined_services  protoreflect.FieldDescriptor
-	fd_GenesisState_services_allowed_operators protoreflect.FieldDescriptor
-	fd_GenesisState_services_securing_pools    protoreflect.FieldDescriptor
-	fd_GenesisState_delegations                protoreflect.FieldDescriptor
-	fd_GenesisState_unbonding_delegations      protoreflect.FieldDescriptor
-	fd_GenesisState_users_preferences          protoreflect.FieldDescriptor
+	md_GenesisState                                protoreflect.MessageDescriptor
+	fd_GenesisState_params                         protoreflect.FieldDescriptor
+	fd_GenesisState_operators_joined_services      protoreflect.FieldDescriptor
+	fd_GenesisState_services_allowed_operators     protoreflect.FieldDescriptor
+	fd_GenesisState_services_securing_pools        protoreflect.FieldDescriptor
+	fd_GenesisState_delegations                    protoreflect.FieldDescriptor
+	fd_GenesisState_unbonding_delegations          protoreflect.FieldDescriptor
+	fd_GenesisState_users_preferences              protoreflect.FieldDescriptor
+	fd_GenesisState_redelegations                  protoreflect.FieldDescriptor
+	fd_GenesisState_services_snapshots             protoreflect.FieldDescriptor
+	fd_GenesisState_operators_jail_records         protoreflect.FieldDescriptor
+	fd_GenesisState_submitted_evidences            protoreflect.FieldDescriptor
+	fd_GenesisState_pending_slashes                protoreflect.FieldDescriptor
+	fd_GenesisState_next_pending_slash_id          protoreflect.FieldDescriptor
+	fd_GenesisState_operators_service_keys         protoreflect.FieldDescriptor
+	fd_GenesisState_pending_operators_service_keys protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2591,6 +2956,14 @@ func init() {
 	fd_GenesisState_delegations = md_GenesisState.Fields().ByName("delegations")
 	fd_GenesisState_unbonding_delegations = md_GenesisState.Fields().ByName("unbonding_delegations")
 	fd_GenesisState_users_preferences = md_GenesisState.Fields().ByName("users_preferences")
+	fd_GenesisState_redelegations = md_GenesisState.Fields().ByName("redelegations")
+	fd_GenesisState_services_snapshots = md_GenesisState.Fields().ByName("services_snapshots")
+	fd_GenesisState_operators_jail_records = md_GenesisState.Fields().ByName("operators_jail_records")
+	fd_GenesisState_submitted_evidences = md_GenesisState.Fields().ByName("submitted_evidences")
+	fd_GenesisState_pending_slashes = md_GenesisState.Fields().ByName("pending_slashes")
+	fd_GenesisState_next_pending_slash_id = md_GenesisState.Fields().ByName("next_pending_slash_id")
+	fd_GenesisState_operators_service_keys = md_GenesisState.Fields().ByName("operators_service_keys")
+	fd_GenesisState_pending_operators_service_keys = md_GenesisState.Fields().ByName("pending_operators_service_keys")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -2700,10 +3073,58 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
+	if len(x.Redelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.Redelegations})
+		if !f(fd_GenesisState_redelegations, value) {
+			return
+		}
+	}
+	if len(x.ServicesSnapshots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.ServicesSnapshots})
+		if !f(fd_GenesisState_services_snapshots, value) {
+			return
+		}
+	}
+	if len(x.OperatorsJailRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.OperatorsJailRecords})
+		if !f(fd_GenesisState_operators_jail_records, value) {
+			return
+		}
+	}
+	if len(x.SubmittedEvidences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.SubmittedEvidences})
+		if !f(fd_GenesisState_submitted_evidences, value) {
+			return
+		}
+	}
+	if len(x.PendingSlashes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.PendingSlashes})
+		if !f(fd_GenesisState_pending_slashes, value) {
+			return
+		}
+	}
+	if x.NextPendingSlashId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPendingSlashId)
+		if !f(fd_GenesisState_next_pending_slash_id, value) {
+			return
+		}
+	}
+	if len(x.OperatorsServiceKeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.OperatorsServiceKeys})
+		if !f(fd_GenesisState_operators_service_keys, value) {
+			return
+		}
+	}
+	if len(x.PendingOperatorsServiceKeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.PendingOperatorsServiceKeys})
+		if !f(fd_GenesisState_pending_operators_service_keys, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
 // Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
@@ -2729,6 +3150,22 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.UnbondingDelegations) != 0
 	case "milkyway.restaking.v1.GenesisState.users_preferences":
 		return len(x.UsersPreferences) != 0
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		return len(x.Redelegations) != 0
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		return len(x.ServicesSnapshots) != 0
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		return len(x.OperatorsJailRecords) != 0
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		return len(x.SubmittedEvidences) != 0
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		return len(x.PendingSlashes) != 0
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		return x.NextPendingSlashId != uint64(0)
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		return len(x.OperatorsServiceKeys) != 0
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		return len(x.PendingOperatorsServiceKeys) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -2759,6 +3196,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.UnbondingDelegations = nil
 	case "milkyway.restaking.v1.GenesisState.users_preferences":
 		x.UsersPreferences = nil
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		x.Redelegations = nil
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		x.ServicesSnapshots = nil
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		x.OperatorsJailRecords = nil
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		x.SubmittedEvidences = nil
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		x.PendingSlashes = nil
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		x.NextPendingSlashId = uint64(0)
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		x.OperatorsServiceKeys = nil
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		x.PendingOperatorsServiceKeys = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -2814,6 +3267,51 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_7_list{list: &x.UsersPreferences}
 		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		if len(x.Redelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.Redelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		if len(x.ServicesSnapshots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.ServicesSnapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		if len(x.OperatorsJailRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.OperatorsJailRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		if len(x.SubmittedEvidences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.SubmittedEvidences}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		if len(x.PendingSlashes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.PendingSlashes}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		value := x.NextPendingSlashId
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		if len(x.OperatorsServiceKeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.OperatorsServiceKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		if len(x.PendingOperatorsServiceKeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.PendingOperatorsServiceKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -2860,6 +3358,36 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_7_list)
 		x.UsersPreferences = *clv.list
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.Redelegations = *clv.list
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.ServicesSnapshots = *clv.list
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.OperatorsJailRecords = *clv.list
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.SubmittedEvidences = *clv.list
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.PendingSlashes = *clv.list
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		x.NextPendingSlashId = value.Uint()
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.OperatorsServiceKeys = *clv.list
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.PendingOperatorsServiceKeys = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -2921,6 +3449,50 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_7_list{list: &x.UsersPreferences}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		if x.Redelegations == nil {
+			x.Redelegations = []*Redelegation{}
+		}
+		value := &_GenesisState_8_list{list: &x.Redelegations}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		if x.ServicesSnapshots == nil {
+			x.ServicesSnapshots = []*ServiceSnapshot{}
+		}
+		value := &_GenesisState_9_list{list: &x.ServicesSnapshots}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		if x.OperatorsJailRecords == nil {
+			x.OperatorsJailRecords = []*OperatorJailRecord{}
+		}
+		value := &_GenesisState_10_list{list: &x.OperatorsJailRecords}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		if x.SubmittedEvidences == nil {
+			x.SubmittedEvidences = []*SubmittedEvidence{}
+		}
+		value := &_GenesisState_11_list{list: &x.SubmittedEvidences}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		if x.PendingSlashes == nil {
+			x.PendingSlashes = []*PendingSlash{}
+		}
+		value := &_GenesisState_12_list{list: &x.PendingSlashes}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		if x.OperatorsServiceKeys == nil {
+			x.OperatorsServiceKeys = []*OperatorServiceKey{}
+		}
+		value := &_GenesisState_14_list{list: &x.OperatorsServiceKeys}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		if x.PendingOperatorsServiceKeys == nil {
+			x.PendingOperatorsServiceKeys = []*OperatorServiceKey{}
+		}
+		value := &_GenesisState_15_list{list: &x.PendingOperatorsServiceKeys}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		panic(fmt.Errorf("field next_pending_slash_id of message milkyway.restaking.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -2955,6 +3527,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "milkyway.restaking.v1.GenesisState.users_preferences":
 		list := []*UserPreferencesEntry{}
 		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.redelegations":
+		list := []*Redelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.services_snapshots":
+		list := []*ServiceSnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.operators_jail_records":
+		list := []*OperatorJailRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.submitted_evidences":
+		list := []*SubmittedEvidence{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.pending_slashes":
+		list := []*PendingSlash{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.next_pending_slash_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.restaking.v1.GenesisState.operators_service_keys":
+		list := []*OperatorServiceKey{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "milkyway.restaking.v1.GenesisState.pending_operators_service_keys":
+		list := []*OperatorServiceKey{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.GenesisState"))
@@ -3064,6 +3659,51 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Redelegations) > 0 {
+			for _, e := range x.Redelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ServicesSnapshots) > 0 {
+			for _, e := range x.ServicesSnapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OperatorsJailRecords) > 0 {
+			for _, e := range x.OperatorsJailRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SubmittedEvidences) > 0 {
+			for _, e := range x.SubmittedEvidences {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingSlashes) > 0 {
+			for _, e := range x.PendingSlashes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextPendingSlashId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPendingSlashId))
+		}
+		if len(x.OperatorsServiceKeys) > 0 {
+			for _, e := range x.OperatorsServiceKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingOperatorsServiceKeys) > 0 {
+			for _, e := range x.PendingOperatorsServiceKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3093,6 +3733,123 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingOperatorsServiceKeys) > 0 {
+			for iNdEx := len(x.PendingOperatorsServiceKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingOperatorsServiceKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.OperatorsServiceKeys) > 0 {
+			for iNdEx := len(x.OperatorsServiceKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OperatorsServiceKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.NextPendingSlashId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPendingSlashId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.PendingSlashes) > 0 {
+			for iNdEx := len(x.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingSlashes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.SubmittedEvidences) > 0 {
+			for iNdEx := len(x.SubmittedEvidences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SubmittedEvidences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.OperatorsJailRecords) > 0 {
+			for iNdEx := len(x.OperatorsJailRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OperatorsJailRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.ServicesSnapshots) > 0 {
+			for iNdEx := len(x.ServicesSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ServicesSnapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Redelegations) > 0 {
+			for iNdEx := len(x.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Redelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if len(x.UsersPreferences) > 0 {
 			for iNdEx := len(x.UsersPreferences) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.UsersPreferences[iNdEx])
@@ -3134,127 +3891,333 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						Buf:               input.Buf,
 					}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.ServicesSecuringPools) > 0 {
-			for iNdEx := len(x.ServicesSecuringPools) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ServicesSecuringPools[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ServicesSecuringPools) > 0 {
+			for iNdEx := len(x.ServicesSecuringPools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ServicesSecuringPools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ServicesAllowedOperators) > 0 {
+			for iNdEx := len(x.ServicesAllowedOperators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ServicesAllowedOperators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.OperatorsJoinedServices) > 0 {
+			for iNdEx := len(x.OperatorsJoinedServices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OperatorsJoinedServices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorsJoinedServices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorsJoinedServices = append(x.OperatorsJoinedServices, &OperatorJoinedServices{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OperatorsJoinedServices[len(x.OperatorsJoinedServices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServicesAllowedOperators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServicesAllowedOperators = append(x.ServicesAllowedOperators, &ServiceAllowedOperators{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServicesAllowedOperators[len(x.ServicesAllowedOperators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServicesSecuringPools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServicesSecuringPools = append(x.ServicesSecuringPools, &ServiceSecuringPools{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServicesSecuringPools[len(x.ServicesSecuringPools)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &Delegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.ServicesAllowedOperators) > 0 {
-			for iNdEx := len(x.ServicesAllowedOperators) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ServicesAllowedOperators[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.OperatorsJoinedServices) > 0 {
-			for iNdEx := len(x.OperatorsJoinedServices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OperatorsJoinedServices[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.UnbondingDelegations = append(x.UnbondingDelegations, &UnbondingDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingDelegations[len(x.UnbondingDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsersPreferences", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3281,16 +4244,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.UsersPreferences = append(x.UsersPreferences, &UserPreferencesEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UsersPreferences[len(x.UsersPreferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Redelegations = append(x.Redelegations, &Redelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegations[len(x.Redelegations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorsJoinedServices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServicesSnapshots", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3317,14 +4312,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OperatorsJoinedServices = append(x.OperatorsJoinedServices, &OperatorJoinedServices{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OperatorsJoinedServices[len(x.OperatorsJoinedServices)-1]); err != nil {
+				x.ServicesSnapshots = append(x.ServicesSnapshots, &ServiceSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServicesSnapshots[len(x.ServicesSnapshots)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServicesAllowedOperators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorsJailRecords", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3351,14 +4346,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ServicesAllowedOperators = append(x.ServicesAllowedOperators, &ServiceAllowedOperators{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServicesAllowedOperators[len(x.ServicesAllowedOperators)-1]); err != nil {
+				x.OperatorsJailRecords = append(x.OperatorsJailRecords, &OperatorJailRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OperatorsJailRecords[len(x.OperatorsJailRecords)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServicesSecuringPools", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmittedEvidences", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3385,14 +4380,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ServicesSecuringPools = append(x.ServicesSecuringPools, &ServiceSecuringPools{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServicesSecuringPools[len(x.ServicesSecuringPools)-1]); err != nil {
+				x.SubmittedEvidences = append(x.SubmittedEvidences, &SubmittedEvidence{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmittedEvidences[len(x.SubmittedEvidences)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3419,14 +4414,33 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Delegations = append(x.Delegations, &Delegation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+				x.PendingSlashes = append(x.PendingSlashes, &PendingSlash{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingSlashes[len(x.PendingSlashes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPendingSlashId", wireType)
+				}
+				x.NextPendingSlashId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPendingSlashId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorsServiceKeys", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3453,14 +4467,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnbondingDelegations = append(x.UnbondingDelegations, &UnbondingDelegation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingDelegations[len(x.UnbondingDelegations)-1]); err != nil {
+				x.OperatorsServiceKeys = append(x.OperatorsServiceKeys, &OperatorServiceKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OperatorsServiceKeys[len(x.OperatorsServiceKeys)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsersPreferences", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOperatorsServiceKeys", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3487,8 +4501,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UsersPreferences = append(x.UsersPreferences, &UserPreferencesEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UsersPreferences[len(x.UsersPreferences)-1]); err != nil {
+				x.PendingOperatorsServiceKeys = append(x.PendingOperatorsServiceKeys, &OperatorServiceKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingOperatorsServiceKeys[len(x.PendingOperatorsServiceKeys)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3750,6 +4764,28 @@ type GenesisState struct {
 	UnbondingDelegations []*UnbondingDelegation `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations,omitempty"`
 	// UserPreferences represents the user preferences.
 	UsersPreferences []*UserPreferencesEntry `protobuf:"bytes,7,rep,name=users_preferences,json=usersPreferences,proto3" json:"users_preferences,omitempty"`
+	// Redelegations represents the redelegations.
+	Redelegations []*Redelegation `protobuf:"bytes,8,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// ServicesSnapshots represents the snapshots of the services' validating
+	// sets.
+	ServicesSnapshots []*ServiceSnapshot `protobuf:"bytes,9,rep,name=services_snapshots,json=servicesSnapshots,proto3" json:"services_snapshots,omitempty"`
+	// OperatorsJailRecords represents the records of the operators that have
+	// been jailed from the services.
+	OperatorsJailRecords []*OperatorJailRecord `protobuf:"bytes,10,rep,name=operators_jail_records,json=operatorsJailRecords,proto3" json:"operators_jail_records,omitempty"`
+	// SubmittedEvidences represents the evidences of the operators misbehaviors
+	// that have already been submitted.
+	SubmittedEvidences []*SubmittedEvidence `protobuf:"bytes,11,rep,name=submitted_evidences,json=submittedEvidences,proto3" json:"submitted_evidences,omitempty"`
+	// PendingSlashes represents the slashes that are waiting to be executed.
+	PendingSlashes []*PendingSlash `protobuf:"bytes,12,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes,omitempty"`
+	// NextPendingSlashID represents the ID that will be assigned to the next
+	// pending slash.
+	NextPendingSlashId uint64 `protobuf:"varint,13,opt,name=next_pending_slash_id,json=nextPendingSlashId,proto3" json:"next_pending_slash_id,omitempty"`
+	// OperatorsServiceKeys represents the keys currently used by the operators
+	// for the services.
+	OperatorsServiceKeys []*OperatorServiceKey `protobuf:"bytes,14,rep,name=operators_service_keys,json=operatorsServiceKeys,proto3" json:"operators_service_keys,omitempty"`
+	// PendingOperatorsServiceKeys represents the keys registered by the
+	// operators that will replace the current ones once activated.
+	PendingOperatorsServiceKeys []*OperatorServiceKey `protobuf:"bytes,15,rep,name=pending_operators_service_keys,json=pendingOperatorsServiceKeys,proto3" json:"pending_operators_service_keys,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -3821,6 +4857,62 @@ func (x *GenesisState) GetUsersPreferences() []*UserPreferencesEntry {
 	return nil
 }
 
+func (x *GenesisState) GetRedelegations() []*Redelegation {
+	if x != nil {
+		return x.Redelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetServicesSnapshots() []*ServiceSnapshot {
+	if x != nil {
+		return x.ServicesSnapshots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOperatorsJailRecords() []*OperatorJailRecord {
+	if x != nil {
+		return x.OperatorsJailRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSubmittedEvidences() []*SubmittedEvidence {
+	if x != nil {
+		return x.SubmittedEvidences
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingSlashes() []*PendingSlash {
+	if x != nil {
+		return x.PendingSlashes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextPendingSlashId() uint64 {
+	if x != nil {
+		return x.NextPendingSlashId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetOperatorsServiceKeys() []*OperatorServiceKey {
+	if x != nil {
+		return x.OperatorsServiceKeys
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingOperatorsServiceKeys() []*OperatorServiceKey {
+	if x != nil {
+		return x.PendingOperatorsServiceKeys
+	}
+	return nil
+}
+
 var File_milkyway_restaking_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_milkyway_restaking_v1_genesis_proto_rawDesc = []byte{
@@ -3864,7 +4956,7 @@ var file_milkyway_restaking_v1_genesis_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
 	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
 	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xad, 0x05,
+	0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x9f, 0x0b,
 	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b,
 	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
 	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b,
@@ -3907,23 +4999,70 @@ var file_milkyway_restaking_v1_genesis_proto_rawDesc = []byte{
 	0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
 	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0xec, 0x01,
-	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76,
-	0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02,
-	0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
-	0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x21, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a,
+	0x0d, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0d, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x16, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4a, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x5f, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x12, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x4e, 0x65, 0x78, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x49, 0x44, 0x52, 0x12,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x49, 0x64, 0x12, 0x65, 0x0a, 0x16, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x14, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x74, 0x0a, 0x1e, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x1b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x42,
+	0xec, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58,
+	0xaa, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x21, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a,
+	0x3a, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3949,21 +5088,34 @@ var file_milkyway_restaking_v1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),                  // 6: milkyway.restaking.v1.Params
 	(*Delegation)(nil),              // 7: milkyway.restaking.v1.Delegation
 	(*UnbondingDelegation)(nil),     // 8: milkyway.restaking.v1.UnbondingDelegation
+	(*Redelegation)(nil),            // 9: milkyway.restaking.v1.Redelegation
+	(*ServiceSnapshot)(nil),         // 10: milkyway.restaking.v1.ServiceSnapshot
+	(*OperatorJailRecord)(nil),      // 11: milkyway.restaking.v1.OperatorJailRecord
+	(*SubmittedEvidence)(nil),       // 12: milkyway.restaking.v1.SubmittedEvidence
+	(*PendingSlash)(nil),            // 13: milkyway.restaking.v1.PendingSlash
+	(*OperatorServiceKey)(nil),      // 14: milkyway.restaking.v1.OperatorServiceKey
 }
 var file_milkyway_restaking_v1_genesis_proto_depIdxs = []int32{
-	5, // 0: milkyway.restaking.v1.UserPreferencesEntry.preferences:type_name -> milkyway.restaking.v1.UserPreferences
-	6, // 1: milkyway.restaking.v1.GenesisState.params:type_name -> milkyway.restaking.v1.Params
-	0, // 2: milkyway.restaking.v1.GenesisState.operators_joined_services:type_name -> milkyway.restaking.v1.OperatorJoinedServices
-	1, // 3: milkyway.restaking.v1.GenesisState.services_allowed_operators:type_name -> milkyway.restaking.v1.ServiceAllowedOperators
-	2, // 4: milkyway.restaking.v1.GenesisState.services_securing_pools:type_name -> milkyway.restaking.v1.ServiceSecuringPools
-	7, // 5: milkyway.restaking.v1.GenesisState.delegations:type_name -> milkyway.restaking.v1.Delegation
-	8, // 6: milkyway.restaking.v1.GenesisState.unbonding_delegations:type_name -> milkyway.restaking.v1.UnbondingDelegation
-	3, // 7: milkyway.restaking.v1.GenesisState.users_preferences:type_name -> milkyway.restaking.v1.UserPreferencesEntry
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	5,  // 0: milkyway.restaking.v1.UserPreferencesEntry.preferences:type_name -> milkyway.restaking.v1.UserPreferences
+	6,  // 1: milkyway.restaking.v1.GenesisState.params:type_name -> milkyway.restaking.v1.Params
+	0,  // 2: milkyway.restaking.v1.GenesisState.operators_joined_services:type_name -> milkyway.restaking.v1.OperatorJoinedServices
+	1,  // 3: milkyway.restaking.v1.GenesisState.services_allowed_operators:type_name -> milkyway.restaking.v1.ServiceAllowedOperators
+	2,  // 4: milkyway.restaking.v1.GenesisState.services_securing_pools:type_name -> milkyway.restaking.v1.ServiceSecuringPools
+	7,  // 5: milkyway.restaking.v1.GenesisState.delegations:type_name -> milkyway.restaking.v1.Delegation
+	8,  // 6: milkyway.restaking.v1.GenesisState.unbonding_delegations:type_name -> milkyway.restaking.v1.UnbondingDelegation
+	3,  // 7: milkyway.restaking.v1.GenesisState.users_preferences:type_name -> milkyway.restaking.v1.UserPreferencesEntry
+	9,  // 8: milkyway.restaking.v1.GenesisState.redelegations:type_name -> milkyway.restaking.v1.Redelegation
+	10, // 9: milkyway.restaking.v1.GenesisState.services_snapshots:type_name -> milkyway.restaking.v1.ServiceSnapshot
+	11, // 10: milkyway.restaking.v1.GenesisState.operators_jail_records:type_name -> milkyway.restaking.v1.OperatorJailRecord
+	12, // 11: milkyway.restaking.v1.GenesisState.submitted_evidences:type_name -> milkyway.restaking.v1.SubmittedEvidence
+	13, // 12: milkyway.restaking.v1.GenesisState.pending_slashes:type_name -> milkyway.restaking.v1.PendingSlash
+	14, // 13: milkyway.restaking.v1.GenesisState.operators_service_keys:type_name -> milkyway.restaking.v1.OperatorServiceKey
+	14, // 14: milkyway.restaking.v1.GenesisState.pending_operators_service_keys:type_name -> milkyway.restaking.v1.OperatorServiceKey
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_restaking_v1_genesis_proto_init() }
@@ -33,6 +33,14 @@ const (
 	Msg_UndelegateOperator_FullMethodName          = "/milkyway.restaking.v1.Msg/UndelegateOperator"
 	Msg_UndelegateService_FullMethodName           = "/milkyway.restaking.v1.Msg/UndelegateService"
 	Msg_SetUserPreferences_FullMethodName          = "/milkyway.restaking.v1.Msg/SetUserPreferences"
+	Msg_RedelegatePool_FullMethodName              = "/milkyway.restaking.v1.Msg/RedelegatePool"
+	Msg_RedelegateOperator_FullMethodName          = "/milkyway.restaking.v1.Msg/RedelegateOperator"
+	Msg_RedelegateService_FullMethodName           = "/milkyway.restaking.v1.Msg/RedelegateService"
+	Msg_CancelUnbondingDelegation_FullMethodName   = "/milkyway.restaking.v1.Msg/CancelUnbondingDelegation"
+	Msg_UnjailOperator_FullMethodName              = "/milkyway.restaking.v1.Msg/UnjailOperator"
+	Msg_SubmitOperatorEvidence_FullMethodName      = "/milkyway.restaking.v1.Msg/SubmitOperatorEvidence"
+	Msg_VetoSlash_FullMethodName                   = "/milkyway.restaking.v1.Msg/VetoSlash"
+	Msg_SetOperatorServiceKey_FullMethodName       = "/milkyway.restaking.v1.Msg/SetOperatorServiceKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -88,6 +96,34 @@ type MsgClient interface {
 	// SetUserPreferences defines the operation that allows users to set their
 	// preferences for the restaking module.
 	SetUserPreferences(ctx context.Context, in *MsgSetUserPreferences, opts ...grpc.CallOption) (*MsgSetUserPreferencesResponse, error)
+	// RedelegatePool defines the operation that allows users to move their
+	// assets from a pool to an operator or a service without waiting for the
+	// unbonding period to pass.
+	RedelegatePool(ctx context.Context, in *MsgRedelegatePool, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// RedelegateOperator defines the operation that allows users to move their
+	// assets from an operator to another operator without waiting for the
+	// unbonding period to pass.
+	RedelegateOperator(ctx context.Context, in *MsgRedelegateOperator, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// RedelegateService defines the operation that allows users to move their
+	// assets from a service to another service without waiting for the
+	// unbonding period to pass.
+	RedelegateService(ctx context.Context, in *MsgRedelegateService, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// CancelUnbondingDelegation defines the operation that allows users to
+	// cancel an unbonding delegation entry that has not completed yet, delegating
+	// the tokens back to the same target.
+	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// UnjailOperator defines the operation that allows the operator admin to
+	// unjail an operator from a service once the jail period has ended.
+	UnjailOperator(ctx context.Context, in *MsgUnjailOperator, opts ...grpc.CallOption) (*MsgUnjailOperatorResponse, error)
+	// SubmitOperatorEvidence defines the operation that allows anyone to submit
+	// an evidence of an operator misbehavior performed while securing a service.
+	SubmitOperatorEvidence(ctx context.Context, in *MsgSubmitOperatorEvidence, opts ...grpc.CallOption) (*MsgSubmitOperatorEvidenceResponse, error)
+	// VetoSlash defines the operation that allows the module authority or the
+	// slash veto committee to cancel a pending slash before it gets executed.
+	VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error)
+	// SetOperatorServiceKey defines the operation that allows the operator admin
+	// to register the public key that the operator uses for a service.
+	SetOperatorServiceKey(ctx context.Context, in *MsgSetOperatorServiceKey, opts ...grpc.CallOption) (*MsgSetOperatorServiceKeyResponse, error)
 }
 
 type msgClient struct {
@@ -238,6 +274,86 @@ func (c *msgClient) SetUserPreferences(ctx context.Context, in *MsgSetUserPrefer
 	return out, nil
 }
 
+func (c *msgClient) RedelegatePool(ctx context.Context, in *MsgRedelegatePool, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegatePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedelegateOperator(ctx context.Context, in *MsgRedelegateOperator, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegateOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedelegateService(ctx context.Context, in *MsgRedelegateService, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegateService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelUnbondingDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailOperator(ctx context.Context, in *MsgUnjailOperator, opts ...grpc.CallOption) (*MsgUnjailOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnjailOperatorResponse)
+	err := c.cc.Invoke(ctx, Msg_UnjailOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitOperatorEvidence(ctx context.Context, in *MsgSubmitOperatorEvidence, opts ...grpc.CallOption) (*MsgSubmitOperatorEvidenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitOperatorEvidenceResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitOperatorEvidence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgVetoSlashResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoSlash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOperatorServiceKey(ctx context.Context, in *MsgSetOperatorServiceKey, opts ...grpc.CallOption) (*MsgSetOperatorServiceKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOperatorServiceKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOperatorServiceKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -291,6 +407,34 @@ type MsgServer interface {
 	// SetUserPreferences defines the operation that allows users to set their
 	// preferences for the restaking module.
 	SetUserPreferences(context.Context, *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error)
+	// RedelegatePool defines the operation that allows users to move their
+	// assets from a pool to an operator or a service without waiting for the
+	// unbonding period to pass.
+	RedelegatePool(context.Context, *MsgRedelegatePool) (*MsgRedelegateResponse, error)
+	// RedelegateOperator defines the operation that allows users to move their
+	// assets from an operator to another operator without waiting for the
+	// unbonding period to pass.
+	RedelegateOperator(context.Context, *MsgRedelegateOperator) (*MsgRedelegateResponse, error)
+	// RedelegateService defines the operation that allows users to move their
+	// assets from a service to another service without waiting for the
+	// unbonding period to pass.
+	RedelegateService(context.Context, *MsgRedelegateService) (*MsgRedelegateResponse, error)
+	// CancelUnbondingDelegation defines the operation that allows users to
+	// cancel an unbonding delegation entry that has not completed yet, delegating
+	// the tokens back to the same target.
+	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// UnjailOperator defines the operation that allows the operator admin to
+	// unjail an operator from a service once the jail period has ended.
+	UnjailOperator(context.Context, *MsgUnjailOperator) (*MsgUnjailOperatorResponse, error)
+	// SubmitOperatorEvidence defines the operation that allows anyone to submit
+	// an evidence of an operator misbehavior performed while securing a service.
+	SubmitOperatorEvidence(context.Context, *MsgSubmitOperatorEvidence) (*MsgSubmitOperatorEvidenceResponse, error)
+	// VetoSlash defines the operation that allows the module authority or the
+	// slash veto committee to cancel a pending slash before it gets executed.
+	VetoSlash(context.Context, *MsgVetoSlash) (*MsgVetoSlashResponse, error)
+	// SetOperatorServiceKey defines the operation that allows the operator admin
+	// to register the public key that the operator uses for a service.
+	SetOperatorServiceKey(context.Context, *MsgSetOperatorServiceKey) (*MsgSetOperatorServiceKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -343,6 +487,30 @@ func (UnimplementedMsgServer) UndelegateService(context.Context, *MsgUndelegateS
 func (UnimplementedMsgServer) SetUserPreferences(context.Context, *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserPreferences not implemented")
 }
+func (UnimplementedMsgServer) RedelegatePool(context.Context, *MsgRedelegatePool) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegatePool not implemented")
+}
+func (UnimplementedMsgServer) RedelegateOperator(context.Context, *MsgRedelegateOperator) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateOperator not implemented")
+}
+func (UnimplementedMsgServer) RedelegateService(context.Context, *MsgRedelegateService) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateService not implemented")
+}
+func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
+}
+func (UnimplementedMsgServer) UnjailOperator(context.Context, *MsgUnjailOperator) (*MsgUnjailOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailOperator not implemented")
+}
+func (UnimplementedMsgServer) SubmitOperatorEvidence(context.Context, *MsgSubmitOperatorEvidence) (*MsgSubmitOperatorEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOperatorEvidence not implemented")
+}
+func (UnimplementedMsgServer) VetoSlash(context.Context, *MsgVetoSlash) (*MsgVetoSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoSlash not implemented")
+}
+func (UnimplementedMsgServer) SetOperatorServiceKey(context.Context, *MsgSetOperatorServiceKey) (*MsgSetOperatorServiceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorServiceKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -616,6 +784,150 @@ func _Msg_SetUserPreferences_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegatePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegatePool(ctx, req.(*MsgRedelegatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegateOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateOperator(ctx, req.(*MsgRedelegateOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateService)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateService(ctx, req.(*MsgRedelegateService))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelUnbondingDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, req.(*MsgCancelUnbondingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnjailOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailOperator(ctx, req.(*MsgUnjailOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitOperatorEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOperatorEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOperatorEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitOperatorEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOperatorEvidence(ctx, req.(*MsgSubmitOperatorEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoSlash(ctx, req.(*MsgVetoSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOperatorServiceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorServiceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorServiceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOperatorServiceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorServiceKey(ctx, req.(*MsgSetOperatorServiceKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -679,6 +991,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetUserPreferences",
 			Handler:    _Msg_SetUserPreferences_Handler,
 		},
+		{
+			MethodName: "RedelegatePool",
+			Handler:    _Msg_RedelegatePool_Handler,
+		},
+		{
+			MethodName: "RedelegateOperator",
+			Handler:    _Msg_RedelegateOperator_Handler,
+		},
+		{
+			MethodName: "RedelegateService",
+			Handler:    _Msg_RedelegateService_Handler,
+		},
+		{
+			MethodName: "CancelUnbondingDelegation",
+			Handler:    _Msg_CancelUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "UnjailOperator",
+			Handler:    _Msg_UnjailOperator_Handler,
+		},
+		{
+			MethodName: "SubmitOperatorEvidence",
+			Handler:    _Msg_SubmitOperatorEvidence_Handler,
+		},
+		{
+			MethodName: "VetoSlash",
+			Handler:    _Msg_VetoSlash_Handler,
+		},
+		{
+			MethodName: "SetOperatorServiceKey",
+			Handler:    _Msg_SetOperatorServiceKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/restaking/v1/messages.proto",
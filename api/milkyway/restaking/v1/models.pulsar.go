@@ -3715,77 +3715,87 @@ func (x *fastReflection_DTDataList) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_UserPreferences_4_list)(nil)
+var _ protoreflect.List = (*_Redelegation_6_list)(nil)
 
-type _UserPreferences_4_list struct {
-	list *[]*TrustedServiceEntry
+type _Redelegation_6_list struct {
+	list *[]*RedelegationEntry
 }
 
-func (x *_UserPreferences_4_list) Len() int {
+func (x *_Redelegation_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_UserPreferences_4_list) Get(i int) protoreflect.Value {
+func (x *_Redelegation_6_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_UserPreferences_4_list) Set(i int, value protoreflect.Value) {
+func (x *_Redelegation_6_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TrustedServiceEntry)
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_UserPreferences_4_list) Append(value protoreflect.Value) {
+func (x *_Redelegation_6_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TrustedServiceEntry)
+	concreteValue := valueUnwrapped.Interface().(*RedelegationEntry)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_UserPreferences_4_list) AppendMutable() protoreflect.Value {
-	v := new(TrustedServiceEntry)
+func (x *_Redelegation_6_list) AppendMutable() protoreflect.Value {
+	v := new(RedelegationEntry)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UserPreferences_4_list) Truncate(n int) {
+func (x *_Redelegation_6_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_UserPreferences_4_list) NewElement() protoreflect.Value {
-	v := new(TrustedServiceEntry)
+func (x *_Redelegation_6_list) NewElement() protoreflect.Value {
+	v := new(RedelegationEntry)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_UserPreferences_4_list) IsValid() bool {
+func (x *_Redelegation_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_UserPreferences                  protoreflect.MessageDescriptor
-	fd_UserPreferences_trusted_services protoreflect.FieldDescriptor
+	md_Redelegation                   protoreflect.MessageDescriptor
+	fd_Redelegation_delegator_address protoreflect.FieldDescriptor
+	fd_Redelegation_src_type          protoreflect.FieldDescriptor
+	fd_Redelegation_src_target_id     protoreflect.FieldDescriptor
+	fd_Redelegation_dst_type          protoreflect.FieldDescriptor
+	fd_Redelegation_dst_target_id     protoreflect.FieldDescriptor
+	fd_Redelegation_entries           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_restaking_v1_models_proto_init()
-	md_UserPreferences = File_milkyway_restaking_v1_models_proto.Messages().ByName("UserPreferences")
-	fd_UserPreferences_trusted_services = md_UserPreferences.Fields().ByName("trusted_services")
+	md_Redelegation = File_milkyway_restaking_v1_models_proto.Messages().ByName("Redelegation")
+	fd_Redelegation_delegator_address = md_Redelegation.Fields().ByName("delegator_address")
+	fd_Redelegation_src_type = md_Redelegation.Fields().ByName("src_type")
+	fd_Redelegation_src_target_id = md_Redelegation.Fields().ByName("src_target_id")
+	fd_Redelegation_dst_type = md_Redelegation.Fields().ByName("dst_type")
+	fd_Redelegation_dst_target_id = md_Redelegation.Fields().ByName("dst_target_id")
+	fd_Redelegation_entries = md_Redelegation.Fields().ByName("entries")
 }
 
-var _ protoreflect.Message = (*fastReflection_UserPreferences)(nil)
+var _ protoreflect.Message = (*fastReflection_Redelegation)(nil)
 
-type fastReflection_UserPreferences UserPreferences
+type fastReflection_Redelegation Redelegation
 
-func (x *UserPreferences) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_UserPreferences)(x)
+func (x *Redelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Redelegation)(x)
 }
 
-func (x *UserPreferences) slowProtoReflect() protoreflect.Message {
+func (x *Redelegation) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3797,43 +3807,43 @@ func (x *UserPreferences) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_UserPreferences_messageType fastReflection_UserPreferences_messageType
-var _ protoreflect.MessageType = fastReflection_UserPreferences_messageType{}
+var _fastReflection_Redelegation_messageType fastReflection_Redelegation_messageType
+var _ protoreflect.MessageType = fastReflection_Redelegation_messageType{}
 
-type fastReflection_UserPreferences_messageType struct{}
+type fastReflection_Redelegation_messageType struct{}
 
-func (x fastReflection_UserPreferences_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_UserPreferences)(nil)
+func (x fastReflection_Redelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Redelegation)(nil)
 }
-func (x fastReflection_UserPreferences_messageType) New() protoreflect.Message {
-	return new(fastReflection_UserPreferences)
+func (x fastReflection_Redelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_Redelegation)
 }
-func (x fastReflection_UserPreferences_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_UserPreferences
+func (x fastReflection_Redelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redelegation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_UserPreferences) Descriptor() protoreflect.MessageDescriptor {
-	return md_UserPreferences
+func (x *fastReflection_Redelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_Redelegation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_UserPreferences) Type() protoreflect.MessageType {
-	return _fastReflection_UserPreferences_messageType
+func (x *fastReflection_Redelegation) Type() protoreflect.MessageType {
+	return _fastReflection_Redelegation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_UserPreferences) New() protoreflect.Message {
-	return new(fastReflection_UserPreferences)
+func (x *fastReflection_Redelegation) New() protoreflect.Message {
+	return new(fastReflection_Redelegation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_UserPreferences) Interface() protoreflect.ProtoMessage {
-	return (*UserPreferences)(x)
+func (x *fastReflection_Redelegation) Interface() protoreflect.ProtoMessage {
+	return (*Redelegation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3841,10 +3851,40 @@ func (x *fastReflection_UserPreferences) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_UserPreferences) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TrustedServices) != 0 {
-		value := protoreflect.ValueOfList(&_UserPreferences_4_list{list: &x.TrustedServices})
-		if !f(fd_UserPreferences_trusted_services, value) {
+func (x *fastReflection_Redelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_Redelegation_delegator_address, value) {
+			return
+		}
+	}
+	if x.SrcType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SrcType))
+		if !f(fd_Redelegation_src_type, value) {
+			return
+		}
+	}
+	if x.SrcTargetId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SrcTargetId)
+		if !f(fd_Redelegation_src_target_id, value) {
+			return
+		}
+	}
+	if x.DstType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DstType))
+		if !f(fd_Redelegation_dst_type, value) {
+			return
+		}
+	}
+	if x.DstTargetId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DstTargetId)
+		if !f(fd_Redelegation_dst_target_id, value) {
+			return
+		}
+	}
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_Redelegation_6_list{list: &x.Entries})
+		if !f(fd_Redelegation_entries, value) {
 			return
 		}
 	}
@@ -3861,15 +3901,25 @@ func (x *fastReflection_UserPreferences) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_UserPreferences) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Redelegation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
-		return len(x.TrustedServices) != 0
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		return x.SrcType != 0
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		return x.SrcTargetId != uint32(0)
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		return x.DstType != 0
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		return x.DstTargetId != uint32(0)
+	case "milkyway.restaking.v1.Redelegation.entries":
+		return len(x.Entries) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3879,15 +3929,25 @@ func (x *fastReflection_UserPreferences) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UserPreferences) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Redelegation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
-		x.TrustedServices = nil
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		x.SrcType = 0
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		x.SrcTargetId = uint32(0)
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		x.DstType = 0
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		x.DstTargetId = uint32(0)
+	case "milkyway.restaking.v1.Redelegation.entries":
+		x.Entries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3897,19 +3957,34 @@ func (x *fastReflection_UserPreferences) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_UserPreferences) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
-		if len(x.TrustedServices) == 0 {
-			return protoreflect.ValueOfList(&_UserPreferences_4_list{})
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		value := x.SrcType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		value := x.SrcTargetId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		value := x.DstType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		value := x.DstTargetId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.Redelegation.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_Redelegation_6_list{})
 		}
-		listValue := &_UserPreferences_4_list{list: &x.TrustedServices}
+		listValue := &_Redelegation_6_list{list: &x.Entries}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3923,17 +3998,27 @@ func (x *fastReflection_UserPreferences) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UserPreferences) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Redelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		x.SrcType = (DelegationType)(value.Enum())
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		x.SrcTargetId = uint32(value.Uint())
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		x.DstType = (DelegationType)(value.Enum())
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		x.DstTargetId = uint32(value.Uint())
+	case "milkyway.restaking.v1.Redelegation.entries":
 		lv := value.List()
-		clv := lv.(*_UserPreferences_4_list)
-		x.TrustedServices = *clv.list
+		clv := lv.(*_Redelegation_6_list)
+		x.Entries = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3947,45 +4032,65 @@ func (x *fastReflection_UserPreferences) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UserPreferences) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
-		if x.TrustedServices == nil {
-			x.TrustedServices = []*TrustedServiceEntry{}
+	case "milkyway.restaking.v1.Redelegation.entries":
+		if x.Entries == nil {
+			x.Entries = []*RedelegationEntry{}
 		}
-		value := &_UserPreferences_4_list{list: &x.TrustedServices}
+		value := &_Redelegation_6_list{list: &x.Entries}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.restaking.v1.Redelegation is not mutable"))
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		panic(fmt.Errorf("field src_type of message milkyway.restaking.v1.Redelegation is not mutable"))
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		panic(fmt.Errorf("field src_target_id of message milkyway.restaking.v1.Redelegation is not mutable"))
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		panic(fmt.Errorf("field dst_type of message milkyway.restaking.v1.Redelegation is not mutable"))
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		panic(fmt.Errorf("field dst_target_id of message milkyway.restaking.v1.Redelegation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_UserPreferences) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Redelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.UserPreferences.trusted_services":
-		list := []*TrustedServiceEntry{}
-		return protoreflect.ValueOfList(&_UserPreferences_4_list{list: &list})
+	case "milkyway.restaking.v1.Redelegation.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.Redelegation.src_type":
+		return protoreflect.ValueOfEnum(0)
+	case "milkyway.restaking.v1.Redelegation.src_target_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.Redelegation.dst_type":
+		return protoreflect.ValueOfEnum(0)
+	case "milkyway.restaking.v1.Redelegation.dst_target_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.Redelegation.entries":
+		list := []*RedelegationEntry{}
+		return protoreflect.ValueOfList(&_Redelegation_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Redelegation"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.Redelegation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_UserPreferences) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Redelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.UserPreferences", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.Redelegation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3993,7 +4098,7 @@ func (x *fastReflection_UserPreferences) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_UserPreferences) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Redelegation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4004,7 +4109,7 @@ func (x *fastReflection_UserPreferences) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_UserPreferences) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Redelegation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4016,7 +4121,7 @@ func (x *fastReflection_UserPreferences) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_UserPreferences) IsValid() bool {
+func (x *fastReflection_Redelegation) IsValid() bool {
 	return x != nil
 }
 
@@ -4026,9 +4131,9 @@ func (x *fastReflection_UserPreferences) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Redelegation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*UserPreferences)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4040,8 +4145,24 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.TrustedServices) > 0 {
-			for _, e := range x.TrustedServices {
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SrcType != 0 {
+			n += 1 + runtime.Sov(uint64(x.SrcType))
+		}
+		if x.SrcTargetId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SrcTargetId))
+		}
+		if x.DstType != 0 {
+			n += 1 + runtime.Sov(uint64(x.DstType))
+		}
+		if x.DstTargetId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DstTargetId))
+		}
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4056,7 +4177,7 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*UserPreferences)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4075,9 +4196,9 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TrustedServices) > 0 {
-			for iNdEx := len(x.TrustedServices) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TrustedServices[iNdEx])
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4088,9 +4209,36 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0x32
 			}
 		}
+		if x.DstTargetId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DstTargetId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DstType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DstType))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SrcTargetId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SrcTargetId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SrcType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SrcType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4102,7 +4250,7 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*UserPreferences)
+		x := input.Message.Interface().(*Redelegation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4134,17 +4282,17 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UserPreferences: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 4:
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedServices", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4154,129 +4302,299 @@ func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TrustedServices = append(x.TrustedServices, &TrustedServiceEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrustedServices[len(x.TrustedServices)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcType", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.SrcType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SrcType |= DelegationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcTargetId", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.SrcTargetId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SrcTargetId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_TrustedServiceEntry_2_list)(nil)
-
-type _TrustedServiceEntry_2_list struct {
-	list *[]uint32
-}
-
-func (x *_TrustedServiceEntry_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TrustedServiceEntry_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint32((*x.list)[i])
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstType", wireType)
+				}
+				x.DstType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DstType |= DelegationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstTargetId", wireType)
+				}
+				x.DstTargetId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DstTargetId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &RedelegationEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-func (x *_TrustedServiceEntry_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
+var _ protoreflect.List = (*_RedelegationEntry_3_list)(nil)
+
+type _RedelegationEntry_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RedelegationEntry_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RedelegationEntry_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RedelegationEntry_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TrustedServiceEntry_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
+func (x *_RedelegationEntry_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TrustedServiceEntry_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TrustedServiceEntry at list field PoolsIds as it is not of Message kind"))
+func (x *_RedelegationEntry_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TrustedServiceEntry_2_list) Truncate(n int) {
+func (x *_RedelegationEntry_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TrustedServiceEntry_2_list) NewElement() protoreflect.Value {
-	v := uint32(0)
-	return protoreflect.ValueOfUint32(v)
+func (x *_RedelegationEntry_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_TrustedServiceEntry_2_list) IsValid() bool {
+func (x *_RedelegationEntry_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RedelegationEntry_4_list)(nil)
+
+type _RedelegationEntry_4_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_RedelegationEntry_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RedelegationEntry_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RedelegationEntry_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RedelegationEntry_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RedelegationEntry_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationEntry_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RedelegationEntry_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RedelegationEntry_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TrustedServiceEntry            protoreflect.MessageDescriptor
-	fd_TrustedServiceEntry_service_id protoreflect.FieldDescriptor
-	fd_TrustedServiceEntry_pools_ids  protoreflect.FieldDescriptor
+	md_RedelegationEntry                 protoreflect.MessageDescriptor
+	fd_RedelegationEntry_creation_height protoreflect.FieldDescriptor
+	fd_RedelegationEntry_completion_time protoreflect.FieldDescriptor
+	fd_RedelegationEntry_initial_balance protoreflect.FieldDescriptor
+	fd_RedelegationEntry_shares_dst      protoreflect.FieldDescriptor
+	fd_RedelegationEntry_unbonding_id    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_restaking_v1_models_proto_init()
-	md_TrustedServiceEntry = File_milkyway_restaking_v1_models_proto.Messages().ByName("TrustedServiceEntry")
-	fd_TrustedServiceEntry_service_id = md_TrustedServiceEntry.Fields().ByName("service_id")
-	fd_TrustedServiceEntry_pools_ids = md_TrustedServiceEntry.Fields().ByName("pools_ids")
+	md_RedelegationEntry = File_milkyway_restaking_v1_models_proto.Messages().ByName("RedelegationEntry")
+	fd_RedelegationEntry_creation_height = md_RedelegationEntry.Fields().ByName("creation_height")
+	fd_RedelegationEntry_completion_time = md_RedelegationEntry.Fields().ByName("completion_time")
+	fd_RedelegationEntry_initial_balance = md_RedelegationEntry.Fields().ByName("initial_balance")
+	fd_RedelegationEntry_shares_dst = md_RedelegationEntry.Fields().ByName("shares_dst")
+	fd_RedelegationEntry_unbonding_id = md_RedelegationEntry.Fields().ByName("unbonding_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_TrustedServiceEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_RedelegationEntry)(nil)
 
-type fastReflection_TrustedServiceEntry TrustedServiceEntry
+type fastReflection_RedelegationEntry RedelegationEntry
 
-func (x *TrustedServiceEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TrustedServiceEntry)(x)
+func (x *RedelegationEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedelegationEntry)(x)
 }
 
-func (x *TrustedServiceEntry) slowProtoReflect() protoreflect.Message {
+func (x *RedelegationEntry) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4288,43 +4606,43 @@ func (x *TrustedServiceEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TrustedServiceEntry_messageType fastReflection_TrustedServiceEntry_messageType
-var _ protoreflect.MessageType = fastReflection_TrustedServiceEntry_messageType{}
+var _fastReflection_RedelegationEntry_messageType fastReflection_RedelegationEntry_messageType
+var _ protoreflect.MessageType = fastReflection_RedelegationEntry_messageType{}
 
-type fastReflection_TrustedServiceEntry_messageType struct{}
+type fastReflection_RedelegationEntry_messageType struct{}
 
-func (x fastReflection_TrustedServiceEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TrustedServiceEntry)(nil)
+func (x fastReflection_RedelegationEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedelegationEntry)(nil)
 }
-func (x fastReflection_TrustedServiceEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_TrustedServiceEntry)
+func (x fastReflection_RedelegationEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntry)
 }
-func (x fastReflection_TrustedServiceEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TrustedServiceEntry
+func (x fastReflection_RedelegationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TrustedServiceEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_TrustedServiceEntry
+func (x *fastReflection_RedelegationEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedelegationEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TrustedServiceEntry) Type() protoreflect.MessageType {
-	return _fastReflection_TrustedServiceEntry_messageType
+func (x *fastReflection_RedelegationEntry) Type() protoreflect.MessageType {
+	return _fastReflection_RedelegationEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TrustedServiceEntry) New() protoreflect.Message {
-	return new(fastReflection_TrustedServiceEntry)
+func (x *fastReflection_RedelegationEntry) New() protoreflect.Message {
+	return new(fastReflection_RedelegationEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TrustedServiceEntry) Interface() protoreflect.ProtoMessage {
-	return (*TrustedServiceEntry)(x)
+func (x *fastReflection_RedelegationEntry) Interface() protoreflect.ProtoMessage {
+	return (*RedelegationEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4332,16 +4650,34 @@ func (x *fastReflection_TrustedServiceEntry) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TrustedServiceEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ServiceId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ServiceId)
-		if !f(fd_TrustedServiceEntry_service_id, value) {
+func (x *fastReflection_RedelegationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CreationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreationHeight)
+		if !f(fd_RedelegationEntry_creation_height, value) {
 			return
 		}
 	}
-	if len(x.PoolsIds) != 0 {
-		value := protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{list: &x.PoolsIds})
-		if !f(fd_TrustedServiceEntry_pools_ids, value) {
+	if x.CompletionTime != nil {
+		value := protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+		if !f(fd_RedelegationEntry_completion_time, value) {
+			return
+		}
+	}
+	if len(x.InitialBalance) != 0 {
+		value := protoreflect.ValueOfList(&_RedelegationEntry_3_list{list: &x.InitialBalance})
+		if !f(fd_RedelegationEntry_initial_balance, value) {
+			return
+		}
+	}
+	if len(x.SharesDst) != 0 {
+		value := protoreflect.ValueOfList(&_RedelegationEntry_4_list{list: &x.SharesDst})
+		if !f(fd_RedelegationEntry_shares_dst, value) {
+			return
+		}
+	}
+	if x.UnbondingId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnbondingId)
+		if !f(fd_RedelegationEntry_unbonding_id, value) {
 			return
 		}
 	}
@@ -4358,17 +4694,23 @@ func (x *fastReflection_TrustedServiceEntry) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TrustedServiceEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RedelegationEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		return x.ServiceId != uint32(0)
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
-		return len(x.PoolsIds) != 0
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		return x.CreationHeight != int64(0)
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		return x.CompletionTime != nil
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
+		return len(x.InitialBalance) != 0
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		return len(x.SharesDst) != 0
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		return x.UnbondingId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4378,17 +4720,23 @@ func (x *fastReflection_TrustedServiceEntry) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedServiceEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RedelegationEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		x.ServiceId = uint32(0)
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
-		x.PoolsIds = nil
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		x.CreationHeight = int64(0)
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		x.CompletionTime = nil
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
+		x.InitialBalance = nil
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		x.SharesDst = nil
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		x.UnbondingId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4398,22 +4746,34 @@ func (x *fastReflection_TrustedServiceEntry) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TrustedServiceEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		value := x.ServiceId
-		return protoreflect.ValueOfUint32(value)
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
-		if len(x.PoolsIds) == 0 {
-			return protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{})
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		value := x.CreationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		value := x.CompletionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
+		if len(x.InitialBalance) == 0 {
+			return protoreflect.ValueOfList(&_RedelegationEntry_3_list{})
 		}
-		listValue := &_TrustedServiceEntry_2_list{list: &x.PoolsIds}
+		listValue := &_RedelegationEntry_3_list{list: &x.InitialBalance}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		if len(x.SharesDst) == 0 {
+			return protoreflect.ValueOfList(&_RedelegationEntry_4_list{})
+		}
+		listValue := &_RedelegationEntry_4_list{list: &x.SharesDst}
 		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		value := x.UnbondingId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4427,19 +4787,27 @@ func (x *fastReflection_TrustedServiceEntry) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedServiceEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RedelegationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		x.ServiceId = uint32(value.Uint())
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		x.CreationHeight = value.Int()
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		x.CompletionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
 		lv := value.List()
-		clv := lv.(*_TrustedServiceEntry_2_list)
-		x.PoolsIds = *clv.list
+		clv := lv.(*_RedelegationEntry_3_list)
+		x.InitialBalance = *clv.list
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		lv := value.List()
+		clv := lv.(*_RedelegationEntry_4_list)
+		x.SharesDst = *clv.list
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		x.UnbondingId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4453,49 +4821,70 @@ func (x *fastReflection_TrustedServiceEntry) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedServiceEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
-		if x.PoolsIds == nil {
-			x.PoolsIds = []uint32{}
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		if x.CompletionTime == nil {
+			x.CompletionTime = new(timestamppb.Timestamp)
 		}
-		value := &_TrustedServiceEntry_2_list{list: &x.PoolsIds}
+		return protoreflect.ValueOfMessage(x.CompletionTime.ProtoReflect())
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
+		if x.InitialBalance == nil {
+			x.InitialBalance = []*v1beta1.Coin{}
+		}
+		value := &_RedelegationEntry_3_list{list: &x.InitialBalance}
 		return protoreflect.ValueOfList(value)
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.TrustedServiceEntry is not mutable"))
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		if x.SharesDst == nil {
+			x.SharesDst = []*v1beta1.DecCoin{}
+		}
+		value := &_RedelegationEntry_4_list{list: &x.SharesDst}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		panic(fmt.Errorf("field creation_height of message milkyway.restaking.v1.RedelegationEntry is not mutable"))
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		panic(fmt.Errorf("field unbonding_id of message milkyway.restaking.v1.RedelegationEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TrustedServiceEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedelegationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
-		list := []uint32{}
-		return protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{list: &list})
+	case "milkyway.restaking.v1.RedelegationEntry.creation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.RedelegationEntry.completion_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "milkyway.restaking.v1.RedelegationEntry.initial_balance":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RedelegationEntry_3_list{list: &list})
+	case "milkyway.restaking.v1.RedelegationEntry.shares_dst":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_RedelegationEntry_4_list{list: &list})
+	case "milkyway.restaking.v1.RedelegationEntry.unbonding_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RedelegationEntry"))
 		}
-		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.restaking.v1.RedelegationEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TrustedServiceEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RedelegationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.TrustedServiceEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.RedelegationEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4503,7 +4892,7 @@ func (x *fastReflection_TrustedServiceEntry) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TrustedServiceEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RedelegationEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4514,7 +4903,7 @@ func (x *fastReflection_TrustedServiceEntry) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedServiceEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RedelegationEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4526,7 +4915,7 @@ func (x *fastReflection_TrustedServiceEntry) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TrustedServiceEntry) IsValid() bool {
+func (x *fastReflection_RedelegationEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -4536,9 +4925,9 @@ func (x *fastReflection_TrustedServiceEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RedelegationEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TrustedServiceEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4550,15 +4939,27 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.ServiceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		if x.CreationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreationHeight))
 		}
-		if len(x.PoolsIds) > 0 {
-			l = 0
-			for _, e := range x.PoolsIds {
-				l += runtime.Sov(uint64(e))
+		if x.CompletionTime != nil {
+			l = options.Size(x.CompletionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InitialBalance) > 0 {
+			for _, e := range x.InitialBalance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
 			}
-			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.SharesDst) > 0 {
+			for _, e := range x.SharesDst {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.UnbondingId != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4570,7 +4971,7 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TrustedServiceEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4589,28 +4990,59 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PoolsIds) > 0 {
-			var pksize2 int
-			for _, num := range x.PoolsIds {
-				pksize2 += runtime.Sov(uint64(num))
+		if x.UnbondingId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.SharesDst) > 0 {
+			for iNdEx := len(x.SharesDst) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SharesDst[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
-			i -= pksize2
-			j1 := i
-			for _, num := range x.PoolsIds {
-				for num >= 1<<7 {
-					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-					num >>= 7
-					j1++
+		}
+		if len(x.InitialBalance) > 0 {
+			for iNdEx := len(x.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InitialBalance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				dAtA[j1] = uint8(num)
-				j1++
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+		}
+		if x.CompletionTime != nil {
+			encoded, err := options.Marshal(x.CompletionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ServiceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+		if x.CreationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationHeight))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4625,7 +5057,7 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TrustedServiceEntry)
+		x := input.Message.Interface().(*RedelegationEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4657,17 +5089,17 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedServiceEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedServiceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 				}
-				x.ServiceId = 0
+				x.CreationHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4677,102 +5109,149 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ServiceId |= uint32(b&0x7F) << shift
+					x.CreationHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType == 0 {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					x.PoolsIds = append(x.PoolsIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					var count int
-					for _, integer := range dAtA[iNdEx:postIndex] {
-						if integer < 128 {
-							count++
-						}
-					}
-					elementCount = count
-					if elementCount != 0 && len(x.PoolsIds) == 0 {
-						x.PoolsIds = make([]uint32, 0, elementCount)
-					}
-					for iNdEx < postIndex {
-						var v uint32
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.PoolsIds = append(x.PoolsIds, v)
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolsIds", wireType)
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
+				if x.CompletionTime == nil {
+					x.CompletionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompletionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialBalance = append(x.InitialBalance, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialBalance[len(x.InitialBalance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SharesDst = append(x.SharesDst, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SharesDst[len(x.SharesDst)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+				}
+				x.UnbondingId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
 				iNdEx += skippy
 			}
 		}
@@ -4793,500 +5272,8018 @@ func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: milkyway/restaking/v1/models.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_RTData                   protoreflect.MessageDescriptor
+	fd_RTData_delegator_address protoreflect.FieldDescriptor
+	fd_RTData_src_type          protoreflect.FieldDescriptor
+	fd_RTData_src_target_id     protoreflect.FieldDescriptor
+	fd_RTData_dst_type          protoreflect.FieldDescriptor
+	fd_RTData_dst_target_id     protoreflect.FieldDescriptor
 )
 
-// DelegationType defines the type of delegation.
-type DelegationType int32
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_RTData = File_milkyway_restaking_v1_models_proto.Messages().ByName("RTData")
+	fd_RTData_delegator_address = md_RTData.Fields().ByName("delegator_address")
+	fd_RTData_src_type = md_RTData.Fields().ByName("src_type")
+	fd_RTData_src_target_id = md_RTData.Fields().ByName("src_target_id")
+	fd_RTData_dst_type = md_RTData.Fields().ByName("dst_type")
+	fd_RTData_dst_target_id = md_RTData.Fields().ByName("dst_target_id")
+}
 
-const (
-	// DELEGATION_TYPE_UNSPECIFIED defines an unspecified delegation type.
-	DelegationType_DELEGATION_TYPE_UNSPECIFIED DelegationType = 0
-	// DELEGATION_TYPE_POOL defines a delegation to a pool.
-	DelegationType_DELEGATION_TYPE_POOL DelegationType = 1
-	// DELEGATION_TYPE_OPERATOR defines a delegation to an operator.
-	DelegationType_DELEGATION_TYPE_OPERATOR DelegationType = 2
-	// DELEGATION_TYPE_SERVICE defines a delegation to a service.
-	DelegationType_DELEGATION_TYPE_SERVICE DelegationType = 3
-)
+var _ protoreflect.Message = (*fastReflection_RTData)(nil)
 
-// Enum value maps for DelegationType.
-var (
-	DelegationType_name = map[int32]string{
-		0: "DELEGATION_TYPE_UNSPECIFIED",
-		1: "DELEGATION_TYPE_POOL",
-		2: "DELEGATION_TYPE_OPERATOR",
-		3: "DELEGATION_TYPE_SERVICE",
+type fastReflection_RTData RTData
+
+func (x *RTData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RTData)(x)
+}
+
+func (x *RTData) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	DelegationType_value = map[string]int32{
-		"DELEGATION_TYPE_UNSPECIFIED": 0,
-		"DELEGATION_TYPE_POOL":        1,
-		"DELEGATION_TYPE_OPERATOR":    2,
-		"DELEGATION_TYPE_SERVICE":     3,
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RTData_messageType fastReflection_RTData_messageType
+var _ protoreflect.MessageType = fastReflection_RTData_messageType{}
+
+type fastReflection_RTData_messageType struct{}
+
+func (x fastReflection_RTData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RTData)(nil)
+}
+func (x fastReflection_RTData_messageType) New() protoreflect.Message {
+	return new(fastReflection_RTData)
+}
+func (x fastReflection_RTData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RTData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RTData) Descriptor() protoreflect.MessageDescriptor {
+	return md_RTData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RTData) Type() protoreflect.MessageType {
+	return _fastReflection_RTData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RTData) New() protoreflect.Message {
+	return new(fastReflection_RTData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RTData) Interface() protoreflect.ProtoMessage {
+	return (*RTData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RTData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_RTData_delegator_address, value) {
+			return
+		}
+	}
+	if x.SrcType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SrcType))
+		if !f(fd_RTData_src_type, value) {
+			return
+		}
+	}
+	if x.SrcTargetId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SrcTargetId)
+		if !f(fd_RTData_src_target_id, value) {
+			return
+		}
+	}
+	if x.DstType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DstType))
+		if !f(fd_RTData_dst_type, value) {
+			return
+		}
+	}
+	if x.DstTargetId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DstTargetId)
+		if !f(fd_RTData_dst_target_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RTData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.restaking.v1.RTData.src_type":
+		return x.SrcType != 0
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		return x.SrcTargetId != uint32(0)
+	case "milkyway.restaking.v1.RTData.dst_type":
+		return x.DstType != 0
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		return x.DstTargetId != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.restaking.v1.RTData.src_type":
+		x.SrcType = 0
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		x.SrcTargetId = uint32(0)
+	case "milkyway.restaking.v1.RTData.dst_type":
+		x.DstType = 0
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		x.DstTargetId = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RTData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.RTData.src_type":
+		value := x.SrcType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		value := x.SrcTargetId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.RTData.dst_type":
+		value := x.DstType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		value := x.DstTargetId
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.restaking.v1.RTData.src_type":
+		x.SrcType = (DelegationType)(value.Enum())
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		x.SrcTargetId = uint32(value.Uint())
+	case "milkyway.restaking.v1.RTData.dst_type":
+		x.DstType = (DelegationType)(value.Enum())
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		x.DstTargetId = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.restaking.v1.RTData is not mutable"))
+	case "milkyway.restaking.v1.RTData.src_type":
+		panic(fmt.Errorf("field src_type of message milkyway.restaking.v1.RTData is not mutable"))
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		panic(fmt.Errorf("field src_target_id of message milkyway.restaking.v1.RTData is not mutable"))
+	case "milkyway.restaking.v1.RTData.dst_type":
+		panic(fmt.Errorf("field dst_type of message milkyway.restaking.v1.RTData is not mutable"))
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		panic(fmt.Errorf("field dst_target_id of message milkyway.restaking.v1.RTData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RTData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTData.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.RTData.src_type":
+		return protoreflect.ValueOfEnum(0)
+	case "milkyway.restaking.v1.RTData.src_target_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.RTData.dst_type":
+		return protoreflect.ValueOfEnum(0)
+	case "milkyway.restaking.v1.RTData.dst_target_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTData"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RTData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.RTData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RTData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RTData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RTData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RTData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SrcType != 0 {
+			n += 1 + runtime.Sov(uint64(x.SrcType))
+		}
+		if x.SrcTargetId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SrcTargetId))
+		}
+		if x.DstType != 0 {
+			n += 1 + runtime.Sov(uint64(x.DstType))
+		}
+		if x.DstTargetId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DstTargetId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RTData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DstTargetId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DstTargetId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DstType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DstType))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SrcTargetId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SrcTargetId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SrcType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SrcType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RTData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RTData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RTData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcType", wireType)
+				}
+				x.SrcType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SrcType |= DelegationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcTargetId", wireType)
+				}
+				x.SrcTargetId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SrcTargetId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstType", wireType)
+				}
+				x.DstType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DstType |= DelegationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DstTargetId", wireType)
+				}
+				x.DstTargetId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DstTargetId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RTDataList_1_list)(nil)
+
+type _RTDataList_1_list struct {
+	list *[]*RTData
+}
+
+func (x *_RTDataList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RTDataList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RTDataList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RTData)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RTDataList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RTData)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RTDataList_1_list) AppendMutable() protoreflect.Value {
+	v := new(RTData)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RTDataList_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RTDataList_1_list) NewElement() protoreflect.Value {
+	v := new(RTData)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RTDataList_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RTDataList      protoreflect.MessageDescriptor
+	fd_RTDataList_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_RTDataList = File_milkyway_restaking_v1_models_proto.Messages().ByName("RTDataList")
+	fd_RTDataList_data = md_RTDataList.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_RTDataList)(nil)
+
+type fastReflection_RTDataList RTDataList
+
+func (x *RTDataList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RTDataList)(x)
+}
+
+func (x *RTDataList) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RTDataList_messageType fastReflection_RTDataList_messageType
+var _ protoreflect.MessageType = fastReflection_RTDataList_messageType{}
+
+type fastReflection_RTDataList_messageType struct{}
+
+func (x fastReflection_RTDataList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RTDataList)(nil)
+}
+func (x fastReflection_RTDataList_messageType) New() protoreflect.Message {
+	return new(fastReflection_RTDataList)
+}
+func (x fastReflection_RTDataList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RTDataList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RTDataList) Descriptor() protoreflect.MessageDescriptor {
+	return md_RTDataList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RTDataList) Type() protoreflect.MessageType {
+	return _fastReflection_RTDataList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RTDataList) New() protoreflect.Message {
+	return new(fastReflection_RTDataList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RTDataList) Interface() protoreflect.ProtoMessage {
+	return (*RTDataList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RTDataList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfList(&_RTDataList_1_list{list: &x.Data})
+		if !f(fd_RTDataList_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RTDataList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTDataList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RTDataList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		if len(x.Data) == 0 {
+			return protoreflect.ValueOfList(&_RTDataList_1_list{})
+		}
+		listValue := &_RTDataList_1_list{list: &x.Data}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTDataList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		lv := value.List()
+		clv := lv.(*_RTDataList_1_list)
+		x.Data = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTDataList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		if x.Data == nil {
+			x.Data = []*RTData{}
+		}
+		value := &_RTDataList_1_list{list: &x.Data}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RTDataList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.RTDataList.data":
+		list := []*RTData{}
+		return protoreflect.ValueOfList(&_RTDataList_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.RTDataList"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.RTDataList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RTDataList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.RTDataList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RTDataList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RTDataList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RTDataList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RTDataList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RTDataList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Data) > 0 {
+			for _, e := range x.Data {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RTDataList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			for iNdEx := len(x.Data) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Data[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RTDataList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RTDataList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RTDataList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data, &RTData{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Data[len(x.Data)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_UserPreferences_4_list)(nil)
+
+type _UserPreferences_4_list struct {
+	list *[]*TrustedServiceEntry
+}
+
+func (x *_UserPreferences_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_UserPreferences_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_UserPreferences_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustedServiceEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UserPreferences_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustedServiceEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UserPreferences_4_list) AppendMutable() protoreflect.Value {
+	v := new(TrustedServiceEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UserPreferences_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UserPreferences_4_list) NewElement() protoreflect.Value {
+	v := new(TrustedServiceEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UserPreferences_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UserPreferences                  protoreflect.MessageDescriptor
+	fd_UserPreferences_trusted_services protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_UserPreferences = File_milkyway_restaking_v1_models_proto.Messages().ByName("UserPreferences")
+	fd_UserPreferences_trusted_services = md_UserPreferences.Fields().ByName("trusted_services")
+}
+
+var _ protoreflect.Message = (*fastReflection_UserPreferences)(nil)
+
+type fastReflection_UserPreferences UserPreferences
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UserPreferences)(x)
+}
+
+func (x *UserPreferences) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UserPreferences_messageType fastReflection_UserPreferences_messageType
+var _ protoreflect.MessageType = fastReflection_UserPreferences_messageType{}
+
+type fastReflection_UserPreferences_messageType struct{}
+
+func (x fastReflection_UserPreferences_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UserPreferences)(nil)
+}
+func (x fastReflection_UserPreferences_messageType) New() protoreflect.Message {
+	return new(fastReflection_UserPreferences)
+}
+func (x fastReflection_UserPreferences_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UserPreferences
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UserPreferences) Descriptor() protoreflect.MessageDescriptor {
+	return md_UserPreferences
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UserPreferences) Type() protoreflect.MessageType {
+	return _fastReflection_UserPreferences_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UserPreferences) New() protoreflect.Message {
+	return new(fastReflection_UserPreferences)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UserPreferences) Interface() protoreflect.ProtoMessage {
+	return (*UserPreferences)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UserPreferences) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TrustedServices) != 0 {
+		value := protoreflect.ValueOfList(&_UserPreferences_4_list{list: &x.TrustedServices})
+		if !f(fd_UserPreferences_trusted_services, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UserPreferences) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		return len(x.TrustedServices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UserPreferences) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		x.TrustedServices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UserPreferences) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		if len(x.TrustedServices) == 0 {
+			return protoreflect.ValueOfList(&_UserPreferences_4_list{})
+		}
+		listValue := &_UserPreferences_4_list{list: &x.TrustedServices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UserPreferences) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		lv := value.List()
+		clv := lv.(*_UserPreferences_4_list)
+		x.TrustedServices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UserPreferences) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		if x.TrustedServices == nil {
+			x.TrustedServices = []*TrustedServiceEntry{}
+		}
+		value := &_UserPreferences_4_list{list: &x.TrustedServices}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UserPreferences) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.UserPreferences.trusted_services":
+		list := []*TrustedServiceEntry{}
+		return protoreflect.ValueOfList(&_UserPreferences_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.UserPreferences"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.UserPreferences does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UserPreferences) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.UserPreferences", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UserPreferences) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UserPreferences) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UserPreferences) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UserPreferences) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UserPreferences)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TrustedServices) > 0 {
+			for _, e := range x.TrustedServices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UserPreferences)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TrustedServices) > 0 {
+			for iNdEx := len(x.TrustedServices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TrustedServices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UserPreferences)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UserPreferences: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedServices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrustedServices = append(x.TrustedServices, &TrustedServiceEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrustedServices[len(x.TrustedServices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TrustedServiceEntry_2_list)(nil)
+
+type _TrustedServiceEntry_2_list struct {
+	list *[]uint32
+}
+
+func (x *_TrustedServiceEntry_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TrustedServiceEntry_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_TrustedServiceEntry_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TrustedServiceEntry_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TrustedServiceEntry_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TrustedServiceEntry at list field PoolsIds as it is not of Message kind"))
+}
+
+func (x *_TrustedServiceEntry_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TrustedServiceEntry_2_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_TrustedServiceEntry_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TrustedServiceEntry            protoreflect.MessageDescriptor
+	fd_TrustedServiceEntry_service_id protoreflect.FieldDescriptor
+	fd_TrustedServiceEntry_pools_ids  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_TrustedServiceEntry = File_milkyway_restaking_v1_models_proto.Messages().ByName("TrustedServiceEntry")
+	fd_TrustedServiceEntry_service_id = md_TrustedServiceEntry.Fields().ByName("service_id")
+	fd_TrustedServiceEntry_pools_ids = md_TrustedServiceEntry.Fields().ByName("pools_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrustedServiceEntry)(nil)
+
+type fastReflection_TrustedServiceEntry TrustedServiceEntry
+
+func (x *TrustedServiceEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrustedServiceEntry)(x)
+}
+
+func (x *TrustedServiceEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrustedServiceEntry_messageType fastReflection_TrustedServiceEntry_messageType
+var _ protoreflect.MessageType = fastReflection_TrustedServiceEntry_messageType{}
+
+type fastReflection_TrustedServiceEntry_messageType struct{}
+
+func (x fastReflection_TrustedServiceEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrustedServiceEntry)(nil)
+}
+func (x fastReflection_TrustedServiceEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrustedServiceEntry)
+}
+func (x fastReflection_TrustedServiceEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustedServiceEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrustedServiceEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustedServiceEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrustedServiceEntry) Type() protoreflect.MessageType {
+	return _fastReflection_TrustedServiceEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrustedServiceEntry) New() protoreflect.Message {
+	return new(fastReflection_TrustedServiceEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrustedServiceEntry) Interface() protoreflect.ProtoMessage {
+	return (*TrustedServiceEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrustedServiceEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_TrustedServiceEntry_service_id, value) {
+			return
+		}
+	}
+	if len(x.PoolsIds) != 0 {
+		value := protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{list: &x.PoolsIds})
+		if !f(fd_TrustedServiceEntry_pools_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrustedServiceEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		return len(x.PoolsIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedServiceEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		x.PoolsIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrustedServiceEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		if len(x.PoolsIds) == 0 {
+			return protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{})
+		}
+		listValue := &_TrustedServiceEntry_2_list{list: &x.PoolsIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedServiceEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		lv := value.List()
+		clv := lv.(*_TrustedServiceEntry_2_list)
+		x.PoolsIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedServiceEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		if x.PoolsIds == nil {
+			x.PoolsIds = []uint32{}
+		}
+		value := &_TrustedServiceEntry_2_list{list: &x.PoolsIds}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.TrustedServiceEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrustedServiceEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.TrustedServiceEntry.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.TrustedServiceEntry.pools_ids":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_TrustedServiceEntry_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.TrustedServiceEntry"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.TrustedServiceEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrustedServiceEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.TrustedServiceEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrustedServiceEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedServiceEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrustedServiceEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrustedServiceEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrustedServiceEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if len(x.PoolsIds) > 0 {
+			l = 0
+			for _, e := range x.PoolsIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrustedServiceEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PoolsIds) > 0 {
+			var pksize2 int
+			for _, num := range x.PoolsIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PoolsIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrustedServiceEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedServiceEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedServiceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PoolsIds = append(x.PoolsIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PoolsIds) == 0 {
+						x.PoolsIds = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PoolsIds = append(x.PoolsIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolsIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatingOperator_2_list)(nil)
+
+type _ValidatingOperator_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ValidatingOperator_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatingOperator_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatingOperator_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatingOperator_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatingOperator_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatingOperator_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatingOperator_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatingOperator_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatingOperator             protoreflect.MessageDescriptor
+	fd_ValidatingOperator_operator_id protoreflect.FieldDescriptor
+	fd_ValidatingOperator_tokens      protoreflect.FieldDescriptor
+	fd_ValidatingOperator_usd_value   protoreflect.FieldDescriptor
+	fd_ValidatingOperator_power       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_ValidatingOperator = File_milkyway_restaking_v1_models_proto.Messages().ByName("ValidatingOperator")
+	fd_ValidatingOperator_operator_id = md_ValidatingOperator.Fields().ByName("operator_id")
+	fd_ValidatingOperator_tokens = md_ValidatingOperator.Fields().ByName("tokens")
+	fd_ValidatingOperator_usd_value = md_ValidatingOperator.Fields().ByName("usd_value")
+	fd_ValidatingOperator_power = md_ValidatingOperator.Fields().ByName("power")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatingOperator)(nil)
+
+type fastReflection_ValidatingOperator ValidatingOperator
+
+func (x *ValidatingOperator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatingOperator)(x)
+}
+
+func (x *ValidatingOperator) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatingOperator_messageType fastReflection_ValidatingOperator_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatingOperator_messageType{}
+
+type fastReflection_ValidatingOperator_messageType struct{}
+
+func (x fastReflection_ValidatingOperator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatingOperator)(nil)
+}
+func (x fastReflection_ValidatingOperator_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatingOperator)
+}
+func (x fastReflection_ValidatingOperator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatingOperator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatingOperator) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatingOperator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatingOperator) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatingOperator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatingOperator) New() protoreflect.Message {
+	return new(fastReflection_ValidatingOperator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatingOperator) Interface() protoreflect.ProtoMessage {
+	return (*ValidatingOperator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatingOperator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_ValidatingOperator_operator_id, value) {
+			return
+		}
+	}
+	if len(x.Tokens) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatingOperator_2_list{list: &x.Tokens})
+		if !f(fd_ValidatingOperator_tokens, value) {
+			return
+		}
+	}
+	if x.UsdValue != "" {
+		value := protoreflect.ValueOfString(x.UsdValue)
+		if !f(fd_ValidatingOperator_usd_value, value) {
+			return
+		}
+	}
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_ValidatingOperator_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatingOperator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		return len(x.Tokens) != 0
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		return x.UsdValue != ""
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		return x.Power != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatingOperator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		x.Tokens = nil
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		x.UsdValue = ""
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		x.Power = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatingOperator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		if len(x.Tokens) == 0 {
+			return protoreflect.ValueOfList(&_ValidatingOperator_2_list{})
+		}
+		listValue := &_ValidatingOperator_2_list{list: &x.Tokens}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		value := x.UsdValue
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatingOperator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		lv := value.List()
+		clv := lv.(*_ValidatingOperator_2_list)
+		x.Tokens = *clv.list
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		x.UsdValue = value.Interface().(string)
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		x.Power = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatingOperator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		if x.Tokens == nil {
+			x.Tokens = []*v1beta1.Coin{}
+		}
+		value := &_ValidatingOperator_2_list{list: &x.Tokens}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.restaking.v1.ValidatingOperator is not mutable"))
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		panic(fmt.Errorf("field usd_value of message milkyway.restaking.v1.ValidatingOperator is not mutable"))
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		panic(fmt.Errorf("field power of message milkyway.restaking.v1.ValidatingOperator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatingOperator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ValidatingOperator.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.ValidatingOperator.tokens":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ValidatingOperator_2_list{list: &list})
+	case "milkyway.restaking.v1.ValidatingOperator.usd_value":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.ValidatingOperator.power":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ValidatingOperator"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ValidatingOperator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatingOperator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.ValidatingOperator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatingOperator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatingOperator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatingOperator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatingOperator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatingOperator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if len(x.Tokens) > 0 {
+			for _, e := range x.Tokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.UsdValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatingOperator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.UsdValue) > 0 {
+			i -= len(x.UsdValue)
+			copy(dAtA[i:], x.UsdValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UsdValue)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Tokens) > 0 {
+			for iNdEx := len(x.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatingOperator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatingOperator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatingOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tokens = append(x.Tokens, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tokens[len(x.Tokens)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsdValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UsdValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				}
+				x.Power = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Power |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ServiceSnapshot_5_list)(nil)
+
+type _ServiceSnapshot_5_list struct {
+	list *[]*ValidatingOperator
+}
+
+func (x *_ServiceSnapshot_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceSnapshot_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ServiceSnapshot_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatingOperator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceSnapshot_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatingOperator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceSnapshot_5_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatingOperator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceSnapshot_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceSnapshot_5_list) NewElement() protoreflect.Value {
+	v := new(ValidatingOperator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ServiceSnapshot_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ServiceSnapshot             protoreflect.MessageDescriptor
+	fd_ServiceSnapshot_service_id  protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_epoch       protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_height      protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_time        protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_operators   protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_total_power protoreflect.FieldDescriptor
+	fd_ServiceSnapshot_merkle_root protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_ServiceSnapshot = File_milkyway_restaking_v1_models_proto.Messages().ByName("ServiceSnapshot")
+	fd_ServiceSnapshot_service_id = md_ServiceSnapshot.Fields().ByName("service_id")
+	fd_ServiceSnapshot_epoch = md_ServiceSnapshot.Fields().ByName("epoch")
+	fd_ServiceSnapshot_height = md_ServiceSnapshot.Fields().ByName("height")
+	fd_ServiceSnapshot_time = md_ServiceSnapshot.Fields().ByName("time")
+	fd_ServiceSnapshot_operators = md_ServiceSnapshot.Fields().ByName("operators")
+	fd_ServiceSnapshot_total_power = md_ServiceSnapshot.Fields().ByName("total_power")
+	fd_ServiceSnapshot_merkle_root = md_ServiceSnapshot.Fields().ByName("merkle_root")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceSnapshot)(nil)
+
+type fastReflection_ServiceSnapshot ServiceSnapshot
+
+func (x *ServiceSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceSnapshot)(x)
+}
+
+func (x *ServiceSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceSnapshot_messageType fastReflection_ServiceSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceSnapshot_messageType{}
+
+type fastReflection_ServiceSnapshot_messageType struct{}
+
+func (x fastReflection_ServiceSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceSnapshot)(nil)
+}
+func (x fastReflection_ServiceSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceSnapshot)
+}
+func (x fastReflection_ServiceSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceSnapshot) New() protoreflect.Message {
+	return new(fastReflection_ServiceSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*ServiceSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_ServiceSnapshot_service_id, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_ServiceSnapshot_epoch, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ServiceSnapshot_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_ServiceSnapshot_time, value) {
+			return
+		}
+	}
+	if len(x.Operators) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceSnapshot_5_list{list: &x.Operators})
+		if !f(fd_ServiceSnapshot_operators, value) {
+			return
+		}
+	}
+	if x.TotalPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalPower)
+		if !f(fd_ServiceSnapshot_total_power, value) {
+			return
+		}
+	}
+	if len(x.MerkleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.MerkleRoot)
+		if !f(fd_ServiceSnapshot_merkle_root, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		return x.Epoch != uint64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		return x.Height != int64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		return x.Time != nil
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		return len(x.Operators) != 0
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		return x.TotalPower != int64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		return len(x.MerkleRoot) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		x.Epoch = uint64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		x.Height = int64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		x.Time = nil
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		x.Operators = nil
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		x.TotalPower = int64(0)
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		x.MerkleRoot = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		if len(x.Operators) == 0 {
+			return protoreflect.ValueOfList(&_ServiceSnapshot_5_list{})
+		}
+		listValue := &_ServiceSnapshot_5_list{list: &x.Operators}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		x.Epoch = value.Uint()
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		x.Height = value.Int()
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		lv := value.List()
+		clv := lv.(*_ServiceSnapshot_5_list)
+		x.Operators = *clv.list
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		x.TotalPower = value.Int()
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		x.MerkleRoot = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		if x.Operators == nil {
+			x.Operators = []*ValidatingOperator{}
+		}
+		value := &_ServiceSnapshot_5_list{list: &x.Operators}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.ServiceSnapshot is not mutable"))
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		panic(fmt.Errorf("field epoch of message milkyway.restaking.v1.ServiceSnapshot is not mutable"))
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		panic(fmt.Errorf("field height of message milkyway.restaking.v1.ServiceSnapshot is not mutable"))
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		panic(fmt.Errorf("field total_power of message milkyway.restaking.v1.ServiceSnapshot is not mutable"))
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message milkyway.restaking.v1.ServiceSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ServiceSnapshot.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.ServiceSnapshot.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.restaking.v1.ServiceSnapshot.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.ServiceSnapshot.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "milkyway.restaking.v1.ServiceSnapshot.operators":
+		list := []*ValidatingOperator{}
+		return protoreflect.ValueOfList(&_ServiceSnapshot_5_list{list: &list})
+	case "milkyway.restaking.v1.ServiceSnapshot.total_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.ServiceSnapshot.merkle_root":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ServiceSnapshot"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ServiceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.ServiceSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Operators) > 0 {
+			for _, e := range x.Operators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPower))
+		}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TotalPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPower))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Operators) > 0 {
+			for iNdEx := len(x.Operators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Operators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operators = append(x.Operators, &ValidatingOperator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Operators[len(x.Operators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				x.TotalPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerkleRoot = append(x.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.MerkleRoot == nil {
+					x.MerkleRoot = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OperatorJailRecord              protoreflect.MessageDescriptor
+	fd_OperatorJailRecord_service_id   protoreflect.FieldDescriptor
+	fd_OperatorJailRecord_operator_id  protoreflect.FieldDescriptor
+	fd_OperatorJailRecord_jailed_until protoreflect.FieldDescriptor
+	fd_OperatorJailRecord_tombstoned   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_OperatorJailRecord = File_milkyway_restaking_v1_models_proto.Messages().ByName("OperatorJailRecord")
+	fd_OperatorJailRecord_service_id = md_OperatorJailRecord.Fields().ByName("service_id")
+	fd_OperatorJailRecord_operator_id = md_OperatorJailRecord.Fields().ByName("operator_id")
+	fd_OperatorJailRecord_jailed_until = md_OperatorJailRecord.Fields().ByName("jailed_until")
+	fd_OperatorJailRecord_tombstoned = md_OperatorJailRecord.Fields().ByName("tombstoned")
+}
+
+var _ protoreflect.Message = (*fastReflection_OperatorJailRecord)(nil)
+
+type fastReflection_OperatorJailRecord OperatorJailRecord
+
+func (x *OperatorJailRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OperatorJailRecord)(x)
+}
+
+func (x *OperatorJailRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OperatorJailRecord_messageType fastReflection_OperatorJailRecord_messageType
+var _ protoreflect.MessageType = fastReflection_OperatorJailRecord_messageType{}
+
+type fastReflection_OperatorJailRecord_messageType struct{}
+
+func (x fastReflection_OperatorJailRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OperatorJailRecord)(nil)
+}
+func (x fastReflection_OperatorJailRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_OperatorJailRecord)
+}
+func (x fastReflection_OperatorJailRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorJailRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OperatorJailRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorJailRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OperatorJailRecord) Type() protoreflect.MessageType {
+	return _fastReflection_OperatorJailRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OperatorJailRecord) New() protoreflect.Message {
+	return new(fastReflection_OperatorJailRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OperatorJailRecord) Interface() protoreflect.ProtoMessage {
+	return (*OperatorJailRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OperatorJailRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_OperatorJailRecord_service_id, value) {
+			return
+		}
+	}
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_OperatorJailRecord_operator_id, value) {
+			return
+		}
+	}
+	if x.JailedUntil != nil {
+		value := protoreflect.ValueOfMessage(x.JailedUntil.ProtoReflect())
+		if !f(fd_OperatorJailRecord_jailed_until, value) {
+			return
+		}
+	}
+	if x.Tombstoned != false {
+		value := protoreflect.ValueOfBool(x.Tombstoned)
+		if !f(fd_OperatorJailRecord_tombstoned, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OperatorJailRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		return x.JailedUntil != nil
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		return x.Tombstoned != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorJailRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		x.JailedUntil = nil
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		x.Tombstoned = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OperatorJailRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		value := x.JailedUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		value := x.Tombstoned
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorJailRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		x.JailedUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		x.Tombstoned = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorJailRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		if x.JailedUntil == nil {
+			x.JailedUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.JailedUntil.ProtoReflect())
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.OperatorJailRecord is not mutable"))
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.restaking.v1.OperatorJailRecord is not mutable"))
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		panic(fmt.Errorf("field tombstoned of message milkyway.restaking.v1.OperatorJailRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OperatorJailRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorJailRecord.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.OperatorJailRecord.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.OperatorJailRecord.jailed_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "milkyway.restaking.v1.OperatorJailRecord.tombstoned":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorJailRecord"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorJailRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OperatorJailRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.OperatorJailRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OperatorJailRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorJailRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OperatorJailRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OperatorJailRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OperatorJailRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if x.JailedUntil != nil {
+			l = options.Size(x.JailedUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Tombstoned {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OperatorJailRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tombstoned {
+			i--
+			if x.Tombstoned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.JailedUntil != nil {
+			encoded, err := options.Marshal(x.JailedUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OperatorJailRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorJailRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorJailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JailedUntil == nil {
+					x.JailedUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailedUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Tombstoned = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractEvidence             protoreflect.MessageDescriptor
+	fd_ContractEvidence_operator_id protoreflect.FieldDescriptor
+	fd_ContractEvidence_height      protoreflect.FieldDescriptor
+	fd_ContractEvidence_data        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_ContractEvidence = File_milkyway_restaking_v1_models_proto.Messages().ByName("ContractEvidence")
+	fd_ContractEvidence_operator_id = md_ContractEvidence.Fields().ByName("operator_id")
+	fd_ContractEvidence_height = md_ContractEvidence.Fields().ByName("height")
+	fd_ContractEvidence_data = md_ContractEvidence.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractEvidence)(nil)
+
+type fastReflection_ContractEvidence ContractEvidence
+
+func (x *ContractEvidence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractEvidence)(x)
+}
+
+func (x *ContractEvidence) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractEvidence_messageType fastReflection_ContractEvidence_messageType
+var _ protoreflect.MessageType = fastReflection_ContractEvidence_messageType{}
+
+type fastReflection_ContractEvidence_messageType struct{}
+
+func (x fastReflection_ContractEvidence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractEvidence)(nil)
+}
+func (x fastReflection_ContractEvidence_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractEvidence)
+}
+func (x fastReflection_ContractEvidence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractEvidence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractEvidence) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractEvidence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractEvidence) Type() protoreflect.MessageType {
+	return _fastReflection_ContractEvidence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractEvidence) New() protoreflect.Message {
+	return new(fastReflection_ContractEvidence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractEvidence) Interface() protoreflect.ProtoMessage {
+	return (*ContractEvidence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractEvidence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_ContractEvidence_operator_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ContractEvidence_height, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_ContractEvidence_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractEvidence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		return x.Height != int64(0)
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractEvidence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		x.Height = int64(0)
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractEvidence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractEvidence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		x.Height = value.Int()
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractEvidence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.restaking.v1.ContractEvidence is not mutable"))
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		panic(fmt.Errorf("field height of message milkyway.restaking.v1.ContractEvidence is not mutable"))
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		panic(fmt.Errorf("field data of message milkyway.restaking.v1.ContractEvidence is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractEvidence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.ContractEvidence.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.ContractEvidence.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.ContractEvidence.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.ContractEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.ContractEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractEvidence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.ContractEvidence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractEvidence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractEvidence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractEvidence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractEvidence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractEvidence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractEvidence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractEvidence)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractEvidence: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SubmittedEvidence            protoreflect.MessageDescriptor
+	fd_SubmittedEvidence_service_id protoreflect.FieldDescriptor
+	fd_SubmittedEvidence_hash       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_SubmittedEvidence = File_milkyway_restaking_v1_models_proto.Messages().ByName("SubmittedEvidence")
+	fd_SubmittedEvidence_service_id = md_SubmittedEvidence.Fields().ByName("service_id")
+	fd_SubmittedEvidence_hash = md_SubmittedEvidence.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubmittedEvidence)(nil)
+
+type fastReflection_SubmittedEvidence SubmittedEvidence
+
+func (x *SubmittedEvidence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubmittedEvidence)(x)
+}
+
+func (x *SubmittedEvidence) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubmittedEvidence_messageType fastReflection_SubmittedEvidence_messageType
+var _ protoreflect.MessageType = fastReflection_SubmittedEvidence_messageType{}
+
+type fastReflection_SubmittedEvidence_messageType struct{}
+
+func (x fastReflection_SubmittedEvidence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubmittedEvidence)(nil)
+}
+func (x fastReflection_SubmittedEvidence_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubmittedEvidence)
+}
+func (x fastReflection_SubmittedEvidence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmittedEvidence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubmittedEvidence) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubmittedEvidence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubmittedEvidence) Type() protoreflect.MessageType {
+	return _fastReflection_SubmittedEvidence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubmittedEvidence) New() protoreflect.Message {
+	return new(fastReflection_SubmittedEvidence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubmittedEvidence) Interface() protoreflect.ProtoMessage {
+	return (*SubmittedEvidence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubmittedEvidence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_SubmittedEvidence_service_id, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SubmittedEvidence_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubmittedEvidence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		return len(x.Hash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmittedEvidence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		x.Hash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubmittedEvidence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmittedEvidence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		x.Hash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmittedEvidence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.SubmittedEvidence is not mutable"))
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		panic(fmt.Errorf("field hash of message milkyway.restaking.v1.SubmittedEvidence is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubmittedEvidence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.SubmittedEvidence.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.SubmittedEvidence.hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.SubmittedEvidence"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.SubmittedEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubmittedEvidence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.SubmittedEvidence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubmittedEvidence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubmittedEvidence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubmittedEvidence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubmittedEvidence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubmittedEvidence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubmittedEvidence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubmittedEvidence)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmittedEvidence: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubmittedEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingSlash                   protoreflect.MessageDescriptor
+	fd_PendingSlash_id                protoreflect.FieldDescriptor
+	fd_PendingSlash_service_id        protoreflect.FieldDescriptor
+	fd_PendingSlash_operator_id       protoreflect.FieldDescriptor
+	fd_PendingSlash_fraction          protoreflect.FieldDescriptor
+	fd_PendingSlash_infraction_height protoreflect.FieldDescriptor
+	fd_PendingSlash_execution_time    protoreflect.FieldDescriptor
+	fd_PendingSlash_tombstone         protoreflect.FieldDescriptor
+	fd_PendingSlash_retries           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_PendingSlash = File_milkyway_restaking_v1_models_proto.Messages().ByName("PendingSlash")
+	fd_PendingSlash_id = md_PendingSlash.Fields().ByName("id")
+	fd_PendingSlash_service_id = md_PendingSlash.Fields().ByName("service_id")
+	fd_PendingSlash_operator_id = md_PendingSlash.Fields().ByName("operator_id")
+	fd_PendingSlash_fraction = md_PendingSlash.Fields().ByName("fraction")
+	fd_PendingSlash_infraction_height = md_PendingSlash.Fields().ByName("infraction_height")
+	fd_PendingSlash_execution_time = md_PendingSlash.Fields().ByName("execution_time")
+	fd_PendingSlash_tombstone = md_PendingSlash.Fields().ByName("tombstone")
+	fd_PendingSlash_retries = md_PendingSlash.Fields().ByName("retries")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingSlash)(nil)
+
+type fastReflection_PendingSlash PendingSlash
+
+func (x *PendingSlash) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingSlash)(x)
+}
+
+func (x *PendingSlash) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingSlash_messageType fastReflection_PendingSlash_messageType
+var _ protoreflect.MessageType = fastReflection_PendingSlash_messageType{}
+
+type fastReflection_PendingSlash_messageType struct{}
+
+func (x fastReflection_PendingSlash_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingSlash)(nil)
+}
+func (x fastReflection_PendingSlash_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingSlash)
+}
+func (x fastReflection_PendingSlash_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSlash
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingSlash) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSlash
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingSlash) Type() protoreflect.MessageType {
+	return _fastReflection_PendingSlash_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingSlash) New() protoreflect.Message {
+	return new(fastReflection_PendingSlash)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingSlash) Interface() protoreflect.ProtoMessage {
+	return (*PendingSlash)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingSlash) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PendingSlash_id, value) {
+			return
+		}
+	}
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_PendingSlash_service_id, value) {
+			return
+		}
+	}
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_PendingSlash_operator_id, value) {
+			return
+		}
+	}
+	if x.Fraction != "" {
+		value := protoreflect.ValueOfString(x.Fraction)
+		if !f(fd_PendingSlash_fraction, value) {
+			return
+		}
+	}
+	if x.InfractionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InfractionHeight)
+		if !f(fd_PendingSlash_infraction_height, value) {
+			return
+		}
+	}
+	if x.ExecutionTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionTime.ProtoReflect())
+		if !f(fd_PendingSlash_execution_time, value) {
+			return
+		}
+	}
+	if x.Tombstone != false {
+		value := protoreflect.ValueOfBool(x.Tombstone)
+		if !f(fd_PendingSlash_tombstone, value) {
+			return
+		}
+	}
+	if x.Retries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Retries)
+		if !f(fd_PendingSlash_retries, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingSlash) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.id":
+		return x.Id != uint64(0)
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		return x.Fraction != ""
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		return x.InfractionHeight != int64(0)
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		return x.ExecutionTime != nil
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		return x.Tombstone != false
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		return x.Retries != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSlash) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.id":
+		x.Id = uint64(0)
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		x.Fraction = ""
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		x.InfractionHeight = int64(0)
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		x.ExecutionTime = nil
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		x.Tombstone = false
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		x.Retries = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingSlash) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		value := x.Fraction
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		value := x.InfractionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		value := x.ExecutionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		value := x.Tombstone
+		return protoreflect.ValueOfBool(value)
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		value := x.Retries
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSlash) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.id":
+		x.Id = value.Uint()
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		x.Fraction = value.Interface().(string)
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		x.InfractionHeight = value.Int()
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		x.ExecutionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		x.Tombstone = value.Bool()
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		x.Retries = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSlash) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		if x.ExecutionTime == nil {
+			x.ExecutionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionTime.ProtoReflect())
+	case "milkyway.restaking.v1.PendingSlash.id":
+		panic(fmt.Errorf("field id of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		panic(fmt.Errorf("field fraction of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		panic(fmt.Errorf("field infraction_height of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		panic(fmt.Errorf("field tombstone of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		panic(fmt.Errorf("field retries of message milkyway.restaking.v1.PendingSlash is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingSlash) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.PendingSlash.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.restaking.v1.PendingSlash.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.PendingSlash.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.PendingSlash.fraction":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.PendingSlash.infraction_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.PendingSlash.execution_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "milkyway.restaking.v1.PendingSlash.tombstone":
+		return protoreflect.ValueOfBool(false)
+	case "milkyway.restaking.v1.PendingSlash.retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.PendingSlash"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.PendingSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingSlash) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.PendingSlash", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingSlash) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSlash) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingSlash) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingSlash) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingSlash)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		l = len(x.Fraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InfractionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.InfractionHeight))
+		}
+		if x.ExecutionTime != nil {
+			l = options.Size(x.ExecutionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Tombstone {
+			n += 2
+		}
+		if x.Retries != 0 {
+			n += 1 + runtime.Sov(uint64(x.Retries))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSlash)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Retries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retries))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Tombstone {
+			i--
+			if x.Tombstone {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExecutionTime != nil {
+			encoded, err := options.Marshal(x.ExecutionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.InfractionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InfractionHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Fraction) > 0 {
+			i -= len(x.Fraction)
+			copy(dAtA[i:], x.Fraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fraction)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSlash)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+				}
+				x.InfractionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InfractionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutionTime == nil {
+					x.ExecutionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Tombstone = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				}
+				x.Retries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OperatorServiceKey                 protoreflect.MessageDescriptor
+	fd_OperatorServiceKey_operator_id     protoreflect.FieldDescriptor
+	fd_OperatorServiceKey_service_id      protoreflect.FieldDescriptor
+	fd_OperatorServiceKey_key_type        protoreflect.FieldDescriptor
+	fd_OperatorServiceKey_public_key      protoreflect.FieldDescriptor
+	fd_OperatorServiceKey_activation_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_restaking_v1_models_proto_init()
+	md_OperatorServiceKey = File_milkyway_restaking_v1_models_proto.Messages().ByName("OperatorServiceKey")
+	fd_OperatorServiceKey_operator_id = md_OperatorServiceKey.Fields().ByName("operator_id")
+	fd_OperatorServiceKey_service_id = md_OperatorServiceKey.Fields().ByName("service_id")
+	fd_OperatorServiceKey_key_type = md_OperatorServiceKey.Fields().ByName("key_type")
+	fd_OperatorServiceKey_public_key = md_OperatorServiceKey.Fields().ByName("public_key")
+	fd_OperatorServiceKey_activation_time = md_OperatorServiceKey.Fields().ByName("activation_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_OperatorServiceKey)(nil)
+
+type fastReflection_OperatorServiceKey OperatorServiceKey
+
+func (x *OperatorServiceKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OperatorServiceKey)(x)
+}
+
+func (x *OperatorServiceKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_restaking_v1_models_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OperatorServiceKey_messageType fastReflection_OperatorServiceKey_messageType
+var _ protoreflect.MessageType = fastReflection_OperatorServiceKey_messageType{}
+
+type fastReflection_OperatorServiceKey_messageType struct{}
+
+func (x fastReflection_OperatorServiceKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OperatorServiceKey)(nil)
+}
+func (x fastReflection_OperatorServiceKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_OperatorServiceKey)
+}
+func (x fastReflection_OperatorServiceKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorServiceKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OperatorServiceKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_OperatorServiceKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OperatorServiceKey) Type() protoreflect.MessageType {
+	return _fastReflection_OperatorServiceKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OperatorServiceKey) New() protoreflect.Message {
+	return new(fastReflection_OperatorServiceKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OperatorServiceKey) Interface() protoreflect.ProtoMessage {
+	return (*OperatorServiceKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OperatorServiceKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_OperatorServiceKey_operator_id, value) {
+			return
+		}
+	}
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_OperatorServiceKey_service_id, value) {
+			return
+		}
+	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_OperatorServiceKey_key_type, value) {
+			return
+		}
+	}
+	if len(x.PublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PublicKey)
+		if !f(fd_OperatorServiceKey_public_key, value) {
+			return
+		}
+	}
+	if x.ActivationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ActivationTime.ProtoReflect())
+		if !f(fd_OperatorServiceKey_activation_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OperatorServiceKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		return x.OperatorId != uint32(0)
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		return x.KeyType != 0
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		return len(x.PublicKey) != 0
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		return x.ActivationTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorServiceKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		x.OperatorId = uint32(0)
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		x.KeyType = 0
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		x.PublicKey = nil
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		x.ActivationTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OperatorServiceKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		value := x.ActivationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorServiceKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		x.KeyType = (KeyType)(value.Enum())
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		x.PublicKey = value.Bytes()
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		x.ActivationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorServiceKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		if x.ActivationTime == nil {
+			x.ActivationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ActivationTime.ProtoReflect())
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.restaking.v1.OperatorServiceKey is not mutable"))
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.restaking.v1.OperatorServiceKey is not mutable"))
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		panic(fmt.Errorf("field key_type of message milkyway.restaking.v1.OperatorServiceKey is not mutable"))
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		panic(fmt.Errorf("field public_key of message milkyway.restaking.v1.OperatorServiceKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OperatorServiceKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.restaking.v1.OperatorServiceKey.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.OperatorServiceKey.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.OperatorServiceKey.key_type":
+		return protoreflect.ValueOfEnum(0)
+	case "milkyway.restaking.v1.OperatorServiceKey.public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "milkyway.restaking.v1.OperatorServiceKey.activation_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.OperatorServiceKey"))
+		}
+		panic(fmt.Errorf("message milkyway.restaking.v1.OperatorServiceKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OperatorServiceKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.restaking.v1.OperatorServiceKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OperatorServiceKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OperatorServiceKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OperatorServiceKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OperatorServiceKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OperatorServiceKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivationTime != nil {
+			l = options.Size(x.ActivationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OperatorServiceKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ActivationTime != nil {
+			encoded, err := options.Marshal(x.ActivationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OperatorServiceKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorServiceKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OperatorServiceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PublicKey == nil {
+					x.PublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ActivationTime == nil {
+					x.ActivationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActivationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: milkyway/restaking/v1/models.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DelegationType defines the type of delegation.
+type DelegationType int32
+
+const (
+	// DELEGATION_TYPE_UNSPECIFIED defines an unspecified delegation type.
+	DelegationType_DELEGATION_TYPE_UNSPECIFIED DelegationType = 0
+	// DELEGATION_TYPE_POOL defines a delegation to a pool.
+	DelegationType_DELEGATION_TYPE_POOL DelegationType = 1
+	// DELEGATION_TYPE_OPERATOR defines a delegation to an operator.
+	DelegationType_DELEGATION_TYPE_OPERATOR DelegationType = 2
+	// DELEGATION_TYPE_SERVICE defines a delegation to a service.
+	DelegationType_DELEGATION_TYPE_SERVICE DelegationType = 3
+)
+
+// Enum value maps for DelegationType.
+var (
+	DelegationType_name = map[int32]string{
+		0: "DELEGATION_TYPE_UNSPECIFIED",
+		1: "DELEGATION_TYPE_POOL",
+		2: "DELEGATION_TYPE_OPERATOR",
+		3: "DELEGATION_TYPE_SERVICE",
+	}
+	DelegationType_value = map[string]int32{
+		"DELEGATION_TYPE_UNSPECIFIED": 0,
+		"DELEGATION_TYPE_POOL":        1,
+		"DELEGATION_TYPE_OPERATOR":    2,
+		"DELEGATION_TYPE_SERVICE":     3,
+	}
+)
+
+func (x DelegationType) Enum() *DelegationType {
+	p := new(DelegationType)
+	*p = x
+	return p
+}
+
+func (x DelegationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DelegationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_milkyway_restaking_v1_models_proto_enumTypes[0].Descriptor()
+}
+
+func (DelegationType) Type() protoreflect.EnumType {
+	return &file_milkyway_restaking_v1_models_proto_enumTypes[0]
+}
+
+func (x DelegationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DelegationType.Descriptor instead.
+func (DelegationType) EnumDescriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{0}
+}
+
+// KeyType defines the type of a public key registered by an operator.
+type KeyType int32
+
+const (
+	// KEY_TYPE_UNSPECIFIED defines an unspecified key type.
+	KeyType_KEY_TYPE_UNSPECIFIED KeyType = 0
+	// KEY_TYPE_ED25519 defines an ed25519 public key.
+	KeyType_KEY_TYPE_ED25519 KeyType = 1
+	// KEY_TYPE_SECP256K1 defines a compressed secp256k1 public key.
+	KeyType_KEY_TYPE_SECP256K1 KeyType = 2
+	// KEY_TYPE_BLS12_381 defines a compressed BLS12-381 public key in G1.
+	KeyType_KEY_TYPE_BLS12_381 KeyType = 3
+)
+
+// Enum value maps for KeyType.
+var (
+	KeyType_name = map[int32]string{
+		0: "KEY_TYPE_UNSPECIFIED",
+		1: "KEY_TYPE_ED25519",
+		2: "KEY_TYPE_SECP256K1",
+		3: "KEY_TYPE_BLS12_381",
+	}
+	KeyType_value = map[string]int32{
+		"KEY_TYPE_UNSPECIFIED": 0,
+		"KEY_TYPE_ED25519":     1,
+		"KEY_TYPE_SECP256K1":   2,
+		"KEY_TYPE_BLS12_381":   3,
+	}
+)
+
+func (x KeyType) Enum() *KeyType {
+	p := new(KeyType)
+	*p = x
+	return p
+}
+
+func (x KeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_milkyway_restaking_v1_models_proto_enumTypes[1].Descriptor()
+}
+
+func (KeyType) Type() protoreflect.EnumType {
+	return &file_milkyway_restaking_v1_models_proto_enumTypes[1]
+}
+
+func (x KeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyType.Descriptor instead.
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{1}
+}
+
+// Delegation represents the bond with tokens held by an account with a
+// given target.
+type Delegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type is the type of delegation.
+	Type_ DelegationType `protobuf:"varint,1,opt,name=type,proto3,enum=milkyway.restaking.v1.DelegationType" json:"type,omitempty"`
+	// UserAddress is the encoded address of the user.
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// TargetID is the id of the target to which the delegation is associated
+	// (pool, operator, service).
+	TargetId uint32 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Shares define the delegation shares received.
+	Shares []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delegation) ProtoMessage() {}
+
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Delegation) GetType_() DelegationType {
+	if x != nil {
+		return x.Type_
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (x *Delegation) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *Delegation) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Delegation) GetShares() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// DelegationResponse is equivalent to Delegation except that it
+// contains a balance in addition to shares which is more suitable for client
+// responses.
+type DelegationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegation *Delegation     `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	Balance    []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *DelegationResponse) Reset() {
+	*x = DelegationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationResponse) ProtoMessage() {}
+
+// Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DelegationResponse) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+func (x *DelegationResponse) GetBalance() []*v1beta1.Coin {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// UnbondingDelegation stores all of a single delegator's unbonding bonds
+// for a single target in an time-ordered list.
+type UnbondingDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type is the type of the unbonding delegation.
+	Type_ DelegationType `protobuf:"varint,1,opt,name=type,proto3,enum=milkyway.restaking.v1.DelegationType" json:"type,omitempty"`
+	// DelegatorAddress is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// TargetID is the ID of the target from which the tokens will be undelegated
+	// (pool, service, operator)
+	TargetId uint32 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Entries are the unbonding delegation entries.
+	Entries []*UnbondingDelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"` // unbonding delegation entries
+}
+
+func (x *UnbondingDelegation) Reset() {
+	*x = UnbondingDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbondingDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingDelegation) ProtoMessage() {}
+
+// Deprecated: Use UnbondingDelegation.ProtoReflect.Descriptor instead.
+func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnbondingDelegation) GetType_() DelegationType {
+	if x != nil {
+		return x.Type_
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (x *UnbondingDelegation) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *UnbondingDelegation) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *UnbondingDelegation) GetEntries() []*UnbondingDelegationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
+type UnbondingDelegationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CreationHeight is the height which the unbonding took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// CompletionTime is the unix time for unbonding completion.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// InitialBalance defines the tokens initially scheduled to receive at
+	// completion.
+	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	// Balance defines the tokens to receive at completion.
+	Balance []*v1beta1.Coin `protobuf:"bytes,4,rep,name=balance,proto3" json:"balance,omitempty"`
+	// Incrementing id that uniquely identifies this entry
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+}
+
+func (x *UnbondingDelegationEntry) Reset() {
+	*x = UnbondingDelegationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbondingDelegationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingDelegationEntry) ProtoMessage() {}
+
+// Deprecated: Use UnbondingDelegationEntry.ProtoReflect.Descriptor instead.
+func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnbondingDelegationEntry) GetCreationHeight() int64 {
+	if x != nil {
+		return x.CreationHeight
+	}
+	return 0
+}
+
+func (x *UnbondingDelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *UnbondingDelegationEntry) GetInitialBalance() []*v1beta1.Coin {
+	if x != nil {
+		return x.InitialBalance
+	}
+	return nil
+}
+
+func (x *UnbondingDelegationEntry) GetBalance() []*v1beta1.Coin {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *UnbondingDelegationEntry) GetUnbondingId() uint64 {
+	if x != nil {
+		return x.UnbondingId
+	}
+	return 0
+}
+
+// DTData is a struct that contains the basic information about an unbonding
+// delegation. It is intended to be used as a marshalable pointer. For example,
+// a DTData can be used to construct the key to getting an UnbondingDelegation
+// from state.
+type DTData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnbondingDelegationType DelegationType `protobuf:"varint,1,opt,name=unbonding_delegation_type,json=unbondingDelegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"unbonding_delegation_type,omitempty"`
+	DelegatorAddress        string         `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	TargetId                uint32         `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+}
+
+func (x *DTData) Reset() {
+	*x = DTData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DTData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DTData) ProtoMessage() {}
+
+// Deprecated: Use DTData.ProtoReflect.Descriptor instead.
+func (*DTData) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DTData) GetUnbondingDelegationType() DelegationType {
+	if x != nil {
+		return x.UnbondingDelegationType
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (x *DTData) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *DTData) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+// DTDataList defines an array of DTData objects.
+type DTDataList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*DTData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DTDataList) Reset() {
+	*x = DTDataList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DTDataList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DTDataList) ProtoMessage() {}
+
+// Deprecated: Use DTDataList.ProtoReflect.Descriptor instead.
+func (*DTDataList) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DTDataList) GetData() []*DTData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Redelegation contains the list of a particular delegator's redelegating
+// bonds from a particular source target to a particular destination target.
+type Redelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DelegatorAddress is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// SrcType is the type of the target from which the tokens have been
+	// redelegated.
+	SrcType DelegationType `protobuf:"varint,2,opt,name=src_type,json=srcType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"src_type,omitempty"`
+	// SrcTargetID is the ID of the target from which the tokens have been
+	// redelegated (pool, operator, service).
+	SrcTargetId uint32 `protobuf:"varint,3,opt,name=src_target_id,json=srcTargetId,proto3" json:"src_target_id,omitempty"`
+	// DstType is the type of the target to which the tokens have been
+	// redelegated.
+	DstType DelegationType `protobuf:"varint,4,opt,name=dst_type,json=dstType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"dst_type,omitempty"`
+	// DstTargetID is the ID of the target to which the tokens have been
+	// redelegated (operator, service).
+	DstTargetId uint32 `protobuf:"varint,5,opt,name=dst_target_id,json=dstTargetId,proto3" json:"dst_target_id,omitempty"`
+	// Entries are the redelegation entries.
+	Entries []*RedelegationEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *Redelegation) Reset() {
+	*x = Redelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Redelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redelegation) ProtoMessage() {}
+
+// Deprecated: Use Redelegation.ProtoReflect.Descriptor instead.
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Redelegation) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *Redelegation) GetSrcType() DelegationType {
+	if x != nil {
+		return x.SrcType
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (x *Redelegation) GetSrcTargetId() uint32 {
+	if x != nil {
+		return x.SrcTargetId
+	}
+	return 0
+}
+
+func (x *Redelegation) GetDstType() DelegationType {
+	if x != nil {
+		return x.DstType
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (x *Redelegation) GetDstTargetId() uint32 {
+	if x != nil {
+		return x.DstTargetId
+	}
+	return 0
+}
+
+func (x *Redelegation) GetEntries() []*RedelegationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// RedelegationEntry defines a redelegation object with relevant metadata.
+type RedelegationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CreationHeight is the height at which the redelegation took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// CompletionTime is the unix time for redelegation completion.
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// InitialBalance defines the tokens that have been redelegated.
+	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
+	// SharesDst defines the amount of shares received from the destination
+	// target.
+	SharesDst []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=shares_dst,json=sharesDst,proto3" json:"shares_dst,omitempty"`
+	// Incrementing id that uniquely identifies this entry
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+}
+
+func (x *RedelegationEntry) Reset() {
+	*x = RedelegationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedelegationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedelegationEntry) ProtoMessage() {}
+
+// Deprecated: Use RedelegationEntry.ProtoReflect.Descriptor instead.
+func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RedelegationEntry) GetCreationHeight() int64 {
+	if x != nil {
+		return x.CreationHeight
+	}
+	return 0
+}
+
+func (x *RedelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *RedelegationEntry) GetInitialBalance() []*v1beta1.Coin {
+	if x != nil {
+		return x.InitialBalance
+	}
+	return nil
+}
+
+func (x *RedelegationEntry) GetSharesDst() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.SharesDst
+	}
+	return nil
+}
+
+func (x *RedelegationEntry) GetUnbondingId() uint64 {
+	if x != nil {
+		return x.UnbondingId
+	}
+	return 0
+}
+
+// RTData is a struct that contains the basic information about a
+// redelegation. It is intended to be used as a marshalable pointer. For
+// example, a RTData can be used to construct the key to getting a Redelegation
+// from state.
+type RTData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegatorAddress string         `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SrcType          DelegationType `protobuf:"varint,2,opt,name=src_type,json=srcType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"src_type,omitempty"`
+	SrcTargetId      uint32         `protobuf:"varint,3,opt,name=src_target_id,json=srcTargetId,proto3" json:"src_target_id,omitempty"`
+	DstType          DelegationType `protobuf:"varint,4,opt,name=dst_type,json=dstType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"dst_type,omitempty"`
+	DstTargetId      uint32         `protobuf:"varint,5,opt,name=dst_target_id,json=dstTargetId,proto3" json:"dst_target_id,omitempty"`
+}
+
+func (x *RTData) Reset() {
+	*x = RTData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x DelegationType) Enum() *DelegationType {
-	p := new(DelegationType)
-	*p = x
-	return p
+func (x *RTData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x DelegationType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*RTData) ProtoMessage() {}
+
+// Deprecated: Use RTData.ProtoReflect.Descriptor instead.
+func (*RTData) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{8}
 }
 
-func (DelegationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_milkyway_restaking_v1_models_proto_enumTypes[0].Descriptor()
+func (x *RTData) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
 }
 
-func (DelegationType) Type() protoreflect.EnumType {
-	return &file_milkyway_restaking_v1_models_proto_enumTypes[0]
+func (x *RTData) GetSrcType() DelegationType {
+	if x != nil {
+		return x.SrcType
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
 }
 
-func (x DelegationType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *RTData) GetSrcTargetId() uint32 {
+	if x != nil {
+		return x.SrcTargetId
+	}
+	return 0
 }
 
-// Deprecated: Use DelegationType.Descriptor instead.
-func (DelegationType) EnumDescriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{0}
+func (x *RTData) GetDstType() DelegationType {
+	if x != nil {
+		return x.DstType
+	}
+	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
 }
 
-// Delegation represents the bond with tokens held by an account with a
-// given target.
-type Delegation struct {
+func (x *RTData) GetDstTargetId() uint32 {
+	if x != nil {
+		return x.DstTargetId
+	}
+	return 0
+}
+
+// RTDataList defines an array of RTData objects.
+type RTDataList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Type is the type of delegation.
-	Type_ DelegationType `protobuf:"varint,1,opt,name=type,proto3,enum=milkyway.restaking.v1.DelegationType" json:"type,omitempty"`
-	// UserAddress is the encoded address of the user.
-	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
-	// TargetID is the id of the target to which the delegation is associated
-	// (pool, operator, service).
-	TargetId uint32 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	// Shares define the delegation shares received.
-	Shares []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	Data []*RTData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Delegation) Reset() {
-	*x = Delegation{}
+func (x *RTDataList) Reset() {
+	*x = RTDataList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[0]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Delegation) String() string {
+func (x *RTDataList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Delegation) ProtoMessage() {}
+func (*RTDataList) ProtoMessage() {}
 
-// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
-func (*Delegation) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RTDataList.ProtoReflect.Descriptor instead.
+func (*RTDataList) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Delegation) GetType_() DelegationType {
+func (x *RTDataList) GetData() []*RTData {
 	if x != nil {
-		return x.Type_
+		return x.Data
 	}
-	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Delegation) GetUserAddress() string {
-	if x != nil {
-		return x.UserAddress
-	}
-	return ""
+// UserPreferences is a struct that contains a user's preferences for
+// restaking.
+type UserPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TrustedServices is a list of services that the user trusts
+	TrustedServices []*TrustedServiceEntry `protobuf:"bytes,4,rep,name=trusted_services,json=trustedServices,proto3" json:"trusted_services,omitempty"`
 }
 
-func (x *Delegation) GetTargetId() uint32 {
-	if x != nil {
-		return x.TargetId
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Delegation) GetShares() []*v1beta1.DecCoin {
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserPreferences) GetTrustedServices() []*TrustedServiceEntry {
 	if x != nil {
-		return x.Shares
+		return x.TrustedServices
 	}
 	return nil
 }
 
-// DelegationResponse is equivalent to Delegation except that it
-// contains a balance in addition to shares which is more suitable for client
-// responses.
-type DelegationResponse struct {
+// TrustedServiceEntry is a struct that contains the ID of a service that the user trusts
+// and the IDs of the pools that the user wants the service to access
+type TrustedServiceEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Delegation *Delegation     `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
-	Balance    []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+	// ServiceID represents the ID of the service that the user trusts
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// PoolsIDs represents the IDs of the pools that the user wants the service with ServiceID to access
+	PoolsIds []uint32 `protobuf:"varint,2,rep,packed,name=pools_ids,json=poolsIds,proto3" json:"pools_ids,omitempty"`
 }
 
-func (x *DelegationResponse) Reset() {
-	*x = DelegationResponse{}
+func (x *TrustedServiceEntry) Reset() {
+	*x = TrustedServiceEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[1]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelegationResponse) String() string {
+func (x *TrustedServiceEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegationResponse) ProtoMessage() {}
+func (*TrustedServiceEntry) ProtoMessage() {}
 
-// Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TrustedServiceEntry.ProtoReflect.Descriptor instead.
+func (*TrustedServiceEntry) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DelegationResponse) GetDelegation() *Delegation {
+func (x *TrustedServiceEntry) GetServiceId() uint32 {
 	if x != nil {
-		return x.Delegation
+		return x.ServiceId
 	}
-	return nil
+	return 0
 }
 
-func (x *DelegationResponse) GetBalance() []*v1beta1.Coin {
+func (x *TrustedServiceEntry) GetPoolsIds() []uint32 {
 	if x != nil {
-		return x.Balance
+		return x.PoolsIds
 	}
 	return nil
 }
 
-// UnbondingDelegation stores all of a single delegator's unbonding bonds
-// for a single target in an time-ordered list.
-type UnbondingDelegation struct {
+// ValidatingOperator represents an operator that is part of a service's
+// validating set, along with the stake it secures the service with and its
+// resulting voting power.
+type ValidatingOperator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Type is the type of the unbonding delegation.
-	Type_ DelegationType `protobuf:"varint,1,opt,name=type,proto3,enum=milkyway.restaking.v1.DelegationType" json:"type,omitempty"`
-	// DelegatorAddress is the encoded address of the delegator.
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// TargetID is the ID of the target from which the tokens will be undelegated
-	// (pool, service, operator)
-	TargetId uint32 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	// Entries are the unbonding delegation entries.
-	Entries []*UnbondingDelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"` // unbonding delegation entries
-}
-
-func (x *UnbondingDelegation) Reset() {
-	*x = UnbondingDelegation{}
+	// OperatorID is the ID of the operator
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Tokens are the tokens securing the service through the operator. They
+	// include the tokens delegated to the operator and the share of the tokens
+	// borrowed from the pools that the delegators allow the service to use
+	Tokens []*v1beta1.Coin `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// USDValue is the USD value of the tokens
+	UsdValue string `protobuf:"bytes,3,opt,name=usd_value,json=usdValue,proto3" json:"usd_value,omitempty"`
+	// Power is the voting power of the operator, equal to its USD value
+	// truncated to an integer
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *ValidatingOperator) Reset() {
+	*x = ValidatingOperator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[2]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnbondingDelegation) String() string {
+func (x *ValidatingOperator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbondingDelegation) ProtoMessage() {}
+func (*ValidatingOperator) ProtoMessage() {}
 
-// Deprecated: Use UnbondingDelegation.ProtoReflect.Descriptor instead.
-func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ValidatingOperator.ProtoReflect.Descriptor instead.
+func (*ValidatingOperator) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UnbondingDelegation) GetType_() DelegationType {
+func (x *ValidatingOperator) GetOperatorId() uint32 {
 	if x != nil {
-		return x.Type_
+		return x.OperatorId
 	}
-	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *UnbondingDelegation) GetDelegatorAddress() string {
+func (x *ValidatingOperator) GetTokens() []*v1beta1.Coin {
 	if x != nil {
-		return x.DelegatorAddress
+		return x.Tokens
 	}
-	return ""
+	return nil
 }
 
-func (x *UnbondingDelegation) GetTargetId() uint32 {
+func (x *ValidatingOperator) GetUsdValue() string {
 	if x != nil {
-		return x.TargetId
+		return x.UsdValue
 	}
-	return 0
+	return ""
 }
 
-func (x *UnbondingDelegation) GetEntries() []*UnbondingDelegationEntry {
+func (x *ValidatingOperator) GetPower() int64 {
 	if x != nil {
-		return x.Entries
+		return x.Power
 	}
-	return nil
+	return 0
 }
 
-// UnbondingDelegationEntry defines an unbonding object with relevant metadata.
-type UnbondingDelegationEntry struct {
+// ServiceSnapshot represents a snapshot of a service's validating set taken at
+// a given epoch.
+type ServiceSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// CreationHeight is the height which the unbonding took place.
-	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
-	// CompletionTime is the unix time for unbonding completion.
-	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
-	// InitialBalance defines the tokens initially scheduled to receive at
-	// completion.
-	InitialBalance []*v1beta1.Coin `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
-	// Balance defines the tokens to receive at completion.
-	Balance []*v1beta1.Coin `protobuf:"bytes,4,rep,name=balance,proto3" json:"balance,omitempty"`
-	// Incrementing id that uniquely identifies this entry
-	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
-}
-
-func (x *UnbondingDelegationEntry) Reset() {
-	*x = UnbondingDelegationEntry{}
+	// ServiceID is the ID of the service
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Epoch is the sequential number of the snapshot, starting from 1
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Height is the block height at which the snapshot has been taken
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time at which the snapshot has been taken
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Operators is the validating set of the service at the time of the
+	// snapshot, sorted by voting power in descending order
+	Operators []*ValidatingOperator `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators,omitempty"`
+	// TotalPower is the sum of the voting power of all the operators
+	TotalPower int64 `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// MerkleRoot is the root of the Merkle tree built from the operators. Each
+	// leaf is the big-endian encoding of the operator ID (4 bytes) followed by
+	// the big-endian encoding of its voting power (8 bytes)
+	MerkleRoot []byte `protobuf:"bytes,7,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+}
+
+func (x *ServiceSnapshot) Reset() {
+	*x = ServiceSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[3]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnbondingDelegationEntry) String() string {
+func (x *ServiceSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbondingDelegationEntry) ProtoMessage() {}
+func (*ServiceSnapshot) ProtoMessage() {}
 
-// Deprecated: Use UnbondingDelegationEntry.ProtoReflect.Descriptor instead.
-func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ServiceSnapshot.ProtoReflect.Descriptor instead.
+func (*ServiceSnapshot) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UnbondingDelegationEntry) GetCreationHeight() int64 {
+func (x *ServiceSnapshot) GetServiceId() uint32 {
 	if x != nil {
-		return x.CreationHeight
+		return x.ServiceId
 	}
 	return 0
 }
 
-func (x *UnbondingDelegationEntry) GetCompletionTime() *timestamppb.Timestamp {
+func (x *ServiceSnapshot) GetEpoch() uint64 {
 	if x != nil {
-		return x.CompletionTime
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ServiceSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ServiceSnapshot) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-func (x *UnbondingDelegationEntry) GetInitialBalance() []*v1beta1.Coin {
+func (x *ServiceSnapshot) GetOperators() []*ValidatingOperator {
 	if x != nil {
-		return x.InitialBalance
+		return x.Operators
 	}
 	return nil
 }
 
-func (x *UnbondingDelegationEntry) GetBalance() []*v1beta1.Coin {
+func (x *ServiceSnapshot) GetTotalPower() int64 {
 	if x != nil {
-		return x.Balance
+		return x.TotalPower
+	}
+	return 0
+}
+
+func (x *ServiceSnapshot) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+// OperatorJailRecord represents the record of an operator that has been jailed
+// from securing a service.
+type OperatorJailRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ServiceID is the ID of the service from which the operator has been jailed
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// OperatorID is the ID of the jailed operator
+	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// JailedUntil is the time until which the operator is jailed. After this
+	// time, the operator can unjail itself from the service
+	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// Tombstoned tells whether the operator has been permanently jailed from the
+	// service. Tombstoned operators can never be unjailed
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (x *OperatorJailRecord) Reset() {
+	*x = OperatorJailRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatorJailRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorJailRecord) ProtoMessage() {}
+
+// Deprecated: Use OperatorJailRecord.ProtoReflect.Descriptor instead.
+func (*OperatorJailRecord) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OperatorJailRecord) GetServiceId() uint32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *OperatorJailRecord) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *OperatorJailRecord) GetJailedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JailedUntil
 	}
 	return nil
 }
 
-func (x *UnbondingDelegationEntry) GetUnbondingId() uint64 {
+func (x *OperatorJailRecord) GetTombstoned() bool {
 	if x != nil {
-		return x.UnbondingId
+		return x.Tombstoned
 	}
-	return 0
+	return false
 }
 
-// DTData is a struct that contains the basic information about an unbonding
-// delegation. It is intended to be used as a marshalable pointer. For example,
-// a DTData can be used to construct the key to getting an UnbondingDelegation
-// from state.
-type DTData struct {
+// ContractEvidence represents a generic evidence of an operator misbehavior
+// whose content is verified by the CosmWasm contract set as the evidence
+// verifier of the service for which it has been submitted.
+type ContractEvidence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnbondingDelegationType DelegationType `protobuf:"varint,1,opt,name=unbonding_delegation_type,json=unbondingDelegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"unbonding_delegation_type,omitempty"`
-	DelegatorAddress        string         `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	TargetId                uint32         `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// OperatorID is the ID of the operator that misbehaved
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Height is the height at which the misbehavior occurred
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Data contains the contract-specific proof of the misbehavior
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *DTData) Reset() {
-	*x = DTData{}
+func (x *ContractEvidence) Reset() {
+	*x = ContractEvidence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[4]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DTData) String() string {
+func (x *ContractEvidence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DTData) ProtoMessage() {}
+func (*ContractEvidence) ProtoMessage() {}
 
-// Deprecated: Use DTData.ProtoReflect.Descriptor instead.
-func (*DTData) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ContractEvidence.ProtoReflect.Descriptor instead.
+func (*ContractEvidence) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DTData) GetUnbondingDelegationType() DelegationType {
+func (x *ContractEvidence) GetOperatorId() uint32 {
 	if x != nil {
-		return x.UnbondingDelegationType
+		return x.OperatorId
 	}
-	return DelegationType_DELEGATION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *DTData) GetDelegatorAddress() string {
+func (x *ContractEvidence) GetHeight() int64 {
 	if x != nil {
-		return x.DelegatorAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *DTData) GetTargetId() uint32 {
+func (x *ContractEvidence) GetData() []byte {
 	if x != nil {
-		return x.TargetId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// DTDataList defines an array of DTData objects.
-type DTDataList struct {
+// SubmittedEvidence represents an evidence of an operator misbehavior that has
+// already been submitted for a service.
+type SubmittedEvidence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*DTData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// ServiceID is the ID of the service for which the evidence was submitted
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Hash is the hash of the submitted evidence
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *DTDataList) Reset() {
-	*x = DTDataList{}
+func (x *SubmittedEvidence) Reset() {
+	*x = SubmittedEvidence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[5]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DTDataList) String() string {
+func (x *SubmittedEvidence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DTDataList) ProtoMessage() {}
+func (*SubmittedEvidence) ProtoMessage() {}
 
-// Deprecated: Use DTDataList.ProtoReflect.Descriptor instead.
-func (*DTDataList) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SubmittedEvidence.ProtoReflect.Descriptor instead.
+func (*SubmittedEvidence) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DTDataList) GetData() []*DTData {
+func (x *SubmittedEvidence) GetServiceId() uint32 {
 	if x != nil {
-		return x.Data
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *SubmittedEvidence) GetHash() []byte {
+	if x != nil {
+		return x.Hash
 	}
 	return nil
 }
 
-// UserPreferences is a struct that contains a user's preferences for
-// restaking.
-type UserPreferences struct {
+// PendingSlash represents a slash that has been requested for an operator and
+// that will be executed once its veto window has passed, unless it gets vetoed
+// in the meantime.
+type PendingSlash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// TrustedServices is a list of services that the user trusts
-	TrustedServices []*TrustedServiceEntry `protobuf:"bytes,4,rep,name=trusted_services,json=trustedServices,proto3" json:"trusted_services,omitempty"`
-}
-
-func (x *UserPreferences) Reset() {
-	*x = UserPreferences{}
+	// ID is the unique identifier of the pending slash
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ServiceID is the ID of the service for which the misbehavior occurred
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// OperatorID is the ID of the operator to be slashed
+	OperatorId uint32 `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Fraction is the fraction of the operator's security to be slashed
+	Fraction string `protobuf:"bytes,4,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	// InfractionHeight is the height at which the misbehavior occurred
+	InfractionHeight int64 `protobuf:"varint,5,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// ExecutionTime is the time after which the slash will be executed
+	ExecutionTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Tombstone tells whether the operator should also be tombstoned from the
+	// service when the slash is executed. If true, the fraction can be zero
+	Tombstone bool `protobuf:"varint,7,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	// Retries is the number of times the execution of the slash has failed and
+	// the slash has been queued again
+	Retries uint32 `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (x *PendingSlash) Reset() {
+	*x = PendingSlash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[6]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserPreferences) String() string {
+func (x *PendingSlash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPreferences) ProtoMessage() {}
+func (*PendingSlash) ProtoMessage() {}
 
-// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
-func (*UserPreferences) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PendingSlash.ProtoReflect.Descriptor instead.
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UserPreferences) GetTrustedServices() []*TrustedServiceEntry {
+func (x *PendingSlash) GetId() uint64 {
 	if x != nil {
-		return x.TrustedServices
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PendingSlash) GetServiceId() uint32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *PendingSlash) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PendingSlash) GetFraction() string {
+	if x != nil {
+		return x.Fraction
+	}
+	return ""
+}
+
+func (x *PendingSlash) GetInfractionHeight() int64 {
+	if x != nil {
+		return x.InfractionHeight
+	}
+	return 0
+}
+
+func (x *PendingSlash) GetExecutionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutionTime
 	}
 	return nil
 }
 
-// TrustedServiceEntry is a struct that contains the ID of a service that the user trusts
-// and the IDs of the pools that the user wants the service to access
-type TrustedServiceEntry struct {
+func (x *PendingSlash) GetTombstone() bool {
+	if x != nil {
+		return x.Tombstone
+	}
+	return false
+}
+
+func (x *PendingSlash) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+// OperatorServiceKey represents the public key that an operator uses to sign
+// the attestations of a service.
+type OperatorServiceKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ServiceID represents the ID of the service that the user trusts
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PoolsIDs represents the IDs of the pools that the user wants the service with ServiceID to access
-	PoolsIds []uint32 `protobuf:"varint,2,rep,packed,name=pools_ids,json=poolsIds,proto3" json:"pools_ids,omitempty"`
+	// OperatorID is the ID of the operator that registered the key
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// ServiceID is the ID of the service for which the key is used
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// KeyType is the type of the key
+	KeyType KeyType `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=milkyway.restaking.v1.KeyType" json:"key_type,omitempty"`
+	// PublicKey is the encoded public key
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// ActivationTime is the time from which the key is used
+	ActivationTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
 }
 
-func (x *TrustedServiceEntry) Reset() {
-	*x = TrustedServiceEntry{}
+func (x *OperatorServiceKey) Reset() {
+	*x = OperatorServiceKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[7]
+		mi := &file_milkyway_restaking_v1_models_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrustedServiceEntry) String() string {
+func (x *OperatorServiceKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrustedServiceEntry) ProtoMessage() {}
+func (*OperatorServiceKey) ProtoMessage() {}
 
-// Deprecated: Use TrustedServiceEntry.ProtoReflect.Descriptor instead.
-func (*TrustedServiceEntry) Descriptor() ([]byte, []int) {
-	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use OperatorServiceKey.ProtoReflect.Descriptor instead.
+func (*OperatorServiceKey) Descriptor() ([]byte, []int) {
+	return file_milkyway_restaking_v1_models_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TrustedServiceEntry) GetServiceId() uint32 {
+func (x *OperatorServiceKey) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *OperatorServiceKey) GetServiceId() uint32 {
 	if x != nil {
 		return x.ServiceId
 	}
 	return 0
 }
 
-func (x *TrustedServiceEntry) GetPoolsIds() []uint32 {
+func (x *OperatorServiceKey) GetKeyType() KeyType {
 	if x != nil {
-		return x.PoolsIds
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
+}
+
+func (x *OperatorServiceKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *OperatorServiceKey) GetActivationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivationTime
 	}
 	return nil
 }
@@ -5402,47 +13399,244 @@ var file_milkyway_restaking_v1_models_proto_rawDesc = []byte{
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
 	0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x54,
 	0x44, 0x61, 0x74, 0x61, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x10, 0x74, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73,
-	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x17, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x04, 0x22,
-	0x6e, 0x0a, 0x13, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x50, 0x6f, 0x6f,
-	0x6c, 0x73, 0x49, 0x44, 0x73, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x73, 0x2a,
-	0x8c, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a,
-	0x18, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x44,
-	0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xeb,
-	0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76,
-	0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02,
-	0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
-	0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x21, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a,
+	0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x33, 0x0a, 0x0d, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x53, 0x72, 0x63, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64,
+	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xe2,
+	0xde, 0x1f, 0x0b, 0x44, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x52, 0x0b,
+	0x64, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d,
+	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb2, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x70, 0x0a,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x44, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x55, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x0b, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x02, 0x0a, 0x06, 0x52, 0x54,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73,
+	0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a,
+	0x0d, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x53, 0x72, 0x63, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xe2, 0xde, 0x1f,
+	0x0b, 0x44, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x52, 0x0b, 0x64, 0x73,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x22, 0x4a, 0x0a, 0x0a, 0x52, 0x54, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x54, 0x44, 0x61, 0x74, 0x61, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x87, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0xc8, 0xde, 0x1f, 0x00, 0xe2,
+	0xde, 0x1f, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x04, 0x22, 0x6e, 0x0a, 0x13, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0d, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x44, 0x73, 0x52,
+	0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x12, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x68, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x5a, 0x0a, 0x09, 0x75,
+	0x73, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xe2, 0xde, 0x1f, 0x08, 0x55, 0x53, 0x44, 0x56, 0x61, 0x6c, 0x75, 0x65, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x75,
+	0x73, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc2, 0x02,
+	0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4a,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2,
+	0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xde,
+	0x1f, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x6a, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x6f, 0x6d, 0x62,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x2a, 0xca, 0xb4, 0x2d, 0x26, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2,
+	0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x8b, 0x03, 0x0a, 0x0c,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x50, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x12, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x2f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x0f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x8c, 0x01,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x4c,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6f, 0x0a, 0x07,
+	0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x53, 0x31,
+	0x32, 0x5f, 0x33, 0x38, 0x31, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xeb, 0x01,
+	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31,
+	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02, 0x15,
+	0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21,
+	0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5457,40 +13651,68 @@ func file_milkyway_restaking_v1_models_proto_rawDescGZIP() []byte {
 	return file_milkyway_restaking_v1_models_proto_rawDescData
 }
 
-var file_milkyway_restaking_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_milkyway_restaking_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_milkyway_restaking_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_milkyway_restaking_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_milkyway_restaking_v1_models_proto_goTypes = []interface{}{
 	(DelegationType)(0),              // 0: milkyway.restaking.v1.DelegationType
-	(*Delegation)(nil),               // 1: milkyway.restaking.v1.Delegation
-	(*DelegationResponse)(nil),       // 2: milkyway.restaking.v1.DelegationResponse
-	(*UnbondingDelegation)(nil),      // 3: milkyway.restaking.v1.UnbondingDelegation
-	(*UnbondingDelegationEntry)(nil), // 4: milkyway.restaking.v1.UnbondingDelegationEntry
-	(*DTData)(nil),                   // 5: milkyway.restaking.v1.DTData
-	(*DTDataList)(nil),               // 6: milkyway.restaking.v1.DTDataList
-	(*UserPreferences)(nil),          // 7: milkyway.restaking.v1.UserPreferences
-	(*TrustedServiceEntry)(nil),      // 8: milkyway.restaking.v1.TrustedServiceEntry
-	(*v1beta1.DecCoin)(nil),          // 9: cosmos.base.v1beta1.DecCoin
-	(*v1beta1.Coin)(nil),             // 10: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(KeyType)(0),                     // 1: milkyway.restaking.v1.KeyType
+	(*Delegation)(nil),               // 2: milkyway.restaking.v1.Delegation
+	(*DelegationResponse)(nil),       // 3: milkyway.restaking.v1.DelegationResponse
+	(*UnbondingDelegation)(nil),      // 4: milkyway.restaking.v1.UnbondingDelegation
+	(*UnbondingDelegationEntry)(nil), // 5: milkyway.restaking.v1.UnbondingDelegationEntry
+	(*DTData)(nil),                   // 6: milkyway.restaking.v1.DTData
+	(*DTDataList)(nil),               // 7: milkyway.restaking.v1.DTDataList
+	(*Redelegation)(nil),             // 8: milkyway.restaking.v1.Redelegation
+	(*RedelegationEntry)(nil),        // 9: milkyway.restaking.v1.RedelegationEntry
+	(*RTData)(nil),                   // 10: milkyway.restaking.v1.RTData
+	(*RTDataList)(nil),               // 11: milkyway.restaking.v1.RTDataList
+	(*UserPreferences)(nil),          // 12: milkyway.restaking.v1.UserPreferences
+	(*TrustedServiceEntry)(nil),      // 13: milkyway.restaking.v1.TrustedServiceEntry
+	(*ValidatingOperator)(nil),       // 14: milkyway.restaking.v1.ValidatingOperator
+	(*ServiceSnapshot)(nil),          // 15: milkyway.restaking.v1.ServiceSnapshot
+	(*OperatorJailRecord)(nil),       // 16: milkyway.restaking.v1.OperatorJailRecord
+	(*ContractEvidence)(nil),         // 17: milkyway.restaking.v1.ContractEvidence
+	(*SubmittedEvidence)(nil),        // 18: milkyway.restaking.v1.SubmittedEvidence
+	(*PendingSlash)(nil),             // 19: milkyway.restaking.v1.PendingSlash
+	(*OperatorServiceKey)(nil),       // 20: milkyway.restaking.v1.OperatorServiceKey
+	(*v1beta1.DecCoin)(nil),          // 21: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),             // 22: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
 }
 var file_milkyway_restaking_v1_models_proto_depIdxs = []int32{
 	0,  // 0: milkyway.restaking.v1.Delegation.type:type_name -> milkyway.restaking.v1.DelegationType
-	9,  // 1: milkyway.restaking.v1.Delegation.shares:type_name -> cosmos.base.v1beta1.DecCoin
-	1,  // 2: milkyway.restaking.v1.DelegationResponse.delegation:type_name -> milkyway.restaking.v1.Delegation
-	10, // 3: milkyway.restaking.v1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	21, // 1: milkyway.restaking.v1.Delegation.shares:type_name -> cosmos.base.v1beta1.DecCoin
+	2,  // 2: milkyway.restaking.v1.DelegationResponse.delegation:type_name -> milkyway.restaking.v1.Delegation
+	22, // 3: milkyway.restaking.v1.DelegationResponse.balance:type_name -> cosmos.base.v1beta1.Coin
 	0,  // 4: milkyway.restaking.v1.UnbondingDelegation.type:type_name -> milkyway.restaking.v1.DelegationType
-	4,  // 5: milkyway.restaking.v1.UnbondingDelegation.entries:type_name -> milkyway.restaking.v1.UnbondingDelegationEntry
-	11, // 6: milkyway.restaking.v1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
-	10, // 7: milkyway.restaking.v1.UnbondingDelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
-	10, // 8: milkyway.restaking.v1.UnbondingDelegationEntry.balance:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 5: milkyway.restaking.v1.UnbondingDelegation.entries:type_name -> milkyway.restaking.v1.UnbondingDelegationEntry
+	23, // 6: milkyway.restaking.v1.UnbondingDelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	22, // 7: milkyway.restaking.v1.UnbondingDelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
+	22, // 8: milkyway.restaking.v1.UnbondingDelegationEntry.balance:type_name -> cosmos.base.v1beta1.Coin
 	0,  // 9: milkyway.restaking.v1.DTData.unbonding_delegation_type:type_name -> milkyway.restaking.v1.DelegationType
-	5,  // 10: milkyway.restaking.v1.DTDataList.data:type_name -> milkyway.restaking.v1.DTData
-	8,  // 11: milkyway.restaking.v1.UserPreferences.trusted_services:type_name -> milkyway.restaking.v1.TrustedServiceEntry
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 10: milkyway.restaking.v1.DTDataList.data:type_name -> milkyway.restaking.v1.DTData
+	0,  // 11: milkyway.restaking.v1.Redelegation.src_type:type_name -> milkyway.restaking.v1.DelegationType
+	0,  // 12: milkyway.restaking.v1.Redelegation.dst_type:type_name -> milkyway.restaking.v1.DelegationType
+	9,  // 13: milkyway.restaking.v1.Redelegation.entries:type_name -> milkyway.restaking.v1.RedelegationEntry
+	23, // 14: milkyway.restaking.v1.RedelegationEntry.completion_time:type_name -> google.protobuf.Timestamp
+	22, // 15: milkyway.restaking.v1.RedelegationEntry.initial_balance:type_name -> cosmos.base.v1beta1.Coin
+	21, // 16: milkyway.restaking.v1.RedelegationEntry.shares_dst:type_name -> cosmos.base.v1beta1.DecCoin
+	0,  // 17: milkyway.restaking.v1.RTData.src_type:type_name -> milkyway.restaking.v1.DelegationType
+	0,  // 18: milkyway.restaking.v1.RTData.dst_type:type_name -> milkyway.restaking.v1.DelegationType
+	10, // 19: milkyway.restaking.v1.RTDataList.data:type_name -> milkyway.restaking.v1.RTData
+	13, // 20: milkyway.restaking.v1.UserPreferences.trusted_services:type_name -> milkyway.restaking.v1.TrustedServiceEntry
+	22, // 21: milkyway.restaking.v1.ValidatingOperator.tokens:type_name -> cosmos.base.v1beta1.Coin
+	23, // 22: milkyway.restaking.v1.ServiceSnapshot.time:type_name -> google.protobuf.Timestamp
+	14, // 23: milkyway.restaking.v1.ServiceSnapshot.operators:type_name -> milkyway.restaking.v1.ValidatingOperator
+	23, // 24: milkyway.restaking.v1.OperatorJailRecord.jailed_until:type_name -> google.protobuf.Timestamp
+	23, // 25: milkyway.restaking.v1.PendingSlash.execution_time:type_name -> google.protobuf.Timestamp
+	1,  // 26: milkyway.restaking.v1.OperatorServiceKey.key_type:type_name -> milkyway.restaking.v1.KeyType
+	23, // 27: milkyway.restaking.v1.OperatorServiceKey.activation_time:type_name -> google.protobuf.Timestamp
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_restaking_v1_models_proto_init() }
@@ -5572,7 +13794,7 @@ func file_milkyway_restaking_v1_models_proto_init() {
 			}
 		}
 		file_milkyway_restaking_v1_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPreferences); i {
+			switch v := v.(*Redelegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5584,6 +13806,54 @@ func file_milkyway_restaking_v1_models_proto_init() {
 			}
 		}
 		file_milkyway_restaking_v1_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedelegationEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTDataList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrustedServiceEntry); i {
 			case 0:
 				return &v.state
@@ -5595,14 +13865,98 @@ func file_milkyway_restaking_v1_models_proto_init() {
 				return nil
 			}
 		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatingOperator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatorJailRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmittedEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingSlash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_restaking_v1_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatorServiceKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_milkyway_restaking_v1_models_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
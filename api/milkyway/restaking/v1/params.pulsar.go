@@ -61,11 +61,20 @@ func (x *_Params_2_list) IsValid() bool {
 }
 
 var (
-	md_Params                protoreflect.MessageDescriptor
-	fd_Params_unbonding_time protoreflect.FieldDescriptor
-	fd_Params_allowed_denoms protoreflect.FieldDescriptor
-	fd_Params_restaking_cap  protoreflect.FieldDescriptor
-	fd_Params_max_entries    protoreflect.FieldDescriptor
+	md_Params                                     protoreflect.MessageDescriptor
+	fd_Params_unbonding_time                      protoreflect.FieldDescriptor
+	fd_Params_allowed_denoms                      protoreflect.FieldDescriptor
+	fd_Params_restaking_cap                       protoreflect.FieldDescriptor
+	fd_Params_max_entries                         protoreflect.FieldDescriptor
+	fd_Params_slashed_funds_recipient             protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration              protoreflect.FieldDescriptor
+	fd_Params_slash_veto_window                   protoreflect.FieldDescriptor
+	fd_Params_accredited_slash_veto_window        protoreflect.FieldDescriptor
+	fd_Params_slash_veto_committee                protoreflect.FieldDescriptor
+	fd_Params_operator_service_key_rotation_delay protoreflect.FieldDescriptor
+	fd_Params_max_service_unbonding_time          protoreflect.FieldDescriptor
+	fd_Params_active_operators_refresh_interval   protoreflect.FieldDescriptor
+	fd_Params_max_evidence_age                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -75,6 +84,15 @@ func init() {
 	fd_Params_allowed_denoms = md_Params.Fields().ByName("allowed_denoms")
 	fd_Params_restaking_cap = md_Params.Fields().ByName("restaking_cap")
 	fd_Params_max_entries = md_Params.Fields().ByName("max_entries")
+	fd_Params_slashed_funds_recipient = md_Params.Fields().ByName("slashed_funds_recipient")
+	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
+	fd_Params_slash_veto_window = md_Params.Fields().ByName("slash_veto_window")
+	fd_Params_accredited_slash_veto_window = md_Params.Fields().ByName("accredited_slash_veto_window")
+	fd_Params_slash_veto_committee = md_Params.Fields().ByName("slash_veto_committee")
+	fd_Params_operator_service_key_rotation_delay = md_Params.Fields().ByName("operator_service_key_rotation_delay")
+	fd_Params_max_service_unbonding_time = md_Params.Fields().ByName("max_service_unbonding_time")
+	fd_Params_active_operators_refresh_interval = md_Params.Fields().ByName("active_operators_refresh_interval")
+	fd_Params_max_evidence_age = md_Params.Fields().ByName("max_evidence_age")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -166,6 +184,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SlashedFundsRecipient != "" {
+		value := protoreflect.ValueOfString(x.SlashedFundsRecipient)
+		if !f(fd_Params_slashed_funds_recipient, value) {
+			return
+		}
+	}
+	if x.DowntimeJailDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DowntimeJailDuration)
+		if !f(fd_Params_downtime_jail_duration, value) {
+			return
+		}
+	}
+	if x.SlashVetoWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlashVetoWindow)
+		if !f(fd_Params_slash_veto_window, value) {
+			return
+		}
+	}
+	if x.AccreditedSlashVetoWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccreditedSlashVetoWindow)
+		if !f(fd_Params_accredited_slash_veto_window, value) {
+			return
+		}
+	}
+	if x.SlashVetoCommittee != "" {
+		value := protoreflect.ValueOfString(x.SlashVetoCommittee)
+		if !f(fd_Params_slash_veto_committee, value) {
+			return
+		}
+	}
+	if x.OperatorServiceKeyRotationDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OperatorServiceKeyRotationDelay)
+		if !f(fd_Params_operator_service_key_rotation_delay, value) {
+			return
+		}
+	}
+	if x.MaxServiceUnbondingTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxServiceUnbondingTime)
+		if !f(fd_Params_max_service_unbonding_time, value) {
+			return
+		}
+	}
+	if x.ActiveOperatorsRefreshInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActiveOperatorsRefreshInterval)
+		if !f(fd_Params_active_operators_refresh_interval, value) {
+			return
+		}
+	}
+	if x.MaxEvidenceAge != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxEvidenceAge)
+		if !f(fd_Params_max_evidence_age, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -189,6 +261,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RestakingCap != ""
 	case "milkyway.restaking.v1.Params.max_entries":
 		return x.MaxEntries != uint32(0)
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		return x.SlashedFundsRecipient != ""
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		return x.DowntimeJailDuration != int64(0)
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		return x.SlashVetoWindow != int64(0)
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		return x.AccreditedSlashVetoWindow != int64(0)
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		return x.SlashVetoCommittee != ""
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		return x.OperatorServiceKeyRotationDelay != int64(0)
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		return x.MaxServiceUnbondingTime != int64(0)
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		return x.ActiveOperatorsRefreshInterval != uint64(0)
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		return x.MaxEvidenceAge != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -213,6 +303,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.RestakingCap = ""
 	case "milkyway.restaking.v1.Params.max_entries":
 		x.MaxEntries = uint32(0)
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		x.SlashedFundsRecipient = ""
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		x.DowntimeJailDuration = int64(0)
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		x.SlashVetoWindow = int64(0)
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		x.AccreditedSlashVetoWindow = int64(0)
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		x.SlashVetoCommittee = ""
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		x.OperatorServiceKeyRotationDelay = int64(0)
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		x.MaxServiceUnbondingTime = int64(0)
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		x.ActiveOperatorsRefreshInterval = uint64(0)
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		x.MaxEvidenceAge = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -244,6 +352,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "milkyway.restaking.v1.Params.max_entries":
 		value := x.MaxEntries
 		return protoreflect.ValueOfUint32(value)
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		value := x.SlashedFundsRecipient
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		value := x.DowntimeJailDuration
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		value := x.SlashVetoWindow
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		value := x.AccreditedSlashVetoWindow
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		value := x.SlashVetoCommittee
+		return protoreflect.ValueOfString(value)
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		value := x.OperatorServiceKeyRotationDelay
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		value := x.MaxServiceUnbondingTime
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		value := x.ActiveOperatorsRefreshInterval
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		value := x.MaxEvidenceAge
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -274,6 +409,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.RestakingCap = value.Interface().(string)
 	case "milkyway.restaking.v1.Params.max_entries":
 		x.MaxEntries = uint32(value.Uint())
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		x.SlashedFundsRecipient = value.Interface().(string)
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		x.DowntimeJailDuration = value.Int()
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		x.SlashVetoWindow = value.Int()
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		x.AccreditedSlashVetoWindow = value.Int()
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		x.SlashVetoCommittee = value.Interface().(string)
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		x.OperatorServiceKeyRotationDelay = value.Int()
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		x.MaxServiceUnbondingTime = value.Int()
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		x.ActiveOperatorsRefreshInterval = value.Uint()
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		x.MaxEvidenceAge = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -306,6 +459,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field restaking_cap of message milkyway.restaking.v1.Params is not mutable"))
 	case "milkyway.restaking.v1.Params.max_entries":
 		panic(fmt.Errorf("field max_entries of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		panic(fmt.Errorf("field slashed_funds_recipient of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		panic(fmt.Errorf("field downtime_jail_duration of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		panic(fmt.Errorf("field slash_veto_window of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		panic(fmt.Errorf("field accredited_slash_veto_window of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		panic(fmt.Errorf("field slash_veto_committee of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		panic(fmt.Errorf("field operator_service_key_rotation_delay of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		panic(fmt.Errorf("field max_service_unbonding_time of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		panic(fmt.Errorf("field active_operators_refresh_interval of message milkyway.restaking.v1.Params is not mutable"))
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		panic(fmt.Errorf("field max_evidence_age of message milkyway.restaking.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -328,6 +499,24 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "milkyway.restaking.v1.Params.max_entries":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.restaking.v1.Params.slashed_funds_recipient":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.Params.downtime_jail_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.Params.slash_veto_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.Params.accredited_slash_veto_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.Params.slash_veto_committee":
+		return protoreflect.ValueOfString("")
+	case "milkyway.restaking.v1.Params.operator_service_key_rotation_delay":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.Params.max_service_unbonding_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.restaking.v1.Params.active_operators_refresh_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.restaking.v1.Params.max_evidence_age":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.restaking.v1.Params"))
@@ -413,6 +602,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxEntries != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxEntries))
 		}
+		l = len(x.SlashedFundsRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeJailDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.DowntimeJailDuration))
+		}
+		if x.SlashVetoWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashVetoWindow))
+		}
+		if x.AccreditedSlashVetoWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccreditedSlashVetoWindow))
+		}
+		l = len(x.SlashVetoCommittee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OperatorServiceKeyRotationDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorServiceKeyRotationDelay))
+		}
+		if x.MaxServiceUnbondingTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxServiceUnbondingTime))
+		}
+		if x.ActiveOperatorsRefreshInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActiveOperatorsRefreshInterval))
+		}
+		if x.MaxEvidenceAge != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEvidenceAge))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -442,6 +660,55 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxEvidenceAge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEvidenceAge))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.ActiveOperatorsRefreshInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveOperatorsRefreshInterval))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.MaxServiceUnbondingTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxServiceUnbondingTime))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.OperatorServiceKeyRotationDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorServiceKeyRotationDelay))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.SlashVetoCommittee) > 0 {
+			i -= len(x.SlashVetoCommittee)
+			copy(dAtA[i:], x.SlashVetoCommittee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashVetoCommittee)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.AccreditedSlashVetoWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccreditedSlashVetoWindow))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SlashVetoWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashVetoWindow))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.DowntimeJailDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DowntimeJailDuration))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SlashedFundsRecipient) > 0 {
+			i -= len(x.SlashedFundsRecipient)
+			copy(dAtA[i:], x.SlashedFundsRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedFundsRecipient)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.MaxEntries != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEntries))
 			i--
@@ -619,6 +886,203 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedFundsRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedFundsRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				}
+				x.DowntimeJailDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DowntimeJailDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashVetoWindow", wireType)
+				}
+				x.SlashVetoWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashVetoWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccreditedSlashVetoWindow", wireType)
+				}
+				x.AccreditedSlashVetoWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccreditedSlashVetoWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashVetoCommittee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashVetoCommittee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorServiceKeyRotationDelay", wireType)
+				}
+				x.OperatorServiceKeyRotationDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorServiceKeyRotationDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxServiceUnbondingTime", wireType)
+				}
+				x.MaxServiceUnbondingTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxServiceUnbondingTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveOperatorsRefreshInterval", wireType)
+				}
+				x.ActiveOperatorsRefreshInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActiveOperatorsRefreshInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEvidenceAge", wireType)
+				}
+				x.MaxEvidenceAge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEvidenceAge |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -688,6 +1152,44 @@ type Params struct {
 	// MaxEntries represents the maximum number of entries for unbonding
 	// delegation.
 	MaxEntries uint32 `protobuf:"varint,4,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// SlashedFundsRecipient represents the address to which the slashed funds
+	// will be sent. If empty, the slashed funds will be burned.
+	SlashedFundsRecipient string `protobuf:"bytes,5,opt,name=slashed_funds_recipient,json=slashedFundsRecipient,proto3" json:"slashed_funds_recipient,omitempty"`
+	// DowntimeJailDuration represents the time for which an operator is jailed
+	// from a service due to downtime. After this period, the operator can unjail
+	// itself from the service.
+	DowntimeJailDuration int64 `protobuf:"varint,6,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
+	// SlashVetoWindow represents the time that must pass between the moment in
+	// which a slash is requested and the moment in which it is executed. During
+	// this period, the slash can be vetoed.
+	SlashVetoWindow int64 `protobuf:"varint,7,opt,name=slash_veto_window,json=slashVetoWindow,proto3" json:"slash_veto_window,omitempty"`
+	// AccreditedSlashVetoWindow represents the slash veto window that is applied
+	// to the slashes requested by accredited services. It cannot be greater
+	// than SlashVetoWindow.
+	AccreditedSlashVetoWindow int64 `protobuf:"varint,8,opt,name=accredited_slash_veto_window,json=accreditedSlashVetoWindow,proto3" json:"accredited_slash_veto_window,omitempty"`
+	// SlashVetoCommittee represents the address that, together with the module
+	// authority, is allowed to veto the pending slashes. If empty, only the
+	// module authority can veto the pending slashes.
+	SlashVetoCommittee string `protobuf:"bytes,9,opt,name=slash_veto_committee,json=slashVetoCommittee,proto3" json:"slash_veto_committee,omitempty"`
+	// OperatorServiceKeyRotationDelay represents the time that must pass
+	// between the moment in which an operator registers a new key for a service
+	// and the moment in which it replaces the current one.
+	OperatorServiceKeyRotationDelay int64 `protobuf:"varint,10,opt,name=operator_service_key_rotation_delay,json=operatorServiceKeyRotationDelay,proto3" json:"operator_service_key_rotation_delay,omitempty"`
+	// MaxServiceUnbondingTime represents the maximum unbonding time that is
+	// applied to the delegations securing a service. Services setting a longer
+	// unbonding time inside their params are capped to this value. It cannot be
+	// lower than UnbondingTime.
+	MaxServiceUnbondingTime int64 `protobuf:"varint,11,opt,name=max_service_unbonding_time,json=maxServiceUnbondingTime,proto3" json:"max_service_unbonding_time,omitempty"`
+	// ActiveOperatorsRefreshInterval represents the number of blocks after which
+	// the active operators of all the services are recomputed. In between, only
+	// the services whose operators set might have changed are recomputed.
+	ActiveOperatorsRefreshInterval uint64 `protobuf:"varint,12,opt,name=active_operators_refresh_interval,json=activeOperatorsRefreshInterval,proto3" json:"active_operators_refresh_interval,omitempty"`
+	// MaxEvidenceAge represents the maximum number of blocks that can pass
+	// between the height at which a misbehavior occurred and the moment in which
+	// its evidence is submitted. It should correspond to a period shorter than
+	// UnbondingTime minus SlashVetoWindow, so that the stake that was unbonding
+	// at the time of the misbehavior can still be slashed.
+	MaxEvidenceAge uint64 `protobuf:"varint,13,opt,name=max_evidence_age,json=maxEvidenceAge,proto3" json:"max_evidence_age,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -738,6 +1240,69 @@ func (x *Params) GetMaxEntries() uint32 {
 	return 0
 }
 
+func (x *Params) GetSlashedFundsRecipient() string {
+	if x != nil {
+		return x.SlashedFundsRecipient
+	}
+	return ""
+}
+
+func (x *Params) GetDowntimeJailDuration() int64 {
+	if x != nil {
+		return x.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (x *Params) GetSlashVetoWindow() int64 {
+	if x != nil {
+		return x.SlashVetoWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAccreditedSlashVetoWindow() int64 {
+	if x != nil {
+		return x.AccreditedSlashVetoWindow
+	}
+	return 0
+}
+
+func (x *Params) GetSlashVetoCommittee() string {
+	if x != nil {
+		return x.SlashVetoCommittee
+	}
+	return ""
+}
+
+func (x *Params) GetOperatorServiceKeyRotationDelay() int64 {
+	if x != nil {
+		return x.OperatorServiceKeyRotationDelay
+	}
+	return 0
+}
+
+func (x *Params) GetMaxServiceUnbondingTime() int64 {
+	if x != nil {
+		return x.MaxServiceUnbondingTime
+	}
+	return 0
+}
+
+func (x *Params) GetActiveOperatorsRefreshInterval() uint64 {
+	if x != nil {
+		return x.ActiveOperatorsRefreshInterval
+	}
+	return 0
+}
+
+func (x *Params) GetMaxEvidenceAge() uint64 {
+	if x != nil {
+		return x.MaxEvidenceAge
+	}
+	return 0
+}
+
 var File_milkyway_restaking_v1_params_proto protoreflect.FileDescriptor
 
 var file_milkyway_restaking_v1_params_proto_rawDesc = []byte{
@@ -747,7 +1312,7 @@ var file_milkyway_restaking_v1_params_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x06, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
 	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
 	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
@@ -761,22 +1326,60 @@ var file_milkyway_restaking_v1_params_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
 	0x43, 0x61, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
 	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x42, 0xeb, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
-	0x76, 0x31, 0x3b, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
-	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d,
-	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c,
-	0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98,
+	0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x45, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x19, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x56, 0x65, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4a, 0x0a, 0x14, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x52, 0x0a, 0x23, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x1f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x41, 0x0a, 0x1a, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49,
+	0x0a, 0x21, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x41, 0x67, 0x65, 0x42, 0xeb, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x3b, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4d, 0x52, 0x58, 0xaa, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
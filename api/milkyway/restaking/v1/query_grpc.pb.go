@@ -22,6 +22,13 @@ const (
 	Query_OperatorJoinedServices_FullMethodName                = "/milkyway.restaking.v1.Query/OperatorJoinedServices"
 	Query_ServiceAllowedOperators_FullMethodName               = "/milkyway.restaking.v1.Query/ServiceAllowedOperators"
 	Query_ServiceSecuringPools_FullMethodName                  = "/milkyway.restaking.v1.Query/ServiceSecuringPools"
+	Query_ServiceActiveOperators_FullMethodName                = "/milkyway.restaking.v1.Query/ServiceActiveOperators"
+	Query_ServiceValidatingSet_FullMethodName                  = "/milkyway.restaking.v1.Query/ServiceValidatingSet"
+	Query_ServiceSnapshot_FullMethodName                       = "/milkyway.restaking.v1.Query/ServiceSnapshot"
+	Query_OperatorJailRecord_FullMethodName                    = "/milkyway.restaking.v1.Query/OperatorJailRecord"
+	Query_PendingSlash_FullMethodName                          = "/milkyway.restaking.v1.Query/PendingSlash"
+	Query_PendingSlashes_FullMethodName                        = "/milkyway.restaking.v1.Query/PendingSlashes"
+	Query_OperatorServiceKey_FullMethodName                    = "/milkyway.restaking.v1.Query/OperatorServiceKey"
 	Query_ServiceOperators_FullMethodName                      = "/milkyway.restaking.v1.Query/ServiceOperators"
 	Query_PoolDelegations_FullMethodName                       = "/milkyway.restaking.v1.Query/PoolDelegations"
 	Query_PoolDelegation_FullMethodName                        = "/milkyway.restaking.v1.Query/PoolDelegation"
@@ -47,6 +54,8 @@ const (
 	Query_DelegatorOperator_FullMethodName                     = "/milkyway.restaking.v1.Query/DelegatorOperator"
 	Query_DelegatorServices_FullMethodName                     = "/milkyway.restaking.v1.Query/DelegatorServices"
 	Query_DelegatorService_FullMethodName                      = "/milkyway.restaking.v1.Query/DelegatorService"
+	Query_DelegatorRedelegations_FullMethodName                = "/milkyway.restaking.v1.Query/DelegatorRedelegations"
+	Query_TargetRedelegations_FullMethodName                   = "/milkyway.restaking.v1.Query/TargetRedelegations"
 	Query_UserPreferences_FullMethodName                       = "/milkyway.restaking.v1.Query/UserPreferences"
 	Query_Params_FullMethodName                                = "/milkyway.restaking.v1.Query/Params"
 )
@@ -63,6 +72,26 @@ type QueryClient interface {
 	ServiceAllowedOperators(ctx context.Context, in *QueryServiceAllowedOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceAllowedOperatorsResponse, error)
 	// ServiceSecuringPools queries the pools that are securing a given service.
 	ServiceSecuringPools(ctx context.Context, in *QueryServiceSecuringPoolsRequest, opts ...grpc.CallOption) (*QueryServiceSecuringPoolsResponse, error)
+	// ServiceActiveOperators queries the operators that are part of the active
+	// operators set of a given service.
+	ServiceActiveOperators(ctx context.Context, in *QueryServiceActiveOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceActiveOperatorsResponse, error)
+	// ServiceValidatingSet queries the validating set of a given service, along
+	// with the voting power of each operator. Historical validating sets can be
+	// queried by setting the x-cosmos-block-height header.
+	ServiceValidatingSet(ctx context.Context, in *QueryServiceValidatingSetRequest, opts ...grpc.CallOption) (*QueryServiceValidatingSetResponse, error)
+	// ServiceSnapshot queries the snapshot of a service's validating set taken
+	// at a given epoch. If the epoch is 0, the latest snapshot is returned.
+	ServiceSnapshot(ctx context.Context, in *QueryServiceSnapshotRequest, opts ...grpc.CallOption) (*QueryServiceSnapshotResponse, error)
+	// OperatorJailRecord queries the jail record of an operator for a given
+	// service.
+	OperatorJailRecord(ctx context.Context, in *QueryOperatorJailRecordRequest, opts ...grpc.CallOption) (*QueryOperatorJailRecordResponse, error)
+	// PendingSlash queries the pending slash having the given ID.
+	PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error)
+	// PendingSlashes queries all the pending slashes.
+	PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error)
+	// OperatorServiceKey queries the key that an operator uses for a given
+	// service.
+	OperatorServiceKey(ctx context.Context, in *QueryOperatorServiceKeyRequest, opts ...grpc.CallOption) (*QueryOperatorServiceKeyResponse, error)
 	// ServiceOperators queries the operators for a given service.
 	ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error)
 	// PoolDelegations queries the delegations info for the given pool.
@@ -131,6 +160,11 @@ type QueryClient interface {
 	// DelegatorService queries the service info for given delegator and service
 	// id.
 	DelegatorService(ctx context.Context, in *QueryDelegatorServiceRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceResponse, error)
+	// DelegatorRedelegations queries all the redelegations of a given delegator
+	// address.
+	DelegatorRedelegations(ctx context.Context, in *QueryDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorRedelegationsResponse, error)
+	// TargetRedelegations queries all the redelegations from a given target.
+	TargetRedelegations(ctx context.Context, in *QueryTargetRedelegationsRequest, opts ...grpc.CallOption) (*QueryTargetRedelegationsResponse, error)
 	// UserPreferences queries the user preferences.
 	UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error)
 	// Params queries the restaking parameters.
@@ -175,6 +209,76 @@ func (c *queryClient) ServiceSecuringPools(ctx context.Context, in *QueryService
 	return out, nil
 }
 
+func (c *queryClient) ServiceActiveOperators(ctx context.Context, in *QueryServiceActiveOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceActiveOperatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryServiceActiveOperatorsResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceActiveOperators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceValidatingSet(ctx context.Context, in *QueryServiceValidatingSetRequest, opts ...grpc.CallOption) (*QueryServiceValidatingSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryServiceValidatingSetResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceValidatingSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceSnapshot(ctx context.Context, in *QueryServiceSnapshotRequest, opts ...grpc.CallOption) (*QueryServiceSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryServiceSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorJailRecord(ctx context.Context, in *QueryOperatorJailRecordRequest, opts ...grpc.CallOption) (*QueryOperatorJailRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOperatorJailRecordResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorJailRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingSlashResponse)
+	err := c.cc.Invoke(ctx, Query_PendingSlash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingSlashesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingSlashes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorServiceKey(ctx context.Context, in *QueryOperatorServiceKeyRequest, opts ...grpc.CallOption) (*QueryOperatorServiceKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOperatorServiceKeyResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorServiceKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryServiceOperatorsResponse)
@@ -425,6 +529,26 @@ func (c *queryClient) DelegatorService(ctx context.Context, in *QueryDelegatorSe
 	return out, nil
 }
 
+func (c *queryClient) DelegatorRedelegations(ctx context.Context, in *QueryDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorRedelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorRedelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TargetRedelegations(ctx context.Context, in *QueryTargetRedelegationsRequest, opts ...grpc.CallOption) (*QueryTargetRedelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTargetRedelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_TargetRedelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryUserPreferencesResponse)
@@ -457,6 +581,26 @@ type QueryServer interface {
 	ServiceAllowedOperators(context.Context, *QueryServiceAllowedOperatorsRequest) (*QueryServiceAllowedOperatorsResponse, error)
 	// ServiceSecuringPools queries the pools that are securing a given service.
 	ServiceSecuringPools(context.Context, *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error)
+	// ServiceActiveOperators queries the operators that are part of the active
+	// operators set of a given service.
+	ServiceActiveOperators(context.Context, *QueryServiceActiveOperatorsRequest) (*QueryServiceActiveOperatorsResponse, error)
+	// ServiceValidatingSet queries the validating set of a given service, along
+	// with the voting power of each operator. Historical validating sets can be
+	// queried by setting the x-cosmos-block-height header.
+	ServiceValidatingSet(context.Context, *QueryServiceValidatingSetRequest) (*QueryServiceValidatingSetResponse, error)
+	// ServiceSnapshot queries the snapshot of a service's validating set taken
+	// at a given epoch. If the epoch is 0, the latest snapshot is returned.
+	ServiceSnapshot(context.Context, *QueryServiceSnapshotRequest) (*QueryServiceSnapshotResponse, error)
+	// OperatorJailRecord queries the jail record of an operator for a given
+	// service.
+	OperatorJailRecord(context.Context, *QueryOperatorJailRecordRequest) (*QueryOperatorJailRecordResponse, error)
+	// PendingSlash queries the pending slash having the given ID.
+	PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error)
+	// PendingSlashes queries all the pending slashes.
+	PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error)
+	// OperatorServiceKey queries the key that an operator uses for a given
+	// service.
+	OperatorServiceKey(context.Context, *QueryOperatorServiceKeyRequest) (*QueryOperatorServiceKeyResponse, error)
 	// ServiceOperators queries the operators for a given service.
 	ServiceOperators(context.Context, *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error)
 	// PoolDelegations queries the delegations info for the given pool.
@@ -525,6 +669,11 @@ type QueryServer interface {
 	// DelegatorService queries the service info for given delegator and service
 	// id.
 	DelegatorService(context.Context, *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error)
+	// DelegatorRedelegations queries all the redelegations of a given delegator
+	// address.
+	DelegatorRedelegations(context.Context, *QueryDelegatorRedelegationsRequest) (*QueryDelegatorRedelegationsResponse, error)
+	// TargetRedelegations queries all the redelegations from a given target.
+	TargetRedelegations(context.Context, *QueryTargetRedelegationsRequest) (*QueryTargetRedelegationsResponse, error)
 	// UserPreferences queries the user preferences.
 	UserPreferences(context.Context, *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error)
 	// Params queries the restaking parameters.
@@ -548,6 +697,27 @@ func (UnimplementedQueryServer) ServiceAllowedOperators(context.Context, *QueryS
 func (UnimplementedQueryServer) ServiceSecuringPools(context.Context, *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceSecuringPools not implemented")
 }
+func (UnimplementedQueryServer) ServiceActiveOperators(context.Context, *QueryServiceActiveOperatorsRequest) (*QueryServiceActiveOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceActiveOperators not implemented")
+}
+func (UnimplementedQueryServer) ServiceValidatingSet(context.Context, *QueryServiceValidatingSetRequest) (*QueryServiceValidatingSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceValidatingSet not implemented")
+}
+func (UnimplementedQueryServer) ServiceSnapshot(context.Context, *QueryServiceSnapshotRequest) (*QueryServiceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceSnapshot not implemented")
+}
+func (UnimplementedQueryServer) OperatorJailRecord(context.Context, *QueryOperatorJailRecordRequest) (*QueryOperatorJailRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorJailRecord not implemented")
+}
+func (UnimplementedQueryServer) PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlash not implemented")
+}
+func (UnimplementedQueryServer) PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlashes not implemented")
+}
+func (UnimplementedQueryServer) OperatorServiceKey(context.Context, *QueryOperatorServiceKeyRequest) (*QueryOperatorServiceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorServiceKey not implemented")
+}
 func (UnimplementedQueryServer) ServiceOperators(context.Context, *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceOperators not implemented")
 }
@@ -623,6 +793,12 @@ func (UnimplementedQueryServer) DelegatorServices(context.Context, *QueryDelegat
 func (UnimplementedQueryServer) DelegatorService(context.Context, *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorService not implemented")
 }
+func (UnimplementedQueryServer) DelegatorRedelegations(context.Context, *QueryDelegatorRedelegationsRequest) (*QueryDelegatorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRedelegations not implemented")
+}
+func (UnimplementedQueryServer) TargetRedelegations(context.Context, *QueryTargetRedelegationsRequest) (*QueryTargetRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TargetRedelegations not implemented")
+}
 func (UnimplementedQueryServer) UserPreferences(context.Context, *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserPreferences not implemented")
 }
@@ -704,6 +880,132 @@ func _Query_ServiceSecuringPools_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ServiceActiveOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceActiveOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceActiveOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceActiveOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceActiveOperators(ctx, req.(*QueryServiceActiveOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceValidatingSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceValidatingSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceValidatingSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceValidatingSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceValidatingSet(ctx, req.(*QueryServiceValidatingSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceSnapshot(ctx, req.(*QueryServiceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorJailRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorJailRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorJailRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorJailRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorJailRecord(ctx, req.(*QueryOperatorJailRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlash(ctx, req.(*QueryPendingSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingSlashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlashes(ctx, req.(*QueryPendingSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorServiceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorServiceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorServiceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorServiceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorServiceKey(ctx, req.(*QueryOperatorServiceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ServiceOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryServiceOperatorsRequest)
 	if err := dec(in); err != nil {
@@ -1154,6 +1456,42 @@ func _Query_DelegatorService_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorRedelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRedelegations(ctx, req.(*QueryDelegatorRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TargetRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TargetRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TargetRedelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TargetRedelegations(ctx, req.(*QueryTargetRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_UserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUserPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -1209,6 +1547,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ServiceSecuringPools",
 			Handler:    _Query_ServiceSecuringPools_Handler,
 		},
+		{
+			MethodName: "ServiceActiveOperators",
+			Handler:    _Query_ServiceActiveOperators_Handler,
+		},
+		{
+			MethodName: "ServiceValidatingSet",
+			Handler:    _Query_ServiceValidatingSet_Handler,
+		},
+		{
+			MethodName: "ServiceSnapshot",
+			Handler:    _Query_ServiceSnapshot_Handler,
+		},
+		{
+			MethodName: "OperatorJailRecord",
+			Handler:    _Query_OperatorJailRecord_Handler,
+		},
+		{
+			MethodName: "PendingSlash",
+			Handler:    _Query_PendingSlash_Handler,
+		},
+		{
+			MethodName: "PendingSlashes",
+			Handler:    _Query_PendingSlashes_Handler,
+		},
+		{
+			MethodName: "OperatorServiceKey",
+			Handler:    _Query_OperatorServiceKey_Handler,
+		},
 		{
 			MethodName: "ServiceOperators",
 			Handler:    _Query_ServiceOperators_Handler,
@@ -1309,6 +1675,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorService",
 			Handler:    _Query_DelegatorService_Handler,
 		},
+		{
+			MethodName: "DelegatorRedelegations",
+			Handler:    _Query_DelegatorRedelegations_Handler,
+		},
+		{
+			MethodName: "TargetRedelegations",
+			Handler:    _Query_TargetRedelegations_Handler,
+		},
 		{
 			MethodName: "UserPreferences",
 			Handler:    _Query_UserPreferences_Handler,
@@ -66,14 +66,20 @@ func (x *_Params_1_list) IsValid() bool {
 }
 
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_rewards_plan_creation_fee protoreflect.FieldDescriptor
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_rewards_plan_creation_fee       protoreflect.FieldDescriptor
+	fd_Params_auto_compound_interval          protoreflect.FieldDescriptor
+	fd_Params_auto_compound_max_gas_per_block protoreflect.FieldDescriptor
+	fd_Params_allocation_epoch_duration       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_params_proto_init()
 	md_Params = File_milkyway_rewards_v1_params_proto.Messages().ByName("Params")
 	fd_Params_rewards_plan_creation_fee = md_Params.Fields().ByName("rewards_plan_creation_fee")
+	fd_Params_auto_compound_interval = md_Params.Fields().ByName("auto_compound_interval")
+	fd_Params_auto_compound_max_gas_per_block = md_Params.Fields().ByName("auto_compound_max_gas_per_block")
+	fd_Params_allocation_epoch_duration = md_Params.Fields().ByName("allocation_epoch_duration")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -147,6 +153,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.AutoCompoundInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AutoCompoundInterval)
+		if !f(fd_Params_auto_compound_interval, value) {
+			return
+		}
+	}
+	if x.AutoCompoundMaxGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AutoCompoundMaxGasPerBlock)
+		if !f(fd_Params_auto_compound_max_gas_per_block, value) {
+			return
+		}
+	}
+	if x.AllocationEpochDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AllocationEpochDuration)
+		if !f(fd_Params_allocation_epoch_duration, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -164,6 +188,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "milkyway.rewards.v1.Params.rewards_plan_creation_fee":
 		return len(x.RewardsPlanCreationFee) != 0
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		return x.AutoCompoundInterval != int64(0)
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		return x.AutoCompoundMaxGasPerBlock != uint64(0)
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		return x.AllocationEpochDuration != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -182,6 +212,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "milkyway.rewards.v1.Params.rewards_plan_creation_fee":
 		x.RewardsPlanCreationFee = nil
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = int64(0)
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		x.AutoCompoundMaxGasPerBlock = uint64(0)
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		x.AllocationEpochDuration = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -204,6 +240,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_1_list{list: &x.RewardsPlanCreationFee}
 		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		value := x.AutoCompoundInterval
+		return protoreflect.ValueOfInt64(value)
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		value := x.AutoCompoundMaxGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		value := x.AllocationEpochDuration
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -228,6 +273,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_1_list)
 		x.RewardsPlanCreationFee = *clv.list
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = value.Int()
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		x.AutoCompoundMaxGasPerBlock = value.Uint()
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		x.AllocationEpochDuration = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -254,6 +305,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.RewardsPlanCreationFee}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		panic(fmt.Errorf("field auto_compound_interval of message milkyway.rewards.v1.Params is not mutable"))
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		panic(fmt.Errorf("field auto_compound_max_gas_per_block of message milkyway.rewards.v1.Params is not mutable"))
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		panic(fmt.Errorf("field allocation_epoch_duration of message milkyway.rewards.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -270,6 +327,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "milkyway.rewards.v1.Params.rewards_plan_creation_fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "milkyway.rewards.v1.Params.auto_compound_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "milkyway.rewards.v1.Params.auto_compound_max_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.rewards.v1.Params.allocation_epoch_duration":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.Params"))
@@ -345,6 +408,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.AutoCompoundInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.AutoCompoundInterval))
+		}
+		if x.AutoCompoundMaxGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.AutoCompoundMaxGasPerBlock))
+		}
+		if x.AllocationEpochDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.AllocationEpochDuration))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -374,6 +446,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AllocationEpochDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AllocationEpochDuration))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AutoCompoundMaxGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AutoCompoundMaxGasPerBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AutoCompoundInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AutoCompoundInterval))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.RewardsPlanCreationFee) > 0 {
 			for iNdEx := len(x.RewardsPlanCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RewardsPlanCreationFee[iNdEx])
@@ -473,6 +560,63 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+				}
+				x.AutoCompoundInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AutoCompoundInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGasPerBlock", wireType)
+				}
+				x.AutoCompoundMaxGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AutoCompoundMaxGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllocationEpochDuration", wireType)
+				}
+				x.AllocationEpochDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AllocationEpochDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -532,6 +676,17 @@ type Params struct {
 	// The fee is drawn from the MsgCreateRewardsPlan sender's account and
 	// transferred to the community pool.
 	RewardsPlanCreationFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=rewards_plan_creation_fee,json=rewardsPlanCreationFee,proto3" json:"rewards_plan_creation_fee,omitempty"`
+	// AutoCompoundInterval represents the interval between two auto-compounding
+	// epochs. If set to 0, auto-compounding is disabled.
+	AutoCompoundInterval int64 `protobuf:"varint,2,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// AutoCompoundMaxGasPerBlock represents the maximum amount of gas that can
+	// be consumed in each block while processing the auto-compounding queue.
+	AutoCompoundMaxGasPerBlock uint64 `protobuf:"varint,3,opt,name=auto_compound_max_gas_per_block,json=autoCompoundMaxGasPerBlock,proto3" json:"auto_compound_max_gas_per_block,omitempty"`
+	// AllocationEpochDuration represents the duration of the rewards allocation
+	// epochs. Rewards accrue every block, but they are only distributed to the
+	// delegation targets once per epoch. If set to 0, rewards are distributed
+	// every block.
+	AllocationEpochDuration int64 `protobuf:"varint,4,opt,name=allocation_epoch_duration,json=allocationEpochDuration,proto3" json:"allocation_epoch_duration,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -561,6 +716,27 @@ func (x *Params) GetRewardsPlanCreationFee() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetAutoCompoundInterval() int64 {
+	if x != nil {
+		return x.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (x *Params) GetAutoCompoundMaxGasPerBlock() uint64 {
+	if x != nil {
+		return x.AutoCompoundMaxGasPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetAllocationEpochDuration() int64 {
+	if x != nil {
+		return x.AllocationEpochDuration
+	}
+	return 0
+}
+
 var File_milkyway_rewards_v1_params_proto protoreflect.FileDescriptor
 
 var file_milkyway_rewards_v1_params_proto_rawDesc = []byte{
@@ -570,7 +746,7 @@ var file_milkyway_rewards_v1_params_proto_rawDesc = []byte{
 	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x01,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x02,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x72, 0x65, 0x77,
 	0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
@@ -580,21 +756,33 @@ var file_milkyway_rewards_v1_params_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x16, 0x72, 0x65, 0x77, 0x61, 0x72,
 	0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
-	0x65, 0x42, 0xe1, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58, 0xaa,
-	0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15,
-	0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x3a, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x43, 0x0a,
+	0x1f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x40, 0x0a, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x17, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0xe1, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d,
+	0x52, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1f, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2657,25 +2657,25 @@ func (x *fastReflection_QueryRewardsPlanResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryPoolOutstandingRewardsRequest         protoreflect.MessageDescriptor
-	fd_QueryPoolOutstandingRewardsRequest_pool_id protoreflect.FieldDescriptor
+	md_QueryArchivedRewardsPlansRequest            protoreflect.MessageDescriptor
+	fd_QueryArchivedRewardsPlansRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolOutstandingRewardsRequest")
-	fd_QueryPoolOutstandingRewardsRequest_pool_id = md_QueryPoolOutstandingRewardsRequest.Fields().ByName("pool_id")
+	md_QueryArchivedRewardsPlansRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryArchivedRewardsPlansRequest")
+	fd_QueryArchivedRewardsPlansRequest_pagination = md_QueryArchivedRewardsPlansRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolOutstandingRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryArchivedRewardsPlansRequest)(nil)
 
-type fastReflection_QueryPoolOutstandingRewardsRequest QueryPoolOutstandingRewardsRequest
+type fastReflection_QueryArchivedRewardsPlansRequest QueryArchivedRewardsPlansRequest
 
-func (x *QueryPoolOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolOutstandingRewardsRequest)(x)
+func (x *QueryArchivedRewardsPlansRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlansRequest)(x)
 }
 
-func (x *QueryPoolOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryArchivedRewardsPlansRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2687,43 +2687,43 @@ func (x *QueryPoolOutstandingRewardsRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolOutstandingRewardsRequest_messageType fastReflection_QueryPoolOutstandingRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolOutstandingRewardsRequest_messageType{}
+var _fastReflection_QueryArchivedRewardsPlansRequest_messageType fastReflection_QueryArchivedRewardsPlansRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryArchivedRewardsPlansRequest_messageType{}
 
-type fastReflection_QueryPoolOutstandingRewardsRequest_messageType struct{}
+type fastReflection_QueryArchivedRewardsPlansRequest_messageType struct{}
 
-func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolOutstandingRewardsRequest)(nil)
+func (x fastReflection_QueryArchivedRewardsPlansRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlansRequest)(nil)
 }
-func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolOutstandingRewardsRequest)
+func (x fastReflection_QueryArchivedRewardsPlansRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlansRequest)
 }
-func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolOutstandingRewardsRequest
+func (x fastReflection_QueryArchivedRewardsPlansRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlansRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolOutstandingRewardsRequest
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlansRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolOutstandingRewardsRequest_messageType
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryArchivedRewardsPlansRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolOutstandingRewardsRequest)
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlansRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolOutstandingRewardsRequest)(x)
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryArchivedRewardsPlansRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2731,10 +2731,10 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PoolId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PoolId)
-		if !f(fd_QueryPoolOutstandingRewardsRequest_pool_id, value) {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryArchivedRewardsPlansRequest_pagination, value) {
 			return
 		}
 	}
@@ -2751,15 +2751,15 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		return x.PoolId != uint32(0)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2769,15 +2769,15 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		x.PoolId = uint32(0)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2787,16 +2787,16 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		value := x.PoolId
-		return protoreflect.ValueOfUint32(value)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2810,15 +2810,15 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		x.PoolId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2832,40 +2832,44 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryArchivedRewardsPlansRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2873,7 +2877,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2884,7 +2888,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2896,7 +2900,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2906,9 +2910,9 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryArchivedRewardsPlansRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2920,8 +2924,9 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.PoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.PoolId))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2933,7 +2938,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2952,10 +2957,19 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2968,7 +2982,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3000,17 +3014,17 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlansRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.PoolId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3020,11 +3034,28 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PoolId |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3060,26 +3091,79 @@ func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *prot
 	}
 }
 
+var _ protoreflect.List = (*_QueryArchivedRewardsPlansResponse_1_list)(nil)
+
+type _QueryArchivedRewardsPlansResponse_1_list struct {
+	list *[]*ArchivedRewardsPlan
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArchivedRewardsPlan)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArchivedRewardsPlan)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ArchivedRewardsPlan)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ArchivedRewardsPlan)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryArchivedRewardsPlansResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryPoolOutstandingRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryPoolOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
+	md_QueryArchivedRewardsPlansResponse                        protoreflect.MessageDescriptor
+	fd_QueryArchivedRewardsPlansResponse_archived_rewards_plans protoreflect.FieldDescriptor
+	fd_QueryArchivedRewardsPlansResponse_pagination             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolOutstandingRewardsResponse")
-	fd_QueryPoolOutstandingRewardsResponse_rewards = md_QueryPoolOutstandingRewardsResponse.Fields().ByName("rewards")
+	md_QueryArchivedRewardsPlansResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryArchivedRewardsPlansResponse")
+	fd_QueryArchivedRewardsPlansResponse_archived_rewards_plans = md_QueryArchivedRewardsPlansResponse.Fields().ByName("archived_rewards_plans")
+	fd_QueryArchivedRewardsPlansResponse_pagination = md_QueryArchivedRewardsPlansResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolOutstandingRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryArchivedRewardsPlansResponse)(nil)
 
-type fastReflection_QueryPoolOutstandingRewardsResponse QueryPoolOutstandingRewardsResponse
+type fastReflection_QueryArchivedRewardsPlansResponse QueryArchivedRewardsPlansResponse
 
-func (x *QueryPoolOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolOutstandingRewardsResponse)(x)
+func (x *QueryArchivedRewardsPlansResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlansResponse)(x)
 }
 
-func (x *QueryPoolOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryArchivedRewardsPlansResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3091,43 +3175,43 @@ func (x *QueryPoolOutstandingRewardsResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolOutstandingRewardsResponse_messageType fastReflection_QueryPoolOutstandingRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolOutstandingRewardsResponse_messageType{}
+var _fastReflection_QueryArchivedRewardsPlansResponse_messageType fastReflection_QueryArchivedRewardsPlansResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryArchivedRewardsPlansResponse_messageType{}
 
-type fastReflection_QueryPoolOutstandingRewardsResponse_messageType struct{}
+type fastReflection_QueryArchivedRewardsPlansResponse_messageType struct{}
 
-func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolOutstandingRewardsResponse)(nil)
+func (x fastReflection_QueryArchivedRewardsPlansResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlansResponse)(nil)
 }
-func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolOutstandingRewardsResponse)
+func (x fastReflection_QueryArchivedRewardsPlansResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlansResponse)
 }
-func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolOutstandingRewardsResponse
+func (x fastReflection_QueryArchivedRewardsPlansResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlansResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolOutstandingRewardsResponse
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlansResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolOutstandingRewardsResponse_messageType
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryArchivedRewardsPlansResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolOutstandingRewardsResponse)
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlansResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolOutstandingRewardsResponse)(x)
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryArchivedRewardsPlansResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3135,10 +3219,16 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Rewards != nil {
-		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
-		if !f(fd_QueryPoolOutstandingRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ArchivedRewardsPlans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryArchivedRewardsPlansResponse_1_list{list: &x.ArchivedRewardsPlans})
+		if !f(fd_QueryArchivedRewardsPlansResponse_archived_rewards_plans, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryArchivedRewardsPlansResponse_pagination, value) {
 			return
 		}
 	}
@@ -3155,15 +3245,17 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		return x.Rewards != nil
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		return len(x.ArchivedRewardsPlans) != 0
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3173,15 +3265,17 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		x.ArchivedRewardsPlans = nil
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3191,16 +3285,22 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		value := x.Rewards
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		if len(x.ArchivedRewardsPlans) == 0 {
+			return protoreflect.ValueOfList(&_QueryArchivedRewardsPlansResponse_1_list{})
+		}
+		listValue := &_QueryArchivedRewardsPlansResponse_1_list{list: &x.ArchivedRewardsPlans}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3214,15 +3314,19 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		x.Rewards = value.Message().Interface().(*OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		lv := value.List()
+		clv := lv.(*_QueryArchivedRewardsPlansResponse_1_list)
+		x.ArchivedRewardsPlans = *clv.list
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3236,44 +3340,53 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = new(OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		if x.ArchivedRewardsPlans == nil {
+			x.ArchivedRewardsPlans = []*ArchivedRewardsPlan{}
 		}
-		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+		value := &_QueryArchivedRewardsPlansResponse_1_list{list: &x.ArchivedRewardsPlans}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
-		m := new(OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans":
+		list := []*ArchivedRewardsPlan{}
+		return protoreflect.ValueOfList(&_QueryArchivedRewardsPlansResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlansResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryArchivedRewardsPlansResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3281,7 +3394,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3292,7 +3405,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3304,7 +3417,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3314,9 +3427,9 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryArchivedRewardsPlansResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3328,9 +3441,15 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if x.Rewards != nil {
-			l = options.Size(x.Rewards)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ArchivedRewardsPlans) > 0 {
+			for _, e := range x.ArchivedRewardsPlans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3342,7 +3461,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3361,8 +3480,8 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Rewards != nil {
-			encoded, err := options.Marshal(x.Rewards)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3373,7 +3492,23 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.ArchivedRewardsPlans) > 0 {
+			for iNdEx := len(x.ArchivedRewardsPlans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ArchivedRewardsPlans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3386,7 +3521,7 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlansResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3418,15 +3553,15 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlansResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedRewardsPlans", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3453,10 +3588,44 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Rewards == nil {
-					x.Rewards = &OutstandingRewards{}
+				x.ArchivedRewardsPlans = append(x.ArchivedRewardsPlans, &ArchivedRewardsPlan{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ArchivedRewardsPlans[len(x.ArchivedRewardsPlans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3496,25 +3665,25 @@ func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *pro
 }
 
 var (
-	md_QueryOperatorOutstandingRewardsRequest             protoreflect.MessageDescriptor
-	fd_QueryOperatorOutstandingRewardsRequest_operator_id protoreflect.FieldDescriptor
+	md_QueryArchivedRewardsPlanRequest         protoreflect.MessageDescriptor
+	fd_QueryArchivedRewardsPlanRequest_plan_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorOutstandingRewardsRequest")
-	fd_QueryOperatorOutstandingRewardsRequest_operator_id = md_QueryOperatorOutstandingRewardsRequest.Fields().ByName("operator_id")
+	md_QueryArchivedRewardsPlanRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryArchivedRewardsPlanRequest")
+	fd_QueryArchivedRewardsPlanRequest_plan_id = md_QueryArchivedRewardsPlanRequest.Fields().ByName("plan_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorOutstandingRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryArchivedRewardsPlanRequest)(nil)
 
-type fastReflection_QueryOperatorOutstandingRewardsRequest QueryOperatorOutstandingRewardsRequest
+type fastReflection_QueryArchivedRewardsPlanRequest QueryArchivedRewardsPlanRequest
 
-func (x *QueryOperatorOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorOutstandingRewardsRequest)(x)
+func (x *QueryArchivedRewardsPlanRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlanRequest)(x)
 }
 
-func (x *QueryOperatorOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryArchivedRewardsPlanRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3526,43 +3695,43 @@ func (x *QueryOperatorOutstandingRewardsRequest) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorOutstandingRewardsRequest_messageType fastReflection_QueryOperatorOutstandingRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorOutstandingRewardsRequest_messageType{}
+var _fastReflection_QueryArchivedRewardsPlanRequest_messageType fastReflection_QueryArchivedRewardsPlanRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryArchivedRewardsPlanRequest_messageType{}
 
-type fastReflection_QueryOperatorOutstandingRewardsRequest_messageType struct{}
+type fastReflection_QueryArchivedRewardsPlanRequest_messageType struct{}
 
-func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorOutstandingRewardsRequest)(nil)
+func (x fastReflection_QueryArchivedRewardsPlanRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlanRequest)(nil)
 }
-func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorOutstandingRewardsRequest)
+func (x fastReflection_QueryArchivedRewardsPlanRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlanRequest)
 }
-func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorOutstandingRewardsRequest
+func (x fastReflection_QueryArchivedRewardsPlanRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlanRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorOutstandingRewardsRequest
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlanRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorOutstandingRewardsRequest_messageType
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryArchivedRewardsPlanRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorOutstandingRewardsRequest)
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlanRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorOutstandingRewardsRequest)(x)
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryArchivedRewardsPlanRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3570,10 +3739,10 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OperatorId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.OperatorId)
-		if !f(fd_QueryOperatorOutstandingRewardsRequest_operator_id, value) {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PlanId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PlanId)
+		if !f(fd_QueryArchivedRewardsPlanRequest_plan_id, value) {
 			return
 		}
 	}
@@ -3590,15 +3759,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		return x.OperatorId != uint32(0)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		return x.PlanId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3608,15 +3777,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		x.OperatorId = uint32(0)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		x.PlanId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3626,16 +3795,16 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		value := x.OperatorId
-		return protoreflect.ValueOfUint32(value)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		value := x.PlanId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3649,15 +3818,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		x.OperatorId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		x.PlanId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3671,40 +3840,40 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		panic(fmt.Errorf("field plan_id of message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest.plan_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryArchivedRewardsPlanRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3712,7 +3881,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3723,7 +3892,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3735,7 +3904,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3745,9 +3914,9 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryArchivedRewardsPlanRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3759,8 +3928,8 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
-		if x.OperatorId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		if x.PlanId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PlanId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3772,7 +3941,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3791,8 +3960,8 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OperatorId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+		if x.PlanId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PlanId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3807,7 +3976,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3839,17 +4008,17 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlanRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 				}
-				x.OperatorId = 0
+				x.PlanId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3859,7 +4028,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OperatorId |= uint32(b&0x7F) << shift
+					x.PlanId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3900,25 +4069,25 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *
 }
 
 var (
-	md_QueryOperatorOutstandingRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryOperatorOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
+	md_QueryArchivedRewardsPlanResponse                       protoreflect.MessageDescriptor
+	fd_QueryArchivedRewardsPlanResponse_archived_rewards_plan protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorOutstandingRewardsResponse")
-	fd_QueryOperatorOutstandingRewardsResponse_rewards = md_QueryOperatorOutstandingRewardsResponse.Fields().ByName("rewards")
+	md_QueryArchivedRewardsPlanResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryArchivedRewardsPlanResponse")
+	fd_QueryArchivedRewardsPlanResponse_archived_rewards_plan = md_QueryArchivedRewardsPlanResponse.Fields().ByName("archived_rewards_plan")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorOutstandingRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryArchivedRewardsPlanResponse)(nil)
 
-type fastReflection_QueryOperatorOutstandingRewardsResponse QueryOperatorOutstandingRewardsResponse
+type fastReflection_QueryArchivedRewardsPlanResponse QueryArchivedRewardsPlanResponse
 
-func (x *QueryOperatorOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorOutstandingRewardsResponse)(x)
+func (x *QueryArchivedRewardsPlanResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlanResponse)(x)
 }
 
-func (x *QueryOperatorOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryArchivedRewardsPlanResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3930,43 +4099,43 @@ func (x *QueryOperatorOutstandingRewardsResponse) slowProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorOutstandingRewardsResponse_messageType fastReflection_QueryOperatorOutstandingRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorOutstandingRewardsResponse_messageType{}
+var _fastReflection_QueryArchivedRewardsPlanResponse_messageType fastReflection_QueryArchivedRewardsPlanResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryArchivedRewardsPlanResponse_messageType{}
 
-type fastReflection_QueryOperatorOutstandingRewardsResponse_messageType struct{}
+type fastReflection_QueryArchivedRewardsPlanResponse_messageType struct{}
 
-func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorOutstandingRewardsResponse)(nil)
+func (x fastReflection_QueryArchivedRewardsPlanResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryArchivedRewardsPlanResponse)(nil)
 }
-func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorOutstandingRewardsResponse)
+func (x fastReflection_QueryArchivedRewardsPlanResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlanResponse)
 }
-func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorOutstandingRewardsResponse
+func (x fastReflection_QueryArchivedRewardsPlanResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlanResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorOutstandingRewardsResponse
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryArchivedRewardsPlanResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorOutstandingRewardsResponse_messageType
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryArchivedRewardsPlanResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorOutstandingRewardsResponse)
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryArchivedRewardsPlanResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorOutstandingRewardsResponse)(x)
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryArchivedRewardsPlanResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3974,10 +4143,10 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Interface() pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Rewards != nil {
-		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
-		if !f(fd_QueryOperatorOutstandingRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ArchivedRewardsPlan != nil {
+		value := protoreflect.ValueOfMessage(x.ArchivedRewardsPlan.ProtoReflect())
+		if !f(fd_QueryArchivedRewardsPlanResponse_archived_rewards_plan, value) {
 			return
 		}
 	}
@@ -3994,15 +4163,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Range(f func(pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		return x.Rewards != nil
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		return x.ArchivedRewardsPlan != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4012,15 +4181,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Has(fd protoref
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		x.ArchivedRewardsPlan = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4030,16 +4199,16 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Clear(fd protor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		value := x.Rewards
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		value := x.ArchivedRewardsPlan
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4053,15 +4222,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		x.Rewards = value.Message().Interface().(*OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		x.ArchivedRewardsPlan = value.Message().Interface().(*ArchivedRewardsPlan)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4075,44 +4244,44 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Set(fd protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = new(OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		if x.ArchivedRewardsPlan == nil {
+			x.ArchivedRewardsPlan = new(ArchivedRewardsPlan)
 		}
-		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.ArchivedRewardsPlan.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
-		m := new(OutstandingRewards)
+	case "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan":
+		m := new(ArchivedRewardsPlan)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryArchivedRewardsPlanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryArchivedRewardsPlanResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4120,7 +4289,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) WhichOneof(d pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4131,7 +4300,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) GetUnknown() pr
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4143,7 +4312,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) SetUnknown(fiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4153,9 +4322,9 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryArchivedRewardsPlanResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4167,8 +4336,8 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 		var n int
 		var l int
 		_ = l
-		if x.Rewards != nil {
-			l = options.Size(x.Rewards)
+		if x.ArchivedRewardsPlan != nil {
+			l = options.Size(x.ArchivedRewardsPlan)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4181,7 +4350,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4200,8 +4369,8 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Rewards != nil {
-			encoded, err := options.Marshal(x.Rewards)
+		if x.ArchivedRewardsPlan != nil {
+			encoded, err := options.Marshal(x.ArchivedRewardsPlan)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4225,7 +4394,7 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryArchivedRewardsPlanResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4257,15 +4426,15 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlanResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryArchivedRewardsPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedRewardsPlan", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4292,10 +4461,10 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Rewards == nil {
-					x.Rewards = &OutstandingRewards{}
+				if x.ArchivedRewardsPlan == nil {
+					x.ArchivedRewardsPlan = &ArchivedRewardsPlan{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ArchivedRewardsPlan); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4335,25 +4504,25 @@ func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods()
 }
 
 var (
-	md_QueryServiceOutstandingRewardsRequest            protoreflect.MessageDescriptor
-	fd_QueryServiceOutstandingRewardsRequest_service_id protoreflect.FieldDescriptor
+	md_QueryPoolOutstandingRewardsRequest         protoreflect.MessageDescriptor
+	fd_QueryPoolOutstandingRewardsRequest_pool_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryServiceOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceOutstandingRewardsRequest")
-	fd_QueryServiceOutstandingRewardsRequest_service_id = md_QueryServiceOutstandingRewardsRequest.Fields().ByName("service_id")
+	md_QueryPoolOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolOutstandingRewardsRequest")
+	fd_QueryPoolOutstandingRewardsRequest_pool_id = md_QueryPoolOutstandingRewardsRequest.Fields().ByName("pool_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryServiceOutstandingRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolOutstandingRewardsRequest)(nil)
 
-type fastReflection_QueryServiceOutstandingRewardsRequest QueryServiceOutstandingRewardsRequest
+type fastReflection_QueryPoolOutstandingRewardsRequest QueryPoolOutstandingRewardsRequest
 
-func (x *QueryServiceOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryServiceOutstandingRewardsRequest)(x)
+func (x *QueryPoolOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolOutstandingRewardsRequest)(x)
 }
 
-func (x *QueryServiceOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4365,43 +4534,43 @@ func (x *QueryServiceOutstandingRewardsRequest) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryServiceOutstandingRewardsRequest_messageType fastReflection_QueryServiceOutstandingRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryServiceOutstandingRewardsRequest_messageType{}
+var _fastReflection_QueryPoolOutstandingRewardsRequest_messageType fastReflection_QueryPoolOutstandingRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolOutstandingRewardsRequest_messageType{}
 
-type fastReflection_QueryServiceOutstandingRewardsRequest_messageType struct{}
+type fastReflection_QueryPoolOutstandingRewardsRequest_messageType struct{}
 
-func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryServiceOutstandingRewardsRequest)(nil)
+func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolOutstandingRewardsRequest)(nil)
 }
-func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceOutstandingRewardsRequest)
+func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolOutstandingRewardsRequest)
 }
-func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceOutstandingRewardsRequest
+func (x fastReflection_QueryPoolOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolOutstandingRewardsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceOutstandingRewardsRequest
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolOutstandingRewardsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryServiceOutstandingRewardsRequest_messageType
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolOutstandingRewardsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceOutstandingRewardsRequest)
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolOutstandingRewardsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryServiceOutstandingRewardsRequest)(x)
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolOutstandingRewardsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4409,10 +4578,10 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ServiceId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ServiceId)
-		if !f(fd_QueryServiceOutstandingRewardsRequest_service_id, value) {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PoolId)
+		if !f(fd_QueryPoolOutstandingRewardsRequest_pool_id, value) {
 			return
 		}
 	}
@@ -4429,15 +4598,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
-		return x.ServiceId != uint32(0)
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
+		return x.PoolId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4447,15 +4616,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
-		x.ServiceId = uint32(0)
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
+		x.PoolId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4465,16 +4634,16 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
-		value := x.ServiceId
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
+		value := x.PoolId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4488,15 +4657,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
-		x.ServiceId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
+		x.PoolId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4510,40 +4679,40 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
-		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest.pool_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4551,7 +4720,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4562,7 +4731,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4574,7 +4743,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4584,9 +4753,9 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4598,8 +4767,8 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		if x.ServiceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4611,7 +4780,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4630,8 +4799,8 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ServiceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -4646,7 +4815,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4678,17 +4847,17 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 				}
-				x.ServiceId = 0
+				x.PoolId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4698,7 +4867,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ServiceId |= uint32(b&0x7F) << shift
+					x.PoolId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4739,25 +4908,25 @@ func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *p
 }
 
 var (
-	md_QueryServiceOutstandingRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryServiceOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
+	md_QueryPoolOutstandingRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryPoolOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryServiceOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceOutstandingRewardsResponse")
-	fd_QueryServiceOutstandingRewardsResponse_rewards = md_QueryServiceOutstandingRewardsResponse.Fields().ByName("rewards")
+	md_QueryPoolOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolOutstandingRewardsResponse")
+	fd_QueryPoolOutstandingRewardsResponse_rewards = md_QueryPoolOutstandingRewardsResponse.Fields().ByName("rewards")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryServiceOutstandingRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolOutstandingRewardsResponse)(nil)
 
-type fastReflection_QueryServiceOutstandingRewardsResponse QueryServiceOutstandingRewardsResponse
+type fastReflection_QueryPoolOutstandingRewardsResponse QueryPoolOutstandingRewardsResponse
 
-func (x *QueryServiceOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryServiceOutstandingRewardsResponse)(x)
+func (x *QueryPoolOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolOutstandingRewardsResponse)(x)
 }
 
-func (x *QueryServiceOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4769,43 +4938,43 @@ func (x *QueryServiceOutstandingRewardsResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryServiceOutstandingRewardsResponse_messageType fastReflection_QueryServiceOutstandingRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryServiceOutstandingRewardsResponse_messageType{}
+var _fastReflection_QueryPoolOutstandingRewardsResponse_messageType fastReflection_QueryPoolOutstandingRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolOutstandingRewardsResponse_messageType{}
 
-type fastReflection_QueryServiceOutstandingRewardsResponse_messageType struct{}
+type fastReflection_QueryPoolOutstandingRewardsResponse_messageType struct{}
 
-func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryServiceOutstandingRewardsResponse)(nil)
+func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolOutstandingRewardsResponse)(nil)
 }
-func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceOutstandingRewardsResponse)
+func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolOutstandingRewardsResponse)
 }
-func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceOutstandingRewardsResponse
+func (x fastReflection_QueryPoolOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolOutstandingRewardsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceOutstandingRewardsResponse
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolOutstandingRewardsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryServiceOutstandingRewardsResponse_messageType
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolOutstandingRewardsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceOutstandingRewardsResponse)
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolOutstandingRewardsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryServiceOutstandingRewardsResponse)(x)
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolOutstandingRewardsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4813,10 +4982,10 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Rewards != nil {
 		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
-		if !f(fd_QueryServiceOutstandingRewardsResponse_rewards, value) {
+		if !f(fd_QueryPoolOutstandingRewardsResponse_rewards, value) {
 			return
 		}
 	}
@@ -4833,15 +5002,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		return x.Rewards != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4851,15 +5020,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4869,16 +5038,16 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		value := x.Rewards
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4892,15 +5061,15 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		x.Rewards = value.Message().Interface().(*OutstandingRewards)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4914,44 +5083,44 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		if x.Rewards == nil {
 			x.Rewards = new(OutstandingRewards)
 		}
 		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards":
 		m := new(OutstandingRewards)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4959,7 +5128,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4970,7 +5139,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4982,7 +5151,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4992,9 +5161,9 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5020,7 +5189,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5064,7 +5233,7 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5096,10 +5265,10 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5174,25 +5343,25 @@ func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *
 }
 
 var (
-	md_QueryOperatorCommissionRequest             protoreflect.MessageDescriptor
-	fd_QueryOperatorCommissionRequest_operator_id protoreflect.FieldDescriptor
+	md_QueryOperatorOutstandingRewardsRequest             protoreflect.MessageDescriptor
+	fd_QueryOperatorOutstandingRewardsRequest_operator_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorCommissionRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorCommissionRequest")
-	fd_QueryOperatorCommissionRequest_operator_id = md_QueryOperatorCommissionRequest.Fields().ByName("operator_id")
+	md_QueryOperatorOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorOutstandingRewardsRequest")
+	fd_QueryOperatorOutstandingRewardsRequest_operator_id = md_QueryOperatorOutstandingRewardsRequest.Fields().ByName("operator_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorCommissionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOperatorOutstandingRewardsRequest)(nil)
 
-type fastReflection_QueryOperatorCommissionRequest QueryOperatorCommissionRequest
+type fastReflection_QueryOperatorOutstandingRewardsRequest QueryOperatorOutstandingRewardsRequest
 
-func (x *QueryOperatorCommissionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorCommissionRequest)(x)
+func (x *QueryOperatorOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorOutstandingRewardsRequest)(x)
 }
 
-func (x *QueryOperatorCommissionRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOperatorOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5204,43 +5373,43 @@ func (x *QueryOperatorCommissionRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorCommissionRequest_messageType fastReflection_QueryOperatorCommissionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorCommissionRequest_messageType{}
+var _fastReflection_QueryOperatorOutstandingRewardsRequest_messageType fastReflection_QueryOperatorOutstandingRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorOutstandingRewardsRequest_messageType{}
 
-type fastReflection_QueryOperatorCommissionRequest_messageType struct{}
+type fastReflection_QueryOperatorOutstandingRewardsRequest_messageType struct{}
 
-func (x fastReflection_QueryOperatorCommissionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorCommissionRequest)(nil)
+func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorOutstandingRewardsRequest)(nil)
 }
-func (x fastReflection_QueryOperatorCommissionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorCommissionRequest)
+func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorOutstandingRewardsRequest)
 }
-func (x fastReflection_QueryOperatorCommissionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorCommissionRequest
+func (x fastReflection_QueryOperatorOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorOutstandingRewardsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorCommissionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorCommissionRequest
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorOutstandingRewardsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorCommissionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorCommissionRequest_messageType
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorOutstandingRewardsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorCommissionRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorCommissionRequest)
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorOutstandingRewardsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorCommissionRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorCommissionRequest)(x)
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorOutstandingRewardsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5248,10 +5417,10 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorCommissionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.OperatorId != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.OperatorId)
-		if !f(fd_QueryOperatorCommissionRequest_operator_id, value) {
+		if !f(fd_QueryOperatorOutstandingRewardsRequest_operator_id, value) {
 			return
 		}
 	}
@@ -5268,15 +5437,15 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorCommissionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
 		return x.OperatorId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5286,15 +5455,15 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
 		x.OperatorId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5304,16 +5473,16 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorCommissionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
 		value := x.OperatorId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5327,15 +5496,15 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
 		x.OperatorId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5349,40 +5518,40 @@ func (x *fastReflection_QueryOperatorCommissionRequest) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
-		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorCommissionRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorCommissionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest.operator_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorCommissionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorCommissionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5390,7 +5559,7 @@ func (x *fastReflection_QueryOperatorCommissionRequest) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorCommissionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5401,7 +5570,7 @@ func (x *fastReflection_QueryOperatorCommissionRequest) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5413,7 +5582,7 @@ func (x *fastReflection_QueryOperatorCommissionRequest) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorCommissionRequest) IsValid() bool {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5423,9 +5592,9 @@ func (x *fastReflection_QueryOperatorCommissionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5450,7 +5619,7 @@ func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5485,7 +5654,7 @@ func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5517,10 +5686,10 @@ func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5578,25 +5747,25 @@ func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryOperatorCommissionResponse            protoreflect.MessageDescriptor
-	fd_QueryOperatorCommissionResponse_commission protoreflect.FieldDescriptor
+	md_QueryOperatorOutstandingRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryOperatorOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorCommissionResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorCommissionResponse")
-	fd_QueryOperatorCommissionResponse_commission = md_QueryOperatorCommissionResponse.Fields().ByName("commission")
+	md_QueryOperatorOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorOutstandingRewardsResponse")
+	fd_QueryOperatorOutstandingRewardsResponse_rewards = md_QueryOperatorOutstandingRewardsResponse.Fields().ByName("rewards")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorCommissionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOperatorOutstandingRewardsResponse)(nil)
 
-type fastReflection_QueryOperatorCommissionResponse QueryOperatorCommissionResponse
+type fastReflection_QueryOperatorOutstandingRewardsResponse QueryOperatorOutstandingRewardsResponse
 
-func (x *QueryOperatorCommissionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorCommissionResponse)(x)
+func (x *QueryOperatorOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorOutstandingRewardsResponse)(x)
 }
 
-func (x *QueryOperatorCommissionResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOperatorOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5608,43 +5777,43 @@ func (x *QueryOperatorCommissionResponse) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorCommissionResponse_messageType fastReflection_QueryOperatorCommissionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorCommissionResponse_messageType{}
+var _fastReflection_QueryOperatorOutstandingRewardsResponse_messageType fastReflection_QueryOperatorOutstandingRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorOutstandingRewardsResponse_messageType{}
 
-type fastReflection_QueryOperatorCommissionResponse_messageType struct{}
+type fastReflection_QueryOperatorOutstandingRewardsResponse_messageType struct{}
 
-func (x fastReflection_QueryOperatorCommissionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorCommissionResponse)(nil)
+func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorOutstandingRewardsResponse)(nil)
 }
-func (x fastReflection_QueryOperatorCommissionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorCommissionResponse)
+func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorOutstandingRewardsResponse)
 }
-func (x fastReflection_QueryOperatorCommissionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorCommissionResponse
+func (x fastReflection_QueryOperatorOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorOutstandingRewardsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorCommissionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorCommissionResponse
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorOutstandingRewardsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorCommissionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorCommissionResponse_messageType
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorOutstandingRewardsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorCommissionResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorCommissionResponse)
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorOutstandingRewardsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorCommissionResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorCommissionResponse)(x)
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorOutstandingRewardsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5652,10 +5821,10 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorCommissionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Commission != nil {
-		value := protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
-		if !f(fd_QueryOperatorCommissionResponse_commission, value) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rewards != nil {
+		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+		if !f(fd_QueryOperatorOutstandingRewardsResponse_rewards, value) {
 			return
 		}
 	}
@@ -5672,15 +5841,15 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorCommissionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		return x.Commission != nil
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		return x.Rewards != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5690,15 +5859,15 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		x.Commission = nil
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5708,16 +5877,16 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorCommissionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		value := x.Commission
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		value := x.Rewards
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5731,15 +5900,15 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		x.Commission = value.Message().Interface().(*AccumulatedCommission)
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		x.Rewards = value.Message().Interface().(*OutstandingRewards)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5753,44 +5922,44 @@ func (x *fastReflection_QueryOperatorCommissionResponse) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		if x.Commission == nil {
-			x.Commission = new(AccumulatedCommission)
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = new(OutstandingRewards)
 		}
-		return protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorCommissionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
-		m := new(AccumulatedCommission)
+	case "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards":
+		m := new(OutstandingRewards)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorCommissionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorCommissionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5798,7 +5967,7 @@ func (x *fastReflection_QueryOperatorCommissionResponse) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorCommissionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5809,7 +5978,7 @@ func (x *fastReflection_QueryOperatorCommissionResponse) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorCommissionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5821,7 +5990,7 @@ func (x *fastReflection_QueryOperatorCommissionResponse) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorCommissionResponse) IsValid() bool {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5831,9 +6000,9 @@ func (x *fastReflection_QueryOperatorCommissionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5845,8 +6014,8 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		if x.Commission != nil {
-			l = options.Size(x.Commission)
+		if x.Rewards != nil {
+			l = options.Size(x.Rewards)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5859,7 +6028,7 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5878,8 +6047,8 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Commission != nil {
-			encoded, err := options.Marshal(x.Commission)
+		if x.Rewards != nil {
+			encoded, err := options.Marshal(x.Rewards)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5903,7 +6072,7 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
+		x := input.Message.Interface().(*QueryOperatorOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5935,15 +6104,15 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5970,10 +6139,10 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Commission == nil {
-					x.Commission = &AccumulatedCommission{}
+				if x.Rewards == nil {
+					x.Rewards = &OutstandingRewards{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6013,27 +6182,25 @@ func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoif
 }
 
 var (
-	md_QueryPoolServiceTotalDelegatorSharesRequest            protoreflect.MessageDescriptor
-	fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id    protoreflect.FieldDescriptor
-	fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id protoreflect.FieldDescriptor
+	md_QueryServiceOutstandingRewardsRequest            protoreflect.MessageDescriptor
+	fd_QueryServiceOutstandingRewardsRequest_service_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolServiceTotalDelegatorSharesRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceTotalDelegatorSharesRequest")
-	fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id = md_QueryPoolServiceTotalDelegatorSharesRequest.Fields().ByName("pool_id")
-	fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id = md_QueryPoolServiceTotalDelegatorSharesRequest.Fields().ByName("service_id")
+	md_QueryServiceOutstandingRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceOutstandingRewardsRequest")
+	fd_QueryServiceOutstandingRewardsRequest_service_id = md_QueryServiceOutstandingRewardsRequest.Fields().ByName("service_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryServiceOutstandingRewardsRequest)(nil)
 
-type fastReflection_QueryPoolServiceTotalDelegatorSharesRequest QueryPoolServiceTotalDelegatorSharesRequest
+type fastReflection_QueryServiceOutstandingRewardsRequest QueryServiceOutstandingRewardsRequest
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(x)
+func (x *QueryServiceOutstandingRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceOutstandingRewardsRequest)(x)
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryServiceOutstandingRewardsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6045,43 +6212,43 @@ func (x *QueryPoolServiceTotalDelegatorSharesRequest) slowProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType{}
+var _fastReflection_QueryServiceOutstandingRewardsRequest_messageType fastReflection_QueryServiceOutstandingRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceOutstandingRewardsRequest_messageType{}
 
-type fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType struct{}
+type fastReflection_QueryServiceOutstandingRewardsRequest_messageType struct{}
 
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(nil)
+func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceOutstandingRewardsRequest)(nil)
 }
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)
+func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceOutstandingRewardsRequest)
 }
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolServiceTotalDelegatorSharesRequest
+func (x fastReflection_QueryServiceOutstandingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceOutstandingRewardsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolServiceTotalDelegatorSharesRequest
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceOutstandingRewardsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceOutstandingRewardsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceOutstandingRewardsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolServiceTotalDelegatorSharesRequest)(x)
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceOutstandingRewardsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6089,16 +6256,10 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PoolId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PoolId)
-		if !f(fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ServiceId != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.ServiceId)
-		if !f(fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id, value) {
+		if !f(fd_QueryServiceOutstandingRewardsRequest_service_id, value) {
 			return
 		}
 	}
@@ -6115,17 +6276,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Range(f fun
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		return x.PoolId != uint32(0)
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
 		return x.ServiceId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6135,17 +6294,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Has(fd prot
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		x.PoolId = uint32(0)
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
 		x.ServiceId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6155,19 +6312,16 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Clear(fd pr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		value := x.PoolId
-		return protoreflect.ValueOfUint32(value)
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
 		value := x.ServiceId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6181,17 +6335,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Get(descrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		x.PoolId = uint32(value.Uint())
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
 		x.ServiceId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6205,44 +6357,40 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Set(fd prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest is not mutable"))
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
-		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest.service_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6250,7 +6398,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) WhichOneof(
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6261,7 +6409,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) GetUnknown(
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6273,7 +6421,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) SetUnknown(
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) IsValid() bool {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6283,9 +6431,9 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) IsValid() b
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryServiceOutstandingRewardsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6297,9 +6445,6 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 		var n int
 		var l int
 		_ = l
-		if x.PoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.PoolId))
-		}
 		if x.ServiceId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ServiceId))
 		}
@@ -6313,7 +6458,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6335,11 +6480,6 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 		if x.ServiceId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
 			i--
-			dAtA[i] = 0x10
-		}
-		if x.PoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
-			i--
 			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
@@ -6353,7 +6493,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6385,32 +6525,13 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-				}
-				x.PoolId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PoolId |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
@@ -6464,77 +6585,26 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethod
 	}
 }
 
-var _ protoreflect.List = (*_QueryPoolServiceTotalDelegatorSharesResponse_1_list)(nil)
-
-type _QueryPoolServiceTotalDelegatorSharesResponse_1_list struct {
-	list *[]*v1beta11.DecCoin
-}
+var (
+	md_QueryServiceOutstandingRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryServiceOutstandingRewardsResponse_rewards protoreflect.FieldDescriptor
+)
 
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryServiceOutstandingRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceOutstandingRewardsResponse")
+	fd_QueryServiceOutstandingRewardsResponse_rewards = md_QueryServiceOutstandingRewardsResponse.Fields().ByName("rewards")
 }
 
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+var _ protoreflect.Message = (*fastReflection_QueryServiceOutstandingRewardsResponse)(nil)
 
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
-	(*x.list)[i] = concreteValue
-}
+type fastReflection_QueryServiceOutstandingRewardsResponse QueryServiceOutstandingRewardsResponse
 
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryServiceOutstandingRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceOutstandingRewardsResponse)(x)
 }
 
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryPoolServiceTotalDelegatorSharesResponse        protoreflect.MessageDescriptor
-	fd_QueryPoolServiceTotalDelegatorSharesResponse_shares protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolServiceTotalDelegatorSharesResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceTotalDelegatorSharesResponse")
-	fd_QueryPoolServiceTotalDelegatorSharesResponse_shares = md_QueryPoolServiceTotalDelegatorSharesResponse.Fields().ByName("shares")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(nil)
-
-type fastReflection_QueryPoolServiceTotalDelegatorSharesResponse QueryPoolServiceTotalDelegatorSharesResponse
-
-func (x *QueryPoolServiceTotalDelegatorSharesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(x)
-}
-
-func (x *QueryPoolServiceTotalDelegatorSharesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryServiceOutstandingRewardsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6546,43 +6616,43 @@ func (x *QueryPoolServiceTotalDelegatorSharesResponse) slowProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType{}
+var _fastReflection_QueryServiceOutstandingRewardsResponse_messageType fastReflection_QueryServiceOutstandingRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceOutstandingRewardsResponse_messageType{}
 
-type fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType struct{}
+type fastReflection_QueryServiceOutstandingRewardsResponse_messageType struct{}
 
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(nil)
+func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceOutstandingRewardsResponse)(nil)
 }
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)
+func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceOutstandingRewardsResponse)
 }
-func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolServiceTotalDelegatorSharesResponse
+func (x fastReflection_QueryServiceOutstandingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceOutstandingRewardsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolServiceTotalDelegatorSharesResponse
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceOutstandingRewardsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceOutstandingRewardsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceOutstandingRewardsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolServiceTotalDelegatorSharesResponse)(x)
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceOutstandingRewardsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6590,10 +6660,10 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Interface(
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Shares) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares})
-		if !f(fd_QueryPoolServiceTotalDelegatorSharesResponse_shares, value) {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rewards != nil {
+		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+		if !f(fd_QueryServiceOutstandingRewardsResponse_rewards, value) {
 			return
 		}
 	}
@@ -6610,15 +6680,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Range(f fu
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		return len(x.Shares) != 0
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		return x.Rewards != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6628,15 +6698,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Has(fd pro
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		x.Shares = nil
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6646,19 +6716,16 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Clear(fd p
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		if len(x.Shares) == 0 {
-			return protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{})
-		}
-		listValue := &_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares}
-		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		value := x.Rewards
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6672,17 +6739,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Get(descri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		lv := value.List()
-		clv := lv.(*_QueryPoolServiceTotalDelegatorSharesResponse_1_list)
-		x.Shares = *clv.list
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		x.Rewards = value.Message().Interface().(*OutstandingRewards)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6696,45 +6761,44 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Set(fd pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		if x.Shares == nil {
-			x.Shares = []*v1beta11.DecCoin{}
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = new(OutstandingRewards)
 		}
-		value := &_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
-		list := []*v1beta11.DecCoin{}
-		return protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards":
+		m := new(OutstandingRewards)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6742,7 +6806,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) WhichOneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6753,7 +6817,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) GetUnknown
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6765,7 +6829,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) SetUnknown
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) IsValid() bool {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6775,9 +6839,9 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) IsValid()
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryServiceOutstandingRewardsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6789,11 +6853,9 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 		var n int
 		var l int
 		_ = l
-		if len(x.Shares) > 0 {
-			for _, e := range x.Shares {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Rewards != nil {
+			l = options.Size(x.Rewards)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6805,7 +6867,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6824,21 +6886,19 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Shares) > 0 {
-			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Shares[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.Rewards != nil {
+			encoded, err := options.Marshal(x.Rewards)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6851,7 +6911,7 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
+		x := input.Message.Interface().(*QueryServiceOutstandingRewardsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6883,15 +6943,15 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6918,8 +6978,10 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Shares = append(x.Shares, &v1beta11.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares[len(x.Shares)-1]); err != nil {
+				if x.Rewards == nil {
+					x.Rewards = &OutstandingRewards{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6959,27 +7021,25 @@ func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMetho
 }
 
 var (
-	md_QueryPoolDelegationRewardsRequest                   protoreflect.MessageDescriptor
-	fd_QueryPoolDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
-	fd_QueryPoolDelegationRewardsRequest_pool_id           protoreflect.FieldDescriptor
+	md_QueryOperatorCommissionRequest             protoreflect.MessageDescriptor
+	fd_QueryOperatorCommissionRequest_operator_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolDelegationRewardsRequest")
-	fd_QueryPoolDelegationRewardsRequest_delegator_address = md_QueryPoolDelegationRewardsRequest.Fields().ByName("delegator_address")
-	fd_QueryPoolDelegationRewardsRequest_pool_id = md_QueryPoolDelegationRewardsRequest.Fields().ByName("pool_id")
+	md_QueryOperatorCommissionRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorCommissionRequest")
+	fd_QueryOperatorCommissionRequest_operator_id = md_QueryOperatorCommissionRequest.Fields().ByName("operator_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolDelegationRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOperatorCommissionRequest)(nil)
 
-type fastReflection_QueryPoolDelegationRewardsRequest QueryPoolDelegationRewardsRequest
+type fastReflection_QueryOperatorCommissionRequest QueryOperatorCommissionRequest
 
-func (x *QueryPoolDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolDelegationRewardsRequest)(x)
+func (x *QueryOperatorCommissionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorCommissionRequest)(x)
 }
 
-func (x *QueryPoolDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOperatorCommissionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6991,43 +7051,43 @@ func (x *QueryPoolDelegationRewardsRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolDelegationRewardsRequest_messageType fastReflection_QueryPoolDelegationRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolDelegationRewardsRequest_messageType{}
+var _fastReflection_QueryOperatorCommissionRequest_messageType fastReflection_QueryOperatorCommissionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorCommissionRequest_messageType{}
 
-type fastReflection_QueryPoolDelegationRewardsRequest_messageType struct{}
+type fastReflection_QueryOperatorCommissionRequest_messageType struct{}
 
-func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolDelegationRewardsRequest)(nil)
-}
-func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolDelegationRewardsRequest)
+func (x fastReflection_QueryOperatorCommissionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorCommissionRequest)(nil)
 }
-func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolDelegationRewardsRequest
+func (x fastReflection_QueryOperatorCommissionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorCommissionRequest)
+}
+func (x fastReflection_QueryOperatorCommissionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorCommissionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolDelegationRewardsRequest
+func (x *fastReflection_QueryOperatorCommissionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorCommissionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolDelegationRewardsRequest_messageType
+func (x *fastReflection_QueryOperatorCommissionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorCommissionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolDelegationRewardsRequest)
+func (x *fastReflection_QueryOperatorCommissionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorCommissionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolDelegationRewardsRequest)(x)
+func (x *fastReflection_QueryOperatorCommissionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorCommissionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7035,16 +7095,10 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_QueryPoolDelegationRewardsRequest_delegator_address, value) {
-			return
-		}
-	}
-	if x.PoolId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PoolId)
-		if !f(fd_QueryPoolDelegationRewardsRequest_pool_id, value) {
+func (x *fastReflection_QueryOperatorCommissionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_QueryOperatorCommissionRequest_operator_id, value) {
 			return
 		}
 	}
@@ -7061,17 +7115,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorCommissionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		return x.DelegatorAddress != ""
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
-		return x.PoolId != uint32(0)
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+		return x.OperatorId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7081,17 +7133,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorCommissionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = ""
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
-		x.PoolId = uint32(0)
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+		x.OperatorId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7101,19 +7151,16 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
-		value := x.PoolId
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+		value := x.OperatorId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7127,17 +7174,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorCommissionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
-		x.PoolId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+		x.OperatorId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7151,44 +7196,40 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest is not mutable"))
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
-		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorCommissionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
-		return protoreflect.ValueOfString("")
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+	case "milkyway.rewards.v1.QueryOperatorCommissionRequest.operator_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorCommissionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolDelegationRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorCommissionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7196,7 +7237,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorCommissionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7207,7 +7248,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorCommissionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7219,7 +7260,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryOperatorCommissionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7229,9 +7270,9 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorCommissionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7243,12 +7284,8 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.PoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.PoolId))
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7260,7 +7297,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7279,17 +7316,10 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7302,7 +7332,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorCommissionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7334,49 +7364,17 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 				}
-				x.PoolId = 0
+				x.OperatorId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7386,7 +7384,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PoolId |= uint32(b&0x7F) << shift
+					x.OperatorId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -7426,125 +7424,74 @@ func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *proto
 	}
 }
 
-var _ protoreflect.List = (*_QueryPoolDelegationRewardsResponse_1_list)(nil)
+var (
+	md_QueryOperatorCommissionResponse            protoreflect.MessageDescriptor
+	fd_QueryOperatorCommissionResponse_commission protoreflect.FieldDescriptor
+)
 
-type _QueryPoolDelegationRewardsResponse_1_list struct {
-	list *[]*DecPool
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryOperatorCommissionResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorCommissionResponse")
+	fd_QueryOperatorCommissionResponse_commission = md_QueryOperatorCommissionResponse.Fields().ByName("commission")
 }
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryOperatorCommissionResponse)(nil)
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_QueryOperatorCommissionResponse QueryOperatorCommissionResponse
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
-	(*x.list)[i] = concreteValue
+func (x *QueryOperatorCommissionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorCommissionResponse)(x)
 }
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryOperatorCommissionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _fastReflection_QueryOperatorCommissionResponse_messageType fastReflection_QueryOperatorCommissionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorCommissionResponse_messageType{}
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_QueryOperatorCommissionResponse_messageType struct{}
 
-func (x *_QueryPoolDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x fastReflection_QueryOperatorCommissionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorCommissionResponse)(nil)
 }
-
-func (x *_QueryPoolDelegationRewardsResponse_1_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_QueryOperatorCommissionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorCommissionResponse)
 }
-
-var (
-	md_QueryPoolDelegationRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryPoolDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryPoolDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolDelegationRewardsResponse")
-	fd_QueryPoolDelegationRewardsResponse_rewards = md_QueryPoolDelegationRewardsResponse.Fields().ByName("rewards")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryPoolDelegationRewardsResponse)(nil)
-
-type fastReflection_QueryPoolDelegationRewardsResponse QueryPoolDelegationRewardsResponse
-
-func (x *QueryPoolDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolDelegationRewardsResponse)(x)
-}
-
-func (x *QueryPoolDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryPoolDelegationRewardsResponse_messageType fastReflection_QueryPoolDelegationRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolDelegationRewardsResponse_messageType{}
-
-type fastReflection_QueryPoolDelegationRewardsResponse_messageType struct{}
-
-func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolDelegationRewardsResponse)(nil)
-}
-func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolDelegationRewardsResponse)
-}
-func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolDelegationRewardsResponse
+func (x fastReflection_QueryOperatorCommissionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorCommissionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolDelegationRewardsResponse
+func (x *fastReflection_QueryOperatorCommissionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorCommissionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolDelegationRewardsResponse_messageType
+func (x *fastReflection_QueryOperatorCommissionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorCommissionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolDelegationRewardsResponse)
+func (x *fastReflection_QueryOperatorCommissionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorCommissionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolDelegationRewardsResponse)(x)
+func (x *fastReflection_QueryOperatorCommissionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorCommissionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7552,10 +7499,10 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards})
-		if !f(fd_QueryPoolDelegationRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryOperatorCommissionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Commission != nil {
+		value := protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
+		if !f(fd_QueryOperatorCommissionResponse_commission, value) {
 			return
 		}
 	}
@@ -7572,15 +7519,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorCommissionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		return len(x.Rewards) != 0
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		return x.Commission != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7590,15 +7537,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorCommissionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		x.Commission = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7608,19 +7555,16 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{})
-		}
-		listValue := &_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards}
-		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		value := x.Commission
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7634,17 +7578,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorCommissionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		lv := value.List()
-		clv := lv.(*_QueryPoolDelegationRewardsResponse_1_list)
-		x.Rewards = *clv.list
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		x.Commission = value.Message().Interface().(*AccumulatedCommission)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7658,45 +7600,44 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DecPool{}
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		if x.Commission == nil {
+			x.Commission = new(AccumulatedCommission)
 		}
-		value := &_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Commission.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorCommissionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryOperatorCommissionResponse.commission":
+		m := new(AccumulatedCommission)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorCommissionResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorCommissionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorCommissionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolDelegationRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorCommissionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7704,7 +7645,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorCommissionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7715,7 +7656,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorCommissionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7727,7 +7668,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryOperatorCommissionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7737,9 +7678,9 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorCommissionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7751,11 +7692,9 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Commission != nil {
+			l = options.Size(x.Commission)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7767,7 +7706,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7786,21 +7725,19 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.Commission != nil {
+			encoded, err := options.Marshal(x.Commission)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7813,7 +7750,7 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorCommissionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7845,15 +7782,15 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7880,8 +7817,10 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				if x.Commission == nil {
+					x.Commission = &AccumulatedCommission{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7921,27 +7860,27 @@ func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *prot
 }
 
 var (
-	md_QueryOperatorDelegationRewardsRequest                   protoreflect.MessageDescriptor
-	fd_QueryOperatorDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
-	fd_QueryOperatorDelegationRewardsRequest_operator_id       protoreflect.FieldDescriptor
+	md_QueryPoolServiceTotalDelegatorSharesRequest            protoreflect.MessageDescriptor
+	fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id    protoreflect.FieldDescriptor
+	fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorDelegationRewardsRequest")
-	fd_QueryOperatorDelegationRewardsRequest_delegator_address = md_QueryOperatorDelegationRewardsRequest.Fields().ByName("delegator_address")
-	fd_QueryOperatorDelegationRewardsRequest_operator_id = md_QueryOperatorDelegationRewardsRequest.Fields().ByName("operator_id")
+	md_QueryPoolServiceTotalDelegatorSharesRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceTotalDelegatorSharesRequest")
+	fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id = md_QueryPoolServiceTotalDelegatorSharesRequest.Fields().ByName("pool_id")
+	fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id = md_QueryPoolServiceTotalDelegatorSharesRequest.Fields().ByName("service_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorDelegationRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(nil)
 
-type fastReflection_QueryOperatorDelegationRewardsRequest QueryOperatorDelegationRewardsRequest
+type fastReflection_QueryPoolServiceTotalDelegatorSharesRequest QueryPoolServiceTotalDelegatorSharesRequest
 
-func (x *QueryOperatorDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorDelegationRewardsRequest)(x)
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(x)
 }
 
-func (x *QueryOperatorDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7953,43 +7892,43 @@ func (x *QueryOperatorDelegationRewardsRequest) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorDelegationRewardsRequest_messageType fastReflection_QueryOperatorDelegationRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorDelegationRewardsRequest_messageType{}
+var _fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType{}
 
-type fastReflection_QueryOperatorDelegationRewardsRequest_messageType struct{}
+type fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType struct{}
 
-func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorDelegationRewardsRequest)(nil)
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)(nil)
 }
-func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorDelegationRewardsRequest)
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)
 }
-func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorDelegationRewardsRequest
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceTotalDelegatorSharesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorDelegationRewardsRequest
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceTotalDelegatorSharesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorDelegationRewardsRequest_messageType
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolServiceTotalDelegatorSharesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorDelegationRewardsRequest)
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorDelegationRewardsRequest)(x)
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolServiceTotalDelegatorSharesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7997,16 +7936,16 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_QueryOperatorDelegationRewardsRequest_delegator_address, value) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PoolId)
+		if !f(fd_QueryPoolServiceTotalDelegatorSharesRequest_pool_id, value) {
 			return
 		}
 	}
-	if x.OperatorId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.OperatorId)
-		if !f(fd_QueryOperatorDelegationRewardsRequest_operator_id, value) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_QueryPoolServiceTotalDelegatorSharesRequest_service_id, value) {
 			return
 		}
 	}
@@ -8023,17 +7962,17 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		return x.DelegatorAddress != ""
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
-		return x.OperatorId != uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		return x.PoolId != uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+		return x.ServiceId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8043,17 +7982,17 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = ""
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
-		x.OperatorId = uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		x.PoolId = uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+		x.ServiceId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8063,19 +8002,19 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
-		value := x.OperatorId
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+		value := x.ServiceId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8089,17 +8028,17 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
-		x.OperatorId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		x.PoolId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+		x.ServiceId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8113,44 +8052,44 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest is not mutable"))
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
-		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
-		return protoreflect.ValueOfString("")
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.pool_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest.service_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8158,7 +8097,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8169,7 +8108,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8181,7 +8120,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8191,9 +8130,9 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8205,12 +8144,11 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
-		if x.OperatorId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8222,7 +8160,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8241,17 +8179,15 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OperatorId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8264,7 +8200,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8296,17 +8232,17 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 				}
-				var stringLen uint64
+				x.PoolId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8316,29 +8252,16 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PoolId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
-				x.OperatorId = 0
+				x.ServiceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8348,7 +8271,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OperatorId |= uint32(b&0x7F) << shift
+					x.ServiceId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8388,77 +8311,77 @@ func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *p
 	}
 }
 
-var _ protoreflect.List = (*_QueryOperatorDelegationRewardsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPoolServiceTotalDelegatorSharesResponse_1_list)(nil)
 
-type _QueryOperatorDelegationRewardsResponse_1_list struct {
-	list *[]*DecPool
+type _QueryPoolServiceTotalDelegatorSharesResponse_1_list struct {
+	list *[]*v1beta11.DecCoin
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) Len() int {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) Truncate(n int) {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryOperatorDelegationRewardsResponse_1_list) IsValid() bool {
+func (x *_QueryPoolServiceTotalDelegatorSharesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryOperatorDelegationRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryOperatorDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
+	md_QueryPoolServiceTotalDelegatorSharesResponse        protoreflect.MessageDescriptor
+	fd_QueryPoolServiceTotalDelegatorSharesResponse_shares protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryOperatorDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorDelegationRewardsResponse")
-	fd_QueryOperatorDelegationRewardsResponse_rewards = md_QueryOperatorDelegationRewardsResponse.Fields().ByName("rewards")
+	md_QueryPoolServiceTotalDelegatorSharesResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceTotalDelegatorSharesResponse")
+	fd_QueryPoolServiceTotalDelegatorSharesResponse_shares = md_QueryPoolServiceTotalDelegatorSharesResponse.Fields().ByName("shares")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOperatorDelegationRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(nil)
 
-type fastReflection_QueryOperatorDelegationRewardsResponse QueryOperatorDelegationRewardsResponse
+type fastReflection_QueryPoolServiceTotalDelegatorSharesResponse QueryPoolServiceTotalDelegatorSharesResponse
 
-func (x *QueryOperatorDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOperatorDelegationRewardsResponse)(x)
+func (x *QueryPoolServiceTotalDelegatorSharesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(x)
 }
 
-func (x *QueryOperatorDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolServiceTotalDelegatorSharesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8470,43 +8393,43 @@ func (x *QueryOperatorDelegationRewardsResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOperatorDelegationRewardsResponse_messageType fastReflection_QueryOperatorDelegationRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOperatorDelegationRewardsResponse_messageType{}
+var _fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType{}
 
-type fastReflection_QueryOperatorDelegationRewardsResponse_messageType struct{}
+type fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType struct{}
 
-func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOperatorDelegationRewardsResponse)(nil)
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)(nil)
 }
-func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorDelegationRewardsResponse)
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)
 }
-func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorDelegationRewardsResponse
+func (x fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceTotalDelegatorSharesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOperatorDelegationRewardsResponse
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceTotalDelegatorSharesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOperatorDelegationRewardsResponse_messageType
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolServiceTotalDelegatorSharesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOperatorDelegationRewardsResponse)
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceTotalDelegatorSharesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOperatorDelegationRewardsResponse)(x)
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolServiceTotalDelegatorSharesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8514,10 +8437,10 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards})
-		if !f(fd_QueryOperatorDelegationRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Shares) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares})
+		if !f(fd_QueryPoolServiceTotalDelegatorSharesResponse_shares, value) {
 			return
 		}
 	}
@@ -8534,15 +8457,15 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
-		return len(x.Rewards) != 0
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
+		return len(x.Shares) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8552,15 +8475,15 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
-		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
+		x.Shares = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8570,19 +8493,19 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{})
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
+		if len(x.Shares) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{})
 		}
-		listValue := &_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards}
+		listValue := &_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8596,17 +8519,17 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
 		lv := value.List()
-		clv := lv.(*_QueryOperatorDelegationRewardsResponse_1_list)
-		x.Rewards = *clv.list
+		clv := lv.(*_QueryPoolServiceTotalDelegatorSharesResponse_1_list)
+		x.Shares = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8620,45 +8543,45 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DecPool{}
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
+		if x.Shares == nil {
+			x.Shares = []*v1beta11.DecCoin{}
 		}
-		value := &_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards}
+		value := &_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &x.Shares}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares":
+		list := []*v1beta11.DecCoin{}
+		return protoreflect.ValueOfList(&_QueryPoolServiceTotalDelegatorSharesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8666,7 +8589,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8677,7 +8600,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8689,7 +8612,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8699,9 +8622,9 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolServiceTotalDelegatorSharesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8713,8 +8636,8 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		if len(x.Shares) > 0 {
+			for _, e := range x.Shares {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -8729,7 +8652,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8748,9 +8671,9 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.Shares) > 0 {
+			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Shares[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8775,7 +8698,7 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolServiceTotalDelegatorSharesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8807,15 +8730,15 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8842,8 +8765,8 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.Shares = append(x.Shares, &v1beta11.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares[len(x.Shares)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8883,27 +8806,25 @@ func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *
 }
 
 var (
-	md_QueryServiceDelegationRewardsRequest                   protoreflect.MessageDescriptor
-	fd_QueryServiceDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
-	fd_QueryServiceDelegationRewardsRequest_service_id        protoreflect.FieldDescriptor
+	md_QueryPoolAPRRequest         protoreflect.MessageDescriptor
+	fd_QueryPoolAPRRequest_pool_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryServiceDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceDelegationRewardsRequest")
-	fd_QueryServiceDelegationRewardsRequest_delegator_address = md_QueryServiceDelegationRewardsRequest.Fields().ByName("delegator_address")
-	fd_QueryServiceDelegationRewardsRequest_service_id = md_QueryServiceDelegationRewardsRequest.Fields().ByName("service_id")
+	md_QueryPoolAPRRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolAPRRequest")
+	fd_QueryPoolAPRRequest_pool_id = md_QueryPoolAPRRequest.Fields().ByName("pool_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryServiceDelegationRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolAPRRequest)(nil)
 
-type fastReflection_QueryServiceDelegationRewardsRequest QueryServiceDelegationRewardsRequest
+type fastReflection_QueryPoolAPRRequest QueryPoolAPRRequest
 
-func (x *QueryServiceDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryServiceDelegationRewardsRequest)(x)
+func (x *QueryPoolAPRRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolAPRRequest)(x)
 }
 
-func (x *QueryServiceDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolAPRRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8915,43 +8836,43 @@ func (x *QueryServiceDelegationRewardsRequest) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryServiceDelegationRewardsRequest_messageType fastReflection_QueryServiceDelegationRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryServiceDelegationRewardsRequest_messageType{}
+var _fastReflection_QueryPoolAPRRequest_messageType fastReflection_QueryPoolAPRRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolAPRRequest_messageType{}
 
-type fastReflection_QueryServiceDelegationRewardsRequest_messageType struct{}
+type fastReflection_QueryPoolAPRRequest_messageType struct{}
 
-func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryServiceDelegationRewardsRequest)(nil)
+func (x fastReflection_QueryPoolAPRRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolAPRRequest)(nil)
 }
-func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceDelegationRewardsRequest)
+func (x fastReflection_QueryPoolAPRRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolAPRRequest)
 }
-func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceDelegationRewardsRequest
+func (x fastReflection_QueryPoolAPRRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolAPRRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceDelegationRewardsRequest
+func (x *fastReflection_QueryPoolAPRRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolAPRRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryServiceDelegationRewardsRequest_messageType
+func (x *fastReflection_QueryPoolAPRRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolAPRRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceDelegationRewardsRequest)
+func (x *fastReflection_QueryPoolAPRRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolAPRRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryServiceDelegationRewardsRequest)(x)
+func (x *fastReflection_QueryPoolAPRRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolAPRRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8959,16 +8880,10 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_QueryServiceDelegationRewardsRequest_delegator_address, value) {
-			return
-		}
-	}
-	if x.ServiceId != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ServiceId)
-		if !f(fd_QueryServiceDelegationRewardsRequest_service_id, value) {
+func (x *fastReflection_QueryPoolAPRRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PoolId)
+		if !f(fd_QueryPoolAPRRequest_pool_id, value) {
 			return
 		}
 	}
@@ -8985,17 +8900,15 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolAPRRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		return x.DelegatorAddress != ""
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
-		return x.ServiceId != uint32(0)
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
+		return x.PoolId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9005,17 +8918,15 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolAPRRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = ""
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
-		x.ServiceId = uint32(0)
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
+		x.PoolId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9025,19 +8936,16 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
-		value := x.ServiceId
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
+		value := x.PoolId
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9051,17 +8959,15 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolAPRRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
-		x.ServiceId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
+		x.PoolId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9075,44 +8981,40 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest is not mutable"))
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
-		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolAPRRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
-		return protoreflect.ValueOfString("")
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+	case "milkyway.rewards.v1.QueryPoolAPRRequest.pool_id":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolAPRRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceDelegationRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolAPRRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9120,7 +9022,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolAPRRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9131,7 +9033,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolAPRRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9143,7 +9045,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryPoolAPRRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9153,9 +9055,9 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolAPRRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolAPRRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9167,12 +9069,8 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ServiceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9184,7 +9082,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolAPRRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9203,17 +9101,10 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ServiceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9226,7 +9117,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		x := input.Message.Interface().(*QueryPoolAPRRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9258,17 +9149,17 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolAPRRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 				}
-				var stringLen uint64
+				x.PoolId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9278,50 +9169,18 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PoolId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
-				}
-				x.ServiceId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ServiceId |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -9350,77 +9209,79 @@ func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *pr
 	}
 }
 
-var _ protoreflect.List = (*_QueryServiceDelegationRewardsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPoolAPRResponse_2_list)(nil)
 
-type _QueryServiceDelegationRewardsResponse_1_list struct {
-	list *[]*DecPool
+type _QueryPoolAPRResponse_2_list struct {
+	list *[]*PlanAPR
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) Len() int {
+func (x *_QueryPoolAPRResponse_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPoolAPRResponse_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPoolAPRResponse_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPoolAPRResponse_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryPoolAPRResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(PlanAPR)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) Truncate(n int) {
+func (x *_QueryPoolAPRResponse_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryPoolAPRResponse_2_list) NewElement() protoreflect.Value {
+	v := new(PlanAPR)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryServiceDelegationRewardsResponse_1_list) IsValid() bool {
+func (x *_QueryPoolAPRResponse_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryServiceDelegationRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryServiceDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
+	md_QueryPoolAPRResponse       protoreflect.MessageDescriptor
+	fd_QueryPoolAPRResponse_apr   protoreflect.FieldDescriptor
+	fd_QueryPoolAPRResponse_plans protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryServiceDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceDelegationRewardsResponse")
-	fd_QueryServiceDelegationRewardsResponse_rewards = md_QueryServiceDelegationRewardsResponse.Fields().ByName("rewards")
+	md_QueryPoolAPRResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolAPRResponse")
+	fd_QueryPoolAPRResponse_apr = md_QueryPoolAPRResponse.Fields().ByName("apr")
+	fd_QueryPoolAPRResponse_plans = md_QueryPoolAPRResponse.Fields().ByName("plans")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryServiceDelegationRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolAPRResponse)(nil)
 
-type fastReflection_QueryServiceDelegationRewardsResponse QueryServiceDelegationRewardsResponse
+type fastReflection_QueryPoolAPRResponse QueryPoolAPRResponse
 
-func (x *QueryServiceDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryServiceDelegationRewardsResponse)(x)
+func (x *QueryPoolAPRResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolAPRResponse)(x)
 }
 
-func (x *QueryServiceDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolAPRResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9432,43 +9293,43 @@ func (x *QueryServiceDelegationRewardsResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryServiceDelegationRewardsResponse_messageType fastReflection_QueryServiceDelegationRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryServiceDelegationRewardsResponse_messageType{}
+var _fastReflection_QueryPoolAPRResponse_messageType fastReflection_QueryPoolAPRResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolAPRResponse_messageType{}
 
-type fastReflection_QueryServiceDelegationRewardsResponse_messageType struct{}
+type fastReflection_QueryPoolAPRResponse_messageType struct{}
 
-func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryServiceDelegationRewardsResponse)(nil)
+func (x fastReflection_QueryPoolAPRResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolAPRResponse)(nil)
 }
-func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceDelegationRewardsResponse)
+func (x fastReflection_QueryPoolAPRResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolAPRResponse)
 }
-func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceDelegationRewardsResponse
+func (x fastReflection_QueryPoolAPRResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolAPRResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryServiceDelegationRewardsResponse
+func (x *fastReflection_QueryPoolAPRResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolAPRResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryServiceDelegationRewardsResponse_messageType
+func (x *fastReflection_QueryPoolAPRResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolAPRResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryServiceDelegationRewardsResponse)
+func (x *fastReflection_QueryPoolAPRResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolAPRResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryServiceDelegationRewardsResponse)(x)
+func (x *fastReflection_QueryPoolAPRResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolAPRResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9476,10 +9337,16 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards})
-		if !f(fd_QueryServiceDelegationRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryPoolAPRResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Apr != "" {
+		value := protoreflect.ValueOfString(x.Apr)
+		if !f(fd_QueryPoolAPRResponse_apr, value) {
+			return
+		}
+	}
+	if len(x.Plans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolAPRResponse_2_list{list: &x.Plans})
+		if !f(fd_QueryPoolAPRResponse_plans, value) {
 			return
 		}
 	}
@@ -9496,15 +9363,17 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolAPRResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
-		return len(x.Rewards) != 0
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		return x.Apr != ""
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
+		return len(x.Plans) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9514,15 +9383,17 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolAPRResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
-		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		x.Apr = ""
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
+		x.Plans = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9532,19 +9403,22 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{})
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		value := x.Apr
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
+		if len(x.Plans) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolAPRResponse_2_list{})
 		}
-		listValue := &_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards}
+		listValue := &_QueryPoolAPRResponse_2_list{list: &x.Plans}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9558,17 +9432,19 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolAPRResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		x.Apr = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
 		lv := value.List()
-		clv := lv.(*_QueryServiceDelegationRewardsResponse_1_list)
-		x.Rewards = *clv.list
+		clv := lv.(*_QueryPoolAPRResponse_2_list)
+		x.Plans = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9582,45 +9458,49 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DecPool{}
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
+		if x.Plans == nil {
+			x.Plans = []*PlanAPR{}
 		}
-		value := &_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards}
+		value := &_QueryPoolAPRResponse_2_list{list: &x.Plans}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		panic(fmt.Errorf("field apr of message milkyway.rewards.v1.QueryPoolAPRResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolAPRResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.apr":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryPoolAPRResponse.plans":
+		list := []*PlanAPR{}
+		return protoreflect.ValueOfList(&_QueryPoolAPRResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolAPRResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceDelegationRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolAPRResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9628,7 +9508,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolAPRResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9639,7 +9519,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolAPRResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9651,7 +9531,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryPoolAPRResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9661,9 +9541,9 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolAPRResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolAPRResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9675,8 +9555,12 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		l = len(x.Apr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Plans) > 0 {
+			for _, e := range x.Plans {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -9691,7 +9575,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolAPRResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9710,9 +9594,9 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.Plans) > 0 {
+			for iNdEx := len(x.Plans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Plans[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9723,9 +9607,16 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Apr) > 0 {
+			i -= len(x.Apr)
+			copy(dAtA[i:], x.Apr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Apr)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9737,7 +9628,7 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		x := input.Message.Interface().(*QueryPoolAPRResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9769,15 +9660,47 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolAPRResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Apr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9804,8 +9727,8 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.Plans = append(x.Plans, &PlanAPR{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Plans[len(x.Plans)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9845,25 +9768,25 @@ func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *p
 }
 
 var (
-	md_QueryDelegatorTotalRewardsRequest                   protoreflect.MessageDescriptor
-	fd_QueryDelegatorTotalRewardsRequest_delegator_address protoreflect.FieldDescriptor
+	md_QueryOperatorAPRRequest             protoreflect.MessageDescriptor
+	fd_QueryOperatorAPRRequest_operator_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryDelegatorTotalRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorTotalRewardsRequest")
-	fd_QueryDelegatorTotalRewardsRequest_delegator_address = md_QueryDelegatorTotalRewardsRequest.Fields().ByName("delegator_address")
+	md_QueryOperatorAPRRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorAPRRequest")
+	fd_QueryOperatorAPRRequest_operator_id = md_QueryOperatorAPRRequest.Fields().ByName("operator_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDelegatorTotalRewardsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOperatorAPRRequest)(nil)
 
-type fastReflection_QueryDelegatorTotalRewardsRequest QueryDelegatorTotalRewardsRequest
+type fastReflection_QueryOperatorAPRRequest QueryOperatorAPRRequest
 
-func (x *QueryDelegatorTotalRewardsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorTotalRewardsRequest)(x)
+func (x *QueryOperatorAPRRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorAPRRequest)(x)
 }
 
-func (x *QueryDelegatorTotalRewardsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOperatorAPRRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9875,43 +9798,43 @@ func (x *QueryDelegatorTotalRewardsRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDelegatorTotalRewardsRequest_messageType fastReflection_QueryDelegatorTotalRewardsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDelegatorTotalRewardsRequest_messageType{}
+var _fastReflection_QueryOperatorAPRRequest_messageType fastReflection_QueryOperatorAPRRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorAPRRequest_messageType{}
 
-type fastReflection_QueryDelegatorTotalRewardsRequest_messageType struct{}
+type fastReflection_QueryOperatorAPRRequest_messageType struct{}
 
-func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorTotalRewardsRequest)(nil)
+func (x fastReflection_QueryOperatorAPRRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorAPRRequest)(nil)
 }
-func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorTotalRewardsRequest)
+func (x fastReflection_QueryOperatorAPRRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorAPRRequest)
 }
-func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorTotalRewardsRequest
+func (x fastReflection_QueryOperatorAPRRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorAPRRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorTotalRewardsRequest
+func (x *fastReflection_QueryOperatorAPRRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorAPRRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDelegatorTotalRewardsRequest_messageType
+func (x *fastReflection_QueryOperatorAPRRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorAPRRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorTotalRewardsRequest)
+func (x *fastReflection_QueryOperatorAPRRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorAPRRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryDelegatorTotalRewardsRequest)(x)
+func (x *fastReflection_QueryOperatorAPRRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorAPRRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9919,10 +9842,10 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_QueryDelegatorTotalRewardsRequest_delegator_address, value) {
+func (x *fastReflection_QueryOperatorAPRRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_QueryOperatorAPRRequest_operator_id, value) {
 			return
 		}
 	}
@@ -9939,15 +9862,15 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorAPRRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		return x.OperatorId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9957,15 +9880,15 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorAPRRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		x.OperatorId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9975,16 +9898,16 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9998,15 +9921,15 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorAPRRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		x.OperatorId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10020,40 +9943,40 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorAPRRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
-		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryOperatorAPRRequest.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorAPRRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorAPRRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10061,7 +9984,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorAPRRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10072,7 +9995,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorAPRRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10084,7 +10007,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) IsValid() bool {
+func (x *fastReflection_QueryOperatorAPRRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10094,9 +10017,9 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorAPRRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorAPRRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10108,9 +10031,8 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10122,7 +10044,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorAPRRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10141,12 +10063,10 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10159,7 +10079,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		x := input.Message.Interface().(*QueryOperatorAPRRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10191,17 +10111,17 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorAPRRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 				}
-				var stringLen uint64
+				x.OperatorId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10211,24 +10131,11 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OperatorId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10264,130 +10171,79 @@ func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *proto
 	}
 }
 
-var _ protoreflect.List = (*_QueryDelegatorTotalRewardsResponse_1_list)(nil)
-
-type _QueryDelegatorTotalRewardsResponse_1_list struct {
-	list *[]*DelegationDelegatorReward
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DelegationDelegatorReward)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DelegationDelegatorReward)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(DelegationDelegatorReward)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(DelegationDelegatorReward)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryDelegatorTotalRewardsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_QueryDelegatorTotalRewardsResponse_2_list)(nil)
+var _ protoreflect.List = (*_QueryOperatorAPRResponse_2_list)(nil)
 
-type _QueryDelegatorTotalRewardsResponse_2_list struct {
-	list *[]*DecPool
+type _QueryOperatorAPRResponse_2_list struct {
+	list *[]*PlanAPR
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) Len() int {
+func (x *_QueryOperatorAPRResponse_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) Get(i int) protoreflect.Value {
+func (x *_QueryOperatorAPRResponse_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryOperatorAPRResponse_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) Append(value protoreflect.Value) {
+func (x *_QueryOperatorAPRResponse_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryOperatorAPRResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(PlanAPR)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) Truncate(n int) {
+func (x *_QueryOperatorAPRResponse_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) NewElement() protoreflect.Value {
-	v := new(DecPool)
+func (x *_QueryOperatorAPRResponse_2_list) NewElement() protoreflect.Value {
+	v := new(PlanAPR)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryDelegatorTotalRewardsResponse_2_list) IsValid() bool {
+func (x *_QueryOperatorAPRResponse_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryDelegatorTotalRewardsResponse         protoreflect.MessageDescriptor
-	fd_QueryDelegatorTotalRewardsResponse_rewards protoreflect.FieldDescriptor
-	fd_QueryDelegatorTotalRewardsResponse_total   protoreflect.FieldDescriptor
+	md_QueryOperatorAPRResponse       protoreflect.MessageDescriptor
+	fd_QueryOperatorAPRResponse_apr   protoreflect.FieldDescriptor
+	fd_QueryOperatorAPRResponse_plans protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryDelegatorTotalRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorTotalRewardsResponse")
-	fd_QueryDelegatorTotalRewardsResponse_rewards = md_QueryDelegatorTotalRewardsResponse.Fields().ByName("rewards")
-	fd_QueryDelegatorTotalRewardsResponse_total = md_QueryDelegatorTotalRewardsResponse.Fields().ByName("total")
+	md_QueryOperatorAPRResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorAPRResponse")
+	fd_QueryOperatorAPRResponse_apr = md_QueryOperatorAPRResponse.Fields().ByName("apr")
+	fd_QueryOperatorAPRResponse_plans = md_QueryOperatorAPRResponse.Fields().ByName("plans")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDelegatorTotalRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOperatorAPRResponse)(nil)
 
-type fastReflection_QueryDelegatorTotalRewardsResponse QueryDelegatorTotalRewardsResponse
+type fastReflection_QueryOperatorAPRResponse QueryOperatorAPRResponse
 
-func (x *QueryDelegatorTotalRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorTotalRewardsResponse)(x)
+func (x *QueryOperatorAPRResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorAPRResponse)(x)
 }
 
-func (x *QueryDelegatorTotalRewardsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOperatorAPRResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10399,43 +10255,43 @@ func (x *QueryDelegatorTotalRewardsResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDelegatorTotalRewardsResponse_messageType fastReflection_QueryDelegatorTotalRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDelegatorTotalRewardsResponse_messageType{}
+var _fastReflection_QueryOperatorAPRResponse_messageType fastReflection_QueryOperatorAPRResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorAPRResponse_messageType{}
 
-type fastReflection_QueryDelegatorTotalRewardsResponse_messageType struct{}
+type fastReflection_QueryOperatorAPRResponse_messageType struct{}
 
-func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorTotalRewardsResponse)(nil)
+func (x fastReflection_QueryOperatorAPRResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorAPRResponse)(nil)
 }
-func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorTotalRewardsResponse)
+func (x fastReflection_QueryOperatorAPRResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorAPRResponse)
 }
-func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorTotalRewardsResponse
+func (x fastReflection_QueryOperatorAPRResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorAPRResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorTotalRewardsResponse
+func (x *fastReflection_QueryOperatorAPRResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorAPRResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDelegatorTotalRewardsResponse_messageType
+func (x *fastReflection_QueryOperatorAPRResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorAPRResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorTotalRewardsResponse)
+func (x *fastReflection_QueryOperatorAPRResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorAPRResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryDelegatorTotalRewardsResponse)(x)
+func (x *fastReflection_QueryOperatorAPRResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorAPRResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10443,16 +10299,16 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards})
-		if !f(fd_QueryDelegatorTotalRewardsResponse_rewards, value) {
+func (x *fastReflection_QueryOperatorAPRResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Apr != "" {
+		value := protoreflect.ValueOfString(x.Apr)
+		if !f(fd_QueryOperatorAPRResponse_apr, value) {
 			return
 		}
 	}
-	if len(x.Total) != 0 {
-		value := protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total})
-		if !f(fd_QueryDelegatorTotalRewardsResponse_total, value) {
+	if len(x.Plans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOperatorAPRResponse_2_list{list: &x.Plans})
+		if !f(fd_QueryOperatorAPRResponse_plans, value) {
 			return
 		}
 	}
@@ -10469,17 +10325,17 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOperatorAPRResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
-		return len(x.Rewards) != 0
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		return len(x.Total) != 0
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		return x.Apr != ""
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
+		return len(x.Plans) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10489,17 +10345,17 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOperatorAPRResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
-		x.Rewards = nil
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		x.Total = nil
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		x.Apr = ""
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
+		x.Plans = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10509,25 +10365,22 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{})
-		}
-		listValue := &_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards}
-		return protoreflect.ValueOfList(listValue)
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		if len(x.Total) == 0 {
-			return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{})
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		value := x.Apr
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
+		if len(x.Plans) == 0 {
+			return protoreflect.ValueOfList(&_QueryOperatorAPRResponse_2_list{})
 		}
-		listValue := &_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total}
+		listValue := &_QueryOperatorAPRResponse_2_list{list: &x.Plans}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10541,21 +10394,19 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOperatorAPRResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		x.Apr = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
 		lv := value.List()
-		clv := lv.(*_QueryDelegatorTotalRewardsResponse_1_list)
-		x.Rewards = *clv.list
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		lv := value.List()
-		clv := lv.(*_QueryDelegatorTotalRewardsResponse_2_list)
-		x.Total = *clv.list
+		clv := lv.(*_QueryOperatorAPRResponse_2_list)
+		x.Plans = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10569,54 +10420,49 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*DelegationDelegatorReward{}
-		}
-		value := &_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards}
-		return protoreflect.ValueOfList(value)
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		if x.Total == nil {
-			x.Total = []*DecPool{}
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
+		if x.Plans == nil {
+			x.Plans = []*PlanAPR{}
 		}
-		value := &_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total}
+		value := &_QueryOperatorAPRResponse_2_list{list: &x.Plans}
 		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		panic(fmt.Errorf("field apr of message milkyway.rewards.v1.QueryOperatorAPRResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOperatorAPRResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
-		list := []*DelegationDelegatorReward{}
-		return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{list: &list})
-	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
-		list := []*DecPool{}
-		return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{list: &list})
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.apr":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryOperatorAPRResponse.plans":
+		list := []*PlanAPR{}
+		return protoreflect.ValueOfList(&_QueryOperatorAPRResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOperatorAPRResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorAPRResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10624,7 +10470,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOperatorAPRResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10635,7 +10481,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOperatorAPRResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10647,7 +10493,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) IsValid() bool {
+func (x *fastReflection_QueryOperatorAPRResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10657,9 +10503,9 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOperatorAPRResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorAPRResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10671,14 +10517,12 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Apr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Total) > 0 {
-			for _, e := range x.Total {
+		if len(x.Plans) > 0 {
+			for _, e := range x.Plans {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -10693,7 +10537,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorAPRResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10712,9 +10556,9 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Total) > 0 {
-			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Total[iNdEx])
+		if len(x.Plans) > 0 {
+			for iNdEx := len(x.Plans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Plans[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10728,21 +10572,12 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Apr) > 0 {
+			i -= len(x.Apr)
+			copy(dAtA[i:], x.Apr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Apr)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10755,7 +10590,7 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		x := input.Message.Interface().(*QueryOperatorAPRResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10787,17 +10622,17 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorAPRResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10807,29 +10642,27 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &DelegationDelegatorReward{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Apr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10856,8 +10689,8 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Total = append(x.Total, &DecPool{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+				x.Plans = append(x.Plans, &PlanAPR{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Plans[len(x.Plans)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10897,25 +10730,25 @@ func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *prot
 }
 
 var (
-	md_QueryDelegatorWithdrawAddressRequest                   protoreflect.MessageDescriptor
-	fd_QueryDelegatorWithdrawAddressRequest_delegator_address protoreflect.FieldDescriptor
+	md_QueryServiceAPRRequest            protoreflect.MessageDescriptor
+	fd_QueryServiceAPRRequest_service_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryDelegatorWithdrawAddressRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorWithdrawAddressRequest")
-	fd_QueryDelegatorWithdrawAddressRequest_delegator_address = md_QueryDelegatorWithdrawAddressRequest.Fields().ByName("delegator_address")
+	md_QueryServiceAPRRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceAPRRequest")
+	fd_QueryServiceAPRRequest_service_id = md_QueryServiceAPRRequest.Fields().ByName("service_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDelegatorWithdrawAddressRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryServiceAPRRequest)(nil)
 
-type fastReflection_QueryDelegatorWithdrawAddressRequest QueryDelegatorWithdrawAddressRequest
+type fastReflection_QueryServiceAPRRequest QueryServiceAPRRequest
 
-func (x *QueryDelegatorWithdrawAddressRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorWithdrawAddressRequest)(x)
+func (x *QueryServiceAPRRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceAPRRequest)(x)
 }
 
-func (x *QueryDelegatorWithdrawAddressRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryServiceAPRRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10927,43 +10760,43 @@ func (x *QueryDelegatorWithdrawAddressRequest) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDelegatorWithdrawAddressRequest_messageType fastReflection_QueryDelegatorWithdrawAddressRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDelegatorWithdrawAddressRequest_messageType{}
+var _fastReflection_QueryServiceAPRRequest_messageType fastReflection_QueryServiceAPRRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceAPRRequest_messageType{}
 
-type fastReflection_QueryDelegatorWithdrawAddressRequest_messageType struct{}
+type fastReflection_QueryServiceAPRRequest_messageType struct{}
 
-func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorWithdrawAddressRequest)(nil)
+func (x fastReflection_QueryServiceAPRRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceAPRRequest)(nil)
 }
-func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorWithdrawAddressRequest)
+func (x fastReflection_QueryServiceAPRRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceAPRRequest)
 }
-func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorWithdrawAddressRequest
+func (x fastReflection_QueryServiceAPRRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceAPRRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorWithdrawAddressRequest
+func (x *fastReflection_QueryServiceAPRRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceAPRRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDelegatorWithdrawAddressRequest_messageType
+func (x *fastReflection_QueryServiceAPRRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceAPRRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorWithdrawAddressRequest)
+func (x *fastReflection_QueryServiceAPRRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceAPRRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryDelegatorWithdrawAddressRequest)(x)
+func (x *fastReflection_QueryServiceAPRRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceAPRRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10971,10 +10804,10 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DelegatorAddress != "" {
-		value := protoreflect.ValueOfString(x.DelegatorAddress)
-		if !f(fd_QueryDelegatorWithdrawAddressRequest_delegator_address, value) {
+func (x *fastReflection_QueryServiceAPRRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_QueryServiceAPRRequest_service_id, value) {
 			return
 		}
 	}
@@ -10991,15 +10824,15 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryServiceAPRRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		return x.ServiceId != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11009,15 +10842,15 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryServiceAPRRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		x.ServiceId = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11027,16 +10860,16 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		value := x.DelegatorAddress
-		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11050,15 +10883,15 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryServiceAPRRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		x.ServiceId = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11072,40 +10905,40 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryServiceAPRRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
-		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryServiceAPRRequest.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRRequest"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryServiceAPRRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceAPRRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11113,7 +10946,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryServiceAPRRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11124,7 +10957,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryServiceAPRRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11136,7 +10969,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) IsValid() bool {
+func (x *fastReflection_QueryServiceAPRRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11146,9 +10979,9 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryServiceAPRRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		x := input.Message.Interface().(*QueryServiceAPRRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11160,9 +10993,8 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		l = len(x.DelegatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11174,7 +11006,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		x := input.Message.Interface().(*QueryServiceAPRRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11193,12 +11025,10 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DelegatorAddress) > 0 {
-			i -= len(x.DelegatorAddress)
-			copy(dAtA[i:], x.DelegatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11211,7 +11041,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		x := input.Message.Interface().(*QueryServiceAPRRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11243,17 +11073,17 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceAPRRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 				}
-				var stringLen uint64
+				x.ServiceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11263,24 +11093,11 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ServiceId |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11316,26 +11133,79 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *pr
 	}
 }
 
+var _ protoreflect.List = (*_QueryServiceAPRResponse_2_list)(nil)
+
+type _QueryServiceAPRResponse_2_list struct {
+	list *[]*PlanAPR
+}
+
+func (x *_QueryServiceAPRResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryServiceAPRResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryServiceAPRResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryServiceAPRResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryServiceAPRResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(PlanAPR)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryServiceAPRResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryServiceAPRResponse_2_list) NewElement() protoreflect.Value {
+	v := new(PlanAPR)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryServiceAPRResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryDelegatorWithdrawAddressResponse                  protoreflect.MessageDescriptor
-	fd_QueryDelegatorWithdrawAddressResponse_withdraw_address protoreflect.FieldDescriptor
+	md_QueryServiceAPRResponse       protoreflect.MessageDescriptor
+	fd_QueryServiceAPRResponse_apr   protoreflect.FieldDescriptor
+	fd_QueryServiceAPRResponse_plans protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_rewards_v1_query_proto_init()
-	md_QueryDelegatorWithdrawAddressResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorWithdrawAddressResponse")
-	fd_QueryDelegatorWithdrawAddressResponse_withdraw_address = md_QueryDelegatorWithdrawAddressResponse.Fields().ByName("withdraw_address")
+	md_QueryServiceAPRResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceAPRResponse")
+	fd_QueryServiceAPRResponse_apr = md_QueryServiceAPRResponse.Fields().ByName("apr")
+	fd_QueryServiceAPRResponse_plans = md_QueryServiceAPRResponse.Fields().ByName("plans")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDelegatorWithdrawAddressResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryServiceAPRResponse)(nil)
 
-type fastReflection_QueryDelegatorWithdrawAddressResponse QueryDelegatorWithdrawAddressResponse
+type fastReflection_QueryServiceAPRResponse QueryServiceAPRResponse
 
-func (x *QueryDelegatorWithdrawAddressResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorWithdrawAddressResponse)(x)
+func (x *QueryServiceAPRResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceAPRResponse)(x)
 }
 
-func (x *QueryDelegatorWithdrawAddressResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryServiceAPRResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11347,43 +11217,43 @@ func (x *QueryDelegatorWithdrawAddressResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDelegatorWithdrawAddressResponse_messageType fastReflection_QueryDelegatorWithdrawAddressResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDelegatorWithdrawAddressResponse_messageType{}
+var _fastReflection_QueryServiceAPRResponse_messageType fastReflection_QueryServiceAPRResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceAPRResponse_messageType{}
 
-type fastReflection_QueryDelegatorWithdrawAddressResponse_messageType struct{}
+type fastReflection_QueryServiceAPRResponse_messageType struct{}
 
-func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDelegatorWithdrawAddressResponse)(nil)
+func (x fastReflection_QueryServiceAPRResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceAPRResponse)(nil)
 }
-func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorWithdrawAddressResponse)
+func (x fastReflection_QueryServiceAPRResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceAPRResponse)
 }
-func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorWithdrawAddressResponse
+func (x fastReflection_QueryServiceAPRResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceAPRResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDelegatorWithdrawAddressResponse
+func (x *fastReflection_QueryServiceAPRResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceAPRResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDelegatorWithdrawAddressResponse_messageType
+func (x *fastReflection_QueryServiceAPRResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceAPRResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryDelegatorWithdrawAddressResponse)
+func (x *fastReflection_QueryServiceAPRResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceAPRResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryDelegatorWithdrawAddressResponse)(x)
+func (x *fastReflection_QueryServiceAPRResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceAPRResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11391,10 +11261,16 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.WithdrawAddress != "" {
-		value := protoreflect.ValueOfString(x.WithdrawAddress)
-		if !f(fd_QueryDelegatorWithdrawAddressResponse_withdraw_address, value) {
+func (x *fastReflection_QueryServiceAPRResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Apr != "" {
+		value := protoreflect.ValueOfString(x.Apr)
+		if !f(fd_QueryServiceAPRResponse_apr, value) {
+			return
+		}
+	}
+	if len(x.Plans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryServiceAPRResponse_2_list{list: &x.Plans})
+		if !f(fd_QueryServiceAPRResponse_plans, value) {
 			return
 		}
 	}
@@ -11411,15 +11287,17 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryServiceAPRResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
-		return x.WithdrawAddress != ""
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
+		return x.Apr != ""
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		return len(x.Plans) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11429,15 +11307,17 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryServiceAPRResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
-		x.WithdrawAddress = ""
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
+		x.Apr = ""
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		x.Plans = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11447,16 +11327,22 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
-		value := x.WithdrawAddress
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
+		value := x.Apr
 		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		if len(x.Plans) == 0 {
+			return protoreflect.ValueOfList(&_QueryServiceAPRResponse_2_list{})
+		}
+		listValue := &_QueryServiceAPRResponse_2_list{list: &x.Plans}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11470,15 +11356,19 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryServiceAPRResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
-		x.WithdrawAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
+		x.Apr = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		lv := value.List()
+		clv := lv.(*_QueryServiceAPRResponse_2_list)
+		x.Plans = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11492,40 +11382,49 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
-		panic(fmt.Errorf("field withdraw_address of message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse is not mutable"))
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		if x.Plans == nil {
+			x.Plans = []*PlanAPR{}
+		}
+		value := &_QueryServiceAPRResponse_2_list{list: &x.Plans}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
+		panic(fmt.Errorf("field apr of message milkyway.rewards.v1.QueryServiceAPRResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryServiceAPRResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.apr":
 		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryServiceAPRResponse.plans":
+		list := []*PlanAPR{}
+		return protoreflect.ValueOfList(&_QueryServiceAPRResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceAPRResponse"))
 		}
-		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceAPRResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryServiceAPRResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceAPRResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11533,7 +11432,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryServiceAPRResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11544,7 +11443,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryServiceAPRResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11556,7 +11455,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) IsValid() bool {
+func (x *fastReflection_QueryServiceAPRResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11566,9 +11465,9 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryServiceAPRResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		x := input.Message.Interface().(*QueryServiceAPRResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11580,10 +11479,16 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		l = len(x.WithdrawAddress)
+		l = len(x.Apr)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Plans) > 0 {
+			for _, e := range x.Plans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11594,7 +11499,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		x := input.Message.Interface().(*QueryServiceAPRResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11613,10 +11518,26 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.WithdrawAddress) > 0 {
-			i -= len(x.WithdrawAddress)
-			copy(dAtA[i:], x.WithdrawAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawAddress)))
+		if len(x.Plans) > 0 {
+			for iNdEx := len(x.Plans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Plans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Apr) > 0 {
+			i -= len(x.Apr)
+			copy(dAtA[i:], x.Apr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Apr)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11631,7 +11552,7 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		x := input.Message.Interface().(*QueryServiceAPRResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11663,15 +11584,15 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceAPRResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11699,12 +11620,46 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+				x.Apr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Plans = append(x.Plans, &PlanAPR{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Plans[len(x.Plans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -11736,544 +11691,7903 @@ func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *p
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: milkyway/rewards/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryPoolServiceAPRRequest            protoreflect.MessageDescriptor
+	fd_QueryPoolServiceAPRRequest_pool_id    protoreflect.FieldDescriptor
+	fd_QueryPoolServiceAPRRequest_service_id protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryPoolServiceAPRRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceAPRRequest")
+	fd_QueryPoolServiceAPRRequest_pool_id = md_QueryPoolServiceAPRRequest.Fields().ByName("pool_id")
+	fd_QueryPoolServiceAPRRequest_service_id = md_QueryPoolServiceAPRRequest.Fields().ByName("service_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolServiceAPRRequest)(nil)
+
+type fastReflection_QueryPoolServiceAPRRequest QueryPoolServiceAPRRequest
+
+func (x *QueryPoolServiceAPRRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceAPRRequest)(x)
+}
+
+func (x *QueryPoolServiceAPRRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolServiceAPRRequest_messageType fastReflection_QueryPoolServiceAPRRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolServiceAPRRequest_messageType{}
+
+type fastReflection_QueryPoolServiceAPRRequest_messageType struct{}
+
+func (x fastReflection_QueryPoolServiceAPRRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceAPRRequest)(nil)
+}
+func (x fastReflection_QueryPoolServiceAPRRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceAPRRequest)
+}
+func (x fastReflection_QueryPoolServiceAPRRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceAPRRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceAPRRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolServiceAPRRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolServiceAPRRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceAPRRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolServiceAPRRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PoolId)
+		if !f(fd_QueryPoolServiceAPRRequest_pool_id, value) {
+			return
+		}
+	}
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_QueryPoolServiceAPRRequest_service_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		return x.PoolId != uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		return x.ServiceId != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		x.PoolId = uint32(0)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		x.ServiceId = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		x.PoolId = uint32(value.Uint())
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		x.ServiceId = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolServiceAPRRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryPoolServiceAPRRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolServiceAPRRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.pool_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.rewards.v1.QueryPoolServiceAPRRequest.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolServiceAPRRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceAPRRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolServiceAPRRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolServiceAPRRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolServiceAPRRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolServiceAPRRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolServiceAPRRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolServiceAPRRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceAPRRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolServiceAPRResponse_2_list)(nil)
+
+type _QueryPoolServiceAPRResponse_2_list struct {
+	list *[]*PlanAPR
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PlanAPR)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(PlanAPR)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) NewElement() protoreflect.Value {
+	v := new(PlanAPR)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolServiceAPRResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolServiceAPRResponse       protoreflect.MessageDescriptor
+	fd_QueryPoolServiceAPRResponse_apr   protoreflect.FieldDescriptor
+	fd_QueryPoolServiceAPRResponse_plans protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryPoolServiceAPRResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolServiceAPRResponse")
+	fd_QueryPoolServiceAPRResponse_apr = md_QueryPoolServiceAPRResponse.Fields().ByName("apr")
+	fd_QueryPoolServiceAPRResponse_plans = md_QueryPoolServiceAPRResponse.Fields().ByName("plans")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolServiceAPRResponse)(nil)
+
+type fastReflection_QueryPoolServiceAPRResponse QueryPoolServiceAPRResponse
+
+func (x *QueryPoolServiceAPRResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceAPRResponse)(x)
+}
+
+func (x *QueryPoolServiceAPRResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolServiceAPRResponse_messageType fastReflection_QueryPoolServiceAPRResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolServiceAPRResponse_messageType{}
+
+type fastReflection_QueryPoolServiceAPRResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolServiceAPRResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolServiceAPRResponse)(nil)
+}
+func (x fastReflection_QueryPoolServiceAPRResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceAPRResponse)
+}
+func (x fastReflection_QueryPoolServiceAPRResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceAPRResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolServiceAPRResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolServiceAPRResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolServiceAPRResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolServiceAPRResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolServiceAPRResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Apr != "" {
+		value := protoreflect.ValueOfString(x.Apr)
+		if !f(fd_QueryPoolServiceAPRResponse_apr, value) {
+			return
+		}
+	}
+	if len(x.Plans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolServiceAPRResponse_2_list{list: &x.Plans})
+		if !f(fd_QueryPoolServiceAPRResponse_plans, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		return x.Apr != ""
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		return len(x.Plans) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		x.Apr = ""
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		x.Plans = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		value := x.Apr
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		if len(x.Plans) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolServiceAPRResponse_2_list{})
+		}
+		listValue := &_QueryPoolServiceAPRResponse_2_list{list: &x.Plans}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		x.Apr = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		lv := value.List()
+		clv := lv.(*_QueryPoolServiceAPRResponse_2_list)
+		x.Plans = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		if x.Plans == nil {
+			x.Plans = []*PlanAPR{}
+		}
+		value := &_QueryPoolServiceAPRResponse_2_list{list: &x.Plans}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		panic(fmt.Errorf("field apr of message milkyway.rewards.v1.QueryPoolServiceAPRResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolServiceAPRResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.apr":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans":
+		list := []*PlanAPR{}
+		return protoreflect.ValueOfList(&_QueryPoolServiceAPRResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolServiceAPRResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolServiceAPRResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolServiceAPRResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolServiceAPRResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolServiceAPRResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolServiceAPRResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolServiceAPRResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolServiceAPRResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolServiceAPRResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Apr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Plans) > 0 {
+			for _, e := range x.Plans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolServiceAPRResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Plans) > 0 {
+			for iNdEx := len(x.Plans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Plans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Apr) > 0 {
+			i -= len(x.Apr)
+			copy(dAtA[i:], x.Apr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Apr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolServiceAPRResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceAPRResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolServiceAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Apr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Plans = append(x.Plans, &PlanAPR{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Plans[len(x.Plans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPoolDelegationRewardsRequest                   protoreflect.MessageDescriptor
+	fd_QueryPoolDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
+	fd_QueryPoolDelegationRewardsRequest_pool_id           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryPoolDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolDelegationRewardsRequest")
+	fd_QueryPoolDelegationRewardsRequest_delegator_address = md_QueryPoolDelegationRewardsRequest.Fields().ByName("delegator_address")
+	fd_QueryPoolDelegationRewardsRequest_pool_id = md_QueryPoolDelegationRewardsRequest.Fields().ByName("pool_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolDelegationRewardsRequest)(nil)
+
+type fastReflection_QueryPoolDelegationRewardsRequest QueryPoolDelegationRewardsRequest
+
+func (x *QueryPoolDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolDelegationRewardsRequest)(x)
+}
+
+func (x *QueryPoolDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolDelegationRewardsRequest_messageType fastReflection_QueryPoolDelegationRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolDelegationRewardsRequest_messageType{}
+
+type fastReflection_QueryPoolDelegationRewardsRequest_messageType struct{}
+
+func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolDelegationRewardsRequest)(nil)
+}
+func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolDelegationRewardsRequest)
+}
+func (x fastReflection_QueryPoolDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolDelegationRewardsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolDelegationRewardsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolDelegationRewardsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolDelegationRewardsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolDelegationRewardsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryPoolDelegationRewardsRequest_delegator_address, value) {
+			return
+		}
+	}
+	if x.PoolId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PoolId)
+		if !f(fd_QueryPoolDelegationRewardsRequest_pool_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		return x.PoolId != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		x.PoolId = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		x.PoolId = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest.pool_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolDelegationRewardsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolDelegationRewardsResponse_1_list)(nil)
+
+type _QueryPoolDelegationRewardsResponse_1_list struct {
+	list *[]*DecPool
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolDelegationRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolDelegationRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryPoolDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryPoolDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryPoolDelegationRewardsResponse")
+	fd_QueryPoolDelegationRewardsResponse_rewards = md_QueryPoolDelegationRewardsResponse.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolDelegationRewardsResponse)(nil)
+
+type fastReflection_QueryPoolDelegationRewardsResponse QueryPoolDelegationRewardsResponse
+
+func (x *QueryPoolDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolDelegationRewardsResponse)(x)
+}
+
+func (x *QueryPoolDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolDelegationRewardsResponse_messageType fastReflection_QueryPoolDelegationRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolDelegationRewardsResponse_messageType{}
+
+type fastReflection_QueryPoolDelegationRewardsResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolDelegationRewardsResponse)(nil)
+}
+func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolDelegationRewardsResponse)
+}
+func (x fastReflection_QueryPoolDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolDelegationRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolDelegationRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolDelegationRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolDelegationRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolDelegationRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_QueryPoolDelegationRewardsResponse_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{})
+		}
+		listValue := &_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryPoolDelegationRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DecPool{}
+		}
+		value := &_QueryPoolDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_QueryPoolDelegationRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryPoolDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryPoolDelegationRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOperatorDelegationRewardsRequest                   protoreflect.MessageDescriptor
+	fd_QueryOperatorDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
+	fd_QueryOperatorDelegationRewardsRequest_operator_id       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryOperatorDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorDelegationRewardsRequest")
+	fd_QueryOperatorDelegationRewardsRequest_delegator_address = md_QueryOperatorDelegationRewardsRequest.Fields().ByName("delegator_address")
+	fd_QueryOperatorDelegationRewardsRequest_operator_id = md_QueryOperatorDelegationRewardsRequest.Fields().ByName("operator_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOperatorDelegationRewardsRequest)(nil)
+
+type fastReflection_QueryOperatorDelegationRewardsRequest QueryOperatorDelegationRewardsRequest
+
+func (x *QueryOperatorDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorDelegationRewardsRequest)(x)
+}
+
+func (x *QueryOperatorDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOperatorDelegationRewardsRequest_messageType fastReflection_QueryOperatorDelegationRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorDelegationRewardsRequest_messageType{}
+
+type fastReflection_QueryOperatorDelegationRewardsRequest_messageType struct{}
+
+func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorDelegationRewardsRequest)(nil)
+}
+func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorDelegationRewardsRequest)
+}
+func (x fastReflection_QueryOperatorDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorDelegationRewardsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorDelegationRewardsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorDelegationRewardsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorDelegationRewardsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorDelegationRewardsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryOperatorDelegationRewardsRequest_delegator_address, value) {
+			return
+		}
+	}
+	if x.OperatorId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OperatorId)
+		if !f(fd_QueryOperatorDelegationRewardsRequest_operator_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		return x.OperatorId != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		x.OperatorId = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		value := x.OperatorId
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		x.OperatorId = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		panic(fmt.Errorf("field operator_id of message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest.operator_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOperatorDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OperatorId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OperatorId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OperatorId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OperatorId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				}
+				x.OperatorId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OperatorId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryOperatorDelegationRewardsResponse_1_list)(nil)
+
+type _QueryOperatorDelegationRewardsResponse_1_list struct {
+	list *[]*DecPool
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOperatorDelegationRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryOperatorDelegationRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryOperatorDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryOperatorDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryOperatorDelegationRewardsResponse")
+	fd_QueryOperatorDelegationRewardsResponse_rewards = md_QueryOperatorDelegationRewardsResponse.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOperatorDelegationRewardsResponse)(nil)
+
+type fastReflection_QueryOperatorDelegationRewardsResponse QueryOperatorDelegationRewardsResponse
+
+func (x *QueryOperatorDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOperatorDelegationRewardsResponse)(x)
+}
+
+func (x *QueryOperatorDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOperatorDelegationRewardsResponse_messageType fastReflection_QueryOperatorDelegationRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOperatorDelegationRewardsResponse_messageType{}
+
+type fastReflection_QueryOperatorDelegationRewardsResponse_messageType struct{}
+
+func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOperatorDelegationRewardsResponse)(nil)
+}
+func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorDelegationRewardsResponse)
+}
+func (x fastReflection_QueryOperatorDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorDelegationRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOperatorDelegationRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOperatorDelegationRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOperatorDelegationRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOperatorDelegationRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_QueryOperatorDelegationRewardsResponse_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{})
+		}
+		listValue := &_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryOperatorDelegationRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DecPool{}
+		}
+		value := &_QueryOperatorDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_QueryOperatorDelegationRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOperatorDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOperatorDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOperatorDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryServiceDelegationRewardsRequest                   protoreflect.MessageDescriptor
+	fd_QueryServiceDelegationRewardsRequest_delegator_address protoreflect.FieldDescriptor
+	fd_QueryServiceDelegationRewardsRequest_service_id        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryServiceDelegationRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceDelegationRewardsRequest")
+	fd_QueryServiceDelegationRewardsRequest_delegator_address = md_QueryServiceDelegationRewardsRequest.Fields().ByName("delegator_address")
+	fd_QueryServiceDelegationRewardsRequest_service_id = md_QueryServiceDelegationRewardsRequest.Fields().ByName("service_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryServiceDelegationRewardsRequest)(nil)
+
+type fastReflection_QueryServiceDelegationRewardsRequest QueryServiceDelegationRewardsRequest
+
+func (x *QueryServiceDelegationRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceDelegationRewardsRequest)(x)
+}
+
+func (x *QueryServiceDelegationRewardsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryServiceDelegationRewardsRequest_messageType fastReflection_QueryServiceDelegationRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceDelegationRewardsRequest_messageType{}
+
+type fastReflection_QueryServiceDelegationRewardsRequest_messageType struct{}
+
+func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceDelegationRewardsRequest)(nil)
+}
+func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceDelegationRewardsRequest)
+}
+func (x fastReflection_QueryServiceDelegationRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceDelegationRewardsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceDelegationRewardsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceDelegationRewardsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceDelegationRewardsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceDelegationRewardsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryServiceDelegationRewardsRequest_delegator_address, value) {
+			return
+		}
+	}
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_QueryServiceDelegationRewardsRequest_service_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		return x.ServiceId != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		x.ServiceId = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		x.ServiceId = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest is not mutable"))
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceDelegationRewardsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryServiceDelegationRewardsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryServiceDelegationRewardsResponse_1_list)(nil)
+
+type _QueryServiceDelegationRewardsResponse_1_list struct {
+	list *[]*DecPool
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryServiceDelegationRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryServiceDelegationRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryServiceDelegationRewardsResponse_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryServiceDelegationRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryServiceDelegationRewardsResponse")
+	fd_QueryServiceDelegationRewardsResponse_rewards = md_QueryServiceDelegationRewardsResponse.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryServiceDelegationRewardsResponse)(nil)
+
+type fastReflection_QueryServiceDelegationRewardsResponse QueryServiceDelegationRewardsResponse
+
+func (x *QueryServiceDelegationRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryServiceDelegationRewardsResponse)(x)
+}
+
+func (x *QueryServiceDelegationRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryServiceDelegationRewardsResponse_messageType fastReflection_QueryServiceDelegationRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryServiceDelegationRewardsResponse_messageType{}
+
+type fastReflection_QueryServiceDelegationRewardsResponse_messageType struct{}
+
+func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryServiceDelegationRewardsResponse)(nil)
+}
+func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceDelegationRewardsResponse)
+}
+func (x fastReflection_QueryServiceDelegationRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceDelegationRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryServiceDelegationRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryServiceDelegationRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryServiceDelegationRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryServiceDelegationRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_QueryServiceDelegationRewardsResponse_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{})
+		}
+		listValue := &_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryServiceDelegationRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DecPool{}
+		}
+		value := &_QueryServiceDelegationRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_QueryServiceDelegationRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryServiceDelegationRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryServiceDelegationRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryServiceDelegationRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryServiceDelegationRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryServiceDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegatorTotalRewardsRequest                   protoreflect.MessageDescriptor
+	fd_QueryDelegatorTotalRewardsRequest_delegator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorTotalRewardsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorTotalRewardsRequest")
+	fd_QueryDelegatorTotalRewardsRequest_delegator_address = md_QueryDelegatorTotalRewardsRequest.Fields().ByName("delegator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorTotalRewardsRequest)(nil)
+
+type fastReflection_QueryDelegatorTotalRewardsRequest QueryDelegatorTotalRewardsRequest
+
+func (x *QueryDelegatorTotalRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorTotalRewardsRequest)(x)
+}
+
+func (x *QueryDelegatorTotalRewardsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorTotalRewardsRequest_messageType fastReflection_QueryDelegatorTotalRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorTotalRewardsRequest_messageType{}
+
+type fastReflection_QueryDelegatorTotalRewardsRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorTotalRewardsRequest)(nil)
+}
+func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorTotalRewardsRequest)
+}
+func (x fastReflection_QueryDelegatorTotalRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorTotalRewardsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorTotalRewardsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorTotalRewardsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorTotalRewardsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorTotalRewardsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryDelegatorTotalRewardsRequest_delegator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		x.DelegatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorTotalRewardsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDelegatorTotalRewardsResponse_1_list)(nil)
+
+type _QueryDelegatorTotalRewardsResponse_1_list struct {
+	list *[]*DelegationDelegatorReward
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationDelegatorReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationDelegatorReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(DelegationDelegatorReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(DelegationDelegatorReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryDelegatorTotalRewardsResponse_2_list)(nil)
+
+type _QueryDelegatorTotalRewardsResponse_2_list struct {
+	list *[]*DecPool
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DecPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(DecPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(DecPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorTotalRewardsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDelegatorTotalRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryDelegatorTotalRewardsResponse_rewards protoreflect.FieldDescriptor
+	fd_QueryDelegatorTotalRewardsResponse_total   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorTotalRewardsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorTotalRewardsResponse")
+	fd_QueryDelegatorTotalRewardsResponse_rewards = md_QueryDelegatorTotalRewardsResponse.Fields().ByName("rewards")
+	fd_QueryDelegatorTotalRewardsResponse_total = md_QueryDelegatorTotalRewardsResponse.Fields().ByName("total")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorTotalRewardsResponse)(nil)
+
+type fastReflection_QueryDelegatorTotalRewardsResponse QueryDelegatorTotalRewardsResponse
+
+func (x *QueryDelegatorTotalRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorTotalRewardsResponse)(x)
+}
+
+func (x *QueryDelegatorTotalRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorTotalRewardsResponse_messageType fastReflection_QueryDelegatorTotalRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorTotalRewardsResponse_messageType{}
+
+type fastReflection_QueryDelegatorTotalRewardsResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorTotalRewardsResponse)(nil)
+}
+func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorTotalRewardsResponse)
+}
+func (x fastReflection_QueryDelegatorTotalRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorTotalRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorTotalRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorTotalRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorTotalRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorTotalRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_QueryDelegatorTotalRewardsResponse_rewards, value) {
+			return
+		}
+	}
+	if len(x.Total) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total})
+		if !f(fd_QueryDelegatorTotalRewardsResponse_total, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		return len(x.Total) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		x.Rewards = nil
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		x.Total = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{})
+		}
+		listValue := &_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		if len(x.Total) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{})
+		}
+		listValue := &_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryDelegatorTotalRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		lv := value.List()
+		clv := lv.(*_QueryDelegatorTotalRewardsResponse_2_list)
+		x.Total = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*DelegationDelegatorReward{}
+		}
+		value := &_QueryDelegatorTotalRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		if x.Total == nil {
+			x.Total = []*DecPool{}
+		}
+		value := &_QueryDelegatorTotalRewardsResponse_2_list{list: &x.Total}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards":
+		list := []*DelegationDelegatorReward{}
+		return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total":
+		list := []*DecPool{}
+		return protoreflect.ValueOfList(&_QueryDelegatorTotalRewardsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorTotalRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Total) > 0 {
+			for _, e := range x.Total {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Total) > 0 {
+			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Total[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorTotalRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &DelegationDelegatorReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = append(x.Total, &DecPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegatorWithdrawAddressRequest                   protoreflect.MessageDescriptor
+	fd_QueryDelegatorWithdrawAddressRequest_delegator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorWithdrawAddressRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorWithdrawAddressRequest")
+	fd_QueryDelegatorWithdrawAddressRequest_delegator_address = md_QueryDelegatorWithdrawAddressRequest.Fields().ByName("delegator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorWithdrawAddressRequest)(nil)
+
+type fastReflection_QueryDelegatorWithdrawAddressRequest QueryDelegatorWithdrawAddressRequest
+
+func (x *QueryDelegatorWithdrawAddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorWithdrawAddressRequest)(x)
+}
+
+func (x *QueryDelegatorWithdrawAddressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorWithdrawAddressRequest_messageType fastReflection_QueryDelegatorWithdrawAddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorWithdrawAddressRequest_messageType{}
+
+type fastReflection_QueryDelegatorWithdrawAddressRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorWithdrawAddressRequest)(nil)
+}
+func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorWithdrawAddressRequest)
+}
+func (x fastReflection_QueryDelegatorWithdrawAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorWithdrawAddressRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorWithdrawAddressRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorWithdrawAddressRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorWithdrawAddressRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorWithdrawAddressRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryDelegatorWithdrawAddressRequest_delegator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		x.DelegatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorWithdrawAddressRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegatorWithdrawAddressResponse                  protoreflect.MessageDescriptor
+	fd_QueryDelegatorWithdrawAddressResponse_withdraw_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorWithdrawAddressResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorWithdrawAddressResponse")
+	fd_QueryDelegatorWithdrawAddressResponse_withdraw_address = md_QueryDelegatorWithdrawAddressResponse.Fields().ByName("withdraw_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorWithdrawAddressResponse)(nil)
+
+type fastReflection_QueryDelegatorWithdrawAddressResponse QueryDelegatorWithdrawAddressResponse
+
+func (x *QueryDelegatorWithdrawAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorWithdrawAddressResponse)(x)
+}
+
+func (x *QueryDelegatorWithdrawAddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorWithdrawAddressResponse_messageType fastReflection_QueryDelegatorWithdrawAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorWithdrawAddressResponse_messageType{}
+
+type fastReflection_QueryDelegatorWithdrawAddressResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorWithdrawAddressResponse)(nil)
+}
+func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorWithdrawAddressResponse)
+}
+func (x fastReflection_QueryDelegatorWithdrawAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorWithdrawAddressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorWithdrawAddressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorWithdrawAddressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorWithdrawAddressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorWithdrawAddressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WithdrawAddress != "" {
+		value := protoreflect.ValueOfString(x.WithdrawAddress)
+		if !f(fd_QueryDelegatorWithdrawAddressResponse_withdraw_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		return x.WithdrawAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		x.WithdrawAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		value := x.WithdrawAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		x.WithdrawAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		panic(fmt.Errorf("field withdraw_address of message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse.withdraw_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorWithdrawAddressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WithdrawAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WithdrawAddress) > 0 {
+			i -= len(x.WithdrawAddress)
+			copy(dAtA[i:], x.WithdrawAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorWithdrawAddressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegatorAutoCompoundSettingsRequest                   protoreflect.MessageDescriptor
+	fd_QueryDelegatorAutoCompoundSettingsRequest_delegator_address protoreflect.FieldDescriptor
+	fd_QueryDelegatorAutoCompoundSettingsRequest_pagination        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorAutoCompoundSettingsRequest = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorAutoCompoundSettingsRequest")
+	fd_QueryDelegatorAutoCompoundSettingsRequest_delegator_address = md_QueryDelegatorAutoCompoundSettingsRequest.Fields().ByName("delegator_address")
+	fd_QueryDelegatorAutoCompoundSettingsRequest_pagination = md_QueryDelegatorAutoCompoundSettingsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorAutoCompoundSettingsRequest)(nil)
+
+type fastReflection_QueryDelegatorAutoCompoundSettingsRequest QueryDelegatorAutoCompoundSettingsRequest
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorAutoCompoundSettingsRequest)(x)
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType{}
+
+type fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorAutoCompoundSettingsRequest)(nil)
+}
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorAutoCompoundSettingsRequest)
+}
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorAutoCompoundSettingsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorAutoCompoundSettingsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorAutoCompoundSettingsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorAutoCompoundSettingsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorAutoCompoundSettingsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_QueryDelegatorAutoCompoundSettingsRequest_delegator_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDelegatorAutoCompoundSettingsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		return x.DelegatorAddress != ""
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		x.DelegatorAddress = ""
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDelegatorAutoCompoundSettingsResponse_1_list)(nil)
+
+type _QueryDelegatorAutoCompoundSettingsResponse_1_list struct {
+	list *[]*AutoCompoundSetting
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoCompoundSetting)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoCompoundSetting)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AutoCompoundSetting)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AutoCompoundSetting)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDelegatorAutoCompoundSettingsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDelegatorAutoCompoundSettingsResponse                        protoreflect.MessageDescriptor
+	fd_QueryDelegatorAutoCompoundSettingsResponse_auto_compound_settings protoreflect.FieldDescriptor
+	fd_QueryDelegatorAutoCompoundSettingsResponse_pagination             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_rewards_v1_query_proto_init()
+	md_QueryDelegatorAutoCompoundSettingsResponse = File_milkyway_rewards_v1_query_proto.Messages().ByName("QueryDelegatorAutoCompoundSettingsResponse")
+	fd_QueryDelegatorAutoCompoundSettingsResponse_auto_compound_settings = md_QueryDelegatorAutoCompoundSettingsResponse.Fields().ByName("auto_compound_settings")
+	fd_QueryDelegatorAutoCompoundSettingsResponse_pagination = md_QueryDelegatorAutoCompoundSettingsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatorAutoCompoundSettingsResponse)(nil)
+
+type fastReflection_QueryDelegatorAutoCompoundSettingsResponse QueryDelegatorAutoCompoundSettingsResponse
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorAutoCompoundSettingsResponse)(x)
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_rewards_v1_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType{}
+
+type fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatorAutoCompoundSettingsResponse)(nil)
+}
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorAutoCompoundSettingsResponse)
+}
+func (x fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorAutoCompoundSettingsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatorAutoCompoundSettingsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatorAutoCompoundSettingsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatorAutoCompoundSettingsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatorAutoCompoundSettingsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AutoCompoundSettings) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegatorAutoCompoundSettingsResponse_1_list{list: &x.AutoCompoundSettings})
+		if !f(fd_QueryDelegatorAutoCompoundSettingsResponse_auto_compound_settings, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDelegatorAutoCompoundSettingsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		return len(x.AutoCompoundSettings) != 0
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		x.AutoCompoundSettings = nil
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		if len(x.AutoCompoundSettings) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegatorAutoCompoundSettingsResponse_1_list{})
+		}
+		listValue := &_QueryDelegatorAutoCompoundSettingsResponse_1_list{list: &x.AutoCompoundSettings}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		lv := value.List()
+		clv := lv.(*_QueryDelegatorAutoCompoundSettingsResponse_1_list)
+		x.AutoCompoundSettings = *clv.list
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		if x.AutoCompoundSettings == nil {
+			x.AutoCompoundSettings = []*AutoCompoundSetting{}
+		}
+		value := &_QueryDelegatorAutoCompoundSettingsResponse_1_list{list: &x.AutoCompoundSettings}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings":
+		list := []*AutoCompoundSetting{}
+		return protoreflect.ValueOfList(&_QueryDelegatorAutoCompoundSettingsResponse_1_list{list: &list})
+	case "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse"))
+		}
+		panic(fmt.Errorf("message milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatorAutoCompoundSettingsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AutoCompoundSettings) > 0 {
+			for _, e := range x.AutoCompoundSettings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AutoCompoundSettings) > 0 {
+			for iNdEx := len(x.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoCompoundSettings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatorAutoCompoundSettingsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AutoCompoundSettings = append(x.AutoCompoundSettings, &AutoCompoundSetting{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoCompoundSettings[len(x.AutoCompoundSettings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: milkyway/rewards/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryRewardsPlansRequest is the request type for the Query/RewardsPlans RPC
+// method.
+type QueryRewardsPlansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsPlansRequest) Reset() {
+	*x = QueryRewardsPlansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsPlansRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsPlansRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPlansRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryRewardsPlansRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsPlansResponse is the response type for the Query/RewardsPlans
+// RPC method.
+type QueryRewardsPlansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardsPlans []*RewardsPlan `protobuf:"bytes,1,rep,name=rewards_plans,json=rewardsPlans,proto3" json:"rewards_plans,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsPlansResponse) Reset() {
+	*x = QueryRewardsPlansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsPlansResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsPlansResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPlansResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryRewardsPlansResponse) GetRewardsPlans() []*RewardsPlan {
+	if x != nil {
+		return x.RewardsPlans
+	}
+	return nil
+}
+
+func (x *QueryRewardsPlansResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsPlanRequest is the request type for the Query/RewardsPlan RPC
+// method.
+type QueryRewardsPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (x *QueryRewardsPlanRequest) Reset() {
+	*x = QueryRewardsPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsPlanRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsPlanRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPlanRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryRewardsPlanRequest) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+// QueryRewardsPlanResponse is the response type for the Query/RewardsPlan RPC
+// method.
+type QueryRewardsPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardsPlan *RewardsPlan `protobuf:"bytes,2,opt,name=rewards_plan,json=rewardsPlan,proto3" json:"rewards_plan,omitempty"`
+}
+
+func (x *QueryRewardsPlanResponse) Reset() {
+	*x = QueryRewardsPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsPlanResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsPlanResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPlanResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryRewardsPlanResponse) GetRewardsPlan() *RewardsPlan {
+	if x != nil {
+		return x.RewardsPlan
+	}
+	return nil
+}
+
+// QueryArchivedRewardsPlansRequest is the request type for the
+// Query/ArchivedRewardsPlans RPC method.
+type QueryArchivedRewardsPlansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryArchivedRewardsPlansRequest) Reset() {
+	*x = QueryArchivedRewardsPlansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryArchivedRewardsPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryArchivedRewardsPlansRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryArchivedRewardsPlansRequest.ProtoReflect.Descriptor instead.
+func (*QueryArchivedRewardsPlansRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryArchivedRewardsPlansRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryArchivedRewardsPlansResponse is the response type for the
+// Query/ArchivedRewardsPlans RPC method.
+type QueryArchivedRewardsPlansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArchivedRewardsPlans []*ArchivedRewardsPlan `protobuf:"bytes,1,rep,name=archived_rewards_plans,json=archivedRewardsPlans,proto3" json:"archived_rewards_plans,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryArchivedRewardsPlansResponse) Reset() {
+	*x = QueryArchivedRewardsPlansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryArchivedRewardsPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryArchivedRewardsPlansResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryArchivedRewardsPlansResponse.ProtoReflect.Descriptor instead.
+func (*QueryArchivedRewardsPlansResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryArchivedRewardsPlansResponse) GetArchivedRewardsPlans() []*ArchivedRewardsPlan {
+	if x != nil {
+		return x.ArchivedRewardsPlans
+	}
+	return nil
+}
+
+func (x *QueryArchivedRewardsPlansResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryArchivedRewardsPlanRequest is the request type for the
+// Query/ArchivedRewardsPlan RPC method.
+type QueryArchivedRewardsPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (x *QueryArchivedRewardsPlanRequest) Reset() {
+	*x = QueryArchivedRewardsPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryArchivedRewardsPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryArchivedRewardsPlanRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryArchivedRewardsPlanRequest.ProtoReflect.Descriptor instead.
+func (*QueryArchivedRewardsPlanRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryArchivedRewardsPlanRequest) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+// QueryArchivedRewardsPlanResponse is the response type for the
+// Query/ArchivedRewardsPlan RPC method.
+type QueryArchivedRewardsPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArchivedRewardsPlan *ArchivedRewardsPlan `protobuf:"bytes,1,opt,name=archived_rewards_plan,json=archivedRewardsPlan,proto3" json:"archived_rewards_plan,omitempty"`
+}
+
+func (x *QueryArchivedRewardsPlanResponse) Reset() {
+	*x = QueryArchivedRewardsPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryArchivedRewardsPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryArchivedRewardsPlanResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryArchivedRewardsPlanResponse.ProtoReflect.Descriptor instead.
+func (*QueryArchivedRewardsPlanResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryArchivedRewardsPlanResponse) GetArchivedRewardsPlan() *ArchivedRewardsPlan {
+	if x != nil {
+		return x.ArchivedRewardsPlan
+	}
+	return nil
+}
+
+// QueryPoolOutstandingRewardsRequest is the request type for the
+// Query/PoolOutstandingRewards RPC method.
+type QueryPoolOutstandingRewardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pool_id defines the pool ID to query for.
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (x *QueryPoolOutstandingRewardsRequest) Reset() {
+	*x = QueryPoolOutstandingRewardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolOutstandingRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolOutstandingRewardsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryPoolOutstandingRewardsRequest) GetPoolId() uint32 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+// QueryPoolOutstandingRewardsResponse is the response type for the
+// Query/PoolOutstandingRewards RPC method.
+type QueryPoolOutstandingRewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *QueryPoolOutstandingRewardsResponse) Reset() {
+	*x = QueryPoolOutstandingRewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolOutstandingRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolOutstandingRewardsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryPoolOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// QueryOperatorOutstandingRewardsRequest is the request type for the
+// Query/OperatorOutstandingRewards RPC method.
+type QueryOperatorOutstandingRewardsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// operator_id defines the operator ID to query for.
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryOperatorOutstandingRewardsRequest) Reset() {
+	*x = QueryOperatorOutstandingRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[0]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryOperatorOutstandingRewardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryOperatorOutstandingRewardsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryOperatorOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOperatorOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryOperatorOutstandingRewardsRequest) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// QueryOperatorOutstandingRewardsResponse is the response type for the
+// Query/OperatorOutstandingRewards RPC method.
+type QueryOperatorOutstandingRewardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryOperatorOutstandingRewardsResponse) Reset() {
+	*x = QueryOperatorOutstandingRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[1]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryOperatorOutstandingRewardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryOperatorOutstandingRewardsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryOperatorOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOperatorOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryOperatorOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
 	if x != nil {
-		return x.Params
+		return x.Rewards
 	}
 	return nil
 }
 
-// QueryRewardsPlansRequest is the request type for the Query/RewardsPlans RPC
-// method.
-type QueryRewardsPlansRequest struct {
+// QueryServiceOutstandingRewardsRequest is the request type for the
+// Query/ServiceOutstandingRewards RPC method.
+type QueryServiceOutstandingRewardsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// service_id defines the service ID to query for.
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *QueryRewardsPlansRequest) Reset() {
-	*x = QueryRewardsPlansRequest{}
+func (x *QueryServiceOutstandingRewardsRequest) Reset() {
+	*x = QueryServiceOutstandingRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[2]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsPlansRequest) String() string {
+func (x *QueryServiceOutstandingRewardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsPlansRequest) ProtoMessage() {}
+func (*QueryServiceOutstandingRewardsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRewardsPlansRequest.ProtoReflect.Descriptor instead.
-func (*QueryRewardsPlansRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryServiceOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
+func (*QueryServiceOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryRewardsPlansRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryServiceOutstandingRewardsRequest) GetServiceId() uint32 {
 	if x != nil {
-		return x.Pagination
+		return x.ServiceId
 	}
-	return nil
+	return 0
 }
 
-// QueryRewardsPlansResponse is the response type for the Query/RewardsPlans
-// RPC method.
-type QueryRewardsPlansResponse struct {
+// QueryServiceOutstandingRewardsResponse is the response type for the
+// Query/ServiceOutstandingRewards RPC method.
+type QueryServiceOutstandingRewardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardsPlans []*RewardsPlan `protobuf:"bytes,1,rep,name=rewards_plans,json=rewardsPlans,proto3" json:"rewards_plans,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *QueryRewardsPlansResponse) Reset() {
-	*x = QueryRewardsPlansResponse{}
+func (x *QueryServiceOutstandingRewardsResponse) Reset() {
+	*x = QueryServiceOutstandingRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[3]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsPlansResponse) String() string {
+func (x *QueryServiceOutstandingRewardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsPlansResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryRewardsPlansResponse.ProtoReflect.Descriptor instead.
-func (*QueryRewardsPlansResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{3}
-}
+func (*QueryServiceOutstandingRewardsResponse) ProtoMessage() {}
 
-func (x *QueryRewardsPlansResponse) GetRewardsPlans() []*RewardsPlan {
-	if x != nil {
-		return x.RewardsPlans
-	}
-	return nil
+// Deprecated: Use QueryServiceOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
+func (*QueryServiceOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryRewardsPlansResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryServiceOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
 	if x != nil {
-		return x.Pagination
+		return x.Rewards
 	}
 	return nil
 }
 
-// QueryRewardsPlanRequest is the request type for the Query/RewardsPlan RPC
-// method.
-type QueryRewardsPlanRequest struct {
+// QueryOperatorCommissionRequest is the request type for the
+// Query/OperatorCommission RPC method
+type QueryOperatorCommissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// operator_id defines the validator address to query for.
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (x *QueryRewardsPlanRequest) Reset() {
-	*x = QueryRewardsPlanRequest{}
+func (x *QueryOperatorCommissionRequest) Reset() {
+	*x = QueryOperatorCommissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[4]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsPlanRequest) String() string {
+func (x *QueryOperatorCommissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsPlanRequest) ProtoMessage() {}
+func (*QueryOperatorCommissionRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRewardsPlanRequest.ProtoReflect.Descriptor instead.
-func (*QueryRewardsPlanRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryOperatorCommissionRequest.ProtoReflect.Descriptor instead.
+func (*QueryOperatorCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryRewardsPlanRequest) GetPlanId() uint64 {
+func (x *QueryOperatorCommissionRequest) GetOperatorId() uint32 {
 	if x != nil {
-		return x.PlanId
+		return x.OperatorId
 	}
 	return 0
 }
 
-// QueryRewardsPlanResponse is the response type for the Query/RewardsPlan RPC
-// method.
-type QueryRewardsPlanResponse struct {
+// QueryOperatorCommissionResponse is the response type for the
+// Query/OperatorCommission RPC method
+type QueryOperatorCommissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardsPlan *RewardsPlan `protobuf:"bytes,2,opt,name=rewards_plan,json=rewardsPlan,proto3" json:"rewards_plan,omitempty"`
+	// commission defines the commission the operator received.
+	Commission *AccumulatedCommission `protobuf:"bytes,1,opt,name=commission,proto3" json:"commission,omitempty"`
 }
 
-func (x *QueryRewardsPlanResponse) Reset() {
-	*x = QueryRewardsPlanResponse{}
+func (x *QueryOperatorCommissionResponse) Reset() {
+	*x = QueryOperatorCommissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[5]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsPlanResponse) String() string {
+func (x *QueryOperatorCommissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsPlanResponse) ProtoMessage() {}
+func (*QueryOperatorCommissionResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRewardsPlanResponse.ProtoReflect.Descriptor instead.
-func (*QueryRewardsPlanResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryOperatorCommissionResponse.ProtoReflect.Descriptor instead.
+func (*QueryOperatorCommissionResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryRewardsPlanResponse) GetRewardsPlan() *RewardsPlan {
+func (x *QueryOperatorCommissionResponse) GetCommission() *AccumulatedCommission {
 	if x != nil {
-		return x.RewardsPlan
+		return x.Commission
 	}
 	return nil
 }
 
-// QueryPoolOutstandingRewardsRequest is the request type for the
-// Query/PoolOutstandingRewards RPC method.
-type QueryPoolOutstandingRewardsRequest struct {
+// QueryPoolServiceTotalDelegatorSharesRequest is the request type for the
+// Query/PoolServiceTotalDelegatorShares RPC method.
+type QueryPoolServiceTotalDelegatorSharesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// pool_id defines the pool ID to query for.
 	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// service_id defines the service ID to query for.
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *QueryPoolOutstandingRewardsRequest) Reset() {
-	*x = QueryPoolOutstandingRewardsRequest{}
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) Reset() {
+	*x = QueryPoolServiceTotalDelegatorSharesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[6]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolOutstandingRewardsRequest) String() string {
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolOutstandingRewardsRequest) ProtoMessage() {}
+func (*QueryPoolServiceTotalDelegatorSharesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPoolOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryPoolServiceTotalDelegatorSharesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolServiceTotalDelegatorSharesRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryPoolOutstandingRewardsRequest) GetPoolId() uint32 {
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) GetPoolId() uint32 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-// QueryPoolOutstandingRewardsResponse is the response type for the
-// Query/PoolOutstandingRewards RPC method.
-type QueryPoolOutstandingRewardsResponse struct {
+func (x *QueryPoolServiceTotalDelegatorSharesRequest) GetServiceId() uint32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+// QueryPoolServiceTotalDelegatorSharesResponse is the response type for the
+// Query/PoolServiceTotalDelegatorShares RPC method.
+type QueryPoolServiceTotalDelegatorSharesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// total_delegator_shares defines the total delegator shares for a
+	// pool-service pair.
+	Shares []*v1beta11.DecCoin `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (x *QueryPoolOutstandingRewardsResponse) Reset() {
-	*x = QueryPoolOutstandingRewardsResponse{}
+func (x *QueryPoolServiceTotalDelegatorSharesResponse) Reset() {
+	*x = QueryPoolServiceTotalDelegatorSharesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[7]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolOutstandingRewardsResponse) String() string {
+func (x *QueryPoolServiceTotalDelegatorSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolOutstandingRewardsResponse) ProtoMessage() {}
+func (*QueryPoolServiceTotalDelegatorSharesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPoolOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryPoolServiceTotalDelegatorSharesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolServiceTotalDelegatorSharesResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryPoolOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
+func (x *QueryPoolServiceTotalDelegatorSharesResponse) GetShares() []*v1beta11.DecCoin {
 	if x != nil {
-		return x.Rewards
+		return x.Shares
 	}
 	return nil
 }
 
-// QueryOperatorOutstandingRewardsRequest is the request type for the
-// Query/OperatorOutstandingRewards RPC method.
-type QueryOperatorOutstandingRewardsRequest struct {
+// QueryPoolAPRRequest is the request type for the Query/PoolAPR RPC
+// method.
+type QueryPoolAPRRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// operator_id defines the operator ID to query for.
-	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// pool_id defines the pool ID to query for.
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (x *QueryOperatorOutstandingRewardsRequest) Reset() {
-	*x = QueryOperatorOutstandingRewardsRequest{}
+func (x *QueryPoolAPRRequest) Reset() {
+	*x = QueryPoolAPRRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[8]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOperatorOutstandingRewardsRequest) String() string {
+func (x *QueryPoolAPRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOperatorOutstandingRewardsRequest) ProtoMessage() {}
+func (*QueryPoolAPRRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOperatorOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
-func (*QueryOperatorOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryPoolAPRRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolAPRRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryOperatorOutstandingRewardsRequest) GetOperatorId() uint32 {
+func (x *QueryPoolAPRRequest) GetPoolId() uint32 {
 	if x != nil {
-		return x.OperatorId
+		return x.PoolId
 	}
 	return 0
 }
 
-// QueryOperatorOutstandingRewardsResponse is the response type for the
-// Query/OperatorOutstandingRewards RPC method.
-type QueryOperatorOutstandingRewardsResponse struct {
+// QueryPoolAPRResponse is the response type for the Query/PoolAPR RPC
+// method.
+type QueryPoolAPRResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// apr defines the total projected annualised yield.
+	Apr string `protobuf:"bytes,1,opt,name=apr,proto3" json:"apr,omitempty"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []*PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
 }
 
-func (x *QueryOperatorOutstandingRewardsResponse) Reset() {
-	*x = QueryOperatorOutstandingRewardsResponse{}
+func (x *QueryPoolAPRResponse) Reset() {
+	*x = QueryPoolAPRResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[9]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOperatorOutstandingRewardsResponse) String() string {
+func (x *QueryPoolAPRResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOperatorOutstandingRewardsResponse) ProtoMessage() {}
+func (*QueryPoolAPRResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOperatorOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
-func (*QueryOperatorOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryPoolAPRResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolAPRResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryOperatorOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
+func (x *QueryPoolAPRResponse) GetApr() string {
 	if x != nil {
-		return x.Rewards
+		return x.Apr
+	}
+	return ""
+}
+
+func (x *QueryPoolAPRResponse) GetPlans() []*PlanAPR {
+	if x != nil {
+		return x.Plans
 	}
 	return nil
 }
 
-// QueryServiceOutstandingRewardsRequest is the request type for the
-// Query/ServiceOutstandingRewards RPC method.
-type QueryServiceOutstandingRewardsRequest struct {
+// QueryOperatorAPRRequest is the request type for the Query/OperatorAPR RPC
+// method.
+type QueryOperatorAPRRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// service_id defines the service ID to query for.
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// operator_id defines the operator ID to query for.
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (x *QueryServiceOutstandingRewardsRequest) Reset() {
-	*x = QueryServiceOutstandingRewardsRequest{}
+func (x *QueryOperatorAPRRequest) Reset() {
+	*x = QueryOperatorAPRRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[10]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryServiceOutstandingRewardsRequest) String() string {
+func (x *QueryOperatorAPRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryServiceOutstandingRewardsRequest) ProtoMessage() {}
+func (*QueryOperatorAPRRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryServiceOutstandingRewardsRequest.ProtoReflect.Descriptor instead.
-func (*QueryServiceOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryOperatorAPRRequest.ProtoReflect.Descriptor instead.
+func (*QueryOperatorAPRRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryServiceOutstandingRewardsRequest) GetServiceId() uint32 {
+func (x *QueryOperatorAPRRequest) GetOperatorId() uint32 {
 	if x != nil {
-		return x.ServiceId
+		return x.OperatorId
 	}
 	return 0
 }
 
-// QueryServiceOutstandingRewardsResponse is the response type for the
-// Query/ServiceOutstandingRewards RPC method.
-type QueryServiceOutstandingRewardsResponse struct {
+// QueryOperatorAPRResponse is the response type for the Query/OperatorAPR RPC
+// method.
+type QueryOperatorAPRResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards *OutstandingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// apr defines the total projected annualised yield.
+	Apr string `protobuf:"bytes,1,opt,name=apr,proto3" json:"apr,omitempty"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []*PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
 }
 
-func (x *QueryServiceOutstandingRewardsResponse) Reset() {
-	*x = QueryServiceOutstandingRewardsResponse{}
+func (x *QueryOperatorAPRResponse) Reset() {
+	*x = QueryOperatorAPRResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[11]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryServiceOutstandingRewardsResponse) String() string {
+func (x *QueryOperatorAPRResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryServiceOutstandingRewardsResponse) ProtoMessage() {}
+func (*QueryOperatorAPRResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryServiceOutstandingRewardsResponse.ProtoReflect.Descriptor instead.
-func (*QueryServiceOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryOperatorAPRResponse.ProtoReflect.Descriptor instead.
+func (*QueryOperatorAPRResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryServiceOutstandingRewardsResponse) GetRewards() *OutstandingRewards {
+func (x *QueryOperatorAPRResponse) GetApr() string {
 	if x != nil {
-		return x.Rewards
+		return x.Apr
+	}
+	return ""
+}
+
+func (x *QueryOperatorAPRResponse) GetPlans() []*PlanAPR {
+	if x != nil {
+		return x.Plans
 	}
 	return nil
 }
 
-// QueryOperatorCommissionRequest is the request type for the
-// Query/OperatorCommission RPC method
-type QueryOperatorCommissionRequest struct {
+// QueryServiceAPRRequest is the request type for the Query/ServiceAPR RPC
+// method.
+type QueryServiceAPRRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// operator_id defines the validator address to query for.
-	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// service_id defines the service ID to query for.
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *QueryOperatorCommissionRequest) Reset() {
-	*x = QueryOperatorCommissionRequest{}
+func (x *QueryServiceAPRRequest) Reset() {
+	*x = QueryServiceAPRRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[12]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOperatorCommissionRequest) String() string {
+func (x *QueryServiceAPRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOperatorCommissionRequest) ProtoMessage() {}
+func (*QueryServiceAPRRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOperatorCommissionRequest.ProtoReflect.Descriptor instead.
-func (*QueryOperatorCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryServiceAPRRequest.ProtoReflect.Descriptor instead.
+func (*QueryServiceAPRRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryOperatorCommissionRequest) GetOperatorId() uint32 {
+func (x *QueryServiceAPRRequest) GetServiceId() uint32 {
 	if x != nil {
-		return x.OperatorId
+		return x.ServiceId
 	}
 	return 0
 }
 
-// QueryOperatorCommissionResponse is the response type for the
-// Query/OperatorCommission RPC method
-type QueryOperatorCommissionResponse struct {
+// QueryServiceAPRResponse is the response type for the Query/ServiceAPR RPC
+// method.
+type QueryServiceAPRResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// commission defines the commission the operator received.
-	Commission *AccumulatedCommission `protobuf:"bytes,1,opt,name=commission,proto3" json:"commission,omitempty"`
+	// apr defines the total projected annualised yield.
+	Apr string `protobuf:"bytes,1,opt,name=apr,proto3" json:"apr,omitempty"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []*PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
 }
 
-func (x *QueryOperatorCommissionResponse) Reset() {
-	*x = QueryOperatorCommissionResponse{}
+func (x *QueryServiceAPRResponse) Reset() {
+	*x = QueryServiceAPRResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[13]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOperatorCommissionResponse) String() string {
+func (x *QueryServiceAPRResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOperatorCommissionResponse) ProtoMessage() {}
+func (*QueryServiceAPRResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOperatorCommissionResponse.ProtoReflect.Descriptor instead.
-func (*QueryOperatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryServiceAPRResponse.ProtoReflect.Descriptor instead.
+func (*QueryServiceAPRResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryOperatorCommissionResponse) GetCommission() *AccumulatedCommission {
+func (x *QueryServiceAPRResponse) GetApr() string {
 	if x != nil {
-		return x.Commission
+		return x.Apr
+	}
+	return ""
+}
+
+func (x *QueryServiceAPRResponse) GetPlans() []*PlanAPR {
+	if x != nil {
+		return x.Plans
 	}
 	return nil
 }
 
-// QueryPoolServiceTotalDelegatorSharesRequest is the request type for the
-// Query/PoolServiceTotalDelegatorShares RPC method.
-type QueryPoolServiceTotalDelegatorSharesRequest struct {
+// QueryPoolServiceAPRRequest is the request type for the Query/PoolServiceAPR RPC
+// method.
+type QueryPoolServiceAPRRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12284,75 +19598,83 @@ type QueryPoolServiceTotalDelegatorSharesRequest struct {
 	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) Reset() {
-	*x = QueryPoolServiceTotalDelegatorSharesRequest{}
+func (x *QueryPoolServiceAPRRequest) Reset() {
+	*x = QueryPoolServiceAPRRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[14]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) String() string {
+func (x *QueryPoolServiceAPRRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolServiceTotalDelegatorSharesRequest) ProtoMessage() {}
+func (*QueryPoolServiceAPRRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolServiceTotalDelegatorSharesRequest.ProtoReflect.Descriptor instead.
-func (*QueryPoolServiceTotalDelegatorSharesRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryPoolServiceAPRRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolServiceAPRRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) GetPoolId() uint32 {
+func (x *QueryPoolServiceAPRRequest) GetPoolId() uint32 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesRequest) GetServiceId() uint32 {
+func (x *QueryPoolServiceAPRRequest) GetServiceId() uint32 {
 	if x != nil {
 		return x.ServiceId
 	}
 	return 0
 }
 
-// QueryPoolServiceTotalDelegatorSharesResponse is the response type for the
-// Query/PoolServiceTotalDelegatorShares RPC method.
-type QueryPoolServiceTotalDelegatorSharesResponse struct {
+// QueryPoolServiceAPRResponse is the response type for the Query/PoolServiceAPR RPC
+// method.
+type QueryPoolServiceAPRResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// total_delegator_shares defines the total delegator shares for a
-	// pool-service pair.
-	Shares []*v1beta11.DecCoin `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	// apr defines the total projected annualised yield.
+	Apr string `protobuf:"bytes,1,opt,name=apr,proto3" json:"apr,omitempty"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []*PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesResponse) Reset() {
-	*x = QueryPoolServiceTotalDelegatorSharesResponse{}
+func (x *QueryPoolServiceAPRResponse) Reset() {
+	*x = QueryPoolServiceAPRResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[15]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesResponse) String() string {
+func (x *QueryPoolServiceAPRResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolServiceTotalDelegatorSharesResponse) ProtoMessage() {}
+func (*QueryPoolServiceAPRResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolServiceTotalDelegatorSharesResponse.ProtoReflect.Descriptor instead.
-func (*QueryPoolServiceTotalDelegatorSharesResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryPoolServiceAPRResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolServiceAPRResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryPoolServiceTotalDelegatorSharesResponse) GetShares() []*v1beta11.DecCoin {
+func (x *QueryPoolServiceAPRResponse) GetApr() string {
 	if x != nil {
-		return x.Shares
+		return x.Apr
+	}
+	return ""
+}
+
+func (x *QueryPoolServiceAPRResponse) GetPlans() []*PlanAPR {
+	if x != nil {
+		return x.Plans
 	}
 	return nil
 }
@@ -12373,7 +19695,7 @@ type QueryPoolDelegationRewardsRequest struct {
 func (x *QueryPoolDelegationRewardsRequest) Reset() {
 	*x = QueryPoolDelegationRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[16]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12387,7 +19709,7 @@ func (*QueryPoolDelegationRewardsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryPoolDelegationRewardsRequest.ProtoReflect.Descriptor instead.
 func (*QueryPoolDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryPoolDelegationRewardsRequest) GetDelegatorAddress() string {
@@ -12418,7 +19740,7 @@ type QueryPoolDelegationRewardsResponse struct {
 func (x *QueryPoolDelegationRewardsResponse) Reset() {
 	*x = QueryPoolDelegationRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[17]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12432,7 +19754,7 @@ func (*QueryPoolDelegationRewardsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryPoolDelegationRewardsResponse.ProtoReflect.Descriptor instead.
 func (*QueryPoolDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryPoolDelegationRewardsResponse) GetRewards() []*DecPool {
@@ -12458,7 +19780,7 @@ type QueryOperatorDelegationRewardsRequest struct {
 func (x *QueryOperatorDelegationRewardsRequest) Reset() {
 	*x = QueryOperatorDelegationRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[18]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12472,7 +19794,7 @@ func (*QueryOperatorDelegationRewardsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryOperatorDelegationRewardsRequest.ProtoReflect.Descriptor instead.
 func (*QueryOperatorDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryOperatorDelegationRewardsRequest) GetDelegatorAddress() string {
@@ -12503,7 +19825,7 @@ type QueryOperatorDelegationRewardsResponse struct {
 func (x *QueryOperatorDelegationRewardsResponse) Reset() {
 	*x = QueryOperatorDelegationRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[19]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12517,7 +19839,7 @@ func (*QueryOperatorDelegationRewardsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryOperatorDelegationRewardsResponse.ProtoReflect.Descriptor instead.
 func (*QueryOperatorDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryOperatorDelegationRewardsResponse) GetRewards() []*DecPool {
@@ -12543,7 +19865,7 @@ type QueryServiceDelegationRewardsRequest struct {
 func (x *QueryServiceDelegationRewardsRequest) Reset() {
 	*x = QueryServiceDelegationRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[20]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12557,7 +19879,7 @@ func (*QueryServiceDelegationRewardsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryServiceDelegationRewardsRequest.ProtoReflect.Descriptor instead.
 func (*QueryServiceDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryServiceDelegationRewardsRequest) GetDelegatorAddress() string {
@@ -12588,7 +19910,7 @@ type QueryServiceDelegationRewardsResponse struct {
 func (x *QueryServiceDelegationRewardsResponse) Reset() {
 	*x = QueryServiceDelegationRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[21]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12602,7 +19924,7 @@ func (*QueryServiceDelegationRewardsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryServiceDelegationRewardsResponse.ProtoReflect.Descriptor instead.
 func (*QueryServiceDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryServiceDelegationRewardsResponse) GetRewards() []*DecPool {
@@ -12626,7 +19948,7 @@ type QueryDelegatorTotalRewardsRequest struct {
 func (x *QueryDelegatorTotalRewardsRequest) Reset() {
 	*x = QueryDelegatorTotalRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[22]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12640,7 +19962,7 @@ func (*QueryDelegatorTotalRewardsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryDelegatorTotalRewardsRequest.ProtoReflect.Descriptor instead.
 func (*QueryDelegatorTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryDelegatorTotalRewardsRequest) GetDelegatorAddress() string {
@@ -12666,7 +19988,7 @@ type QueryDelegatorTotalRewardsResponse struct {
 func (x *QueryDelegatorTotalRewardsResponse) Reset() {
 	*x = QueryDelegatorTotalRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[23]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12680,7 +20002,7 @@ func (*QueryDelegatorTotalRewardsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryDelegatorTotalRewardsResponse.ProtoReflect.Descriptor instead.
 func (*QueryDelegatorTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryDelegatorTotalRewardsResponse) GetRewards() []*DelegationDelegatorReward {
@@ -12711,7 +20033,7 @@ type QueryDelegatorWithdrawAddressRequest struct {
 func (x *QueryDelegatorWithdrawAddressRequest) Reset() {
 	*x = QueryDelegatorWithdrawAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[24]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12725,7 +20047,7 @@ func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryDelegatorWithdrawAddressRequest.ProtoReflect.Descriptor instead.
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *QueryDelegatorWithdrawAddressRequest) GetDelegatorAddress() string {
@@ -12749,7 +20071,7 @@ type QueryDelegatorWithdrawAddressResponse struct {
 func (x *QueryDelegatorWithdrawAddressResponse) Reset() {
 	*x = QueryDelegatorWithdrawAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[25]
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12763,7 +20085,7 @@ func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryDelegatorWithdrawAddressResponse.ProtoReflect.Descriptor instead.
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *QueryDelegatorWithdrawAddressResponse) GetWithdrawAddress() string {
@@ -12773,6 +20095,99 @@ func (x *QueryDelegatorWithdrawAddressResponse) GetWithdrawAddress() string {
 	return ""
 }
 
+// QueryDelegatorAutoCompoundSettingsRequest is the request type for the
+// Query/DelegatorAutoCompoundSettings RPC method.
+type QueryDelegatorAutoCompoundSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) Reset() {
+	*x = QueryDelegatorAutoCompoundSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegatorAutoCompoundSettingsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegatorAutoCompoundSettingsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDelegatorAutoCompoundSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorAutoCompoundSettingsResponse is the response type for the
+// Query/DelegatorAutoCompoundSettings RPC method.
+type QueryDelegatorAutoCompoundSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AutoCompoundSettings []*AutoCompoundSetting `protobuf:"bytes,1,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) Reset() {
+	*x = QueryDelegatorAutoCompoundSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_rewards_v1_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegatorAutoCompoundSettingsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegatorAutoCompoundSettingsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegatorAutoCompoundSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_milkyway_rewards_v1_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) GetAutoCompoundSettings() []*AutoCompoundSetting {
+	if x != nil {
+		return x.AutoCompoundSettings
+	}
+	return nil
+}
+
+func (x *QueryDelegatorAutoCompoundSettingsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_milkyway_rewards_v1_query_proto protoreflect.FileDescriptor
 
 var file_milkyway_rewards_v1_query_proto_rawDesc = []byte{
@@ -12828,337 +20243,536 @@ var file_milkyway_rewards_v1_query_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
 	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
 	0x73, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x3d, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
-	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x26,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74,
-	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0x46, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x50, 0x6c, 0x61, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x14, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x20, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x15, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50,
+	0x6c, 0x61, 0x6e, 0x22, 0x3d, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
 	0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0x41, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c,
+	0x49, 0x64, 0x22, 0x73, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c,
 	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a,
-	0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70,
-	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
-	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x22, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x22, 0x77, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x46, 0x0a, 0x25, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x1e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x78,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x9e, 0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x22, 0x2e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x50, 0x52,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
+	0x22, 0xa6, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x50,
+	0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x03, 0x61, 0x70, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xe2, 0xde, 0x1f, 0x03, 0x41,
+	0x50, 0x52, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x61, 0x70, 0x72, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x6c,
+	0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x41, 0x50, 0x52, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x50, 0x52, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x03, 0x61, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xe2, 0xde, 0x1f, 0x03, 0x41, 0x50, 0x52, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03,
+	0x61, 0x70, 0x72, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c,
-	0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f,
-	0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0x8f, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x64, 0x22, 0x77, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x50, 0x52,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x61,
+	0x6e, 0x73, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x03, 0x61, 0x70, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xe2, 0xde, 0x1f, 0x03, 0x41, 0x50, 0x52,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x03, 0x61, 0x70, 0x72, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x41, 0x50, 0x52, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xad, 0x01,
+	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x03, 0x61, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xe2,
+	0xde, 0x1f, 0x03, 0x41, 0x50, 0x52, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x61, 0x70, 0x72, 0x12, 0x3d,
+	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f,
-	0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x24,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x25, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f,
-	0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50,
-	0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x22, 0x6a, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc4,
-	0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
-	0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
-	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
-	0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x6d, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a,
-	0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
-	0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x50, 0x52, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x83, 0x01,
+	0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x6f,
+	0x6c, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x26, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50,
+	0x6f, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x32, 0x98, 0x15, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x99, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73,
-	0x12, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x2c, 0x2e, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
-	0x12, 0x2c, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70,
-	0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcd,
-	0x01, 0x0a, 0x16, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
-	0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
-	0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x73, 0x74,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xe1,
-	0x01, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3b, 0x2e,
-	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42,
-	0x12, 0x40, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f,
-	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0xdc, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x12, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d,
+	0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0x76, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d,
 	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
-	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x40, 0x12, 0x3e, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x12, 0xc0, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x6a, 0x0a, 0x21, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x49, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
+	0x15, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x6d, 0x0a,
+	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x25,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x29, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x2a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x61, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xaa, 0x1f, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
 	0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
-	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
-	0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x81, 0x02, 0x0a, 0x1f, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
 	0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c,
-	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0xdd, 0x01, 0x0a, 0x15, 0x50, 0x6f, 0x6f,
-	0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
+	0x22, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
+	0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xba, 0x01, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12,
+	0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x34, 0x2e, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
+	0x12, 0x35, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcd, 0x01, 0x0a, 0x16, 0x50, 0x6f, 0x6f, 0x6c,
+	0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
 	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
-	0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b, 0x2f, 0x6d, 0x69,
-	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xf1, 0x01, 0x0a, 0x19, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xe1, 0x01, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
 	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x55, 0x12, 0x53, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xec, 0x01, 0x0a,
-	0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x39, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
+	0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40, 0x2f, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xdc, 0x01, 0x0a, 0x19,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
 	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xc0, 0x01, 0x0a, 0x12, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x81, 0x02,
+	0x0a, 0x1f, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x12, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51,
+	0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x12, 0x90, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x50, 0x52, 0x12, 0x28, 0x2e,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x50, 0x52,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x61, 0x70, 0x72, 0x12, 0xa4, 0x01, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x50, 0x52, 0x12, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
 	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcd, 0x01, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x50, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x72, 0x12, 0x9f, 0x01, 0x0a, 0x0a,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x12, 0x2b, 0x2e, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x72, 0x12, 0xbb, 0x01,
+	0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52,
+	0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x72, 0x12, 0xdd, 0x01, 0x0a, 0x15,
+	0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
 	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
 	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b,
 	0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
 	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f,
 	0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xdf, 0x01, 0x0a, 0x18,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
+	0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xf1, 0x01, 0x0a, 0x19,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b,
+	0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x55, 0x12, 0x53, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xec, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x39, 0x2e, 0x6d,
+	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
+	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcd,
+	0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79,
 	0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0xe0, 0x01,
-	0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77,
-	0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76,
-	0x31, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x69, 0x6c,
-	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
-	0x79, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3d, 0x12, 0x3b, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0xdf,
+	0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x2e, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
+	0x79, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0xf4, 0x01, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0xe0, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4d, 0x52, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x69, 0x6c,
+	0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1f, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -13173,7 +20787,7 @@ func file_milkyway_rewards_v1_query_proto_rawDescGZIP() []byte {
 	return file_milkyway_rewards_v1_query_proto_rawDescData
 }
 
-var file_milkyway_rewards_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_milkyway_rewards_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_milkyway_rewards_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                           // 0: milkyway.rewards.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                          // 1: milkyway.rewards.v1.QueryParamsResponse
@@ -13181,83 +20795,125 @@ var file_milkyway_rewards_v1_query_proto_goTypes = []interface{}{
 	(*QueryRewardsPlansResponse)(nil),                    // 3: milkyway.rewards.v1.QueryRewardsPlansResponse
 	(*QueryRewardsPlanRequest)(nil),                      // 4: milkyway.rewards.v1.QueryRewardsPlanRequest
 	(*QueryRewardsPlanResponse)(nil),                     // 5: milkyway.rewards.v1.QueryRewardsPlanResponse
-	(*QueryPoolOutstandingRewardsRequest)(nil),           // 6: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest
-	(*QueryPoolOutstandingRewardsResponse)(nil),          // 7: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse
-	(*QueryOperatorOutstandingRewardsRequest)(nil),       // 8: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest
-	(*QueryOperatorOutstandingRewardsResponse)(nil),      // 9: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse
-	(*QueryServiceOutstandingRewardsRequest)(nil),        // 10: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest
-	(*QueryServiceOutstandingRewardsResponse)(nil),       // 11: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse
-	(*QueryOperatorCommissionRequest)(nil),               // 12: milkyway.rewards.v1.QueryOperatorCommissionRequest
-	(*QueryOperatorCommissionResponse)(nil),              // 13: milkyway.rewards.v1.QueryOperatorCommissionResponse
-	(*QueryPoolServiceTotalDelegatorSharesRequest)(nil),  // 14: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest
-	(*QueryPoolServiceTotalDelegatorSharesResponse)(nil), // 15: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse
-	(*QueryPoolDelegationRewardsRequest)(nil),            // 16: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest
-	(*QueryPoolDelegationRewardsResponse)(nil),           // 17: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse
-	(*QueryOperatorDelegationRewardsRequest)(nil),        // 18: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest
-	(*QueryOperatorDelegationRewardsResponse)(nil),       // 19: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse
-	(*QueryServiceDelegationRewardsRequest)(nil),         // 20: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest
-	(*QueryServiceDelegationRewardsResponse)(nil),        // 21: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse
-	(*QueryDelegatorTotalRewardsRequest)(nil),            // 22: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest
-	(*QueryDelegatorTotalRewardsResponse)(nil),           // 23: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse
-	(*QueryDelegatorWithdrawAddressRequest)(nil),         // 24: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest
-	(*QueryDelegatorWithdrawAddressResponse)(nil),        // 25: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse
-	(*Params)(nil),                    // 26: milkyway.rewards.v1.Params
-	(*v1beta1.PageRequest)(nil),       // 27: cosmos.base.query.v1beta1.PageRequest
-	(*RewardsPlan)(nil),               // 28: milkyway.rewards.v1.RewardsPlan
-	(*v1beta1.PageResponse)(nil),      // 29: cosmos.base.query.v1beta1.PageResponse
-	(*OutstandingRewards)(nil),        // 30: milkyway.rewards.v1.OutstandingRewards
-	(*AccumulatedCommission)(nil),     // 31: milkyway.rewards.v1.AccumulatedCommission
-	(*v1beta11.DecCoin)(nil),          // 32: cosmos.base.v1beta1.DecCoin
-	(*DecPool)(nil),                   // 33: milkyway.rewards.v1.DecPool
-	(*DelegationDelegatorReward)(nil), // 34: milkyway.rewards.v1.DelegationDelegatorReward
+	(*QueryArchivedRewardsPlansRequest)(nil),             // 6: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest
+	(*QueryArchivedRewardsPlansResponse)(nil),            // 7: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse
+	(*QueryArchivedRewardsPlanRequest)(nil),              // 8: milkyway.rewards.v1.QueryArchivedRewardsPlanRequest
+	(*QueryArchivedRewardsPlanResponse)(nil),             // 9: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse
+	(*QueryPoolOutstandingRewardsRequest)(nil),           // 10: milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest
+	(*QueryPoolOutstandingRewardsResponse)(nil),          // 11: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse
+	(*QueryOperatorOutstandingRewardsRequest)(nil),       // 12: milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest
+	(*QueryOperatorOutstandingRewardsResponse)(nil),      // 13: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse
+	(*QueryServiceOutstandingRewardsRequest)(nil),        // 14: milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest
+	(*QueryServiceOutstandingRewardsResponse)(nil),       // 15: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse
+	(*QueryOperatorCommissionRequest)(nil),               // 16: milkyway.rewards.v1.QueryOperatorCommissionRequest
+	(*QueryOperatorCommissionResponse)(nil),              // 17: milkyway.rewards.v1.QueryOperatorCommissionResponse
+	(*QueryPoolServiceTotalDelegatorSharesRequest)(nil),  // 18: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest
+	(*QueryPoolServiceTotalDelegatorSharesResponse)(nil), // 19: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse
+	(*QueryPoolAPRRequest)(nil),                          // 20: milkyway.rewards.v1.QueryPoolAPRRequest
+	(*QueryPoolAPRResponse)(nil),                         // 21: milkyway.rewards.v1.QueryPoolAPRResponse
+	(*QueryOperatorAPRRequest)(nil),                      // 22: milkyway.rewards.v1.QueryOperatorAPRRequest
+	(*QueryOperatorAPRResponse)(nil),                     // 23: milkyway.rewards.v1.QueryOperatorAPRResponse
+	(*QueryServiceAPRRequest)(nil),                       // 24: milkyway.rewards.v1.QueryServiceAPRRequest
+	(*QueryServiceAPRResponse)(nil),                      // 25: milkyway.rewards.v1.QueryServiceAPRResponse
+	(*QueryPoolServiceAPRRequest)(nil),                   // 26: milkyway.rewards.v1.QueryPoolServiceAPRRequest
+	(*QueryPoolServiceAPRResponse)(nil),                  // 27: milkyway.rewards.v1.QueryPoolServiceAPRResponse
+	(*QueryPoolDelegationRewardsRequest)(nil),            // 28: milkyway.rewards.v1.QueryPoolDelegationRewardsRequest
+	(*QueryPoolDelegationRewardsResponse)(nil),           // 29: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse
+	(*QueryOperatorDelegationRewardsRequest)(nil),        // 30: milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest
+	(*QueryOperatorDelegationRewardsResponse)(nil),       // 31: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse
+	(*QueryServiceDelegationRewardsRequest)(nil),         // 32: milkyway.rewards.v1.QueryServiceDelegationRewardsRequest
+	(*QueryServiceDelegationRewardsResponse)(nil),        // 33: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse
+	(*QueryDelegatorTotalRewardsRequest)(nil),            // 34: milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest
+	(*QueryDelegatorTotalRewardsResponse)(nil),           // 35: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse
+	(*QueryDelegatorWithdrawAddressRequest)(nil),         // 36: milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest
+	(*QueryDelegatorWithdrawAddressResponse)(nil),        // 37: milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse
+	(*QueryDelegatorAutoCompoundSettingsRequest)(nil),    // 38: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest
+	(*QueryDelegatorAutoCompoundSettingsResponse)(nil),   // 39: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse
+	(*Params)(nil),                    // 40: milkyway.rewards.v1.Params
+	(*v1beta1.PageRequest)(nil),       // 41: cosmos.base.query.v1beta1.PageRequest
+	(*RewardsPlan)(nil),               // 42: milkyway.rewards.v1.RewardsPlan
+	(*v1beta1.PageResponse)(nil),      // 43: cosmos.base.query.v1beta1.PageResponse
+	(*ArchivedRewardsPlan)(nil),       // 44: milkyway.rewards.v1.ArchivedRewardsPlan
+	(*OutstandingRewards)(nil),        // 45: milkyway.rewards.v1.OutstandingRewards
+	(*AccumulatedCommission)(nil),     // 46: milkyway.rewards.v1.AccumulatedCommission
+	(*v1beta11.DecCoin)(nil),          // 47: cosmos.base.v1beta1.DecCoin
+	(*PlanAPR)(nil),                   // 48: milkyway.rewards.v1.PlanAPR
+	(*DecPool)(nil),                   // 49: milkyway.rewards.v1.DecPool
+	(*DelegationDelegatorReward)(nil), // 50: milkyway.rewards.v1.DelegationDelegatorReward
+	(*AutoCompoundSetting)(nil),       // 51: milkyway.rewards.v1.AutoCompoundSetting
 }
 var file_milkyway_rewards_v1_query_proto_depIdxs = []int32{
-	26, // 0: milkyway.rewards.v1.QueryParamsResponse.params:type_name -> milkyway.rewards.v1.Params
-	27, // 1: milkyway.rewards.v1.QueryRewardsPlansRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 2: milkyway.rewards.v1.QueryRewardsPlansResponse.rewards_plans:type_name -> milkyway.rewards.v1.RewardsPlan
-	29, // 3: milkyway.rewards.v1.QueryRewardsPlansResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 4: milkyway.rewards.v1.QueryRewardsPlanResponse.rewards_plan:type_name -> milkyway.rewards.v1.RewardsPlan
-	30, // 5: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
-	30, // 6: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
-	30, // 7: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
-	31, // 8: milkyway.rewards.v1.QueryOperatorCommissionResponse.commission:type_name -> milkyway.rewards.v1.AccumulatedCommission
-	32, // 9: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares:type_name -> cosmos.base.v1beta1.DecCoin
-	33, // 10: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
-	33, // 11: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
-	33, // 12: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
-	34, // 13: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DelegationDelegatorReward
-	33, // 14: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total:type_name -> milkyway.rewards.v1.DecPool
-	0,  // 15: milkyway.rewards.v1.Query.Params:input_type -> milkyway.rewards.v1.QueryParamsRequest
-	2,  // 16: milkyway.rewards.v1.Query.RewardsPlans:input_type -> milkyway.rewards.v1.QueryRewardsPlansRequest
-	4,  // 17: milkyway.rewards.v1.Query.RewardsPlan:input_type -> milkyway.rewards.v1.QueryRewardsPlanRequest
-	6,  // 18: milkyway.rewards.v1.Query.PoolOutstandingRewards:input_type -> milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest
-	8,  // 19: milkyway.rewards.v1.Query.OperatorOutstandingRewards:input_type -> milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest
-	10, // 20: milkyway.rewards.v1.Query.ServiceOutstandingRewards:input_type -> milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest
-	12, // 21: milkyway.rewards.v1.Query.OperatorCommission:input_type -> milkyway.rewards.v1.QueryOperatorCommissionRequest
-	14, // 22: milkyway.rewards.v1.Query.PoolServiceTotalDelegatorShares:input_type -> milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest
-	16, // 23: milkyway.rewards.v1.Query.PoolDelegationRewards:input_type -> milkyway.rewards.v1.QueryPoolDelegationRewardsRequest
-	18, // 24: milkyway.rewards.v1.Query.OperatorDelegationRewards:input_type -> milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest
-	20, // 25: milkyway.rewards.v1.Query.ServiceDelegationRewards:input_type -> milkyway.rewards.v1.QueryServiceDelegationRewardsRequest
-	22, // 26: milkyway.rewards.v1.Query.DelegatorTotalRewards:input_type -> milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest
-	24, // 27: milkyway.rewards.v1.Query.DelegatorWithdrawAddress:input_type -> milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest
-	1,  // 28: milkyway.rewards.v1.Query.Params:output_type -> milkyway.rewards.v1.QueryParamsResponse
-	3,  // 29: milkyway.rewards.v1.Query.RewardsPlans:output_type -> milkyway.rewards.v1.QueryRewardsPlansResponse
-	5,  // 30: milkyway.rewards.v1.Query.RewardsPlan:output_type -> milkyway.rewards.v1.QueryRewardsPlanResponse
-	7,  // 31: milkyway.rewards.v1.Query.PoolOutstandingRewards:output_type -> milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse
-	9,  // 32: milkyway.rewards.v1.Query.OperatorOutstandingRewards:output_type -> milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse
-	11, // 33: milkyway.rewards.v1.Query.ServiceOutstandingRewards:output_type -> milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse
-	13, // 34: milkyway.rewards.v1.Query.OperatorCommission:output_type -> milkyway.rewards.v1.QueryOperatorCommissionResponse
-	15, // 35: milkyway.rewards.v1.Query.PoolServiceTotalDelegatorShares:output_type -> milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse
-	17, // 36: milkyway.rewards.v1.Query.PoolDelegationRewards:output_type -> milkyway.rewards.v1.QueryPoolDelegationRewardsResponse
-	19, // 37: milkyway.rewards.v1.Query.OperatorDelegationRewards:output_type -> milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse
-	21, // 38: milkyway.rewards.v1.Query.ServiceDelegationRewards:output_type -> milkyway.rewards.v1.QueryServiceDelegationRewardsResponse
-	23, // 39: milkyway.rewards.v1.Query.DelegatorTotalRewards:output_type -> milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse
-	25, // 40: milkyway.rewards.v1.Query.DelegatorWithdrawAddress:output_type -> milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse
-	28, // [28:41] is the sub-list for method output_type
-	15, // [15:28] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	40, // 0: milkyway.rewards.v1.QueryParamsResponse.params:type_name -> milkyway.rewards.v1.Params
+	41, // 1: milkyway.rewards.v1.QueryRewardsPlansRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 2: milkyway.rewards.v1.QueryRewardsPlansResponse.rewards_plans:type_name -> milkyway.rewards.v1.RewardsPlan
+	43, // 3: milkyway.rewards.v1.QueryRewardsPlansResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	42, // 4: milkyway.rewards.v1.QueryRewardsPlanResponse.rewards_plan:type_name -> milkyway.rewards.v1.RewardsPlan
+	41, // 5: milkyway.rewards.v1.QueryArchivedRewardsPlansRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 6: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.archived_rewards_plans:type_name -> milkyway.rewards.v1.ArchivedRewardsPlan
+	43, // 7: milkyway.rewards.v1.QueryArchivedRewardsPlansResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 8: milkyway.rewards.v1.QueryArchivedRewardsPlanResponse.archived_rewards_plan:type_name -> milkyway.rewards.v1.ArchivedRewardsPlan
+	45, // 9: milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
+	45, // 10: milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
+	45, // 11: milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse.rewards:type_name -> milkyway.rewards.v1.OutstandingRewards
+	46, // 12: milkyway.rewards.v1.QueryOperatorCommissionResponse.commission:type_name -> milkyway.rewards.v1.AccumulatedCommission
+	47, // 13: milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse.shares:type_name -> cosmos.base.v1beta1.DecCoin
+	48, // 14: milkyway.rewards.v1.QueryPoolAPRResponse.plans:type_name -> milkyway.rewards.v1.PlanAPR
+	48, // 15: milkyway.rewards.v1.QueryOperatorAPRResponse.plans:type_name -> milkyway.rewards.v1.PlanAPR
+	48, // 16: milkyway.rewards.v1.QueryServiceAPRResponse.plans:type_name -> milkyway.rewards.v1.PlanAPR
+	48, // 17: milkyway.rewards.v1.QueryPoolServiceAPRResponse.plans:type_name -> milkyway.rewards.v1.PlanAPR
+	49, // 18: milkyway.rewards.v1.QueryPoolDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
+	49, // 19: milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
+	49, // 20: milkyway.rewards.v1.QueryServiceDelegationRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DecPool
+	50, // 21: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.rewards:type_name -> milkyway.rewards.v1.DelegationDelegatorReward
+	49, // 22: milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse.total:type_name -> milkyway.rewards.v1.DecPool
+	41, // 23: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	51, // 24: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.auto_compound_settings:type_name -> milkyway.rewards.v1.AutoCompoundSetting
+	43, // 25: milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 26: milkyway.rewards.v1.Query.Params:input_type -> milkyway.rewards.v1.QueryParamsRequest
+	2,  // 27: milkyway.rewards.v1.Query.RewardsPlans:input_type -> milkyway.rewards.v1.QueryRewardsPlansRequest
+	4,  // 28: milkyway.rewards.v1.Query.RewardsPlan:input_type -> milkyway.rewards.v1.QueryRewardsPlanRequest
+	6,  // 29: milkyway.rewards.v1.Query.ArchivedRewardsPlans:input_type -> milkyway.rewards.v1.QueryArchivedRewardsPlansRequest
+	8,  // 30: milkyway.rewards.v1.Query.ArchivedRewardsPlan:input_type -> milkyway.rewards.v1.QueryArchivedRewardsPlanRequest
+	10, // 31: milkyway.rewards.v1.Query.PoolOutstandingRewards:input_type -> milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest
+	12, // 32: milkyway.rewards.v1.Query.OperatorOutstandingRewards:input_type -> milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest
+	14, // 33: milkyway.rewards.v1.Query.ServiceOutstandingRewards:input_type -> milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest
+	16, // 34: milkyway.rewards.v1.Query.OperatorCommission:input_type -> milkyway.rewards.v1.QueryOperatorCommissionRequest
+	18, // 35: milkyway.rewards.v1.Query.PoolServiceTotalDelegatorShares:input_type -> milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest
+	20, // 36: milkyway.rewards.v1.Query.PoolAPR:input_type -> milkyway.rewards.v1.QueryPoolAPRRequest
+	22, // 37: milkyway.rewards.v1.Query.OperatorAPR:input_type -> milkyway.rewards.v1.QueryOperatorAPRRequest
+	24, // 38: milkyway.rewards.v1.Query.ServiceAPR:input_type -> milkyway.rewards.v1.QueryServiceAPRRequest
+	26, // 39: milkyway.rewards.v1.Query.PoolServiceAPR:input_type -> milkyway.rewards.v1.QueryPoolServiceAPRRequest
+	28, // 40: milkyway.rewards.v1.Query.PoolDelegationRewards:input_type -> milkyway.rewards.v1.QueryPoolDelegationRewardsRequest
+	30, // 41: milkyway.rewards.v1.Query.OperatorDelegationRewards:input_type -> milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest
+	32, // 42: milkyway.rewards.v1.Query.ServiceDelegationRewards:input_type -> milkyway.rewards.v1.QueryServiceDelegationRewardsRequest
+	34, // 43: milkyway.rewards.v1.Query.DelegatorTotalRewards:input_type -> milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest
+	36, // 44: milkyway.rewards.v1.Query.DelegatorWithdrawAddress:input_type -> milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest
+	38, // 45: milkyway.rewards.v1.Query.DelegatorAutoCompoundSettings:input_type -> milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest
+	1,  // 46: milkyway.rewards.v1.Query.Params:output_type -> milkyway.rewards.v1.QueryParamsResponse
+	3,  // 47: milkyway.rewards.v1.Query.RewardsPlans:output_type -> milkyway.rewards.v1.QueryRewardsPlansResponse
+	5,  // 48: milkyway.rewards.v1.Query.RewardsPlan:output_type -> milkyway.rewards.v1.QueryRewardsPlanResponse
+	7,  // 49: milkyway.rewards.v1.Query.ArchivedRewardsPlans:output_type -> milkyway.rewards.v1.QueryArchivedRewardsPlansResponse
+	9,  // 50: milkyway.rewards.v1.Query.ArchivedRewardsPlan:output_type -> milkyway.rewards.v1.QueryArchivedRewardsPlanResponse
+	11, // 51: milkyway.rewards.v1.Query.PoolOutstandingRewards:output_type -> milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse
+	13, // 52: milkyway.rewards.v1.Query.OperatorOutstandingRewards:output_type -> milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse
+	15, // 53: milkyway.rewards.v1.Query.ServiceOutstandingRewards:output_type -> milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse
+	17, // 54: milkyway.rewards.v1.Query.OperatorCommission:output_type -> milkyway.rewards.v1.QueryOperatorCommissionResponse
+	19, // 55: milkyway.rewards.v1.Query.PoolServiceTotalDelegatorShares:output_type -> milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse
+	21, // 56: milkyway.rewards.v1.Query.PoolAPR:output_type -> milkyway.rewards.v1.QueryPoolAPRResponse
+	23, // 57: milkyway.rewards.v1.Query.OperatorAPR:output_type -> milkyway.rewards.v1.QueryOperatorAPRResponse
+	25, // 58: milkyway.rewards.v1.Query.ServiceAPR:output_type -> milkyway.rewards.v1.QueryServiceAPRResponse
+	27, // 59: milkyway.rewards.v1.Query.PoolServiceAPR:output_type -> milkyway.rewards.v1.QueryPoolServiceAPRResponse
+	29, // 60: milkyway.rewards.v1.Query.PoolDelegationRewards:output_type -> milkyway.rewards.v1.QueryPoolDelegationRewardsResponse
+	31, // 61: milkyway.rewards.v1.Query.OperatorDelegationRewards:output_type -> milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse
+	33, // 62: milkyway.rewards.v1.Query.ServiceDelegationRewards:output_type -> milkyway.rewards.v1.QueryServiceDelegationRewardsResponse
+	35, // 63: milkyway.rewards.v1.Query.DelegatorTotalRewards:output_type -> milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse
+	37, // 64: milkyway.rewards.v1.Query.DelegatorWithdrawAddress:output_type -> milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse
+	39, // 65: milkyway.rewards.v1.Query.DelegatorAutoCompoundSettings:output_type -> milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse
+	46, // [46:66] is the sub-list for method output_type
+	26, // [26:46] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_rewards_v1_query_proto_init() }
@@ -13341,7 +20997,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolOutstandingRewardsRequest); i {
+			switch v := v.(*QueryArchivedRewardsPlansRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13353,7 +21009,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolOutstandingRewardsResponse); i {
+			switch v := v.(*QueryArchivedRewardsPlansResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13365,7 +21021,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorOutstandingRewardsRequest); i {
+			switch v := v.(*QueryArchivedRewardsPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13377,7 +21033,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorOutstandingRewardsResponse); i {
+			switch v := v.(*QueryArchivedRewardsPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13389,7 +21045,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServiceOutstandingRewardsRequest); i {
+			switch v := v.(*QueryPoolOutstandingRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13401,7 +21057,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServiceOutstandingRewardsResponse); i {
+			switch v := v.(*QueryPoolOutstandingRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13413,7 +21069,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorCommissionRequest); i {
+			switch v := v.(*QueryOperatorOutstandingRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13425,7 +21081,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorCommissionResponse); i {
+			switch v := v.(*QueryOperatorOutstandingRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13437,7 +21093,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolServiceTotalDelegatorSharesRequest); i {
+			switch v := v.(*QueryServiceOutstandingRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13449,7 +21105,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolServiceTotalDelegatorSharesResponse); i {
+			switch v := v.(*QueryServiceOutstandingRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13461,7 +21117,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolDelegationRewardsRequest); i {
+			switch v := v.(*QueryOperatorCommissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13473,7 +21129,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolDelegationRewardsResponse); i {
+			switch v := v.(*QueryOperatorCommissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13485,7 +21141,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorDelegationRewardsRequest); i {
+			switch v := v.(*QueryPoolServiceTotalDelegatorSharesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13497,7 +21153,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOperatorDelegationRewardsResponse); i {
+			switch v := v.(*QueryPoolServiceTotalDelegatorSharesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13509,7 +21165,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServiceDelegationRewardsRequest); i {
+			switch v := v.(*QueryPoolAPRRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13521,7 +21177,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryServiceDelegationRewardsResponse); i {
+			switch v := v.(*QueryPoolAPRResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13533,7 +21189,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDelegatorTotalRewardsRequest); i {
+			switch v := v.(*QueryOperatorAPRRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13545,7 +21201,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDelegatorTotalRewardsResponse); i {
+			switch v := v.(*QueryOperatorAPRResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13557,7 +21213,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDelegatorWithdrawAddressRequest); i {
+			switch v := v.(*QueryServiceAPRRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13569,6 +21225,150 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			}
 		}
 		file_milkyway_rewards_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryServiceAPRResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolServiceAPRRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolServiceAPRResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolDelegationRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolDelegationRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOperatorDelegationRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOperatorDelegationRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryServiceDelegationRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryServiceDelegationRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatorTotalRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatorTotalRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatorWithdrawAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryDelegatorWithdrawAddressResponse); i {
 			case 0:
 				return &v.state
@@ -13580,6 +21380,30 @@ func file_milkyway_rewards_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatorAutoCompoundSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_milkyway_rewards_v1_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatorAutoCompoundSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -13587,7 +21411,7 @@ func file_milkyway_rewards_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_milkyway_rewards_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
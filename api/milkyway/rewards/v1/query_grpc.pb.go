@@ -22,16 +22,23 @@ const (
 	Query_Params_FullMethodName                          = "/milkyway.rewards.v1.Query/Params"
 	Query_RewardsPlans_FullMethodName                    = "/milkyway.rewards.v1.Query/RewardsPlans"
 	Query_RewardsPlan_FullMethodName                     = "/milkyway.rewards.v1.Query/RewardsPlan"
+	Query_ArchivedRewardsPlans_FullMethodName            = "/milkyway.rewards.v1.Query/ArchivedRewardsPlans"
+	Query_ArchivedRewardsPlan_FullMethodName             = "/milkyway.rewards.v1.Query/ArchivedRewardsPlan"
 	Query_PoolOutstandingRewards_FullMethodName          = "/milkyway.rewards.v1.Query/PoolOutstandingRewards"
 	Query_OperatorOutstandingRewards_FullMethodName      = "/milkyway.rewards.v1.Query/OperatorOutstandingRewards"
 	Query_ServiceOutstandingRewards_FullMethodName       = "/milkyway.rewards.v1.Query/ServiceOutstandingRewards"
 	Query_OperatorCommission_FullMethodName              = "/milkyway.rewards.v1.Query/OperatorCommission"
 	Query_PoolServiceTotalDelegatorShares_FullMethodName = "/milkyway.rewards.v1.Query/PoolServiceTotalDelegatorShares"
+	Query_PoolAPR_FullMethodName                         = "/milkyway.rewards.v1.Query/PoolAPR"
+	Query_OperatorAPR_FullMethodName                     = "/milkyway.rewards.v1.Query/OperatorAPR"
+	Query_ServiceAPR_FullMethodName                      = "/milkyway.rewards.v1.Query/ServiceAPR"
+	Query_PoolServiceAPR_FullMethodName                  = "/milkyway.rewards.v1.Query/PoolServiceAPR"
 	Query_PoolDelegationRewards_FullMethodName           = "/milkyway.rewards.v1.Query/PoolDelegationRewards"
 	Query_OperatorDelegationRewards_FullMethodName       = "/milkyway.rewards.v1.Query/OperatorDelegationRewards"
 	Query_ServiceDelegationRewards_FullMethodName        = "/milkyway.rewards.v1.Query/ServiceDelegationRewards"
 	Query_DelegatorTotalRewards_FullMethodName           = "/milkyway.rewards.v1.Query/DelegatorTotalRewards"
 	Query_DelegatorWithdrawAddress_FullMethodName        = "/milkyway.rewards.v1.Query/DelegatorWithdrawAddress"
+	Query_DelegatorAutoCompoundSettings_FullMethodName   = "/milkyway.rewards.v1.Query/DelegatorAutoCompoundSettings"
 )
 
 // QueryClient is the client API for Query service.
@@ -47,6 +54,11 @@ type QueryClient interface {
 	RewardsPlans(ctx context.Context, in *QueryRewardsPlansRequest, opts ...grpc.CallOption) (*QueryRewardsPlansResponse, error)
 	// RewardsPlan queries a specific rewards plan by its ID.
 	RewardsPlan(ctx context.Context, in *QueryRewardsPlanRequest, opts ...grpc.CallOption) (*QueryRewardsPlanResponse, error)
+	// ArchivedRewardsPlans queries all the rewards plans that have been
+	// terminated.
+	ArchivedRewardsPlans(ctx context.Context, in *QueryArchivedRewardsPlansRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlansResponse, error)
+	// ArchivedRewardsPlan queries a specific terminated rewards plan by its ID.
+	ArchivedRewardsPlan(ctx context.Context, in *QueryArchivedRewardsPlanRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlanResponse, error)
 	// PoolOutstandingRewards queries rewards of a pool.
 	PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error)
 	// OperatorOutstandingRewards queries rewards of an operator.
@@ -58,6 +70,18 @@ type QueryClient interface {
 	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
 	// pool-service pair.
 	PoolServiceTotalDelegatorShares(ctx context.Context, in *QueryPoolServiceTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
+	// PoolAPR queries the projected annualised yield of the delegations to a
+	// pool, derived from the currently active rewards plans.
+	PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error)
+	// OperatorAPR queries the projected annualised yield of the delegations to
+	// an operator, derived from the currently active rewards plans.
+	OperatorAPR(ctx context.Context, in *QueryOperatorAPRRequest, opts ...grpc.CallOption) (*QueryOperatorAPRResponse, error)
+	// ServiceAPR queries the projected annualised yield of the delegations to
+	// a service, derived from the currently active rewards plans.
+	ServiceAPR(ctx context.Context, in *QueryServiceAPRRequest, opts ...grpc.CallOption) (*QueryServiceAPRResponse, error)
+	// PoolServiceAPR queries the projected annualised yield that the
+	// delegations to a pool receive from the rewards plans of a service.
+	PoolServiceAPR(ctx context.Context, in *QueryPoolServiceAPRRequest, opts ...grpc.CallOption) (*QueryPoolServiceAPRResponse, error)
 	// PoolDelegationRewards queries the total rewards accrued by a pool
 	// delegation.
 	PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error)
@@ -72,6 +96,9 @@ type QueryClient interface {
 	DelegatorTotalRewards(ctx context.Context, in *QueryDelegatorTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalRewardsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegation targets for which a
+	// delegator has enabled the auto-compounding of the rewards.
+	DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error)
 }
 
 type queryClient struct {
@@ -112,6 +139,26 @@ func (c *queryClient) RewardsPlan(ctx context.Context, in *QueryRewardsPlanReque
 	return out, nil
 }
 
+func (c *queryClient) ArchivedRewardsPlans(ctx context.Context, in *QueryArchivedRewardsPlansRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryArchivedRewardsPlansResponse)
+	err := c.cc.Invoke(ctx, Query_ArchivedRewardsPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedRewardsPlan(ctx context.Context, in *QueryArchivedRewardsPlanRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryArchivedRewardsPlanResponse)
+	err := c.cc.Invoke(ctx, Query_ArchivedRewardsPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPoolOutstandingRewardsResponse)
@@ -162,6 +209,46 @@ func (c *queryClient) PoolServiceTotalDelegatorShares(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryClient) PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPoolAPRResponse)
+	err := c.cc.Invoke(ctx, Query_PoolAPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorAPR(ctx context.Context, in *QueryOperatorAPRRequest, opts ...grpc.CallOption) (*QueryOperatorAPRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOperatorAPRResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorAPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceAPR(ctx context.Context, in *QueryServiceAPRRequest, opts ...grpc.CallOption) (*QueryServiceAPRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryServiceAPRResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceAPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolServiceAPR(ctx context.Context, in *QueryPoolServiceAPRRequest, opts ...grpc.CallOption) (*QueryPoolServiceAPRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPoolServiceAPRResponse)
+	err := c.cc.Invoke(ctx, Query_PoolServiceAPR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPoolDelegationRewardsResponse)
@@ -212,6 +299,16 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorAutoCompoundSettingsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompoundSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -225,6 +322,11 @@ type QueryServer interface {
 	RewardsPlans(context.Context, *QueryRewardsPlansRequest) (*QueryRewardsPlansResponse, error)
 	// RewardsPlan queries a specific rewards plan by its ID.
 	RewardsPlan(context.Context, *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error)
+	// ArchivedRewardsPlans queries all the rewards plans that have been
+	// terminated.
+	ArchivedRewardsPlans(context.Context, *QueryArchivedRewardsPlansRequest) (*QueryArchivedRewardsPlansResponse, error)
+	// ArchivedRewardsPlan queries a specific terminated rewards plan by its ID.
+	ArchivedRewardsPlan(context.Context, *QueryArchivedRewardsPlanRequest) (*QueryArchivedRewardsPlanResponse, error)
 	// PoolOutstandingRewards queries rewards of a pool.
 	PoolOutstandingRewards(context.Context, *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error)
 	// OperatorOutstandingRewards queries rewards of an operator.
@@ -236,6 +338,18 @@ type QueryServer interface {
 	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
 	// pool-service pair.
 	PoolServiceTotalDelegatorShares(context.Context, *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
+	// PoolAPR queries the projected annualised yield of the delegations to a
+	// pool, derived from the currently active rewards plans.
+	PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error)
+	// OperatorAPR queries the projected annualised yield of the delegations to
+	// an operator, derived from the currently active rewards plans.
+	OperatorAPR(context.Context, *QueryOperatorAPRRequest) (*QueryOperatorAPRResponse, error)
+	// ServiceAPR queries the projected annualised yield of the delegations to
+	// a service, derived from the currently active rewards plans.
+	ServiceAPR(context.Context, *QueryServiceAPRRequest) (*QueryServiceAPRResponse, error)
+	// PoolServiceAPR queries the projected annualised yield that the
+	// delegations to a pool receive from the rewards plans of a service.
+	PoolServiceAPR(context.Context, *QueryPoolServiceAPRRequest) (*QueryPoolServiceAPRResponse, error)
 	// PoolDelegationRewards queries the total rewards accrued by a pool
 	// delegation.
 	PoolDelegationRewards(context.Context, *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error)
@@ -250,6 +364,9 @@ type QueryServer interface {
 	DelegatorTotalRewards(context.Context, *QueryDelegatorTotalRewardsRequest) (*QueryDelegatorTotalRewardsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegation targets for which a
+	// delegator has enabled the auto-compounding of the rewards.
+	DelegatorAutoCompoundSettings(context.Context, *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -269,6 +386,12 @@ func (UnimplementedQueryServer) RewardsPlans(context.Context, *QueryRewardsPlans
 func (UnimplementedQueryServer) RewardsPlan(context.Context, *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsPlan not implemented")
 }
+func (UnimplementedQueryServer) ArchivedRewardsPlans(context.Context, *QueryArchivedRewardsPlansRequest) (*QueryArchivedRewardsPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedRewardsPlans not implemented")
+}
+func (UnimplementedQueryServer) ArchivedRewardsPlan(context.Context, *QueryArchivedRewardsPlanRequest) (*QueryArchivedRewardsPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedRewardsPlan not implemented")
+}
 func (UnimplementedQueryServer) PoolOutstandingRewards(context.Context, *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolOutstandingRewards not implemented")
 }
@@ -284,6 +407,18 @@ func (UnimplementedQueryServer) OperatorCommission(context.Context, *QueryOperat
 func (UnimplementedQueryServer) PoolServiceTotalDelegatorShares(context.Context, *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolServiceTotalDelegatorShares not implemented")
 }
+func (UnimplementedQueryServer) PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
+func (UnimplementedQueryServer) OperatorAPR(context.Context, *QueryOperatorAPRRequest) (*QueryOperatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorAPR not implemented")
+}
+func (UnimplementedQueryServer) ServiceAPR(context.Context, *QueryServiceAPRRequest) (*QueryServiceAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceAPR not implemented")
+}
+func (UnimplementedQueryServer) PoolServiceAPR(context.Context, *QueryPoolServiceAPRRequest) (*QueryPoolServiceAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolServiceAPR not implemented")
+}
 func (UnimplementedQueryServer) PoolDelegationRewards(context.Context, *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegationRewards not implemented")
 }
@@ -299,6 +434,9 @@ func (UnimplementedQueryServer) DelegatorTotalRewards(context.Context, *QueryDel
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DelegatorAutoCompoundSettings(context.Context, *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundSettings not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -374,6 +512,42 @@ func _Query_RewardsPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ArchivedRewardsPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedRewardsPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedRewardsPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ArchivedRewardsPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedRewardsPlans(ctx, req.(*QueryArchivedRewardsPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedRewardsPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedRewardsPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedRewardsPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ArchivedRewardsPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedRewardsPlan(ctx, req.(*QueryArchivedRewardsPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PoolOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolOutstandingRewardsRequest)
 	if err := dec(in); err != nil {
@@ -464,6 +638,78 @@ func _Query_PoolServiceTotalDelegatorShares_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolAPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*QueryPoolAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorAPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorAPR(ctx, req.(*QueryOperatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceAPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceAPR(ctx, req.(*QueryServiceAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolServiceAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolServiceAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolServiceAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolServiceAPR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolServiceAPR(ctx, req.(*QueryPoolServiceAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PoolDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolDelegationRewardsRequest)
 	if err := dec(in); err != nil {
@@ -554,6 +800,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompoundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompoundSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, req.(*QueryDelegatorAutoCompoundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -573,6 +837,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsPlan",
 			Handler:    _Query_RewardsPlan_Handler,
 		},
+		{
+			MethodName: "ArchivedRewardsPlans",
+			Handler:    _Query_ArchivedRewardsPlans_Handler,
+		},
+		{
+			MethodName: "ArchivedRewardsPlan",
+			Handler:    _Query_ArchivedRewardsPlan_Handler,
+		},
 		{
 			MethodName: "PoolOutstandingRewards",
 			Handler:    _Query_PoolOutstandingRewards_Handler,
@@ -593,6 +865,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolServiceTotalDelegatorShares",
 			Handler:    _Query_PoolServiceTotalDelegatorShares_Handler,
 		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
+		{
+			MethodName: "OperatorAPR",
+			Handler:    _Query_OperatorAPR_Handler,
+		},
+		{
+			MethodName: "ServiceAPR",
+			Handler:    _Query_ServiceAPR_Handler,
+		},
+		{
+			MethodName: "PoolServiceAPR",
+			Handler:    _Query_PoolServiceAPR_Handler,
+		},
 		{
 			MethodName: "PoolDelegationRewards",
 			Handler:    _Query_PoolDelegationRewards_Handler,
@@ -613,6 +901,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompoundSettings",
+			Handler:    _Query_DelegatorAutoCompoundSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/rewards/v1/query.proto",
@@ -26,6 +26,8 @@ const (
 	Msg_DeleteService_FullMethodName              = "/milkyway.services.v1.Msg/DeleteService"
 	Msg_TransferServiceOwnership_FullMethodName   = "/milkyway.services.v1.Msg/TransferServiceOwnership"
 	Msg_SetServiceParams_FullMethodName           = "/milkyway.services.v1.Msg/SetServiceParams"
+	Msg_GrantServiceRole_FullMethodName           = "/milkyway.services.v1.Msg/GrantServiceRole"
+	Msg_RevokeServiceRole_FullMethodName          = "/milkyway.services.v1.Msg/RevokeServiceRole"
 	Msg_UpdateParams_FullMethodName               = "/milkyway.services.v1.Msg/UpdateParams"
 	Msg_AccreditService_FullMethodName            = "/milkyway.services.v1.Msg/AccreditService"
 	Msg_RevokeServiceAccreditation_FullMethodName = "/milkyway.services.v1.Msg/RevokeServiceAccreditation"
@@ -56,6 +58,12 @@ type MsgClient interface {
 	// SetServiceParams defines the operation for setting a service's
 	// parameters.
 	SetServiceParams(ctx context.Context, in *MsgSetServiceParams, opts ...grpc.CallOption) (*MsgSetServiceParamsResponse, error)
+	// GrantServiceRole defines the operation for granting a role of a service
+	// to another account.
+	GrantServiceRole(ctx context.Context, in *MsgGrantServiceRole, opts ...grpc.CallOption) (*MsgGrantServiceRoleResponse, error)
+	// RevokeServiceRole defines the operation for revoking a role of a service
+	// previously granted to another account.
+	RevokeServiceRole(ctx context.Context, in *MsgRevokeServiceRole, opts ...grpc.CallOption) (*MsgRevokeServiceRoleResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -146,6 +154,26 @@ func (c *msgClient) SetServiceParams(ctx context.Context, in *MsgSetServiceParam
 	return out, nil
 }
 
+func (c *msgClient) GrantServiceRole(ctx context.Context, in *MsgGrantServiceRole, opts ...grpc.CallOption) (*MsgGrantServiceRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantServiceRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantServiceRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeServiceRole(ctx context.Context, in *MsgRevokeServiceRole, opts ...grpc.CallOption) (*MsgRevokeServiceRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeServiceRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeServiceRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -201,6 +229,12 @@ type MsgServer interface {
 	// SetServiceParams defines the operation for setting a service's
 	// parameters.
 	SetServiceParams(context.Context, *MsgSetServiceParams) (*MsgSetServiceParamsResponse, error)
+	// GrantServiceRole defines the operation for granting a role of a service
+	// to another account.
+	GrantServiceRole(context.Context, *MsgGrantServiceRole) (*MsgGrantServiceRoleResponse, error)
+	// RevokeServiceRole defines the operation for revoking a role of a service
+	// previously granted to another account.
+	RevokeServiceRole(context.Context, *MsgRevokeServiceRole) (*MsgRevokeServiceRoleResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -242,6 +276,12 @@ func (UnimplementedMsgServer) TransferServiceOwnership(context.Context, *MsgTran
 func (UnimplementedMsgServer) SetServiceParams(context.Context, *MsgSetServiceParams) (*MsgSetServiceParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetServiceParams not implemented")
 }
+func (UnimplementedMsgServer) GrantServiceRole(context.Context, *MsgGrantServiceRole) (*MsgGrantServiceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantServiceRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeServiceRole(context.Context, *MsgRevokeServiceRole) (*MsgRevokeServiceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceRole not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -398,6 +438,42 @@ func _Msg_SetServiceParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantServiceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantServiceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantServiceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantServiceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantServiceRole(ctx, req.(*MsgGrantServiceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeServiceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeServiceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeServiceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeServiceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeServiceRole(ctx, req.(*MsgRevokeServiceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -487,6 +563,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetServiceParams",
 			Handler:    _Msg_SetServiceParams_Handler,
 		},
+		{
+			MethodName: "GrantServiceRole",
+			Handler:    _Msg_GrantServiceRole_Handler,
+		},
+		{
+			MethodName: "RevokeServiceRole",
+			Handler:    _Msg_RevokeServiceRole_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1228,14 +1228,26 @@ func (x *_ServiceParams_1_list) IsValid() bool {
 }
 
 var (
-	md_ServiceParams                protoreflect.MessageDescriptor
-	fd_ServiceParams_allowed_denoms protoreflect.FieldDescriptor
+	md_ServiceParams                        protoreflect.MessageDescriptor
+	fd_ServiceParams_allowed_denoms         protoreflect.FieldDescriptor
+	fd_ServiceParams_min_operator_stake_usd protoreflect.FieldDescriptor
+	fd_ServiceParams_max_active_operators   protoreflect.FieldDescriptor
+	fd_ServiceParams_snapshot_interval      protoreflect.FieldDescriptor
+	fd_ServiceParams_snapshot_retention     protoreflect.FieldDescriptor
+	fd_ServiceParams_evidence_verifier      protoreflect.FieldDescriptor
+	fd_ServiceParams_unbonding_time         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_milkyway_services_v1_models_proto_init()
 	md_ServiceParams = File_milkyway_services_v1_models_proto.Messages().ByName("ServiceParams")
 	fd_ServiceParams_allowed_denoms = md_ServiceParams.Fields().ByName("allowed_denoms")
+	fd_ServiceParams_min_operator_stake_usd = md_ServiceParams.Fields().ByName("min_operator_stake_usd")
+	fd_ServiceParams_max_active_operators = md_ServiceParams.Fields().ByName("max_active_operators")
+	fd_ServiceParams_snapshot_interval = md_ServiceParams.Fields().ByName("snapshot_interval")
+	fd_ServiceParams_snapshot_retention = md_ServiceParams.Fields().ByName("snapshot_retention")
+	fd_ServiceParams_evidence_verifier = md_ServiceParams.Fields().ByName("evidence_verifier")
+	fd_ServiceParams_unbonding_time = md_ServiceParams.Fields().ByName("unbonding_time")
 }
 
 var _ protoreflect.Message = (*fastReflection_ServiceParams)(nil)
@@ -1309,6 +1321,764 @@ func (x *fastReflection_ServiceParams) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.MinOperatorStakeUsd != "" {
+		value := protoreflect.ValueOfString(x.MinOperatorStakeUsd)
+		if !f(fd_ServiceParams_min_operator_stake_usd, value) {
+			return
+		}
+	}
+	if x.MaxActiveOperators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxActiveOperators)
+		if !f(fd_ServiceParams_max_active_operators, value) {
+			return
+		}
+	}
+	if x.SnapshotInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SnapshotInterval)
+		if !f(fd_ServiceParams_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.SnapshotRetention != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SnapshotRetention)
+		if !f(fd_ServiceParams_snapshot_retention, value) {
+			return
+		}
+	}
+	if x.EvidenceVerifier != "" {
+		value := protoreflect.ValueOfString(x.EvidenceVerifier)
+		if !f(fd_ServiceParams_evidence_verifier, value) {
+			return
+		}
+	}
+	if x.UnbondingTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingTime)
+		if !f(fd_ServiceParams_unbonding_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		return x.MinOperatorStakeUsd != ""
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		return x.MaxActiveOperators != uint32(0)
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		return x.SnapshotInterval != uint64(0)
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		return x.SnapshotRetention != uint32(0)
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		return x.EvidenceVerifier != ""
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		return x.UnbondingTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		x.MinOperatorStakeUsd = ""
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		x.MaxActiveOperators = uint32(0)
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		x.SnapshotInterval = uint64(0)
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		x.SnapshotRetention = uint32(0)
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		x.EvidenceVerifier = ""
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		x.UnbondingTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_ServiceParams_1_list{})
+		}
+		listValue := &_ServiceParams_1_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		value := x.MinOperatorStakeUsd
+		return protoreflect.ValueOfString(value)
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		value := x.MaxActiveOperators
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		value := x.SnapshotInterval
+		return protoreflect.ValueOfUint64(value)
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		value := x.SnapshotRetention
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		value := x.EvidenceVerifier
+		return protoreflect.ValueOfString(value)
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		value := x.UnbondingTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_ServiceParams_1_list)
+		x.AllowedDenoms = *clv.list
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		x.MinOperatorStakeUsd = value.Interface().(string)
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		x.MaxActiveOperators = uint32(value.Uint())
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		x.SnapshotInterval = value.Uint()
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		x.SnapshotRetention = uint32(value.Uint())
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		x.EvidenceVerifier = value.Interface().(string)
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		x.UnbondingTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_ServiceParams_1_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		panic(fmt.Errorf("field min_operator_stake_usd of message milkyway.services.v1.ServiceParams is not mutable"))
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		panic(fmt.Errorf("field max_active_operators of message milkyway.services.v1.ServiceParams is not mutable"))
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		panic(fmt.Errorf("field snapshot_interval of message milkyway.services.v1.ServiceParams is not mutable"))
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		panic(fmt.Errorf("field snapshot_retention of message milkyway.services.v1.ServiceParams is not mutable"))
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		panic(fmt.Errorf("field evidence_verifier of message milkyway.services.v1.ServiceParams is not mutable"))
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		panic(fmt.Errorf("field unbonding_time of message milkyway.services.v1.ServiceParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "milkyway.services.v1.ServiceParams.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceParams_1_list{list: &list})
+	case "milkyway.services.v1.ServiceParams.min_operator_stake_usd":
+		return protoreflect.ValueOfString("")
+	case "milkyway.services.v1.ServiceParams.max_active_operators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.services.v1.ServiceParams.snapshot_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "milkyway.services.v1.ServiceParams.snapshot_retention":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.services.v1.ServiceParams.evidence_verifier":
+		return protoreflect.ValueOfString("")
+	case "milkyway.services.v1.ServiceParams.unbonding_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+		}
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.services.v1.ServiceParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MinOperatorStakeUsd)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxActiveOperators != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActiveOperators))
+		}
+		if x.SnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotInterval))
+		}
+		if x.SnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotRetention))
+		}
+		l = len(x.EvidenceVerifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnbondingTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnbondingTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingTime))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EvidenceVerifier) > 0 {
+			i -= len(x.EvidenceVerifier)
+			copy(dAtA[i:], x.EvidenceVerifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceVerifier)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotRetention))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotInterval))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxActiveOperators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActiveOperators))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MinOperatorStakeUsd) > 0 {
+			i -= len(x.MinOperatorStakeUsd)
+			copy(dAtA[i:], x.MinOperatorStakeUsd)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOperatorStakeUsd)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOperatorStakeUsd", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinOperatorStakeUsd = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActiveOperators", wireType)
+				}
+				x.MaxActiveOperators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActiveOperators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+				}
+				x.SnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+				}
+				x.SnapshotRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotRetention |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceVerifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceVerifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				}
+				x.UnbondingTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceRoleGrant            protoreflect.MessageDescriptor
+	fd_ServiceRoleGrant_service_id protoreflect.FieldDescriptor
+	fd_ServiceRoleGrant_grantee    protoreflect.FieldDescriptor
+	fd_ServiceRoleGrant_role       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_milkyway_services_v1_models_proto_init()
+	md_ServiceRoleGrant = File_milkyway_services_v1_models_proto.Messages().ByName("ServiceRoleGrant")
+	fd_ServiceRoleGrant_service_id = md_ServiceRoleGrant.Fields().ByName("service_id")
+	fd_ServiceRoleGrant_grantee = md_ServiceRoleGrant.Fields().ByName("grantee")
+	fd_ServiceRoleGrant_role = md_ServiceRoleGrant.Fields().ByName("role")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceRoleGrant)(nil)
+
+type fastReflection_ServiceRoleGrant ServiceRoleGrant
+
+func (x *ServiceRoleGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceRoleGrant)(x)
+}
+
+func (x *ServiceRoleGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_milkyway_services_v1_models_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceRoleGrant_messageType fastReflection_ServiceRoleGrant_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceRoleGrant_messageType{}
+
+type fastReflection_ServiceRoleGrant_messageType struct{}
+
+func (x fastReflection_ServiceRoleGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceRoleGrant)(nil)
+}
+func (x fastReflection_ServiceRoleGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceRoleGrant)
+}
+func (x fastReflection_ServiceRoleGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceRoleGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceRoleGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceRoleGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceRoleGrant) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceRoleGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceRoleGrant) New() protoreflect.Message {
+	return new(fastReflection_ServiceRoleGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceRoleGrant) Interface() protoreflect.ProtoMessage {
+	return (*ServiceRoleGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceRoleGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ServiceId)
+		if !f(fd_ServiceRoleGrant_service_id, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_ServiceRoleGrant_grantee, value) {
+			return
+		}
+	}
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_ServiceRoleGrant_role, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1322,15 +2092,19 @@ func (x *fastReflection_ServiceParams) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ServiceParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ServiceRoleGrant) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		return len(x.AllowedDenoms) != 0
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		return x.ServiceId != uint32(0)
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		return x.Grantee != ""
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		return x.Role != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1340,15 +2114,19 @@ func (x *fastReflection_ServiceParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ServiceParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ServiceRoleGrant) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		x.AllowedDenoms = nil
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		x.ServiceId = uint32(0)
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		x.Grantee = ""
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		x.Role = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1358,19 +2136,22 @@ func (x *fastReflection_ServiceParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ServiceParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceRoleGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		if len(x.AllowedDenoms) == 0 {
-			return protoreflect.ValueOfList(&_ServiceParams_1_list{})
-		}
-		listValue := &_ServiceParams_1_list{list: &x.AllowedDenoms}
-		return protoreflect.ValueOfList(listValue)
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfUint32(value)
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1384,17 +2165,19 @@ func (x *fastReflection_ServiceParams) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ServiceParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ServiceRoleGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		lv := value.List()
-		clv := lv.(*_ServiceParams_1_list)
-		x.AllowedDenoms = *clv.list
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		x.ServiceId = uint32(value.Uint())
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		x.Grantee = value.Interface().(string)
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		x.Role = (ServiceRole)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1408,45 +2191,48 @@ func (x *fastReflection_ServiceParams) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ServiceParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceRoleGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		if x.AllowedDenoms == nil {
-			x.AllowedDenoms = []string{}
-		}
-		value := &_ServiceParams_1_list{list: &x.AllowedDenoms}
-		return protoreflect.ValueOfList(value)
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		panic(fmt.Errorf("field service_id of message milkyway.services.v1.ServiceRoleGrant is not mutable"))
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		panic(fmt.Errorf("field grantee of message milkyway.services.v1.ServiceRoleGrant is not mutable"))
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		panic(fmt.Errorf("field role of message milkyway.services.v1.ServiceRoleGrant is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ServiceParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ServiceRoleGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "milkyway.services.v1.ServiceParams.allowed_denoms":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ServiceParams_1_list{list: &list})
+	case "milkyway.services.v1.ServiceRoleGrant.service_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "milkyway.services.v1.ServiceRoleGrant.grantee":
+		return protoreflect.ValueOfString("")
+	case "milkyway.services.v1.ServiceRoleGrant.role":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: milkyway.services.v1.ServiceRoleGrant"))
 		}
-		panic(fmt.Errorf("message milkyway.services.v1.ServiceParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message milkyway.services.v1.ServiceRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ServiceParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ServiceRoleGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in milkyway.services.v1.ServiceParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in milkyway.services.v1.ServiceRoleGrant", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1454,7 +2240,7 @@ func (x *fastReflection_ServiceParams) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ServiceParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ServiceRoleGrant) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1465,7 +2251,7 @@ func (x *fastReflection_ServiceParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ServiceParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ServiceRoleGrant) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1477,7 +2263,7 @@ func (x *fastReflection_ServiceParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ServiceParams) IsValid() bool {
+func (x *fastReflection_ServiceRoleGrant) IsValid() bool {
 	return x != nil
 }
 
@@ -1487,9 +2273,9 @@ func (x *fastReflection_ServiceParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ServiceRoleGrant) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ServiceParams)
+		x := input.Message.Interface().(*ServiceRoleGrant)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1501,11 +2287,15 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.AllowedDenoms) > 0 {
-			for _, s := range x.AllowedDenoms {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ServiceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ServiceId))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1517,7 +2307,7 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ServiceParams)
+		x := input.Message.Interface().(*ServiceRoleGrant)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1536,14 +2326,22 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AllowedDenoms) > 0 {
-			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AllowedDenoms[iNdEx])
-				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ServiceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ServiceId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1556,7 +2354,7 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ServiceParams)
+		x := input.Message.Interface().(*ServiceRoleGrant)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1588,15 +2386,34 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceRoleGrant: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				x.ServiceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ServiceId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1624,8 +2441,27 @@ func (x *fastReflection_ServiceParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				x.Grantee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				x.Role = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Role |= ServiceRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1732,6 +2568,66 @@ func (ServiceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_milkyway_services_v1_models_proto_rawDescGZIP(), []int{0}
 }
 
+// ServiceRole defines the permissions that the admin of a service can grant
+// to other accounts
+type ServiceRole int32
+
+const (
+	// SERVICE_ROLE_UNSPECIFIED defines an unspecified role
+	ServiceRole_SERVICE_ROLE_UNSPECIFIED ServiceRole = 0
+	// SERVICE_ROLE_METADATA allows to update the service details
+	ServiceRole_SERVICE_ROLE_METADATA ServiceRole = 1
+	// SERVICE_ROLE_ALLOW_LIST allows to manage the list of operators allowed to
+	// join the service
+	ServiceRole_SERVICE_ROLE_ALLOW_LIST ServiceRole = 2
+	// SERVICE_ROLE_REWARDS_PLANS allows to create and edit the rewards plans of
+	// the service
+	ServiceRole_SERVICE_ROLE_REWARDS_PLANS ServiceRole = 3
+)
+
+// Enum value maps for ServiceRole.
+var (
+	ServiceRole_name = map[int32]string{
+		0: "SERVICE_ROLE_UNSPECIFIED",
+		1: "SERVICE_ROLE_METADATA",
+		2: "SERVICE_ROLE_ALLOW_LIST",
+		3: "SERVICE_ROLE_REWARDS_PLANS",
+	}
+	ServiceRole_value = map[string]int32{
+		"SERVICE_ROLE_UNSPECIFIED":   0,
+		"SERVICE_ROLE_METADATA":      1,
+		"SERVICE_ROLE_ALLOW_LIST":    2,
+		"SERVICE_ROLE_REWARDS_PLANS": 3,
+	}
+)
+
+func (x ServiceRole) Enum() *ServiceRole {
+	p := new(ServiceRole)
+	*p = x
+	return p
+}
+
+func (x ServiceRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_milkyway_services_v1_models_proto_enumTypes[1].Descriptor()
+}
+
+func (ServiceRole) Type() protoreflect.EnumType {
+	return &file_milkyway_services_v1_models_proto_enumTypes[1]
+}
+
+func (x ServiceRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceRole.Descriptor instead.
+func (ServiceRole) EnumDescriptor() ([]byte, []int) {
+	return file_milkyway_services_v1_models_proto_rawDescGZIP(), []int{1}
+}
+
 // Service defines the fields of a service
 type Service struct {
 	state         protoimpl.MessageState
@@ -1877,6 +2773,31 @@ type ServiceParams struct {
 	// AllowedDenoms defines the list of denoms that can be restaked toward
 	// the service. If the list is empty, any denom can be used.
 	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// MinOperatorStakeUSD defines the minimum USD value of the stake (self plus
+	// delegated) that an operator must have in order to join the service and to
+	// be part of its active operators set. If set to 0, no minimum is required.
+	MinOperatorStakeUsd string `protobuf:"bytes,2,opt,name=min_operator_stake_usd,json=minOperatorStakeUsd,proto3" json:"min_operator_stake_usd,omitempty"`
+	// MaxActiveOperators defines the maximum number of operators that can be
+	// part of the service's active operators set. If set to 0, there is no
+	// limit to the number of active operators.
+	MaxActiveOperators uint32 `protobuf:"varint,3,opt,name=max_active_operators,json=maxActiveOperators,proto3" json:"max_active_operators,omitempty"`
+	// SnapshotInterval defines the number of blocks between two consecutive
+	// snapshots of the service's validating set. If set to 0, no snapshots are
+	// taken.
+	SnapshotInterval uint64 `protobuf:"varint,4,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// SnapshotRetention defines the number of most recent snapshots of the
+	// service's validating set that are kept. Older snapshots are pruned.
+	SnapshotRetention uint32 `protobuf:"varint,5,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// EvidenceVerifier defines the address of the CosmWasm contract used to
+	// verify the evidences of the operators' misbehaviors submitted for the
+	// service. If empty, the evidences are verified by the handlers registered
+	// inside the restaking module.
+	EvidenceVerifier string `protobuf:"bytes,6,opt,name=evidence_verifier,json=evidenceVerifier,proto3" json:"evidence_verifier,omitempty"`
+	// UnbondingTime defines the time that it takes for the assets securing the
+	// service to be unbonded. When a delegation secures multiple services, the
+	// longest unbonding time among them is used. If set to 0, the unbonding time
+	// set inside the restaking module params is used.
+	UnbondingTime int64 `protobuf:"varint,7,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
 }
 
 func (x *ServiceParams) Reset() {
@@ -1906,6 +2827,104 @@ func (x *ServiceParams) GetAllowedDenoms() []string {
 	return nil
 }
 
+func (x *ServiceParams) GetMinOperatorStakeUsd() string {
+	if x != nil {
+		return x.MinOperatorStakeUsd
+	}
+	return ""
+}
+
+func (x *ServiceParams) GetMaxActiveOperators() uint32 {
+	if x != nil {
+		return x.MaxActiveOperators
+	}
+	return 0
+}
+
+func (x *ServiceParams) GetSnapshotInterval() uint64 {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return 0
+}
+
+func (x *ServiceParams) GetSnapshotRetention() uint32 {
+	if x != nil {
+		return x.SnapshotRetention
+	}
+	return 0
+}
+
+func (x *ServiceParams) GetEvidenceVerifier() string {
+	if x != nil {
+		return x.EvidenceVerifier
+	}
+	return ""
+}
+
+func (x *ServiceParams) GetUnbondingTime() int64 {
+	if x != nil {
+		return x.UnbondingTime
+	}
+	return 0
+}
+
+// ServiceRoleGrant represents a role that has been granted by the admin of a
+// service to another account.
+type ServiceRoleGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ServiceID is the ID of the service.
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Grantee is the address of the account that has been granted the role.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role that has been granted.
+	Role ServiceRole `protobuf:"varint,3,opt,name=role,proto3,enum=milkyway.services.v1.ServiceRole" json:"role,omitempty"`
+}
+
+func (x *ServiceRoleGrant) Reset() {
+	*x = ServiceRoleGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_milkyway_services_v1_models_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRoleGrant) ProtoMessage() {}
+
+// Deprecated: Use ServiceRoleGrant.ProtoReflect.Descriptor instead.
+func (*ServiceRoleGrant) Descriptor() ([]byte, []int) {
+	return file_milkyway_services_v1_models_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceRoleGrant) GetServiceId() uint32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *ServiceRoleGrant) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *ServiceRoleGrant) GetRole() ServiceRole {
+	if x != nil {
+		return x.Role
+	}
+	return ServiceRole_SERVICE_ROLE_UNSPECIFIED
+}
+
 var File_milkyway_services_v1_models_proto protoreflect.FileDescriptor
 
 var file_milkyway_services_v1_models_proto_rawDesc = []byte{
@@ -1954,10 +2973,45 @@ var file_milkyway_services_v1_models_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61,
 	0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65,
 	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x2a, 0x89, 0x01, 0x0a, 0x0d,
+	0x74, 0x65, 0x64, 0x22, 0xb7, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x7d, 0x0a, 0x16,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xe2, 0xde, 0x1f, 0x13, 0x4d, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x53, 0x44, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x65, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0d,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xab, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x2a, 0x89, 0x01, 0x0a, 0x0d,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
 	0x1a, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
 	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
@@ -1966,22 +3020,31 @@ var file_milkyway_services_v1_models_proto_rawDesc = []byte{
 	0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49,
 	0x56, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
 	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
-	0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe8, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x18,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x31, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x14, 0x4d, 0x69, 0x6c, 0x6b, 0x79,
-	0x77, 0x61, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x14, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61,
-	0x79, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4d, 0x69, 0x6c, 0x6b,
-	0x79, 0x77, 0x61, 0x79, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x89, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45,
+	0x57, 0x41, 0x52, 0x44, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x53, 0x10, 0x03, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x42, 0xe8, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x14, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x4d, 0x69,
+	0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x20, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79, 0x5c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4d, 0x69, 0x6c, 0x6b, 0x79, 0x77, 0x61, 0x79,
+	0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1996,24 +3059,27 @@ func file_milkyway_services_v1_models_proto_rawDescGZIP() []byte {
 	return file_milkyway_services_v1_models_proto_rawDescData
 }
 
-var file_milkyway_services_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_milkyway_services_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_milkyway_services_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_milkyway_services_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_milkyway_services_v1_models_proto_goTypes = []interface{}{
-	(ServiceStatus)(0),      // 0: milkyway.services.v1.ServiceStatus
-	(*Service)(nil),         // 1: milkyway.services.v1.Service
-	(*ServiceParams)(nil),   // 2: milkyway.services.v1.ServiceParams
-	(*v1beta1.Coin)(nil),    // 3: cosmos.base.v1beta1.Coin
-	(*v1beta1.DecCoin)(nil), // 4: cosmos.base.v1beta1.DecCoin
+	(ServiceStatus)(0),       // 0: milkyway.services.v1.ServiceStatus
+	(ServiceRole)(0),         // 1: milkyway.services.v1.ServiceRole
+	(*Service)(nil),          // 2: milkyway.services.v1.Service
+	(*ServiceParams)(nil),    // 3: milkyway.services.v1.ServiceParams
+	(*ServiceRoleGrant)(nil), // 4: milkyway.services.v1.ServiceRoleGrant
+	(*v1beta1.Coin)(nil),     // 5: cosmos.base.v1beta1.Coin
+	(*v1beta1.DecCoin)(nil),  // 6: cosmos.base.v1beta1.DecCoin
 }
 var file_milkyway_services_v1_models_proto_depIdxs = []int32{
 	0, // 0: milkyway.services.v1.Service.status:type_name -> milkyway.services.v1.ServiceStatus
-	3, // 1: milkyway.services.v1.Service.tokens:type_name -> cosmos.base.v1beta1.Coin
-	4, // 2: milkyway.services.v1.Service.delegator_shares:type_name -> cosmos.base.v1beta1.DecCoin
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 1: milkyway.services.v1.Service.tokens:type_name -> cosmos.base.v1beta1.Coin
+	6, // 2: milkyway.services.v1.Service.delegator_shares:type_name -> cosmos.base.v1beta1.DecCoin
+	1, // 3: milkyway.services.v1.ServiceRoleGrant.role:type_name -> milkyway.services.v1.ServiceRole
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_milkyway_services_v1_models_proto_init() }
@@ -2046,14 +3112,26 @@ func file_milkyway_services_v1_models_proto_init() {
 				return nil
 			}
 		}
+		file_milkyway_services_v1_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRoleGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_milkyway_services_v1_models_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Service_FullMethodName       = "/milkyway.services.v1.Query/Service"
-	Query_Services_FullMethodName      = "/milkyway.services.v1.Query/Services"
-	Query_ServiceParams_FullMethodName = "/milkyway.services.v1.Query/ServiceParams"
-	Query_Params_FullMethodName        = "/milkyway.services.v1.Query/Params"
+	Query_Service_FullMethodName           = "/milkyway.services.v1.Query/Service"
+	Query_Services_FullMethodName          = "/milkyway.services.v1.Query/Services"
+	Query_ServiceParams_FullMethodName     = "/milkyway.services.v1.Query/ServiceParams"
+	Query_ServiceRoleGrants_FullMethodName = "/milkyway.services.v1.Query/ServiceRoleGrants"
+	Query_Params_FullMethodName            = "/milkyway.services.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +41,9 @@ type QueryClient interface {
 	// ServiceParams defines a gRPC query method that returns the parameters of
 	// service.
 	ServiceParams(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error)
+	// ServiceRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the service with the given id.
+	ServiceRoleGrants(ctx context.Context, in *QueryServiceRoleGrantsRequest, opts ...grpc.CallOption) (*QueryServiceRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -83,6 +87,16 @@ func (c *queryClient) ServiceParams(ctx context.Context, in *QueryServiceParamsR
 	return out, nil
 }
 
+func (c *queryClient) ServiceRoleGrants(ctx context.Context, in *QueryServiceRoleGrantsRequest, opts ...grpc.CallOption) (*QueryServiceRoleGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryServiceRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceRoleGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -108,6 +122,9 @@ type QueryServer interface {
 	// ServiceParams defines a gRPC query method that returns the parameters of
 	// service.
 	ServiceParams(context.Context, *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error)
+	// ServiceRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the service with the given id.
+	ServiceRoleGrants(context.Context, *QueryServiceRoleGrantsRequest) (*QueryServiceRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -130,6 +147,9 @@ func (UnimplementedQueryServer) Services(context.Context, *QueryServicesRequest)
 func (UnimplementedQueryServer) ServiceParams(context.Context, *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceParams not implemented")
 }
+func (UnimplementedQueryServer) ServiceRoleGrants(context.Context, *QueryServiceRoleGrantsRequest) (*QueryServiceRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceRoleGrants not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -208,6 +228,24 @@ func _Query_ServiceParams_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ServiceRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceRoleGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceRoleGrants(ctx, req.(*QueryServiceRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +283,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ServiceParams",
 			Handler:    _Query_ServiceParams_Handler,
 		},
+		{
+			MethodName: "ServiceRoleGrants",
+			Handler:    _Query_ServiceRoleGrants_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
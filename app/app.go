@@ -72,6 +72,7 @@ import (
 	v11warpfix "github.com/milkyway-labs/milkyway/v12/app/upgrades/v11-warp-fix"
 	v12 "github.com/milkyway-labs/milkyway/v12/app/upgrades/v12"
 	v12commissionfix "github.com/milkyway-labs/milkyway/v12/app/upgrades/v12-commission-fix"
+	v13 "github.com/milkyway-labs/milkyway/v12/app/upgrades/v13"
 	v6 "github.com/milkyway-labs/milkyway/v12/app/upgrades/v6"
 	v9 "github.com/milkyway-labs/milkyway/v12/app/upgrades/v9"
 	_ "github.com/milkyway-labs/milkyway/v12/client/docs/statik"
@@ -95,6 +96,7 @@ var (
 		v11warpfix.Upgrade,
 		v12.Upgrade,
 		v12commissionfix.Upgrade,
+		v13.Upgrade,
 	}
 )
 
@@ -602,6 +602,8 @@ func NewAppKeeper(
 	appKeepers.AssetsKeeper = assetskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[assetstypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.OracleKeeper,
 		govAuthority,
 	)
 	appKeepers.RestakingKeeper = restakingkeeper.NewKeeper(
@@ -612,7 +614,6 @@ func NewAppKeeper(
 		appKeepers.PoolsKeeper,
 		appKeepers.OperatorsKeeper,
 		appKeepers.ServicesKeeper,
-		appKeepers.OracleKeeper,
 		appKeepers.AssetsKeeper,
 		govAuthority,
 	)
@@ -622,7 +623,6 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
-		appKeepers.OracleKeeper,
 		appKeepers.PoolsKeeper,
 		appKeepers.OperatorsKeeper,
 		appKeepers.ServicesKeeper,
@@ -678,9 +678,17 @@ func NewAppKeeper(
 	appKeepers.RestakingKeeper.SetRestakeRestriction(
 		appKeepers.LiquidVestingKeeper.RestakeRestrictionFn,
 	)
+	appKeepers.RestakingKeeper.SetSlashCover(
+		appKeepers.LiquidVestingKeeper.SlashCoverFn,
+	)
+	appKeepers.RestakingKeeper.SetContractKeeper(appKeepers.ContractKeeper)
+	appKeepers.AssetsKeeper.SetContractQuerier(&appKeepers.WasmKeeper)
+	appKeepers.RestakingKeeper.SetEvidenceRouter(restakingtypes.NewEvidenceRouter())
+	appKeepers.OperatorsKeeper.SetRestakingKeeper(appKeepers.RestakingKeeper)
 
 	// Must be called on PFMRouter AFTER TransferKeeper initialized
 	appKeepers.PFMRouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
+	appKeepers.RewardsKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
 	// Middleware Stacks
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
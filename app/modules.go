@@ -112,6 +112,7 @@ var MaccPerms = map[string][]string{
 	rewardstypes.RewardsPoolName:  nil,
 	liquidvestingtypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	investorstypes.ModuleName:     nil,
+	restakingtypes.ModuleName:     {authtypes.Burner},
 
 	// Warp module
 	warptypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
@@ -274,6 +275,7 @@ func orderBeginBlockers() []string {
 		marketmaptypes.ModuleName,
 
 		// MilkyWay modules
+		assetstypes.ModuleName,
 		rewardstypes.ModuleName,
 		servicestypes.ModuleName,
 		operatorstypes.ModuleName,
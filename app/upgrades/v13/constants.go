@@ -0,0 +1,18 @@
+package v13
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/milkyway-labs/milkyway/v12/app/upgrades"
+)
+
+const UpgradeName = "v13"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added:   []string{},
+		Deleted: []string{},
+	},
+}
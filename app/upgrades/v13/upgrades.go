@@ -0,0 +1,41 @@
+package v13
+
+import (
+	"context"
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/milkyway-labs/milkyway/v12/app/keepers"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configuration module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		vm, err := mm.RunMigrations(ctx, configuration, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// Allow the restaking module account to burn the slashed funds. The
+		// permissions of an existing module account are not updated automatically
+		moduleAccount := keepers.AccountKeeper.GetModuleAccount(ctx, restakingtypes.ModuleName)
+		if !moduleAccount.HasPermission(authtypes.Burner) {
+			account, ok := moduleAccount.(*authtypes.ModuleAccount)
+			if !ok {
+				return nil, fmt.Errorf("invalid restaking module account type: %T", moduleAccount)
+			}
+
+			account.Permissions = append(account.Permissions, authtypes.Burner)
+			keepers.AccountKeeper.SetModuleAccount(ctx, account)
+		}
+
+		return vm, nil
+	}
+}
@@ -1,10 +1,14 @@
 package utils
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // MapGetOrDefault gets a value from the map with the given key. If the key
@@ -57,3 +61,154 @@ func IsKeySetEmpty[K any](ctx sdk.Context, collectionMap collections.KeySet[K],
 
 	return true, nil
 }
+
+// paginatedRanger wraps a collections.Ranger so that the iteration starts from
+// the given pagination key, following the requested order.
+type paginatedRanger[K any] struct {
+	ranger  collections.Ranger[K]
+	key     *K
+	reverse bool
+}
+
+// RangeValues implements collections.Ranger
+func (r paginatedRanger[K]) RangeValues() (start, end *collections.RangeKey[K], order collections.Order, err error) {
+	if r.ranger != nil {
+		start, end, _, err = r.ranger.RangeValues()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	order = collections.OrderAscending
+	if r.reverse {
+		order = collections.OrderDescending
+	}
+
+	if r.key != nil {
+		if r.reverse {
+			end = collections.RangeKeyNext(*r.key)
+		} else {
+			start = collections.RangeKeyExact(*r.key)
+		}
+	}
+
+	return start, end, order, nil
+}
+
+// CollectionIndexPaginate works in the same way as query.CollectionFilteredPaginate,
+// but iterates over the references of the given multi index that are included
+// within the given ranger. The value associated to each reference is loaded
+// using the provided getValueFunc.
+// A nil predicateFunc means no filtering is applied and results are collected as is.
+// The pagination next key is the encoded full key (reference key, primary key)
+// of the index.
+func CollectionIndexPaginate[R, K, V, T any](
+	ctx context.Context,
+	index *indexes.Multi[R, K, V],
+	ranger collections.Ranger[collections.Pair[R, K]],
+	getValueFunc func(ctx context.Context, key K) (V, error),
+	pageReq *query.PageRequest,
+	predicateFunc func(key K, value V) (include bool, err error),
+	transformFunc func(key K, value V) (T, error),
+) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset := pageReq.Offset
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	if offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	pageRanger := paginatedRanger[collections.Pair[R, K]]{ranger: ranger, reverse: pageReq.Reverse}
+	if len(pageReq.Key) != 0 {
+		_, key, err := index.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		pageRanger.key = &key
+	}
+
+	iterator, err := index.Iterate(ctx, pageRanger)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	var (
+		skipped uint64
+		count   uint64
+		nextKey []byte
+		results []T
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		// Skip the first offset references
+		if skipped < offset {
+			skipped++
+			continue
+		}
+
+		// If we have found all the requested results, we only need to get the
+		// next key and, if required, keep counting the remaining results
+		if count == limit && nextKey == nil {
+			fullKey, err := iterator.FullKey()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			nextKey = make([]byte, index.KeyCodec().Size(fullKey))
+			_, err = index.KeyCodec().Encode(nextKey, fullKey)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !countTotal {
+				break
+			}
+		}
+
+		primaryKey, err := iterator.PrimaryKey()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		value, err := getValueFunc(ctx, primaryKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if predicateFunc != nil {
+			include, err := predicateFunc(primaryKey, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !include {
+				continue
+			}
+		}
+
+		if count < limit {
+			transformed, err := transformFunc(primaryKey, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			results = append(results, transformed)
+		}
+		count++
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count + offset
+	}
+
+	return results, pageRes, nil
+}
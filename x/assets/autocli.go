@@ -30,6 +30,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "denom"},
 					},
 				},
+				{
+					RpcMethod: "RedemptionRate",
+					Use:       "redemption-rate [denom]",
+					Short:     "Query the redemption rate of a liquid staking asset by its denomination",
+					Example:   fmt.Sprintf(`$ %s query assets redemption-rate stumilk`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -44,6 +53,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "DeregisterAsset",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "UpdateRedemptionRate",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ResetRedemptionRate",
+					Skip:      true,
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
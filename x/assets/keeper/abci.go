@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	"context"
+)
+
+// BeginBlocker is called at the beginning of every block
+func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	return k.UpdateContractRedemptionRates(ctx)
+}
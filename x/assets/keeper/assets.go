@@ -16,6 +16,12 @@ func (k *Keeper) SetAsset(ctx context.Context, asset types.Asset) error {
 	if err := k.TickerIndexes.Set(ctx, collections.Join(asset.Ticker, asset.Denom)); err != nil {
 		return err
 	}
+
+	// Remove the redemption rate if the asset is no longer a liquid staking asset
+	if !asset.IsLiquidStakingAsset() {
+		return k.removeRedemptionRate(ctx, asset.Denom)
+	}
+
 	return nil
 }
 
@@ -35,5 +41,8 @@ func (k *Keeper) RemoveAsset(ctx context.Context, denom string) error {
 	if err := k.Assets.Remove(ctx, denom); err != nil {
 		return err
 	}
+	if err := k.removeRedemptionRate(ctx, denom); err != nil {
+		return err
+	}
 	return k.TickerIndexes.Remove(ctx, collections.Join(asset.Ticker, denom))
 }
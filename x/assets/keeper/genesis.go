@@ -15,7 +15,19 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		return false, nil
 	})
 
-	return types.NewGenesisState(assets), nil
+	// Get all the redemption rates
+	redemptionRates, err := k.GetAllRedemptionRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all the redemption rate snapshots
+	redemptionRateSnapshots, err := k.GetAllRedemptionRateSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(assets, redemptionRates, redemptionRateSnapshots), nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -30,5 +42,21 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		}
 	}
 
+	// Store the redemption rates
+	for _, rate := range state.RedemptionRates {
+		err := k.SetRedemptionRate(ctx, rate.Denom, rate.Rate)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the redemption rate snapshots
+	for _, snapshot := range state.RedemptionRateSnapshots {
+		err := k.SetRedemptionRateSnapshot(ctx, snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
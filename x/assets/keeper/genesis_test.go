@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
@@ -34,6 +37,49 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 				},
 			},
 		},
+		{
+			name: "redemption rates are exported correctly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(125, 2))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot(
+					"stuatom",
+					math.LegacyNewDecWithPrec(12, 1),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+			},
+			shouldErr: false,
+			expGenesis: &types.GenesisState{
+				Assets: []types.Asset{
+					types.NewLiquidStakingAsset(
+						"stuatom",
+						"STATOM",
+						6,
+						types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+					),
+					types.NewAsset("uatom", "ATOM", 6),
+				},
+				RedemptionRates: []types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyNewDecWithPrec(125, 2)),
+				},
+				RedemptionRateSnapshots: []types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDecWithPrec(12, 1), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -71,6 +117,8 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 					types.NewAsset("umilk2", "MILK", 6),
 					types.NewAsset("uatom", "ATOM", 6),
 				},
+				nil,
+				nil,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -87,6 +135,42 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				suite.Require().Equal(types.NewAsset("uatom", "ATOM", 6), stored)
 			},
 		},
+		{
+			name: "redemption rates are initialized properly",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset(
+						"stuatom",
+						"STATOM",
+						6,
+						types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+					),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyNewDecWithPrec(125, 2)),
+				},
+				[]types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDecWithPrec(12, 1), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(math.LegacyNewDecWithPrec(125, 2), rate)
+
+				snapshot, found, err := suite.keeper.GetRedemptionRateSnapshot(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRedemptionRateSnapshot(
+					"stuatom",
+					math.LegacyNewDecWithPrec(12, 1),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				), snapshot)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,3 +86,28 @@ func (q queryServer) Asset(ctx context.Context, req *types.QueryAssetRequest) (*
 
 	return &types.QueryAssetResponse{Asset: asset}, nil
 }
+
+// RedemptionRate queries the redemption rate of a liquid staking asset by its denom
+func (q queryServer) RedemptionRate(ctx context.Context, req *types.QueryRedemptionRateRequest) (*types.QueryRedemptionRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Validate the denom
+	err := sdk.ValidateDenom(req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+
+	// Get the redemption rate
+	rate, found, err := q.k.GetRedemptionRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "redemption rate for denom %s not found", req.Denom)
+	}
+
+	return &types.QueryRedemptionRateResponse{RedemptionRate: types.NewRedemptionRate(req.Denom, rate)}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -156,3 +157,55 @@ func (suite *KeeperTestSuite) TestQuerier_Asset() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQuerier_RedemptionRate() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryRedemptionRateRequest
+		shouldErr bool
+		expRate   types.RedemptionRate
+	}{
+		{
+			name:      "invalid denom returns error",
+			req:       types.NewQueryRedemptionRateRequest("!@#$"),
+			shouldErr: true,
+		},
+		{
+			name:      "non-existing rate returns error",
+			req:       types.NewQueryRedemptionRateRequest("stuatom"),
+			shouldErr: true,
+		},
+		{
+			name: "existing rate is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(125, 2))
+				suite.Require().NoError(err)
+			},
+			req:       types.NewQueryRedemptionRateRequest("stuatom"),
+			shouldErr: false,
+			expRate:   types.NewRedemptionRate("stuatom", math.LegacyNewDecWithPrec(125, 2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.RedemptionRate(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRate, res.RedemptionRate)
+			}
+		})
+	}
+}
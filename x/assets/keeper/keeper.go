@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,12 +20,21 @@ type Keeper struct {
 	Assets        collections.Map[string, types.Asset]                 // denom => types.Asset
 	TickerIndexes collections.KeySet[collections.Pair[string, string]] // ticker + denom => nil
 
+	RedemptionRates         collections.Map[string, math.LegacyDec]               // denom => redemption rate
+	RedemptionRateSnapshots collections.Map[string, types.RedemptionRateSnapshot] // denom => types.RedemptionRateSnapshot
+
+	accountKeeper   types.AccountKeeper
+	oracleKeeper    types.OracleKeeper
+	contractQuerier types.ContractQuerier
+
 	authority string
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeService corestoretypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	oracleKeeper types.OracleKeeper,
 	authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
@@ -44,6 +54,23 @@ func NewKeeper(
 			"ticker_indexes",
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 		),
+		RedemptionRates: collections.NewMap(
+			sb,
+			types.RedemptionRateKeyPrefix,
+			"redemption_rates",
+			collections.StringKey,
+			sdk.LegacyDecValue,
+		),
+		RedemptionRateSnapshots: collections.NewMap(
+			sb,
+			types.RedemptionRateSnapshotKeyPrefix,
+			"redemption_rate_snapshots",
+			collections.StringKey,
+			codec.CollValue[types.RedemptionRateSnapshot](cdc),
+		),
+
+		accountKeeper: accountKeeper,
+		oracleKeeper:  oracleKeeper,
 
 		authority: authority,
 	}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
@@ -33,6 +35,22 @@ func (k msgServer) RegisterAsset(ctx context.Context, msg *types.MsgRegisterAsse
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	// Make sure the underlying asset is registered and is not a liquid staking
+	// asset itself, so that its price can be taken from the oracle directly
+	if msg.Asset.IsLiquidStakingAsset() {
+		underlying, err := k.GetAsset(ctx, msg.Asset.Underlying.Denom)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, errors.Wrapf(sdkerrors.ErrNotFound, "underlying asset %s not registered", msg.Asset.Underlying.Denom)
+			}
+			return nil, err
+		}
+
+		if underlying.IsLiquidStakingAsset() {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "underlying asset %s is a liquid staking asset", underlying.Denom)
+		}
+	}
+
 	// Store the asset
 	err = k.SetAsset(ctx, msg.Asset)
 	if err != nil {
@@ -81,3 +99,74 @@ func (k msgServer) DeregisterAsset(ctx context.Context, msg *types.MsgDeregister
 
 	return &types.MsgDeregisterAssetResponse{}, nil
 }
+
+// UpdateRedemptionRate defines the rpc method for Msg/UpdateRedemptionRate
+func (k msgServer) UpdateRedemptionRate(ctx context.Context, msg *types.MsgUpdateRedemptionRate) (*types.MsgUpdateRedemptionRateResponse, error) {
+	// Validate the message
+	err := msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the authority is correct
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	// Get the asset
+	asset, err := k.GetAsset(ctx, msg.Denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(sdkerrors.ErrNotFound, "asset %s not registered", msg.Denom)
+		}
+		return nil, err
+	}
+
+	// Make sure the rate of the asset is set by governance
+	if !asset.IsLiquidStakingAsset() {
+		return nil, errors.Wrapf(types.ErrNotLiquidStakingAsset, "asset %s has no underlying asset", msg.Denom)
+	}
+
+	if asset.Underlying.RateSource != types.REDEMPTION_RATE_SOURCE_GOVERNANCE {
+		return nil, errors.Wrapf(types.ErrInvalidRateSource, "redemption rate of %s is not set by governance", msg.Denom)
+	}
+
+	// Update the rate
+	err = k.Keeper.UpdateRedemptionRate(ctx, msg.Denom, msg.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateRedemptionRateResponse{}, nil
+}
+
+// ResetRedemptionRate defines the rpc method for Msg/ResetRedemptionRate
+func (k msgServer) ResetRedemptionRate(ctx context.Context, msg *types.MsgResetRedemptionRate) (*types.MsgResetRedemptionRateResponse, error) {
+	// Validate the message
+	err := msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the authority is correct
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	// Make sure the asset exists
+	_, err = k.GetAsset(ctx, msg.Denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(sdkerrors.ErrNotFound, "asset %s not registered", msg.Denom)
+		}
+		return nil, err
+	}
+
+	// Reset the rate
+	err = k.Keeper.ResetRedemptionRate(ctx, msg.Denom, msg.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetRedemptionRateResponse{}, nil
+}
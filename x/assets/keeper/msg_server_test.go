@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/milkyway-labs/milkyway/v12/x/assets/keeper"
@@ -46,6 +49,54 @@ func (suite *KeeperTestSuite) TestMsgServer_RegisterAsset() {
 				suite.Require().Equal(types.NewAsset("umilk", "MILK", 6), asset)
 			},
 		},
+		{
+			name: "liquid staking asset with unregistered underlying asset returns error",
+			msg: types.NewMsgRegisterAsset(suite.authority, types.NewLiquidStakingAsset(
+				"stuatom",
+				"STATOM",
+				6,
+				types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+			)),
+			shouldErr: true,
+		},
+		{
+			name: "liquid staking asset with liquid staking underlying asset returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRegisterAsset(suite.authority, types.NewLiquidStakingAsset(
+				"ststuatom",
+				"STSTATOM",
+				6,
+				types.NewUnderlyingAsset("stuatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+			)),
+			shouldErr: true,
+		},
+		{
+			name: "valid liquid staking asset is registered properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRegisterAsset(suite.authority, types.NewLiquidStakingAsset(
+				"stuatom",
+				"STATOM",
+				6,
+				types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+			)),
+			check: func(ctx sdk.Context) {
+				asset, err := suite.keeper.GetAsset(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(asset.IsLiquidStakingAsset())
+				suite.Require().Equal("uatom", asset.Underlying.Denom)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -153,3 +204,250 @@ func (suite *KeeperTestSuite) TestMsgServer_DeregisterAsset() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgServer_UpdateRedemptionRate() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgUpdateRedemptionRate
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "invalid authority returns error",
+			msg:       types.NewMsgUpdateRedemptionRate("invalid", "stuatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid rate returns error",
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyZeroDec()),
+			shouldErr: true,
+		},
+		{
+			name:      "asset not found returns error",
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name: "non liquid staking asset returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "uatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name: "asset with contract rate source returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_CONTRACT, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name: "rate change out of bounds returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyNewDecWithPrec(11, 1)),
+			shouldErr: true,
+		},
+		{
+			name: "first rate is set properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyNewDecWithPrec(125, 2)),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUpdateRedemptionRate,
+					sdk.NewAttribute(types.AttributeKeyDenom, "stuatom"),
+					sdk.NewAttribute(types.AttributeKeyRate, "1.250000000000000000"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(math.LegacyNewDecWithPrec(125, 2), rate)
+			},
+		},
+		{
+			name: "rate change within bounds is set properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUpdateRedemptionRate(suite.authority, "stuatom", math.LegacyNewDecWithPrec(102, 2)),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(math.LegacyNewDecWithPrec(102, 2), rate)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.keeper)
+			_, err := msgServer.UpdateRedemptionRate(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_ResetRedemptionRate() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgResetRedemptionRate
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "invalid authority returns error",
+			msg:       types.NewMsgResetRedemptionRate("invalid", "stuatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid rate returns error",
+			msg:       types.NewMsgResetRedemptionRate(suite.authority, "stuatom", math.LegacyZeroDec()),
+			shouldErr: true,
+		},
+		{
+			name:      "asset not found returns error",
+			msg:       types.NewMsgResetRedemptionRate(suite.authority, "stuatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name: "non liquid staking asset returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgResetRedemptionRate(suite.authority, "uatom", math.LegacyOneDec()),
+			shouldErr: true,
+		},
+		{
+			name: "rate out of bounds is reset properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+					"stuatom",
+					"STATOM",
+					6,
+					types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_CONTRACT, rateContractAddress, math.LegacyNewDecWithPrec(5, 2)),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot(
+					"stuatom",
+					math.LegacyOneDec(),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgResetRedemptionRate(suite.authority, "stuatom", math.LegacyNewDec(2)),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeResetRedemptionRate,
+					sdk.NewAttribute(types.AttributeKeyDenom, "stuatom"),
+					sdk.NewAttribute(types.AttributeKeyRate, "2.000000000000000000"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(math.LegacyNewDec(2), rate)
+
+				// Make sure a new window is started from the reset rate
+				snapshot, found, err := suite.keeper.GetRedemptionRateSnapshot(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDec(2), ctx.BlockTime()), snapshot)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.keeper)
+			_, err := msgServer.ResetRedemptionRate(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
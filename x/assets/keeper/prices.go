@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
+)
+
+// GetAssetPrice returns the asset having the given denom and its USD price,
+// along with the block time at which the price has been last updated by the
+// oracle. If either the asset or the price is not found, 0 is returned as price.
+// Liquid staking assets are priced using the price of their underlying asset
+// multiplied by their redemption rate.
+func (k *Keeper) GetAssetPrice(ctx context.Context, denom string) (types.Asset, math.LegacyDec, time.Time, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		// If asset is not found, then we return 0 as price.
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.Asset{}, math.LegacyZeroDec(), time.Time{}, nil
+		}
+		return types.Asset{}, math.LegacyDec{}, time.Time{}, err
+	}
+
+	if asset.IsLiquidStakingAsset() {
+		price, priceTime, err := k.getLiquidStakingAssetPrice(ctx, asset)
+		return asset, price, priceTime, err
+	}
+
+	price, priceTime, err := k.getTickerPrice(ctx, asset.Ticker)
+	return asset, price, priceTime, err
+}
+
+// getTickerPrice returns the USD price of the given ticker along with the block
+// time at which it has been last updated by the oracle. If the price is not
+// found, 0 is returned.
+func (k *Keeper) getTickerPrice(ctx context.Context, ticker string) (math.LegacyDec, time.Time, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := connecttypes.NewCurrencyPair(ticker, types.USDTicker)
+	qpn, err := k.oracleKeeper.GetPriceWithNonceForCurrencyPair(sdkCtx, cp)
+	if err != nil {
+		// If currency pair is not found return 0 as well.
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return math.LegacyZeroDec(), time.Time{}, nil
+		}
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(sdkCtx, cp)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	// Divide returned quote price by 10^{decimals} gives us the real price in
+	// decimal number.
+	return math.LegacyNewDecFromIntWithPrec(qpn.Price, int64(decimals)), qpn.BlockTimestamp, nil
+}
+
+// getLiquidStakingAssetPrice returns the price of the given liquid staking
+// asset, computed as the price of its underlying asset multiplied by its
+// redemption rate. If either the underlying asset, its price or the
+// redemption rate is not found, 0 is returned.
+func (k *Keeper) getLiquidStakingAssetPrice(ctx context.Context, asset types.Asset) (math.LegacyDec, time.Time, error) {
+	rate, found, err := k.GetRedemptionRate(ctx, asset.Denom)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	if !found {
+		return math.LegacyZeroDec(), time.Time{}, nil
+	}
+
+	underlying, err := k.GetAsset(ctx, asset.Underlying.Denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return math.LegacyZeroDec(), time.Time{}, nil
+		}
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	price, priceTime, err := k.getTickerPrice(ctx, underlying.Ticker)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	return price.Mul(rate), priceTime, nil
+}
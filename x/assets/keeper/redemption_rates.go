@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
+)
+
+// SetContractQuerier sets the querier used to get the redemption rates of the
+// liquid staking assets from the CosmWasm contracts
+func (k *Keeper) SetContractQuerier(contractQuerier types.ContractQuerier) {
+	k.contractQuerier = contractQuerier
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetRedemptionRate stores the redemption rate of the asset having the given denom
+func (k *Keeper) SetRedemptionRate(ctx context.Context, denom string, rate math.LegacyDec) error {
+	return k.RedemptionRates.Set(ctx, denom, rate)
+}
+
+// GetRedemptionRate returns the redemption rate of the liquid staking asset
+// having the given denom. If no rate has been set yet, false is returned.
+func (k *Keeper) GetRedemptionRate(ctx context.Context, denom string) (math.LegacyDec, bool, error) {
+	rate, err := k.RedemptionRates.Get(ctx, denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, false, nil
+		}
+		return math.LegacyDec{}, false, err
+	}
+	return rate, true, nil
+}
+
+// GetAllRedemptionRates returns all the stored redemption rates
+func (k *Keeper) GetAllRedemptionRates(ctx context.Context) ([]types.RedemptionRate, error) {
+	var rates []types.RedemptionRate
+	err := k.RedemptionRates.Walk(ctx, nil, func(denom string, rate math.LegacyDec) (stop bool, err error) {
+		rates = append(rates, types.NewRedemptionRate(denom, rate))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// removeRedemptionRate removes the redemption rate of the asset having the
+// given denom, along with its snapshot
+func (k *Keeper) removeRedemptionRate(ctx context.Context, denom string) error {
+	err := k.RedemptionRates.Remove(ctx, denom)
+	if err != nil {
+		return err
+	}
+	return k.RedemptionRateSnapshots.Remove(ctx, denom)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetRedemptionRateSnapshot stores the given redemption rate snapshot
+func (k *Keeper) SetRedemptionRateSnapshot(ctx context.Context, snapshot types.RedemptionRateSnapshot) error {
+	return k.RedemptionRateSnapshots.Set(ctx, snapshot.Denom, snapshot)
+}
+
+// GetRedemptionRateSnapshot returns the redemption rate snapshot of the liquid
+// staking asset having the given denom. If no snapshot has been taken yet,
+// false is returned.
+func (k *Keeper) GetRedemptionRateSnapshot(ctx context.Context, denom string) (types.RedemptionRateSnapshot, bool, error) {
+	snapshot, err := k.RedemptionRateSnapshots.Get(ctx, denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.RedemptionRateSnapshot{}, false, nil
+		}
+		return types.RedemptionRateSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// GetAllRedemptionRateSnapshots returns all the stored redemption rate snapshots
+func (k *Keeper) GetAllRedemptionRateSnapshots(ctx context.Context) ([]types.RedemptionRateSnapshot, error) {
+	var snapshots []types.RedemptionRateSnapshot
+	err := k.RedemptionRateSnapshots.Walk(ctx, nil, func(_ string, snapshot types.RedemptionRateSnapshot) (stop bool, err error) {
+		snapshots = append(snapshots, snapshot)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// getRateWindowSnapshot returns the redemption rate snapshot of the current
+// rate window of the asset having the given denom. If the previous window has
+// ended, a new window is started from the given current rate.
+func (k *Keeper) getRateWindowSnapshot(ctx context.Context, denom string, currentRate math.LegacyDec) (types.RedemptionRateSnapshot, error) {
+	snapshot, found, err := k.GetRedemptionRateSnapshot(ctx, denom)
+	if err != nil {
+		return types.RedemptionRateSnapshot{}, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if found && !snapshot.IsExpired(blockTime) {
+		return snapshot, nil
+	}
+
+	snapshot = types.NewRedemptionRateSnapshot(denom, currentRate, blockTime)
+	err = k.SetRedemptionRateSnapshot(ctx, snapshot)
+	if err != nil {
+		return types.RedemptionRateSnapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// getLiquidStakingAsset returns the liquid staking asset having the given denom,
+// making sure that the given rate is a valid redemption rate
+func (k *Keeper) getLiquidStakingAsset(ctx context.Context, denom string, rate math.LegacyDec) (types.Asset, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return types.Asset{}, err
+	}
+
+	if !asset.IsLiquidStakingAsset() {
+		return types.Asset{}, errors.Wrapf(types.ErrNotLiquidStakingAsset, "asset %s has no underlying asset", denom)
+	}
+
+	err = types.ValidateRate(rate)
+	if err != nil {
+		return types.Asset{}, errors.Wrap(types.ErrInvalidRedemptionRate, err.Error())
+	}
+
+	return asset, nil
+}
+
+// UpdateRedemptionRate sets the redemption rate of the liquid staking asset
+// having the given denom, making sure that the change with respect to the
+// rate snapshot of the current rate window does not exceed the max rate change
+// of the asset. Bounding the change with respect to a snapshot prevents small
+// changes from compounding across consecutive updates.
+func (k *Keeper) UpdateRedemptionRate(ctx context.Context, denom string, rate math.LegacyDec) error {
+	asset, err := k.getLiquidStakingAsset(ctx, denom, rate)
+	if err != nil {
+		return err
+	}
+
+	currentRate, found, err := k.GetRedemptionRate(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the rate does not change too much, unless this is the first
+	// time that the rate is set
+	if found {
+		snapshot, err := k.getRateWindowSnapshot(ctx, denom, currentRate)
+		if err != nil {
+			return err
+		}
+
+		err = asset.Underlying.ValidateRateChange(snapshot.Rate, rate)
+		if err != nil {
+			return errors.Wrap(types.ErrRedemptionRateOutOfBounds, err.Error())
+		}
+	} else {
+		_, err = k.getRateWindowSnapshot(ctx, denom, rate)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = k.SetRedemptionRate(ctx, denom, rate)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRedemptionRate,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		),
+	)
+
+	return nil
+}
+
+// ResetRedemptionRate sets the redemption rate of the liquid staking asset
+// having the given denom without checking the max rate change of the asset,
+// and starts a new rate window from the given rate. It allows to recover a rate
+// that can no longer be updated within the allowed bounds.
+func (k *Keeper) ResetRedemptionRate(ctx context.Context, denom string, rate math.LegacyDec) error {
+	_, err := k.getLiquidStakingAsset(ctx, denom, rate)
+	if err != nil {
+		return err
+	}
+
+	err = k.SetRedemptionRate(ctx, denom, rate)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = k.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot(denom, rate, sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetRedemptionRate,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		),
+	)
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// UpdateContractRedemptionRates updates the redemption rates of all the liquid
+// staking assets whose rate is provided by a CosmWasm contract. If a contract
+// cannot be queried or returns a rate that is out of bounds, the error is
+// logged and the previous rate is kept so that a faulty contract cannot halt
+// the chain.
+func (k *Keeper) UpdateContractRedemptionRates(ctx context.Context) error {
+	if k.contractQuerier == nil {
+		return nil
+	}
+
+	var assets []types.Asset
+	err := k.Assets.Walk(ctx, nil, func(_ string, asset types.Asset) (stop bool, err error) {
+		if asset.IsLiquidStakingAsset() && asset.Underlying.RateSource == types.REDEMPTION_RATE_SOURCE_CONTRACT {
+			assets = append(assets, asset)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, asset := range assets {
+		// Update the rate using a cached context so that a rejected rate does
+		// not leave any change behind (e.g. a rolled over rate window)
+		cachedCtx, writeCache := sdkCtx.CacheContext()
+		rate, err := k.queryContractRedemptionRate(cachedCtx, asset)
+		if err == nil {
+			err = k.UpdateRedemptionRate(cachedCtx, asset.Denom, rate)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("failed to update redemption rate",
+				"denom", asset.Denom,
+				"contract", asset.Underlying.Contract,
+				"error", err,
+			)
+			continue
+		}
+
+		writeCache()
+	}
+
+	return nil
+}
+
+// queryContractRedemptionRate queries the redemption rate of the given asset
+// from the CosmWasm contract set as its rate source
+func (k *Keeper) queryContractRedemptionRate(ctx context.Context, asset types.Asset) (rate math.LegacyDec, err error) {
+	contractAddr, err := k.accountKeeper.AddressCodec().StringToBytes(asset.Underlying.Contract)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	reqBz, err := json.Marshal(types.RedemptionRateQueryMsg{
+		RedemptionRate: types.RedemptionRateQuery{Denom: asset.Denom},
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// Limit the gas that can be used by the contract, converting the out of gas
+	// panic into an error
+	queryCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewGasMeter(types.RedemptionRateQueryGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			rate, err = math.LegacyDec{}, errors.Wrap(types.ErrInvalidRedemptionRate, "out of gas while querying contract")
+		}
+	}()
+
+	resBz, err := k.contractQuerier.QuerySmart(queryCtx, sdk.AccAddress(contractAddr), reqBz)
+	if err != nil {
+		return math.LegacyDec{}, errors.Wrap(types.ErrInvalidRedemptionRate, err.Error())
+	}
+
+	var res types.RedemptionRateQueryResponse
+	err = json.Unmarshal(resBz, &res)
+	if err != nil {
+		return math.LegacyDec{}, errors.Wrapf(types.ErrInvalidRedemptionRate, "invalid contract response: %s", err)
+	}
+
+	return res.RedemptionRate, nil
+}
@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
+)
+
+const rateContractAddress = "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+// mockContractQuerier is a ContractQuerier that returns the configured
+// response, optionally consuming the given amount of gas
+type mockContractQuerier struct {
+	res []byte
+	err error
+	gas uint64
+}
+
+func (m mockContractQuerier) QuerySmart(ctx context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gas, "mock contract query")
+	return m.res, m.err
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UpdateContractRedemptionRates() {
+	windowStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		blockTime time.Time
+		querier   mockContractQuerier
+		shouldErr bool
+		expRate   math.LegacyDec
+		expFound  bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "first rate is stored properly",
+			querier: mockContractQuerier{
+				res: []byte(`{"redemption_rate":"1.25"}`),
+			},
+			shouldErr: false,
+			expRate:   math.LegacyNewDecWithPrec(125, 2),
+			expFound:  true,
+		},
+		{
+			name: "rate within bounds is updated properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(12, 1))
+				suite.Require().NoError(err)
+			},
+			querier: mockContractQuerier{
+				res: []byte(`{"redemption_rate":"1.25"}`),
+			},
+			shouldErr: false,
+			expRate:   math.LegacyNewDecWithPrec(125, 2),
+			expFound:  true,
+		},
+		{
+			name: "rate out of bounds is ignored",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+			},
+			querier: mockContractQuerier{
+				res: []byte(`{"redemption_rate":"2"}`),
+			},
+			shouldErr: false,
+			expRate:   math.LegacyOneDec(),
+			expFound:  true,
+		},
+		{
+			name: "rate out of bounds does not roll over an expired window",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(104, 2))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart))
+				suite.Require().NoError(err)
+			},
+			blockTime: windowStart.Add(types.RedemptionRateWindow),
+			querier: mockContractQuerier{
+				res: []byte(`{"redemption_rate":"2"}`),
+			},
+			shouldErr: false,
+			expRate:   math.LegacyNewDecWithPrec(104, 2),
+			expFound:  true,
+			check: func(ctx sdk.Context) {
+				snapshot, found, err := suite.keeper.GetRedemptionRateSnapshot(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart), snapshot)
+			},
+		},
+		{
+			name: "contract error is ignored",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+			},
+			querier: mockContractQuerier{
+				err: fmt.Errorf("contract error"),
+			},
+			shouldErr: false,
+			expRate:   math.LegacyOneDec(),
+			expFound:  true,
+		},
+		{
+			name: "invalid contract response is ignored",
+			querier: mockContractQuerier{
+				res: []byte(`{"rate":"1.25"}`),
+			},
+			shouldErr: false,
+			expFound:  false,
+		},
+		{
+			name: "contract running out of gas is ignored",
+			querier: mockContractQuerier{
+				res: []byte(`{"redemption_rate":"1.25"}`),
+				gas: types.RedemptionRateQueryGasLimit + 1,
+			},
+			shouldErr: false,
+			expFound:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+
+			err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+				"stuatom",
+				"STATOM",
+				6,
+				types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_CONTRACT, rateContractAddress, math.LegacyNewDecWithPrec(5, 2)),
+			))
+			suite.Require().NoError(err)
+
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			suite.keeper.SetContractQuerier(tc.querier)
+
+			err = suite.keeper.UpdateContractRedemptionRates(ctx)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFound, found)
+				if tc.expFound {
+					suite.Require().Equal(tc.expRate, rate)
+				}
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UpdateRedemptionRate() {
+	windowStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		blockTime   time.Time
+		rate        math.LegacyDec
+		shouldErr   bool
+		expRate     math.LegacyDec
+		expSnapshot types.RedemptionRateSnapshot
+	}{
+		{
+			name:        "first rate starts a new window",
+			blockTime:   windowStart,
+			rate:        math.LegacyNewDecWithPrec(125, 2),
+			shouldErr:   false,
+			expRate:     math.LegacyNewDecWithPrec(125, 2),
+			expSnapshot: types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDecWithPrec(125, 2), windowStart),
+		},
+		{
+			name: "missing snapshot starts a new window from the current rate",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyOneDec())
+				suite.Require().NoError(err)
+			},
+			blockTime:   windowStart,
+			rate:        math.LegacyNewDecWithPrec(104, 2),
+			shouldErr:   false,
+			expRate:     math.LegacyNewDecWithPrec(104, 2),
+			expSnapshot: types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart),
+		},
+		{
+			name: "rate changes within the same window do not compound",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(104, 2))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart))
+				suite.Require().NoError(err)
+			},
+			blockTime: windowStart.Add(time.Hour),
+			rate:      math.LegacyNewDecWithPrec(108, 2),
+			shouldErr: true,
+		},
+		{
+			name: "rate change within the window bounds is set properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(104, 2))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart))
+				suite.Require().NoError(err)
+			},
+			blockTime:   windowStart.Add(time.Hour),
+			rate:        math.LegacyNewDecWithPrec(96, 2),
+			shouldErr:   false,
+			expRate:     math.LegacyNewDecWithPrec(96, 2),
+			expSnapshot: types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart),
+		},
+		{
+			name: "expired window is replaced by a new one starting from the current rate",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.SetRedemptionRate(ctx, "stuatom", math.LegacyNewDecWithPrec(104, 2))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetRedemptionRateSnapshot(ctx, types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), windowStart))
+				suite.Require().NoError(err)
+			},
+			blockTime:   windowStart.Add(types.RedemptionRateWindow),
+			rate:        math.LegacyNewDecWithPrec(108, 2),
+			shouldErr:   false,
+			expRate:     math.LegacyNewDecWithPrec(108, 2),
+			expSnapshot: types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDecWithPrec(104, 2), windowStart.Add(types.RedemptionRateWindow)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			err := suite.keeper.SetAsset(ctx, types.NewAsset("uatom", "ATOM", 6))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.SetAsset(ctx, types.NewLiquidStakingAsset(
+				"stuatom",
+				"STATOM",
+				6,
+				types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2)),
+			))
+			suite.Require().NoError(err)
+
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err = suite.keeper.UpdateRedemptionRate(ctx, "stuatom", tc.rate)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				rate, found, err := suite.keeper.GetRedemptionRate(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expRate, rate)
+
+				snapshot, found, err := suite.keeper.GetRedemptionRateSnapshot(ctx, "stuatom")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expSnapshot, snapshot)
+			}
+		})
+	}
+}
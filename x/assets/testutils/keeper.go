@@ -7,6 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	marketmapkeeper "github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	oraclekeeper "github.com/skip-mev/connect/v2/x/oracle/keeper"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
 	"github.com/milkyway-labs/milkyway/v12/testutils/storetesting"
 	"github.com/milkyway-labs/milkyway/v12/x/assets/keeper"
@@ -20,13 +24,16 @@ type KeeperTestData struct {
 
 	StoreKey *storetypes.KVStoreKey
 
-	Keeper *keeper.Keeper
+	MarketMapKeeper *marketmapkeeper.Keeper
+	OracleKeeper    *oraclekeeper.Keeper
+	Keeper          *keeper.Keeper
 }
 
 func NewKeeperTestData(t *testing.T) KeeperTestData {
 	var data = KeeperTestData{
 		BaseKeeperTestData: storetesting.NewBaseKeeperTestData(t, []string{
 			types.StoreKey,
+			marketmaptypes.StoreKey, oracletypes.StoreKey,
 		}),
 	}
 
@@ -36,9 +43,23 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 	data.AuthorityAddress = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	// Build the keepers
+	data.MarketMapKeeper = marketmapkeeper.NewKeeper(
+		runtime.NewKVStoreService(data.Keys[marketmaptypes.StoreKey]),
+		data.Cdc,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	oracleKeeper := oraclekeeper.NewKeeper(
+		runtime.NewKVStoreService(data.Keys[oracletypes.StoreKey]),
+		data.Cdc,
+		data.MarketMapKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	data.OracleKeeper = &oracleKeeper
 	data.Keeper = keeper.NewKeeper(
 		data.Cdc,
 		runtime.NewKVStoreService(data.StoreKey),
+		data.AccountKeeper,
+		data.OracleKeeper,
 		data.AuthorityAddress,
 	)
 
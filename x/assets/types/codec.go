@@ -11,12 +11,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterAsset{}, "milkyway/MsgRegisterAsset")
 	legacy.RegisterAminoMsg(cdc, &MsgDeregisterAsset{}, "milkyway/MsgDeregisterAsset")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateRedemptionRate{}, "milkyway/MsgUpdateRedemptionRate")
+	legacy.RegisterAminoMsg(cdc, &MsgResetRedemptionRate{}, "milkyway/MsgResetRedemptionRate")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterAsset{},
 		&MsgDeregisterAsset{},
+		&MsgUpdateRedemptionRate{},
+		&MsgResetRedemptionRate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidRedemptionRate     = errors.Register(ModuleName, 1, "invalid redemption rate")
+	ErrRedemptionRateOutOfBounds = errors.Register(ModuleName, 2, "redemption rate change out of bounds")
+	ErrNotLiquidStakingAsset     = errors.Register(ModuleName, 3, "asset is not a liquid staking asset")
+	ErrInvalidRateSource         = errors.Register(ModuleName, 4, "invalid redemption rate source")
+)
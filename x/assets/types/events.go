@@ -6,7 +6,11 @@ const (
 	EventTypeRegisterAsset   = "register_asset"
 	EventTypeDeregisterAsset = "deregister_asset"
 
+	EventTypeUpdateRedemptionRate = "update_redemption_rate"
+	EventTypeResetRedemptionRate  = "reset_redemption_rate"
+
 	AttributeKeyDenom    = "denom"
 	AttributeKeyTicker   = "ticker"
 	AttributeKeyExponent = "exponent"
+	AttributeKeyRate     = "rate"
 )
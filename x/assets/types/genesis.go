@@ -1,15 +1,25 @@
 package types
 
+import (
+	"fmt"
+)
+
 // NewGenesisState returns a new GenesisState instance
-func NewGenesisState(assets []Asset) *GenesisState {
+func NewGenesisState(
+	assets []Asset,
+	redemptionRates []RedemptionRate,
+	redemptionRateSnapshots []RedemptionRateSnapshot,
+) *GenesisState {
 	return &GenesisState{
-		Assets: assets,
+		Assets:                  assets,
+		RedemptionRates:         redemptionRates,
+		RedemptionRateSnapshots: redemptionRateSnapshots,
 	}
 }
 
 // DefaultGenesis returns a default GenesisState
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(nil)
+	return NewGenesisState(nil, nil, nil)
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -17,11 +27,50 @@ func DefaultGenesis() *GenesisState {
 // Validate validates the GenesisState and returns an error if it is invalid.
 func (data *GenesisState) Validate() error {
 	// Validate the assets
+	assets := make(map[string]Asset, len(data.Assets))
 	for _, asset := range data.Assets {
 		err := asset.Validate()
 		if err != nil {
 			return err
 		}
+		assets[asset.Denom] = asset
+	}
+
+	// Validate the redemption rates
+	seenRates := make(map[string]bool, len(data.RedemptionRates))
+	for _, rate := range data.RedemptionRates {
+		err := rate.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid redemption rate for %s: %w", rate.Denom, err)
+		}
+
+		if seenRates[rate.Denom] {
+			return fmt.Errorf("duplicated redemption rate for %s", rate.Denom)
+		}
+		seenRates[rate.Denom] = true
+
+		asset, found := assets[rate.Denom]
+		if !found || !asset.IsLiquidStakingAsset() {
+			return fmt.Errorf("redemption rate for %s is not associated to a liquid staking asset", rate.Denom)
+		}
+	}
+
+	// Validate the redemption rate snapshots
+	seenSnapshots := make(map[string]bool, len(data.RedemptionRateSnapshots))
+	for _, snapshot := range data.RedemptionRateSnapshots {
+		err := snapshot.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid redemption rate snapshot for %s: %w", snapshot.Denom, err)
+		}
+
+		if seenSnapshots[snapshot.Denom] {
+			return fmt.Errorf("duplicated redemption rate snapshot for %s", snapshot.Denom)
+		}
+		seenSnapshots[snapshot.Denom] = true
+
+		if !seenRates[snapshot.Denom] {
+			return fmt.Errorf("redemption rate snapshot for %s is not associated to a redemption rate", snapshot.Denom)
+		}
 	}
 
 	return nil
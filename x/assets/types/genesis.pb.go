@@ -28,6 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Assets defines the registered assets.
 	Assets []Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets"`
+	// RedemptionRates defines the redemption rates of the liquid staking assets.
+	RedemptionRates []RedemptionRate `protobuf:"bytes,2,rep,name=redemption_rates,json=redemptionRates,proto3" json:"redemption_rates"`
+	// RedemptionRateSnapshots defines the redemption rate snapshots of the
+	// liquid staking assets.
+	RedemptionRateSnapshots []RedemptionRateSnapshot `protobuf:"bytes,3,rep,name=redemption_rate_snapshots,json=redemptionRateSnapshots,proto3" json:"redemption_rate_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +75,20 @@ func (m *GenesisState) GetAssets() []Asset {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptionRates() []RedemptionRate {
+	if m != nil {
+		return m.RedemptionRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionRateSnapshots() []RedemptionRateSnapshot {
+	if m != nil {
+		return m.RedemptionRateSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "milkyway.assets.v1.GenesisState")
 }
@@ -77,21 +96,26 @@ func init() {
 func init() { proto.RegisterFile("milkyway/assets/v1/genesis.proto", fileDescriptor_f17ee47d995cb1cb) }
 
 var fileDescriptor_f17ee47d995cb1cb = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
+	// 296 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xcd, 0xcc, 0xc9,
 	0xae, 0x2c, 0x4f, 0xac, 0xd4, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0x4f,
 	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa9,
 	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
 	0x10, 0x65, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0xc7,
-	0x62, 0x7c, 0x6e, 0x7e, 0x4a, 0x6a, 0x0e, 0xd4, 0x74, 0x25, 0x1f, 0x2e, 0x1e, 0x77, 0x88, 0x75,
-	0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x36, 0x5c, 0x6c, 0x10, 0x95, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x92, 0x7a, 0x98, 0xd6, 0xeb, 0x39, 0x82, 0x58, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33,
-	0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xc7, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0x61, 0x26, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x79, 0xfa, 0x65, 0x86, 0x46, 0xfa, 0x15,
-	0x30, 0x77, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x69, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x80, 0x50, 0x99, 0x8e, 0x26, 0x01, 0x00, 0x00,
+	0x62, 0x7c, 0x6e, 0x7e, 0x4a, 0x6a, 0x0e, 0xd4, 0x74, 0xa5, 0x99, 0x4c, 0x5c, 0x3c, 0xee, 0x10,
+	0xfb, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x20, 0x4a, 0x25, 0x18, 0x15, 0x98,
+	0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x30, 0xed, 0xd7, 0x73, 0x04, 0xb1, 0x9c, 0x38, 0x4f, 0xdc, 0x93,
+	0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x8f, 0x50, 0x04, 0x97, 0x40, 0x51, 0x6a,
+	0x4a, 0x6a, 0x6e, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x51, 0x62, 0x49, 0x6a, 0xb1, 0x04, 0x13,
+	0xd8, 0x1c, 0x25, 0x6c, 0xe6, 0x04, 0xc1, 0xd5, 0x06, 0x25, 0x96, 0xa4, 0x22, 0x1b, 0xc8, 0x5f,
+	0x84, 0x22, 0x55, 0x2c, 0x54, 0xc8, 0x25, 0x89, 0x66, 0x72, 0x7c, 0x71, 0x5e, 0x62, 0x41, 0x71,
+	0x46, 0x7e, 0x49, 0xb1, 0x04, 0x33, 0xd8, 0x0a, 0x2d, 0xc2, 0x56, 0x04, 0x43, 0xb5, 0x20, 0x5b,
+	0x25, 0x5e, 0x84, 0x55, 0x49, 0xb1, 0x93, 0xdf, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
+	0x31, 0x44, 0x99, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0xec,
+	0xd4, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0xf3, 0xf4, 0xcb, 0x0c, 0x8d, 0xf4, 0x2b, 0x60, 0xa1, 0x5e,
+	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x72, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x6b, 0x32, 0xfc, 0x8e, 0xf4, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RedemptionRateSnapshots) > 0 {
+		for iNdEx := len(m.RedemptionRateSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RedemptionRates) > 0 {
+		for iNdEx := len(m.RedemptionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Assets) > 0 {
 		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +206,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RedemptionRates) > 0 {
+		for _, e := range m.RedemptionRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionRateSnapshots) > 0 {
+		for _, e := range m.RedemptionRateSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -226,6 +290,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRates = append(m.RedemptionRates, RedemptionRate{})
+			if err := m.RedemptionRates[len(m.RedemptionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateSnapshots = append(m.RedemptionRateSnapshots, RedemptionRateSnapshot{})
+			if err := m.RedemptionRateSnapshots[len(m.RedemptionRateSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,13 +2,17 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
 )
 
 func TestGenesis_Validate(t *testing.T) {
+	underlying := types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2))
+
 	testCases := []struct {
 		name    string
 		genesis *types.GenesisState
@@ -25,9 +29,116 @@ func TestGenesis_Validate(t *testing.T) {
 				[]types.Asset{
 					types.NewAsset("@#$%", "bitcoin", 1),
 				},
+				nil,
+				nil,
+			),
+			expErr: true,
+		},
+		{
+			name: "invalid redemption rate returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyZeroDec()),
+				},
+				nil,
+			),
+			expErr: true,
+		},
+		{
+			name: "duplicated redemption rate returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyOneDec()),
+					types.NewRedemptionRate("stuatom", math.LegacyOneDec()),
+				},
+				nil,
 			),
 			expErr: true,
 		},
+		{
+			name: "redemption rate of a non liquid staking asset returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("uatom", math.LegacyOneDec()),
+				},
+				nil,
+			),
+			expErr: true,
+		},
+		{
+			name: "invalid redemption rate snapshot returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyOneDec()),
+				},
+				[]types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), time.Time{}),
+				},
+			),
+			expErr: true,
+		},
+		{
+			name: "duplicated redemption rate snapshot returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyOneDec()),
+				},
+				[]types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			),
+			expErr: true,
+		},
+		{
+			name: "redemption rate snapshot without redemption rate returns error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				nil,
+				[]types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyOneDec(), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			),
+			expErr: true,
+		},
+		{
+			name: "valid genesis returns no error",
+			genesis: types.NewGenesisState(
+				[]types.Asset{
+					types.NewAsset("uatom", "ATOM", 6),
+					types.NewLiquidStakingAsset("stuatom", "STATOM", 6, underlying),
+				},
+				[]types.RedemptionRate{
+					types.NewRedemptionRate("stuatom", math.LegacyNewDecWithPrec(125, 2)),
+				},
+				[]types.RedemptionRateSnapshot{
+					types.NewRedemptionRateSnapshot("stuatom", math.LegacyNewDecWithPrec(12, 1), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			),
+			expErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -14,4 +14,7 @@ var (
 
 	AssetKeyPrefix       = collections.NewPrefix(0x11)
 	TickerIndexKeyPrefix = collections.NewPrefix(0x12)
+
+	RedemptionRateKeyPrefix         = collections.NewPrefix(0x21)
+	RedemptionRateSnapshotKeyPrefix = collections.NewPrefix(0x22)
 )
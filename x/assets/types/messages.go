@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,6 +10,8 @@ import (
 var (
 	_ sdk.Msg = &MsgRegisterAsset{}
 	_ sdk.Msg = &MsgDeregisterAsset{}
+	_ sdk.Msg = &MsgUpdateRedemptionRate{}
+	_ sdk.Msg = &MsgResetRedemptionRate{}
 )
 
 // NewMsgRegisterAsset creates a new MsgRegisterAsset instance
@@ -54,3 +57,61 @@ func (msg *MsgDeregisterAsset) Validate() error {
 	}
 	return nil
 }
+
+// NewMsgUpdateRedemptionRate creates a new MsgUpdateRedemptionRate instance
+func NewMsgUpdateRedemptionRate(authority, denom string, rate math.LegacyDec) *MsgUpdateRedemptionRate {
+	return &MsgUpdateRedemptionRate{
+		Authority: authority,
+		Denom:     denom,
+		Rate:      rate,
+	}
+}
+
+// Validate validates the MsgUpdateRedemptionRate instance
+func (msg *MsgUpdateRedemptionRate) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address")
+	}
+
+	err = sdk.ValidateDenom(msg.Denom)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = ValidateRate(msg.Rate)
+	if err != nil {
+		return errors.Wrap(ErrInvalidRedemptionRate, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgResetRedemptionRate creates a new MsgResetRedemptionRate instance
+func NewMsgResetRedemptionRate(authority, denom string, rate math.LegacyDec) *MsgResetRedemptionRate {
+	return &MsgResetRedemptionRate{
+		Authority: authority,
+		Denom:     denom,
+		Rate:      rate,
+	}
+}
+
+// Validate validates the MsgResetRedemptionRate instance
+func (msg *MsgResetRedemptionRate) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address")
+	}
+
+	err = sdk.ValidateDenom(msg.Denom)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = ValidateRate(msg.Rate)
+	if err != nil {
+		return errors.Wrap(ErrInvalidRedemptionRate, err.Error())
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -220,44 +221,254 @@ func (m *MsgDeregisterAssetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeregisterAssetResponse proto.InternalMessageInfo
 
+// MsgUpdateRedemptionRate defines the message structure for the
+// UpdateRedemptionRate gRPC service method. It allows the authority to update
+// the redemption rate of a liquid staking asset.
+type MsgUpdateRedemptionRate struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom represents the denomination of the liquid staking asset.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the new redemption rate of the asset.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgUpdateRedemptionRate) Reset()         { *m = MsgUpdateRedemptionRate{} }
+func (m *MsgUpdateRedemptionRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionRate) ProtoMessage()    {}
+func (*MsgUpdateRedemptionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa7f7c938a129c02, []int{4}
+}
+func (m *MsgUpdateRedemptionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRedemptionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRedemptionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionRate.Merge(m, src)
+}
+func (m *MsgUpdateRedemptionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRedemptionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRedemptionRate proto.InternalMessageInfo
+
+func (m *MsgUpdateRedemptionRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateRedemptionRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgUpdateRedemptionRateResponse is the return value of
+// MsgUpdateRedemptionRate.
+type MsgUpdateRedemptionRateResponse struct {
+}
+
+func (m *MsgUpdateRedemptionRateResponse) Reset()         { *m = MsgUpdateRedemptionRateResponse{} }
+func (m *MsgUpdateRedemptionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionRateResponse) ProtoMessage()    {}
+func (*MsgUpdateRedemptionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa7f7c938a129c02, []int{5}
+}
+func (m *MsgUpdateRedemptionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRedemptionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRedemptionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionRateResponse.Merge(m, src)
+}
+func (m *MsgUpdateRedemptionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRedemptionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRedemptionRateResponse proto.InternalMessageInfo
+
+// MsgResetRedemptionRate defines the message structure for the
+// ResetRedemptionRate gRPC service method. It allows the authority to reset
+// the redemption rate of a liquid staking asset that can no longer be updated
+// within the max rate change allowed.
+type MsgResetRedemptionRate struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom represents the denomination of the liquid staking asset.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the new redemption rate of the asset.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgResetRedemptionRate) Reset()         { *m = MsgResetRedemptionRate{} }
+func (m *MsgResetRedemptionRate) String() string { return proto.CompactTextString(m) }
+func (*MsgResetRedemptionRate) ProtoMessage()    {}
+func (*MsgResetRedemptionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa7f7c938a129c02, []int{6}
+}
+func (m *MsgResetRedemptionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetRedemptionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetRedemptionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetRedemptionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetRedemptionRate.Merge(m, src)
+}
+func (m *MsgResetRedemptionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetRedemptionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetRedemptionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetRedemptionRate proto.InternalMessageInfo
+
+func (m *MsgResetRedemptionRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResetRedemptionRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgResetRedemptionRateResponse is the return value of
+// MsgResetRedemptionRate.
+type MsgResetRedemptionRateResponse struct {
+}
+
+func (m *MsgResetRedemptionRateResponse) Reset()         { *m = MsgResetRedemptionRateResponse{} }
+func (m *MsgResetRedemptionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetRedemptionRateResponse) ProtoMessage()    {}
+func (*MsgResetRedemptionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa7f7c938a129c02, []int{7}
+}
+func (m *MsgResetRedemptionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetRedemptionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetRedemptionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetRedemptionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetRedemptionRateResponse.Merge(m, src)
+}
+func (m *MsgResetRedemptionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetRedemptionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetRedemptionRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetRedemptionRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterAsset)(nil), "milkyway.assets.v1.MsgRegisterAsset")
 	proto.RegisterType((*MsgRegisterAssetResponse)(nil), "milkyway.assets.v1.MsgRegisterAssetResponse")
 	proto.RegisterType((*MsgDeregisterAsset)(nil), "milkyway.assets.v1.MsgDeregisterAsset")
 	proto.RegisterType((*MsgDeregisterAssetResponse)(nil), "milkyway.assets.v1.MsgDeregisterAssetResponse")
+	proto.RegisterType((*MsgUpdateRedemptionRate)(nil), "milkyway.assets.v1.MsgUpdateRedemptionRate")
+	proto.RegisterType((*MsgUpdateRedemptionRateResponse)(nil), "milkyway.assets.v1.MsgUpdateRedemptionRateResponse")
+	proto.RegisterType((*MsgResetRedemptionRate)(nil), "milkyway.assets.v1.MsgResetRedemptionRate")
+	proto.RegisterType((*MsgResetRedemptionRateResponse)(nil), "milkyway.assets.v1.MsgResetRedemptionRateResponse")
 }
 
 func init() { proto.RegisterFile("milkyway/assets/v1/messages.proto", fileDescriptor_fa7f7c938a129c02) }
 
 var fileDescriptor_fa7f7c938a129c02 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcd, 0xcc, 0xc9,
-	0xae, 0x2c, 0x4f, 0xac, 0xd4, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0xcf,
-	0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82,
-	0x29, 0xd1, 0x83, 0x28, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07,
-	0x93, 0x10, 0x65, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0x60,
-	0x43, 0x8a, 0xd3, 0xa1, 0x12, 0x92, 0x10, 0x89, 0x78, 0x30, 0x4f, 0x1f, 0xc2, 0x81, 0x4a, 0x89,
-	0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0xc4, 0x41, 0x2c, 0xa8, 0xa8, 0x3c, 0x36, 0x37, 0xe5, 0xa7, 0xa4,
-	0xe6, 0x40, 0xb5, 0x29, 0xad, 0x67, 0xe4, 0x12, 0xf0, 0x2d, 0x4e, 0x0f, 0x4a, 0x4d, 0xcf, 0x2c,
-	0x2e, 0x49, 0x2d, 0x72, 0x04, 0xa9, 0x12, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04,
-	0x6a, 0xa1, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10,
-	0x42, 0xa9, 0x90, 0x29, 0x17, 0x2b, 0xd8, 0x1a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49,
-	0x3d, 0x4c, 0xef, 0xea, 0x81, 0x6d, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xa2, 0xda,
-	0x4a, 0xa7, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x31, 0x5d, 0xcf, 0x37, 0x68, 0x49, 0xc2, 0xdd, 0x8d,
-	0xee, 0x38, 0x25, 0x29, 0x2e, 0x09, 0x74, 0xb1, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54,
-	0xa5, 0x49, 0x8c, 0x5c, 0x42, 0xbe, 0xc5, 0xe9, 0x2e, 0xa9, 0x45, 0x54, 0xf1, 0x8f, 0x08, 0x17,
-	0x6b, 0x4a, 0x6a, 0x5e, 0x7e, 0x2e, 0xd8, 0x3f, 0x9c, 0x41, 0x10, 0x8e, 0x95, 0x1e, 0xa6, 0x73,
-	0xa5, 0x91, 0x9d, 0x8b, 0x66, 0xbb, 0x92, 0x0c, 0x97, 0x14, 0xa6, 0x28, 0xcc, 0xc9, 0x46, 0x77,
-	0x18, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x92, 0xb9, 0x78, 0x51, 0x23, 0x41, 0x05, 0x5b, 0xe8,
-	0xa1, 0xfb, 0x5c, 0x4a, 0x87, 0x18, 0x55, 0x30, 0xcb, 0x84, 0x32, 0xb9, 0xf8, 0xd1, 0xc3, 0x46,
-	0x0d, 0x87, 0x01, 0x68, 0xea, 0xa4, 0xf4, 0x88, 0x53, 0x07, 0xb3, 0x4a, 0x8a, 0xb5, 0xe1, 0xf9,
-	0x06, 0x2d, 0x46, 0x27, 0xbf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
-	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32,
-	0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x19, 0xad, 0x9b, 0x93,
-	0x98, 0x54, 0x0c, 0xe7, 0xe9, 0x97, 0x19, 0x1a, 0xe9, 0x57, 0xc0, 0x52, 0x6e, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0x38, 0xd9, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xf9, 0x46,
-	0x3b, 0x6d, 0x03, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0x16, 0xa9, 0x87, 0x10, 0x60, 0x22, 0x9a, 0xb8, 0xc8, 0x49, 0x2d, 0x84, 0xaa,
+	0xd0, 0xd8, 0x4a, 0x02, 0x0c, 0xd9, 0x1a, 0x85, 0x8d, 0x30, 0x18, 0xb1, 0xb0, 0xa0, 0x8b, 0x7d,
+	0xba, 0x58, 0x8d, 0x73, 0x91, 0xdf, 0x25, 0x34, 0x1b, 0x62, 0x64, 0x42, 0xfc, 0x23, 0x64, 0xe8,
+	0x1f, 0xd1, 0x05, 0xa9, 0xea, 0x84, 0x18, 0x2a, 0x48, 0x86, 0xfc, 0x1b, 0x28, 0xe7, 0x1f, 0x6d,
+	0x6d, 0x47, 0x0a, 0x52, 0x07, 0x96, 0x28, 0xef, 0xbd, 0xcf, 0xef, 0xfb, 0xbe, 0x77, 0xf7, 0x0e,
+	0xef, 0x79, 0x6e, 0xff, 0x68, 0xf2, 0x91, 0x4c, 0x4c, 0x02, 0x40, 0x05, 0x98, 0xe3, 0x9a, 0xe9,
+	0x51, 0x00, 0xc2, 0x28, 0x18, 0x43, 0x9f, 0x0b, 0xae, 0x28, 0x11, 0xc4, 0x08, 0x20, 0xc6, 0xb8,
+	0xa6, 0x3e, 0x20, 0x9e, 0x3b, 0xe0, 0xa6, 0xfc, 0x0d, 0x60, 0xea, 0x8e, 0xcd, 0xc1, 0xe3, 0x60,
+	0x7a, 0xc0, 0x64, 0x13, 0x60, 0x61, 0xa1, 0x18, 0x14, 0x3e, 0xc8, 0xc8, 0x0c, 0x82, 0xb0, 0x94,
+	0x67, 0x9c, 0xf1, 0x20, 0xbf, 0xfc, 0x17, 0x66, 0x4b, 0x59, 0x9a, 0xb8, 0x43, 0xfb, 0xe1, 0x67,
+	0xfa, 0x77, 0x84, 0xef, 0x77, 0x80, 0x59, 0x94, 0xb9, 0x20, 0xa8, 0x7f, 0xb8, 0x44, 0x29, 0x2f,
+	0xf1, 0x36, 0x19, 0x89, 0x1e, 0xf7, 0x5d, 0x31, 0x29, 0xa0, 0x32, 0xda, 0xdf, 0x6e, 0x15, 0xce,
+	0x4f, 0xaa, 0xf9, 0x90, 0xf0, 0xd0, 0x71, 0x7c, 0x0a, 0xf0, 0x56, 0xf8, 0xee, 0x80, 0x59, 0x97,
+	0x50, 0xe5, 0x05, 0xde, 0x92, 0x34, 0x85, 0x5b, 0x65, 0xb4, 0x7f, 0xa7, 0x5e, 0x34, 0xd2, 0x76,
+	0x0d, 0xc9, 0xd0, 0xda, 0x3c, 0xbd, 0x28, 0xe5, 0xac, 0x00, 0xdd, 0x3c, 0xf8, 0xbc, 0x98, 0x56,
+	0x2e, 0xdb, 0x7c, 0x59, 0x4c, 0x2b, 0xc5, 0x58, 0x77, 0x52, 0x9c, 0xae, 0xe2, 0x42, 0x32, 0x67,
+	0x51, 0x18, 0xf2, 0x01, 0x50, 0xfd, 0x1b, 0xc2, 0x4a, 0x07, 0x58, 0x9b, 0xfa, 0x37, 0xe2, 0x27,
+	0x8f, 0xb7, 0x1c, 0x3a, 0xe0, 0x9e, 0xf4, 0xb3, 0x6d, 0x05, 0x41, 0xd3, 0x48, 0xcb, 0xdd, 0xbd,
+	0x2a, 0x37, 0xc1, 0xae, 0x3f, 0xc6, 0x6a, 0x3a, 0x1b, 0x4b, 0x9e, 0x23, 0xbc, 0xd3, 0x01, 0xf6,
+	0x6e, 0xe8, 0x10, 0x41, 0x2d, 0xea, 0x50, 0x6f, 0x28, 0x5c, 0x3e, 0xb0, 0x88, 0xa0, 0x37, 0xab,
+	0x5b, 0x79, 0x85, 0x37, 0x7d, 0x22, 0x68, 0x61, 0x43, 0x36, 0xaa, 0x2d, 0x4f, 0xe0, 0xd7, 0x45,
+	0x69, 0x37, 0x68, 0x06, 0xce, 0x91, 0xe1, 0x72, 0xd3, 0x23, 0xa2, 0x67, 0xbc, 0xa6, 0x8c, 0xd8,
+	0x93, 0x36, 0xb5, 0xcf, 0x4f, 0xaa, 0x38, 0xe4, 0x6a, 0x53, 0xdb, 0x92, 0x9f, 0x37, 0x1b, 0x69,
+	0xfb, 0xe5, 0xab, 0xf6, 0xb3, 0x9c, 0xe8, 0x7b, 0xb8, 0xb4, 0xa2, 0x14, 0x0f, 0xe2, 0x0f, 0xc2,
+	0x8f, 0xe4, 0xc1, 0xca, 0xe9, 0xfc, 0xff, 0x73, 0xa8, 0xa7, 0xe7, 0x50, 0xba, 0x7e, 0x6b, 0x53,
+	0x46, 0xf4, 0x32, 0xd6, 0xb2, 0x2b, 0xd1, 0x14, 0xea, 0x3f, 0x36, 0xf0, 0x46, 0x07, 0x98, 0x62,
+	0xe3, 0xbb, 0xd7, 0x77, 0xf2, 0x49, 0xd6, 0x32, 0x25, 0x17, 0x41, 0x3d, 0x58, 0x07, 0x15, 0x91,
+	0x29, 0x2e, 0xbe, 0x97, 0x5c, 0x95, 0xa7, 0x2b, 0x1a, 0x24, 0x70, 0xaa, 0xb1, 0x1e, 0x2e, 0xa6,
+	0x3a, 0xc6, 0xf9, 0xcc, 0x2b, 0xfe, 0x6c, 0x45, 0x9f, 0x2c, 0xb0, 0xda, 0xf8, 0x07, 0x70, 0xcc,
+	0x3c, 0xc2, 0x0f, 0xb3, 0xee, 0x54, 0x65, 0xe5, 0xa4, 0x52, 0x58, 0xb5, 0xbe, 0x3e, 0x36, 0xa2,
+	0x55, 0xb7, 0x3e, 0x2d, 0xa6, 0x15, 0xd4, 0x7a, 0x73, 0x3a, 0xd3, 0xd0, 0xd9, 0x4c, 0x43, 0xbf,
+	0x67, 0x1a, 0xfa, 0x3a, 0xd7, 0x72, 0x67, 0x73, 0x2d, 0xf7, 0x73, 0xae, 0xe5, 0xde, 0x3f, 0x67,
+	0xae, 0xe8, 0x8d, 0xba, 0x86, 0xcd, 0x3d, 0x33, 0x6a, 0x5f, 0xed, 0x93, 0x2e, 0xc4, 0x91, 0x39,
+	0xae, 0xd5, 0xcd, 0xe3, 0xe8, 0xe5, 0x16, 0x93, 0x21, 0x85, 0xee, 0x6d, 0xf9, 0x6c, 0x37, 0xfe,
+	0x06, 0x00, 0x00, 0xff, 0xff, 0x13, 0xce, 0x59, 0x38, 0x6d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -277,6 +488,13 @@ type MsgClient interface {
 	// DeregisterAsset defines the operation for de-registering an asset with
 	// its denomination.
 	DeregisterAsset(ctx context.Context, in *MsgDeregisterAsset, opts ...grpc.CallOption) (*MsgDeregisterAssetResponse, error)
+	// UpdateRedemptionRate defines the operation for updating the redemption
+	// rate of a liquid staking asset whose rate is set by the authority.
+	UpdateRedemptionRate(ctx context.Context, in *MsgUpdateRedemptionRate, opts ...grpc.CallOption) (*MsgUpdateRedemptionRateResponse, error)
+	// ResetRedemptionRate defines the operation for resetting the redemption
+	// rate of a liquid staking asset, regardless of its rate source and of the
+	// max rate change allowed.
+	ResetRedemptionRate(ctx context.Context, in *MsgResetRedemptionRate, opts ...grpc.CallOption) (*MsgResetRedemptionRateResponse, error)
 }
 
 type msgClient struct {
@@ -305,6 +523,24 @@ func (c *msgClient) DeregisterAsset(ctx context.Context, in *MsgDeregisterAsset,
 	return out, nil
 }
 
+func (c *msgClient) UpdateRedemptionRate(ctx context.Context, in *MsgUpdateRedemptionRate, opts ...grpc.CallOption) (*MsgUpdateRedemptionRateResponse, error) {
+	out := new(MsgUpdateRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.assets.v1.Msg/UpdateRedemptionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetRedemptionRate(ctx context.Context, in *MsgResetRedemptionRate, opts ...grpc.CallOption) (*MsgResetRedemptionRateResponse, error) {
+	out := new(MsgResetRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.assets.v1.Msg/ResetRedemptionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterAsset defines the operation for registering an asset.
@@ -312,6 +548,13 @@ type MsgServer interface {
 	// DeregisterAsset defines the operation for de-registering an asset with
 	// its denomination.
 	DeregisterAsset(context.Context, *MsgDeregisterAsset) (*MsgDeregisterAssetResponse, error)
+	// UpdateRedemptionRate defines the operation for updating the redemption
+	// rate of a liquid staking asset whose rate is set by the authority.
+	UpdateRedemptionRate(context.Context, *MsgUpdateRedemptionRate) (*MsgUpdateRedemptionRateResponse, error)
+	// ResetRedemptionRate defines the operation for resetting the redemption
+	// rate of a liquid staking asset, regardless of its rate source and of the
+	// max rate change allowed.
+	ResetRedemptionRate(context.Context, *MsgResetRedemptionRate) (*MsgResetRedemptionRateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +567,12 @@ func (*UnimplementedMsgServer) RegisterAsset(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) DeregisterAsset(ctx context.Context, req *MsgDeregisterAsset) (*MsgDeregisterAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAsset not implemented")
 }
+func (*UnimplementedMsgServer) UpdateRedemptionRate(ctx context.Context, req *MsgUpdateRedemptionRate) (*MsgUpdateRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionRate not implemented")
+}
+func (*UnimplementedMsgServer) ResetRedemptionRate(ctx context.Context, req *MsgResetRedemptionRate) (*MsgResetRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRedemptionRate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +614,42 @@ func _Msg_DeregisterAsset_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateRedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRedemptionRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.assets.v1.Msg/UpdateRedemptionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRedemptionRate(ctx, req.(*MsgUpdateRedemptionRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetRedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetRedemptionRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetRedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.assets.v1.Msg/ResetRedemptionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetRedemptionRate(ctx, req.(*MsgResetRedemptionRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milkyway.assets.v1.Msg",
@@ -378,6 +663,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterAsset",
 			Handler:    _Msg_DeregisterAsset_Handler,
 		},
+		{
+			MethodName: "UpdateRedemptionRate",
+			Handler:    _Msg_UpdateRedemptionRate_Handler,
+		},
+		{
+			MethodName: "ResetRedemptionRate",
+			Handler:    _Msg_ResetRedemptionRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/assets/v1/messages.proto",
@@ -506,74 +799,599 @@ func (m *MsgDeregisterAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRedemptionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterAsset) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdateRedemptionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMessages(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRedemptionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRedemptionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRedemptionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRedemptionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRedemptionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRedemptionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRedemptionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRedemptionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRedemptionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRedemptionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetRedemptionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgResetRedemptionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovMessages(uint64(l))
-	return n
-}
 
-func (m *MsgRegisterAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeregisterAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+func (m *MsgDeregisterAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeregisterAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRedemptionRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -596,10 +1414,10 @@ func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRedemptionRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -636,9 +1454,9 @@ func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -648,22 +1466,55 @@ func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -688,7 +1539,7 @@ func (m *MsgRegisterAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRedemptionRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -711,10 +1562,10 @@ func (m *MsgRegisterAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRedemptionRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRedemptionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -738,7 +1589,7 @@ func (m *MsgRegisterAssetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeregisterAsset) Unmarshal(dAtA []byte) error {
+func (m *MsgResetRedemptionRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -761,10 +1612,10 @@ func (m *MsgDeregisterAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeregisterAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetRedemptionRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeregisterAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetRedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -831,6 +1682,40 @@ func (m *MsgDeregisterAsset) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -852,7 +1737,7 @@ func (m *MsgDeregisterAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeregisterAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetRedemptionRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -875,10 +1760,10 @@ func (m *MsgDeregisterAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeregisterAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetRedemptionRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeregisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetRedemptionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +17,20 @@ func NewAsset(denom, ticker string, exponent uint32) Asset {
 	}
 }
 
+// NewLiquidStakingAsset returns a new Asset instance that can be redeemed for
+// the given underlying asset
+func NewLiquidStakingAsset(denom, ticker string, exponent uint32, underlying UnderlyingAsset) Asset {
+	asset := NewAsset(denom, ticker, exponent)
+	asset.Underlying = &underlying
+	return asset
+}
+
+// IsLiquidStakingAsset returns true if the asset can be redeemed for an
+// underlying asset
+func (asset *Asset) IsLiquidStakingAsset() bool {
+	return asset.Underlying != nil
+}
+
 // Validate validates the Asset instance
 func (asset *Asset) Validate() error {
 	err := sdk.ValidateDenom(asset.Denom)
@@ -27,5 +43,138 @@ func (asset *Asset) Validate() error {
 		return fmt.Errorf("invalid ticker: %w", err)
 	}
 
+	if asset.Underlying != nil {
+		err = asset.Underlying.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid underlying asset: %w", err)
+		}
+
+		if asset.Underlying.Denom == asset.Denom {
+			return fmt.Errorf("underlying asset cannot be the asset itself")
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewUnderlyingAsset returns a new UnderlyingAsset instance
+func NewUnderlyingAsset(
+	denom string,
+	rateSource RedemptionRateSource,
+	contract string,
+	maxRateChange math.LegacyDec,
+) UnderlyingAsset {
+	return UnderlyingAsset{
+		Denom:         denom,
+		RateSource:    rateSource,
+		Contract:      contract,
+		MaxRateChange: maxRateChange,
+	}
+}
+
+// Validate validates the UnderlyingAsset instance
+func (u *UnderlyingAsset) Validate() error {
+	err := sdk.ValidateDenom(u.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	switch u.RateSource {
+	case REDEMPTION_RATE_SOURCE_GOVERNANCE:
+		if u.Contract != "" {
+			return fmt.Errorf("contract must be empty when the rate is set by governance")
+		}
+
+	case REDEMPTION_RATE_SOURCE_CONTRACT:
+		_, err = sdk.AccAddressFromBech32(u.Contract)
+		if err != nil {
+			return fmt.Errorf("invalid contract address: %s, %w", u.Contract, err)
+		}
+
+	default:
+		return fmt.Errorf("invalid rate source: %s", u.RateSource)
+	}
+
+	if u.MaxRateChange.IsNil() || !u.MaxRateChange.IsPositive() || u.MaxRateChange.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid max rate change: %s", u.MaxRateChange)
+	}
+
+	return nil
+}
+
+// ValidateRateChange checks that moving from the snapshot rate to the new rate
+// does not exceed the maximum rate change allowed
+func (u *UnderlyingAsset) ValidateRateChange(snapshotRate, newRate math.LegacyDec) error {
+	change := newRate.Sub(snapshotRate).Abs().Quo(snapshotRate)
+	if change.GT(u.MaxRateChange) {
+		return fmt.Errorf("rate change from %s to %s exceeds the max rate change %s",
+			snapshotRate, newRate, u.MaxRateChange)
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewRedemptionRate returns a new RedemptionRate instance
+func NewRedemptionRate(denom string, rate math.LegacyDec) RedemptionRate {
+	return RedemptionRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// ValidateRate checks that the given value is a valid redemption rate
+func ValidateRate(rate math.LegacyDec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return fmt.Errorf("invalid rate: %s", rate)
+	}
+	return nil
+}
+
+// Validate validates the RedemptionRate instance
+func (r *RedemptionRate) Validate() error {
+	err := sdk.ValidateDenom(r.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	return ValidateRate(r.Rate)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewRedemptionRateSnapshot returns a new RedemptionRateSnapshot instance
+func NewRedemptionRateSnapshot(denom string, rate math.LegacyDec, snapshotTime time.Time) RedemptionRateSnapshot {
+	return RedemptionRateSnapshot{
+		Denom: denom,
+		Rate:  rate,
+		Time:  snapshotTime,
+	}
+}
+
+// IsExpired returns true if the rate window started by the snapshot has ended
+// at the given time
+func (s *RedemptionRateSnapshot) IsExpired(now time.Time) bool {
+	return !now.Before(s.Time.Add(RedemptionRateWindow))
+}
+
+// Validate validates the RedemptionRateSnapshot instance
+func (s *RedemptionRateSnapshot) Validate() error {
+	err := sdk.ValidateDenom(s.Denom)
+	if err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	err = ValidateRate(s.Rate)
+	if err != nil {
+		return err
+	}
+
+	if s.Time.IsZero() {
+		return fmt.Errorf("invalid time: %s", s.Time)
+	}
+
 	return nil
 }
@@ -4,18 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +30,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RedemptionRateSource defines where the redemption rate of a liquid staking
+// asset is taken from
+type RedemptionRateSource int32
+
+const (
+	// REDEMPTION_RATE_SOURCE_UNSPECIFIED defines an unspecified source
+	REDEMPTION_RATE_SOURCE_UNSPECIFIED RedemptionRateSource = 0
+	// REDEMPTION_RATE_SOURCE_GOVERNANCE identifies a redemption rate that is set
+	// by the module authority
+	REDEMPTION_RATE_SOURCE_GOVERNANCE RedemptionRateSource = 1
+	// REDEMPTION_RATE_SOURCE_CONTRACT identifies a redemption rate that is
+	// queried from a CosmWasm contract at the beginning of each block
+	REDEMPTION_RATE_SOURCE_CONTRACT RedemptionRateSource = 2
+)
+
+var RedemptionRateSource_name = map[int32]string{
+	0: "REDEMPTION_RATE_SOURCE_UNSPECIFIED",
+	1: "REDEMPTION_RATE_SOURCE_GOVERNANCE",
+	2: "REDEMPTION_RATE_SOURCE_CONTRACT",
+}
+
+var RedemptionRateSource_value = map[string]int32{
+	"REDEMPTION_RATE_SOURCE_UNSPECIFIED": 0,
+	"REDEMPTION_RATE_SOURCE_GOVERNANCE":  1,
+	"REDEMPTION_RATE_SOURCE_CONTRACT":    2,
+}
+
+func (x RedemptionRateSource) String() string {
+	return proto.EnumName(RedemptionRateSource_name, int32(x))
+}
+
+func (RedemptionRateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_551852e014d32f36, []int{0}
+}
+
 // Asset represents an asset that can be registered on the chain.
 type Asset struct {
 	// Denom is the denomination of the asset.
@@ -33,6 +75,11 @@ type Asset struct {
 	// raise the denom to in order to equal the given ticker.
 	// 1 ticker = 10^exponent denom
 	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// Underlying contains the details of the asset that this asset can be
+	// redeemed for, if this is a liquid staking asset. When set, the asset
+	// is valued using the price of the underlying asset multiplied by the
+	// redemption rate instead of the price of its own ticker.
+	Underlying *UnderlyingAsset `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -68,28 +115,196 @@ func (m *Asset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Asset proto.InternalMessageInfo
 
+// UnderlyingAsset represents the asset that a liquid staking asset can be
+// redeemed for, along with the way the redemption rate is obtained.
+type UnderlyingAsset struct {
+	// Denom is the denomination of the underlying asset. The underlying asset
+	// must be registered as well.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// RateSource is the source of the redemption rate.
+	RateSource RedemptionRateSource `protobuf:"varint,2,opt,name=rate_source,json=rateSource,proto3,enum=milkyway.assets.v1.RedemptionRateSource" json:"rate_source,omitempty"`
+	// Contract is the address of the CosmWasm contract that is queried to get
+	// the redemption rate. It must be set only if the rate source is
+	// REDEMPTION_RATE_SOURCE_CONTRACT.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// MaxRateChange is the maximum relative change allowed with respect to the
+	// redemption rate snapshot taken at the beginning of the current rate window
+	// (e.g. 0.05 for 5%). Updates that exceed this bound are rejected.
+	MaxRateChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_rate_change,json=maxRateChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate_change"`
+}
+
+func (m *UnderlyingAsset) Reset()         { *m = UnderlyingAsset{} }
+func (m *UnderlyingAsset) String() string { return proto.CompactTextString(m) }
+func (*UnderlyingAsset) ProtoMessage()    {}
+func (*UnderlyingAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_551852e014d32f36, []int{1}
+}
+func (m *UnderlyingAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnderlyingAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnderlyingAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnderlyingAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnderlyingAsset.Merge(m, src)
+}
+func (m *UnderlyingAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnderlyingAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnderlyingAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnderlyingAsset proto.InternalMessageInfo
+
+// RedemptionRate represents the redemption rate of a liquid staking asset.
+// It defines how many units (expressed using the ticker) of the underlying
+// asset one unit (expressed using the ticker) of the liquid staking asset
+// can be redeemed for.
+type RedemptionRate struct {
+	// Denom is the denomination of the liquid staking asset.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the redemption rate of the asset.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *RedemptionRate) Reset()         { *m = RedemptionRate{} }
+func (m *RedemptionRate) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRate) ProtoMessage()    {}
+func (*RedemptionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_551852e014d32f36, []int{2}
+}
+func (m *RedemptionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRate.Merge(m, src)
+}
+func (m *RedemptionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRate proto.InternalMessageInfo
+
+// RedemptionRateSnapshot represents the redemption rate of a liquid staking
+// asset at the beginning of a rate window. Redemption rate updates are bounded
+// with respect to the snapshot rate until the window ends.
+type RedemptionRateSnapshot struct {
+	// Denom is the denomination of the liquid staking asset.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the redemption rate of the asset at the time of the snapshot.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// Time is the time at which the snapshot was taken.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RedemptionRateSnapshot) Reset()         { *m = RedemptionRateSnapshot{} }
+func (m *RedemptionRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateSnapshot) ProtoMessage()    {}
+func (*RedemptionRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_551852e014d32f36, []int{3}
+}
+func (m *RedemptionRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateSnapshot.Merge(m, src)
+}
+func (m *RedemptionRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateSnapshot proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("milkyway.assets.v1.RedemptionRateSource", RedemptionRateSource_name, RedemptionRateSource_value)
 	proto.RegisterType((*Asset)(nil), "milkyway.assets.v1.Asset")
+	proto.RegisterType((*UnderlyingAsset)(nil), "milkyway.assets.v1.UnderlyingAsset")
+	proto.RegisterType((*RedemptionRate)(nil), "milkyway.assets.v1.RedemptionRate")
+	proto.RegisterType((*RedemptionRateSnapshot)(nil), "milkyway.assets.v1.RedemptionRateSnapshot")
 }
 
 func init() { proto.RegisterFile("milkyway/assets/v1/models.proto", fileDescriptor_551852e014d32f36) }
 
 var fileDescriptor_551852e014d32f36 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xcd, 0xcc, 0xc9,
-	0xae, 0x2c, 0x4f, 0xac, 0xd4, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0xcf,
-	0xcd, 0x4f, 0x49, 0xcd, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x4a, 0x81, 0x5c, 0xac, 0x8e, 0x20, 0x25, 0x42, 0x22, 0x5c, 0xac, 0x29, 0xa9, 0x79,
-	0xf9, 0xb9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x18, 0x17, 0x5b, 0x49,
-	0x66, 0x72, 0x76, 0x6a, 0x91, 0x04, 0x13, 0x58, 0x18, 0xca, 0x13, 0x92, 0xe2, 0xe2, 0x48, 0xad,
-	0x28, 0xc8, 0xcf, 0x4b, 0xcd, 0x2b, 0x91, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x0d, 0x82, 0xf3, 0x9d,
-	0x82, 0x4e, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
-	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
-	0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x98, 0x2b, 0x75, 0x73,
-	0x12, 0x93, 0x8a, 0xe1, 0x3c, 0xfd, 0x32, 0x43, 0x23, 0xfd, 0x0a, 0x98, 0xd7, 0x4a, 0x2a, 0x0b,
-	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xae, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x06, 0xcd, 0x84,
-	0x4d, 0xfa, 0x00, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0xf5, 0x95, 0xb4, 0x6a, 0xaf, 0xea, 0x1f, 0x4e, 0x51, 0x15, 0x82, 0xe4, 0x94, 0x54, 0xa0,
+	0xa8, 0x52, 0x6d, 0x25, 0x54, 0x88, 0x35, 0x71, 0x0c, 0x0a, 0x82, 0xa4, 0xba, 0xa4, 0x48, 0xb0,
+	0x58, 0x17, 0xfb, 0x70, 0xac, 0xe6, 0x7c, 0x96, 0xef, 0x52, 0x92, 0x99, 0x85, 0xb1, 0x13, 0x5f,
+	0x80, 0x85, 0x91, 0xa1, 0x1f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x14, 0x68, 0x06, 0xbe, 0x06, 0xf2,
+	0x39, 0x89, 0xa0, 0x34, 0x03, 0x03, 0x8b, 0x75, 0xef, 0xee, 0xdd, 0xef, 0xbd, 0xdf, 0xef, 0x9e,
+	0x0c, 0x0b, 0x2c, 0xe8, 0x1f, 0x8d, 0xde, 0x92, 0x91, 0x49, 0x84, 0xa0, 0x52, 0x98, 0xc7, 0x65,
+	0x93, 0x71, 0x8f, 0xf6, 0x85, 0x11, 0xc5, 0x5c, 0x72, 0x84, 0xa6, 0x04, 0x23, 0x25, 0x18, 0xc7,
+	0xe5, 0xfc, 0x6d, 0xc2, 0x82, 0x90, 0x9b, 0xea, 0x9b, 0xd2, 0xf2, 0x77, 0x5c, 0x2e, 0x18, 0x17,
+	0x8e, 0x42, 0x66, 0x0a, 0x26, 0x47, 0x59, 0x9f, 0xfb, 0x3c, 0xdd, 0x4f, 0x56, 0x93, 0xdd, 0x82,
+	0xcf, 0xb9, 0xdf, 0xa7, 0xa6, 0x42, 0xdd, 0xc1, 0x1b, 0x53, 0x06, 0x8c, 0x0a, 0x49, 0x58, 0x94,
+	0x12, 0x8a, 0x1f, 0x00, 0x5c, 0xac, 0x26, 0x92, 0x28, 0x0b, 0x17, 0x3d, 0x1a, 0x72, 0x96, 0x03,
+	0xdb, 0xa0, 0xb4, 0x82, 0x53, 0x80, 0xb6, 0xe0, 0x92, 0x0c, 0xdc, 0x23, 0x1a, 0xe7, 0x16, 0xd4,
+	0xf6, 0x04, 0xa1, 0x3c, 0x5c, 0xa6, 0xc3, 0x88, 0x87, 0x34, 0x94, 0xb9, 0x5b, 0xdb, 0xa0, 0xb4,
+	0x86, 0x67, 0x18, 0x59, 0x10, 0x0e, 0x42, 0x8f, 0xc6, 0xfd, 0x51, 0x10, 0xfa, 0xb9, 0xcc, 0x36,
+	0x28, 0xad, 0x56, 0x76, 0x8c, 0xbf, 0x3b, 0x34, 0x0e, 0x67, 0x2c, 0x65, 0x01, 0xff, 0x76, 0xad,
+	0xf8, 0x6e, 0x01, 0x6e, 0x5c, 0x3b, 0x9f, 0x63, 0xb1, 0x01, 0x57, 0x63, 0x22, 0xa9, 0x23, 0xf8,
+	0x20, 0x76, 0xa9, 0xf2, 0xb9, 0x5e, 0x29, 0xdd, 0xa4, 0x87, 0xa9, 0x47, 0x59, 0x24, 0x03, 0x1e,
+	0x62, 0x22, 0x69, 0x5b, 0xf1, 0x31, 0x8c, 0x67, 0x6b, 0xb4, 0x0f, 0x97, 0x5d, 0x1e, 0xca, 0x98,
+	0xb8, 0x69, 0x57, 0x2b, 0xb5, 0xdc, 0xc5, 0xe9, 0x5e, 0x76, 0x32, 0xe8, 0xaa, 0xe7, 0xc5, 0x54,
+	0x88, 0xb6, 0x8c, 0x83, 0xd0, 0xc7, 0x33, 0x26, 0x7a, 0x05, 0x37, 0x18, 0x19, 0x3a, 0xca, 0x84,
+	0xdb, 0x23, 0xa1, 0x4f, 0x55, 0xd3, 0x2b, 0xb5, 0xf2, 0xd9, 0x65, 0x41, 0xfb, 0x7a, 0x59, 0xb8,
+	0x9b, 0x16, 0x10, 0xde, 0x91, 0x11, 0x70, 0x93, 0x11, 0xd9, 0x33, 0x9e, 0x53, 0x9f, 0xb8, 0xa3,
+	0x3a, 0x75, 0x2f, 0x4e, 0xf7, 0xe0, 0xa4, 0x7e, 0x9d, 0xba, 0x78, 0x8d, 0x91, 0x61, 0x62, 0xce,
+	0x52, 0x75, 0x8a, 0x31, 0x5c, 0xff, 0xd3, 0xf4, 0x9c, 0x19, 0x3c, 0x83, 0x99, 0x44, 0x3e, 0x7d,
+	0xa4, 0xda, 0xa3, 0x7f, 0xd6, 0xfd, 0xf4, 0xf3, 0xf3, 0x2e, 0xc0, 0xaa, 0x46, 0xf1, 0x14, 0xc0,
+	0xad, 0x6b, 0x93, 0x0a, 0x49, 0x24, 0x7a, 0x5c, 0xfe, 0x7f, 0x71, 0xf4, 0x18, 0x66, 0x92, 0x88,
+	0xaa, 0xe9, 0xaf, 0x56, 0xf2, 0x46, 0x9a, 0x5f, 0x63, 0x9a, 0x5f, 0xa3, 0x33, 0xcd, 0x6f, 0x6d,
+	0x39, 0xd1, 0x39, 0xf9, 0x56, 0x00, 0x58, 0xdd, 0xd8, 0x3d, 0x01, 0x30, 0x7b, 0xd3, 0x03, 0xa3,
+	0x07, 0xb0, 0x88, 0xed, 0xba, 0xfd, 0xe2, 0xa0, 0xd3, 0x68, 0x35, 0x1d, 0x5c, 0xed, 0xd8, 0x4e,
+	0xbb, 0x75, 0x88, 0x2d, 0xdb, 0x39, 0x6c, 0xb6, 0x0f, 0x6c, 0xab, 0xf1, 0xa4, 0x61, 0xd7, 0x37,
+	0x35, 0x74, 0x1f, 0xde, 0x9b, 0xc3, 0x7b, 0xda, 0x7a, 0x69, 0xe3, 0x66, 0xb5, 0x69, 0xd9, 0x9b,
+	0x00, 0xed, 0xc0, 0xc2, 0x1c, 0x9a, 0xd5, 0x6a, 0x76, 0x70, 0xd5, 0xea, 0x6c, 0x2e, 0xe4, 0x33,
+	0xef, 0x3f, 0xea, 0x5a, 0x0d, 0x9f, 0xfd, 0xd0, 0xb5, 0xb3, 0x2b, 0x1d, 0x9c, 0x5f, 0xe9, 0xe0,
+	0xfb, 0x95, 0x0e, 0x4e, 0xc6, 0xba, 0x76, 0x3e, 0xd6, 0xb5, 0x2f, 0x63, 0x5d, 0x7b, 0xbd, 0xef,
+	0x07, 0xb2, 0x37, 0xe8, 0x1a, 0x2e, 0x67, 0xe6, 0x34, 0xac, 0x7b, 0x7d, 0xd2, 0x15, 0x33, 0x64,
+	0x1e, 0x97, 0x2b, 0xe6, 0x70, 0xfa, 0xcf, 0x90, 0xa3, 0x88, 0x8a, 0xee, 0x92, 0x1a, 0xc5, 0xc3,
+	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40, 0x99, 0x00, 0x5a, 0x53, 0x04, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -112,6 +327,18 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Underlying != nil {
+		{
+			size, err := m.Underlying.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Exponent != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.Exponent))
 		i--
@@ -134,6 +361,146 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnderlyingAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnderlyingAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnderlyingAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxRateChange.Size()
+		i -= size
+		if _, err := m.MaxRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RateSource != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RateSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintModels(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -162,6 +529,64 @@ func (m *Asset) Size() (n int) {
 	if m.Exponent != 0 {
 		n += 1 + sovModels(uint64(m.Exponent))
 	}
+	if m.Underlying != nil {
+		l = m.Underlying.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *UnderlyingAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.RateSource != 0 {
+		n += 1 + sovModels(uint64(m.RateSource))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.MaxRateChange.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *RedemptionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *RedemptionRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovModels(uint64(l))
 	return n
 }
 
@@ -283,6 +708,474 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Underlying", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Underlying == nil {
+				m.Underlying = &UnderlyingAsset{}
+			}
+			if err := m.Underlying.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnderlyingAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnderlyingAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnderlyingAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			m.RateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSource |= RedemptionRateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
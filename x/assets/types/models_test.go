@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milkyway-labs/milkyway/v12/x/assets/types"
@@ -29,6 +30,62 @@ func TestAsset_Validate(t *testing.T) {
 			asset:  types.NewAsset("btc", "bitcoin", 1),
 			expErr: false,
 		},
+		{
+			name: "underlying asset equal to the asset itself returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"stuatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "invalid underlying denom returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"@#$", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "unspecified rate source returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_UNSPECIFIED, "", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "contract with governance rate source returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "invalid contract address returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_CONTRACT, "invalid", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "zero max rate change returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyZeroDec(),
+			)),
+			expErr: true,
+		},
+		{
+			name: "max rate change greater than one returns error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDec(2),
+			)),
+			expErr: true,
+		},
+		{
+			name: "valid liquid staking asset returns no error",
+			asset: types.NewLiquidStakingAsset("stuatom", "STATOM", 6, types.NewUnderlyingAsset(
+				"uatom", types.REDEMPTION_RATE_SOURCE_CONTRACT, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", math.LegacyNewDecWithPrec(5, 2),
+			)),
+			expErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -42,3 +99,50 @@ func TestAsset_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestUnderlyingAsset_ValidateRateChange(t *testing.T) {
+	underlying := types.NewUnderlyingAsset("uatom", types.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", math.LegacyNewDecWithPrec(5, 2))
+
+	testCases := []struct {
+		name        string
+		currentRate math.LegacyDec
+		newRate     math.LegacyDec
+		expErr      bool
+	}{
+		{
+			name:        "increase above the max change returns error",
+			currentRate: math.LegacyOneDec(),
+			newRate:     math.LegacyNewDecWithPrec(106, 2),
+			expErr:      true,
+		},
+		{
+			name:        "decrease above the max change returns error",
+			currentRate: math.LegacyOneDec(),
+			newRate:     math.LegacyNewDecWithPrec(94, 2),
+			expErr:      true,
+		},
+		{
+			name:        "change equal to the max change returns no error",
+			currentRate: math.LegacyOneDec(),
+			newRate:     math.LegacyNewDecWithPrec(105, 2),
+			expErr:      false,
+		},
+		{
+			name:        "change below the max change returns no error",
+			currentRate: math.LegacyNewDec(2),
+			newRate:     math.LegacyNewDecWithPrec(195, 2),
+			expErr:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := underlying.ValidateRateChange(tc.currentRate, tc.newRate)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
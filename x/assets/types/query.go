@@ -13,3 +13,8 @@ func NewQueryAssetsRequest(ticker string, pagination *query.PageRequest) *QueryA
 func NewQueryAssetRequest(denom string) *QueryAssetRequest {
 	return &QueryAssetRequest{Denom: denom}
 }
+
+// NewQueryRedemptionRateRequest creates a new instance of QueryRedemptionRateRequest
+func NewQueryRedemptionRateRequest(denom string) *QueryRedemptionRateRequest {
+	return &QueryRedemptionRateRequest{Denom: denom}
+}
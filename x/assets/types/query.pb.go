@@ -234,46 +234,147 @@ func (m *QueryAssetResponse) GetAsset() Asset {
 	return Asset{}
 }
 
+// QueryRedemptionRateRequest is the request type for the Query/RedemptionRate
+// RPC method.
+type QueryRedemptionRateRequest struct {
+	// Denom is the denomination of the liquid staking asset.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRedemptionRateRequest) Reset()         { *m = QueryRedemptionRateRequest{} }
+func (m *QueryRedemptionRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d64a9f39441c4d1, []int{4}
+}
+func (m *QueryRedemptionRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionRateRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRedemptionRateResponse is the response type for the
+// Query/RedemptionRate RPC method.
+type QueryRedemptionRateResponse struct {
+	// RedemptionRate is the redemption rate of the liquid staking asset.
+	RedemptionRate RedemptionRate `protobuf:"bytes,1,opt,name=redemption_rate,json=redemptionRate,proto3" json:"redemption_rate"`
+}
+
+func (m *QueryRedemptionRateResponse) Reset()         { *m = QueryRedemptionRateResponse{} }
+func (m *QueryRedemptionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d64a9f39441c4d1, []int{5}
+}
+func (m *QueryRedemptionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionRateResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateResponse) GetRedemptionRate() RedemptionRate {
+	if m != nil {
+		return m.RedemptionRate
+	}
+	return RedemptionRate{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAssetsRequest)(nil), "milkyway.assets.v1.QueryAssetsRequest")
 	proto.RegisterType((*QueryAssetsResponse)(nil), "milkyway.assets.v1.QueryAssetsResponse")
 	proto.RegisterType((*QueryAssetRequest)(nil), "milkyway.assets.v1.QueryAssetRequest")
 	proto.RegisterType((*QueryAssetResponse)(nil), "milkyway.assets.v1.QueryAssetResponse")
+	proto.RegisterType((*QueryRedemptionRateRequest)(nil), "milkyway.assets.v1.QueryRedemptionRateRequest")
+	proto.RegisterType((*QueryRedemptionRateResponse)(nil), "milkyway.assets.v1.QueryRedemptionRateResponse")
 }
 
 func init() { proto.RegisterFile("milkyway/assets/v1/query.proto", fileDescriptor_2d64a9f39441c4d1) }
 
 var fileDescriptor_2d64a9f39441c4d1 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
-	0x1c, 0xf6, 0x05, 0x6c, 0xa9, 0xd7, 0xa9, 0x47, 0x85, 0x82, 0x55, 0xb9, 0x91, 0x05, 0x69, 0x88,
-	0xc4, 0x9d, 0x6c, 0x60, 0x63, 0xa1, 0x03, 0x0c, 0x48, 0x08, 0x3c, 0xb2, 0x9d, 0xd3, 0x93, 0xb1,
-	0x6a, 0xfb, 0xdc, 0xdc, 0xc5, 0x60, 0xa1, 0x2e, 0x5d, 0x59, 0x90, 0x58, 0x79, 0x00, 0x46, 0x1e,
-	0xa3, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x24, 0x5e, 0x03, 0xf9, 0xee, 0xdc, 0xb4, 0x8a, 0x9b,
-	0x2c, 0xd1, 0x5d, 0x7e, 0xdf, 0xf7, 0xfb, 0xfe, 0xf8, 0xa0, 0x97, 0xa7, 0xd9, 0x71, 0xfd, 0x81,
-	0xd6, 0x84, 0x0a, 0xc1, 0xa4, 0x20, 0x55, 0x40, 0x4e, 0x66, 0x6c, 0x5a, 0xe3, 0x72, 0xca, 0x25,
-	0x47, 0xa8, 0x9d, 0x63, 0x3d, 0xc7, 0x55, 0xe0, 0xee, 0xd0, 0x3c, 0x2d, 0x38, 0x51, 0xbf, 0x1a,
-	0xe6, 0x8e, 0x27, 0x5c, 0xe4, 0x5c, 0x90, 0x98, 0x0a, 0xa6, 0xf9, 0xa4, 0x0a, 0x62, 0x26, 0x69,
-	0x40, 0x4a, 0x9a, 0xa4, 0x05, 0x95, 0x29, 0x2f, 0x0c, 0x76, 0x37, 0xe1, 0x09, 0x57, 0x47, 0xd2,
-	0x9c, 0xcc, 0xbf, 0x7b, 0x09, 0xe7, 0x49, 0xc6, 0x08, 0x2d, 0x53, 0x42, 0x8b, 0x82, 0x4b, 0x45,
-	0x11, 0x66, 0xba, 0xdf, 0x61, 0x33, 0xe7, 0x47, 0x2c, 0x33, 0x00, 0x5f, 0x42, 0xf4, 0xb6, 0x91,
-	0x7d, 0xae, 0xc6, 0x11, 0x3b, 0x99, 0x31, 0x21, 0xd1, 0x5d, 0xe8, 0xc8, 0x74, 0x72, 0xcc, 0xa6,
-	0x7d, 0x30, 0x00, 0xa3, 0xad, 0xc8, 0xdc, 0xd0, 0x0b, 0x08, 0x97, 0xb6, 0xfa, 0xbd, 0x01, 0x18,
-	0x6d, 0x87, 0x43, 0xac, 0x33, 0xe0, 0x26, 0x03, 0xd6, 0x1d, 0x98, 0x0c, 0xf8, 0x0d, 0x4d, 0x98,
-	0xd9, 0x19, 0x5d, 0x61, 0xfa, 0xdf, 0x00, 0xbc, 0x73, 0x4d, 0x56, 0x94, 0xbc, 0x10, 0x0c, 0x3d,
-	0x83, 0x8e, 0xf6, 0xd9, 0x07, 0x83, 0x5b, 0xa3, 0xed, 0xf0, 0x1e, 0x5e, 0xad, 0x11, 0x2b, 0xce,
-	0xe1, 0xd6, 0xf9, 0xef, 0x7d, 0xeb, 0xfb, 0xbf, 0x1f, 0x63, 0x10, 0x19, 0x0e, 0x7a, 0xd9, 0xe1,
-	0xee, 0x60, 0xa3, 0x3b, 0x2d, 0x7d, 0xcd, 0xde, 0x43, 0xb8, 0xb3, 0x74, 0xd7, 0x76, 0xb2, 0x0b,
-	0xed, 0x23, 0x56, 0xf0, 0xdc, 0x54, 0xa2, 0x2f, 0xfe, 0xab, 0xab, 0xfd, 0x5d, 0xe6, 0x78, 0x0a,
-	0x6d, 0xe5, 0x49, 0x61, 0xd7, 0xc6, 0xb8, 0xdd, 0xc4, 0x88, 0x34, 0x3a, 0xfc, 0xdc, 0x83, 0xb6,
-	0xda, 0x86, 0x4e, 0xa1, 0xa3, 0xab, 0x41, 0xc3, 0x2e, 0xee, 0xea, 0x27, 0x73, 0x0f, 0x36, 0xe2,
-	0xb4, 0x37, 0xdf, 0x3f, 0xfb, 0xf9, 0xf7, 0x6b, 0x6f, 0x0f, 0xb9, 0xa4, 0xe3, 0x6d, 0x98, 0x26,
-	0xcf, 0x00, 0xb4, 0x15, 0x0d, 0x3d, 0x58, 0xbf, 0xb6, 0x55, 0x1f, 0x6e, 0x82, 0x19, 0xf1, 0xb1,
-	0x12, 0xbf, 0x8f, 0xfc, 0x9b, 0xc5, 0xc9, 0x27, 0xd5, 0xec, 0xe9, 0xe1, 0xeb, 0xf3, 0xb9, 0x07,
-	0x2e, 0xe6, 0x1e, 0xf8, 0x33, 0xf7, 0xc0, 0x97, 0x85, 0x67, 0x5d, 0x2c, 0x3c, 0xeb, 0xd7, 0xc2,
-	0xb3, 0xde, 0x3d, 0x49, 0x52, 0xf9, 0x7e, 0x16, 0xe3, 0x09, 0xcf, 0x2f, 0xf7, 0x3c, 0xca, 0x68,
-	0x2c, 0x96, 0x5b, 0xab, 0x20, 0x24, 0x1f, 0xdb, 0x8d, 0xb2, 0x2e, 0x99, 0x88, 0x1d, 0xf5, 0xe2,
-	0x1f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xc7, 0xbf, 0xfa, 0xbb, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xad, 0x09, 0x74, 0x0a, 0x95, 0x8e, 0x45, 0xe2, 0x5a, 0xb6, 0x61, 0xd1, 0x34,
+	0x06, 0x9c, 0x61, 0xd7, 0x7a, 0x11, 0x2f, 0xf6, 0xa0, 0x07, 0x41, 0xec, 0x1e, 0xbd, 0xc8, 0x6c,
+	0x32, 0xac, 0x4b, 0xb3, 0x3b, 0xdb, 0x9d, 0x49, 0x34, 0x48, 0x2f, 0xfd, 0x04, 0x82, 0x57, 0x3f,
+	0x80, 0x17, 0xc1, 0xbb, 0x5f, 0xa0, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xd7, 0x90, 0x9d,
+	0x99, 0x24, 0x5d, 0xbb, 0xc9, 0x7a, 0x09, 0x3b, 0xf3, 0xfe, 0xef, 0xfd, 0x7f, 0xef, 0xbd, 0x21,
+	0xd0, 0x8e, 0xa3, 0xc1, 0xf1, 0xf8, 0x2d, 0x1d, 0x13, 0x2a, 0x04, 0x93, 0x82, 0x8c, 0x5c, 0x72,
+	0x32, 0x64, 0xd9, 0x18, 0xa7, 0x19, 0x97, 0x1c, 0xa1, 0x59, 0x1c, 0xeb, 0x38, 0x1e, 0xb9, 0xd6,
+	0x36, 0x8d, 0xa3, 0x84, 0x13, 0xf5, 0xab, 0x65, 0x56, 0xb7, 0xc7, 0x45, 0xcc, 0x05, 0x09, 0xa8,
+	0x60, 0x3a, 0x9f, 0x8c, 0xdc, 0x80, 0x49, 0xea, 0x92, 0x94, 0x86, 0x51, 0x42, 0x65, 0xc4, 0x13,
+	0xa3, 0xdd, 0x09, 0x79, 0xc8, 0xd5, 0x27, 0xc9, 0xbf, 0xcc, 0xed, 0x6e, 0xc8, 0x79, 0x38, 0x60,
+	0x84, 0xa6, 0x11, 0xa1, 0x49, 0xc2, 0xa5, 0x4a, 0x11, 0x26, 0xba, 0x57, 0x82, 0x19, 0xf3, 0x3e,
+	0x1b, 0x18, 0x81, 0x23, 0x21, 0x3a, 0xca, 0x6d, 0x9f, 0xa8, 0xb0, 0xcf, 0x4e, 0x86, 0x4c, 0x48,
+	0x74, 0x13, 0x36, 0x64, 0xd4, 0x3b, 0x66, 0x59, 0x13, 0xb4, 0x40, 0x67, 0xc3, 0x37, 0x27, 0xf4,
+	0x14, 0xc2, 0x05, 0x56, 0x73, 0xad, 0x05, 0x3a, 0x9b, 0x5e, 0x1b, 0xeb, 0x1e, 0x70, 0xde, 0x03,
+	0xd6, 0x33, 0x30, 0x3d, 0xe0, 0x97, 0x34, 0x64, 0xa6, 0xa6, 0x7f, 0x29, 0xd3, 0xf9, 0x04, 0xe0,
+	0x8d, 0x82, 0xad, 0x48, 0x79, 0x22, 0x18, 0x7a, 0x0c, 0x1b, 0x9a, 0xb3, 0x09, 0x5a, 0xeb, 0x9d,
+	0x4d, 0xef, 0x16, 0xbe, 0x3a, 0x46, 0xac, 0x72, 0x0e, 0x37, 0xce, 0x7f, 0xee, 0xd5, 0x3e, 0xff,
+	0xf9, 0xda, 0x05, 0xbe, 0xc9, 0x41, 0xcf, 0x4a, 0xe8, 0xf6, 0x2b, 0xe9, 0xb4, 0x75, 0x01, 0xef,
+	0x1e, 0xdc, 0x5e, 0xd0, 0xcd, 0x66, 0xb2, 0x03, 0xeb, 0x7d, 0x96, 0xf0, 0xd8, 0x8c, 0x44, 0x1f,
+	0x9c, 0xe7, 0x97, 0xe7, 0x37, 0xef, 0xe3, 0x21, 0xac, 0x2b, 0x26, 0xa5, 0x5d, 0xd9, 0xc6, 0xb5,
+	0xbc, 0x0d, 0x5f, 0xab, 0x1d, 0x0f, 0x5a, 0xaa, 0x98, 0xcf, 0xfa, 0x2c, 0x4e, 0x73, 0x14, 0x9f,
+	0x4a, 0xb6, 0x1a, 0x20, 0x85, 0xb7, 0x4b, 0x73, 0x0c, 0xc9, 0x11, 0xbc, 0x9e, 0xcd, 0x23, 0xaf,
+	0x33, 0x2a, 0x99, 0x61, 0x72, 0xca, 0x98, 0x8a, 0x45, 0x0c, 0xdc, 0x56, 0x56, 0xb8, 0xf5, 0xbe,
+	0xad, 0xc3, 0xba, 0xb2, 0x44, 0xa7, 0xb0, 0xa1, 0x17, 0x88, 0xda, 0x65, 0xd5, 0xae, 0x3e, 0x2c,
+	0x6b, 0xbf, 0x52, 0xa7, 0xb9, 0x1d, 0xe7, 0xec, 0xfb, 0xef, 0x8f, 0x6b, 0xbb, 0xc8, 0x22, 0x25,
+	0x2f, 0xd8, 0xec, 0xfb, 0x0c, 0xc0, 0xba, 0x4a, 0x43, 0x77, 0x57, 0x97, 0x9d, 0xb9, 0xb7, 0xab,
+	0x64, 0xc6, 0xbc, 0xab, 0xcc, 0xef, 0x20, 0x67, 0xb9, 0x39, 0x79, 0xaf, 0xc6, 0x7f, 0x8a, 0xbe,
+	0x00, 0xb8, 0x55, 0x1c, 0x1b, 0xc2, 0x4b, 0x6d, 0x4a, 0x17, 0x6b, 0x91, 0xff, 0xd6, 0x1b, 0xbe,
+	0x47, 0x8a, 0xef, 0x00, 0x79, 0xd5, 0x7c, 0xe4, 0x9f, 0xed, 0x1f, 0xbe, 0x38, 0x9f, 0xd8, 0xe0,
+	0x62, 0x62, 0x83, 0x5f, 0x13, 0x1b, 0x7c, 0x98, 0xda, 0xb5, 0x8b, 0xa9, 0x5d, 0xfb, 0x31, 0xb5,
+	0x6b, 0xaf, 0x0e, 0xc2, 0x48, 0xbe, 0x19, 0x06, 0xb8, 0xc7, 0xe3, 0x79, 0xdd, 0xfb, 0x03, 0x1a,
+	0x88, 0x85, 0xcb, 0xc8, 0xf5, 0xc8, 0xbb, 0x99, 0x83, 0x1c, 0xa7, 0x4c, 0x04, 0x0d, 0xf5, 0x3f,
+	0xf2, 0xe0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x91, 0x1b, 0xe2, 0x11, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -293,6 +394,10 @@ type QueryClient interface {
 	// Asset defines a gRPC query method that returns the asset associated with
 	// the given token denomination.
 	Asset(ctx context.Context, in *QueryAssetRequest, opts ...grpc.CallOption) (*QueryAssetResponse, error)
+	// RedemptionRate defines a gRPC query method that returns the redemption
+	// rate of the liquid staking asset associated with the given token
+	// denomination.
+	RedemptionRate(ctx context.Context, in *QueryRedemptionRateRequest, opts ...grpc.CallOption) (*QueryRedemptionRateResponse, error)
 }
 
 type queryClient struct {
@@ -321,6 +426,15 @@ func (c *queryClient) Asset(ctx context.Context, in *QueryAssetRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) RedemptionRate(ctx context.Context, in *QueryRedemptionRateRequest, opts ...grpc.CallOption) (*QueryRedemptionRateResponse, error) {
+	out := new(QueryRedemptionRateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.assets.v1.Query/RedemptionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Assets defined a gRPC query method that returns all assets registered.
@@ -328,6 +442,10 @@ type QueryServer interface {
 	// Asset defines a gRPC query method that returns the asset associated with
 	// the given token denomination.
 	Asset(context.Context, *QueryAssetRequest) (*QueryAssetResponse, error)
+	// RedemptionRate defines a gRPC query method that returns the redemption
+	// rate of the liquid staking asset associated with the given token
+	// denomination.
+	RedemptionRate(context.Context, *QueryRedemptionRateRequest) (*QueryRedemptionRateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -340,6 +458,9 @@ func (*UnimplementedQueryServer) Assets(ctx context.Context, req *QueryAssetsReq
 func (*UnimplementedQueryServer) Asset(ctx context.Context, req *QueryAssetRequest) (*QueryAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Asset not implemented")
 }
+func (*UnimplementedQueryServer) RedemptionRate(ctx context.Context, req *QueryRedemptionRateRequest) (*QueryRedemptionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -381,6 +502,24 @@ func _Query_Asset_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RedemptionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.assets.v1.Query/RedemptionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRate(ctx, req.(*QueryRedemptionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milkyway.assets.v1.Query",
@@ -394,6 +533,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Asset",
 			Handler:    _Query_Asset_Handler,
 		},
+		{
+			MethodName: "RedemptionRate",
+			Handler:    _Query_RedemptionRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milkyway/assets/v1/query.proto",
@@ -553,6 +696,69 @@ func (m *QueryAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRedemptionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RedemptionRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -624,6 +830,30 @@ func (m *QueryAssetResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRedemptionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1033,6 +1263,171 @@ func (m *QueryAssetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRedemptionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// RedemptionRateWindow is the duration of the window during which the
+// redemption rate updates are bounded with respect to the same snapshot rate
+const RedemptionRateWindow = 24 * time.Hour
+
+// RedemptionRateQueryGasLimit is the maximum amount of gas that can be used
+// when querying the redemption rate of an asset from a CosmWasm contract
+const RedemptionRateQueryGasLimit uint64 = 1_000_000
+
+// RedemptionRateQueryMsg represents the query sent to the CosmWasm contract
+// set as the redemption rate source of a liquid staking asset
+type RedemptionRateQueryMsg struct {
+	RedemptionRate RedemptionRateQuery `json:"redemption_rate"`
+}
+
+type RedemptionRateQuery struct {
+	Denom string `json:"denom"`
+}
+
+// RedemptionRateQueryResponse represents the response returned by the CosmWasm
+// contract set as the redemption rate source of a liquid staking asset
+type RedemptionRateQueryResponse struct {
+	RedemptionRate math.LegacyDec `json:"redemption_rate"`
+}
@@ -8,6 +8,9 @@ import (
 const (
 	// MaxTickerLength is the maximum length of an asset ticker
 	MaxTickerLength = 10
+
+	// USDTicker is the ticker of the quote currency used to price the assets
+	USDTicker = "USD"
 )
 
 var (
@@ -50,6 +50,21 @@ func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "user_address"},
 					},
 				},
+				{
+					RpcMethod: "InsuranceFundUsages",
+					Use:       "insurance-fund-usages",
+					Short:     "Query all the usages of the users' insurance fund to cover slashes",
+					Example:   fmt.Sprintf("$ %s query liquidvesting insurance-fund-usages", version.AppName),
+				},
+				{
+					RpcMethod: "UserInsuranceFundUsages",
+					Use:       "user-insurance-fund-usages [user-address]",
+					Short:     "Query the usages of an user's insurance fund to cover slashes",
+					Example:   fmt.Sprintf(`$ %s query liquidvesting user-insurance-fund-usages init1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "user_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
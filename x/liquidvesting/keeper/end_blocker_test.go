@@ -39,6 +39,13 @@ func (suite *KeeperTestSuite) TestKeeper_EndBlocker() {
 					nil,
 					restakingtypes.DefaultRestakingCap,
 					restakingtypes.DefaultMaxEntries,
+					"",
+					restakingtypes.DefaultDowntimeJailDuration,
+					restakingtypes.DefaultSlashVetoWindow, restakingtypes.DefaultAccreditedSlashVetoWindow, "",
+					restakingtypes.DefaultOperatorServiceKeyRotationDelay,
+					restakingtypes.DefaultMaxServiceUnbondingTime,
+					restakingtypes.DefaultActiveOperatorsRefreshInterval,
+					restakingtypes.DefaultMaxEvidenceAge,
 				))
 				suite.Require().NoError(err)
 
@@ -127,6 +134,13 @@ func (suite *KeeperTestSuite) TestKeeper_EndBlocker() {
 					nil,
 					restakingtypes.DefaultRestakingCap,
 					restakingtypes.DefaultMaxEntries,
+					"",
+					restakingtypes.DefaultDowntimeJailDuration,
+					restakingtypes.DefaultSlashVetoWindow, restakingtypes.DefaultAccreditedSlashVetoWindow, "",
+					restakingtypes.DefaultOperatorServiceKeyRotationDelay,
+					restakingtypes.DefaultMaxServiceUnbondingTime,
+					restakingtypes.DefaultActiveOperatorsRefreshInterval,
+					restakingtypes.DefaultMaxEvidenceAge,
 				))
 				suite.Require().NoError(err)
 
@@ -22,7 +22,24 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, k.GetAllBurnCoins(ctx), insuranceFundsEntries), nil
+	// Get the insurance fund usages
+	insuranceFundUsages, err := k.GetAllInsuranceFundUsages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextInsuranceFundUsageID, err := k.GetNextInsuranceFundUsageID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(
+		params,
+		k.GetAllBurnCoins(ctx),
+		insuranceFundsEntries,
+		insuranceFundUsages,
+		nextInsuranceFundUsageID,
+	), nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -82,6 +99,21 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		return fmt.Errorf("the liquid vesting module doesn't have the coins specified in the users' insurance fund")
 	}
 
+	// Store the insurance fund usages
+	for _, usage := range state.InsuranceFundUsages {
+		err = k.SaveInsuranceFundUsage(ctx, usage)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.NextInsuranceFundUsageID != 0 {
+		err = k.SetNextInsuranceFundUsageID(ctx, state.NextInsuranceFundUsageID)
+		if err != nil {
+			return err
+		}
+	}
+
 	unbondingDelegations, err := k.restakingKeeper.GetAllUnbondingDelegations(ctx)
 	if err != nil {
 		return err
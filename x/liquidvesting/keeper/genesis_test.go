@@ -30,9 +30,10 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 			},
 			shouldErr: false,
 			expGenesis: &types.GenesisState{
-				Params:             types.DefaultParams(),
-				BurnCoins:          nil,
-				UserInsuranceFunds: nil,
+				Params:                   types.DefaultParams(),
+				BurnCoins:                nil,
+				UserInsuranceFunds:       nil,
+				NextInsuranceFundUsageID: 1,
 			},
 		},
 		{
@@ -55,6 +56,40 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)),
 					),
 				},
+				NextInsuranceFundUsageID: 1,
+			},
+		},
+		{
+			name: "insurance fund usages are exported correctly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveInsuranceFundUsage(ctx, types.NewInsuranceFundUsage(
+					1,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					1,
+					1,
+					10,
+					sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 10)),
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetNextInsuranceFundUsageID(ctx, 2)
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				InsuranceFundUsages: []types.InsuranceFundUsage{
+					types.NewInsuranceFundUsage(
+						1,
+						"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+						1,
+						1,
+						10,
+						sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 10)),
+						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+					),
+				},
+				NextInsuranceFundUsageID: 2,
 			},
 		},
 		{
@@ -71,6 +106,13 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					nil,
 					restakingtypes.DefaultRestakingCap,
 					restakingtypes.DefaultMaxEntries,
+					"",
+					restakingtypes.DefaultDowntimeJailDuration,
+					restakingtypes.DefaultSlashVetoWindow, restakingtypes.DefaultAccreditedSlashVetoWindow, "",
+					restakingtypes.DefaultOperatorServiceKeyRotationDelay,
+					restakingtypes.DefaultMaxServiceUnbondingTime,
+					restakingtypes.DefaultActiveOperatorsRefreshInterval,
+					restakingtypes.DefaultMaxEvidenceAge,
 				))
 				suite.Require().NoError(err)
 
@@ -145,6 +187,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)),
 					),
 				},
+				NextInsuranceFundUsageID: 1,
 			},
 		},
 	}
@@ -187,6 +230,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.DefaultParams(),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -200,6 +245,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(-1), nil, nil, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -209,6 +256,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(0), nil, nil, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -218,6 +267,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(100), nil, nil, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -231,6 +282,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(101), nil, nil, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -240,6 +293,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(2), nil, []string{"cosmos1fdsfd"}, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -249,6 +304,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(2), []string{"cosmos1fdsfd"}, nil, nil),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -258,6 +315,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				types.NewParams(math.LegacyNewDec(2), nil, nil, []string{"cosmos1fdsfd"}),
 				nil,
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -272,6 +331,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 100)),
 					),
 				},
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -286,6 +347,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 100)),
 					),
 				},
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -341,6 +404,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)),
 					),
 				},
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -359,6 +424,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 100)),
 					),
 				},
+				nil,
+				1,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -419,6 +486,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 10)),
 					),
 				},
+				nil,
+				1,
 			),
 			shouldErr: false,
 		},
@@ -434,6 +503,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 					),
 				},
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: true,
 		},
@@ -466,6 +537,8 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 					),
 				},
 				nil,
+				nil,
+				1,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -474,6 +547,36 @@ func (suite *KeeperTestSuite) TestKeepr_InitGenesis() {
 				suite.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 2)), burnCoins[0].Amount)
 			},
 		},
+		{
+			name: "should initialize insurance fund usages properly",
+			genesis: types.NewGenesisState(
+				types.DefaultParams(),
+				nil,
+				nil,
+				[]types.InsuranceFundUsage{
+					types.NewInsuranceFundUsage(
+						1,
+						"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+						1,
+						1,
+						10,
+						sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 10)),
+						sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+					),
+				},
+				2,
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				usages, err := suite.k.GetUserInsuranceFundUsages(ctx, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre")
+				suite.Require().NoError(err)
+				suite.Require().Len(usages, 1)
+
+				nextID, err := suite.k.GetNextInsuranceFundUsageID(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), nextID)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
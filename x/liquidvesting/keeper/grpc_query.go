@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -31,6 +32,50 @@ func (q Querier) InsuranceFund(ctx context.Context, _ *types.QueryInsuranceFundR
 	return &types.QueryInsuranceFundResponse{Amount: balance}, nil
 }
 
+// InsuranceFundUsages implements types.QueryServer.
+func (q Querier) InsuranceFundUsages(ctx context.Context, req *types.QueryInsuranceFundUsagesRequest) (*types.QueryInsuranceFundUsagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	usages, pagination, err := query.CollectionPaginate(ctx, q.insuranceFundUsages, req.Pagination,
+		func(_ collections.Pair[string, uint64], usage types.InsuranceFundUsage) (types.InsuranceFundUsage, error) {
+			return usage, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInsuranceFundUsagesResponse{
+		Usages:     usages,
+		Pagination: pagination,
+	}, nil
+}
+
+// UserInsuranceFundUsages implements types.QueryServer.
+func (q Querier) UserInsuranceFundUsages(ctx context.Context, req *types.QueryUserInsuranceFundUsagesRequest) (*types.QueryUserInsuranceFundUsagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.UserAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user address")
+	}
+
+	usages, pagination, err := query.CollectionPaginate(ctx, q.insuranceFundUsages, req.Pagination,
+		func(_ collections.Pair[string, uint64], usage types.InsuranceFundUsage) (types.InsuranceFundUsage, error) {
+			return usage, nil
+		}, query.WithCollectionPaginationPairPrefix[string, uint64](req.UserAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUserInsuranceFundUsagesResponse{
+		Usages:     usages,
+		Pagination: pagination,
+	}, nil
+}
+
 // Params implements types.QueryServer.
 func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params, err := q.GetParams(ctx)
@@ -27,6 +27,10 @@ type Keeper struct {
 	params         collections.Item[types.Params]
 	insuranceFunds collections.Map[string, types.UserInsuranceFund] // User address -> UserInsuranceFund
 
+	nextInsuranceFundUsageID collections.Sequence
+	// The pair represents the user address and the insurance fund usage ID
+	insuranceFundUsages collections.Map[collections.Pair[string, uint64], types.InsuranceFundUsage]
+
 	// Addresses
 	ModuleAddress string
 	authority     string
@@ -70,6 +74,18 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.UserInsuranceFund](cdc),
 		),
+		nextInsuranceFundUsageID: collections.NewSequence(
+			sb,
+			types.NextInsuranceFundUsageIDKey,
+			"next_insurance_fund_usage_id",
+		),
+		insuranceFundUsages: collections.NewMap(
+			sb,
+			types.InsuranceFundUsagesKey,
+			"insurance_fund_usages",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.InsuranceFundUsage](cdc),
+		),
 
 		ModuleAddress: moduleAddress,
 		authority:     authority,
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/liquidvesting/types"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SlashCoverFn covers the locked representations that have been slashed from the
+// given user by taking the proportional amount of native coins from the user's
+// insurance fund and sending them to the slashed funds destination.
+// The usage of the insurance fund is stored so that it can be queried later.
+func (k *Keeper) SlashCoverFn(ctx context.Context, serviceID uint32, operatorID uint32, user string, slashedAmount sdk.Coins) error {
+	slashedLockedRepresentations := sdk.NewCoins()
+	for _, coin := range slashedAmount {
+		if types.IsLockedRepresentationDenom(coin.Denom) {
+			slashedLockedRepresentations = slashedLockedRepresentations.Add(coin)
+		}
+	}
+
+	// No locked representation has been slashed, nothing to cover
+	if slashedLockedRepresentations.IsZero() {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	insuranceFund, err := k.GetUserInsuranceFund(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	// Compute the amount that should be taken from the user's insurance fund
+	coveredAmount := sdk.NewCoins()
+	for _, coin := range slashedLockedRepresentations {
+		nativeDenom, err := types.LockedDenomToNative(coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		requiredAmount := params.InsurancePercentage.MulInt(coin.Amount).QuoInt64(100).Ceil().TruncateInt()
+		coveredAmount = coveredAmount.Add(sdk.NewCoin(
+			nativeDenom,
+			// Pick the minimum between the required amount and the amount
+			// in the insurance fund, since we can't take more than what the user has
+			math.MinInt(requiredAmount, insuranceFund.Balance.AmountOf(nativeDenom)),
+		))
+	}
+
+	if !coveredAmount.IsZero() {
+		// Update the user's insurance fund
+		insuranceFund.Balance = insuranceFund.Balance.Sub(coveredAmount...)
+		err = k.insuranceFunds.Set(ctx, user, insuranceFund)
+		if err != nil {
+			return err
+		}
+
+		// Send the covered amount to the slashed funds destination
+		err = k.restakingKeeper.TransferSlashedFunds(ctx, k.ModuleAddress, coveredAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	usageID, err := k.incrementNextInsuranceFundUsageID(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	usage := types.NewInsuranceFundUsage(
+		usageID,
+		user,
+		serviceID,
+		operatorID,
+		sdkCtx.BlockHeight(),
+		slashedLockedRepresentations,
+		coveredAmount,
+	)
+	err = k.SaveInsuranceFundUsage(ctx, usage)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCoverSlash,
+			sdk.NewAttribute(types.AttributeKeyInsuranceFundUsageID, fmt.Sprint(usage.ID)),
+			sdk.NewAttribute(types.AttributeKeyUser, user),
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(serviceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(operatorID)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, slashedLockedRepresentations.String()),
+			sdk.NewAttribute(types.AttributeKeyCoveredAmount, coveredAmount.String()),
+		),
+	)
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetNextInsuranceFundUsageID sets the ID that will be assigned to the next insurance fund usage
+func (k *Keeper) SetNextInsuranceFundUsageID(ctx context.Context, usageID uint64) error {
+	return k.nextInsuranceFundUsageID.Set(ctx, usageID)
+}
+
+// GetNextInsuranceFundUsageID returns the ID that will be assigned to the next insurance fund usage
+func (k *Keeper) GetNextInsuranceFundUsageID(ctx context.Context) (uint64, error) {
+	usageID, err := k.nextInsuranceFundUsageID.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The insurance fund usage IDs start from 1
+	if usageID == 0 {
+		return 1, nil
+	}
+
+	return usageID, nil
+}
+
+// incrementNextInsuranceFundUsageID returns the ID to be assigned to a new
+// insurance fund usage and increments the stored one
+func (k *Keeper) incrementNextInsuranceFundUsageID(ctx context.Context) (uint64, error) {
+	usageID, err := k.GetNextInsuranceFundUsageID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.SetNextInsuranceFundUsageID(ctx, usageID+1)
+	if err != nil {
+		return 0, err
+	}
+
+	return usageID, nil
+}
+
+// SaveInsuranceFundUsage stores the given insurance fund usage
+func (k *Keeper) SaveInsuranceFundUsage(ctx context.Context, usage types.InsuranceFundUsage) error {
+	return k.insuranceFundUsages.Set(ctx, collections.Join(usage.UserAddress, usage.ID), usage)
+}
+
+// GetAllInsuranceFundUsages returns all the stored insurance fund usages
+func (k *Keeper) GetAllInsuranceFundUsages(ctx context.Context) ([]types.InsuranceFundUsage, error) {
+	iterator, err := k.insuranceFundUsages.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// GetUserInsuranceFundUsages returns all the usages of the given user's insurance fund
+func (k *Keeper) GetUserInsuranceFundUsages(ctx context.Context, user string) ([]types.InsuranceFundUsage, error) {
+	iterator, err := k.insuranceFundUsages.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](user))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	"github.com/milkyway-labs/milkyway/v12/x/liquidvesting/types"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	restakingkeeper "github.com/milkyway-labs/milkyway/v12/x/restaking/keeper"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SlashCoverFn() {
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		slashedAmount    sdk.Coins
+		shouldErr        bool
+		expUsages        []types.InsuranceFundUsage
+		expInsuranceFund sdk.Coins
+		expEvents        sdk.Events
+		check            func(ctx sdk.Context)
+	}{
+		{
+			name: "slash without locked representations is not covered",
+			store: func(ctx sdk.Context) {
+				suite.fundAccountInsuranceFund(ctx,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 10)),
+				)
+			},
+			slashedAmount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			shouldErr:        false,
+			expUsages:        nil,
+			expInsuranceFund: sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 10)),
+		},
+		{
+			name: "slash is covered properly",
+			store: func(ctx sdk.Context) {
+				suite.fundAccountInsuranceFund(ctx,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 10)),
+				)
+			},
+			slashedAmount: sdk.NewCoins(
+				sdk.NewInt64Coin(LockedIBCDenom, 100),
+				sdk.NewInt64Coin("stake", 100),
+			),
+			shouldErr: false,
+			expUsages: []types.InsuranceFundUsage{
+				types.NewInsuranceFundUsage(
+					1,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					1,
+					2,
+					10,
+					sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)),
+				),
+			},
+			expInsuranceFund: sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 8)),
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeCoverSlash,
+					sdk.NewAttribute(types.AttributeKeyInsuranceFundUsageID, "1"),
+					sdk.NewAttribute(types.AttributeKeyUser, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "2"),
+					sdk.NewAttribute(types.AttributeKeySlashedAmount, "100"+LockedIBCDenom),
+					sdk.NewAttribute(types.AttributeKeyCoveredAmount, "2"+IBCDenom),
+				),
+			},
+		},
+		{
+			name: "covered amount is limited to the insurance fund balance",
+			store: func(ctx sdk.Context) {
+				suite.fundAccountInsuranceFund(ctx,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+				)
+
+				err := suite.k.SetNextInsuranceFundUsageID(ctx, 3)
+				suite.Require().NoError(err)
+			},
+			slashedAmount: sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+			shouldErr:     false,
+			expUsages: []types.InsuranceFundUsage{
+				types.NewInsuranceFundUsage(
+					3,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					1,
+					2,
+					10,
+					sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+				),
+			},
+			expInsuranceFund: sdk.NewCoins(),
+		},
+		{
+			name:          "slash without insurance fund is recorded with no covered amount",
+			slashedAmount: sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+			shouldErr:     false,
+			expUsages: []types.InsuranceFundUsage{
+				types.NewInsuranceFundUsage(
+					1,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					1,
+					2,
+					10,
+					sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+					nil,
+				),
+			},
+			expInsuranceFund: sdk.NewCoins(),
+		},
+		{
+			name: "covered amount is sent to the slashed funds recipient",
+			store: func(ctx sdk.Context) {
+				params := restakingtypes.DefaultParams()
+				params.SlashedFundsRecipient = "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+				err := suite.rk.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				suite.fundAccountInsuranceFund(ctx,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 10)),
+				)
+			},
+			slashedAmount: sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+			shouldErr:     false,
+			expUsages: []types.InsuranceFundUsage{
+				types.NewInsuranceFundUsage(
+					1,
+					"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+					1,
+					2,
+					10,
+					sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 100)),
+					sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)),
+				),
+			},
+			expInsuranceFund: sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 8)),
+			check: func(ctx sdk.Context) {
+				balance := suite.bk.GetAllBalances(ctx, sdk.MustAccAddressFromBech32("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"))
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 2)), balance)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(10)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.k.SlashCoverFn(ctx, 1, 2, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre", tc.slashedAmount)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				usages, err := suite.k.GetUserInsuranceFundUsages(ctx, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre")
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expUsages, usages)
+
+				insuranceFund, err := suite.k.GetUserInsuranceFundBalance(ctx, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre")
+				suite.Require().NoError(err)
+				suite.Require().True(tc.expInsuranceFund.Equal(insuranceFund))
+
+				// Make sure the covered amount has left the module account
+				moduleBalance, err := suite.k.GetInsuranceFundBalance(ctx)
+				suite.Require().NoError(err)
+				suite.Require().True(tc.expInsuranceFund.Equal(moduleBalance))
+
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SlashOperatorCoversLockedRepresentations() {
+	suite.SetupTest()
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(10)
+
+	suite.createOperator(ctx, 1)
+	suite.createService(ctx, 1)
+
+	err := suite.rk.AddServiceToOperatorJoinedServices(ctx, 1, 1)
+	suite.Require().NoError(err)
+
+	// Fund the user's insurance fund and mint the locked representations
+	suite.fundAccountInsuranceFund(ctx,
+		"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+		sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 6)),
+	)
+	suite.mintLockedRepresentation(ctx,
+		"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+		sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 300)),
+	)
+
+	// Delegate the locked representations to the operator
+	msgSrv := restakingkeeper.NewMsgServer(suite.rk)
+	_, err = msgSrv.DelegateOperator(ctx, restakingtypes.NewMsgDelegateOperator(
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 300)),
+		"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+	))
+	suite.Require().NoError(err)
+
+	// Slash the operator
+	_, err = suite.rk.SlashOperator(ctx, 1, 1, utils.MustParseDec("0.1"), 10)
+	suite.Require().NoError(err)
+
+	// Make sure the insurance fund has been used to cover the slash
+	usages, err := suite.k.GetUserInsuranceFundUsages(ctx, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InsuranceFundUsage{
+		types.NewInsuranceFundUsage(
+			1,
+			"cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre",
+			1,
+			1,
+			10,
+			sdk.NewCoins(sdk.NewInt64Coin(LockedIBCDenom, 30)),
+			sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 1)),
+		),
+	}, usages)
+
+	insuranceFund, err := suite.k.GetUserInsuranceFundBalance(ctx, "cosmos1pgzph9rze2j2xxavx4n7pdhxlkgsq7raqh8hre")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(IBCDenom, 5)), insuranceFund)
+}
@@ -52,6 +52,7 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 			types.StoreKey,
 			operatorstypes.StoreKey, poolstypes.StoreKey, servicestypes.StoreKey,
 			restakingtypes.StoreKey, stakingtypes.StoreKey,
+			marketmaptypes.StoreKey, oracletypes.StoreKey, assetstypes.StoreKey,
 		}),
 	}
 
@@ -92,6 +93,8 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 	assetsKeeper := assetskeeper.NewKeeper(
 		data.Cdc,
 		runtime.NewKVStoreService(data.Keys[assetstypes.StoreKey]),
+		data.AccountKeeper,
+		&oracleKeeper,
 		data.AuthorityAddress,
 	)
 	data.RestakingKeeper = restakingkeeper.NewKeeper(
@@ -102,7 +105,6 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 		data.PoolsKeeper,
 		data.OperatorsKeeper,
 		data.ServicesKeeper,
-		&oracleKeeper,
 		assetsKeeper,
 		data.AuthorityAddress,
 	)
@@ -122,6 +124,7 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 	// Set bank hooks
 	data.BankKeeper.AppendSendRestriction(data.Keeper.SendRestrictionFn)
 	data.RestakingKeeper.SetRestakeRestriction(data.Keeper.RestakeRestrictionFn)
+	data.RestakingKeeper.SetSlashCover(data.Keeper.SlashCoverFn)
 
 	// Set ibc hooks
 	var ibcStack porttypes.IBCModule = mockIBCMiddleware{}
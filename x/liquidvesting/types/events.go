@@ -5,7 +5,11 @@ const (
 	EventTypeMintLockedRepresentation   = "mint_locked_representation"
 	EventTypeBurnLockedRepresentation   = "burn_locked_representation"
 	EventTypeWithdrawInsuranceFund      = "withdraw_insurance_fund"
+	EventTypeCoverSlash                 = "cover_slash"
 
-	AttributeKeyUser     = "user"
-	AttributeKeyReceiver = "receiver"
+	AttributeKeyUser                 = "user"
+	AttributeKeyReceiver             = "receiver"
+	AttributeKeyInsuranceFundUsageID = "insurance_fund_usage_id"
+	AttributeKeySlashedAmount        = "slashed_amount"
+	AttributeKeyCoveredAmount        = "covered_amount"
 )
@@ -56,4 +56,5 @@ type RestakingKeeper interface {
 	GetAllUnbondingDelegations(ctx context.Context) ([]restakingtypes.UnbondingDelegation, error)
 	GetAllUserRestakedCoins(ctx context.Context, userAddress string) (sdk.DecCoins, error)
 	GetAllUserUnbondingDelegations(ctx context.Context, userAddress string) []restakingtypes.UnbondingDelegation
+	TransferSlashedFunds(ctx context.Context, from string, amount sdk.Coins) error
 }
@@ -1,17 +1,27 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState instance.
-func NewGenesisState(params Params, burnCoins []BurnCoins, usersInsuranceFunds []UserInsuranceFundEntry) *GenesisState {
+func NewGenesisState(
+	params Params,
+	burnCoins []BurnCoins,
+	usersInsuranceFunds []UserInsuranceFundEntry,
+	insuranceFundUsages []InsuranceFundUsage,
+	nextInsuranceFundUsageID uint64,
+) *GenesisState {
 	return &GenesisState{
-		Params:             params,
-		BurnCoins:          burnCoins,
-		UserInsuranceFunds: usersInsuranceFunds,
+		Params:                   params,
+		BurnCoins:                burnCoins,
+		UserInsuranceFunds:       usersInsuranceFunds,
+		InsuranceFundUsages:      insuranceFundUsages,
+		NextInsuranceFundUsageID: nextInsuranceFundUsageID,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), nil, nil)
+	return NewGenesisState(DefaultParams(), nil, nil, nil, 1)
 }
 
 func (g *GenesisState) Validate() error {
@@ -31,5 +41,22 @@ func (g *GenesisState) Validate() error {
 		}
 	}
 
+	usagesIDs := make(map[uint64]bool, len(g.InsuranceFundUsages))
+	for _, usage := range g.InsuranceFundUsages {
+		if usagesIDs[usage.ID] {
+			return fmt.Errorf("duplicated insurance fund usage: %d", usage.ID)
+		}
+		usagesIDs[usage.ID] = true
+
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("invalid insurance fund usage %d: %w", usage.ID, err)
+		}
+
+		if usage.ID >= g.NextInsuranceFundUsageID {
+			return fmt.Errorf("insurance fund usage id %d is not lower than the next insurance fund usage id %d",
+				usage.ID, g.NextInsuranceFundUsageID)
+		}
+	}
+
 	return nil
 }
@@ -32,6 +32,12 @@ type GenesisState struct {
 	BurnCoins []BurnCoins `protobuf:"bytes,2,rep,name=burn_coins,json=burnCoins,proto3" json:"burn_coins"`
 	// UserInsuranceFunds represents the users' insurance fund.
 	UserInsuranceFunds []UserInsuranceFundEntry `protobuf:"bytes,3,rep,name=user_insurance_funds,json=userInsuranceFunds,proto3" json:"user_insurance_funds"`
+	// InsuranceFundUsages represents the usages of the users' insurance fund to
+	// cover the slashed locked representations.
+	InsuranceFundUsages []InsuranceFundUsage `protobuf:"bytes,4,rep,name=insurance_fund_usages,json=insuranceFundUsages,proto3" json:"insurance_fund_usages"`
+	// NextInsuranceFundUsageID represents the ID that will be assigned to the
+	// next insurance fund usage.
+	NextInsuranceFundUsageID uint64 `protobuf:"varint,5,opt,name=next_insurance_fund_usage_id,json=nextInsuranceFundUsageId,proto3" json:"next_insurance_fund_usage_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +94,20 @@ func (m *GenesisState) GetUserInsuranceFunds() []UserInsuranceFundEntry {
 	return nil
 }
 
+func (m *GenesisState) GetInsuranceFundUsages() []InsuranceFundUsage {
+	if m != nil {
+		return m.InsuranceFundUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextInsuranceFundUsageID() uint64 {
+	if m != nil {
+		return m.NextInsuranceFundUsageID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "milkyway.liquidvesting.v1.GenesisState")
 }
@@ -97,27 +117,31 @@ func init() {
 }
 
 var fileDescriptor_ec377133c71b10fb = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0x93, 0xf6, 0x53, 0xa5, 0xcf, 0x65, 0x8a, 0x3a, 0x94, 0x0e, 0xa6, 0x20, 0x04, 0x5d,
-	0xb0, 0x95, 0x32, 0x32, 0x20, 0x15, 0x01, 0xea, 0x86, 0x40, 0x5d, 0x58, 0x2a, 0xa7, 0x31, 0xc1,
-	0x22, 0xb1, 0x8b, 0x8f, 0x1d, 0xc8, 0x05, 0xb0, 0x73, 0x59, 0x1d, 0x3b, 0x32, 0x21, 0xd4, 0xde,
-	0x08, 0x6a, 0x7e, 0x90, 0xa8, 0xd4, 0xb2, 0xf9, 0xe7, 0x79, 0x9f, 0x73, 0xf4, 0xa2, 0xe3, 0x44,
-	0xc4, 0x4f, 0xd9, 0x0b, 0xcb, 0x68, 0x2c, 0x9e, 0xad, 0x08, 0x53, 0x0e, 0x46, 0xc8, 0x88, 0xa6,
-	0x3e, 0x8d, 0xb8, 0xe4, 0x20, 0x80, 0x4c, 0xb5, 0x32, 0xca, 0xdb, 0xad, 0x40, 0xf2, 0x0b, 0x24,
-	0xa9, 0xdf, 0x69, 0x45, 0x2a, 0x52, 0x39, 0x45, 0x57, 0xa7, 0x22, 0xd0, 0x39, 0xda, 0x6c, 0x4e,
-	0x54, 0xc8, 0x63, 0xf8, 0x9b, 0x9b, 0x32, 0xcd, 0x92, 0x92, 0x3b, 0x78, 0xab, 0xa1, 0x9d, 0xeb,
-	0x62, 0xa5, 0x3b, 0xc3, 0x0c, 0xf7, 0xce, 0x51, 0xa3, 0x00, 0xda, 0x6e, 0xd7, 0xed, 0x35, 0xfb,
-	0xfb, 0x64, 0xe3, 0x8a, 0xe4, 0x26, 0x07, 0x07, 0xff, 0x66, 0x9f, 0x7b, 0xce, 0x6d, 0x19, 0xf3,
-	0x86, 0x08, 0x05, 0x56, 0xcb, 0xf1, 0x44, 0x09, 0x09, 0xed, 0x5a, 0xb7, 0xde, 0x6b, 0xf6, 0x0f,
-	0xb7, 0x48, 0x06, 0x56, 0xcb, 0x8b, 0x15, 0x5b, 0x7a, 0xfe, 0x07, 0xd5, 0x83, 0x27, 0x50, 0xcb,
-	0x02, 0xd7, 0x63, 0x21, 0xc1, 0x6a, 0x26, 0x27, 0x7c, 0xfc, 0x60, 0x65, 0x08, 0xed, 0x7a, 0x2e,
-	0xf5, 0xb7, 0x48, 0x47, 0xc0, 0xf5, 0xb0, 0x4a, 0x5d, 0x59, 0x19, 0x5e, 0x4a, 0xa3, 0xb3, 0x72,
-	0x82, 0x67, 0xd7, 0x7f, 0x61, 0x30, 0x9a, 0x2d, 0xb0, 0x3b, 0x5f, 0x60, 0xf7, 0x6b, 0x81, 0xdd,
-	0xf7, 0x25, 0x76, 0xe6, 0x4b, 0xec, 0x7c, 0x2c, 0xb1, 0x73, 0x7f, 0x16, 0x09, 0xf3, 0x68, 0x03,
-	0x32, 0x51, 0x09, 0xad, 0x06, 0x9e, 0xc4, 0x2c, 0x80, 0x9f, 0x1b, 0x4d, 0xfd, 0x3e, 0x7d, 0x5d,
-	0x2b, 0xda, 0x64, 0x53, 0x0e, 0x41, 0x23, 0x6f, 0xf9, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe3,
-	0x3d, 0x1f, 0xdd, 0x11, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x6b, 0xa3, 0x50,
+	0x14, 0x86, 0x75, 0x92, 0x09, 0xcc, 0xcd, 0xac, 0x9c, 0x0c, 0x38, 0x21, 0x98, 0xcc, 0x30, 0xb4,
+	0xd9, 0x44, 0x31, 0x5d, 0x76, 0x51, 0xb0, 0x5f, 0x64, 0x53, 0x4a, 0x4a, 0x36, 0xa5, 0x20, 0x1a,
+	0x6f, 0xed, 0xa5, 0x7a, 0x4d, 0xef, 0xb9, 0xd7, 0xc6, 0x7f, 0xd1, 0x9f, 0x95, 0x45, 0x17, 0x59,
+	0x76, 0x15, 0x8a, 0xf9, 0x23, 0x25, 0x46, 0x0b, 0xf9, 0xec, 0x4e, 0x3d, 0xcf, 0xfb, 0xbc, 0x47,
+	0x38, 0xe8, 0x30, 0x24, 0xc1, 0x63, 0xf2, 0xec, 0x24, 0x46, 0x40, 0x9e, 0x04, 0xf1, 0x62, 0x0c,
+	0x9c, 0x50, 0xdf, 0x88, 0x4d, 0xc3, 0xc7, 0x14, 0x03, 0x01, 0x7d, 0xc4, 0x22, 0x1e, 0x29, 0x7f,
+	0x0a, 0x50, 0x5f, 0x01, 0xf5, 0xd8, 0xac, 0xd7, 0xfc, 0xc8, 0x8f, 0x32, 0xca, 0x58, 0x3c, 0x2d,
+	0x03, 0xf5, 0x83, 0xdd, 0xe6, 0x30, 0xf2, 0x70, 0x00, 0x5f, 0x73, 0x23, 0x87, 0x39, 0x61, 0xce,
+	0xfd, 0x7b, 0x2d, 0xa1, 0x9f, 0x97, 0xcb, 0x95, 0x6e, 0xb8, 0xc3, 0xb1, 0x72, 0x82, 0x2a, 0x4b,
+	0x40, 0x95, 0x5b, 0x72, 0xbb, 0xda, 0xfd, 0xab, 0xef, 0x5c, 0x51, 0xbf, 0xce, 0x40, 0xab, 0x3c,
+	0x99, 0x35, 0xa5, 0x7e, 0x1e, 0x53, 0x7a, 0x08, 0xb9, 0x82, 0x51, 0x7b, 0x18, 0x11, 0x0a, 0xea,
+	0xb7, 0x56, 0xa9, 0x5d, 0xed, 0xfe, 0xdf, 0x23, 0xb1, 0x04, 0xa3, 0xa7, 0x0b, 0x36, 0xf7, 0xfc,
+	0x70, 0x8b, 0x0f, 0x0a, 0x41, 0x35, 0x01, 0x98, 0xd9, 0x84, 0x82, 0x60, 0x0e, 0x1d, 0x62, 0xfb,
+	0x5e, 0x50, 0x0f, 0xd4, 0x52, 0x26, 0x35, 0xf7, 0x48, 0x07, 0x80, 0x59, 0xaf, 0x48, 0x5d, 0x08,
+	0xea, 0x9d, 0x53, 0xce, 0x92, 0xbc, 0x41, 0x11, 0xeb, 0x53, 0x50, 0x7c, 0xf4, 0x7b, 0xb5, 0xc5,
+	0x16, 0xe0, 0xf8, 0x18, 0xd4, 0x72, 0xd6, 0xd5, 0xd9, 0xd3, 0xb5, 0x62, 0x1a, 0x2c, 0x52, 0x79,
+	0xcf, 0x2f, 0xb2, 0x31, 0x01, 0xe5, 0x0e, 0x35, 0x28, 0x1e, 0x73, 0x7b, 0x5b, 0x9b, 0x4d, 0x3c,
+	0xf5, 0x7b, 0x4b, 0x6e, 0x97, 0xad, 0x46, 0x3a, 0x6b, 0xaa, 0x57, 0x78, 0xcc, 0x37, 0xe5, 0xbd,
+	0xb3, 0xbe, 0x4a, 0xb7, 0x4f, 0x3c, 0x6b, 0x30, 0x49, 0x35, 0x79, 0x9a, 0x6a, 0xf2, 0x7b, 0xaa,
+	0xc9, 0x2f, 0x73, 0x4d, 0x9a, 0xce, 0x35, 0xe9, 0x6d, 0xae, 0x49, 0xb7, 0xc7, 0x3e, 0xe1, 0x0f,
+	0xc2, 0xd5, 0x87, 0x51, 0x68, 0x14, 0xff, 0xd2, 0x09, 0x1c, 0x17, 0x3e, 0xdf, 0x8c, 0xd8, 0xec,
+	0x1a, 0xe3, 0xb5, 0x7b, 0xe1, 0xc9, 0x08, 0x83, 0x5b, 0xc9, 0x8e, 0xe5, 0xe8, 0x63, 0x00, 0xb3,
+	0xab, 0xd2, 0x8e, 0xd8, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -140,6 +164,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextInsuranceFundUsageID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextInsuranceFundUsageID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InsuranceFundUsages) > 0 {
+		for iNdEx := len(m.InsuranceFundUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFundUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.UserInsuranceFunds) > 0 {
 		for iNdEx := len(m.UserInsuranceFunds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -212,6 +255,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InsuranceFundUsages) > 0 {
+		for _, e := range m.InsuranceFundUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextInsuranceFundUsageID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextInsuranceFundUsageID))
+	}
 	return n
 }
 
@@ -351,6 +403,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundUsages = append(m.InsuranceFundUsages, InsuranceFundUsage{})
+			if err := m.InsuranceFundUsages[len(m.InsuranceFundUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextInsuranceFundUsageID", wireType)
+			}
+			m.NextInsuranceFundUsageID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextInsuranceFundUsageID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -15,8 +15,10 @@ const (
 var (
 	ParamsKey = collections.NewPrefix(0x01)
 
-	InsuranceFundKey  = collections.NewPrefix(0x10)
-	BurnCoinsQueueKey = collections.NewPrefix(0x20)
+	InsuranceFundKey            = collections.NewPrefix(0x10)
+	NextInsuranceFundUsageIDKey = collections.NewPrefix(0x11)
+	InsuranceFundUsagesKey      = collections.NewPrefix(0x12)
+	BurnCoinsQueueKey           = collections.NewPrefix(0x20)
 )
 
 // GetBurnCoinsQueueTimeKey creates the prefix to obtain the list of
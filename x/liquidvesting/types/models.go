@@ -80,3 +80,53 @@ func (uif *UserInsuranceFundEntry) Validate() error {
 
 	return uif.Balance.Validate()
 }
+
+// NewInsuranceFundUsage creates a new InsuranceFundUsage instance.
+func NewInsuranceFundUsage(
+	id uint64,
+	userAddress string,
+	serviceID uint32,
+	operatorID uint32,
+	height int64,
+	slashedAmount sdk.Coins,
+	coveredAmount sdk.Coins,
+) InsuranceFundUsage {
+	return InsuranceFundUsage{
+		ID:            id,
+		UserAddress:   userAddress,
+		ServiceID:     serviceID,
+		OperatorID:    operatorID,
+		Height:        height,
+		SlashedAmount: slashedAmount,
+		CoveredAmount: coveredAmount,
+	}
+}
+
+// Validate checks that the InsuranceFundUsage has valid values.
+func (u *InsuranceFundUsage) Validate() error {
+	if u.ID == 0 {
+		return fmt.Errorf("invalid id: %d", u.ID)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(u.UserAddress); err != nil {
+		return err
+	}
+
+	if u.ServiceID == 0 {
+		return fmt.Errorf("invalid service id: %d", u.ServiceID)
+	}
+
+	if u.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id: %d", u.OperatorID)
+	}
+
+	if u.Height < 0 {
+		return fmt.Errorf("invalid height: %d", u.Height)
+	}
+
+	if err := u.SlashedAmount.Validate(); err != nil {
+		return err
+	}
+
+	return u.CoveredAmount.Validate()
+}
@@ -222,11 +222,114 @@ func (m *UserInsuranceFundEntry) GetBalance() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// InsuranceFundUsage represents the usage of a user's insurance fund to cover
+// the locked representations that have been slashed from the user.
+type InsuranceFundUsage struct {
+	// ID is the unique identifier of the insurance fund usage.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of who owns the insurance fund.
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// ID of the service that requested the slash.
+	ServiceID uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// ID of the operator that has been slashed.
+	OperatorID uint32 `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Height at which the slash has been performed.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Amount of locked representations that have been slashed from the user.
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=slashed_amount,json=slashedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed_amount"`
+	// Amount of coins that have been taken from the user's insurance fund to
+	// cover the slash.
+	CoveredAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=covered_amount,json=coveredAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"covered_amount"`
+}
+
+func (m *InsuranceFundUsage) Reset()         { *m = InsuranceFundUsage{} }
+func (m *InsuranceFundUsage) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundUsage) ProtoMessage()    {}
+func (*InsuranceFundUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2106835df08f3d44, []int{4}
+}
+func (m *InsuranceFundUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundUsage.Merge(m, src)
+}
+func (m *InsuranceFundUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundUsage proto.InternalMessageInfo
+
+func (m *InsuranceFundUsage) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *InsuranceFundUsage) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
+	}
+	return ""
+}
+
+func (m *InsuranceFundUsage) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
+}
+
+func (m *InsuranceFundUsage) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
+	}
+	return 0
+}
+
+func (m *InsuranceFundUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InsuranceFundUsage) GetSlashedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return nil
+}
+
+func (m *InsuranceFundUsage) GetCoveredAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoveredAmount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserInsuranceFund)(nil), "milkyway.liquidvesting.v1.UserInsuranceFund")
 	proto.RegisterType((*BurnCoins)(nil), "milkyway.liquidvesting.v1.BurnCoins")
 	proto.RegisterType((*BurnCoinsList)(nil), "milkyway.liquidvesting.v1.BurnCoinsList")
 	proto.RegisterType((*UserInsuranceFundEntry)(nil), "milkyway.liquidvesting.v1.UserInsuranceFundEntry")
+	proto.RegisterType((*InsuranceFundUsage)(nil), "milkyway.liquidvesting.v1.InsuranceFundUsage")
 }
 
 func init() {
@@ -234,39 +337,48 @@ func init() {
 }
 
 var fileDescriptor_2106835df08f3d44 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xa5, 0xa8, 0x90, 0x0b, 0x05, 0x62, 0x55, 0xc8, 0xc9, 0x60, 0x47, 0x11, 0x42, 0x11,
-	0x52, 0xee, 0x70, 0xd8, 0xe8, 0x84, 0xab, 0x22, 0x21, 0x31, 0x85, 0x76, 0x61, 0x89, 0xce, 0xf6,
-	0x61, 0x4e, 0xf5, 0xdd, 0x05, 0xdf, 0xd9, 0x10, 0x7e, 0x01, 0x63, 0x17, 0xf6, 0x8e, 0x88, 0x89,
-	0x81, 0x91, 0x1f, 0xd0, 0xb1, 0x62, 0x62, 0xa2, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdb, 0xe7, 0x50,
-	0x40, 0x48, 0x0c, 0xb0, 0xd8, 0xfe, 0xde, 0xfb, 0xde, 0xbb, 0xef, 0x7d, 0xf7, 0x0c, 0x6f, 0x72,
-	0x96, 0x1e, 0x2e, 0x9e, 0x93, 0x05, 0x4e, 0xd9, 0xb3, 0x9c, 0xc5, 0x05, 0x55, 0x9a, 0x89, 0x04,
-	0x17, 0x3e, 0xe6, 0x32, 0xa6, 0xa9, 0x42, 0xf3, 0x4c, 0x6a, 0x69, 0xf7, 0x1a, 0x1e, 0xfa, 0x89,
-	0x87, 0x0a, 0xbf, 0xdf, 0x25, 0x9c, 0x09, 0x89, 0xab, 0x67, 0xcd, 0xee, 0xbb, 0x91, 0x54, 0x5c,
-	0x2a, 0x1c, 0x12, 0x45, 0x71, 0xe1, 0x87, 0x54, 0x13, 0x1f, 0x47, 0x92, 0x09, 0x93, 0xef, 0xd5,
-	0xf9, 0x59, 0x85, 0x70, 0x0d, 0x4c, 0x6a, 0x3b, 0x91, 0x89, 0xac, 0xe3, 0xe5, 0x97, 0x89, 0x7a,
-	0x89, 0x94, 0x49, 0x4a, 0x71, 0x85, 0xc2, 0xfc, 0x09, 0xd6, 0x8c, 0x53, 0xa5, 0x09, 0x9f, 0xd7,
-	0x84, 0xe1, 0x4b, 0xd8, 0x3d, 0x50, 0x34, 0x7b, 0x20, 0x54, 0x9e, 0x11, 0x11, 0xd1, 0xfb, 0xb9,
-	0x88, 0x6d, 0x0a, 0x2f, 0x86, 0x24, 0x2d, 0xa1, 0x03, 0x06, 0x1b, 0xa3, 0xce, 0xa4, 0x87, 0xcc,
-	0x59, 0xa5, 0x30, 0x64, 0x84, 0xa1, 0x5d, 0xc9, 0x44, 0x70, 0xfb, 0xe4, 0xb3, 0x67, 0xbd, 0x3d,
-	0xf3, 0x46, 0x09, 0xd3, 0x4f, 0xf3, 0x10, 0x45, 0x92, 0x1b, 0x61, 0xe6, 0x35, 0x56, 0xf1, 0x21,
-	0xd6, 0x8b, 0x39, 0x55, 0x55, 0x81, 0x9a, 0x36, 0xbd, 0x87, 0xaf, 0x5b, 0xb0, 0x1d, 0xe4, 0x99,
-	0xa8, 0xc2, 0xf6, 0x1e, 0xec, 0xc6, 0x34, 0xa5, 0x09, 0xd1, 0x32, 0x9b, 0x91, 0x38, 0xce, 0xa8,
-	0x52, 0x0e, 0x18, 0x80, 0x51, 0x3b, 0x70, 0x3e, 0xbe, 0x1f, 0x6f, 0x1b, 0x05, 0xf7, 0xea, 0xcc,
-	0x23, 0x9d, 0x31, 0x91, 0x4c, 0xaf, 0xad, 0x4b, 0x4c, 0xdc, 0x9e, 0xc2, 0xab, 0x91, 0xe4, 0xf3,
-	0x94, 0x6a, 0x26, 0xc5, 0xac, 0x1c, 0xd7, 0x69, 0x0d, 0xc0, 0xa8, 0x33, 0xe9, 0xa3, 0xda, 0x0b,
-	0xd4, 0x78, 0x81, 0xf6, 0x1b, 0x2f, 0x82, 0xad, 0x72, 0x88, 0xa3, 0x33, 0x0f, 0xbc, 0xf9, 0xfa,
-	0xee, 0x16, 0x98, 0x5e, 0xf9, 0xd1, 0xa1, 0xe4, 0xd8, 0x11, 0xdc, 0x24, 0x5c, 0xe6, 0x42, 0x3b,
-	0x1b, 0xff, 0xde, 0x0e, 0xd3, 0xfa, 0xee, 0xa5, 0x57, 0xc7, 0x9e, 0xf5, 0xed, 0xd8, 0xb3, 0x86,
-	0xfb, 0x70, 0x6b, 0x6d, 0xcb, 0x43, 0xa6, 0xb4, 0xbd, 0x0b, 0x2f, 0xc4, 0x44, 0x13, 0x73, 0x19,
-	0x37, 0xd0, 0x1f, 0x77, 0x0a, 0xad, 0xeb, 0x82, 0x76, 0x29, 0xa4, 0x1e, 0xa7, 0x2a, 0x1e, 0x7e,
-	0x00, 0xf0, 0xfa, 0x6f, 0x57, 0xbd, 0x27, 0x74, 0xb6, 0xb0, 0x77, 0xe0, 0xe5, 0x5c, 0xd1, 0xbf,
-	0x77, 0xbd, 0x53, 0xb2, 0x1b, 0xc3, 0xcf, 0x2d, 0x4b, 0xeb, 0xff, 0x2d, 0x4b, 0x70, 0x70, 0xb2,
-	0x74, 0xc1, 0xe9, 0xd2, 0x05, 0x5f, 0x96, 0x2e, 0x38, 0x5a, 0xb9, 0xd6, 0xe9, 0xca, 0xb5, 0x3e,
-	0xad, 0x5c, 0xeb, 0xf1, 0xce, 0xb9, 0x66, 0x8d, 0x33, 0xe3, 0x94, 0x84, 0x6a, 0x8d, 0x70, 0xe1,
-	0x4f, 0xf0, 0x8b, 0x5f, 0xfe, 0xd4, 0xea, 0x94, 0x70, 0xb3, 0xda, 0x86, 0x3b, 0xdf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x8a, 0xdd, 0x12, 0x1a, 0xd0, 0x03, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x9d, 0x92, 0x92, 0x0b, 0x29, 0xd4, 0xaa, 0x2a, 0xb7, 0x83, 0x1d, 0x45, 0x08, 0x45,
+	0x88, 0xfa, 0x48, 0xd9, 0xe8, 0x54, 0xb7, 0x45, 0x8a, 0x84, 0x84, 0xe4, 0xb6, 0x0b, 0x4b, 0x74,
+	0xf6, 0x1d, 0xce, 0xa9, 0xb6, 0x2f, 0xf8, 0xce, 0x86, 0xf0, 0x0b, 0x18, 0xbb, 0xb0, 0x77, 0x44,
+	0x4c, 0x0c, 0x8c, 0xfc, 0x80, 0x8e, 0x15, 0x13, 0x53, 0x8a, 0xdc, 0x01, 0x7e, 0x06, 0xb2, 0x7d,
+	0x4e, 0x5b, 0x2a, 0x24, 0x90, 0xda, 0x25, 0xc9, 0x7b, 0xef, 0x7b, 0xef, 0xfb, 0xde, 0xe7, 0x17,
+	0x83, 0x07, 0x21, 0x0d, 0x0e, 0x26, 0x6f, 0xd0, 0x04, 0x06, 0xf4, 0x75, 0x42, 0x71, 0x4a, 0xb8,
+	0xa0, 0x91, 0x0f, 0xd3, 0x3e, 0x0c, 0x19, 0x26, 0x01, 0xb7, 0xc6, 0x31, 0x13, 0x4c, 0x5b, 0xa9,
+	0x70, 0xd6, 0x25, 0x9c, 0x95, 0xf6, 0x57, 0x17, 0x51, 0x48, 0x23, 0x06, 0x8b, 0xcf, 0x12, 0xbd,
+	0x6a, 0x78, 0x8c, 0x87, 0x8c, 0x43, 0x17, 0x71, 0x02, 0xd3, 0xbe, 0x4b, 0x04, 0xea, 0x43, 0x8f,
+	0xd1, 0x48, 0xd6, 0x57, 0xca, 0xfa, 0xb0, 0x88, 0x60, 0x19, 0xc8, 0xd2, 0x92, 0xcf, 0x7c, 0x56,
+	0xe6, 0xf3, 0x5f, 0x32, 0x6b, 0xfa, 0x8c, 0xf9, 0x01, 0x81, 0x45, 0xe4, 0x26, 0xaf, 0xa0, 0xa0,
+	0x21, 0xe1, 0x02, 0x85, 0xe3, 0x12, 0xd0, 0x7d, 0x07, 0x16, 0xf7, 0x39, 0x89, 0x07, 0x11, 0x4f,
+	0x62, 0x14, 0x79, 0xe4, 0x59, 0x12, 0x61, 0x8d, 0x80, 0x79, 0x17, 0x05, 0x79, 0xa8, 0x2b, 0x9d,
+	0x7a, 0xaf, 0xb5, 0xbe, 0x62, 0x49, 0xae, 0x5c, 0x98, 0x25, 0x85, 0x59, 0x5b, 0x8c, 0x46, 0xf6,
+	0xe3, 0xe3, 0xa9, 0x59, 0xfb, 0x74, 0x6a, 0xf6, 0x7c, 0x2a, 0x46, 0x89, 0x6b, 0x79, 0x2c, 0x94,
+	0xc2, 0xe4, 0xd7, 0x1a, 0xc7, 0x07, 0x50, 0x4c, 0xc6, 0x84, 0x17, 0x0d, 0xdc, 0xa9, 0x66, 0x77,
+	0x3f, 0xa8, 0xa0, 0x69, 0x27, 0x71, 0x54, 0xa4, 0xb5, 0x1d, 0xb0, 0x88, 0x49, 0x40, 0x7c, 0x24,
+	0x58, 0x3c, 0x44, 0x18, 0xc7, 0x84, 0x73, 0x5d, 0xe9, 0x28, 0xbd, 0xa6, 0xad, 0x7f, 0xfb, 0xb2,
+	0xb6, 0x24, 0x15, 0x6c, 0x96, 0x95, 0x5d, 0x11, 0xd3, 0xc8, 0x77, 0xee, 0xcd, 0x5a, 0x64, 0x5e,
+	0x73, 0xc0, 0x5d, 0x8f, 0x85, 0xe3, 0x80, 0x08, 0xca, 0xa2, 0x61, 0xbe, 0xae, 0xae, 0x76, 0x94,
+	0x5e, 0x6b, 0x7d, 0xd5, 0x2a, 0xbd, 0xb0, 0x2a, 0x2f, 0xac, 0xbd, 0xca, 0x0b, 0xbb, 0x9d, 0x2f,
+	0x71, 0x78, 0x6a, 0x2a, 0x1f, 0x7f, 0x7e, 0x7e, 0xa8, 0x38, 0x0b, 0xe7, 0x13, 0x72, 0x8c, 0xe6,
+	0x81, 0x06, 0x0a, 0x59, 0x12, 0x09, 0xbd, 0x7e, 0xfd, 0x76, 0xc8, 0xd1, 0x4f, 0x6f, 0xbf, 0x3f,
+	0x32, 0x6b, 0xbf, 0x8e, 0xcc, 0x5a, 0x77, 0x0f, 0xb4, 0x67, 0xb6, 0x3c, 0xa7, 0x5c, 0x68, 0x5b,
+	0x60, 0x0e, 0x23, 0x81, 0xe4, 0xc3, 0xb8, 0x6f, 0xfd, 0xf5, 0xa6, 0xac, 0x59, 0x9f, 0xdd, 0xcc,
+	0x85, 0x94, 0xeb, 0x14, 0xcd, 0xdd, 0xaf, 0x0a, 0x58, 0xbe, 0xf2, 0xa8, 0x77, 0x22, 0x11, 0x4f,
+	0xb4, 0x0d, 0x70, 0x27, 0xe1, 0xe4, 0xdf, 0x5d, 0x6f, 0xe5, 0xe8, 0xca, 0xf0, 0x0b, 0xc7, 0xa2,
+	0xde, 0xe0, 0xb1, 0x1c, 0xd7, 0x81, 0x76, 0x49, 0xfa, 0x3e, 0x47, 0x3e, 0xd1, 0x96, 0x81, 0x4a,
+	0x71, 0x21, 0x78, 0xce, 0x6e, 0x64, 0x53, 0x53, 0x1d, 0x6c, 0x3b, 0x2a, 0xc5, 0x57, 0x56, 0x52,
+	0xff, 0x67, 0xa5, 0x47, 0x00, 0x70, 0x12, 0xa7, 0xd4, 0x23, 0x43, 0x8a, 0xf5, 0x7a, 0x47, 0xe9,
+	0xb5, 0xed, 0x76, 0x36, 0x35, 0x9b, 0xbb, 0x65, 0x76, 0xb0, 0xed, 0x34, 0x25, 0x60, 0x80, 0x35,
+	0x08, 0x5a, 0x6c, 0x4c, 0xe2, 0xe2, 0x6e, 0x29, 0xd6, 0xe7, 0x0a, 0xf8, 0x42, 0x36, 0x35, 0xc1,
+	0x0b, 0x99, 0x1e, 0x6c, 0x3b, 0xa0, 0x82, 0x0c, 0xb0, 0xb6, 0x0c, 0x1a, 0x23, 0x42, 0xfd, 0x91,
+	0xd0, 0x6f, 0x75, 0x94, 0x5e, 0xdd, 0x91, 0x91, 0x16, 0x83, 0x05, 0x1e, 0x20, 0x3e, 0x22, 0x78,
+	0x28, 0xcf, 0xad, 0x71, 0xfd, 0x86, 0xb6, 0x25, 0xc5, 0x66, 0xc1, 0x90, 0x73, 0x7a, 0x2c, 0x25,
+	0xf1, 0x39, 0xe7, 0xfc, 0x0d, 0x70, 0x4a, 0x8a, 0x92, 0xd3, 0xde, 0x3f, 0xce, 0x0c, 0xe5, 0x24,
+	0x33, 0x94, 0x1f, 0x99, 0xa1, 0x1c, 0x9e, 0x19, 0xb5, 0x93, 0x33, 0xa3, 0xf6, 0xfd, 0xcc, 0xa8,
+	0xbd, 0xdc, 0xb8, 0x30, 0xb2, 0x3a, 0xf2, 0xb5, 0x00, 0xb9, 0x7c, 0x16, 0xc1, 0xb4, 0xbf, 0x0e,
+	0xdf, 0xfe, 0xf1, 0xd2, 0x2d, 0xb8, 0xdc, 0x46, 0xf1, 0xc7, 0x7e, 0xf2, 0x7b, 0x00, 0x36, 0x2d,
+	0xef, 0xf1, 0x9b, 0x05, 0x00, 0x00,
 }
 
 func (m *UserInsuranceFund) Marshal() (dAtA []byte, err error) {
@@ -439,6 +551,84 @@ func (m *UserInsuranceFundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *InsuranceFundUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoveredAmount) > 0 {
+		for iNdEx := len(m.CoveredAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoveredAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SlashedAmount) > 0 {
+		for iNdEx := len(m.SlashedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -520,6 +710,43 @@ func (m *UserInsuranceFundEntry) Size() (n int) {
 	return n
 }
 
+func (m *InsuranceFundUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovModels(uint64(m.Height))
+	}
+	if len(m.SlashedAmount) > 0 {
+		for _, e := range m.SlashedAmount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.CoveredAmount) > 0 {
+		for _, e := range m.CoveredAmount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovModels(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -959,6 +1186,232 @@ func (m *UserInsuranceFundEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InsuranceFundUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedAmount = append(m.SlashedAmount, types.Coin{})
+			if err := m.SlashedAmount[len(m.SlashedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoveredAmount = append(m.CoveredAmount, types.Coin{})
+			if err := m.CoveredAmount[len(m.CoveredAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModels(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -43,3 +43,18 @@ func NewQueryInsuranceFundRequest() *QueryInsuranceFundRequest {
 func NewQueryParamsRequest() *QueryParamsRequest {
 	return &QueryParamsRequest{}
 }
+
+// NewQueryInsuranceFundUsagesRequest creates a new QueryInsuranceFundUsagesRequest object.
+func NewQueryInsuranceFundUsagesRequest(pagination *query.PageRequest) *QueryInsuranceFundUsagesRequest {
+	return &QueryInsuranceFundUsagesRequest{
+		Pagination: pagination,
+	}
+}
+
+// NewQueryUserInsuranceFundUsagesRequest creates a new QueryUserInsuranceFundUsagesRequest object.
+func NewQueryUserInsuranceFundUsagesRequest(user string, pagination *query.PageRequest) *QueryUserInsuranceFundUsagesRequest {
+	return &QueryUserInsuranceFundUsagesRequest{
+		UserAddress: user,
+		Pagination:  pagination,
+	}
+}
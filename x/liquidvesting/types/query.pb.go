@@ -484,6 +484,219 @@ func (m *QueryInsuranceFundResponse) GetAmount() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+// QueryInsuranceFundUsagesRequest is the request type for the
+// Query/InsuranceFundUsages RPC method.
+type QueryInsuranceFundUsagesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInsuranceFundUsagesRequest) Reset()         { *m = QueryInsuranceFundUsagesRequest{} }
+func (m *QueryInsuranceFundUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundUsagesRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b32b8fa870c8abf, []int{9}
+}
+func (m *QueryInsuranceFundUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundUsagesRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundUsagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundUsagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundUsagesRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundUsagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInsuranceFundUsagesResponse is the response type for the
+// Query/InsuranceFundUsages RPC method.
+type QueryInsuranceFundUsagesResponse struct {
+	// usages is the list of insurance fund usages.
+	Usages []InsuranceFundUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInsuranceFundUsagesResponse) Reset()         { *m = QueryInsuranceFundUsagesResponse{} }
+func (m *QueryInsuranceFundUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundUsagesResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b32b8fa870c8abf, []int{10}
+}
+func (m *QueryInsuranceFundUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundUsagesResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundUsagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundUsagesResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundUsagesResponse) GetUsages() []InsuranceFundUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *QueryInsuranceFundUsagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUserInsuranceFundUsagesRequest is the request type for the
+// Query/UserInsuranceFundUsages RPC method.
+type QueryUserInsuranceFundUsagesRequest struct {
+	// user_address is the address of the user to query.
+	UserAddress string             `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserInsuranceFundUsagesRequest) Reset()         { *m = QueryUserInsuranceFundUsagesRequest{} }
+func (m *QueryUserInsuranceFundUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserInsuranceFundUsagesRequest) ProtoMessage()    {}
+func (*QueryUserInsuranceFundUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b32b8fa870c8abf, []int{11}
+}
+func (m *QueryUserInsuranceFundUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserInsuranceFundUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserInsuranceFundUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserInsuranceFundUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserInsuranceFundUsagesRequest.Merge(m, src)
+}
+func (m *QueryUserInsuranceFundUsagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserInsuranceFundUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserInsuranceFundUsagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserInsuranceFundUsagesRequest proto.InternalMessageInfo
+
+func (m *QueryUserInsuranceFundUsagesRequest) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
+	}
+	return ""
+}
+
+func (m *QueryUserInsuranceFundUsagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUserInsuranceFundUsagesResponse is the response type for the
+// Query/UserInsuranceFundUsages RPC method.
+type QueryUserInsuranceFundUsagesResponse struct {
+	// usages is the list of the user's insurance fund usages.
+	Usages []InsuranceFundUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) Reset()         { *m = QueryUserInsuranceFundUsagesResponse{} }
+func (m *QueryUserInsuranceFundUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserInsuranceFundUsagesResponse) ProtoMessage()    {}
+func (*QueryUserInsuranceFundUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b32b8fa870c8abf, []int{12}
+}
+func (m *QueryUserInsuranceFundUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserInsuranceFundUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserInsuranceFundUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserInsuranceFundUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserInsuranceFundUsagesResponse.Merge(m, src)
+}
+func (m *QueryUserInsuranceFundUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserInsuranceFundUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserInsuranceFundUsagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserInsuranceFundUsagesResponse proto.InternalMessageInfo
+
+func (m *QueryUserInsuranceFundUsagesResponse) GetUsages() []InsuranceFundUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -492,7 +705,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b32b8fa870c8abf, []int{9}
+	return fileDescriptor_0b32b8fa870c8abf, []int{13}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +743,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b32b8fa870c8abf, []int{10}
+	return fileDescriptor_0b32b8fa870c8abf, []int{14}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,6 +789,10 @@ func init() {
 	proto.RegisterType((*QueryUserRestakableAssetsResponse)(nil), "milkyway.liquidvesting.v1.QueryUserRestakableAssetsResponse")
 	proto.RegisterType((*QueryInsuranceFundRequest)(nil), "milkyway.liquidvesting.v1.QueryInsuranceFundRequest")
 	proto.RegisterType((*QueryInsuranceFundResponse)(nil), "milkyway.liquidvesting.v1.QueryInsuranceFundResponse")
+	proto.RegisterType((*QueryInsuranceFundUsagesRequest)(nil), "milkyway.liquidvesting.v1.QueryInsuranceFundUsagesRequest")
+	proto.RegisterType((*QueryInsuranceFundUsagesResponse)(nil), "milkyway.liquidvesting.v1.QueryInsuranceFundUsagesResponse")
+	proto.RegisterType((*QueryUserInsuranceFundUsagesRequest)(nil), "milkyway.liquidvesting.v1.QueryUserInsuranceFundUsagesRequest")
+	proto.RegisterType((*QueryUserInsuranceFundUsagesResponse)(nil), "milkyway.liquidvesting.v1.QueryUserInsuranceFundUsagesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.liquidvesting.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.liquidvesting.v1.QueryParamsResponse")
 }
@@ -585,56 +802,64 @@ func init() {
 }
 
 var fileDescriptor_0b32b8fa870c8abf = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xee, 0x14, 0x2c, 0x61, 0xf0, 0x23, 0x8c, 0x98, 0x40, 0xd5, 0x85, 0xd6, 0x88, 0x80, 0x61,
-	0x87, 0x56, 0x8d, 0x1f, 0x18, 0x85, 0xaa, 0xa8, 0x37, 0xdc, 0x04, 0x0f, 0x5e, 0x9a, 0xd9, 0xee,
-	0xb8, 0x6c, 0x68, 0x77, 0xca, 0xce, 0x6e, 0xb5, 0x31, 0x1e, 0xe4, 0xe4, 0xd1, 0xe8, 0x9f, 0x30,
-	0x5e, 0xf4, 0x47, 0x18, 0xc3, 0xb1, 0x89, 0x17, 0x13, 0x13, 0x35, 0x60, 0xa2, 0x3f, 0xc3, 0xec,
-	0xec, 0x2c, 0x74, 0xa1, 0xdb, 0x52, 0x22, 0x5c, 0xda, 0xcd, 0xbc, 0xef, 0xfb, 0xcc, 0xf3, 0xbc,
-	0x5f, 0xbb, 0xf0, 0x7c, 0xc5, 0x2a, 0xaf, 0xd4, 0x9f, 0x91, 0x3a, 0x2e, 0x5b, 0xab, 0x9e, 0x65,
-	0xd4, 0x28, 0x77, 0x2d, 0xdb, 0xc4, 0xb5, 0x1c, 0x5e, 0xf5, 0xa8, 0x53, 0x57, 0xab, 0x0e, 0x73,
-	0x19, 0x1a, 0x09, 0xdd, 0xd4, 0x88, 0x9b, 0x5a, 0xcb, 0xa5, 0x07, 0x49, 0xc5, 0xb2, 0x19, 0x16,
-	0xbf, 0x81, 0x77, 0x7a, 0xaa, 0xc4, 0x78, 0x85, 0x71, 0xac, 0x13, 0x4e, 0x03, 0x18, 0x5c, 0xcb,
-	0xe9, 0xd4, 0x25, 0x39, 0x5c, 0x25, 0xa6, 0x65, 0x13, 0xd7, 0x62, 0xb6, 0xf4, 0x55, 0x9a, 0x7d,
-	0x43, 0xaf, 0x12, 0xb3, 0x42, 0xfb, 0x90, 0xc9, 0x4c, 0x26, 0x1e, 0xb1, 0xff, 0x24, 0x4f, 0xcf,
-	0x98, 0x8c, 0x99, 0x65, 0x8a, 0x49, 0xd5, 0xc2, 0xc4, 0xb6, 0x99, 0x2b, 0x20, 0xb9, 0xb4, 0x8e,
-	0xc7, 0x8b, 0xaa, 0x12, 0x87, 0x54, 0xa4, 0x5f, 0xb6, 0x00, 0xcf, 0x3e, 0xf2, 0xd9, 0x2d, 0x71,
-	0xea, 0x3c, 0xb4, 0xb9, 0xe7, 0x10, 0xbb, 0x44, 0x17, 0x3c, 0xdb, 0xd0, 0xe8, 0xaa, 0x47, 0xb9,
-	0x8b, 0x32, 0xf0, 0xa8, 0xc7, 0xa9, 0x53, 0x24, 0x86, 0xe1, 0x50, 0xce, 0x87, 0xc1, 0x18, 0x98,
-	0xe8, 0xd7, 0x06, 0xfc, 0xb3, 0xf9, 0xe0, 0x28, 0xfb, 0x17, 0x40, 0x25, 0x0e, 0x84, 0x57, 0x99,
-	0xcd, 0x29, 0xa2, 0xb0, 0x4f, 0x27, 0x65, 0xff, 0x78, 0x18, 0x8c, 0xf5, 0x4c, 0x0c, 0xe4, 0x47,
-	0xd4, 0x40, 0xb4, 0xea, 0x8b, 0x56, 0xa5, 0x68, 0xf5, 0x0e, 0xb3, 0xec, 0xc2, 0xcc, 0xfa, 0x8f,
-	0xd1, 0xc4, 0x87, 0x9f, 0xa3, 0x13, 0xa6, 0xe5, 0x2e, 0x7b, 0xba, 0x5a, 0x62, 0x15, 0x2c, 0x33,
-	0x14, 0xfc, 0x4d, 0x73, 0x63, 0x05, 0xbb, 0xf5, 0x2a, 0xe5, 0x22, 0x80, 0x6b, 0x21, 0x36, 0x2a,
-	0xc2, 0x5e, 0x8f, 0x53, 0x63, 0x38, 0xf9, 0xff, 0xef, 0x10, 0xc0, 0xd9, 0xe5, 0x38, 0xa5, 0x3c,
-	0xcc, 0xd7, 0x02, 0x84, 0xdb, 0x05, 0x16, 0xd9, 0x1a, 0xc8, 0x8f, 0x47, 0x88, 0x04, 0x4d, 0x15,
-	0xd2, 0x59, 0x24, 0x26, 0x95, 0xb1, 0x5a, 0x53, 0x64, 0x76, 0x2d, 0x09, 0x4f, 0xed, 0xba, 0xe5,
-	0x2e, 0x71, 0xc9, 0x1e, 0x2a, 0xd2, 0x9c, 0xee, 0xe4, 0x21, 0xa4, 0xbb, 0xe7, 0xa0, 0xd2, 0xdd,
-	0x00, 0x70, 0x34, 0x36, 0xdf, 0xb2, 0xb5, 0x0c, 0x78, 0xc2, 0x0a, 0x2d, 0xc5, 0xa7, 0xbe, 0x49,
-	0xb6, 0xd8, 0x8c, 0x1a, 0x3b, 0xb1, 0x6a, 0xcb, 0xcc, 0x16, 0xfa, 0x7d, 0x9a, 0xef, 0xff, 0x7c,
-	0x9a, 0x02, 0xda, 0x71, 0x2b, 0x72, 0x1b, 0xba, 0x1f, 0x29, 0x6b, 0x52, 0x94, 0xf5, 0x42, 0xc7,
-	0xb2, 0x06, 0x14, 0x23, 0x75, 0xbd, 0x07, 0xc7, 0xb6, 0x14, 0x69, 0x94, 0xbb, 0x64, 0x85, 0xe8,
-	0x65, 0x3a, 0xcf, 0x39, 0x75, 0x79, 0x17, 0x33, 0xf7, 0x1a, 0xc0, 0x4c, 0x1b, 0x1c, 0x99, 0x9b,
-	0x12, 0x4c, 0x91, 0x0a, 0xf3, 0x6c, 0xf7, 0x20, 0xa6, 0x4e, 0x42, 0x67, 0x4f, 0xc3, 0x11, 0xc1,
-	0xa4, 0xd5, 0xfa, 0xc8, 0xbe, 0x02, 0x30, 0xdd, 0xca, 0x7a, 0x98, 0x04, 0x87, 0x20, 0x12, 0x14,
-	0x16, 0xc5, 0xe2, 0x0b, 0x99, 0x3d, 0x86, 0x27, 0x23, 0xa7, 0x92, 0xd1, 0x6d, 0x98, 0x0a, 0x16,
-	0xa4, 0x9c, 0xdd, 0x4c, 0x9b, 0x2e, 0x0a, 0x42, 0x0b, 0xbd, 0x3e, 0x33, 0x4d, 0x86, 0xe5, 0xbf,
-	0xf4, 0xc1, 0x23, 0x02, 0x18, 0x35, 0x00, 0x1c, 0xdc, 0xd5, 0x68, 0xe8, 0x5a, 0x1b, 0xc0, 0xb6,
-	0xab, 0x38, 0x7d, 0x7d, 0x1f, 0x91, 0x81, 0xaa, 0xec, 0xc2, 0xda, 0xd7, 0xdf, 0xef, 0x92, 0x73,
-	0xe8, 0x16, 0x8e, 0x7f, 0x2f, 0x88, 0x96, 0xdb, 0x31, 0x4a, 0xf8, 0x45, 0x73, 0x23, 0xbe, 0x44,
-	0x9f, 0x01, 0x44, 0xbb, 0x67, 0x11, 0x75, 0xcf, 0x2c, 0x2c, 0x43, 0xfa, 0xc6, 0x7e, 0x42, 0xa5,
-	0xaa, 0xab, 0x42, 0x55, 0x0e, 0xe1, 0x2e, 0x55, 0xa1, 0xef, 0x00, 0x0e, 0xb5, 0x1a, 0x1c, 0x34,
-	0xbb, 0x17, 0x36, 0x31, 0x63, 0x9b, 0xbe, 0xb9, 0xbf, 0x60, 0x29, 0xe6, 0x81, 0x10, 0x53, 0x40,
-	0x73, 0x9d, 0xc4, 0x38, 0x5b, 0x08, 0x45, 0x22, 0x20, 0x76, 0x16, 0xe9, 0x23, 0x80, 0xc7, 0xa2,
-	0x3d, 0x77, 0xb9, 0x13, 0xb3, 0x96, 0xfd, 0x76, 0xa5, 0xcb, 0x28, 0x29, 0x24, 0x27, 0x84, 0x5c,
-	0x44, 0x93, 0x6d, 0x84, 0x44, 0x0b, 0x82, 0xde, 0x02, 0x98, 0x0a, 0x86, 0x09, 0x4d, 0x77, 0xba,
-	0x34, 0x32, 0xc5, 0x69, 0x75, 0xaf, 0xee, 0x92, 0xdc, 0xa4, 0x20, 0x77, 0x0e, 0x65, 0x70, 0xa7,
-	0x0f, 0xa4, 0xc2, 0xd2, 0xfa, 0x86, 0x02, 0x1a, 0x1b, 0x0a, 0xf8, 0xb5, 0xa1, 0x80, 0x37, 0x9b,
-	0x4a, 0xa2, 0xb1, 0xa9, 0x24, 0xbe, 0x6d, 0x2a, 0x89, 0x27, 0xb3, 0x4d, 0x2b, 0x28, 0x84, 0x99,
-	0x2e, 0x13, 0x9d, 0x6f, 0x83, 0xd6, 0x72, 0x79, 0xfc, 0x7c, 0x07, 0xb4, 0xd8, 0x4d, 0x7a, 0x4a,
-	0x7c, 0x78, 0x5d, 0xfa, 0x17, 0x00, 0x00, 0xff, 0xff, 0x49, 0xf6, 0x2f, 0x07, 0x77, 0x0a, 0x00,
-	0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0xc5, 0xa8, 0x2f, 0xfc, 0x50, 0xa7, 0x41, 0x24, 0x0b, 0xac, 0x7f, 0x14, 0x4a,
+	0x5a, 0xe4, 0x9d, 0xd8, 0x80, 0x28, 0x0d, 0x6a, 0x1a, 0x03, 0x2e, 0xdc, 0x82, 0xa5, 0x70, 0xe0,
+	0x62, 0x8d, 0xbd, 0xc3, 0x76, 0x15, 0x7b, 0xc7, 0xd9, 0xd9, 0x35, 0x58, 0x88, 0x03, 0x3d, 0x71,
+	0xac, 0xe0, 0x9f, 0x40, 0x5c, 0xe0, 0x1f, 0xe0, 0x80, 0xc4, 0x21, 0x47, 0x4b, 0x5c, 0x90, 0x90,
+	0x00, 0xc5, 0x48, 0xf0, 0x1f, 0x70, 0x45, 0x3b, 0x3b, 0xdb, 0x7a, 0xed, 0x5d, 0xff, 0x52, 0x12,
+	0xf5, 0x92, 0x58, 0x33, 0xef, 0x7d, 0xf3, 0x7d, 0xdf, 0x7b, 0xf3, 0x76, 0x17, 0x5e, 0xe9, 0xda,
+	0x9d, 0xc3, 0xc1, 0x67, 0x74, 0x40, 0x3a, 0xf6, 0x91, 0x6f, 0x9b, 0x7d, 0x26, 0x3c, 0xdb, 0xb1,
+	0x48, 0xbf, 0x42, 0x8e, 0x7c, 0xe6, 0x0e, 0x8c, 0x9e, 0xcb, 0x3d, 0x8e, 0x37, 0xa3, 0x30, 0x23,
+	0x16, 0x66, 0xf4, 0x2b, 0xda, 0x65, 0xda, 0xb5, 0x1d, 0x4e, 0xe4, 0xdf, 0x30, 0x5a, 0xbb, 0xd1,
+	0xe6, 0xa2, 0xcb, 0x05, 0x69, 0x51, 0xc1, 0x42, 0x18, 0xd2, 0xaf, 0xb4, 0x98, 0x47, 0x2b, 0xa4,
+	0x47, 0x2d, 0xdb, 0xa1, 0x9e, 0xcd, 0x1d, 0x15, 0xab, 0x8f, 0xc7, 0x46, 0x51, 0x6d, 0x6e, 0x47,
+	0xfb, 0xeb, 0x16, 0xb7, 0xb8, 0xfc, 0x49, 0x82, 0x5f, 0x6a, 0xf5, 0x45, 0x8b, 0x73, 0xab, 0xc3,
+	0x08, 0xed, 0xd9, 0x84, 0x3a, 0x0e, 0xf7, 0x24, 0xa4, 0x50, 0xbb, 0xd7, 0xd2, 0x45, 0x75, 0xb9,
+	0xc9, 0x3a, 0x0b, 0xc4, 0xf5, 0xa8, 0x4b, 0xbb, 0x2a, 0xae, 0x54, 0x83, 0x97, 0x3e, 0x0a, 0x54,
+	0x1c, 0x08, 0xe6, 0x7e, 0xe8, 0x08, 0xdf, 0xa5, 0x4e, 0x9b, 0xd5, 0x7d, 0xc7, 0x6c, 0xb0, 0x23,
+	0x9f, 0x09, 0x0f, 0x17, 0xe1, 0x29, 0x5f, 0x30, 0xb7, 0x49, 0x4d, 0xd3, 0x65, 0x42, 0x6c, 0xa0,
+	0x02, 0xda, 0xba, 0xd4, 0x58, 0x0b, 0xd6, 0xf6, 0xc2, 0xa5, 0xd2, 0xbf, 0x08, 0xf4, 0x34, 0x10,
+	0xd1, 0xe3, 0x8e, 0x60, 0x98, 0xc1, 0x93, 0x2d, 0xda, 0x09, 0x96, 0x37, 0x50, 0xe1, 0xc2, 0xd6,
+	0x5a, 0x75, 0xd3, 0x08, 0xcd, 0x31, 0x02, 0x73, 0x0c, 0x65, 0x8e, 0xf1, 0x2e, 0xb7, 0x9d, 0xda,
+	0xf6, 0xf1, 0x1f, 0xf9, 0xcc, 0xf7, 0x7f, 0xe6, 0xb7, 0x2c, 0xdb, 0xbb, 0xe7, 0xb7, 0x8c, 0x36,
+	0xef, 0x12, 0xe5, 0x64, 0xf8, 0xaf, 0x2c, 0xcc, 0x43, 0xe2, 0x0d, 0x7a, 0x4c, 0xc8, 0x04, 0xd1,
+	0x88, 0xb0, 0x71, 0x13, 0x2e, 0xfa, 0x82, 0x99, 0x1b, 0xd9, 0xd3, 0x3f, 0x43, 0x02, 0x97, 0xee,
+	0xa5, 0x29, 0x15, 0x91, 0x5f, 0x75, 0x80, 0x47, 0x8d, 0x20, 0xdd, 0x5a, 0xab, 0x5e, 0x8b, 0x11,
+	0x09, 0x9b, 0x2f, 0xa2, 0xb3, 0x4f, 0x2d, 0xa6, 0x72, 0x1b, 0x63, 0x99, 0xa5, 0xfb, 0x59, 0x78,
+	0x6e, 0xea, 0x94, 0xf7, 0xa8, 0x47, 0x17, 0xa8, 0xc8, 0xb8, 0xdd, 0xd9, 0x73, 0xb0, 0xfb, 0xc2,
+	0x59, 0xd9, 0x3d, 0x44, 0x90, 0x4f, 0xf5, 0x5b, 0xb5, 0x96, 0x09, 0xcf, 0xda, 0xd1, 0x4e, 0xf3,
+	0xd3, 0x60, 0x4b, 0xb5, 0xd8, 0xb6, 0x91, 0x7a, 0xb3, 0x8d, 0x44, 0x67, 0x6b, 0x97, 0x02, 0x9a,
+	0xdf, 0xfd, 0xf3, 0xe3, 0x0d, 0xd4, 0x78, 0xc6, 0x8e, 0x9d, 0x86, 0xef, 0xc6, 0xca, 0x9a, 0x95,
+	0x65, 0x7d, 0x75, 0x6e, 0x59, 0x43, 0x8a, 0xb1, 0xba, 0xbe, 0x0f, 0x85, 0x87, 0x8a, 0x1a, 0x4c,
+	0x78, 0xf4, 0x90, 0xb6, 0x3a, 0x6c, 0x4f, 0x08, 0xe6, 0x89, 0x25, 0xee, 0xdc, 0xd7, 0x08, 0x8a,
+	0x33, 0x70, 0x94, 0x37, 0x6d, 0xc8, 0xd1, 0x2e, 0xf7, 0x1d, 0xef, 0x2c, 0x6e, 0x9d, 0x82, 0x2e,
+	0xbd, 0x00, 0x9b, 0x92, 0x49, 0xd2, 0xf8, 0x28, 0x7d, 0x85, 0x40, 0x4b, 0xda, 0x3d, 0x4f, 0x82,
+	0x36, 0xe4, 0xa7, 0x29, 0x1c, 0x08, 0x6a, 0xb1, 0x53, 0xbf, 0xb5, 0x3f, 0x21, 0x28, 0xa4, 0x9f,
+	0xa5, 0x44, 0xef, 0x43, 0xce, 0x97, 0x2b, 0x4a, 0x74, 0x79, 0x46, 0xa3, 0x4e, 0xe3, 0x8c, 0x77,
+	0xa9, 0xc2, 0x39, 0xbd, 0xee, 0x7c, 0x80, 0xe0, 0x6a, 0xf2, 0x85, 0x8b, 0xfb, 0xb5, 0xc0, 0x0c,
+	0xaa, 0x27, 0x70, 0x5a, 0xc5, 0xd2, 0x9f, 0x11, 0xbc, 0x3c, 0x9b, 0xd2, 0xe3, 0x6f, 0xeb, 0x3a,
+	0x60, 0x29, 0x61, 0x5f, 0x3e, 0x7a, 0xa3, 0xbb, 0xf1, 0x31, 0x5c, 0x89, 0xad, 0x2a, 0x1d, 0xbb,
+	0x90, 0x0b, 0x1f, 0xd1, 0xaa, 0x0f, 0x8b, 0x33, 0x74, 0x84, 0xa9, 0xb5, 0x8b, 0x01, 0xf7, 0x86,
+	0x4a, 0xab, 0xfe, 0x07, 0xf0, 0x84, 0x04, 0xc6, 0x43, 0x04, 0x97, 0xa7, 0x6c, 0xc3, 0x37, 0x67,
+	0x00, 0xce, 0x7c, 0x19, 0xd0, 0xde, 0x5e, 0x21, 0x33, 0x54, 0x55, 0xaa, 0xdf, 0xff, 0xf5, 0xef,
+	0x6f, 0xb3, 0x77, 0xf0, 0x6d, 0x92, 0xfe, 0x66, 0x22, 0x5b, 0x6a, 0x62, 0x98, 0x93, 0x2f, 0xc6,
+	0x1b, 0xed, 0x4b, 0xfc, 0x0b, 0x02, 0x3c, 0x75, 0x8a, 0xc0, 0xcb, 0x33, 0x8b, 0xca, 0xa0, 0xdd,
+	0x5a, 0x25, 0x55, 0xa9, 0x7a, 0x4b, 0xaa, 0xaa, 0x60, 0xb2, 0xa4, 0x2a, 0xfc, 0x3b, 0x82, 0xf5,
+	0xa4, 0xd1, 0x8d, 0x77, 0x16, 0x61, 0x93, 0xf2, 0xe0, 0xd0, 0xde, 0x59, 0x2d, 0x59, 0x89, 0xf9,
+	0x40, 0x8a, 0xa9, 0xe1, 0x3b, 0xf3, 0xc4, 0xb8, 0x0f, 0x11, 0x9a, 0x54, 0x42, 0x4c, 0x16, 0xe9,
+	0x07, 0x04, 0x4f, 0xc7, 0x7b, 0xee, 0x8d, 0x79, 0xcc, 0x12, 0xfb, 0xed, 0xcd, 0x25, 0xb3, 0x94,
+	0x90, 0x8a, 0x14, 0xf2, 0x1a, 0xbe, 0x3e, 0x43, 0x48, 0xbc, 0x20, 0xf8, 0x18, 0xc1, 0x95, 0x84,
+	0xe1, 0x82, 0x6f, 0x2d, 0xc5, 0x20, 0x36, 0x24, 0xb5, 0x9d, 0x95, 0x72, 0x95, 0x86, 0x9b, 0x52,
+	0x43, 0x15, 0x6f, 0x2f, 0xac, 0xa1, 0xa9, 0xa6, 0xd6, 0x08, 0xc1, 0xf3, 0x29, 0xb3, 0x12, 0xdf,
+	0x5e, 0xba, 0xd7, 0xe3, 0x92, 0x76, 0x57, 0xce, 0x57, 0xb2, 0xee, 0x4a, 0x59, 0x7b, 0x78, 0x77,
+	0x59, 0x59, 0x93, 0x2d, 0xf6, 0x0d, 0x82, 0x5c, 0x38, 0xfd, 0x70, 0x79, 0x1e, 0xa9, 0xd8, 0xd8,
+	0xd5, 0x8c, 0x45, 0xc3, 0x15, 0xe5, 0xeb, 0x92, 0xf2, 0x55, 0x5c, 0x24, 0xf3, 0xbe, 0xa9, 0x6a,
+	0x07, 0xc7, 0x27, 0x3a, 0x1a, 0x9e, 0xe8, 0xe8, 0xaf, 0x13, 0x1d, 0x3d, 0x18, 0xe9, 0x99, 0xe1,
+	0x48, 0xcf, 0xfc, 0x36, 0xd2, 0x33, 0x9f, 0xec, 0x8c, 0xbd, 0xb5, 0x44, 0x30, 0xe5, 0x0e, 0x6d,
+	0x89, 0x47, 0xa0, 0xfd, 0x4a, 0x95, 0x7c, 0x3e, 0x01, 0x2d, 0x5f, 0x67, 0x5a, 0x39, 0xf9, 0xad,
+	0xf6, 0xfa, 0xff, 0x03, 0x00, 0xff, 0xf6, 0x65, 0x90, 0xd2, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -662,6 +887,12 @@ type QueryClient interface {
 	// InsuranceFund defines a gRPC query method that returns the amount of tokens
 	// that are in the insurance fund.
 	InsuranceFund(ctx context.Context, in *QueryInsuranceFundRequest, opts ...grpc.CallOption) (*QueryInsuranceFundResponse, error)
+	// InsuranceFundUsages defines a gRPC query method that returns all the
+	// usages of the users' insurance funds to cover slashes.
+	InsuranceFundUsages(ctx context.Context, in *QueryInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundUsagesResponse, error)
+	// UserInsuranceFundUsages defines a gRPC query method that returns the
+	// usages of the user's insurance fund to cover slashes given their address.
+	UserInsuranceFundUsages(ctx context.Context, in *QueryUserInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryUserInsuranceFundUsagesResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -711,6 +942,24 @@ func (c *queryClient) InsuranceFund(ctx context.Context, in *QueryInsuranceFundR
 	return out, nil
 }
 
+func (c *queryClient) InsuranceFundUsages(ctx context.Context, in *QueryInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryInsuranceFundUsagesResponse, error) {
+	out := new(QueryInsuranceFundUsagesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.liquidvesting.v1.Query/InsuranceFundUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserInsuranceFundUsages(ctx context.Context, in *QueryUserInsuranceFundUsagesRequest, opts ...grpc.CallOption) (*QueryUserInsuranceFundUsagesResponse, error) {
+	out := new(QueryUserInsuranceFundUsagesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.liquidvesting.v1.Query/UserInsuranceFundUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.liquidvesting.v1.Query/Params", in, out, opts...)
@@ -735,6 +984,12 @@ type QueryServer interface {
 	// InsuranceFund defines a gRPC query method that returns the amount of tokens
 	// that are in the insurance fund.
 	InsuranceFund(context.Context, *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error)
+	// InsuranceFundUsages defines a gRPC query method that returns all the
+	// usages of the users' insurance funds to cover slashes.
+	InsuranceFundUsages(context.Context, *QueryInsuranceFundUsagesRequest) (*QueryInsuranceFundUsagesResponse, error)
+	// UserInsuranceFundUsages defines a gRPC query method that returns the
+	// usages of the user's insurance fund to cover slashes given their address.
+	UserInsuranceFundUsages(context.Context, *QueryUserInsuranceFundUsagesRequest) (*QueryUserInsuranceFundUsagesResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -756,6 +1011,12 @@ func (*UnimplementedQueryServer) UserRestakableAssets(ctx context.Context, req *
 func (*UnimplementedQueryServer) InsuranceFund(ctx context.Context, req *QueryInsuranceFundRequest) (*QueryInsuranceFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFund not implemented")
 }
+func (*UnimplementedQueryServer) InsuranceFundUsages(ctx context.Context, req *QueryInsuranceFundUsagesRequest) (*QueryInsuranceFundUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundUsages not implemented")
+}
+func (*UnimplementedQueryServer) UserInsuranceFundUsages(ctx context.Context, req *QueryUserInsuranceFundUsagesRequest) (*QueryUserInsuranceFundUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInsuranceFundUsages not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -836,6 +1097,42 @@ func _Query_InsuranceFund_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InsuranceFundUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.liquidvesting.v1.Query/InsuranceFundUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundUsages(ctx, req.(*QueryInsuranceFundUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserInsuranceFundUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserInsuranceFundUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserInsuranceFundUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.liquidvesting.v1.Query/UserInsuranceFundUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserInsuranceFundUsages(ctx, req.(*QueryUserInsuranceFundUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -875,6 +1172,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InsuranceFund",
 			Handler:    _Query_InsuranceFund_Handler,
 		},
+		{
+			MethodName: "InsuranceFundUsages",
+			Handler:    _Query_InsuranceFundUsages_Handler,
+		},
+		{
+			MethodName: "UserInsuranceFundUsages",
+			Handler:    _Query_UserInsuranceFundUsages_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1234,7 +1539,7 @@ func (m *QueryInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryInsuranceFundUsagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1244,20 +1549,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInsuranceFundUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInsuranceFundUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryInsuranceFundUsagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1267,55 +1584,218 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInsuranceFundUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInsuranceFundUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryUserInsuranceFundUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryUserInsuranceFundRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryUserInsuranceFundUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserInsuranceFundResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryUserInsuranceFundUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryUserInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserInsuranceFundResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1444,6 +1924,74 @@ func (m *QueryInsuranceFundResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInsuranceFundUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserInsuranceFundUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserInsuranceFundUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2326,6 +2874,450 @@ func (m *QueryInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInsuranceFundUsagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundUsagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundUsagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundUsagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, InsuranceFundUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserInsuranceFundUsagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserInsuranceFundUsagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserInsuranceFundUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserInsuranceFundUsagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserInsuranceFundUsagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserInsuranceFundUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, InsuranceFundUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
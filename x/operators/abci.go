@@ -12,10 +12,11 @@ import (
 // BeginBlocker is called at the beginning of every block.
 //
 // It iterates over all the operators that are set to be inactivated by the current block time
-// and updates their status to inactive.
+// and updates their status to inactive. It then applies all the commission changes that
+// take effect by the current block time.
 func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 	// Iterate over all the operators that are set to be inactivated by the current block time
-	return keeper.IterateInactivatingOperatorQueue(ctx, ctx.BlockTime(), func(operator types.Operator) (stop bool, err error) {
+	err := keeper.IterateInactivatingOperatorQueue(ctx, ctx.BlockTime(), func(operator types.Operator) (stop bool, err error) {
 		// Complete the operator inactivation process
 		err = keeper.CompleteOperatorInactivation(ctx, operator)
 		if err != nil {
@@ -30,6 +31,28 @@ func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			),
 		)
 
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Apply all the commission changes that take effect by the current block time
+	return keeper.IterateMatureCommissionChanges(ctx, ctx.BlockTime(), func(change types.CommissionChange) (stop bool, err error) {
+		err = keeper.ApplyCommissionChange(ctx, change)
+		if err != nil {
+			return true, err
+		}
+
+		// Emit an event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeApplyCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyOperatorID, fmt.Sprintf("%d", change.OperatorID)),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.CommissionRate.String()),
+			),
+		)
+
 		return false, nil
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -29,7 +30,7 @@ func TestBeginBlocker(t *testing.T) {
 				return ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
 			},
 			store: func(ctx sdk.Context) {
-				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour))
+				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour, 24*time.Hour))
 				require.NoError(t, err)
 
 				err = operatorsKeeper.StartOperatorInactivation(ctx, types.NewOperator(
@@ -60,7 +61,7 @@ func TestBeginBlocker(t *testing.T) {
 				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 0, 0, 1, time.UTC))
 			},
 			store: func(ctx sdk.Context) {
-				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour))
+				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour, 24*time.Hour))
 				require.NoError(t, err)
 
 				err = operatorsKeeper.StartOperatorInactivation(ctx, types.NewOperator(
@@ -97,7 +98,7 @@ func TestBeginBlocker(t *testing.T) {
 				return ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 0, 0, 1, time.UTC))
 			},
 			store: func(ctx sdk.Context) {
-				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour))
+				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour, 24*time.Hour))
 				require.NoError(t, err)
 
 				err = operatorsKeeper.StartOperatorInactivation(ctx, types.NewOperator(
@@ -128,6 +129,71 @@ func TestBeginBlocker(t *testing.T) {
 				require.False(t, hasKey)
 			},
 		},
+		{
+			name: "commission change is not applied before time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour, 24*time.Hour))
+				require.NoError(t, err)
+
+				err = operatorsKeeper.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				), true)
+				require.NoError(t, err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 2, 11, 59, 59, 0, time.UTC))
+			},
+			check: func(ctx sdk.Context) {
+				params, err := operatorsKeeper.GetOperatorParams(ctx, 1)
+				require.NoError(t, err)
+				require.Equal(t, types.DefaultOperatorParams(), params)
+
+				pendingChange, err := operatorsKeeper.GetPendingCommissionChange(ctx, 1)
+				require.NoError(t, err)
+				require.NotNil(t, pendingChange)
+			},
+		},
+		{
+			name: "commission change is applied after time",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				err := operatorsKeeper.SetParams(ctx, types.NewParams(nil, 6*time.Hour, 24*time.Hour))
+				require.NoError(t, err)
+
+				err = operatorsKeeper.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				), true)
+				require.NoError(t, err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC))
+			},
+			check: func(ctx sdk.Context) {
+				params, err := operatorsKeeper.GetOperatorParams(ctx, 1)
+				require.NoError(t, err)
+				require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.2"), params.CommissionRate)
+
+				pendingChange, err := operatorsKeeper.GetPendingCommissionChange(ctx, 1)
+				require.NoError(t, err)
+				require.Nil(t, pendingChange)
+
+				// The last change should still be stored
+				_, found, err := operatorsKeeper.GetCommissionChange(ctx, 1)
+				require.NoError(t, err)
+				require.True(t, found)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
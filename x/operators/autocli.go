@@ -40,6 +40,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "operator_id"},
 					},
 				},
+				{
+					RpcMethod: "OperatorRoleGrants",
+					Use:       "role-grants [operator-id]",
+					Short:     "Query the roles granted by the admin of the operator with the given id",
+					Example:   fmt.Sprintf(`%s query %s role-grants 1 --grantee cosmos1example`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_id"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -144,6 +153,38 @@ Only the fields that you provide will be updated`,
 						{ProtoField: "operator_id"},
 					},
 				},
+				{
+					RpcMethod: "GrantOperatorRole",
+					Use:       "grant-role [operator-id] [grantee] [role]",
+					Short:     "Grant one of the operator roles to another account",
+					Long: `Grant one of the operator roles to another account.
+
+The available roles are:
+- OPERATOR_ROLE_METADATA: allows to update the operator details
+- OPERATOR_ROLE_COMMISSION: allows to update the operator params, including the commission rate
+- OPERATOR_ROLE_JOIN_LEAVE: allows to join and leave services on behalf of the operator`,
+					Example: fmt.Sprintf(`%s tx %s grant-role 1 cosmos1example OPERATOR_ROLE_METADATA --from alice`,
+						version.AppName, types.ModuleName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_id"},
+						{ProtoField: "grantee"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "RevokeOperatorRole",
+					Use:       "revoke-role [operator-id] [grantee] [role]",
+					Short:     "Revoke an operator role previously granted to another account",
+					Example: fmt.Sprintf(`%s tx %s revoke-role 1 cosmos1example OPERATOR_ROLE_METADATA --from alice`,
+						version.AppName, types.ModuleName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_id"},
+						{ProtoField: "grantee"},
+						{ProtoField: "role"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true,
@@ -31,14 +31,25 @@ func GetTxCmd() *cobra.Command {
 	return txCmd
 }
 
+const (
+	flagMaxRate       = "max-rate"
+	flagMaxChangeRate = "max-change-rate"
+)
+
 // GetCmdSetOperatorParams returns the command allowing to set an existing operator's
 // parameters
 func GetCmdSetOperatorParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "set-params [operator-id] [commission-rate]",
-		Args:    cobra.ExactArgs(2),
-		Short:   "Set the parameters of the operator with the given id",
-		Example: fmt.Sprintf(`%s tx %s set-params 1 0.2 --from alice`, version.AppName, types.ModuleName),
+		Use:   "set-params [operator-id] [commission-rate]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the parameters of the operator with the given id",
+		Long: `Set the parameters of the operator with the given id.
+
+A change of the commission rate is announced and takes effect only after the commission change notice period.
+The max rate and max change rate can only be decreased. If they are not specified, the current values are used.`,
+		Example: fmt.Sprintf(`%s tx %s set-params 1 0.2 --max-rate 0.5 --max-change-rate 0.05 --from alice`,
+			version.AppName, types.ModuleName,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -55,10 +66,48 @@ func GetCmdSetOperatorParams() *cobra.Command {
 				return fmt.Errorf("invalid commission rate: %s", err)
 			}
 
+			maxRateStr, err := cmd.Flags().GetString(flagMaxRate)
+			if err != nil {
+				return err
+			}
+
+			maxChangeRateStr, err := cmd.Flags().GetString(flagMaxChangeRate)
+			if err != nil {
+				return err
+			}
+
+			// Use the current limits if they are not specified
+			var currentParams types.OperatorParams
+			if maxRateStr == "" || maxChangeRateStr == "" {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.OperatorParams(cmd.Context(), types.NewQueryOperatorParamsRequest(id))
+				if err != nil {
+					return err
+				}
+				currentParams = res.OperatorParams
+			}
+
+			maxRate := currentParams.MaxRate
+			if maxRateStr != "" {
+				maxRate, err = math.LegacyNewDecFromStr(maxRateStr)
+				if err != nil {
+					return fmt.Errorf("invalid max rate: %s", err)
+				}
+			}
+
+			maxChangeRate := currentParams.MaxChangeRate
+			if maxChangeRateStr != "" {
+				maxChangeRate, err = math.LegacyNewDecFromStr(maxChangeRateStr)
+				if err != nil {
+					return fmt.Errorf("invalid max change rate: %s", err)
+				}
+			}
+
 			creator := clientCtx.FromAddress.String()
 
 			// Create and validate the message
-			msg := types.NewMsgSetOperatorParams(id, types.NewOperatorParams(commissionRete), creator)
+			params := types.NewOperatorParams(commissionRete, maxRate, maxChangeRate)
+			msg := types.NewMsgSetOperatorParams(id, params, creator)
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -67,6 +116,9 @@ func GetCmdSetOperatorParams() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagMaxRate, "", "maximum commission rate that the operator can ever charge")
+	cmd.Flags().String(flagMaxChangeRate, "", "maximum daily change of the commission rate")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
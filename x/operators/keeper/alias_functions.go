@@ -116,3 +116,16 @@ func (k *Keeper) GetAllOperatorParamsRecords(ctx context.Context) ([]types.Opera
 	}
 	return records, nil
 }
+
+// GetAllCommissionChanges returns the last commission change announced by each operator
+func (k *Keeper) GetAllCommissionChanges(ctx context.Context) ([]types.CommissionChange, error) {
+	var changes []types.CommissionChange
+	err := k.commissionChanges.Walk(ctx, nil, func(_ uint32, change types.CommissionChange) (stop bool, err error) {
+		changes = append(changes, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
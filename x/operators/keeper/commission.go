@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
+)
+
+// UpdateOperatorParams updates the params of the operator with the given ID.
+// The commission rate limits are updated immediately, while a change of the
+// commission rate is announced and only takes effect after the commission
+// change notice period. If a commission change has been announced, it is
+// returned.
+func (k *Keeper) UpdateOperatorParams(
+	ctx context.Context, operatorID uint32, params types.OperatorParams,
+) (*types.CommissionChange, error) {
+	current, err := k.GetOperatorParams(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the commission rate limits are not increased
+	if params.MaxRate.GT(current.MaxRate) {
+		return nil, types.ErrInvalidOperatorParams.Wrapf("max rate cannot be increased: %s > %s",
+			params.MaxRate, current.MaxRate)
+	}
+
+	if params.MaxChangeRate.GT(current.MaxChangeRate) {
+		return nil, types.ErrInvalidOperatorParams.Wrapf("max change rate cannot be increased: %s > %s",
+			params.MaxChangeRate, current.MaxChangeRate)
+	}
+
+	pendingChange, err := k.GetPendingCommissionChange(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the pending commission change is still allowed by the new max rate
+	if pendingChange != nil && pendingChange.CommissionRate.GT(params.MaxRate) {
+		return nil, types.ErrInvalidOperatorParams.Wrapf("max rate cannot be lower than the pending commission rate: %s < %s",
+			params.MaxRate, pendingChange.CommissionRate)
+	}
+
+	var change *types.CommissionChange
+	if !params.CommissionRate.Equal(current.CommissionRate) {
+		if pendingChange != nil {
+			return nil, types.ErrInvalidCommissionChange.Wrap("a commission change is already pending")
+		}
+
+		announced, err := k.announceCommissionChange(ctx, operatorID, current.CommissionRate, params)
+		if err != nil {
+			return nil, err
+		}
+		change = &announced
+	}
+
+	// The commission rate is only updated once the announced change takes effect
+	params.CommissionRate = current.CommissionRate
+	err = k.SaveOperatorParams(ctx, operatorID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// announceCommissionChange announces a change of the commission rate of the
+// operator with the given ID, making sure it respects the given params limits
+func (k *Keeper) announceCommissionChange(
+	ctx context.Context, operatorID uint32, currentRate math.LegacyDec, params types.OperatorParams,
+) (types.CommissionChange, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	// Make sure the commission rate is not changed more than once per day
+	lastChange, found, err := k.GetCommissionChange(ctx, operatorID)
+	if err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	if found && blockTime.Before(lastChange.AnnounceTime.Add(types.CommissionChangeInterval)) {
+		return types.CommissionChange{}, types.ErrInvalidCommissionChange.Wrapf(
+			"commission rate cannot be changed more than once within %s", types.CommissionChangeInterval)
+	}
+
+	// Make sure the change respects the max change rate
+	if params.CommissionRate.Sub(currentRate).Abs().GT(params.MaxChangeRate) {
+		return types.CommissionChange{}, types.ErrInvalidCommissionChange.Wrapf(
+			"commission rate change exceeds the max change rate: %s", params.MaxChangeRate)
+	}
+
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	change := types.NewCommissionChange(
+		operatorID,
+		params.CommissionRate,
+		blockTime,
+		blockTime.Add(moduleParams.CommissionChangeNoticePeriod),
+	)
+
+	err = k.SaveCommissionChange(ctx, change, true)
+	if err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	return change, nil
+}
+
+// SaveCommissionChange stores the given commission change. If pending is true,
+// the change is also inserted into the queue of the changes to be applied.
+func (k *Keeper) SaveCommissionChange(ctx context.Context, change types.CommissionChange, pending bool) error {
+	err := k.commissionChanges.Set(ctx, change.OperatorID, change)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		return k.commissionChangeQueue.Set(ctx, collections.Join(change.EffectiveTime, change.OperatorID))
+	}
+
+	return nil
+}
+
+// GetCommissionChange returns the last commission change announced by the
+// operator with the given ID. If no change has ever been announced, false is
+// returned.
+func (k *Keeper) GetCommissionChange(ctx context.Context, operatorID uint32) (types.CommissionChange, bool, error) {
+	change, err := k.commissionChanges.Get(ctx, operatorID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.CommissionChange{}, false, nil
+		}
+		return types.CommissionChange{}, false, err
+	}
+	return change, true, nil
+}
+
+// GetPendingCommissionChange returns the commission change announced by the
+// operator with the given ID that has not been applied yet, or nil if there
+// is none.
+func (k *Keeper) GetPendingCommissionChange(ctx context.Context, operatorID uint32) (*types.CommissionChange, error) {
+	change, found, err := k.GetCommissionChange(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	pending, err := k.commissionChangeQueue.Has(ctx, collections.Join(change.EffectiveTime, operatorID))
+	if err != nil {
+		return nil, err
+	}
+
+	if !pending {
+		return nil, nil
+	}
+
+	return &change, nil
+}
+
+// DeleteCommissionChange removes the commission change of the operator with
+// the given ID, along with its entry in the queue if it is still pending
+func (k *Keeper) DeleteCommissionChange(ctx context.Context, operatorID uint32) error {
+	change, found, err := k.GetCommissionChange(ctx, operatorID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	err = k.commissionChangeQueue.Remove(ctx, collections.Join(change.EffectiveTime, operatorID))
+	if err != nil {
+		return err
+	}
+
+	return k.commissionChanges.Remove(ctx, operatorID)
+}
+
+// GetEffectiveCommissionRate returns the commission rate that the operator
+// with the given ID charges at the current block time. This takes into
+// account the pending commission change whose effective time has already
+// been reached but that has not been applied yet.
+func (k *Keeper) GetEffectiveCommissionRate(ctx context.Context, operatorID uint32) (math.LegacyDec, error) {
+	params, err := k.GetOperatorParams(ctx, operatorID)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	pendingChange, err := k.GetPendingCommissionChange(ctx, operatorID)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if pendingChange != nil && !pendingChange.EffectiveTime.After(sdkCtx.BlockTime()) {
+		return pendingChange.CommissionRate, nil
+	}
+
+	return params.CommissionRate, nil
+}
+
+// IterateMatureCommissionChanges iterates over all the pending commission
+// changes that take effect by the given time and calls the given function.
+func (k *Keeper) IterateMatureCommissionChanges(
+	ctx context.Context, endTime time.Time, fn func(change types.CommissionChange) (stop bool, err error),
+) error {
+	// Collect the changes first, so that the callback can modify the queue
+	var changes []types.CommissionChange
+	err := k.commissionChangeQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint32]) (stop bool, err error) {
+		if key.K1().After(endTime) {
+			return true, nil
+		}
+
+		change, err := k.commissionChanges.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		changes = append(changes, change)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		stop, err := fn(change)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil
+}
+
+// ApplyCommissionChange sets the commission rate of the operator to the one
+// of the given change and removes the change from the pending queue
+func (k *Keeper) ApplyCommissionChange(ctx context.Context, change types.CommissionChange) error {
+	params, err := k.GetOperatorParams(ctx, change.OperatorID)
+	if err != nil {
+		return err
+	}
+
+	params.CommissionRate = change.CommissionRate
+	err = k.SaveOperatorParams(ctx, change.OperatorID, params)
+	if err != nil {
+		return err
+	}
+
+	return k.commissionChangeQueue.Remove(ctx, collections.Join(change.EffectiveTime, change.OperatorID))
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_GetEffectiveCommissionRate() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		operatorID uint32
+		shouldErr  bool
+		expRate    sdkmath.LegacyDec
+	}{
+		{
+			name:       "default commission rate is returned properly",
+			operatorID: 1,
+			shouldErr:  false,
+			expRate:    sdkmath.LegacyZeroDec(),
+		},
+		{
+			name: "pending commission rate is not returned before the effective time",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyOneDec(),
+					sdkmath.LegacyOneDec(),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 12, 0, 1, 0, time.UTC),
+				), true)
+				suite.Require().NoError(err)
+			},
+			operatorID: 1,
+			shouldErr:  false,
+			expRate:    sdkmath.LegacyMustNewDecFromStr("0.1"),
+		},
+		{
+			name: "pending commission rate is returned after the effective time",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyOneDec(),
+					sdkmath.LegacyOneDec(),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				), true)
+				suite.Require().NoError(err)
+			},
+			operatorID: 1,
+			shouldErr:  false,
+			expRate:    sdkmath.LegacyMustNewDecFromStr("0.2"),
+		},
+		{
+			name: "applied commission change is ignored",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyOneDec(),
+					sdkmath.LegacyOneDec(),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+				), false)
+				suite.Require().NoError(err)
+			},
+			operatorID: 1,
+			shouldErr:  false,
+			expRate:    sdkmath.LegacyMustNewDecFromStr("0.1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			rate, err := suite.k.GetEffectiveCommissionRate(ctx, tc.operatorID)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRate, rate)
+			}
+		})
+	}
+}
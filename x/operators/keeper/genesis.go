@@ -32,6 +32,21 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	commissionChanges, err := k.GetAllCommissionChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	roleGrants, err := k.GetAllOperatorRoleGrants(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	jailedOperatorsIDs, err := k.GetJailedOperatorsIDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -42,6 +57,9 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		operators,
 		operatorParamsRecords,
 		inactiveOperators,
+		commissionChanges,
+		roleGrants,
+		jailedOperatorsIDs,
 		params,
 	)
 }
@@ -81,6 +99,56 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		}
 	}
 
+	// Store the commission changes
+	for _, change := range state.CommissionChanges {
+		// Ensure that the operator is present
+		_, err := k.GetOperator(ctx, change.OperatorID)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrapf(types.ErrOperatorNotFound, "operator %d not found", change.OperatorID)
+			}
+			return err
+		}
+
+		// The changes that have not been applied yet are queued again. The
+		// ones that have already been applied are simply applied once more
+		// since they still represent the current commission rate
+		params, err := k.GetOperatorParams(ctx, change.OperatorID)
+		if err != nil {
+			return err
+		}
+
+		err = k.SaveCommissionChange(ctx, change, !params.CommissionRate.Equal(change.CommissionRate))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the role grants
+	for _, grant := range state.RoleGrants {
+		// Ensure that the operator is present
+		_, err := k.GetOperator(ctx, grant.OperatorID)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrapf(types.ErrOperatorNotFound, "operator %d not found", grant.OperatorID)
+			}
+			return err
+		}
+
+		err = k.GrantOperatorRole(ctx, grant.OperatorID, grant.Grantee, grant.Role)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the jailed operators
+	for _, operatorID := range state.JailedOperatorsIDs {
+		err = k.jailedOperators.Set(ctx, operatorID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Store the inactivating operators
 	for _, entry := range state.UnbondingOperators {
 		err = k.setOperatorAsInactivating(ctx, entry.OperatorID, entry.UnbondingCompletionTime)
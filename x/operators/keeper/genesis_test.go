@@ -171,6 +171,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				// Set some custom params
 				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
 					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				))
 				suite.Require().NoError(err)
 
@@ -187,6 +188,7 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				// Set some custom params
 				err = suite.k.SaveOperatorParams(ctx, 2, types.NewOperatorParams(
 					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				))
 				suite.Require().NoError(err)
 			},
@@ -213,14 +215,70 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				OperatorsParams: []types.OperatorParamsRecord{
 					types.NewOperatorParamsRecord(1, types.NewOperatorParams(
 						sdkmath.LegacyMustNewDecFromStr("0.1"),
+						sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 					)),
 					types.NewOperatorParamsRecord(2, types.NewOperatorParams(
 						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 					)),
 				},
 				Params: types.DefaultParams(),
 			},
 		},
+		{
+			name: "commission changes are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetNextOperatorID(ctx, 10)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				), true)
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextOperatorID: 10,
+				CommissionChanges: []types.CommissionChange{
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		{
+			name: "role grants are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetNextOperatorID(ctx, 10)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_JOIN_LEAVE)
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextOperatorID: 10,
+				RoleGrants: []types.OperatorRoleGrant{
+					types.NewOperatorRoleGrant(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA),
+					types.NewOperatorRoleGrant(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_JOIN_LEAVE),
+				},
+				Params: types.DefaultParams(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -355,6 +413,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				Params: types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					10*time.Hour,
+					24*time.Hour,
 				),
 			},
 			check: func(ctx sdk.Context) {
@@ -363,6 +422,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				suite.Require().Equal(types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					10*time.Hour,
+					24*time.Hour,
 				), params)
 			},
 		},
@@ -401,6 +461,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				OperatorsParams: []types.OperatorParamsRecord{
 					types.NewOperatorParamsRecord(1, types.NewOperatorParams(
 						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 					)),
 				},
 			},
@@ -409,9 +470,119 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				suite.Require().NoError(err)
 				suite.Require().Equal(params, types.NewOperatorParams(
 					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				))
 			},
 		},
+		{
+			name: "commission change without associated operator should err",
+			genesis: &types.GenesisState{
+				CommissionChanges: []types.CommissionChange{
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "commission changes are initialized properly",
+			genesis: &types.GenesisState{
+				Operators: []types.Operator{
+					types.NewOperator(
+						1,
+						types.OPERATOR_STATUS_ACTIVE,
+						"MilkyWay Operator",
+						"https://milkyway.com",
+						"https://milkyway.com/picture",
+						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					),
+					types.NewOperator(
+						2,
+						types.OPERATOR_STATUS_ACTIVE,
+						"Inertia",
+						"https://inertia.zone",
+						"",
+						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					),
+				},
+				OperatorsParams: []types.OperatorParamsRecord{
+					types.NewOperatorParamsRecord(1, types.NewOperatorParams(
+						sdkmath.LegacyMustNewDecFromStr("0.1"),
+						sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
+					)),
+					types.NewOperatorParamsRecord(2, types.NewOperatorParams(
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
+					)),
+				},
+				CommissionChanges: []types.CommissionChange{
+					// Pending change
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+					// Already applied change
+					types.NewCommissionChange(
+						2,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				pendingChange, err := suite.k.GetPendingCommissionChange(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().NotNil(pendingChange)
+				suite.Require().Equal(sdkmath.LegacyMustNewDecFromStr("0.2"), pendingChange.CommissionRate)
+
+				pendingChange, err = suite.k.GetPendingCommissionChange(ctx, 2)
+				suite.Require().NoError(err)
+				suite.Require().Nil(pendingChange)
+
+				_, found, err := suite.k.GetCommissionChange(ctx, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+			},
+		},
+		{
+			name: "role grant without associated operator should err",
+			genesis: &types.GenesisState{
+				RoleGrants: []types.OperatorRoleGrant{
+					types.NewOperatorRoleGrant(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "role grants are initialized properly",
+			genesis: &types.GenesisState{
+				Operators: []types.Operator{
+					types.NewOperator(
+						1,
+						types.OPERATOR_STATUS_ACTIVE,
+						"MilkyWay Operator",
+						"https://milkyway.com",
+						"https://milkyway.com/picture",
+						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					),
+				},
+				RoleGrants: []types.OperatorRoleGrant{
+					types.NewOperatorRoleGrant(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,10 +5,12 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
 )
 
@@ -29,9 +31,58 @@ func (k *Keeper) Operator(ctx context.Context, request *types.QueryOperatorReque
 
 // Operators implements the Query/Operators gRPC method
 func (k *Keeper) Operators(ctx context.Context, request *types.QueryOperatorsRequest) (*types.QueryOperatorsResponse, error) {
-	operators, pageRes, err := query.CollectionPaginate(ctx, k.operators, request.Pagination, func(_ uint32, operator types.Operator) (types.Operator, error) {
+	if request.Admin != "" {
+		_, err := sdk.AccAddressFromBech32(request.Admin)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin address")
+		}
+	}
+
+	if request.JoinedServiceId != 0 && k.restakingKeeper == nil {
+		return nil, status.Error(codes.Unimplemented, "filtering by joined service is not supported")
+	}
+
+	// Filter the operators that do not match the requested criteria
+	predicateFunc := func(operatorID uint32, operator types.Operator) (bool, error) {
+		if request.Status != types.OPERATOR_STATUS_UNSPECIFIED && operator.Status != request.Status {
+			return false, nil
+		}
+
+		if request.Admin != "" && operator.Admin != request.Admin {
+			return false, nil
+		}
+
+		if request.JoinedServiceId != 0 {
+			return k.restakingKeeper.HasOperatorJoinedService(ctx, operatorID, request.JoinedServiceId)
+		}
+
+		return true, nil
+	}
+
+	transformFunc := func(_ uint32, operator types.Operator) (types.Operator, error) {
 		return operator, nil
-	})
+	}
+
+	var operators []types.Operator
+	var pageRes *query.PageResponse
+	var err error
+
+	switch {
+	case request.Admin != "":
+		operators, pageRes, err = utils.CollectionIndexPaginate(ctx, k.operators.Indexes.Admin,
+			collections.NewPrefixedPairRange[string, uint32](request.Admin),
+			k.operators.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	case request.Status != types.OPERATOR_STATUS_UNSPECIFIED:
+		operators, pageRes, err = utils.CollectionIndexPaginate(ctx, k.operators.Indexes.Status,
+			collections.NewPrefixedPairRange[int32, uint32](int32(request.Status)),
+			k.operators.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	default:
+		operators, pageRes, err = query.CollectionFilteredPaginate(ctx, k.operators, request.Pagination,
+			predicateFunc, transformFunc,
+		)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -55,7 +106,49 @@ func (k *Keeper) OperatorParams(ctx context.Context, request *types.QueryOperato
 		return nil, err
 	}
 
-	return &types.QueryOperatorParamsResponse{OperatorParams: params}, nil
+	pendingChange, err := k.GetPendingCommissionChange(ctx, request.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOperatorParamsResponse{
+		OperatorParams:          params,
+		PendingCommissionChange: pendingChange,
+	}, nil
+}
+
+// OperatorRoleGrants implements the Query/OperatorRoleGrants gRPC method
+func (k *Keeper) OperatorRoleGrants(ctx context.Context, request *types.QueryOperatorRoleGrantsRequest) (*types.QueryOperatorRoleGrantsResponse, error) {
+	if request.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid operator id")
+	}
+
+	// Filter the grants of the given grantee, if any
+	prefix := collections.TriplePrefix[uint32, string, int32](request.OperatorId)
+	if request.Grantee != "" {
+		_, err := sdk.AccAddressFromBech32(request.Grantee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid grantee address")
+		}
+		prefix = collections.TripleSuperPrefix[uint32, string, int32](request.OperatorId, request.Grantee)
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(ctx, k.roleGrants, request.Pagination,
+		func(key collections.Triple[uint32, string, int32], _ collections.NoValue) (types.OperatorRoleGrant, error) {
+			return types.NewOperatorRoleGrant(key.K1(), key.K2(), types.OperatorRole(key.K3())), nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint32, string, int32]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOperatorRoleGrantsResponse{
+		RoleGrants: grants,
+		Pagination: pageRes,
+	}, nil
 }
 
 // Params implements the Query/Params gRPC method
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"context"
+	"slices"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -114,6 +118,7 @@ func (suite *KeeperTestSuite) TestQueryServer_OperatorParams() {
 
 				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
 					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				))
 			},
 			request:   types.NewQueryOperatorParamsRequest(1),
@@ -121,9 +126,52 @@ func (suite *KeeperTestSuite) TestQueryServer_OperatorParams() {
 			expResponse: &types.QueryOperatorParamsResponse{
 				OperatorParams: types.NewOperatorParams(
 					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				),
 			},
 		},
+		{
+			name: "pending commission change is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.3"),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				), true)
+				suite.Require().NoError(err)
+			},
+			request:   types.NewQueryOperatorParamsRequest(1),
+			shouldErr: false,
+			expResponse: &types.QueryOperatorParamsResponse{
+				OperatorParams: types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
+				),
+				PendingCommissionChange: &types.CommissionChange{
+					OperatorID:     1,
+					CommissionRate: sdkmath.LegacyMustNewDecFromStr("0.3"),
+					AnnounceTime:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					EffectiveTime:  time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,6 +192,14 @@ func (suite *KeeperTestSuite) TestQueryServer_OperatorParams() {
 	}
 }
 
+// mockRestakingKeeper is a types.RestakingKeeper implementation that
+// associates each operator ID with the IDs of the services it has joined
+type mockRestakingKeeper map[uint32][]uint32
+
+func (m mockRestakingKeeper) HasOperatorJoinedService(_ context.Context, operatorID uint32, serviceID uint32) (bool, error) {
+	return slices.Contains(m[operatorID], serviceID), nil
+}
+
 func (suite *KeeperTestSuite) TestQueryServer_Operators() {
 	testCases := []struct {
 		name         string
@@ -235,6 +291,371 @@ func (suite *KeeperTestSuite) TestQueryServer_Operators() {
 				),
 			},
 		},
+		{
+			name: "query with invalid admin returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryOperatorsRequest{
+				Admin: "invalid",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "query with status filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryOperatorsRequest{
+				Status: types.OPERATOR_STATUS_ACTIVE,
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				),
+				types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+			},
+		},
+		{
+			name: "query with status filter and pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryOperatorsRequest{
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+				Status:     types.OPERATOR_STATUS_ACTIVE,
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+			},
+		},
+		{
+			name: "query with admin filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryOperatorsRequest{
+				Admin: "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+				types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+			},
+		},
+		{
+			name: "query with admin and status filters returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryOperatorsRequest{
+				Status: types.OPERATOR_STATUS_INACTIVATING,
+				Admin:  "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+			},
+		},
+		{
+			name: "query with joined service filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				suite.k.SetRestakingKeeper(mockRestakingKeeper{
+					1: {1},
+					2: {1, 2},
+					3: {2},
+				})
+			},
+			request: &types.QueryOperatorsRequest{
+				JoinedServiceId: 2,
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+				types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				),
+			},
+		},
+		{
+			name: "query with joined service and status filters returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					2,
+					types.OPERATOR_STATUS_INACTIVATING,
+					"Inertia",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateOperator(ctx, types.NewOperator(
+					3,
+					types.OPERATOR_STATUS_ACTIVE,
+					"Inertia Operator",
+					"https://inertia.zone",
+					"",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				))
+				suite.Require().NoError(err)
+
+				suite.k.SetRestakingKeeper(mockRestakingKeeper{
+					1: {1},
+					2: {1, 2},
+					3: {2},
+				})
+			},
+			request: &types.QueryOperatorsRequest{
+				Status:          types.OPERATOR_STATUS_ACTIVE,
+				JoinedServiceId: 1,
+			},
+			shouldErr: false,
+			expOperators: []types.Operator{
+				types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,15 +19,23 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	hooks        types.OperatorsHooks
 
-	accountKeeper types.AccountKeeper
-	poolKeeper    types.CommunityPoolKeeper
-	Schema        collections.Schema
+	accountKeeper   types.AccountKeeper
+	poolKeeper      types.CommunityPoolKeeper
+	restakingKeeper types.RestakingKeeper
+	Schema          collections.Schema
 
-	nextOperatorID     collections.Sequence                          // Next operator ID
-	operators          collections.Map[uint32, types.Operator]       // operator ID -> operator
-	operatorAddressSet collections.KeySet[string]                    // Set of operator addresses
-	operatorParams     collections.Map[uint32, types.OperatorParams] // operator ID -> parameters
-	params             collections.Item[types.Params]                // global parameters
+	nextOperatorID     collections.Sequence                                             // Next operator ID
+	operators          *collections.IndexedMap[uint32, types.Operator, operatorIndexes] // operator ID -> operator
+	operatorAddressSet collections.KeySet[string]                                       // Set of operator addresses
+	operatorParams     collections.Map[uint32, types.OperatorParams]                    // operator ID -> parameters
+	params             collections.Item[types.Params]                                   // global parameters
+
+	commissionChanges     collections.Map[uint32, types.CommissionChange]         // operator ID -> last commission change
+	commissionChangeQueue collections.KeySet[collections.Pair[time.Time, uint32]] // (effective time, operator ID)
+
+	roleGrants collections.KeySet[collections.Triple[uint32, string, int32]] // (operator ID, grantee, role)
+
+	jailedOperators collections.KeySet[uint32] // Set of permanently jailed operator IDs
 
 	// authority represents the address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
@@ -53,12 +63,13 @@ func NewKeeper(
 			types.NextOperatorIDKey,
 			"next_operator_id",
 		),
-		operators: collections.NewMap(
+		operators: collections.NewIndexedMap(
 			sb,
 			types.OperatorPrefix,
 			"operators",
 			collections.Uint32Key,
 			codec.CollValue[types.Operator](cdc),
+			newOperatorIndexes(sb),
 		),
 		operatorAddressSet: collections.NewKeySet(
 			sb,
@@ -79,6 +90,31 @@ func NewKeeper(
 			"params",
 			codec.CollValue[types.Params](cdc),
 		),
+		commissionChanges: collections.NewMap(
+			sb,
+			types.CommissionChangePrefix,
+			"commission_changes",
+			collections.Uint32Key,
+			codec.CollValue[types.CommissionChange](cdc),
+		),
+		commissionChangeQueue: collections.NewKeySet(
+			sb,
+			types.CommissionChangeQueuePrefix,
+			"commission_change_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint32Key),
+		),
+		roleGrants: collections.NewKeySet(
+			sb,
+			types.OperatorRoleGrantPrefix,
+			"operators_role_grants",
+			collections.TripleKeyCodec(collections.Uint32Key, collections.StringKey, collections.Int32Key),
+		),
+		jailedOperators: collections.NewKeySet(
+			sb,
+			types.JailedOperatorsPrefix,
+			"jailed_operators",
+			collections.Uint32Key,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -105,3 +141,49 @@ func (k *Keeper) SetHooks(rs types.OperatorsHooks) *Keeper {
 	k.hooks = rs
 	return k
 }
+
+// SetRestakingKeeper sets the restaking keeper used to filter the operators
+// based on the services they have joined
+func (k *Keeper) SetRestakingKeeper(restakingKeeper types.RestakingKeeper) *Keeper {
+	k.restakingKeeper = restakingKeeper
+	return k
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type operatorIndexes struct {
+	// Index that allows to retrieve all the operators having a given status
+	Status *indexes.Multi[int32, uint32, types.Operator]
+
+	// Index that allows to retrieve all the operators managed by a given admin
+	Admin *indexes.Multi[string, uint32, types.Operator]
+}
+
+func (i operatorIndexes) IndexesList() []collections.Index[uint32, types.Operator] {
+	return []collections.Index[uint32, types.Operator]{i.Status, i.Admin}
+}
+
+func newOperatorIndexes(sb *collections.SchemaBuilder) operatorIndexes {
+	return operatorIndexes{
+		Status: indexes.NewMulti(
+			sb,
+			types.OperatorStatusIndexPrefix,
+			"operators_by_status",
+			collections.Int32Key,
+			collections.Uint32Key,
+			func(_ uint32, operator types.Operator) (int32, error) {
+				return int32(operator.Status), nil
+			},
+		),
+		Admin: indexes.NewMulti(
+			sb,
+			types.OperatorAdminIndexPrefix,
+			"operators_by_admin",
+			collections.StringKey,
+			collections.Uint32Key,
+			func(_ uint32, operator types.Operator) (string, error) {
+				return operator.Admin, nil
+			},
+		),
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -104,9 +105,14 @@ func (k msgServer) UpdateOperator(ctx context.Context, msg *types.MsgUpdateOpera
 		return nil, err
 	}
 
-	// Make sure only the admin can update the operator
-	if operator.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can update the operator")
+	// Make sure only the admin or an account having the metadata role can update the operator
+	hasPermission, err := k.HasOperatorPermission(ctx, operator, msg.Sender, types.OPERATOR_ROLE_METADATA)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin or an account having the %s role can update the operator", types.OPERATOR_ROLE_METADATA)
 	}
 
 	// Update the operator
@@ -248,6 +254,11 @@ func (k msgServer) TransferOperatorOwnership(ctx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	// Remove the roles granted by the previous admin
+	if err := k.DeleteOperatorRoleGrants(ctx, operator.ID); err != nil {
+		return nil, err
+	}
+
 	// Emit the event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
@@ -269,9 +280,14 @@ func (k msgServer) SetOperatorParams(ctx context.Context, msg *types.MsgSetOpera
 		return nil, err
 	}
 
-	// Make sure only the admin can update the operator
-	if operator.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can update the operator params")
+	// Make sure only the admin or an account having the commission role can update the operator params
+	hasPermission, err := k.HasOperatorPermission(ctx, operator, msg.Sender, types.OPERATOR_ROLE_COMMISSION)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin or an account having the %s role can update the operator params", types.OPERATOR_ROLE_COMMISSION)
 	}
 
 	// Make sure that the received params are valid
@@ -279,13 +295,14 @@ func (k msgServer) SetOperatorParams(ctx context.Context, msg *types.MsgSetOpera
 		return nil, errors.Wrap(types.ErrInvalidOperatorParams, err.Error())
 	}
 
-	// Update the operator params
-	err = k.SaveOperatorParams(ctx, msg.OperatorID, msg.Params)
+	// Update the operator params. If the commission rate has changed, the
+	// change is announced and applied after the notice period
+	change, err := k.UpdateOperatorParams(ctx, msg.OperatorID, msg.Params)
 	if err != nil {
 		return nil, err
 	}
 
-	// Emit the event
+	// Emit the events
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -295,9 +312,96 @@ func (k msgServer) SetOperatorParams(ctx context.Context, msg *types.MsgSetOpera
 		),
 	})
 
+	if change != nil {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAnnounceCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyOperatorID, fmt.Sprintf("%d", change.OperatorID)),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.CommissionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
 	return &types.MsgSetOperatorParamsResponse{}, nil
 }
 
+// GrantOperatorRole defines the rpc method for Msg/GrantOperatorRole
+func (k msgServer) GrantOperatorRole(ctx context.Context, msg *types.MsgGrantOperatorRole) (*types.MsgGrantOperatorRoleResponse, error) {
+	operator, err := k.GetOperator(ctx, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure only the admin can grant the operator roles
+	if operator.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can grant the operator roles")
+	}
+
+	// Grant the role
+	err = k.Keeper.GrantOperatorRole(ctx, msg.OperatorID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantOperatorRole,
+			sdk.NewAttribute(types.AttributeKeyOperatorID, fmt.Sprintf("%d", msg.OperatorID)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgGrantOperatorRoleResponse{}, nil
+}
+
+// RevokeOperatorRole defines the rpc method for Msg/RevokeOperatorRole
+func (k msgServer) RevokeOperatorRole(ctx context.Context, msg *types.MsgRevokeOperatorRole) (*types.MsgRevokeOperatorRoleResponse, error) {
+	operator, err := k.GetOperator(ctx, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure only the admin can revoke the operator roles
+	if operator.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can revoke the operator roles")
+	}
+
+	// Make sure the role has been granted
+	hasRole, err := k.HasOperatorRole(ctx, msg.OperatorID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasRole {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "role %s not granted to %s", msg.Role, msg.Grantee)
+	}
+
+	// Revoke the role
+	err = k.Keeper.RevokeOperatorRole(ctx, msg.OperatorID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeOperatorRole,
+			sdk.NewAttribute(types.AttributeKeyOperatorID, fmt.Sprintf("%d", msg.OperatorID)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRevokeOperatorRoleResponse{}, nil
+}
+
 // UpdateParams defines the rpc method for Msg/UpdateParams
 func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	// Check the authority
@@ -45,6 +45,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RegisterOperator() {
 				err = suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					6*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
@@ -66,6 +67,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RegisterOperator() {
 				err = suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					6*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
@@ -94,6 +96,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RegisterOperator() {
 				err = suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					6*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
@@ -174,6 +177,7 @@ func (suite *KeeperTestSuite) TestMsgServer_RegisterOperator() {
 						sdk.NewCoin("milktia", sdkmath.NewInt(80_000_000)),
 					),
 					6*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
@@ -358,6 +362,63 @@ func (suite *KeeperTestSuite) TestMsgServer_UpdateOperator() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "sender with the metadata role updates the operator successfully",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgUpdateOperator(
+				1,
+				"MilkyWay Updated Operator",
+				"https://milkyway.zone",
+				"https://milkyway.zone/picture",
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgUpdateOperatorResponse{},
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal("MilkyWay Updated Operator", stored.Moniker)
+				suite.Require().Equal("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", stored.Admin)
+			},
+		},
+		{
+			name: "sender with another role returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_COMMISSION)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgUpdateOperator(
+				1,
+				"MilkyWay Updated Operator",
+				"https://milkyway.zone",
+				"https://milkyway.zone/picture",
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "operator updated successfully",
 			store: func(ctx sdk.Context) {
@@ -522,6 +583,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DeactivateOperator() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					6*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
@@ -807,6 +869,9 @@ func (suite *KeeperTestSuite) TestMsgServer_TransferOperatorOwnership() {
 					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
 				))
 				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgTransferOperatorOwnership(
 				1,
@@ -835,6 +900,11 @@ func (suite *KeeperTestSuite) TestMsgServer_TransferOperatorOwnership() {
 					"https://milkyway.com/picture",
 					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
 				), stored)
+
+				// Make sure the roles granted by the previous admin were removed
+				hasRole, err := suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
 			},
 		},
 	}
@@ -1036,7 +1106,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 			},
 			msg: types.NewMsgSetOperatorParams(
 				1,
-				types.NewOperatorParams(sdkmath.LegacyNewDec(-1)),
+				types.NewOperatorParams(sdkmath.LegacyNewDec(-1), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
 				operatorAdmin,
 			),
 			shouldErr: true,
@@ -1057,7 +1127,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 			},
 			msg: types.NewMsgSetOperatorParams(
 				1,
-				types.NewOperatorParams(sdkmath.LegacyMustNewDecFromStr("0.2")),
+				types.NewOperatorParams(sdkmath.LegacyMustNewDecFromStr("0.2"), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
 				"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
 			),
 			shouldErr: true,
@@ -1078,13 +1148,83 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 			},
 			msg: types.NewMsgSetOperatorParams(
 				3,
-				types.NewOperatorParams(sdkmath.LegacyMustNewDecFromStr("0.2")),
+				types.NewOperatorParams(sdkmath.LegacyMustNewDecFromStr("0.2"), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
+				operatorAdmin,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "increasing the max rate fails",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.6"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "increasing the max change rate fails",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+				),
 				operatorAdmin,
 			),
 			shouldErr: true,
 		},
 		{
-			name: "set params works properly",
+			name: "commission change exceeding the max change rate fails",
 			store: func(ctx sdk.Context) {
 				// Register a test operator
 				err := suite.k.CreateOperator(ctx, types.NewOperator(
@@ -1096,12 +1236,194 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 					operatorAdmin,
 				))
 				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.05"),
+				))
+				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgSetOperatorParams(
 				1,
-				types.NewOperatorParams(sdkmath.LegacyMustNewDecFromStr("0.2")),
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.05"),
+				),
 				operatorAdmin,
 			),
+			shouldErr: true,
+		},
+		{
+			name: "commission change within one day from the last one fails",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+
+				// Store a change announced one hour ago that has already been applied
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					ctx.BlockTime().Add(-time.Hour),
+					ctx.BlockTime().Add(-time.Hour),
+				), false)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.15"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "commission change while another one is pending fails",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+
+				// Store a pending change announced two days ago
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					ctx.BlockTime().Add(-48*time.Hour),
+					ctx.BlockTime().Add(time.Hour),
+				), true)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.15"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "max rate lower than the pending commission rate fails",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+
+				// Store a pending change announced two days ago
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					ctx.BlockTime().Add(-48*time.Hour),
+					ctx.BlockTime().Add(time.Hour),
+				), true)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.15"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "commission rate limits are updated immediately",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.5"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.3"),
+					sdkmath.LegacyMustNewDecFromStr("0.05"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: false,
 			expEvents: []sdk.Event{
 				sdk.NewEvent(
 					types.EventTypeSetOperatorParams,
@@ -1109,13 +1431,75 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 					sdk.NewAttribute(sdk.AttributeKeySender, operatorAdmin),
 				),
 			},
-			shouldErr: false,
 			check: func(ctx sdk.Context) {
 				params, err := suite.k.GetOperatorParams(ctx, 1)
-				suite.Require().Nil(err)
+				suite.Require().NoError(err)
 				suite.Require().Equal(types.NewOperatorParams(
-					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyMustNewDecFromStr("0.1"),
+					sdkmath.LegacyMustNewDecFromStr("0.3"),
+					sdkmath.LegacyMustNewDecFromStr("0.05"),
 				), params)
+
+				pendingChange, err := suite.k.GetPendingCommissionChange(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Nil(pendingChange)
+			},
+		},
+		{
+			name: "commission change is announced properly",
+			store: func(ctx sdk.Context) {
+				// Register a test operator
+				err := suite.k.CreateOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					operatorAdmin,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetOperatorParams(
+				1,
+				types.NewOperatorParams(
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					sdkmath.LegacyMustNewDecFromStr("1"),
+					sdkmath.LegacyMustNewDecFromStr("1"),
+				),
+				operatorAdmin,
+			),
+			shouldErr: false,
+			expEvents: []sdk.Event{
+				sdk.NewEvent(
+					types.EventTypeSetOperatorParams,
+					sdk.NewAttribute(types.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(sdk.AttributeKeySender, operatorAdmin),
+				),
+				sdk.NewEvent(
+					types.EventTypeAnnounceCommissionChange,
+					sdk.NewAttribute(types.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeyCommissionRate, "0.200000000000000000"),
+					sdk.NewAttribute(types.AttributeKeyEffectiveTime, "2024-01-02T12:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				// The commission rate should not be changed yet
+				params, err := suite.k.GetOperatorParams(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.DefaultOperatorParams(), params)
+
+				pendingChange, err := suite.k.GetPendingCommissionChange(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().NotNil(pendingChange)
+				suite.Require().Equal(types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				), *pendingChange)
 			},
 		},
 	}
@@ -1126,6 +1510,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 			suite.SetupTest()
 
 			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
 			if tc.store != nil {
 				tc.store(ctx)
 			}
@@ -1149,6 +1534,261 @@ func (suite *KeeperTestSuite) TestMsgServer_SetOperatorParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgServer_GrantOperatorRole() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgGrantOperatorRole
+		shouldErr   bool
+		expResponse *types.MsgGrantOperatorRoleResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "not found operator returns error",
+			msg: types.NewMsgGrantOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non admin sender returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgGrantOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "role granted successfully",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgGrantOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgGrantOperatorRoleResponse{},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeGrantOperatorRole,
+					sdk.NewAttribute(types.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyRole, types.OPERATOR_ROLE_METADATA.String()),
+					sdk.NewAttribute(sdk.AttributeKeySender, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
+
+				// Make sure the other roles are not affected
+				hasRole, err = suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_COMMISSION)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.GrantOperatorRole(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RevokeOperatorRole() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgRevokeOperatorRole
+		shouldErr   bool
+		expResponse *types.MsgRevokeOperatorRoleResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "not found operator returns error",
+			msg: types.NewMsgRevokeOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non admin sender returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "not granted role returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "role revoked successfully",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_COMMISSION)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeOperatorRole(
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				types.OPERATOR_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgRevokeOperatorRoleResponse{},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRevokeOperatorRole,
+					sdk.NewAttribute(types.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyRole, types.OPERATOR_ROLE_METADATA.String()),
+					sdk.NewAttribute(sdk.AttributeKeySender, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
+
+				// Make sure the other roles are not affected
+				hasRole, err = suite.k.HasOperatorRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.OPERATOR_ROLE_COMMISSION)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.RevokeOperatorRole(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgServer_UpdateParams() {
 	testCases := []struct {
 		name        string
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -70,7 +71,8 @@ func (k *Keeper) SaveOperator(ctx context.Context, operator types.Operator) erro
 	return k.operatorAddressSet.Set(ctx, operator.Address)
 }
 
-// StartOperatorInactivation starts the inactivation process for the operator with the given ID
+// StartOperatorInactivation starts the inactivation process for the operator with the given ID.
+// Jailed operators can be inactivated as well, so that they can eventually be deleted
 func (k *Keeper) StartOperatorInactivation(ctx context.Context, operator types.Operator) error {
 	// Make sure the operator is not already inactive or inactivating
 	if operator.Status == types.OPERATOR_STATUS_INACTIVATING || operator.Status == types.OPERATOR_STATUS_INACTIVE {
@@ -100,6 +102,16 @@ func (k *Keeper) ReactivateInactiveOperator(ctx context.Context, operator types.
 		return types.ErrOperatorNotInactive
 	}
 
+	// Make sure the operator has never been jailed
+	jailed, err := k.IsOperatorJailed(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
+	if jailed {
+		return types.ErrOperatorJailed
+	}
+
 	// Update the operator status
 	operator.Status = types.OPERATOR_STATUS_ACTIVE
 	if err := k.SaveOperator(ctx, operator); err != nil {
@@ -129,9 +141,70 @@ func (k *Keeper) DeleteOperator(ctx context.Context, operator types.Operator) er
 		return err
 	}
 
+	// Delete the roles granted by the operator admin
+	err = k.DeleteOperatorRoleGrants(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
+	err = k.jailedOperators.Remove(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
 	return k.operatorAddressSet.Remove(ctx, operator.Address)
 }
 
+// JailOperator permanently jails the given operator. If the operator is
+// inactivating, it is removed from the inactivating queue.
+// A jailed operator can still be inactivated and then deleted, but it can
+// never be reactivated
+func (k *Keeper) JailOperator(ctx context.Context, operator types.Operator) error {
+	// Make sure the operator is not already jailed
+	jailed, err := k.IsOperatorJailed(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
+	if jailed {
+		return types.ErrOperatorJailed
+	}
+
+	err = k.jailedOperators.Set(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the operator from the inactivating queue
+	if operator.Status == types.OPERATOR_STATUS_INACTIVATING {
+		err = k.removeFromInactivatingQueue(ctx, operator.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Update the operator status
+	operator.Status = types.OPERATOR_STATUS_JAILED
+	return k.SaveOperator(ctx, operator)
+}
+
+// IsOperatorJailed returns true if the operator with the given ID has been
+// permanently jailed, even if it has been inactivated afterwards
+func (k *Keeper) IsOperatorJailed(ctx context.Context, operatorID uint32) (bool, error) {
+	return k.jailedOperators.Has(ctx, operatorID)
+}
+
+// GetJailedOperatorsIDs returns the IDs of all the permanently jailed operators
+func (k *Keeper) GetJailedOperatorsIDs(ctx context.Context) ([]uint32, error) {
+	iterator, err := k.jailedOperators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Keys()
+}
+
 // CompleteOperatorInactivation completes the inactivation process for the operator with the given ID
 func (k *Keeper) CompleteOperatorInactivation(ctx context.Context, operator types.Operator) error {
 	// Update the operator status
@@ -151,6 +224,12 @@ func (k *Keeper) CompleteOperatorInactivation(ctx context.Context, operator type
 		return err
 	}
 
+	// Remove the commission change as well, so that it's not applied later
+	err = k.DeleteCommissionChange(ctx, operator.ID)
+	if err != nil {
+		return err
+	}
+
 	// Call the hook
 	return k.AfterOperatorInactivatingCompleted(ctx, operator.ID)
 }
@@ -172,6 +251,16 @@ func (k *Keeper) GetOperatorParams(ctx context.Context, operatorID uint32) (type
 			return types.OperatorParams{}, err
 		}
 	}
+
+	// Params stored before the introduction of the commission rate limits
+	// don't have any limit set
+	if params.MaxRate.IsNil() {
+		params.MaxRate = math.LegacyOneDec()
+	}
+	if params.MaxChangeRate.IsNil() {
+		params.MaxChangeRate = math.LegacyOneDec()
+	}
+
 	return params, nil
 }
 
@@ -127,6 +127,7 @@ func (suite *KeeperTestSuite) TestKeeper_CreateOperator() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					24*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
@@ -369,6 +370,7 @@ func (suite *KeeperTestSuite) TestKeeper_StartOperatorInactivation() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					12*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
@@ -388,6 +390,7 @@ func (suite *KeeperTestSuite) TestKeeper_StartOperatorInactivation() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					12*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
@@ -401,6 +404,49 @@ func (suite *KeeperTestSuite) TestKeeper_StartOperatorInactivation() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "jailed operator inactivation is started properly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.NewParams(
+					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
+					12*time.Hour,
+					24*time.Hour,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.JailOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			operator: types.NewOperator(
+				1,
+				types.OPERATOR_STATUS_JAILED,
+				"MilkyWay Operator",
+				"https://milkyway.com",
+				"https://milkyway.com/picture",
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.OPERATOR_STATUS_INACTIVATING, stored.Status)
+
+				// Make sure the operator is still tracked as jailed
+				jailed, err := suite.k.IsOperatorJailed(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(jailed)
+			},
+		},
 		{
 			name: "operator inactivation is started properly",
 			setupCtx: func(ctx sdk.Context) sdk.Context {
@@ -410,6 +456,7 @@ func (suite *KeeperTestSuite) TestKeeper_StartOperatorInactivation() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					12*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
@@ -497,13 +544,23 @@ func (suite *KeeperTestSuite) TestKeeper_CompleteOperatorInactivation() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 					12*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 
 				err = suite.k.SaveOperatorParams(ctx, 1, types.NewOperatorParams(
 					sdkmath.LegacyNewDec(100),
+					sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(),
 				))
 				suite.Require().NoError(err)
+
+				err = suite.k.SaveCommissionChange(ctx, types.NewCommissionChange(
+					1,
+					sdkmath.LegacyMustNewDecFromStr("0.2"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				), true)
+				suite.Require().NoError(err)
 			},
 			operator: types.NewOperator(
 				1,
@@ -535,6 +592,15 @@ func (suite *KeeperTestSuite) TestKeeper_CompleteOperatorInactivation() {
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.DefaultOperatorParams(), operatorParams)
 
+				// Make sure the commission change is no longer there
+				_, found, err := suite.k.GetCommissionChange(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				pendingChange, err := suite.k.GetPendingCommissionChange(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Nil(pendingChange)
+
 				// Make sure the hook has been called
 				suite.Require().True(suite.hooks.CalledMap["AfterOperatorInactivatingCompleted"])
 			},
@@ -571,6 +637,152 @@ func (suite *KeeperTestSuite) TestKeeper_CompleteOperatorInactivation() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_JailOperator() {
+	testCases := []struct {
+		name      string
+		setupCtx  func(ctx sdk.Context) sdk.Context
+		store     func(ctx sdk.Context)
+		operator  types.Operator
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "jailed operator returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.JailOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			operator: types.NewOperator(
+				1,
+				types.OPERATOR_STATUS_JAILED,
+				"MilkyWay Operator",
+				"https://milkyway.com",
+				"https://milkyway.com/picture",
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "jailed operator that has been inactivated returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.JailOperator(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			operator: types.NewOperator(
+				1,
+				types.OPERATOR_STATUS_INACTIVE,
+				"MilkyWay Operator",
+				"https://milkyway.com",
+				"https://milkyway.com/picture",
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "active operator is jailed properly",
+			operator: types.NewOperator(
+				1,
+				types.OPERATOR_STATUS_ACTIVE,
+				"MilkyWay Operator",
+				"https://milkyway.com",
+				"https://milkyway.com/picture",
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.OPERATOR_STATUS_JAILED, stored.Status)
+
+				jailed, err := suite.k.IsOperatorJailed(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(jailed)
+			},
+		},
+		{
+			name: "inactivating operator is jailed and removed from the inactivating queue",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.NewParams(
+					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
+					12*time.Hour,
+					24*time.Hour,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.StartOperatorInactivation(ctx, types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+			},
+			operator: types.NewOperator(
+				1,
+				types.OPERATOR_STATUS_INACTIVATING,
+				"MilkyWay Operator",
+				"https://milkyway.com",
+				"https://milkyway.com/picture",
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.OPERATOR_STATUS_JAILED, stored.Status)
+
+				inactivatingQueue, _ := suite.k.GetInactivatingOperators(ctx)
+				suite.Require().Len(inactivatingQueue, 0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.setupCtx != nil {
+				ctx = tc.setupCtx(ctx)
+			}
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.JailOperator(ctx, tc.operator)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_ReactivateInactiveOperator() {
 	testCases := []struct {
 		name       string
@@ -611,6 +823,31 @@ func (suite *KeeperTestSuite) TestKeeper_ReactivateInactiveOperator() {
 			operatorID: 1,
 			shouldErr:  true,
 		},
+		{
+			name: "reactivate jailed inactive operator fails",
+			store: func(ctx sdk.Context) {
+				operator := types.NewOperator(
+					1,
+					types.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				)
+				err := suite.k.CreateOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				err = suite.k.JailOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				// Simulate the completion of the operator inactivation
+				operator.Status = types.OPERATOR_STATUS_INACTIVE
+				err = suite.k.SaveOperator(ctx, operator)
+				suite.Require().NoError(err)
+			},
+			operatorID: 1,
+			shouldErr:  true,
+		},
 		{
 			name: "reactivate inactive operator works properly",
 			store: func(ctx sdk.Context) {
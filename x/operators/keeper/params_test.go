@@ -22,6 +22,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 			params: types.NewParams(
 				sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
 				3*time.Hour,
+				24*time.Hour,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -30,6 +31,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 				suite.Require().Equal(types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
 					3*time.Hour,
+					24*time.Hour,
 				), stored)
 			},
 		},
@@ -39,12 +41,14 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
 					3*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
 			params: types.NewParams(
 				sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(2000))),
 				24*time.Hour,
+				24*time.Hour,
 			),
 			shouldErr: false,
 			check: func(ctx sdk.Context) {
@@ -53,6 +57,7 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 				suite.Require().Equal(types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(2000))),
 					24*time.Hour,
+					24*time.Hour,
 				), stored)
 			},
 		},
@@ -97,6 +102,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 				err := suite.k.SetParams(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
 					3*time.Hour,
+					24*time.Hour,
 				))
 				suite.Require().NoError(err)
 			},
@@ -104,6 +110,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 			expParams: types.NewParams(
 				sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
 				3*time.Hour,
+				24*time.Hour,
 			),
 		},
 	}
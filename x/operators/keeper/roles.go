@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
+)
+
+// GrantOperatorRole grants the given role of the operator with the given ID
+// to the provided grantee
+func (k *Keeper) GrantOperatorRole(ctx context.Context, operatorID uint32, grantee string, role types.OperatorRole) error {
+	return k.roleGrants.Set(ctx, collections.Join3(operatorID, grantee, int32(role)))
+}
+
+// RevokeOperatorRole revokes the given role of the operator with the given ID
+// from the provided grantee
+func (k *Keeper) RevokeOperatorRole(ctx context.Context, operatorID uint32, grantee string, role types.OperatorRole) error {
+	return k.roleGrants.Remove(ctx, collections.Join3(operatorID, grantee, int32(role)))
+}
+
+// HasOperatorRole returns true if the given role of the operator with the
+// given ID has been granted to the provided grantee
+func (k *Keeper) HasOperatorRole(ctx context.Context, operatorID uint32, grantee string, role types.OperatorRole) (bool, error) {
+	return k.roleGrants.Has(ctx, collections.Join3(operatorID, grantee, int32(role)))
+}
+
+// HasOperatorPermission returns true if the given address is allowed to
+// perform the actions associated with the given role on behalf of the
+// operator. The operator admin is always allowed to perform any action
+func (k *Keeper) HasOperatorPermission(ctx context.Context, operator types.Operator, address string, role types.OperatorRole) (bool, error) {
+	if operator.Admin == address {
+		return true, nil
+	}
+
+	return k.HasOperatorRole(ctx, operator.ID, address, role)
+}
+
+// IterateOperatorRoleGrants iterates over all the role grants of the operator
+// with the given ID and performs the given callback function
+func (k *Keeper) IterateOperatorRoleGrants(
+	ctx context.Context, operatorID uint32, cb func(grant types.OperatorRoleGrant) (stop bool, err error),
+) error {
+	ranger := collections.NewPrefixedTripleRange[uint32, string, int32](operatorID)
+	return k.roleGrants.Walk(ctx, ranger, func(key collections.Triple[uint32, string, int32]) (stop bool, err error) {
+		return cb(types.NewOperatorRoleGrant(key.K1(), key.K2(), types.OperatorRole(key.K3())))
+	})
+}
+
+// GetAllOperatorRoleGrants returns all the role grants stored in the KVStore
+func (k *Keeper) GetAllOperatorRoleGrants(ctx context.Context) ([]types.OperatorRoleGrant, error) {
+	var grants []types.OperatorRoleGrant
+	err := k.roleGrants.Walk(ctx, nil, func(key collections.Triple[uint32, string, int32]) (stop bool, err error) {
+		grants = append(grants, types.NewOperatorRoleGrant(key.K1(), key.K2(), types.OperatorRole(key.K3())))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// DeleteOperatorRoleGrants removes all the role grants of the operator with
+// the given ID
+func (k *Keeper) DeleteOperatorRoleGrants(ctx context.Context, operatorID uint32) error {
+	ranger := collections.NewPrefixedTripleRange[uint32, string, int32](operatorID)
+	return k.roleGrants.Clear(ctx, ranger)
+}
@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
+)
+
+type Keeper interface {
+	GetOperators(ctx context.Context) ([]types.Operator, error)
+	SaveOperator(ctx context.Context, operator types.Operator) error
+	GetParams(ctx context.Context) (types.Params, error)
+	SetParams(ctx context.Context, params types.Params) error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migrations include:
+// - Populate the operators status and admin indexes by storing again all the existing operators
+// - Set the default value of the commission change notice period param
+func MigrateStore(ctx sdk.Context, k Keeper) error {
+	err := indexOperators(ctx, k)
+	if err != nil {
+		return err
+	}
+
+	return setCommissionChangeNoticePeriod(ctx, k)
+}
+
+// indexOperators stores again all the existing operators so that the status and admin
+// indexes are populated
+func indexOperators(ctx sdk.Context, k Keeper) error {
+	operators, err := k.GetOperators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, operator := range operators {
+		err = k.SaveOperator(ctx, operator)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setCommissionChangeNoticePeriod sets the commission change notice period param, which
+// did not exist in v1, to its default value
+func setCommissionChangeNoticePeriod(ctx sdk.Context, k Keeper) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.CommissionChangeNoticePeriod = types.DefaultCommissionChangeNoticePeriod
+	return k.SetParams(ctx, params)
+}
@@ -0,0 +1,40 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/milkyway-labs/milkyway/v12/x/operators/migrations/v2"
+	"github.com/milkyway-labs/milkyway/v12/x/operators/testutils"
+	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
+)
+
+func TestMigrateStore_setCommissionChangeNoticePeriod(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+	ctx, _ := testData.Context.CacheContext()
+
+	// Store the params as they were stored before the migration
+	err := testData.Keeper.SetParams(ctx, types.Params{
+		OperatorRegistrationFee: sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+		DeactivationTime:        7 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	err = v2.MigrateStore(ctx, testData.Keeper)
+	require.NoError(t, err)
+
+	params, err := testData.Keeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// Make sure the existing params have been kept
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))), params.OperatorRegistrationFee)
+	require.Equal(t, 7*24*time.Hour, params.DeactivationTime)
+
+	// Make sure the new param has been set to its default value
+	require.Equal(t, types.DefaultCommissionChangeNoticePeriod, params.CommissionChangeNoticePeriod)
+	require.NoError(t, params.Validate())
+}
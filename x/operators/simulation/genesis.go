@@ -55,7 +55,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	params := RandomParams(simState.Rand, simState.BondDenom)
 
 	// Set the genesis state inside the simulation
-	genesis := types.NewGenesisState(nextOperatorID, operators, operatorParams, unbondingOperators, params)
+	genesis := types.NewGenesisState(nextOperatorID, operators, operatorParams, unbondingOperators, nil, nil, nil, params)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
 }
 
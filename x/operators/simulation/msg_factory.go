@@ -369,8 +369,25 @@ func SimulateSetOperatorParams(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "could not get operator"), nil, nil
 		}
 
-		// Generate a new data
-		newParams := RandomOperatorParams(r)
+		// Generate new params that respect the current commission rate limits
+		currentParams, err := k.GetOperatorParams(ctx, operator.ID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "could not get operator params"), nil, nil
+		}
+		newParams := RandomOperatorParamsUpdate(r, currentParams)
+
+		// Keep the current commission rate if it cannot be changed yet
+		lastChange, found, err := k.GetCommissionChange(ctx, operator.ID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "could not get commission change"), nil, nil
+		}
+		pendingChange, err := k.GetPendingCommissionChange(ctx, operator.ID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "could not get commission change"), nil, nil
+		}
+		if pendingChange != nil || (found && ctx.BlockTime().Before(lastChange.AnnounceTime.Add(types.CommissionChangeInterval))) {
+			newParams.CommissionRate = currentParams.CommissionRate
+		}
 
 		// Get the admin account that should sign the transaction
 		adminAddress, err := sdk.AccAddressFromBech32(operator.Admin)
@@ -37,16 +37,32 @@ func randomOperatorStatus(r *rand.Rand) types.OperatorStatus {
 
 // RandomOperatorParams returns random operator params
 func RandomOperatorParams(r *rand.Rand) types.OperatorParams {
+	maxRate := sdkmath.LegacyNewDecWithPrec(int64(r.Intn(100)+1), 2)
 	return types.NewOperatorParams(
-		sdkmath.LegacyNewDecWithPrec(int64(r.Intn(100)), 2),
+		sdkmath.LegacyNewDecWithPrec(int64(r.Intn(int(maxRate.MulInt64(100).TruncateInt64())+1)), 2),
+		maxRate,
+		sdkmath.LegacyNewDecWithPrec(int64(r.Intn(int(maxRate.MulInt64(100).TruncateInt64())+1)), 2),
 	)
 }
 
+// RandomOperatorParamsUpdate returns random operator params that respect the
+// commission rate limits of the given params
+func RandomOperatorParamsUpdate(r *rand.Rand, params types.OperatorParams) types.OperatorParams {
+	// Change the commission rate by a random amount within the max change rate
+	change := params.MaxChangeRate.MulInt64(int64(r.Intn(201) - 100)).QuoInt64(100)
+	commissionRate := params.CommissionRate.Add(change)
+	commissionRate = sdkmath.LegacyMaxDec(commissionRate, sdkmath.LegacyZeroDec())
+	commissionRate = sdkmath.LegacyMinDec(commissionRate, params.MaxRate)
+
+	return types.NewOperatorParams(commissionRate, params.MaxRate, params.MaxChangeRate)
+}
+
 // RandomParams returns random params
 func RandomParams(r *rand.Rand, stakeDenom string) types.Params {
 	return types.NewParams(
 		sdk.NewCoins(simtesting.RandomCoin(r, stakeDenom, 10)),
 		simtesting.RandomDuration(r, 5*time.Minute, 3*24*time.Hour),
+		simtesting.RandomDuration(r, 5*time.Minute, 3*24*time.Hour),
 	)
 }
 
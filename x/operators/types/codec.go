@@ -16,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeactivateOperator{}, "milkyway/MsgDeactivateOperator")
 	legacy.RegisterAminoMsg(cdc, &MsgReactivateOperator{}, "milkyway/MsgReactivateOperator")
 	legacy.RegisterAminoMsg(cdc, &MsgDeleteOperator{}, "milkyway/MsgDeleteOperator")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantOperatorRole{}, "milkyway/MsgGrantOperatorRole")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperatorRole{}, "milkyway/MsgRevokeOperatorRole")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "milkyway/operators/MsgUpdateParams")
 }
 
@@ -27,6 +29,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeactivateOperator{},
 		&MsgReactivateOperator{},
 		&MsgDeleteOperator{},
+		&MsgGrantOperatorRole{},
+		&MsgRevokeOperatorRole{},
 		&MsgUpdateParams{},
 	)
 
@@ -13,4 +13,6 @@ var (
 	ErrOperatorNotActive       = errors.Register(ModuleName, 4, "operator not active")
 	ErrInvalidOperatorParams   = errors.Register(ModuleName, 5, "invalid operator params")
 	ErrOperatorNotInactive     = errors.Register(ModuleName, 6, "operator not inactive")
+	ErrOperatorJailed          = errors.Register(ModuleName, 7, "operator jailed")
+	ErrInvalidCommissionChange = errors.Register(ModuleName, 8, "invalid commission change")
 )
@@ -11,7 +11,15 @@ const (
 	EventTypeTransferOperatorOwnership    = "transfer_operator_ownership"
 	EventTypeSetOperatorParams            = "set_operator_params"
 	EventTypeDeleteOperator               = "delete_operator"
+	EventTypeAnnounceCommissionChange     = "announce_commission_change"
+	EventTypeApplyCommissionChange        = "apply_commission_change"
+	EventTypeGrantOperatorRole            = "grant_operator_role"
+	EventTypeRevokeOperatorRole           = "revoke_operator_role"
 
-	AttributeKeyOperatorID = "operator_id"
-	AttributeKeyNewAdmin   = "new_admin"
+	AttributeKeyOperatorID     = "operator_id"
+	AttributeKeyNewAdmin       = "new_admin"
+	AttributeKeyCommissionRate = "commission_rate"
+	AttributeKeyEffectiveTime  = "effective_time"
+	AttributeKeyGrantee        = "grantee"
+	AttributeKeyRole           = "role"
 )
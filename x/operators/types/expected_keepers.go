@@ -15,3 +15,7 @@ type AccountKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+type RestakingKeeper interface {
+	HasOperatorJoinedService(ctx context.Context, operatorID uint32, serviceID uint32) (bool, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/milkyway-labs/milkyway/v12/utils"
@@ -12,6 +13,9 @@ func NewGenesisState(
 	operators []Operator,
 	operatorParams []OperatorParamsRecord,
 	unbondingOperators []UnbondingOperator,
+	commissionChanges []CommissionChange,
+	roleGrants []OperatorRoleGrant,
+	jailedOperatorsIDs []uint32,
 	params Params,
 ) *GenesisState {
 	return &GenesisState{
@@ -19,13 +23,16 @@ func NewGenesisState(
 		Operators:          operators,
 		OperatorsParams:    operatorParams,
 		UnbondingOperators: unbondingOperators,
+		CommissionChanges:  commissionChanges,
+		RoleGrants:         roleGrants,
+		JailedOperatorsIDs: jailedOperatorsIDs,
 		Params:             params,
 	}
 }
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(1, nil, nil, nil, DefaultParams())
+	return NewGenesisState(1, nil, nil, nil, nil, nil, nil, DefaultParams())
 }
 
 // Validate checks that the genesis state is valid.
@@ -86,6 +93,55 @@ func (data *GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated commission changes
+	if duplicate := findDuplicateCommissionChanges(data.CommissionChanges); duplicate != nil {
+		return fmt.Errorf("duplicated commission change for operator: %d", duplicate.OperatorID)
+	}
+
+	// Validate the commission changes
+	for _, change := range data.CommissionChanges {
+		err := change.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid commission change for operator %d: %s", change.OperatorID, err)
+		}
+	}
+
+	// Check for duplicated role grants
+	if duplicate := findDuplicateRoleGrants(data.RoleGrants); duplicate != nil {
+		return fmt.Errorf("duplicated role grant for operator %d: %s", duplicate.OperatorID, duplicate.Role)
+	}
+
+	// Validate the role grants
+	for _, grant := range data.RoleGrants {
+		err := grant.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid role grant for operator %d: %s", grant.OperatorID, err)
+		}
+	}
+
+	// Check for duplicated jailed operators
+	if duplicate := utils.FindDuplicate(data.JailedOperatorsIDs); duplicate != nil {
+		return fmt.Errorf("duplicated jailed operator: %d", *duplicate)
+	}
+
+	// Make sure the jailed operators exist
+	for _, operatorID := range data.JailedOperatorsIDs {
+		_, found := utils.Find(data.Operators, func(operator Operator) bool {
+			return operator.ID == operatorID
+		})
+
+		if !found {
+			return fmt.Errorf("jailed operator with id %d not found", operatorID)
+		}
+	}
+
+	// Make sure the operators having the jailed status are tracked as jailed
+	for _, operator := range data.Operators {
+		if operator.Status == OPERATOR_STATUS_JAILED && !slices.Contains(data.JailedOperatorsIDs, operator.ID) {
+			return fmt.Errorf("operator with id %d is jailed but not included in the jailed operators", operator.ID)
+		}
+	}
+
 	// Validate params
 	err := data.Params.Validate()
 	if err != nil {
@@ -119,6 +175,22 @@ func findDuplicateUnbondingOperators(operators []UnbondingOperator) *UnbondingOp
 	})
 }
 
+// findDuplicateCommissionChanges returns the first duplicated commission change in the slice.
+// If no duplicates are found, it returns nil instead.
+func findDuplicateCommissionChanges(changes []CommissionChange) *CommissionChange {
+	return utils.FindDuplicateFunc(changes, func(a, b CommissionChange) bool {
+		return a.OperatorID == b.OperatorID
+	})
+}
+
+// findDuplicateRoleGrants returns the first duplicated role grant in the slice.
+// If no duplicates are found, it returns nil instead.
+func findDuplicateRoleGrants(grants []OperatorRoleGrant) *OperatorRoleGrant {
+	return utils.FindDuplicateFunc(grants, func(a, b OperatorRoleGrant) bool {
+		return a.OperatorID == b.OperatorID && a.Grantee == b.Grantee && a.Role == b.Role
+	})
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewUnbondingOperator creates a new UnbondingOperator instance.
@@ -41,6 +41,15 @@ type GenesisState struct {
 	UnbondingOperators []UnbondingOperator `protobuf:"bytes,4,rep,name=unbonding_operators,json=unbondingOperators,proto3" json:"unbonding_operators" yaml:"unbonding_operators"`
 	// OperatorsParams defines the list of operators params.
 	OperatorsParams []OperatorParamsRecord `protobuf:"bytes,5,rep,name=operators_params,json=operatorsParams,proto3" json:"operators_params" yaml:"operators_params"`
+	// CommissionChanges defines the list of the last commission changes
+	// announced by the operators.
+	CommissionChanges []CommissionChange `protobuf:"bytes,6,rep,name=commission_changes,json=commissionChanges,proto3" json:"commission_changes" yaml:"commission_changes"`
+	// RoleGrants defines the list of roles granted by the operators admins.
+	RoleGrants []OperatorRoleGrant `protobuf:"bytes,7,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants" yaml:"role_grants"`
+	// JailedOperatorsIDs defines the IDs of the operators that have been
+	// permanently jailed. They are kept even after the operators have been
+	// inactivated, so that they can never be reactivated.
+	JailedOperatorsIDs []uint32 `protobuf:"varint,8,rep,packed,name=jailed_operators_ids,json=jailedOperatorsIds,proto3" json:"jailed_operators_ids,omitempty" yaml:"jailed_operators_ids"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -111,6 +120,27 @@ func (m *GenesisState) GetOperatorsParams() []OperatorParamsRecord {
 	return nil
 }
 
+func (m *GenesisState) GetCommissionChanges() []CommissionChange {
+	if m != nil {
+		return m.CommissionChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleGrants() []OperatorRoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailedOperatorsIDs() []uint32 {
+	if m != nil {
+		return m.JailedOperatorsIDs
+	}
+	return nil
+}
+
 // UnbondingOperator contains the data about an operator that is currently being
 // unbonded.
 type UnbondingOperator struct {
@@ -235,39 +265,47 @@ func init() {
 }
 
 var fileDescriptor_2071f056fdcb6ee8 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcb, 0x8e, 0xd3, 0x30,
-	0x14, 0x86, 0x6b, 0x3a, 0x8c, 0xc0, 0x85, 0x52, 0xcc, 0xa0, 0x29, 0x95, 0x48, 0x2a, 0x23, 0xa4,
-	0x4a, 0x08, 0x5b, 0x2d, 0x0b, 0x24, 0x60, 0x95, 0x41, 0x42, 0x6c, 0xb8, 0x04, 0x10, 0x12, 0x9b,
-	0x90, 0x34, 0x26, 0x44, 0x24, 0x71, 0x94, 0xb8, 0x9d, 0x76, 0xc1, 0x1b, 0xb0, 0x98, 0xa7, 0xe0,
-	0x59, 0x66, 0x39, 0x4b, 0xc4, 0xa2, 0xa0, 0xf4, 0x0d, 0x78, 0x02, 0x94, 0xd8, 0x71, 0xcb, 0xd0,
-	0x72, 0xd9, 0xe5, 0x24, 0xdf, 0x39, 0xff, 0xb9, 0xfc, 0x81, 0x37, 0xe2, 0x30, 0xfa, 0x30, 0x3f,
-	0x74, 0xe7, 0x94, 0xa7, 0x2c, 0x73, 0x05, 0xcf, 0x72, 0x3a, 0x1d, 0xd2, 0x80, 0x25, 0x2c, 0x0f,
-	0x73, 0x92, 0x66, 0x5c, 0x70, 0x74, 0xb5, 0x86, 0x88, 0x86, 0xc8, 0x74, 0xd8, 0xdb, 0x0b, 0x78,
-	0xc0, 0x2b, 0x82, 0x96, 0x4f, 0x12, 0xee, 0x99, 0x01, 0xe7, 0x41, 0xc4, 0x68, 0x15, 0x79, 0x93,
-	0x77, 0x54, 0x84, 0x31, 0xcb, 0x85, 0x1b, 0xa7, 0x0a, 0xc0, 0x9b, 0x25, 0x63, 0xee, 0xb3, 0x28,
-	0xff, 0x33, 0x93, 0xba, 0x99, 0x1b, 0x2b, 0x06, 0x7f, 0x6d, 0xc2, 0x0b, 0x8f, 0x64, 0x9f, 0x2f,
-	0x84, 0x2b, 0x18, 0xba, 0x0f, 0x77, 0x25, 0xd0, 0x05, 0x7d, 0x30, 0x68, 0x8d, 0xae, 0x93, 0x8d,
-	0x7d, 0x93, 0x67, 0x15, 0x64, 0xed, 0x1c, 0x2f, 0xcc, 0x86, 0xad, 0x52, 0xd0, 0x03, 0xd8, 0x49,
-	0xd8, 0x4c, 0x38, 0x35, 0xe9, 0x84, 0x7e, 0xf7, 0x4c, 0x1f, 0x0c, 0x2e, 0x5a, 0xa8, 0x58, 0x98,
-	0xed, 0x27, 0x6c, 0x26, 0x9e, 0xaa, 0x4f, 0x8f, 0x1f, 0xda, 0xed, 0x64, 0x3d, 0xf6, 0xd1, 0x6b,
-	0x78, 0x5e, 0x4b, 0x74, 0x9b, 0xfd, 0xe6, 0xa0, 0x35, 0x32, 0xb7, 0xa8, 0xd7, 0x59, 0x56, 0xb7,
-	0xd4, 0xff, 0xb1, 0x30, 0x3b, 0x73, 0x37, 0x8e, 0xee, 0x61, 0x0d, 0x61, 0x7b, 0x55, 0x0b, 0x7d,
-	0x84, 0x57, 0x26, 0x89, 0xc7, 0x13, 0x3f, 0x4c, 0x02, 0x67, 0x25, 0xb1, 0x53, 0x49, 0x0c, 0xb6,
-	0x48, 0xbc, 0xaa, 0x33, 0xb4, 0x16, 0x56, 0x5a, 0x3d, 0xa9, 0xb5, 0xa1, 0x24, 0xb6, 0xd1, 0xe4,
-	0x74, 0x5a, 0x8e, 0x0e, 0x61, 0x47, 0x13, 0x8e, 0x5a, 0xee, 0xd9, 0x4a, 0xfb, 0xd6, 0x5f, 0xc6,
-	0x93, 0x4b, 0xb6, 0xd9, 0x98, 0x67, 0xbe, 0x65, 0x2a, 0xf9, 0xfd, 0x53, 0xa3, 0xaa, 0x92, 0xd8,
-	0xbe, 0xa4, 0x5f, 0xc9, 0x3c, 0xfc, 0x19, 0xc0, 0xcb, 0xbf, 0x8d, 0x81, 0x28, 0x6c, 0xad, 0xdf,
-	0x07, 0x54, 0xf7, 0x69, 0x17, 0x0b, 0x13, 0xae, 0xdd, 0x06, 0xf2, 0xd5, 0x5d, 0xde, 0xc2, 0x6b,
-	0xab, 0x59, 0xc7, 0x3c, 0x4e, 0x23, 0x26, 0x42, 0x9e, 0x38, 0xa5, 0x27, 0xab, 0xf3, 0xb6, 0x46,
-	0x3d, 0x22, 0x0d, 0x4b, 0x6a, 0xc3, 0x92, 0x97, 0xb5, 0x61, 0xad, 0x73, 0x65, 0xdf, 0x47, 0xdf,
-	0x4c, 0x60, 0xef, 0xeb, 0x32, 0x07, 0xba, 0x4a, 0xc9, 0xe1, 0x4f, 0x00, 0xee, 0x6d, 0x9a, 0xf9,
-	0xff, 0x7b, 0x3d, 0xd0, 0xf6, 0x95, 0x8d, 0xdd, 0xfc, 0xa7, 0x0d, 0xff, 0x6a, 0x63, 0xeb, 0xf9,
-	0x71, 0x61, 0x80, 0x93, 0xc2, 0x00, 0xdf, 0x0b, 0x03, 0x1c, 0x2d, 0x8d, 0xc6, 0xc9, 0xd2, 0x68,
-	0x7c, 0x59, 0x1a, 0x8d, 0x37, 0x77, 0x83, 0x50, 0xbc, 0x9f, 0x78, 0x64, 0xcc, 0x63, 0x5a, 0x17,
-	0xbe, 0x1d, 0xb9, 0x5e, 0xae, 0x23, 0x3a, 0x1d, 0x8e, 0xe8, 0x6c, 0xed, 0x8f, 0x13, 0xf3, 0x94,
-	0xe5, 0xde, 0x6e, 0xb5, 0x98, 0x3b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x26, 0xe0, 0x27, 0x42,
-	0x2b, 0x04, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0xfb, 0x0f, 0x65, 0x42, 0x43, 0x3a, 0x04, 0xd5, 0x0d, 0xc2, 0x0e, 0x83,
+	0x10, 0x91, 0x10, 0xb6, 0x12, 0x84, 0x90, 0x80, 0x95, 0x53, 0xa9, 0x2a, 0x0b, 0x3e, 0x0c, 0x08,
+	0x89, 0x8d, 0x71, 0xec, 0xc1, 0x75, 0xb1, 0x3d, 0x96, 0x67, 0x92, 0x26, 0x0b, 0xde, 0x80, 0x45,
+	0x5f, 0x02, 0x9e, 0xa5, 0xcb, 0x2e, 0x59, 0x19, 0xe4, 0xbc, 0x41, 0x9f, 0x00, 0xf9, 0x6b, 0x12,
+	0xd2, 0xa4, 0xc0, 0x2e, 0x13, 0x9f, 0x73, 0x7e, 0x33, 0x77, 0xee, 0x1d, 0x70, 0xdb, 0x77, 0xbd,
+	0x4f, 0x93, 0x23, 0x73, 0xa2, 0x92, 0x10, 0x47, 0x26, 0x23, 0x11, 0x55, 0x47, 0x5d, 0xd5, 0xc1,
+	0x01, 0xa6, 0x2e, 0x55, 0xc2, 0x88, 0x30, 0x02, 0xaf, 0x97, 0x22, 0x85, 0x8b, 0x94, 0x51, 0xb7,
+	0xd5, 0x74, 0x88, 0x43, 0x32, 0x85, 0x9a, 0xfe, 0xca, 0xc5, 0x2d, 0xd9, 0x21, 0xc4, 0xf1, 0xb0,
+	0x9a, 0xad, 0x06, 0xc3, 0x8f, 0x2a, 0x73, 0x7d, 0x4c, 0x99, 0xe9, 0x87, 0x85, 0x00, 0x2d, 0x47,
+	0xfa, 0xc4, 0xc6, 0x1e, 0xbd, 0x58, 0x13, 0x9a, 0x91, 0xe9, 0x17, 0x1a, 0xf4, 0xb5, 0x0a, 0xae,
+	0xec, 0xe5, 0xfb, 0x7c, 0xcd, 0x4c, 0x86, 0xe1, 0x13, 0x50, 0xcd, 0x05, 0xa2, 0xd0, 0x16, 0x3a,
+	0xb5, 0xde, 0x4d, 0x65, 0xe9, 0xbe, 0x95, 0x97, 0x99, 0x48, 0x5b, 0x3f, 0x89, 0xe5, 0x8a, 0x5e,
+	0x58, 0xe0, 0x53, 0xd0, 0x08, 0xf0, 0x98, 0x19, 0xa5, 0xd2, 0x70, 0x6d, 0xf1, 0xbf, 0xb6, 0xd0,
+	0xd9, 0xd4, 0x60, 0x12, 0xcb, 0xf5, 0xe7, 0x78, 0xcc, 0x5e, 0x14, 0x9f, 0xf6, 0x77, 0xf5, 0x7a,
+	0x30, 0xbf, 0xb6, 0xe1, 0x3b, 0x70, 0x99, 0x23, 0xc4, 0xb5, 0xf6, 0x5a, 0xa7, 0xd6, 0x93, 0x57,
+	0xd0, 0x4b, 0x97, 0x26, 0xa6, 0xfc, 0xb3, 0x58, 0x6e, 0x4c, 0x4c, 0xdf, 0x7b, 0x8c, 0xb8, 0x08,
+	0xe9, 0xb3, 0x2c, 0xf8, 0x19, 0x5c, 0x1b, 0x06, 0x03, 0x12, 0xd8, 0x6e, 0xe0, 0x18, 0x33, 0xc4,
+	0x7a, 0x86, 0xe8, 0xac, 0x40, 0xbc, 0x2d, 0x1d, 0x9c, 0x85, 0x0a, 0x56, 0x2b, 0x67, 0x2d, 0x89,
+	0x44, 0x3a, 0x1c, 0x2e, 0xda, 0x28, 0x3c, 0x02, 0x0d, 0xae, 0x30, 0x8a, 0xe2, 0xfe, 0x9f, 0xb1,
+	0xef, 0xfd, 0xe1, 0x78, 0x79, 0x91, 0x75, 0x6c, 0x91, 0xc8, 0xd6, 0xe4, 0x02, 0xbf, 0xbd, 0x70,
+	0xd4, 0x22, 0x12, 0xe9, 0x57, 0xf9, 0x5f, 0xb9, 0x0f, 0x4e, 0x00, 0xb4, 0x88, 0xef, 0xbb, 0x94,
+	0xba, 0x24, 0x30, 0xac, 0x03, 0x33, 0x70, 0x30, 0x15, 0xab, 0x19, 0xfa, 0xee, 0x0a, 0x74, 0x9f,
+	0x1b, 0xfa, 0x99, 0x5e, 0xbb, 0x55, 0x60, 0x77, 0x72, 0xec, 0xf9, 0x40, 0xa4, 0x6f, 0x59, 0x0b,
+	0x26, 0x0a, 0x31, 0xa8, 0x45, 0xc4, 0xc3, 0x86, 0x13, 0x99, 0x01, 0xa3, 0xe2, 0xa5, 0x0b, 0x4b,
+	0x5d, 0x1e, 0x57, 0x27, 0x1e, 0xde, 0x4b, 0x0d, 0x5a, 0xab, 0x80, 0xc2, 0x1c, 0x3a, 0x17, 0x85,
+	0x74, 0x10, 0x95, 0x32, 0x0a, 0x1d, 0xd0, 0x3c, 0x34, 0x5d, 0x0f, 0xdb, 0xb3, 0x3b, 0x30, 0x5c,
+	0x9b, 0x8a, 0x1b, 0xed, 0xb5, 0xce, 0xa6, 0xf6, 0x30, 0x89, 0x65, 0xf8, 0x2c, 0xfb, 0xce, 0x6f,
+	0x63, 0x7f, 0x97, 0x9e, 0xc5, 0xf2, 0x8d, 0x3c, 0x77, 0x99, 0x17, 0xe9, 0xf0, 0x70, 0xc1, 0x62,
+	0x53, 0xf4, 0x4d, 0x00, 0x5b, 0xe7, 0x3a, 0x02, 0xaa, 0xa0, 0x36, 0xdf, 0xea, 0x42, 0xd6, 0xea,
+	0xf5, 0x24, 0x96, 0xc1, 0x5c, 0x9b, 0x03, 0x32, 0x6b, 0xf1, 0x0f, 0x60, 0x67, 0xd6, 0x36, 0x16,
+	0xf1, 0x43, 0x0f, 0xb3, 0xb4, 0x94, 0xe9, 0x78, 0x67, 0x93, 0x52, 0xeb, 0xb5, 0x94, 0x7c, 0xf6,
+	0x95, 0x72, 0xf6, 0x95, 0x37, 0xe5, 0xec, 0x6b, 0x1b, 0x69, 0x59, 0x8e, 0x7f, 0xc8, 0x82, 0xbe,
+	0xcd, 0x63, 0xfa, 0x3c, 0x25, 0xd5, 0xa1, 0x2f, 0x02, 0x68, 0x2e, 0x6b, 0x9f, 0x7f, 0xdf, 0x6b,
+	0x9f, 0xbf, 0x04, 0xf9, 0xc6, 0xee, 0xfc, 0x55, 0xb3, 0xfe, 0xfe, 0x22, 0x68, 0xaf, 0x4e, 0x12,
+	0x49, 0x38, 0x4d, 0x24, 0xe1, 0x67, 0x22, 0x09, 0xc7, 0x53, 0xa9, 0x72, 0x3a, 0x95, 0x2a, 0xdf,
+	0xa7, 0x52, 0xe5, 0xfd, 0x23, 0xc7, 0x65, 0x07, 0xc3, 0x81, 0x62, 0x11, 0x5f, 0x2d, 0x83, 0xef,
+	0x7b, 0xe6, 0x80, 0xf2, 0x95, 0x3a, 0xea, 0xf6, 0xd4, 0xf1, 0xdc, 0xe3, 0xc5, 0x26, 0x21, 0xa6,
+	0x83, 0x6a, 0x56, 0x98, 0x07, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0x4b, 0x73, 0x99, 0x76,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -290,6 +328,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JailedOperatorsIDs) > 0 {
+		dAtA2 := make([]byte, len(m.JailedOperatorsIDs)*10)
+		var j1 int
+		for _, num := range m.JailedOperatorsIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CommissionChanges) > 0 {
+		for iNdEx := len(m.CommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.OperatorsParams) > 0 {
 		for iNdEx := len(m.OperatorsParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,12 +454,12 @@ func (m *UnbondingOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingCompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingCompletionTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingCompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingCompletionTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.OperatorID != 0 {
@@ -464,6 +548,25 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CommissionChanges) > 0 {
+		for _, e := range m.CommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailedOperatorsIDs) > 0 {
+		l = 0
+		for _, e := range m.JailedOperatorsIDs {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -684,6 +787,150 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionChanges = append(m.CommissionChanges, CommissionChange{})
+			if err := m.CommissionChanges[len(m.CommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, OperatorRoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.JailedOperatorsIDs = append(m.JailedOperatorsIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.JailedOperatorsIDs) == 0 {
+					m.JailedOperatorsIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.JailedOperatorsIDs = append(m.JailedOperatorsIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedOperatorsIDs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
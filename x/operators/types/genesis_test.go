@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milkyway-labs/milkyway/v12/x/operators/types"
@@ -136,6 +137,71 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			name: "duplicated commission change returns error",
+			genesis: &types.GenesisState{
+				NextOperatorID: 1,
+				CommissionChanges: []types.CommissionChange{
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.3"),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+					),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid commission change returns error",
+			genesis: &types.GenesisState{
+				NextOperatorID: 1,
+				CommissionChanges: []types.CommissionChange{
+					types.NewCommissionChange(
+						1,
+						sdkmath.LegacyMustNewDecFromStr("0.2"),
+						time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "not found jailed operator returns error",
+			genesis: &types.GenesisState{
+				NextOperatorID:     1,
+				JailedOperatorsIDs: []uint32{1},
+				Params:             types.DefaultParams(),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "jailed operator not in jailed operators IDs returns error",
+			genesis: &types.GenesisState{
+				NextOperatorID: 2,
+				Operators: []types.Operator{
+					types.NewOperator(
+						1,
+						types.OPERATOR_STATUS_JAILED,
+						"MilkyWay Operator",
+						"https://milkyway.com",
+						"https://milkyway.com/picture",
+						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldErr: true,
+		},
 		{
 			name: "invalid params returns error",
 			genesis: &types.GenesisState{
@@ -171,8 +237,10 @@ func TestGenesisState_Validate(t *testing.T) {
 						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
 					),
 				},
+				JailedOperatorsIDs: []uint32{1},
 				Params: types.Params{
-					DeactivationTime: 3 * 24 * time.Hour,
+					DeactivationTime:             3 * 24 * time.Hour,
+					CommissionChangeNoticePeriod: 24 * time.Hour,
 				},
 			},
 			shouldErr: false,
@@ -23,6 +23,12 @@ var (
 	InactivatingOperatorQueuePrefix = []byte{0xa3}
 	OperatorAddressSetPrefix        = []byte{0xa4}
 	OperatorParamsMapPrefix         = []byte{0xa5}
+	CommissionChangePrefix          = []byte{0xa6}
+	CommissionChangeQueuePrefix     = []byte{0xa7}
+	OperatorStatusIndexPrefix       = []byte{0xa8}
+	OperatorAdminIndexPrefix        = []byte{0xa9}
+	OperatorRoleGrantPrefix         = []byte{0xaa}
+	JailedOperatorsPrefix           = []byte{0xab}
 )
 
 // GetOperatorIDBytes returns the byte representation of the operator ID
@@ -227,6 +227,84 @@ func (msg *MsgTransferOperatorOwnership) GetSigners() []sdk.AccAddress {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgGrantOperatorRole creates a new MsgGrantOperatorRole instance
+func NewMsgGrantOperatorRole(operatorID uint32, grantee string, role OperatorRole, sender string) *MsgGrantOperatorRole {
+	return &MsgGrantOperatorRole{
+		OperatorID: operatorID,
+		Grantee:    grantee,
+		Role:       role,
+		Sender:     sender,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgGrantOperatorRole) ValidateBasic() error {
+	grant := NewOperatorRoleGrant(msg.OperatorID, msg.Grantee, msg.Role)
+	err := grant.Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgGrantOperatorRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgGrantOperatorRole) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRevokeOperatorRole creates a new MsgRevokeOperatorRole instance
+func NewMsgRevokeOperatorRole(operatorID uint32, grantee string, role OperatorRole, sender string) *MsgRevokeOperatorRole {
+	return &MsgRevokeOperatorRole{
+		OperatorID: operatorID,
+		Grantee:    grantee,
+		Role:       role,
+		Sender:     sender,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRevokeOperatorRole) ValidateBasic() error {
+	grant := NewOperatorRoleGrant(msg.OperatorID, msg.Grantee, msg.Role)
+	err := grant.Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRevokeOperatorRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRevokeOperatorRole) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
 	return &MsgUpdateParams{
 		Params:    params,
@@ -782,6 +782,230 @@ func (m *MsgTransferOperatorOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferOperatorOwnershipResponse proto.InternalMessageInfo
 
+// MsgGrantOperatorRole defines the message structure for the
+// GrantOperatorRole gRPC service method. It allows an operator admin to
+// grant one of the operator roles to another account.
+type MsgGrantOperatorRole struct {
+	// Sender is the address of the operator admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OperatorID represents the ID of the operator
+	OperatorID uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Grantee is the address of the account that will be granted the role
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role to be granted
+	Role OperatorRole `protobuf:"varint,4,opt,name=role,proto3,enum=milkyway.operators.v1.OperatorRole" json:"role,omitempty"`
+}
+
+func (m *MsgGrantOperatorRole) Reset()         { *m = MsgGrantOperatorRole{} }
+func (m *MsgGrantOperatorRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantOperatorRole) ProtoMessage()    {}
+func (*MsgGrantOperatorRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376ca0aafe99868c, []int{14}
+}
+func (m *MsgGrantOperatorRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantOperatorRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantOperatorRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantOperatorRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantOperatorRole.Merge(m, src)
+}
+func (m *MsgGrantOperatorRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantOperatorRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantOperatorRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantOperatorRole proto.InternalMessageInfo
+
+func (m *MsgGrantOperatorRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgGrantOperatorRole) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
+	}
+	return 0
+}
+
+func (m *MsgGrantOperatorRole) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgGrantOperatorRole) GetRole() OperatorRole {
+	if m != nil {
+		return m.Role
+	}
+	return OPERATOR_ROLE_UNSPECIFIED
+}
+
+// MsgGrantOperatorRoleResponse is the return value of MsgGrantOperatorRole.
+type MsgGrantOperatorRoleResponse struct {
+}
+
+func (m *MsgGrantOperatorRoleResponse) Reset()         { *m = MsgGrantOperatorRoleResponse{} }
+func (m *MsgGrantOperatorRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantOperatorRoleResponse) ProtoMessage()    {}
+func (*MsgGrantOperatorRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376ca0aafe99868c, []int{15}
+}
+func (m *MsgGrantOperatorRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantOperatorRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantOperatorRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantOperatorRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantOperatorRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantOperatorRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantOperatorRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantOperatorRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantOperatorRoleResponse proto.InternalMessageInfo
+
+// MsgRevokeOperatorRole defines the message structure for the
+// RevokeOperatorRole gRPC service method. It allows an operator admin to
+// revoke a role previously granted to another account.
+type MsgRevokeOperatorRole struct {
+	// Sender is the address of the operator admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OperatorID represents the ID of the operator
+	OperatorID uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Grantee is the address of the account whose role will be revoked
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role to be revoked
+	Role OperatorRole `protobuf:"varint,4,opt,name=role,proto3,enum=milkyway.operators.v1.OperatorRole" json:"role,omitempty"`
+}
+
+func (m *MsgRevokeOperatorRole) Reset()         { *m = MsgRevokeOperatorRole{} }
+func (m *MsgRevokeOperatorRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOperatorRole) ProtoMessage()    {}
+func (*MsgRevokeOperatorRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376ca0aafe99868c, []int{16}
+}
+func (m *MsgRevokeOperatorRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeOperatorRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeOperatorRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeOperatorRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOperatorRole.Merge(m, src)
+}
+func (m *MsgRevokeOperatorRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeOperatorRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOperatorRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeOperatorRole proto.InternalMessageInfo
+
+func (m *MsgRevokeOperatorRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRevokeOperatorRole) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
+	}
+	return 0
+}
+
+func (m *MsgRevokeOperatorRole) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgRevokeOperatorRole) GetRole() OperatorRole {
+	if m != nil {
+		return m.Role
+	}
+	return OPERATOR_ROLE_UNSPECIFIED
+}
+
+// MsgRevokeOperatorRoleResponse is the return value of MsgRevokeOperatorRole.
+type MsgRevokeOperatorRoleResponse struct {
+}
+
+func (m *MsgRevokeOperatorRoleResponse) Reset()         { *m = MsgRevokeOperatorRoleResponse{} }
+func (m *MsgRevokeOperatorRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOperatorRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeOperatorRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376ca0aafe99868c, []int{17}
+}
+func (m *MsgRevokeOperatorRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeOperatorRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeOperatorRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeOperatorRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOperatorRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeOperatorRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeOperatorRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOperatorRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeOperatorRoleResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines the message structure for the UpdateParams gRPC
 // service method. It allows the authority to update the module parameters.
 type MsgUpdateParams struct {
@@ -798,7 +1022,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_376ca0aafe99868c, []int{14}
+	return fileDescriptor_376ca0aafe99868c, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +1073,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_376ca0aafe99868c, []int{15}
+	return fileDescriptor_376ca0aafe99868c, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,6 +1117,10 @@ func init() {
 	proto.RegisterType((*MsgSetOperatorParamsResponse)(nil), "milkyway.operators.v1.MsgSetOperatorParamsResponse")
 	proto.RegisterType((*MsgTransferOperatorOwnership)(nil), "milkyway.operators.v1.MsgTransferOperatorOwnership")
 	proto.RegisterType((*MsgTransferOperatorOwnershipResponse)(nil), "milkyway.operators.v1.MsgTransferOperatorOwnershipResponse")
+	proto.RegisterType((*MsgGrantOperatorRole)(nil), "milkyway.operators.v1.MsgGrantOperatorRole")
+	proto.RegisterType((*MsgGrantOperatorRoleResponse)(nil), "milkyway.operators.v1.MsgGrantOperatorRoleResponse")
+	proto.RegisterType((*MsgRevokeOperatorRole)(nil), "milkyway.operators.v1.MsgRevokeOperatorRole")
+	proto.RegisterType((*MsgRevokeOperatorRoleResponse)(nil), "milkyway.operators.v1.MsgRevokeOperatorRoleResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "milkyway.operators.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "milkyway.operators.v1.MsgUpdateParamsResponse")
 }
@@ -902,69 +1130,76 @@ func init() {
 }
 
 var fileDescriptor_376ca0aafe99868c = []byte{
-	// 990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xfb, 0x6f, 0xe9, 0x84, 0xb6, 0x5b, 0xd3, 0xd5, 0x26, 0xde, 0xad, 0xbd, 0xb2, 0xba,
-	0xab, 0x10, 0x5a, 0xbb, 0x49, 0x59, 0x21, 0xb2, 0xa7, 0x66, 0xcb, 0x01, 0x44, 0xd8, 0xc5, 0x4b,
-	0x25, 0xc4, 0x25, 0x9a, 0xc4, 0x53, 0xd7, 0x34, 0xf6, 0x58, 0x1e, 0x27, 0xd9, 0xdc, 0x10, 0xe2,
-	0x84, 0x84, 0xc4, 0x67, 0xe0, 0xb8, 0xa7, 0x1e, 0xb8, 0x23, 0x71, 0xda, 0xe3, 0xc2, 0x89, 0x53,
-	0x40, 0xe9, 0xa1, 0x27, 0x84, 0xd4, 0x4f, 0x80, 0x3c, 0x1e, 0xbb, 0x8e, 0x63, 0x87, 0x66, 0x91,
-	0xa2, 0xbd, 0xb4, 0x9d, 0xf7, 0x7e, 0xef, 0xcf, 0xef, 0xbd, 0xe7, 0x37, 0x53, 0xb0, 0x6d, 0x99,
-	0xed, 0xd3, 0x7e, 0x0f, 0xf6, 0x55, 0xec, 0x20, 0x17, 0x7a, 0xd8, 0x25, 0x6a, 0xb7, 0xac, 0x5a,
-	0x88, 0x10, 0x68, 0x20, 0xa2, 0x38, 0x2e, 0xf6, 0x30, 0x7f, 0x2b, 0x44, 0x29, 0x11, 0x4a, 0xe9,
-	0x96, 0x85, 0x0d, 0x68, 0x99, 0x36, 0x56, 0xe9, 0xcf, 0x00, 0x29, 0x88, 0x2d, 0x4c, 0x2c, 0x4c,
-	0xd4, 0x26, 0x24, 0x48, 0xed, 0x96, 0x9b, 0xc8, 0x83, 0x65, 0xb5, 0x85, 0x4d, 0x9b, 0xe9, 0x6f,
-	0x33, 0xbd, 0x45, 0x0c, 0x1a, 0x87, 0x18, 0x4c, 0x51, 0x08, 0x14, 0x0d, 0x7a, 0x52, 0x83, 0x03,
-	0x53, 0x6d, 0x1a, 0xd8, 0xc0, 0x81, 0xdc, 0xff, 0x8b, 0x49, 0xe5, 0x8c, 0xcc, 0xb1, 0x8e, 0xda,
-	0x64, 0x32, 0xc6, 0x81, 0x2e, 0xb4, 0x18, 0x46, 0xfe, 0x6e, 0x01, 0xbc, 0x53, 0x27, 0x86, 0x86,
-	0x0c, 0x93, 0x78, 0xc8, 0x7d, 0xc2, 0x80, 0xfc, 0x1e, 0x58, 0x26, 0xc8, 0xd6, 0x91, 0x9b, 0xe7,
-	0xee, 0x71, 0xc5, 0x95, 0x5a, 0xfe, 0xf7, 0x9f, 0x77, 0x37, 0x59, 0x5e, 0x07, 0xba, 0xee, 0x22,
-	0x42, 0x9e, 0x79, 0xae, 0x69, 0x1b, 0x1a, 0xc3, 0xf1, 0x3b, 0xe0, 0x86, 0x85, 0x6d, 0xf3, 0x14,
-	0xb9, 0xf9, 0x79, 0x6a, 0xc2, 0x5f, 0x0e, 0xa4, 0xb5, 0x3e, 0xb4, 0xda, 0x55, 0x99, 0x29, 0x64,
-	0x2d, 0x84, 0xf8, 0xe8, 0x1e, 0x6a, 0x12, 0xd3, 0x43, 0xf9, 0x85, 0x24, 0x9a, 0x29, 0x64, 0x2d,
-	0x84, 0xf0, 0x1f, 0x81, 0x9c, 0x63, 0xb6, 0xbc, 0x8e, 0x8b, 0x1a, 0x1d, 0xb7, 0x9d, 0x5f, 0xa4,
-	0x16, 0xdb, 0xc3, 0x81, 0x04, 0x9e, 0x06, 0xe2, 0x23, 0xed, 0xd3, 0xcb, 0x81, 0xc4, 0x07, 0xf6,
-	0x31, 0xa8, 0xac, 0x01, 0x76, 0x3a, 0x72, 0xdb, 0xfc, 0xd7, 0x00, 0x1c, 0x23, 0xd4, 0x80, 0x16,
-	0xee, 0xd8, 0x5e, 0x7e, 0xe9, 0xde, 0x42, 0x31, 0x57, 0x29, 0x28, 0x8c, 0x95, 0xdf, 0x33, 0x85,
-	0xf5, 0x4c, 0x79, 0x8c, 0x4d, 0xbb, 0xb6, 0xf7, 0x72, 0x20, 0xcd, 0xbd, 0xf8, 0x53, 0x2a, 0x1a,
-	0xa6, 0x77, 0xd2, 0x69, 0x2a, 0x2d, 0x6c, 0xb1, 0xd6, 0xb0, 0x5f, 0xbb, 0x44, 0x3f, 0x55, 0xbd,
-	0xbe, 0x83, 0x08, 0x35, 0x20, 0xda, 0xca, 0x31, 0x42, 0x07, 0xd4, 0x7b, 0x75, 0xe7, 0xdb, 0x8b,
-	0xb3, 0x12, 0xab, 0xcd, 0xf7, 0x17, 0x67, 0xa5, 0xbb, 0x51, 0x33, 0x52, 0xca, 0x2d, 0x7f, 0x09,
-	0xee, 0xa4, 0x88, 0x35, 0x44, 0x1c, 0x6c, 0x13, 0xc4, 0x7f, 0x08, 0xd6, 0x6d, 0xd4, 0x6b, 0x84,
-	0x6d, 0x6c, 0x98, 0x3a, 0x6d, 0xcb, 0x6a, 0x6d, 0x63, 0x38, 0x90, 0x56, 0x3f, 0x43, 0xbd, 0xd0,
-	0xe2, 0xe3, 0x43, 0x6d, 0xd5, 0x8e, 0x1d, 0x75, 0xf9, 0xd7, 0x79, 0xb0, 0x51, 0x27, 0xc6, 0x91,
-	0xa3, 0x43, 0x0f, 0xfd, 0x8f, 0xf6, 0xaa, 0x20, 0x17, 0x0f, 0x3f, 0x4f, 0xc3, 0xaf, 0xf9, 0x2d,
-	0x88, 0xc5, 0x06, 0x38, 0x0a, 0xcc, 0xbf, 0x7b, 0x35, 0x0f, 0x41, 0x87, 0xd7, 0x2f, 0x07, 0x52,
-	0x2e, 0xe8, 0x90, 0x0d, 0x2d, 0x94, 0x3e, 0x0c, 0x8b, 0x53, 0x0f, 0xc3, 0xd2, 0xeb, 0x0d, 0x43,
-	0xb5, 0x94, 0x68, 0x90, 0x10, 0x6f, 0xd0, 0x68, 0xb9, 0xe4, 0x3b, 0xa0, 0x30, 0x26, 0x0c, 0x9b,
-	0x23, 0xbf, 0xe0, 0xc0, 0xad, 0x3a, 0x31, 0x0e, 0x11, 0x6c, 0x79, 0x66, 0x77, 0xb6, 0x55, 0xae,
-	0x2a, 0x09, 0x16, 0x62, 0x9c, 0xc5, 0x78, 0x4a, 0xb2, 0x04, 0xb6, 0x52, 0x15, 0x49, 0x36, 0xda,
-	0x9b, 0xc7, 0x46, 0xcb, 0x62, 0xa3, 0x65, 0xb3, 0xf9, 0x89, 0xa3, 0xd3, 0x7f, 0x88, 0xda, 0x68,
-	0xb6, 0x4c, 0x26, 0x4e, 0xd7, 0x68, 0x3a, 0x6c, 0xba, 0x46, 0x85, 0x11, 0x83, 0xbf, 0x39, 0xb0,
-	0x59, 0x27, 0xc6, 0x33, 0xe4, 0x85, 0xaa, 0xa7, 0x74, 0x7f, 0xcf, 0xe2, 0x13, 0x7e, 0x0c, 0x96,
-	0x83, 0xcb, 0x82, 0x7e, 0xc1, 0xb9, 0xca, 0x7d, 0x25, 0xf5, 0x26, 0x54, 0x46, 0x33, 0xab, 0x2d,
-	0xfa, 0x7b, 0x53, 0x63, 0xa6, 0xd5, 0xdd, 0x44, 0x25, 0xb6, 0xe2, 0x95, 0x18, 0xa3, 0x25, 0x8b,
-	0xe0, 0x6e, 0x9a, 0x3c, 0xaa, 0xc7, 0x3f, 0x1c, 0x05, 0x7c, 0xe1, 0x42, 0x9b, 0x1c, 0x5f, 0xad,
-	0xca, 0x27, 0x3d, 0x1b, 0xb9, 0xe4, 0xc4, 0x74, 0x66, 0x51, 0x97, 0x87, 0x60, 0xc5, 0x5f, 0xc7,
-	0x50, 0xb7, 0x4c, 0x9b, 0x2d, 0xb7, 0xec, 0x28, 0x6f, 0xd9, 0xa8, 0x77, 0xe0, 0x23, 0xab, 0x0f,
-	0x13, 0x95, 0xb8, 0x1f, 0xaf, 0x44, 0x26, 0x21, 0xf9, 0x01, 0xd8, 0x9e, 0xa4, 0x8f, 0x2a, 0xf3,
-	0x1b, 0x07, 0xd6, 0xa3, 0x2d, 0xc5, 0x86, 0xe4, 0x13, 0xb0, 0x02, 0x3b, 0xde, 0x09, 0x76, 0x4d,
-	0xaf, 0xcf, 0xea, 0xb1, 0x73, 0x39, 0x90, 0x6e, 0x06, 0xbb, 0x31, 0x52, 0xc9, 0x99, 0xd9, 0x5f,
-	0x99, 0xf3, 0x8f, 0xa2, 0x69, 0x98, 0xa7, 0xd3, 0xb0, 0x95, 0x31, 0x0d, 0xa9, 0x53, 0x40, 0xb9,
-	0x5f, 0x39, 0xf3, 0xe9, 0xa7, 0x3d, 0x4f, 0x12, 0xf9, 0xcb, 0x05, 0x70, 0x3b, 0x21, 0x0a, 0xe9,
-	0x56, 0x7e, 0xb9, 0x01, 0x16, 0xea, 0xc4, 0xe0, 0x5d, 0x70, 0x73, 0xec, 0xf5, 0x52, 0xca, 0x48,
-	0x2d, 0xe5, 0x8e, 0x15, 0x2a, 0xd7, 0xc7, 0x46, 0xf7, 0x71, 0x1b, 0xac, 0x25, 0x2e, 0xd4, 0x62,
-	0xb6, 0x97, 0x51, 0xa4, 0xb0, 0x77, 0x5d, 0x64, 0x14, 0xed, 0x39, 0xe0, 0x53, 0x2e, 0x97, 0x9d,
-	0x6c, 0x3f, 0xe3, 0x68, 0xe1, 0xfd, 0x69, 0xd0, 0xf1, 0xc8, 0xda, 0x54, 0x91, 0xb5, 0xa9, 0x22,
-	0x67, 0x2f, 0x6e, 0xbf, 0xc2, 0x89, 0xa5, 0x5d, 0x9c, 0xc4, 0x20, 0x8e, 0x9c, 0x54, 0xe1, 0xf4,
-	0x25, 0xcb, 0xff, 0xc0, 0x81, 0x42, 0xf6, 0x46, 0xd9, 0xcf, 0xf6, 0x97, 0x69, 0x24, 0x3c, 0x7a,
-	0x0d, 0xa3, 0x28, 0x9f, 0x0e, 0xd8, 0x18, 0x5f, 0xf8, 0xef, 0x65, 0x7b, 0x1c, 0x03, 0x0b, 0xfb,
-	0x53, 0x80, 0xa3, 0xb0, 0xc7, 0xe0, 0xed, 0x91, 0xed, 0xf1, 0xe0, 0xbf, 0x46, 0x95, 0x05, 0x53,
-	0xae, 0x87, 0x0b, 0xe3, 0x08, 0x4b, 0xdf, 0x5c, 0x9c, 0x95, 0xb8, 0xda, 0xe7, 0x2f, 0x87, 0x22,
-	0xf7, 0x6a, 0x28, 0x72, 0x7f, 0x0d, 0x45, 0xee, 0xc7, 0x73, 0x71, 0xee, 0xd5, 0xb9, 0x38, 0xf7,
-	0xc7, 0xb9, 0x38, 0xf7, 0xd5, 0x07, 0xb1, 0x17, 0x77, 0xe8, 0x7a, 0xb7, 0x0d, 0x9b, 0x24, 0x3a,
-	0xa9, 0xdd, 0x72, 0x45, 0x7d, 0x1e, 0xdb, 0x1c, 0xf4, 0x19, 0xde, 0x5c, 0xa6, 0xff, 0xd5, 0xec,
-	0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb3, 0x42, 0xc0, 0xd9, 0x0d, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x3f, 0x8a, 0xc7, 0x24, 0x69, 0x96, 0x54, 0xb5, 0xdd, 0xc6, 0xae, 0x96, 0xb4,
+	0x32, 0x26, 0xd9, 0x8d, 0x1d, 0xaa, 0x0a, 0xf7, 0x14, 0x37, 0x08, 0x81, 0x30, 0x2d, 0x5b, 0x22,
+	0x21, 0x2e, 0xd6, 0xda, 0x9e, 0x6c, 0x96, 0xec, 0xee, 0x58, 0x33, 0x6b, 0xbb, 0xbe, 0x21, 0xc4,
+	0x09, 0x09, 0x89, 0x0b, 0xe2, 0xce, 0xb1, 0xa7, 0x1c, 0xf8, 0x0b, 0x38, 0xf5, 0x58, 0x38, 0x71,
+	0x32, 0xc8, 0x39, 0xe4, 0x84, 0x90, 0xf2, 0x17, 0xa0, 0x9d, 0x9d, 0xdd, 0xac, 0xf7, 0x57, 0xe2,
+	0x22, 0x59, 0xa8, 0x97, 0x24, 0x3b, 0xef, 0x7b, 0xf3, 0xe6, 0xfb, 0xde, 0xdb, 0x37, 0x6f, 0x03,
+	0x36, 0x0d, 0x4d, 0x3f, 0x1e, 0x0e, 0x94, 0xa1, 0x84, 0xba, 0x10, 0x2b, 0x16, 0xc2, 0x44, 0xea,
+	0x57, 0x24, 0x03, 0x12, 0xa2, 0xa8, 0x90, 0x88, 0x5d, 0x8c, 0x2c, 0xc4, 0xdf, 0x70, 0x51, 0xa2,
+	0x87, 0x12, 0xfb, 0x95, 0xfc, 0x9a, 0x62, 0x68, 0x26, 0x92, 0xe8, 0x4f, 0x07, 0x99, 0x2f, 0xb4,
+	0x11, 0x31, 0x10, 0x91, 0x5a, 0x0a, 0x81, 0x52, 0xbf, 0xd2, 0x82, 0x96, 0x52, 0x91, 0xda, 0x48,
+	0x33, 0x99, 0xfd, 0x26, 0xb3, 0x1b, 0x44, 0xa5, 0x71, 0x88, 0xca, 0x0c, 0x39, 0xc7, 0xd0, 0xa4,
+	0x4f, 0x92, 0xf3, 0xc0, 0x4c, 0xeb, 0x2a, 0x52, 0x91, 0xb3, 0x6e, 0xff, 0xc5, 0x56, 0x85, 0x98,
+	0x93, 0xa3, 0x0e, 0xd4, 0x49, 0x32, 0xa6, 0xab, 0x60, 0xc5, 0x60, 0x18, 0xe1, 0xdb, 0x79, 0xf0,
+	0x56, 0x83, 0xa8, 0x32, 0x54, 0x35, 0x62, 0x41, 0xfc, 0x98, 0x01, 0xf9, 0x1d, 0xb0, 0x44, 0xa0,
+	0xd9, 0x81, 0x38, 0xcb, 0xdd, 0xe1, 0x4a, 0xe9, 0x7a, 0xf6, 0xf7, 0x5f, 0xb6, 0xd7, 0xd9, 0xb9,
+	0xf6, 0x3a, 0x1d, 0x0c, 0x09, 0x79, 0x6a, 0x61, 0xcd, 0x54, 0x65, 0x86, 0xe3, 0xb7, 0xc0, 0x35,
+	0x03, 0x99, 0xda, 0x31, 0xc4, 0xd9, 0x14, 0x75, 0xe1, 0xcf, 0x47, 0xc5, 0x95, 0xa1, 0x62, 0xe8,
+	0x35, 0x81, 0x19, 0x04, 0xd9, 0x85, 0xd8, 0xe8, 0x01, 0x6c, 0x11, 0xcd, 0x82, 0xd9, 0xf9, 0x20,
+	0x9a, 0x19, 0x04, 0xd9, 0x85, 0xf0, 0x1f, 0x80, 0x4c, 0x57, 0x6b, 0x5b, 0x3d, 0x0c, 0x9b, 0x3d,
+	0xac, 0x67, 0x17, 0xa8, 0xc7, 0xe6, 0x78, 0x54, 0x04, 0x4f, 0x9c, 0xe5, 0x03, 0xf9, 0x93, 0xf3,
+	0x51, 0x91, 0x77, 0xfc, 0x7d, 0x50, 0x41, 0x06, 0xec, 0xe9, 0x00, 0xeb, 0xfc, 0x57, 0x00, 0x1c,
+	0x42, 0xd8, 0x54, 0x0c, 0xd4, 0x33, 0xad, 0xec, 0xe2, 0x9d, 0xf9, 0x52, 0xa6, 0x9a, 0x13, 0x19,
+	0x2b, 0x3b, 0x67, 0x22, 0xcb, 0x99, 0xf8, 0x08, 0x69, 0x66, 0x7d, 0xe7, 0xc5, 0xa8, 0x38, 0xf7,
+	0xfc, 0xcf, 0x62, 0x49, 0xd5, 0xac, 0xa3, 0x5e, 0x4b, 0x6c, 0x23, 0x83, 0xa5, 0x86, 0xfd, 0xda,
+	0x26, 0x9d, 0x63, 0xc9, 0x1a, 0x76, 0x21, 0xa1, 0x0e, 0x44, 0x4e, 0x1f, 0x42, 0xb8, 0x47, 0x77,
+	0xaf, 0x6d, 0x7d, 0x73, 0x76, 0x52, 0x66, 0xda, 0x7c, 0x77, 0x76, 0x52, 0xbe, 0xed, 0x25, 0x23,
+	0x42, 0x6e, 0xe1, 0x0b, 0x70, 0x2b, 0x62, 0x59, 0x86, 0xa4, 0x8b, 0x4c, 0x02, 0xf9, 0xf7, 0xc1,
+	0xaa, 0x09, 0x07, 0x4d, 0x37, 0x8d, 0x4d, 0xad, 0x43, 0xd3, 0xb2, 0x5c, 0x5f, 0x1b, 0x8f, 0x8a,
+	0xcb, 0x9f, 0xc2, 0x81, 0xeb, 0xf1, 0xd1, 0xbe, 0xbc, 0x6c, 0xfa, 0x1e, 0x3b, 0xc2, 0xaf, 0x29,
+	0xb0, 0xd6, 0x20, 0xea, 0x41, 0xb7, 0xa3, 0x58, 0xf0, 0x3f, 0xa4, 0x57, 0x02, 0x19, 0x7f, 0xf8,
+	0x14, 0x0d, 0xbf, 0x62, 0xa7, 0xc0, 0x17, 0x1b, 0x20, 0x2f, 0x30, 0xff, 0xce, 0x45, 0x3d, 0x38,
+	0x19, 0x5e, 0x3d, 0x1f, 0x15, 0x33, 0x4e, 0x86, 0x4c, 0xc5, 0x80, 0xd1, 0xc5, 0xb0, 0x30, 0x75,
+	0x31, 0x2c, 0xbe, 0x5a, 0x31, 0xd4, 0xca, 0x81, 0x04, 0xe5, 0xfd, 0x09, 0x9a, 0x94, 0x4b, 0xb8,
+	0x05, 0x72, 0xa1, 0x45, 0x37, 0x39, 0xc2, 0x73, 0x0e, 0xdc, 0x68, 0x10, 0x75, 0x1f, 0x2a, 0x6d,
+	0x4b, 0xeb, 0xcf, 0x56, 0xe5, 0x9a, 0x18, 0x60, 0x51, 0xf0, 0xb3, 0x08, 0x1f, 0x49, 0x28, 0x82,
+	0x8d, 0x48, 0x43, 0x90, 0x8d, 0xfc, 0xff, 0x63, 0x23, 0xc7, 0xb1, 0x91, 0xe3, 0xd9, 0xfc, 0xcc,
+	0xd1, 0xea, 0xdf, 0x87, 0x3a, 0x9c, 0x2d, 0x93, 0xc4, 0xea, 0x9a, 0x3c, 0x0e, 0xab, 0xae, 0xc9,
+	0x45, 0x8f, 0xc1, 0xdf, 0x1c, 0x58, 0x6f, 0x10, 0xf5, 0x29, 0xb4, 0x5c, 0xd3, 0x13, 0xda, 0xbf,
+	0x67, 0xf1, 0x0a, 0x3f, 0x02, 0x4b, 0xce, 0x65, 0x41, 0xdf, 0xe0, 0x4c, 0xf5, 0xae, 0x18, 0x79,
+	0x13, 0x8a, 0x93, 0x27, 0xab, 0x2f, 0xd8, 0x7d, 0x53, 0x66, 0xae, 0xb5, 0xed, 0x80, 0x12, 0x1b,
+	0x7e, 0x25, 0x42, 0xb4, 0x84, 0x02, 0xb8, 0x1d, 0xb5, 0xee, 0xe9, 0xf1, 0x0f, 0x47, 0x01, 0x9f,
+	0x63, 0xc5, 0x24, 0x87, 0x17, 0xad, 0xf2, 0xf1, 0xc0, 0x84, 0x98, 0x1c, 0x69, 0xdd, 0x59, 0xe8,
+	0x72, 0x1f, 0xa4, 0xed, 0x76, 0xac, 0x74, 0x0c, 0xcd, 0x64, 0xcd, 0x2d, 0x3e, 0xca, 0x1b, 0x26,
+	0x1c, 0xec, 0xd9, 0xc8, 0xda, 0xfd, 0x80, 0x12, 0x77, 0xfd, 0x4a, 0xc4, 0x12, 0x12, 0xee, 0x81,
+	0xcd, 0x24, 0xbb, 0xa7, 0xcc, 0x8f, 0x29, 0x5a, 0x29, 0x1f, 0x62, 0xc5, 0xf4, 0xc4, 0x93, 0x91,
+	0x0e, 0x67, 0xa1, 0x48, 0x15, 0x5c, 0x53, 0xed, 0xb8, 0x10, 0x5e, 0xaa, 0x87, 0x0b, 0xe4, 0x1f,
+	0x80, 0x05, 0x8c, 0x74, 0xa7, 0xe5, 0xaf, 0x54, 0xdf, 0xbe, 0xa4, 0xb6, 0x6c, 0x26, 0x32, 0x75,
+	0x48, 0xae, 0xa8, 0x10, 0x7d, 0x56, 0x51, 0xa1, 0x75, 0x4f, 0xb7, 0x9f, 0x52, 0xac, 0xe3, 0xf5,
+	0xd1, 0x31, 0x7c, 0xad, 0x85, 0xbb, 0xa4, 0xbd, 0x06, 0xf9, 0x7b, 0xed, 0x35, 0x68, 0xf0, 0xa4,
+	0xfb, 0x8d, 0x03, 0xab, 0xde, 0xc5, 0xc8, 0xfa, 0xd2, 0xc7, 0x20, 0xad, 0xf4, 0xac, 0x23, 0x84,
+	0x35, 0x6b, 0xc8, 0x74, 0xdb, 0x3a, 0x1f, 0x15, 0xaf, 0x3b, 0xd7, 0xb1, 0x67, 0x12, 0x62, 0x79,
+	0x5e, 0xb8, 0xf3, 0x0f, 0xbd, 0x06, 0x94, 0xa2, 0x0d, 0x68, 0x23, 0x86, 0x6b, 0x64, 0xe3, 0xa1,
+	0xaf, 0xdb, 0xc5, 0x66, 0x36, 0xe1, 0xa8, 0x89, 0x38, 0x70, 0x7e, 0x21, 0x07, 0x6e, 0x06, 0x96,
+	0x5c, 0xba, 0xd5, 0x93, 0x34, 0x98, 0x6f, 0x10, 0x95, 0xc7, 0xe0, 0x7a, 0x68, 0x60, 0x2e, 0xc7,
+	0x1c, 0x2d, 0x62, 0xac, 0xcb, 0x57, 0xaf, 0x8e, 0xf5, 0x46, 0x40, 0x1d, 0xac, 0x04, 0x66, 0xb8,
+	0x52, 0xfc, 0x2e, 0x93, 0xc8, 0xfc, 0xce, 0x55, 0x91, 0x5e, 0xb4, 0x67, 0x80, 0x8f, 0x98, 0x67,
+	0xb6, 0xe2, 0xf7, 0x09, 0xa3, 0xf3, 0xef, 0x4d, 0x83, 0xf6, 0x47, 0x96, 0xa7, 0x8a, 0x2c, 0x4f,
+	0x15, 0x39, 0x7e, 0x56, 0xb0, 0x15, 0x0e, 0xcc, 0x09, 0xa5, 0x24, 0x06, 0x7e, 0x64, 0x92, 0xc2,
+	0xd1, 0xf7, 0x3a, 0xff, 0x3d, 0x07, 0x72, 0xf1, 0x97, 0xd8, 0x6e, 0xfc, 0x7e, 0xb1, 0x4e, 0xf9,
+	0x87, 0xaf, 0xe0, 0xe4, 0x9d, 0xa7, 0x07, 0xd6, 0xc2, 0x33, 0xc6, 0xbb, 0xf1, 0x3b, 0x86, 0xc0,
+	0xf9, 0xdd, 0x29, 0xc0, 0xfe, 0xb0, 0xe1, 0x0b, 0x2b, 0x21, 0x6c, 0x08, 0x9c, 0x14, 0x36, 0xb6,
+	0xe7, 0x3b, 0x55, 0x16, 0xea, 0xf7, 0x89, 0x55, 0x16, 0x44, 0x27, 0x57, 0x59, 0x5c, 0xcb, 0xe4,
+	0x0f, 0xc1, 0x9b, 0x13, 0xed, 0xf2, 0xde, 0x65, 0xef, 0x26, 0x53, 0x57, 0xbc, 0x1a, 0xce, 0x8d,
+	0x93, 0x5f, 0xfc, 0xfa, 0xec, 0xa4, 0xcc, 0xd5, 0x3f, 0x7b, 0x31, 0x2e, 0x70, 0x2f, 0xc7, 0x05,
+	0xee, 0xaf, 0x71, 0x81, 0xfb, 0xe1, 0xb4, 0x30, 0xf7, 0xf2, 0xb4, 0x30, 0xf7, 0xc7, 0x69, 0x61,
+	0xee, 0xcb, 0x07, 0xbe, 0xaf, 0x5a, 0x77, 0xeb, 0x6d, 0x5d, 0x69, 0x11, 0xef, 0x49, 0xea, 0x57,
+	0xaa, 0xd2, 0x33, 0x5f, 0xab, 0xa4, 0x9f, 0xba, 0xad, 0x25, 0xfa, 0x9f, 0x83, 0xdd, 0x7f, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xc2, 0xd5, 0x3f, 0xba, 0x3d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -999,6 +1234,12 @@ type MsgClient interface {
 	// SetOperatorParams defines the operation for setting a operator's
 	// parameters.
 	SetOperatorParams(ctx context.Context, in *MsgSetOperatorParams, opts ...grpc.CallOption) (*MsgSetOperatorParamsResponse, error)
+	// GrantOperatorRole defines the operation for granting a role of an
+	// operator to another account.
+	GrantOperatorRole(ctx context.Context, in *MsgGrantOperatorRole, opts ...grpc.CallOption) (*MsgGrantOperatorRoleResponse, error)
+	// RevokeOperatorRole defines the operation for revoking a role of an
+	// operator previously granted to another account.
+	RevokeOperatorRole(ctx context.Context, in *MsgRevokeOperatorRole, opts ...grpc.CallOption) (*MsgRevokeOperatorRoleResponse, error)
 	// UpdateParams defines a governance operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -1076,6 +1317,24 @@ func (c *msgClient) SetOperatorParams(ctx context.Context, in *MsgSetOperatorPar
 	return out, nil
 }
 
+func (c *msgClient) GrantOperatorRole(ctx context.Context, in *MsgGrantOperatorRole, opts ...grpc.CallOption) (*MsgGrantOperatorRoleResponse, error) {
+	out := new(MsgGrantOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.operators.v1.Msg/GrantOperatorRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeOperatorRole(ctx context.Context, in *MsgRevokeOperatorRole, opts ...grpc.CallOption) (*MsgRevokeOperatorRoleResponse, error) {
+	out := new(MsgRevokeOperatorRoleResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.operators.v1.Msg/RevokeOperatorRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.operators.v1.Msg/UpdateParams", in, out, opts...)
@@ -1107,6 +1366,12 @@ type MsgServer interface {
 	// SetOperatorParams defines the operation for setting a operator's
 	// parameters.
 	SetOperatorParams(context.Context, *MsgSetOperatorParams) (*MsgSetOperatorParamsResponse, error)
+	// GrantOperatorRole defines the operation for granting a role of an
+	// operator to another account.
+	GrantOperatorRole(context.Context, *MsgGrantOperatorRole) (*MsgGrantOperatorRoleResponse, error)
+	// RevokeOperatorRole defines the operation for revoking a role of an
+	// operator previously granted to another account.
+	RevokeOperatorRole(context.Context, *MsgRevokeOperatorRole) (*MsgRevokeOperatorRoleResponse, error)
 	// UpdateParams defines a governance operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -1138,6 +1403,12 @@ func (*UnimplementedMsgServer) TransferOperatorOwnership(ctx context.Context, re
 func (*UnimplementedMsgServer) SetOperatorParams(ctx context.Context, req *MsgSetOperatorParams) (*MsgSetOperatorParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorParams not implemented")
 }
+func (*UnimplementedMsgServer) GrantOperatorRole(ctx context.Context, req *MsgGrantOperatorRole) (*MsgGrantOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantOperatorRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeOperatorRole(ctx context.Context, req *MsgRevokeOperatorRole) (*MsgRevokeOperatorRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperatorRole not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1272,6 +1543,42 @@ func _Msg_SetOperatorParams_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.operators.v1.Msg/GrantOperatorRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantOperatorRole(ctx, req.(*MsgGrantOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOperatorRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.operators.v1.Msg/RevokeOperatorRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOperatorRole(ctx, req.(*MsgRevokeOperatorRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1323,6 +1630,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetOperatorParams",
 			Handler:    _Msg_SetOperatorParams_Handler,
 		},
+		{
+			MethodName: "GrantOperatorRole",
+			Handler:    _Msg_GrantOperatorRole_Handler,
+		},
+		{
+			MethodName: "RevokeOperatorRole",
+			Handler:    _Msg_RevokeOperatorRole_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1811,7 +2126,7 @@ func (m *MsgTransferOperatorOwnershipResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantOperatorRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1821,37 +2136,44 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantOperatorRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantOperatorRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
+	if m.Role != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantOperatorRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1861,12 +2183,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantOperatorRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantOperatorRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1874,38 +2196,171 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRevokeOperatorRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterOperator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRevokeOperatorRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PictureURL)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOperatorRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperatorRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.PictureURL)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
 	}
 	if len(m.FeeAmount) > 0 {
 		for _, e := range m.FeeAmount {
@@ -2096,6 +2551,70 @@ func (m *MsgTransferOperatorOwnershipResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgGrantOperatorRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovMessages(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgGrantOperatorRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeOperatorRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovMessages(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRevokeOperatorRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3474,6 +3993,410 @@ func (m *MsgTransferOperatorOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGrantOperatorRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantOperatorRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantOperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= OperatorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantOperatorRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantOperatorRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantOperatorRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperatorRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= OperatorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperatorRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
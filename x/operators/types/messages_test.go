@@ -324,6 +324,7 @@ var msgUpdateParams = types.NewMsgUpdateParams(
 	types.NewParams(
 		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 		24*time.Hour,
+		24*time.Hour,
 	),
 	"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
 )
@@ -337,7 +338,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid params return error",
 			msg: types.NewMsgUpdateParams(
-				types.NewParams(nil, 0),
+				types.NewParams(nil, 0, 24*time.Hour),
 				msgUpdateParams.Authority,
 			),
 			shouldErr: true,
@@ -371,7 +372,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateParams_GetSignBytes(t *testing.T) {
-	expected := `{"type":"milkyway/operators/MsgUpdateParams","value":{"authority":"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4","params":{"deactivation_time":"86400000000000","operator_registration_fee":[{"amount":"100000000","denom":"uatom"}]}}}`
+	expected := `{"type":"milkyway/operators/MsgUpdateParams","value":{"authority":"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4","params":{"commission_change_notice_period":"86400000000000","deactivation_time":"86400000000000","operator_registration_fee":[{"amount":"100000000","denom":"uatom"}]}}}`
 	require.Equal(t, expected, string(msgUpdateParams.GetSignBytes()))
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -220,22 +221,115 @@ func (o *Operator) Update(update OperatorUpdate) Operator {
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewOperatorParams creates a new OperatorParams instance
-func NewOperatorParams(commissionRate math.LegacyDec) OperatorParams {
+func NewOperatorParams(commissionRate, maxRate, maxChangeRate math.LegacyDec) OperatorParams {
 	return OperatorParams{
 		CommissionRate: commissionRate,
+		MaxRate:        maxRate,
+		MaxChangeRate:  maxChangeRate,
 	}
 }
 
 // DefaultOperatorParams returns the default operator params
 func DefaultOperatorParams() OperatorParams {
-	return NewOperatorParams(math.LegacyZeroDec())
+	return NewOperatorParams(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyOneDec())
 }
 
 // Validate validates the operator params
 func (p *OperatorParams) Validate() error {
-	if p.CommissionRate.IsNegative() || p.CommissionRate.GT(math.LegacyOneDec()) {
+	if p.CommissionRate.IsNil() || p.CommissionRate.IsNegative() || p.CommissionRate.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("invalid commission rate: %s", p.CommissionRate.String())
 	}
 
+	if p.MaxRate.IsNil() || p.MaxRate.IsNegative() || p.MaxRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid max rate: %s", p.MaxRate.String())
+	}
+
+	if p.MaxChangeRate.IsNil() || p.MaxChangeRate.IsNegative() || p.MaxChangeRate.GT(p.MaxRate) {
+		return fmt.Errorf("invalid max change rate: %s", p.MaxChangeRate.String())
+	}
+
+	if p.CommissionRate.GT(p.MaxRate) {
+		return fmt.Errorf("commission rate cannot be greater than the max rate: %s > %s", p.CommissionRate, p.MaxRate)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// CommissionChangeInterval represents the minimum amount of time that must
+// pass between two commission changes of the same operator
+const CommissionChangeInterval = 24 * time.Hour
+
+// NewCommissionChange creates a new CommissionChange instance
+func NewCommissionChange(
+	operatorID uint32, commissionRate math.LegacyDec, announceTime time.Time, effectiveTime time.Time,
+) CommissionChange {
+	return CommissionChange{
+		OperatorID:     operatorID,
+		CommissionRate: commissionRate,
+		AnnounceTime:   announceTime,
+		EffectiveTime:  effectiveTime,
+	}
+}
+
+// Validate checks that the CommissionChange has valid values.
+func (c *CommissionChange) Validate() error {
+	if c.OperatorID == 0 {
+		return fmt.Errorf("invalid operator ID: %d", c.OperatorID)
+	}
+
+	if c.CommissionRate.IsNil() || c.CommissionRate.IsNegative() || c.CommissionRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid commission rate: %s", c.CommissionRate.String())
+	}
+
+	if c.AnnounceTime.IsZero() {
+		return fmt.Errorf("invalid announce time: %s", c.AnnounceTime)
+	}
+
+	if c.EffectiveTime.Before(c.AnnounceTime) {
+		return fmt.Errorf("effective time cannot be before the announce time: %s", c.EffectiveTime)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IsValidOperatorRole returns true if the given role is one of the roles that
+// can be granted by an operator admin
+func IsValidOperatorRole(role OperatorRole) bool {
+	switch role {
+	case OPERATOR_ROLE_METADATA, OPERATOR_ROLE_COMMISSION, OPERATOR_ROLE_JOIN_LEAVE:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewOperatorRoleGrant creates a new OperatorRoleGrant instance
+func NewOperatorRoleGrant(operatorID uint32, grantee string, role OperatorRole) OperatorRoleGrant {
+	return OperatorRoleGrant{
+		OperatorID: operatorID,
+		Grantee:    grantee,
+		Role:       role,
+	}
+}
+
+// Validate checks that the OperatorRoleGrant has valid values.
+func (g *OperatorRoleGrant) Validate() error {
+	if g.OperatorID == 0 {
+		return fmt.Errorf("invalid operator ID: %d", g.OperatorID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(g.Grantee)
+	if err != nil {
+		return fmt.Errorf("invalid grantee address: %s", g.Grantee)
+	}
+
+	if !IsValidOperatorRole(g.Role) {
+		return fmt.Errorf("invalid role: %s", g.Role)
+	}
+
 	return nil
 }
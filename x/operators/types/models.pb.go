@@ -11,15 +11,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -42,6 +46,9 @@ const (
 	// OPERATOR_STATUS_INACTIVE defines an inactive operator that is not providing
 	// services
 	OPERATOR_STATUS_INACTIVE OperatorStatus = 3
+	// OPERATOR_STATUS_JAILED identifies an operator that has been permanently
+	// jailed due to a severe misbehavior and is no longer providing services
+	OPERATOR_STATUS_JAILED OperatorStatus = 4
 )
 
 var OperatorStatus_name = map[int32]string{
@@ -49,6 +56,7 @@ var OperatorStatus_name = map[int32]string{
 	1: "OPERATOR_STATUS_ACTIVE",
 	2: "OPERATOR_STATUS_INACTIVATING",
 	3: "OPERATOR_STATUS_INACTIVE",
+	4: "OPERATOR_STATUS_JAILED",
 }
 
 var OperatorStatus_value = map[string]int32{
@@ -56,6 +64,7 @@ var OperatorStatus_value = map[string]int32{
 	"OPERATOR_STATUS_ACTIVE":       1,
 	"OPERATOR_STATUS_INACTIVATING": 2,
 	"OPERATOR_STATUS_INACTIVE":     3,
+	"OPERATOR_STATUS_JAILED":       4,
 }
 
 func (x OperatorStatus) String() string {
@@ -66,6 +75,45 @@ func (OperatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8fb40b1089c56c16, []int{0}
 }
 
+// OperatorRole defines the permissions that the admin of an operator can
+// grant to other accounts
+type OperatorRole int32
+
+const (
+	// OPERATOR_ROLE_UNSPECIFIED defines an unspecified role
+	OPERATOR_ROLE_UNSPECIFIED OperatorRole = 0
+	// OPERATOR_ROLE_METADATA allows to update the operator details
+	OPERATOR_ROLE_METADATA OperatorRole = 1
+	// OPERATOR_ROLE_COMMISSION allows to update the operator params, including
+	// the commission rate
+	OPERATOR_ROLE_COMMISSION OperatorRole = 2
+	// OPERATOR_ROLE_JOIN_LEAVE allows to join and leave services on behalf of
+	// the operator
+	OPERATOR_ROLE_JOIN_LEAVE OperatorRole = 3
+)
+
+var OperatorRole_name = map[int32]string{
+	0: "OPERATOR_ROLE_UNSPECIFIED",
+	1: "OPERATOR_ROLE_METADATA",
+	2: "OPERATOR_ROLE_COMMISSION",
+	3: "OPERATOR_ROLE_JOIN_LEAVE",
+}
+
+var OperatorRole_value = map[string]int32{
+	"OPERATOR_ROLE_UNSPECIFIED": 0,
+	"OPERATOR_ROLE_METADATA":    1,
+	"OPERATOR_ROLE_COMMISSION":  2,
+	"OPERATOR_ROLE_JOIN_LEAVE":  3,
+}
+
+func (x OperatorRole) String() string {
+	return proto.EnumName(OperatorRole_name, int32(x))
+}
+
+func (OperatorRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8fb40b1089c56c16, []int{1}
+}
+
 // Operator defines the fields of an operator
 type Operator struct {
 	// ID is the auto-generated unique identifier for the operator
@@ -128,6 +176,12 @@ type OperatorParams struct {
 	// CommissionRate defines the commission rate charged to delegators, as a
 	// fraction.
 	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// MaxRate defines the maximum commission rate which the operator can ever
+	// charge, as a fraction. It can only be decreased.
+	MaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate"`
+	// MaxChangeRate defines the maximum daily increase or decrease of the
+	// commission rate, as a fraction. It can only be decreased.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
 }
 
 func (m *OperatorParams) Reset()         { *m = OperatorParams{} }
@@ -163,10 +217,103 @@ func (m *OperatorParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperatorParams proto.InternalMessageInfo
 
+// CommissionChange represents the last commission rate change that has been
+// announced by an operator.
+type CommissionChange struct {
+	// OperatorID is the ID of the operator.
+	OperatorID uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// CommissionRate is the new commission rate, as a fraction.
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// AnnounceTime is the time at which the change has been announced.
+	AnnounceTime time.Time `protobuf:"bytes,3,opt,name=announce_time,json=announceTime,proto3,stdtime" json:"announce_time"`
+	// EffectiveTime is the time at which the new commission rate takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *CommissionChange) Reset()         { *m = CommissionChange{} }
+func (m *CommissionChange) String() string { return proto.CompactTextString(m) }
+func (*CommissionChange) ProtoMessage()    {}
+func (*CommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fb40b1089c56c16, []int{2}
+}
+func (m *CommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChange.Merge(m, src)
+}
+func (m *CommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChange proto.InternalMessageInfo
+
+// OperatorRoleGrant represents a role that has been granted by the admin of an
+// operator to another account.
+type OperatorRoleGrant struct {
+	// OperatorID is the ID of the operator.
+	OperatorID uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Grantee is the address of the account that has been granted the role.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role that has been granted.
+	Role OperatorRole `protobuf:"varint,3,opt,name=role,proto3,enum=milkyway.operators.v1.OperatorRole" json:"role,omitempty"`
+}
+
+func (m *OperatorRoleGrant) Reset()         { *m = OperatorRoleGrant{} }
+func (m *OperatorRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*OperatorRoleGrant) ProtoMessage()    {}
+func (*OperatorRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fb40b1089c56c16, []int{3}
+}
+func (m *OperatorRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorRoleGrant.Merge(m, src)
+}
+func (m *OperatorRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorRoleGrant proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("milkyway.operators.v1.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
+	proto.RegisterEnum("milkyway.operators.v1.OperatorRole", OperatorRole_name, OperatorRole_value)
 	proto.RegisterType((*Operator)(nil), "milkyway.operators.v1.Operator")
 	proto.RegisterType((*OperatorParams)(nil), "milkyway.operators.v1.OperatorParams")
+	proto.RegisterType((*CommissionChange)(nil), "milkyway.operators.v1.CommissionChange")
+	proto.RegisterType((*OperatorRoleGrant)(nil), "milkyway.operators.v1.OperatorRoleGrant")
 }
 
 func init() {
@@ -174,46 +321,63 @@ func init() {
 }
 
 var fileDescriptor_8fb40b1089c56c16 = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x77, 0xd3, 0x36, 0x6d, 0xa7, 0xfc, 0xd3, 0x30, 0xf4, 0x5f, 0xb6, 0x69, 0xd9, 0x84,
-	0x8a, 0x10, 0x94, 0xce, 0x98, 0xf4, 0xe0, 0xc9, 0x43, 0xd2, 0x44, 0x09, 0x94, 0x36, 0x4c, 0x12,
-	0x0f, 0x1e, 0x0c, 0x93, 0xdd, 0x21, 0x1d, 0x92, 0xdd, 0x09, 0x33, 0x93, 0xd4, 0x80, 0x1f, 0xc0,
-	0x8b, 0x20, 0xf8, 0x11, 0xbc, 0x79, 0xf6, 0x43, 0xf4, 0x58, 0x3c, 0x89, 0x87, 0xa8, 0xe9, 0x17,
-	0x91, 0xec, 0xce, 0xb6, 0xa5, 0x54, 0xf4, 0xb4, 0xfb, 0xce, 0xf3, 0x3c, 0xf3, 0x1b, 0xe6, 0x7d,
-	0x07, 0xec, 0x07, 0x7c, 0x38, 0x98, 0x9e, 0xd3, 0x29, 0x16, 0x23, 0x26, 0xa9, 0x16, 0x52, 0xe1,
-	0x49, 0x09, 0x07, 0xc2, 0x67, 0x43, 0x85, 0x46, 0x52, 0x68, 0x01, 0xff, 0x4f, 0x3c, 0xe8, 0xda,
-	0x83, 0x26, 0xa5, 0x9c, 0xeb, 0x09, 0x15, 0x08, 0x85, 0x7b, 0x54, 0x31, 0x3c, 0x29, 0xf5, 0x98,
-	0xa6, 0x25, 0xec, 0x09, 0x1e, 0xc6, 0xb1, 0xdc, 0x4e, 0xac, 0x77, 0xa3, 0x0a, 0xc7, 0x85, 0x91,
-	0xb6, 0xfa, 0xa2, 0x2f, 0xe2, 0xf5, 0xc5, 0x5f, 0xbc, 0xba, 0xff, 0x7e, 0x19, 0xac, 0x9d, 0x1a,
-	0x02, 0xdc, 0x06, 0x29, 0xee, 0x3b, 0x76, 0xc1, 0x2e, 0xfe, 0x57, 0x4d, 0xcf, 0x67, 0xf9, 0x54,
-	0xa3, 0x46, 0x52, 0xdc, 0x87, 0xcf, 0x40, 0x5a, 0x69, 0xaa, 0xc7, 0xca, 0x49, 0x15, 0xec, 0x62,
-	0xa6, 0xfc, 0x10, 0xdd, 0x7b, 0x3a, 0x94, 0x6c, 0xd4, 0x8a, 0xcc, 0xc4, 0x84, 0x20, 0x02, 0x2b,
-	0xd4, 0x0f, 0x78, 0xe8, 0x2c, 0x15, 0xec, 0xe2, 0x7a, 0xd5, 0xf9, 0xfa, 0xe5, 0x60, 0xcb, 0x1c,
-	0xad, 0xe2, 0xfb, 0x92, 0x29, 0xd5, 0xd2, 0x92, 0x87, 0x7d, 0x12, 0xdb, 0xa0, 0x03, 0x56, 0x03,
-	0x11, 0xf2, 0x01, 0x93, 0xce, 0xf2, 0x22, 0x41, 0x92, 0x72, 0xa1, 0x9c, 0xb3, 0x9e, 0xe2, 0x9a,
-	0x39, 0x2b, 0xb1, 0x62, 0x4a, 0x88, 0xc1, 0xc6, 0x88, 0x7b, 0x7a, 0x2c, 0x59, 0x77, 0x2c, 0x87,
-	0x4e, 0x3a, 0x22, 0x65, 0xe6, 0xb3, 0x3c, 0x68, 0xc6, 0xcb, 0x1d, 0x72, 0x4c, 0x80, 0xb1, 0x74,
-	0xe4, 0x10, 0x96, 0xc1, 0x2a, 0x8d, 0xe1, 0xce, 0xea, 0x5f, 0x8e, 0x95, 0x18, 0xa1, 0x07, 0xd2,
-	0x5a, 0x0c, 0x58, 0xa8, 0x9c, 0xb5, 0xc2, 0x52, 0x71, 0xa3, 0xbc, 0x83, 0x8c, 0x7f, 0xd1, 0x0e,
-	0x64, 0xda, 0x81, 0x8e, 0x04, 0x0f, 0xab, 0x4f, 0x2e, 0x66, 0x79, 0xeb, 0xf3, 0x8f, 0x7c, 0xb1,
-	0xcf, 0xf5, 0xd9, 0xb8, 0x87, 0x3c, 0x11, 0x98, 0x76, 0x98, 0xcf, 0x81, 0xf2, 0x07, 0x58, 0x4f,
-	0x47, 0x4c, 0x45, 0x01, 0x45, 0xcc, 0xd6, 0xf0, 0x2d, 0xc8, 0xfa, 0x6c, 0xc8, 0xfa, 0x8b, 0x8b,
-	0xec, 0xaa, 0x33, 0x2a, 0x99, 0x72, 0xd6, 0x23, 0xdc, 0xde, 0xbd, 0xb8, 0x1a, 0xf3, 0x22, 0xe2,
-	0xa1, 0x21, 0x3e, 0xfe, 0x07, 0xa2, 0xc9, 0x28, 0xb2, 0x79, 0x8d, 0x6a, 0x45, 0xa4, 0xfd, 0xd7,
-	0x20, 0x93, 0x74, 0xb1, 0x49, 0x25, 0x0d, 0x14, 0x3c, 0x06, 0x9b, 0x9e, 0x08, 0x02, 0xae, 0x14,
-	0x17, 0x61, 0x57, 0x52, 0xcd, 0xa2, 0x09, 0x59, 0xaf, 0x3e, 0x58, 0x00, 0xbf, 0xcf, 0xf2, 0xbb,
-	0xf1, 0xf6, 0xca, 0x1f, 0x20, 0x2e, 0x70, 0x40, 0xf5, 0x19, 0x3a, 0x66, 0x7d, 0xea, 0x4d, 0x6b,
-	0xcc, 0x23, 0x99, 0x9b, 0x2c, 0xa1, 0x9a, 0x3d, 0xfa, 0x68, 0xdf, 0x00, 0xe2, 0x31, 0x81, 0x79,
-	0xb0, 0x7b, 0xda, 0xac, 0x93, 0x4a, 0xfb, 0x94, 0x74, 0x5b, 0xed, 0x4a, 0xbb, 0xd3, 0xea, 0x76,
-	0x4e, 0x5a, 0xcd, 0xfa, 0x51, 0xe3, 0x79, 0xa3, 0x5e, 0xcb, 0x5a, 0x30, 0x07, 0xb6, 0xef, 0x1a,
-	0x2a, 0x47, 0xed, 0xc6, 0xcb, 0x7a, 0xd6, 0x86, 0x05, 0xb0, 0x77, 0x57, 0x6b, 0x9c, 0x44, 0x6a,
-	0xa5, 0xdd, 0x38, 0x79, 0x91, 0x4d, 0xc1, 0x3d, 0xe0, 0xfc, 0xc1, 0x51, 0xcf, 0x2e, 0xe5, 0x96,
-	0xdf, 0x7d, 0x72, 0xad, 0x6a, 0xe7, 0xe2, 0x97, 0x6b, 0x5d, 0xcc, 0x5d, 0xfb, 0x72, 0xee, 0xda,
-	0x3f, 0xe7, 0xae, 0xfd, 0xe1, 0xca, 0xb5, 0x2e, 0xaf, 0x5c, 0xeb, 0xdb, 0x95, 0x6b, 0xbd, 0x7a,
-	0x7a, 0xeb, 0x46, 0x93, 0xc1, 0x3f, 0x18, 0xd2, 0x9e, 0xba, 0xae, 0xf0, 0xa4, 0x54, 0xc6, 0x6f,
-	0x6e, 0x3d, 0xe7, 0xe8, 0x9a, 0x7b, 0xe9, 0xe8, 0x8d, 0x1d, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x5f, 0xbe, 0xd6, 0xf1, 0x03, 0x00, 0x00,
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0x6c, 0xd2, 0x4e, 0xb7, 0x69, 0x18, 0x2d, 0x2b, 0x37, 0x5b, 0x9c, 0x28, 0x2b,
+	0xa4, 0x68, 0x51, 0x6d, 0x92, 0x3d, 0xec, 0x09, 0x24, 0x27, 0x31, 0x2b, 0xef, 0xa6, 0x49, 0xe5,
+	0x24, 0x1c, 0xb8, 0x58, 0x13, 0x7b, 0xea, 0x5a, 0xb1, 0x3d, 0x91, 0x67, 0x92, 0x6d, 0x25, 0x7e,
+	0x00, 0x12, 0x42, 0xda, 0xff, 0xc0, 0x09, 0x2e, 0x08, 0x89, 0x1f, 0xd1, 0xe3, 0x8a, 0x13, 0xe2,
+	0xd0, 0x85, 0xf4, 0xc8, 0x9f, 0x40, 0x9e, 0xb1, 0x43, 0xa9, 0xca, 0x6e, 0xcb, 0xa9, 0x7d, 0xf3,
+	0x7d, 0xdf, 0x7b, 0x9f, 0xdf, 0xbc, 0x37, 0x01, 0x8d, 0xd0, 0x0f, 0x66, 0x67, 0xaf, 0xd0, 0x99,
+	0x46, 0xe6, 0x38, 0x46, 0x8c, 0xc4, 0x54, 0x5b, 0xb6, 0xb4, 0x90, 0xb8, 0x38, 0xa0, 0xea, 0x3c,
+	0x26, 0x8c, 0xc0, 0x0f, 0x33, 0x8e, 0xba, 0xe6, 0xa8, 0xcb, 0x56, 0x55, 0x71, 0x08, 0x0d, 0x09,
+	0xd5, 0xa6, 0x88, 0x62, 0x6d, 0xd9, 0x9a, 0x62, 0x86, 0x5a, 0x9a, 0x43, 0xfc, 0x48, 0xc8, 0xaa,
+	0x7b, 0x02, 0xb7, 0x79, 0xa4, 0x89, 0x20, 0x85, 0x1e, 0x78, 0xc4, 0x23, 0xe2, 0x3c, 0xf9, 0x2f,
+	0x3d, 0xad, 0x79, 0x84, 0x78, 0x01, 0xd6, 0x78, 0x34, 0x5d, 0x1c, 0x6b, 0xcc, 0x0f, 0x31, 0x65,
+	0x28, 0x9c, 0x0b, 0x42, 0xe3, 0xbb, 0x02, 0xd8, 0x1c, 0xa6, 0x16, 0xe0, 0x43, 0x90, 0xf7, 0x5d,
+	0x59, 0xaa, 0x4b, 0xcd, 0x9d, 0x4e, 0x71, 0x75, 0x51, 0xcb, 0x9b, 0x3d, 0x2b, 0xef, 0xbb, 0xf0,
+	0x33, 0x50, 0xa4, 0x0c, 0xb1, 0x05, 0x95, 0xf3, 0x75, 0xa9, 0x59, 0x6e, 0x7f, 0xac, 0xde, 0x68,
+	0x5f, 0xcd, 0x12, 0x8d, 0x38, 0xd9, 0x4a, 0x45, 0x50, 0x05, 0xf7, 0x90, 0x1b, 0xfa, 0x91, 0xbc,
+	0x51, 0x97, 0x9a, 0x5b, 0x1d, 0xf9, 0xd7, 0x5f, 0x0e, 0x1e, 0xa4, 0xde, 0x75, 0xd7, 0x8d, 0x31,
+	0xa5, 0x23, 0x16, 0xfb, 0x91, 0x67, 0x09, 0x1a, 0x94, 0x41, 0x29, 0x24, 0x91, 0x3f, 0xc3, 0xb1,
+	0x5c, 0x48, 0x14, 0x56, 0x16, 0x26, 0xc8, 0x2b, 0x3c, 0xa5, 0x3e, 0xc3, 0xf2, 0x3d, 0x81, 0xa4,
+	0x21, 0xd4, 0xc0, 0xf6, 0xdc, 0x77, 0xd8, 0x22, 0xc6, 0xf6, 0x22, 0x0e, 0xe4, 0x22, 0xaf, 0x54,
+	0x5e, 0x5d, 0xd4, 0xc0, 0x91, 0x38, 0x9e, 0x58, 0x7d, 0x0b, 0xa4, 0x94, 0x49, 0x1c, 0xc0, 0x36,
+	0x28, 0x21, 0x51, 0x5c, 0x2e, 0xbd, 0xc7, 0x56, 0x46, 0x84, 0x0e, 0x28, 0x32, 0x32, 0xc3, 0x11,
+	0x95, 0x37, 0xeb, 0x1b, 0xcd, 0xed, 0xf6, 0x9e, 0x9a, 0xf2, 0x93, 0xfb, 0x52, 0xd3, 0xfb, 0x52,
+	0xbb, 0xc4, 0x8f, 0x3a, 0x9f, 0x9e, 0x5f, 0xd4, 0x72, 0x3f, 0xbe, 0xad, 0x35, 0x3d, 0x9f, 0x9d,
+	0x2c, 0xa6, 0xaa, 0x43, 0xc2, 0xf4, 0xbe, 0xd2, 0x3f, 0x07, 0xd4, 0x9d, 0x69, 0xec, 0x6c, 0x8e,
+	0x29, 0x17, 0x50, 0x2b, 0x4d, 0x0d, 0xbf, 0x06, 0x15, 0x17, 0x07, 0xd8, 0x4b, 0x1a, 0x69, 0xd3,
+	0x13, 0x14, 0x63, 0x2a, 0x6f, 0xf1, 0x72, 0xfb, 0x37, 0x96, 0xeb, 0x61, 0x87, 0x57, 0x7c, 0x9a,
+	0x56, 0xfc, 0xe4, 0x16, 0x15, 0x53, 0x0d, 0xb5, 0x76, 0xd7, 0xa5, 0x46, 0xbc, 0x52, 0xe3, 0x2f,
+	0x09, 0x94, 0xb3, 0x6b, 0x3c, 0x42, 0x31, 0x0a, 0x29, 0xec, 0x83, 0x5d, 0x87, 0x84, 0xa1, 0x4f,
+	0xa9, 0x4f, 0x22, 0x3b, 0x46, 0x0c, 0xf3, 0x11, 0xd9, 0xea, 0x3c, 0x4e, 0x2a, 0xfe, 0x7e, 0x51,
+	0x7b, 0x24, 0xf2, 0x53, 0x77, 0xa6, 0xfa, 0x44, 0x0b, 0x11, 0x3b, 0x51, 0xfb, 0xd8, 0x43, 0xce,
+	0x59, 0x0f, 0x3b, 0x56, 0xf9, 0x1f, 0xad, 0x85, 0x18, 0x86, 0x9f, 0x83, 0xcd, 0x10, 0x9d, 0x8a,
+	0x34, 0xf9, 0xdb, 0xa7, 0x29, 0x85, 0xe8, 0x94, 0xeb, 0x5f, 0x82, 0xdd, 0x44, 0xef, 0x9c, 0xa0,
+	0xc8, 0xc3, 0x22, 0xcd, 0xc6, 0xed, 0xd3, 0xec, 0x84, 0xe8, 0xb4, 0xcb, 0xa5, 0x49, 0xb2, 0xc6,
+	0x0f, 0x79, 0x50, 0xe9, 0xae, 0xfd, 0x09, 0x20, 0x19, 0xa5, 0x6c, 0xaa, 0xed, 0xf5, 0x3a, 0xf0,
+	0x51, 0xca, 0x1a, 0x63, 0xf6, 0x2c, 0x90, 0x51, 0x4c, 0xf7, 0xa6, 0x06, 0xe5, 0xff, 0x7f, 0x83,
+	0x4c, 0xb0, 0x83, 0xa2, 0x88, 0x2c, 0x22, 0x07, 0xdb, 0xc9, 0xb6, 0xf2, 0xcf, 0xdb, 0x6e, 0x57,
+	0x55, 0xb1, 0xca, 0x6a, 0xb6, 0xca, 0xea, 0x38, 0x5b, 0xe5, 0xce, 0x66, 0x52, 0xe7, 0xf5, 0xdb,
+	0x9a, 0x64, 0xdd, 0xcf, 0xa4, 0x09, 0x08, 0x5f, 0x82, 0x32, 0x3e, 0x3e, 0xc6, 0x0e, 0xf3, 0x97,
+	0x69, 0xae, 0xc2, 0x1d, 0x72, 0xed, 0xac, 0xb5, 0x09, 0xda, 0xf8, 0x59, 0x02, 0x1f, 0x64, 0x0d,
+	0xb0, 0x48, 0x80, 0x9f, 0xc7, 0x28, 0x62, 0x77, 0x6f, 0x56, 0x1b, 0x94, 0xbc, 0x44, 0x89, 0xb3,
+	0x26, 0xbd, 0x63, 0xef, 0x52, 0x22, 0x7c, 0x06, 0x0a, 0x31, 0x09, 0x44, 0x27, 0xca, 0xed, 0xc7,
+	0xef, 0x79, 0x7d, 0x12, 0x73, 0x16, 0x17, 0x3c, 0xf9, 0xe9, 0xca, 0x34, 0x8b, 0x47, 0x09, 0xd6,
+	0xc0, 0xa3, 0xe1, 0x91, 0x61, 0xe9, 0xe3, 0xa1, 0x65, 0x8f, 0xc6, 0xfa, 0x78, 0x32, 0xb2, 0x27,
+	0x83, 0xd1, 0x91, 0xd1, 0x35, 0xbf, 0x30, 0x8d, 0x5e, 0x25, 0x07, 0xab, 0xe0, 0xe1, 0x75, 0x82,
+	0xde, 0x1d, 0x9b, 0x5f, 0x1a, 0x15, 0x09, 0xd6, 0xc1, 0xfe, 0x75, 0xcc, 0x1c, 0x70, 0x54, 0x1f,
+	0x9b, 0x83, 0xe7, 0x95, 0x3c, 0xdc, 0x07, 0xf2, 0x7f, 0x30, 0x8c, 0xca, 0xc6, 0x4d, 0xb9, 0x5f,
+	0xe8, 0x66, 0xdf, 0xe8, 0x55, 0x0a, 0xd5, 0xc2, 0x37, 0xdf, 0x2b, 0xb9, 0x27, 0xdf, 0x4a, 0xe0,
+	0xfe, 0xd5, 0x0f, 0x81, 0x1f, 0x81, 0xbd, 0xb5, 0xc4, 0x1a, 0xf6, 0x8d, 0x77, 0xb8, 0xe5, 0xf0,
+	0xa1, 0x31, 0xd6, 0x7b, 0xfa, 0x58, 0xaf, 0x48, 0xff, 0xf2, 0xc2, 0xb1, 0xee, 0xf0, 0xf0, 0xd0,
+	0x1c, 0x8d, 0xcc, 0xe1, 0xe0, 0x9a, 0x53, 0x8e, 0xbe, 0x18, 0x9a, 0x03, 0xbb, 0x6f, 0xe8, 0x89,
+	0x53, 0xe1, 0xa6, 0x33, 0x39, 0xff, 0x53, 0xc9, 0x9d, 0xaf, 0x14, 0xe9, 0xcd, 0x4a, 0x91, 0xfe,
+	0x58, 0x29, 0xd2, 0xeb, 0x4b, 0x25, 0xf7, 0xe6, 0x52, 0xc9, 0xfd, 0x76, 0xa9, 0xe4, 0xbe, 0x7a,
+	0x76, 0xe5, 0xa5, 0xc9, 0xae, 0xe4, 0x20, 0x40, 0x53, 0xba, 0x8e, 0xb4, 0x65, 0xab, 0xad, 0x9d,
+	0x5e, 0xf9, 0x1d, 0xe4, 0xcf, 0xcf, 0xb4, 0xc8, 0xe7, 0xee, 0xe9, 0xdf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xaa, 0x8c, 0x7f, 0x2c, 0x2a, 0x07, 0x00, 0x00,
 }
 
 func (m *Operator) Marshal() (dAtA []byte, err error) {
@@ -332,6 +496,26 @@ func (m *OperatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.CommissionRate.Size()
 		i -= size
@@ -345,6 +529,100 @@ func (m *OperatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModels(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AnnounceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintModels(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -411,6 +689,47 @@ func (m *OperatorParams) Size() (n int) {
 	_ = l
 	l = m.CommissionRate.Size()
 	n += 1 + l + sovModels(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *CommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *OperatorRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovModels(uint64(m.Role))
+	}
 	return n
 }
 
@@ -799,6 +1118,363 @@ func (m *OperatorParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AnnounceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= OperatorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
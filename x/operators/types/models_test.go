@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -338,3 +339,151 @@ func TestOperator_TokensFromShares(t *testing.T) {
 		})
 	}
 }
+
+func TestOperatorParams_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.OperatorParams
+		shouldErr bool
+	}{
+		{
+			name: "invalid commission rate returns error",
+			params: types.NewOperatorParams(
+				sdkmath.LegacyNewDec(-1),
+				sdkmath.LegacyOneDec(),
+				sdkmath.LegacyOneDec(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "max rate greater than one returns error",
+			params: types.NewOperatorParams(
+				sdkmath.LegacyZeroDec(),
+				sdkmath.LegacyNewDec(2),
+				sdkmath.LegacyOneDec(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "max change rate greater than max rate returns error",
+			params: types.NewOperatorParams(
+				sdkmath.LegacyZeroDec(),
+				sdkmath.LegacyMustNewDecFromStr("0.5"),
+				sdkmath.LegacyMustNewDecFromStr("0.6"),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "commission rate greater than max rate returns error",
+			params: types.NewOperatorParams(
+				sdkmath.LegacyMustNewDecFromStr("0.6"),
+				sdkmath.LegacyMustNewDecFromStr("0.5"),
+				sdkmath.LegacyMustNewDecFromStr("0.1"),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "missing max rate returns error",
+			params: types.OperatorParams{
+				CommissionRate: sdkmath.LegacyZeroDec(),
+				MaxChangeRate:  sdkmath.LegacyOneDec(),
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "default params return no error",
+			params:    types.DefaultOperatorParams(),
+			shouldErr: false,
+		},
+		{
+			name: "valid params return no error",
+			params: types.NewOperatorParams(
+				sdkmath.LegacyMustNewDecFromStr("0.1"),
+				sdkmath.LegacyMustNewDecFromStr("0.5"),
+				sdkmath.LegacyMustNewDecFromStr("0.05"),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommissionChange_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		change    types.CommissionChange
+		shouldErr bool
+	}{
+		{
+			name: "invalid operator ID returns error",
+			change: types.NewCommissionChange(
+				0,
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid commission rate returns error",
+			change: types.NewCommissionChange(
+				1,
+				sdkmath.LegacyNewDec(2),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero announce time returns error",
+			change: types.NewCommissionChange(
+				1,
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				time.Time{},
+				time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "effective time before announce time returns error",
+			change: types.NewCommissionChange(
+				1,
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid commission change returns no error",
+			change: types.NewCommissionChange(
+				1,
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
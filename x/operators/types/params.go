@@ -7,19 +7,28 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	DefaultDeactivationTime             = 3 * 24 * time.Hour // 3 days
+	DefaultCommissionChangeNoticePeriod = 24 * time.Hour     // 1 day
+)
+
 // NewParams creates a new Params object
-func NewParams(registrationFee sdk.Coins, deactivationTime time.Duration) Params {
+func NewParams(
+	registrationFee sdk.Coins, deactivationTime time.Duration, commissionChangeNoticePeriod time.Duration,
+) Params {
 	return Params{
-		OperatorRegistrationFee: registrationFee,
-		DeactivationTime:        deactivationTime,
+		OperatorRegistrationFee:      registrationFee,
+		DeactivationTime:             deactivationTime,
+		CommissionChangeNoticePeriod: commissionChangeNoticePeriod,
 	}
 }
 
 // DefaultParams returns default Params
 func DefaultParams() Params {
 	return Params{
-		OperatorRegistrationFee: nil,
-		DeactivationTime:        3 * 24 * time.Hour, // 3 days
+		OperatorRegistrationFee:      nil,
+		DeactivationTime:             DefaultDeactivationTime,
+		CommissionChangeNoticePeriod: DefaultCommissionChangeNoticePeriod,
 	}
 }
 
@@ -33,5 +42,9 @@ func (p *Params) Validate() error {
 		return ErrInvalidDeactivationTime
 	}
 
+	if p.CommissionChangeNoticePeriod <= 0 {
+		return fmt.Errorf("invalid commission change notice period: %s", p.CommissionChangeNoticePeriod)
+	}
+
 	return nil
 }
@@ -38,6 +38,10 @@ type Params struct {
 	// the time that an operator signals its willingness to deactivate and the
 	// time that it actually becomes inactive.
 	DeactivationTime time.Duration `protobuf:"varint,2,opt,name=deactivation_time,json=deactivationTime,proto3,stdduration" json:"deactivation_time,omitempty"`
+	// CommissionChangeNoticePeriod represents the amount of time that will pass
+	// between the time that an operator announces a commission rate change and
+	// the time that the new commission rate takes effect.
+	CommissionChangeNoticePeriod time.Duration `protobuf:"varint,3,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +91,13 @@ func (m *Params) GetDeactivationTime() time.Duration {
 	return 0
 }
 
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "milkyway.operators.v1.Params")
 }
@@ -96,28 +107,30 @@ func init() {
 }
 
 var fileDescriptor_c14ac199294977d8 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x31, 0x4e, 0xf3, 0x30,
-	0x14, 0xc7, 0xe3, 0xaf, 0x9f, 0x3a, 0x84, 0x05, 0x2a, 0x10, 0x6d, 0x85, 0x9c, 0x2a, 0x53, 0x97,
-	0xda, 0x72, 0x19, 0x90, 0x18, 0x8b, 0xc4, 0x5c, 0x2a, 0x26, 0x96, 0xca, 0x49, 0x1f, 0xc1, 0x6a,
-	0x5d, 0x47, 0xb6, 0x09, 0xe4, 0x16, 0x8c, 0xdc, 0x00, 0x89, 0x93, 0x74, 0xec, 0xc8, 0xd4, 0xa2,
-	0x46, 0x5c, 0x80, 0x13, 0xa0, 0x26, 0x4d, 0x94, 0xc9, 0xcf, 0xf6, 0xf3, 0xef, 0xff, 0xd3, 0xb3,
-	0xeb, 0x4b, 0xb1, 0x98, 0xa7, 0x2f, 0x3c, 0xa5, 0x2a, 0x06, 0xcd, 0xad, 0xd2, 0x86, 0x26, 0x8c,
-	0xc6, 0x5c, 0x73, 0x69, 0x48, 0xac, 0x95, 0x55, 0xad, 0xb3, 0xb2, 0x87, 0x54, 0x3d, 0x24, 0x61,
-	0x5d, 0x1c, 0x2a, 0x23, 0x95, 0xa1, 0x01, 0x37, 0x40, 0x13, 0x16, 0x80, 0xe5, 0x8c, 0x86, 0x4a,
-	0x2c, 0x8b, 0x67, 0xdd, 0xd3, 0x48, 0x45, 0x2a, 0x2f, 0xe9, 0xbe, 0x2a, 0x4e, 0xfd, 0x1f, 0xe4,
-	0x36, 0xc7, 0x39, 0xbd, 0xf5, 0x81, 0xdc, 0x4e, 0x49, 0x9c, 0x6a, 0x88, 0x84, 0xb1, 0x9a, 0x5b,
-	0xa1, 0x96, 0xd3, 0x47, 0x80, 0x36, 0xea, 0x35, 0xfa, 0x47, 0xc3, 0x0e, 0x29, 0x52, 0xc8, 0x3e,
-	0x85, 0x1c, 0x52, 0xc8, 0x8d, 0x12, 0xcb, 0xd1, 0x78, 0xb5, 0xf1, 0x9c, 0xdf, 0x8d, 0x77, 0x91,
-	0x72, 0xb9, 0xb8, 0xf6, 0x2b, 0x52, 0xa8, 0xa1, 0xa2, 0xf8, 0x9f, 0x5b, 0xaf, 0x1f, 0x09, 0xfb,
-	0xf4, 0x1c, 0x90, 0x50, 0x49, 0x7a, 0x50, 0x2e, 0x96, 0x81, 0x99, 0xcd, 0xa9, 0x4d, 0x63, 0x30,
-	0x39, 0xd0, 0x4c, 0xce, 0x4b, 0xc6, 0xa4, 0x26, 0x73, 0x0b, 0xd0, 0x62, 0xee, 0xc9, 0x0c, 0x78,
-	0x68, 0x45, 0x52, 0x90, 0xad, 0x90, 0xd0, 0xfe, 0xd7, 0x43, 0xfd, 0xc6, 0xe8, 0xff, 0xfb, 0xd6,
-	0x43, 0x93, 0xe3, 0xfa, 0xf5, 0xbd, 0x90, 0x30, 0xba, 0x5b, 0xed, 0x30, 0x5a, 0xef, 0x30, 0xfa,
-	0xde, 0x61, 0xf4, 0x96, 0x61, 0x67, 0x9d, 0x61, 0xe7, 0x2b, 0xc3, 0xce, 0xc3, 0x55, 0xcd, 0xa7,
-	0x9c, 0xec, 0x60, 0xc1, 0x03, 0x53, 0xed, 0x68, 0xc2, 0x86, 0xf4, 0xb5, 0xf6, 0x23, 0xb9, 0x64,
-	0xd0, 0xcc, 0x27, 0x78, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x2f, 0x97, 0x2d, 0xb4, 0x01,
-	0x00, 0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x40, 0x25, 0xbb, 0xf0, 0xa0, 0x2e, 0xad, 0xd0, 0xa2, 0xb6, 0x61, 0x48, 0x86, 0x26, 0x2f,
+	0x26, 0x21, 0x77, 0x28, 0xd0, 0xd1, 0x06, 0xba, 0x14, 0x28, 0x5c, 0xa3, 0x53, 0x17, 0x81, 0xa2,
+	0x2f, 0x32, 0x61, 0x53, 0x14, 0x48, 0x46, 0x89, 0xfe, 0x22, 0x63, 0xfe, 0x20, 0x40, 0xc6, 0x7c,
+	0x85, 0x47, 0x8f, 0x99, 0xec, 0xc0, 0xfe, 0x83, 0x7c, 0x41, 0x60, 0xca, 0x52, 0x34, 0xf1, 0x48,
+	0xbe, 0x7b, 0x77, 0x87, 0x73, 0x02, 0xce, 0x36, 0xeb, 0xe2, 0x86, 0x14, 0x58, 0x64, 0x20, 0x89,
+	0x16, 0x52, 0xe1, 0x3c, 0xc4, 0x19, 0x91, 0x84, 0x2b, 0x94, 0x49, 0xa1, 0x85, 0xfb, 0xb5, 0x62,
+	0x50, 0xcd, 0xa0, 0x3c, 0xec, 0x7b, 0x54, 0x28, 0x2e, 0x14, 0x8e, 0x89, 0x02, 0x9c, 0x87, 0x31,
+	0x68, 0x12, 0x62, 0x2a, 0x58, 0x5a, 0xa6, 0xf5, 0xbf, 0x24, 0x22, 0x11, 0x26, 0xc4, 0xe7, 0xa8,
+	0x7c, 0x0d, 0x9e, 0x5a, 0x4e, 0x67, 0x6e, 0xec, 0xee, 0x83, 0xed, 0xf4, 0x2a, 0x63, 0x24, 0x21,
+	0x61, 0x4a, 0x4b, 0xa2, 0x99, 0x48, 0xa3, 0x2b, 0x80, 0xae, 0x3d, 0x6c, 0x8f, 0x3e, 0x4e, 0x7a,
+	0xa8, 0xac, 0x82, 0xce, 0x55, 0xd0, 0xa5, 0x0a, 0x9a, 0x09, 0x96, 0x4e, 0xe7, 0xdb, 0xbd, 0x6f,
+	0xbd, 0xee, 0xfd, 0x41, 0x41, 0xf8, 0xe6, 0x67, 0x50, 0x9b, 0xa8, 0x84, 0xda, 0x12, 0x3c, 0x1e,
+	0xfc, 0x51, 0xc2, 0xf4, 0xea, 0x3a, 0x46, 0x54, 0x70, 0x7c, 0x69, 0xb9, 0x3c, 0xc6, 0x6a, 0xb9,
+	0xc6, 0xba, 0xc8, 0x40, 0x19, 0xa1, 0x5a, 0x7c, 0xab, 0x1c, 0x8b, 0x46, 0x33, 0xbf, 0x00, 0xdc,
+	0xd0, 0xf9, 0xbc, 0x04, 0x42, 0x35, 0xcb, 0x4b, 0xb3, 0x66, 0x1c, 0xba, 0xad, 0xa1, 0x3d, 0x6a,
+	0x4f, 0x3f, 0xdc, 0x1f, 0x7c, 0x7b, 0xf1, 0xa9, 0xf9, 0xfd, 0x8f, 0x71, 0x70, 0x7f, 0x3b, 0x3e,
+	0x15, 0x9c, 0x33, 0xa5, 0xce, 0x09, 0x74, 0x45, 0xd2, 0x04, 0xa2, 0x54, 0x68, 0x46, 0x21, 0xca,
+	0x40, 0x32, 0xb1, 0xec, 0xb6, 0x1b, 0x82, 0xc1, 0x3b, 0x3c, 0x33, 0xec, 0x1f, 0x83, 0xce, 0x0d,
+	0x39, 0xfd, 0xbb, 0x3d, 0x7a, 0xf6, 0xee, 0xe8, 0xd9, 0x2f, 0x47, 0xcf, 0xbe, 0x3b, 0x79, 0xd6,
+	0xee, 0xe4, 0x59, 0xcf, 0x27, 0xcf, 0xfa, 0xff, 0xa3, 0x31, 0x5c, 0xb5, 0xa6, 0xf1, 0x86, 0xc4,
+	0xaa, 0xbe, 0xe1, 0x3c, 0x9c, 0xe0, 0xdb, 0xc6, 0x7a, 0xcd, 0xc4, 0x71, 0xc7, 0xac, 0xe3, 0xfb,
+	0xdb, 0x00, 0xff, 0x16, 0xc4, 0xf1, 0x01, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +153,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionChangeNoticePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommissionChangeNoticePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DeactivationTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeactivationTime))
 		i--
@@ -188,6 +206,9 @@ func (m *Params) Size() (n int) {
 	if m.DeactivationTime != 0 {
 		n += 1 + sovParams(uint64(m.DeactivationTime))
 	}
+	if m.CommissionChangeNoticePeriod != 0 {
+		n += 1 + sovParams(uint64(m.CommissionChangeNoticePeriod))
+	}
 	return n
 }
 
@@ -279,6 +300,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			m.CommissionChangeNoticePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChangeNoticePeriod |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
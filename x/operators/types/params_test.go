@@ -22,6 +22,7 @@ func TestParams_Validate(t *testing.T) {
 			params: types.NewParams(
 				sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(100_000_000)}},
 				24*time.Hour,
+				24*time.Hour,
 			),
 			shouldErr: true,
 		},
@@ -30,6 +31,16 @@ func TestParams_Validate(t *testing.T) {
 			params: types.NewParams(
 				nil,
 				0,
+				24*time.Hour,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero commission change notice period returns error",
+			params: types.NewParams(
+				nil,
+				24*time.Hour,
+				0,
 			),
 			shouldErr: true,
 		},
@@ -43,6 +54,7 @@ func TestParams_Validate(t *testing.T) {
 			params: types.NewParams(
 				sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100_000_000))),
 				60*time.Second,
+				24*time.Hour,
 			),
 			shouldErr: false,
 		},
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -172,6 +173,9 @@ func (m *QueryOperatorParamsRequest) GetOperatorId() uint32 {
 // RPC method.
 type QueryOperatorParamsResponse struct {
 	OperatorParams OperatorParams `protobuf:"bytes,1,opt,name=operator_params,json=operatorParams,proto3" json:"operator_params"`
+	// PendingCommissionChange is the commission rate change that has been
+	// announced by the operator and that has not taken effect yet, if any
+	PendingCommissionChange *CommissionChange `protobuf:"bytes,2,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
 }
 
 func (m *QueryOperatorParamsResponse) Reset()         { *m = QueryOperatorParamsResponse{} }
@@ -214,9 +218,25 @@ func (m *QueryOperatorParamsResponse) GetOperatorParams() OperatorParams {
 	return OperatorParams{}
 }
 
+func (m *QueryOperatorParamsResponse) GetPendingCommissionChange() *CommissionChange {
+	if m != nil {
+		return m.PendingCommissionChange
+	}
+	return nil
+}
+
 // QueryOperatorsRequest is the request type for the Query/Operators RPC method.
 type QueryOperatorsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Status allows to return only the operators having the given status.
+	// If unspecified, the operators are not filtered by status
+	Status OperatorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=milkyway.operators.v1.OperatorStatus" json:"status,omitempty"`
+	// Admin allows to return only the operators managed by the given address
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// JoinedServiceId allows to return only the operators that have joined the
+	// service with the given ID. If 0, the operators are not filtered by the
+	// services they have joined
+	JoinedServiceId uint32 `protobuf:"varint,4,opt,name=joined_service_id,json=joinedServiceId,proto3" json:"joined_service_id,omitempty"`
 }
 
 func (m *QueryOperatorsRequest) Reset()         { *m = QueryOperatorsRequest{} }
@@ -259,6 +279,27 @@ func (m *QueryOperatorsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryOperatorsRequest) GetStatus() OperatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OPERATOR_STATUS_UNSPECIFIED
+}
+
+func (m *QueryOperatorsRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *QueryOperatorsRequest) GetJoinedServiceId() uint32 {
+	if m != nil {
+		return m.JoinedServiceId
+	}
+	return 0
+}
+
 // QueryOperatorsResponse is the response type for the Query/Operators RPC
 // method.
 type QueryOperatorsResponse struct {
@@ -315,6 +356,126 @@ func (m *QueryOperatorsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOperatorRoleGrantsRequest is the request type for the
+// Query/OperatorRoleGrants RPC method.
+type QueryOperatorRoleGrantsRequest struct {
+	// OperatorID is the ID of the operator for which to query the role grants
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Grantee allows to return only the roles granted to the given address
+	Grantee    string             `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorRoleGrantsRequest) Reset()         { *m = QueryOperatorRoleGrantsRequest{} }
+func (m *QueryOperatorRoleGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorRoleGrantsRequest) ProtoMessage()    {}
+func (*QueryOperatorRoleGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d34b833547f80d63, []int{6}
+}
+func (m *QueryOperatorRoleGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorRoleGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorRoleGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorRoleGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorRoleGrantsRequest.Merge(m, src)
+}
+func (m *QueryOperatorRoleGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorRoleGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorRoleGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorRoleGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorRoleGrantsRequest) GetOperatorId() uint32 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *QueryOperatorRoleGrantsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryOperatorRoleGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorRoleGrantsResponse is the response type for the
+// Query/OperatorRoleGrants RPC method.
+type QueryOperatorRoleGrantsResponse struct {
+	// RoleGrants is the list of roles granted by the operator admin
+	RoleGrants []OperatorRoleGrant `protobuf:"bytes,1,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorRoleGrantsResponse) Reset()         { *m = QueryOperatorRoleGrantsResponse{} }
+func (m *QueryOperatorRoleGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorRoleGrantsResponse) ProtoMessage()    {}
+func (*QueryOperatorRoleGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d34b833547f80d63, []int{7}
+}
+func (m *QueryOperatorRoleGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorRoleGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorRoleGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorRoleGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorRoleGrantsResponse.Merge(m, src)
+}
+func (m *QueryOperatorRoleGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorRoleGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorRoleGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorRoleGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorRoleGrantsResponse) GetRoleGrants() []OperatorRoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *QueryOperatorRoleGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -323,7 +484,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d34b833547f80d63, []int{6}
+	return fileDescriptor_d34b833547f80d63, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +522,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d34b833547f80d63, []int{7}
+	return fileDescriptor_d34b833547f80d63, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,6 +565,8 @@ func init() {
 	proto.RegisterType((*QueryOperatorParamsResponse)(nil), "milkyway.operators.v1.QueryOperatorParamsResponse")
 	proto.RegisterType((*QueryOperatorsRequest)(nil), "milkyway.operators.v1.QueryOperatorsRequest")
 	proto.RegisterType((*QueryOperatorsResponse)(nil), "milkyway.operators.v1.QueryOperatorsResponse")
+	proto.RegisterType((*QueryOperatorRoleGrantsRequest)(nil), "milkyway.operators.v1.QueryOperatorRoleGrantsRequest")
+	proto.RegisterType((*QueryOperatorRoleGrantsResponse)(nil), "milkyway.operators.v1.QueryOperatorRoleGrantsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.operators.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.operators.v1.QueryParamsResponse")
 }
@@ -411,43 +574,58 @@ func init() {
 func init() { proto.RegisterFile("milkyway/operators/v1/query.proto", fileDescriptor_d34b833547f80d63) }
 
 var fileDescriptor_d34b833547f80d63 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc5, 0xeb, 0x31, 0xaa, 0xed, 0x3f, 0x31, 0x24, 0xd3, 0xa1, 0xa9, 0xb0, 0xb4, 0x44, 0x1a,
-	0x8c, 0xc1, 0x6c, 0x52, 0x04, 0x3d, 0xa0, 0x1d, 0x18, 0x12, 0x88, 0x13, 0x5b, 0xc5, 0x69, 0x97,
-	0xc9, 0x5d, 0xad, 0x10, 0xd1, 0xc6, 0x59, 0x9c, 0x16, 0x2a, 0xc4, 0x85, 0x03, 0x67, 0x04, 0x67,
-	0xae, 0x7c, 0x04, 0x3e, 0xc3, 0x8e, 0x93, 0xb8, 0x70, 0x42, 0xa8, 0xe5, 0x73, 0xa0, 0xa9, 0x8e,
-	0x93, 0xd6, 0x55, 0xb6, 0x66, 0xb7, 0xd4, 0x7d, 0xef, 0xef, 0x5f, 0x9e, 0x5f, 0x0c, 0xb7, 0x3a,
-	0x5e, 0xfb, 0x6d, 0xff, 0x1d, 0xeb, 0x53, 0x11, 0xf0, 0x90, 0x45, 0x22, 0x94, 0xb4, 0xe7, 0xd0,
-	0xa3, 0x2e, 0x0f, 0xfb, 0x24, 0x08, 0x45, 0x24, 0xf0, 0x4a, 0x22, 0x21, 0xa9, 0x84, 0xf4, 0x9c,
-	0xf2, 0xe6, 0xa1, 0x90, 0x1d, 0x21, 0x69, 0x93, 0x49, 0x1e, 0xeb, 0x69, 0xcf, 0x69, 0xf2, 0x88,
-	0x39, 0x34, 0x60, 0xae, 0xe7, 0xb3, 0xc8, 0x13, 0x7e, 0x3c, 0xa2, 0x5c, 0x72, 0x85, 0x2b, 0xd4,
-	0x23, 0x1d, 0x3d, 0xe9, 0xd5, 0x9b, 0xae, 0x10, 0x6e, 0x9b, 0x53, 0x16, 0x78, 0x94, 0xf9, 0xbe,
-	0x88, 0x94, 0x45, 0xea, 0x7f, 0xed, 0x6c, 0xb2, 0x8e, 0x68, 0xf1, 0xf6, 0x0c, 0x4d, 0xc0, 0x42,
-	0xd6, 0xd1, 0x1a, 0xbb, 0x0e, 0xa5, 0xbd, 0x11, 0xdd, 0x2b, 0xad, 0x68, 0xf0, 0xa3, 0x2e, 0x97,
-	0x11, 0xae, 0xc0, 0x52, 0x62, 0x3a, 0xf0, 0x5a, 0xab, 0xa8, 0x8a, 0x36, 0xae, 0x34, 0x20, 0x59,
-	0x7a, 0xd9, 0xb2, 0xf7, 0x61, 0x65, 0xca, 0x28, 0x03, 0xe1, 0x4b, 0x8e, 0x9f, 0xc2, 0x42, 0x22,
-	0x53, 0xb6, 0xa5, 0x5a, 0x85, 0x64, 0x66, 0x44, 0x12, 0xeb, 0xce, 0xfc, 0xf1, 0x9f, 0x4a, 0xa1,
-	0x91, 0xda, 0xec, 0x6d, 0x28, 0x1b, 0xb3, 0x77, 0x15, 0x71, 0x6e, 0x34, 0x09, 0x37, 0x32, 0xed,
-	0x1a, 0xf0, 0x35, 0x5c, 0x4d, 0xfd, 0x71, 0x16, 0x9a, 0x73, 0x7d, 0x06, 0x67, 0x3c, 0x47, 0xd3,
-	0x2e, 0x0b, 0x63, 0xd5, 0x3e, 0x98, 0xca, 0x23, 0xc5, 0x7d, 0x0e, 0x30, 0x3e, 0x71, 0xbd, 0xd3,
-	0x6d, 0x12, 0xd7, 0x83, 0x8c, 0xea, 0x41, 0xe2, 0x3a, 0xe9, 0x7a, 0x90, 0x5d, 0xe6, 0x72, 0xed,
-	0x6d, 0x4c, 0x38, 0xed, 0x1f, 0x08, 0xae, 0x4f, 0xef, 0xa0, 0xdf, 0xe8, 0x19, 0x2c, 0xa6, 0xc0,
-	0xab, 0xa8, 0x7a, 0x29, 0x7f, 0xe6, 0x63, 0x1f, 0x7e, 0x61, 0x70, 0xce, 0x29, 0xce, 0x3b, 0x33,
-	0x39, 0x63, 0x02, 0x03, 0xb4, 0x04, 0x58, 0x71, 0x1a, 0xa7, 0x66, 0x37, 0xe0, 0x9a, 0xb1, 0xaa,
-	0xd1, 0x9f, 0x40, 0xd1, 0x38, 0x83, 0xb5, 0x33, 0xb8, 0x8d, 0xec, 0xb5, 0xa5, 0xf6, 0x7f, 0x1e,
-	0x2e, 0xab, 0xa1, 0xf8, 0x3b, 0x82, 0x85, 0xe4, 0xd5, 0xf0, 0xbd, 0x33, 0x66, 0x64, 0x15, 0xbd,
-	0x7c, 0x3f, 0x9f, 0x38, 0xc6, 0xb5, 0x1f, 0x7f, 0xfa, 0xf5, 0xef, 0xdb, 0xdc, 0x03, 0x4c, 0x68,
-	0xf6, 0xb7, 0x35, 0xfe, 0xf1, 0x61, 0xa2, 0xa3, 0x1f, 0xf1, 0x4f, 0x04, 0xcb, 0x66, 0x8d, 0xb0,
-	0x93, 0x67, 0x63, 0x23, 0xc3, 0x72, 0xed, 0x22, 0x16, 0x4d, 0xbc, 0xad, 0x88, 0xeb, 0xf8, 0xd1,
-	0xc5, 0x88, 0xf5, 0x2d, 0x81, 0xbf, 0x22, 0x58, 0x4c, 0x0b, 0x87, 0x73, 0x85, 0x95, 0xe2, 0x6e,
-	0xe5, 0x54, 0x6b, 0xd2, 0x0d, 0x45, 0x6a, 0xe3, 0xea, 0x2c, 0x52, 0xfc, 0x19, 0x41, 0x51, 0xa7,
-	0x78, 0xf7, 0xbc, 0x3d, 0xcc, 0xf4, 0x36, 0xf3, 0x48, 0x35, 0xcb, 0xba, 0x62, 0xa9, 0xe0, 0x35,
-	0x7a, 0xde, 0x1d, 0xba, 0xb3, 0x77, 0x3c, 0xb0, 0xd0, 0xc9, 0xc0, 0x42, 0x7f, 0x07, 0x16, 0xfa,
-	0x32, 0xb4, 0x0a, 0x27, 0x43, 0xab, 0xf0, 0x7b, 0x68, 0x15, 0xf6, 0xeb, 0xae, 0x17, 0xbd, 0xe9,
-	0x36, 0xc9, 0xa1, 0xe8, 0xa4, 0x23, 0xb6, 0xda, 0xac, 0x29, 0xc7, 0x03, 0x7b, 0x4e, 0x8d, 0xbe,
-	0x9f, 0x18, 0x1b, 0xf5, 0x03, 0x2e, 0x9b, 0x45, 0x75, 0x2f, 0x3f, 0x3c, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xba, 0xab, 0x6a, 0x18, 0x7b, 0x06, 0x00, 0x00,
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
+	0x1c, 0xd5, 0xf9, 0x8f, 0x6a, 0xff, 0x8c, 0xda, 0xe8, 0x55, 0x6e, 0x65, 0xb5, 0x96, 0x54, 0x02,
+	0x6e, 0x55, 0xb7, 0x26, 0x2b, 0x15, 0xb6, 0x81, 0xb6, 0x1e, 0x2c, 0x03, 0x35, 0x3c, 0xd9, 0xa6,
+	0x3b, 0x79, 0x11, 0x4e, 0xe2, 0x81, 0x66, 0x2b, 0xf2, 0x68, 0x1e, 0xa5, 0x56, 0x28, 0xb2, 0x64,
+	0xc8, 0x1c, 0x24, 0x73, 0xd6, 0x7c, 0x82, 0x20, 0x4b, 0x90, 0x39, 0x1e, 0x8d, 0x64, 0xf1, 0x14,
+	0x04, 0x76, 0x3e, 0x45, 0xa6, 0x40, 0xc7, 0x23, 0x25, 0x2a, 0x92, 0x45, 0x05, 0xd9, 0x74, 0xc7,
+	0xf7, 0x1e, 0xdf, 0xbd, 0x7b, 0x77, 0x22, 0x7c, 0x67, 0x5b, 0xcd, 0x7f, 0x3a, 0xff, 0x92, 0x8e,
+	0xc6, 0x5c, 0xea, 0x11, 0x9f, 0x79, 0x5c, 0x6b, 0x97, 0xb5, 0xf3, 0x16, 0xf5, 0x3a, 0xaa, 0xeb,
+	0x31, 0x9f, 0xe1, 0xe5, 0x10, 0xa2, 0x46, 0x10, 0xb5, 0x5d, 0xce, 0xad, 0x34, 0x18, 0xb7, 0x19,
+	0xaf, 0x09, 0x90, 0x16, 0x0c, 0x02, 0x46, 0x6e, 0x3d, 0x18, 0x69, 0x75, 0xc2, 0x69, 0x20, 0xa5,
+	0xb5, 0xcb, 0x75, 0xea, 0x93, 0xb2, 0xe6, 0x12, 0xd3, 0x72, 0x88, 0x6f, 0x31, 0x47, 0x62, 0x33,
+	0x26, 0x33, 0x59, 0xa0, 0xd1, 0xfd, 0x25, 0x67, 0xbf, 0x35, 0x19, 0x33, 0x9b, 0x54, 0x23, 0xae,
+	0xa5, 0x11, 0xc7, 0x61, 0xbe, 0xa0, 0x84, 0xfa, 0xca, 0x70, 0xd3, 0x36, 0x33, 0x68, 0x73, 0x0c,
+	0xc6, 0x25, 0x1e, 0xb1, 0x25, 0x46, 0xd9, 0x86, 0xcc, 0x71, 0xd7, 0xdd, 0xa1, 0x44, 0xe8, 0xf4,
+	0xbc, 0x45, 0xb9, 0x8f, 0x0b, 0xb0, 0x10, 0x92, 0x6a, 0x96, 0x91, 0x45, 0x45, 0x54, 0xfa, 0x5c,
+	0x87, 0x70, 0xea, 0xc0, 0x50, 0x4e, 0x61, 0x79, 0x80, 0xc8, 0x5d, 0xe6, 0x70, 0x8a, 0x77, 0x61,
+	0x2e, 0x84, 0x09, 0xda, 0x42, 0xa5, 0xa0, 0x0e, 0x8d, 0x4f, 0x0d, 0xa9, 0xd5, 0x99, 0x8b, 0xd7,
+	0x85, 0x94, 0x1e, 0xd1, 0x94, 0x1d, 0xc8, 0xc5, 0xb4, 0x8f, 0x84, 0xe3, 0xc4, 0xd6, 0xae, 0x10,
+	0x7c, 0x33, 0x94, 0x2f, 0x1d, 0xfe, 0x05, 0x4b, 0x91, 0x40, 0x10, 0x86, 0x34, 0xba, 0x36, 0xc6,
+	0x68, 0xa0, 0x23, 0xed, 0x2e, 0xb2, 0xd8, 0x2c, 0x6e, 0xc0, 0x8a, 0x4b, 0x1d, 0xc3, 0x72, 0xcc,
+	0x5a, 0x83, 0xd9, 0xb6, 0xc5, 0xb9, 0xc5, 0x9c, 0x5a, 0xe3, 0x8c, 0x38, 0x26, 0xcd, 0x4e, 0x09,
+	0xfd, 0x1f, 0x46, 0xe8, 0xef, 0x45, 0xf8, 0x3d, 0x01, 0xd7, 0xbf, 0x96, 0x4a, 0x83, 0x0f, 0x94,
+	0x77, 0x68, 0x20, 0xf6, 0x28, 0x95, 0x3f, 0x01, 0x7a, 0xc5, 0x92, 0xeb, 0xf9, 0x5e, 0x95, 0x9d,
+	0xec, 0xb6, 0x50, 0x0d, 0x0a, 0x2d, 0x5b, 0xa8, 0x1e, 0x11, 0x93, 0x4a, 0xae, 0xde, 0xc7, 0xc4,
+	0x3b, 0x90, 0xe6, 0x3e, 0xf1, 0x5b, 0x5c, 0x78, 0x5e, 0x1c, 0x9b, 0xc9, 0x89, 0x00, 0xeb, 0x92,
+	0x84, 0x55, 0x98, 0x25, 0x86, 0x6d, 0x39, 0xd9, 0xe9, 0x22, 0x2a, 0xcd, 0x57, 0xb3, 0x2f, 0x9f,
+	0x6c, 0x64, 0xa4, 0x89, 0x5d, 0xc3, 0xf0, 0x28, 0xe7, 0x27, 0xbe, 0x67, 0x39, 0xa6, 0x1e, 0xc0,
+	0xf0, 0x3a, 0x7c, 0xf1, 0x37, 0xb3, 0x1c, 0x6a, 0xd4, 0x38, 0xf5, 0xda, 0x56, 0x83, 0x76, 0xb7,
+	0x74, 0x46, 0x6c, 0xe9, 0x52, 0xf0, 0xe0, 0x24, 0x98, 0x3f, 0x30, 0x94, 0xc7, 0x08, 0xbe, 0x1a,
+	0x5c, 0xbc, 0xdc, 0xd2, 0x3d, 0x98, 0x8f, 0xdc, 0x65, 0x51, 0x71, 0x3a, 0x79, 0xeb, 0x7a, 0x3c,
+	0xbc, 0x1f, 0x8b, 0x30, 0xdc, 0xb2, 0x71, 0x11, 0x06, 0x0e, 0xfa, 0x33, 0x54, 0x9e, 0x23, 0xc8,
+	0xc7, 0x0f, 0x07, 0x6b, 0xd2, 0x7d, 0x8f, 0x38, 0x7e, 0xe2, 0x12, 0xe3, 0x0a, 0x7c, 0x66, 0x76,
+	0x19, 0x34, 0x28, 0xcf, 0x6d, 0x51, 0x86, 0xc0, 0x81, 0x0e, 0x4c, 0x7f, 0x6c, 0x07, 0x94, 0x67,
+	0x08, 0x0a, 0x23, 0xfd, 0xcb, 0xc4, 0x0f, 0x61, 0xc1, 0x63, 0x4d, 0x5a, 0x13, 0xef, 0x0e, 0x33,
+	0x2f, 0x8d, 0xc9, 0x3c, 0xd2, 0x91, 0xe1, 0x83, 0x17, 0x09, 0x7f, 0xba, 0xf4, 0x33, 0x80, 0x85,
+	0xf9, 0xd8, 0xad, 0xa1, 0xe8, 0xf0, 0x65, 0x6c, 0x56, 0x2e, 0xe3, 0x77, 0x48, 0xc7, 0xae, 0x80,
+	0xd5, 0x11, 0x2b, 0x88, 0x1d, 0x7d, 0x49, 0xa9, 0x5c, 0xa4, 0x61, 0x56, 0x88, 0xe2, 0x47, 0x08,
+	0xe6, 0xc2, 0x45, 0xe2, 0x9f, 0x46, 0x68, 0x0c, 0xbb, 0x68, 0x73, 0x3f, 0x27, 0x03, 0x07, 0x76,
+	0x95, 0xad, 0xbb, 0xaf, 0xde, 0x3e, 0x9c, 0xfa, 0x05, 0xab, 0xda, 0xf0, 0xbb, 0xbd, 0x37, 0xf8,
+	0xbf, 0xaf, 0x5e, 0x77, 0xf0, 0x53, 0x04, 0x8b, 0xf1, 0x5b, 0x0c, 0x97, 0x93, 0xbc, 0x38, 0x96,
+	0x61, 0xae, 0x32, 0x09, 0x45, 0x3a, 0xde, 0x11, 0x8e, 0xb7, 0xf1, 0xe6, 0x64, 0x8e, 0xe5, 0xbf,
+	0x14, 0x7e, 0x80, 0x60, 0x3e, 0x3a, 0xee, 0x38, 0x51, 0x58, 0x91, 0xdd, 0x8d, 0x84, 0x68, 0xe9,
+	0xb4, 0x24, 0x9c, 0x2a, 0xb8, 0x38, 0xce, 0x29, 0x7e, 0x81, 0x00, 0x7f, 0x78, 0x34, 0xf0, 0x66,
+	0xa2, 0xad, 0x1c, 0xbc, 0x0a, 0x72, 0x5b, 0x93, 0xd2, 0xa4, 0xdf, 0xaa, 0xf0, 0xfb, 0x07, 0xfe,
+	0x6d, 0xc2, 0x64, 0xfb, 0x8e, 0x2d, 0xbe, 0x87, 0x20, 0x2d, 0xfb, 0xf0, 0xe3, 0x6d, 0x36, 0xe2,
+	0x3d, 0x58, 0x4f, 0x02, 0x95, 0x2e, 0xd7, 0x84, 0xcb, 0x02, 0x5e, 0xd5, 0x6e, 0xfb, 0x1a, 0xa9,
+	0x1e, 0x5f, 0x5c, 0xe7, 0xd1, 0xe5, 0x75, 0x1e, 0xbd, 0xb9, 0xce, 0xa3, 0xfb, 0x37, 0xf9, 0xd4,
+	0xe5, 0x4d, 0x3e, 0x75, 0x75, 0x93, 0x4f, 0x9d, 0x6e, 0x9b, 0x96, 0x7f, 0xd6, 0xaa, 0xab, 0x0d,
+	0x66, 0x47, 0x12, 0x1b, 0x4d, 0x52, 0xe7, 0x3d, 0xc1, 0x76, 0xb9, 0xa2, 0xfd, 0xd7, 0x27, 0xeb,
+	0x77, 0x5c, 0xca, 0xeb, 0x69, 0xf1, 0x85, 0xf3, 0xeb, 0xfb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8,
+	0xc0, 0x96, 0xef, 0xe0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -470,6 +648,9 @@ type QueryClient interface {
 	OperatorParams(ctx context.Context, in *QueryOperatorParamsRequest, opts ...grpc.CallOption) (*QueryOperatorParamsResponse, error)
 	// Operators defines a gRPC query method that returns the list of operators.
 	Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error)
+	// OperatorRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the operator with the given id.
+	OperatorRoleGrants(ctx context.Context, in *QueryOperatorRoleGrantsRequest, opts ...grpc.CallOption) (*QueryOperatorRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -510,6 +691,15 @@ func (c *queryClient) Operators(ctx context.Context, in *QueryOperatorsRequest,
 	return out, nil
 }
 
+func (c *queryClient) OperatorRoleGrants(ctx context.Context, in *QueryOperatorRoleGrantsRequest, opts ...grpc.CallOption) (*QueryOperatorRoleGrantsResponse, error) {
+	out := new(QueryOperatorRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.operators.v1.Query/OperatorRoleGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.operators.v1.Query/Params", in, out, opts...)
@@ -529,6 +719,9 @@ type QueryServer interface {
 	OperatorParams(context.Context, *QueryOperatorParamsRequest) (*QueryOperatorParamsResponse, error)
 	// Operators defines a gRPC query method that returns the list of operators.
 	Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error)
+	// OperatorRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the operator with the given id.
+	OperatorRoleGrants(context.Context, *QueryOperatorRoleGrantsRequest) (*QueryOperatorRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -547,6 +740,9 @@ func (*UnimplementedQueryServer) OperatorParams(ctx context.Context, req *QueryO
 func (*UnimplementedQueryServer) Operators(ctx context.Context, req *QueryOperatorsRequest) (*QueryOperatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Operators not implemented")
 }
+func (*UnimplementedQueryServer) OperatorRoleGrants(ctx context.Context, req *QueryOperatorRoleGrantsRequest) (*QueryOperatorRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorRoleGrants not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -609,6 +805,24 @@ func _Query_Operators_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OperatorRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.operators.v1.Query/OperatorRoleGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorRoleGrants(ctx, req.(*QueryOperatorRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -644,6 +858,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Operators",
 			Handler:    _Query_Operators_Handler,
 		},
+		{
+			MethodName: "OperatorRoleGrants",
+			Handler:    _Query_OperatorRoleGrants_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -762,6 +980,18 @@ func (m *QueryOperatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.PendingCommissionChange != nil {
+		{
+			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.OperatorParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -795,6 +1025,23 @@ func (m *QueryOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JoinedServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JoinedServiceId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -859,6 +1106,102 @@ func (m *QueryOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOperatorRoleGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorRoleGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorRoleGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorRoleGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorRoleGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorRoleGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -969,6 +1312,10 @@ func (m *QueryOperatorParamsResponse) Size() (n int) {
 	_ = l
 	l = m.OperatorParams.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingCommissionChange != nil {
+		l = m.PendingCommissionChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -982,6 +1329,16 @@ func (m *QueryOperatorsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.JoinedServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.JoinedServiceId))
+	}
 	return n
 }
 
@@ -1004,6 +1361,45 @@ func (m *QueryOperatorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOperatorRoleGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorRoleGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1313,9 +1709,45 @@ func (m *QueryOperatorParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingCommissionChange == nil {
+				m.PendingCommissionChange = &CommissionChange{}
+			}
+			if err := m.PendingCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -1399,6 +1831,76 @@ func (m *QueryOperatorsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OperatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedServiceId", wireType)
+			}
+			m.JoinedServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinedServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1540,6 +2042,263 @@ func (m *QueryOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOperatorRoleGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorRoleGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorRoleGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorRoleGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorRoleGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorRoleGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, OperatorRoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
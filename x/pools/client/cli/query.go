@@ -11,6 +11,10 @@ import (
 	"github.com/milkyway-labs/milkyway/v12/x/pools/types"
 )
 
+const (
+	flagDenomPrefix = "denom-prefix"
+)
+
 // GetQueryCmd returns the command allowing to perform queries
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -94,10 +98,11 @@ func getCmdQueryPoolByDenom() *cobra.Command {
 // getCmdQueryPools returns the command to query the stored pools
 func getCmdQueryPools() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "pools",
-		Short:   "Query the pools",
-		Example: fmt.Sprintf(`%s query %s pools --page=2 --limit=100`, version.AppName, types.ModuleName),
-		Args:    cobra.NoArgs,
+		Use:   "pools",
+		Short: "Query the pools",
+		Example: fmt.Sprintf(`%s query %s pools --page=2 --limit=100
+%s query %s pools --denom-prefix=ibc/`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -110,7 +115,12 @@ func getCmdQueryPools() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Pools(cmd.Context(), types.NewQueryPoolsRequest(pageReq))
+			denomPrefix, err := cmd.Flags().GetString(flagDenomPrefix)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pools(cmd.Context(), types.NewQueryPoolsRequest(denomPrefix, pageReq))
 			if err != nil {
 				return err
 			}
@@ -119,6 +129,8 @@ func getCmdQueryPools() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagDenomPrefix, "", "return only the pools whose denom starts with the given prefix")
+
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "pools")
 
@@ -3,13 +3,16 @@ package keeper
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	"github.com/milkyway-labs/milkyway/v12/x/pools/types"
 )
 
@@ -52,9 +55,31 @@ func (k *Keeper) PoolByDenom(ctx context.Context, request *types.QueryPoolByDeno
 
 // Pools implements the Query/Pools gRPC method
 func (k *Keeper) Pools(ctx context.Context, request *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
-	pools, pageRes, err := query.CollectionPaginate(ctx, k.pools, request.Pagination, func(_ uint32, pool types.Pool) (types.Pool, error) {
+	transformFunc := func(_ uint32, pool types.Pool) (types.Pool, error) {
 		return pool, nil
-	})
+	}
+
+	var pools []types.Pool
+	var pageRes *query.PageResponse
+	var err error
+
+	if request.DenomPrefix == "" {
+		pools, pageRes, err = query.CollectionPaginate(ctx, k.pools, request.Pagination, transformFunc)
+	} else {
+		// If the prefix refers to locked representations, we only return the
+		// locked pools among the ones indexed under the native denom prefix
+		var predicateFunc func(uint32, types.Pool) (bool, error)
+		if strings.HasPrefix(request.DenomPrefix, types.LockedDenomPrefix) {
+			predicateFunc = func(_ uint32, pool types.Pool) (bool, error) {
+				return strings.HasPrefix(pool.Denom, request.DenomPrefix), nil
+			}
+		}
+
+		pools, pageRes, err = utils.CollectionIndexPaginate(ctx, k.pools.Indexes.Denom,
+			getDenomPrefixRange(types.GetPoolDenomIndexKey(request.DenomPrefix)),
+			k.pools.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -64,3 +89,17 @@ func (k *Keeper) Pools(ctx context.Context, request *types.QueryPoolsRequest) (*
 		Pagination: pageRes,
 	}, nil
 }
+
+// getDenomPrefixRange returns the range of the denom index references whose
+// denom starts with the given prefix
+func getDenomPrefixRange(prefix string) collections.Ranger[collections.Pair[string, uint32]] {
+	ranger := new(collections.Range[collections.Pair[string, uint32]]).
+		StartInclusive(collections.Join(prefix, uint32(0)))
+
+	end := storetypes.PrefixEndBytes([]byte(prefix))
+	if end != nil {
+		ranger = ranger.EndExclusive(collections.Join(string(end), uint32(0)))
+	}
+
+	return ranger
+}
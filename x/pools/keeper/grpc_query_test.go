@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/milkyway-labs/milkyway/v12/x/pools/types"
 )
@@ -109,3 +110,93 @@ func (suite *KeeperTestSuite) TestQueryServer_PoolByDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryServer_Pools() {
+	testCases := []struct {
+		name       string
+		store      func(ctx sdk.Context)
+		request    *types.QueryPoolsRequest
+		shouldErr  bool
+		expPools   []types.Pool
+		expNextKey bool
+	}{
+		{
+			name: "all pools are returned without filters",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(1, "umilk")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(2, "uatom")))
+			},
+			request:   types.NewQueryPoolsRequest("", nil),
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(1, "umilk"),
+				types.NewPool(2, "uatom"),
+			},
+		},
+		{
+			name: "denom prefix returns native and locked pools",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(1, "umilk")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(2, "uatom")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(3, "locked/umilk")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(4, "umilktia")))
+			},
+			request:   types.NewQueryPoolsRequest("umilk", nil),
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(1, "umilk"),
+				types.NewPool(3, "locked/umilk"),
+				types.NewPool(4, "umilktia"),
+			},
+		},
+		{
+			name: "locked denom prefix returns only locked pools",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(1, "umilk")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(2, "locked/uatom")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(3, "locked/umilk")))
+			},
+			request:   types.NewQueryPoolsRequest("locked/", nil),
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(2, "locked/uatom"),
+				types.NewPool(3, "locked/umilk"),
+			},
+		},
+		{
+			name: "pagination works with denom prefix",
+			store: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(1, "ibc/1")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(2, "umilk")))
+				suite.Require().NoError(suite.k.SavePool(ctx, types.NewPool(3, "ibc/2")))
+			},
+			request: types.NewQueryPoolsRequest("ibc/", &query.PageRequest{
+				Limit: 1,
+			}),
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(1, "ibc/1"),
+			},
+			expNextKey: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			res, err := suite.k.Pools(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(tc.expPools, res.Pools)
+				suite.Require().Equal(tc.expNextKey, res.Pagination.NextKey != nil)
+			}
+		})
+	}
+}
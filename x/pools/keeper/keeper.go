@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,9 +20,9 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 
 	schema         collections.Schema
-	nextPoolID     collections.Sequence                // Sequence for pool IDs
-	pools          collections.Map[uint32, types.Pool] // Map of pool ID to pool
-	poolAddressSet collections.KeySet[string]          // Set of pool addresses
+	nextPoolID     collections.Sequence                                     // Sequence for pool IDs
+	pools          *collections.IndexedMap[uint32, types.Pool, poolIndexes] // Map of pool ID to pool
+	poolAddressSet collections.KeySet[string]                               // Set of pool addresses
 }
 
 func NewKeeper(cdc codec.Codec,
@@ -39,12 +40,13 @@ func NewKeeper(cdc codec.Codec,
 			types.NextPoolIDKey,
 			"next_pool_id",
 		),
-		pools: collections.NewMap(
+		pools: collections.NewIndexedMap(
 			sb,
 			types.PoolPrefix,
 			"pools",
 			collections.Uint32Key,
 			codec.CollValue[types.Pool](cdc),
+			newPoolIndexes(sb),
 		),
 		poolAddressSet: collections.NewKeySet(
 			sb,
@@ -78,3 +80,30 @@ func (k *Keeper) SetHooks(rs types.PoolsHooks) *Keeper {
 	k.hooks = rs
 	return k
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type poolIndexes struct {
+	// Index that allows to retrieve all the pools based on their denom.
+	// Pools of locked representations are indexed under their native denom
+	Denom *indexes.Multi[string, uint32, types.Pool]
+}
+
+func (i poolIndexes) IndexesList() []collections.Index[uint32, types.Pool] {
+	return []collections.Index[uint32, types.Pool]{i.Denom}
+}
+
+func newPoolIndexes(sb *collections.SchemaBuilder) poolIndexes {
+	return poolIndexes{
+		Denom: indexes.NewMulti(
+			sb,
+			types.PoolDenomIndexPrefix,
+			"pools_by_denom",
+			collections.StringKey,
+			collections.Uint32Key,
+			func(_ uint32, pool types.Pool) (string, error) {
+				return types.GetPoolDenomIndexKey(pool.Denom), nil
+			},
+		),
+	}
+}
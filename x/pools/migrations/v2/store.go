@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/pools/types"
+)
+
+type Keeper interface {
+	GetPools(ctx context.Context) ([]types.Pool, error)
+	SavePool(ctx context.Context, pool types.Pool) error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migrations include:
+// - Populate the pools denom index by storing again all the existing pools
+func MigrateStore(ctx sdk.Context, k Keeper) error {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		err = k.SavePool(ctx, pool)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,19 +2,32 @@ package types
 
 import (
 	"encoding/binary"
+	"strings"
 )
 
 const (
 	ModuleName = "pools"
 	StoreKey   = ModuleName
+
+	// LockedDenomPrefix represents the prefix of the denoms that the x/liquidvesting
+	// module uses to represent locked tokens
+	LockedDenomPrefix = "locked/"
 )
 
 var (
 	NextPoolIDKey        = []byte{0xa1}
 	PoolPrefix           = []byte{0xa2}
 	PoolAddressSetPrefix = []byte{0xa3}
+	PoolDenomIndexPrefix = []byte{0xa4}
 )
 
+// GetPoolDenomIndexKey returns the key used to index a pool by its denom.
+// Locked representations are indexed under their native denom, so that they
+// are returned along with the pools of the native tokens
+func GetPoolDenomIndexKey(denom string) string {
+	return strings.TrimPrefix(denom, LockedDenomPrefix)
+}
+
 // GetPoolIDBytes returns the byte representation of the pool ID
 func GetPoolIDBytes(poolID uint32) (poolIDBz []byte) {
 	poolIDBz = make([]byte, 4)
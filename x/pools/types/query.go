@@ -19,8 +19,9 @@ func NewQueryPoolByDenomRequest(denom string) *QueryPoolByDenomRequest {
 }
 
 // NewQueryPoolsRequest creates a new instance of QueryPoolsRequest
-func NewQueryPoolsRequest(pagination *query.PageRequest) *QueryPoolsRequest {
+func NewQueryPoolsRequest(denomPrefix string, pagination *query.PageRequest) *QueryPoolsRequest {
 	return &QueryPoolsRequest{
-		Pagination: pagination,
+		DenomPrefix: denomPrefix,
+		Pagination:  pagination,
 	}
 }
@@ -173,6 +173,10 @@ func (m *QueryPoolResponse) GetPool() Pool {
 // QueryPoolsRequest is the request type for the Query/Pools RPC method.
 type QueryPoolsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// DenomPrefix allows to return only the pools whose denom starts with the
+	// given prefix. The pools of the locked representations of the matching
+	// denoms (e.g. locked/<denom>) are returned as well
+	DenomPrefix string `protobuf:"bytes,2,opt,name=denom_prefix,json=denomPrefix,proto3" json:"denom_prefix,omitempty"`
 }
 
 func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
@@ -215,6 +219,13 @@ func (m *QueryPoolsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryPoolsRequest) GetDenomPrefix() string {
+	if m != nil {
+		return m.DenomPrefix
+	}
+	return ""
+}
+
 // QueryPoolsResponse is the response type for the Query/Pools RPC method.
 type QueryPoolsResponse struct {
 	// Pools is the list of pool
@@ -281,38 +292,39 @@ func init() {
 func init() { proto.RegisterFile("milkyway/pools/v1/query.proto", fileDescriptor_bb7667236b657a7d) }
 
 var fileDescriptor_bb7667236b657a7d = []byte{
-	// 491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0xda, 0xa6, 0xea, 0x14, 0x0f, 0x1d, 0x16, 0x76, 0x09, 0x1a, 0x97, 0xd0, 0xba,
-	0xb2, 0xd0, 0x19, 0xb2, 0xfb, 0x0d, 0x4a, 0xa9, 0xf4, 0x20, 0xd4, 0x1c, 0xf5, 0x20, 0x93, 0x66,
-	0x88, 0xc1, 0x24, 0x2f, 0xed, 0x64, 0xa3, 0xa1, 0xf4, 0x22, 0x78, 0x15, 0xd1, 0x8b, 0x1f, 0xa9,
-	0xc7, 0x82, 0x17, 0x4f, 0x22, 0xbb, 0x7e, 0x10, 0xc9, 0xcc, 0xa4, 0x8d, 0x6c, 0xbb, 0xeb, 0x69,
-	0x67, 0x76, 0xde, 0xff, 0xff, 0x7e, 0xfc, 0xdf, 0x0b, 0x7e, 0x9c, 0xc6, 0xc9, 0xbb, 0xea, 0x3d,
-	0xaf, 0x58, 0x0e, 0x90, 0x48, 0x56, 0x7a, 0xec, 0x74, 0x2a, 0xce, 0x2a, 0x9a, 0x9f, 0x41, 0x01,
-	0x64, 0xbb, 0x79, 0xa6, 0xea, 0x99, 0x96, 0x9e, 0x3d, 0x3a, 0x01, 0x99, 0x82, 0x64, 0x01, 0x97,
-	0x42, 0xd7, 0xb2, 0xd2, 0x0b, 0x44, 0xc1, 0x3d, 0x96, 0xf3, 0x28, 0xce, 0x78, 0x11, 0x43, 0xa6,
-	0xe5, 0x76, 0x37, 0x82, 0x08, 0xd4, 0x91, 0xd5, 0x27, 0xf3, 0xef, 0xa3, 0x08, 0x20, 0x4a, 0x04,
-	0xe3, 0x79, 0xcc, 0x78, 0x96, 0x41, 0xa1, 0x24, 0xd2, 0xbc, 0x3a, 0x8b, 0x44, 0x29, 0x84, 0x22,
-	0x31, 0xef, 0x2e, 0xc3, 0xdd, 0x97, 0x75, 0xd7, 0x63, 0x80, 0x64, 0xbf, 0x3a, 0x0a, 0x7d, 0x71,
-	0x3a, 0x15, 0xb2, 0x20, 0x3d, 0x7c, 0xaf, 0x16, 0xbc, 0x89, 0xc3, 0x3e, 0x1a, 0xa0, 0x67, 0x0f,
-	0xfd, 0xcd, 0xfa, 0x7a, 0x14, 0xba, 0x0c, 0xf7, 0x5a, 0x82, 0x03, 0x91, 0x41, 0xda, 0x68, 0xba,
-	0xd8, 0x0a, 0xeb, 0xbb, 0x52, 0x3c, 0xf0, 0xf5, 0xc5, 0x3d, 0xc4, 0xdb, 0xd7, 0x02, 0x5f, 0xc8,
-	0x1c, 0x32, 0x29, 0x88, 0x87, 0x37, 0x6a, 0x3f, 0x55, 0xb9, 0x35, 0xee, 0xd1, 0x85, 0x60, 0xa8,
-	0xf2, 0xdf, 0xb8, 0xfc, 0xf5, 0xa4, 0xe3, 0xab, 0x52, 0xf7, 0x75, 0xcb, 0x47, 0x36, 0x2d, 0x0f,
-	0x31, 0xbe, 0x89, 0xc9, 0xb8, 0x3d, 0xa5, 0x3a, 0x53, 0x5a, 0x67, 0x4a, 0x75, 0xfe, 0x26, 0x53,
-	0x7a, 0xcc, 0x23, 0x61, 0xb4, 0x7e, 0x4b, 0xe9, 0x7e, 0x45, 0x98, 0xb4, 0xdd, 0x0d, 0xe6, 0x04,
-	0x5b, 0x0a, 0xa8, 0x8f, 0x06, 0xeb, 0xab, 0x39, 0x75, 0x2d, 0x79, 0xfe, 0x0f, 0xd3, 0x9a, 0x62,
-	0x1a, 0xae, 0x64, 0xd2, 0x1d, 0xdb, 0x50, 0xe3, 0xef, 0xeb, 0xd8, 0x52, 0x50, 0xe4, 0x13, 0xc2,
-	0xf7, 0xcd, 0x84, 0x0e, 0xc8, 0xf0, 0x16, 0x8a, 0xdb, 0x66, 0x68, 0xef, 0x2c, 0x2b, 0x6c, 0x3a,
-	0xba, 0xa3, 0x8f, 0x3f, 0xfe, 0x7c, 0x5b, 0xdb, 0x21, 0x2e, 0x5b, 0x5c, 0x15, 0x7d, 0x38, 0x37,
-	0x9b, 0x70, 0x41, 0x3e, 0x23, 0xbc, 0xd5, 0x1a, 0x3c, 0x19, 0x2d, 0x47, 0x69, 0x6f, 0xc7, 0x7f,
-	0xd2, 0xec, 0x29, 0x9a, 0x21, 0xd9, 0xbd, 0x83, 0x86, 0xa9, 0xa5, 0x62, 0xe7, 0xea, 0xe7, 0x82,
-	0x94, 0xd8, 0x52, 0x13, 0x23, 0x4b, 0xdd, 0x9b, 0x75, 0xb1, 0x77, 0x57, 0x54, 0x19, 0x88, 0x81,
-	0x82, 0xb0, 0x49, 0xff, 0xae, 0x48, 0xf6, 0x5f, 0x5c, 0xce, 0x1c, 0x74, 0x35, 0x73, 0xd0, 0xef,
-	0x99, 0x83, 0xbe, 0xcc, 0x9d, 0xce, 0xd5, 0xdc, 0xe9, 0xfc, 0x9c, 0x3b, 0x9d, 0x57, 0x93, 0x28,
-	0x2e, 0xde, 0x4e, 0x03, 0x7a, 0x02, 0xe9, 0xb5, 0x7a, 0x2f, 0xe1, 0x81, 0xbc, 0xf1, 0x2a, 0xbd,
-	0x31, 0xfb, 0x60, 0x1c, 0x8b, 0x2a, 0x17, 0x32, 0xd8, 0x54, 0x1f, 0xe3, 0xe4, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0d, 0x6c, 0xae, 0x80, 0x40, 0x04, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc6, 0xe3, 0xb4, 0xe9, 0xfb, 0xb2, 0x81, 0x43, 0x57, 0x91, 0x12, 0x59, 0x60, 0x82, 0xd5,
+	0x12, 0x14, 0xa9, 0xbb, 0x72, 0xf2, 0x0d, 0xaa, 0xaa, 0xa8, 0x07, 0xa4, 0xe0, 0x23, 0x97, 0xca,
+	0xae, 0x17, 0x63, 0x61, 0x7b, 0xdc, 0xac, 0x63, 0x6a, 0x55, 0xe5, 0x80, 0xc4, 0x15, 0x21, 0xb8,
+	0xf0, 0x91, 0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x1f, 0x04, 0x79, 0xbc, 0x6e, 0x8d, 0xd2,
+	0x26, 0x9c, 0xbc, 0x7f, 0xe6, 0x99, 0xf9, 0xed, 0x33, 0x63, 0xf2, 0x28, 0x0a, 0xc2, 0xb7, 0xf9,
+	0x3b, 0x27, 0xe7, 0x09, 0x40, 0x28, 0x79, 0x66, 0xf1, 0xd3, 0x99, 0x98, 0xe6, 0x2c, 0x99, 0x42,
+	0x0a, 0x74, 0xbb, 0xba, 0x66, 0x78, 0xcd, 0x32, 0x4b, 0x1f, 0x9e, 0x80, 0x8c, 0x40, 0x72, 0xd7,
+	0x91, 0xa2, 0x8c, 0xe5, 0x99, 0xe5, 0x8a, 0xd4, 0xb1, 0x78, 0xe2, 0xf8, 0x41, 0xec, 0xa4, 0x01,
+	0xc4, 0xa5, 0x5c, 0xef, 0xf8, 0xe0, 0x03, 0x2e, 0x79, 0xb1, 0x52, 0xa7, 0x0f, 0x7d, 0x00, 0x3f,
+	0x14, 0xdc, 0x49, 0x02, 0xee, 0xc4, 0x31, 0xa4, 0x28, 0x91, 0xea, 0xd6, 0x58, 0x26, 0x8a, 0xc0,
+	0x13, 0xa1, 0xba, 0x37, 0x39, 0xe9, 0xbc, 0x2c, 0xaa, 0x4e, 0x00, 0xc2, 0xfd, 0xfc, 0xc8, 0xb3,
+	0xc5, 0xe9, 0x4c, 0xc8, 0x94, 0x76, 0xc9, 0x7f, 0x85, 0xe0, 0x38, 0xf0, 0x7a, 0x5a, 0x5f, 0x7b,
+	0xf6, 0xc0, 0xde, 0x2a, 0xb6, 0x47, 0x9e, 0xc9, 0x49, 0xb7, 0x26, 0x38, 0x10, 0x31, 0x44, 0x95,
+	0xa6, 0x43, 0x5a, 0x5e, 0xb1, 0x47, 0xc5, 0x3d, 0xbb, 0xdc, 0x98, 0x87, 0x64, 0xfb, 0x5a, 0x60,
+	0x0b, 0x99, 0x40, 0x2c, 0x05, 0xb5, 0xc8, 0x66, 0x91, 0x0f, 0x23, 0xdb, 0xa3, 0x2e, 0x5b, 0x32,
+	0x86, 0x61, 0xfe, 0xcd, 0xcb, 0x9f, 0x8f, 0x1b, 0x36, 0x86, 0x9a, 0xef, 0x6b, 0x79, 0x64, 0x55,
+	0xf2, 0x90, 0x90, 0x1b, 0x9b, 0x54, 0xb6, 0xa7, 0xac, 0xf4, 0x94, 0x15, 0x9e, 0xb2, 0xd2, 0x7f,
+	0xe5, 0x29, 0x9b, 0x38, 0xbe, 0x50, 0x5a, 0xbb, 0xa6, 0xa4, 0x4f, 0xc8, 0x7d, 0xa4, 0x3d, 0x4e,
+	0xa6, 0xe2, 0x75, 0x70, 0xd6, 0x6b, 0xe2, 0x0b, 0xda, 0x78, 0x36, 0xc1, 0x23, 0xf3, 0x8b, 0x46,
+	0x68, 0x1d, 0x40, 0xbd, 0x64, 0x4c, 0x5a, 0xc8, 0xdc, 0xd3, 0xfa, 0x1b, 0xeb, 0x9f, 0x52, 0xc6,
+	0xd2, 0xe7, 0x7f, 0x61, 0x37, 0x11, 0x7b, 0xb0, 0x16, 0xbb, 0xac, 0x58, 0xe7, 0x1e, 0x7d, 0xdb,
+	0x20, 0x2d, 0x84, 0xa2, 0x1f, 0x35, 0xf2, 0xbf, 0x6a, 0xe2, 0x01, 0x1d, 0xdc, 0x42, 0x71, 0x5b,
+	0x9b, 0xf5, 0x9d, 0x55, 0x81, 0x55, 0x45, 0x73, 0xf8, 0xe1, 0xfb, 0xef, 0xaf, 0xcd, 0x1d, 0x6a,
+	0xf2, 0xe5, 0x69, 0x2a, 0x17, 0xe7, 0x6a, 0x58, 0x2e, 0xe8, 0x27, 0x8d, 0xb4, 0x6b, 0xb3, 0x41,
+	0x87, 0xab, 0x51, 0xea, 0x03, 0xf4, 0x8f, 0x34, 0x7b, 0x48, 0x33, 0xa0, 0xbb, 0x77, 0xd0, 0x70,
+	0xec, 0x1a, 0x3f, 0xc7, 0xcf, 0x05, 0xcd, 0x48, 0x0b, 0x3b, 0x46, 0x57, 0x66, 0xaf, 0x26, 0x4a,
+	0xdf, 0x5d, 0x13, 0xa5, 0x20, 0xfa, 0x08, 0xa1, 0xd3, 0xde, 0x5d, 0x96, 0xec, 0xbf, 0xb8, 0x9c,
+	0x1b, 0xda, 0xd5, 0xdc, 0xd0, 0x7e, 0xcd, 0x0d, 0xed, 0xf3, 0xc2, 0x68, 0x5c, 0x2d, 0x8c, 0xc6,
+	0x8f, 0x85, 0xd1, 0x78, 0x35, 0xf6, 0x83, 0xf4, 0xcd, 0xcc, 0x65, 0x27, 0x10, 0x5d, 0xab, 0xf7,
+	0x42, 0xc7, 0x95, 0x37, 0xb9, 0x32, 0x6b, 0xc4, 0xcf, 0x54, 0xc6, 0x34, 0x4f, 0x84, 0x74, 0xb7,
+	0xf0, 0x7f, 0x1d, 0xff, 0x19, 0x00, 0x91, 0x27, 0x69, 0x54, 0x63, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,6 +599,13 @@ func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomPrefix) > 0 {
+		i -= len(m.DenomPrefix)
+		copy(dAtA[i:], m.DenomPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -708,6 +727,10 @@ func (m *QueryPoolsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.DenomPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1035,6 +1058,38 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
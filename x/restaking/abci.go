@@ -10,9 +10,31 @@ import (
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
-// EndBlocker is called every block and is responsible for maturing unbonding delegations
+// EndBlocker is called every block and is responsible for executing the pending slashes,
+// activating the operators service keys, maturing unbonding delegations, updating the
+// services' active operators sets and taking their validating sets snapshots
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	return k.CompleteMatureUnbondingDelegations(ctx)
+	err := k.ExecuteMaturePendingSlashes(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.ActivateMatureOperatorServiceKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.CompleteMatureUnbondingDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.UpdateServicesActiveOperators(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SnapshotServicesValidatingSets(ctx)
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -30,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetServicesQueryCmd(),
 		GetDelegatorQueryCmd(),
 		GetUserQueryCmd(),
+		GetPendingSlashQueryCmd(),
+		GetPendingSlashesQueryCmd(),
 		GetParamsQueryCmd(),
 	)
 
@@ -50,6 +53,7 @@ func GetPoolsQueryCmd() *cobra.Command {
 		getPoolDelegationQueryCmd(),
 		getPoolUnbondingDelegationsQueryCmd(),
 		getPoolUnbondingDelegationQueryCmd(),
+		getPoolRedelegationsQueryCmd(),
 	)
 
 	return queryCmd
@@ -203,6 +207,45 @@ func getPoolUnbondingDelegationQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// getPoolRedelegationsQueryCmd returns the command allowing to query the redelegations made from a pool
+func getPoolRedelegationsQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redelegations [pool-id]",
+		Short:   "Query redelegations made from a pool",
+		Example: fmt.Sprintf(`%s query %s pool redelegations 1 --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := poolstypes.ParsePoolID(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TargetRedelegations(cmd.Context(), types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_POOL, poolID, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pool redelegations")
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetOperatorsQueryCmd returns the command allowing to query operators
@@ -218,6 +261,8 @@ func GetOperatorsQueryCmd() *cobra.Command {
 		getOperatorDelegationQueryCmd(),
 		getOperatorUnbondingDelegationsQueryCmd(),
 		getOperatorUnbondingDelegationQueryCmd(),
+		getOperatorRedelegationsQueryCmd(),
+		getOperatorServiceKeyQueryCmd(),
 	)
 
 	return queryCmd
@@ -264,6 +309,48 @@ func getOperatorJoinedServices() *cobra.Command {
 	return cmd
 }
 
+// getOperatorServiceKeyQueryCmd returns the command allowing to query the key
+// used by an operator for a service.
+func getOperatorServiceKeyQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "service-key [operator-id] [service-id]",
+		Short:   "Query the key used by an operator for a service",
+		Example: fmt.Sprintf(`%s query %s operator service-key 1 1`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[0])
+			if err != nil {
+				return err
+			}
+
+			serviceID, err := servicestypes.ParseServiceID(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OperatorServiceKey(
+				cmd.Context(),
+				types.NewQueryOperatorServiceKeyRequest(operatorID, serviceID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getOperatorDelegationsQueryCmd returns the command allowing to query an operator delegations
 func getOperatorDelegationsQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -412,6 +499,45 @@ func getOperatorUnbondingDelegationQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// getOperatorRedelegationsQueryCmd returns the command allowing to query the redelegations made from an operator
+func getOperatorRedelegationsQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redelegations [operator-id]",
+		Short:   "Query redelegations made from an operator",
+		Example: fmt.Sprintf(`%s query %s operator redelegations 1 --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TargetRedelegations(cmd.Context(), types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_OPERATOR, operatorID, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operator redelegations")
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetServicesQueryCmd returns the command allowing to perform queries
@@ -424,11 +550,16 @@ func GetServicesQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getServiceAllowedOperatorsQueryCmd(),
 		getServiceSecuringPoolsQueryCmd(),
+		getServiceActiveOperatorsQueryCmd(),
+		getServiceValidatingSetQueryCmd(),
+		getServiceSnapshotQueryCmd(),
+		getOperatorJailRecordQueryCmd(),
 		getServiceOperatorsQueryCmd(),
 		getServiceDelegationsQueryCmd(),
 		getServiceDelegationQueryCmd(),
 		getServiceUnbondingDelegationsQueryCmd(),
 		getServiceUnbondingDelegationQueryCmd(),
+		getServiceRedelegationsQueryCmd(),
 	)
 
 	return queryCmd
@@ -477,6 +608,177 @@ func getServiceAllowedOperatorsQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// getServiceActiveOperatorsQueryCmd returns the command allowing to query
+// the list of operators that are part of a service's active operators set.
+func getServiceActiveOperatorsQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-operators [service-id]",
+		Short:   "Query the list of operators that are part of a service's active operators set",
+		Example: fmt.Sprintf(`%s query %s service active-operators 1`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ServiceActiveOperators(
+				cmd.Context(),
+				types.NewQueryServiceActiveOperatorsRequest(serviceID, pageReq),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "service active operators")
+
+	return cmd
+}
+
+// getServiceValidatingSetQueryCmd returns the command allowing to query the
+// validating set of a service along with the voting power of each operator.
+func getServiceValidatingSetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validating-set [service-id]",
+		Short: "Query the validating set of a service along with the operators voting power",
+		Long: `Query the validating set of a service along with the operators voting power.
+The validating set at a past block can be queried by using the --height flag.`,
+		Example: fmt.Sprintf(`%s query %s service validating-set 1 --height 100`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ServiceValidatingSet(
+				cmd.Context(),
+				types.NewQueryServiceValidatingSetRequest(serviceID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getServiceSnapshotQueryCmd returns the command allowing to query the snapshot
+// of a service's validating set taken at a given epoch.
+func getServiceSnapshotQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [service-id] [epoch]",
+		Short: "Query the snapshot of a service's validating set taken at a given epoch",
+		Long: `Query the snapshot of a service's validating set taken at a given epoch.
+If the epoch is not provided, the latest snapshot is returned.`,
+		Example: fmt.Sprintf(`%s query %s service snapshot 1 10`, version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var epoch uint64
+			if len(args) > 1 {
+				epoch, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid epoch: %w", err)
+				}
+			}
+
+			res, err := queryClient.ServiceSnapshot(
+				cmd.Context(),
+				types.NewQueryServiceSnapshotRequest(serviceID, epoch),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getOperatorJailRecordQueryCmd returns the command allowing to query the jail
+// record of an operator for a service.
+func getOperatorJailRecordQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "jail-record [service-id] [operator-id]",
+		Short:   "Query the jail record of an operator for a service",
+		Example: fmt.Sprintf(`%s query %s service jail-record 1 1`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OperatorJailRecord(
+				cmd.Context(),
+				types.NewQueryOperatorJailRecordRequest(serviceID, operatorID),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getServiceSecuringPoolsQueryCmd returns the command that allows querying the list
 // of pools from which a service is permitted to borrow its security.
 func getServiceSecuringPoolsQueryCmd() *cobra.Command {
@@ -708,6 +1010,45 @@ func getServiceUnbondingDelegationQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// getServiceRedelegationsQueryCmd returns the command allowing to query the redelegations made from a service
+func getServiceRedelegationsQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redelegations [service-id]",
+		Short:   "Query redelegations made from a service",
+		Example: fmt.Sprintf(`%s query %s service redelegations 1 --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TargetRedelegations(cmd.Context(), types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_SERVICE, serviceID, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "service redelegations")
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetDelegatorQueryCmd returns the command allowing to perform queries for a delegator
@@ -730,6 +1071,7 @@ func GetDelegatorQueryCmd() *cobra.Command {
 		getDelegatorServiceUnbondingDelegationsQueryCmd(),
 		getDelegatorServicesQueryCmd(),
 		getDelegatorServiceQueryCmd(),
+		getDelegatorRedelegationsQueryCmd(),
 	)
 
 	return queryCmd
@@ -1161,6 +1503,42 @@ func getDelegatorServiceQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// getDelegatorRedelegationsQueryCmd returns the command allowing to query all the redelegations of a delegator
+func getDelegatorRedelegationsQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redelegations [delegator-address]",
+		Short:   "Query all redelegations of a delegator",
+		Example: fmt.Sprintf(`%s query %s delegator redelegations init1yu5vratzjspgtd0rnrc0d5a79kkqy0n57rhfyh --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddress := args[0]
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorRedelegations(cmd.Context(), types.NewQueryDelegatorRedelegationsRequest(delegatorAddress, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator redelegations")
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetUserQueryCmd returns the command allowing to perform queries for users
@@ -1206,6 +1584,75 @@ func getUserPreferencesQueryCmd() *cobra.Command {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// GetPendingSlashQueryCmd returns the command to query a pending slash
+func GetPendingSlashQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-slash [pending-slash-id]",
+		Short:   "Query the pending slash having the given ID",
+		Example: fmt.Sprintf(`%s query %s pending-slash 1`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pendingSlashID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingSlash(cmd.Context(), types.NewQueryPendingSlashRequest(pendingSlashID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetPendingSlashesQueryCmd returns the command to query all the pending slashes
+func GetPendingSlashesQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-slashes",
+		Short:   "Query all the pending slashes",
+		Example: fmt.Sprintf(`%s query %s pending-slashes --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingSlashes(cmd.Context(), types.NewQueryPendingSlashesRequest(pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending slashes")
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // GetParamsQueryCmd returns the command to query the module params
 func GetParamsQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -30,9 +33,11 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetDelegateTxCmd(),
 		GetUnbondTxCmd(),
+		GetRedelegateTxCmd(),
 		GetOperatorTxCmd(),
 		GetServiceTxCmd(),
 		GetUserTxCmd(),
+		GetVetoSlashTxCmd(),
 	)
 
 	return txCmd
@@ -184,6 +189,7 @@ func GetUnbondTxCmd() *cobra.Command {
 		GetUnbondFromPoolCmd(),
 		GetUnbondFromOperatorCmd(),
 		GetUnbondFromServiceCmd(),
+		GetCancelUnbondingCmd(),
 	)
 
 	return txCmd
@@ -304,6 +310,199 @@ func GetUnbondFromServiceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCancelUnbondingCmd returns the command allowing to cancel an unbonding delegation entry
+func GetCancelUnbondingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel [pool|operator|service] [target-id] [amount] [creation-height]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Cancel the unbonding delegation entry created at the given height and delegate the given amount back to the target",
+		Example: fmt.Sprintf("%s tx %s unbond cancel operator 1 1000000milk 123456 --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			targetType, targetID, err := ParseDelegationTarget(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid creation height: %w", err)
+			}
+
+			delegator := clientCtx.FromAddress.String()
+
+			// Create and validate the message
+			msg := types.NewMsgCancelUnbondingDelegation(targetType, targetID, amount, creationHeight, delegator)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetRedelegateTxCmd returns the command allowing to redelegate tokens
+func GetRedelegateTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   "redelegate",
+		Short: "Redelegate transactions subcommands",
+	}
+
+	txCmd.AddCommand(
+		GetRedelegateFromPoolCmd(),
+		GetRedelegateFromOperatorCmd(),
+		GetRedelegateFromServiceCmd(),
+	)
+
+	return txCmd
+}
+
+// GetRedelegateFromPoolCmd returns the command allowing to redelegate from a pool
+func GetRedelegateFromPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool [amount] [operator|service] [target-id]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Redelegate the given amount from a pool to an operator or a service",
+		Example: fmt.Sprintf("%s tx %s redelegate pool 1000000umilk operator 1 --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			dstType, dstTargetID, err := ParseRedelegationTarget(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.FromAddress.String()
+
+			// Create and validate the message
+			msg := types.NewMsgRedelegatePool(amount, dstType, dstTargetID, delegator)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRedelegateFromOperatorCmd returns the command allowing to redelegate from an operator
+func GetRedelegateFromOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "operator [src-operator-id] [dst-operator-id] [amount]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Redelegate the given amount from an operator to another operator",
+		Example: fmt.Sprintf("%s tx %s redelegate operator 1 2 1000000milk --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcOperatorID, err := operatorstypes.ParseOperatorID(args[0])
+			if err != nil {
+				return err
+			}
+
+			dstOperatorID, err := operatorstypes.ParseOperatorID(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.FromAddress.String()
+
+			// Create and validate the message
+			msg := types.NewMsgRedelegateOperator(srcOperatorID, dstOperatorID, amount, delegator)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRedelegateFromServiceCmd returns the command allowing to redelegate from a service
+func GetRedelegateFromServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "service [src-service-id] [dst-service-id] [amount]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Redelegate the given amount from a service to another service",
+		Example: fmt.Sprintf("%s tx %s redelegate service 1 2 1000000milk --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcServiceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return err
+			}
+
+			dstServiceID, err := servicestypes.ParseServiceID(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.FromAddress.String()
+
+			// Create and validate the message
+			msg := types.NewMsgRedelegateService(srcServiceID, dstServiceID, amount, delegator)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetOperatorTxCmd returns the command to manage the operators.
@@ -316,6 +515,8 @@ func GetOperatorTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetJoinServiceCmd(),
 		GetLeaveServiceCmd(),
+		GetUnjailOperatorCmd(),
+		GetSetOperatorServiceKeyCmd(),
 	)
 
 	return txCmd
@@ -399,6 +600,112 @@ func GetLeaveServiceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetUnjailOperatorCmd returns the command allowing to unjail an operator
+// from a service once the jail period has ended.
+func GetUnjailOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unjail [operator-id] [service-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Unjail an operator from a service",
+		Example: fmt.Sprintf("%s tx %s operator unjail 1 1 --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[0])
+			if err != nil {
+				return fmt.Errorf("parse operator id: %w", err)
+			}
+
+			serviceID, err := servicestypes.ParseServiceID(args[1])
+			if err != nil {
+				return fmt.Errorf("parse service id: %w", err)
+			}
+
+			// Create and validate the message
+			msg := types.NewMsgUnjailOperator(operatorID, serviceID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSetOperatorServiceKeyCmd returns the command allowing to register the
+// key used by an operator for a service.
+func GetSetOperatorServiceKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-service-key [operator-id] [service-id] [key-type] [public-key] [proof-of-possession]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Register the key used by an operator for a service",
+		Long: `Register the key used by an operator for a service.
+The key type must be one of ed25519, secp256k1 or bls12_381.
+Both the public key and the proof of possession must be hex encoded.
+The proof of possession is the signature, made with the registered key, of the
+message binding the key to the chain, the operator and the service.`,
+		Example: fmt.Sprintf("%s tx %s operator set-service-key 1 1 ed25519 <public-key-hex> <signature-hex> --from alice",
+			version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[0])
+			if err != nil {
+				return fmt.Errorf("parse operator id: %w", err)
+			}
+
+			serviceID, err := servicestypes.ParseServiceID(args[1])
+			if err != nil {
+				return fmt.Errorf("parse service id: %w", err)
+			}
+
+			keyType, err := ParseKeyType(args[2])
+			if err != nil {
+				return fmt.Errorf("parse key type: %w", err)
+			}
+
+			publicKey, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("parse public key: %w", err)
+			}
+
+			proofOfPossession, err := hex.DecodeString(args[4])
+			if err != nil {
+				return fmt.Errorf("parse proof of possession: %w", err)
+			}
+
+			// Create and validate the message
+			msg := types.NewMsgSetOperatorServiceKey(
+				operatorID,
+				serviceID,
+				keyType,
+				publicKey,
+				proofOfPossession,
+				clientCtx.FromAddress.String(),
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func GetServiceTxCmd() *cobra.Command {
@@ -412,6 +719,7 @@ func GetServiceTxCmd() *cobra.Command {
 		GetRemoveAllowedOperatorTxCmd(),
 		GetBorrowPoolSecurityTxCmd(),
 		GetCeasePoolSecurityBorrowTxCmd(),
+		GetSubmitContractEvidenceTxCmd(),
 	)
 
 	return txCmd
@@ -565,6 +873,63 @@ func GetCeasePoolSecurityBorrowTxCmd() *cobra.Command {
 	return cmd
 }
 
+// GetSubmitContractEvidenceTxCmd returns the command allowing to submit an
+// evidence of an operator misbehavior that is verified by the service's contract
+func GetSubmitContractEvidenceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [service-id] [operator-id] [height] [data]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submits an evidence of an operator misbehavior to be verified by the service's evidence verifier contract",
+		Long: `Submits an evidence of an operator misbehavior performed while securing a service.
+The data must be the base64 encoded proof of the misbehavior, which will be verified by the
+CosmWasm contract set as the evidence verifier of the service.`,
+		Example: fmt.Sprintf("%s tx %s service submit-evidence 1 1 100 eyJkb3VibGVfc2lnbiI6e319 --from alice", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			serviceID, err := servicestypes.ParseServiceID(args[0])
+			if err != nil {
+				return fmt.Errorf("parse service id: %w", err)
+			}
+
+			operatorID, err := operatorstypes.ParseOperatorID(args[1])
+			if err != nil {
+				return fmt.Errorf("parse operator id: %w", err)
+			}
+
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse height: %w", err)
+			}
+
+			data, err := base64.StdEncoding.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("parse data: %w", err)
+			}
+
+			// Create and validate the message
+			evidence := types.NewContractEvidence(operatorID, height, data)
+			msg, err := types.NewMsgSubmitOperatorEvidence(serviceID, evidence, clientCtx.FromAddress.String())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 const (
@@ -639,3 +1004,39 @@ If you are updating your preferences, you must provide all the flags that you wa
 
 	return cmd
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetVetoSlashTxCmd returns the command allowing the slash veto committee to
+// veto a pending slash before it gets executed.
+func GetVetoSlashTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "veto-slash [pending-slash-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Veto a pending slash before it gets executed",
+		Example: fmt.Sprintf("%s tx %s veto-slash 1 --from committee", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pendingSlashID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pending slash id: %w", err)
+			}
+
+			// Create and validate the message
+			msg := types.NewMsgVetoSlash(pendingSlashID, clientCtx.FromAddress.String())
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
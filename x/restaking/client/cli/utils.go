@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
@@ -33,3 +35,45 @@ func ParseTrustedServiceEntry(value string) (types.TrustedServiceEntry, error) {
 
 	return types.NewTrustedServiceEntry(serviceID, poolsIDs), nil
 }
+
+// ParseDelegationTarget parses the given values into the type and ID of a delegation target.
+// The target type must be either "pool", "operator" or "service".
+func ParseDelegationTarget(targetType string, targetID string) (types.DelegationType, uint32, error) {
+	switch strings.ToLower(targetType) {
+	case "pool":
+		poolID, err := poolstypes.ParsePoolID(targetID)
+		return types.DELEGATION_TYPE_POOL, poolID, err
+	case "operator":
+		operatorID, err := operatorstypes.ParseOperatorID(targetID)
+		return types.DELEGATION_TYPE_OPERATOR, operatorID, err
+	case "service":
+		serviceID, err := servicestypes.ParseServiceID(targetID)
+		return types.DELEGATION_TYPE_SERVICE, serviceID, err
+	default:
+		return types.DELEGATION_TYPE_UNSPECIFIED, 0, fmt.Errorf("invalid delegation target type: %s", targetType)
+	}
+}
+
+// ParseRedelegationTarget parses the given values into the type and ID of a redelegation target.
+// The target type must be either "operator" or "service".
+func ParseRedelegationTarget(targetType string, targetID string) (types.DelegationType, uint32, error) {
+	if strings.EqualFold(targetType, "pool") {
+		return types.DELEGATION_TYPE_UNSPECIFIED, 0, fmt.Errorf("invalid redelegation target type: %s", targetType)
+	}
+	return ParseDelegationTarget(targetType, targetID)
+}
+
+// ParseKeyType parses the given value into an operator service key type.
+// The value must be either "ed25519", "secp256k1" or "bls12_381".
+func ParseKeyType(value string) (types.KeyType, error) {
+	switch strings.ToLower(value) {
+	case "ed25519":
+		return types.KEY_TYPE_ED25519, nil
+	case "secp256k1":
+		return types.KEY_TYPE_SECP256K1, nil
+	case "bls12_381", "bls12-381", "bls":
+		return types.KEY_TYPE_BLS12_381, nil
+	default:
+		return types.KEY_TYPE_UNSPECIFIED, fmt.Errorf("invalid key type: %s", value)
+	}
+}
@@ -78,3 +78,159 @@ func TestParseTrustedServiceEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDelegationTarget(t *testing.T) {
+	testCases := []struct {
+		name       string
+		targetType string
+		targetID   string
+		shouldErr  bool
+		expType    types.DelegationType
+		expID      uint32
+	}{
+		{
+			name:       "pool target is parsed properly",
+			targetType: "pool",
+			targetID:   "1",
+			shouldErr:  false,
+			expType:    types.DELEGATION_TYPE_POOL,
+			expID:      1,
+		},
+		{
+			name:       "operator target is parsed properly",
+			targetType: "OPERATOR",
+			targetID:   "2",
+			shouldErr:  false,
+			expType:    types.DELEGATION_TYPE_OPERATOR,
+			expID:      2,
+		},
+		{
+			name:       "service target is parsed properly",
+			targetType: "service",
+			targetID:   "3",
+			shouldErr:  false,
+			expType:    types.DELEGATION_TYPE_SERVICE,
+			expID:      3,
+		},
+		{
+			name:       "invalid target type returns an error",
+			targetType: "user",
+			targetID:   "1",
+			shouldErr:  true,
+		},
+		{
+			name:       "invalid target id returns an error",
+			targetType: "pool",
+			targetID:   "abc",
+			shouldErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			targetType, targetID, err := cli.ParseDelegationTarget(tc.targetType, tc.targetID)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expType, targetType)
+				require.Equal(t, tc.expID, targetID)
+			}
+		})
+	}
+}
+
+func TestParseRedelegationTarget(t *testing.T) {
+	testCases := []struct {
+		name       string
+		targetType string
+		targetID   string
+		shouldErr  bool
+		expType    types.DelegationType
+		expID      uint32
+	}{
+		{
+			name:       "operator target is parsed properly",
+			targetType: "operator",
+			targetID:   "1",
+			shouldErr:  false,
+			expType:    types.DELEGATION_TYPE_OPERATOR,
+			expID:      1,
+		},
+		{
+			name:       "service target is parsed properly",
+			targetType: "Service",
+			targetID:   "2",
+			shouldErr:  false,
+			expType:    types.DELEGATION_TYPE_SERVICE,
+			expID:      2,
+		},
+		{
+			name:       "pool target returns an error",
+			targetType: "pool",
+			targetID:   "1",
+			shouldErr:  true,
+		},
+		{
+			name:       "invalid target id returns an error",
+			targetType: "operator",
+			targetID:   "abc",
+			shouldErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			targetType, targetID, err := cli.ParseRedelegationTarget(tc.targetType, tc.targetID)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expType, targetType)
+				require.Equal(t, tc.expID, targetID)
+			}
+		})
+	}
+}
+
+func TestParseKeyType(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		shouldErr bool
+		expType   types.KeyType
+	}{
+		{
+			name:    "ed25519 is parsed properly",
+			value:   "ed25519",
+			expType: types.KEY_TYPE_ED25519,
+		},
+		{
+			name:    "secp256k1 is parsed properly",
+			value:   "Secp256k1",
+			expType: types.KEY_TYPE_SECP256K1,
+		},
+		{
+			name:    "bls12-381 is parsed properly",
+			value:   "bls12-381",
+			expType: types.KEY_TYPE_BLS12_381,
+		},
+		{
+			name:      "invalid key type returns an error",
+			value:     "rsa",
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keyType, err := cli.ParseKeyType(tc.value)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expType, keyType)
+			}
+		})
+	}
+}
@@ -290,6 +290,29 @@ func (k *Keeper) GetAllPoolDelegations(ctx context.Context) ([]types.Delegation,
 	return delegations, err
 }
 
+// GetPoolDelegations returns all the delegations made to the pool having the given ID
+func (k *Keeper) GetPoolDelegations(ctx context.Context, poolID uint32) ([]types.Delegation, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.DelegationsByPoolIDStorePrefix(poolID))
+	defer iterator.Close()
+
+	var delegations []types.Delegation
+	for ; iterator.Valid(); iterator.Next() {
+		_, userAddress := types.ParseDelegationByPoolIDStoreKey(iterator.Key())
+		delegation, found, err := k.GetPoolDelegation(ctx, poolID, userAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrDelegationNotFound
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations, nil
+}
+
 // IterateUserOperatorDelegations iterates all the operator delegations of a user and performs the given callback function
 func (k *Keeper) IterateUserOperatorDelegations(ctx context.Context, userAddress string, cb func(del types.Delegation) (stop bool, err error)) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -348,6 +371,29 @@ func (k *Keeper) GetAllOperatorDelegations(ctx context.Context) ([]types.Delegat
 	return delegations, err
 }
 
+// GetOperatorDelegations returns all the delegations made to the operator having the given ID
+func (k *Keeper) GetOperatorDelegations(ctx context.Context, operatorID uint32) ([]types.Delegation, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.DelegationsByOperatorIDStorePrefix(operatorID))
+	defer iterator.Close()
+
+	var delegations []types.Delegation
+	for ; iterator.Valid(); iterator.Next() {
+		_, userAddress := types.ParseDelegationByOperatorIDStoreKey(iterator.Key())
+		delegation, found, err := k.GetOperatorDelegation(ctx, operatorID, userAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrDelegationNotFound
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations, nil
+}
+
 // IterateUserServiceDelegations iterates all the service delegations of a user and performs the given callback function
 func (k *Keeper) IterateUserServiceDelegations(ctx context.Context, userAddress string, cb func(del types.Delegation) (stop bool, err error)) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -606,47 +652,67 @@ func (k *Keeper) PerformDelegation(ctx context.Context, data types.DelegationDat
 
 // getUnbondingDelegationTarget returns the target of the given unbonding delegation
 func (k *Keeper) getUnbondingDelegationTarget(ctx context.Context, ubd types.UnbondingDelegation) (types.DelegationTarget, error) {
-	switch ubd.Type {
+	return k.getDelegationTarget(ctx, ubd.Type, ubd.TargetID)
+}
+
+// getDelegationTarget returns the target having the given delegation type and ID
+func (k *Keeper) getDelegationTarget(ctx context.Context, targetType types.DelegationType, targetID uint32) (types.DelegationTarget, error) {
+	switch targetType {
 	case types.DELEGATION_TYPE_POOL:
-		pool, err := k.poolsKeeper.GetPool(ctx, ubd.TargetID)
+		pool, err := k.poolsKeeper.GetPool(ctx, targetID)
 		if err != nil {
 			return nil, err
 		}
 		return pool, nil
 
 	case types.DELEGATION_TYPE_OPERATOR:
-		operator, err := k.operatorsKeeper.GetOperator(ctx, ubd.TargetID)
+		operator, err := k.operatorsKeeper.GetOperator(ctx, targetID)
 		if err != nil {
 			return nil, err
 		}
 		return operator, nil
 
 	case types.DELEGATION_TYPE_SERVICE:
-		service, err := k.servicesKeeper.GetService(ctx, ubd.TargetID)
+		service, err := k.servicesKeeper.GetService(ctx, targetID)
 		if err != nil {
 			return nil, err
 		}
 		return service, nil
 
 	default:
-		return nil, errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type %v", ubd.Type)
+		return nil, errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type %v", targetType)
 	}
 }
 
-// getUnbondingDelegationKeyBuilder returns the key builder for the given unbonding delegation
-func (k *Keeper) getUnbondingDelegationKeyBuilder(ud types.UnbondingDelegation) (types.UnbondingDelegationKeyBuilder, error) {
-	switch ud.Type {
+// getDelegationHooks returns the hooks that should be called when modifying a delegation of the given type
+func (k *Keeper) getDelegationHooks(delegationType types.DelegationType) (types.DelegationHooks, error) {
+	switch delegationType {
 	case types.DELEGATION_TYPE_POOL:
-		return types.UserPoolUnbondingDelegationKey, nil
+		return types.DelegationHooks{
+			BeforeDelegationSharesModified: k.BeforePoolDelegationSharesModified,
+			BeforeDelegationCreated:        k.BeforePoolDelegationCreated,
+			AfterDelegationModified:        k.AfterPoolDelegationModified,
+			BeforeDelegationRemoved:        k.BeforePoolDelegationRemoved,
+		}, nil
 
 	case types.DELEGATION_TYPE_OPERATOR:
-		return types.UserOperatorUnbondingDelegationKey, nil
+		return types.DelegationHooks{
+			BeforeDelegationSharesModified: k.BeforeOperatorDelegationSharesModified,
+			BeforeDelegationCreated:        k.BeforeOperatorDelegationCreated,
+			AfterDelegationModified:        k.AfterOperatorDelegationModified,
+			BeforeDelegationRemoved:        k.BeforeOperatorDelegationRemoved,
+		}, nil
 
 	case types.DELEGATION_TYPE_SERVICE:
-		return types.UserServiceUnbondingDelegationKey, nil
+		return types.DelegationHooks{
+			BeforeDelegationSharesModified: k.BeforeServiceDelegationSharesModified,
+			BeforeDelegationCreated:        k.BeforeServiceDelegationCreated,
+			AfterDelegationModified:        k.AfterServiceDelegationModified,
+			BeforeDelegationRemoved:        k.BeforeServiceDelegationRemoved,
+		}, nil
 
 	default:
-		return nil, errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type %v", ud.Type)
+		return types.DelegationHooks{}, errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type %v", delegationType)
 	}
 }
 
@@ -676,8 +742,8 @@ func (k Keeper) HasMaxUnbondingDelegationEntries(ctx context.Context, delegator
 
 // SetUnbondingDelegation stores the given unbonding delegation in the store
 func (k *Keeper) SetUnbondingDelegation(ctx context.Context, ud types.UnbondingDelegation) ([]byte, error) {
-	// Get the key to be used to store the unbonding delegation
-	getUnbondingDelegation, err := k.getUnbondingDelegationKeyBuilder(ud)
+	// Get the keys builders
+	getUnbondingDelegation, getUnbondingDelegationByTargetID, err := types.GetUnbondingDelegationKeyBuilders(ud)
 	if err != nil {
 		return nil, err
 	}
@@ -690,6 +756,12 @@ func (k *Keeper) SetUnbondingDelegation(ctx context.Context, ud types.UnbondingD
 		return nil, err
 	}
 
+	// Store the key in the unbonding delegation by target ID index (used for reversed lookup)
+	err = store.Set(getUnbondingDelegationByTargetID(ud.TargetID, ud.DelegatorAddress), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
 	return unbondingDelegationKey, nil
 }
 
@@ -727,15 +799,19 @@ func (k *Keeper) GetUnbondingDelegation(
 
 // RemoveUnbondingDelegation removes the unbonding delegation object and associated index.
 func (k *Keeper) RemoveUnbondingDelegation(ctx context.Context, ubd types.UnbondingDelegation) error {
-	// Get the key to be used to store the unbonding delegation
-	getUnbondingDelegation, err := k.getUnbondingDelegationKeyBuilder(ubd)
+	// Get the keys builders
+	getUnbondingDelegation, getUnbondingDelegationByTargetID, err := types.GetUnbondingDelegationKeyBuilders(ubd)
 	if err != nil {
 		return err
 	}
-	unbondingDelegationKey := getUnbondingDelegation(ubd.DelegatorAddress, ubd.TargetID)
 
 	store := k.storeService.OpenKVStore(ctx)
-	return store.Delete(unbondingDelegationKey)
+	err = store.Delete(getUnbondingDelegation(ubd.DelegatorAddress, ubd.TargetID))
+	if err != nil {
+		return err
+	}
+
+	return store.Delete(getUnbondingDelegationByTargetID(ubd.TargetID, ubd.DelegatorAddress))
 }
 
 // PerformUndelegation unbonds an amount of delegator shares from a given validator. It
@@ -759,8 +835,14 @@ func (k *Keeper) PerformUndelegation(ctx context.Context, data types.Undelegatio
 		return time.Time{}, err
 	}
 
-	// Compute the time at which the unbonding delegation should end
-	unbondingTime, err := k.UnbondingTime(ctx)
+	// Compute the time at which the unbonding delegation should end, based on
+	// the services that are currently secured by the delegation
+	delType, err := types.GetDelegationTypeFromTarget(data.Target)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	unbondingTime, err := k.GetUndelegationUnbondingTime(ctx, delType, data.Target.GetID(), data.Delegator)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -786,6 +868,7 @@ func (k *Keeper) PerformUndelegation(ctx context.Context, data types.Undelegatio
 // UnbondRestakedAssets unbonds the provided amount from the user's delegations.
 // The algorithm will go over the user's delegation in the following order: pools, services and operators
 // until the token undelegated matches the provided amount.
+// The returned completion time is the latest among the ones of the performed undelegations.
 func (k *Keeper) UnbondRestakedAssets(ctx context.Context, user sdk.AccAddress, amount sdk.Coins) (time.Time, error) {
 	var undelegations []types.UndelegationData
 	toUndelegateTokens := sdk.NewDecCoinsFromCoins(amount...)
@@ -826,35 +909,20 @@ func (k *Keeper) UnbondRestakedAssets(ctx context.Context, user sdk.AccAddress,
 
 		// Update the list of undelegations to perform
 		var buildUnbondingDelegation types.UnbondingDelegationBuilder
-		var hooks types.DelegationHooks
 		switch delegation.Type {
 		case types.DELEGATION_TYPE_POOL:
 			buildUnbondingDelegation = types.NewPoolUnbondingDelegation
-			hooks = types.DelegationHooks{
-				BeforeDelegationSharesModified: k.BeforePoolDelegationSharesModified,
-				BeforeDelegationCreated:        k.BeforePoolDelegationCreated,
-				AfterDelegationModified:        k.AfterPoolDelegationModified,
-				BeforeDelegationRemoved:        k.BeforePoolDelegationRemoved,
-			}
 		case types.DELEGATION_TYPE_SERVICE:
 			buildUnbondingDelegation = types.NewServiceUnbondingDelegation
-			hooks = types.DelegationHooks{
-				BeforeDelegationSharesModified: k.BeforeServiceDelegationSharesModified,
-				BeforeDelegationCreated:        k.BeforeServiceDelegationCreated,
-				AfterDelegationModified:        k.AfterServiceDelegationModified,
-				BeforeDelegationRemoved:        k.BeforeServiceDelegationRemoved,
-			}
 		case types.DELEGATION_TYPE_OPERATOR:
 			buildUnbondingDelegation = types.NewOperatorUnbondingDelegation
-			hooks = types.DelegationHooks{
-				BeforeDelegationSharesModified: k.BeforeOperatorDelegationSharesModified,
-				BeforeDelegationCreated:        k.BeforeOperatorDelegationCreated,
-				AfterDelegationModified:        k.AfterOperatorDelegationModified,
-				BeforeDelegationRemoved:        k.BeforeOperatorDelegationRemoved,
-			}
 		default:
 			return true, fmt.Errorf("unsupported delegation type: %s", delegation.Type.String())
 		}
+		hooks, err := k.getDelegationHooks(delegation.Type)
+		if err != nil {
+			return true, err
+		}
 		truncatedCoins, _ := coins.TruncateDecimal()
 		undelegations = append(undelegations, types.UndelegationData{
 			Amount:                   truncatedCoins,
@@ -888,7 +956,7 @@ func (k *Keeper) UnbondRestakedAssets(ctx context.Context, user sdk.AccAddress,
 		if err != nil {
 			return time.Time{}, err
 		}
-		if completionTime.IsZero() {
+		if ct.After(completionTime) {
 			completionTime = ct
 		}
 	}
@@ -1008,6 +1076,36 @@ func (k *Keeper) GetAllUserServiceUnbondingDelegations(ctx context.Context, user
 	return unbondingDelegations
 }
 
+// GetTargetUnbondingDelegations returns all the unbonding delegations from the target
+// having the given type and ID
+func (k *Keeper) GetTargetUnbondingDelegations(
+	ctx context.Context, ubdType types.DelegationType, targetID uint32,
+) ([]types.UnbondingDelegation, error) {
+	targetPrefix, err := types.UnbondingDelegationsByTargetIDStorePrefix(ubdType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), targetPrefix)
+	defer iterator.Close()
+
+	var unbondingDelegations []types.UnbondingDelegation
+	for ; iterator.Valid(); iterator.Next() {
+		_, delegatorAddress := types.ParseUnbondingDelegationByTargetIDStoreKey(iterator.Key())
+		ubd, found, err := k.GetUnbondingDelegation(ctx, delegatorAddress, ubdType, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrDelegationNotFound
+		}
+		unbondingDelegations = append(unbondingDelegations, ubd)
+	}
+
+	return unbondingDelegations, nil
+}
+
 // GetAllUnbondingDelegations returns all the unbonding delegations
 func (k *Keeper) GetAllUnbondingDelegations(ctx context.Context) ([]types.UnbondingDelegation, error) {
 	var unbondingDelegations []types.UnbondingDelegation
@@ -779,6 +779,111 @@ func (suite *KeeperTestSuite) TestKeeper_GetAllServiceUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_GetTargetUnbondingDelegations() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		ubdType      types.DelegationType
+		targetID     uint32
+		shouldErr    bool
+		expUnbonding []types.UnbondingDelegation
+	}{
+		{
+			name:      "invalid delegation type returns error",
+			ubdType:   types.DELEGATION_TYPE_UNSPECIFIED,
+			targetID:  1,
+			shouldErr: true,
+		},
+		{
+			name: "only the unbonding delegations of the given target are returned",
+			store: func(ctx sdk.Context) {
+				_, err := suite.k.SetUnbondingDelegation(ctx, types.NewOperatorUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					1,
+					10,
+					time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					1,
+				))
+				suite.Require().NoError(err)
+
+				_, err = suite.k.SetUnbondingDelegation(ctx, types.NewOperatorUnbondingDelegation(
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					2,
+					10,
+					time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					2,
+				))
+				suite.Require().NoError(err)
+
+				_, err = suite.k.SetUnbondingDelegation(ctx, types.NewPoolUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					1,
+					10,
+					time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					3,
+				))
+				suite.Require().NoError(err)
+			},
+			ubdType:   types.DELEGATION_TYPE_OPERATOR,
+			targetID:  1,
+			shouldErr: false,
+			expUnbonding: []types.UnbondingDelegation{
+				types.NewOperatorUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					1,
+					10,
+					time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					1,
+				),
+			},
+		},
+		{
+			name: "removed unbonding delegations are not returned",
+			store: func(ctx sdk.Context) {
+				ubd := types.NewServiceUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					1,
+					10,
+					time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					1,
+				)
+				_, err := suite.k.SetUnbondingDelegation(ctx, ubd)
+				suite.Require().NoError(err)
+
+				err = suite.k.RemoveUnbondingDelegation(ctx, ubd)
+				suite.Require().NoError(err)
+			},
+			ubdType:      types.DELEGATION_TYPE_SERVICE,
+			targetID:     1,
+			shouldErr:    false,
+			expUnbonding: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			unbonding, err := suite.k.GetTargetUnbondingDelegations(ctx, tc.ubdType, tc.targetID)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expUnbonding, unbonding)
+			}
+		})
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func (suite *KeeperTestSuite) TestKeeper_UnbondRestakedAssets() {
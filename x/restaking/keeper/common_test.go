@@ -23,7 +23,6 @@ import (
 	poolskeeper "github.com/milkyway-labs/milkyway/v12/x/pools/keeper"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/keeper"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/testutils"
-	rewardstypes "github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 	serviceskeeper "github.com/milkyway-labs/milkyway/v12/x/services/keeper"
 )
 
@@ -91,7 +90,7 @@ func (suite *KeeperTestSuite) fundAccount(ctx sdk.Context, address string, amoun
 // and price. RegisterCurrency creates a market for the currency if not exists.
 func (suite *KeeperTestSuite) RegisterCurrency(ctx sdk.Context, denom string, ticker string, exponent uint32, price math.LegacyDec) {
 	// Create the market only if it doesn't exist.
-	mmTicker := marketmaptypes.NewTicker(ticker, rewardstypes.USDTicker, math.LegacyPrecision, 0, true)
+	mmTicker := marketmaptypes.NewTicker(ticker, assetstypes.USDTicker, math.LegacyPrecision, 0, true)
 	hasMarket, err := suite.marketMapKeeper.HasMarket(ctx, mmTicker.String())
 	suite.Require().NoError(err)
 
@@ -103,7 +102,7 @@ func (suite *KeeperTestSuite) RegisterCurrency(ctx sdk.Context, denom string, ti
 	// Set the price for the currency pair.
 	err = suite.oracleKeeper.SetPriceForCurrencyPair(
 		ctx,
-		connecttypes.NewCurrencyPair(ticker, rewardstypes.USDTicker),
+		connecttypes.NewCurrencyPair(ticker, assetstypes.USDTicker),
 		oracletypes.QuotePrice{
 			Price:          math.NewIntFromBigInt(price.BigInt()),
 			BlockTimestamp: ctx.BlockTime(),
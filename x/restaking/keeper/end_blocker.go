@@ -34,5 +34,30 @@ func (k *Keeper) CompleteMatureUnbondingDelegations(ctx sdk.Context) error {
 		)
 	}
 
+	// Remove all mature redelegations from the redelegation queue.
+	matureRedelegations, err := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+
+	for _, data := range matureRedelegations {
+		balances, err := k.CompleteRedelegation(ctx, data)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteRedelegation,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, data.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySrcTargetType, data.SrcType.String()),
+				sdk.NewAttribute(types.AttributeKeySrcTargetID, fmt.Sprintf("%d", data.SrcTargetID)),
+				sdk.NewAttribute(types.AttributeKeyDstTargetType, data.DstType.String()),
+				sdk.NewAttribute(types.AttributeKeyDstTargetID, fmt.Sprintf("%d", data.DstTargetID)),
+			),
+		)
+	}
+
 	return nil
 }
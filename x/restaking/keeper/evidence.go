@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// SetEvidenceRouter sets the router used to route the evidences of the
+// operators misbehaviors to the registered handlers. The router is sealed
+// in order to prevent any further handler from being registered
+func (k *Keeper) SetEvidenceRouter(router types.EvidenceRouter) {
+	if k.evidenceRouter != nil {
+		panic("evidence router already set")
+	}
+
+	if !router.Sealed() {
+		router.Seal()
+	}
+
+	k.evidenceRouter = router
+}
+
+// SetContractKeeper sets the keeper used to call the CosmWasm contracts that
+// verify the evidences of the operators misbehaviors
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveSubmittedEvidence stores the hash of an evidence submitted for the given service
+func (k *Keeper) SaveSubmittedEvidence(ctx context.Context, serviceID uint32, hash []byte) error {
+	return k.submittedEvidences.Set(ctx, collections.Join(serviceID, hash))
+}
+
+// HasSubmittedEvidence returns true if the evidence with the given hash has
+// already been submitted for the given service
+func (k *Keeper) HasSubmittedEvidence(ctx context.Context, serviceID uint32, hash []byte) (bool, error) {
+	return k.submittedEvidences.Has(ctx, collections.Join(serviceID, hash))
+}
+
+// GetAllSubmittedEvidences returns all the stored submitted evidences
+func (k *Keeper) GetAllSubmittedEvidences(ctx context.Context) ([]types.SubmittedEvidence, error) {
+	iterator, err := k.submittedEvidences.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var evidences []types.SubmittedEvidence
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.Key()
+		if err != nil {
+			return nil, err
+		}
+		evidences = append(evidences, types.NewSubmittedEvidence(key.K1(), key.K2()))
+	}
+
+	return evidences, nil
+}
+
+// DeleteServiceSubmittedEvidences removes all the evidences submitted for the given service
+func (k *Keeper) DeleteServiceSubmittedEvidences(ctx context.Context, serviceID uint32) error {
+	return k.submittedEvidences.Clear(ctx, collections.NewPrefixedPairRange[uint32, []byte](serviceID))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SubmitOperatorEvidence verifies the given evidence of an operator misbehavior
+// performed while securing the given service, and punishes the operator based on
+// the verdict returned by the service's verifier. The verifier is the CosmWasm
+// contract set inside the service's params if any, otherwise the handler
+// registered for the evidence route. The hash of the evidence is returned
+func (k *Keeper) SubmitOperatorEvidence(ctx context.Context, serviceID uint32, submitter string, evidence types.OperatorEvidence) ([]byte, error) {
+	// Make sure the service and the operator exist
+	_, err := k.servicesKeeper.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.operatorsKeeper.GetOperator(ctx, evidence.GetOperatorID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the operator is securing the service, so that a service cannot
+	// punish operators that have never been part of it
+	joined, err := k.HasOperatorJoinedService(ctx, evidence.GetOperatorID(), serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !joined {
+		return nil, errors.Wrapf(types.ErrServiceNotJoinedByOperator, "operator %d has not joined service %d", evidence.GetOperatorID(), serviceID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if evidence.GetHeight() > sdkCtx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidEvidence, "evidence height %d is greater than the current height %d", evidence.GetHeight(), sdkCtx.BlockHeight())
+	}
+
+	// Make sure the evidence is not too old, so that the stake that was unbonding
+	// when the misbehavior occurred can still be slashed once the veto window ends
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(sdkCtx.BlockHeight()-evidence.GetHeight()) > params.MaxEvidenceAge {
+		return nil, errors.Wrapf(types.ErrInvalidEvidence, "evidence height %d is older than the max evidence age of %d blocks", evidence.GetHeight(), params.MaxEvidenceAge)
+	}
+
+	// Make sure the evidence has not been submitted already
+	hash := evidence.Hash()
+	submitted, err := k.HasSubmittedEvidence(ctx, serviceID, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if submitted {
+		return nil, errors.Wrapf(types.ErrEvidenceAlreadySubmitted, "evidence %X has already been submitted for service %d", hash, serviceID)
+	}
+
+	// Verify the evidence
+	verdict, err := k.verifyOperatorEvidence(ctx, serviceID, submitter, evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verdict.Validate()
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidEvidence, "invalid verdict: %s", err)
+	}
+
+	err = k.SaveSubmittedEvidence(ctx, serviceID, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Punish the operator
+	err = k.applyEvidenceVerdict(ctx, serviceID, evidence, verdict)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// verifyOperatorEvidence verifies the given evidence using the verifier of the
+// given service, returning the verdict if the evidence is valid
+func (k *Keeper) verifyOperatorEvidence(ctx context.Context, serviceID uint32, submitter string, evidence types.OperatorEvidence) (types.EvidenceVerdict, error) {
+	params, err := k.servicesKeeper.GetServiceParams(ctx, serviceID)
+	if err != nil {
+		return types.EvidenceVerdict{}, err
+	}
+
+	// Verify the evidence using the service's contract, if any
+	if params.EvidenceVerifier != "" {
+		return k.verifyOperatorEvidenceWithContract(ctx, params.EvidenceVerifier, serviceID, submitter, evidence)
+	}
+
+	// Verify the evidence using the registered handler
+	if k.evidenceRouter == nil || !k.evidenceRouter.HasRoute(evidence.Route()) {
+		return types.EvidenceVerdict{}, errors.Wrapf(types.ErrNoEvidenceVerifier, "no verifier found for route %s and service %d", evidence.Route(), serviceID)
+	}
+
+	verdict, err := k.evidenceRouter.GetRoute(evidence.Route())(ctx, serviceID, evidence)
+	if err != nil {
+		return types.EvidenceVerdict{}, errors.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	return verdict, nil
+}
+
+// verifyOperatorEvidenceWithContract verifies the given evidence by calling
+// the CosmWasm contract having the given address
+func (k *Keeper) verifyOperatorEvidenceWithContract(
+	ctx context.Context, contractAddress string, serviceID uint32, submitter string, evidence types.OperatorEvidence,
+) (types.EvidenceVerdict, error) {
+	if k.contractKeeper == nil {
+		return types.EvidenceVerdict{}, errors.Wrap(types.ErrNoEvidenceVerifier, "contract keeper not set")
+	}
+
+	contractAddr, err := k.accountKeeper.AddressCodec().StringToBytes(contractAddress)
+	if err != nil {
+		return types.EvidenceVerdict{}, err
+	}
+
+	evidenceAny, err := codectypes.NewAnyWithValue(evidence)
+	if err != nil {
+		return types.EvidenceVerdict{}, err
+	}
+
+	msgBz, err := json.Marshal(types.VerifyOperatorEvidenceSudoMsg{
+		VerifyOperatorEvidence: types.VerifyOperatorEvidenceMsg{
+			ServiceID:    serviceID,
+			OperatorID:   evidence.GetOperatorID(),
+			Height:       evidence.GetHeight(),
+			Submitter:    submitter,
+			EvidenceType: evidenceAny.TypeUrl,
+			Evidence:     evidenceAny.Value,
+		},
+	})
+	if err != nil {
+		return types.EvidenceVerdict{}, err
+	}
+
+	resBz, err := k.contractKeeper.Sudo(sdk.UnwrapSDKContext(ctx), sdk.AccAddress(contractAddr), msgBz)
+	if err != nil {
+		return types.EvidenceVerdict{}, errors.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	var verdict types.ContractEvidenceVerdict
+	err = json.Unmarshal(resBz, &verdict)
+	if err != nil {
+		return types.EvidenceVerdict{}, errors.Wrapf(types.ErrInvalidEvidence, "invalid contract verdict: %s", err)
+	}
+
+	evidenceVerdict, err := verdict.ToEvidenceVerdict()
+	if err != nil {
+		return types.EvidenceVerdict{}, errors.Wrapf(types.ErrInvalidEvidence, "invalid contract verdict: %s", err)
+	}
+
+	return evidenceVerdict, nil
+}
+
+// applyEvidenceVerdict queues the slash and the tombstone of the operator that
+// misbehaved and jails it based on the given verdict
+func (k *Keeper) applyEvidenceVerdict(ctx context.Context, serviceID uint32, evidence types.OperatorEvidence, verdict types.EvidenceVerdict) error {
+	operatorID := evidence.GetOperatorID()
+
+	// Tombstoning is permanent, so it goes through the veto window as well
+	if verdict.Tombstone {
+		fraction := verdict.SlashFraction
+		if fraction.IsNil() {
+			fraction = math.LegacyZeroDec()
+		}
+
+		_, err := k.QueueOperatorTombstone(ctx, serviceID, operatorID, fraction, evidence.GetHeight())
+		if err != nil {
+			return err
+		}
+
+		// Jail the operator while the tombstone waits for the veto window
+		if verdict.JailDuration > 0 {
+			return k.JailOperator(ctx, serviceID, operatorID, verdict.JailDuration)
+		}
+
+		return nil
+	}
+
+	if verdict.ShouldSlash() {
+		_, err := k.QueueOperatorSlash(ctx, serviceID, operatorID, verdict.SlashFraction, evidence.GetHeight())
+		if err != nil {
+			return err
+		}
+	}
+
+	if verdict.JailDuration > 0 {
+		return k.JailOperator(ctx, serviceID, operatorID, verdict.JailDuration)
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// mockEvidence represents an evidence that is verified by the handler
+// registered for the mock route
+type mockEvidence struct {
+	*types.ContractEvidence
+}
+
+func (e mockEvidence) Route() string {
+	return "mock"
+}
+
+// mockContractKeeper represents a contract keeper that returns the given
+// response when the evidence verifier contract is called
+type mockContractKeeper struct {
+	msgs     [][]byte
+	response []byte
+	err      error
+}
+
+func (k *mockContractKeeper) Sudo(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	k.msgs = append(k.msgs, msg)
+	return k.response, k.err
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (suite *KeeperTestSuite) TestKeeper_SubmitOperatorEvidence() {
+	// Register a handler that returns a verdict based on the evidence data
+	suite.k.SetEvidenceRouter(types.NewEvidenceRouter().AddRoute("mock",
+		func(_ context.Context, serviceID uint32, evidence types.OperatorEvidence) (types.EvidenceVerdict, error) {
+			switch string(evidence.(mockEvidence).Data) {
+			case "slash":
+				return types.NewEvidenceVerdict(utils.MustParseDec("0.1"), time.Hour, false), nil
+			case "tombstone":
+				return types.NewEvidenceVerdict(utils.MustParseDec("0.5"), 0, true), nil
+			case "tombstone and jail":
+				return types.NewEvidenceVerdict(utils.MustParseDec("0.5"), time.Hour, true), nil
+			case "invalid verdict":
+				return types.NewEvidenceVerdict(utils.MustParseDec("2"), 0, false), nil
+			default:
+				return types.EvidenceVerdict{}, fmt.Errorf("invalid evidence")
+			}
+		},
+	))
+
+	contractAddress := authtypes.NewModuleAddress("evidence-verifier").String()
+
+	testCases := []struct {
+		name           string
+		store          func(ctx sdk.Context)
+		contractKeeper *mockContractKeeper
+		evidence       types.OperatorEvidence
+		shouldErr      bool
+		expErr         error
+		check          func(ctx sdk.Context)
+	}{
+		{
+			name:      "non existing service returns error",
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))},
+			shouldErr: true,
+		},
+		{
+			name: "non existing operator returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))},
+			shouldErr: true,
+		},
+		{
+			name: "operator that has not joined the service returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				err := suite.ok.SaveOperator(ctx, newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"))
+				suite.Require().NoError(err)
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))},
+			shouldErr: true,
+			expErr:    types.ErrServiceNotJoinedByOperator,
+		},
+		{
+			name: "evidence with future height returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 101, []byte("slash"))},
+			shouldErr: true,
+			expErr:    types.ErrInvalidEvidence,
+		},
+		{
+			name: "evidence older than the max evidence age returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+				params.MaxEvidenceAge = 50
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 49, []byte("slash"))},
+			shouldErr: true,
+			expErr:    types.ErrInvalidEvidence,
+		},
+		{
+			name: "evidence as old as the max evidence age is accepted",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+				params.MaxEvidenceAge = 50
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 50, []byte("slash"))},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+			},
+		},
+		{
+			name: "already submitted evidence returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				evidence := mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))}
+				err := suite.k.SaveSubmittedEvidence(ctx, 1, evidence.Hash())
+				suite.Require().NoError(err)
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))},
+			shouldErr: true,
+			expErr:    types.ErrEvidenceAlreadySubmitted,
+		},
+		{
+			name: "evidence without verifier returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  types.NewContractEvidence(1, 10, []byte("slash")),
+			shouldErr: true,
+			expErr:    types.ErrNoEvidenceVerifier,
+		},
+		{
+			name: "evidence rejected by the handler returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("double sign"))},
+			shouldErr: true,
+			expErr:    types.ErrInvalidEvidence,
+		},
+		{
+			name: "invalid verdict returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("invalid verdict"))},
+			shouldErr: true,
+			expErr:    types.ErrInvalidEvidence,
+		},
+		{
+			name: "evidence verified by the handler queues the slash and jails the operator",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+					types.EventTypeJailOperator,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, "2024-01-01T13:00:00Z"),
+				))
+
+				pendingSlash, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC),
+				), pendingSlash)
+
+				submitted, err := suite.k.HasSubmittedEvidence(ctx, 1, mockEvidence{types.NewContractEvidence(1, 10, []byte("slash"))}.Hash())
+				suite.Require().NoError(err)
+				suite.Require().True(submitted)
+			},
+		},
+		{
+			name: "evidence verified by the handler queues the tombstone of the operator",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("tombstone"))},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				// Make sure the operator is not tombstoned until the veto window has passed
+				_, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(operatorstypes.OPERATOR_STATUS_ACTIVE, operator.Status)
+
+				pendingSlash, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().True(pendingSlash.Tombstone)
+				suite.Require().Equal(utils.MustParseDec("0.5"), pendingSlash.Fraction)
+			},
+		},
+		{
+			name: "evidence verified by the handler jails the operator while its tombstone is pending",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			evidence:  mockEvidence{types.NewContractEvidence(1, 10, []byte("tombstone and jail"))},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC), false), record)
+
+				pendingSlash, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().True(pendingSlash.Tombstone)
+			},
+		},
+		{
+			name: "evidence rejected by the contract returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, utils.MustParseDec("0"), 0, 0, 0, contractAddress, 0))
+				suite.Require().NoError(err)
+			},
+			contractKeeper: &mockContractKeeper{err: fmt.Errorf("invalid signature")},
+			evidence:       types.NewContractEvidence(1, 10, []byte("proof")),
+			shouldErr:      true,
+			expErr:         types.ErrInvalidEvidence,
+		},
+		{
+			name: "contract verdict with overflowing jail duration returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, utils.MustParseDec("0"), 0, 0, 0, contractAddress, 0))
+				suite.Require().NoError(err)
+			},
+			contractKeeper: &mockContractKeeper{response: []byte(`{"jail_duration_seconds":18446744073709551615}`)},
+			evidence:       types.NewContractEvidence(1, 10, []byte("proof")),
+			shouldErr:      true,
+			expErr:         types.ErrInvalidEvidence,
+		},
+		{
+			name: "evidence verified by the contract jails the operator",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, utils.MustParseDec("0"), 0, 0, 0, contractAddress, 0))
+				suite.Require().NoError(err)
+			},
+			contractKeeper: &mockContractKeeper{response: []byte(`{"jail_duration_seconds":1800}`)},
+			evidence:       types.NewContractEvidence(1, 10, []byte("proof")),
+			shouldErr:      false,
+			check: func(ctx sdk.Context) {
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC), false), record)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			if tc.contractKeeper != nil {
+				suite.k.SetContractKeeper(tc.contractKeeper)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			hash, err := suite.k.SubmitOperatorEvidence(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", tc.evidence)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.evidence.Hash(), hash)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+
+			if tc.contractKeeper != nil {
+				suite.Require().Len(tc.contractKeeper.msgs, 1)
+
+				var msg types.VerifyOperatorEvidenceSudoMsg
+				err = json.Unmarshal(tc.contractKeeper.msgs[0], &msg)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint32(1), msg.VerifyOperatorEvidence.ServiceID)
+				suite.Require().Equal(tc.evidence.GetOperatorID(), msg.VerifyOperatorEvidence.OperatorID)
+				suite.Require().Equal("/milkyway.restaking.v1.ContractEvidence", msg.VerifyOperatorEvidence.EvidenceType)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteServiceSubmittedEvidences() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	err := suite.k.SaveSubmittedEvidence(ctx, 1, []byte("hash1"))
+	suite.Require().NoError(err)
+	err = suite.k.SaveSubmittedEvidence(ctx, 2, []byte("hash2"))
+	suite.Require().NoError(err)
+
+	err = suite.k.DeleteServiceSubmittedEvidences(ctx, 1)
+	suite.Require().NoError(err)
+
+	evidences, err := suite.k.GetAllSubmittedEvidences(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SubmittedEvidence{types.NewSubmittedEvidence(2, []byte("hash2"))}, evidences)
+}
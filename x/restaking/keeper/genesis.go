@@ -33,6 +33,46 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	redelegations, err := k.GetAllRedelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	servicesSnapshots, err := k.GetAllServicesSnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	operatorsJailRecords, err := k.GetAllOperatorsJailRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	submittedEvidences, err := k.GetAllSubmittedEvidences(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingSlashes, err := k.GetAllPendingSlashes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextPendingSlashID, err := k.GetNextPendingSlashID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	operatorsServiceKeys, err := k.GetAllOperatorsServiceKeys(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingOperatorsServiceKeys, err := k.GetAllPendingOperatorsServiceKeys(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	preferences, err := k.GetUserPreferencesEntries(ctx)
 	if err != nil {
 		panic(err)
@@ -50,6 +90,14 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		delegations,
 		unbondingDelegations,
 		preferences,
+		redelegations,
+		servicesSnapshots,
+		operatorsJailRecords,
+		submittedEvidences,
+		pendingSlashes,
+		nextPendingSlashID,
+		operatorsServiceKeys,
+		pendingOperatorsServiceKeys,
 		params,
 	)
 }
@@ -109,6 +157,77 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
 		}
 	}
 
+	// Store the redelegations
+	for _, red := range data.Redelegations {
+		err := k.SetRedelegation(ctx, red)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range red.Entries {
+			err = k.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Store the services snapshots
+	for _, snapshot := range data.ServicesSnapshots {
+		err := k.SaveServiceSnapshot(ctx, snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the operators jail records
+	for _, record := range data.OperatorsJailRecords {
+		err := k.SaveOperatorJailRecord(ctx, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the submitted evidences
+	for _, evidence := range data.SubmittedEvidences {
+		err := k.SaveSubmittedEvidence(ctx, evidence.ServiceID, evidence.Hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the pending slashes
+	for _, pendingSlash := range data.PendingSlashes {
+		err := k.SavePendingSlash(ctx, pendingSlash)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the next pending slash ID
+	if data.NextPendingSlashID != 0 {
+		err := k.SetNextPendingSlashID(ctx, data.NextPendingSlashID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the operators service keys
+	for _, key := range data.OperatorsServiceKeys {
+		err := k.SaveOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store the pending operators service keys
+	for _, key := range data.PendingOperatorsServiceKeys {
+		err := k.SavePendingOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Store the user preferences
 	for _, entry := range data.UsersPreferences {
 		err := k.SetUserPreferences(ctx, entry.UserAddress, entry.Preferences)
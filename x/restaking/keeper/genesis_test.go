@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
@@ -33,7 +35,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				OperatorsJoinedServices: []types.OperatorJoinedServices{
 					types.NewOperatorJoinedServices(1, []uint32{1, 2}),
 					types.NewOperatorJoinedServices(2, []uint32{3, 4}),
@@ -57,7 +60,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				ServicesAllowedOperators: []types.ServiceAllowedOperators{
 					types.NewServiceAllowedOperators(1, []uint32{1, 2}),
 					types.NewServiceAllowedOperators(2, []uint32{3, 4}),
@@ -81,7 +85,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				ServicesSecuringPools: []types.ServiceSecuringPools{
 					types.NewServiceSecuringPools(1, []uint32{1, 2}),
 					types.NewServiceSecuringPools(2, []uint32{3, 4}),
@@ -109,7 +114,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				Delegations: []types.Delegation{
 					types.NewPoolDelegation(
 						1,
@@ -149,7 +155,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				Delegations: []types.Delegation{
 					types.NewServiceDelegation(
 						1,
@@ -193,7 +200,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				Delegations: []types.Delegation{
 					types.NewOperatorDelegation(
 						1,
@@ -229,7 +237,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				UnbondingDelegations: []types.UnbondingDelegation{
 					types.NewPoolUnbondingDelegation(
 						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
@@ -259,7 +268,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				UnbondingDelegations: []types.UnbondingDelegation{
 					types.NewOperatorUnbondingDelegation(
 						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
@@ -289,7 +299,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				UnbondingDelegations: []types.UnbondingDelegation{
 					types.NewServiceUnbondingDelegation(
 						"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
@@ -316,7 +327,8 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
-				Params: types.DefaultParams(),
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
 				UsersPreferences: []types.UserPreferencesEntry{
 					types.NewUserPreferencesEntry(
 						"cosmos1jseuux3pktht0kkhlcsv4kqff3mql65udqs4jw",
@@ -329,6 +341,150 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				},
 			},
 		},
+		{
+			name: "services snapshots are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(2, 1, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, 2, 20, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil))
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
+				ServicesSnapshots: []types.ServiceSnapshot{
+					types.NewServiceSnapshot(1, 2, 20, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil),
+					types.NewServiceSnapshot(2, 1, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+				},
+			},
+		},
+		{
+			name: "operators jail records are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(2, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), true))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 3, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), false))
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
+				OperatorsJailRecords: []types.OperatorJailRecord{
+					types.NewOperatorJailRecord(1, 3, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), false),
+					types.NewOperatorJailRecord(2, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), true),
+				},
+			},
+		},
+		{
+			name: "submitted evidences are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveSubmittedEvidence(ctx, 2, []byte("hash1"))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveSubmittedEvidence(ctx, 1, []byte("hash2"))
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
+				SubmittedEvidences: []types.SubmittedEvidence{
+					types.NewSubmittedEvidence(1, []byte("hash2")),
+					types.NewSubmittedEvidence(2, []byte("hash1")),
+				},
+			},
+		},
+		{
+			name: "pending slashes are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SetNextPendingSlashID(ctx, 2)
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 2,
+				Params:             types.DefaultParams(),
+				PendingSlashes: []types.PendingSlash{
+					types.NewPendingSlash(
+						1,
+						1,
+						1,
+						utils.MustParseDec("0.1"),
+						10,
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					),
+				},
+			},
+		},
+		{
+			name: "operators service keys are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					bytes.Repeat([]byte{1}, 32),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					bytes.Repeat([]byte{2}, 32),
+					time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 1,
+				Params:             types.DefaultParams(),
+				OperatorsServiceKeys: []types.OperatorServiceKey{
+					types.NewOperatorServiceKey(
+						1,
+						1,
+						types.KEY_TYPE_ED25519,
+						bytes.Repeat([]byte{1}, 32),
+						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+				},
+				PendingOperatorsServiceKeys: []types.OperatorServiceKey{
+					types.NewOperatorServiceKey(
+						1,
+						1,
+						types.KEY_TYPE_ED25519,
+						bytes.Repeat([]byte{2}, 32),
+						time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					),
+				},
+			},
+		},
 		{
 			name: "params are exported properly",
 			store: func(ctx sdk.Context) {
@@ -337,15 +493,30 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 					nil,
 					sdkmath.LegacyNewDec(100000),
 					100,
+					"",
+					types.DefaultDowntimeJailDuration,
+					types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "",
+					types.DefaultOperatorServiceKeyRotationDelay,
+					types.DefaultMaxServiceUnbondingTime,
+					types.DefaultActiveOperatorsRefreshInterval,
+					types.DefaultMaxEvidenceAge,
 				))
 				suite.Require().NoError(err)
 			},
 			expGenesis: &types.GenesisState{
+				NextPendingSlashID: 1,
 				Params: types.NewParams(
 					30*24*time.Hour,
 					nil,
 					sdkmath.LegacyNewDec(100000),
 					100,
+					"",
+					types.DefaultDowntimeJailDuration,
+					types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "",
+					types.DefaultOperatorServiceKeyRotationDelay,
+					types.DefaultMaxServiceUnbondingTime,
+					types.DefaultActiveOperatorsRefreshInterval,
+					types.DefaultMaxEvidenceAge,
 				),
 			},
 		},
@@ -653,6 +824,148 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				}, stored)
 			},
 		},
+		{
+			name: "services snapshots are stored properly",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ServicesSnapshots: []types.ServiceSnapshot{
+					types.NewServiceSnapshot(1, 1, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+					types.NewServiceSnapshot(1, 2, 20, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				snapshot, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewServiceSnapshot(1, 2, 20, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil), snapshot)
+			},
+		},
+		{
+			name: "operators jail records are stored properly",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OperatorsJailRecords: []types.OperatorJailRecord{
+					types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false),
+					types.NewOperatorJailRecord(2, 1, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), true),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 2, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewOperatorJailRecord(2, 1, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), true), record)
+			},
+		},
+		{
+			name: "submitted evidences are stored properly",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SubmittedEvidences: []types.SubmittedEvidence{
+					types.NewSubmittedEvidence(1, []byte("hash")),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				submitted, err := suite.k.HasSubmittedEvidence(ctx, 1, []byte("hash"))
+				suite.Require().NoError(err)
+				suite.Require().True(submitted)
+			},
+		},
+		{
+			name: "pending slashes are stored properly",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingSlashes: []types.PendingSlash{
+					types.NewPendingSlash(
+						1,
+						1,
+						1,
+						utils.MustParseDec("0.1"),
+						10,
+						time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					),
+				},
+				NextPendingSlashID: 2,
+			},
+			check: func(ctx sdk.Context) {
+				pendingSlash, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				), pendingSlash)
+
+				nextID, err := suite.k.GetNextPendingSlashID(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), nextID)
+
+				// Make sure the pending slash has been added to the queue. Since the operator
+				// does not exist, the slash fails and gets dropped
+				ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+				err = suite.k.ExecuteMaturePendingSlashes(ctx)
+				suite.Require().NoError(err)
+
+				_, found, err = suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "operators service keys are stored properly",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OperatorsServiceKeys: []types.OperatorServiceKey{
+					types.NewOperatorServiceKey(
+						1,
+						1,
+						types.KEY_TYPE_ED25519,
+						bytes.Repeat([]byte{1}, 32),
+						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+				},
+				PendingOperatorsServiceKeys: []types.OperatorServiceKey{
+					types.NewOperatorServiceKey(
+						1,
+						1,
+						types.KEY_TYPE_ED25519,
+						bytes.Repeat([]byte{2}, 32),
+						time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					),
+				},
+			},
+			check: func(ctx sdk.Context) {
+				ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+				key, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(bytes.Repeat([]byte{1}, 32), key.PublicKey)
+
+				// Make sure the pending key has been added to the queue
+				ctx = ctx.WithBlockTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+				err = suite.k.ActivateMatureOperatorServiceKeys(ctx)
+				suite.Require().NoError(err)
+
+				_, found, err = suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				keys, err := suite.k.GetAllOperatorsServiceKeys(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(
+						1,
+						1,
+						types.KEY_TYPE_ED25519,
+						bytes.Repeat([]byte{2}, 32),
+						time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					),
+				}, keys)
+			},
+		},
 		{
 			name: "params are stored properly",
 			genesis: &types.GenesisState{
@@ -661,6 +974,13 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 					nil,
 					sdkmath.LegacyNewDec(100000),
 					100,
+					"",
+					types.DefaultDowntimeJailDuration,
+					types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "",
+					types.DefaultOperatorServiceKeyRotationDelay,
+					types.DefaultMaxServiceUnbondingTime,
+					types.DefaultActiveOperatorsRefreshInterval,
+					types.DefaultMaxEvidenceAge,
 				),
 			},
 			check: func(ctx sdk.Context) {
@@ -671,6 +991,13 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 					nil,
 					sdkmath.LegacyNewDec(100000),
 					100,
+					"",
+					types.DefaultDowntimeJailDuration,
+					types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "",
+					types.DefaultOperatorServiceKeyRotationDelay,
+					types.DefaultMaxServiceUnbondingTime,
+					types.DefaultActiveOperatorsRefreshInterval,
+					types.DefaultMaxEvidenceAge,
 				), params)
 			},
 		},
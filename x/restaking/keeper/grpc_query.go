@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -86,6 +87,221 @@ func (k Querier) ServiceAllowedOperators(
 	}, nil
 }
 
+// ServiceActiveOperators queries the operators that are part of the active
+// operators set of a given service.
+func (k Querier) ServiceActiveOperators(
+	ctx context.Context,
+	req *types.QueryServiceActiveOperatorsRequest,
+) (*types.QueryServiceActiveOperatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service id cannot be 0")
+	}
+
+	// Get the service's active operators
+	operatorIDs, pageResponse, err := query.CollectionPaginate(ctx, k.serviceActiveOperators, req.Pagination,
+		func(key collections.Pair[uint32, uint32], _ collections.NoValue) (uint32, error) {
+			return key.K2(), nil
+		}, query.WithCollectionPaginationPairPrefix[uint32, uint32](req.ServiceId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryServiceActiveOperatorsResponse{
+		OperatorIds: operatorIDs,
+		Pagination:  pageResponse,
+	}, nil
+}
+
+// ServiceValidatingSet queries the validating set of a given service, along
+// with the voting power of each operator.
+func (k Querier) ServiceValidatingSet(
+	ctx context.Context,
+	req *types.QueryServiceValidatingSetRequest,
+) (*types.QueryServiceValidatingSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service id cannot be 0")
+	}
+
+	_, err := k.servicesKeeper.GetService(ctx, req.ServiceId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service with id %d not found", req.ServiceId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validatingSet, err := k.GetServiceValidatingSet(ctx, req.ServiceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryServiceValidatingSetResponse{
+		Operators: validatingSet,
+	}, nil
+}
+
+// ServiceSnapshot queries the snapshot of a service's validating set taken at
+// a given epoch. If the epoch is 0, the latest snapshot is returned.
+func (k Querier) ServiceSnapshot(
+	ctx context.Context,
+	req *types.QueryServiceSnapshotRequest,
+) (*types.QueryServiceSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service id cannot be 0")
+	}
+
+	var snapshot types.ServiceSnapshot
+	var found bool
+	var err error
+	if req.Epoch == 0 {
+		snapshot, found, err = k.GetLatestServiceSnapshot(ctx, req.ServiceId)
+	} else {
+		snapshot, found, err = k.GetServiceSnapshot(ctx, req.ServiceId, req.Epoch)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "snapshot for service %d at epoch %d not found", req.ServiceId, req.Epoch)
+	}
+
+	return &types.QueryServiceSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+// OperatorJailRecord queries the jail record of an operator for a given service.
+func (k Querier) OperatorJailRecord(
+	ctx context.Context,
+	req *types.QueryOperatorJailRecordRequest,
+) (*types.QueryOperatorJailRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service id cannot be 0")
+	}
+
+	if req.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator id cannot be 0")
+	}
+
+	record, found, err := k.GetOperatorJailRecord(ctx, req.ServiceId, req.OperatorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "operator %d is not jailed from service %d", req.OperatorId, req.ServiceId)
+	}
+
+	return &types.QueryOperatorJailRecordResponse{
+		JailRecord: record,
+	}, nil
+}
+
+// OperatorServiceKey queries the key that an operator uses for a given service.
+func (k Querier) OperatorServiceKey(
+	ctx context.Context,
+	req *types.QueryOperatorServiceKeyRequest,
+) (*types.QueryOperatorServiceKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator id cannot be 0")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service id cannot be 0")
+	}
+
+	key, found, err := k.GetOperatorServiceKey(ctx, req.OperatorId, req.ServiceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "operator %d has no key for service %d", req.OperatorId, req.ServiceId)
+	}
+
+	pendingKey, found, err := k.GetPendingOperatorServiceKey(ctx, req.OperatorId, req.ServiceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The pending key is returned only if it has not replaced the current one yet
+	var pending *types.OperatorServiceKey
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if found && !pendingKey.IsActive(sdkCtx.BlockTime()) {
+		pending = &pendingKey
+	}
+
+	return &types.QueryOperatorServiceKeyResponse{
+		Key:        key,
+		PendingKey: pending,
+	}, nil
+}
+
+// PendingSlash queries the pending slash having the given ID.
+func (k Querier) PendingSlash(ctx context.Context, req *types.QueryPendingSlashRequest) (*types.QueryPendingSlashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PendingSlashId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pending slash id cannot be 0")
+	}
+
+	pendingSlash, found, err := k.GetPendingSlash(ctx, req.PendingSlashId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending slash %d not found", req.PendingSlashId)
+	}
+
+	return &types.QueryPendingSlashResponse{
+		PendingSlash: pendingSlash,
+	}, nil
+}
+
+// PendingSlashes queries all the pending slashes.
+func (k Querier) PendingSlashes(ctx context.Context, req *types.QueryPendingSlashesRequest) (*types.QueryPendingSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pendingSlashes, pageResponse, err := query.CollectionPaginate(ctx, k.pendingSlashes, req.Pagination,
+		func(_ uint64, pendingSlash types.PendingSlash) (types.PendingSlash, error) {
+			return pendingSlash, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingSlashesResponse{
+		PendingSlashes: pendingSlashes,
+		Pagination:     pageResponse,
+	}, nil
+}
+
 // ServiceSecuringPools queries the pools that are securing a given service.
 func (k Querier) ServiceSecuringPools(
 	ctx context.Context,
@@ -1071,6 +1287,85 @@ func (k Querier) DelegatorService(ctx context.Context, req *types.QueryDelegator
 	}, nil
 }
 
+// DelegatorRedelegations queries the redelegations for the given delegator address
+func (k Querier) DelegatorRedelegations(ctx context.Context, req *types.QueryDelegatorRedelegationsRequest) (*types.QueryDelegatorRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	// Get the user redelegations store
+	store := k.storeService.OpenKVStore(ctx)
+	redStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.UserRedelegationsStorePrefix(req.DelegatorAddress))
+
+	// Get the redelegations
+	var redelegations []types.Redelegation
+	pageRes, err := query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
+		red, err := types.UnmarshalRedelegation(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		redelegations = append(redelegations, red)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorRedelegationsResponse{
+		Redelegations: redelegations,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// TargetRedelegations queries the redelegations made from the given target
+func (k Querier) TargetRedelegations(ctx context.Context, req *types.QueryTargetRedelegationsRequest) (*types.QueryTargetRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TargetType == types.DELEGATION_TYPE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "target type cannot be unspecified")
+	}
+
+	if req.TargetId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target id cannot be 0")
+	}
+
+	// Get the redelegations by source target store
+	store := k.storeService.OpenKVStore(ctx)
+	indexStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.RedelegationsBySrcTargetStorePrefix(req.TargetType, req.TargetId))
+	srcPrefix := types.RedelegationsBySrcTargetStorePrefix(req.TargetType, req.TargetId)
+
+	// Get the redelegations
+	var redelegations []types.Redelegation
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		data := types.ParseRedelegationBySrcTargetStoreKey(append(srcPrefix, key...))
+		red, found, err := k.GetRedelegation(ctx, data.DelegatorAddress, data.SrcType, data.SrcTargetID, data.DstType, data.DstTargetID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return types.ErrNoRedelegation
+		}
+
+		redelegations = append(redelegations, red)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTargetRedelegationsResponse{
+		Redelegations: redelegations,
+		Pagination:    pageRes,
+	}, nil
+}
+
 // UserPreferences queries the user preferences for the given user address
 func (k Querier) UserPreferences(ctx context.Context, req *types.QueryUserPreferencesRequest) (*types.QueryUserPreferencesResponse, error) {
 	if req == nil {
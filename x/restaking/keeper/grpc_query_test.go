@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/keeper"
@@ -185,6 +186,515 @@ func (suite *KeeperTestSuite) TestQuerier_ServiceAllowedOperators() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQuerier_ServiceActiveOperators() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		request      *types.QueryServiceActiveOperatorsRequest
+		shouldErr    bool
+		expOperators []uint32
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service id returns error",
+			request:   types.NewQueryServiceActiveOperatorsRequest(0, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "service without active operators returns empty list",
+			request:   types.NewQueryServiceActiveOperatorsRequest(1, nil),
+			shouldErr: false,
+		},
+		{
+			name: "service active operators are returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{2, 1})
+				suite.Require().NoError(err)
+				err = suite.k.SetServiceActiveOperators(ctx, 2, []uint32{3})
+				suite.Require().NoError(err)
+			},
+			request:      types.NewQueryServiceActiveOperatorsRequest(1, nil),
+			shouldErr:    false,
+			expOperators: []uint32{1, 2},
+		},
+		{
+			name: "pagination is handled properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{2, 1})
+				suite.Require().NoError(err)
+				err = suite.k.SetServiceActiveOperators(ctx, 2, []uint32{3})
+				suite.Require().NoError(err)
+			},
+			request: types.NewQueryServiceActiveOperatorsRequest(1, &query.PageRequest{
+				Offset: 0,
+				Limit:  1,
+			}),
+			shouldErr:    false,
+			expOperators: []uint32{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.ServiceActiveOperators(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expOperators, res.OperatorIds)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_ServiceValidatingSet() {
+	testCases := []struct {
+		name         string
+		store        func(ctx sdk.Context)
+		request      *types.QueryServiceValidatingSetRequest
+		shouldErr    bool
+		expOperators []types.ValidatingOperator
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service id returns error",
+			request:   types.NewQueryServiceValidatingSetRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found service returns error",
+			request:   types.NewQueryServiceValidatingSetRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "service validating set is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+			},
+			request:   types.NewQueryServiceValidatingSetRequest(1),
+			shouldErr: false,
+			expOperators: []types.ValidatingOperator{
+				types.NewValidatingOperator(2, utils.MustParseCoins("30_000000umilk"), utils.MustParseDec("60")),
+				types.NewValidatingOperator(1, utils.MustParseCoins("10_000000umilk"), utils.MustParseDec("20")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.ServiceValidatingSet(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expOperators, res.Operators)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_ServiceSnapshot() {
+	snapshotTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		request     *types.QueryServiceSnapshotRequest
+		shouldErr   bool
+		expSnapshot types.ServiceSnapshot
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service id returns error",
+			request:   types.NewQueryServiceSnapshotRequest(0, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "not found snapshot returns error",
+			request:   types.NewQueryServiceSnapshotRequest(1, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "not found latest snapshot returns error",
+			request:   types.NewQueryServiceSnapshotRequest(1, 0),
+			shouldErr: true,
+		},
+		{
+			name: "snapshot at the given epoch is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, 1, 10, snapshotTime, nil))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, 2, 20, snapshotTime, nil))
+				suite.Require().NoError(err)
+			},
+			request:     types.NewQueryServiceSnapshotRequest(1, 1),
+			shouldErr:   false,
+			expSnapshot: types.NewServiceSnapshot(1, 1, 10, snapshotTime, nil),
+		},
+		{
+			name: "latest snapshot is returned when epoch is 0",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, 1, 10, snapshotTime, nil))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, 2, 20, snapshotTime, nil))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(2, 3, 30, snapshotTime, nil))
+				suite.Require().NoError(err)
+			},
+			request:     types.NewQueryServiceSnapshotRequest(1, 0),
+			shouldErr:   false,
+			expSnapshot: types.NewServiceSnapshot(1, 2, 20, snapshotTime, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.ServiceSnapshot(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSnapshot, res.Snapshot)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_PendingSlash() {
+	executionTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		store           func(ctx sdk.Context)
+		request         *types.QueryPendingSlashRequest
+		shouldErr       bool
+		expPendingSlash types.PendingSlash
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid pending slash id returns error",
+			request:   types.NewQueryPendingSlashRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "non existing pending slash returns error",
+			request:   types.NewQueryPendingSlashRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "pending slash is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, executionTime))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(2, 1, 2, utils.MustParseDec("0.2"), 10, executionTime))
+				suite.Require().NoError(err)
+			},
+			request:         types.NewQueryPendingSlashRequest(1),
+			shouldErr:       false,
+			expPendingSlash: types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, executionTime),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.PendingSlash(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPendingSlash, res.PendingSlash)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_PendingSlashes() {
+	executionTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name              string
+		store             func(ctx sdk.Context)
+		request           *types.QueryPendingSlashesRequest
+		shouldErr         bool
+		expPendingSlashes []types.PendingSlash
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name: "pending slashes are returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, executionTime))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(2, 1, 2, utils.MustParseDec("0.2"), 10, executionTime))
+				suite.Require().NoError(err)
+			},
+			request:   types.NewQueryPendingSlashesRequest(nil),
+			shouldErr: false,
+			expPendingSlashes: []types.PendingSlash{
+				types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, executionTime),
+				types.NewPendingSlash(2, 1, 2, utils.MustParseDec("0.2"), 10, executionTime),
+			},
+		},
+		{
+			name: "pagination is applied properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, executionTime))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(2, 1, 2, utils.MustParseDec("0.2"), 10, executionTime))
+				suite.Require().NoError(err)
+			},
+			request:   types.NewQueryPendingSlashesRequest(&query.PageRequest{Offset: 1, Limit: 1}),
+			shouldErr: false,
+			expPendingSlashes: []types.PendingSlash{
+				types.NewPendingSlash(2, 1, 2, utils.MustParseDec("0.2"), 10, executionTime),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.PendingSlashes(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPendingSlashes, res.PendingSlashes)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_OperatorJailRecord() {
+	jailedUntil := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		request   *types.QueryOperatorJailRecordRequest
+		shouldErr bool
+		expRecord types.OperatorJailRecord
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service id returns error",
+			request:   types.NewQueryOperatorJailRecordRequest(0, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid operator id returns error",
+			request:   types.NewQueryOperatorJailRecordRequest(1, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "not jailed operator returns error",
+			request:   types.NewQueryOperatorJailRecordRequest(1, 1),
+			shouldErr: true,
+		},
+		{
+			name: "jail record is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailedUntil, false))
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(2, 1, jailedUntil, true))
+				suite.Require().NoError(err)
+			},
+			request:   types.NewQueryOperatorJailRecordRequest(1, 1),
+			shouldErr: false,
+			expRecord: types.NewOperatorJailRecord(1, 1, jailedUntil, false),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.OperatorJailRecord(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRecord, res.JailRecord)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_OperatorServiceKey() {
+	blockTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentKey := types.NewOperatorServiceKey(
+		1,
+		1,
+		types.KEY_TYPE_ED25519,
+		firstOperatorServiceKey.PubKey().Bytes(),
+		blockTime.Add(-time.Hour),
+	)
+	pendingKey := types.NewOperatorServiceKey(
+		1,
+		1,
+		types.KEY_TYPE_ED25519,
+		secondOperatorServiceKey.PubKey().Bytes(),
+		blockTime.Add(time.Hour),
+	)
+
+	testCases := []struct {
+		name          string
+		store         func(ctx sdk.Context)
+		request       *types.QueryOperatorServiceKeyRequest
+		shouldErr     bool
+		expKey        types.OperatorServiceKey
+		expPendingKey *types.OperatorServiceKey
+	}{
+		{
+			name:      "invalid request returns error",
+			request:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid operator id returns error",
+			request:   types.NewQueryOperatorServiceKeyRequest(0, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service id returns error",
+			request:   types.NewQueryOperatorServiceKeyRequest(1, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "operator without key returns error",
+			request:   types.NewQueryOperatorServiceKeyRequest(1, 1),
+			shouldErr: true,
+		},
+		{
+			name: "key is returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorServiceKey(ctx, currentKey)
+				suite.Require().NoError(err)
+			},
+			request:       types.NewQueryOperatorServiceKeyRequest(1, 1),
+			shouldErr:     false,
+			expKey:        currentKey,
+			expPendingKey: nil,
+		},
+		{
+			name: "key is returned properly with the pending one",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorServiceKey(ctx, currentKey)
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingOperatorServiceKey(ctx, pendingKey)
+				suite.Require().NoError(err)
+			},
+			request:       types.NewQueryOperatorServiceKeyRequest(1, 1),
+			shouldErr:     false,
+			expKey:        currentKey,
+			expPendingKey: &pendingKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.OperatorServiceKey(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expKey, res.Key)
+				suite.Require().Equal(tc.expPendingKey, res.PendingKey)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQuerier_ServiceSecuringPools() {
 	testCases := []struct {
 		name      string
@@ -3489,6 +3999,217 @@ func (suite *KeeperTestSuite) TestQuerier_DelegatorService() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQuerier_DelegatorRedelegations() {
+	firstRedelegation := types.NewRedelegation(
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		types.DELEGATION_TYPE_OPERATOR,
+		1,
+		types.DELEGATION_TYPE_OPERATOR,
+		2,
+		10,
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+		sdk.NewDecCoins(sdk.NewDecCoin("operator/2/umilk", sdkmath.NewInt(100))),
+		1,
+	)
+	secondRedelegation := types.NewRedelegation(
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		types.DELEGATION_TYPE_POOL,
+		1,
+		types.DELEGATION_TYPE_SERVICE,
+		1,
+		20,
+		time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(50))),
+		sdk.NewDecCoins(sdk.NewDecCoin("service/1/umilk", sdkmath.NewInt(50))),
+		2,
+	)
+
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		request          *types.QueryDelegatorRedelegationsRequest
+		shouldErr        bool
+		expRedelegations []types.Redelegation
+	}{
+		{
+			name:      "invalid delegator address returns error",
+			request:   types.NewQueryDelegatorRedelegationsRequest("", nil),
+			shouldErr: true,
+		},
+		{
+			name: "query without pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetRedelegation(ctx, firstRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, secondRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, types.NewRedelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					types.DELEGATION_TYPE_OPERATOR,
+					1,
+					types.DELEGATION_TYPE_OPERATOR,
+					2,
+					10,
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					sdk.NewDecCoins(sdk.NewDecCoin("operator/2/umilk", sdkmath.NewInt(100))),
+					3,
+				))
+				suite.Require().NoError(err)
+			},
+			request:          types.NewQueryDelegatorRedelegationsRequest("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", nil),
+			shouldErr:        false,
+			expRedelegations: []types.Redelegation{secondRedelegation, firstRedelegation},
+		},
+		{
+			name: "query with pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetRedelegation(ctx, firstRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, secondRedelegation)
+				suite.Require().NoError(err)
+			},
+			request: types.NewQueryDelegatorRedelegationsRequest("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", &query.PageRequest{
+				Limit:  1,
+				Offset: 1,
+			}),
+			shouldErr:        false,
+			expRedelegations: []types.Redelegation{firstRedelegation},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.DelegatorRedelegations(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRedelegations, res.Redelegations)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_TargetRedelegations() {
+	firstRedelegation := types.NewRedelegation(
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		types.DELEGATION_TYPE_OPERATOR,
+		1,
+		types.DELEGATION_TYPE_OPERATOR,
+		2,
+		10,
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+		sdk.NewDecCoins(sdk.NewDecCoin("operator/2/umilk", sdkmath.NewInt(100))),
+		1,
+	)
+	secondRedelegation := types.NewRedelegation(
+		"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+		types.DELEGATION_TYPE_OPERATOR,
+		1,
+		types.DELEGATION_TYPE_OPERATOR,
+		3,
+		20,
+		time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(50))),
+		sdk.NewDecCoins(sdk.NewDecCoin("operator/3/umilk", sdkmath.NewInt(50))),
+		2,
+	)
+
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		request          *types.QueryTargetRedelegationsRequest
+		shouldErr        bool
+		expRedelegations []types.Redelegation
+	}{
+		{
+			name:      "unspecified target type returns error",
+			request:   types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_UNSPECIFIED, 1, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid target id returns error",
+			request:   types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_OPERATOR, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name: "query without pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetRedelegation(ctx, firstRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, secondRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, types.NewRedelegation(
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					types.DELEGATION_TYPE_SERVICE,
+					1,
+					types.DELEGATION_TYPE_SERVICE,
+					2,
+					10,
+					time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+					sdk.NewDecCoins(sdk.NewDecCoin("service/2/umilk", sdkmath.NewInt(100))),
+					3,
+				))
+				suite.Require().NoError(err)
+			},
+			request:          types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_OPERATOR, 1, nil),
+			shouldErr:        false,
+			expRedelegations: []types.Redelegation{firstRedelegation, secondRedelegation},
+		},
+		{
+			name: "query with pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetRedelegation(ctx, firstRedelegation)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetRedelegation(ctx, secondRedelegation)
+				suite.Require().NoError(err)
+			},
+			request: types.NewQueryTargetRedelegationsRequest(types.DELEGATION_TYPE_OPERATOR, 1, &query.PageRequest{
+				Limit:  1,
+				Offset: 1,
+			}),
+			shouldErr:        false,
+			expRedelegations: []types.Redelegation{secondRedelegation},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			querier := keeper.NewQuerier(suite.k)
+			res, err := querier.TargetRedelegations(ctx, tc.request)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRedelegations, res.Redelegations)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQuerier_Params() {
 	testCases := []struct {
 		name      string
@@ -3500,13 +4221,13 @@ func (suite *KeeperTestSuite) TestQuerier_Params() {
 		{
 			name: "params are returned properly",
 			store: func(ctx sdk.Context) {
-				params := types.NewParams(30*24*time.Hour, []string{"uinit", "umilk"}, sdkmath.LegacyNewDec(100000), 5)
+				params := types.NewParams(30*24*time.Hour, []string{"uinit", "umilk"}, sdkmath.LegacyNewDec(100000), 5, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge)
 				err := suite.k.SetParams(ctx, params)
 				suite.Require().NoError(err)
 			},
 			request:   types.NewQueryParamsRequest(),
 			shouldErr: false,
-			expParams: types.NewParams(30*24*time.Hour, []string{"uinit", "umilk"}, sdkmath.LegacyNewDec(100000), 5),
+			expParams: types.NewParams(30*24*time.Hour, []string{"uinit", "umilk"}, sdkmath.LegacyNewDec(100000), 5, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 		},
 	}
 
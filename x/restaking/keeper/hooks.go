@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
@@ -112,6 +115,24 @@ func (k *Keeper) BeforeServiceDelegationRemoved(ctx context.Context, serviceID u
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// BeforeOperatorSlashed implements types.RestakingHooks
+func (k *Keeper) BeforeOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeOperatorSlashed(ctx, serviceID, operatorID, fraction)
+	}
+	return nil
+}
+
+// AfterOperatorSlashed implements types.RestakingHooks
+func (k *Keeper) AfterOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, slashedAmount sdk.Coins) error {
+	if k.hooks != nil {
+		return k.hooks.AfterOperatorSlashed(ctx, serviceID, operatorID, fraction, slashedAmount)
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // AfterUnbondingInitiated implements types.RestakingHooks
 func (k *Keeper) AfterUnbondingInitiated(ctx context.Context, unbondingDelegationID uint64) error {
 	if k.hooks != nil {
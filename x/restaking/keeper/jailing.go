@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SaveOperatorJailRecord stores the given operator jail record
+func (k *Keeper) SaveOperatorJailRecord(ctx context.Context, record types.OperatorJailRecord) error {
+	err := k.operatorsJailRecords.Set(ctx, collections.Join(record.ServiceID, record.OperatorID), record)
+	if err != nil {
+		return err
+	}
+
+	return k.MarkServiceDirty(ctx, record.ServiceID)
+}
+
+// GetOperatorJailRecord returns the jail record of the given operator for the
+// given service. If the operator is not jailed from the service, false is returned
+func (k *Keeper) GetOperatorJailRecord(ctx context.Context, serviceID uint32, operatorID uint32) (types.OperatorJailRecord, bool, error) {
+	record, err := k.operatorsJailRecords.Get(ctx, collections.Join(serviceID, operatorID))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.OperatorJailRecord{}, false, nil
+		}
+		return types.OperatorJailRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// IsOperatorJailedFromService returns true if the given operator is jailed from
+// the given service
+func (k *Keeper) IsOperatorJailedFromService(ctx context.Context, serviceID uint32, operatorID uint32) (bool, error) {
+	return k.operatorsJailRecords.Has(ctx, collections.Join(serviceID, operatorID))
+}
+
+// GetAllOperatorsJailRecords returns all the stored operators jail records
+func (k *Keeper) GetAllOperatorsJailRecords(ctx context.Context) ([]types.OperatorJailRecord, error) {
+	iterator, err := k.operatorsJailRecords.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// DeleteServiceJailRecords removes all the jail records of the given service
+func (k *Keeper) DeleteServiceJailRecords(ctx context.Context, serviceID uint32) error {
+	return k.operatorsJailRecords.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint32](serviceID))
+}
+
+// DeleteOperatorJailRecords removes all the jail records of the given operator
+func (k *Keeper) DeleteOperatorJailRecords(ctx context.Context, operatorID uint32) error {
+	records, err := k.GetAllOperatorsJailRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.OperatorID != operatorID {
+			continue
+		}
+
+		err = k.operatorsJailRecords.Remove(ctx, collections.Join(record.ServiceID, record.OperatorID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// JailOperator jails the given operator from the given service for the given
+// duration. If the operator is already jailed from the service, the jail period
+// is extended only if the new one ends later than the current one
+func (k *Keeper) JailOperator(ctx context.Context, serviceID uint32, operatorID uint32, duration time.Duration) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	jailedUntil := sdkCtx.BlockTime().Add(duration)
+	record, found, err := k.GetOperatorJailRecord(ctx, serviceID, operatorID)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		if record.Tombstoned || !jailedUntil.After(record.JailedUntil) {
+			return nil
+		}
+		record.JailedUntil = jailedUntil
+	} else {
+		record = types.NewOperatorJailRecord(serviceID, operatorID, jailedUntil, false)
+	}
+
+	err = k.SaveOperatorJailRecord(ctx, record)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailOperator,
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(serviceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(operatorID)),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, record.JailedUntil.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// JailOperatorForDowntime jails the given operator from the given service for
+// the downtime jail duration set inside the params
+func (k *Keeper) JailOperatorForDowntime(ctx context.Context, serviceID uint32, operatorID uint32) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.JailOperator(ctx, serviceID, operatorID, params.DowntimeJailDuration)
+}
+
+// TombstoneOperator permanently jails the given operator from the given service.
+// This should be used for severe misbehaviors (e.g. double signing), and it also
+// sets the operator status to jailed, preventing it from securing any service.
+// A jailed operator can still be inactivated and deleted, but never reactivated
+func (k *Keeper) TombstoneOperator(ctx context.Context, serviceID uint32, operatorID uint32) error {
+	operator, err := k.operatorsKeeper.GetOperator(ctx, operatorID)
+	if err != nil {
+		return err
+	}
+
+	record, found, err := k.GetOperatorJailRecord(ctx, serviceID, operatorID)
+	if err != nil {
+		return err
+	}
+
+	if found && record.Tombstoned {
+		return types.ErrOperatorTombstoned
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !found {
+		record = types.NewOperatorJailRecord(serviceID, operatorID, sdkCtx.BlockTime(), true)
+	}
+	record.Tombstoned = true
+
+	err = k.SaveOperatorJailRecord(ctx, record)
+	if err != nil {
+		return err
+	}
+
+	// Jail the operator globally
+	jailed, err := k.operatorsKeeper.IsOperatorJailed(ctx, operatorID)
+	if err != nil {
+		return err
+	}
+
+	if !jailed {
+		err = k.operatorsKeeper.JailOperator(ctx, operator)
+		if err != nil {
+			return err
+		}
+
+		err = k.markOperatorServicesDirty(ctx, operatorID)
+		if err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTombstoneOperator,
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(serviceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(operatorID)),
+		),
+	)
+
+	return nil
+}
+
+// UnjailOperator removes the jail record of the given operator for the given
+// service, if the jail period has ended and the operator is not tombstoned
+func (k *Keeper) UnjailOperator(ctx context.Context, serviceID uint32, operatorID uint32) error {
+	record, found, err := k.GetOperatorJailRecord(ctx, serviceID, operatorID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.Wrapf(types.ErrOperatorNotJailed, "operator %d is not jailed from service %d", operatorID, serviceID)
+	}
+
+	if record.Tombstoned {
+		return errors.Wrapf(types.ErrOperatorTombstoned, "operator %d is tombstoned from service %d", operatorID, serviceID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !record.CanUnjail(sdkCtx.BlockTime()) {
+		return errors.Wrapf(types.ErrOperatorJailPeriodNotEnded, "operator %d is jailed until %s", operatorID, record.JailedUntil)
+	}
+
+	err = k.operatorsJailRecords.Remove(ctx, collections.Join(serviceID, operatorID))
+	if err != nil {
+		return err
+	}
+
+	return k.MarkServiceDirty(ctx, serviceID)
+}
@@ -0,0 +1,318 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+var jailingBlockTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func (suite *KeeperTestSuite) TestKeeper_JailOperator() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		duration  time.Duration
+		expRecord types.OperatorJailRecord
+		expEvents sdk.Events
+	}{
+		{
+			name:      "operator is jailed properly",
+			duration:  time.Hour,
+			expRecord: types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Hour), false),
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeJailOperator,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, "2024-01-01T13:00:00Z"),
+				),
+			},
+		},
+		{
+			name: "jail period is extended if the new one ends later",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Minute), false))
+				suite.Require().NoError(err)
+			},
+			duration:  time.Hour,
+			expRecord: types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Hour), false),
+		},
+		{
+			name: "jail period is not shortened",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(2*time.Hour), false))
+				suite.Require().NoError(err)
+			},
+			duration:  time.Hour,
+			expRecord: types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(2*time.Hour), false),
+		},
+		{
+			name: "tombstoned operator record is not changed",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime, true))
+				suite.Require().NoError(err)
+			},
+			duration:  time.Hour,
+			expRecord: types.NewOperatorJailRecord(1, 1, jailingBlockTime, true),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(jailingBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.k.JailOperator(ctx, 1, 1, tc.duration)
+			suite.Require().NoError(err)
+
+			record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expRecord, record)
+
+			for _, event := range tc.expEvents {
+				suite.Require().Contains(ctx.EventManager().Events(), event)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_JailOperatorForDowntime() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(jailingBlockTime)
+
+	params := types.DefaultParams()
+	params.DowntimeJailDuration = 30 * time.Minute
+	err := suite.k.SetParams(ctx, params)
+	suite.Require().NoError(err)
+
+	err = suite.k.JailOperatorForDowntime(ctx, 1, 1)
+	suite.Require().NoError(err)
+
+	record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(jailingBlockTime.Add(30*time.Minute), record.JailedUntil)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_TombstoneOperator() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "non existing operator returns error",
+			shouldErr: true,
+		},
+		{
+			name: "already tombstoned operator returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime, true))
+				suite.Require().NoError(err)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "jailed operator is tombstoned properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Hour), false))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Hour), true), record)
+
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(operatorstypes.OPERATOR_STATUS_JAILED, operator.Status)
+			},
+		},
+		{
+			name: "operator not jailed is tombstoned properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			check: func(ctx sdk.Context) {
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().True(record.Tombstoned)
+
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(operatorstypes.OPERATOR_STATUS_JAILED, operator.Status)
+			},
+		},
+		{
+			name: "tombstoned operator can be inactivated and deleted but not reactivated",
+			store: func(ctx sdk.Context) {
+				err := suite.ok.SetParams(ctx, operatorstypes.DefaultParams())
+				suite.Require().NoError(err)
+
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			check: func(ctx sdk.Context) {
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+
+				err = suite.ok.StartOperatorInactivation(ctx, operator)
+				suite.Require().NoError(err)
+
+				operator, err = suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+
+				err = suite.ok.CompleteOperatorInactivation(ctx, operator)
+				suite.Require().NoError(err)
+
+				operator, err = suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(operatorstypes.OPERATOR_STATUS_INACTIVE, operator.Status)
+
+				// Make sure the operator can not be reactivated
+				err = suite.ok.ReactivateInactiveOperator(ctx, operator)
+				suite.Require().ErrorIs(err, operatorstypes.ErrOperatorJailed)
+
+				// Make sure the operator can be deleted
+				err = suite.ok.DeleteOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				_, err = suite.ok.GetOperator(ctx, 1)
+				suite.Require().Error(err)
+
+				jailed, err := suite.ok.IsOperatorJailed(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(jailed)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(jailingBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.TombstoneOperator(ctx, 1, 1)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UnjailOperator() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expErr    error
+		shouldErr bool
+	}{
+		{
+			name:      "operator not jailed returns error",
+			shouldErr: true,
+			expErr:    types.ErrOperatorNotJailed,
+		},
+		{
+			name: "tombstoned operator returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(-time.Hour), true))
+				suite.Require().NoError(err)
+			},
+			shouldErr: true,
+			expErr:    types.ErrOperatorTombstoned,
+		},
+		{
+			name: "jail period not ended returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime.Add(time.Second), false))
+				suite.Require().NoError(err)
+			},
+			shouldErr: true,
+			expErr:    types.ErrOperatorJailPeriodNotEnded,
+		},
+		{
+			name: "operator is unjailed properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, jailingBlockTime, false))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(jailingBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.UnjailOperator(ctx, 1, 1)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+
+				jailed, err := suite.k.IsOperatorJailedFromService(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(jailed)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteOperatorJailRecords() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	records := []types.OperatorJailRecord{
+		types.NewOperatorJailRecord(1, 1, jailingBlockTime, false),
+		types.NewOperatorJailRecord(1, 2, jailingBlockTime, false),
+		types.NewOperatorJailRecord(2, 1, jailingBlockTime, true),
+	}
+	for _, record := range records {
+		err := suite.k.SaveOperatorJailRecord(ctx, record)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.k.DeleteOperatorJailRecords(ctx, 1)
+	suite.Require().NoError(err)
+
+	stored, err := suite.k.GetAllOperatorsJailRecords(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OperatorJailRecord{records[1]}, stored)
+}
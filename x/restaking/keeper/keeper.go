@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -24,8 +25,8 @@ type Keeper struct {
 	poolsKeeper     types.PoolsKeeper
 	operatorsKeeper types.OperatorsKeeper
 	servicesKeeper  types.ServicesKeeper
-	oracleKeeper    types.OracleKeeper
 	assetsKeeper    types.AssetsKeeper
+	contractKeeper  types.ContractKeeper
 
 	// Keeper data
 	Schema collections.Schema
@@ -38,6 +39,44 @@ type Keeper struct {
 	// The pair represents the service ID and the operator ID
 	serviceOperatorsAllowList collections.KeySet[collections.Pair[uint32, uint32]]
 
+	// The pair represents the service ID and the ID of an operator that is part
+	// of the service's active operators set
+	serviceActiveOperators collections.KeySet[collections.Pair[uint32, uint32]]
+
+	// The set contains the IDs of the services whose active operators set must
+	// be recomputed at the end of the current block
+	dirtyServices collections.KeySet[uint32]
+
+	// The map stores (service ID, epoch) -> ServiceSnapshot associations
+	serviceSnapshots collections.Map[collections.Pair[uint32, uint64], types.ServiceSnapshot]
+
+	// The map stores (service ID, operator ID) -> OperatorJailRecord associations
+	operatorsJailRecords collections.Map[collections.Pair[uint32, uint32], types.OperatorJailRecord]
+
+	// The pair represents the service ID and the hash of an evidence submitted
+	// for such service
+	submittedEvidences collections.KeySet[collections.Pair[uint32, []byte]]
+
+	nextPendingSlashID collections.Sequence // Next pending slash ID
+
+	// The map stores pending slash ID -> PendingSlash associations
+	pendingSlashes collections.Map[uint64, types.PendingSlash]
+
+	// The pair represents the execution time and the ID of a pending slash
+	pendingSlashesQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+
+	// The map stores (operator ID, service ID) -> OperatorServiceKey associations
+	// of the keys currently used by the operators
+	operatorServiceKeys collections.Map[collections.Pair[uint32, uint32], types.OperatorServiceKey]
+
+	// The map stores (operator ID, service ID) -> OperatorServiceKey associations
+	// of the keys that will replace the current ones once activated
+	pendingOperatorServiceKeys collections.Map[collections.Pair[uint32, uint32], types.OperatorServiceKey]
+
+	// The triple represents the activation time, the operator ID and the service
+	// ID of a pending operator service key
+	operatorServiceKeysQueue collections.KeySet[collections.Triple[time.Time, uint32, uint32]]
+
 	// The pair represents the service ID and the pool ID
 	serviceSecuringPools collections.KeySet[collections.Pair[uint32, uint32]]
 
@@ -46,6 +85,8 @@ type Keeper struct {
 
 	hooks              types.RestakingHooks
 	restakeRestriction types.RestakeRestrictionFn
+	slashCover         types.SlashCoverFn
+	evidenceRouter     types.EvidenceRouter
 }
 
 func NewKeeper(
@@ -56,7 +97,6 @@ func NewKeeper(
 	poolsKeeper types.PoolsKeeper,
 	operatorsKeeper types.OperatorsKeeper,
 	servicesKeeper types.ServicesKeeper,
-	oracleKeeper types.OracleKeeper,
 	assetsKeeper types.AssetsKeeper,
 	authority string,
 ) *Keeper {
@@ -76,7 +116,6 @@ func NewKeeper(
 		poolsKeeper:     poolsKeeper,
 		operatorsKeeper: operatorsKeeper,
 		servicesKeeper:  servicesKeeper,
-		oracleKeeper:    oracleKeeper,
 		assetsKeeper:    assetsKeeper,
 
 		operatorJoinedServices: collections.NewIndexedMap(
@@ -91,6 +130,65 @@ func NewKeeper(
 			"service_operators_allow_list",
 			collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key),
 		),
+		serviceActiveOperators: collections.NewKeySet(
+			sb, types.ServiceActiveOperatorsPrefix,
+			"service_active_operators",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key),
+		),
+		dirtyServices: collections.NewKeySet(
+			sb, types.DirtyServicesPrefix,
+			"dirty_services",
+			collections.Uint32Key,
+		),
+		serviceSnapshots: collections.NewMap(
+			sb, types.ServiceSnapshotsPrefix,
+			"service_snapshots",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Uint64Key),
+			codec.CollValue[types.ServiceSnapshot](cdc),
+		),
+		operatorsJailRecords: collections.NewMap(
+			sb, types.OperatorsJailRecordsPrefix,
+			"operators_jail_records",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key),
+			codec.CollValue[types.OperatorJailRecord](cdc),
+		),
+		submittedEvidences: collections.NewKeySet(
+			sb, types.SubmittedEvidencesPrefix,
+			"submitted_evidences",
+			collections.PairKeyCodec(collections.Uint32Key, collections.BytesKey),
+		),
+		nextPendingSlashID: collections.NewSequence(
+			sb, types.NextPendingSlashIDKey,
+			"next_pending_slash_id",
+		),
+		pendingSlashes: collections.NewMap(
+			sb, types.PendingSlashesPrefix,
+			"pending_slashes",
+			collections.Uint64Key,
+			codec.CollValue[types.PendingSlash](cdc),
+		),
+		pendingSlashesQueue: collections.NewKeySet(
+			sb, types.PendingSlashesQueuePrefix,
+			"pending_slashes_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		operatorServiceKeys: collections.NewMap(
+			sb, types.OperatorServiceKeysPrefix,
+			"operator_service_keys",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key),
+			codec.CollValue[types.OperatorServiceKey](cdc),
+		),
+		pendingOperatorServiceKeys: collections.NewMap(
+			sb, types.PendingOperatorServiceKeysPrefix,
+			"pending_operator_service_keys",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key),
+			codec.CollValue[types.OperatorServiceKey](cdc),
+		),
+		operatorServiceKeysQueue: collections.NewKeySet(
+			sb, types.OperatorServiceKeysQueuePrefix,
+			"operator_service_keys_queue",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.Uint32Key, collections.Uint32Key),
+		),
 		serviceSecuringPools: collections.NewKeySet(
 			sb, types.ServiceSecuringPoolsPrefix,
 			"service_securing_pools",
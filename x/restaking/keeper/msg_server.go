@@ -35,8 +35,23 @@ func (k msgServer) JoinService(ctx context.Context, msg *types.MsgJoinService) (
 		return nil, err
 	}
 
-	if operator.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can join the service")
+	// Make sure only the admin or an account having the join/leave role can join the service
+	hasPermission, err := k.operatorsKeeper.HasOperatorPermission(ctx, operator, msg.Sender, operatorstypes.OPERATOR_ROLE_JOIN_LEAVE)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the operator admin or an account having the %s role can join the service", operatorstypes.OPERATOR_ROLE_JOIN_LEAVE)
+	}
+
+	jailed, err := k.operatorsKeeper.IsOperatorJailed(ctx, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if jailed {
+		return nil, errors.Wrapf(operatorstypes.ErrOperatorJailed, "operator %d is jailed", msg.OperatorID)
 	}
 
 	service, err := k.servicesKeeper.GetService(ctx, msg.ServiceID)
@@ -58,6 +73,16 @@ func (k msgServer) JoinService(ctx context.Context, msg *types.MsgJoinService) (
 		return nil, types.ErrOperatorNotAllowed
 	}
 
+	// Make sure the operator has enough stake to join
+	hasEnoughStake, err := k.HasOperatorEnoughStakeForService(ctx, msg.ServiceID, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasEnoughStake {
+		return nil, types.ErrOperatorStakeTooLow
+	}
+
 	err = k.AddServiceToOperatorJoinedServices(ctx, msg.OperatorID, msg.ServiceID)
 	if err != nil {
 		return nil, err
@@ -82,8 +107,14 @@ func (k msgServer) LeaveService(ctx context.Context, msg *types.MsgLeaveService)
 		return nil, err
 	}
 
-	if operator.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can leave the service")
+	// Make sure only the admin or an account having the join/leave role can leave the service
+	hasPermission, err := k.operatorsKeeper.HasOperatorPermission(ctx, operator, msg.Sender, operatorstypes.OPERATOR_ROLE_JOIN_LEAVE)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the operator admin or an account having the %s role can leave the service", operatorstypes.OPERATOR_ROLE_JOIN_LEAVE)
 	}
 
 	_, err = k.servicesKeeper.GetService(ctx, msg.ServiceID)
@@ -116,9 +147,14 @@ func (k msgServer) AddOperatorToAllowList(ctx context.Context, msg *types.MsgAdd
 		return nil, err
 	}
 
-	// Ensure the service admin is performing this action
-	if service.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin can allow an operator")
+	// Ensure the service admin or an account having the allow list role is performing this action
+	hasPermission, err := k.servicesKeeper.HasServicePermission(ctx, service, msg.Sender, servicestypes.SERVICE_ROLE_ALLOW_LIST)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin or an account having the %s role can allow an operator", servicestypes.SERVICE_ROLE_ALLOW_LIST)
 	}
 
 	// Ensure that the operator exists
@@ -190,9 +226,14 @@ func (k msgServer) RemoveOperatorFromAllowlist(ctx context.Context, msg *types.M
 		return nil, err
 	}
 
-	// Ensure the service admin is performing this action
-	if service.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin can allow an operator")
+	// Ensure the service admin or an account having the allow list role is performing this action
+	hasPermission, err := k.servicesKeeper.HasServicePermission(ctx, service, msg.Sender, servicestypes.SERVICE_ROLE_ALLOW_LIST)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin or an account having the %s role can remove an operator from the allow list", servicestypes.SERVICE_ROLE_ALLOW_LIST)
 	}
 
 	// Ensure the operator is allowed before removing
@@ -579,6 +620,174 @@ func (k msgServer) UndelegateService(ctx context.Context, msg *types.MsgUndelega
 	}, nil
 }
 
+// RedelegatePool defines the rpc method for Msg/RedelegatePool
+func (k msgServer) RedelegatePool(ctx context.Context, msg *types.MsgRedelegatePool) (*types.MsgRedelegateResponse, error) {
+	// Perform the redelegation
+	completionTime, err := k.Keeper.RedelegateFromPool(ctx, msg.Amount, msg.Delegator, msg.DstType, msg.DstTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the redelegation
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "redelegate_pool")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	// Emit the redelegation event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegatePool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyDstTargetType, msg.DstType.String()),
+			sdk.NewAttribute(types.AttributeKeyDstTargetID, fmt.Sprintf("%d", msg.DstTargetID)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// RedelegateOperator defines the rpc method for Msg/RedelegateOperator
+func (k msgServer) RedelegateOperator(ctx context.Context, msg *types.MsgRedelegateOperator) (*types.MsgRedelegateResponse, error) {
+	// Perform the redelegation
+	completionTime, err := k.Keeper.RedelegateFromOperator(ctx, msg.SrcOperatorID, msg.DstOperatorID, msg.Amount, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the redelegation
+	for _, token := range msg.Amount {
+		if token.Amount.IsInt64() {
+			defer func() {
+				telemetry.IncrCounter(1, types.ModuleName, "redelegate_operator")
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("src_operator_id", fmt.Sprintf("%d", msg.SrcOperatorID)),
+						telemetry.NewLabel("dst_operator_id", fmt.Sprintf("%d", msg.DstOperatorID)),
+						telemetry.NewLabel("denom", token.Denom),
+					},
+				)
+			}()
+		}
+	}
+
+	// Emit the redelegation event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegateOperator,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeySrcTargetID, fmt.Sprintf("%d", msg.SrcOperatorID)),
+			sdk.NewAttribute(types.AttributeKeyDstTargetID, fmt.Sprintf("%d", msg.DstOperatorID)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// RedelegateService defines the rpc method for Msg/RedelegateService
+func (k msgServer) RedelegateService(ctx context.Context, msg *types.MsgRedelegateService) (*types.MsgRedelegateResponse, error) {
+	// Perform the redelegation
+	completionTime, err := k.Keeper.RedelegateFromService(ctx, msg.SrcServiceID, msg.DstServiceID, msg.Amount, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the redelegation
+	for _, token := range msg.Amount {
+		if token.Amount.IsInt64() {
+			defer func() {
+				telemetry.IncrCounter(1, types.ModuleName, "redelegate_service")
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("src_service_id", fmt.Sprintf("%d", msg.SrcServiceID)),
+						telemetry.NewLabel("dst_service_id", fmt.Sprintf("%d", msg.DstServiceID)),
+						telemetry.NewLabel("denom", token.Denom),
+					},
+				)
+			}()
+		}
+	}
+
+	// Emit the redelegation event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegateService,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeySrcTargetID, fmt.Sprintf("%d", msg.SrcServiceID)),
+			sdk.NewAttribute(types.AttributeKeyDstTargetID, fmt.Sprintf("%d", msg.DstServiceID)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// CancelUnbondingDelegation defines the rpc method for Msg/CancelUnbondingDelegation
+func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	// Cancel the unbonding delegation entry
+	err := k.Keeper.CancelUnbondingDelegation(ctx, msg.Delegator, msg.DelegationType, msg.TargetID, msg.Amount, msg.CreationHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the cancellation
+	for _, token := range msg.Amount {
+		if token.Amount.IsInt64() {
+			defer func() {
+				telemetry.IncrCounter(1, types.ModuleName, "cancel_unbonding")
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("delegation_type", msg.DelegationType.String()),
+						telemetry.NewLabel("target_id", fmt.Sprintf("%d", msg.TargetID)),
+						telemetry.NewLabel("denom", token.Denom),
+					},
+				)
+			}()
+		}
+	}
+
+	// Emit the cancellation event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeUnbondingDelegationType, msg.DelegationType.String()),
+			sdk.NewAttribute(types.AttributeTargetID, fmt.Sprintf("%d", msg.TargetID)),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, fmt.Sprintf("%d", msg.CreationHeight)),
+		),
+	})
+
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
 // SetUserPreferences defines the rpc method for Msg/SetUserPreferences
 func (k msgServer) SetUserPreferences(ctx context.Context, msg *types.MsgSetUserPreferences) (*types.MsgSetUserPreferencesResponse, error) {
 	// Make sure that each service exists
@@ -622,6 +831,128 @@ func (k msgServer) SetUserPreferences(ctx context.Context, msg *types.MsgSetUser
 	return &types.MsgSetUserPreferencesResponse{}, nil
 }
 
+// UnjailOperator defines the rpc method for Msg/UnjailOperator
+func (k msgServer) UnjailOperator(ctx context.Context, msg *types.MsgUnjailOperator) (*types.MsgUnjailOperatorResponse, error) {
+	operator, err := k.operatorsKeeper.GetOperator(ctx, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can unjail the operator")
+	}
+
+	err = k.Keeper.UnjailOperator(ctx, msg.ServiceID, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjailOperator,
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(msg.OperatorID)),
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(msg.ServiceID)),
+		),
+	})
+
+	return &types.MsgUnjailOperatorResponse{}, nil
+}
+
+// SubmitOperatorEvidence defines the rpc method for Msg/SubmitOperatorEvidence
+func (k msgServer) SubmitOperatorEvidence(ctx context.Context, msg *types.MsgSubmitOperatorEvidence) (*types.MsgSubmitOperatorEvidenceResponse, error) {
+	evidence := msg.GetOperatorEvidence()
+	if evidence == nil {
+		return nil, errors.Wrap(types.ErrInvalidEvidence, "missing evidence")
+	}
+
+	hash, err := k.Keeper.SubmitOperatorEvidence(ctx, msg.ServiceID, msg.Submitter, evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitOperatorEvidence,
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(msg.ServiceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(evidence.GetOperatorID())),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, fmt.Sprintf("%X", hash)),
+		),
+	})
+
+	return &types.MsgSubmitOperatorEvidenceResponse{Hash: hash}, nil
+}
+
+// VetoSlash defines the rpc method for Msg/VetoSlash
+func (k msgServer) VetoSlash(ctx context.Context, msg *types.MsgVetoSlash) (*types.MsgVetoSlashResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the signer is either the authority or the slash veto committee
+	isCommittee := params.SlashVetoCommittee != "" && msg.Signer == params.SlashVetoCommittee
+	if msg.Signer != k.authority && !isCommittee {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the authority or the slash veto committee can veto a slash")
+	}
+
+	err = k.Keeper.VetoSlash(ctx, msg.PendingSlashID)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVetoSlash,
+			sdk.NewAttribute(types.AttributeKeyPendingSlashID, fmt.Sprint(msg.PendingSlashID)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	return &types.MsgVetoSlashResponse{}, nil
+}
+
+// SetOperatorServiceKey defines the rpc method for Msg/SetOperatorServiceKey
+func (k msgServer) SetOperatorServiceKey(ctx context.Context, msg *types.MsgSetOperatorServiceKey) (*types.MsgSetOperatorServiceKeyResponse, error) {
+	operator, err := k.operatorsKeeper.GetOperator(ctx, msg.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can set the operator service key")
+	}
+
+	key, err := k.Keeper.SetOperatorServiceKey(
+		ctx,
+		msg.OperatorID,
+		msg.ServiceID,
+		msg.KeyType,
+		msg.PublicKey,
+		msg.ProofOfPossession,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetOperatorServiceKey,
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(msg.OperatorID)),
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(msg.ServiceID)),
+			sdk.NewAttribute(types.AttributeKeyKeyType, key.KeyType.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, fmt.Sprintf("%X", key.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, key.ActivationTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgSetOperatorServiceKeyResponse{ActivationTime: key.ActivationTime}, nil
+}
+
 // UpdateParams defines the rpc method for Msg/UpdateParams
 func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	// Check the authority
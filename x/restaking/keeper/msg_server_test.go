@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/keeper"
@@ -119,6 +121,42 @@ func (suite *KeeperTestSuite) TestMsgServer_JoinService() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "jailed operator returns error",
+			store: func(ctx sdk.Context) {
+				operator := operatorstypes.NewOperator(
+					1,
+					operatorstypes.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				)
+				err := suite.ok.SaveOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				err = suite.ok.JailOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				err = suite.sk.SaveService(ctx, servicestypes.NewService(
+					1,
+					servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgJoinService(
+				1,
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "can't join inactive service",
 			store: func(ctx sdk.Context) {
@@ -216,6 +254,91 @@ func (suite *KeeperTestSuite) TestMsgServer_JoinService() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "can't join service due to operator stake lower than the minimum",
+			store: func(ctx sdk.Context) {
+				suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+				operator := operatorstypes.NewOperator(
+					1,
+					operatorstypes.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				)
+				operator.Tokens = utils.MustParseCoins("10_000000umilk")
+				err := suite.ok.SaveOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				err = suite.sk.SaveService(ctx, servicestypes.NewService(
+					1,
+					servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyNewDec(100), 0, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgJoinService(
+				1,
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "join is performed correctly - operator stake equal to the minimum",
+			store: func(ctx sdk.Context) {
+				suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+				operator := operatorstypes.NewOperator(
+					1,
+					operatorstypes.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				)
+				operator.Tokens = utils.MustParseCoins("50_000000umilk")
+				err := suite.ok.SaveOperator(ctx, operator)
+				suite.Require().NoError(err)
+
+				err = suite.sk.SaveService(ctx, servicestypes.NewService(
+					1,
+					servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyNewDec(100), 0, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgJoinService(
+				1,
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeJoinService,
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+				),
+			},
+		},
 		{
 			name: "join is performed correctly - no allow list",
 			store: func(ctx sdk.Context) {
@@ -255,6 +378,48 @@ func (suite *KeeperTestSuite) TestMsgServer_JoinService() {
 				),
 			},
 		},
+		{
+			name: "join is performed correctly - sender with the join/leave role",
+			store: func(ctx sdk.Context) {
+				err := suite.ok.SaveOperator(ctx, operatorstypes.NewOperator(
+					1,
+					operatorstypes.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.ok.GrantOperatorRole(ctx, 1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", operatorstypes.OPERATOR_ROLE_JOIN_LEAVE)
+				suite.Require().NoError(err)
+
+				err = suite.sk.SaveService(ctx, servicestypes.NewService(
+					1,
+					servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgJoinService(
+				1,
+				1,
+				"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeJoinService,
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+				),
+			},
+		},
 		{
 			name: "join is performed correctly - allow list",
 			store: func(ctx sdk.Context) {
@@ -633,6 +798,44 @@ func (suite *KeeperTestSuite) TestMsgServer_AddOperatorToAllowList() {
 				),
 			},
 		},
+		{
+			name: "operator is allowed properly by sender with the allow list role",
+			store: func(ctx sdk.Context) {
+				err := suite.ok.SaveOperator(ctx, operatorstypes.NewOperator(
+					1, operatorstypes.OPERATOR_STATUS_ACTIVE,
+					"MilkyWay Operator",
+					"https://milkyway.com",
+					"https://milkyway.com/picture",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				))
+				suite.Require().NoError(err)
+
+				err = suite.sk.SaveService(ctx, servicestypes.NewService(
+					1, servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.sk.GrantServiceRole(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", servicestypes.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgAddOperatorToAllowList(
+				1,
+				1,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				whitelisted, err := suite.k.CanOperatorValidateService(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(whitelisted)
+			},
+		},
 		{
 			name: "adding the first operator to allow list makes other operators to leave",
 			store: func(ctx sdk.Context) {
@@ -1492,7 +1695,7 @@ func (suite *KeeperTestSuite) TestMsgServer_UndelegatePool() {
 			},
 			store: func(ctx sdk.Context) {
 				// Set the unbonding time to 1 week
-				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries))
+				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge))
 				suite.Require().NoError(err)
 
 				// Create the pool
@@ -1809,7 +2012,7 @@ func (suite *KeeperTestSuite) TestMsgServer_UndelegateOperator() {
 			},
 			store: func(ctx sdk.Context) {
 				// Set the unbonding time to 1 week
-				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries))
+				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge))
 				suite.Require().NoError(err)
 
 				// Create the operator
@@ -2001,7 +2204,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DelegateService() {
 				suite.Require().NoError(err)
 
 				// Configure the service parameters
-				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"uinit"}))
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
 				// Send some funds to the user
@@ -2040,7 +2243,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DelegateService() {
 				suite.Require().NoError(err)
 
 				// Configure the service parameters
-				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"uinit"}))
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
 				// Send some funds to the user
@@ -2159,7 +2362,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DelegateService() {
 				suite.Require().NoError(err)
 
 				// Configure the service's allowed restakable denoms
-				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"umilk"}))
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
 				// Send some funds to the user
@@ -2207,7 +2410,7 @@ func (suite *KeeperTestSuite) TestMsgServer_DelegateService() {
 				suite.Require().NoError(err)
 
 				// Configure the service's allowed restakable denoms
-				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"umilk"}))
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
 				// Send some funds to the user
@@ -2295,7 +2498,7 @@ func (suite *KeeperTestSuite) TestMsgServer_UndelegateService() {
 			},
 			store: func(ctx sdk.Context) {
 				// Set the unbonding time to 1 week
-				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries))
+				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge))
 				suite.Require().NoError(err)
 
 				// Create the service
@@ -2355,6 +2558,67 @@ func (suite *KeeperTestSuite) TestMsgServer_UndelegateService() {
 				suite.Require().Empty(delegation.Shares)
 			},
 		},
+		{
+			name: "service unbonding time is used properly",
+			setupCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+			store: func(ctx sdk.Context) {
+				// Set the unbonding time to 1 week
+				err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge))
+				suite.Require().NoError(err)
+
+				// Create the service with an unbonding time of 3 days
+				err = suite.sk.SaveService(ctx, servicestypes.Service{
+					ID:      1,
+					Status:  servicestypes.SERVICE_STATUS_ACTIVE,
+					Address: servicestypes.GetServiceAddress(1).String(),
+				})
+				suite.Require().NoError(err)
+
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 3*24*time.Hour))
+				suite.Require().NoError(err)
+
+				// Send some funds to the user
+				suite.fundAccount(
+					ctx,
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+				)
+
+				// Delegate some funds
+				msgServer := keeper.NewMsgServer(suite.k)
+				_, err = msgServer.DelegateService(ctx, &types.MsgDelegateService{
+					ServiceID: 1,
+					Delegator: "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					Amount:    sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+				})
+				suite.Require().NoError(err)
+			},
+			msg: &types.MsgUndelegateService{
+				Delegator: "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				ServiceID: 1,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+			},
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnbondService,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "100umilk"),
+					sdk.NewAttribute(types.AttributeKeyDelegator, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyCompletionTime, "2024-01-04T00:00:00Z"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				// Check the unbonding delegation
+				ubd, found, err := suite.k.GetServiceUnbondingDelegation(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(ubd.Entries, 1)
+				suite.Require().Equal(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), ubd.Entries[0].CompletionTime)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2564,6 +2828,416 @@ func (suite *KeeperTestSuite) TestMsgServer_SetUserPreferences() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgServer_UnjailOperator() {
+	blockTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgUnjailOperator
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "non-existent operator returns error",
+			msg:       types.NewMsgUnjailOperator(1, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+			shouldErr: true,
+		},
+		{
+			name: "only operator admin can unjail the operator",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, blockTime, false))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUnjailOperator(1, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+			shouldErr: true,
+		},
+		{
+			name: "operator not jailed returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			msg:       types.NewMsgUnjailOperator(1, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+			shouldErr: true,
+		},
+		{
+			name: "operator still in jail returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, blockTime.Add(time.Hour), false))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUnjailOperator(1, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+			shouldErr: true,
+		},
+		{
+			name: "operator is unjailed properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SaveOperatorJailRecord(ctx, types.NewOperatorJailRecord(1, 1, blockTime.Add(-time.Hour), false))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgUnjailOperator(1, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnjailOperator,
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				jailed, err := suite.k.IsOperatorJailedFromService(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(jailed)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServer(suite.k)
+			_, err := msgServer.UnjailOperator(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SetOperatorServiceKey() {
+	blockTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	publicKey := firstOperatorServiceKey.PubKey().Bytes()
+	proofOfPossession := suite.signOperatorServiceKeyPossession(suite.ctx, firstOperatorServiceKey, 1, 1)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetOperatorServiceKey
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "non-existent operator returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				publicKey,
+				proofOfPossession,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "only operator admin can set the operator service key",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			msg: types.NewMsgSetOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				publicKey,
+				proofOfPossession,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "operator service key is set properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			msg: types.NewMsgSetOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				publicKey,
+				proofOfPossession,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetOperatorServiceKey,
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyKeyType, types.KEY_TYPE_ED25519.String()),
+					sdk.NewAttribute(types.AttributeKeyPublicKey, fmt.Sprintf("%X", publicKey)),
+					sdk.NewAttribute(types.AttributeKeyActivationTime, blockTime.Format(time.RFC3339)),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				key, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, publicKey, blockTime), key)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(blockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.SetOperatorServiceKey(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(blockTime, res.ActivationTime)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_SubmitOperatorEvidence() {
+	suite.k.SetEvidenceRouter(types.NewEvidenceRouter())
+	suite.k.SetContractKeeper(&mockContractKeeper{response: []byte(`{"jail_duration_seconds":60}`)})
+
+	evidence := types.NewContractEvidence(1, 10, []byte("proof"))
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSubmitOperatorEvidence
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "missing evidence returns error",
+			msg:       &types.MsgSubmitOperatorEvidence{ServiceID: 1, Submitter: "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"},
+			shouldErr: true,
+		},
+		{
+			name: "service without verifier returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			msg: func() *types.MsgSubmitOperatorEvidence {
+				msg, err := types.NewMsgSubmitOperatorEvidence(1, evidence, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				return msg
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "evidence is submitted properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(
+					nil,
+					sdkmath.LegacyZeroDec(),
+					0,
+					0,
+					0,
+					authtypes.NewModuleAddress("evidence-verifier").String(),
+					0,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: func() *types.MsgSubmitOperatorEvidence {
+				msg, err := types.NewMsgSubmitOperatorEvidence(1, evidence, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				return msg
+			}(),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSubmitOperatorEvidence,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(types.AttributeKeySubmitter, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyEvidenceHash, fmt.Sprintf("%X", evidence.Hash())),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				jailed, err := suite.k.IsOperatorJailedFromService(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(jailed)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(100)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.SubmitOperatorEvidence(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(evidence.Hash(), res.Hash)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_VetoSlash() {
+	committee := authtypes.NewModuleAddress("slash-veto-committee").String()
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgVetoSlash
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "unauthorized signer returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, time.Now()))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgVetoSlash(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+			shouldErr: true,
+		},
+		{
+			name:      "non existing pending slash returns error",
+			msg:       types.NewMsgVetoSlash(1, authtypes.NewModuleAddress("gov").String()),
+			shouldErr: true,
+		},
+		{
+			name: "authority vetoes the slash properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, time.Now()))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgVetoSlash(1, authtypes.NewModuleAddress("gov").String()),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeVetoSlash,
+					sdk.NewAttribute(types.AttributeKeyPendingSlashID, "1"),
+					sdk.NewAttribute(types.AttributeKeySigner, authtypes.NewModuleAddress("gov").String()),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "slash veto committee vetoes the slash properly",
+			store: func(ctx sdk.Context) {
+				params := types.DefaultParams()
+				params.SlashVetoCommittee = committee
+				err := suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, time.Now()))
+				suite.Require().NoError(err)
+			},
+			msg:       types.NewMsgVetoSlash(1, committee),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServer(suite.k)
+			_, err := msgServer.VetoSlash(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgServer_UpdateParams() {
 	testCases := []struct {
 		name      string
@@ -17,14 +17,24 @@ import (
 // the operator with the given ID
 func (k *Keeper) AddServiceToOperatorJoinedServices(ctx context.Context, operatorID uint32, serviceID uint32) error {
 	operatorServicePair := collections.Join(operatorID, serviceID)
-	return k.operatorJoinedServices.Set(ctx, operatorServicePair, collections.NoValue{})
+	err := k.operatorJoinedServices.Set(ctx, operatorServicePair, collections.NoValue{})
+	if err != nil {
+		return err
+	}
+
+	return k.MarkServiceDirty(ctx, serviceID)
 }
 
 // RemoveServiceFromOperatorJoinedServices removes the given service from the list of services joined by
 // the operator with the given ID
 func (k *Keeper) RemoveServiceFromOperatorJoinedServices(ctx context.Context, operatorID uint32, serviceID uint32) error {
 	operatorServicePair := collections.Join(operatorID, serviceID)
-	return k.operatorJoinedServices.Remove(ctx, operatorServicePair)
+	err := k.operatorJoinedServices.Remove(ctx, operatorServicePair)
+	if err != nil {
+		return err
+	}
+
+	return k.MarkServiceDirty(ctx, serviceID)
 }
 
 // HasOperatorJoinedService returns whether the operator with the given ID has
@@ -61,6 +71,13 @@ func (k *Keeper) AddOperatorTokensAndShares(
 
 	// Save the operator
 	err = k.operatorsKeeper.SaveOperator(ctx, operator)
+	if err != nil {
+		return operator, addedShares, err
+	}
+
+	// The operator tokens changed, so its position in the active sets of the
+	// joined services might have changed too
+	err = k.markOperatorServicesDirty(ctx, operator.ID)
 	return operator, addedShares, err
 }
 
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SaveOperatorServiceKey stores the given key as the one currently used by
+// its operator for its service
+func (k *Keeper) SaveOperatorServiceKey(ctx context.Context, key types.OperatorServiceKey) error {
+	return k.operatorServiceKeys.Set(ctx, collections.Join(key.OperatorID, key.ServiceID), key)
+}
+
+// SavePendingOperatorServiceKey stores the given key as the one that will
+// replace the key currently used by its operator for its service, and inserts
+// it into the queue of the keys to be activated
+func (k *Keeper) SavePendingOperatorServiceKey(ctx context.Context, key types.OperatorServiceKey) error {
+	err := k.pendingOperatorServiceKeys.Set(ctx, collections.Join(key.OperatorID, key.ServiceID), key)
+	if err != nil {
+		return err
+	}
+
+	return k.operatorServiceKeysQueue.Set(ctx, collections.Join3(key.ActivationTime, key.OperatorID, key.ServiceID))
+}
+
+// RemovePendingOperatorServiceKey removes the given pending key from the store
+// and from the queue of the keys to be activated
+func (k *Keeper) RemovePendingOperatorServiceKey(ctx context.Context, key types.OperatorServiceKey) error {
+	err := k.pendingOperatorServiceKeys.Remove(ctx, collections.Join(key.OperatorID, key.ServiceID))
+	if err != nil {
+		return err
+	}
+
+	return k.operatorServiceKeysQueue.Remove(ctx, collections.Join3(key.ActivationTime, key.OperatorID, key.ServiceID))
+}
+
+// GetPendingOperatorServiceKey returns the key that will replace the one
+// currently used by the given operator for the given service. If there is no
+// such key, false is returned
+func (k *Keeper) GetPendingOperatorServiceKey(
+	ctx context.Context, operatorID uint32, serviceID uint32,
+) (types.OperatorServiceKey, bool, error) {
+	key, err := k.pendingOperatorServiceKeys.Get(ctx, collections.Join(operatorID, serviceID))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.OperatorServiceKey{}, false, nil
+		}
+		return types.OperatorServiceKey{}, false, err
+	}
+	return key, true, nil
+}
+
+// GetOperatorServiceKey returns the key that the given operator uses for the
+// given service at the current block time. This takes into account the pending
+// key whose activation time has already been reached but that has not been
+// activated yet. If the operator has no key for the service, false is returned
+func (k *Keeper) GetOperatorServiceKey(
+	ctx context.Context, operatorID uint32, serviceID uint32,
+) (types.OperatorServiceKey, bool, error) {
+	pendingKey, found, err := k.GetPendingOperatorServiceKey(ctx, operatorID, serviceID)
+	if err != nil {
+		return types.OperatorServiceKey{}, false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if found && pendingKey.IsActive(sdkCtx.BlockTime()) {
+		return pendingKey, true, nil
+	}
+
+	key, err := k.operatorServiceKeys.Get(ctx, collections.Join(operatorID, serviceID))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.OperatorServiceKey{}, false, nil
+		}
+		return types.OperatorServiceKey{}, false, err
+	}
+	return key, true, nil
+}
+
+// GetAllOperatorsServiceKeys returns all the keys currently used by the
+// operators
+func (k *Keeper) GetAllOperatorsServiceKeys(ctx context.Context) ([]types.OperatorServiceKey, error) {
+	iterator, err := k.operatorServiceKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// GetAllPendingOperatorsServiceKeys returns all the keys that will replace the
+// ones currently used by the operators
+func (k *Keeper) GetAllPendingOperatorsServiceKeys(ctx context.Context) ([]types.OperatorServiceKey, error) {
+	iterator, err := k.pendingOperatorServiceKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// DeleteOperatorServiceKeys removes all the keys of the given operator
+func (k *Keeper) DeleteOperatorServiceKeys(ctx context.Context, operatorID uint32) error {
+	iterator, err := k.pendingOperatorServiceKeys.Iterate(ctx, collections.NewPrefixedPairRange[uint32, uint32](operatorID))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	pendingKeys, err := iterator.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pendingKeys {
+		err = k.RemovePendingOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.operatorServiceKeys.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint32](operatorID))
+}
+
+// DeleteServiceOperatorsKeys removes all the keys that the operators use for
+// the given service
+func (k *Keeper) DeleteServiceOperatorsKeys(ctx context.Context, serviceID uint32) error {
+	pendingKeys, err := k.GetAllPendingOperatorsServiceKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pendingKeys {
+		if key.ServiceID != serviceID {
+			continue
+		}
+
+		err = k.RemovePendingOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	keys, err := k.GetAllOperatorsServiceKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if key.ServiceID != serviceID {
+			continue
+		}
+
+		err = k.operatorServiceKeys.Remove(ctx, collections.Join(key.OperatorID, key.ServiceID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SetOperatorServiceKey registers the given public key as the one that the
+// given operator uses for the given service, after verifying the proof of its
+// possession. If the operator has no key for the service, the new key is used
+// immediately. Otherwise, it replaces the current one once the rotation delay
+// set inside the params has passed. The registered key is returned
+func (k *Keeper) SetOperatorServiceKey(
+	ctx context.Context,
+	operatorID uint32,
+	serviceID uint32,
+	keyType types.KeyType,
+	publicKey []byte,
+	proofOfPossession []byte,
+) (types.OperatorServiceKey, error) {
+	err := types.ValidatePublicKey(keyType, publicKey)
+	if err != nil {
+		return types.OperatorServiceKey{}, errors.Wrap(types.ErrInvalidOperatorServiceKey, err.Error())
+	}
+
+	// Make sure the operator and the service exist
+	_, err = k.operatorsKeeper.GetOperator(ctx, operatorID)
+	if err != nil {
+		return types.OperatorServiceKey{}, err
+	}
+
+	_, err = k.servicesKeeper.GetService(ctx, serviceID)
+	if err != nil {
+		return types.OperatorServiceKey{}, err
+	}
+
+	// Make sure the proof of possession has been made with the key
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	msg := types.GetOperatorServiceKeyPossessionMessage(sdkCtx.ChainID(), operatorID, serviceID, keyType, publicKey)
+	if !types.VerifyProofOfPossession(keyType, publicKey, msg, proofOfPossession) {
+		return types.OperatorServiceKey{}, errors.Wrap(types.ErrInvalidProofOfPossession, "invalid signature")
+	}
+
+	currentKey, found, err := k.GetOperatorServiceKey(ctx, operatorID, serviceID)
+	if err != nil {
+		return types.OperatorServiceKey{}, err
+	}
+
+	pendingKey, hasPendingKey, err := k.GetPendingOperatorServiceKey(ctx, operatorID, serviceID)
+	if err != nil {
+		return types.OperatorServiceKey{}, err
+	}
+
+	// Registering the current key again cancels the pending rotation, if any
+	if found && currentKey.KeyType == keyType && bytes.Equal(currentKey.PublicKey, publicKey) {
+		if !hasPendingKey || pendingKey.IsActive(sdkCtx.BlockTime()) {
+			return types.OperatorServiceKey{}, errors.Wrap(types.ErrInvalidOperatorServiceKey, "key is already in use")
+		}
+		return currentKey, k.RemovePendingOperatorServiceKey(ctx, pendingKey)
+	}
+
+	// Remove the previously registered pending key, activating it first if its
+	// activation time has already been reached
+	if hasPendingKey {
+		err = k.RemovePendingOperatorServiceKey(ctx, pendingKey)
+		if err != nil {
+			return types.OperatorServiceKey{}, err
+		}
+
+		if pendingKey.IsActive(sdkCtx.BlockTime()) {
+			err = k.SaveOperatorServiceKey(ctx, pendingKey)
+			if err != nil {
+				return types.OperatorServiceKey{}, err
+			}
+		}
+	}
+
+	// The first key registered for a service is used immediately
+	if !found {
+		key := types.NewOperatorServiceKey(operatorID, serviceID, keyType, publicKey, sdkCtx.BlockTime())
+		return key, k.SaveOperatorServiceKey(ctx, key)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.OperatorServiceKey{}, err
+	}
+
+	activationTime := sdkCtx.BlockTime().Add(params.OperatorServiceKeyRotationDelay)
+	key := types.NewOperatorServiceKey(operatorID, serviceID, keyType, publicKey, activationTime)
+	if params.OperatorServiceKeyRotationDelay == 0 {
+		return key, k.SaveOperatorServiceKey(ctx, key)
+	}
+
+	return key, k.SavePendingOperatorServiceKey(ctx, key)
+}
+
+// ActivateMatureOperatorServiceKeys replaces the keys currently used by the
+// operators with the pending ones whose activation time has been reached
+func (k *Keeper) ActivateMatureOperatorServiceKeys(ctx sdk.Context) error {
+	var matureKeys []collections.Pair[uint32, uint32]
+	err := k.operatorServiceKeysQueue.Walk(ctx, nil, func(key collections.Triple[time.Time, uint32, uint32]) (stop bool, err error) {
+		if key.K1().After(ctx.BlockTime()) {
+			return true, nil
+		}
+
+		matureKeys = append(matureKeys, collections.Join(key.K2(), key.K3()))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, storeKey := range matureKeys {
+		key, err := k.pendingOperatorServiceKeys.Get(ctx, storeKey)
+		if err != nil {
+			return err
+		}
+
+		err = k.RemovePendingOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		err = k.SaveOperatorServiceKey(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeActivateServiceKey,
+				sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(key.OperatorID)),
+				sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(key.ServiceID)),
+				sdk.NewAttribute(types.AttributeKeyKeyType, key.KeyType.String()),
+				sdk.NewAttribute(types.AttributeKeyPublicKey, fmt.Sprintf("%X", key.PublicKey)),
+			),
+		)
+	}
+
+	return nil
+}
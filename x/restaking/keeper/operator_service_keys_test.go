@@ -0,0 +1,452 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+var (
+	operatorServiceKeysBlockTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	firstOperatorServiceKey  = ed25519.GenPrivKey()
+	secondOperatorServiceKey = ed25519.GenPrivKey()
+	thirdOperatorServiceKey  = ed25519.GenPrivKey()
+)
+
+// signOperatorServiceKeyPossession returns the proof of possession of the given
+// key when registering it for the given operator and service
+func (suite *KeeperTestSuite) signOperatorServiceKeyPossession(
+	ctx sdk.Context, privKey *ed25519.PrivKey, operatorID uint32, serviceID uint32,
+) []byte {
+	msg := types.GetOperatorServiceKeyPossessionMessage(
+		ctx.ChainID(),
+		operatorID,
+		serviceID,
+		types.KEY_TYPE_ED25519,
+		privKey.PubKey().Bytes(),
+	)
+	signature, err := privKey.Sign(msg)
+	suite.Require().NoError(err)
+	return signature
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SetOperatorServiceKey() {
+	testCases := []struct {
+		name              string
+		store             func(ctx sdk.Context)
+		operatorID        uint32
+		serviceID         uint32
+		publicKey         []byte
+		proofOfPossession func(ctx sdk.Context) []byte
+		shouldErr         bool
+		expKey            types.OperatorServiceKey
+		check             func(ctx sdk.Context)
+	}{
+		{
+			name:       "non existing operator returns error",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 1)
+			},
+			shouldErr: true,
+		},
+		{
+			name:       "non existing service returns error",
+			operatorID: 1,
+			serviceID:  2,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 2)
+			},
+			shouldErr: true,
+		},
+		{
+			name:       "proof of possession made for another service returns error",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 2)
+			},
+			shouldErr: true,
+		},
+		{
+			name:       "proof of possession made with another key returns error",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, secondOperatorServiceKey, 1, 1)
+			},
+			shouldErr: true,
+		},
+		{
+			name:       "first key is used immediately",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 1)
+			},
+			expKey: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				firstOperatorServiceKey.PubKey().Bytes(),
+				operatorServiceKeysBlockTime,
+			),
+			check: func(ctx sdk.Context) {
+				key, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(firstOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+
+				_, found, err = suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name:       "new key replaces the current one after the rotation delay",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					firstOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			publicKey: secondOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, secondOperatorServiceKey, 1, 1)
+			},
+			expKey: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				secondOperatorServiceKey.PubKey().Bytes(),
+				operatorServiceKeysBlockTime.Add(types.DefaultOperatorServiceKeyRotationDelay),
+			),
+			check: func(ctx sdk.Context) {
+				// Make sure the current key is still used
+				key, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(firstOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+
+				// Make sure the new key is used once the rotation delay has passed
+				ctx = ctx.WithBlockTime(operatorServiceKeysBlockTime.Add(types.DefaultOperatorServiceKeyRotationDelay))
+				key, found, err = suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(secondOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+			},
+		},
+		{
+			name:       "new key replaces the current one immediately with no rotation delay",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+				params.OperatorServiceKeyRotationDelay = 0
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				err = suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					firstOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			publicKey: secondOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, secondOperatorServiceKey, 1, 1)
+			},
+			expKey: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				secondOperatorServiceKey.PubKey().Bytes(),
+				operatorServiceKeysBlockTime,
+			),
+			check: func(ctx sdk.Context) {
+				key, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(secondOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+
+				_, found, err = suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name:       "new key replaces the pending one",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					firstOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					secondOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			publicKey: thirdOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, thirdOperatorServiceKey, 1, 1)
+			},
+			expKey: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				thirdOperatorServiceKey.PubKey().Bytes(),
+				operatorServiceKeysBlockTime.Add(types.DefaultOperatorServiceKeyRotationDelay),
+			),
+			check: func(ctx sdk.Context) {
+				key, found, err := suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(thirdOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+
+				// Make sure the replaced pending key is never activated
+				ctx = ctx.WithBlockTime(operatorServiceKeysBlockTime.Add(2 * time.Hour))
+				err = suite.k.ActivateMatureOperatorServiceKeys(ctx)
+				suite.Require().NoError(err)
+
+				key, found, err = suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(firstOperatorServiceKey.PubKey().Bytes(), key.PublicKey)
+			},
+		},
+		{
+			name:       "registering the current key cancels the pending rotation",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					firstOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					secondOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 1)
+			},
+			expKey: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				firstOperatorServiceKey.PubKey().Bytes(),
+				operatorServiceKeysBlockTime.Add(-time.Hour),
+			),
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name:       "registering the current key without a pending rotation returns error",
+			operatorID: 1,
+			serviceID:  1,
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+				})
+
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1,
+					1,
+					types.KEY_TYPE_ED25519,
+					firstOperatorServiceKey.PubKey().Bytes(),
+					operatorServiceKeysBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			publicKey: firstOperatorServiceKey.PubKey().Bytes(),
+			proofOfPossession: func(ctx sdk.Context) []byte {
+				return suite.signOperatorServiceKeyPossession(ctx, firstOperatorServiceKey, 1, 1)
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(operatorServiceKeysBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			key, err := suite.k.SetOperatorServiceKey(
+				ctx,
+				tc.operatorID,
+				tc.serviceID,
+				types.KEY_TYPE_ED25519,
+				tc.publicKey,
+				tc.proofOfPossession(ctx),
+			)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expKey, key)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ActivateMatureOperatorServiceKeys() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(operatorServiceKeysBlockTime)
+
+	err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+		1,
+		1,
+		types.KEY_TYPE_ED25519,
+		firstOperatorServiceKey.PubKey().Bytes(),
+		operatorServiceKeysBlockTime.Add(-time.Hour),
+	))
+	suite.Require().NoError(err)
+
+	// Store a mature pending key and one that is not mature yet
+	err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+		1,
+		1,
+		types.KEY_TYPE_ED25519,
+		secondOperatorServiceKey.PubKey().Bytes(),
+		operatorServiceKeysBlockTime,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+		1,
+		2,
+		types.KEY_TYPE_ED25519,
+		thirdOperatorServiceKey.PubKey().Bytes(),
+		operatorServiceKeysBlockTime.Add(time.Hour),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.k.ActivateMatureOperatorServiceKeys(ctx)
+	suite.Require().NoError(err)
+
+	// Make sure the mature key has been activated
+	keys, err := suite.k.GetAllOperatorsServiceKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OperatorServiceKey{
+		types.NewOperatorServiceKey(
+			1,
+			1,
+			types.KEY_TYPE_ED25519,
+			secondOperatorServiceKey.PubKey().Bytes(),
+			operatorServiceKeysBlockTime,
+		),
+	}, keys)
+
+	// Make sure the other key is still pending
+	pendingKeys, err := suite.k.GetAllPendingOperatorsServiceKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OperatorServiceKey{
+		types.NewOperatorServiceKey(
+			1,
+			2,
+			types.KEY_TYPE_ED25519,
+			thirdOperatorServiceKey.PubKey().Bytes(),
+			operatorServiceKeysBlockTime.Add(time.Hour),
+		),
+	}, pendingKeys)
+
+	var activated int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeActivateServiceKey {
+			activated++
+		}
+	}
+	suite.Require().Equal(1, activated)
+}
@@ -45,6 +45,11 @@ func (o *OperatorsHooks) BeforeOperatorDeleted(ctx context.Context, operatorID u
 		if err != nil {
 			return err
 		}
+
+		err = o.MarkServiceDirty(ctx, key.K2())
+		if err != nil {
+			return err
+		}
 	}
 
 	// Remove the operator from the services allow list where has been
@@ -54,7 +59,14 @@ func (o *OperatorsHooks) BeforeOperatorDeleted(ctx context.Context, operatorID u
 		return err
 	}
 
-	return nil
+	// Remove the operator jail records
+	err = o.DeleteOperatorJailRecords(ctx, operatorID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the keys registered by the operator for the services
+	return o.DeleteOperatorServiceKeys(ctx, operatorID)
 }
 
 func (o *OperatorsHooks) removeOperatorFromServicesAllowList(ctx context.Context, operatorID uint32) error {
@@ -114,17 +126,17 @@ func (o *OperatorsHooks) removeOperatorFromServicesAllowList(ctx context.Context
 
 // AfterOperatorInactivatingCompleted implements types.OperatorsHooks.
 func (o *OperatorsHooks) AfterOperatorInactivatingCompleted(ctx context.Context, operatorID uint32) error {
-	return nil
+	return o.markOperatorServicesDirty(ctx, operatorID)
 }
 
 // AfterOperatorInactivatingStarted implements types.OperatorsHooks.
 func (o *OperatorsHooks) AfterOperatorInactivatingStarted(ctx context.Context, operatorID uint32) error {
-	return nil
+	return o.markOperatorServicesDirty(ctx, operatorID)
 }
 
 // AfterOperatorReactivated implements types.OperatorsHooks.
 func (o *OperatorsHooks) AfterOperatorReactivated(ctx context.Context, operatorID uint32) error {
-	return nil
+	return o.markOperatorServicesDirty(ctx, operatorID)
 }
 
 // AfterOperatorRegistered implements types.OperatorsHooks.
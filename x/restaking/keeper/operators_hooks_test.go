@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
 
@@ -268,6 +272,49 @@ func (suite *KeeperTestSuite) TestOperatorHooks_BeforeOperatorDeleted() {
 			operatorID: 1,
 			shouldErr:  false,
 		},
+		{
+			name: "operator service keys are removed",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+				err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{2}, 32), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+
+				// Add some other to ensure we don't eliminate the wrong data
+				err = suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					2, 2, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{3}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				_, found, err = suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				// Check that we didn't remove other data
+				_, found, err = suite.k.GetOperatorServiceKey(ctx, 2, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				// Make sure the removed pending key is not activated
+				err = suite.k.ActivateMatureOperatorServiceKeys(ctx.WithBlockTime(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)))
+				suite.Require().NoError(err)
+
+				_, found, err = suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+			operatorID: 1,
+			shouldErr:  false,
+		},
 	}
 
 	for _, tc := range testCases {
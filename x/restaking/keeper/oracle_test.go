@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	assetstypes "github.com/milkyway-labs/milkyway/v12/x/assets/types"
 )
 
 func (suite *KeeperTestSuite) TestGetAssetAndPrice() {
@@ -39,6 +40,35 @@ func (suite *KeeperTestSuite) TestGetCoinValue() {
 	suite.Require().Equal(utils.MustParseDec("0.000636"), value)
 }
 
+func (suite *KeeperTestSuite) TestGetCoinValue_LiquidStakingAsset() {
+	// Cache the context to avoid issues
+	ctx, _ := suite.ctx.CacheContext()
+
+	suite.RegisterCurrency(ctx, "uatom", "ATOM", 6, utils.MustParseDec("8"))
+
+	// Register the liquid staking asset, without setting its own price
+	err := suite.assetsKeeper.SetAsset(ctx, assetstypes.NewLiquidStakingAsset(
+		"stuatom",
+		"STATOM",
+		6,
+		assetstypes.NewUnderlyingAsset("uatom", assetstypes.REDEMPTION_RATE_SOURCE_GOVERNANCE, "", utils.MustParseDec("0.05")),
+	))
+	suite.Require().NoError(err)
+
+	// Without a redemption rate the asset has no value
+	value, err := suite.k.GetCoinValue(ctx, utils.MustParseCoin("10_000000stuatom"))
+	suite.Require().NoError(err)
+	suite.Require().True(value.IsZero())
+
+	// The asset is valued using the underlying price and the redemption rate
+	err = suite.assetsKeeper.SetRedemptionRate(ctx, "stuatom", utils.MustParseDec("1.25"))
+	suite.Require().NoError(err)
+
+	value, err = suite.k.GetCoinValue(ctx, utils.MustParseCoin("10_000000stuatom"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(utils.MustParseDec("100"), value)
+}
+
 func (suite *KeeperTestSuite) TestGetCoinsValue() {
 	// Cache the context to avoid issues
 	ctx, _ := suite.ctx.CacheContext()
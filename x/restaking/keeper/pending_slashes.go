@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SetNextPendingSlashID sets the ID that will be assigned to the next pending slash
+func (k *Keeper) SetNextPendingSlashID(ctx context.Context, pendingSlashID uint64) error {
+	return k.nextPendingSlashID.Set(ctx, pendingSlashID)
+}
+
+// GetNextPendingSlashID returns the ID that will be assigned to the next pending slash
+func (k *Keeper) GetNextPendingSlashID(ctx context.Context) (uint64, error) {
+	nextPendingSlashID, err := k.nextPendingSlashID.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The pending slash IDs start from 1
+	if nextPendingSlashID == 0 {
+		return 1, nil
+	}
+
+	return nextPendingSlashID, nil
+}
+
+// incrementNextPendingSlashID returns the ID to be assigned to a new pending
+// slash and increments the stored one
+func (k *Keeper) incrementNextPendingSlashID(ctx context.Context) (uint64, error) {
+	pendingSlashID, err := k.GetNextPendingSlashID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.SetNextPendingSlashID(ctx, pendingSlashID+1)
+	if err != nil {
+		return 0, err
+	}
+
+	return pendingSlashID, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SavePendingSlash stores the given pending slash and inserts it into the
+// queue of the pending slashes to be executed
+func (k *Keeper) SavePendingSlash(ctx context.Context, pendingSlash types.PendingSlash) error {
+	err := k.pendingSlashes.Set(ctx, pendingSlash.ID, pendingSlash)
+	if err != nil {
+		return err
+	}
+
+	return k.pendingSlashesQueue.Set(ctx, collections.Join(pendingSlash.ExecutionTime, pendingSlash.ID))
+}
+
+// GetPendingSlash returns the pending slash having the given ID. If the
+// pending slash does not exist, false is returned
+func (k *Keeper) GetPendingSlash(ctx context.Context, pendingSlashID uint64) (types.PendingSlash, bool, error) {
+	pendingSlash, err := k.pendingSlashes.Get(ctx, pendingSlashID)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.PendingSlash{}, false, nil
+		}
+		return types.PendingSlash{}, false, err
+	}
+	return pendingSlash, true, nil
+}
+
+// RemovePendingSlash removes the given pending slash from the store and from
+// the queue of the pending slashes to be executed
+func (k *Keeper) RemovePendingSlash(ctx context.Context, pendingSlash types.PendingSlash) error {
+	err := k.pendingSlashes.Remove(ctx, pendingSlash.ID)
+	if err != nil {
+		return err
+	}
+
+	return k.pendingSlashesQueue.Remove(ctx, collections.Join(pendingSlash.ExecutionTime, pendingSlash.ID))
+}
+
+// GetAllPendingSlashes returns all the stored pending slashes
+func (k *Keeper) GetAllPendingSlashes(ctx context.Context) ([]types.PendingSlash, error) {
+	iterator, err := k.pendingSlashes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// DeleteServicePendingSlashes removes all the pending slashes requested by the given service
+func (k *Keeper) DeleteServicePendingSlashes(ctx context.Context, serviceID uint32) error {
+	pendingSlashes, err := k.GetAllPendingSlashes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pendingSlash := range pendingSlashes {
+		if pendingSlash.ServiceID != serviceID {
+			continue
+		}
+
+		err = k.RemovePendingSlash(ctx, pendingSlash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// QueueOperatorSlash creates a new pending slash of the given fraction of the
+// security that the operator is providing to the service. The slash will be
+// executed once the veto window has passed, unless it gets vetoed in the meantime.
+// Accredited services use the accredited slash veto window set inside the params
+func (k *Keeper) QueueOperatorSlash(
+	ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64,
+) (types.PendingSlash, error) {
+	if fraction.IsNil() || !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return types.PendingSlash{}, errors.Wrapf(types.ErrInvalidSlashFraction, "fraction must be between 0 (exclusive) and 1 (inclusive): %s", fraction)
+	}
+
+	return k.queuePendingSlash(ctx, serviceID, operatorID, fraction, infractionHeight, false)
+}
+
+// QueueOperatorTombstone creates a new pending slash that, once executed, slashes
+// the given fraction of the security that the operator is providing to the service
+// and tombstones the operator from the service. The fraction can be zero, in which
+// case the operator is only tombstoned. As for the other pending slashes, the
+// tombstone can be vetoed until the veto window has passed
+func (k *Keeper) QueueOperatorTombstone(
+	ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64,
+) (types.PendingSlash, error) {
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return types.PendingSlash{}, errors.Wrapf(types.ErrInvalidSlashFraction, "fraction must be between 0 and 1 (inclusive): %s", fraction)
+	}
+
+	return k.queuePendingSlash(ctx, serviceID, operatorID, fraction, infractionHeight, true)
+}
+
+// queuePendingSlash stores a new pending slash for the given operator and service,
+// to be executed once the veto window of the service has passed
+func (k *Keeper) queuePendingSlash(
+	ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64, tombstone bool,
+) (types.PendingSlash, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if infractionHeight < 0 || infractionHeight > sdkCtx.BlockHeight() {
+		return types.PendingSlash{}, errors.Wrapf(types.ErrInvalidInfractionHeight, "infraction height %d is not valid at height %d", infractionHeight, sdkCtx.BlockHeight())
+	}
+
+	// Make sure the service and the operator exist
+	service, err := k.servicesKeeper.GetService(ctx, serviceID)
+	if err != nil {
+		return types.PendingSlash{}, err
+	}
+
+	_, err = k.operatorsKeeper.GetOperator(ctx, operatorID)
+	if err != nil {
+		return types.PendingSlash{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.PendingSlash{}, err
+	}
+
+	pendingSlashID, err := k.incrementNextPendingSlashID(ctx)
+	if err != nil {
+		return types.PendingSlash{}, err
+	}
+
+	executionTime := sdkCtx.BlockTime().Add(params.SlashVetoWindowForService(service.Accredited))
+	pendingSlash := types.NewPendingSlash(pendingSlashID, serviceID, operatorID, fraction, infractionHeight, executionTime)
+	pendingSlash.Tombstone = tombstone
+
+	err = k.SavePendingSlash(ctx, pendingSlash)
+	if err != nil {
+		return types.PendingSlash{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueSlash,
+			sdk.NewAttribute(types.AttributeKeyPendingSlashID, fmt.Sprint(pendingSlash.ID)),
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(serviceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(operatorID)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprint(infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyTombstone, fmt.Sprint(tombstone)),
+		),
+	)
+
+	return pendingSlash, nil
+}
+
+// VetoSlash cancels the pending slash having the given ID
+func (k *Keeper) VetoSlash(ctx context.Context, pendingSlashID uint64) error {
+	pendingSlash, found, err := k.GetPendingSlash(ctx, pendingSlashID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.Wrapf(types.ErrPendingSlashNotFound, "pending slash %d not found", pendingSlashID)
+	}
+
+	return k.RemovePendingSlash(ctx, pendingSlash)
+}
+
+// ExecuteMaturePendingSlashes executes all the pending slashes whose veto
+// window has passed. Pending slashes that fail to be executed are kept and
+// re-queued to be retried once the slash veto window has passed again, so
+// that they are either executed later on or vetoed
+func (k *Keeper) ExecuteMaturePendingSlashes(ctx sdk.Context) error {
+	var matureSlashesIDs []uint64
+	err := k.pendingSlashesQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		if key.K1().After(ctx.BlockTime()) {
+			return true, nil
+		}
+
+		matureSlashesIDs = append(matureSlashesIDs, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pendingSlashID := range matureSlashesIDs {
+		pendingSlash, err := k.pendingSlashes.Get(ctx, pendingSlashID)
+		if err != nil {
+			return err
+		}
+
+		err = k.RemovePendingSlash(ctx, pendingSlash)
+		if err != nil {
+			return err
+		}
+
+		// Execute the slash using a cached context so that a failure does not
+		// leave the store in an inconsistent state
+		cachedCtx, writeCache := ctx.CacheContext()
+		slashedAmount, executionErr := k.executePendingSlash(cachedCtx, pendingSlash)
+		if executionErr != nil {
+			k.Logger(ctx).Error("failed to execute pending slash", "id", pendingSlash.ID, "error", executionErr)
+
+			err = k.requeueFailedPendingSlash(ctx, pendingSlash, executionErr)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteSlash,
+				sdk.NewAttribute(types.AttributeKeyPendingSlashID, fmt.Sprint(pendingSlash.ID)),
+				sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(pendingSlash.ServiceID)),
+				sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(pendingSlash.OperatorID)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, slashedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyTombstone, fmt.Sprint(pendingSlash.Tombstone)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// requeueFailedPendingSlash queues again the given pending slash, whose
+// execution has failed, so that it can be retried once the service's veto
+// window has passed. The slash is dropped instead if its service or operator
+// no longer exist, or if its execution has already been retried
+// MaxPendingSlashRetries times.
+func (k *Keeper) requeueFailedPendingSlash(ctx sdk.Context, pendingSlash types.PendingSlash, executionErr error) error {
+	service, err := k.servicesKeeper.GetService(ctx, pendingSlash.ServiceID)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	serviceFound := err == nil
+
+	_, err = k.operatorsKeeper.GetOperator(ctx, pendingSlash.OperatorID)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	operatorFound := err == nil
+
+	if !serviceFound || !operatorFound || pendingSlash.Retries >= types.MaxPendingSlashRetries {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDropSlash,
+				sdk.NewAttribute(types.AttributeKeyPendingSlashID, fmt.Sprint(pendingSlash.ID)),
+				sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(pendingSlash.ServiceID)),
+				sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(pendingSlash.OperatorID)),
+				sdk.NewAttribute(types.AttributeKeyError, executionErr.Error()),
+				sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprint(pendingSlash.Retries)),
+			),
+		)
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	pendingSlash.Retries++
+	pendingSlash.ExecutionTime = ctx.BlockTime().Add(params.SlashVetoWindowForService(service.Accredited))
+	err = k.SavePendingSlash(ctx, pendingSlash)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashFailed,
+			sdk.NewAttribute(types.AttributeKeyPendingSlashID, fmt.Sprint(pendingSlash.ID)),
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(pendingSlash.ServiceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(pendingSlash.OperatorID)),
+			sdk.NewAttribute(types.AttributeKeyError, executionErr.Error()),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, pendingSlash.ExecutionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprint(pendingSlash.Retries)),
+		),
+	)
+
+	return nil
+}
+
+// executePendingSlash slashes and, if required, tombstones the operator of the given pending slash
+func (k *Keeper) executePendingSlash(ctx context.Context, pendingSlash types.PendingSlash) (sdk.Coins, error) {
+	slashedAmount := sdk.NewCoins()
+	if pendingSlash.Fraction.IsPositive() {
+		amount, err := k.slashOperator(
+			ctx,
+			pendingSlash.ServiceID,
+			pendingSlash.OperatorID,
+			pendingSlash.Fraction,
+			pendingSlash.InfractionHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+		slashedAmount = amount
+	}
+
+	if pendingSlash.Tombstone {
+		err := k.TombstoneOperator(ctx, pendingSlash.ServiceID, pendingSlash.OperatorID)
+		if err != nil && !errors.IsOf(err, types.ErrOperatorTombstoned) {
+			return nil, err
+		}
+	}
+
+	return slashedAmount, nil
+}
@@ -0,0 +1,530 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+var pendingSlashesBlockTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func (suite *KeeperTestSuite) TestKeeper_QueueOperatorSlash() {
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		fraction         sdkmath.LegacyDec
+		infractionHeight int64
+		shouldErr        bool
+		expPendingSlash  types.PendingSlash
+	}{
+		{
+			name: "invalid fraction returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			fraction:         sdkmath.LegacyNewDec(2),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name: "future infraction height returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			fraction:         utils.MustParseDec("0.1"),
+			infractionHeight: 101,
+			shouldErr:        true,
+		},
+		{
+			name:             "non existing service returns error",
+			fraction:         utils.MustParseDec("0.1"),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name: "non existing operator returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+			},
+			fraction:         utils.MustParseDec("0.1"),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name: "slash is queued with the slash veto window",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			fraction:         utils.MustParseDec("0.1"),
+			infractionHeight: 10,
+			expPendingSlash: types.NewPendingSlash(
+				1,
+				1,
+				1,
+				utils.MustParseDec("0.1"),
+				10,
+				pendingSlashesBlockTime.Add(types.DefaultSlashVetoWindow),
+			),
+		},
+		{
+			name: "slash of an accredited service is queued with the accredited slash veto window",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				service, err := suite.sk.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				service.Accredited = true
+				err = suite.sk.SaveService(ctx, service)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetNextPendingSlashID(ctx, 5)
+				suite.Require().NoError(err)
+			},
+			fraction:         utils.MustParseDec("0.1"),
+			infractionHeight: 10,
+			expPendingSlash: types.NewPendingSlash(
+				5,
+				1,
+				1,
+				utils.MustParseDec("0.1"),
+				10,
+				pendingSlashesBlockTime.Add(types.DefaultAccreditedSlashVetoWindow),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(pendingSlashesBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			pendingSlash, err := suite.k.QueueOperatorSlash(ctx, 1, 1, tc.fraction, tc.infractionHeight)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPendingSlash, pendingSlash)
+
+				stored, found, err := suite.k.GetPendingSlash(ctx, pendingSlash.ID)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expPendingSlash, stored)
+
+				nextID, err := suite.k.GetNextPendingSlashID(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(pendingSlash.ID+1, nextID)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_QueueOperatorTombstone() {
+	testCases := []struct {
+		name            string
+		store           func(ctx sdk.Context)
+		fraction        sdkmath.LegacyDec
+		shouldErr       bool
+		expPendingSlash types.PendingSlash
+	}{
+		{
+			name: "negative fraction returns error",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			fraction:  sdkmath.LegacyNewDec(-1),
+			shouldErr: true,
+		},
+		{
+			name: "tombstone without slash is queued properly",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+			},
+			fraction:  sdkmath.LegacyZeroDec(),
+			shouldErr: false,
+			expPendingSlash: types.PendingSlash{
+				ID:               1,
+				ServiceID:        1,
+				OperatorID:       1,
+				Fraction:         sdkmath.LegacyZeroDec(),
+				InfractionHeight: 10,
+				ExecutionTime:    pendingSlashesBlockTime.Add(types.DefaultSlashVetoWindow),
+				Tombstone:        true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(pendingSlashesBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			pendingSlash, err := suite.k.QueueOperatorTombstone(ctx, 1, 1, tc.fraction, 10)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPendingSlash, pendingSlash)
+
+				// Make sure the operator is not tombstoned before the veto window has passed
+				_, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VetoSlash() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		shouldErr bool
+	}{
+		{
+			name:      "non existing pending slash returns error",
+			shouldErr: true,
+		},
+		{
+			name: "pending slash is vetoed properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					pendingSlashesBlockTime,
+				))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(pendingSlashesBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := suite.k.VetoSlash(ctx, 1)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, types.ErrPendingSlashNotFound)
+			} else {
+				suite.Require().NoError(err)
+
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				// Make sure the vetoed slash is not executed
+				err = suite.k.ExecuteMaturePendingSlashes(ctx)
+				suite.Require().NoError(err)
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeExecuteSlash, event.Type)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ExecuteMaturePendingSlashes() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "pending slash not mature is not executed",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					pendingSlashesBlockTime.Add(time.Second),
+				))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+			},
+		},
+		{
+			name: "mature pending slash is executed and removed",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					pendingSlashesBlockTime,
+				))
+				suite.Require().NoError(err)
+			},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeExecuteSlash,
+					sdk.NewAttribute(types.AttributeKeyPendingSlashID, "1"),
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, ""),
+					sdk.NewAttribute(types.AttributeKeyTombstone, "false"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "mature pending tombstone tombstones the operator",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				pendingSlash := types.NewPendingSlash(
+					1,
+					1,
+					1,
+					sdkmath.LegacyZeroDec(),
+					10,
+					pendingSlashesBlockTime,
+				)
+				pendingSlash.Tombstone = true
+				err := suite.k.SavePendingSlash(ctx, pendingSlash)
+				suite.Require().NoError(err)
+			},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeTombstoneOperator,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetPendingSlash(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				record, found, err := suite.k.GetOperatorJailRecord(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().True(record.Tombstoned)
+
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(operatorstypes.OPERATOR_STATUS_JAILED, operator.Status)
+			},
+		},
+		{
+			name: "pending slash that can't be executed is re-queued",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				// Make the service accredited and store the operator
+				err := suite.sk.SaveService(ctx, servicestypes.NewService(
+					1,
+					servicestypes.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.ok.SaveOperator(ctx, newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"))
+				suite.Require().NoError(err)
+
+				// Use an infraction height in the future, so that the slash fails
+				err = suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					1000,
+					pendingSlashesBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				expected := types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					1000,
+					pendingSlashesBlockTime.Add(types.DefaultAccreditedSlashVetoWindow),
+				)
+				expected.Retries = 1
+
+				pendingSlashes, err := suite.k.GetAllPendingSlashes(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.PendingSlash{expected}, pendingSlashes)
+
+				var failedEvents int
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeExecuteSlash, event.Type)
+					if event.Type == types.EventTypeSlashFailed {
+						failedEvents++
+					}
+				}
+				suite.Require().Equal(1, failedEvents)
+			},
+		},
+		{
+			name: "pending slash that has been retried too many times is dropped",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				err := suite.ok.SaveOperator(ctx, newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"))
+				suite.Require().NoError(err)
+
+				// Use an infraction height in the future, so that the slash fails
+				pendingSlash := types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					1000,
+					pendingSlashesBlockTime.Add(-time.Hour),
+				)
+				pendingSlash.Retries = types.MaxPendingSlashRetries
+				err = suite.k.SavePendingSlash(ctx, pendingSlash)
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				pendingSlashes, err := suite.k.GetAllPendingSlashes(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Empty(pendingSlashes)
+
+				var droppedEvents int
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeSlashFailed, event.Type)
+					if event.Type == types.EventTypeDropSlash {
+						droppedEvents++
+					}
+				}
+				suite.Require().Equal(1, droppedEvents)
+			},
+		},
+		{
+			name: "pending slash of an operator that no longer exists is dropped",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				err := suite.k.SavePendingSlash(ctx, types.NewPendingSlash(
+					1,
+					1,
+					1,
+					utils.MustParseDec("0.1"),
+					10,
+					pendingSlashesBlockTime.Add(-time.Hour),
+				))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				pendingSlashes, err := suite.k.GetAllPendingSlashes(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Empty(pendingSlashes)
+
+				var droppedEvents int
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeDropSlash {
+						droppedEvents++
+					}
+				}
+				suite.Require().Equal(1, droppedEvents)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(pendingSlashesBlockTime)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.k.ExecuteMaturePendingSlashes(ctx)
+			suite.Require().NoError(err)
+
+			for _, event := range tc.expEvents {
+				suite.Require().Contains(ctx.EventManager().Events(), event)
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteServicePendingSlashes() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	pendingSlashes := []types.PendingSlash{
+		types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, pendingSlashesBlockTime),
+		types.NewPendingSlash(2, 2, 1, utils.MustParseDec("0.1"), 10, pendingSlashesBlockTime),
+		types.NewPendingSlash(3, 1, 2, utils.MustParseDec("0.1"), 10, pendingSlashesBlockTime),
+	}
+	for _, pendingSlash := range pendingSlashes {
+		err := suite.k.SavePendingSlash(ctx, pendingSlash)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.k.DeleteServicePendingSlashes(ctx, 1)
+	suite.Require().NoError(err)
+
+	stored, err := suite.k.GetAllPendingSlashes(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PendingSlash{pendingSlashes[1]}, stored)
+}
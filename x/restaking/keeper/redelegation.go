@@ -0,0 +1,517 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// SetRedelegation stores the given redelegation in the store
+func (k *Keeper) SetRedelegation(ctx context.Context, red types.Redelegation) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	redelegationKey := types.UserRedelegationStoreKey(red.DelegatorAddress, red.SrcType, red.SrcTargetID, red.DstType, red.DstTargetID)
+	err := store.Set(redelegationKey, types.MustMarshalRedelegation(k.cdc, red))
+	if err != nil {
+		return err
+	}
+
+	// Store the key in the redelegations by source target index (used for reversed lookup)
+	return store.Set(types.RedelegationBySrcTargetStoreKey(red.SrcType, red.SrcTargetID, red.DstType, red.DstTargetID, red.DelegatorAddress), []byte{})
+}
+
+// GetRedelegation returns the redelegation made by the given delegator from the source target to the
+// destination target. If no redelegation is found, false is returned instead.
+func (k *Keeper) GetRedelegation(
+	ctx context.Context,
+	delegatorAddress string,
+	srcType types.DelegationType,
+	srcTargetID uint32,
+	dstType types.DelegationType,
+	dstTargetID uint32,
+) (types.Redelegation, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	redBz, err := store.Get(types.UserRedelegationStoreKey(delegatorAddress, srcType, srcTargetID, dstType, dstTargetID))
+	if err != nil {
+		return types.Redelegation{}, false, err
+	}
+
+	if redBz == nil {
+		return types.Redelegation{}, false, nil
+	}
+
+	return types.MustUnmarshalRedelegation(k.cdc, redBz), true, nil
+}
+
+// RemoveRedelegation removes the given redelegation and its associated index from the store
+func (k *Keeper) RemoveRedelegation(ctx context.Context, red types.Redelegation) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	err := store.Delete(types.UserRedelegationStoreKey(red.DelegatorAddress, red.SrcType, red.SrcTargetID, red.DstType, red.DstTargetID))
+	if err != nil {
+		return err
+	}
+
+	return store.Delete(types.RedelegationBySrcTargetStoreKey(red.SrcType, red.SrcTargetID, red.DstType, red.DstTargetID, red.DelegatorAddress))
+}
+
+// GetAllUserRedelegations returns all the redelegations made by the given user
+func (k *Keeper) GetAllUserRedelegations(ctx context.Context, userAddress string) []types.Redelegation {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.UserRedelegationsStorePrefix(userAddress))
+	defer iterator.Close()
+
+	var redelegations []types.Redelegation
+	for ; iterator.Valid(); iterator.Next() {
+		redelegations = append(redelegations, types.MustUnmarshalRedelegation(k.cdc, iterator.Value()))
+	}
+
+	return redelegations
+}
+
+// GetSrcTargetRedelegations returns all the redelegations made from the target having the given type and ID
+func (k *Keeper) GetSrcTargetRedelegations(ctx context.Context, srcType types.DelegationType, srcTargetID uint32) ([]types.Redelegation, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.RedelegationsBySrcTargetStorePrefix(srcType, srcTargetID))
+	defer iterator.Close()
+
+	var redelegations []types.Redelegation
+	for ; iterator.Valid(); iterator.Next() {
+		data := types.ParseRedelegationBySrcTargetStoreKey(iterator.Key())
+		red, found, err := k.GetRedelegation(ctx, data.DelegatorAddress, data.SrcType, data.SrcTargetID, data.DstType, data.DstTargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			redelegations = append(redelegations, red)
+		}
+	}
+
+	return redelegations, nil
+}
+
+// GetAllRedelegations returns all the redelegations
+func (k *Keeper) GetAllRedelegations(ctx context.Context) ([]types.Redelegation, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.RedelegationPrefix, storetypes.PrefixEndBytes(types.RedelegationPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var redelegations []types.Redelegation
+	for ; iterator.Valid(); iterator.Next() {
+		redelegations = append(redelegations, types.MustUnmarshalRedelegation(k.cdc, iterator.Value()))
+	}
+
+	return redelegations, nil
+}
+
+// HasReceivingRedelegation tells whether the given delegator has any redelegation that is still
+// in progress towards the target having the given type and ID
+func (k *Keeper) HasReceivingRedelegation(
+	ctx context.Context, delegatorAddress string, dstType types.DelegationType, dstTargetID uint32,
+) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, red := range k.GetAllUserRedelegations(ctx, delegatorAddress) {
+		if red.DstType != dstType || red.DstTargetID != dstTargetID {
+			continue
+		}
+
+		for _, entry := range red.Entries {
+			if !entry.IsMature(sdkCtx.BlockTime()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// HasMaxRedelegationEntries checks if the redelegation between the given targets has the maximum number of entries
+func (k *Keeper) HasMaxRedelegationEntries(
+	ctx context.Context,
+	delegatorAddress string,
+	srcType types.DelegationType,
+	srcTargetID uint32,
+	dstType types.DelegationType,
+	dstTargetID uint32,
+) (bool, error) {
+	red, found, err := k.GetRedelegation(ctx, delegatorAddress, srcType, srcTargetID, dstType, dstTargetID)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		// If there's no redelegation, then we know it hasn't reached the max entries
+		return false, nil
+	}
+
+	maxEntries, err := k.MaxEntries(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(red.Entries) >= int(maxEntries), nil
+}
+
+// SetRedelegationEntry adds an entry to the redelegation between the given targets.
+// It creates the redelegation if it does not exist.
+func (k *Keeper) SetRedelegationEntry(
+	ctx context.Context,
+	delegatorAddress string,
+	srcType types.DelegationType,
+	srcTargetID uint32,
+	dstType types.DelegationType,
+	dstTargetID uint32,
+	creationHeight int64,
+	minTime time.Time,
+	balance sdk.Coins,
+	sharesDst sdk.DecCoins,
+) (types.Redelegation, error) {
+	// Get the ID of the next redelegation entry
+	id, err := k.IncrementUnbondingID(ctx)
+	if err != nil {
+		return types.Redelegation{}, err
+	}
+
+	// Either get the existing redelegation, or create a new one
+	red, found, err := k.GetRedelegation(ctx, delegatorAddress, srcType, srcTargetID, dstType, dstTargetID)
+	if err != nil {
+		return types.Redelegation{}, err
+	}
+
+	if found {
+		red.AddEntry(creationHeight, minTime, balance, sharesDst, id)
+	} else {
+		red = types.NewRedelegation(delegatorAddress, srcType, srcTargetID, dstType, dstTargetID, creationHeight, minTime, balance, sharesDst, id)
+	}
+
+	err = k.SetRedelegation(ctx, red)
+	if err != nil {
+		return types.Redelegation{}, err
+	}
+
+	// Add to the index to look up the redelegation by the entry ID
+	store := k.storeService.OpenKVStore(ctx)
+	err = store.Set(types.GetRedelegationIndexKey(id), types.UserRedelegationStoreKey(delegatorAddress, srcType, srcTargetID, dstType, dstTargetID))
+	if err != nil {
+		return types.Redelegation{}, err
+	}
+
+	// Call the hook after the unbonding has been initiated
+	err = k.AfterUnbondingInitiated(ctx, id)
+	if err != nil {
+		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	}
+
+	return red, nil
+}
+
+// Redelegate moves the given amount from the delegation of the user to the source target to a delegation
+// to the target having the given type and ID. The tokens are delegated to the destination target
+// immediately, while a redelegation entry is tracked until the unbonding time has passed.
+// Redelegating from a target that is receiving a redelegation that is still in progress is not allowed.
+func (k *Keeper) Redelegate(
+	ctx context.Context,
+	delegator string,
+	src types.DelegationTarget,
+	dstType types.DelegationType,
+	dstTargetID uint32,
+	amount sdk.Coins,
+) (time.Time, error) {
+	srcType, err := types.GetDelegationTypeFromTarget(src)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if srcType == dstType && src.GetID() == dstTargetID {
+		return time.Time{}, types.ErrSelfRedelegation
+	}
+
+	// Make sure the tokens being redelegated have not been redelegated to the source target already
+	if k.HasReceivingRedelegation(ctx, delegator, srcType, src.GetID()) {
+		return time.Time{}, types.ErrTransitiveRedelegation
+	}
+
+	// Check if the redelegation has reached the maximum number of entries
+	hasMaxEntries, err := k.HasMaxRedelegationEntries(ctx, delegator, srcType, src.GetID(), dstType, dstTargetID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if hasMaxEntries {
+		return time.Time{}, types.ErrMaxRedelegationEntries
+	}
+
+	// Get the shares to be removed from the source target
+	shares, err := k.ValidateUnbondAmount(ctx, delegator, src, amount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	hooks, err := k.getDelegationHooks(srcType)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Unbond the tokens from the source target
+	returnAmount, err := k.Unbond(ctx, types.UndelegationData{
+		Delegator: delegator,
+		Target:    src,
+		Hooks:     hooks,
+		Shares:    shares,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return time.Time{}, errors.Wrap(sdkerrors.ErrInvalidRequest, "too few tokens to redelegate (truncates to zero tokens)")
+	}
+
+	// Send the tokens back to the delegator so that they can be delegated to the destination target
+	srcAddress, err := k.accountKeeper.AddressCodec().StringToBytes(src.GetAddress())
+	if err != nil {
+		return time.Time{}, err
+	}
+	delegatorAddress, err := k.accountKeeper.AddressCodec().StringToBytes(delegator)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, srcAddress, delegatorAddress, returnAmount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Delegate the tokens to the destination target
+	var sharesDst sdk.DecCoins
+	switch dstType {
+	case types.DELEGATION_TYPE_OPERATOR:
+		sharesDst, err = k.DelegateToOperator(ctx, dstTargetID, returnAmount, delegator)
+	case types.DELEGATION_TYPE_SERVICE:
+		sharesDst, err = k.DelegateToService(ctx, dstTargetID, returnAmount, delegator)
+	default:
+		err = errors.Wrapf(types.ErrInvalidDelegationType, "cannot redelegate to target of type %v", dstType)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Compute the time at which the redelegation should end, using the same unbonding
+	// time that would be applied when undelegating from the source target
+	unbondingTime, err := k.GetUndelegationUnbondingTime(ctx, srcType, src.GetID(), delegator)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	completionTime := sdkCtx.BlockHeader().Time.Add(unbondingTime)
+
+	// Store the redelegation entry inside the store
+	red, err := k.SetRedelegationEntry(ctx, delegator, srcType, src.GetID(), dstType, dstTargetID, sdkCtx.BlockHeight(), completionTime, returnAmount, sharesDst)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Insert the redelegation into the redelegation queue
+	err = k.InsertRedelegationQueue(ctx, red, completionTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return completionTime, nil
+}
+
+// RedelegateFromPool moves the given amount from the pool delegation of the user to the target
+// having the given type and ID
+func (k *Keeper) RedelegateFromPool(
+	ctx context.Context, amount sdk.Coin, delegator string, dstType types.DelegationType, dstTargetID uint32,
+) (time.Time, error) {
+	pool, found, err := k.poolsKeeper.GetPoolByDenom(ctx, amount.Denom)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !found {
+		return time.Time{}, poolstypes.ErrPoolNotFound
+	}
+
+	return k.Redelegate(ctx, delegator, pool, dstType, dstTargetID, sdk.NewCoins(amount))
+}
+
+// RedelegateFromOperator moves the given amount from the delegation of the user to the operator
+// having the source ID to the operator having the destination ID
+func (k *Keeper) RedelegateFromOperator(
+	ctx context.Context, srcOperatorID uint32, dstOperatorID uint32, amount sdk.Coins, delegator string,
+) (time.Time, error) {
+	operator, err := k.operatorsKeeper.GetOperator(ctx, srcOperatorID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.Redelegate(ctx, delegator, operator, types.DELEGATION_TYPE_OPERATOR, dstOperatorID, amount)
+}
+
+// RedelegateFromService moves the given amount from the delegation of the user to the service
+// having the source ID to the service having the destination ID
+func (k *Keeper) RedelegateFromService(
+	ctx context.Context, srcServiceID uint32, dstServiceID uint32, amount sdk.Coins, delegator string,
+) (time.Time, error) {
+	service, err := k.servicesKeeper.GetService(ctx, srcServiceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.Redelegate(ctx, delegator, service, types.DELEGATION_TYPE_SERVICE, dstServiceID, amount)
+}
+
+// CompleteRedelegation completes all the mature entries of the redelegation identified by the
+// given data and returns the total redelegated balance of such entries
+func (k *Keeper) CompleteRedelegation(ctx context.Context, data types.RTData) (sdk.Coins, error) {
+	red, found, err := k.GetRedelegation(ctx, data.DelegatorAddress, data.SrcType, data.SrcTargetID, data.DstType, data.DstTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, types.ErrNoRedelegation
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ctxTime := sdkCtx.BlockHeader().Time
+
+	// Loop through all the entries and complete the mature ones
+	balances := sdk.NewCoins()
+	for i := 0; i < len(red.Entries); i++ {
+		entry := red.Entries[i]
+		if entry.IsMature(ctxTime) {
+			// Remove the entry
+			red.RemoveEntry(int64(i))
+			i--
+
+			// Delete the index
+			err = k.DeleteRedelegationIndex(ctx, entry.UnbondingID)
+			if err != nil {
+				return nil, err
+			}
+
+			balances = balances.Add(entry.InitialBalance...)
+		}
+	}
+
+	// Set the redelegation or remove it if there are no more entries
+	if len(red.Entries) == 0 {
+		err = k.RemoveRedelegation(ctx, red)
+	} else {
+		err = k.SetRedelegation(ctx, red)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// DeleteRedelegationIndex removes a mapping from the redelegation entry ID to the redelegation
+func (k *Keeper) DeleteRedelegationIndex(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetRedelegationIndexKey(id))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+// --- Redelegation queue operations
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetRedelegationQueueTimeSlice gets a specific redelegation queue timeslice. A timeslice
+// is a slice of RTData corresponding to redelegations that expire at a certain time.
+func (k *Keeper) GetRedelegationQueueTimeSlice(ctx context.Context, timestamp time.Time) ([]types.RTData, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.GetRedelegationTimeKey(timestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return []types.RTData{}, nil
+	}
+
+	data := types.RTDataList{}
+	k.cdc.MustUnmarshal(bz, &data)
+
+	return data.Data, nil
+}
+
+// SetRedelegationQueueTimeSlice sets a specific redelegation queue timeslice.
+func (k *Keeper) SetRedelegationQueueTimeSlice(ctx context.Context, timestamp time.Time, keys []types.RTData) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&types.RTDataList{Data: keys})
+	return store.Set(types.GetRedelegationTimeKey(timestamp), bz)
+}
+
+// InsertRedelegationQueue inserts a redelegation to the appropriate timeslice
+// in the redelegation queue.
+func (k *Keeper) InsertRedelegationQueue(ctx context.Context, red types.Redelegation, completionTime time.Time) error {
+	data := types.RTData{
+		DelegatorAddress: red.DelegatorAddress,
+		SrcType:          red.SrcType,
+		SrcTargetID:      red.SrcTargetID,
+		DstType:          red.DstType,
+		DstTargetID:      red.DstTargetID,
+	}
+
+	timeSlice, err := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+	if err != nil {
+		return err
+	}
+
+	timeSlice = append(timeSlice, data)
+	return k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
+}
+
+// RedelegationQueueIterator returns all the redelegation queue timeslices from time 0 until endTime.
+func (k *Keeper) RedelegationQueueIterator(ctx context.Context, endTime time.Time) (storetypes.Iterator, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Iterator(types.RedelegationQueueKey, storetypes.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+}
+
+// DequeueAllMatureRedelegationQueue returns a concatenated list of all the timeslices inclusively previous to
+// currTime, and deletes the timeslices from the queue.
+func (k *Keeper) DequeueAllMatureRedelegationQueue(ctx context.Context, currTime time.Time) (matureRedelegations []types.RTData, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	// Get an iterator for all timeslices from time 0 until the current BlockHeader time
+	iterator, err := k.RedelegationQueueIterator(ctx, currTime)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timeslice := types.RTDataList{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+
+		matureRedelegations = append(matureRedelegations, timeslice.Data...)
+
+		err = store.Delete(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return matureRedelegations, nil
+}
@@ -0,0 +1,337 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// storeRedelegationTargets stores two operators, two services and a pool that can be used
+// as source and destination targets of a redelegation
+func (suite *KeeperTestSuite) storeRedelegationTargets(ctx sdk.Context) {
+	err := suite.k.SetParams(ctx, types.NewParams(7*24*time.Hour, nil, types.DefaultRestakingCap, 2, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge))
+	suite.Require().NoError(err)
+
+	for _, id := range []uint32{1, 2} {
+		err = suite.ok.SaveOperator(ctx, operatorstypes.Operator{
+			ID:      id,
+			Status:  operatorstypes.OPERATOR_STATUS_ACTIVE,
+			Address: operatorstypes.GetOperatorAddress(id).String(),
+		})
+		suite.Require().NoError(err)
+
+		err = suite.sk.SaveService(ctx, servicestypes.Service{
+			ID:      id,
+			Status:  servicestypes.SERVICE_STATUS_ACTIVE,
+			Address: servicestypes.GetServiceAddress(id).String(),
+		})
+		suite.Require().NoError(err)
+	}
+
+	err = suite.pk.SavePool(ctx, poolstypes.NewPool(1, "umilk"))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Redelegate() {
+	delegator := "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		redelegate       func(ctx sdk.Context) (time.Time, error)
+		shouldErr        bool
+		expErr           error
+		expUnbondingTime time.Duration
+		check            func(ctx sdk.Context)
+	}{
+		{
+			name: "redelegating to the same operator returns error",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromOperator(ctx, 1, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+			},
+			shouldErr: true,
+			expErr:    types.ErrSelfRedelegation,
+		},
+		{
+			name: "redelegating without a delegation returns error",
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+			},
+			shouldErr: true,
+			expErr:    types.ErrDelegationNotFound,
+		},
+		{
+			name: "transitive redelegation returns error",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+
+				_, err = suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromOperator(ctx, 2, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+			},
+			shouldErr: true,
+			expErr:    types.ErrTransitiveRedelegation,
+		},
+		{
+			name: "redelegation with max entries returns error",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+
+				_, err = suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)), delegator)
+				suite.Require().NoError(err)
+
+				_, err = suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)), delegator)
+			},
+			shouldErr: true,
+			expErr:    types.ErrMaxRedelegationEntries,
+		},
+		{
+			name: "operator redelegation is performed properly",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 40)), delegator)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/1/umilk", 60)), delegation.Shares)
+
+				delegation, found, err = suite.k.GetOperatorDelegation(ctx, 2, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/2/umilk", 40)), delegation.Shares)
+
+				// Make sure the tokens have been moved between the operators
+				balance := suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(1), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 60), balance)
+				balance = suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(2), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 40), balance)
+
+				// Make sure the redelegation has been stored
+				red, found, err := suite.k.GetRedelegation(ctx, delegator, types.DELEGATION_TYPE_OPERATOR, 1, types.DELEGATION_TYPE_OPERATOR, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(red.Entries, 1)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 40)), red.Entries[0].InitialBalance)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/2/umilk", 40)), red.Entries[0].SharesDst)
+				suite.Require().Equal(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), red.Entries[0].CompletionTime)
+
+				// Make sure the redelegation has been inserted inside the queue
+				timeSlice, err := suite.k.GetRedelegationQueueTimeSlice(ctx, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC))
+				suite.Require().NoError(err)
+				suite.Require().Len(timeSlice, 1)
+
+				// Make sure the redelegation entry has been indexed inside the redelegations index
+				store := suite.storeService.OpenKVStore(ctx)
+				redelegationKey, err := store.Get(types.GetRedelegationIndexKey(red.Entries[0].UnbondingID))
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.UserRedelegationStoreKey(delegator, types.DELEGATION_TYPE_OPERATOR, 1, types.DELEGATION_TYPE_OPERATOR, 2), redelegationKey)
+
+				unbondingKey, err := store.Get(types.GetUnbondingIndexKey(red.Entries[0].UnbondingID))
+				suite.Require().NoError(err)
+				suite.Require().Nil(unbondingKey)
+			},
+		},
+		{
+			name: "service redelegation is performed properly",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToService(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromService(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetServiceDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				delegation, found, err := suite.k.GetServiceDelegation(ctx, 2, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("service/2/umilk", 100)), delegation.Shares)
+
+				red, found, err := suite.k.GetRedelegation(ctx, delegator, types.DELEGATION_TYPE_SERVICE, 1, types.DELEGATION_TYPE_SERVICE, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(red.Entries, 1)
+			},
+		},
+		{
+			name: "service redelegation uses the source service unbonding time",
+			store: func(ctx sdk.Context) {
+				serviceParams := servicestypes.DefaultServiceParams()
+				serviceParams.UnbondingTime = 14 * 24 * time.Hour
+				err := suite.sk.SetServiceParams(ctx, 1, serviceParams)
+				suite.Require().NoError(err)
+
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToService(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromService(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+			},
+			shouldErr:        false,
+			expUnbondingTime: 14 * 24 * time.Hour,
+			check: func(ctx sdk.Context) {
+				red, found, err := suite.k.GetRedelegation(ctx, delegator, types.DELEGATION_TYPE_SERVICE, 1, types.DELEGATION_TYPE_SERVICE, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(red.Entries, 1)
+				suite.Require().Equal(ctx.BlockTime().Add(14*24*time.Hour), red.Entries[0].CompletionTime)
+			},
+		},
+		{
+			name: "pool redelegation to an operator is performed properly",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromPool(ctx, sdk.NewInt64Coin("umilk", 30), delegator, types.DELEGATION_TYPE_OPERATOR, 1)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				pool, err := suite.pk.GetPool(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkmath.NewInt(70), pool.Tokens)
+
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/1/umilk", 30)), delegation.Shares)
+
+				redelegations, err := suite.k.GetSrcTargetRedelegations(ctx, types.DELEGATION_TYPE_POOL, 1)
+				suite.Require().NoError(err)
+				suite.Require().Len(redelegations, 1)
+				suite.Require().Equal(delegator, redelegations[0].DelegatorAddress)
+			},
+		},
+		{
+			name: "pool redelegation to a pool returns error",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), delegator)
+				suite.Require().NoError(err)
+			},
+			redelegate: func(ctx sdk.Context) (time.Time, error) {
+				return suite.k.RedelegateFromPool(ctx, sdk.NewInt64Coin("umilk", 30), delegator, types.DELEGATION_TYPE_POOL, 2)
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			suite.storeRedelegationTargets(ctx)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			completionTime, err := tc.redelegate(ctx)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			} else {
+				suite.Require().NoError(err)
+
+				expUnbondingTime := 7 * 24 * time.Hour
+				if tc.expUnbondingTime != 0 {
+					expUnbondingTime = tc.expUnbondingTime
+				}
+				suite.Require().Equal(ctx.BlockTime().Add(expUnbondingTime), completionTime)
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_CompleteMatureRedelegations() {
+	delegator := "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.storeRedelegationTargets(ctx)
+
+	suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+	_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+	suite.Require().NoError(err)
+
+	_, err = suite.k.RedelegateFromOperator(ctx, 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+	suite.Require().NoError(err)
+
+	// Before the completion time the redelegation should still be there
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC))
+	err = suite.k.CompleteMatureUnbondingDelegations(ctx)
+	suite.Require().NoError(err)
+
+	_, found, err := suite.k.GetRedelegation(ctx, delegator, types.DELEGATION_TYPE_OPERATOR, 1, types.DELEGATION_TYPE_OPERATOR, 2)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// Redelegating back from the destination operator is not allowed yet
+	_, err = suite.k.RedelegateFromOperator(ctx, 2, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+	suite.Require().ErrorIs(err, types.ErrTransitiveRedelegation)
+
+	// After the completion time the redelegation should be removed
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC))
+	err = suite.k.CompleteMatureUnbondingDelegations(ctx)
+	suite.Require().NoError(err)
+
+	_, found, err = suite.k.GetRedelegation(ctx, delegator, types.DELEGATION_TYPE_OPERATOR, 1, types.DELEGATION_TYPE_OPERATOR, 2)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	redelegations, err := suite.k.GetSrcTargetRedelegations(ctx, types.DELEGATION_TYPE_OPERATOR, 1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(redelegations)
+
+	// The delegation to the destination operator should not be affected
+	delegation, found, err := suite.k.GetOperatorDelegation(ctx, 2, delegator)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/2/umilk", 50)), delegation.Shares)
+
+	// Redelegating back is now allowed
+	_, err = suite.k.RedelegateFromOperator(ctx, 2, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), delegator)
+	suite.Require().NoError(err)
+}
@@ -83,6 +83,13 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateRestakeRestakingCap() {
 					nil,
 					sdkmath.LegacyNewDec(5000),
 					types.DefaultMaxEntries,
+					"",
+					types.DefaultDowntimeJailDuration,
+					types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "",
+					types.DefaultOperatorServiceKeyRotationDelay,
+					types.DefaultMaxServiceUnbondingTime,
+					types.DefaultActiveOperatorsRefreshInterval,
+					types.DefaultMaxEvidenceAge,
 				))
 				suite.Require().NoError(err)
 			},
@@ -94,7 +101,7 @@ func (suite *KeeperTestSuite) TestKeeper_ValidateRestakeRestakingCap() {
 			store: func(ctx sdk.Context) {
 				// Set restaking cap
 				err := suite.k.SetParams(ctx, types.NewParams(
-					types.DefaultUnbondingTime, nil, sdkmath.LegacyNewDec(5000), types.DefaultMaxEntries),
+					types.DefaultUnbondingTime, nil, sdkmath.LegacyNewDec(5000), types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 				)
 				suite.Require().NoError(err)
 			},
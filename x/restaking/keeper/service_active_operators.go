@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// GetOperatorSecuredValue returns the USD value of the stake (self plus delegated)
+// that the given operator is securing
+func (k *Keeper) GetOperatorSecuredValue(ctx context.Context, operator operatorstypes.Operator) (math.LegacyDec, error) {
+	return k.GetCoinsValue(ctx, operator.Tokens)
+}
+
+// getServiceMinOperatorStakeUSD returns the minimum USD value of the stake
+// that operators must have in order to secure the service with the given params
+func getServiceMinOperatorStakeUSD(params servicestypes.ServiceParams) math.LegacyDec {
+	if params.MinOperatorStakeUSD.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return params.MinOperatorStakeUSD
+}
+
+// HasOperatorEnoughStakeForService returns true if the given operator's secured
+// value is greater than or equal to the minimum required by the given service
+func (k *Keeper) HasOperatorEnoughStakeForService(ctx context.Context, serviceID uint32, operator operatorstypes.Operator) (bool, error) {
+	params, err := k.servicesKeeper.GetServiceParams(ctx, serviceID)
+	if err != nil {
+		return false, err
+	}
+
+	minStake := getServiceMinOperatorStakeUSD(params)
+	if !minStake.IsPositive() {
+		return true, nil
+	}
+
+	securedValue, err := k.GetOperatorSecuredValue(ctx, operator)
+	if err != nil {
+		return false, err
+	}
+
+	return securedValue.GTE(minStake), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetServiceActiveOperators returns the IDs of the operators that are part of
+// the active operators set of the given service
+func (k *Keeper) GetServiceActiveOperators(ctx context.Context, serviceID uint32) ([]uint32, error) {
+	iterator, err := k.serviceActiveOperators.Iterate(ctx, collections.NewPrefixedPairRange[uint32, uint32](serviceID))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var operators []uint32
+	for ; iterator.Valid(); iterator.Next() {
+		serviceOperatorPair, err := iterator.Key()
+		if err != nil {
+			return nil, err
+		}
+		operators = append(operators, serviceOperatorPair.K2())
+	}
+
+	return operators, nil
+}
+
+// IsOperatorInServiceActiveSet returns true if the given operator is part of
+// the active operators set of the given service
+func (k *Keeper) IsOperatorInServiceActiveSet(ctx context.Context, serviceID uint32, operatorID uint32) (bool, error) {
+	return k.serviceActiveOperators.Has(ctx, collections.Join(serviceID, operatorID))
+}
+
+// SetServiceActiveOperators replaces the active operators set of the given
+// service with the given operators
+func (k *Keeper) SetServiceActiveOperators(ctx context.Context, serviceID uint32, operatorIDs []uint32) error {
+	err := k.serviceActiveOperators.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint32](serviceID))
+	if err != nil {
+		return err
+	}
+
+	for _, operatorID := range operatorIDs {
+		err = k.serviceActiveOperators.Set(ctx, collections.Join(serviceID, operatorID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// operatorSecuredValue represents the USD value secured by an operator
+type operatorSecuredValue struct {
+	operatorID uint32
+	value      math.LegacyDec
+}
+
+// ComputeServiceActiveOperators returns the IDs of the operators that should be
+// part of the active operators set of the given service. Operators are ranked
+// by their secured value in descending order (ties are broken by the lowest ID),
+// those that are jailed from the service or do not reach the service minimum
+// stake are excluded and the
+// resulting list is capped to the service maximum number of active operators.
+func (k *Keeper) ComputeServiceActiveOperators(ctx context.Context, service servicestypes.Service) ([]uint32, error) {
+	if !service.IsActive() {
+		return nil, nil
+	}
+
+	params, err := k.servicesKeeper.GetServiceParams(ctx, service.ID)
+	if err != nil {
+		return nil, err
+	}
+	minStake := getServiceMinOperatorStakeUSD(params)
+
+	var candidates []operatorSecuredValue
+	err = k.IterateServiceValidatingOperators(ctx, service.ID, func(operatorID uint32) (stop bool, err error) {
+		operator, err := k.operatorsKeeper.GetOperator(ctx, operatorID)
+		if err != nil {
+			return true, err
+		}
+
+		if !operator.IsActive() {
+			return false, nil
+		}
+
+		jailed, err := k.IsOperatorJailedFromService(ctx, service.ID, operatorID)
+		if err != nil {
+			return true, err
+		}
+
+		if jailed {
+			return false, nil
+		}
+
+		value, err := k.GetOperatorSecuredValue(ctx, operator)
+		if err != nil {
+			return true, err
+		}
+
+		if minStake.IsPositive() && value.LT(minStake) {
+			return false, nil
+		}
+
+		candidates = append(candidates, operatorSecuredValue{operatorID: operatorID, value: value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].value.Equal(candidates[j].value) {
+			return candidates[i].value.GT(candidates[j].value)
+		}
+		return candidates[i].operatorID < candidates[j].operatorID
+	})
+
+	if params.MaxActiveOperators > 0 && uint32(len(candidates)) > params.MaxActiveOperators {
+		candidates = candidates[:params.MaxActiveOperators]
+	}
+
+	operatorIDs := make([]uint32, len(candidates))
+	for i, candidate := range candidates {
+		operatorIDs[i] = candidate.operatorID
+	}
+
+	return operatorIDs, nil
+}
+
+// MarkServiceDirty marks the given service so that its active operators set
+// is recomputed at the end of the current block
+func (k *Keeper) MarkServiceDirty(ctx context.Context, serviceID uint32) error {
+	return k.dirtyServices.Set(ctx, serviceID)
+}
+
+// markOperatorServicesDirty marks all the services joined by the given operator
+// so that their active operators sets are recomputed at the end of the current block
+func (k *Keeper) markOperatorServicesDirty(ctx context.Context, operatorID uint32) error {
+	iterator, err := k.operatorJoinedServices.Iterate(ctx, collections.NewPrefixedPairRange[uint32, uint32](operatorID))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operatorServicePair, err := iterator.Key()
+		if err != nil {
+			return err
+		}
+
+		err = k.MarkServiceDirty(ctx, operatorServicePair.K2())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateServicesActiveOperators computes and stores the active operators set of
+// the services marked as dirty, emitting an event for each service whose set
+// has changed. Every ActiveOperatorsRefreshInterval blocks the sets of all the
+// services are recomputed instead
+func (k *Keeper) UpdateServicesActiveOperators(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if uint64(sdkCtx.BlockHeight())%params.ActiveOperatorsRefreshInterval == 0 {
+		err = k.servicesKeeper.IterateServices(ctx, func(service servicestypes.Service) (bool, error) {
+			err := k.updateServiceActiveOperators(ctx, service)
+			return err != nil, err
+		})
+	} else {
+		err = k.dirtyServices.Walk(ctx, nil, func(serviceID uint32) (bool, error) {
+			service, err := k.servicesKeeper.GetService(ctx, serviceID)
+			if err != nil {
+				return true, err
+			}
+
+			err = k.updateServiceActiveOperators(ctx, service)
+			return err != nil, err
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.dirtyServices.Clear(ctx, nil)
+}
+
+// updateServiceActiveOperators computes and stores the active operators set of
+// the given service, emitting an event if the set has changed
+func (k *Keeper) updateServiceActiveOperators(ctx context.Context, service servicestypes.Service) error {
+	operatorIDs, err := k.ComputeServiceActiveOperators(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	currentOperatorIDs, err := k.GetServiceActiveOperators(ctx, service.ID)
+	if err != nil {
+		return err
+	}
+
+	// Skip the update if the active set has not changed. The stored set is
+	// ordered by operator ID, so we compare it against a sorted copy
+	sortedOperatorIDs := slices.Clone(operatorIDs)
+	slices.Sort(sortedOperatorIDs)
+	if slices.Equal(currentOperatorIDs, sortedOperatorIDs) {
+		return nil
+	}
+
+	err = k.SetServiceActiveOperators(ctx, service.ID, operatorIDs)
+	if err != nil {
+		return err
+	}
+
+	operatorIDsStr := make([]string, len(operatorIDs))
+	for i, operatorID := range operatorIDs {
+		operatorIDsStr[i] = fmt.Sprint(operatorID)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeServiceActiveOperators,
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(service.ID)),
+			sdk.NewAttribute(types.AttributeKeyOperatorIDs, strings.Join(operatorIDsStr, ",")),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// storeActiveOperatorsCandidates stores an active service with ID 1 and the
+// given operators, making each of them join the service
+func (suite *KeeperTestSuite) storeActiveOperatorsCandidates(ctx sdk.Context, operators []operatorstypes.Operator) {
+	suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+	err := suite.sk.SaveService(ctx, servicestypes.NewService(
+		1,
+		servicestypes.SERVICE_STATUS_ACTIVE,
+		"MilkyWay",
+		"MilkyWay is a restaking platform",
+		"https://milkyway.com",
+		"https://milkyway.com/logo.png",
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		false,
+	))
+	suite.Require().NoError(err)
+
+	for _, operator := range operators {
+		err = suite.ok.SaveOperator(ctx, operator)
+		suite.Require().NoError(err)
+
+		err = suite.k.AddServiceToOperatorJoinedServices(ctx, operator.ID, 1)
+		suite.Require().NoError(err)
+	}
+}
+
+func newOperatorWithTokens(id uint32, status operatorstypes.OperatorStatus, tokens string) operatorstypes.Operator {
+	operator := operatorstypes.NewOperator(
+		id,
+		status,
+		"MilkyWay Operator",
+		"https://milkyway.com",
+		"https://milkyway.com/picture",
+		"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+	)
+	operator.Tokens = utils.MustParseCoins(tokens)
+	return operator
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasOperatorEnoughStakeForService() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		operator  operatorstypes.Operator
+		expResult bool
+	}{
+		{
+			name:      "service without min stake allows any operator",
+			operator:  newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, ""),
+			expResult: true,
+		},
+		{
+			name: "operator with stake lower than the min returns false",
+			store: func(ctx sdk.Context) {
+				suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyNewDec(100), 0, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			operator:  newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "49_000000umilk"),
+			expResult: false,
+		},
+		{
+			name: "operator with stake equal to the min returns true",
+			store: func(ctx sdk.Context) {
+				suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyNewDec(100), 0, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			operator:  newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+			expResult: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			result, err := suite.k.HasOperatorEnoughStakeForService(ctx, 1, tc.operator)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UpdateServicesActiveOperators() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		blockHeight int64
+		shouldErr   bool
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "inactive service has no active operators",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "100_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1})
+				suite.Require().NoError(err)
+
+				err = suite.sk.DeactivateService(ctx, 1)
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceActiveOperators,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyOperatorIDs, ""),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Empty(operators)
+			},
+		},
+		{
+			name: "operators are ranked by secured value and capped to the max",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+					newOperatorWithTokens(3, operatorstypes.OPERATOR_STATUS_ACTIVE, "20_000000umilk"),
+					newOperatorWithTokens(4, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 3, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceActiveOperators,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyOperatorIDs, "2,4,3"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{2, 3, 4}, operators)
+			},
+		},
+		{
+			name: "operators below the min stake and inactive operators are excluded",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+					newOperatorWithTokens(3, operatorstypes.OPERATOR_STATUS_INACTIVE, "100_000000umilk"),
+				})
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyNewDec(100), 0, 0, 0, "", 0))
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceActiveOperators,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyOperatorIDs, "2"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{2}, operators)
+			},
+		},
+		{
+			name: "operators jailed from the service are excluded",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+
+				err = suite.k.JailOperator(ctx, 1, 2, time.Hour)
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceActiveOperators,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyOperatorIDs, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{1}, operators)
+			},
+		},
+		{
+			name: "unchanged active set does not emit events",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			check: func(ctx sdk.Context) {
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeServiceActiveOperators, event.Type)
+				}
+
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{1, 2}, operators)
+			},
+		},
+		{
+			name: "services not marked as dirty are not updated",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+				})
+
+				serviceParams := servicestypes.DefaultServiceParams()
+				serviceParams.MaxActiveOperators = 1
+				err := suite.sk.SetServiceParams(ctx, 1, serviceParams)
+				suite.Require().NoError(err)
+
+				err = suite.k.UpdateServicesActiveOperators(ctx)
+				suite.Require().NoError(err)
+
+				// Change the operator tokens without going through the restaking
+				// module, so that the service is not marked as dirty
+				err = suite.ok.SaveOperator(ctx, newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "100_000000umilk"))
+				suite.Require().NoError(err)
+			},
+			blockHeight: 1,
+			shouldErr:   false,
+			check: func(ctx sdk.Context) {
+				for _, event := range ctx.EventManager().Events() {
+					suite.Require().NotEqual(types.EventTypeServiceActiveOperators, event.Type)
+				}
+
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{2}, operators)
+			},
+		},
+		{
+			name: "all services are updated at the refresh interval",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+				})
+
+				serviceParams := servicestypes.DefaultServiceParams()
+				serviceParams.MaxActiveOperators = 1
+				err := suite.sk.SetServiceParams(ctx, 1, serviceParams)
+				suite.Require().NoError(err)
+
+				err = suite.k.UpdateServicesActiveOperators(ctx.WithBlockHeight(1))
+				suite.Require().NoError(err)
+
+				// Change the operator tokens without going through the restaking
+				// module, so that the service is not marked as dirty
+				err = suite.ok.SaveOperator(ctx, newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "100_000000umilk"))
+				suite.Require().NoError(err)
+			},
+			blockHeight: types.DefaultActiveOperatorsRefreshInterval,
+			shouldErr:   false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceActiveOperators,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyOperatorIDs, "1"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				operators, err := suite.k.GetServiceActiveOperators(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint32{1}, operators)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.k.UpdateServicesActiveOperators(ctx)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SaveServiceSnapshot stores the given service snapshot
+func (k *Keeper) SaveServiceSnapshot(ctx context.Context, snapshot types.ServiceSnapshot) error {
+	return k.serviceSnapshots.Set(ctx, collections.Join(snapshot.ServiceID, snapshot.Epoch), snapshot)
+}
+
+// GetServiceSnapshot returns the snapshot of the given service taken at the
+// given epoch. If the snapshot does not exist, false is returned
+func (k *Keeper) GetServiceSnapshot(ctx context.Context, serviceID uint32, epoch uint64) (types.ServiceSnapshot, bool, error) {
+	snapshot, err := k.serviceSnapshots.Get(ctx, collections.Join(serviceID, epoch))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ServiceSnapshot{}, false, nil
+		}
+		return types.ServiceSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// GetLatestServiceSnapshot returns the latest snapshot taken for the given
+// service. If no snapshot exists, false is returned
+func (k *Keeper) GetLatestServiceSnapshot(ctx context.Context, serviceID uint32) (types.ServiceSnapshot, bool, error) {
+	iterator, err := k.serviceSnapshots.Iterate(ctx, collections.NewPrefixedPairRange[uint32, uint64](serviceID).Descending())
+	if err != nil {
+		return types.ServiceSnapshot{}, false, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ServiceSnapshot{}, false, nil
+	}
+
+	snapshot, err := iterator.Value()
+	if err != nil {
+		return types.ServiceSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// GetAllServicesSnapshots returns all the stored services snapshots
+func (k *Keeper) GetAllServicesSnapshots(ctx context.Context) ([]types.ServiceSnapshot, error) {
+	iterator, err := k.serviceSnapshots.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// DeleteServiceSnapshots removes all the snapshots of the given service
+func (k *Keeper) DeleteServiceSnapshots(ctx context.Context, serviceID uint32) error {
+	return k.serviceSnapshots.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint64](serviceID))
+}
+
+// pruneServiceSnapshots removes the snapshots of the given service taken at an
+// epoch lower than or equal to the given one
+func (k *Keeper) pruneServiceSnapshots(ctx context.Context, serviceID uint32, maxEpoch uint64) error {
+	return k.serviceSnapshots.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint64](serviceID).EndInclusive(maxEpoch))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SnapshotServiceValidatingSet takes a new snapshot of the validating set of the
+// given service, pruning the snapshots that exceed the given retention
+func (k *Keeper) SnapshotServiceValidatingSet(ctx context.Context, serviceID uint32, retention uint32) (types.ServiceSnapshot, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	validatingSet, err := k.GetServiceValidatingSet(ctx, serviceID)
+	if err != nil {
+		return types.ServiceSnapshot{}, err
+	}
+
+	// Get the next epoch
+	epoch := uint64(1)
+	latest, found, err := k.GetLatestServiceSnapshot(ctx, serviceID)
+	if err != nil {
+		return types.ServiceSnapshot{}, err
+	}
+	if found {
+		epoch = latest.Epoch + 1
+	}
+
+	snapshot := types.NewServiceSnapshot(serviceID, epoch, sdkCtx.BlockHeight(), sdkCtx.BlockTime(), validatingSet)
+	err = k.SaveServiceSnapshot(ctx, snapshot)
+	if err != nil {
+		return types.ServiceSnapshot{}, err
+	}
+
+	// Prune the old snapshots, keeping only the latest ones
+	if uint64(retention) < epoch {
+		err = k.pruneServiceSnapshots(ctx, serviceID, epoch-uint64(retention))
+		if err != nil {
+			return types.ServiceSnapshot{}, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// SnapshotServicesValidatingSets takes a snapshot of the validating set of all
+// the active services whose snapshot interval is reached at the current height
+func (k *Keeper) SnapshotServicesValidatingSets(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return k.servicesKeeper.IterateServices(ctx, func(service servicestypes.Service) (bool, error) {
+		if !service.IsActive() {
+			return false, nil
+		}
+
+		params, err := k.servicesKeeper.GetServiceParams(ctx, service.ID)
+		if err != nil {
+			return true, err
+		}
+
+		if params.SnapshotInterval == 0 || uint64(sdkCtx.BlockHeight())%params.SnapshotInterval != 0 {
+			return false, nil
+		}
+
+		snapshot, err := k.SnapshotServiceValidatingSet(ctx, service.ID, params.SnapshotRetention)
+		if err != nil {
+			return true, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeServiceSnapshot,
+				sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(service.ID)),
+				sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(snapshot.Epoch)),
+				sdk.NewAttribute(types.AttributeKeyTotalPower, fmt.Sprint(snapshot.TotalPower)),
+				sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(snapshot.MerkleRoot)),
+			),
+		)
+
+		return false, nil
+	})
+}
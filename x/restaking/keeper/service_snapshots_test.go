@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_ServiceSnapshotStoreKey() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	snapshot := types.NewServiceSnapshot(1, 2, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	err := suite.k.SaveServiceSnapshot(ctx, snapshot)
+	suite.Require().NoError(err)
+
+	// Make sure the snapshot can be read using the raw store key, which is
+	// the one used to query it with a Merkle proof
+	store := suite.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ServiceSnapshotStoreKey(1, 2))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(bz)
+
+	var stored types.ServiceSnapshot
+	suite.cdc.MustUnmarshal(bz, &stored)
+	suite.Require().Equal(snapshot, stored)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SnapshotServiceValidatingSet() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		retention uint32
+		expEpoch  uint64
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "first snapshot is taken at epoch 1",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+			},
+			retention: 2,
+			expEpoch:  1,
+			check: func(ctx sdk.Context) {
+				snapshot, found, err := suite.k.GetServiceSnapshot(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				operators := []types.ValidatingOperator{
+					types.NewValidatingOperator(2, utils.MustParseCoins("30_000000umilk"), utils.MustParseDec("60")),
+					types.NewValidatingOperator(1, utils.MustParseCoins("10_000000umilk"), utils.MustParseDec("20")),
+				}
+				suite.Require().Equal(operators, snapshot.Operators)
+				suite.Require().Equal(int64(80), snapshot.TotalPower)
+				suite.Require().Equal(types.ComputeValidatingSetMerkleRoot(operators), snapshot.MerkleRoot)
+				suite.Require().Equal(ctx.BlockHeight(), snapshot.Height)
+			},
+		},
+		{
+			name: "old snapshots are pruned based on the retention",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				for epoch := uint64(1); epoch <= 3; epoch++ {
+					err := suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(1, epoch, int64(epoch), ctx.BlockTime(), nil))
+					suite.Require().NoError(err)
+				}
+
+				// Store a snapshot for another service to make sure it's not pruned
+				err := suite.k.SaveServiceSnapshot(ctx, types.NewServiceSnapshot(2, 1, 1, ctx.BlockTime(), nil))
+				suite.Require().NoError(err)
+			},
+			retention: 2,
+			expEpoch:  4,
+			check: func(ctx sdk.Context) {
+				snapshots, err := suite.k.GetAllServicesSnapshots(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Len(snapshots, 3)
+
+				suite.Require().Equal(uint32(1), snapshots[0].ServiceID)
+				suite.Require().Equal(uint64(3), snapshots[0].Epoch)
+				suite.Require().Equal(uint32(1), snapshots[1].ServiceID)
+				suite.Require().Equal(uint64(4), snapshots[1].Epoch)
+				suite.Require().Equal(uint32(2), snapshots[2].ServiceID)
+				suite.Require().Equal(uint64(1), snapshots[2].Epoch)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			snapshot, err := suite.k.SnapshotServiceValidatingSet(ctx, 1, tc.retention)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expEpoch, snapshot.Epoch)
+
+			latest, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(snapshot.Epoch, latest.Epoch)
+			suite.Require().Equal(snapshot.MerkleRoot, latest.MerkleRoot)
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SnapshotServicesValidatingSets() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		height    int64
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "no snapshot is taken if the interval is not set",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+			},
+			height: 10,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "no snapshot is taken if the interval is not reached",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 10, 2, "", 0))
+				suite.Require().NoError(err)
+			},
+			height: 15,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "no snapshot is taken for inactive services",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, nil)
+
+				err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 10, 2, "", 0))
+				suite.Require().NoError(err)
+
+				err = suite.sk.DeactivateService(ctx, 1)
+				suite.Require().NoError(err)
+			},
+			height: 20,
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+		},
+		{
+			name: "snapshot is taken when the interval is reached",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1})
+				suite.Require().NoError(err)
+
+				err = suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 10, 2, "", 0))
+				suite.Require().NoError(err)
+			},
+			height: 20,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeServiceSnapshot,
+					sdk.NewAttribute(servicestypes.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyEpoch, "1"),
+					sdk.NewAttribute(types.AttributeKeyTotalPower, "20"),
+					sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(types.ComputeValidatingSetMerkleRoot(
+						[]types.ValidatingOperator{
+							types.NewValidatingOperator(1, utils.MustParseCoins("10_000000umilk"), utils.MustParseDec("20")),
+						},
+					))),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				snapshot, found, err := suite.k.GetLatestServiceSnapshot(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), snapshot.Epoch)
+				suite.Require().Equal(int64(20), snapshot.Height)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			ctx = ctx.WithBlockHeight(tc.height).WithEventManager(sdk.NewEventManager())
+			err := suite.k.SnapshotServicesValidatingSets(ctx)
+			suite.Require().NoError(err)
+
+			for _, event := range tc.expEvents {
+				suite.Require().Contains(ctx.EventManager().Events(), event)
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
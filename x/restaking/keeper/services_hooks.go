@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
 
@@ -84,17 +86,54 @@ func (h *ServicesHooks) BeforeServiceDeleted(ctx context.Context, serviceID uint
 		}
 	}
 
-	return nil
+	// Remove the service active operators set
+	err = h.serviceActiveOperators.Clear(ctx, collections.NewPrefixedPairRange[uint32, uint32](serviceID))
+	if err != nil {
+		return err
+	}
+
+	// Make sure the active operators set is not recomputed for the deleted service
+	err = h.dirtyServices.Remove(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the service snapshots
+	err = h.DeleteServiceSnapshots(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the operators jail records
+	err = h.DeleteServiceJailRecords(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the submitted evidences
+	err = h.DeleteServiceSubmittedEvidences(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the pending slashes
+	err = h.DeleteServicePendingSlashes(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the keys registered by the operators for the service
+	return h.DeleteServiceOperatorsKeys(ctx, serviceID)
 }
 
 // AfterServiceDeactivated implements types.ServicesHooks.
 func (h *ServicesHooks) AfterServiceDeactivated(ctx context.Context, serviceID uint32) error {
-	return nil
+	return h.MarkServiceDirty(ctx, serviceID)
 }
 
 // AfterServiceActivated implements types.ServicesHooks.
 func (h *ServicesHooks) AfterServiceActivated(ctx context.Context, serviceID uint32) error {
-	return nil
+	return h.MarkServiceDirty(ctx, serviceID)
 }
 
 // AfterServiceCreated implements types.ServicesHooks.
@@ -106,3 +145,25 @@ func (h *ServicesHooks) AfterServiceCreated(ctx context.Context, serviceID uint3
 func (h *ServicesHooks) AfterServiceAccreditationModified(ctx context.Context, serviceID uint32) error {
 	return nil
 }
+
+// AfterServiceParamsModified implements types.ServicesHooks.
+func (h *ServicesHooks) AfterServiceParamsModified(ctx context.Context, serviceID uint32) error {
+	serviceParams, err := h.servicesKeeper.GetServiceParams(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	params, err := h.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the delegations securing the service can't be unbonded before
+	// the pending slashes are executed
+	if serviceParams.UnbondingTime > 0 && serviceParams.UnbondingTime < params.MinServiceUnbondingTime() {
+		return errors.Wrapf(types.ErrInvalidServiceUnbondingTime,
+			"service unbonding time cannot be lower than %s: %s", params.MinServiceUnbondingTime(), serviceParams.UnbondingTime)
+	}
+
+	return h.MarkServiceDirty(ctx, serviceID)
+}
@@ -1,7 +1,13 @@
 package keeper_test
 
 import (
+	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
 
 func (suite *KeeperTestSuite) TestServicesHooks_BeforeServiceDeleted() {
@@ -76,6 +82,49 @@ func (suite *KeeperTestSuite) TestServicesHooks_BeforeServiceDeleted() {
 			serviceID: 1,
 			shouldErr: false,
 		},
+		{
+			name: "operators keys for the service are removed",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+				err = suite.k.SavePendingOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{2}, 32), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+
+				// Add some other to ensure we don't eliminate the wrong data
+				err = suite.k.SaveOperatorServiceKey(ctx, types.NewOperatorServiceKey(
+					2, 2, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{3}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				))
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				_, found, err := suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				_, found, err = suite.k.GetPendingOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				// Check that we didn't remove other data
+				_, found, err = suite.k.GetOperatorServiceKey(ctx, 2, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				// Make sure the removed pending key is not activated
+				err = suite.k.ActivateMatureOperatorServiceKeys(ctx.WithBlockTime(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)))
+				suite.Require().NoError(err)
+
+				_, found, err = suite.k.GetOperatorServiceKey(ctx, 1, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			},
+			serviceID: 1,
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -100,3 +149,48 @@ func (suite *KeeperTestSuite) TestServicesHooks_BeforeServiceDeleted() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestServicesHooks_AfterServiceParamsModified() {
+	testCases := []struct {
+		name          string
+		unbondingTime time.Duration
+		shouldErr     bool
+	}{
+		{
+			name:          "unbonding time lower than the slash veto window returns error",
+			unbondingTime: time.Nanosecond,
+			shouldErr:     true,
+		},
+		{
+			name:          "default unbonding time returns no error",
+			unbondingTime: 0,
+			shouldErr:     false,
+		},
+		{
+			name:          "unbonding time equal to the slash veto window returns no error",
+			unbondingTime: types.DefaultSlashVetoWindow,
+			shouldErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+
+			serviceParams := servicestypes.DefaultServiceParams()
+			serviceParams.UnbondingTime = tc.unbondingTime
+			err := suite.sk.SetServiceParams(ctx, 1, serviceParams)
+			suite.Require().NoError(err)
+
+			hooks := suite.k.ServicesHooks()
+			err = hooks.AfterServiceParamsModified(ctx, 1)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidServiceUnbondingTime)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
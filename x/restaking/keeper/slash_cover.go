@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// SetSlashCover sets a function that is called for each delegator affected by a slash
+// so that the slashed amount can be covered.
+func (k *Keeper) SetSlashCover(cover types.SlashCoverFn) {
+	if k.slashCover != nil {
+		panic("slash cover already set")
+	}
+
+	k.slashCover = cover
+}
+
+// coverSlash calls the slash cover function, if any, for the given delegator.
+func (k *Keeper) coverSlash(ctx context.Context, serviceID uint32, operatorID uint32, delegator string, slashedAmount sdk.Coins) error {
+	if k.slashCover == nil || slashedAmount.IsZero() {
+		return nil
+	}
+
+	return k.slashCover(ctx, serviceID, operatorID, delegator, slashedAmount)
+}
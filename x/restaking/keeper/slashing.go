@@ -0,0 +1,504 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// SlashOperator slashes the given fraction of the security that the operator having the given ID
+// is providing to the service having the given ID. The slash is applied to:
+//   - the tokens delegated to the operator;
+//   - the tokens delegated to the pools from which the service is borrowing security,
+//     limited to the delegators that trust the service with such pools. Only the share
+//     of the borrowed security attributed to the operator is slashed, so the fraction
+//     applied to the pools is reduced proportionally;
+//   - the balances of the unbonding delegations of the above targets that have been
+//     created at or after the infraction height and that are not yet mature;
+//   - the delegations that have been created by redelegating from the above targets
+//     at or after the infraction height and that are not yet mature.
+//
+// The slashed funds are either sent to the slashed funds recipient set inside the
+// params, or burned if no recipient has been set. After the slash, the slash cover function
+// (if set) is called for each delegator whose tokens have been reduced. The total slashed
+// amount is returned.
+//
+// The operator must have joined the service, otherwise an error is returned.
+func (k *Keeper) SlashOperator(
+	ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64,
+) (sdk.Coins, error) {
+	joined, err := k.HasOperatorJoinedService(ctx, operatorID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !joined {
+		return nil, errors.Wrapf(types.ErrServiceNotJoinedByOperator, "operator %d has not joined service %d", operatorID, serviceID)
+	}
+
+	return k.slashOperator(ctx, serviceID, operatorID, fraction, infractionHeight)
+}
+
+// slashOperator performs the slashing described in SlashOperator without checking whether the
+// operator is still part of the service. This is used when executing the pending slashes, so that
+// an operator cannot avoid being slashed by leaving the service during the veto window.
+func (k *Keeper) slashOperator(
+	ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64,
+) (sdk.Coins, error) {
+	if fraction.IsNil() || !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return nil, errors.Wrapf(types.ErrInvalidSlashFraction, "fraction must be between 0 (exclusive) and 1 (inclusive): %s", fraction)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if infractionHeight < 0 || infractionHeight > sdkCtx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidInfractionHeight, "infraction height %d is not valid at height %d", infractionHeight, sdkCtx.BlockHeight())
+	}
+
+	// Make sure the service and the operator exist
+	_, err := k.servicesKeeper.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := k.operatorsKeeper.GetOperator(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.BeforeOperatorSlashed(ctx, serviceID, operatorID, fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the share of the borrowed security attributed to the operator before
+	// slashing it, as its value changes after its delegations are slashed
+	borrowedShare, err := k.getOperatorBorrowedSecurityShare(ctx, serviceID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep track of the amounts slashed from each delegator
+	delegatorsSlashes := newDelegatorsSlashedAmounts()
+
+	// Slash the operator delegations
+	slashedAmount, err := k.slashOperatorDelegations(ctx, operatorID, fraction, delegatorsSlashes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slash the operator unbonding delegations
+	slashedUnbondings, err := k.slashUnbondingDelegations(ctx, types.DELEGATION_TYPE_OPERATOR, operatorID, operator.Address, fraction, infractionHeight, nil, delegatorsSlashes)
+	if err != nil {
+		return nil, err
+	}
+	slashedAmount = slashedAmount.Add(slashedUnbondings...)
+
+	// Slash the operator redelegations
+	slashedRedelegations, err := k.slashRedelegations(ctx, types.DELEGATION_TYPE_OPERATOR, operatorID, fraction, infractionHeight, nil, delegatorsSlashes)
+	if err != nil {
+		return nil, err
+	}
+	slashedAmount = slashedAmount.Add(slashedRedelegations...)
+
+	// Slash the pools from which the service is borrowing security. Only the
+	// share of the borrowed security attributed to the operator is slashed
+	poolsFraction := fraction.Mul(borrowedShare)
+	if poolsFraction.IsPositive() {
+		poolsIDs, err := k.GetAllServiceSecuringPools(ctx, serviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, poolID := range poolsIDs {
+			slashedPoolAmount, err := k.slashPoolSecurity(ctx, serviceID, poolID, poolsFraction, infractionHeight, delegatorsSlashes)
+			if err != nil {
+				return nil, err
+			}
+			slashedAmount = slashedAmount.Add(slashedPoolAmount...)
+		}
+	}
+
+	// Allow the slashed amounts to be covered
+	for _, delegator := range delegatorsSlashes.delegators {
+		err = k.coverSlash(ctx, serviceID, operatorID, delegator, delegatorsSlashes.amounts[delegator])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.AfterOperatorSlashed(ctx, serviceID, operatorID, fraction, slashedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashOperator,
+			sdk.NewAttribute(servicestypes.AttributeKeyServiceID, fmt.Sprint(serviceID)),
+			sdk.NewAttribute(operatorstypes.AttributeKeyOperatorID, fmt.Sprint(operatorID)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprint(infractionHeight)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, slashedAmount.String()),
+		),
+	)
+
+	return slashedAmount, nil
+}
+
+// delegatorsSlashedAmounts keeps track of the amounts slashed from each delegator,
+// preserving the order in which the delegators have been slashed
+type delegatorsSlashedAmounts struct {
+	delegators []string
+	amounts    map[string]sdk.Coins
+}
+
+// newDelegatorsSlashedAmounts returns a new empty delegatorsSlashedAmounts instance
+func newDelegatorsSlashedAmounts() *delegatorsSlashedAmounts {
+	return &delegatorsSlashedAmounts{
+		amounts: make(map[string]sdk.Coins),
+	}
+}
+
+// add records that the given amount has been slashed from the provided delegator
+func (d *delegatorsSlashedAmounts) add(delegator string, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+
+	current, found := d.amounts[delegator]
+	if !found {
+		d.delegators = append(d.delegators, delegator)
+		current = sdk.NewCoins()
+	}
+	d.amounts[delegator] = current.Add(amount...)
+}
+
+// slashOperatorDelegations slashes the given fraction of all the delegations made to the operator
+// having the given ID, returning the total slashed amount
+func (k *Keeper) slashOperatorDelegations(
+	ctx context.Context, operatorID uint32, fraction math.LegacyDec, delegatorsSlashes *delegatorsSlashedAmounts,
+) (sdk.Coins, error) {
+	delegations, err := k.GetOperatorDelegations(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	slashedAmount := sdk.NewCoins()
+	for _, delegation := range delegations {
+		amount, err := k.slashDelegation(ctx, delegation, fraction)
+		if err != nil {
+			return nil, err
+		}
+		slashedAmount = slashedAmount.Add(amount...)
+		delegatorsSlashes.add(delegation.UserAddress, amount)
+	}
+
+	return slashedAmount, nil
+}
+
+// slashPoolSecurity slashes the given fraction of the security that the pool having the given ID
+// is lending to the service having the given ID. Only the delegations (as well as the unbonding
+// delegations and redelegations) of the users that trust the service with the pool are slashed.
+func (k *Keeper) slashPoolSecurity(
+	ctx context.Context,
+	serviceID uint32,
+	poolID uint32,
+	fraction math.LegacyDec,
+	infractionHeight int64,
+	delegatorsSlashes *delegatorsSlashedAmounts,
+) (sdk.Coins, error) {
+	pool, err := k.poolsKeeper.GetPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.GetPoolDelegations(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	// isTrusting tells whether the given user trusts the service with the pool
+	isTrusting := func(userAddress string) (bool, error) {
+		preferences, err := k.GetUserPreferences(ctx, userAddress)
+		if err != nil {
+			return false, err
+		}
+		return preferences.IsServiceTrustedWithPool(serviceID, poolID), nil
+	}
+
+	slashedAmount := sdk.NewCoins()
+	for _, delegation := range delegations {
+		trusting, err := isTrusting(delegation.UserAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !trusting {
+			continue
+		}
+
+		amount, err := k.slashDelegation(ctx, delegation, fraction)
+		if err != nil {
+			return nil, err
+		}
+		slashedAmount = slashedAmount.Add(amount...)
+		delegatorsSlashes.add(delegation.UserAddress, amount)
+	}
+
+	slashedUnbondings, err := k.slashUnbondingDelegations(ctx, types.DELEGATION_TYPE_POOL, poolID, pool.Address, fraction, infractionHeight, isTrusting, delegatorsSlashes)
+	if err != nil {
+		return nil, err
+	}
+
+	slashedRedelegations, err := k.slashRedelegations(ctx, types.DELEGATION_TYPE_POOL, poolID, fraction, infractionHeight, isTrusting, delegatorsSlashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashedAmount.Add(slashedUnbondings...).Add(slashedRedelegations...), nil
+}
+
+// slashDelegation removes the given fraction of shares from the provided delegation and
+// transfers the corresponding tokens out of the delegation target.
+// The shares are removed using the same path as undelegations so that the
+// hooks are called properly and the rewards of the delegator are kept consistent.
+func (k *Keeper) slashDelegation(ctx context.Context, delegation types.Delegation, fraction math.LegacyDec) (sdk.Coins, error) {
+	shares := delegation.Shares.MulDecTruncate(fraction)
+	if shares.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	// Get the target again as its tokens change after each slash
+	target, err := k.GetDelegationTargetFromDelegation(ctx, delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := k.getDelegationHooks(delegation.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Unbond(ctx, types.UndelegationData{
+		Delegator: delegation.UserAddress,
+		Target:    target,
+		Hooks:     hooks,
+		Shares:    shares,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TransferSlashedFunds(ctx, target.GetAddress(), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// slashUnbondingDelegations slashes the given fraction of the balance of all the unbonding delegation
+// entries of the given type and target that have been created at or after the infraction height and
+// that are not yet mature. If a filter is provided, only the unbonding delegations whose delegator
+// satisfies it are slashed.
+func (k *Keeper) slashUnbondingDelegations(
+	ctx context.Context,
+	ubdType types.DelegationType,
+	targetID uint32,
+	targetAddress string,
+	fraction math.LegacyDec,
+	infractionHeight int64,
+	filter func(delegator string) (bool, error),
+	delegatorsSlashes *delegatorsSlashedAmounts,
+) (sdk.Coins, error) {
+	unbondingDelegations, err := k.GetTargetUnbondingDelegations(ctx, ubdType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slashedAmount := sdk.NewCoins()
+	for _, ubd := range unbondingDelegations {
+		if filter != nil {
+			include, err := filter(ubd.DelegatorAddress)
+			if err != nil {
+				return nil, err
+			}
+			if !include {
+				continue
+			}
+		}
+
+		ubdSlashedAmount := sdk.NewCoins()
+		for i, entry := range ubd.Entries {
+			// Only slash the entries that have been created after the infraction
+			// and that have not been completed yet
+			if entry.CreationHeight < infractionHeight || entry.IsMature(sdkCtx.BlockTime()) {
+				continue
+			}
+
+			amount, _ := sdk.NewDecCoinsFromCoins(entry.Balance...).MulDecTruncate(fraction).TruncateDecimal()
+			if amount.IsZero() {
+				continue
+			}
+
+			ubd.Entries[i].Balance = entry.Balance.Sub(amount...)
+			ubdSlashedAmount = ubdSlashedAmount.Add(amount...)
+		}
+
+		if ubdSlashedAmount.IsZero() {
+			continue
+		}
+
+		_, err = k.SetUnbondingDelegation(ctx, ubd)
+		if err != nil {
+			return nil, err
+		}
+
+		slashedAmount = slashedAmount.Add(ubdSlashedAmount...)
+		delegatorsSlashes.add(ubd.DelegatorAddress, ubdSlashedAmount)
+	}
+
+	err = k.TransferSlashedFunds(ctx, targetAddress, slashedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashedAmount, nil
+}
+
+// slashRedelegations slashes the given fraction of the destination shares of all the redelegation entries
+// from the given source target that have been created at or after the infraction height and that are
+// not yet mature. If a filter is provided, only the redelegations whose delegator satisfies it are slashed.
+func (k *Keeper) slashRedelegations(
+	ctx context.Context,
+	srcType types.DelegationType,
+	srcTargetID uint32,
+	fraction math.LegacyDec,
+	infractionHeight int64,
+	filter func(delegator string) (bool, error),
+	delegatorsSlashes *delegatorsSlashedAmounts,
+) (sdk.Coins, error) {
+	redelegations, err := k.GetSrcTargetRedelegations(ctx, srcType, srcTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slashedAmount := sdk.NewCoins()
+	for _, red := range redelegations {
+		if filter != nil {
+			include, err := filter(red.DelegatorAddress)
+			if err != nil {
+				return nil, err
+			}
+			if !include {
+				continue
+			}
+		}
+
+		for _, entry := range red.Entries {
+			// Only slash the entries that have been created after the infraction
+			// and that have not been completed yet
+			if entry.CreationHeight < infractionHeight || entry.IsMature(sdkCtx.BlockTime()) {
+				continue
+			}
+
+			amount, err := k.slashRedelegationEntry(ctx, red, entry, fraction)
+			if err != nil {
+				return nil, err
+			}
+			slashedAmount = slashedAmount.Add(amount...)
+			delegatorsSlashes.add(red.DelegatorAddress, amount)
+		}
+	}
+
+	return slashedAmount, nil
+}
+
+// slashRedelegationEntry removes the given fraction of the shares received by the provided redelegation
+// entry from the delegation to the destination target, and transfers the corresponding tokens out of it.
+// If the delegator has already undelegated part of such shares, only the remaining ones are slashed.
+func (k *Keeper) slashRedelegationEntry(
+	ctx context.Context, red types.Redelegation, entry types.RedelegationEntry, fraction math.LegacyDec,
+) (sdk.Coins, error) {
+	target, err := k.getDelegationTarget(ctx, red.DstType, red.DstTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, found, err := k.GetDelegationForTarget(ctx, target, red.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	shares := entry.SharesDst.MulDecTruncate(fraction).Intersect(delegation.Shares)
+	if shares.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	hooks, err := k.getDelegationHooks(red.DstType)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Unbond(ctx, types.UndelegationData{
+		Delegator: red.DelegatorAddress,
+		Target:    target,
+		Hooks:     hooks,
+		Shares:    shares,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TransferSlashedFunds(ctx, target.GetAddress(), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// TransferSlashedFunds moves the given slashed amount out of the account having the given address.
+// The funds are sent to the slashed funds recipient if one is set, otherwise they are burned.
+func (k *Keeper) TransferSlashedFunds(ctx context.Context, from string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	fromAddress, err := k.accountKeeper.AddressCodec().StringToBytes(from)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.SlashedFundsRecipient != "" {
+		recipient, err := k.accountKeeper.AddressCodec().StringToBytes(params.SlashedFundsRecipient)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(ctx, fromAddress, recipient, amount)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+}
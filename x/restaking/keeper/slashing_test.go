@@ -0,0 +1,377 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SlashOperator() {
+	// storeTargets creates a service, an operator that has joined it and a pool
+	// from which the service is borrowing security
+	storeTargets := func(ctx sdk.Context) {
+		err := suite.sk.SaveService(ctx, servicestypes.Service{
+			ID:      1,
+			Status:  servicestypes.SERVICE_STATUS_ACTIVE,
+			Address: servicestypes.GetServiceAddress(1).String(),
+		})
+		suite.Require().NoError(err)
+
+		err = suite.ok.SaveOperator(ctx, operatorstypes.Operator{
+			ID:      1,
+			Status:  operatorstypes.OPERATOR_STATUS_ACTIVE,
+			Address: operatorstypes.GetOperatorAddress(1).String(),
+		})
+		suite.Require().NoError(err)
+
+		err = suite.k.AddServiceToOperatorJoinedServices(ctx, 1, 1)
+		suite.Require().NoError(err)
+
+		err = suite.pk.SavePool(ctx, poolstypes.NewPool(1, "umilk"))
+		suite.Require().NoError(err)
+
+		err = suite.k.AddPoolToServiceSecuringPools(ctx, 1, 1)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		serviceID        uint32
+		operatorID       uint32
+		fraction         sdkmath.LegacyDec
+		infractionHeight int64
+		shouldErr        bool
+		expSlashed       sdk.Coins
+		check            func(ctx sdk.Context)
+	}{
+		{
+			name:             "zero fraction returns error",
+			store:            storeTargets,
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyZeroDec(),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name:             "fraction greater than one returns error",
+			store:            storeTargets,
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDec(2),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name:             "future infraction height returns error",
+			store:            storeTargets,
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(1, 1),
+			infractionHeight: 11,
+			shouldErr:        true,
+		},
+		{
+			name:             "non existing operator returns error",
+			store:            storeTargets,
+			serviceID:        1,
+			operatorID:       2,
+			fraction:         sdkmath.LegacyNewDecWithPrec(1, 1),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name: "operator that has not joined the service returns error",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				err := suite.ok.SaveOperator(ctx, operatorstypes.Operator{
+					ID:      2,
+					Status:  operatorstypes.OPERATOR_STATUS_ACTIVE,
+					Address: operatorstypes.GetOperatorAddress(2).String(),
+				})
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       2,
+			fraction:         sdkmath.LegacyNewDecWithPrec(1, 1),
+			infractionHeight: 10,
+			shouldErr:        true,
+		},
+		{
+			name: "operator delegations are slashed and burned properly",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(1, 1),
+			infractionHeight: 10,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			check: func(ctx sdk.Context) {
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 90)), operator.Tokens)
+
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/1/umilk", 90)), delegation.Shares)
+
+				// Make sure the tokens have been burned
+				balance := suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(1), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 90), balance)
+				suite.Require().Equal(sdkmath.NewInt(90), suite.bk.GetSupply(ctx, "umilk").Amount)
+			},
+		},
+		{
+			name: "only the pool delegations of the users trusting the service are slashed",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				// The first user trusts the service with the pool
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+
+				err = suite.k.SetUserPreferences(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.NewUserPreferences([]types.TrustedServiceEntry{
+					types.NewTrustedServiceEntry(1, []uint32{1}),
+				}))
+				suite.Require().NoError(err)
+
+				// The second user does not trust any service
+				suite.fundAccount(ctx, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				err = suite.k.SetUserPreferences(ctx, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", types.NewUserPreferences(nil))
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(5, 1),
+			infractionHeight: 10,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)),
+			check: func(ctx sdk.Context) {
+				pool, err := suite.pk.GetPool(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkmath.NewInt(150), pool.Tokens)
+
+				delegation, found, err := suite.k.GetPoolDelegation(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("pool/1/umilk", 50)), delegation.Shares)
+
+				delegation, found, err = suite.k.GetPoolDelegation(ctx, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("pool/1/umilk", 100)), delegation.Shares)
+			},
+		},
+		{
+			name: "pool delegations are slashed only for the share attributed to the operator",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+				suite.RegisterCurrency(ctx, "umilk", "MILK", 6, sdkmath.LegacyNewDec(2))
+
+				err := suite.ok.SaveOperator(ctx, operatorstypes.Operator{
+					ID:      2,
+					Status:  operatorstypes.OPERATOR_STATUS_ACTIVE,
+					Address: operatorstypes.GetOperatorAddress(2).String(),
+				})
+				suite.Require().NoError(err)
+
+				err = suite.k.AddServiceToOperatorJoinedServices(ctx, 2, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+
+				// The first operator secures 25% of the operators value
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+
+				suite.fundAccount(ctx, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", sdk.NewCoins(sdk.NewInt64Coin("umilk", 300)))
+				_, err = suite.k.DelegateToOperator(ctx, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 300)), "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				// The pool delegator trusts the service with the pool
+				suite.fundAccount(ctx, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn")
+				suite.Require().NoError(err)
+
+				err = suite.k.SetUserPreferences(ctx, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.NewUserPreferences([]types.TrustedServiceEntry{
+					types.NewTrustedServiceEntry(1, []uint32{1}),
+				}))
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(4, 1),
+			infractionHeight: 10,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)),
+			check: func(ctx sdk.Context) {
+				// The operator delegations are slashed by the whole fraction
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/1/umilk", 60)), delegation.Shares)
+
+				// The pool delegations are slashed only for the operator's 25% share
+				delegation, found, err = suite.k.GetPoolDelegation(ctx, 1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("pool/1/umilk", 90)), delegation.Shares)
+
+				// The delegations of the second operator are not affected
+				delegation, found, err = suite.k.GetOperatorDelegation(ctx, 2, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/2/umilk", 300)), delegation.Shares)
+
+				operator, err := suite.ok.GetOperator(ctx, 2)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 300)), operator.Tokens)
+			},
+		},
+		{
+			name: "only unbonding entries created after the infraction are slashed",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 200)))
+				_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 200)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+
+				// Unbond before the infraction
+				_, err = suite.k.UndelegateFromOperator(ctx.WithBlockHeight(5), 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+
+				// Unbond after the infraction
+				_, err = suite.k.UndelegateFromOperator(ctx.WithBlockHeight(9), 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(2, 1),
+			infractionHeight: 8,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 20)),
+			check: func(ctx sdk.Context) {
+				ubd, found, err := suite.k.GetOperatorUnbondingDelegation(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(ubd.Entries, 2)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), ubd.Entries[0].Balance)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 80)), ubd.Entries[1].Balance)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), ubd.Entries[1].InitialBalance)
+
+				balance := suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(1), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 180), balance)
+			},
+		},
+		{
+			name: "redelegations created after the infraction are slashed from the destination target",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				err := suite.ok.SaveOperator(ctx, operatorstypes.Operator{
+					ID:      2,
+					Status:  operatorstypes.OPERATOR_STATUS_ACTIVE,
+					Address: operatorstypes.GetOperatorAddress(2).String(),
+				})
+				suite.Require().NoError(err)
+
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+
+				// Redelegate after the infraction
+				_, err = suite.k.RedelegateFromOperator(ctx.WithBlockHeight(9), 1, 2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(2, 1),
+			infractionHeight: 8,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 20)),
+			check: func(ctx sdk.Context) {
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 2, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/2/umilk", 40)), delegation.Shares)
+
+				balance := suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(1), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 40), balance)
+				balance = suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(2), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 40), balance)
+			},
+		},
+		{
+			name: "slashed funds are sent to the recipient if set",
+			store: func(ctx sdk.Context) {
+				storeTargets(ctx)
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+				params.SlashedFundsRecipient = "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn"
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				suite.fundAccount(ctx, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err = suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+			},
+			serviceID:        1,
+			operatorID:       1,
+			fraction:         sdkmath.LegacyNewDecWithPrec(1, 1),
+			infractionHeight: 10,
+			shouldErr:        false,
+			expSlashed:       sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			check: func(ctx sdk.Context) {
+				recipient := sdk.MustAccAddressFromBech32("cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 10), suite.bk.GetBalance(ctx, recipient, "umilk"))
+				suite.Require().Equal(sdkmath.NewInt(100), suite.bk.GetSupply(ctx, "umilk").Amount)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			slashed, err := suite.k.SlashOperator(ctx, tc.serviceID, tc.operatorID, tc.fraction, tc.infractionHeight)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSlashed, slashed)
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/binary"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/milkyway-labs/milkyway/v12/utils"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
@@ -83,6 +85,104 @@ func (k *Keeper) ValidateUnbondAmount(
 	return shares, nil
 }
 
+// GetDelegationSecuredServicesIDs returns the IDs of the services that are currently
+// secured by the delegation of the given user toward the target having the given type and ID.
+// Service delegations only secure the service itself, operator delegations secure all the
+// services joined by the operator, while pool delegations secure the services that the user
+// trusts with the pool, based on their preferences, and that are secured by the pool
+func (k *Keeper) GetDelegationSecuredServicesIDs(
+	ctx context.Context, delType types.DelegationType, targetID uint32, delegator string,
+) ([]uint32, error) {
+	switch delType {
+	case types.DELEGATION_TYPE_SERVICE:
+		return []uint32{targetID}, nil
+
+	case types.DELEGATION_TYPE_OPERATOR:
+		var servicesIDs []uint32
+		ranger := collections.NewPrefixedPairRange[uint32, uint32](targetID)
+		err := k.operatorJoinedServices.Walk(ctx, ranger, func(key collections.Pair[uint32, uint32], _ collections.NoValue) (stop bool, err error) {
+			servicesIDs = append(servicesIDs, key.K2())
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return servicesIDs, nil
+
+	case types.DELEGATION_TYPE_POOL:
+		preferences, err := k.GetUserPreferences(ctx, delegator)
+		if err != nil {
+			return nil, err
+		}
+
+		var servicesIDs []uint32
+		for _, serviceID := range preferences.TrustedServicesIDs() {
+			if !preferences.IsServiceTrustedWithPool(serviceID, targetID) {
+				continue
+			}
+
+			secured, err := k.IsServiceSecuredByPool(ctx, serviceID, targetID)
+			if err != nil {
+				return nil, err
+			}
+
+			if secured {
+				servicesIDs = append(servicesIDs, serviceID)
+			}
+		}
+		return servicesIDs, nil
+
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type: %v", delType)
+	}
+}
+
+// GetUndelegationUnbondingTime returns the unbonding time that should be applied when the
+// given user undelegates from the target having the given type and ID.
+// This is the longest unbonding time among the services currently secured by the delegation.
+// Services that have not set their own unbonding time use the one set inside the module params,
+// which is also used when the delegation is not securing any service. All unbonding times
+// are bounded by the min service unbonding time, while services unbonding times are also
+// capped to the max service unbonding time set inside the module params
+func (k *Keeper) GetUndelegationUnbondingTime(
+	ctx context.Context, delType types.DelegationType, targetID uint32, delegator string,
+) (time.Duration, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defaultUnbondingTime := max(moduleParams.UnbondingTime, moduleParams.MinServiceUnbondingTime())
+
+	servicesIDs, err := k.GetDelegationSecuredServicesIDs(ctx, delType, targetID, delegator)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(servicesIDs) == 0 {
+		return defaultUnbondingTime, nil
+	}
+
+	var unbondingTime time.Duration
+	for _, serviceID := range servicesIDs {
+		params, err := k.servicesKeeper.GetServiceParams(ctx, serviceID)
+		if err != nil {
+			return 0, err
+		}
+
+		serviceUnbondingTime := defaultUnbondingTime
+		if params.UnbondingTime > 0 {
+			serviceUnbondingTime = min(
+				max(params.UnbondingTime, moduleParams.MinServiceUnbondingTime()),
+				moduleParams.MaxServiceUnbondingTime,
+			)
+		}
+
+		unbondingTime = max(unbondingTime, serviceUnbondingTime)
+	}
+
+	return unbondingTime, nil
+}
+
 // Unbond unbonds a particular delegation and perform associated store operations.
 func (k *Keeper) Unbond(ctx context.Context, data types.UndelegationData) (amount sdk.Coins, err error) {
 	// Check if a delegation object exists in the store
@@ -153,6 +253,9 @@ func (k *Keeper) RemoveTargetTokensAndShares(ctx context.Context, data types.Und
 		if err := k.operatorsKeeper.SaveOperator(ctx, operator); err != nil {
 			return nil, err
 		}
+		if err := k.markOperatorServicesDirty(ctx, operator.ID); err != nil {
+			return nil, err
+		}
 		issuedTokensAmount = amount
 	case servicestypes.Service:
 		service, amount := target.RemoveDelShares(data.Shares)
@@ -309,6 +412,125 @@ func (k *Keeper) CompleteUnbonding(ctx context.Context, data types.DTData) (sdk.
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels the given amount of the unbonding delegation entry created at the given
+// height by the delegator towards the target having the given type and ID. The cancelled tokens are delegated
+// back to the same target, while the unbonding delegation entry is updated or removed accordingly.
+func (k *Keeper) CancelUnbondingDelegation(
+	ctx context.Context,
+	delegator string,
+	targetType types.DelegationType,
+	targetID uint32,
+	amount sdk.Coins,
+	creationHeight int64,
+) error {
+	ubd, found, err := k.GetUnbondingDelegation(ctx, delegator, targetType, targetID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Find the entry created at the given height that has not completed yet
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(sdkCtx.BlockTime()) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return errors.Wrapf(types.ErrNoUnbondingDelegationEntry, "no unbonding delegation entry found at height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if !amount.IsAllLTE(entry.Balance) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "amount %s is greater than the unbonding delegation entry balance %s", amount, entry.Balance)
+	}
+
+	// Get the target of the unbonding delegation
+	target, err := k.getUnbondingDelegationTarget(ctx, ubd)
+	if err != nil {
+		return err
+	}
+
+	// Send the tokens back to the delegator so that they can be delegated again to the target.
+	// This allows to perform all the checks of a normal delegation and to call the proper hooks.
+	targetAddress, err := k.accountKeeper.AddressCodec().StringToBytes(target.GetAddress())
+	if err != nil {
+		return err
+	}
+	delegatorAddress, err := k.accountKeeper.AddressCodec().StringToBytes(delegator)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, targetAddress, delegatorAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	// Delegate the tokens back to the target
+	switch targetType {
+	case types.DELEGATION_TYPE_POOL:
+		if len(amount) != 1 {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount for a pool: %s", amount)
+		}
+		_, err = k.DelegateToPool(ctx, amount[0], delegator)
+	case types.DELEGATION_TYPE_OPERATOR:
+		_, err = k.DelegateToOperator(ctx, targetID, amount, delegator)
+	case types.DELEGATION_TYPE_SERVICE:
+		_, err = k.DelegateToService(ctx, targetID, amount, delegator)
+	default:
+		err = errors.Wrapf(types.ErrInvalidDelegationType, "invalid delegation type %v", targetType)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Update the unbonding delegation entry, removing it if there are no tokens left
+	entry.Balance = entry.Balance.Sub(amount...)
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+
+		err = k.DeleteUnbondingIndex(ctx, entry.UnbondingID)
+		if err != nil {
+			return err
+		}
+
+		// Remove the unbonding delegation from the queue if no other entry completes at the same time
+		hasSameCompletionTime := false
+		for _, other := range ubd.Entries {
+			if other.CompletionTime.Equal(entry.CompletionTime) {
+				hasSameCompletionTime = true
+				break
+			}
+		}
+
+		if !hasSameCompletionTime {
+			err = k.RemoveFromUBDQueue(ctx, ubd, entry.CompletionTime)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		entry.InitialBalance = entry.InitialBalance.Sub(amount...)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// Set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		return k.RemoveUnbondingDelegation(ctx, ubd)
+	}
+
+	_, err = k.SetUnbondingDelegation(ctx, ubd)
+	return err
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 // --- Unbonding queue operations
 // --------------------------------------------------------------------------------------------------------------------
@@ -364,6 +586,32 @@ func (k *Keeper) InsertUBDQueue(ctx context.Context, ubd types.UnbondingDelegati
 	return k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
 }
 
+// RemoveFromUBDQueue removes the given unbonding delegation from the timeslice of the unbonding queue
+// associated to the given completion time.
+func (k *Keeper) RemoveFromUBDQueue(ctx context.Context, ubd types.UnbondingDelegation, completionTime time.Time) error {
+	timeSlice, err := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	if err != nil {
+		return err
+	}
+
+	var newTimeSlice []types.DTData
+	for _, data := range timeSlice {
+		if data.UnbondingDelegationType == ubd.Type &&
+			data.DelegatorAddress == ubd.DelegatorAddress &&
+			data.TargetID == ubd.TargetID {
+			continue
+		}
+		newTimeSlice = append(newTimeSlice, data)
+	}
+
+	if len(newTimeSlice) == 0 {
+		store := k.storeService.OpenKVStore(ctx)
+		return store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	}
+
+	return k.SetUBDQueueTimeSlice(ctx, completionTime, newTimeSlice)
+}
+
 // UBDQueueIterator returns all the unbonding queue timeslices from time 0 until endTime.
 func (k *Keeper) UBDQueueIterator(ctx context.Context, endTime time.Time) (storetypes.Iterator, error) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -3,9 +3,14 @@ package keeper_test
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/keeper"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
 
 func (suite *KeeperTestSuite) TestKeeper_MaxUnbondingEntries() {
@@ -38,3 +43,404 @@ func (suite *KeeperTestSuite) TestKeeper_MaxUnbondingEntries() {
 	_, err = msgServer.UndelegatePool(ctx, types.NewMsgUndelegatePool(utils.MustParseCoin("1000_000000umilk"), delegator))
 	suite.Require().ErrorIs(err, types.ErrMaxUnbondingDelegationEntries)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_CancelUnbondingDelegation() {
+	delegator := "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+	// storeOperatorUnbonding delegates 100umilk to the operator 1 and unbonds 40umilk from it
+	storeOperatorUnbonding := func(ctx sdk.Context) {
+		suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+		_, err := suite.k.DelegateToOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), delegator)
+		suite.Require().NoError(err)
+
+		_, err = suite.k.UndelegateFromOperator(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 40)), delegator)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name           string
+		store          func(ctx sdk.Context)
+		updateCtx      func(ctx sdk.Context) sdk.Context
+		targetType     types.DelegationType
+		targetID       uint32
+		amount         sdk.Coins
+		creationHeight int64
+		shouldErr      bool
+		expErr         error
+		check          func(ctx sdk.Context)
+	}{
+		{
+			name:           "non existing unbonding delegation returns error",
+			targetType:     types.DELEGATION_TYPE_OPERATOR,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			creationHeight: 10,
+			shouldErr:      true,
+			expErr:         types.ErrNoUnbondingDelegation,
+		},
+		{
+			name:           "non existing entry returns error",
+			store:          storeOperatorUnbonding,
+			targetType:     types.DELEGATION_TYPE_OPERATOR,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			creationHeight: 9,
+			shouldErr:      true,
+			expErr:         types.ErrNoUnbondingDelegationEntry,
+		},
+		{
+			name:  "mature entry returns error",
+			store: storeOperatorUnbonding,
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(ctx.BlockTime().Add(7 * 24 * time.Hour))
+			},
+			targetType:     types.DELEGATION_TYPE_OPERATOR,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			creationHeight: 10,
+			shouldErr:      true,
+			expErr:         types.ErrNoUnbondingDelegationEntry,
+		},
+		{
+			name:           "amount greater than the entry balance returns error",
+			store:          storeOperatorUnbonding,
+			targetType:     types.DELEGATION_TYPE_OPERATOR,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 50)),
+			creationHeight: 10,
+			shouldErr:      true,
+		},
+		{
+			name:           "partial cancellation updates the entry properly",
+			store:          storeOperatorUnbonding,
+			targetType:     types.DELEGATION_TYPE_OPERATOR,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 10)),
+			creationHeight: 10,
+			shouldErr:      false,
+			check: func(ctx sdk.Context) {
+				delegation, found, err := suite.k.GetOperatorDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("operator/1/umilk", 70)), delegation.Shares)
+
+				operator, err := suite.ok.GetOperator(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 70)), operator.Tokens)
+
+				ubd, found, err := suite.k.GetOperatorUnbondingDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Len(ubd.Entries, 1)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 30)), ubd.Entries[0].Balance)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umilk", 30)), ubd.Entries[0].InitialBalance)
+
+				// The tokens should still be inside the operator account
+				balance := suite.bk.GetBalance(ctx, operatorstypes.GetOperatorAddress(1), "umilk")
+				suite.Require().Equal(sdk.NewInt64Coin("umilk", 100), balance)
+
+				// The unbonding delegation should still be inside the queue
+				timeSlice, err := suite.k.GetUBDQueueTimeSlice(ctx, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC))
+				suite.Require().NoError(err)
+				suite.Require().Len(timeSlice, 1)
+			},
+		},
+		{
+			name: "full cancellation removes the entry and the queue item",
+			store: func(ctx sdk.Context) {
+				suite.fundAccount(ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)))
+				_, err := suite.k.DelegateToPool(ctx, sdk.NewInt64Coin("umilk", 100), delegator)
+				suite.Require().NoError(err)
+
+				_, err = suite.k.UndelegateFromPool(ctx, sdk.NewInt64Coin("umilk", 40), delegator)
+				suite.Require().NoError(err)
+			},
+			targetType:     types.DELEGATION_TYPE_POOL,
+			targetID:       1,
+			amount:         sdk.NewCoins(sdk.NewInt64Coin("umilk", 40)),
+			creationHeight: 10,
+			shouldErr:      false,
+			check: func(ctx sdk.Context) {
+				delegation, found, err := suite.k.GetPoolDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("pool/1/umilk", 100)), delegation.Shares)
+
+				pool, err := suite.pk.GetPool(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkmath.NewInt(100), pool.Tokens)
+
+				_, found, err = suite.k.GetPoolUnbondingDelegation(ctx, 1, delegator)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
+				timeSlice, err := suite.k.GetUBDQueueTimeSlice(ctx, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC))
+				suite.Require().NoError(err)
+				suite.Require().Empty(timeSlice)
+
+				// Completing the unbonding delegations should not fail
+				err = suite.k.CompleteMatureUnbondingDelegations(ctx.WithBlockTime(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			suite.storeRedelegationTargets(ctx)
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+			if tc.updateCtx != nil {
+				ctx = tc.updateCtx(ctx)
+			}
+
+			err := suite.k.CancelUnbondingDelegation(ctx, delegator, tc.targetType, tc.targetID, tc.amount, tc.creationHeight)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUndelegationUnbondingTime() {
+	delegator := "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+	// storeServicesParams sets the unbonding time of the service 1 to 3 days and
+	// the one of the service 2 to 21 days
+	storeServicesParams := func(ctx sdk.Context) {
+		err := suite.sk.SetServiceParams(ctx, 1, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 3*24*time.Hour))
+		suite.Require().NoError(err)
+
+		err = suite.sk.SetServiceParams(ctx, 2, servicestypes.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 21*24*time.Hour))
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		delegationType   types.DelegationType
+		targetID         uint32
+		shouldErr        bool
+		expUnbondingTime time.Duration
+	}{
+		{
+			name:           "invalid delegation type returns error",
+			delegationType: types.DELEGATION_TYPE_UNSPECIFIED,
+			targetID:       1,
+			shouldErr:      true,
+		},
+		{
+			name:             "service without unbonding time uses the default one",
+			delegationType:   types.DELEGATION_TYPE_SERVICE,
+			targetID:         3,
+			shouldErr:        false,
+			expUnbondingTime: 7 * 24 * time.Hour,
+		},
+		{
+			name:             "service unbonding time is used properly",
+			store:            storeServicesParams,
+			delegationType:   types.DELEGATION_TYPE_SERVICE,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 3 * 24 * time.Hour,
+		},
+		{
+			name: "service unbonding time is capped to the max service unbonding time",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+
+				params.MaxServiceUnbondingTime = 14 * 24 * time.Hour
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_SERVICE,
+			targetID:         2,
+			shouldErr:        false,
+			expUnbondingTime: 14 * 24 * time.Hour,
+		},
+		{
+			name: "service unbonding time is raised to the slash veto window",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+
+				params.SlashVetoWindow = 5 * 24 * time.Hour
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_SERVICE,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 5 * 24 * time.Hour,
+		},
+		{
+			name: "default unbonding time is raised to the slash veto window",
+			store: func(ctx sdk.Context) {
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+
+				params.UnbondingTime = 24 * time.Hour
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_OPERATOR,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: types.DefaultSlashVetoWindow,
+		},
+		{
+			name: "service without unbonding time uses the default one raised to the slash veto window",
+			store: func(ctx sdk.Context) {
+				params, err := suite.k.GetParams(ctx)
+				suite.Require().NoError(err)
+
+				params.UnbondingTime = 24 * time.Hour
+				err = suite.k.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_SERVICE,
+			targetID:         3,
+			shouldErr:        false,
+			expUnbondingTime: types.DefaultSlashVetoWindow,
+		},
+		{
+			name:             "operator without joined services uses the default one",
+			store:            storeServicesParams,
+			delegationType:   types.DELEGATION_TYPE_OPERATOR,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 7 * 24 * time.Hour,
+		},
+		{
+			name: "operator uses the longest unbonding time among the joined services",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				err := suite.k.AddServiceToOperatorJoinedServices(ctx, 1, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.AddServiceToOperatorJoinedServices(ctx, 1, 2)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_OPERATOR,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 21 * 24 * time.Hour,
+		},
+		{
+			name: "operator joined services without unbonding time use the default one",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				err := suite.k.AddServiceToOperatorJoinedServices(ctx, 1, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.AddServiceToOperatorJoinedServices(ctx, 1, 3)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_OPERATOR,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 7 * 24 * time.Hour,
+		},
+		{
+			name: "pool not trusted by the user uses the default one",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				err := suite.k.AddPoolToServiceSecuringPools(ctx, 1, 1)
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_POOL,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 7 * 24 * time.Hour,
+		},
+		{
+			name: "pool only considers the trusted services secured by the pool",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				err := suite.k.AddPoolToServiceSecuringPools(ctx, 1, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.AddPoolToServiceSecuringPools(ctx, 2, 2)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetUserPreferences(ctx, delegator, types.NewUserPreferences([]types.TrustedServiceEntry{
+					types.NewTrustedServiceEntry(1, nil),
+					types.NewTrustedServiceEntry(2, nil),
+				}))
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_POOL,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 3 * 24 * time.Hour,
+		},
+		{
+			name: "pool uses the longest unbonding time among the trusted services",
+			store: func(ctx sdk.Context) {
+				storeServicesParams(ctx)
+
+				err := suite.k.AddPoolToServiceSecuringPools(ctx, 1, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.AddPoolToServiceSecuringPools(ctx, 2, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetUserPreferences(ctx, delegator, types.NewUserPreferences([]types.TrustedServiceEntry{
+					types.NewTrustedServiceEntry(1, []uint32{1}),
+					types.NewTrustedServiceEntry(2, []uint32{1}),
+				}))
+				suite.Require().NoError(err)
+			},
+			delegationType:   types.DELEGATION_TYPE_POOL,
+			targetID:         1,
+			shouldErr:        false,
+			expUnbondingTime: 21 * 24 * time.Hour,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			params, err := suite.k.GetParams(ctx)
+			suite.Require().NoError(err)
+			params.UnbondingTime = 7 * 24 * time.Hour
+			err = suite.k.SetParams(ctx, params)
+			suite.Require().NoError(err)
+
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			unbondingTime, err := suite.k.GetUndelegationUnbondingTime(ctx, tc.delegationType, tc.targetID, delegator)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expUnbondingTime, unbondingTime)
+			}
+		})
+	}
+}
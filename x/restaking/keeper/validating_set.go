@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// GetServiceBorrowedPoolsTokens returns the tokens that the service with the
+// given ID is borrowing from the pools securing it. Only the delegations of the
+// users that trust the service with the pool, based on their UserPreferences,
+// are taken into account
+func (k *Keeper) GetServiceBorrowedPoolsTokens(ctx context.Context, serviceID uint32) (sdk.Coins, error) {
+	poolsIDs, err := k.GetAllServiceSecuringPools(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	borrowedTokens := sdk.NewCoins()
+	for _, poolID := range poolsIDs {
+		pool, err := k.poolsKeeper.GetPool(ctx, poolID)
+		if err != nil {
+			return nil, err
+		}
+
+		delegations, err := k.GetPoolDelegations(ctx, poolID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, delegation := range delegations {
+			preferences, err := k.GetUserPreferences(ctx, delegation.UserAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			if !preferences.IsServiceTrustedWithPool(serviceID, poolID) {
+				continue
+			}
+
+			tokens, _ := pool.TokensFromSharesTruncated(delegation.Shares).TruncateDecimal()
+			borrowedTokens = borrowedTokens.Add(tokens...)
+		}
+	}
+
+	return borrowedTokens, nil
+}
+
+// GetServiceValidatingSet returns the operators that are part of the active
+// operators set of the given service, along with their voting power.
+// The power of each operator is computed from the tokens delegated to it plus
+// a share of the tokens that the service is borrowing from its securing pools.
+// The borrowed tokens are split among the operators proportionally to the USD
+// value of their own delegations.
+// The returned operators are sorted by voting power in descending order, ties
+// are broken by the lowest operator ID.
+func (k *Keeper) GetServiceValidatingSet(ctx context.Context, serviceID uint32) ([]types.ValidatingOperator, error) {
+	operatorsIDs, err := k.GetServiceActiveOperators(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the tokens and USD value of each operator
+	operatorsTokens := make([]sdk.Coins, len(operatorsIDs))
+	operatorsValues := make([]math.LegacyDec, len(operatorsIDs))
+	totalOperatorsValue := math.LegacyZeroDec()
+	for i, operatorID := range operatorsIDs {
+		operator, err := k.operatorsKeeper.GetOperator(ctx, operatorID)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := k.GetOperatorSecuredValue(ctx, operator)
+		if err != nil {
+			return nil, err
+		}
+
+		operatorsTokens[i] = operator.Tokens
+		operatorsValues[i] = value
+		totalOperatorsValue = totalOperatorsValue.Add(value)
+	}
+
+	borrowedTokens, err := k.GetServiceBorrowedPoolsTokens(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	borrowedDecTokens := sdk.NewDecCoinsFromCoins(borrowedTokens...)
+
+	validatingSet := make([]types.ValidatingOperator, len(operatorsIDs))
+	for i, operatorID := range operatorsIDs {
+		tokens := operatorsTokens[i]
+
+		// Add the operator's share of the borrowed tokens
+		if totalOperatorsValue.IsPositive() {
+			share := operatorsValues[i].Quo(totalOperatorsValue)
+			operatorBorrowedTokens, _ := borrowedDecTokens.MulDecTruncate(share).TruncateDecimal()
+			tokens = tokens.Add(operatorBorrowedTokens...)
+		}
+
+		value, err := k.GetCoinsValue(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		validatingSet[i] = types.NewValidatingOperator(operatorID, tokens, value)
+	}
+
+	sort.SliceStable(validatingSet, func(i, j int) bool {
+		if !validatingSet[i].USDValue.Equal(validatingSet[j].USDValue) {
+			return validatingSet[i].USDValue.GT(validatingSet[j].USDValue)
+		}
+		return validatingSet[i].OperatorID < validatingSet[j].OperatorID
+	})
+
+	return validatingSet, nil
+}
+
+// getOperatorBorrowedSecurityShare returns the share of the security borrowed by
+// the given service from its securing pools that is attributed to the given
+// operator. As in the validating set, the borrowed security is split among the
+// active operators proportionally to the USD value of their own delegations.
+// The given operator is always taken into account, even if it is no longer part
+// of the active set. If none of the operators has any value, the borrowed
+// security is split equally among them.
+func (k *Keeper) getOperatorBorrowedSecurityShare(ctx context.Context, serviceID uint32, operatorID uint32) (math.LegacyDec, error) {
+	operatorsIDs, err := k.GetServiceActiveOperators(ctx, serviceID)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !slices.Contains(operatorsIDs, operatorID) {
+		operatorsIDs = append(operatorsIDs, operatorID)
+	}
+
+	operatorValue := math.LegacyZeroDec()
+	totalOperatorsValue := math.LegacyZeroDec()
+	for _, id := range operatorsIDs {
+		operator, err := k.operatorsKeeper.GetOperator(ctx, id)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		value, err := k.GetOperatorSecuredValue(ctx, operator)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if id == operatorID {
+			operatorValue = value
+		}
+		totalOperatorsValue = totalOperatorsValue.Add(value)
+	}
+
+	if !totalOperatorsValue.IsPositive() {
+		return math.LegacyOneDec().QuoInt64(int64(len(operatorsIDs))), nil
+	}
+
+	return operatorValue.Quo(totalOperatorsValue), nil
+}
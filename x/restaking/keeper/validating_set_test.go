@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// storeBorrowedPoolDelegations stores a pool with ID 1 securing the service
+// with ID 1, delegating the given amount to it from three different users:
+// the first one trusts the service, the second one trusts another service and
+// the third one has no preferences set
+func (suite *KeeperTestSuite) storeBorrowedPoolDelegations(ctx sdk.Context, amount string) {
+	err := suite.pk.SavePool(ctx, poolstypes.NewPool(1, "umilk"))
+	suite.Require().NoError(err)
+
+	err = suite.k.AddPoolToServiceSecuringPools(ctx, 1, 1)
+	suite.Require().NoError(err)
+
+	delegators := []string{
+		"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+	}
+	for _, delegator := range delegators {
+		suite.fundAccount(ctx, delegator, utils.MustParseCoins(amount))
+		_, err = suite.k.DelegateToPool(ctx, utils.MustParseCoin(amount), delegator)
+		suite.Require().NoError(err)
+	}
+
+	err = suite.k.SetUserPreferences(ctx, delegators[0], types.NewUserPreferences([]types.TrustedServiceEntry{
+		types.NewTrustedServiceEntry(1, []uint32{1}),
+	}))
+	suite.Require().NoError(err)
+
+	err = suite.k.SetUserPreferences(ctx, delegators[1], types.NewUserPreferences([]types.TrustedServiceEntry{
+		types.NewTrustedServiceEntry(2, nil),
+	}))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetServiceBorrowedPoolsTokens() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		serviceID uint32
+		expTokens sdk.Coins
+	}{
+		{
+			name:      "service without securing pools returns no tokens",
+			serviceID: 1,
+			expTokens: sdk.NewCoins(),
+		},
+		{
+			name: "only the delegations of users trusting the service are returned",
+			store: func(ctx sdk.Context) {
+				suite.storeBorrowedPoolDelegations(ctx, "40_000000umilk")
+			},
+			serviceID: 1,
+			expTokens: utils.MustParseCoins("40_000000umilk"),
+		},
+		{
+			name: "delegations are not returned if the service is not secured by the pool",
+			store: func(ctx sdk.Context) {
+				suite.storeBorrowedPoolDelegations(ctx, "40_000000umilk")
+			},
+			serviceID: 2,
+			expTokens: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			tokens, err := suite.k.GetServiceBorrowedPoolsTokens(ctx, tc.serviceID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expTokens, tokens)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetServiceValidatingSet() {
+	testCases := []struct {
+		name             string
+		store            func(ctx sdk.Context)
+		expValidatingSet []types.ValidatingOperator
+	}{
+		{
+			name:             "service without active operators returns empty set",
+			expValidatingSet: []types.ValidatingOperator{},
+		},
+		{
+			name: "operators power is computed from their own delegations",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+				})
+
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+			},
+			expValidatingSet: []types.ValidatingOperator{
+				types.NewValidatingOperator(2, utils.MustParseCoins("30_000000umilk"), utils.MustParseDec("60")),
+				types.NewValidatingOperator(1, utils.MustParseCoins("10_000000umilk"), utils.MustParseDec("20")),
+			},
+		},
+		{
+			name: "borrowed pool tokens are split proportionally among operators",
+			store: func(ctx sdk.Context) {
+				suite.storeActiveOperatorsCandidates(ctx, []operatorstypes.Operator{
+					newOperatorWithTokens(1, operatorstypes.OPERATOR_STATUS_ACTIVE, "10_000000umilk"),
+					newOperatorWithTokens(2, operatorstypes.OPERATOR_STATUS_ACTIVE, "30_000000umilk"),
+					newOperatorWithTokens(3, operatorstypes.OPERATOR_STATUS_ACTIVE, "50_000000umilk"),
+				})
+
+				// Operator 3 is not part of the active set
+				err := suite.k.SetServiceActiveOperators(ctx, 1, []uint32{1, 2})
+				suite.Require().NoError(err)
+
+				suite.storeBorrowedPoolDelegations(ctx, "40_000000umilk")
+			},
+			expValidatingSet: []types.ValidatingOperator{
+				types.NewValidatingOperator(2, utils.MustParseCoins("60_000000umilk"), utils.MustParseDec("120")),
+				types.NewValidatingOperator(1, utils.MustParseCoins("20_000000umilk"), utils.MustParseDec("40")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			validatingSet, err := suite.k.GetServiceValidatingSet(ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expValidatingSet, validatingSet)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package v3
+
+import (
+	"context"
+
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+type Keeper interface {
+	GetParams(ctx context.Context) (restakingtypes.Params, error)
+	SetParams(ctx context.Context, params restakingtypes.Params) error
+
+	GetAllUnbondingDelegations(ctx context.Context) ([]restakingtypes.UnbondingDelegation, error)
+	SetUnbondingDelegation(ctx context.Context, ud restakingtypes.UnbondingDelegation) ([]byte, error)
+}
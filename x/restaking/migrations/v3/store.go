@@ -0,0 +1,62 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migrations include:
+// - Setting the default values of the params added in v3
+// - Populating the unbonding delegations by target ID indexes
+func MigrateStore(ctx sdk.Context, keeper Keeper) error {
+	err := setNewParamsDefaults(ctx, keeper)
+	if err != nil {
+		return err
+	}
+
+	return indexUnbondingDelegationsByTarget(ctx, keeper)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// setNewParamsDefaults sets the default values of the params that did not exist in v2.
+// The SlashedFundsRecipient and SlashVetoCommittee params are left empty, which is
+// their default value. The slash veto windows are capped to the existing unbonding time,
+// so that it is never lower than the min service unbonding time
+func setNewParamsDefaults(ctx sdk.Context, keeper Keeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.DowntimeJailDuration = types.DefaultDowntimeJailDuration
+	params.SlashVetoWindow = min(types.DefaultSlashVetoWindow, params.UnbondingTime)
+	params.AccreditedSlashVetoWindow = min(types.DefaultAccreditedSlashVetoWindow, params.SlashVetoWindow)
+	params.OperatorServiceKeyRotationDelay = types.DefaultOperatorServiceKeyRotationDelay
+	params.MaxServiceUnbondingTime = max(types.DefaultMaxServiceUnbondingTime, params.UnbondingTime)
+	params.ActiveOperatorsRefreshInterval = types.DefaultActiveOperatorsRefreshInterval
+	params.MaxEvidenceAge = types.DefaultMaxEvidenceAge
+
+	return keeper.SetParams(ctx, params)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// indexUnbondingDelegationsByTarget stores again all the existing unbonding delegations
+// so that the indexes allowing to look them up by target ID are populated
+func indexUnbondingDelegationsByTarget(ctx sdk.Context, keeper Keeper) error {
+	unbondingDelegations, err := keeper.GetAllUnbondingDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ubd := range unbondingDelegations {
+		_, err = keeper.SetUnbondingDelegation(ctx, ubd)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
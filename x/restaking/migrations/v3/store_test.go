@@ -0,0 +1,158 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/milkyway-labs/milkyway/v12/x/restaking/migrations/v3"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/testutils"
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+func TestMigrateStore_indexUnbondingDelegationsByTarget(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "unbonding delegations are indexed properly",
+			store: func(ctx sdk.Context) {
+				// Store the unbonding delegations without the index, as it was done before the migration
+				store := testData.StoreService.OpenKVStore(ctx)
+
+				ubd := types.NewPoolUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					1,
+					10,
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)),
+					1,
+				)
+				err := store.Set(types.UserPoolUnbondingDelegationKey(ubd.DelegatorAddress, ubd.TargetID), types.MustMarshalUnbondingDelegation(testData.Cdc, ubd))
+				require.NoError(t, err)
+
+				ubd = types.NewOperatorUnbondingDelegation(
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					2,
+					10,
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)),
+					2,
+				)
+				err = store.Set(types.UserOperatorUnbondingDelegationKey(ubd.DelegatorAddress, ubd.TargetID), types.MustMarshalUnbondingDelegation(testData.Cdc, ubd))
+				require.NoError(t, err)
+
+				// Make sure the index is empty
+				ubds, err := testData.Keeper.GetTargetUnbondingDelegations(ctx, types.DELEGATION_TYPE_POOL, 1)
+				require.NoError(t, err)
+				require.Empty(t, ubds)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				ubds, err := testData.Keeper.GetTargetUnbondingDelegations(ctx, types.DELEGATION_TYPE_POOL, 1)
+				require.NoError(t, err)
+				require.Len(t, ubds, 1)
+				require.Equal(t, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", ubds[0].DelegatorAddress)
+
+				ubds, err = testData.Keeper.GetTargetUnbondingDelegations(ctx, types.DELEGATION_TYPE_OPERATOR, 2)
+				require.NoError(t, err)
+				require.Len(t, ubds, 1)
+				require.Equal(t, uint32(2), ubds[0].TargetID)
+
+				ubds, err = testData.Keeper.GetTargetUnbondingDelegations(ctx, types.DELEGATION_TYPE_OPERATOR, 1)
+				require.NoError(t, err)
+				require.Empty(t, ubds)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := testData.Context.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			err := v3.MigrateStore(ctx, testData.Keeper)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+func TestMigrateStore_setNewParamsDefaults(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+	ctx, _ := testData.Context.CacheContext()
+
+	// Store the params as they were stored before the migration
+	err := testData.Keeper.SetParams(ctx, types.Params{
+		UnbondingTime: 7 * 24 * time.Hour,
+		AllowedDenoms: []string{"umilk"},
+		RestakingCap:  sdkmath.LegacyNewDec(1000),
+		MaxEntries:    10,
+	})
+	require.NoError(t, err)
+
+	err = v3.MigrateStore(ctx, testData.Keeper)
+	require.NoError(t, err)
+
+	params, err := testData.Keeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// Make sure the existing params have been kept
+	require.Equal(t, 7*24*time.Hour, params.UnbondingTime)
+	require.Equal(t, []string{"umilk"}, params.AllowedDenoms)
+	require.Equal(t, sdkmath.LegacyNewDec(1000), params.RestakingCap)
+	require.Equal(t, uint32(10), params.MaxEntries)
+
+	// Make sure the new params have been set to their default values
+	require.Empty(t, params.SlashedFundsRecipient)
+	require.Equal(t, types.DefaultDowntimeJailDuration, params.DowntimeJailDuration)
+	require.Equal(t, types.DefaultSlashVetoWindow, params.SlashVetoWindow)
+	require.Equal(t, types.DefaultAccreditedSlashVetoWindow, params.AccreditedSlashVetoWindow)
+	require.Empty(t, params.SlashVetoCommittee)
+	require.Equal(t, types.DefaultOperatorServiceKeyRotationDelay, params.OperatorServiceKeyRotationDelay)
+	require.Equal(t, types.DefaultMaxServiceUnbondingTime, params.MaxServiceUnbondingTime)
+	require.Equal(t, uint64(types.DefaultActiveOperatorsRefreshInterval), params.ActiveOperatorsRefreshInterval)
+	require.Equal(t, uint64(types.DefaultMaxEvidenceAge), params.MaxEvidenceAge)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrateStore_setNewParamsDefaults_ShortUnbondingTime(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+	ctx, _ := testData.Context.CacheContext()
+
+	// Store params having an unbonding time lower than the default slash veto window
+	err := testData.Keeper.SetParams(ctx, types.Params{
+		UnbondingTime: 36 * time.Hour,
+		MaxEntries:    7,
+	})
+	require.NoError(t, err)
+
+	err = v3.MigrateStore(ctx, testData.Keeper)
+	require.NoError(t, err)
+
+	params, err := testData.Keeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// Make sure the slash veto windows have been capped to the unbonding time
+	require.Equal(t, 36*time.Hour, params.SlashVetoWindow)
+	require.Equal(t, types.DefaultAccreditedSlashVetoWindow, params.AccreditedSlashVetoWindow)
+	require.NoError(t, params.Validate())
+}
@@ -70,10 +70,34 @@ func NewDecodeStore(cdc codec.BinaryCodec, keeper *keeper.Keeper) func(kvA kv.Pa
 			cdc.MustUnmarshal(kvB.Value, &listB)
 			return fmt.Sprintf("%v\n%v", listA, listB)
 
+		case bytes.Equal(kvA.Key[:1], types.RedelegationPrefix):
+			redelegationA := types.MustUnmarshalRedelegation(cdc, kvA.Value)
+			redelegationB := types.MustUnmarshalRedelegation(cdc, kvB.Value)
+			return fmt.Sprintf("%v\n%v", redelegationA, redelegationB)
+
+		case bytes.Equal(kvA.Key[:1], types.RedelegationsBySrcTargetPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.RedelegationIndexKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.RedelegationQueueKey):
+			var listA, listB types.RTDataList
+			cdc.MustUnmarshal(kvA.Value, &listA)
+			cdc.MustUnmarshal(kvB.Value, &listB)
+			return fmt.Sprintf("%v\n%v", listA, listB)
+
 		// Collections
 		case bytes.Equal(kvA.Key[:1], types.OperatorJoinedServicesPrefix),
 			bytes.Equal(kvA.Key[:1], types.ServiceOperatorsAllowListPrefix),
 			bytes.Equal(kvA.Key[:1], types.ServiceSecuringPoolsPrefix),
+			bytes.Equal(kvA.Key[:1], types.ServiceActiveOperatorsPrefix),
+			bytes.Equal(kvA.Key[:1], types.ServiceSnapshotsPrefix),
+			bytes.Equal(kvA.Key[:1], types.OperatorsJailRecordsPrefix),
+			bytes.Equal(kvA.Key[:1], types.SubmittedEvidencesPrefix),
+			bytes.Equal(kvA.Key[:1], types.NextPendingSlashIDKey),
+			bytes.Equal(kvA.Key[:1], types.PendingSlashesPrefix),
+			bytes.Equal(kvA.Key[:1], types.PendingSlashesQueuePrefix),
 			bytes.Equal(kvA.Key[:1], types.UserPreferencesPrefix):
 			return collectionsDecoder(kvA, kvB)
 
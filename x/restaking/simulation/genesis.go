@@ -24,6 +24,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		nil,
 		nil,
 		RandomUserPreferencesEntries(simState.Rand, servicesGenesis.Services),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		1,
+		nil,
+		nil,
 		RandomParams(simState.Rand),
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
@@ -157,6 +157,13 @@ func SimulateMsgJoinService(
 			if !s.IsActive() {
 				return false
 			}
+			hasEnoughStake, err := k.HasOperatorEnoughStakeForService(ctx, s.ID, operator)
+			if err != nil {
+				panic(err)
+			}
+			if !hasEnoughStake {
+				return false
+			}
 			configured, err := k.IsServiceOperatorsAllowListConfigured(ctx, s.ID)
 			if err != nil {
 				panic(err)
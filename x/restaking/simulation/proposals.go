@@ -37,8 +37,8 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	var authority sdk.AccAddress = address.Module("gov")
 
 	params := types.DefaultParams()
-	unbondingDays := time.Duration(r.Intn(7) + 1)
-	params.UnbondingTime = time.Hour * 24 * unbondingDays
+	unbondingDays := time.Duration(r.Intn(6) + 2)
+	params.UnbondingTime = max(time.Hour*24*unbondingDays, params.MinServiceUnbondingTime())
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
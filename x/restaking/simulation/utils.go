@@ -124,7 +124,10 @@ func RandomUserPreferencesEntries(
 
 func RandomParams(r *rand.Rand) types.Params {
 	unbondingDays := time.Duration(r.Intn(7) + 1)
-	return types.NewParams(time.Hour*24*unbondingDays, nil, simulation.RandomDecAmount(r, math.LegacyNewDec(10000)), uint32(r.Intn(20)+1))
+	slashVetoWindow := time.Hour * time.Duration(r.Intn(24*int(unbondingDays)+1))
+	accreditedSlashVetoWindow := time.Duration(r.Int63n(int64(slashVetoWindow) + 1))
+	maxServiceUnbondingTime := max(time.Hour*24*(unbondingDays+time.Duration(r.Intn(28))), slashVetoWindow)
+	return types.NewParams(time.Hour*24*unbondingDays, nil, simulation.RandomDecAmount(r, math.LegacyNewDec(10000)), uint32(r.Intn(20)+1), "", time.Minute*time.Duration(r.Intn(60)), slashVetoWindow, accreditedSlashVetoWindow, "", time.Hour*time.Duration(r.Intn(48)), maxServiceUnbondingTime, uint64(r.Intn(200)+1), uint64(r.Intn(10000)+1))
 }
 
 func RandomUserPreferences(r *rand.Rand, services []servicestypes.Service) types.UserPreferences {
@@ -3,6 +3,9 @@ package testutils
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
@@ -90,6 +93,18 @@ func (m MockHooks) BeforeServiceDelegationRemoved(ctx context.Context, serviceID
 	return nil
 }
 
+// BeforeOperatorSlashed implements restakingtypes.Hooks
+func (m MockHooks) BeforeOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec) error {
+	m.CalledMap["BeforeOperatorSlashed"] = true
+	return nil
+}
+
+// AfterOperatorSlashed implements restakingtypes.Hooks
+func (m MockHooks) AfterOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, slashedAmount sdk.Coins) error {
+	m.CalledMap["AfterOperatorSlashed"] = true
+	return nil
+}
+
 // AfterUnbondingInitiated implements restakingtypes.Hooks
 func (m MockHooks) AfterUnbondingInitiated(ctx context.Context, unbondingDelegationID uint64) error {
 	m.CalledMap["AfterUnbondingInitiated"] = true
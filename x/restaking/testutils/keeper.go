@@ -87,6 +87,8 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 	data.AssetsKeeper = assetskeeper.NewKeeper(
 		data.Cdc,
 		runtime.NewKVStoreService(data.Keys[assetstypes.StoreKey]),
+		data.AccountKeeper,
+		data.OracleKeeper,
 		data.AuthorityAddress,
 	)
 	data.Keeper = keeper.NewKeeper(
@@ -97,7 +99,6 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 		data.PoolsKeeper,
 		data.OperatorsKeeper,
 		data.ServicesKeeper,
-		data.OracleKeeper,
 		data.AssetsKeeper,
 		data.AuthorityAddress,
 	).SetHooks(NewMockHooks())
@@ -23,8 +23,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDelegateOperator{}, "milkyway/MsgDelegateOperator")
 	legacy.RegisterAminoMsg(cdc, &MsgUndelegateOperator{}, "milkyway/MsgUndelegateOperator")
 	legacy.RegisterAminoMsg(cdc, &MsgSetUserPreferences{}, "milkyway/MsgSetUserPreferences")
+	legacy.RegisterAminoMsg(cdc, &MsgRedelegatePool{}, "milkyway/MsgRedelegatePool")
+	legacy.RegisterAminoMsg(cdc, &MsgRedelegateOperator{}, "milkyway/MsgRedelegateOperator")
+	legacy.RegisterAminoMsg(cdc, &MsgRedelegateService{}, "milkyway/MsgRedelegateService")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "milkyway/MsgCancelUnbondingDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgUnjailOperator{}, "milkyway/MsgUnjailOperator")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitOperatorEvidence{}, "milkyway/MsgSubmitOperatorEvidence")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoSlash{}, "milkyway/MsgVetoSlash")
+	legacy.RegisterAminoMsg(cdc, &MsgSetOperatorServiceKey{}, "milkyway/MsgSetOperatorServiceKey")
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "milkyway/restaking/MsgUpdateParams")
+
+	cdc.RegisterInterface((*OperatorEvidence)(nil), nil)
+	cdc.RegisterConcrete(&ContractEvidence{}, "milkyway/ContractEvidence", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -42,8 +53,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegateOperator{},
 		&MsgUndelegateOperator{},
 		&MsgSetUserPreferences{},
+		&MsgRedelegatePool{},
+		&MsgRedelegateOperator{},
+		&MsgRedelegateService{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgUnjailOperator{},
+		&MsgSubmitOperatorEvidence{},
+		&MsgVetoSlash{},
+		&MsgSetOperatorServiceKey{},
 		&MsgUpdateParams{},
 	)
+	registry.RegisterInterface(
+		"milkyway.restaking.v1.OperatorEvidence",
+		(*OperatorEvidence)(nil),
+		&ContractEvidence{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -21,4 +21,23 @@ var (
 	ErrDenomNotRestakable             = errors.Register(ModuleName, 14, "denom not restakable")
 	ErrRestakingCapExceeded           = errors.Register(ModuleName, 15, "restaking cap exceeded")
 	ErrMaxUnbondingDelegationEntries  = errors.Register(ModuleName, 16, "too many unbonding delegation entries for (delegator, delegation target) tuple")
+	ErrInvalidSlashFraction           = errors.Register(ModuleName, 17, "invalid slash fraction")
+	ErrInvalidInfractionHeight        = errors.Register(ModuleName, 18, "invalid infraction height")
+	ErrSelfRedelegation               = errors.Register(ModuleName, 19, "cannot redelegate to the same target")
+	ErrTransitiveRedelegation         = errors.Register(ModuleName, 20, "redelegation to this target already in progress; first redelegation to this target must complete before next redelegation")
+	ErrMaxRedelegationEntries         = errors.Register(ModuleName, 21, "too many redelegation entries for (delegator, src target, dst target) tuple")
+	ErrNoRedelegation                 = errors.Register(ModuleName, 22, "no redelegation found")
+	ErrNoUnbondingDelegationEntry     = errors.Register(ModuleName, 23, "no valid unbonding delegation entry found")
+	ErrOperatorStakeTooLow            = errors.Register(ModuleName, 24, "operator stake is lower than the service minimum")
+	ErrOperatorNotJailed              = errors.Register(ModuleName, 25, "operator is not jailed")
+	ErrOperatorTombstoned             = errors.Register(ModuleName, 26, "operator is tombstoned")
+	ErrOperatorJailPeriodNotEnded     = errors.Register(ModuleName, 27, "operator jail period has not ended yet")
+	ErrInvalidEvidence                = errors.Register(ModuleName, 28, "invalid evidence")
+	ErrEvidenceAlreadySubmitted       = errors.Register(ModuleName, 29, "evidence already submitted")
+	ErrNoEvidenceVerifier             = errors.Register(ModuleName, 30, "no evidence verifier found")
+	ErrPendingSlashNotFound           = errors.Register(ModuleName, 31, "pending slash not found")
+	ErrInvalidOperatorServiceKey      = errors.Register(ModuleName, 32, "invalid operator service key")
+	ErrInvalidProofOfPossession       = errors.Register(ModuleName, 33, "invalid proof of possession")
+	ErrOperatorServiceKeyNotFound     = errors.Register(ModuleName, 34, "operator service key not found")
+	ErrInvalidServiceUnbondingTime    = errors.Register(ModuleName, 35, "invalid service unbonding time")
 )
@@ -15,6 +15,25 @@ const (
 	EventTypeUnbondOperator          = "unbond_operator"
 	EventTypeUnbondService           = "unbond_service"
 	EventTypeSetUserPreferences      = "set_user_preferences"
+	EventTypeSlashOperator           = "slash_operator"
+	EventTypeRedelegatePool          = "redelegate_pool"
+	EventTypeRedelegateOperator      = "redelegate_operator"
+	EventTypeRedelegateService       = "redelegate_service"
+	EventTypeCompleteRedelegation    = "complete_redelegation"
+	EventTypeCancelUnbonding         = "cancel_unbonding"
+	EventTypeServiceActiveOperators  = "service_active_operators"
+	EventTypeServiceSnapshot         = "service_snapshot"
+	EventTypeJailOperator            = "jail_operator"
+	EventTypeUnjailOperator          = "unjail_operator"
+	EventTypeTombstoneOperator       = "tombstone_operator"
+	EventTypeSubmitOperatorEvidence  = "submit_operator_evidence"
+	EventTypeQueueSlash              = "queue_slash"
+	EventTypeVetoSlash               = "veto_slash"
+	EventTypeExecuteSlash            = "execute_slash"
+	EventTypeSlashFailed             = "slash_failed"
+	EventTypeDropSlash               = "drop_slash"
+	EventTypeSetOperatorServiceKey   = "set_operator_service_key"
+	EventTypeActivateServiceKey      = "activate_operator_service_key"
 
 	AttributeKeyDelegator            = "delegator"
 	AttributeKeyNewShares            = "new_shares"
@@ -22,4 +41,27 @@ const (
 	AttributeUnbondingDelegationType = "unbonding_delegation"
 	AttributeTargetID                = "target_id"
 	AttributeKeyUser                 = "user"
+	AttributeKeyFraction             = "fraction"
+	AttributeKeyInfractionHeight     = "infraction_height"
+	AttributeKeySrcTargetType        = "src_target_type"
+	AttributeKeySrcTargetID          = "src_target_id"
+	AttributeKeyDstTargetType        = "dst_target_type"
+	AttributeKeyDstTargetID          = "dst_target_id"
+	AttributeKeyCreationHeight       = "creation_height"
+	AttributeKeyOperatorIDs          = "operator_ids"
+	AttributeKeyEpoch                = "epoch"
+	AttributeKeyTotalPower           = "total_power"
+	AttributeKeyMerkleRoot           = "merkle_root"
+	AttributeKeyJailedUntil          = "jailed_until"
+	AttributeKeySubmitter            = "submitter"
+	AttributeKeyEvidenceHash         = "evidence_hash"
+	AttributeKeyPendingSlashID       = "pending_slash_id"
+	AttributeKeyExecutionTime        = "execution_time"
+	AttributeKeyTombstone            = "tombstone"
+	AttributeKeyError                = "error"
+	AttributeKeyRetries              = "retries"
+	AttributeKeySigner               = "signer"
+	AttributeKeyKeyType              = "key_type"
+	AttributeKeyPublicKey            = "public_key"
+	AttributeKeyActivationTime       = "activation_time"
 )
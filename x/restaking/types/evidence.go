@@ -0,0 +1,166 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// OperatorEvidence defines the contract which concrete evidence types of
+// operators misbehaviors must implement
+type OperatorEvidence interface {
+	proto.Message
+
+	// Route returns the route of the handler that should verify the evidence
+	Route() string
+
+	// Hash returns the hash that uniquely identifies the evidence
+	Hash() []byte
+
+	// ValidateBasic performs stateless checks on the evidence
+	ValidateBasic() error
+
+	// GetOperatorID returns the ID of the operator that misbehaved
+	GetOperatorID() uint32
+
+	// GetHeight returns the height at which the misbehavior occurred
+	GetHeight() int64
+}
+
+// GetOperatorEvidence returns the operator evidence contained inside the given Any
+func GetOperatorEvidence(unpacker codectypes.AnyUnpacker, evidenceAny *codectypes.Any) (OperatorEvidence, error) {
+	var evidence OperatorEvidence
+	err := unpacker.UnpackAny(evidenceAny, &evidence)
+	if err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// EvidenceVerdict represents the outcome of the verification of a valid
+// evidence, telling how the operator that misbehaved should be punished
+type EvidenceVerdict struct {
+	// SlashFraction is the fraction of the operator's security that should be
+	// slashed. If zero, the operator is not slashed
+	SlashFraction math.LegacyDec
+
+	// JailDuration is the amount of time for which the operator should be
+	// jailed from the service. If zero, the operator is not jailed
+	JailDuration time.Duration
+
+	// Tombstone tells whether the operator should be permanently jailed
+	Tombstone bool
+}
+
+// NewEvidenceVerdict returns a new EvidenceVerdict instance
+func NewEvidenceVerdict(slashFraction math.LegacyDec, jailDuration time.Duration, tombstone bool) EvidenceVerdict {
+	return EvidenceVerdict{
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+		Tombstone:     tombstone,
+	}
+}
+
+// Validate checks that the verdict is valid
+func (v EvidenceVerdict) Validate() error {
+	if !v.SlashFraction.IsNil() && (v.SlashFraction.IsNegative() || v.SlashFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("invalid slash fraction: %s", v.SlashFraction)
+	}
+
+	if v.JailDuration < 0 {
+		return fmt.Errorf("invalid jail duration: %s", v.JailDuration)
+	}
+
+	return nil
+}
+
+// ShouldSlash tells whether the operator should be slashed
+func (v EvidenceVerdict) ShouldSlash() bool {
+	return !v.SlashFraction.IsNil() && v.SlashFraction.IsPositive()
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// EvidenceHandler defines a handler that verifies the evidences of the
+// operators misbehaviors submitted for a service. If the evidence is valid, the
+// handler returns the verdict telling how the operator should be punished
+type EvidenceHandler func(ctx context.Context, serviceID uint32, evidence OperatorEvidence) (EvidenceVerdict, error)
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ OperatorEvidence = &ContractEvidence{}
+
+const (
+	// RouteContractEvidence is the route of the contract evidences
+	RouteContractEvidence = "contract"
+)
+
+// NewContractEvidence returns a new ContractEvidence instance
+func NewContractEvidence(operatorID uint32, height int64, data []byte) *ContractEvidence {
+	return &ContractEvidence{
+		OperatorID: operatorID,
+		Height:     height,
+		Data:       data,
+	}
+}
+
+// Route implements OperatorEvidence
+func (e *ContractEvidence) Route() string {
+	return RouteContractEvidence
+}
+
+// Hash implements OperatorEvidence
+func (e *ContractEvidence) Hash() []byte {
+	bz, err := proto.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic implements OperatorEvidence
+func (e *ContractEvidence) ValidateBasic() error {
+	if e.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id: %d", e.OperatorID)
+	}
+
+	if e.Height <= 0 {
+		return fmt.Errorf("invalid height: %d", e.Height)
+	}
+
+	if len(e.Data) == 0 {
+		return fmt.Errorf("empty evidence data")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewSubmittedEvidence returns a new SubmittedEvidence instance
+func NewSubmittedEvidence(serviceID uint32, hash []byte) SubmittedEvidence {
+	return SubmittedEvidence{
+		ServiceID: serviceID,
+		Hash:      hash,
+	}
+}
+
+// Validate checks that the submitted evidence is valid
+func (e SubmittedEvidence) Validate() error {
+	if e.ServiceID == 0 {
+		return fmt.Errorf("invalid service id: %d", e.ServiceID)
+	}
+
+	if len(e.Hash) == 0 {
+		return fmt.Errorf("empty evidence hash")
+	}
+
+	return nil
+}
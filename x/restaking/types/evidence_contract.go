@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	stdmath "math"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// VerifyOperatorEvidenceSudoMsg represents the message sent to the CosmWasm
+// contract set as the evidence verifier of a service in order to verify an
+// evidence submitted for such service
+type VerifyOperatorEvidenceSudoMsg struct {
+	VerifyOperatorEvidence VerifyOperatorEvidenceMsg `json:"verify_operator_evidence"`
+}
+
+type VerifyOperatorEvidenceMsg struct {
+	ServiceID    uint32 `json:"service_id"`
+	OperatorID   uint32 `json:"operator_id"`
+	Height       int64  `json:"height"`
+	Submitter    string `json:"submitter"`
+	EvidenceType string `json:"evidence_type"`
+	Evidence     []byte `json:"evidence"`
+}
+
+// ContractEvidenceVerdict represents the verdict returned by the CosmWasm
+// contract set as the evidence verifier of a service when an evidence is valid
+type ContractEvidenceVerdict struct {
+	SlashFraction       math.LegacyDec `json:"slash_fraction"`
+	JailDurationSeconds uint64         `json:"jail_duration_seconds"`
+	Tombstone           bool           `json:"tombstone"`
+}
+
+// ToEvidenceVerdict converts the contract verdict to an EvidenceVerdict,
+// returning an error if the jail duration can not be represented as a
+// time.Duration
+func (v ContractEvidenceVerdict) ToEvidenceVerdict() (EvidenceVerdict, error) {
+	if v.JailDurationSeconds > uint64(stdmath.MaxInt64/int64(time.Second)) {
+		return EvidenceVerdict{}, fmt.Errorf("invalid jail duration seconds: %d", v.JailDurationSeconds)
+	}
+
+	return NewEvidenceVerdict(v.SlashFraction, time.Duration(v.JailDurationSeconds)*time.Second, v.Tombstone), nil
+}
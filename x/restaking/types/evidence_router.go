@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EvidenceRouter defines the contract used to route the evidences of the
+// operators misbehaviors to the registered EvidenceHandler based on their
+// route
+type EvidenceRouter interface {
+	AddRoute(r string, h EvidenceHandler) EvidenceRouter
+	HasRoute(r string) bool
+	GetRoute(path string) EvidenceHandler
+	Seal()
+	Sealed() bool
+}
+
+type evidenceRouter struct {
+	routes map[string]EvidenceHandler
+	sealed bool
+}
+
+// NewEvidenceRouter returns a new EvidenceRouter instance
+func NewEvidenceRouter() EvidenceRouter {
+	return &evidenceRouter{
+		routes: make(map[string]EvidenceHandler),
+	}
+}
+
+// Seal prevents the router from any subsequent route handlers to be registered.
+// Seal will panic if called more than once.
+func (rtr *evidenceRouter) Seal() {
+	if rtr.sealed {
+		panic("evidence router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the router is sealed or not.
+func (rtr *evidenceRouter) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute adds an evidence handler for a given path. It returns the router
+// so AddRoute calls can be linked. It will panic if the router is sealed.
+func (rtr *evidenceRouter) AddRoute(path string, h EvidenceHandler) EvidenceRouter {
+	if rtr.sealed {
+		panic(fmt.Sprintf("evidence router sealed; cannot register %s route handler", path))
+	}
+	if !sdk.IsAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+	if path == RouteContractEvidence {
+		panic(fmt.Sprintf("route %s is reserved to the contract evidences", path))
+	}
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been registered", path))
+	}
+
+	rtr.routes[path] = h
+	return rtr
+}
+
+// HasRoute returns true if the router has a path registered or false otherwise.
+func (rtr *evidenceRouter) HasRoute(path string) bool {
+	return rtr.routes[path] != nil
+}
+
+// GetRoute returns an EvidenceHandler for a given path.
+func (rtr *evidenceRouter) GetRoute(path string) EvidenceHandler {
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route does not exist for path %s", path))
+	}
+	return rtr.routes[path]
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+func TestContractEvidence_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		evidence  *types.ContractEvidence
+		shouldErr bool
+	}{
+		{
+			name:      "invalid operator id returns error",
+			evidence:  types.NewContractEvidence(0, 10, []byte("proof")),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid height returns error",
+			evidence:  types.NewContractEvidence(1, 0, []byte("proof")),
+			shouldErr: true,
+		},
+		{
+			name:      "empty data returns error",
+			evidence:  types.NewContractEvidence(1, 10, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "valid evidence returns no error",
+			evidence:  types.NewContractEvidence(1, 10, []byte("proof")),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.evidence.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContractEvidence_Hash(t *testing.T) {
+	evidence := types.NewContractEvidence(1, 10, []byte("proof"))
+	require.Equal(t, evidence.Hash(), types.NewContractEvidence(1, 10, []byte("proof")).Hash())
+	require.NotEqual(t, evidence.Hash(), types.NewContractEvidence(2, 10, []byte("proof")).Hash())
+}
+
+func TestEvidenceVerdict_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		verdict   types.EvidenceVerdict
+		shouldErr bool
+	}{
+		{
+			name:      "negative slash fraction returns error",
+			verdict:   types.NewEvidenceVerdict(sdkmath.LegacyNewDec(-1), 0, false),
+			shouldErr: true,
+		},
+		{
+			name:      "slash fraction greater than one returns error",
+			verdict:   types.NewEvidenceVerdict(sdkmath.LegacyNewDec(2), 0, false),
+			shouldErr: true,
+		},
+		{
+			name:      "negative jail duration returns error",
+			verdict:   types.NewEvidenceVerdict(sdkmath.LegacyZeroDec(), -time.Hour, false),
+			shouldErr: true,
+		},
+		{
+			name:      "nil slash fraction returns no error",
+			verdict:   types.NewEvidenceVerdict(sdkmath.LegacyDec{}, time.Hour, false),
+			shouldErr: false,
+		},
+		{
+			name:      "valid verdict returns no error",
+			verdict:   types.NewEvidenceVerdict(sdkmath.LegacyNewDecWithPrec(1, 1), time.Hour, true),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.verdict.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContractEvidenceVerdict_ToEvidenceVerdict(t *testing.T) {
+	testCases := []struct {
+		name      string
+		verdict   types.ContractEvidenceVerdict
+		shouldErr bool
+		expected  types.EvidenceVerdict
+	}{
+		{
+			name:      "overflowing jail duration returns error",
+			verdict:   types.ContractEvidenceVerdict{JailDurationSeconds: math.MaxUint64},
+			shouldErr: true,
+		},
+		{
+			name:      "jail duration just above the max duration returns error",
+			verdict:   types.ContractEvidenceVerdict{JailDurationSeconds: uint64(math.MaxInt64/int64(time.Second)) + 1},
+			shouldErr: true,
+		},
+		{
+			name:      "max jail duration is converted properly",
+			verdict:   types.ContractEvidenceVerdict{JailDurationSeconds: uint64(math.MaxInt64 / int64(time.Second))},
+			shouldErr: false,
+			expected:  types.NewEvidenceVerdict(sdkmath.LegacyDec{}, time.Duration(math.MaxInt64/int64(time.Second))*time.Second, false),
+		},
+		{
+			name: "valid verdict is converted properly",
+			verdict: types.ContractEvidenceVerdict{
+				SlashFraction:       sdkmath.LegacyNewDecWithPrec(1, 1),
+				JailDurationSeconds: 1800,
+				Tombstone:           true,
+			},
+			shouldErr: false,
+			expected:  types.NewEvidenceVerdict(sdkmath.LegacyNewDecWithPrec(1, 1), 30*time.Minute, true),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			verdict, err := tc.verdict.ToEvidenceVerdict()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, verdict)
+			}
+		})
+	}
+}
+
+func TestEvidenceRouter(t *testing.T) {
+	handler := func(context.Context, uint32, types.OperatorEvidence) (types.EvidenceVerdict, error) {
+		return types.EvidenceVerdict{}, nil
+	}
+
+	router := types.NewEvidenceRouter()
+	router.AddRoute("doublesign", handler)
+	require.True(t, router.HasRoute("doublesign"))
+	require.NotNil(t, router.GetRoute("doublesign"))
+	require.False(t, router.HasRoute("downtime"))
+
+	require.Panics(t, func() { router.AddRoute("doublesign", handler) })
+	require.Panics(t, func() { router.AddRoute(types.RouteContractEvidence, handler) })
+	require.Panics(t, func() { router.AddRoute("double-sign", handler) })
+	require.Panics(t, func() { router.GetRoute("downtime") })
+
+	router.Seal()
+	require.True(t, router.Sealed())
+	require.Panics(t, func() { router.AddRoute("downtime", handler) })
+	require.Panics(t, func() { router.Seal() })
+}
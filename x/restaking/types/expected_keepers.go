@@ -2,11 +2,11 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
-	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
 	assetstypes "github.com/milkyway-labs/milkyway/v12/x/assets/types"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
@@ -20,6 +20,8 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
@@ -41,6 +43,9 @@ type OperatorsKeeper interface {
 	GetOperators(ctx context.Context) ([]operatorstypes.Operator, error)
 	SaveOperatorParams(ctx context.Context, operatorID uint32, params operatorstypes.OperatorParams) error
 	GetOperatorParams(ctx context.Context, operatorID uint32) (operatorstypes.OperatorParams, error)
+	JailOperator(ctx context.Context, operator operatorstypes.Operator) error
+	IsOperatorJailed(ctx context.Context, operatorID uint32) (bool, error)
+	HasOperatorPermission(ctx context.Context, operator operatorstypes.Operator, address string, role operatorstypes.OperatorRole) (bool, error)
 }
 
 type ServicesKeeper interface {
@@ -51,13 +56,13 @@ type ServicesKeeper interface {
 	GetServices(ctx context.Context) ([]servicestypes.Service, error)
 	DeactivateService(ctx context.Context, serviceID uint32) error
 	GetServiceParams(ctx context.Context, serviceID uint32) (servicestypes.ServiceParams, error)
+	HasServicePermission(ctx context.Context, service servicestypes.Service, address string, role servicestypes.ServiceRole) (bool, error)
 }
 
-type OracleKeeper interface {
-	GetPriceWithNonceForCurrencyPair(ctx sdk.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePriceWithNonce, error)
-	GetDecimalsForCurrencyPair(ctx sdk.Context, cp connecttypes.CurrencyPair) (decimals uint64, err error)
+type AssetsKeeper interface {
+	GetAssetPrice(ctx context.Context, denom string) (assetstypes.Asset, math.LegacyDec, time.Time, error)
 }
 
-type AssetsKeeper interface {
-	GetAsset(ctx context.Context, denom string) (assetstypes.Asset, error)
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 }
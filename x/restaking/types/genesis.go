@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,16 +17,32 @@ func NewGenesis(
 	delegations []Delegation,
 	unbondingDelegations []UnbondingDelegation,
 	usersPreferencesEntries []UserPreferencesEntry,
+	redelegations []Redelegation,
+	servicesSnapshots []ServiceSnapshot,
+	operatorsJailRecords []OperatorJailRecord,
+	submittedEvidences []SubmittedEvidence,
+	pendingSlashes []PendingSlash,
+	nextPendingSlashID uint64,
+	operatorsServiceKeys []OperatorServiceKey,
+	pendingOperatorsServiceKeys []OperatorServiceKey,
 	params Params,
 ) *GenesisState {
 	return &GenesisState{
-		OperatorsJoinedServices:  operatorsJoinedServices,
-		ServicesAllowedOperators: servicesAllowedOperators,
-		ServicesSecuringPools:    servicesSecuringPools,
-		Delegations:              delegations,
-		UnbondingDelegations:     unbondingDelegations,
-		UsersPreferences:         usersPreferencesEntries,
-		Params:                   params,
+		OperatorsJoinedServices:     operatorsJoinedServices,
+		ServicesAllowedOperators:    servicesAllowedOperators,
+		ServicesSecuringPools:       servicesSecuringPools,
+		Delegations:                 delegations,
+		UnbondingDelegations:        unbondingDelegations,
+		UsersPreferences:            usersPreferencesEntries,
+		Redelegations:               redelegations,
+		ServicesSnapshots:           servicesSnapshots,
+		OperatorsJailRecords:        operatorsJailRecords,
+		SubmittedEvidences:          submittedEvidences,
+		PendingSlashes:              pendingSlashes,
+		NextPendingSlashID:          nextPendingSlashID,
+		OperatorsServiceKeys:        operatorsServiceKeys,
+		PendingOperatorsServiceKeys: pendingOperatorsServiceKeys,
+		Params:                      params,
 	}
 }
 
@@ -38,6 +55,14 @@ func DefaultGenesis() *GenesisState {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		1,
+		nil,
+		nil,
 		DefaultParams(),
 	)
 }
@@ -95,6 +120,89 @@ func (g *GenesisState) Validate() error {
 		}
 	}
 
+	// Validate redelegations
+	for _, entry := range g.Redelegations {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid redelegation: %w", err)
+		}
+	}
+
+	// Validate the services snapshots
+	if duplicate := findDuplicateServiceSnapshots(g.ServicesSnapshots); duplicate != nil {
+		return fmt.Errorf("duplicated service snapshot, service id: %d, epoch: %d", duplicate.ServiceID, duplicate.Epoch)
+	}
+
+	for _, entry := range g.ServicesSnapshots {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid service snapshot: %w", err)
+		}
+	}
+
+	// Validate the operators jail records
+	if duplicate := findDuplicateOperatorJailRecords(g.OperatorsJailRecords); duplicate != nil {
+		return fmt.Errorf("duplicated operator jail record, service id: %d, operator id: %d", duplicate.ServiceID, duplicate.OperatorID)
+	}
+
+	for _, entry := range g.OperatorsJailRecords {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid operator jail record: %w", err)
+		}
+	}
+
+	// Validate the submitted evidences
+	if duplicate := findDuplicateSubmittedEvidences(g.SubmittedEvidences); duplicate != nil {
+		return fmt.Errorf("duplicated submitted evidence, service id: %d, hash: %X", duplicate.ServiceID, duplicate.Hash)
+	}
+
+	for _, entry := range g.SubmittedEvidences {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid submitted evidence: %w", err)
+		}
+	}
+
+	// Validate the pending slashes
+	if duplicate := findDuplicatePendingSlashes(g.PendingSlashes); duplicate != nil {
+		return fmt.Errorf("duplicated pending slash, id: %d", duplicate.ID)
+	}
+
+	for _, entry := range g.PendingSlashes {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid pending slash: %w", err)
+		}
+
+		if entry.ID >= g.NextPendingSlashID {
+			return fmt.Errorf("pending slash id %d is not lower than next pending slash id %d", entry.ID, g.NextPendingSlashID)
+		}
+	}
+
+	// Validate the operators service keys
+	if duplicate := findDuplicateOperatorServiceKeys(g.OperatorsServiceKeys); duplicate != nil {
+		return fmt.Errorf("duplicated operator service key, operator id: %d, service id: %d", duplicate.OperatorID, duplicate.ServiceID)
+	}
+
+	for _, entry := range g.OperatorsServiceKeys {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid operator service key: %w", err)
+		}
+	}
+
+	if duplicate := findDuplicateOperatorServiceKeys(g.PendingOperatorsServiceKeys); duplicate != nil {
+		return fmt.Errorf("duplicated pending operator service key, operator id: %d, service id: %d", duplicate.OperatorID, duplicate.ServiceID)
+	}
+
+	for _, entry := range g.PendingOperatorsServiceKeys {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid pending operator service key: %w", err)
+		}
+	}
+
 	for _, entry := range g.UsersPreferences {
 		err := entry.Validate()
 		if err != nil {
@@ -129,6 +237,36 @@ func findDuplicateServiceSecuringPools(records []ServiceSecuringPools) *ServiceS
 	})
 }
 
+func findDuplicateServiceSnapshots(records []ServiceSnapshot) *ServiceSnapshot {
+	return utils.FindDuplicateFunc(records, func(a, b ServiceSnapshot) bool {
+		return a.ServiceID == b.ServiceID && a.Epoch == b.Epoch
+	})
+}
+
+func findDuplicateOperatorJailRecords(records []OperatorJailRecord) *OperatorJailRecord {
+	return utils.FindDuplicateFunc(records, func(a, b OperatorJailRecord) bool {
+		return a.ServiceID == b.ServiceID && a.OperatorID == b.OperatorID
+	})
+}
+
+func findDuplicatePendingSlashes(records []PendingSlash) *PendingSlash {
+	return utils.FindDuplicateFunc(records, func(a, b PendingSlash) bool {
+		return a.ID == b.ID
+	})
+}
+
+func findDuplicateOperatorServiceKeys(records []OperatorServiceKey) *OperatorServiceKey {
+	return utils.FindDuplicateFunc(records, func(a, b OperatorServiceKey) bool {
+		return a.OperatorID == b.OperatorID && a.ServiceID == b.ServiceID
+	})
+}
+
+func findDuplicateSubmittedEvidences(records []SubmittedEvidence) *SubmittedEvidence {
+	return utils.FindDuplicateFunc(records, func(a, b SubmittedEvidence) bool {
+		return a.ServiceID == b.ServiceID && bytes.Equal(a.Hash, b.Hash)
+	})
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewOperatorJoinedServices creates a new instance of OperatorServiceIdRecord.
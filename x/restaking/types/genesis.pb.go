@@ -265,6 +265,28 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 	// UserPreferences represents the user preferences.
 	UsersPreferences []UserPreferencesEntry `protobuf:"bytes,7,rep,name=users_preferences,json=usersPreferences,proto3" json:"users_preferences"`
+	// Redelegations represents the redelegations.
+	Redelegations []Redelegation `protobuf:"bytes,8,rep,name=redelegations,proto3" json:"redelegations"`
+	// ServicesSnapshots represents the snapshots of the services' validating
+	// sets.
+	ServicesSnapshots []ServiceSnapshot `protobuf:"bytes,9,rep,name=services_snapshots,json=servicesSnapshots,proto3" json:"services_snapshots"`
+	// OperatorsJailRecords represents the records of the operators that have
+	// been jailed from the services.
+	OperatorsJailRecords []OperatorJailRecord `protobuf:"bytes,10,rep,name=operators_jail_records,json=operatorsJailRecords,proto3" json:"operators_jail_records"`
+	// SubmittedEvidences represents the evidences of the operators misbehaviors
+	// that have already been submitted.
+	SubmittedEvidences []SubmittedEvidence `protobuf:"bytes,11,rep,name=submitted_evidences,json=submittedEvidences,proto3" json:"submitted_evidences"`
+	// PendingSlashes represents the slashes that are waiting to be executed.
+	PendingSlashes []PendingSlash `protobuf:"bytes,12,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
+	// NextPendingSlashID represents the ID that will be assigned to the next
+	// pending slash.
+	NextPendingSlashID uint64 `protobuf:"varint,13,opt,name=next_pending_slash_id,json=nextPendingSlashId,proto3" json:"next_pending_slash_id,omitempty"`
+	// OperatorsServiceKeys represents the keys currently used by the operators
+	// for the services.
+	OperatorsServiceKeys []OperatorServiceKey `protobuf:"bytes,14,rep,name=operators_service_keys,json=operatorsServiceKeys,proto3" json:"operators_service_keys"`
+	// PendingOperatorsServiceKeys represents the keys registered by the
+	// operators that will replace the current ones once activated.
+	PendingOperatorsServiceKeys []OperatorServiceKey `protobuf:"bytes,15,rep,name=pending_operators_service_keys,json=pendingOperatorsServiceKeys,proto3" json:"pending_operators_service_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -349,6 +371,62 @@ func (m *GenesisState) GetUsersPreferences() []UserPreferencesEntry {
 	return nil
 }
 
+func (m *GenesisState) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetServicesSnapshots() []ServiceSnapshot {
+	if m != nil {
+		return m.ServicesSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorsJailRecords() []OperatorJailRecord {
+	if m != nil {
+		return m.OperatorsJailRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubmittedEvidences() []SubmittedEvidence {
+	if m != nil {
+		return m.SubmittedEvidences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSlashes() []PendingSlash {
+	if m != nil {
+		return m.PendingSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPendingSlashID() uint64 {
+	if m != nil {
+		return m.NextPendingSlashID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOperatorsServiceKeys() []OperatorServiceKey {
+	if m != nil {
+		return m.OperatorsServiceKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOperatorsServiceKeys() []OperatorServiceKey {
+	if m != nil {
+		return m.PendingOperatorsServiceKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OperatorJoinedServices)(nil), "milkyway.restaking.v1.OperatorJoinedServices")
 	proto.RegisterType((*ServiceAllowedOperators)(nil), "milkyway.restaking.v1.ServiceAllowedOperators")
@@ -362,46 +440,59 @@ func init() {
 }
 
 var fileDescriptor_0378bd63cae7d256 = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x8e, 0xd2, 0x40,
-	0x1c, 0xc7, 0xe9, 0xfe, 0x01, 0x99, 0xb2, 0xfe, 0x69, 0x40, 0x2a, 0x89, 0x85, 0xc5, 0x64, 0xb3,
-	0x51, 0xb7, 0x0d, 0x78, 0xf0, 0xe0, 0x69, 0x37, 0x18, 0x83, 0x07, 0xc5, 0x12, 0x2f, 0x1e, 0x6c,
-	0x0a, 0x1d, 0x6b, 0xdd, 0xd2, 0x69, 0xe6, 0x57, 0x58, 0xd1, 0x27, 0xf0, 0xe6, 0x8b, 0xf8, 0x1e,
-	0x7b, 0xdc, 0xa3, 0x27, 0x62, 0xca, 0x8b, 0x98, 0xb6, 0x33, 0xa5, 0xbb, 0x50, 0x92, 0xbd, 0x41,
-	0xe7, 0xfb, 0xfb, 0x7c, 0x26, 0xd3, 0x6f, 0x07, 0x3d, 0x99, 0x38, 0xee, 0xf9, 0xfc, 0xc2, 0x9c,
-	0x6b, 0x14, 0x43, 0x60, 0x9e, 0x3b, 0x9e, 0xad, 0xcd, 0x3a, 0x9a, 0x8d, 0x3d, 0x0c, 0x0e, 0xa8,
-	0x3e, 0x25, 0x01, 0x91, 0x6a, 0x3c, 0xa4, 0xa6, 0x21, 0x75, 0xd6, 0x69, 0x54, 0x6d, 0x62, 0x93,
-	0x38, 0xa1, 0x45, 0xbf, 0x92, 0x70, 0xa3, 0xbd, 0x99, 0x38, 0x21, 0x16, 0x76, 0x61, 0x7b, 0xc6,
-	0x37, 0xa9, 0x39, 0x61, 0x99, 0xf6, 0x0f, 0xf4, 0xf0, 0xbd, 0x8f, 0xa9, 0x19, 0x10, 0xfa, 0x96,
-	0x38, 0x1e, 0xb6, 0x86, 0x98, 0xce, 0x9c, 0x31, 0x06, 0x49, 0x43, 0x22, 0x61, 0x2b, 0x86, 0x63,
-	0xc9, 0x42, 0x4b, 0x38, 0x3e, 0x38, 0xbb, 0x1b, 0x2e, 0x9a, 0x88, 0x0f, 0xf4, 0x7b, 0x3a, 0xe2,
-	0x91, 0xbe, 0x15, 0x0d, 0x40, 0x32, 0x6c, 0x38, 0x16, 0xc8, 0x3b, 0xad, 0x5d, 0x3e, 0xc0, 0x98,
-	0xfd, 0x1e, 0xe8, 0x88, 0x45, 0xfa, 0x16, 0xb4, 0x7f, 0xa2, 0x3a, 0x5b, 0x39, 0x75, 0x5d, 0x72,
-	0x81, 0x2d, 0x0e, 0x06, 0xe9, 0x39, 0x42, 0x2b, 0x16, 0x73, 0x1f, 0x84, 0x8b, 0x66, 0x39, 0x45,
-	0xe9, 0xe5, 0x94, 0x24, 0x75, 0x51, 0x25, 0xb3, 0x55, 0xae, 0xbe, 0x17, 0x2e, 0x9a, 0xe2, 0x6a,
-	0xaf, 0xa0, 0x8b, 0xab, 0xcd, 0x42, 0xdb, 0x45, 0x55, 0xc6, 0x1a, 0xe2, 0xf1, 0x94, 0x3a, 0x9e,
-	0x3d, 0x20, 0xc4, 0xbd, 0xad, 0xf9, 0x08, 0xdd, 0xf1, 0x09, 0x71, 0x33, 0x56, 0x31, 0x5c, 0x34,
-	0x4b, 0x11, 0x2a, 0x32, 0x96, 0xa2, 0xc5, 0xc8, 0xf6, 0x4b, 0x40, 0xd5, 0x8f, 0x80, 0xe9, 0x80,
-	0xe2, 0x2f, 0x98, 0x62, 0x6f, 0x8c, 0xe1, 0xb5, 0x17, 0xd0, 0xb9, 0x74, 0x88, 0x2a, 0x53, 0xc0,
-	0xd4, 0x30, 0x2d, 0x8b, 0x62, 0x80, 0x58, 0x58, 0xd6, 0xc5, 0xe8, 0xd9, 0x69, 0xf2, 0x48, 0x7a,
-	0x87, 0x44, 0x7f, 0x35, 0x26, 0xef, 0xb4, 0x84, 0x63, 0xb1, 0x7b, 0xa4, 0x6e, 0x6c, 0x8b, 0x7a,
-	0x43, 0x72, 0xb6, 0x77, 0xb9, 0x68, 0x16, 0xf4, 0x2c, 0xa0, 0xfd, 0x67, 0x1f, 0x55, 0xde, 0x24,
-	0xcd, 0x1b, 0x06, 0x66, 0x80, 0xa5, 0x57, 0xa8, 0x98, 0x74, 0x22, 0xb6, 0x8b, 0xdd, 0xc7, 0x39,
-	0xec, 0x41, 0x1c, 0x62, 0x48, 0x36, 0x22, 0x11, 0xf4, 0x88, 0x1f, 0x2b, 0x18, 0xdf, 0xe2, 0x0a,
-	0x19, 0xec, 0x7c, 0x92, 0x23, 0x11, 0xbb, 0x27, 0x39, 0xbc, 0xcd, 0xc5, 0x63, 0xfc, 0x7a, 0x4a,
-	0xbd, 0xd1, 0x4b, 0x8a, 0x1a, 0x9c, 0x6f, 0x98, 0x49, 0x6f, 0x8c, 0x34, 0x2b, 0xef, 0xc6, 0x46,
-	0x35, 0xc7, 0x98, 0x53, 0x37, 0xa6, 0x94, 0x39, 0x77, 0xad, 0x8e, 0x0e, 0xaa, 0xa7, 0x4e, 0x60,
-	0x75, 0x31, 0xa2, 0x77, 0x0b, 0xf2, 0x5e, 0x2c, 0x7c, 0xb6, 0x5d, 0x78, 0xad, 0x62, 0xcc, 0x56,
-	0xe3, 0xc4, 0xeb, 0xfd, 0xeb, 0x23, 0xd1, 0xc2, 0x2e, 0xb6, 0xcd, 0xc0, 0x21, 0x1e, 0xc8, 0xfb,
-	0x31, 0xfe, 0x30, 0x07, 0xdf, 0x4b, 0x93, 0xfc, 0x45, 0x67, 0x66, 0x25, 0x8c, 0x6a, 0x53, 0x6f,
-	0x44, 0x3c, 0x2b, 0xda, 0x6d, 0x16, 0x5a, 0x8c, 0xa1, 0x4f, 0xf3, 0x2a, 0xc4, 0x67, 0xd6, 0xe8,
-	0xd5, 0xe9, 0xfa, 0x12, 0x48, 0x9f, 0xd1, 0x83, 0xa8, 0xae, 0x60, 0x64, 0x5b, 0x5a, 0xda, 0x7a,
-	0x2c, 0x9b, 0x3e, 0x05, 0xe6, 0xb8, 0x1f, 0xb3, 0xb2, 0x15, 0xfe, 0x70, 0x19, 0x2a, 0xc2, 0x55,
-	0xa8, 0x08, 0xff, 0x42, 0x45, 0xf8, 0xbd, 0x54, 0x0a, 0x57, 0x4b, 0xa5, 0xf0, 0x77, 0xa9, 0x14,
-	0x3e, 0xbd, 0xb4, 0x9d, 0xe0, 0xeb, 0x74, 0xa4, 0x8e, 0xc9, 0x44, 0xe3, 0xa2, 0x13, 0xd7, 0x1c,
-	0x41, 0xfa, 0x4f, 0x9b, 0x75, 0xba, 0xda, 0xf7, 0xcc, 0xfd, 0x17, 0xcc, 0x7d, 0x0c, 0xa3, 0x62,
-	0x7c, 0xf9, 0xbd, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xc0, 0xef, 0x8d, 0x98, 0x05, 0x00,
-	0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0xe3, 0x6d, 0x69, 0x37, 0xe3, 0xa4, 0x65, 0x87, 0xa4, 0x35, 0x45, 0x24, 0xd9, 0xac,
+	0xb4, 0x0a, 0x7f, 0x36, 0x56, 0xc3, 0x81, 0x03, 0xa7, 0x8d, 0xb2, 0x42, 0x01, 0x69, 0x1b, 0x1c,
+	0x71, 0x01, 0x09, 0xcb, 0x89, 0x5f, 0x52, 0x53, 0xc7, 0x63, 0xcd, 0xeb, 0xa4, 0x0d, 0x7c, 0x02,
+	0x6e, 0x7c, 0x03, 0xbe, 0x4e, 0x8f, 0x3d, 0x72, 0x8a, 0x90, 0xfb, 0x45, 0x90, 0xed, 0x19, 0xc7,
+	0xf9, 0xe3, 0x00, 0x7b, 0x4b, 0xe6, 0x7d, 0xde, 0xdf, 0x33, 0x7e, 0xe7, 0x19, 0x9b, 0xbc, 0x98,
+	0x3a, 0xee, 0xcd, 0xe2, 0xd6, 0x5a, 0xe8, 0x1c, 0x30, 0xb0, 0x6e, 0x1c, 0x6f, 0xa2, 0xcf, 0x2f,
+	0xf5, 0x09, 0x78, 0x80, 0x0e, 0xb6, 0x7d, 0xce, 0x02, 0x46, 0xab, 0x52, 0xd4, 0x4e, 0x45, 0xed,
+	0xf9, 0xe5, 0x45, 0x65, 0xc2, 0x26, 0x2c, 0x56, 0xe8, 0xd1, 0xaf, 0x44, 0x7c, 0xd1, 0xdc, 0x4d,
+	0x9c, 0x32, 0x1b, 0x5c, 0xdc, 0xaf, 0xf1, 0x2d, 0x6e, 0x4d, 0x85, 0xa6, 0xf9, 0x2b, 0x39, 0xbb,
+	0xf2, 0x81, 0x5b, 0x01, 0xe3, 0xdf, 0x30, 0xc7, 0x03, 0x7b, 0x08, 0x7c, 0xee, 0x8c, 0x01, 0xa9,
+	0x4e, 0x54, 0x26, 0x2a, 0xa6, 0x63, 0x6b, 0x4a, 0x43, 0x69, 0x95, 0xbb, 0x27, 0xe1, 0xb2, 0x4e,
+	0x64, 0x43, 0xbf, 0x67, 0x10, 0x29, 0xe9, 0xdb, 0x51, 0x03, 0x26, 0xcd, 0xa6, 0x63, 0xa3, 0xf6,
+	0xa4, 0x71, 0x20, 0x1b, 0x04, 0xb3, 0xdf, 0x43, 0x83, 0x08, 0x49, 0xdf, 0xc6, 0xe6, 0x6f, 0xe4,
+	0x5c, 0x54, 0x5e, 0xbb, 0x2e, 0xbb, 0x05, 0x5b, 0x82, 0x91, 0x7e, 0x4e, 0xc8, 0x8a, 0x25, 0xbc,
+	0xcb, 0xe1, 0xb2, 0x5e, 0x4c, 0x51, 0x46, 0x31, 0x25, 0xd1, 0x0e, 0x29, 0x65, 0xb6, 0x2a, 0xad,
+	0x4f, 0xc3, 0x65, 0x5d, 0x5d, 0xed, 0x15, 0x0d, 0x75, 0xb5, 0x59, 0x6c, 0xba, 0xa4, 0x22, 0x58,
+	0x43, 0x18, 0xcf, 0xb8, 0xe3, 0x4d, 0x06, 0x8c, 0xb9, 0xff, 0xd7, 0xf9, 0x25, 0x79, 0xea, 0x33,
+	0xe6, 0x66, 0x5c, 0xd5, 0x70, 0x59, 0x3f, 0x8e, 0x50, 0x91, 0xe3, 0x71, 0x54, 0x8c, 0xdc, 0x7e,
+	0x57, 0x48, 0xe5, 0x7b, 0x04, 0x3e, 0xe0, 0xf0, 0x33, 0x70, 0xf0, 0xc6, 0x80, 0x6f, 0xbc, 0x80,
+	0x2f, 0xe8, 0x73, 0x52, 0x9a, 0x21, 0x70, 0xd3, 0xb2, 0x6d, 0x0e, 0x88, 0xb1, 0x61, 0xd1, 0x50,
+	0xa3, 0xb5, 0xd7, 0xc9, 0x12, 0x7d, 0x4b, 0x54, 0x7f, 0xd5, 0xa6, 0x3d, 0x69, 0x28, 0x2d, 0xb5,
+	0xf3, 0xb2, 0xbd, 0x33, 0x2d, 0xed, 0x0d, 0x93, 0xee, 0xe1, 0xfd, 0xb2, 0x5e, 0x30, 0xb2, 0x80,
+	0xe6, 0x9f, 0x2a, 0x29, 0x7d, 0x9d, 0x24, 0x6f, 0x18, 0x58, 0x01, 0xd0, 0xaf, 0xc8, 0x51, 0x92,
+	0x89, 0xd8, 0x5d, 0xed, 0x7c, 0x9c, 0xc3, 0x1e, 0xc4, 0x22, 0x81, 0x14, 0x2d, 0x94, 0x91, 0x0f,
+	0xe5, 0x58, 0xd1, 0xfc, 0x25, 0x8e, 0x90, 0x29, 0xe6, 0x93, 0x8c, 0x44, 0xed, 0xbc, 0xca, 0xe1,
+	0xed, 0x0e, 0x9e, 0xe0, 0x9f, 0xa7, 0xd4, 0x8d, 0x5c, 0x72, 0x72, 0x21, 0xf9, 0xa6, 0x95, 0xe4,
+	0xc6, 0x4c, 0xb5, 0xda, 0x41, 0xec, 0xd8, 0xce, 0x71, 0xcc, 0x89, 0x9b, 0xb0, 0xd4, 0x24, 0x77,
+	0x2b, 0x8e, 0x0e, 0x39, 0x4f, 0x3d, 0x51, 0xc4, 0xc5, 0x8c, 0xce, 0x16, 0xb5, 0xc3, 0xd8, 0xf0,
+	0xb3, 0xfd, 0x86, 0x6b, 0x11, 0x13, 0x6e, 0x55, 0x49, 0x5c, 0xcf, 0x5f, 0x9f, 0xa8, 0x36, 0xb8,
+	0x30, 0xb1, 0x02, 0x87, 0x79, 0xa8, 0xbd, 0x17, 0xe3, 0x9f, 0xe7, 0xe0, 0x7b, 0xa9, 0x52, 0x1e,
+	0x74, 0xa6, 0x97, 0x02, 0xa9, 0xce, 0xbc, 0x11, 0xf3, 0xec, 0x68, 0xb7, 0x59, 0xe8, 0x51, 0x0c,
+	0xfd, 0x34, 0x2f, 0x42, 0xb2, 0x67, 0x8b, 0x5e, 0x99, 0x6d, 0x97, 0x90, 0xfe, 0x44, 0x9e, 0x45,
+	0x71, 0x45, 0x33, 0x9b, 0xd2, 0xe3, 0xbd, 0x63, 0xd9, 0x75, 0x15, 0x84, 0xc7, 0xfb, 0x31, 0x2b,
+	0x53, 0xa4, 0x57, 0xa4, 0xcc, 0x21, 0xbb, 0xfd, 0xa7, 0x31, 0xfb, 0x45, 0x0e, 0xdb, 0xc8, 0x68,
+	0x05, 0x73, 0xbd, 0x9f, 0xfe, 0x48, 0xe8, 0xea, 0x34, 0x3d, 0xcb, 0xc7, 0x6b, 0x16, 0xa0, 0x56,
+	0x6c, 0x1c, 0xec, 0xb9, 0x57, 0xf2, 0x20, 0x85, 0x5c, 0x80, 0x9f, 0xa5, 0x67, 0x28, 0x31, 0x14,
+	0xc8, 0x59, 0xe6, 0x3e, 0x58, 0x8e, 0x6b, 0x72, 0x18, 0x33, 0x6e, 0xa3, 0x46, 0x62, 0x83, 0x4f,
+	0xfe, 0xed, 0x32, 0x58, 0x8e, 0x6b, 0xc4, 0x1d, 0x72, 0xe8, 0xab, 0x8b, 0x90, 0x96, 0x90, 0x9a,
+	0xe4, 0x03, 0x9c, 0x8d, 0xa6, 0x4e, 0x10, 0x80, 0x6d, 0xc2, 0xdc, 0xb1, 0x93, 0xb1, 0xab, 0xb1,
+	0x47, 0x2b, 0xef, 0x21, 0x64, 0xc7, 0x1b, 0xd1, 0x20, 0x2c, 0x28, 0x6e, 0x16, 0x90, 0x1a, 0xe4,
+	0xd4, 0x87, 0x24, 0x3a, 0xe8, 0x5a, 0x78, 0x0d, 0xa8, 0x95, 0xf6, 0xce, 0x7d, 0x90, 0xa8, 0x87,
+	0x91, 0x58, 0x70, 0x4f, 0xfc, 0xcc, 0x1a, 0x44, 0xd9, 0xae, 0x7a, 0x70, 0x17, 0x98, 0x6b, 0xe0,
+	0xe8, 0x35, 0x5b, 0x6e, 0x28, 0xad, 0xc3, 0xee, 0x59, 0xb8, 0xac, 0xd3, 0xb7, 0x70, 0x17, 0x64,
+	0x51, 0xfd, 0x9e, 0x41, 0xbd, 0xcd, 0x35, 0x7b, 0x7d, 0xcc, 0xf2, 0x85, 0x7d, 0x03, 0x0b, 0xd4,
+	0x4e, 0xfe, 0xd3, 0x98, 0xc5, 0x79, 0x7e, 0x0b, 0x8b, 0xad, 0x31, 0xaf, 0x4a, 0x48, 0x03, 0x52,
+	0x93, 0x9b, 0xcd, 0xb1, 0x3b, 0x7d, 0x37, 0xbb, 0x8f, 0x04, 0xf6, 0x6a, 0x87, 0x6b, 0xf7, 0xbb,
+	0xfb, 0xb0, 0xa6, 0x3c, 0x84, 0x35, 0xe5, 0xef, 0xb0, 0xa6, 0xfc, 0xf1, 0x58, 0x2b, 0x3c, 0x3c,
+	0xd6, 0x0a, 0x7f, 0x3d, 0xd6, 0x0a, 0x3f, 0x7c, 0x39, 0x71, 0x82, 0xeb, 0xd9, 0xa8, 0x3d, 0x66,
+	0x53, 0x5d, 0x3a, 0xbe, 0x72, 0xad, 0x11, 0xa6, 0xff, 0xf4, 0xf9, 0x65, 0x47, 0xbf, 0xcb, 0x7c,
+	0xf1, 0x83, 0x85, 0x0f, 0x38, 0x3a, 0x8a, 0x3f, 0xf7, 0x5f, 0xfc, 0x33, 0x00, 0x46, 0x6e, 0xbb,
+	0xfc, 0x8a, 0x08, 0x00, 0x00,
 }
 
 func (m *OperatorJoinedServices) Marshal() (dAtA []byte, err error) {
@@ -602,6 +693,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingOperatorsServiceKeys) > 0 {
+		for iNdEx := len(m.PendingOperatorsServiceKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOperatorsServiceKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.OperatorsServiceKeys) > 0 {
+		for iNdEx := len(m.OperatorsServiceKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorsServiceKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.NextPendingSlashID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingSlashID))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SubmittedEvidences) > 0 {
+		for iNdEx := len(m.SubmittedEvidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmittedEvidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OperatorsJailRecords) > 0 {
+		for iNdEx := len(m.OperatorsJailRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorsJailRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ServicesSnapshots) > 0 {
+		for iNdEx := len(m.ServicesSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServicesSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.UsersPreferences) > 0 {
 		for iNdEx := len(m.UsersPreferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -826,6 +1020,51 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ServicesSnapshots) > 0 {
+		for _, e := range m.ServicesSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorsJailRecords) > 0 {
+		for _, e := range m.OperatorsJailRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubmittedEvidences) > 0 {
+		for _, e := range m.SubmittedEvidences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPendingSlashID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPendingSlashID))
+	}
+	if len(m.OperatorsServiceKeys) > 0 {
+		for _, e := range m.OperatorsServiceKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOperatorsServiceKeys) > 0 {
+		for _, e := range m.PendingOperatorsServiceKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1651,6 +1890,263 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicesSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicesSnapshots = append(m.ServicesSnapshots, ServiceSnapshot{})
+			if err := m.ServicesSnapshots[len(m.ServicesSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorsJailRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorsJailRecords = append(m.OperatorsJailRecords, OperatorJailRecord{})
+			if err := m.OperatorsJailRecords[len(m.OperatorsJailRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedEvidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmittedEvidences = append(m.SubmittedEvidences, SubmittedEvidence{})
+			if err := m.SubmittedEvidences[len(m.SubmittedEvidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingSlashID", wireType)
+			}
+			m.NextPendingSlashID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPendingSlashID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorsServiceKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorsServiceKeys = append(m.OperatorsServiceKeys, OperatorServiceKey{})
+			if err := m.OperatorsServiceKeys[len(m.OperatorsServiceKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperatorsServiceKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOperatorsServiceKeys = append(m.PendingOperatorsServiceKeys, OperatorServiceKey{})
+			if err := m.PendingOperatorsServiceKeys[len(m.PendingOperatorsServiceKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
@@ -28,6 +30,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -43,6 +53,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -59,6 +77,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -74,6 +100,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -89,6 +123,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -105,6 +147,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -120,6 +170,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -135,6 +193,14 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -154,6 +220,14 @@ func TestGenesis_Validate(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -173,6 +247,14 @@ func TestGenesis_Validate(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -192,6 +274,14 @@ func TestGenesis_Validate(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -214,6 +304,14 @@ func TestGenesis_Validate(t *testing.T) {
 					),
 				},
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -227,7 +325,15 @@ func TestGenesis_Validate(t *testing.T) {
 				nil,
 				nil,
 				nil,
-				types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			),
 			shouldErr: true,
 		},
@@ -247,6 +353,353 @@ func TestGenesis_Validate(t *testing.T) {
 						}),
 					),
 				},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid redelegation returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.Redelegation{
+					types.NewRedelegation(
+						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						types.DELEGATION_TYPE_OPERATOR,
+						1,
+						types.DELEGATION_TYPE_OPERATOR,
+						1,
+						1,
+						time.Now(),
+						sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100))),
+						sdk.NewDecCoins(sdk.NewDecCoin("operator/1/umilk", sdkmath.NewInt(100))),
+						1,
+					),
+				},
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated service snapshot returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.ServiceSnapshot{
+					types.NewServiceSnapshot(1, 1, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+					types.NewServiceSnapshot(1, 1, 20, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+				},
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid service snapshot returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.ServiceSnapshot{
+					types.NewServiceSnapshot(1, 0, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+				},
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated operator jail record returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.OperatorJailRecord{
+					types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false),
+					types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), true),
+				},
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid operator jail record returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.OperatorJailRecord{
+					types.NewOperatorJailRecord(1, 0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false),
+				},
+				nil,
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated pending slash returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.PendingSlash{
+					types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+					types.NewPendingSlash(1, 2, 1, utils.MustParseDec("0.1"), 10, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				2,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid pending slash returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.PendingSlash{
+					types.NewPendingSlash(1, 1, 1, utils.MustParseDec("2"), 10, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				2,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "pending slash id not lower than next pending slash id returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.PendingSlash{
+					types.NewPendingSlash(2, 1, 1, utils.MustParseDec("0.1"), 10, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				2,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated submitted evidence returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.SubmittedEvidence{
+					types.NewSubmittedEvidence(1, []byte("hash")),
+					types.NewSubmittedEvidence(1, []byte("hash")),
+				},
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid submitted evidence returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				[]types.SubmittedEvidence{
+					types.NewSubmittedEvidence(1, nil),
+				},
+				nil,
+				1,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated operator service key returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid operator service key returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, nil, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				nil,
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated pending operator service key returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid pending operator service key returns error",
+			genesis: types.NewGenesis(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				1,
+				nil,
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(0, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
 				types.DefaultParams(),
 			),
 			shouldErr: true,
@@ -323,7 +776,29 @@ func TestGenesis_Validate(t *testing.T) {
 						}),
 					),
 				},
-				types.NewParams(5*24*time.Hour, nil, sdkmath.LegacyNewDec(100000), types.DefaultMaxEntries),
+				nil,
+				[]types.ServiceSnapshot{
+					types.NewServiceSnapshot(1, 1, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []types.ValidatingOperator{
+						types.NewValidatingOperator(1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+					}),
+				},
+				[]types.OperatorJailRecord{
+					types.NewOperatorJailRecord(1, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false),
+				},
+				[]types.SubmittedEvidence{
+					types.NewSubmittedEvidence(1, []byte("hash")),
+				},
+				[]types.PendingSlash{
+					types.NewPendingSlash(1, 1, 1, utils.MustParseDec("0.1"), 10, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+				},
+				2,
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{1}, 32), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				[]types.OperatorServiceKey{
+					types.NewOperatorServiceKey(1, 1, types.KEY_TYPE_ED25519, bytes.Repeat([]byte{2}, 32), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				types.NewParams(5*24*time.Hour, nil, sdkmath.LegacyNewDec(100000), types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			),
 			shouldErr: false,
 		},
@@ -2,12 +2,16 @@ package types
 
 import (
 	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type RestakingHooks interface {
 	PoolRestakingHooks
 	OperatorRestakingHooks
 	ServiceRestakingHooks
+	SlashingHooks
 	AfterUnbondingInitiated(ctx context.Context, unbondingDelegationID uint64) error
 	AfterUserPreferencesModified(ctx context.Context, userAddress string, oldPreferences, newPreferences UserPreferences) error
 }
@@ -32,3 +36,8 @@ type ServiceRestakingHooks interface {
 	AfterServiceDelegationModified(ctx context.Context, serviceID uint32, delegator string) error
 	BeforeServiceDelegationRemoved(ctx context.Context, serviceID uint32, delegator string) error
 }
+
+type SlashingHooks interface {
+	BeforeOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec) error
+	AfterOperatorSlashed(ctx context.Context, serviceID uint32, operatorID uint32, fraction math.LegacyDec, slashedAmount sdk.Coins) error
+}
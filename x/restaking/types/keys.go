@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
 	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
@@ -27,21 +28,40 @@ var (
 	ServiceOperatorsAllowListPrefix    = []byte{0x14}
 	ServiceSecuringPoolsPrefix         = []byte{0x15}
 	ServiceJoinedByOperatorIndexPrefix = []byte{0x16}
-
-	PoolDelegationPrefix          = []byte{0xa1}
-	PoolDelegationsByPoolIDPrefix = []byte{0xa2}
-	PoolUnbondingDelegationPrefix = []byte{0xa3}
-
-	OperatorDelegationPrefix              = []byte{0xb1}
-	OperatorDelegationsByOperatorIDPrefix = []byte{0xb2}
-	OperatorUnbondingDelegationPrefix     = []byte{0xb3}
-
-	ServiceDelegationPrefix             = []byte{0xc1}
-	ServiceDelegationsByServiceIDPrefix = []byte{0xc2}
-	ServiceUnbondingDelegationPrefix    = []byte{0xc3}
+	ServiceActiveOperatorsPrefix       = []byte{0x17}
+	ServiceSnapshotsPrefix             = []byte{0x18}
+	OperatorsJailRecordsPrefix         = []byte{0x19}
+	SubmittedEvidencesPrefix           = []byte{0x1a}
+	NextPendingSlashIDKey              = []byte{0x1b}
+	PendingSlashesPrefix               = []byte{0x1c}
+	PendingSlashesQueuePrefix          = []byte{0x1d}
+	OperatorServiceKeysPrefix          = []byte{0x1e}
+	PendingOperatorServiceKeysPrefix   = []byte{0x1f}
+	OperatorServiceKeysQueuePrefix     = []byte{0x20}
+	DirtyServicesPrefix                = []byte{0x21}
+
+	PoolDelegationPrefix                   = []byte{0xa1}
+	PoolDelegationsByPoolIDPrefix          = []byte{0xa2}
+	PoolUnbondingDelegationPrefix          = []byte{0xa3}
+	PoolUnbondingDelegationsByPoolIDPrefix = []byte{0xa4}
+
+	OperatorDelegationPrefix                       = []byte{0xb1}
+	OperatorDelegationsByOperatorIDPrefix          = []byte{0xb2}
+	OperatorUnbondingDelegationPrefix              = []byte{0xb3}
+	OperatorUnbondingDelegationsByOperatorIDPrefix = []byte{0xb4}
+
+	ServiceDelegationPrefix                      = []byte{0xc1}
+	ServiceDelegationsByServiceIDPrefix          = []byte{0xc2}
+	ServiceUnbondingDelegationPrefix             = []byte{0xc3}
+	ServiceUnbondingDelegationsByServiceIDPrefix = []byte{0xc4}
 
 	UnbondingQueueKey = []byte{0xd1}
 
+	RedelegationPrefix             = []byte{0xd2}
+	RedelegationsBySrcTargetPrefix = []byte{0xd3}
+	RedelegationQueueKey           = []byte{0xd4}
+	RedelegationIndexKey           = []byte{0xd5}
+
 	UserPreferencesPrefix = []byte{0xe1}
 )
 
@@ -65,12 +85,73 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// getTargetKey returns the bytes identifying the target having the given type and ID
+func getTargetKey(targetType DelegationType, targetID uint32) []byte {
+	return append([]byte{byte(targetType)}, utils.Uint32ToBigEndian(targetID)...)
+}
+
+// UserRedelegationsStorePrefix returns the prefix used to store all the redelegations of a given user
+func UserRedelegationsStorePrefix(delegatorAddress string) []byte {
+	return append(RedelegationPrefix, []byte(delegatorAddress)...)
+}
+
+// UserRedelegationStoreKey returns the key used to store the redelegation of the given user
+// from the source target to the destination target
+func UserRedelegationStoreKey(
+	delegatorAddress string, srcType DelegationType, srcTargetID uint32, dstType DelegationType, dstTargetID uint32,
+) []byte {
+	key := UserRedelegationsStorePrefix(delegatorAddress)
+	key = append(key, getTargetKey(srcType, srcTargetID)...)
+	return append(key, getTargetKey(dstType, dstTargetID)...)
+}
+
+// RedelegationsBySrcTargetStorePrefix returns the prefix used to store the redelegations
+// made from the given target
+func RedelegationsBySrcTargetStorePrefix(srcType DelegationType, srcTargetID uint32) []byte {
+	return append(RedelegationsBySrcTargetPrefix, getTargetKey(srcType, srcTargetID)...)
+}
+
+// RedelegationBySrcTargetStoreKey returns the key used to store the src target -> redelegation association
+func RedelegationBySrcTargetStoreKey(
+	srcType DelegationType, srcTargetID uint32, dstType DelegationType, dstTargetID uint32, delegatorAddress string,
+) []byte {
+	key := RedelegationsBySrcTargetStorePrefix(srcType, srcTargetID)
+	key = append(key, getTargetKey(dstType, dstTargetID)...)
+	return append(key, []byte(delegatorAddress)...)
+}
+
+// ParseRedelegationBySrcTargetStoreKey returns the data identifying a redelegation from the given key
+func ParseRedelegationBySrcTargetStoreKey(key []byte) RTData {
+	return RTData{
+		SrcType:          DelegationType(key[1]),
+		SrcTargetID:      binary.BigEndian.Uint32(key[2:6]),
+		DstType:          DelegationType(key[6]),
+		DstTargetID:      binary.BigEndian.Uint32(key[7:11]),
+		DelegatorAddress: string(key[11:]),
+	}
+}
+
+// GetRedelegationIndexKey returns a key for the index for looking up Redelegations by the RedelegationEntries they contain
+func GetRedelegationIndexKey(id uint64) []byte {
+	return append(RedelegationIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetRedelegationTimeKey returns the key used to store the redelegations that complete at the given time
+func GetRedelegationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RedelegationQueueKey, bz...)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 type DelegationKeyBuilder func(delegatorAddress string, targetID uint32) []byte
 
 type DelegationByTargetIDBuilder func(targetID uint32, delegationAddress string) []byte
 
 type UnbondingDelegationKeyBuilder func(delegatorAddress string, targetID uint32) []byte
 
+type UnbondingDelegationByTargetIDBuilder func(targetID uint32, delegatorAddress string) []byte
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // UserPoolDelegationsStorePrefix returns the prefix used to store all the delegations to a given pool
@@ -110,6 +191,16 @@ func UserPoolUnbondingDelegationKey(delegatorAddress string, poolID uint32) []by
 	return append(PoolUnbondingDelegationsStorePrefix(delegatorAddress), poolstypes.GetPoolIDBytes(poolID)...)
 }
 
+// UnbondingDelegationsByPoolIDStorePrefix returns the prefix used to store the unbonding delegations from a given pool
+func UnbondingDelegationsByPoolIDStorePrefix(poolID uint32) []byte {
+	return append(PoolUnbondingDelegationsByPoolIDPrefix, poolstypes.GetPoolIDBytes(poolID)...)
+}
+
+// UnbondingDelegationByPoolIDStoreKey returns the key used to store the pool -> user unbonding delegation association
+func UnbondingDelegationByPoolIDStoreKey(poolID uint32, delegatorAddress string) []byte {
+	return append(UnbondingDelegationsByPoolIDStorePrefix(poolID), []byte(delegatorAddress)...)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // UserOperatorDelegationsStorePrefix returns the prefix used to store all the delegations to a given operator
@@ -149,6 +240,16 @@ func UserOperatorUnbondingDelegationKey(delegatorAddress string, operatorID uint
 	return append(OperatorUnbondingDelegationsStorePrefix(delegatorAddress), operatorstypes.GetOperatorIDBytes(operatorID)...)
 }
 
+// UnbondingDelegationsByOperatorIDStorePrefix returns the prefix used to store the unbonding delegations from a given operator
+func UnbondingDelegationsByOperatorIDStorePrefix(operatorID uint32) []byte {
+	return append(OperatorUnbondingDelegationsByOperatorIDPrefix, operatorstypes.GetOperatorIDBytes(operatorID)...)
+}
+
+// UnbondingDelegationByOperatorIDStoreKey returns the key used to store the operator -> user unbonding delegation association
+func UnbondingDelegationByOperatorIDStoreKey(operatorID uint32, delegatorAddress string) []byte {
+	return append(UnbondingDelegationsByOperatorIDStorePrefix(operatorID), []byte(delegatorAddress)...)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // UserServiceDelegationsStorePrefix returns the prefix used to store all the delegations to a given service
@@ -188,6 +289,32 @@ func UserServiceUnbondingDelegationKey(delegatorAddress string, serviceID uint32
 	return append(ServiceUnbondingDelegationsStorePrefix(delegatorAddress), servicestypes.GetServiceIDBytes(serviceID)...)
 }
 
+// UnbondingDelegationsByServiceIDStorePrefix returns the prefix used to store the unbonding delegations from a given service
+func UnbondingDelegationsByServiceIDStorePrefix(serviceID uint32) []byte {
+	return append(ServiceUnbondingDelegationsByServiceIDPrefix, servicestypes.GetServiceIDBytes(serviceID)...)
+}
+
+// UnbondingDelegationByServiceIDStoreKey returns the key used to store the service -> user unbonding delegation association
+func UnbondingDelegationByServiceIDStoreKey(serviceID uint32, delegatorAddress string) []byte {
+	return append(UnbondingDelegationsByServiceIDStorePrefix(serviceID), []byte(delegatorAddress)...)
+}
+
+// ParseUnbondingDelegationByTargetIDStoreKey returns the target ID and delegator address from the given
+// unbonding delegation by target ID key
+func ParseUnbondingDelegationByTargetIDStoreKey(key []byte) (targetID uint32, delegatorAddress string) {
+	targetID = binary.BigEndian.Uint32(key[1:5])
+	delegatorAddress = string(key[5:])
+	return
+}
+
+// ServiceSnapshotStoreKey returns the key used to store the snapshot of the
+// given service taken at the given epoch. It can be used to query the snapshot
+// with a Merkle proof through the ABCI store query
+func ServiceSnapshotStoreKey(serviceID uint32, epoch uint64) []byte {
+	key := append(ServiceSnapshotsPrefix, servicestypes.GetServiceIDBytes(serviceID)...)
+	return append(key, sdk.Uint64ToBigEndian(epoch)...)
+}
+
 // GetDelegationKeyBuilders returns the key builders for the given delegation
 func GetDelegationKeyBuilders(delegation Delegation) (DelegationKeyBuilder, DelegationByTargetIDBuilder, error) {
 	switch delegation.Type {
@@ -201,3 +328,32 @@ func GetDelegationKeyBuilders(delegation Delegation) (DelegationKeyBuilder, Dele
 		return nil, nil, errors.Wrapf(ErrInvalidDelegationType, "invalid delegation type: %v", delegation.Type)
 	}
 }
+
+// GetUnbondingDelegationKeyBuilders returns the key builders for the given unbonding delegation
+func GetUnbondingDelegationKeyBuilders(ubd UnbondingDelegation) (UnbondingDelegationKeyBuilder, UnbondingDelegationByTargetIDBuilder, error) {
+	switch ubd.Type {
+	case DELEGATION_TYPE_POOL:
+		return UserPoolUnbondingDelegationKey, UnbondingDelegationByPoolIDStoreKey, nil
+	case DELEGATION_TYPE_OPERATOR:
+		return UserOperatorUnbondingDelegationKey, UnbondingDelegationByOperatorIDStoreKey, nil
+	case DELEGATION_TYPE_SERVICE:
+		return UserServiceUnbondingDelegationKey, UnbondingDelegationByServiceIDStoreKey, nil
+	default:
+		return nil, nil, errors.Wrapf(ErrInvalidDelegationType, "invalid delegation type: %v", ubd.Type)
+	}
+}
+
+// UnbondingDelegationsByTargetIDStorePrefix returns the prefix used to store the unbonding
+// delegations from the target having the given type and ID
+func UnbondingDelegationsByTargetIDStorePrefix(ubdType DelegationType, targetID uint32) ([]byte, error) {
+	switch ubdType {
+	case DELEGATION_TYPE_POOL:
+		return UnbondingDelegationsByPoolIDStorePrefix(targetID), nil
+	case DELEGATION_TYPE_OPERATOR:
+		return UnbondingDelegationsByOperatorIDStorePrefix(targetID), nil
+	case DELEGATION_TYPE_SERVICE:
+		return UnbondingDelegationsByServiceIDStorePrefix(targetID), nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidDelegationType, "invalid delegation type: %v", ubdType)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -479,3 +480,356 @@ func (msg *MsgSetUserPreferences) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.User)
 	return []sdk.AccAddress{addr}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRedelegatePool creates a new MsgRedelegatePool instance
+func NewMsgRedelegatePool(amount sdk.Coin, dstType DelegationType, dstTargetID uint32, delegator string) *MsgRedelegatePool {
+	return &MsgRedelegatePool{
+		Amount:      amount,
+		DstType:     dstType,
+		DstTargetID: dstTargetID,
+		Delegator:   delegator,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRedelegatePool) ValidateBasic() error {
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	if msg.DstType != DELEGATION_TYPE_OPERATOR && msg.DstType != DELEGATION_TYPE_SERVICE {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid destination type: %s", msg.DstType)
+	}
+
+	if msg.DstTargetID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid destination target id")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRedelegatePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRedelegatePool) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRedelegateOperator creates a new MsgRedelegateOperator instance
+func NewMsgRedelegateOperator(srcOperatorID uint32, dstOperatorID uint32, amount sdk.Coins, delegator string) *MsgRedelegateOperator {
+	return &MsgRedelegateOperator{
+		SrcOperatorID: srcOperatorID,
+		DstOperatorID: dstOperatorID,
+		Amount:        amount,
+		Delegator:     delegator,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRedelegateOperator) ValidateBasic() error {
+	if msg.SrcOperatorID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid source operator id")
+	}
+
+	if msg.DstOperatorID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid destination operator id")
+	}
+
+	if msg.SrcOperatorID == msg.DstOperatorID {
+		return ErrSelfRedelegation
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRedelegateOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRedelegateOperator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRedelegateService creates a new MsgRedelegateService instance
+func NewMsgRedelegateService(srcServiceID uint32, dstServiceID uint32, amount sdk.Coins, delegator string) *MsgRedelegateService {
+	return &MsgRedelegateService{
+		SrcServiceID: srcServiceID,
+		DstServiceID: dstServiceID,
+		Amount:       amount,
+		Delegator:    delegator,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRedelegateService) ValidateBasic() error {
+	if msg.SrcServiceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid source service id")
+	}
+
+	if msg.DstServiceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid destination service id")
+	}
+
+	if msg.SrcServiceID == msg.DstServiceID {
+		return ErrSelfRedelegation
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRedelegateService) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRedelegateService) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance
+func NewMsgCancelUnbondingDelegation(
+	delegationType DelegationType, targetID uint32, amount sdk.Coins, creationHeight int64, delegator string,
+) *MsgCancelUnbondingDelegation {
+	return &MsgCancelUnbondingDelegation{
+		Delegator:      delegator,
+		DelegationType: delegationType,
+		TargetID:       targetID,
+		Amount:         amount,
+		CreationHeight: creationHeight,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgCancelUnbondingDelegation) ValidateBasic() error {
+	if msg.DelegationType == DELEGATION_TYPE_UNSPECIFIED {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid delegation type: %s", msg.DelegationType)
+	}
+
+	if msg.TargetID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid target id")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	if msg.CreationHeight <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid creation height")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgUnjailOperator creates a new MsgUnjailOperator instance
+func NewMsgUnjailOperator(operatorID uint32, serviceID uint32, sender string) *MsgUnjailOperator {
+	return &MsgUnjailOperator{
+		OperatorID: operatorID,
+		ServiceID:  serviceID,
+		Sender:     sender,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUnjailOperator) ValidateBasic() error {
+	if msg.OperatorID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid operator ID: %d", msg.OperatorID)
+	}
+
+	if msg.ServiceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid service ID: %d", msg.ServiceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var _ codectypes.UnpackInterfacesMessage = &MsgSubmitOperatorEvidence{}
+
+// NewMsgSubmitOperatorEvidence creates a new MsgSubmitOperatorEvidence instance
+func NewMsgSubmitOperatorEvidence(serviceID uint32, evidence OperatorEvidence, submitter string) (*MsgSubmitOperatorEvidence, error) {
+	evidenceAny, err := codectypes.NewAnyWithValue(evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitOperatorEvidence{
+		Submitter: submitter,
+		ServiceID: serviceID,
+		Evidence:  evidenceAny,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSubmitOperatorEvidence) ValidateBasic() error {
+	if msg.ServiceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid service ID: %d", msg.ServiceID)
+	}
+
+	evidence := msg.GetOperatorEvidence()
+	if evidence == nil {
+		return errors.Wrap(ErrInvalidEvidence, "missing evidence")
+	}
+
+	err := evidence.ValidateBasic()
+	if err != nil {
+		return errors.Wrap(ErrInvalidEvidence, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address")
+	}
+
+	return nil
+}
+
+// GetOperatorEvidence returns the evidence contained inside the message, or nil
+// if the evidence has not been unpacked yet
+func (msg *MsgSubmitOperatorEvidence) GetOperatorEvidence() OperatorEvidence {
+	if msg.Evidence == nil {
+		return nil
+	}
+
+	evidence, ok := msg.Evidence.GetCachedValue().(OperatorEvidence)
+	if !ok {
+		return nil
+	}
+	return evidence
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg *MsgSubmitOperatorEvidence) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var evidence OperatorEvidence
+	return unpacker.UnpackAny(msg.Evidence, &evidence)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgVetoSlash creates a new MsgVetoSlash instance
+func NewMsgVetoSlash(pendingSlashID uint64, signer string) *MsgVetoSlash {
+	return &MsgVetoSlash{
+		Signer:         signer,
+		PendingSlashID: pendingSlashID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgVetoSlash) ValidateBasic() error {
+	if msg.PendingSlashID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pending slash ID: %d", msg.PendingSlashID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSetOperatorServiceKey creates a new MsgSetOperatorServiceKey instance
+func NewMsgSetOperatorServiceKey(
+	operatorID uint32, serviceID uint32, keyType KeyType, publicKey []byte, proofOfPossession []byte, sender string,
+) *MsgSetOperatorServiceKey {
+	return &MsgSetOperatorServiceKey{
+		Sender:            sender,
+		OperatorID:        operatorID,
+		ServiceID:         serviceID,
+		KeyType:           keyType,
+		PublicKey:         publicKey,
+		ProofOfPossession: proofOfPossession,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetOperatorServiceKey) ValidateBasic() error {
+	if msg.OperatorID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid operator ID: %d", msg.OperatorID)
+	}
+
+	if msg.ServiceID == 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid service ID: %d", msg.ServiceID)
+	}
+
+	err := ValidatePublicKey(msg.KeyType, msg.PublicKey)
+	if err != nil {
+		return errors.Wrap(ErrInvalidOperatorServiceKey, err.Error())
+	}
+
+	if len(msg.ProofOfPossession) == 0 {
+		return errors.Wrap(ErrInvalidProofOfPossession, "missing proof of possession")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
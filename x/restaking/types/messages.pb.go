@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -1314,1600 +1315,1902 @@ func (m *MsgSetUserPreferencesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetUserPreferencesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgJoinService)(nil), "milkyway.restaking.v1.MsgJoinService")
-	proto.RegisterType((*MsgJoinServiceResponse)(nil), "milkyway.restaking.v1.MsgJoinServiceResponse")
-	proto.RegisterType((*MsgLeaveService)(nil), "milkyway.restaking.v1.MsgLeaveService")
-	proto.RegisterType((*MsgLeaveServiceResponse)(nil), "milkyway.restaking.v1.MsgLeaveServiceResponse")
-	proto.RegisterType((*MsgAddOperatorToAllowList)(nil), "milkyway.restaking.v1.MsgAddOperatorToAllowList")
-	proto.RegisterType((*MsgAddOperatorToAllowListResponse)(nil), "milkyway.restaking.v1.MsgAddOperatorToAllowListResponse")
-	proto.RegisterType((*MsgRemoveOperatorFromAllowlist)(nil), "milkyway.restaking.v1.MsgRemoveOperatorFromAllowlist")
-	proto.RegisterType((*MsgRemoveOperatorFromAllowlistResponse)(nil), "milkyway.restaking.v1.MsgRemoveOperatorFromAllowlistResponse")
-	proto.RegisterType((*MsgBorrowPoolSecurity)(nil), "milkyway.restaking.v1.MsgBorrowPoolSecurity")
-	proto.RegisterType((*MsgBorrowPoolSecurityResponse)(nil), "milkyway.restaking.v1.MsgBorrowPoolSecurityResponse")
-	proto.RegisterType((*MsgCeasePoolSecurityBorrow)(nil), "milkyway.restaking.v1.MsgCeasePoolSecurityBorrow")
-	proto.RegisterType((*MsgCeasePoolSecurityBorrowResponse)(nil), "milkyway.restaking.v1.MsgCeasePoolSecurityBorrowResponse")
-	proto.RegisterType((*MsgDelegatePool)(nil), "milkyway.restaking.v1.MsgDelegatePool")
-	proto.RegisterType((*MsgDelegatePoolResponse)(nil), "milkyway.restaking.v1.MsgDelegatePoolResponse")
-	proto.RegisterType((*MsgDelegateOperator)(nil), "milkyway.restaking.v1.MsgDelegateOperator")
-	proto.RegisterType((*MsgDelegateOperatorResponse)(nil), "milkyway.restaking.v1.MsgDelegateOperatorResponse")
-	proto.RegisterType((*MsgDelegateService)(nil), "milkyway.restaking.v1.MsgDelegateService")
-	proto.RegisterType((*MsgDelegateServiceResponse)(nil), "milkyway.restaking.v1.MsgDelegateServiceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "milkyway.restaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "milkyway.restaking.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgUndelegatePool)(nil), "milkyway.restaking.v1.MsgUndelegatePool")
-	proto.RegisterType((*MsgUndelegateOperator)(nil), "milkyway.restaking.v1.MsgUndelegateOperator")
-	proto.RegisterType((*MsgUndelegateService)(nil), "milkyway.restaking.v1.MsgUndelegateService")
-	proto.RegisterType((*MsgUndelegateResponse)(nil), "milkyway.restaking.v1.MsgUndelegateResponse")
-	proto.RegisterType((*MsgSetUserPreferences)(nil), "milkyway.restaking.v1.MsgSetUserPreferences")
-	proto.RegisterType((*MsgSetUserPreferencesResponse)(nil), "milkyway.restaking.v1.MsgSetUserPreferencesResponse")
+// MsgRedelegatePool the message structure for the RedelegatePool gRPC service
+// method. It allows a user to move their assets from a restaking pool to an
+// operator or a service.
+type MsgRedelegatePool struct {
+	// Delegator is the address of the user redelegating from the pool.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// Amount is the amount of coins to be redelegated.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// DstType is the type of the target to redelegate to. It can be either an
+	// operator or a service.
+	DstType DelegationType `protobuf:"varint,3,opt,name=dst_type,json=dstType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"dst_type,omitempty"`
+	// DstTargetID is the ID of the target to redelegate to.
+	DstTargetID uint32 `protobuf:"varint,4,opt,name=dst_target_id,json=dstTargetId,proto3" json:"dst_target_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("milkyway/restaking/v1/messages.proto", fileDescriptor_9772be2b1a923bdb)
+func (m *MsgRedelegatePool) Reset()         { *m = MsgRedelegatePool{} }
+func (m *MsgRedelegatePool) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegatePool) ProtoMessage()    {}
+func (*MsgRedelegatePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{26}
 }
-
-var fileDescriptor_9772be2b1a923bdb = []byte{
-	// 1334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0xc5, 0xc8, 0x93, 0x36, 0x6d, 0x97, 0xb6, 0x49, 0xb6, 0x8d, 0x5d, 0xb6, 0xa5,
-	0x35, 0xa9, 0xb3, 0x8b, 0x5d, 0x42, 0x5b, 0x03, 0x87, 0xb8, 0x11, 0x92, 0xab, 0x1a, 0x8a, 0xd3,
-	0x5e, 0xb8, 0x54, 0x6b, 0xef, 0x64, 0xb3, 0xca, 0xee, 0x8e, 0xb5, 0xb3, 0x76, 0x92, 0x1b, 0x42,
-	0x48, 0x20, 0x4e, 0x95, 0x38, 0x71, 0x41, 0xb9, 0x20, 0x21, 0x4e, 0x39, 0x70, 0xe1, 0x86, 0xc4,
-	0xa5, 0x42, 0x1c, 0x0a, 0x07, 0xc4, 0x29, 0xad, 0x9c, 0x43, 0x10, 0x47, 0x24, 0xee, 0x68, 0x77,
-	0x67, 0xc7, 0xfb, 0x1f, 0x3b, 0x82, 0x28, 0x97, 0xc4, 0x3b, 0xef, 0x7b, 0x6f, 0xde, 0xf7, 0xcd,
-	0xcc, 0x9b, 0x37, 0xe0, 0xaa, 0xae, 0x6a, 0xeb, 0x5b, 0x1b, 0xd2, 0x96, 0x68, 0x42, 0x6c, 0x49,
-	0xeb, 0xaa, 0xa1, 0x88, 0xfd, 0x8a, 0xa8, 0x43, 0x8c, 0x25, 0x05, 0x62, 0xa1, 0x6b, 0x22, 0x0b,
-	0xb1, 0xe7, 0x3d, 0x94, 0x40, 0x51, 0x42, 0xbf, 0xc2, 0x9d, 0x95, 0x74, 0xd5, 0x40, 0xa2, 0xf3,
-	0xd7, 0x45, 0x72, 0x85, 0x0e, 0xc2, 0x3a, 0xc2, 0x62, 0x5b, 0xc2, 0x50, 0xec, 0x57, 0xda, 0xd0,
-	0x92, 0x2a, 0x62, 0x07, 0xa9, 0x06, 0xb1, 0x4f, 0x13, 0xbb, 0x8e, 0xdd, 0x79, 0xb0, 0x42, 0x0c,
-	0xb3, 0xae, 0xe1, 0xb1, 0xf3, 0x25, 0xba, 0x1f, 0xc4, 0x74, 0x4e, 0x41, 0x0a, 0x72, 0xc7, 0xed,
-	0x5f, 0x64, 0xb4, 0xa8, 0x20, 0xa4, 0x68, 0x50, 0x74, 0xbe, 0xda, 0xbd, 0x55, 0xd1, 0x52, 0x75,
-	0x3b, 0x39, 0xbd, 0x4b, 0x00, 0x7c, 0x02, 0x35, 0x24, 0x43, 0x0d, 0xa7, 0x63, 0xba, 0x92, 0x29,
-	0xe9, 0x04, 0xc3, 0xff, 0xcc, 0x80, 0xa9, 0x26, 0x56, 0xee, 0x21, 0xd5, 0x58, 0x81, 0x66, 0x5f,
-	0xed, 0x40, 0xf6, 0x0d, 0x90, 0xc3, 0xd0, 0x90, 0xa1, 0x39, 0xc3, 0x5c, 0x66, 0x4a, 0xf9, 0xfa,
-	0xcc, 0x6f, 0xdf, 0x2f, 0x9c, 0x23, 0x39, 0x2f, 0xc9, 0xb2, 0x09, 0x31, 0x5e, 0xb1, 0x4c, 0xd5,
-	0x50, 0x5a, 0x04, 0xc7, 0x8a, 0x60, 0x12, 0x75, 0xa1, 0x29, 0x59, 0xc8, 0x7c, 0xac, 0xca, 0x33,
-	0xd9, 0xcb, 0x4c, 0xe9, 0x54, 0x7d, 0x6a, 0xb0, 0x5b, 0x04, 0x1f, 0x90, 0xe1, 0xc6, 0x72, 0x0b,
-	0x78, 0x90, 0x86, 0xcc, 0x96, 0x01, 0xc0, 0xee, 0x6c, 0x36, 0x7e, 0xc2, 0xc1, 0x9f, 0x1a, 0xec,
-	0x16, 0xf3, 0x24, 0x87, 0xc6, 0x72, 0x2b, 0x4f, 0x00, 0x0d, 0xb9, 0x76, 0xfd, 0x93, 0xfd, 0x9d,
-	0x79, 0x32, 0xd7, 0x17, 0xfb, 0x3b, 0xf3, 0xd3, 0x94, 0x57, 0x30, 0x73, 0x7e, 0x06, 0x5c, 0x08,
-	0x8e, 0xb4, 0x20, 0xee, 0x22, 0x03, 0x43, 0xfe, 0x17, 0x06, 0x9c, 0x6e, 0x62, 0xe5, 0x3e, 0x94,
-	0xfa, 0xf0, 0xd8, 0xf2, 0x2c, 0x85, 0x78, 0xce, 0xf8, 0x79, 0xfa, 0x53, 0xe7, 0x67, 0xc1, 0x74,
-	0x68, 0x88, 0x32, 0x7d, 0xc1, 0x80, 0xd9, 0x26, 0x56, 0x96, 0x64, 0xd9, 0xcb, 0xe9, 0x21, 0x5a,
-	0xd2, 0x34, 0xb4, 0x71, 0x5f, 0xc5, 0xd6, 0x21, 0x38, 0x07, 0x29, 0x64, 0xd3, 0x29, 0x84, 0x15,
-	0x9a, 0x38, 0x48, 0xa1, 0x5a, 0x35, 0xc4, 0x99, 0xf7, 0x73, 0x8e, 0x27, 0xc1, 0x5f, 0x01, 0xaf,
-	0x26, 0x1a, 0xa9, 0x0e, 0x7f, 0x31, 0xa0, 0xd0, 0xc4, 0x4a, 0x0b, 0xea, 0xa8, 0x0f, 0x3d, 0xe0,
-	0x7b, 0x26, 0xd2, 0x1d, 0xa8, 0x76, 0x2c, 0xc5, 0xb8, 0x15, 0x12, 0xe3, 0xba, 0x5f, 0x8c, 0x14,
-	0x26, 0x7c, 0x09, 0x5c, 0x4b, 0x47, 0xf8, 0x0f, 0xc2, 0xf9, 0x26, 0x56, 0xea, 0xc8, 0x34, 0xd1,
-	0xc6, 0x03, 0x84, 0xb4, 0x15, 0xd8, 0xe9, 0x99, 0xaa, 0xb5, 0xf5, 0xbf, 0xab, 0x71, 0x05, 0xbc,
-	0xdc, 0x45, 0x48, 0x1b, 0x2a, 0x01, 0x06, 0xbb, 0xc5, 0x9c, 0x9d, 0x42, 0x63, 0xb9, 0x95, 0xb3,
-	0x4d, 0x0d, 0xb9, 0x26, 0x84, 0x14, 0x28, 0xf8, 0x15, 0x88, 0x26, 0xcd, 0x17, 0xc1, 0x5c, 0xac,
-	0x81, 0xf2, 0xfd, 0x9d, 0x01, 0x5c, 0x13, 0x2b, 0x77, 0xa1, 0x84, 0xa1, 0x1f, 0xe0, 0xba, 0x1c,
-	0x0f, 0xd2, 0x37, 0x43, 0xa4, 0xaf, 0xf8, 0x49, 0x27, 0x64, 0xce, 0x5f, 0x05, 0x7c, 0xb2, 0x95,
-	0xd2, 0xff, 0xc1, 0xad, 0x7b, 0xcb, 0x50, 0x83, 0x8a, 0x64, 0x39, 0x48, 0xf6, 0x2d, 0x90, 0x97,
-	0xdd, 0x6f, 0x74, 0x30, 0xed, 0x21, 0x94, 0x5d, 0x02, 0x39, 0x49, 0x47, 0x3d, 0xc3, 0x72, 0x58,
-	0x4f, 0x56, 0x67, 0x05, 0xe2, 0x61, 0x5f, 0x87, 0x02, 0xb9, 0x0e, 0x85, 0xbb, 0x48, 0x35, 0xea,
-	0x53, 0x4f, 0x77, 0x8b, 0x19, 0x9b, 0xe9, 0x92, 0xe3, 0xd0, 0x22, 0x8e, 0xb5, 0x1b, 0x36, 0xd3,
-	0x61, 0xc8, 0x48, 0x91, 0xf3, 0xe7, 0x49, 0x8a, 0x9c, 0x7f, 0x88, 0xd2, 0xfa, 0x3a, 0x0b, 0x5e,
-	0xf1, 0xd9, 0xbc, 0x2d, 0x7f, 0x68, 0x6a, 0x63, 0x17, 0x76, 0x9d, 0x6a, 0x31, 0x71, 0x79, 0x22,
-	0x5d, 0x8b, 0x5a, 0x50, 0x8b, 0xef, 0x9e, 0x17, 0x4b, 0x8a, 0x6a, 0xad, 0xf5, 0xda, 0x42, 0x07,
-	0xe9, 0xa4, 0x17, 0x20, 0xff, 0x16, 0xb0, 0xbc, 0x2e, 0x5a, 0x5b, 0x5d, 0x88, 0x1d, 0x57, 0x4c,
-	0x75, 0x13, 0xa3, 0xba, 0x5d, 0x8a, 0xd3, 0xcd, 0xcb, 0x96, 0x9f, 0x03, 0x17, 0x63, 0x86, 0xa9,
-	0x7e, 0x5f, 0x65, 0x01, 0xeb, 0xb3, 0x7b, 0x37, 0xe2, 0x61, 0xe5, 0x1b, 0xef, 0x4c, 0x1c, 0xb1,
-	0x76, 0x42, 0x54, 0xbb, 0x8b, 0x71, 0xda, 0x79, 0x77, 0xeb, 0x25, 0xa7, 0x60, 0x84, 0x46, 0xa9,
-	0x72, 0xbf, 0xba, 0x07, 0xea, 0x51, 0x57, 0xb6, 0xf7, 0xa4, 0xd3, 0x49, 0xb1, 0xf7, 0x40, 0x5e,
-	0xea, 0x59, 0x6b, 0xc8, 0x3e, 0x7f, 0x44, 0xb6, 0xf2, 0xdf, 0xbb, 0xc5, 0x33, 0x5b, 0x92, 0xae,
-	0xd5, 0x78, 0x6a, 0xe2, 0x93, 0xa5, 0xa4, 0x18, 0xf6, 0x6d, 0x90, 0x73, 0xfb, 0x33, 0x72, 0xc8,
-	0xe6, 0x84, 0xd8, 0xee, 0x54, 0x70, 0xa7, 0xae, 0x9f, 0xb0, 0x05, 0x6a, 0x11, 0x97, 0xda, 0xa2,
-	0x43, 0x95, 0x06, 0x0b, 0xde, 0xa7, 0xc3, 0x1e, 0x30, 0x94, 0x3f, 0x39, 0x68, 0xfe, 0x21, 0x4a,
-	0xf7, 0x47, 0x06, 0x9c, 0xb5, 0x6d, 0x86, 0xfc, 0x5f, 0x54, 0x90, 0x77, 0x46, 0xaf, 0x20, 0x79,
-	0x9b, 0xd8, 0xb7, 0xfb, 0x3b, 0xf3, 0x0c, 0x5d, 0xc8, 0xc5, 0xcf, 0xb7, 0x8b, 0x99, 0x3f, 0xb7,
-	0x8b, 0x99, 0xe8, 0x82, 0x72, 0xfe, 0x05, 0x0d, 0x26, 0xcb, 0x7f, 0x93, 0x75, 0x6e, 0xbc, 0xe1,
-	0xe8, 0xd1, 0x57, 0x8b, 0xb5, 0xd1, 0x77, 0xfc, 0xa2, 0xcd, 0x7b, 0x9c, 0x7d, 0x1e, 0xd4, 0xe8,
-	0x4e, 0xb2, 0x46, 0x85, 0x78, 0x8d, 0x68, 0xc9, 0xd8, 0xce, 0x82, 0x73, 0x01, 0xcb, 0xd1, 0x56,
-	0x85, 0xa3, 0xd3, 0xe8, 0x76, 0xb2, 0x46, 0x73, 0xf1, 0x1a, 0x79, 0xa5, 0x61, 0x3d, 0xb4, 0x93,
-	0xbc, 0x63, 0xc2, 0xb6, 0xc0, 0xe9, 0x0e, 0xd2, 0xbb, 0x1a, 0xb4, 0x54, 0x64, 0x3c, 0xb6, 0xdf,
-	0x6a, 0x8e, 0x50, 0x93, 0x55, 0x4e, 0x70, 0x1f, 0x72, 0x82, 0xf7, 0x90, 0x13, 0x1e, 0x7a, 0x0f,
-	0xb9, 0xfa, 0x29, 0x9b, 0xc6, 0x93, 0xe7, 0x45, 0xc6, 0x4d, 0x6f, 0x6a, 0x18, 0xc1, 0xc6, 0xf0,
-	0x3f, 0xb9, 0x9d, 0xda, 0x0a, 0xb4, 0x1e, 0x61, 0x68, 0x3e, 0x30, 0xe1, 0x2a, 0x34, 0xa1, 0xd1,
-	0x81, 0x98, 0x2d, 0x83, 0x13, 0x3d, 0x3c, 0x42, 0xcb, 0xe2, 0xa0, 0xd8, 0xf7, 0xc1, 0x64, 0x77,
-	0xe8, 0x4c, 0x4e, 0xde, 0xb5, 0x84, 0xb2, 0x12, 0x9a, 0x8a, 0xd4, 0x17, 0x7f, 0x80, 0x5a, 0xd9,
-	0x96, 0xcd, 0x09, 0x1d, 0xd9, 0x55, 0xd1, 0x5c, 0x49, 0x83, 0x16, 0x35, 0x78, 0xd2, 0x55, 0xff,
-	0x39, 0x09, 0x26, 0x9a, 0x58, 0x61, 0x3b, 0x60, 0xd2, 0xff, 0x08, 0x7d, 0x2d, 0x21, 0xc1, 0xe0,
-	0xfb, 0x8e, 0x5b, 0x18, 0x09, 0x46, 0xd7, 0x69, 0x15, 0x9c, 0x0c, 0x3c, 0x01, 0xaf, 0x25, 0xbb,
-	0xfb, 0x71, 0x9c, 0x30, 0x1a, 0x8e, 0xce, 0xf3, 0x29, 0x03, 0x2e, 0x24, 0xbd, 0xc0, 0x92, 0x43,
-	0xc5, 0x7b, 0x70, 0xb7, 0xc7, 0xf5, 0xa0, 0x69, 0x7c, 0xc9, 0x80, 0x8b, 0x69, 0x0f, 0xa0, 0xc5,
-	0xe4, 0xc8, 0x29, 0x6e, 0xdc, 0xbb, 0x87, 0x72, 0xa3, 0x59, 0x6d, 0x02, 0x36, 0xe6, 0xf9, 0x51,
-	0x4e, 0x0e, 0x1a, 0x45, 0x73, 0x6f, 0x8e, 0x83, 0xa6, 0x33, 0x7f, 0xc6, 0x80, 0xe9, 0xa4, 0x97,
-	0x40, 0x25, 0x39, 0x62, 0x82, 0x0b, 0x77, 0x67, 0x6c, 0x17, 0xff, 0x46, 0x0c, 0xf4, 0xe4, 0x29,
-	0x1b, 0xd1, 0x8f, 0x4b, 0xdb, 0x88, 0x71, 0x8d, 0x32, 0x6b, 0x82, 0x33, 0x91, 0x26, 0x79, 0xfe,
-	0xe0, 0x18, 0x1e, 0x96, 0xab, 0x8e, 0x8e, 0xa5, 0x73, 0x22, 0x70, 0x3a, 0xdc, 0x58, 0xbe, 0x7e,
-	0x70, 0x18, 0xef, 0xa8, 0x55, 0x46, 0x86, 0xfa, 0xc5, 0x0c, 0xf4, 0x63, 0x29, 0x62, 0xfa, 0x71,
-	0x69, 0x62, 0xc6, 0x35, 0x43, 0xec, 0x1a, 0x98, 0x0a, 0x35, 0x42, 0xa5, 0x94, 0x08, 0x01, 0x24,
-	0x57, 0x1e, 0x05, 0x49, 0x67, 0xea, 0x02, 0x36, 0xa6, 0x5f, 0x19, 0x29, 0x06, 0x5d, 0xba, 0xf1,
-	0x66, 0x34, 0xc0, 0xd9, 0xe8, 0xcd, 0x7f, 0x63, 0x94, 0x10, 0xde, 0xc2, 0x8d, 0x37, 0xdf, 0x26,
-	0x60, 0xe3, 0x6e, 0xb6, 0xe4, 0x18, 0x51, 0x74, 0x5a, 0x11, 0x48, 0xbe, 0x70, 0xb8, 0x97, 0x3e,
-	0xb6, 0xaf, 0xdb, 0xfa, 0x87, 0x4f, 0x07, 0x05, 0xe6, 0xd9, 0xa0, 0xc0, 0xbc, 0x18, 0x14, 0x98,
-	0x27, 0x7b, 0x85, 0xcc, 0xb3, 0xbd, 0x42, 0xe6, 0x8f, 0xbd, 0x42, 0xe6, 0xa3, 0x5b, 0xbe, 0xb6,
-	0xc2, 0x9b, 0x60, 0x41, 0x93, 0xda, 0x98, 0x7e, 0x89, 0xfd, 0x4a, 0x55, 0xdc, 0xf4, 0x75, 0xd4,
-	0x4e, 0xaf, 0xd1, 0xce, 0x39, 0x97, 0xfc, 0xcd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x32, 0xcb,
-	0x50, 0x9f, 0x77, 0x16, 0x00, 0x00,
+func (m *MsgRedelegatePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegatePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegatePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegatePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegatePool.Merge(m, src)
+}
+func (m *MsgRedelegatePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegatePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegatePool.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRedelegatePool proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// JoinService defines the operation that allows the operator admin
-	// to start securing an AVS
-	JoinService(ctx context.Context, in *MsgJoinService, opts ...grpc.CallOption) (*MsgJoinServiceResponse, error)
-	// LeaveService defines the operation that allows the operator admin
-	// to stop securing an AVS
-	LeaveService(ctx context.Context, in *MsgLeaveService, opts ...grpc.CallOption) (*MsgLeaveServiceResponse, error)
-	// AddOperatorToAllowList defines the operation that allows the service admin
-	// to add an operator to the list of allowed operator to secure the service
-	AddOperatorToAllowList(ctx context.Context, in *MsgAddOperatorToAllowList, opts ...grpc.CallOption) (*MsgAddOperatorToAllowListResponse, error)
-	// RemoveOperatorFromAllowlist defines the operation that allows the service
-	// admin to remove a previously added operator from the list of allowed
-	// operators to secure the service
-	RemoveOperatorFromAllowlist(ctx context.Context, in *MsgRemoveOperatorFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveOperatorFromAllowlistResponse, error)
-	// BorrowPoolSecurity defines the operation that allows the service admin
-	// to add a pool to the list of pools from which the service has chosen
-	// to borrow security.
-	BorrowPoolSecurity(ctx context.Context, in *MsgBorrowPoolSecurity, opts ...grpc.CallOption) (*MsgBorrowPoolSecurityResponse, error)
-	// CeasePoolSecurityBorrow defines the operation that allows the service admin
-	// to remove a pool from the list of pools from which the service has chosen
-	// to borrow security.
-	CeasePoolSecurityBorrow(ctx context.Context, in *MsgCeasePoolSecurityBorrow, opts ...grpc.CallOption) (*MsgCeasePoolSecurityBorrowResponse, error)
-	// DelegatePool defines the operation that allows users to delegate any amount
-	// of an asset to a pool that can then be used to provide services with
-	// cryptoeconomic security.
-	DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error)
-	// DelegateOperator defines the operation that allows users to delegate their
-	// assets to a specific operator.
-	DelegateOperator(ctx context.Context, in *MsgDelegateOperator, opts ...grpc.CallOption) (*MsgDelegateOperatorResponse, error)
-	// DelegateService defines the operation that allows users to delegate their
-	// assets to a specific service.
-	DelegateService(ctx context.Context, in *MsgDelegateService, opts ...grpc.CallOption) (*MsgDelegateServiceResponse, error)
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters.
-	// The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// UndelegatePool defines the operation that allows users to undelegate their
-	// assets from a pool.
-	UndelegatePool(ctx context.Context, in *MsgUndelegatePool, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
-	// UndelegateOperator defines the operation that allows users to undelegate
-	// their assets from a specific operator.
-	UndelegateOperator(ctx context.Context, in *MsgUndelegateOperator, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
-	// UndelegateService defines the operation that allows users to undelegate
-	// their assets from a specific service.
-	UndelegateService(ctx context.Context, in *MsgUndelegateService, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
-	// SetUserPreferences defines the operation that allows users to set their
-	// preferences for the restaking module.
-	SetUserPreferences(ctx context.Context, in *MsgSetUserPreferences, opts ...grpc.CallOption) (*MsgSetUserPreferencesResponse, error)
+// MsgRedelegateOperator the message structure for the RedelegateOperator gRPC
+// service method. It allows a user to move their assets from an operator to
+// another operator.
+type MsgRedelegateOperator struct {
+	// Delegator is the address of the user redelegating from the operator.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// SrcOperatorID is the ID of the operator to redelegate from.
+	SrcOperatorID uint32 `protobuf:"varint,2,opt,name=src_operator_id,json=srcOperatorId,proto3" json:"src_operator_id,omitempty"`
+	// DstOperatorID is the ID of the operator to redelegate to.
+	DstOperatorID uint32 `protobuf:"varint,3,opt,name=dst_operator_id,json=dstOperatorId,proto3" json:"dst_operator_id,omitempty"`
+	// Amount is the amount of coins to be redelegated.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRedelegateOperator) Reset()         { *m = MsgRedelegateOperator{} }
+func (m *MsgRedelegateOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateOperator) ProtoMessage()    {}
+func (*MsgRedelegateOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{27}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRedelegateOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) JoinService(ctx context.Context, in *MsgJoinService, opts ...grpc.CallOption) (*MsgJoinServiceResponse, error) {
-	out := new(MsgJoinServiceResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/JoinService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedelegateOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) LeaveService(ctx context.Context, in *MsgLeaveService, opts ...grpc.CallOption) (*MsgLeaveServiceResponse, error) {
-	out := new(MsgLeaveServiceResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/LeaveService", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRedelegateOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateOperator.Merge(m, src)
 }
-
-func (c *msgClient) AddOperatorToAllowList(ctx context.Context, in *MsgAddOperatorToAllowList, opts ...grpc.CallOption) (*MsgAddOperatorToAllowListResponse, error) {
-	out := new(MsgAddOperatorToAllowListResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/AddOperatorToAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRedelegateOperator) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) RemoveOperatorFromAllowlist(ctx context.Context, in *MsgRemoveOperatorFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveOperatorFromAllowlistResponse, error) {
-	out := new(MsgRemoveOperatorFromAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/RemoveOperatorFromAllowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRedelegateOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateOperator.DiscardUnknown(m)
 }
 
-func (c *msgClient) BorrowPoolSecurity(ctx context.Context, in *MsgBorrowPoolSecurity, opts ...grpc.CallOption) (*MsgBorrowPoolSecurityResponse, error) {
-	out := new(MsgBorrowPoolSecurityResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/BorrowPoolSecurity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRedelegateOperator proto.InternalMessageInfo
+
+// MsgRedelegateService the message structure for the RedelegateService gRPC
+// service method. It allows a user to move their assets from a service to
+// another service.
+type MsgRedelegateService struct {
+	// Delegator is the address of the user redelegating from the service.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// SrcServiceID is the ID of the service to redelegate from.
+	SrcServiceID uint32 `protobuf:"varint,2,opt,name=src_service_id,json=srcServiceId,proto3" json:"src_service_id,omitempty"`
+	// DstServiceID is the ID of the service to redelegate to.
+	DstServiceID uint32 `protobuf:"varint,3,opt,name=dst_service_id,json=dstServiceId,proto3" json:"dst_service_id,omitempty"`
+	// Amount is the amount of coins to be redelegated.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (c *msgClient) CeasePoolSecurityBorrow(ctx context.Context, in *MsgCeasePoolSecurityBorrow, opts ...grpc.CallOption) (*MsgCeasePoolSecurityBorrowResponse, error) {
-	out := new(MsgCeasePoolSecurityBorrowResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/CeasePoolSecurityBorrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedelegateService) Reset()         { *m = MsgRedelegateService{} }
+func (m *MsgRedelegateService) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateService) ProtoMessage()    {}
+func (*MsgRedelegateService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{28}
+}
+func (m *MsgRedelegateService) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateService.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRedelegateService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateService.Merge(m, src)
+}
+func (m *MsgRedelegateService) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateService) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateService.DiscardUnknown(m)
 }
 
-func (c *msgClient) DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error) {
-	out := new(MsgDelegatePoolResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRedelegateService proto.InternalMessageInfo
+
+// MsgRedelegateResponse defines the response type for the redelegation
+// methods.
+type MsgRedelegateResponse struct {
+	// CompletionTime represents the time at which the redelegation will be
+	// complete
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-func (c *msgClient) DelegateOperator(ctx context.Context, in *MsgDelegateOperator, opts ...grpc.CallOption) (*MsgDelegateOperatorResponse, error) {
-	out := new(MsgDelegateOperatorResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegateOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedelegateResponse) Reset()         { *m = MsgRedelegateResponse{} }
+func (m *MsgRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateResponse) ProtoMessage()    {}
+func (*MsgRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{29}
+}
+func (m *MsgRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateResponse.Merge(m, src)
+}
+func (m *MsgRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DelegateService(ctx context.Context, in *MsgDelegateService, opts ...grpc.CallOption) (*MsgDelegateServiceResponse, error) {
-	out := new(MsgDelegateServiceResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegateService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCancelUnbondingDelegation the message structure for the
+// CancelUnbondingDelegation gRPC service method. It allows a user to cancel
+// an unbonding delegation entry and delegate the tokens back to the target.
+type MsgCancelUnbondingDelegation struct {
+	// Delegator is the address of the user cancelling the unbonding delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// DelegationType is the type of the target from which the tokens are being
+	// unbonded.
+	DelegationType DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// TargetID is the ID of the target from which the tokens are being unbonded.
+	TargetID uint32 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Amount is the amount of coins of the unbonding delegation entry to be
+	// delegated back to the target.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// CreationHeight is the height at which the unbonding delegation entry to
+	// be cancelled has been created.
+	CreationHeight int64 `protobuf:"varint,5,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{30}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
 }
 
-func (c *msgClient) UndelegatePool(ctx context.Context, in *MsgUndelegatePool, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
-	out := new(MsgUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+// MsgCancelUnbondingDelegationResponse is the return value of
+// MsgCancelUnbondingDelegation.
+type MsgCancelUnbondingDelegationResponse struct {
 }
 
-func (c *msgClient) UndelegateOperator(ctx context.Context, in *MsgUndelegateOperator, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
-	out := new(MsgUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegateOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelUnbondingDelegationResponse{} }
+func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{31}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UndelegateService(ctx context.Context, in *MsgUndelegateService, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
-	out := new(MsgUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegateService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
+
+// MsgUnjailOperator defines the message structure for the UnjailOperator gRPC
+// service method. It allows the operator admin to unjail an operator from a
+// service once the jail period has ended.
+type MsgUnjailOperator struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	OperatorID uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	ServiceID  uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (m *MsgUnjailOperator) Reset()         { *m = MsgUnjailOperator{} }
+func (m *MsgUnjailOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailOperator) ProtoMessage()    {}
+func (*MsgUnjailOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{32}
+}
+func (m *MsgUnjailOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnjailOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailOperator.Merge(m, src)
+}
+func (m *MsgUnjailOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailOperator.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetUserPreferences(ctx context.Context, in *MsgSetUserPreferences, opts ...grpc.CallOption) (*MsgSetUserPreferencesResponse, error) {
-	out := new(MsgSetUserPreferencesResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/SetUserPreferences", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnjailOperator proto.InternalMessageInfo
+
+func (m *MsgUnjailOperator) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// JoinService defines the operation that allows the operator admin
-	// to start securing an AVS
-	JoinService(context.Context, *MsgJoinService) (*MsgJoinServiceResponse, error)
-	// LeaveService defines the operation that allows the operator admin
-	// to stop securing an AVS
-	LeaveService(context.Context, *MsgLeaveService) (*MsgLeaveServiceResponse, error)
-	// AddOperatorToAllowList defines the operation that allows the service admin
-	// to add an operator to the list of allowed operator to secure the service
-	AddOperatorToAllowList(context.Context, *MsgAddOperatorToAllowList) (*MsgAddOperatorToAllowListResponse, error)
-	// RemoveOperatorFromAllowlist defines the operation that allows the service
-	// admin to remove a previously added operator from the list of allowed
-	// operators to secure the service
-	RemoveOperatorFromAllowlist(context.Context, *MsgRemoveOperatorFromAllowlist) (*MsgRemoveOperatorFromAllowlistResponse, error)
-	// BorrowPoolSecurity defines the operation that allows the service admin
-	// to add a pool to the list of pools from which the service has chosen
-	// to borrow security.
-	BorrowPoolSecurity(context.Context, *MsgBorrowPoolSecurity) (*MsgBorrowPoolSecurityResponse, error)
-	// CeasePoolSecurityBorrow defines the operation that allows the service admin
-	// to remove a pool from the list of pools from which the service has chosen
-	// to borrow security.
-	CeasePoolSecurityBorrow(context.Context, *MsgCeasePoolSecurityBorrow) (*MsgCeasePoolSecurityBorrowResponse, error)
-	// DelegatePool defines the operation that allows users to delegate any amount
-	// of an asset to a pool that can then be used to provide services with
-	// cryptoeconomic security.
-	DelegatePool(context.Context, *MsgDelegatePool) (*MsgDelegatePoolResponse, error)
-	// DelegateOperator defines the operation that allows users to delegate their
-	// assets to a specific operator.
-	DelegateOperator(context.Context, *MsgDelegateOperator) (*MsgDelegateOperatorResponse, error)
-	// DelegateService defines the operation that allows users to delegate their
-	// assets to a specific service.
-	DelegateService(context.Context, *MsgDelegateService) (*MsgDelegateServiceResponse, error)
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters.
-	// The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// UndelegatePool defines the operation that allows users to undelegate their
-	// assets from a pool.
-	UndelegatePool(context.Context, *MsgUndelegatePool) (*MsgUndelegateResponse, error)
-	// UndelegateOperator defines the operation that allows users to undelegate
-	// their assets from a specific operator.
-	UndelegateOperator(context.Context, *MsgUndelegateOperator) (*MsgUndelegateResponse, error)
-	// UndelegateService defines the operation that allows users to undelegate
-	// their assets from a specific service.
-	UndelegateService(context.Context, *MsgUndelegateService) (*MsgUndelegateResponse, error)
-	// SetUserPreferences defines the operation that allows users to set their
-	// preferences for the restaking module.
-	SetUserPreferences(context.Context, *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error)
+func (m *MsgUnjailOperator) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUnjailOperator) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) JoinService(ctx context.Context, req *MsgJoinService) (*MsgJoinServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinService not implemented")
+// MsgUnjailOperatorResponse is the return value of MsgUnjailOperator.
+type MsgUnjailOperatorResponse struct {
 }
-func (*UnimplementedMsgServer) LeaveService(ctx context.Context, req *MsgLeaveService) (*MsgLeaveServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveService not implemented")
+
+func (m *MsgUnjailOperatorResponse) Reset()         { *m = MsgUnjailOperatorResponse{} }
+func (m *MsgUnjailOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailOperatorResponse) ProtoMessage()    {}
+func (*MsgUnjailOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{33}
 }
-func (*UnimplementedMsgServer) AddOperatorToAllowList(ctx context.Context, req *MsgAddOperatorToAllowList) (*MsgAddOperatorToAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddOperatorToAllowList not implemented")
+func (m *MsgUnjailOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RemoveOperatorFromAllowlist(ctx context.Context, req *MsgRemoveOperatorFromAllowlist) (*MsgRemoveOperatorFromAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveOperatorFromAllowlist not implemented")
+func (m *MsgUnjailOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BorrowPoolSecurity(ctx context.Context, req *MsgBorrowPoolSecurity) (*MsgBorrowPoolSecurityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BorrowPoolSecurity not implemented")
+func (m *MsgUnjailOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailOperatorResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CeasePoolSecurityBorrow(ctx context.Context, req *MsgCeasePoolSecurityBorrow) (*MsgCeasePoolSecurityBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CeasePoolSecurityBorrow not implemented")
+func (m *MsgUnjailOperatorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DelegatePool(ctx context.Context, req *MsgDelegatePool) (*MsgDelegatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatePool not implemented")
+func (m *MsgUnjailOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailOperatorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DelegateOperator(ctx context.Context, req *MsgDelegateOperator) (*MsgDelegateOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateOperator not implemented")
+
+var xxx_messageInfo_MsgUnjailOperatorResponse proto.InternalMessageInfo
+
+// MsgSubmitOperatorEvidence defines the message structure for the
+// SubmitOperatorEvidence gRPC service method. It allows anyone to submit an
+// evidence of an operator misbehavior performed while securing a service.
+type MsgSubmitOperatorEvidence struct {
+	// Submitter is the address of the user submitting the evidence
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// ServiceID is the ID of the service for which the misbehavior occurred
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Evidence is the evidence of the misbehavior
+	Evidence *types1.Any `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
 }
-func (*UnimplementedMsgServer) DelegateService(ctx context.Context, req *MsgDelegateService) (*MsgDelegateServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateService not implemented")
+
+func (m *MsgSubmitOperatorEvidence) Reset()         { *m = MsgSubmitOperatorEvidence{} }
+func (m *MsgSubmitOperatorEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOperatorEvidence) ProtoMessage()    {}
+func (*MsgSubmitOperatorEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{34}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSubmitOperatorEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UndelegatePool(ctx context.Context, req *MsgUndelegatePool) (*MsgUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndelegatePool not implemented")
+func (m *MsgSubmitOperatorEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOperatorEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UndelegateOperator(ctx context.Context, req *MsgUndelegateOperator) (*MsgUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndelegateOperator not implemented")
+func (m *MsgSubmitOperatorEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOperatorEvidence.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UndelegateService(ctx context.Context, req *MsgUndelegateService) (*MsgUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndelegateService not implemented")
+func (m *MsgSubmitOperatorEvidence) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetUserPreferences(ctx context.Context, req *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserPreferences not implemented")
+func (m *MsgSubmitOperatorEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOperatorEvidence.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSubmitOperatorEvidence proto.InternalMessageInfo
 
-func _Msg_JoinService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/JoinService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinService(ctx, req.(*MsgJoinService))
+func (m *MsgSubmitOperatorEvidence) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_LeaveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeaveService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LeaveService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/LeaveService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeaveService(ctx, req.(*MsgLeaveService))
+func (m *MsgSubmitOperatorEvidence) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddOperatorToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddOperatorToAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddOperatorToAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/AddOperatorToAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddOperatorToAllowList(ctx, req.(*MsgAddOperatorToAllowList))
+func (m *MsgSubmitOperatorEvidence) GetEvidence() *types1.Any {
+	if m != nil {
+		return m.Evidence
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RemoveOperatorFromAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveOperatorFromAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveOperatorFromAllowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/RemoveOperatorFromAllowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveOperatorFromAllowlist(ctx, req.(*MsgRemoveOperatorFromAllowlist))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSubmitOperatorEvidenceResponse is the return value of
+// MsgSubmitOperatorEvidence.
+type MsgSubmitOperatorEvidenceResponse struct {
+	// Hash is the hash of the submitted evidence
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func _Msg_BorrowPoolSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBorrowPoolSecurity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BorrowPoolSecurity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/BorrowPoolSecurity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BorrowPoolSecurity(ctx, req.(*MsgBorrowPoolSecurity))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitOperatorEvidenceResponse) Reset()         { *m = MsgSubmitOperatorEvidenceResponse{} }
+func (m *MsgSubmitOperatorEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOperatorEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitOperatorEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{35}
 }
-
-func _Msg_CeasePoolSecurityBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCeasePoolSecurityBorrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CeasePoolSecurityBorrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/CeasePoolSecurityBorrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CeasePoolSecurityBorrow(ctx, req.(*MsgCeasePoolSecurityBorrow))
+func (m *MsgSubmitOperatorEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOperatorEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOperatorEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitOperatorEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOperatorEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitOperatorEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOperatorEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOperatorEvidenceResponse.DiscardUnknown(m)
 }
 
-func _Msg_DelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/DelegatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegatePool(ctx, req.(*MsgDelegatePool))
+var xxx_messageInfo_MsgSubmitOperatorEvidenceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitOperatorEvidenceResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/DelegateOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateOperator(ctx, req.(*MsgDelegateOperator))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgVetoSlash defines the message structure for the VetoSlash gRPC service
+// method. It allows the module authority or the slash veto committee to cancel
+// a pending slash before it gets executed.
+type MsgVetoSlash struct {
+	// Signer is the address of the module authority or of the slash veto
+	// committee
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// PendingSlashID is the ID of the pending slash to veto
+	PendingSlashID uint64 `protobuf:"varint,2,opt,name=pending_slash_id,json=pendingSlashId,proto3" json:"pending_slash_id,omitempty"`
 }
 
-func _Msg_DelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateService)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/DelegateService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateService(ctx, req.(*MsgDelegateService))
+func (m *MsgVetoSlash) Reset()         { *m = MsgVetoSlash{} }
+func (m *MsgVetoSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoSlash) ProtoMessage()    {}
+func (*MsgVetoSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{36}
+}
+func (m *MsgVetoSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgVetoSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoSlash.Merge(m, src)
+}
+func (m *MsgVetoSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoSlash.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgVetoSlash proto.InternalMessageInfo
+
+func (m *MsgVetoSlash) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UndelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndelegatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/UndelegatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndelegatePool(ctx, req.(*MsgUndelegatePool))
+func (m *MsgVetoSlash) GetPendingSlashID() uint64 {
+	if m != nil {
+		return m.PendingSlashID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UndelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegateOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndelegateOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/UndelegateOperator",
+// MsgVetoSlashResponse is the return value of MsgVetoSlash.
+type MsgVetoSlashResponse struct {
+}
+
+func (m *MsgVetoSlashResponse) Reset()         { *m = MsgVetoSlashResponse{} }
+func (m *MsgVetoSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoSlashResponse) ProtoMessage()    {}
+func (*MsgVetoSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{37}
+}
+func (m *MsgVetoSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndelegateOperator(ctx, req.(*MsgUndelegateOperator))
+}
+func (m *MsgVetoSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoSlashResponse.Merge(m, src)
+}
+func (m *MsgVetoSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoSlashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoSlashResponse proto.InternalMessageInfo
+
+// MsgSetOperatorServiceKey defines the message structure for the
+// SetOperatorServiceKey gRPC service method. It allows the operator admin to
+// register the public key that the operator uses for a service. If the
+// operator already has a key for the service, the new key replaces it once
+// the rotation delay has passed.
+type MsgSetOperatorServiceKey struct {
+	// Sender is the address of the operator admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// OperatorID is the ID of the operator
+	OperatorID uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// ServiceID is the ID of the service for which the key is used
+	ServiceID uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// KeyType is the type of the key
+	KeyType KeyType `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=milkyway.restaking.v1.KeyType" json:"key_type,omitempty"`
+	// PublicKey is the encoded public key
+	PublicKey []byte `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// ProofOfPossession is the signature, made with the key, of the message
+	// binding the key to the operator and the service
+	ProofOfPossession []byte `protobuf:"bytes,6,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
+}
+
+func (m *MsgSetOperatorServiceKey) Reset()         { *m = MsgSetOperatorServiceKey{} }
+func (m *MsgSetOperatorServiceKey) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorServiceKey) ProtoMessage()    {}
+func (*MsgSetOperatorServiceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{38}
+}
+func (m *MsgSetOperatorServiceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorServiceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorServiceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetOperatorServiceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorServiceKey.Merge(m, src)
+}
+func (m *MsgSetOperatorServiceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorServiceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorServiceKey.DiscardUnknown(m)
 }
 
-func _Msg_UndelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegateService)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetOperatorServiceKey proto.InternalMessageInfo
+
+func (m *MsgSetOperatorServiceKey) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndelegateService(ctx, in)
+	return ""
+}
+
+func (m *MsgSetOperatorServiceKey) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/UndelegateService",
+	return 0
+}
+
+func (m *MsgSetOperatorServiceKey) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndelegateService(ctx, req.(*MsgUndelegateService))
+	return 0
+}
+
+func (m *MsgSetOperatorServiceKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
 	}
-	return interceptor(ctx, in, info, handler)
+	return KEY_TYPE_UNSPECIFIED
 }
 
-func _Msg_SetUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetUserPreferences)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetOperatorServiceKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetUserPreferences(ctx, in)
+	return nil
+}
+
+func (m *MsgSetOperatorServiceKey) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Msg/SetUserPreferences",
+	return nil
+}
+
+// MsgSetOperatorServiceKeyResponse is the return value of
+// MsgSetOperatorServiceKey.
+type MsgSetOperatorServiceKeyResponse struct {
+	// ActivationTime is the time from which the key is used
+	ActivationTime time.Time `protobuf:"bytes,1,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *MsgSetOperatorServiceKeyResponse) Reset()         { *m = MsgSetOperatorServiceKeyResponse{} }
+func (m *MsgSetOperatorServiceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorServiceKeyResponse) ProtoMessage()    {}
+func (*MsgSetOperatorServiceKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9772be2b1a923bdb, []int{39}
+}
+func (m *MsgSetOperatorServiceKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorServiceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorServiceKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetUserPreferences(ctx, req.(*MsgSetUserPreferences))
+}
+func (m *MsgSetOperatorServiceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorServiceKeyResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorServiceKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorServiceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorServiceKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOperatorServiceKeyResponse proto.InternalMessageInfo
+
+func (m *MsgSetOperatorServiceKeyResponse) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "milkyway.restaking.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "JoinService",
-			Handler:    _Msg_JoinService_Handler,
-		},
-		{
-			MethodName: "LeaveService",
-			Handler:    _Msg_LeaveService_Handler,
-		},
-		{
-			MethodName: "AddOperatorToAllowList",
-			Handler:    _Msg_AddOperatorToAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveOperatorFromAllowlist",
-			Handler:    _Msg_RemoveOperatorFromAllowlist_Handler,
-		},
-		{
-			MethodName: "BorrowPoolSecurity",
-			Handler:    _Msg_BorrowPoolSecurity_Handler,
-		},
-		{
-			MethodName: "CeasePoolSecurityBorrow",
-			Handler:    _Msg_CeasePoolSecurityBorrow_Handler,
-		},
-		{
-			MethodName: "DelegatePool",
-			Handler:    _Msg_DelegatePool_Handler,
-		},
-		{
-			MethodName: "DelegateOperator",
-			Handler:    _Msg_DelegateOperator_Handler,
-		},
-		{
-			MethodName: "DelegateService",
-			Handler:    _Msg_DelegateService_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "UndelegatePool",
-			Handler:    _Msg_UndelegatePool_Handler,
-		},
-		{
-			MethodName: "UndelegateOperator",
-			Handler:    _Msg_UndelegateOperator_Handler,
-		},
-		{
-			MethodName: "UndelegateService",
-			Handler:    _Msg_UndelegateService_Handler,
-		},
-		{
-			MethodName: "SetUserPreferences",
-			Handler:    _Msg_SetUserPreferences_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "milkyway/restaking/v1/messages.proto",
+func init() {
+	proto.RegisterType((*MsgJoinService)(nil), "milkyway.restaking.v1.MsgJoinService")
+	proto.RegisterType((*MsgJoinServiceResponse)(nil), "milkyway.restaking.v1.MsgJoinServiceResponse")
+	proto.RegisterType((*MsgLeaveService)(nil), "milkyway.restaking.v1.MsgLeaveService")
+	proto.RegisterType((*MsgLeaveServiceResponse)(nil), "milkyway.restaking.v1.MsgLeaveServiceResponse")
+	proto.RegisterType((*MsgAddOperatorToAllowList)(nil), "milkyway.restaking.v1.MsgAddOperatorToAllowList")
+	proto.RegisterType((*MsgAddOperatorToAllowListResponse)(nil), "milkyway.restaking.v1.MsgAddOperatorToAllowListResponse")
+	proto.RegisterType((*MsgRemoveOperatorFromAllowlist)(nil), "milkyway.restaking.v1.MsgRemoveOperatorFromAllowlist")
+	proto.RegisterType((*MsgRemoveOperatorFromAllowlistResponse)(nil), "milkyway.restaking.v1.MsgRemoveOperatorFromAllowlistResponse")
+	proto.RegisterType((*MsgBorrowPoolSecurity)(nil), "milkyway.restaking.v1.MsgBorrowPoolSecurity")
+	proto.RegisterType((*MsgBorrowPoolSecurityResponse)(nil), "milkyway.restaking.v1.MsgBorrowPoolSecurityResponse")
+	proto.RegisterType((*MsgCeasePoolSecurityBorrow)(nil), "milkyway.restaking.v1.MsgCeasePoolSecurityBorrow")
+	proto.RegisterType((*MsgCeasePoolSecurityBorrowResponse)(nil), "milkyway.restaking.v1.MsgCeasePoolSecurityBorrowResponse")
+	proto.RegisterType((*MsgDelegatePool)(nil), "milkyway.restaking.v1.MsgDelegatePool")
+	proto.RegisterType((*MsgDelegatePoolResponse)(nil), "milkyway.restaking.v1.MsgDelegatePoolResponse")
+	proto.RegisterType((*MsgDelegateOperator)(nil), "milkyway.restaking.v1.MsgDelegateOperator")
+	proto.RegisterType((*MsgDelegateOperatorResponse)(nil), "milkyway.restaking.v1.MsgDelegateOperatorResponse")
+	proto.RegisterType((*MsgDelegateService)(nil), "milkyway.restaking.v1.MsgDelegateService")
+	proto.RegisterType((*MsgDelegateServiceResponse)(nil), "milkyway.restaking.v1.MsgDelegateServiceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "milkyway.restaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "milkyway.restaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUndelegatePool)(nil), "milkyway.restaking.v1.MsgUndelegatePool")
+	proto.RegisterType((*MsgUndelegateOperator)(nil), "milkyway.restaking.v1.MsgUndelegateOperator")
+	proto.RegisterType((*MsgUndelegateService)(nil), "milkyway.restaking.v1.MsgUndelegateService")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "milkyway.restaking.v1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgSetUserPreferences)(nil), "milkyway.restaking.v1.MsgSetUserPreferences")
+	proto.RegisterType((*MsgSetUserPreferencesResponse)(nil), "milkyway.restaking.v1.MsgSetUserPreferencesResponse")
+	proto.RegisterType((*MsgRedelegatePool)(nil), "milkyway.restaking.v1.MsgRedelegatePool")
+	proto.RegisterType((*MsgRedelegateOperator)(nil), "milkyway.restaking.v1.MsgRedelegateOperator")
+	proto.RegisterType((*MsgRedelegateService)(nil), "milkyway.restaking.v1.MsgRedelegateService")
+	proto.RegisterType((*MsgRedelegateResponse)(nil), "milkyway.restaking.v1.MsgRedelegateResponse")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "milkyway.restaking.v1.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgUnjailOperator)(nil), "milkyway.restaking.v1.MsgUnjailOperator")
+	proto.RegisterType((*MsgUnjailOperatorResponse)(nil), "milkyway.restaking.v1.MsgUnjailOperatorResponse")
+	proto.RegisterType((*MsgSubmitOperatorEvidence)(nil), "milkyway.restaking.v1.MsgSubmitOperatorEvidence")
+	proto.RegisterType((*MsgSubmitOperatorEvidenceResponse)(nil), "milkyway.restaking.v1.MsgSubmitOperatorEvidenceResponse")
+	proto.RegisterType((*MsgVetoSlash)(nil), "milkyway.restaking.v1.MsgVetoSlash")
+	proto.RegisterType((*MsgVetoSlashResponse)(nil), "milkyway.restaking.v1.MsgVetoSlashResponse")
+	proto.RegisterType((*MsgSetOperatorServiceKey)(nil), "milkyway.restaking.v1.MsgSetOperatorServiceKey")
+	proto.RegisterType((*MsgSetOperatorServiceKeyResponse)(nil), "milkyway.restaking.v1.MsgSetOperatorServiceKeyResponse")
 }
 
-func (m *MsgJoinService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("milkyway/restaking/v1/messages.proto", fileDescriptor_9772be2b1a923bdb)
+}
+
+var fileDescriptor_9772be2b1a923bdb = []byte{
+	// 2075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x8a, 0x22, 0x3d, 0x49, 0x94, 0xb5, 0x91, 0x6c, 0x69, 0x6d, 0x91, 0xf2, 0xda,
+	0x96, 0x15, 0x59, 0x5a, 0x46, 0x72, 0x1c, 0xdb, 0x4c, 0x0a, 0x44, 0x8a, 0x5a, 0x54, 0x49, 0x94,
+	0xb8, 0x2b, 0xbb, 0x87, 0xa2, 0x85, 0xb0, 0xe4, 0x8e, 0x96, 0x5b, 0x91, 0x3b, 0xc4, 0xce, 0x8a,
+	0x36, 0x6f, 0x6d, 0x51, 0xa0, 0x45, 0x81, 0x02, 0x01, 0x0a, 0x14, 0xe8, 0xa5, 0xf0, 0x25, 0x45,
+	0xdb, 0x93, 0x0f, 0x01, 0x8a, 0xde, 0x0a, 0xf4, 0x12, 0x04, 0x3d, 0xb8, 0x3d, 0x14, 0x3d, 0x31,
+	0x01, 0x7d, 0x70, 0x90, 0x63, 0xff, 0x82, 0x62, 0x67, 0x77, 0x87, 0xb3, 0xbf, 0xe8, 0xa5, 0xda,
+	0xa8, 0xba, 0x48, 0x9c, 0x79, 0xdf, 0x7b, 0xf3, 0xde, 0x37, 0x33, 0x6f, 0xde, 0xcc, 0xc2, 0xd5,
+	0x86, 0x59, 0x3f, 0x6a, 0x3f, 0xd4, 0xda, 0x25, 0x1b, 0x11, 0x47, 0x3b, 0x32, 0x2d, 0xa3, 0xd4,
+	0xda, 0x28, 0x35, 0x10, 0x21, 0x9a, 0x81, 0x88, 0xd2, 0xb4, 0xb1, 0x83, 0xc5, 0xb9, 0x00, 0xa5,
+	0x30, 0x94, 0xd2, 0xda, 0x90, 0x66, 0xb4, 0x86, 0x69, 0xe1, 0x12, 0xfd, 0xeb, 0x21, 0xa5, 0x42,
+	0x15, 0x93, 0x06, 0x26, 0xa5, 0x8a, 0x46, 0x50, 0xa9, 0xb5, 0x51, 0x41, 0x8e, 0xb6, 0x51, 0xaa,
+	0x62, 0xd3, 0xf2, 0xe5, 0x17, 0x7c, 0x79, 0x83, 0x78, 0xe3, 0x10, 0xc3, 0x17, 0x2c, 0x78, 0x82,
+	0x03, 0xda, 0x2a, 0x79, 0x0d, 0x5f, 0x34, 0x6b, 0x60, 0x03, 0x7b, 0xfd, 0xee, 0xaf, 0x40, 0xc1,
+	0xc0, 0xd8, 0xa8, 0xa3, 0x12, 0x6d, 0x55, 0x8e, 0x0f, 0x4b, 0x9a, 0xd5, 0xf6, 0x45, 0xc5, 0xa8,
+	0xc8, 0x31, 0x1b, 0xae, 0xdf, 0x8d, 0xa6, 0x0f, 0x90, 0x53, 0xa2, 0xc6, 0x3a, 0xaa, 0x93, 0xfe,
+	0x98, 0xa6, 0x66, 0x6b, 0x0d, 0x1f, 0x23, 0x7f, 0x26, 0x40, 0x7e, 0x8f, 0x18, 0xef, 0x62, 0xd3,
+	0xda, 0x47, 0x76, 0xcb, 0xac, 0x22, 0xf1, 0x35, 0x18, 0x25, 0xc8, 0xd2, 0x91, 0x3d, 0x2f, 0x2c,
+	0x09, 0x2b, 0xe3, 0xdb, 0xf3, 0xff, 0xf8, 0x64, 0x7d, 0xd6, 0x0f, 0x67, 0x4b, 0xd7, 0x6d, 0x44,
+	0xc8, 0xbe, 0x63, 0x9b, 0x96, 0xa1, 0xfa, 0x38, 0xb1, 0x04, 0x13, 0xb8, 0x89, 0x6c, 0xcd, 0xc1,
+	0xf6, 0x81, 0xa9, 0xcf, 0xe7, 0x96, 0x84, 0x95, 0xa9, 0xed, 0x7c, 0xb7, 0x53, 0x84, 0x0f, 0xfd,
+	0xee, 0xdd, 0x1d, 0x15, 0x02, 0xc8, 0xae, 0x2e, 0xae, 0x01, 0x10, 0x6f, 0x34, 0x17, 0x3f, 0x4c,
+	0xf1, 0x53, 0xdd, 0x4e, 0x71, 0xdc, 0xf7, 0x61, 0x77, 0x47, 0x1d, 0xf7, 0x01, 0xbb, 0x7a, 0xf9,
+	0xfa, 0x4f, 0x9e, 0x3f, 0x59, 0xf5, 0xc7, 0xfa, 0xc5, 0xf3, 0x27, 0xab, 0x17, 0x58, 0x5c, 0x61,
+	0xcf, 0xe5, 0x79, 0x38, 0x1f, 0xee, 0x51, 0x11, 0x69, 0x62, 0x8b, 0x20, 0xf9, 0x6f, 0x02, 0x4c,
+	0xef, 0x11, 0xe3, 0x7d, 0xa4, 0xb5, 0xd0, 0x99, 0x8d, 0x73, 0x25, 0x12, 0xe7, 0x3c, 0x1f, 0x27,
+	0xef, 0xba, 0xbc, 0x00, 0x17, 0x22, 0x5d, 0x2c, 0xd2, 0x2f, 0x04, 0x58, 0xd8, 0x23, 0xc6, 0x96,
+	0xae, 0x07, 0x3e, 0xdd, 0xc7, 0x5b, 0xf5, 0x3a, 0x7e, 0xf8, 0xbe, 0x49, 0x9c, 0x13, 0xc4, 0x1c,
+	0x0e, 0x21, 0xd7, 0x3f, 0x84, 0x28, 0x43, 0xc3, 0x2f, 0x62, 0xa8, 0xbc, 0x19, 0x89, 0x59, 0xe6,
+	0x63, 0x4e, 0x0e, 0x42, 0xbe, 0x02, 0x97, 0x53, 0x85, 0x8c, 0x87, 0xaf, 0x04, 0x28, 0xec, 0x11,
+	0x43, 0x45, 0x0d, 0xdc, 0x42, 0x01, 0xf0, 0x5b, 0x36, 0x6e, 0x50, 0x68, 0xfd, 0x4c, 0x92, 0x71,
+	0x3b, 0x42, 0xc6, 0x75, 0x9e, 0x8c, 0x3e, 0x91, 0xc8, 0x2b, 0xb0, 0xdc, 0x1f, 0xc1, 0x6f, 0x84,
+	0xb9, 0x3d, 0x62, 0x6c, 0x63, 0xdb, 0xc6, 0x0f, 0xef, 0x61, 0x5c, 0xdf, 0x47, 0xd5, 0x63, 0xdb,
+	0x74, 0xda, 0x5f, 0x3b, 0x1b, 0x57, 0xe0, 0xe5, 0x26, 0xc6, 0xf5, 0x1e, 0x13, 0xd0, 0xed, 0x14,
+	0x47, 0x5d, 0x17, 0x76, 0x77, 0xd4, 0x51, 0x57, 0xb4, 0xab, 0x97, 0x95, 0x08, 0x03, 0x05, 0x9e,
+	0x81, 0xb8, 0xd3, 0x72, 0x11, 0x16, 0x13, 0x05, 0x2c, 0xde, 0x7f, 0x0a, 0x20, 0xed, 0x11, 0xe3,
+	0x1d, 0xa4, 0x11, 0xc4, 0x03, 0x3c, 0x95, 0xb3, 0x11, 0xf4, 0xcd, 0x48, 0xd0, 0x57, 0xf8, 0xa0,
+	0x53, 0x3c, 0x97, 0xaf, 0x82, 0x9c, 0x2e, 0x65, 0xe1, 0xff, 0xd9, 0xcb, 0x7b, 0x3b, 0xa8, 0x8e,
+	0x0c, 0xcd, 0xa1, 0x48, 0xf1, 0x0d, 0x18, 0xd7, 0xbd, 0x36, 0x7e, 0x71, 0xd8, 0x3d, 0xa8, 0xb8,
+	0x05, 0xa3, 0x5a, 0x03, 0x1f, 0x5b, 0x0e, 0x8d, 0x7a, 0x62, 0x73, 0x41, 0xf1, 0x35, 0xdc, 0x93,
+	0x52, 0xf1, 0x4f, 0x4a, 0xe5, 0x1d, 0x6c, 0x5a, 0xdb, 0xf9, 0x4f, 0x3b, 0xc5, 0x21, 0x37, 0xd2,
+	0x2d, 0xaa, 0xa0, 0xfa, 0x8a, 0xe5, 0x1b, 0x6e, 0xa4, 0x3d, 0x93, 0xb1, 0x24, 0xc7, 0xfb, 0xe9,
+	0x27, 0x39, 0xbe, 0x8b, 0x85, 0xf5, 0xdb, 0x1c, 0xbc, 0xc2, 0xc9, 0x82, 0x25, 0x7f, 0xe2, 0xd0,
+	0x06, 0x4e, 0xec, 0x0d, 0xc6, 0xc5, 0xf0, 0xd2, 0x70, 0x7f, 0x2e, 0xca, 0x61, 0x2e, 0xfe, 0xf8,
+	0x79, 0x71, 0xc5, 0x30, 0x9d, 0xda, 0x71, 0x45, 0xa9, 0xe2, 0x86, 0x5f, 0x26, 0xf8, 0xff, 0xd6,
+	0x89, 0x7e, 0x54, 0x72, 0xda, 0x4d, 0x44, 0xa8, 0x2a, 0x61, 0xbc, 0x95, 0xe2, 0xbc, 0x5d, 0x4a,
+	0xe2, 0x2d, 0xf0, 0x56, 0x5e, 0x84, 0x8b, 0x09, 0xdd, 0x8c, 0xbf, 0xdf, 0xe4, 0x40, 0xe4, 0xe4,
+	0xc1, 0x89, 0x78, 0x52, 0xfa, 0x06, 0xdb, 0x13, 0xa7, 0xcc, 0x9d, 0x12, 0xe7, 0xee, 0x62, 0x12,
+	0x77, 0xc1, 0xd9, 0x7a, 0x09, 0xa4, 0x78, 0x2f, 0x63, 0xee, 0xef, 0xde, 0x86, 0x7a, 0xd0, 0xd4,
+	0xdd, 0x35, 0x49, 0x2b, 0x29, 0xf1, 0x5d, 0x18, 0xd7, 0x8e, 0x9d, 0x1a, 0x76, 0xf7, 0x9f, 0x4f,
+	0xdb, 0xda, 0xbf, 0x3b, 0xc5, 0x73, 0x6d, 0xad, 0x51, 0x2f, 0xcb, 0x4c, 0x24, 0xa7, 0x53, 0xc9,
+	0x30, 0xe2, 0x9b, 0x30, 0xea, 0xd5, 0x67, 0xfe, 0x26, 0x5b, 0x54, 0x12, 0x0b, 0x57, 0xc5, 0x1b,
+	0x7a, 0x7b, 0xc4, 0x25, 0x48, 0xf5, 0x55, 0xca, 0xb7, 0x68, 0xa8, 0xcc, 0x58, 0xf8, 0x3c, 0xed,
+	0xd5, 0x80, 0x11, 0xff, 0xfd, 0x8d, 0xc6, 0x77, 0xb1, 0x70, 0xff, 0x22, 0xc0, 0x8c, 0x2b, 0xb3,
+	0xf4, 0xff, 0x45, 0x06, 0x79, 0x2b, 0x7b, 0x06, 0x19, 0x77, 0x03, 0xfb, 0xfd, 0xf3, 0x27, 0xab,
+	0x02, 0x9b, 0xc8, 0x5b, 0x3f, 0x7f, 0x5c, 0x1c, 0xfa, 0xf2, 0x71, 0x71, 0x28, 0x3e, 0xa1, 0x12,
+	0x3f, 0xa1, 0x61, 0x67, 0xe5, 0x8f, 0x73, 0x30, 0x17, 0xea, 0x3d, 0xfd, 0x6c, 0x51, 0xcb, 0xbe,
+	0xe2, 0x6f, 0xb9, 0x71, 0x0f, 0xb2, 0xce, 0xc3, 0x1c, 0xdd, 0x4d, 0xe7, 0xa8, 0x90, 0xcc, 0x11,
+	0x4b, 0x19, 0x8f, 0x73, 0x30, 0x1b, 0x92, 0x9c, 0x6e, 0x56, 0x38, 0x3d, 0x8e, 0xee, 0xa4, 0x73,
+	0xb4, 0x98, 0xcc, 0x51, 0x90, 0x1a, 0x8e, 0x22, 0x2b, 0x29, 0xd8, 0x26, 0xa2, 0x0a, 0xd3, 0x55,
+	0xdc, 0x68, 0xd6, 0x91, 0x63, 0x62, 0xeb, 0xc0, 0xbd, 0xab, 0x51, 0xa2, 0x26, 0x36, 0x25, 0xc5,
+	0xbb, 0xc8, 0x29, 0xc1, 0x45, 0x4e, 0xb9, 0x1f, 0x5c, 0xe4, 0xb6, 0xa7, 0xdc, 0x30, 0x3e, 0xfa,
+	0xbc, 0x28, 0x78, 0xee, 0xe5, 0x7b, 0x16, 0x5c, 0x8c, 0xfc, 0x57, 0xaf, 0x52, 0xdb, 0x47, 0xce,
+	0x03, 0x82, 0xec, 0x7b, 0x36, 0x3a, 0x44, 0x36, 0xb2, 0xaa, 0x88, 0x88, 0x6b, 0x30, 0x72, 0x4c,
+	0x32, 0x94, 0x2c, 0x14, 0x25, 0x7e, 0x00, 0x13, 0xcd, 0x9e, 0xb2, 0xbf, 0xf3, 0x96, 0x53, 0xd2,
+	0x4a, 0x64, 0x28, 0x3f, 0xbf, 0xf0, 0x06, 0xca, 0x6b, 0x2e, 0x6d, 0xd4, 0x74, 0x6c, 0x55, 0xc5,
+	0x7d, 0xf5, 0x0b, 0xb4, 0xb8, 0x80, 0x65, 0x98, 0x3f, 0xe5, 0x68, 0x86, 0x51, 0xd1, 0xff, 0x3f,
+	0xc3, 0x88, 0x6f, 0xc3, 0x98, 0x4e, 0x9c, 0x03, 0x77, 0xe5, 0xd0, 0x72, 0x2d, 0xbf, 0x79, 0x2d,
+	0x85, 0x27, 0xff, 0x78, 0x70, 0xe7, 0xaa, 0xdd, 0x44, 0xea, 0xcb, 0x3a, 0x71, 0xdc, 0x1f, 0xe2,
+	0x4d, 0x98, 0xa2, 0x16, 0x34, 0xdb, 0x40, 0x8e, 0xbb, 0xec, 0x47, 0xe8, 0xb2, 0x9f, 0xee, 0x76,
+	0x8a, 0x13, 0x3b, 0xc4, 0xb9, 0x4f, 0xfb, 0x77, 0x77, 0xd4, 0x09, 0x9d, 0x35, 0xf4, 0xcc, 0x89,
+	0x2d, 0xcc, 0x91, 0xfc, 0x95, 0x97, 0xd8, 0x54, 0x14, 0xdd, 0xca, 0x27, 0x66, 0xef, 0x2e, 0x4c,
+	0x13, 0xbb, 0x7a, 0x10, 0x4f, 0x6e, 0x33, 0xdd, 0x4e, 0x71, 0x6a, 0xdf, 0xae, 0x72, 0xf9, 0x6d,
+	0x8a, 0x70, 0x4d, 0xdd, 0x55, 0x75, 0x03, 0x8f, 0xdf, 0x77, 0xa8, 0xea, 0x0e, 0x71, 0x78, 0x55,
+	0x9d, 0x6b, 0xf2, 0x3b, 0x7f, 0xe4, 0x8c, 0x64, 0xc7, 0x38, 0xa5, 0x72, 0xd7, 0xcb, 0x8e, 0x2a,
+	0x8a, 0xe4, 0x84, 0x13, 0x73, 0xfd, 0x06, 0xe4, 0x5d, 0xae, 0x63, 0x19, 0xf2, 0x5c, 0xb7, 0x53,
+	0x9c, 0xdc, 0xb7, 0xab, 0xbd, 0x24, 0x39, 0x49, 0x7a, 0x2d, 0xdd, 0xd5, 0x73, 0x89, 0x8e, 0x3d,
+	0x2b, 0x50, 0xbd, 0x1d, 0xe2, 0x70, 0x7a, 0x7a, 0xaf, 0x75, 0x9a, 0x2c, 0x67, 0xcd, 0xaf, 0x2a,
+	0x4a, 0xce, 0xaf, 0xbd, 0xfe, 0xaf, 0x35, 0xbf, 0xfe, 0x6e, 0x18, 0x2e, 0xb9, 0x37, 0x28, 0xcd,
+	0xaa, 0xa2, 0xfa, 0x03, 0xab, 0x82, 0x2d, 0xdd, 0xb4, 0x8c, 0xde, 0xc6, 0x3e, 0xf1, 0xcc, 0x7e,
+	0x00, 0xd3, 0x3a, 0xb3, 0xe2, 0x25, 0x93, 0xdc, 0x20, 0xc9, 0x24, 0xaf, 0x87, 0xda, 0xe2, 0xab,
+	0x30, 0xde, 0xcb, 0x27, 0xde, 0x64, 0x4f, 0x76, 0x3b, 0xc5, 0x31, 0x96, 0x4c, 0xc6, 0x3c, 0xf1,
+	0x69, 0x4e, 0xb2, 0x78, 0x1d, 0xa6, 0xab, 0x36, 0xf2, 0x42, 0xac, 0x21, 0xd3, 0xa8, 0x39, 0xf3,
+	0x2f, 0x2d, 0x09, 0x2b, 0xc3, 0x6a, 0x3e, 0xe8, 0xfe, 0x36, 0xed, 0x2d, 0xbf, 0x9d, 0xbe, 0x1a,
+	0xae, 0x85, 0xee, 0xb9, 0x69, 0xf3, 0x20, 0x2f, 0xc3, 0xd5, 0x7e, 0x72, 0x76, 0x92, 0x3c, 0x0d,
+	0x6a, 0xd5, 0x1f, 0x6a, 0x66, 0x9d, 0xe5, 0xc2, 0x33, 0xf7, 0xca, 0xb7, 0x1a, 0xb9, 0xed, 0x47,
+	0x6a, 0x57, 0xde, 0x79, 0xf9, 0x22, 0x2c, 0xc4, 0x3a, 0x59, 0xbc, 0xbf, 0xce, 0x51, 0xe9, 0xfe,
+	0x71, 0xa5, 0x61, 0xb2, 0x7c, 0xfa, 0xcd, 0x96, 0xa9, 0xbb, 0x07, 0xac, 0xbb, 0x7a, 0x09, 0x95,
+	0x38, 0x19, 0x42, 0xef, 0x41, 0x07, 0xac, 0xda, 0xbe, 0x0f, 0x63, 0xc8, 0x1f, 0x91, 0x06, 0x3e,
+	0xb1, 0x39, 0x1b, 0xdb, 0x91, 0x5b, 0x56, 0x7b, 0x7b, 0xf5, 0xb3, 0x4f, 0xd6, 0x97, 0x93, 0x57,
+	0x7f, 0xd4, 0x73, 0x95, 0x59, 0xf4, 0xef, 0x33, 0xcc, 0xb7, 0xd8, 0xfb, 0x60, 0x72, 0xe8, 0xf2,
+	0x6d, 0xb8, 0x9c, 0x2a, 0x64, 0x29, 0x45, 0x84, 0x91, 0x9a, 0x46, 0x6a, 0x94, 0x9a, 0x49, 0x95,
+	0xfe, 0x96, 0x3f, 0x16, 0x60, 0x72, 0x8f, 0x18, 0xdf, 0x45, 0x0e, 0xde, 0xaf, 0x6b, 0xa4, 0x46,
+	0x17, 0x8f, 0x69, 0x58, 0x99, 0x16, 0x0f, 0xc5, 0x89, 0x6f, 0xc1, 0xb9, 0x26, 0xa2, 0x2b, 0xf4,
+	0x80, 0xb8, 0x26, 0x02, 0x12, 0x47, 0xb6, 0xc5, 0x6e, 0xa7, 0x98, 0xbf, 0xe7, 0xc9, 0xa8, 0xf5,
+	0xdd, 0x1d, 0x35, 0xdf, 0xe4, 0xdb, 0x7a, 0xf9, 0x9a, 0xb7, 0x36, 0xa8, 0x29, 0x37, 0xda, 0x39,
+	0x3e, 0x5a, 0xe6, 0x96, 0x7c, 0x1e, 0x66, 0xf9, 0x36, 0x5b, 0x11, 0x5f, 0xe6, 0x60, 0xde, 0xab,
+	0xb6, 0x82, 0xb0, 0xfd, 0x49, 0x7b, 0x0f, 0xb5, 0xcf, 0xdc, 0x46, 0x10, 0xef, 0xc2, 0xd8, 0x11,
+	0x6a, 0x7b, 0x09, 0x72, 0x84, 0x26, 0xc8, 0x42, 0x4a, 0x82, 0x7c, 0x0f, 0xb5, 0xbd, 0x32, 0xeb,
+	0xc8, 0xfb, 0x21, 0x2e, 0x02, 0x34, 0x8f, 0x2b, 0x75, 0xb3, 0x7a, 0x70, 0x84, 0xda, 0x34, 0xf1,
+	0x4c, 0xaa, 0xe3, 0x5e, 0x8f, 0x1b, 0xaa, 0x02, 0xaf, 0x34, 0x6d, 0x8c, 0x0f, 0x0f, 0xf0, 0xe1,
+	0x41, 0x13, 0x13, 0x82, 0x08, 0x31, 0xb1, 0x35, 0x3f, 0x4a, 0x71, 0x33, 0x54, 0xf4, 0xe1, 0xe1,
+	0x3d, 0x26, 0x28, 0x6f, 0x44, 0xb6, 0xe4, 0xe5, 0x48, 0x51, 0x1b, 0x67, 0x53, 0x6e, 0xc1, 0x52,
+	0x9a, 0x8c, 0x3f, 0xb5, 0xb4, 0xaa, 0x63, 0xb6, 0xb4, 0xff, 0xe6, 0xd4, 0xea, 0x59, 0x70, 0x31,
+	0x9b, 0x7f, 0x98, 0x85, 0xe1, 0x3d, 0x62, 0x88, 0x55, 0x98, 0xe0, 0xbf, 0xd9, 0xa4, 0x1d, 0x2d,
+	0xe1, 0xcf, 0x21, 0xd2, 0x7a, 0x26, 0x18, 0x0b, 0xe0, 0x10, 0x26, 0x43, 0x5f, 0x4c, 0x96, 0xd3,
+	0xd5, 0x79, 0x9c, 0xa4, 0x64, 0xc3, 0xb1, 0x71, 0x7e, 0x2a, 0xc0, 0xf9, 0xb4, 0x0f, 0x16, 0xe9,
+	0xa6, 0x92, 0x35, 0xa4, 0x3b, 0x83, 0x6a, 0x30, 0x37, 0x7e, 0x25, 0xc0, 0xc5, 0x7e, 0xdf, 0x0b,
+	0x6e, 0xa5, 0x5b, 0xee, 0xa3, 0x26, 0x7d, 0xe3, 0x44, 0x6a, 0xcc, 0xab, 0x47, 0x20, 0x26, 0xbc,
+	0xd6, 0xaf, 0xa5, 0x1b, 0x8d, 0xa3, 0xa5, 0xd7, 0x07, 0x41, 0xb3, 0x91, 0x7f, 0x26, 0xc0, 0x85,
+	0xb4, 0x87, 0xf3, 0x8d, 0x74, 0x8b, 0x29, 0x2a, 0xd2, 0xdd, 0x81, 0x55, 0xf8, 0x85, 0x18, 0x7a,
+	0xc2, 0xee, 0xb3, 0x10, 0x79, 0x9c, 0xa4, 0x64, 0xc3, 0xb1, 0x71, 0x6c, 0x38, 0x17, 0x7b, 0x53,
+	0x5e, 0x7d, 0xb1, 0x8d, 0x00, 0x2b, 0x6d, 0x66, 0xc7, 0xb2, 0x31, 0x31, 0x4c, 0x47, 0xdf, 0x61,
+	0x5f, 0x7d, 0xb1, 0x99, 0x60, 0xab, 0x6d, 0x64, 0x86, 0xf2, 0x64, 0x86, 0x9e, 0x2f, 0xfb, 0x90,
+	0xc9, 0xe3, 0x24, 0x25, 0x1b, 0x8e, 0x8d, 0x53, 0x83, 0x7c, 0xe4, 0xdd, 0x70, 0xa5, 0x8f, 0x85,
+	0x10, 0x52, 0x5a, 0xcb, 0x82, 0x64, 0x23, 0x35, 0x41, 0x4c, 0x78, 0xde, 0xcb, 0x64, 0x83, 0x4d,
+	0xdd, 0x60, 0x23, 0x5a, 0x30, 0x13, 0x7f, 0x28, 0xbb, 0x91, 0xc5, 0x44, 0x30, 0x71, 0x83, 0x8d,
+	0xf7, 0x08, 0xc4, 0xa4, 0x87, 0xa0, 0x74, 0x1b, 0x71, 0xb4, 0xf4, 0xfa, 0x20, 0x68, 0x7e, 0x16,
+	0x55, 0x94, 0x75, 0x16, 0xc3, 0x48, 0x69, 0x2d, 0x0b, 0x92, 0x9f, 0xc5, 0x84, 0xb7, 0x8c, 0x4c,
+	0x36, 0xb2, 0xcc, 0x62, 0xc2, 0x88, 0x16, 0xcc, 0xa8, 0x68, 0x80, 0x59, 0x8c, 0x81, 0x07, 0x1c,
+	0xef, 0x97, 0x02, 0x2c, 0xa4, 0xdf, 0x37, 0x6f, 0xf6, 0xc9, 0x8f, 0x69, 0x4a, 0xd2, 0x9b, 0x27,
+	0x50, 0x62, 0xfe, 0xd4, 0x21, 0x1f, 0xbe, 0x5b, 0xf4, 0xdf, 0xa1, 0x3c, 0x52, 0x7a, 0x2d, 0x2b,
+	0x32, 0x74, 0xca, 0xa7, 0x5c, 0x56, 0xfa, 0x18, 0x4b, 0xd6, 0x90, 0xee, 0x0c, 0xaa, 0xc1, 0xdc,
+	0xf8, 0x01, 0x8c, 0xf7, 0x0a, 0xfc, 0x2b, 0xe9, 0x66, 0x18, 0x48, 0xba, 0x91, 0x01, 0xc4, 0xcc,
+	0xff, 0x58, 0x80, 0xb9, 0xe4, 0x02, 0xbc, 0xd4, 0x77, 0xff, 0xc5, 0x15, 0xa4, 0xdb, 0x03, 0x2a,
+	0x04, 0x3e, 0x48, 0x2f, 0xfd, 0xc8, 0xad, 0x1d, 0xb7, 0xbf, 0xf3, 0x69, 0xb7, 0x20, 0x3c, 0xed,
+	0x16, 0x84, 0x2f, 0xba, 0x05, 0xe1, 0xa3, 0x67, 0x85, 0xa1, 0xa7, 0xcf, 0x0a, 0x43, 0xff, 0x7a,
+	0x56, 0x18, 0xfa, 0xde, 0x6d, 0xee, 0xd2, 0x1f, 0x8c, 0xb1, 0x5e, 0xd7, 0x2a, 0x84, 0xb5, 0x4a,
+	0xad, 0x8d, 0xcd, 0xd2, 0x23, 0xee, 0xa3, 0x11, 0x7d, 0x09, 0xa8, 0x8c, 0xd2, 0x8a, 0xf5, 0xe6,
+	0x7f, 0x06, 0x00, 0x8e, 0x7d, 0xd6, 0xbf, 0x75, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// JoinService defines the operation that allows the operator admin
+	// to start securing an AVS
+	JoinService(ctx context.Context, in *MsgJoinService, opts ...grpc.CallOption) (*MsgJoinServiceResponse, error)
+	// LeaveService defines the operation that allows the operator admin
+	// to stop securing an AVS
+	LeaveService(ctx context.Context, in *MsgLeaveService, opts ...grpc.CallOption) (*MsgLeaveServiceResponse, error)
+	// AddOperatorToAllowList defines the operation that allows the service admin
+	// to add an operator to the list of allowed operator to secure the service
+	AddOperatorToAllowList(ctx context.Context, in *MsgAddOperatorToAllowList, opts ...grpc.CallOption) (*MsgAddOperatorToAllowListResponse, error)
+	// RemoveOperatorFromAllowlist defines the operation that allows the service
+	// admin to remove a previously added operator from the list of allowed
+	// operators to secure the service
+	RemoveOperatorFromAllowlist(ctx context.Context, in *MsgRemoveOperatorFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveOperatorFromAllowlistResponse, error)
+	// BorrowPoolSecurity defines the operation that allows the service admin
+	// to add a pool to the list of pools from which the service has chosen
+	// to borrow security.
+	BorrowPoolSecurity(ctx context.Context, in *MsgBorrowPoolSecurity, opts ...grpc.CallOption) (*MsgBorrowPoolSecurityResponse, error)
+	// CeasePoolSecurityBorrow defines the operation that allows the service admin
+	// to remove a pool from the list of pools from which the service has chosen
+	// to borrow security.
+	CeasePoolSecurityBorrow(ctx context.Context, in *MsgCeasePoolSecurityBorrow, opts ...grpc.CallOption) (*MsgCeasePoolSecurityBorrowResponse, error)
+	// DelegatePool defines the operation that allows users to delegate any amount
+	// of an asset to a pool that can then be used to provide services with
+	// cryptoeconomic security.
+	DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error)
+	// DelegateOperator defines the operation that allows users to delegate their
+	// assets to a specific operator.
+	DelegateOperator(ctx context.Context, in *MsgDelegateOperator, opts ...grpc.CallOption) (*MsgDelegateOperatorResponse, error)
+	// DelegateService defines the operation that allows users to delegate their
+	// assets to a specific service.
+	DelegateService(ctx context.Context, in *MsgDelegateService, opts ...grpc.CallOption) (*MsgDelegateServiceResponse, error)
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters.
+	// The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UndelegatePool defines the operation that allows users to undelegate their
+	// assets from a pool.
+	UndelegatePool(ctx context.Context, in *MsgUndelegatePool, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// UndelegateOperator defines the operation that allows users to undelegate
+	// their assets from a specific operator.
+	UndelegateOperator(ctx context.Context, in *MsgUndelegateOperator, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// UndelegateService defines the operation that allows users to undelegate
+	// their assets from a specific service.
+	UndelegateService(ctx context.Context, in *MsgUndelegateService, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// SetUserPreferences defines the operation that allows users to set their
+	// preferences for the restaking module.
+	SetUserPreferences(ctx context.Context, in *MsgSetUserPreferences, opts ...grpc.CallOption) (*MsgSetUserPreferencesResponse, error)
+	// RedelegatePool defines the operation that allows users to move their
+	// assets from a pool to an operator or a service without waiting for the
+	// unbonding period to pass.
+	RedelegatePool(ctx context.Context, in *MsgRedelegatePool, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// RedelegateOperator defines the operation that allows users to move their
+	// assets from an operator to another operator without waiting for the
+	// unbonding period to pass.
+	RedelegateOperator(ctx context.Context, in *MsgRedelegateOperator, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// RedelegateService defines the operation that allows users to move their
+	// assets from a service to another service without waiting for the
+	// unbonding period to pass.
+	RedelegateService(ctx context.Context, in *MsgRedelegateService, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	// CancelUnbondingDelegation defines the operation that allows users to
+	// cancel an unbonding delegation entry that has not completed yet, delegating
+	// the tokens back to the same target.
+	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// UnjailOperator defines the operation that allows the operator admin to
+	// unjail an operator from a service once the jail period has ended.
+	UnjailOperator(ctx context.Context, in *MsgUnjailOperator, opts ...grpc.CallOption) (*MsgUnjailOperatorResponse, error)
+	// SubmitOperatorEvidence defines the operation that allows anyone to submit
+	// an evidence of an operator misbehavior performed while securing a service.
+	SubmitOperatorEvidence(ctx context.Context, in *MsgSubmitOperatorEvidence, opts ...grpc.CallOption) (*MsgSubmitOperatorEvidenceResponse, error)
+	// VetoSlash defines the operation that allows the module authority or the
+	// slash veto committee to cancel a pending slash before it gets executed.
+	VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error)
+	// SetOperatorServiceKey defines the operation that allows the operator admin
+	// to register the public key that the operator uses for a service.
+	SetOperatorServiceKey(ctx context.Context, in *MsgSetOperatorServiceKey, opts ...grpc.CallOption) (*MsgSetOperatorServiceKeyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) JoinService(ctx context.Context, in *MsgJoinService, opts ...grpc.CallOption) (*MsgJoinServiceResponse, error) {
+	out := new(MsgJoinServiceResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/JoinService", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgJoinService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LeaveService(ctx context.Context, in *MsgLeaveService, opts ...grpc.CallOption) (*MsgLeaveServiceResponse, error) {
+	out := new(MsgLeaveServiceResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/LeaveService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgJoinService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) AddOperatorToAllowList(ctx context.Context, in *MsgAddOperatorToAllowList, opts ...grpc.CallOption) (*MsgAddOperatorToAllowListResponse, error) {
+	out := new(MsgAddOperatorToAllowListResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/AddOperatorToAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) RemoveOperatorFromAllowlist(ctx context.Context, in *MsgRemoveOperatorFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveOperatorFromAllowlistResponse, error) {
+	out := new(MsgRemoveOperatorFromAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/RemoveOperatorFromAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgJoinServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BorrowPoolSecurity(ctx context.Context, in *MsgBorrowPoolSecurity, opts ...grpc.CallOption) (*MsgBorrowPoolSecurityResponse, error) {
+	out := new(MsgBorrowPoolSecurityResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/BorrowPoolSecurity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgJoinServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CeasePoolSecurityBorrow(ctx context.Context, in *MsgCeasePoolSecurityBorrow, opts ...grpc.CallOption) (*MsgCeasePoolSecurityBorrowResponse, error) {
+	out := new(MsgCeasePoolSecurityBorrowResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/CeasePoolSecurityBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgJoinServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error) {
+	out := new(MsgDelegatePoolResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgLeaveService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelegateOperator(ctx context.Context, in *MsgDelegateOperator, opts ...grpc.CallOption) (*MsgDelegateOperatorResponse, error) {
+	out := new(MsgDelegateOperatorResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegateOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLeaveService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgLeaveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DelegateService(ctx context.Context, in *MsgDelegateService, opts ...grpc.CallOption) (*MsgDelegateServiceResponse, error) {
+	out := new(MsgDelegateServiceResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/DelegateService", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgLeaveServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgLeaveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgLeaveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddOperatorToAllowList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UndelegatePool(ctx context.Context, in *MsgUndelegatePool, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddOperatorToAllowList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAddOperatorToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UndelegateOperator(ctx context.Context, in *MsgUndelegateOperator, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegateOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddOperatorToAllowListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UndelegateService(ctx context.Context, in *MsgUndelegateService, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UndelegateService", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddOperatorToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddOperatorToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveOperatorFromAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetUserPreferences(ctx context.Context, in *MsgSetUserPreferences, opts ...grpc.CallOption) (*MsgSetUserPreferencesResponse, error) {
+	out := new(MsgSetUserPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/SetUserPreferences", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveOperatorFromAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRemoveOperatorFromAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RedelegatePool(ctx context.Context, in *MsgRedelegatePool, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/RedelegatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveOperatorFromAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedelegateOperator(ctx context.Context, in *MsgRedelegateOperator, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/RedelegateOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveOperatorFromAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveOperatorFromAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBorrowPoolSecurity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedelegateService(ctx context.Context, in *MsgRedelegateService, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/RedelegateService", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBorrowPoolSecurity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgBorrowPoolSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
+	out := new(MsgCancelUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/CancelUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBorrowPoolSecurityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnjailOperator(ctx context.Context, in *MsgUnjailOperator, opts ...grpc.CallOption) (*MsgUnjailOperatorResponse, error) {
+	out := new(MsgUnjailOperatorResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/UnjailOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBorrowPoolSecurityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgBorrowPoolSecurityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SubmitOperatorEvidence(ctx context.Context, in *MsgSubmitOperatorEvidence, opts ...grpc.CallOption) (*MsgSubmitOperatorEvidenceResponse, error) {
+	out := new(MsgSubmitOperatorEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/SubmitOperatorEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCeasePoolSecurityBorrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error) {
+	out := new(MsgVetoSlashResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/VetoSlash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCeasePoolSecurityBorrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetOperatorServiceKey(ctx context.Context, in *MsgSetOperatorServiceKey, opts ...grpc.CallOption) (*MsgSetOperatorServiceKeyResponse, error) {
+	out := new(MsgSetOperatorServiceKeyResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Msg/SetOperatorServiceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCeasePoolSecurityBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// JoinService defines the operation that allows the operator admin
+	// to start securing an AVS
+	JoinService(context.Context, *MsgJoinService) (*MsgJoinServiceResponse, error)
+	// LeaveService defines the operation that allows the operator admin
+	// to stop securing an AVS
+	LeaveService(context.Context, *MsgLeaveService) (*MsgLeaveServiceResponse, error)
+	// AddOperatorToAllowList defines the operation that allows the service admin
+	// to add an operator to the list of allowed operator to secure the service
+	AddOperatorToAllowList(context.Context, *MsgAddOperatorToAllowList) (*MsgAddOperatorToAllowListResponse, error)
+	// RemoveOperatorFromAllowlist defines the operation that allows the service
+	// admin to remove a previously added operator from the list of allowed
+	// operators to secure the service
+	RemoveOperatorFromAllowlist(context.Context, *MsgRemoveOperatorFromAllowlist) (*MsgRemoveOperatorFromAllowlistResponse, error)
+	// BorrowPoolSecurity defines the operation that allows the service admin
+	// to add a pool to the list of pools from which the service has chosen
+	// to borrow security.
+	BorrowPoolSecurity(context.Context, *MsgBorrowPoolSecurity) (*MsgBorrowPoolSecurityResponse, error)
+	// CeasePoolSecurityBorrow defines the operation that allows the service admin
+	// to remove a pool from the list of pools from which the service has chosen
+	// to borrow security.
+	CeasePoolSecurityBorrow(context.Context, *MsgCeasePoolSecurityBorrow) (*MsgCeasePoolSecurityBorrowResponse, error)
+	// DelegatePool defines the operation that allows users to delegate any amount
+	// of an asset to a pool that can then be used to provide services with
+	// cryptoeconomic security.
+	DelegatePool(context.Context, *MsgDelegatePool) (*MsgDelegatePoolResponse, error)
+	// DelegateOperator defines the operation that allows users to delegate their
+	// assets to a specific operator.
+	DelegateOperator(context.Context, *MsgDelegateOperator) (*MsgDelegateOperatorResponse, error)
+	// DelegateService defines the operation that allows users to delegate their
+	// assets to a specific service.
+	DelegateService(context.Context, *MsgDelegateService) (*MsgDelegateServiceResponse, error)
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters.
+	// The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UndelegatePool defines the operation that allows users to undelegate their
+	// assets from a pool.
+	UndelegatePool(context.Context, *MsgUndelegatePool) (*MsgUndelegateResponse, error)
+	// UndelegateOperator defines the operation that allows users to undelegate
+	// their assets from a specific operator.
+	UndelegateOperator(context.Context, *MsgUndelegateOperator) (*MsgUndelegateResponse, error)
+	// UndelegateService defines the operation that allows users to undelegate
+	// their assets from a specific service.
+	UndelegateService(context.Context, *MsgUndelegateService) (*MsgUndelegateResponse, error)
+	// SetUserPreferences defines the operation that allows users to set their
+	// preferences for the restaking module.
+	SetUserPreferences(context.Context, *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error)
+	// RedelegatePool defines the operation that allows users to move their
+	// assets from a pool to an operator or a service without waiting for the
+	// unbonding period to pass.
+	RedelegatePool(context.Context, *MsgRedelegatePool) (*MsgRedelegateResponse, error)
+	// RedelegateOperator defines the operation that allows users to move their
+	// assets from an operator to another operator without waiting for the
+	// unbonding period to pass.
+	RedelegateOperator(context.Context, *MsgRedelegateOperator) (*MsgRedelegateResponse, error)
+	// RedelegateService defines the operation that allows users to move their
+	// assets from a service to another service without waiting for the
+	// unbonding period to pass.
+	RedelegateService(context.Context, *MsgRedelegateService) (*MsgRedelegateResponse, error)
+	// CancelUnbondingDelegation defines the operation that allows users to
+	// cancel an unbonding delegation entry that has not completed yet, delegating
+	// the tokens back to the same target.
+	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// UnjailOperator defines the operation that allows the operator admin to
+	// unjail an operator from a service once the jail period has ended.
+	UnjailOperator(context.Context, *MsgUnjailOperator) (*MsgUnjailOperatorResponse, error)
+	// SubmitOperatorEvidence defines the operation that allows anyone to submit
+	// an evidence of an operator misbehavior performed while securing a service.
+	SubmitOperatorEvidence(context.Context, *MsgSubmitOperatorEvidence) (*MsgSubmitOperatorEvidenceResponse, error)
+	// VetoSlash defines the operation that allows the module authority or the
+	// slash veto committee to cancel a pending slash before it gets executed.
+	VetoSlash(context.Context, *MsgVetoSlash) (*MsgVetoSlashResponse, error)
+	// SetOperatorServiceKey defines the operation that allows the operator admin
+	// to register the public key that the operator uses for a service.
+	SetOperatorServiceKey(context.Context, *MsgSetOperatorServiceKey) (*MsgSetOperatorServiceKeyResponse, error)
 }
 
-func (m *MsgCeasePoolSecurityBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCeasePoolSecurityBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) JoinService(ctx context.Context, req *MsgJoinService) (*MsgJoinServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinService not implemented")
 }
-
-func (m *MsgCeasePoolSecurityBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) LeaveService(ctx context.Context, req *MsgLeaveService) (*MsgLeaveServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveService not implemented")
 }
-
-func (m *MsgDelegatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddOperatorToAllowList(ctx context.Context, req *MsgAddOperatorToAllowList) (*MsgAddOperatorToAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOperatorToAllowList not implemented")
 }
-
-func (m *MsgDelegatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RemoveOperatorFromAllowlist(ctx context.Context, req *MsgRemoveOperatorFromAllowlist) (*MsgRemoveOperatorFromAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOperatorFromAllowlist not implemented")
 }
-
-func (m *MsgDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) BorrowPoolSecurity(ctx context.Context, req *MsgBorrowPoolSecurity) (*MsgBorrowPoolSecurityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowPoolSecurity not implemented")
 }
-
-func (m *MsgDelegatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CeasePoolSecurityBorrow(ctx context.Context, req *MsgCeasePoolSecurityBorrow) (*MsgCeasePoolSecurityBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CeasePoolSecurityBorrow not implemented")
 }
-
-func (m *MsgDelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DelegatePool(ctx context.Context, req *MsgDelegatePool) (*MsgDelegatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatePool not implemented")
 }
-
-func (m *MsgDelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DelegateOperator(ctx context.Context, req *MsgDelegateOperator) (*MsgDelegateOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateOperator not implemented")
 }
-
-func (m *MsgDelegateOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DelegateService(ctx context.Context, req *MsgDelegateService) (*MsgDelegateServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateService not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UndelegatePool(ctx context.Context, req *MsgUndelegatePool) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegatePool not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateOperator(ctx context.Context, req *MsgUndelegateOperator) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateOperator not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateService(ctx context.Context, req *MsgUndelegateService) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateService not implemented")
+}
+func (*UnimplementedMsgServer) SetUserPreferences(ctx context.Context, req *MsgSetUserPreferences) (*MsgSetUserPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPreferences not implemented")
+}
+func (*UnimplementedMsgServer) RedelegatePool(ctx context.Context, req *MsgRedelegatePool) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegatePool not implemented")
+}
+func (*UnimplementedMsgServer) RedelegateOperator(ctx context.Context, req *MsgRedelegateOperator) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateOperator not implemented")
+}
+func (*UnimplementedMsgServer) RedelegateService(ctx context.Context, req *MsgRedelegateService) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateService not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
+}
+func (*UnimplementedMsgServer) UnjailOperator(ctx context.Context, req *MsgUnjailOperator) (*MsgUnjailOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailOperator not implemented")
+}
+func (*UnimplementedMsgServer) SubmitOperatorEvidence(ctx context.Context, req *MsgSubmitOperatorEvidence) (*MsgSubmitOperatorEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOperatorEvidence not implemented")
+}
+func (*UnimplementedMsgServer) VetoSlash(ctx context.Context, req *MsgVetoSlash) (*MsgVetoSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoSlash not implemented")
+}
+func (*UnimplementedMsgServer) SetOperatorServiceKey(ctx context.Context, req *MsgSetOperatorServiceKey) (*MsgSetOperatorServiceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorServiceKey not implemented")
 }
 
-func (m *MsgDelegateOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgDelegateOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_JoinService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinService)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).JoinService(ctx, in)
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/JoinService",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinService(ctx, req.(*MsgJoinService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_LeaveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveService)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/LeaveService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveService(ctx, req.(*MsgLeaveService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddOperatorToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddOperatorToAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).AddOperatorToAllowList(ctx, in)
 	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/AddOperatorToAllowList",
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddOperatorToAllowList(ctx, req.(*MsgAddOperatorToAllowList))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveOperatorFromAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOperatorFromAllowlist)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOperatorFromAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/RemoveOperatorFromAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOperatorFromAllowlist(ctx, req.(*MsgRemoveOperatorFromAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BorrowPoolSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowPoolSecurity)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowPoolSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/BorrowPoolSecurity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowPoolSecurity(ctx, req.(*MsgBorrowPoolSecurity))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CeasePoolSecurityBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCeasePoolSecurityBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CeasePoolSecurityBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/CeasePoolSecurityBorrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CeasePoolSecurityBorrow(ctx, req.(*MsgCeasePoolSecurityBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
+func _Msg_DelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegatePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).DelegatePool(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/DelegatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegatePool(ctx, req.(*MsgDelegatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateOperator)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/DelegateOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateOperator(ctx, req.(*MsgDelegateOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateService)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/DelegateService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateService(ctx, req.(*MsgDelegateService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UndelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegatePool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/UndelegatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegatePool(ctx, req.(*MsgUndelegatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
+func _Msg_UndelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateOperator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/UndelegateOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateOperator(ctx, req.(*MsgUndelegateOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UndelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateService)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUndelegateOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/UndelegateService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateService(ctx, req.(*MsgUndelegateService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_SetUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUserPreferences)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).SetUserPreferences(ctx, in)
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/SetUserPreferences",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUserPreferences(ctx, req.(*MsgSetUserPreferences))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateService) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RedelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegatePool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/RedelegatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegatePool(ctx, req.(*MsgRedelegatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateService) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RedelegateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/RedelegateOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateOperator(ctx, req.(*MsgRedelegateOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessages(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_RedelegateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateService)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateService(ctx, in)
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/RedelegateService",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateService(ctx, req.(*MsgRedelegateService))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingDelegation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/CancelUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, req.(*MsgCancelUnbondingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UnjailOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/UnjailOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailOperator(ctx, req.(*MsgUnjailOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err4 != nil {
-		return 0, err4
+func _Msg_SubmitOperatorEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOperatorEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i -= n4
-	i = encodeVarintMessages(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOperatorEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/SubmitOperatorEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOperatorEvidence(ctx, req.(*MsgSubmitOperatorEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetUserPreferences) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_VetoSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoSlash)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).VetoSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/VetoSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoSlash(ctx, req.(*MsgVetoSlash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetUserPreferences) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetOperatorServiceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorServiceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorServiceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Msg/SetOperatorServiceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorServiceKey(ctx, req.(*MsgSetOperatorServiceKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetUserPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milkyway.restaking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinService",
+			Handler:    _Msg_JoinService_Handler,
+		},
+		{
+			MethodName: "LeaveService",
+			Handler:    _Msg_LeaveService_Handler,
+		},
+		{
+			MethodName: "AddOperatorToAllowList",
+			Handler:    _Msg_AddOperatorToAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveOperatorFromAllowlist",
+			Handler:    _Msg_RemoveOperatorFromAllowlist_Handler,
+		},
+		{
+			MethodName: "BorrowPoolSecurity",
+			Handler:    _Msg_BorrowPoolSecurity_Handler,
+		},
+		{
+			MethodName: "CeasePoolSecurityBorrow",
+			Handler:    _Msg_CeasePoolSecurityBorrow_Handler,
+		},
+		{
+			MethodName: "DelegatePool",
+			Handler:    _Msg_DelegatePool_Handler,
+		},
+		{
+			MethodName: "DelegateOperator",
+			Handler:    _Msg_DelegateOperator_Handler,
+		},
+		{
+			MethodName: "DelegateService",
+			Handler:    _Msg_DelegateService_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UndelegatePool",
+			Handler:    _Msg_UndelegatePool_Handler,
+		},
+		{
+			MethodName: "UndelegateOperator",
+			Handler:    _Msg_UndelegateOperator_Handler,
+		},
+		{
+			MethodName: "UndelegateService",
+			Handler:    _Msg_UndelegateService_Handler,
+		},
+		{
+			MethodName: "SetUserPreferences",
+			Handler:    _Msg_SetUserPreferences_Handler,
+		},
+		{
+			MethodName: "RedelegatePool",
+			Handler:    _Msg_RedelegatePool_Handler,
+		},
+		{
+			MethodName: "RedelegateOperator",
+			Handler:    _Msg_RedelegateOperator_Handler,
+		},
+		{
+			MethodName: "RedelegateService",
+			Handler:    _Msg_RedelegateService_Handler,
+		},
+		{
+			MethodName: "CancelUnbondingDelegation",
+			Handler:    _Msg_CancelUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "UnjailOperator",
+			Handler:    _Msg_UnjailOperator_Handler,
+		},
+		{
+			MethodName: "SubmitOperatorEvidence",
+			Handler:    _Msg_SubmitOperatorEvidence_Handler,
+		},
+		{
+			MethodName: "VetoSlash",
+			Handler:    _Msg_VetoSlash_Handler,
+		},
+		{
+			MethodName: "SetOperatorServiceKey",
+			Handler:    _Msg_SetOperatorServiceKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milkyway/restaking/v1/messages.proto",
+}
+
+func (m *MsgJoinService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	{
-		size, err := m.Preferences.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.User)))
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgJoinServiceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2917,12 +3220,12 @@ func (m *MsgSetUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetUserPreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgJoinServiceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetUserPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgJoinServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2930,396 +3233,3203 @@ func (m *MsgSetUserPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgLeaveService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgJoinService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgLeaveService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLeaveServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
-	}
-	return n
+func (m *MsgLeaveServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgLeaveServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddOperatorToAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddOperatorToAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddOperatorToAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddOperatorToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddOperatorToAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddOperatorToAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveOperatorFromAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
-	}
-	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
-	}
-	return n
+func (m *MsgAddOperatorToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveOperatorFromAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddOperatorToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBorrowPoolSecurity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
-	}
-	if m.PoolID != 0 {
-		n += 1 + sovMessages(uint64(m.PoolID))
+func (m *MsgRemoveOperatorFromAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBorrowPoolSecurityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRemoveOperatorFromAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCeasePoolSecurityBorrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveOperatorFromAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PoolID != 0 {
-		n += 1 + sovMessages(uint64(m.PoolID))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCeasePoolSecurityBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveOperatorFromAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMessages(uint64(l))
-	return n
+func (m *MsgRemoveOperatorFromAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveOperatorFromAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateOperator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMessages(uint64(l))
-		}
+func (m *MsgBorrowPoolSecurity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgBorrowPoolSecurity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBorrowPoolSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.PoolID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMessages(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBorrowPoolSecurityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovMessages(uint64(l))
-	return n
+func (m *MsgBorrowPoolSecurityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBorrowPoolSecurityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCeasePoolSecurityBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCeasePoolSecurityBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCeasePoolSecurityBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	if m.PoolID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMessages(uint64(l))
-	return n
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegateOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCeasePoolSecurityBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCeasePoolSecurityBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCeasePoolSecurityBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.OperatorID != 0 {
-		n += 1 + sovMessages(uint64(m.OperatorID))
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMessages(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMessages(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Preferences.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUserPreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUserPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DstTargetID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DstTargetID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DstType != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DstType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DstOperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DstOperatorID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcOperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.SrcOperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DstServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DstServiceID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.SrcServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMessages(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TargetID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.TargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOperatorEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOperatorEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOperatorEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOperatorEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOperatorEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOperatorEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingSlashID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.PendingSlashID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorServiceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorServiceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorServiceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorServiceKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorServiceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorServiceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMessages(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgJoinService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	return n
+}
+
+func (m *MsgJoinServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	return n
+}
+
+func (m *MsgLeaveServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddOperatorToAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	return n
+}
+
+func (m *MsgAddOperatorToAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOperatorFromAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	return n
+}
+
+func (m *MsgRemoveOperatorFromAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBorrowPoolSecurity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovMessages(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgBorrowPoolSecurityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCeasePoolSecurityBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovMessages(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgCeasePoolSecurityBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgDelegatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUndelegateService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgSetUserPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Preferences.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgSetUserPreferencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	if m.DstType != 0 {
+		n += 1 + sovMessages(uint64(m.DstType))
+	}
+	if m.DstTargetID != 0 {
+		n += 1 + sovMessages(uint64(m.DstTargetID))
+	}
+	return n
+}
+
+func (m *MsgRedelegateOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.SrcOperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.SrcOperatorID))
+	}
+	if m.DstOperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.DstOperatorID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRedelegateService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.SrcServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.SrcServiceID))
+	}
+	if m.DstServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.DstServiceID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.DelegationType != 0 {
+		n += 1 + sovMessages(uint64(m.DelegationType))
+	}
+	if m.TargetID != 0 {
+		n += 1 + sovMessages(uint64(m.TargetID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovMessages(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	return n
+}
+
+func (m *MsgUnjailOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitOperatorEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitOperatorEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.PendingSlashID != 0 {
+		n += 1 + sovMessages(uint64(m.PendingSlashID))
+	}
+	return n
+}
+
+func (m *MsgVetoSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOperatorServiceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovMessages(uint64(m.OperatorID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovMessages(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOperatorServiceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOperatorToAllowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOperatorToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddOperatorToAllowListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOperatorToAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOperatorToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOperatorFromAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowPoolSecurity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowPoolSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowPoolSecurityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowPoolSecurityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowPoolSecurityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCeasePoolSecurityBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgUndelegateService) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovMessages(uint64(m.ServiceID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMessages(uint64(l))
+	return nil
+}
+func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovMessages(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgSetUserPreferences) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovMessages(uint64(l))
+func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Preferences.Size()
-	n += 1 + l + sovMessages(uint64(l))
-	return n
-}
 
-func (m *MsgSetUserPreferencesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,15 +6452,15 @@ func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3378,7 +6488,7 @@ func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3400,10 +6510,10 @@ func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ServiceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -3413,11 +6523,26 @@ func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -3439,7 +6564,7 @@ func (m *MsgJoinService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,10 +6587,10 @@ func (m *MsgJoinServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3489,7 +6614,7 @@ func (m *MsgJoinServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3512,15 +6637,15 @@ func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3548,13 +6673,13 @@ func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			m.OperatorID = 0
+			m.ServiceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -3564,16 +6689,16 @@ func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
+				m.ServiceID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ServiceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -3583,11 +6708,26 @@ func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -3609,7 +6749,7 @@ func (m *MsgLeaveService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateServiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,10 +6772,10 @@ func (m *MsgLeaveServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateServiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3659,7 +6799,7 @@ func (m *MsgLeaveServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3682,15 +6822,15 @@ func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOperatorToAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOperatorToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3718,13 +6858,13 @@ func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.ServiceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -3734,30 +6874,25 @@ func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.OperatorID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -3779,7 +6914,7 @@ func (m *MsgAddOperatorToAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddOperatorToAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3802,10 +6937,10 @@ func (m *MsgAddOperatorToAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOperatorToAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOperatorToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3829,7 +6964,7 @@ func (m *MsgAddOperatorToAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3852,15 +6987,15 @@ func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegatePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3888,13 +7023,13 @@ func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ServiceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -3904,30 +7039,25 @@ func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.OperatorID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -3949,7 +7079,7 @@ func (m *MsgRemoveOperatorFromAllowlist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOperatorFromAllowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3972,12 +7102,97 @@ func (m *MsgRemoveOperatorFromAllowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOperatorFromAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -3999,7 +7214,7 @@ func (m *MsgRemoveOperatorFromAllowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,15 +7237,15 @@ func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowPoolSecurity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowPoolSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4058,7 +7273,7 @@ func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -4080,10 +7295,10 @@ func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.PoolID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -4093,11 +7308,26 @@ func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4119,7 +7349,7 @@ func (m *MsgBorrowPoolSecurity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowPoolSecurityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4142,12 +7372,45 @@ func (m *MsgBorrowPoolSecurityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowPoolSecurityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowPoolSecurityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4169,7 +7432,7 @@ func (m *MsgBorrowPoolSecurityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4192,15 +7455,15 @@ func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUserPreferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4228,13 +7491,13 @@ func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
 			}
-			m.ServiceID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -4244,30 +7507,25 @@ func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.PoolID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.Preferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4289,7 +7547,7 @@ func (m *MsgCeasePoolSecurityBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCeasePoolSecurityBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUserPreferencesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4312,10 +7570,10 @@ func (m *MsgCeasePoolSecurityBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUserPreferencesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCeasePoolSecurityBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUserPreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4339,7 +7597,7 @@ func (m *MsgCeasePoolSecurityBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4362,10 +7620,10 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegatePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4433,6 +7691,44 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstType", wireType)
+			}
+			m.DstType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTargetID", wireType)
+			}
+			m.DstTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4454,7 +7750,7 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4464,25 +7760,129 @@ func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowMessages
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcOperatorID", wireType)
+			}
+			m.SrcOperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcOperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorID", wireType)
+			}
+			m.DstOperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstOperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegatePoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4504,7 +7904,7 @@ func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4527,10 +7927,10 @@ func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4567,9 +7967,9 @@ func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcServiceID", wireType)
 			}
-			m.OperatorID = 0
+			m.SrcServiceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -4579,12 +7979,31 @@ func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
+				m.SrcServiceID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstServiceID", wireType)
+			}
+			m.DstServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -4639,7 +8058,7 @@ func (m *MsgDelegateOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4662,12 +8081,45 @@ func (m *MsgDelegateOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4689,7 +8141,7 @@ func (m *MsgDelegateOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4712,10 +8164,10 @@ func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4752,9 +8204,9 @@ func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			m.ServiceID = 0
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -4764,12 +8216,31 @@ func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				m.DelegationType |= DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			}
+			m.TargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -4803,6 +8274,25 @@ func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4824,7 +8314,7 @@ func (m *MsgDelegateService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4847,10 +8337,10 @@ func (m *MsgDelegateServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4874,7 +8364,7 @@ func (m *MsgDelegateServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4897,15 +8387,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4933,13 +8423,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
 			}
-			var msglen int
+			m.OperatorID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -4949,25 +8439,30 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OperatorID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -4989,7 +8484,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5012,10 +8507,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5039,7 +8534,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitOperatorEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5062,15 +8557,15 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitOperatorEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitOperatorEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +8593,30 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5129,7 +8643,10 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Evidence == nil {
+				m.Evidence = &types1.Any{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5154,91 +8671,40 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitOperatorEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessages
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateOperator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateOperator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessages
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
-			}
-			m.OperatorID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOperatorEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOperatorEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5248,24 +8714,24 @@ func (m *MsgUndelegateOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5289,7 +8755,7 @@ func (m *MsgUndelegateOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5312,15 +8778,15 @@ func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,32 +8814,13 @@ func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
-			}
-			m.ServiceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashID", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
+			m.PendingSlashID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5383,26 +8830,11 @@ func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PendingSlashID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -5424,7 +8856,7 @@ func (m *MsgUndelegateService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoSlashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5447,45 +8879,12 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoSlashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -5507,7 +8906,7 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorServiceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5530,15 +8929,15 @@ func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserPreferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorServiceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorServiceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5566,13 +8965,70 @@ func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5582,23 +9038,58 @@ func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Preferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5622,7 +9113,7 @@ func (m *MsgSetUserPreferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUserPreferencesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorServiceKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5645,12 +9136,45 @@ func (m *MsgSetUserPreferencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUserPreferencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorServiceKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUserPreferencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorServiceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -269,7 +270,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid params return error",
 			msg: types.NewMsgUpdateParams(
-				types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries),
+				types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 				msgUpdateParams.Authority,
 			),
 			shouldErr: true,
@@ -302,7 +303,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateParams_GetSignBytes(t *testing.T) {
-	expected := `{"type":"milkyway/restaking/MsgUpdateParams","value":{"authority":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","params":{"max_entries":7,"restaking_cap":"0.000000000000000000","unbonding_time":"259200000000000"}}}`
+	expected := `{"type":"milkyway/restaking/MsgUpdateParams","value":{"authority":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","params":{"accredited_slash_veto_window":"86400000000000","active_operators_refresh_interval":"100","downtime_jail_duration":"600000000000","max_entries":7,"max_evidence_age":"8640","max_service_unbonding_time":"2419200000000000","operator_service_key_rotation_delay":"86400000000000","restaking_cap":"0.000000000000000000","slash_veto_window":"172800000000000","unbonding_time":"259200000000000"}}}`
 	require.Equal(t, expected, string(msgUpdateParams.GetSignBytes()))
 }
 
@@ -572,3 +573,629 @@ func TestMsgSetUserPreferences_GetSigners(t *testing.T) {
 	addr, _ := sdk.AccAddressFromBech32(msgSetUserPreferences.User)
 	require.Equal(t, []sdk.AccAddress{addr}, msgSetUserPreferences.GetSigners())
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRedelegatePool = types.NewMsgRedelegatePool(
+	sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000)),
+	types.DELEGATION_TYPE_OPERATOR,
+	1,
+	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+)
+
+func TestMsgRedelegatePool_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRedelegatePool
+		shouldErr bool
+	}{
+		{
+			name: "invalid amount returns error",
+			msg: types.NewMsgRedelegatePool(
+				sdk.Coin{Denom: "umilk", Amount: sdkmath.ZeroInt()},
+				msgRedelegatePool.DstType,
+				msgRedelegatePool.DstTargetID,
+				msgRedelegatePool.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "pool destination type returns error",
+			msg: types.NewMsgRedelegatePool(
+				msgRedelegatePool.Amount,
+				types.DELEGATION_TYPE_POOL,
+				msgRedelegatePool.DstTargetID,
+				msgRedelegatePool.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid destination target id returns error",
+			msg: types.NewMsgRedelegatePool(
+				msgRedelegatePool.Amount,
+				msgRedelegatePool.DstType,
+				0,
+				msgRedelegatePool.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgRedelegatePool(
+				msgRedelegatePool.Amount,
+				msgRedelegatePool.DstType,
+				msgRedelegatePool.DstTargetID,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRedelegatePool,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRedelegatePool_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgRedelegatePool","value":{"amount":{"amount":"100000000","denom":"umilk"},"delegator":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","dst_target_id":1,"dst_type":2}}`
+	require.Equal(t, expected, string(msgRedelegatePool.GetSignBytes()))
+}
+
+func TestMsgRedelegatePool_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRedelegatePool.Delegator)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRedelegatePool.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRedelegateOperator = types.NewMsgRedelegateOperator(
+	1,
+	2,
+	sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+)
+
+func TestMsgRedelegateOperator_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRedelegateOperator
+		shouldErr bool
+	}{
+		{
+			name: "invalid source operator id returns error",
+			msg: types.NewMsgRedelegateOperator(
+				0,
+				msgRedelegateOperator.DstOperatorID,
+				msgRedelegateOperator.Amount,
+				msgRedelegateOperator.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid destination operator id returns error",
+			msg: types.NewMsgRedelegateOperator(
+				msgRedelegateOperator.SrcOperatorID,
+				0,
+				msgRedelegateOperator.Amount,
+				msgRedelegateOperator.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "same source and destination operator returns error",
+			msg: types.NewMsgRedelegateOperator(
+				msgRedelegateOperator.SrcOperatorID,
+				msgRedelegateOperator.SrcOperatorID,
+				msgRedelegateOperator.Amount,
+				msgRedelegateOperator.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid amount returns error",
+			msg: types.NewMsgRedelegateOperator(
+				msgRedelegateOperator.SrcOperatorID,
+				msgRedelegateOperator.DstOperatorID,
+				sdk.Coins{sdk.Coin{Denom: "umilk", Amount: sdkmath.ZeroInt()}},
+				msgRedelegateOperator.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgRedelegateOperator(
+				msgRedelegateOperator.SrcOperatorID,
+				msgRedelegateOperator.DstOperatorID,
+				msgRedelegateOperator.Amount,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRedelegateOperator,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRedelegateOperator_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgRedelegateOperator","value":{"amount":[{"amount":"100000000","denom":"umilk"}],"delegator":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","dst_operator_id":2,"src_operator_id":1}}`
+	require.Equal(t, expected, string(msgRedelegateOperator.GetSignBytes()))
+}
+
+func TestMsgRedelegateOperator_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRedelegateOperator.Delegator)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRedelegateOperator.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgRedelegateService = types.NewMsgRedelegateService(
+	1,
+	2,
+	sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+)
+
+func TestMsgRedelegateService_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgRedelegateService
+		shouldErr bool
+	}{
+		{
+			name: "invalid source service id returns error",
+			msg: types.NewMsgRedelegateService(
+				0,
+				msgRedelegateService.DstServiceID,
+				msgRedelegateService.Amount,
+				msgRedelegateService.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid destination service id returns error",
+			msg: types.NewMsgRedelegateService(
+				msgRedelegateService.SrcServiceID,
+				0,
+				msgRedelegateService.Amount,
+				msgRedelegateService.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "same source and destination service returns error",
+			msg: types.NewMsgRedelegateService(
+				msgRedelegateService.SrcServiceID,
+				msgRedelegateService.SrcServiceID,
+				msgRedelegateService.Amount,
+				msgRedelegateService.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid amount returns error",
+			msg: types.NewMsgRedelegateService(
+				msgRedelegateService.SrcServiceID,
+				msgRedelegateService.DstServiceID,
+				sdk.Coins{sdk.Coin{Denom: "umilk", Amount: sdkmath.ZeroInt()}},
+				msgRedelegateService.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgRedelegateService(
+				msgRedelegateService.SrcServiceID,
+				msgRedelegateService.DstServiceID,
+				msgRedelegateService.Amount,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgRedelegateService,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRedelegateService_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgRedelegateService","value":{"amount":[{"amount":"100000000","denom":"umilk"}],"delegator":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","dst_service_id":2,"src_service_id":1}}`
+	require.Equal(t, expected, string(msgRedelegateService.GetSignBytes()))
+}
+
+func TestMsgRedelegateService_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgRedelegateService.Delegator)
+	require.Equal(t, []sdk.AccAddress{addr}, msgRedelegateService.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgCancelUnbondingDelegation = types.NewMsgCancelUnbondingDelegation(
+	types.DELEGATION_TYPE_OPERATOR,
+	1,
+	sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+	10,
+	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+)
+
+func TestMsgCancelUnbondingDelegation_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCancelUnbondingDelegation
+		shouldErr bool
+	}{
+		{
+			name: "invalid delegation type returns error",
+			msg: types.NewMsgCancelUnbondingDelegation(
+				types.DELEGATION_TYPE_UNSPECIFIED,
+				msgCancelUnbondingDelegation.TargetID,
+				msgCancelUnbondingDelegation.Amount,
+				msgCancelUnbondingDelegation.CreationHeight,
+				msgCancelUnbondingDelegation.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid target id returns error",
+			msg: types.NewMsgCancelUnbondingDelegation(
+				msgCancelUnbondingDelegation.DelegationType,
+				0,
+				msgCancelUnbondingDelegation.Amount,
+				msgCancelUnbondingDelegation.CreationHeight,
+				msgCancelUnbondingDelegation.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid amount returns error",
+			msg: types.NewMsgCancelUnbondingDelegation(
+				msgCancelUnbondingDelegation.DelegationType,
+				msgCancelUnbondingDelegation.TargetID,
+				sdk.Coins{sdk.Coin{Denom: "umilk", Amount: sdkmath.ZeroInt()}},
+				msgCancelUnbondingDelegation.CreationHeight,
+				msgCancelUnbondingDelegation.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid creation height returns error",
+			msg: types.NewMsgCancelUnbondingDelegation(
+				msgCancelUnbondingDelegation.DelegationType,
+				msgCancelUnbondingDelegation.TargetID,
+				msgCancelUnbondingDelegation.Amount,
+				0,
+				msgCancelUnbondingDelegation.Delegator,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgCancelUnbondingDelegation(
+				msgCancelUnbondingDelegation.DelegationType,
+				msgCancelUnbondingDelegation.TargetID,
+				msgCancelUnbondingDelegation.Amount,
+				msgCancelUnbondingDelegation.CreationHeight,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgCancelUnbondingDelegation,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelUnbondingDelegation_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgCancelUnbondingDelegation","value":{"amount":[{"amount":"100000000","denom":"umilk"}],"creation_height":"10","delegation_type":2,"delegator":"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd","target_id":1}}`
+	require.Equal(t, expected, string(msgCancelUnbondingDelegation.GetSignBytes()))
+}
+
+func TestMsgCancelUnbondingDelegation_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgCancelUnbondingDelegation.Delegator)
+	require.Equal(t, []sdk.AccAddress{addr}, msgCancelUnbondingDelegation.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgUnjailOperator = types.NewMsgUnjailOperator(1, 1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+
+func TestMsgUnjailOperator_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUnjailOperator
+		shouldErr bool
+	}{
+		{
+			name: "invalid operator id returns error",
+			msg: types.NewMsgUnjailOperator(
+				0,
+				msgUnjailOperator.ServiceID,
+				msgUnjailOperator.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid service id returns error",
+			msg: types.NewMsgUnjailOperator(
+				msgUnjailOperator.OperatorID,
+				0,
+				msgUnjailOperator.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid sender returns error",
+			msg: types.NewMsgUnjailOperator(
+				msgUnjailOperator.OperatorID,
+				msgUnjailOperator.ServiceID,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgUnjailOperator,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestMsgSubmitOperatorEvidence_ValidateBasic(t *testing.T) {
+	newMsg := func(serviceID uint32, evidence types.OperatorEvidence, submitter string) *types.MsgSubmitOperatorEvidence {
+		msg, err := types.NewMsgSubmitOperatorEvidence(serviceID, evidence, submitter)
+		require.NoError(t, err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSubmitOperatorEvidence
+		shouldErr bool
+	}{
+		{
+			name:      "invalid service id returns error",
+			msg:       newMsg(0, types.NewContractEvidence(1, 10, []byte("proof")), "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+			shouldErr: true,
+		},
+		{
+			name:      "missing evidence returns error",
+			msg:       &types.MsgSubmitOperatorEvidence{ServiceID: 1, Submitter: "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid evidence returns error",
+			msg:       newMsg(1, types.NewContractEvidence(0, 10, []byte("proof")), "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid submitter returns error",
+			msg:       newMsg(1, types.NewContractEvidence(1, 10, []byte("proof")), "invalid"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  newMsg(1, types.NewContractEvidence(1, 10, []byte("proof")), "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestMsgVetoSlash_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgVetoSlash
+		shouldErr bool
+	}{
+		{
+			name:      "invalid pending slash id returns error",
+			msg:       types.NewMsgVetoSlash(0, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid signer returns error",
+			msg:       types.NewMsgVetoSlash(1, "invalid"),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  types.NewMsgVetoSlash(1, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgSetOperatorServiceKey = types.NewMsgSetOperatorServiceKey(
+	1,
+	1,
+	types.KEY_TYPE_ED25519,
+	bytes.Repeat([]byte{1}, 32),
+	bytes.Repeat([]byte{2}, 64),
+	"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+)
+
+func TestMsgSetOperatorServiceKey_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetOperatorServiceKey
+		shouldErr bool
+	}{
+		{
+			name: "invalid operator id returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				0,
+				msgSetOperatorServiceKey.ServiceID,
+				msgSetOperatorServiceKey.KeyType,
+				msgSetOperatorServiceKey.PublicKey,
+				msgSetOperatorServiceKey.ProofOfPossession,
+				msgSetOperatorServiceKey.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid service id returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				msgSetOperatorServiceKey.OperatorID,
+				0,
+				msgSetOperatorServiceKey.KeyType,
+				msgSetOperatorServiceKey.PublicKey,
+				msgSetOperatorServiceKey.ProofOfPossession,
+				msgSetOperatorServiceKey.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "unspecified key type returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				msgSetOperatorServiceKey.OperatorID,
+				msgSetOperatorServiceKey.ServiceID,
+				types.KEY_TYPE_UNSPECIFIED,
+				msgSetOperatorServiceKey.PublicKey,
+				msgSetOperatorServiceKey.ProofOfPossession,
+				msgSetOperatorServiceKey.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid public key returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				msgSetOperatorServiceKey.OperatorID,
+				msgSetOperatorServiceKey.ServiceID,
+				types.KEY_TYPE_SECP256K1,
+				msgSetOperatorServiceKey.PublicKey,
+				msgSetOperatorServiceKey.ProofOfPossession,
+				msgSetOperatorServiceKey.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty proof of possession returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				msgSetOperatorServiceKey.OperatorID,
+				msgSetOperatorServiceKey.ServiceID,
+				msgSetOperatorServiceKey.KeyType,
+				msgSetOperatorServiceKey.PublicKey,
+				nil,
+				msgSetOperatorServiceKey.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid sender returns error",
+			msg: types.NewMsgSetOperatorServiceKey(
+				msgSetOperatorServiceKey.OperatorID,
+				msgSetOperatorServiceKey.ServiceID,
+				msgSetOperatorServiceKey.KeyType,
+				msgSetOperatorServiceKey.PublicKey,
+				msgSetOperatorServiceKey.ProofOfPossession,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid message returns no error",
+			msg:  msgSetOperatorServiceKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package types
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	stdmath "math"
 	"slices"
 	"sort"
 	"time"
 
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -303,6 +308,150 @@ func MustUnmarshalUnbondingDelegation(cdc codec.BinaryCodec, bz []byte) Unbondin
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewRedelegationEntry creates a new RedelegationEntry instance
+func NewRedelegationEntry(
+	creationHeight int64, completionTime time.Time, balance sdk.Coins, sharesDst sdk.DecCoins, unbondingID uint64,
+) RedelegationEntry {
+	return RedelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		SharesDst:      sharesDst,
+		UnbondingID:    unbondingID,
+	}
+}
+
+// IsMature tells whether is the current entry mature
+func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// Validate validates the redelegation entry
+func (e RedelegationEntry) Validate() error {
+	if e.UnbondingID == 0 {
+		return fmt.Errorf("invalid unbonding id")
+	}
+
+	if e.CreationHeight == 0 {
+		return fmt.Errorf("invalid creation height")
+	}
+
+	if e.CompletionTime.IsZero() {
+		return fmt.Errorf("invalid completion time")
+	}
+
+	if !e.InitialBalance.IsValid() {
+		return fmt.Errorf("invalid initial balance")
+	}
+
+	if !e.SharesDst.IsValid() {
+		return fmt.Errorf("invalid destination shares")
+	}
+
+	return nil
+}
+
+// NewRedelegation creates a new Redelegation instance
+func NewRedelegation(
+	delegatorAddress string, srcType DelegationType, srcTargetID uint32, dstType DelegationType, dstTargetID uint32,
+	creationHeight int64, completionTime time.Time, balance sdk.Coins, sharesDst sdk.DecCoins, id uint64,
+) Redelegation {
+	return Redelegation{
+		DelegatorAddress: delegatorAddress,
+		SrcType:          srcType,
+		SrcTargetID:      srcTargetID,
+		DstType:          dstType,
+		DstTargetID:      dstTargetID,
+		Entries: []RedelegationEntry{
+			NewRedelegationEntry(creationHeight, completionTime, balance, sharesDst, id),
+		},
+	}
+}
+
+// AddEntry allows to append a new entry to the redelegation
+func (red *Redelegation) AddEntry(
+	creationHeight int64, completionTime time.Time, balance sdk.Coins, sharesDst sdk.DecCoins, unbondingID uint64,
+) {
+	entry := NewRedelegationEntry(creationHeight, completionTime, balance, sharesDst, unbondingID)
+	red.Entries = append(red.Entries, entry)
+}
+
+// RemoveEntry removes the entry at index i from the redelegation
+func (red *Redelegation) RemoveEntry(i int64) {
+	red.Entries = append(red.Entries[:i], red.Entries[i+1:]...)
+}
+
+// Validate validates the redelegation
+func (red Redelegation) Validate() error {
+	if red.SrcType == DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid source type")
+	}
+
+	if red.SrcTargetID == 0 {
+		return fmt.Errorf("invalid source target id")
+	}
+
+	if red.DstType == DELEGATION_TYPE_UNSPECIFIED || red.DstType == DELEGATION_TYPE_POOL {
+		return fmt.Errorf("invalid destination type")
+	}
+
+	if red.DstTargetID == 0 {
+		return fmt.Errorf("invalid destination target id")
+	}
+
+	if red.SrcType == red.DstType && red.SrcTargetID == red.DstTargetID {
+		return fmt.Errorf("source and destination targets must be different")
+	}
+
+	_, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address")
+	}
+
+	if len(red.Entries) == 0 {
+		return fmt.Errorf("empty entries")
+	}
+
+	for _, entry := range red.Entries {
+		err = entry.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MustMarshalRedelegation marshals the redelegation using the provided codec
+func MustMarshalRedelegation(cdc codec.BinaryCodec, redelegation Redelegation) []byte {
+	bz, err := cdc.Marshal(&redelegation)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// UnmarshalRedelegation unmarshals the redelegation from the given bytes using the provided codec
+func UnmarshalRedelegation(cdc codec.BinaryCodec, bz []byte) (Redelegation, error) {
+	var redelegation Redelegation
+	err := cdc.Unmarshal(bz, &redelegation)
+	if err != nil {
+		return Redelegation{}, err
+	}
+	return redelegation, nil
+}
+
+// MustUnmarshalRedelegation unmarshals the redelegation from the given bytes using the provided codec
+func MustUnmarshalRedelegation(cdc codec.BinaryCodec, bz []byte) Redelegation {
+	redelegation, err := UnmarshalRedelegation(cdc, bz)
+	if err != nil {
+		panic(err)
+	}
+	return redelegation
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // NewUserPreferences creates a new UserPreferences instance
 func NewUserPreferences(trustedServices []TrustedServiceEntry) UserPreferences {
 	return UserPreferences{
@@ -424,3 +573,227 @@ func (e TrustedServiceEntry) Validate() error {
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewValidatingOperator creates a new ValidatingOperator instance, computing
+// its voting power from the given USD value
+func NewValidatingOperator(operatorID uint32, tokens sdk.Coins, usdValue math.LegacyDec) ValidatingOperator {
+	return ValidatingOperator{
+		OperatorID: operatorID,
+		Tokens:     tokens,
+		USDValue:   usdValue,
+		Power:      PowerFromUSDValue(usdValue),
+	}
+}
+
+// PowerFromUSDValue returns the voting power associated with the given USD value.
+// Each USD is worth one unit of power, and the result is capped to the maximum
+// int64 value
+func PowerFromUSDValue(usdValue math.LegacyDec) int64 {
+	power := usdValue.TruncateInt()
+	if !power.IsInt64() {
+		return stdmath.MaxInt64
+	}
+	return power.Int64()
+}
+
+// Validate validates the validating operator
+func (o ValidatingOperator) Validate() error {
+	if o.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id")
+	}
+
+	if !o.Tokens.IsValid() {
+		return fmt.Errorf("invalid tokens: %s", o.Tokens)
+	}
+
+	if o.USDValue.IsNil() || o.USDValue.IsNegative() {
+		return fmt.Errorf("invalid usd value: %s", o.USDValue)
+	}
+
+	if o.Power < 0 {
+		return fmt.Errorf("invalid power: %d", o.Power)
+	}
+
+	return nil
+}
+
+// MerkleLeaf returns the bytes representing the operator inside the Merkle tree
+// of a service snapshot. They are the big-endian encoding of the operator ID
+// followed by the big-endian encoding of its voting power
+func (o ValidatingOperator) MerkleLeaf() []byte {
+	leaf := make([]byte, 12)
+	binary.BigEndian.PutUint32(leaf[:4], o.OperatorID)
+	binary.BigEndian.PutUint64(leaf[4:], uint64(o.Power))
+	return leaf
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewServiceSnapshot creates a new ServiceSnapshot instance, computing the total
+// power and the Merkle root of the given operators
+func NewServiceSnapshot(
+	serviceID uint32,
+	epoch uint64,
+	height int64,
+	time time.Time,
+	operators []ValidatingOperator,
+) ServiceSnapshot {
+	return ServiceSnapshot{
+		ServiceID:  serviceID,
+		Epoch:      epoch,
+		Height:     height,
+		Time:       time,
+		Operators:  operators,
+		TotalPower: ComputeTotalPower(operators),
+		MerkleRoot: ComputeValidatingSetMerkleRoot(operators),
+	}
+}
+
+// ComputeTotalPower returns the sum of the voting power of the given operators,
+// capped to the maximum int64 value
+func ComputeTotalPower(operators []ValidatingOperator) int64 {
+	var total int64
+	for _, operator := range operators {
+		if operator.Power > stdmath.MaxInt64-total {
+			return stdmath.MaxInt64
+		}
+		total += operator.Power
+	}
+	return total
+}
+
+// ComputeValidatingSetMerkleRoot returns the root of the Merkle tree built
+// from the given operators, in the given order
+func ComputeValidatingSetMerkleRoot(operators []ValidatingOperator) []byte {
+	leaves := make([][]byte, len(operators))
+	for i, operator := range operators {
+		leaves[i] = operator.MerkleLeaf()
+	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// Validate validates the service snapshot
+func (s ServiceSnapshot) Validate() error {
+	if s.ServiceID == 0 {
+		return fmt.Errorf("invalid service id")
+	}
+
+	if s.Epoch == 0 {
+		return fmt.Errorf("invalid epoch")
+	}
+
+	if s.Height < 0 {
+		return fmt.Errorf("invalid height: %d", s.Height)
+	}
+
+	operatorsIDs := make([]uint32, len(s.Operators))
+	for i, operator := range s.Operators {
+		err := operator.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid operator %d: %w", operator.OperatorID, err)
+		}
+		operatorsIDs[i] = operator.OperatorID
+	}
+
+	duplicated := utils.FindDuplicate(operatorsIDs)
+	if duplicated != nil {
+		return fmt.Errorf("duplicated operator id: %d", *duplicated)
+	}
+
+	if s.TotalPower != ComputeTotalPower(s.Operators) {
+		return fmt.Errorf("invalid total power: %d", s.TotalPower)
+	}
+
+	if !bytes.Equal(s.MerkleRoot, ComputeValidatingSetMerkleRoot(s.Operators)) {
+		return fmt.Errorf("invalid merkle root: %X", s.MerkleRoot)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewOperatorJailRecord creates a new OperatorJailRecord instance
+func NewOperatorJailRecord(serviceID uint32, operatorID uint32, jailedUntil time.Time, tombstoned bool) OperatorJailRecord {
+	return OperatorJailRecord{
+		ServiceID:   serviceID,
+		OperatorID:  operatorID,
+		JailedUntil: jailedUntil,
+		Tombstoned:  tombstoned,
+	}
+}
+
+// CanUnjail returns true if the operator can be unjailed at the given time
+func (r OperatorJailRecord) CanUnjail(blockTime time.Time) bool {
+	return !r.Tombstoned && !blockTime.Before(r.JailedUntil)
+}
+
+// Validate validates the operator jail record
+func (r OperatorJailRecord) Validate() error {
+	if r.ServiceID == 0 {
+		return fmt.Errorf("invalid service id")
+	}
+
+	if r.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// MaxPendingSlashRetries represents the number of times the execution of a
+// pending slash is retried before the slash gets dropped
+const MaxPendingSlashRetries = 3
+
+// NewPendingSlash returns a new PendingSlash instance
+func NewPendingSlash(
+	id uint64, serviceID uint32, operatorID uint32, fraction math.LegacyDec, infractionHeight int64, executionTime time.Time,
+) PendingSlash {
+	return PendingSlash{
+		ID:               id,
+		ServiceID:        serviceID,
+		OperatorID:       operatorID,
+		Fraction:         fraction,
+		InfractionHeight: infractionHeight,
+		ExecutionTime:    executionTime,
+	}
+}
+
+// Validate validates the pending slash
+func (s PendingSlash) Validate() error {
+	if s.ID == 0 {
+		return fmt.Errorf("invalid id")
+	}
+
+	if s.ServiceID == 0 {
+		return fmt.Errorf("invalid service id")
+	}
+
+	if s.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id")
+	}
+
+	// The fraction can be zero only if the operator is going to be tombstoned
+	if s.Fraction.IsNil() || s.Fraction.IsNegative() || s.Fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid fraction: %s", s.Fraction)
+	}
+
+	if s.Fraction.IsZero() && !s.Tombstone {
+		return fmt.Errorf("invalid fraction: %s", s.Fraction)
+	}
+
+	if s.InfractionHeight < 0 {
+		return fmt.Errorf("invalid infraction height: %d", s.InfractionHeight)
+	}
+
+	return nil
+}
+
+// IsMature returns true if the pending slash can be executed at the given time
+func (s PendingSlash) IsMature(blockTime time.Time) bool {
+	return !blockTime.Before(s.ExecutionTime)
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -67,6 +68,42 @@ func (DelegationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_86f4cd48423b1e2f, []int{0}
 }
 
+// KeyType defines the type of a public key registered by an operator.
+type KeyType int32
+
+const (
+	// KEY_TYPE_UNSPECIFIED defines an unspecified key type.
+	KEY_TYPE_UNSPECIFIED KeyType = 0
+	// KEY_TYPE_ED25519 defines an ed25519 public key.
+	KEY_TYPE_ED25519 KeyType = 1
+	// KEY_TYPE_SECP256K1 defines a compressed secp256k1 public key.
+	KEY_TYPE_SECP256K1 KeyType = 2
+	// KEY_TYPE_BLS12_381 defines a compressed BLS12-381 public key in G1.
+	KEY_TYPE_BLS12_381 KeyType = 3
+)
+
+var KeyType_name = map[int32]string{
+	0: "KEY_TYPE_UNSPECIFIED",
+	1: "KEY_TYPE_ED25519",
+	2: "KEY_TYPE_SECP256K1",
+	3: "KEY_TYPE_BLS12_381",
+}
+
+var KeyType_value = map[string]int32{
+	"KEY_TYPE_UNSPECIFIED": 0,
+	"KEY_TYPE_ED25519":     1,
+	"KEY_TYPE_SECP256K1":   2,
+	"KEY_TYPE_BLS12_381":   3,
+}
+
+func (x KeyType) String() string {
+	return proto.EnumName(KeyType_name, int32(x))
+}
+
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{1}
+}
+
 // Delegation represents the bond with tokens held by an account with a
 // given target.
 type Delegation struct {
@@ -387,6 +424,233 @@ func (m *DTDataList) GetData() []DTData {
 	return nil
 }
 
+// Redelegation contains the list of a particular delegator's redelegating
+// bonds from a particular source target to a particular destination target.
+type Redelegation struct {
+	// DelegatorAddress is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// SrcType is the type of the target from which the tokens have been
+	// redelegated.
+	SrcType DelegationType `protobuf:"varint,2,opt,name=src_type,json=srcType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"src_type,omitempty"`
+	// SrcTargetID is the ID of the target from which the tokens have been
+	// redelegated (pool, operator, service).
+	SrcTargetID uint32 `protobuf:"varint,3,opt,name=src_target_id,json=srcTargetId,proto3" json:"src_target_id,omitempty"`
+	// DstType is the type of the target to which the tokens have been
+	// redelegated.
+	DstType DelegationType `protobuf:"varint,4,opt,name=dst_type,json=dstType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"dst_type,omitempty"`
+	// DstTargetID is the ID of the target to which the tokens have been
+	// redelegated (operator, service).
+	DstTargetID uint32 `protobuf:"varint,5,opt,name=dst_target_id,json=dstTargetId,proto3" json:"dst_target_id,omitempty"`
+	// Entries are the redelegation entries.
+	Entries []RedelegationEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{6}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
+// RedelegationEntry defines a redelegation object with relevant metadata.
+type RedelegationEntry struct {
+	// CreationHeight is the height at which the redelegation took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// CompletionTime is the unix time for redelegation completion.
+	CompletionTime time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// InitialBalance defines the tokens that have been redelegated.
+	InitialBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=initial_balance,json=initialBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_balance"`
+	// SharesDst defines the amount of shares received from the destination
+	// target.
+	SharesDst github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=shares_dst,json=sharesDst,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"shares_dst"`
+	// Incrementing id that uniquely identifies this entry
+	UnbondingID uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+}
+
+func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
+func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*RedelegationEntry) ProtoMessage()    {}
+func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{7}
+}
+func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntry.Merge(m, src)
+}
+func (m *RedelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationEntry proto.InternalMessageInfo
+
+func (m *RedelegationEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *RedelegationEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *RedelegationEntry) GetInitialBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialBalance
+	}
+	return nil
+}
+
+func (m *RedelegationEntry) GetSharesDst() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.SharesDst
+	}
+	return nil
+}
+
+func (m *RedelegationEntry) GetUnbondingID() uint64 {
+	if m != nil {
+		return m.UnbondingID
+	}
+	return 0
+}
+
+// RTData is a struct that contains the basic information about a
+// redelegation. It is intended to be used as a marshalable pointer. For
+// example, a RTData can be used to construct the key to getting a Redelegation
+// from state.
+type RTData struct {
+	DelegatorAddress string         `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SrcType          DelegationType `protobuf:"varint,2,opt,name=src_type,json=srcType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"src_type,omitempty"`
+	SrcTargetID      uint32         `protobuf:"varint,3,opt,name=src_target_id,json=srcTargetId,proto3" json:"src_target_id,omitempty"`
+	DstType          DelegationType `protobuf:"varint,4,opt,name=dst_type,json=dstType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"dst_type,omitempty"`
+	DstTargetID      uint32         `protobuf:"varint,5,opt,name=dst_target_id,json=dstTargetId,proto3" json:"dst_target_id,omitempty"`
+}
+
+func (m *RTData) Reset()         { *m = RTData{} }
+func (m *RTData) String() string { return proto.CompactTextString(m) }
+func (*RTData) ProtoMessage()    {}
+func (*RTData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{8}
+}
+func (m *RTData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RTData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RTData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RTData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RTData.Merge(m, src)
+}
+func (m *RTData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RTData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RTData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RTData proto.InternalMessageInfo
+
+// RTDataList defines an array of RTData objects.
+type RTDataList struct {
+	Data []RTData `protobuf:"bytes,1,rep,name=data,proto3" json:"data"`
+}
+
+func (m *RTDataList) Reset()         { *m = RTDataList{} }
+func (m *RTDataList) String() string { return proto.CompactTextString(m) }
+func (*RTDataList) ProtoMessage()    {}
+func (*RTDataList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{9}
+}
+func (m *RTDataList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RTDataList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RTDataList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RTDataList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RTDataList.Merge(m, src)
+}
+func (m *RTDataList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RTDataList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RTDataList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RTDataList proto.InternalMessageInfo
+
+func (m *RTDataList) GetData() []RTData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // UserPreferences is a struct that contains a user's preferences for
 // restaking.
 type UserPreferences struct {
@@ -398,7 +662,7 @@ func (m *UserPreferences) Reset()         { *m = UserPreferences{} }
 func (m *UserPreferences) String() string { return proto.CompactTextString(m) }
 func (*UserPreferences) ProtoMessage()    {}
 func (*UserPreferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86f4cd48423b1e2f, []int{6}
+	return fileDescriptor_86f4cd48423b1e2f, []int{10}
 }
 func (m *UserPreferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +711,7 @@ func (m *TrustedServiceEntry) Reset()         { *m = TrustedServiceEntry{} }
 func (m *TrustedServiceEntry) String() string { return proto.CompactTextString(m) }
 func (*TrustedServiceEntry) ProtoMessage()    {}
 func (*TrustedServiceEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86f4cd48423b1e2f, []int{7}
+	return fileDescriptor_86f4cd48423b1e2f, []int{11}
 }
 func (m *TrustedServiceEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,697 +754,3905 @@ func (m *TrustedServiceEntry) GetPoolsIDs() []uint32 {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milkyway.restaking.v1.DelegationType", DelegationType_name, DelegationType_value)
-	proto.RegisterType((*Delegation)(nil), "milkyway.restaking.v1.Delegation")
-	proto.RegisterType((*DelegationResponse)(nil), "milkyway.restaking.v1.DelegationResponse")
-	proto.RegisterType((*UnbondingDelegation)(nil), "milkyway.restaking.v1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "milkyway.restaking.v1.UnbondingDelegationEntry")
-	proto.RegisterType((*DTData)(nil), "milkyway.restaking.v1.DTData")
-	proto.RegisterType((*DTDataList)(nil), "milkyway.restaking.v1.DTDataList")
-	proto.RegisterType((*UserPreferences)(nil), "milkyway.restaking.v1.UserPreferences")
-	proto.RegisterType((*TrustedServiceEntry)(nil), "milkyway.restaking.v1.TrustedServiceEntry")
+// ValidatingOperator represents an operator that is part of a service's
+// validating set, along with the stake it secures the service with and its
+// resulting voting power.
+type ValidatingOperator struct {
+	// OperatorID is the ID of the operator
+	OperatorID uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Tokens are the tokens securing the service through the operator. They
+	// include the tokens delegated to the operator and the share of the tokens
+	// borrowed from the pools that the delegators allow the service to use
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// USDValue is the USD value of the tokens
+	USDValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=usd_value,json=usdValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"usd_value"`
+	// Power is the voting power of the operator, equal to its USD value
+	// truncated to an integer
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("milkyway/restaking/v1/models.proto", fileDescriptor_86f4cd48423b1e2f)
+func (m *ValidatingOperator) Reset()         { *m = ValidatingOperator{} }
+func (m *ValidatingOperator) String() string { return proto.CompactTextString(m) }
+func (*ValidatingOperator) ProtoMessage()    {}
+func (*ValidatingOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{12}
 }
-
-var fileDescriptor_86f4cd48423b1e2f = []byte{
-	// 935 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x24, 0xa1, 0x9b, 0x4c, 0xda, 0x24, 0x3b, 0x5b, 0x54, 0xb7, 0xbb, 0xc4, 0x21, 0x12,
-	0x22, 0x14, 0x6a, 0x93, 0xec, 0x01, 0xb1, 0x70, 0x69, 0x6a, 0x03, 0x5e, 0x55, 0x9b, 0xe0, 0xba,
-	0x48, 0x70, 0xb1, 0x9c, 0x78, 0xd6, 0x1d, 0xd5, 0xf1, 0x44, 0x9e, 0x49, 0x20, 0xbf, 0x80, 0x3d,
-	0x70, 0xd8, 0x9f, 0xb0, 0x02, 0x0e, 0x88, 0x03, 0xe2, 0xc0, 0x8f, 0xd8, 0x1b, 0x2b, 0x4e, 0x9c,
-	0xb2, 0x28, 0x3d, 0x2c, 0x3f, 0x03, 0x79, 0x6c, 0x27, 0xd9, 0xd2, 0x8a, 0x05, 0x15, 0xed, 0xa5,
-	0xed, 0xbc, 0xf7, 0xbd, 0x37, 0xef, 0xfb, 0xe6, 0x7b, 0x56, 0x61, 0x63, 0x48, 0xfc, 0xd3, 0xe9,
-	0x97, 0xce, 0x54, 0x0d, 0x31, 0xe3, 0xce, 0x29, 0x09, 0x3c, 0x75, 0xd2, 0x52, 0x87, 0xd4, 0xc5,
-	0x3e, 0x53, 0x46, 0x21, 0xe5, 0x14, 0xbd, 0x9a, 0x62, 0x94, 0x05, 0x46, 0x99, 0xb4, 0x76, 0xae,
-	0x3b, 0x43, 0x12, 0x50, 0x55, 0xfc, 0x8c, 0x91, 0x3b, 0xb5, 0x01, 0x65, 0x43, 0xca, 0xd4, 0xbe,
-	0xc3, 0xb0, 0x3a, 0x69, 0xf5, 0x31, 0x77, 0x5a, 0xea, 0x80, 0x92, 0x20, 0xc9, 0x6f, 0xc7, 0x79,
-	0x5b, 0x9c, 0xd4, 0xf8, 0x90, 0xa4, 0x36, 0x3d, 0xea, 0xd1, 0x38, 0x1e, 0xfd, 0x95, 0x44, 0x65,
-	0x8f, 0x52, 0xcf, 0xc7, 0xaa, 0x38, 0xf5, 0xc7, 0xf7, 0x55, 0x4e, 0x86, 0xd1, 0x0c, 0xc3, 0x51,
-	0x0c, 0x68, 0x7c, 0x9f, 0x85, 0x50, 0xc3, 0x3e, 0xf6, 0x1c, 0x4e, 0x68, 0x80, 0xde, 0x87, 0x79,
-	0x3e, 0x1d, 0x61, 0x09, 0xd4, 0x41, 0xb3, 0xdc, 0x7e, 0x43, 0xb9, 0x70, 0x72, 0x65, 0x59, 0x60,
-	0x4d, 0x47, 0xd8, 0x14, 0x25, 0xe8, 0x03, 0xb8, 0x3e, 0x66, 0x38, 0xb4, 0x1d, 0xd7, 0x0d, 0x31,
-	0x63, 0x52, 0xb6, 0x0e, 0x9a, 0xc5, 0x8e, 0xf4, 0xdb, 0x2f, 0x7b, 0x9b, 0xc9, 0xa0, 0xfb, 0x71,
-	0xe6, 0x88, 0x87, 0x24, 0xf0, 0xcc, 0x52, 0x84, 0x4e, 0x42, 0xe8, 0x2d, 0x58, 0xe4, 0x4e, 0xe8,
-	0x61, 0x6e, 0x13, 0x57, 0xca, 0xd5, 0x41, 0x73, 0xa3, 0xb3, 0x3e, 0x9f, 0xc9, 0x05, 0x4b, 0x04,
-	0x0d, 0xcd, 0x2c, 0xc4, 0x69, 0xc3, 0x45, 0x04, 0xae, 0xb1, 0x13, 0x27, 0xc4, 0x4c, 0xca, 0xd7,
-	0x73, 0xcd, 0x52, 0xfb, 0x96, 0x92, 0xb4, 0x8f, 0x44, 0x53, 0x12, 0xd1, 0x14, 0x0d, 0x0f, 0x0e,
-	0x28, 0x09, 0x3a, 0xb7, 0x1f, 0xcf, 0xe4, 0xcc, 0x8f, 0x4f, 0xe5, 0xb7, 0x3d, 0xc2, 0x4f, 0xc6,
-	0x7d, 0x65, 0x40, 0x87, 0x89, 0x6e, 0xc9, 0xaf, 0x3d, 0xe6, 0x9e, 0xaa, 0x11, 0x01, 0x96, 0xd6,
-	0x30, 0x33, 0xb9, 0xe0, 0x4e, 0xe1, 0xc1, 0x23, 0x39, 0xf3, 0xe7, 0x23, 0x39, 0xd3, 0xf8, 0x15,
-	0x40, 0xb4, 0x64, 0x6d, 0x62, 0x36, 0xa2, 0x01, 0xc3, 0xe8, 0x10, 0x42, 0x77, 0x11, 0x15, 0xa2,
-	0x95, 0xda, 0xaf, 0xff, 0xa3, 0x68, 0x9d, 0x62, 0x34, 0xd4, 0x0f, 0xcf, 0x7e, 0xde, 0x05, 0xe6,
-	0x4a, 0x3d, 0xc2, 0xf0, 0x5a, 0xdf, 0xf1, 0x9d, 0x60, 0x80, 0xa5, 0xac, 0xa0, 0xb6, 0x7d, 0x21,
-	0x35, 0xc1, 0xeb, 0xdd, 0x84, 0x57, 0xf3, 0x05, 0x78, 0xc5, 0xa4, 0xd2, 0xde, 0x77, 0xf2, 0x82,
-	0xd1, 0xb7, 0x59, 0x78, 0xe3, 0x38, 0xe8, 0xd3, 0xc0, 0x25, 0x81, 0x77, 0x35, 0x0e, 0xd0, 0xe1,
-	0xf5, 0x84, 0x0d, 0x7d, 0x71, 0x1b, 0x54, 0x17, 0x25, 0xff, 0xc1, 0x0b, 0x16, 0xbc, 0x86, 0x03,
-	0x1e, 0x92, 0x85, 0x19, 0xd4, 0x4b, 0xe6, 0xbd, 0x80, 0xa9, 0x1e, 0xf0, 0x70, 0xba, 0xfa, 0x14,
-	0x69, 0xab, 0x95, 0x67, 0xff, 0x29, 0x07, 0xa5, 0xcb, 0x4a, 0xd1, 0x9b, 0xb0, 0x32, 0x08, 0xb1,
-	0x08, 0xd8, 0x27, 0x98, 0x78, 0x27, 0x5c, 0x88, 0x96, 0x33, 0xcb, 0x69, 0xf8, 0x13, 0x11, 0x45,
-	0x26, 0xac, 0x0c, 0xe8, 0x70, 0xe4, 0x63, 0x01, 0x8d, 0x36, 0x50, 0xa8, 0x52, 0x6a, 0xef, 0x28,
-	0xf1, 0x7a, 0x2a, 0xe9, 0x7a, 0x2a, 0x56, 0xba, 0x9e, 0x9d, 0x8d, 0x68, 0xb0, 0x87, 0x4f, 0x65,
-	0x10, 0x0f, 0x57, 0x5e, 0x76, 0x88, 0x30, 0x88, 0xc3, 0x0a, 0x09, 0x08, 0x27, 0x8e, 0x6f, 0xa7,
-	0x9e, 0xc9, 0x5d, 0xbd, 0x67, 0xca, 0xc9, 0x1d, 0x9d, 0xf8, 0x8a, 0x55, 0x87, 0xe6, 0xff, 0x3f,
-	0x87, 0xa2, 0x36, 0x5c, 0x1f, 0xa7, 0xaa, 0x47, 0x26, 0x78, 0xa5, 0x0e, 0x9a, 0xf9, 0x4e, 0x65,
-	0x3e, 0x93, 0x4b, 0x8b, 0xd7, 0x30, 0x34, 0xb3, 0xb4, 0x00, 0x19, 0xae, 0x70, 0x35, 0x68, 0x3c,
-	0x03, 0x70, 0x4d, 0xb3, 0x34, 0x87, 0x3b, 0xc8, 0x81, 0xdb, 0xcb, 0x26, 0xcb, 0x2d, 0xb3, 0xff,
-	0xbd, 0xbb, 0xb7, 0xc6, 0x7f, 0xb7, 0x80, 0xf5, 0x52, 0x0c, 0xbf, 0x62, 0xcd, 0xbb, 0x10, 0xc6,
-	0x44, 0x0f, 0x09, 0xe3, 0xe8, 0x43, 0x98, 0x77, 0x1d, 0xee, 0x48, 0x40, 0xbc, 0xca, 0x6b, 0x97,
-	0xf1, 0x12, 0x05, 0xab, 0x9e, 0x17, 0x55, 0x8d, 0xaf, 0x01, 0xac, 0x1c, 0x33, 0x1c, 0xf6, 0x42,
-	0x7c, 0x1f, 0x87, 0x38, 0x18, 0x60, 0x86, 0x02, 0x58, 0xe5, 0xe1, 0x98, 0x71, 0xec, 0xda, 0x0c,
-	0x87, 0x13, 0x32, 0x58, 0xec, 0xd8, 0xee, 0x25, 0xdd, 0xad, 0x18, 0x7e, 0x14, 0xa3, 0xe3, 0xf5,
-	0xda, 0x8a, 0xae, 0x9a, 0xcf, 0xe4, 0xca, 0xf3, 0x49, 0x66, 0x56, 0xf8, 0xf3, 0x81, 0xbb, 0xf9,
-	0x02, 0xa8, 0xe6, 0x1b, 0x01, 0xbc, 0x71, 0x41, 0x1b, 0xf4, 0x0e, 0x84, 0xc9, 0x10, 0x91, 0x44,
-	0x40, 0x48, 0xb4, 0x31, 0x9f, 0xc9, 0xc5, 0x04, 0x65, 0x68, 0x66, 0x31, 0x01, 0x18, 0x6e, 0xa4,
-	0xe7, 0x88, 0x52, 0x9f, 0xd9, 0xc4, 0x65, 0xe2, 0x4b, 0x9a, 0xe8, 0xd9, 0x8b, 0x82, 0x86, 0xc6,
-	0xcc, 0x82, 0x48, 0x1b, 0x2e, 0xdb, 0xfd, 0x06, 0xc0, 0xf2, 0xb9, 0x47, 0x95, 0xe1, 0x4d, 0x4d,
-	0x3f, 0xd4, 0x3f, 0xde, 0xb7, 0x8c, 0xee, 0x3d, 0xdb, 0xfa, 0xbc, 0xa7, 0xdb, 0xc7, 0xf7, 0x8e,
-	0x7a, 0xfa, 0x81, 0xf1, 0x91, 0xa1, 0x6b, 0xd5, 0x0c, 0x92, 0xe0, 0xe6, 0x79, 0x40, 0xaf, 0xdb,
-	0x3d, 0xac, 0x02, 0x74, 0x0b, 0x4a, 0xe7, 0x33, 0xdd, 0x9e, 0x6e, 0xee, 0x5b, 0x5d, 0xb3, 0x9a,
-	0x45, 0x37, 0xe1, 0xd6, 0xf9, 0xec, 0x91, 0x6e, 0x7e, 0x66, 0x1c, 0xe8, 0xd5, 0xdc, 0x4e, 0xfe,
-	0xc1, 0x77, 0xb5, 0x4c, 0xe7, 0xd3, 0xc7, 0xf3, 0x1a, 0x78, 0x32, 0xaf, 0x81, 0x3f, 0xe6, 0x35,
-	0xf0, 0xf0, 0xac, 0x96, 0x79, 0x72, 0x56, 0xcb, 0xfc, 0x7e, 0x56, 0xcb, 0x7c, 0xf1, 0xde, 0xca,
-	0x16, 0xa5, 0xf2, 0xef, 0xf9, 0x4e, 0x9f, 0x2d, 0x4e, 0xea, 0xa4, 0xd5, 0x56, 0xbf, 0x5a, 0xf9,
-	0x37, 0x44, 0xac, 0x56, 0x7f, 0x4d, 0x7c, 0x5b, 0x6e, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa4,
-	0x23, 0xc9, 0x7a, 0xa9, 0x08, 0x00, 0x00,
+func (m *ValidatingOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*UnbondingDelegationEntry)
-	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ValidatingOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatingOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
-	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if len(this.InitialBalance) != len(that1.InitialBalance) {
-		return false
+}
+func (m *ValidatingOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatingOperator.Merge(m, src)
+}
+func (m *ValidatingOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatingOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatingOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatingOperator proto.InternalMessageInfo
+
+func (m *ValidatingOperator) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	for i := range this.InitialBalance {
-		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *ValidatingOperator) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tokens
 	}
-	if len(this.Balance) != len(that1.Balance) {
-		return false
+	return nil
+}
+
+func (m *ValidatingOperator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	for i := range this.Balance {
-		if !this.Balance[i].Equal(&that1.Balance[i]) {
-			return false
+	return 0
+}
+
+// ServiceSnapshot represents a snapshot of a service's validating set taken at
+// a given epoch.
+type ServiceSnapshot struct {
+	// ServiceID is the ID of the service
+	ServiceID uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Epoch is the sequential number of the snapshot, starting from 1
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Height is the block height at which the snapshot has been taken
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time at which the snapshot has been taken
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// Operators is the validating set of the service at the time of the
+	// snapshot, sorted by voting power in descending order
+	Operators []ValidatingOperator `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators"`
+	// TotalPower is the sum of the voting power of all the operators
+	TotalPower int64 `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// MerkleRoot is the root of the Merkle tree built from the operators. Each
+	// leaf is the big-endian encoding of the operator ID (4 bytes) followed by
+	// the big-endian encoding of its voting power (8 bytes)
+	MerkleRoot []byte `protobuf:"bytes,7,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+}
+
+func (m *ServiceSnapshot) Reset()         { *m = ServiceSnapshot{} }
+func (m *ServiceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ServiceSnapshot) ProtoMessage()    {}
+func (*ServiceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{13}
+}
+func (m *ServiceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.UnbondingID != that1.UnbondingID {
-		return false
-	}
-	return true
 }
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ServiceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSnapshot.Merge(m, src)
+}
+func (m *ServiceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSnapshot.DiscardUnknown(m)
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ServiceSnapshot proto.InternalMessageInfo
+
+func (m *ServiceSnapshot) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TargetID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.UserAddress) > 0 {
-		i -= len(m.UserAddress)
-		copy(dAtA[i:], m.UserAddress)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.UserAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+func (m *ServiceSnapshot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ServiceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ServiceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *ServiceSnapshot) GetOperators() []ValidatingOperator {
+	if m != nil {
+		return m.Operators
 	}
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintModels(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *ServiceSnapshot) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ServiceSnapshot) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OperatorJailRecord represents the record of an operator that has been jailed
+// from securing a service.
+type OperatorJailRecord struct {
+	// ServiceID is the ID of the service from which the operator has been jailed
+	ServiceID uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// OperatorID is the ID of the jailed operator
+	OperatorID uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// JailedUntil is the time until which the operator is jailed. After this
+	// time, the operator can unjail itself from the service
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// Tombstoned tells whether the operator has been permanently jailed from the
+	// service. Tombstoned operators can never be unjailed
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 }
 
-func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *OperatorJailRecord) Reset()         { *m = OperatorJailRecord{} }
+func (m *OperatorJailRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorJailRecord) ProtoMessage()    {}
+func (*OperatorJailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{14}
+}
+func (m *OperatorJailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorJailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorJailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TargetID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *OperatorJailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorJailRecord.Merge(m, src)
+}
+func (m *OperatorJailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorJailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorJailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorJailRecord proto.InternalMessageInfo
+
+func (m *OperatorJailRecord) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *OperatorJailRecord) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *OperatorJailRecord) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperatorJailRecord) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ContractEvidence represents a generic evidence of an operator misbehavior
+// whose content is verified by the CosmWasm contract set as the evidence
+// verifier of the service for which it has been submitted.
+type ContractEvidence struct {
+	// OperatorID is the ID of the operator that misbehaved
+	OperatorID uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Height is the height at which the misbehavior occurred
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Data contains the contract-specific proof of the misbehavior
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingID))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.InitialBalance) > 0 {
-		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *ContractEvidence) Reset()         { *m = ContractEvidence{} }
+func (m *ContractEvidence) String() string { return proto.CompactTextString(m) }
+func (*ContractEvidence) ProtoMessage()    {}
+func (*ContractEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{15}
+}
+func (m *ContractEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err2 != nil {
-		return 0, err2
+}
+func (m *ContractEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvidence.Merge(m, src)
+}
+func (m *ContractEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvidence proto.InternalMessageInfo
+
+func (m *ContractEvidence) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	i -= n2
-	i = encodeVarintModels(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ContractEvidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DTData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractEvidence) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DTData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SubmittedEvidence represents an evidence of an operator misbehavior that has
+// already been submitted for a service.
+type SubmittedEvidence struct {
+	// ServiceID is the ID of the service for which the evidence was submitted
+	ServiceID uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Hash is the hash of the submitted evidence
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *DTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *SubmittedEvidence) Reset()         { *m = SubmittedEvidence{} }
+func (m *SubmittedEvidence) String() string { return proto.CompactTextString(m) }
+func (*SubmittedEvidence) ProtoMessage()    {}
+func (*SubmittedEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{16}
+}
+func (m *SubmittedEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmittedEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmittedEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UnbondingDelegationType != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingDelegationType))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *SubmittedEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmittedEvidence.Merge(m, src)
+}
+func (m *SubmittedEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmittedEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmittedEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmittedEvidence proto.InternalMessageInfo
+
+func (m *SubmittedEvidence) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DTDataList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SubmittedEvidence) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DTDataList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingSlash represents a slash that has been requested for an operator and
+// that will be executed once its veto window has passed, unless it gets vetoed
+// in the meantime.
+type PendingSlash struct {
+	// ID is the unique identifier of the pending slash
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ServiceID is the ID of the service for which the misbehavior occurred
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// OperatorID is the ID of the operator to be slashed
+	OperatorID uint32 `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Fraction is the fraction of the operator's security to be slashed
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// InfractionHeight is the height at which the misbehavior occurred
+	InfractionHeight int64 `protobuf:"varint,5,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// ExecutionTime is the time after which the slash will be executed
+	ExecutionTime time.Time `protobuf:"bytes,6,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+	// Tombstone tells whether the operator should also be tombstoned from the
+	// service when the slash is executed. If true, the fraction can be zero
+	Tombstone bool `protobuf:"varint,7,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	// Retries is the number of times the execution of the slash has failed and
+	// the slash has been queued again
+	Retries uint32 `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
-func (m *DTDataList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PendingSlash) Reset()         { *m = PendingSlash{} }
+func (m *PendingSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingSlash) ProtoMessage()    {}
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{17}
+}
+func (m *PendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlash.Merge(m, src)
+}
+func (m *PendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlash.DiscardUnknown(m)
 }
 
-func (m *UserPreferences) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingSlash proto.InternalMessageInfo
+
+func (m *PendingSlash) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UserPreferences) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingSlash) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
 }
 
-func (m *UserPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrustedServices) > 0 {
-		for iNdEx := len(m.TrustedServices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TrustedServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *PendingSlash) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TrustedServiceEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingSlash) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TrustedServiceEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingSlash) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
 }
 
-func (m *TrustedServiceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolsIDs) > 0 {
-		dAtA4 := make([]byte, len(m.PoolsIDs)*10)
-		var j3 int
-		for _, num := range m.PoolsIDs {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintModels(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ServiceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingSlash) GetTombstone() bool {
+	if m != nil {
+		return m.Tombstone
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingSlash) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
-	}
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.TargetID != 0 {
-		n += 1 + sovModels(uint64(m.TargetID))
-	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
-	}
-	return n
+
+// OperatorServiceKey represents the public key that an operator uses to sign
+// the attestations of a service.
+type OperatorServiceKey struct {
+	// OperatorID is the ID of the operator that registered the key
+	OperatorID uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// ServiceID is the ID of the service for which the key is used
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// KeyType is the type of the key
+	KeyType KeyType `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=milkyway.restaking.v1.KeyType" json:"key_type,omitempty"`
+	// PublicKey is the encoded public key
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// ActivationTime is the time from which the key is used
+	ActivationTime time.Time `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovModels(uint64(l))
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+func (m *OperatorServiceKey) Reset()         { *m = OperatorServiceKey{} }
+func (m *OperatorServiceKey) String() string { return proto.CompactTextString(m) }
+func (*OperatorServiceKey) ProtoMessage()    {}
+func (*OperatorServiceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f4cd48423b1e2f, []int{18}
+}
+func (m *OperatorServiceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorServiceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorServiceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OperatorServiceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorServiceKey.Merge(m, src)
+}
+func (m *OperatorServiceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorServiceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorServiceKey.DiscardUnknown(m)
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+var xxx_messageInfo_OperatorServiceKey proto.InternalMessageInfo
+
+func (m *OperatorServiceKey) GetOperatorID() uint32 {
+	if m != nil {
+		return m.OperatorID
 	}
-	if m.TargetID != 0 {
-		n += 1 + sovModels(uint64(m.TargetID))
+	return 0
+}
+
+func (m *OperatorServiceKey) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	return 0
+}
+
+func (m *OperatorServiceKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
 	}
-	return n
+	return KEY_TYPE_UNSPECIFIED
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperatorServiceKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	var l int
-	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovModels(uint64(m.CreationHeight))
+	return nil
+}
+
+func (m *OperatorServiceKey) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovModels(uint64(l))
-	if len(m.InitialBalance) > 0 {
-		for _, e := range m.InitialBalance {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("milkyway.restaking.v1.DelegationType", DelegationType_name, DelegationType_value)
+	proto.RegisterEnum("milkyway.restaking.v1.KeyType", KeyType_name, KeyType_value)
+	proto.RegisterType((*Delegation)(nil), "milkyway.restaking.v1.Delegation")
+	proto.RegisterType((*DelegationResponse)(nil), "milkyway.restaking.v1.DelegationResponse")
+	proto.RegisterType((*UnbondingDelegation)(nil), "milkyway.restaking.v1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "milkyway.restaking.v1.UnbondingDelegationEntry")
+	proto.RegisterType((*DTData)(nil), "milkyway.restaking.v1.DTData")
+	proto.RegisterType((*DTDataList)(nil), "milkyway.restaking.v1.DTDataList")
+	proto.RegisterType((*Redelegation)(nil), "milkyway.restaking.v1.Redelegation")
+	proto.RegisterType((*RedelegationEntry)(nil), "milkyway.restaking.v1.RedelegationEntry")
+	proto.RegisterType((*RTData)(nil), "milkyway.restaking.v1.RTData")
+	proto.RegisterType((*RTDataList)(nil), "milkyway.restaking.v1.RTDataList")
+	proto.RegisterType((*UserPreferences)(nil), "milkyway.restaking.v1.UserPreferences")
+	proto.RegisterType((*TrustedServiceEntry)(nil), "milkyway.restaking.v1.TrustedServiceEntry")
+	proto.RegisterType((*ValidatingOperator)(nil), "milkyway.restaking.v1.ValidatingOperator")
+	proto.RegisterType((*ServiceSnapshot)(nil), "milkyway.restaking.v1.ServiceSnapshot")
+	proto.RegisterType((*OperatorJailRecord)(nil), "milkyway.restaking.v1.OperatorJailRecord")
+	proto.RegisterType((*ContractEvidence)(nil), "milkyway.restaking.v1.ContractEvidence")
+	proto.RegisterType((*SubmittedEvidence)(nil), "milkyway.restaking.v1.SubmittedEvidence")
+	proto.RegisterType((*PendingSlash)(nil), "milkyway.restaking.v1.PendingSlash")
+	proto.RegisterType((*OperatorServiceKey)(nil), "milkyway.restaking.v1.OperatorServiceKey")
+}
+
+func init() {
+	proto.RegisterFile("milkyway/restaking/v1/models.proto", fileDescriptor_86f4cd48423b1e2f)
+}
+
+var fileDescriptor_86f4cd48423b1e2f = []byte{
+	// 1698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0xf7, 0x48, 0xb2, 0x2c, 0x3d, 0xc9, 0x96, 0xdc, 0x31, 0x59, 0xed, 0x47, 0x24, 0xa3, 0x2a,
+	0xc0, 0x71, 0xb0, 0x06, 0x69, 0x6b, 0x81, 0x5d, 0x48, 0x15, 0x2b, 0x8f, 0x00, 0xed, 0x3a, 0xb1,
+	0x68, 0xc9, 0x5b, 0x95, 0x5c, 0xa6, 0x46, 0x33, 0xbd, 0xd2, 0xa0, 0xd1, 0xb4, 0x6a, 0xba, 0xe5,
+	0x44, 0x7f, 0x01, 0xa9, 0x82, 0x43, 0xee, 0x70, 0x08, 0x1f, 0x07, 0x8a, 0x03, 0x45, 0x51, 0xfb,
+	0x17, 0x70, 0x21, 0xc5, 0x85, 0x54, 0x4e, 0x14, 0x07, 0x07, 0xb4, 0x87, 0xf0, 0x67, 0x50, 0xd3,
+	0x3d, 0x1f, 0xb2, 0xd7, 0xae, 0xdd, 0x81, 0xa4, 0x38, 0x90, 0xcb, 0xee, 0xf4, 0xeb, 0xf7, 0xd1,
+	0xef, 0xf7, 0x7e, 0xef, 0x75, 0xcb, 0x50, 0x9f, 0xda, 0xce, 0x64, 0xf1, 0x8e, 0xb1, 0x50, 0x3d,
+	0xc2, 0xb8, 0x31, 0xb1, 0xdd, 0x91, 0x7a, 0xda, 0x54, 0xa7, 0xd4, 0x22, 0x0e, 0x6b, 0xcc, 0x3c,
+	0xca, 0x29, 0xfa, 0x52, 0xa8, 0xd3, 0x88, 0x74, 0x1a, 0xa7, 0xcd, 0x1b, 0xdb, 0xc6, 0xd4, 0x76,
+	0xa9, 0x2a, 0xfe, 0x95, 0x9a, 0x37, 0xaa, 0x26, 0x65, 0x53, 0xca, 0xd4, 0xa1, 0xc1, 0x88, 0x7a,
+	0xda, 0x1c, 0x12, 0x6e, 0x34, 0x55, 0x93, 0xda, 0x6e, 0xb0, 0x7f, 0x5d, 0xee, 0xeb, 0x62, 0xa5,
+	0xca, 0x45, 0xb0, 0xb5, 0x33, 0xa2, 0x23, 0x2a, 0xe5, 0xfe, 0x57, 0x20, 0xad, 0x8d, 0x28, 0x1d,
+	0x39, 0x44, 0x15, 0xab, 0xe1, 0xfc, 0xb1, 0xca, 0xed, 0xa9, 0x7f, 0x86, 0xe9, 0x4c, 0x2a, 0xd4,
+	0x7f, 0x93, 0x02, 0xd0, 0x88, 0x43, 0x46, 0x06, 0xb7, 0xa9, 0x8b, 0xee, 0x42, 0x86, 0x2f, 0x66,
+	0xa4, 0xa2, 0xec, 0x2a, 0x7b, 0x5b, 0xad, 0xaf, 0x34, 0x2e, 0x3d, 0x79, 0x23, 0x36, 0x18, 0x2c,
+	0x66, 0x04, 0x0b, 0x13, 0xf4, 0x1d, 0x28, 0xce, 0x19, 0xf1, 0x74, 0xc3, 0xb2, 0x3c, 0xc2, 0x58,
+	0x25, 0xb5, 0xab, 0xec, 0xe5, 0xdb, 0x95, 0x8f, 0x9f, 0x1c, 0xec, 0x04, 0x07, 0xbd, 0x2f, 0x77,
+	0xfa, 0xdc, 0xb3, 0xdd, 0x11, 0x2e, 0xf8, 0xda, 0x81, 0x08, 0xbd, 0x0a, 0x79, 0x6e, 0x78, 0x23,
+	0xc2, 0x75, 0xdb, 0xaa, 0xa4, 0x77, 0x95, 0xbd, 0xcd, 0x76, 0x71, 0x79, 0x56, 0xcb, 0x0d, 0x84,
+	0xb0, 0xab, 0xe1, 0x9c, 0xdc, 0xee, 0x5a, 0xc8, 0x86, 0x2c, 0x1b, 0x1b, 0x1e, 0x61, 0x95, 0xcc,
+	0x6e, 0x7a, 0xaf, 0xd0, 0xba, 0xd5, 0x08, 0xdc, 0xfb, 0xa0, 0x35, 0x02, 0xd0, 0x1a, 0x1a, 0x31,
+	0x0f, 0xa9, 0xed, 0xb6, 0x6f, 0x7f, 0x78, 0x56, 0x5b, 0xfb, 0xdd, 0x27, 0xb5, 0xd7, 0x46, 0x36,
+	0x1f, 0xcf, 0x87, 0x0d, 0x93, 0x4e, 0x03, 0xdc, 0x82, 0xff, 0x0e, 0x98, 0x35, 0x51, 0xfd, 0x04,
+	0x58, 0x68, 0xc3, 0x70, 0x10, 0xe0, 0x5e, 0xee, 0xbd, 0x0f, 0x6a, 0x6b, 0xff, 0xfa, 0xa0, 0xb6,
+	0x56, 0xff, 0xab, 0x02, 0x28, 0xce, 0x1a, 0x13, 0x36, 0xa3, 0x2e, 0x23, 0xe8, 0x08, 0xc0, 0x8a,
+	0xa4, 0x02, 0xb4, 0x42, 0xeb, 0xcb, 0xcf, 0x05, 0xad, 0x9d, 0xf7, 0x0f, 0xf5, 0xdb, 0x4f, 0xff,
+	0xb0, 0xaf, 0xe0, 0x15, 0x7b, 0x44, 0x60, 0x63, 0x68, 0x38, 0x86, 0x6b, 0x92, 0x4a, 0x4a, 0xa4,
+	0x76, 0xfd, 0xd2, 0xd4, 0x44, 0x5e, 0xdf, 0x08, 0xf2, 0xda, 0x7b, 0x81, 0xbc, 0x64, 0x52, 0xa1,
+	0xef, 0x7b, 0x19, 0x91, 0xd1, 0xaf, 0x52, 0xf0, 0xd2, 0x89, 0x3b, 0xa4, 0xae, 0x65, 0xbb, 0xa3,
+	0xcf, 0x86, 0x01, 0x1d, 0xd8, 0x0e, 0xb2, 0xa1, 0x2f, 0x4e, 0x83, 0x72, 0x64, 0xf2, 0x1f, 0x70,
+	0x61, 0x00, 0x1b, 0xc4, 0xe5, 0x9e, 0x1d, 0x91, 0x41, 0xbd, 0xe2, 0xbc, 0x97, 0x64, 0xda, 0x71,
+	0xb9, 0xb7, 0x58, 0x2d, 0x45, 0xe8, 0x6a, 0xa5, 0xec, 0xbf, 0x4f, 0x43, 0xe5, 0x2a, 0x53, 0xf4,
+	0x35, 0x28, 0x99, 0x1e, 0x11, 0x02, 0x7d, 0x4c, 0xec, 0xd1, 0x98, 0x0b, 0xd0, 0xd2, 0x78, 0x2b,
+	0x14, 0xff, 0x50, 0x48, 0x11, 0x86, 0x92, 0x49, 0xa7, 0x33, 0x87, 0x08, 0x55, 0xbf, 0x03, 0x05,
+	0x2a, 0x85, 0xd6, 0x8d, 0x86, 0x6c, 0xcf, 0x46, 0xd8, 0x9e, 0x8d, 0x41, 0xd8, 0x9e, 0xed, 0x4d,
+	0xff, 0x60, 0xef, 0x7f, 0x52, 0x53, 0xe4, 0xe1, 0xb6, 0x62, 0x0f, 0xbe, 0x0e, 0xe2, 0x50, 0xb2,
+	0x5d, 0x9b, 0xdb, 0x86, 0xa3, 0x87, 0x9c, 0x49, 0x7f, 0xf6, 0x9c, 0xd9, 0x0a, 0x62, 0xb4, 0x65,
+	0x88, 0x55, 0x86, 0x66, 0x3e, 0x3f, 0x86, 0xa2, 0x16, 0x14, 0xe7, 0x21, 0xea, 0x3e, 0x09, 0xd6,
+	0x77, 0x95, 0xbd, 0x4c, 0xbb, 0xb4, 0x3c, 0xab, 0x15, 0xa2, 0x6a, 0x74, 0x35, 0x5c, 0x88, 0x94,
+	0xba, 0x96, 0x60, 0xb5, 0x52, 0xff, 0x54, 0x81, 0xac, 0x36, 0xd0, 0x0c, 0x6e, 0x20, 0x03, 0xae,
+	0xc7, 0x4e, 0xe2, 0x2e, 0xd3, 0x93, 0xb3, 0xfb, 0xda, 0xfc, 0x59, 0x0a, 0x0c, 0xfe, 0x27, 0x84,
+	0x5f, 0xa1, 0xe6, 0x03, 0x00, 0x99, 0xe8, 0x91, 0xcd, 0x38, 0xfa, 0x2e, 0x64, 0x2c, 0x83, 0x1b,
+	0x15, 0x45, 0x54, 0xe5, 0x95, 0xab, 0xf2, 0x12, 0x06, 0xab, 0x9c, 0x17, 0x56, 0xf5, 0x5f, 0xa4,
+	0xa1, 0x88, 0xc9, 0xca, 0x24, 0xba, 0x34, 0x31, 0x25, 0x71, 0x62, 0xdf, 0x83, 0x1c, 0xf3, 0x4c,
+	0x89, 0x78, 0x2a, 0x09, 0xe2, 0x1b, 0xcc, 0x33, 0x05, 0xc2, 0xb7, 0x61, 0x53, 0x78, 0xb8, 0x00,
+	0x8f, 0xa0, 0x42, 0xdf, 0x33, 0x23, 0x84, 0x0a, 0x2c, 0x5a, 0x58, 0x7e, 0x58, 0x8b, 0x71, 0x19,
+	0x36, 0x93, 0x28, 0xac, 0xc5, 0x78, 0x18, 0x56, 0x78, 0x88, 0xc2, 0xae, 0xc7, 0x61, 0x35, 0xc6,
+	0xe3, 0xb0, 0x56, 0xb4, 0xb0, 0xd0, 0x1b, 0xf1, 0x30, 0xca, 0x8a, 0x32, 0xec, 0x5d, 0x11, 0x75,
+	0x15, 0xea, 0x17, 0x99, 0x42, 0x7f, 0x4c, 0xc3, 0xf6, 0x33, 0x36, 0xff, 0x8f, 0xe3, 0x67, 0x06,
+	0x20, 0x6f, 0x66, 0xdd, 0x62, 0xfc, 0xf3, 0xbb, 0xfe, 0xf3, 0x32, 0x88, 0xc6, 0xf8, 0x7f, 0x31,
+	0x89, 0xfe, 0x9c, 0x82, 0x2c, 0x96, 0x93, 0xe8, 0x8b, 0x6e, 0x4a, 0xde, 0x4d, 0xe7, 0x27, 0x1d,
+	0x4e, 0x3a, 0xe9, 0xf0, 0x15, 0x93, 0xee, 0x27, 0x0a, 0x94, 0x4e, 0x18, 0xf1, 0x7a, 0x1e, 0x79,
+	0x4c, 0x3c, 0xe2, 0x9a, 0x84, 0x21, 0x17, 0xca, 0xdc, 0x9b, 0x33, 0x4e, 0x2c, 0x9d, 0x11, 0xef,
+	0xd4, 0x36, 0xa3, 0xd7, 0xc4, 0xfe, 0x15, 0xde, 0x07, 0x52, 0xbd, 0x2f, 0xb5, 0x65, 0x0b, 0x5f,
+	0xf3, 0x43, 0x2d, 0xcf, 0x6a, 0xa5, 0xf3, 0x9b, 0x0c, 0x97, 0xf8, 0x79, 0xc1, 0x83, 0x4c, 0x4e,
+	0x29, 0x67, 0xea, 0x2e, 0xbc, 0x74, 0x89, 0x1b, 0xf4, 0x75, 0x80, 0xe0, 0x10, 0x3e, 0x50, 0x8a,
+	0x00, 0x6a, 0x73, 0x79, 0x56, 0xcb, 0x07, 0x5a, 0x5d, 0x0d, 0xe7, 0x03, 0x85, 0xae, 0xe5, 0xdf,
+	0x1c, 0x33, 0x4a, 0x1d, 0xa6, 0xdb, 0x16, 0x13, 0x6f, 0xc6, 0xe0, 0xe6, 0xe8, 0xf9, 0xc2, 0xae,
+	0xc6, 0x70, 0x4e, 0x6c, 0x77, 0x2d, 0x56, 0xff, 0x65, 0x0a, 0xd0, 0x23, 0xc3, 0xb1, 0x2d, 0x83,
+	0xdb, 0xee, 0xe8, 0x78, 0x46, 0x3c, 0x9f, 0x5b, 0x48, 0x85, 0x02, 0x0d, 0xbe, 0xe3, 0x80, 0x5b,
+	0xcb, 0xb3, 0x1a, 0x84, 0x2a, 0x5d, 0x0d, 0x43, 0xa8, 0xd2, 0xb5, 0xd0, 0x18, 0xb2, 0x9c, 0x4e,
+	0x88, 0xcb, 0x9e, 0xff, 0x46, 0xbd, 0x93, 0xb4, 0xe1, 0x65, 0xa5, 0x02, 0xff, 0xe8, 0x6d, 0xc8,
+	0xcf, 0x99, 0xa5, 0x9f, 0x1a, 0xce, 0x9c, 0x08, 0xa6, 0xe6, 0xdb, 0xaf, 0xfb, 0x1e, 0xff, 0x7e,
+	0x56, 0xbb, 0x29, 0xed, 0x99, 0x35, 0x69, 0xd8, 0x54, 0x9d, 0x1a, 0x7c, 0xdc, 0x38, 0x22, 0x23,
+	0xc3, 0x5c, 0x68, 0xc4, 0xf4, 0xf3, 0x3f, 0xe9, 0x6b, 0x8f, 0x7c, 0xb3, 0x8f, 0x9f, 0x1c, 0x40,
+	0x70, 0x3c, 0x8d, 0x98, 0x38, 0x37, 0x67, 0x96, 0x90, 0xa3, 0x1d, 0x58, 0x9f, 0xd1, 0x77, 0x88,
+	0x27, 0x18, 0x9d, 0xc6, 0x72, 0x51, 0xff, 0x53, 0x0a, 0x4a, 0x01, 0xce, 0x7d, 0xd7, 0x98, 0xb1,
+	0x31, 0xe5, 0x09, 0x0b, 0xb2, 0x03, 0xeb, 0x64, 0x46, 0xcd, 0xb1, 0x68, 0xd0, 0x0c, 0x96, 0x0b,
+	0xf4, 0x32, 0x64, 0x83, 0x09, 0x9d, 0x16, 0xe1, 0x82, 0x15, 0x7a, 0x1d, 0x32, 0x62, 0x1c, 0x67,
+	0x92, 0x8e, 0x63, 0x61, 0x86, 0x30, 0xe4, 0xc3, 0xc2, 0xb0, 0xca, 0xba, 0xa8, 0xc6, 0xab, 0x57,
+	0x30, 0xf6, 0xd9, 0xca, 0xaf, 0xf6, 0x46, 0xec, 0x06, 0xd5, 0xa0, 0xc0, 0x29, 0x37, 0x1c, 0x5d,
+	0xc2, 0x93, 0x15, 0xe7, 0x05, 0x21, 0xea, 0xf9, 0x12, 0x5f, 0x61, 0x4a, 0xbc, 0x89, 0x43, 0x74,
+	0x8f, 0x52, 0x5e, 0xd9, 0xd8, 0x55, 0xf6, 0x8a, 0x18, 0xa4, 0x08, 0x53, 0xca, 0xeb, 0xff, 0x54,
+	0x00, 0x85, 0x41, 0x1e, 0x18, 0xb6, 0x83, 0x89, 0x49, 0x3d, 0x2b, 0x21, 0x8e, 0x17, 0x68, 0x99,
+	0x7a, 0x2e, 0x2d, 0x8f, 0xa0, 0xf8, 0x63, 0xc3, 0x76, 0x88, 0xa5, 0xcf, 0x5d, 0x6e, 0x3b, 0x02,
+	0xe8, 0x44, 0x90, 0x16, 0xa4, 0xf9, 0x89, 0x6f, 0x8d, 0xaa, 0x00, 0x9c, 0x4e, 0x87, 0x8c, 0x53,
+	0x97, 0x58, 0xa2, 0x3c, 0x39, 0xbc, 0x22, 0xa9, 0xff, 0x5c, 0x81, 0xf2, 0x21, 0x75, 0xb9, 0x67,
+	0x98, 0xbc, 0x73, 0x6a, 0x5b, 0xfe, 0x20, 0x49, 0xde, 0x4a, 0x31, 0x2d, 0x52, 0xe7, 0x68, 0x81,
+	0x82, 0x11, 0x97, 0x16, 0xd8, 0x8a, 0xef, 0x7b, 0xfb, 0x7f, 0x79, 0x72, 0xf0, 0xd5, 0xcb, 0x6b,
+	0x1b, 0x7a, 0x0f, 0x0f, 0x52, 0x3f, 0x81, 0xed, 0xfe, 0x7c, 0x38, 0xb5, 0x39, 0x27, 0x56, 0x74,
+	0xba, 0x64, 0xf8, 0x23, 0xc8, 0x8c, 0x0d, 0x26, 0x69, 0x5c, 0xc4, 0xe2, 0xbb, 0xfe, 0xd3, 0x34,
+	0x14, 0x7b, 0x44, 0xdc, 0x72, 0x7d, 0xc7, 0x60, 0x3e, 0xad, 0x53, 0x81, 0xab, 0x4c, 0x3b, 0xbb,
+	0x3c, 0xab, 0xa5, 0xba, 0x1a, 0x4e, 0xd9, 0x17, 0x4b, 0x9d, 0x4a, 0x56, 0xea, 0xf4, 0x73, 0x61,
+	0x7b, 0x03, 0x72, 0x8f, 0x7d, 0xe0, 0xfd, 0x9f, 0xdc, 0x19, 0x31, 0x16, 0x9a, 0x2f, 0x30, 0x16,
+	0x2e, 0x8e, 0x82, 0xd0, 0x05, 0x7a, 0x0d, 0xb6, 0x6d, 0x37, 0x5c, 0x85, 0x2f, 0xa9, 0x75, 0x51,
+	0x90, 0x72, 0xbc, 0x11, 0xbc, 0xa5, 0x7a, 0xb0, 0x45, 0xde, 0x25, 0xe6, 0x3c, 0x7e, 0x4a, 0x65,
+	0x93, 0x12, 0x6d, 0x33, 0x72, 0x20, 0x5e, 0x52, 0xb7, 0x20, 0x1f, 0x11, 0x4b, 0x74, 0x53, 0x0e,
+	0xc7, 0x02, 0x54, 0x81, 0x0d, 0x8f, 0xc8, 0x37, 0x65, 0xce, 0x07, 0x06, 0x87, 0x4b, 0x31, 0xcf,
+	0x43, 0x80, 0x02, 0x5c, 0x1f, 0x92, 0x45, 0x72, 0x12, 0x26, 0x2b, 0xd6, 0x5d, 0xc8, 0x4d, 0xc8,
+	0x42, 0x3e, 0x06, 0xd2, 0xe2, 0x31, 0x50, 0xbd, 0x62, 0xe2, 0x3c, 0x24, 0x0b, 0xf9, 0x0a, 0x98,
+	0xc8, 0x0f, 0xf4, 0x0a, 0xc0, 0x6c, 0x3e, 0x74, 0x6c, 0x53, 0x9f, 0x90, 0x85, 0x28, 0x5c, 0x11,
+	0xe7, 0xa5, 0xc4, 0x3f, 0x38, 0x86, 0x92, 0x8f, 0xf4, 0xa9, 0x11, 0x43, 0xbb, 0x9e, 0xf8, 0x95,
+	0x1a, 0x7b, 0xf0, 0x75, 0xf6, 0x7f, 0xa6, 0xc0, 0xd6, 0x85, 0x9f, 0x6c, 0x35, 0xb8, 0xa9, 0x75,
+	0x8e, 0x3a, 0x3f, 0xb8, 0x3f, 0xe8, 0x1e, 0xbf, 0xa9, 0x0f, 0xde, 0xea, 0x75, 0xf4, 0x93, 0x37,
+	0xfb, 0xbd, 0xce, 0x61, 0xf7, 0xfb, 0xdd, 0x8e, 0x56, 0x5e, 0x43, 0x15, 0xd8, 0xb9, 0xa8, 0xd0,
+	0x3b, 0x3e, 0x3e, 0x2a, 0x2b, 0xe8, 0x16, 0x54, 0x2e, 0xee, 0x1c, 0xf7, 0x3a, 0xf8, 0xfe, 0xe0,
+	0x18, 0x97, 0x53, 0xe8, 0x26, 0x5c, 0xbb, 0xb8, 0xdb, 0xef, 0xe0, 0x47, 0xdd, 0xc3, 0x4e, 0x39,
+	0x7d, 0x23, 0xf3, 0xde, 0xaf, 0xab, 0x6b, 0xfb, 0x14, 0x36, 0x02, 0x54, 0xfc, 0x28, 0x0f, 0x3b,
+	0x6f, 0x5d, 0x16, 0x7f, 0x07, 0xca, 0xd1, 0x4e, 0x47, 0x6b, 0xdd, 0xb9, 0xd3, 0xbc, 0x5b, 0x56,
+	0xd0, 0xcb, 0x80, 0x22, 0x69, 0xbf, 0x73, 0xd8, 0x6b, 0xdd, 0xf9, 0xe6, 0xc3, 0x66, 0x39, 0x75,
+	0x4e, 0xde, 0x3e, 0xea, 0x37, 0x5b, 0xfa, 0xed, 0x6f, 0x37, 0xc3, 0x80, 0xed, 0x1f, 0x7d, 0xb8,
+	0xac, 0x2a, 0x1f, 0x2d, 0xab, 0xca, 0x3f, 0x96, 0x55, 0xe5, 0xfd, 0xa7, 0xd5, 0xb5, 0x8f, 0x9e,
+	0x56, 0xd7, 0xfe, 0xf6, 0xb4, 0xba, 0xf6, 0xf6, 0xb7, 0x56, 0xae, 0xe4, 0xb0, 0x7e, 0x07, 0x8e,
+	0x31, 0x64, 0xd1, 0x4a, 0x3d, 0x6d, 0xb6, 0xd4, 0x77, 0x57, 0xfe, 0xaa, 0x29, 0xee, 0xe9, 0x61,
+	0x56, 0x54, 0xe1, 0xf6, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xba, 0xa7, 0x1d, 0x43, 0xf8, 0x14,
+	0x00, 0x00,
+}
+
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingDelegationEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if len(this.InitialBalance) != len(that1.InitialBalance) {
+		return false
+	}
+	for i := range this.InitialBalance {
+		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
+			return false
 		}
 	}
-	if m.UnbondingID != 0 {
-		n += 1 + sovModels(uint64(m.UnbondingID))
+	if len(this.Balance) != len(that1.Balance) {
+		return false
 	}
-	return n
+	for i := range this.Balance {
+		if !this.Balance[i].Equal(&that1.Balance[i]) {
+			return false
+		}
+	}
+	if this.UnbondingID != that1.UnbondingID {
+		return false
+	}
+	return true
 }
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DTData) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if len(this.InitialBalance) != len(that1.InitialBalance) {
+		return false
+	}
+	for i := range this.InitialBalance {
+		if !this.InitialBalance[i].Equal(&that1.InitialBalance[i]) {
+			return false
+		}
+	}
+	if len(this.SharesDst) != len(that1.SharesDst) {
+		return false
+	}
+	for i := range this.SharesDst {
+		if !this.SharesDst[i].Equal(&that1.SharesDst[i]) {
+			return false
+		}
+	}
+	if this.UnbondingID != that1.UnbondingID {
+		return false
+	}
+	return true
+}
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UnbondingDelegationType != 0 {
-		n += 1 + sovModels(uint64(m.UnbondingDelegationType))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.TargetID != 0 {
-		n += 1 + sovModels(uint64(m.TargetID))
+		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DTDataList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UserPreferences) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TrustedServices) > 0 {
-		for _, e := range m.TrustedServices {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InitialBalance) > 0 {
+		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintModels(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DTData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DTData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UnbondingDelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingDelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DTDataList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DTDataList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DTDataList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DstTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DstTargetID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DstType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DstType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SrcTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SrcTargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SrcType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SharesDst) > 0 {
+		for iNdEx := len(m.SharesDst) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharesDst[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InitialBalance) > 0 {
+		for iNdEx := len(m.InitialBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintModels(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RTData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RTData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DstTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DstTargetID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DstType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DstType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SrcTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SrcTargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SrcType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RTDataList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RTDataList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RTDataList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustedServices) > 0 {
+		for iNdEx := len(m.TrustedServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustedServiceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedServiceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedServiceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolsIDs) > 0 {
+		dAtA5 := make([]byte, len(m.PoolsIDs)*10)
+		var j4 int
+		for _, num := range m.PoolsIDs {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintModels(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatingOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatingOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatingOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.USDValue.Size()
+		i -= size
+		if _, err := m.USDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintModels(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorJailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorJailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorJailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintModels(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmittedEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmittedEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmittedEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintModels(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	if m.InfractionHeight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorServiceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorServiceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorServiceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintModels(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OperatorID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.TargetID != 0 {
+		n += 1 + sovModels(uint64(m.TargetID))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.TargetID != 0 {
+		n += 1 + sovModels(uint64(m.TargetID))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovModels(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.InitialBalance) > 0 {
+		for _, e := range m.InitialBalance {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.UnbondingID != 0 {
+		n += 1 + sovModels(uint64(m.UnbondingID))
+	}
+	return n
+}
+
+func (m *DTData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingDelegationType != 0 {
+		n += 1 + sovModels(uint64(m.UnbondingDelegationType))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.TargetID != 0 {
+		n += 1 + sovModels(uint64(m.TargetID))
+	}
+	return n
+}
+
+func (m *DTDataList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.SrcType != 0 {
+		n += 1 + sovModels(uint64(m.SrcType))
+	}
+	if m.SrcTargetID != 0 {
+		n += 1 + sovModels(uint64(m.SrcTargetID))
+	}
+	if m.DstType != 0 {
+		n += 1 + sovModels(uint64(m.DstType))
+	}
+	if m.DstTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DstTargetID))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovModels(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.InitialBalance) > 0 {
+		for _, e := range m.InitialBalance {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.SharesDst) > 0 {
+		for _, e := range m.SharesDst {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.UnbondingID != 0 {
+		n += 1 + sovModels(uint64(m.UnbondingID))
+	}
+	return n
+}
+
+func (m *RTData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.SrcType != 0 {
+		n += 1 + sovModels(uint64(m.SrcType))
+	}
+	if m.SrcTargetID != 0 {
+		n += 1 + sovModels(uint64(m.SrcTargetID))
+	}
+	if m.DstType != 0 {
+		n += 1 + sovModels(uint64(m.DstType))
+	}
+	if m.DstTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DstTargetID))
+	}
+	return n
+}
+
+func (m *RTDataList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrustedServices) > 0 {
+		for _, e := range m.TrustedServices {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TrustedServiceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if len(m.PoolsIDs) > 0 {
+		l = 0
+		for _, e := range m.PoolsIDs {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ValidatingOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = m.USDValue.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovModels(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ServiceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovModels(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovModels(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovModels(uint64(m.TotalPower))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorJailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovModels(uint64(m.Height))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmittedEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if m.InfractionHeight != 0 {
+		n += 1 + sovModels(uint64(m.InfractionHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Tombstone {
+		n += 2
+	}
+	if m.Retries != 0 {
+		n += 1 + sovModels(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *OperatorServiceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorID != 0 {
+		n += 1 + sovModels(uint64(m.OperatorID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovModels(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			}
+			m.TargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.DecCoin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			}
+			m.TargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialBalance = append(m.InitialBalance, types.Coin{})
+			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingID", wireType)
+			}
+			m.UnbondingID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DTData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DTData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DTData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegationType", wireType)
+			}
+			m.UnbondingDelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingDelegationType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			}
+			m.TargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DTDataList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DTDataList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DTDataList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, DTData{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcType", wireType)
+			}
+			m.SrcType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTargetID", wireType)
+			}
+			m.SrcTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstType", wireType)
+			}
+			m.DstType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTargetID", wireType)
+			}
+			m.DstTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialBalance = append(m.InitialBalance, types.Coin{})
+			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesDst = append(m.SharesDst, types.DecCoin{})
+			if err := m.SharesDst[len(m.SharesDst)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingID", wireType)
+			}
+			m.UnbondingID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RTData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RTData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RTData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcType", wireType)
+			}
+			m.SrcType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTargetID", wireType)
+			}
+			m.SrcTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstType", wireType)
+			}
+			m.DstType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstType |= DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTargetID", wireType)
+			}
+			m.DstTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RTDataList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RTDataList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RTDataList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, RTData{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPreferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPreferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedServices = append(m.TrustedServices, TrustedServiceEntry{})
+			if err := m.TrustedServices[len(m.TrustedServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedServiceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedServiceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedServiceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolsIDs = append(m.PoolsIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolsIDs) == 0 {
+					m.PoolsIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolsIDs = append(m.PoolsIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *TrustedServiceEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceID != 0 {
-		n += 1 + sovModels(uint64(m.ServiceID))
-	}
-	if len(m.PoolsIDs) > 0 {
-		l = 0
-		for _, e := range m.PoolsIDs {
-			l += sovModels(uint64(e))
-		}
-		n += 1 + sovModels(uint64(l)) + l
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatingOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,17 +4675,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatingOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatingOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
 			}
-			m.Type = 0
+			m.OperatorID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1223,16 +4695,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= DelegationType(b&0x7F) << shift
+				m.OperatorID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1242,29 +4714,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDValue", wireType)
 			}
-			m.TargetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1274,16 +4748,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.USDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1293,26 +4782,11 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Shares = append(m.Shares, types.DecCoin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1334,7 +4808,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *ServiceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,15 +4831,72 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1392,15 +4923,68 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, ValidatingOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1410,24 +4994,24 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1451,7 +5035,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *OperatorJailRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1474,17 +5058,17 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorJailRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorJailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			m.Type = 0
+			m.ServiceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1494,48 +5078,16 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= DelegationType(b&0x7F) << shift
+				m.ServiceID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
 			}
-			m.TargetID = 0
+			m.OperatorID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1545,14 +5097,14 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetID |= uint32(b&0x7F) << shift
+				m.OperatorID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1579,11 +5131,30 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1605,7 +5176,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ContractEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1628,17 +5199,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
 			}
-			m.CreationHeight = 0
+			m.OperatorID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1648,16 +5219,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.OperatorID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1667,64 +5238,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialBalance = append(m.InitialBalance, types.Coin{})
-			if err := m.InitialBalance[len(m.InitialBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1734,45 +5257,26 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingID", wireType)
-			}
-			m.UnbondingID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1794,7 +5298,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DTData) Unmarshal(dAtA []byte) error {
+func (m *SubmittedEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,17 +5321,17 @@ func (m *DTData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DTData: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmittedEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DTData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmittedEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			m.UnbondingDelegationType = 0
+			m.ServiceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1837,16 +5341,16 @@ func (m *DTData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingDelegationType |= DelegationType(b&0x7F) << shift
+				m.ServiceID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1856,43 +5360,26 @@ func (m *DTData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
-			}
-			m.TargetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1914,7 +5401,7 @@ func (m *DTData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DTDataList) Unmarshal(dAtA []byte) error {
+func (m *PendingSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1937,17 +5424,74 @@ func (m *DTDataList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DTDataList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DTDataList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1957,79 +5501,48 @@ func (m *DTDataList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, DTData{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserPreferences) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserPreferences: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2056,11 +5569,49 @@ func (m *UserPreferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedServices = append(m.TrustedServices, TrustedServiceEntry{})
-			if err := m.TrustedServices[len(m.TrustedServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2082,7 +5633,7 @@ func (m *UserPreferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrustedServiceEntry) Unmarshal(dAtA []byte) error {
+func (m *OperatorServiceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,13 +5656,32 @@ func (m *TrustedServiceEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrustedServiceEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorServiceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrustedServiceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorServiceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
@@ -2130,82 +5700,92 @@ func (m *TrustedServiceEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				m.PoolsIDs = append(m.PoolsIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowModels
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthModels
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthModels
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.PoolsIDs) == 0 {
-					m.PoolsIDs = make([]uint32, 0, elementCount)
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowModels
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PoolsIDs = append(m.PoolsIDs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolsIDs", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
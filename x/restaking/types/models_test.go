@@ -2,8 +2,10 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -387,3 +389,330 @@ func TestComputeChangedServices(t *testing.T) {
 		})
 	}
 }
+
+func TestPowerFromUSDValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		usdValue sdkmath.LegacyDec
+		expPower int64
+	}{
+		{
+			name:     "zero value returns zero power",
+			usdValue: sdkmath.LegacyZeroDec(),
+			expPower: 0,
+		},
+		{
+			name:     "decimal value is truncated",
+			usdValue: sdkmath.LegacyMustNewDecFromStr("120.99"),
+			expPower: 120,
+		},
+		{
+			name:     "value exceeding int64 is capped",
+			usdValue: sdkmath.LegacyMustNewDecFromStr("100000000000000000000"),
+			expPower: 9223372036854775807,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPower, types.PowerFromUSDValue(tc.usdValue))
+		})
+	}
+}
+
+func TestValidatingOperator_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		operator  types.ValidatingOperator
+		shouldErr bool
+	}{
+		{
+			name:      "invalid operator id returns error",
+			operator:  types.NewValidatingOperator(0, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+			shouldErr: true,
+		},
+		{
+			name: "invalid tokens returns error",
+			operator: types.NewValidatingOperator(1, sdk.Coins{
+				sdk.Coin{Denom: "1umilk", Amount: sdkmath.NewInt(100)},
+			}, sdkmath.LegacyNewDec(10)),
+			shouldErr: true,
+		},
+		{
+			name:      "negative usd value returns error",
+			operator:  types.NewValidatingOperator(1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(-1)),
+			shouldErr: true,
+		},
+		{
+			name:      "valid operator returns no error",
+			operator:  types.NewValidatingOperator(1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.operator.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestComputeValidatingSetMerkleRoot(t *testing.T) {
+	operators := []types.ValidatingOperator{
+		types.NewValidatingOperator(2, sdk.NewCoins(sdk.NewInt64Coin("umilk", 300)), sdkmath.LegacyNewDec(30)),
+		types.NewValidatingOperator(1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+	}
+
+	// Make sure the leaves are encoded as operator ID followed by power
+	require.Equal(t, []byte{0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 30}, operators[0].MerkleLeaf())
+
+	// Make sure the root matches the one built from the leaves
+	expRoot := merkle.HashFromByteSlices([][]byte{operators[0].MerkleLeaf(), operators[1].MerkleLeaf()})
+	require.Equal(t, expRoot, types.ComputeValidatingSetMerkleRoot(operators))
+
+	// Make sure each operator can be proven against the root
+	root, proofs := merkle.ProofsFromByteSlices([][]byte{operators[0].MerkleLeaf(), operators[1].MerkleLeaf()})
+	require.Equal(t, expRoot, root)
+	for i, operator := range operators {
+		require.NoError(t, proofs[i].Verify(root, operator.MerkleLeaf()))
+	}
+
+	// Make sure the order matters
+	require.NotEqual(t, expRoot, types.ComputeValidatingSetMerkleRoot([]types.ValidatingOperator{operators[1], operators[0]}))
+}
+
+func TestServiceSnapshot_Validate(t *testing.T) {
+	snapshotTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	operators := []types.ValidatingOperator{
+		types.NewValidatingOperator(1, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+	}
+
+	testCases := []struct {
+		name      string
+		snapshot  func() types.ServiceSnapshot
+		shouldErr bool
+	}{
+		{
+			name: "invalid service id returns error",
+			snapshot: func() types.ServiceSnapshot {
+				return types.NewServiceSnapshot(0, 1, 10, snapshotTime, operators)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid epoch returns error",
+			snapshot: func() types.ServiceSnapshot {
+				return types.NewServiceSnapshot(1, 0, 10, snapshotTime, operators)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid operator returns error",
+			snapshot: func() types.ServiceSnapshot {
+				return types.NewServiceSnapshot(1, 1, 10, snapshotTime, []types.ValidatingOperator{
+					types.NewValidatingOperator(0, sdk.NewCoins(sdk.NewInt64Coin("umilk", 100)), sdkmath.LegacyNewDec(10)),
+				})
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated operator returns error",
+			snapshot: func() types.ServiceSnapshot {
+				return types.NewServiceSnapshot(1, 1, 10, snapshotTime, append(operators, operators[0]))
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid total power returns error",
+			snapshot: func() types.ServiceSnapshot {
+				snapshot := types.NewServiceSnapshot(1, 1, 10, snapshotTime, operators)
+				snapshot.TotalPower = 100
+				return snapshot
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid merkle root returns error",
+			snapshot: func() types.ServiceSnapshot {
+				snapshot := types.NewServiceSnapshot(1, 1, 10, snapshotTime, operators)
+				snapshot.MerkleRoot = []byte{0x01}
+				return snapshot
+			},
+			shouldErr: true,
+		},
+		{
+			name: "valid snapshot returns no error",
+			snapshot: func() types.ServiceSnapshot {
+				return types.NewServiceSnapshot(1, 1, 10, snapshotTime, operators)
+			},
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.snapshot().Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOperatorJailRecord_Validate(t *testing.T) {
+	jailedUntil := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		record    types.OperatorJailRecord
+		shouldErr bool
+	}{
+		{
+			name:      "invalid service id returns error",
+			record:    types.NewOperatorJailRecord(0, 1, jailedUntil, false),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid operator id returns error",
+			record:    types.NewOperatorJailRecord(1, 0, jailedUntil, false),
+			shouldErr: true,
+		},
+		{
+			name:      "valid record returns no error",
+			record:    types.NewOperatorJailRecord(1, 1, jailedUntil, true),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.record.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOperatorJailRecord_CanUnjail(t *testing.T) {
+	jailedUntil := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		record    types.OperatorJailRecord
+		blockTime time.Time
+		expResult bool
+	}{
+		{
+			name:      "jail period not ended returns false",
+			record:    types.NewOperatorJailRecord(1, 1, jailedUntil, false),
+			blockTime: jailedUntil.Add(-time.Second),
+			expResult: false,
+		},
+		{
+			name:      "tombstoned operator returns false",
+			record:    types.NewOperatorJailRecord(1, 1, jailedUntil, true),
+			blockTime: jailedUntil.Add(time.Hour),
+			expResult: false,
+		},
+		{
+			name:      "jail period ended returns true",
+			record:    types.NewOperatorJailRecord(1, 1, jailedUntil, false),
+			blockTime: jailedUntil,
+			expResult: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, tc.record.CanUnjail(tc.blockTime))
+		})
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func TestPendingSlash_Validate(t *testing.T) {
+	executionTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		pendingSlash types.PendingSlash
+		shouldErr    bool
+	}{
+		{
+			name:         "invalid id returns error",
+			pendingSlash: types.NewPendingSlash(0, 1, 1, sdkmath.LegacyNewDecWithPrec(1, 1), 10, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "invalid service id returns error",
+			pendingSlash: types.NewPendingSlash(1, 0, 1, sdkmath.LegacyNewDecWithPrec(1, 1), 10, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "invalid operator id returns error",
+			pendingSlash: types.NewPendingSlash(1, 1, 0, sdkmath.LegacyNewDecWithPrec(1, 1), 10, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "zero fraction returns error",
+			pendingSlash: types.NewPendingSlash(1, 1, 1, sdkmath.LegacyZeroDec(), 10, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "fraction greater than one returns error",
+			pendingSlash: types.NewPendingSlash(1, 1, 1, sdkmath.LegacyNewDec(2), 10, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "negative infraction height returns error",
+			pendingSlash: types.NewPendingSlash(1, 1, 1, sdkmath.LegacyNewDecWithPrec(1, 1), -1, executionTime),
+			shouldErr:    true,
+		},
+		{
+			name:         "valid pending slash returns no error",
+			pendingSlash: types.NewPendingSlash(1, 1, 1, sdkmath.LegacyNewDecWithPrec(1, 1), 10, executionTime),
+			shouldErr:    false,
+		},
+		{
+			name: "valid pending tombstone with zero fraction returns no error",
+			pendingSlash: types.PendingSlash{
+				ID:               1,
+				ServiceID:        1,
+				OperatorID:       1,
+				Fraction:         sdkmath.LegacyZeroDec(),
+				InfractionHeight: 10,
+				ExecutionTime:    executionTime,
+				Tombstone:        true,
+			},
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pendingSlash.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/circl/sign/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+)
+
+const (
+	// blsPublicKeySize is the size of a compressed BLS12-381 public key in G1
+	blsPublicKeySize = 48
+
+	// operatorServiceKeyPossessionPrefix is the prefix of the message that must
+	// be signed in order to prove the possession of an operator service key
+	operatorServiceKeyPossessionPrefix = "milkyway/restaking/operator_service_key"
+)
+
+// NewOperatorServiceKey returns a new OperatorServiceKey instance
+func NewOperatorServiceKey(
+	operatorID uint32, serviceID uint32, keyType KeyType, publicKey []byte, activationTime time.Time,
+) OperatorServiceKey {
+	return OperatorServiceKey{
+		OperatorID:     operatorID,
+		ServiceID:      serviceID,
+		KeyType:        keyType,
+		PublicKey:      publicKey,
+		ActivationTime: activationTime,
+	}
+}
+
+// Validate validates the operator service key
+func (k OperatorServiceKey) Validate() error {
+	if k.OperatorID == 0 {
+		return fmt.Errorf("invalid operator id")
+	}
+
+	if k.ServiceID == 0 {
+		return fmt.Errorf("invalid service id")
+	}
+
+	return ValidatePublicKey(k.KeyType, k.PublicKey)
+}
+
+// IsActive returns true if the key is used at the given time
+func (k OperatorServiceKey) IsActive(blockTime time.Time) bool {
+	return !blockTime.Before(k.ActivationTime)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatePublicKey checks that the given public key is a valid encoded key of
+// the given type
+func ValidatePublicKey(keyType KeyType, publicKey []byte) error {
+	switch keyType {
+	case KEY_TYPE_ED25519:
+		if len(publicKey) != ed25519.PubKeySize {
+			return fmt.Errorf("invalid ed25519 public key size: %d", len(publicKey))
+		}
+
+	case KEY_TYPE_SECP256K1:
+		if len(publicKey) != secp256k1.PubKeySize {
+			return fmt.Errorf("invalid secp256k1 public key size: %d", len(publicKey))
+		}
+
+		if publicKey[0] != 0x02 && publicKey[0] != 0x03 {
+			return fmt.Errorf("secp256k1 public key must be compressed")
+		}
+
+	case KEY_TYPE_BLS12_381:
+		if len(publicKey) != blsPublicKeySize {
+			return fmt.Errorf("invalid bls12-381 public key size: %d", len(publicKey))
+		}
+
+		var key bls.PublicKey[bls.KeyG1SigG2]
+		if err := key.UnmarshalBinary(publicKey); err != nil || !key.Validate() {
+			return fmt.Errorf("invalid bls12-381 public key")
+		}
+
+	default:
+		return fmt.Errorf("invalid key type: %s", keyType)
+	}
+
+	return nil
+}
+
+// GetOperatorServiceKeyPossessionMessage returns the message that must be
+// signed with the given key in order to prove its possession when registering
+// it for the given operator and service. The message binds the key to the
+// chain, the operator and the service, so that the proof cannot be replayed
+func GetOperatorServiceKeyPossessionMessage(
+	chainID string, operatorID uint32, serviceID uint32, keyType KeyType, publicKey []byte,
+) []byte {
+	bz := []byte(operatorServiceKeyPossessionPrefix)
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(len(chainID)))...)
+	bz = append(bz, chainID...)
+	bz = append(bz, utils.Uint32ToBigEndian(operatorID)...)
+	bz = append(bz, utils.Uint32ToBigEndian(serviceID)...)
+	bz = append(bz, byte(keyType))
+	bz = append(bz, publicKey...)
+
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// VerifyProofOfPossession returns true if the given signature of the message
+// is valid for the given public key. The public key must have been validated
+// using ValidatePublicKey
+func VerifyProofOfPossession(keyType KeyType, publicKey []byte, msg []byte, signature []byte) bool {
+	switch keyType {
+	case KEY_TYPE_ED25519:
+		key := ed25519.PubKey{Key: publicKey}
+		return key.VerifySignature(msg, signature)
+
+	case KEY_TYPE_SECP256K1:
+		key := secp256k1.PubKey{Key: publicKey}
+		return key.VerifySignature(msg, signature)
+
+	case KEY_TYPE_BLS12_381:
+		var key bls.PublicKey[bls.KeyG1SigG2]
+		if err := key.UnmarshalBinary(publicKey); err != nil {
+			return false
+		}
+		return bls.Verify(&key, msg, signature)
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,287 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/circl/sign/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+)
+
+// generateBLSKey generates a new BLS12-381 key returning the private key and
+// the encoded public key
+func generateBLSKey(t *testing.T) (*bls.PrivateKey[bls.KeyG1SigG2], []byte) {
+	ikm := make([]byte, 32)
+	_, err := rand.Read(ikm)
+	require.NoError(t, err)
+
+	privKey, err := bls.KeyGen[bls.KeyG1SigG2](ikm, nil, nil)
+	require.NoError(t, err)
+
+	publicKey, err := privKey.PublicKey().MarshalBinary()
+	require.NoError(t, err)
+
+	return privKey, publicKey
+}
+
+func TestOperatorServiceKey_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		key       types.OperatorServiceKey
+		shouldErr bool
+	}{
+		{
+			name: "invalid operator id returns error",
+			key: types.NewOperatorServiceKey(
+				0,
+				1,
+				types.KEY_TYPE_ED25519,
+				bytes.Repeat([]byte{1}, 32),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid service id returns error",
+			key: types.NewOperatorServiceKey(
+				1,
+				0,
+				types.KEY_TYPE_ED25519,
+				bytes.Repeat([]byte{1}, 32),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid public key returns error",
+			key: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				bytes.Repeat([]byte{1}, 31),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid key returns no error",
+			key: types.NewOperatorServiceKey(
+				1,
+				1,
+				types.KEY_TYPE_ED25519,
+				bytes.Repeat([]byte{1}, 32),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.key.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOperatorServiceKey_IsActive(t *testing.T) {
+	key := types.NewOperatorServiceKey(
+		1,
+		1,
+		types.KEY_TYPE_ED25519,
+		bytes.Repeat([]byte{1}, 32),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	require.False(t, key.IsActive(time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)))
+	require.True(t, key.IsActive(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, key.IsActive(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	_, blsPublicKey := generateBLSKey(t)
+
+	testCases := []struct {
+		name      string
+		keyType   types.KeyType
+		publicKey []byte
+		shouldErr bool
+	}{
+		{
+			name:      "unspecified key type returns error",
+			keyType:   types.KEY_TYPE_UNSPECIFIED,
+			publicKey: bytes.Repeat([]byte{1}, 32),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid ed25519 key size returns error",
+			keyType:   types.KEY_TYPE_ED25519,
+			publicKey: bytes.Repeat([]byte{1}, 33),
+			shouldErr: true,
+		},
+		{
+			name:      "valid ed25519 key returns no error",
+			keyType:   types.KEY_TYPE_ED25519,
+			publicKey: ed25519.GenPrivKey().PubKey().Bytes(),
+			shouldErr: false,
+		},
+		{
+			name:      "invalid secp256k1 key size returns error",
+			keyType:   types.KEY_TYPE_SECP256K1,
+			publicKey: bytes.Repeat([]byte{2}, 65),
+			shouldErr: true,
+		},
+		{
+			name:      "uncompressed secp256k1 key returns error",
+			keyType:   types.KEY_TYPE_SECP256K1,
+			publicKey: bytes.Repeat([]byte{4}, 33),
+			shouldErr: true,
+		},
+		{
+			name:      "valid secp256k1 key returns no error",
+			keyType:   types.KEY_TYPE_SECP256K1,
+			publicKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+			shouldErr: false,
+		},
+		{
+			name:      "invalid bls12-381 key size returns error",
+			keyType:   types.KEY_TYPE_BLS12_381,
+			publicKey: bytes.Repeat([]byte{1}, 96),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid bls12-381 point returns error",
+			keyType:   types.KEY_TYPE_BLS12_381,
+			publicKey: bytes.Repeat([]byte{1}, 48),
+			shouldErr: true,
+		},
+		{
+			name:      "valid bls12-381 key returns no error",
+			keyType:   types.KEY_TYPE_BLS12_381,
+			publicKey: blsPublicKey,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidatePublicKey(tc.keyType, tc.publicKey)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetOperatorServiceKeyPossessionMessage(t *testing.T) {
+	publicKey := bytes.Repeat([]byte{1}, 32)
+	msg := types.GetOperatorServiceKeyPossessionMessage("milkyway", 1, 1, types.KEY_TYPE_ED25519, publicKey)
+	require.Len(t, msg, 32)
+
+	// Make sure the message changes with each of the bound values
+	require.NotEqual(t, msg, types.GetOperatorServiceKeyPossessionMessage("milkyway-1", 1, 1, types.KEY_TYPE_ED25519, publicKey))
+	require.NotEqual(t, msg, types.GetOperatorServiceKeyPossessionMessage("milkyway", 2, 1, types.KEY_TYPE_ED25519, publicKey))
+	require.NotEqual(t, msg, types.GetOperatorServiceKeyPossessionMessage("milkyway", 1, 2, types.KEY_TYPE_ED25519, publicKey))
+	require.NotEqual(t, msg, types.GetOperatorServiceKeyPossessionMessage("milkyway", 1, 1, types.KEY_TYPE_SECP256K1, publicKey))
+}
+
+func TestVerifyProofOfPossession(t *testing.T) {
+	msg := []byte("message")
+	otherMsg := []byte("other message")
+
+	ed25519Key := ed25519.GenPrivKey()
+	ed25519Signature, err := ed25519Key.Sign(msg)
+	require.NoError(t, err)
+
+	secp256k1Key := secp256k1.GenPrivKey()
+	secp256k1Signature, err := secp256k1Key.Sign(msg)
+	require.NoError(t, err)
+
+	blsKey, blsPublicKey := generateBLSKey(t)
+	blsSignature := bls.Sign(blsKey, msg)
+
+	testCases := []struct {
+		name      string
+		keyType   types.KeyType
+		publicKey []byte
+		msg       []byte
+		signature []byte
+		expValid  bool
+	}{
+		{
+			name:      "unspecified key type returns false",
+			keyType:   types.KEY_TYPE_UNSPECIFIED,
+			publicKey: ed25519Key.PubKey().Bytes(),
+			msg:       msg,
+			signature: ed25519Signature,
+			expValid:  false,
+		},
+		{
+			name:      "invalid ed25519 signature returns false",
+			keyType:   types.KEY_TYPE_ED25519,
+			publicKey: ed25519Key.PubKey().Bytes(),
+			msg:       otherMsg,
+			signature: ed25519Signature,
+			expValid:  false,
+		},
+		{
+			name:      "valid ed25519 signature returns true",
+			keyType:   types.KEY_TYPE_ED25519,
+			publicKey: ed25519Key.PubKey().Bytes(),
+			msg:       msg,
+			signature: ed25519Signature,
+			expValid:  true,
+		},
+		{
+			name:      "invalid secp256k1 signature returns false",
+			keyType:   types.KEY_TYPE_SECP256K1,
+			publicKey: secp256k1Key.PubKey().Bytes(),
+			msg:       otherMsg,
+			signature: secp256k1Signature,
+			expValid:  false,
+		},
+		{
+			name:      "valid secp256k1 signature returns true",
+			keyType:   types.KEY_TYPE_SECP256K1,
+			publicKey: secp256k1Key.PubKey().Bytes(),
+			msg:       msg,
+			signature: secp256k1Signature,
+			expValid:  true,
+		},
+		{
+			name:      "invalid bls12-381 signature returns false",
+			keyType:   types.KEY_TYPE_BLS12_381,
+			publicKey: blsPublicKey,
+			msg:       otherMsg,
+			signature: blsSignature,
+			expValid:  false,
+		},
+		{
+			name:      "valid bls12-381 signature returns true",
+			keyType:   types.KEY_TYPE_BLS12_381,
+			publicKey: blsPublicKey,
+			msg:       msg,
+			signature: blsSignature,
+			expValid:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			valid := types.VerifyProofOfPossession(tc.keyType, tc.publicKey, tc.msg, tc.signature)
+			require.Equal(t, tc.expValid, valid)
+		})
+	}
+}
@@ -11,6 +11,19 @@ import (
 const (
 	DefaultUnbondingTime = 3 * 24 * time.Hour
 	DefaultMaxEntries    = 7
+
+	DefaultDowntimeJailDuration = 10 * time.Minute
+
+	DefaultSlashVetoWindow           = 2 * 24 * time.Hour
+	DefaultAccreditedSlashVetoWindow = 24 * time.Hour
+
+	DefaultOperatorServiceKeyRotationDelay = 24 * time.Hour
+
+	DefaultMaxServiceUnbondingTime = 28 * 24 * time.Hour
+
+	DefaultActiveOperatorsRefreshInterval = 100
+
+	DefaultMaxEvidenceAge = 8640
 )
 
 var (
@@ -23,18 +36,50 @@ func NewParams(
 	allowedDenoms []string,
 	restakingCap math.LegacyDec,
 	maxEntries uint32,
+	slashedFundsRecipient string,
+	downtimeJailDuration time.Duration,
+	slashVetoWindow time.Duration,
+	accreditedSlashVetoWindow time.Duration,
+	slashVetoCommittee string,
+	operatorServiceKeyRotationDelay time.Duration,
+	maxServiceUnbondingTime time.Duration,
+	activeOperatorsRefreshInterval uint64,
+	maxEvidenceAge uint64,
 ) Params {
 	return Params{
-		UnbondingTime: unbondingTime,
-		AllowedDenoms: allowedDenoms,
-		RestakingCap:  restakingCap,
-		MaxEntries:    maxEntries,
+		UnbondingTime:                   unbondingTime,
+		AllowedDenoms:                   allowedDenoms,
+		RestakingCap:                    restakingCap,
+		MaxEntries:                      maxEntries,
+		SlashedFundsRecipient:           slashedFundsRecipient,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashVetoWindow:                 slashVetoWindow,
+		AccreditedSlashVetoWindow:       accreditedSlashVetoWindow,
+		SlashVetoCommittee:              slashVetoCommittee,
+		OperatorServiceKeyRotationDelay: operatorServiceKeyRotationDelay,
+		MaxServiceUnbondingTime:         maxServiceUnbondingTime,
+		ActiveOperatorsRefreshInterval:  activeOperatorsRefreshInterval,
+		MaxEvidenceAge:                  maxEvidenceAge,
 	}
 }
 
 // DefaultParams return a Params instance with default values set
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, nil, DefaultRestakingCap, DefaultMaxEntries)
+	return NewParams(
+		DefaultUnbondingTime,
+		nil,
+		DefaultRestakingCap,
+		DefaultMaxEntries,
+		"",
+		DefaultDowntimeJailDuration,
+		DefaultSlashVetoWindow,
+		DefaultAccreditedSlashVetoWindow,
+		"",
+		DefaultOperatorServiceKeyRotationDelay,
+		DefaultMaxServiceUnbondingTime,
+		DefaultActiveOperatorsRefreshInterval,
+		DefaultMaxEvidenceAge,
+	)
 }
 
 // Validate performs basic validation of params
@@ -58,5 +103,81 @@ func (p *Params) Validate() error {
 		return fmt.Errorf("max entries must be positive: %d", p.MaxEntries)
 	}
 
+	if p.SlashedFundsRecipient != "" {
+		_, err := sdk.AccAddressFromBech32(p.SlashedFundsRecipient)
+		if err != nil {
+			return fmt.Errorf("invalid slashed funds recipient: %w", err)
+		}
+	}
+
+	if p.DowntimeJailDuration < 0 {
+		return fmt.Errorf("downtime jail duration cannot be negative: %s", p.DowntimeJailDuration)
+	}
+
+	if p.SlashVetoWindow < 0 {
+		return fmt.Errorf("slash veto window cannot be negative: %s", p.SlashVetoWindow)
+	}
+
+	if p.AccreditedSlashVetoWindow < 0 {
+		return fmt.Errorf("accredited slash veto window cannot be negative: %s", p.AccreditedSlashVetoWindow)
+	}
+
+	if p.AccreditedSlashVetoWindow > p.SlashVetoWindow {
+		return fmt.Errorf("accredited slash veto window cannot be greater than slash veto window: %s > %s",
+			p.AccreditedSlashVetoWindow, p.SlashVetoWindow)
+	}
+
+	// Services can not set an unbonding time lower than the veto window, so the
+	// max service unbonding time must be able to accommodate both windows
+	if p.MaxServiceUnbondingTime < p.SlashVetoWindow || p.MaxServiceUnbondingTime < p.AccreditedSlashVetoWindow {
+		return fmt.Errorf("max service unbonding time cannot be lower than slash veto windows: %s < %s",
+			p.MaxServiceUnbondingTime, p.MinServiceUnbondingTime())
+	}
+
+	if p.SlashVetoCommittee != "" {
+		_, err := sdk.AccAddressFromBech32(p.SlashVetoCommittee)
+		if err != nil {
+			return fmt.Errorf("invalid slash veto committee: %w", err)
+		}
+	}
+
+	if p.OperatorServiceKeyRotationDelay < 0 {
+		return fmt.Errorf("operator service key rotation delay cannot be negative: %s", p.OperatorServiceKeyRotationDelay)
+	}
+
+	if p.UnbondingTime < p.MinServiceUnbondingTime() {
+		return fmt.Errorf("unbonding time cannot be lower than slash veto window: %s < %s",
+			p.UnbondingTime, p.MinServiceUnbondingTime())
+	}
+
+	if p.MaxServiceUnbondingTime < p.UnbondingTime {
+		return fmt.Errorf("max service unbonding time cannot be lower than unbonding time: %s < %s",
+			p.MaxServiceUnbondingTime, p.UnbondingTime)
+	}
+
+	if p.ActiveOperatorsRefreshInterval == 0 {
+		return fmt.Errorf("active operators refresh interval must be positive: %d", p.ActiveOperatorsRefreshInterval)
+	}
+
+	if p.MaxEvidenceAge == 0 {
+		return fmt.Errorf("max evidence age must be positive: %d", p.MaxEvidenceAge)
+	}
+
 	return nil
 }
+
+// MinServiceUnbondingTime returns the minimum unbonding time that can be set by
+// a service. It matches the slash veto window, so that the delegations securing
+// a service can still be slashed when a pending slash is executed
+func (p *Params) MinServiceUnbondingTime() time.Duration {
+	return p.SlashVetoWindow
+}
+
+// SlashVetoWindowForService returns the veto window that should be applied to the
+// slashes requested by a service, based on whether it is accredited or not
+func (p *Params) SlashVetoWindowForService(accredited bool) time.Duration {
+	if accredited {
+		return p.AccreditedSlashVetoWindow
+	}
+	return p.SlashVetoWindow
+}
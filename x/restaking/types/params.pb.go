@@ -44,6 +44,44 @@ type Params struct {
 	// MaxEntries represents the maximum number of entries for unbonding
 	// delegation.
 	MaxEntries uint32 `protobuf:"varint,4,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// SlashedFundsRecipient represents the address to which the slashed funds
+	// will be sent. If empty, the slashed funds will be burned.
+	SlashedFundsRecipient string `protobuf:"bytes,5,opt,name=slashed_funds_recipient,json=slashedFundsRecipient,proto3" json:"slashed_funds_recipient,omitempty"`
+	// DowntimeJailDuration represents the time for which an operator is jailed
+	// from a service due to downtime. After this period, the operator can unjail
+	// itself from the service.
+	DowntimeJailDuration time.Duration `protobuf:"varint,6,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration,omitempty"`
+	// SlashVetoWindow represents the time that must pass between the moment in
+	// which a slash is requested and the moment in which it is executed. During
+	// this period, the slash can be vetoed.
+	SlashVetoWindow time.Duration `protobuf:"varint,7,opt,name=slash_veto_window,json=slashVetoWindow,proto3,stdduration" json:"slash_veto_window,omitempty"`
+	// AccreditedSlashVetoWindow represents the slash veto window that is applied
+	// to the slashes requested by accredited services. It cannot be greater
+	// than SlashVetoWindow.
+	AccreditedSlashVetoWindow time.Duration `protobuf:"varint,8,opt,name=accredited_slash_veto_window,json=accreditedSlashVetoWindow,proto3,stdduration" json:"accredited_slash_veto_window,omitempty"`
+	// SlashVetoCommittee represents the address that, together with the module
+	// authority, is allowed to veto the pending slashes. If empty, only the
+	// module authority can veto the pending slashes.
+	SlashVetoCommittee string `protobuf:"bytes,9,opt,name=slash_veto_committee,json=slashVetoCommittee,proto3" json:"slash_veto_committee,omitempty"`
+	// OperatorServiceKeyRotationDelay represents the time that must pass
+	// between the moment in which an operator registers a new key for a service
+	// and the moment in which it replaces the current one.
+	OperatorServiceKeyRotationDelay time.Duration `protobuf:"varint,10,opt,name=operator_service_key_rotation_delay,json=operatorServiceKeyRotationDelay,proto3,stdduration" json:"operator_service_key_rotation_delay,omitempty"`
+	// MaxServiceUnbondingTime represents the maximum unbonding time that is
+	// applied to the delegations securing a service. Services setting a longer
+	// unbonding time inside their params are capped to this value. It cannot be
+	// lower than UnbondingTime.
+	MaxServiceUnbondingTime time.Duration `protobuf:"varint,11,opt,name=max_service_unbonding_time,json=maxServiceUnbondingTime,proto3,stdduration" json:"max_service_unbonding_time,omitempty"`
+	// ActiveOperatorsRefreshInterval represents the number of blocks after which
+	// the active operators of all the services are recomputed. In between, only
+	// the services whose operators set might have changed are recomputed.
+	ActiveOperatorsRefreshInterval uint64 `protobuf:"varint,12,opt,name=active_operators_refresh_interval,json=activeOperatorsRefreshInterval,proto3" json:"active_operators_refresh_interval,omitempty"`
+	// MaxEvidenceAge represents the maximum number of blocks that can pass
+	// between the height at which a misbehavior occurred and the moment in which
+	// its evidence is submitted. It should correspond to a period shorter than
+	// UnbondingTime minus SlashVetoWindow, so that the stake that was unbonding
+	// at the time of the misbehavior can still be slashed.
+	MaxEvidenceAge uint64 `protobuf:"varint,13,opt,name=max_evidence_age,json=maxEvidenceAge,proto3" json:"max_evidence_age,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -100,6 +138,69 @@ func (m *Params) GetMaxEntries() uint32 {
 	return 0
 }
 
+func (m *Params) GetSlashedFundsRecipient() string {
+	if m != nil {
+		return m.SlashedFundsRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetSlashVetoWindow() time.Duration {
+	if m != nil {
+		return m.SlashVetoWindow
+	}
+	return 0
+}
+
+func (m *Params) GetAccreditedSlashVetoWindow() time.Duration {
+	if m != nil {
+		return m.AccreditedSlashVetoWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSlashVetoCommittee() string {
+	if m != nil {
+		return m.SlashVetoCommittee
+	}
+	return ""
+}
+
+func (m *Params) GetOperatorServiceKeyRotationDelay() time.Duration {
+	if m != nil {
+		return m.OperatorServiceKeyRotationDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxServiceUnbondingTime() time.Duration {
+	if m != nil {
+		return m.MaxServiceUnbondingTime
+	}
+	return 0
+}
+
+func (m *Params) GetActiveOperatorsRefreshInterval() uint64 {
+	if m != nil {
+		return m.ActiveOperatorsRefreshInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEvidenceAge() uint64 {
+	if m != nil {
+		return m.MaxEvidenceAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "milkyway.restaking.v1.Params")
 }
@@ -109,28 +210,46 @@ func init() {
 }
 
 var fileDescriptor_342e630197fca2bb = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
-	0x00, 0xc5, 0x1b, 0x37, 0x06, 0x8b, 0x76, 0x87, 0xa2, 0x50, 0x27, 0xb4, 0x65, 0x20, 0x14, 0x64,
-	0x0d, 0xd5, 0x83, 0xf7, 0x39, 0x6f, 0x1e, 0xb4, 0x88, 0x07, 0x2f, 0x25, 0x6d, 0x43, 0x17, 0xd6,
-	0x34, 0xa5, 0xc9, 0xfe, 0xf4, 0x5b, 0x78, 0xf4, 0x83, 0xf8, 0x21, 0x76, 0x1c, 0x82, 0x20, 0x1e,
-	0xa6, 0xac, 0x5f, 0x44, 0x6c, 0xb7, 0xe2, 0x2d, 0xef, 0x97, 0x97, 0xc7, 0xcb, 0x83, 0x03, 0x46,
-	0x93, 0x69, 0xb1, 0xc0, 0x05, 0xca, 0x89, 0x90, 0x78, 0x4a, 0xd3, 0x18, 0xcd, 0x5d, 0x94, 0xe1,
-	0x1c, 0x33, 0xe1, 0x64, 0x39, 0x97, 0x5c, 0x3b, 0xd9, 0x7b, 0x9c, 0xc6, 0xe3, 0xcc, 0xdd, 0xfe,
-	0x69, 0xc8, 0x05, 0xe3, 0xc2, 0xaf, 0x4c, 0xa8, 0x16, 0xf5, 0x8b, 0xfe, 0x71, 0xcc, 0x63, 0x5e,
-	0xf3, 0xbf, 0x53, 0x4d, 0x07, 0x1f, 0x00, 0x76, 0xee, 0xab, 0x60, 0xed, 0x02, 0xf6, 0x66, 0x69,
-	0xc0, 0xd3, 0x88, 0xa6, 0xb1, 0x2f, 0x29, 0x23, 0x3a, 0xb0, 0x80, 0xdd, 0x1a, 0xb5, 0x5f, 0xbf,
-	0x4d, 0xe0, 0xa9, 0xcd, 0xdd, 0x23, 0x65, 0x44, 0x3b, 0x87, 0x3d, 0x9c, 0x24, 0x7c, 0x41, 0x22,
-	0x3f, 0x22, 0x29, 0x67, 0x42, 0x3f, 0xb0, 0x5a, 0x76, 0xd7, 0x53, 0x77, 0x74, 0x5c, 0x41, 0xed,
-	0x09, 0xaa, 0x4d, 0x3f, 0x3f, 0xc4, 0x99, 0xde, 0xb2, 0x80, 0xdd, 0x1d, 0xb9, 0xab, 0x8d, 0xa9,
-	0x7c, 0x6d, 0xcc, 0xb3, 0xba, 0xa1, 0x88, 0xa6, 0x0e, 0xe5, 0x88, 0x61, 0x39, 0x71, 0xee, 0x48,
-	0x8c, 0xc3, 0x62, 0x4c, 0xc2, 0xf7, 0xb7, 0x21, 0xdc, 0x7d, 0x60, 0x4c, 0x42, 0xef, 0xa8, 0xc9,
-	0xb9, 0xc1, 0x99, 0x66, 0xc2, 0x43, 0x86, 0x97, 0x3e, 0x49, 0x65, 0x4e, 0x89, 0xd0, 0xdb, 0x16,
-	0xb0, 0x55, 0x0f, 0x32, 0xbc, 0xbc, 0xad, 0xc9, 0xe8, 0x61, 0xb5, 0x35, 0xc0, 0x7a, 0x6b, 0x80,
-	0x9f, 0xad, 0x01, 0x5e, 0x4a, 0x43, 0x59, 0x97, 0x86, 0xf2, 0x59, 0x1a, 0xca, 0xf3, 0x75, 0x4c,
-	0xe5, 0x64, 0x16, 0x38, 0x21, 0x67, 0x68, 0x3f, 0xe2, 0x30, 0xc1, 0x81, 0x68, 0x14, 0x9a, 0xbb,
-	0x97, 0x68, 0xf9, 0x6f, 0x7c, 0x59, 0x64, 0x44, 0x04, 0x9d, 0x6a, 0xb1, 0xab, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc2, 0xf0, 0x85, 0x52, 0x9f, 0x01, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x36, 0x0a, 0xf3, 0xd6, 0x01, 0x51, 0xc7, 0xb2, 0x81, 0xd2, 0x32, 0x84, 0x54,
+	0x09, 0xad, 0xa1, 0x70, 0x40, 0xe2, 0xb6, 0xad, 0x43, 0xda, 0x40, 0x62, 0x64, 0x30, 0x24, 0x2e,
+	0x96, 0x1b, 0xbf, 0x4b, 0x4d, 0x13, 0x3b, 0xb2, 0xdd, 0xb4, 0xf9, 0x16, 0x1c, 0xf9, 0x20, 0xfb,
+	0x10, 0x3b, 0x4e, 0x3b, 0x21, 0x0e, 0x03, 0x6d, 0x5f, 0x04, 0xe5, 0x5f, 0x09, 0xe3, 0xc0, 0x2d,
+	0x7e, 0xfc, 0x7b, 0x9e, 0xbc, 0xb6, 0xdf, 0x17, 0x6d, 0x84, 0x2c, 0x18, 0x25, 0x13, 0x92, 0x38,
+	0x12, 0x94, 0x26, 0x23, 0xc6, 0x7d, 0x27, 0xee, 0x39, 0x11, 0x91, 0x24, 0x54, 0xdd, 0x48, 0x0a,
+	0x2d, 0xcc, 0x95, 0x92, 0xe9, 0xce, 0x98, 0x6e, 0xdc, 0x5b, 0x5f, 0xf3, 0x84, 0x0a, 0x85, 0xc2,
+	0x19, 0xe4, 0xe4, 0x8b, 0xdc, 0xb1, 0xde, 0xf4, 0x85, 0x2f, 0x72, 0x3d, 0xfd, 0xca, 0xd5, 0x8d,
+	0x93, 0x3a, 0xaa, 0x1f, 0x64, 0xc1, 0xe6, 0x53, 0xb4, 0x3c, 0xe6, 0x03, 0xc1, 0x29, 0xe3, 0x3e,
+	0xd6, 0x2c, 0x04, 0xcb, 0x68, 0x1b, 0x9d, 0xb9, 0xed, 0xf9, 0x6f, 0x3f, 0x5b, 0x86, 0xdb, 0x98,
+	0xed, 0x7d, 0x60, 0x21, 0x98, 0x4f, 0xd0, 0x32, 0x09, 0x02, 0x31, 0x01, 0x8a, 0x29, 0x70, 0x11,
+	0x2a, 0xeb, 0x46, 0x7b, 0xae, 0xb3, 0xe0, 0x36, 0x0a, 0xb5, 0x9f, 0x89, 0xe6, 0x11, 0x6a, 0xcc,
+	0xea, 0xc3, 0x1e, 0x89, 0xac, 0xb9, 0xb6, 0xd1, 0x59, 0xd8, 0xee, 0x9d, 0x5e, 0xb4, 0x6a, 0x3f,
+	0x2e, 0x5a, 0x0f, 0xf2, 0x0a, 0x15, 0x1d, 0x75, 0x99, 0x70, 0x42, 0xa2, 0x87, 0xdd, 0xb7, 0xe0,
+	0x13, 0x2f, 0xe9, 0x83, 0x77, 0x7e, 0xb2, 0x89, 0x8a, 0x03, 0xf4, 0xc1, 0x73, 0x97, 0x66, 0x39,
+	0x3b, 0x24, 0x32, 0x5b, 0x68, 0x31, 0x24, 0x53, 0x0c, 0x5c, 0x4b, 0x06, 0xca, 0x9a, 0x6f, 0x1b,
+	0x9d, 0x86, 0x8b, 0x42, 0x32, 0xdd, 0xcd, 0x15, 0xf3, 0x00, 0xad, 0xaa, 0x80, 0xa8, 0x21, 0x50,
+	0x7c, 0x3c, 0xe6, 0x54, 0x61, 0x09, 0x1e, 0x8b, 0x18, 0x70, 0x6d, 0xdd, 0xcc, 0x4a, 0xb0, 0xce,
+	0x4f, 0x36, 0x9b, 0x45, 0xfe, 0x16, 0xa5, 0x12, 0x94, 0x3a, 0xd4, 0x92, 0x71, 0xdf, 0x5d, 0x29,
+	0x8c, 0xaf, 0x53, 0x9f, 0x5b, 0xda, 0xcc, 0x57, 0xe8, 0x3e, 0x15, 0x13, 0x9e, 0x5e, 0x0c, 0xfe,
+	0x42, 0x58, 0x80, 0xe9, 0x58, 0x12, 0xcd, 0x04, 0xb7, 0xea, 0x95, 0x6b, 0x6a, 0x96, 0xcc, 0x3e,
+	0x61, 0x41, 0xbf, 0x20, 0xcc, 0x67, 0xe8, 0x5e, 0x16, 0x8a, 0x63, 0xd0, 0x02, 0x4f, 0x18, 0xa7,
+	0x62, 0x62, 0xdd, 0xaa, 0xd8, 0xee, 0x64, 0xdb, 0x47, 0xa0, 0xc5, 0xa7, 0x6c, 0xd3, 0xdc, 0x45,
+	0x0f, 0x89, 0xe7, 0x49, 0xa0, 0x4c, 0x03, 0xc5, 0xff, 0x9a, 0x6f, 0x57, 0xcc, 0x6b, 0x7f, 0xc8,
+	0xc3, 0x6b, 0x31, 0xfb, 0xa8, 0x59, 0xf1, 0x7a, 0x22, 0x0c, 0x99, 0xd6, 0x00, 0xd6, 0xc2, 0x7f,
+	0xee, 0xc0, 0x9c, 0xd5, 0xb3, 0x53, 0x7a, 0x4c, 0x17, 0x3d, 0x16, 0x11, 0x48, 0xa2, 0x85, 0xc4,
+	0x0a, 0x64, 0xcc, 0x3c, 0xc0, 0x23, 0x48, 0xb0, 0x14, 0x3a, 0x3b, 0x25, 0xa6, 0x10, 0x90, 0xc4,
+	0x42, 0x95, 0xca, 0x5a, 0xa5, 0xe1, 0x30, 0xe7, 0xdf, 0x40, 0xe2, 0x16, 0x74, 0x3f, 0x85, 0xcd,
+	0x2d, 0xb4, 0x9e, 0xbe, 0x63, 0x19, 0x77, 0xad, 0xff, 0x16, 0x2b, 0x51, 0xab, 0x21, 0x99, 0x16,
+	0x29, 0x1f, 0xff, 0xea, 0xc4, 0x3d, 0xf4, 0x88, 0x78, 0x9a, 0xc5, 0x80, 0xcb, 0x9f, 0xa5, 0x8f,
+	0x7d, 0x2c, 0x41, 0x0d, 0x31, 0xe3, 0x1a, 0x64, 0x4c, 0x02, 0x6b, 0xa9, 0x6d, 0x74, 0xe6, 0x5d,
+	0x3b, 0x07, 0xdf, 0x95, 0x9c, 0x9b, 0x63, 0x7b, 0x05, 0x65, 0x76, 0xd0, 0xdd, 0xac, 0xab, 0x62,
+	0x46, 0x81, 0x7b, 0x80, 0x89, 0x0f, 0x56, 0x23, 0x73, 0x2e, 0xa7, 0xad, 0x55, 0xc8, 0x5b, 0x3e,
+	0x6c, 0xbf, 0x3f, 0xbd, 0xb4, 0x8d, 0xb3, 0x4b, 0xdb, 0xf8, 0x75, 0x69, 0x1b, 0x5f, 0xaf, 0xec,
+	0xda, 0xd9, 0x95, 0x5d, 0xfb, 0x7e, 0x65, 0xd7, 0x3e, 0xbf, 0xf4, 0x99, 0x1e, 0x8e, 0x07, 0x5d,
+	0x4f, 0x84, 0x4e, 0x39, 0xa3, 0x9b, 0x01, 0x19, 0xa8, 0xd9, 0xca, 0x89, 0x7b, 0xcf, 0x9d, 0x69,
+	0x65, 0xb6, 0x75, 0x12, 0x81, 0x1a, 0xd4, 0xb3, 0x81, 0x7c, 0xf1, 0x7b, 0x00, 0x5e, 0x1d, 0xc3,
+	0x24, 0xfe, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +272,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEvidenceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEvidenceAge))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ActiveOperatorsRefreshInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActiveOperatorsRefreshInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxServiceUnbondingTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxServiceUnbondingTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OperatorServiceKeyRotationDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OperatorServiceKeyRotationDelay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SlashVetoCommittee) > 0 {
+		i -= len(m.SlashVetoCommittee)
+		copy(dAtA[i:], m.SlashVetoCommittee)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SlashVetoCommittee)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AccreditedSlashVetoWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccreditedSlashVetoWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashVetoWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashVetoWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DowntimeJailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DowntimeJailDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SlashedFundsRecipient) > 0 {
+		i -= len(m.SlashedFundsRecipient)
+		copy(dAtA[i:], m.SlashedFundsRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SlashedFundsRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MaxEntries != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntries))
 		i--
@@ -216,6 +384,35 @@ func (m *Params) Size() (n int) {
 	if m.MaxEntries != 0 {
 		n += 1 + sovParams(uint64(m.MaxEntries))
 	}
+	l = len(m.SlashedFundsRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DowntimeJailDuration != 0 {
+		n += 1 + sovParams(uint64(m.DowntimeJailDuration))
+	}
+	if m.SlashVetoWindow != 0 {
+		n += 1 + sovParams(uint64(m.SlashVetoWindow))
+	}
+	if m.AccreditedSlashVetoWindow != 0 {
+		n += 1 + sovParams(uint64(m.AccreditedSlashVetoWindow))
+	}
+	l = len(m.SlashVetoCommittee)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.OperatorServiceKeyRotationDelay != 0 {
+		n += 1 + sovParams(uint64(m.OperatorServiceKeyRotationDelay))
+	}
+	if m.MaxServiceUnbondingTime != 0 {
+		n += 1 + sovParams(uint64(m.MaxServiceUnbondingTime))
+	}
+	if m.ActiveOperatorsRefreshInterval != 0 {
+		n += 1 + sovParams(uint64(m.ActiveOperatorsRefreshInterval))
+	}
+	if m.MaxEvidenceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxEvidenceAge))
+	}
 	return n
 }
 
@@ -358,6 +555,203 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFundsRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedFundsRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			m.DowntimeJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailDuration |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashVetoWindow", wireType)
+			}
+			m.SlashVetoWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashVetoWindow |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedSlashVetoWindow", wireType)
+			}
+			m.AccreditedSlashVetoWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccreditedSlashVetoWindow |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashVetoCommittee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashVetoCommittee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorServiceKeyRotationDelay", wireType)
+			}
+			m.OperatorServiceKeyRotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorServiceKeyRotationDelay |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServiceUnbondingTime", wireType)
+			}
+			m.MaxServiceUnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxServiceUnbondingTime |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOperatorsRefreshInterval", wireType)
+			}
+			m.ActiveOperatorsRefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveOperatorsRefreshInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvidenceAge", wireType)
+			}
+			m.MaxEvidenceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEvidenceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -18,27 +18,116 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{
 			name:      "invalid unbonding time returns error",
-			params:    types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries),
+			params:    types.NewParams(0, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid denom returns error",
-			params:    types.NewParams(5, []string{"1denom"}, types.DefaultRestakingCap, types.DefaultMaxEntries),
+			params:    types.NewParams(5, []string{"1denom"}, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			shouldErr: true,
 		},
 		{
 			name:      "empty denom returns error",
-			params:    types.NewParams(5, []string{""}, types.DefaultRestakingCap, types.DefaultMaxEntries),
+			params:    types.NewParams(5, []string{""}, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			shouldErr: true,
 		},
 		{
 			name:      "negative restaking cap returns error",
-			params:    types.NewParams(5, nil, math.LegacyNewDec(-1), types.DefaultMaxEntries),
+			params:    types.NewParams(5, nil, math.LegacyNewDec(-1), types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			shouldErr: true,
 		},
 		{
 			name:      "zero max entries returns error",
-			params:    types.NewParams(5, nil, types.DefaultRestakingCap, 0),
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, 0, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid slashed funds recipient returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "invalid", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "negative downtime jail duration returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", -1, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "negative slash veto window returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, -1, 0, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "negative accredited slash veto window returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, -1, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "accredited slash veto window greater than slash veto window returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, time.Hour, 2*time.Hour, "", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid slash veto committee returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "invalid", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name:      "negative operator service key rotation delay returns error",
+			params:    types.NewParams(5, nil, types.DefaultRestakingCap, types.DefaultMaxEntries, "", types.DefaultDowntimeJailDuration, types.DefaultSlashVetoWindow, types.DefaultAccreditedSlashVetoWindow, "", -1, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
+			shouldErr: true,
+		},
+		{
+			name: "max service unbonding time lower than unbonding time returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MaxServiceUnbondingTime = params.UnbondingTime - time.Hour
+				return params
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "max service unbonding time lower than slash veto window returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MaxServiceUnbondingTime = params.SlashVetoWindow - time.Hour
+				return params
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "max service unbonding time lower than accredited slash veto window returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MaxServiceUnbondingTime = params.AccreditedSlashVetoWindow - time.Hour
+				return params
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "unbonding time lower than slash veto window returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.UnbondingTime = params.SlashVetoWindow - time.Hour
+				return params
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "zero active operators refresh interval returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.ActiveOperatorsRefreshInterval = 0
+				return params
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "zero max evidence age returns error",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MaxEvidenceAge = 0
+				return params
+			}(),
 			shouldErr: true,
 		},
 		{
@@ -48,7 +137,7 @@ func TestParams_Validate(t *testing.T) {
 		},
 		{
 			name:      "valid params return no error",
-			params:    types.NewParams(5*time.Hour, nil, math.LegacyNewDec(100000), types.DefaultMaxEntries),
+			params:    types.NewParams(5*time.Hour, nil, math.LegacyNewDec(100000), types.DefaultMaxEntries, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.DefaultDowntimeJailDuration, 4*time.Hour, 2*time.Hour, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.DefaultOperatorServiceKeyRotationDelay, types.DefaultMaxServiceUnbondingTime, types.DefaultActiveOperatorsRefreshInterval, types.DefaultMaxEvidenceAge),
 			shouldErr: false,
 		},
 	}
@@ -54,6 +54,41 @@ func NewQueryServiceAllowedOperatorsRequest(serviceID uint32, pagination *query.
 	return &QueryServiceAllowedOperatorsRequest{ServiceId: serviceID, Pagination: pagination}
 }
 
+// NewQueryServiceActiveOperatorsRequest creates a new QueryServiceActiveOperatorsRequest instance
+func NewQueryServiceActiveOperatorsRequest(serviceID uint32, pagination *query.PageRequest) *QueryServiceActiveOperatorsRequest {
+	return &QueryServiceActiveOperatorsRequest{ServiceId: serviceID, Pagination: pagination}
+}
+
+// NewQueryServiceValidatingSetRequest creates a new QueryServiceValidatingSetRequest instance
+func NewQueryServiceValidatingSetRequest(serviceID uint32) *QueryServiceValidatingSetRequest {
+	return &QueryServiceValidatingSetRequest{ServiceId: serviceID}
+}
+
+// NewQueryServiceSnapshotRequest creates a new QueryServiceSnapshotRequest instance
+func NewQueryServiceSnapshotRequest(serviceID uint32, epoch uint64) *QueryServiceSnapshotRequest {
+	return &QueryServiceSnapshotRequest{ServiceId: serviceID, Epoch: epoch}
+}
+
+// NewQueryOperatorJailRecordRequest creates a new QueryOperatorJailRecordRequest instance
+func NewQueryOperatorJailRecordRequest(serviceID uint32, operatorID uint32) *QueryOperatorJailRecordRequest {
+	return &QueryOperatorJailRecordRequest{ServiceId: serviceID, OperatorId: operatorID}
+}
+
+// NewQueryOperatorServiceKeyRequest creates a new QueryOperatorServiceKeyRequest instance
+func NewQueryOperatorServiceKeyRequest(operatorID uint32, serviceID uint32) *QueryOperatorServiceKeyRequest {
+	return &QueryOperatorServiceKeyRequest{OperatorId: operatorID, ServiceId: serviceID}
+}
+
+// NewQueryPendingSlashRequest creates a new QueryPendingSlashRequest instance
+func NewQueryPendingSlashRequest(pendingSlashID uint64) *QueryPendingSlashRequest {
+	return &QueryPendingSlashRequest{PendingSlashId: pendingSlashID}
+}
+
+// NewQueryPendingSlashesRequest creates a new QueryPendingSlashesRequest instance
+func NewQueryPendingSlashesRequest(pagination *query.PageRequest) *QueryPendingSlashesRequest {
+	return &QueryPendingSlashesRequest{Pagination: pagination}
+}
+
 // NewQueryServiceSecuringPoolsRequest creates a new QueryServiceSecuringPoolsRequest instance
 func NewQueryServiceSecuringPoolsRequest(serviceID uint32, pagination *query.PageRequest) *QueryServiceSecuringPoolsRequest {
 	return &QueryServiceSecuringPoolsRequest{ServiceId: serviceID, Pagination: pagination}
@@ -144,6 +179,16 @@ func NewQueryDelegatorServiceRequest(delegatorAddress string, serviceID uint32)
 	return &QueryDelegatorServiceRequest{DelegatorAddress: delegatorAddress, ServiceId: serviceID}
 }
 
+// NewQueryDelegatorRedelegationsRequest creates a new QueryDelegatorRedelegationsRequest instance
+func NewQueryDelegatorRedelegationsRequest(delegatorAddress string, pagination *query.PageRequest) *QueryDelegatorRedelegationsRequest {
+	return &QueryDelegatorRedelegationsRequest{DelegatorAddress: delegatorAddress, Pagination: pagination}
+}
+
+// NewQueryTargetRedelegationsRequest creates a new QueryTargetRedelegationsRequest instance
+func NewQueryTargetRedelegationsRequest(targetType DelegationType, targetID uint32, pagination *query.PageRequest) *QueryTargetRedelegationsRequest {
+	return &QueryTargetRedelegationsRequest{TargetType: targetType, TargetId: targetID, Pagination: pagination}
+}
+
 // NewQueryUserPreferencesRequest creates a new QueryUserPreferencesRequest instance
 func NewQueryUserPreferencesRequest(userAddress string) *QueryUserPreferencesRequest {
 	return &QueryUserPreferencesRequest{UserAddress: userAddress}
@@ -372,27 +372,27 @@ func (m *QueryServiceSecuringPoolsResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
-// QueryServiceOperatorsRequest is request type for the Query/ServiceOperators
-// RPC method.
-type QueryServiceOperatorsRequest struct {
+// QueryServiceActiveOperatorsRequest is request type for the
+// Query/ServiceActiveOperators RPC method.
+type QueryServiceActiveOperatorsRequest struct {
 	// ServiceId is the ID of the service to query
 	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceOperatorsRequest) Reset()         { *m = QueryServiceOperatorsRequest{} }
-func (m *QueryServiceOperatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceOperatorsRequest) ProtoMessage()    {}
-func (*QueryServiceOperatorsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceActiveOperatorsRequest) Reset()         { *m = QueryServiceActiveOperatorsRequest{} }
+func (m *QueryServiceActiveOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceActiveOperatorsRequest) ProtoMessage()    {}
+func (*QueryServiceActiveOperatorsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{6}
 }
-func (m *QueryServiceOperatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceActiveOperatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceActiveOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceOperatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceActiveOperatorsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -402,53 +402,54 @@ func (m *QueryServiceOperatorsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceOperatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceOperatorsRequest.Merge(m, src)
+func (m *QueryServiceActiveOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceActiveOperatorsRequest.Merge(m, src)
 }
-func (m *QueryServiceOperatorsRequest) XXX_Size() int {
+func (m *QueryServiceActiveOperatorsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceOperatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceOperatorsRequest.DiscardUnknown(m)
+func (m *QueryServiceActiveOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceActiveOperatorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceOperatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceActiveOperatorsRequest proto.InternalMessageInfo
 
-func (m *QueryServiceOperatorsRequest) GetServiceId() uint32 {
+func (m *QueryServiceActiveOperatorsRequest) GetServiceId() uint32 {
 	if m != nil {
 		return m.ServiceId
 	}
 	return 0
 }
 
-func (m *QueryServiceOperatorsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceActiveOperatorsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryServiceOperatorsResponse is response type for the Query/ServiceOperators
-// RPC method.
-type QueryServiceOperatorsResponse struct {
-	// Operators is the list of operators
-	Operators []types.Operator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
+// QueryServiceActiveOperatorsResponse is response type for the
+// Query/ServiceActiveOperators RPC method.
+type QueryServiceActiveOperatorsResponse struct {
+	// OperatorIds is the list of operators that are part of the service's
+	// active operators set
+	OperatorIds []uint32 `protobuf:"varint,1,rep,packed,name=operator_ids,json=operatorIds,proto3" json:"operator_ids,omitempty"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceOperatorsResponse) Reset()         { *m = QueryServiceOperatorsResponse{} }
-func (m *QueryServiceOperatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceOperatorsResponse) ProtoMessage()    {}
-func (*QueryServiceOperatorsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceActiveOperatorsResponse) Reset()         { *m = QueryServiceActiveOperatorsResponse{} }
+func (m *QueryServiceActiveOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceActiveOperatorsResponse) ProtoMessage()    {}
+func (*QueryServiceActiveOperatorsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{7}
 }
-func (m *QueryServiceOperatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceActiveOperatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceActiveOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceOperatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceActiveOperatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,53 +459,51 @@ func (m *QueryServiceOperatorsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceOperatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceOperatorsResponse.Merge(m, src)
+func (m *QueryServiceActiveOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceActiveOperatorsResponse.Merge(m, src)
 }
-func (m *QueryServiceOperatorsResponse) XXX_Size() int {
+func (m *QueryServiceActiveOperatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceOperatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceOperatorsResponse.DiscardUnknown(m)
+func (m *QueryServiceActiveOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceActiveOperatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceOperatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceActiveOperatorsResponse proto.InternalMessageInfo
 
-func (m *QueryServiceOperatorsResponse) GetOperators() []types.Operator {
+func (m *QueryServiceActiveOperatorsResponse) GetOperatorIds() []uint32 {
 	if m != nil {
-		return m.Operators
+		return m.OperatorIds
 	}
 	return nil
 }
 
-func (m *QueryServiceOperatorsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceActiveOperatorsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryPoolDelegationsRequest is request type for the Query/PoolDelegations RPC
-// method.
-type QueryPoolDelegationsRequest struct {
-	// PoolId is the ID of the pool to query.
-	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryServiceValidatingSetRequest is request type for the
+// Query/ServiceValidatingSet RPC method.
+type QueryServiceValidatingSetRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryPoolDelegationsRequest) Reset()         { *m = QueryPoolDelegationsRequest{} }
-func (m *QueryPoolDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationsRequest) ProtoMessage()    {}
-func (*QueryPoolDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceValidatingSetRequest) Reset()         { *m = QueryServiceValidatingSetRequest{} }
+func (m *QueryServiceValidatingSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceValidatingSetRequest) ProtoMessage()    {}
+func (*QueryServiceValidatingSetRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{8}
 }
-func (m *QueryPoolDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceValidatingSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceValidatingSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceValidatingSetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -514,53 +513,45 @@ func (m *QueryPoolDelegationsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationsRequest.Merge(m, src)
+func (m *QueryServiceValidatingSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceValidatingSetRequest.Merge(m, src)
 }
-func (m *QueryPoolDelegationsRequest) XXX_Size() int {
+func (m *QueryServiceValidatingSetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationsRequest.DiscardUnknown(m)
+func (m *QueryServiceValidatingSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceValidatingSetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceValidatingSetRequest proto.InternalMessageInfo
 
-func (m *QueryPoolDelegationsRequest) GetPoolId() uint32 {
+func (m *QueryServiceValidatingSetRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.PoolId
+		return m.ServiceId
 	}
 	return 0
 }
 
-func (m *QueryPoolDelegationsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryPoolDelegationsResponse is response type for the Query/PoolDelegations
-// RPC method.
-type QueryPoolDelegationsResponse struct {
-	// Delegations is the list of delegations.
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryServiceValidatingSetResponse is response type for the
+// Query/ServiceValidatingSet RPC method.
+type QueryServiceValidatingSetResponse struct {
+	// Operators is the list of operators that are part of the service's
+	// validating set, sorted by voting power in descending order
+	Operators []ValidatingOperator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
 }
 
-func (m *QueryPoolDelegationsResponse) Reset()         { *m = QueryPoolDelegationsResponse{} }
-func (m *QueryPoolDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationsResponse) ProtoMessage()    {}
-func (*QueryPoolDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceValidatingSetResponse) Reset()         { *m = QueryServiceValidatingSetResponse{} }
+func (m *QueryServiceValidatingSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceValidatingSetResponse) ProtoMessage()    {}
+func (*QueryServiceValidatingSetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{9}
 }
-func (m *QueryPoolDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceValidatingSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceValidatingSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceValidatingSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -570,53 +561,47 @@ func (m *QueryPoolDelegationsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationsResponse.Merge(m, src)
+func (m *QueryServiceValidatingSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceValidatingSetResponse.Merge(m, src)
 }
-func (m *QueryPoolDelegationsResponse) XXX_Size() int {
+func (m *QueryServiceValidatingSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationsResponse.DiscardUnknown(m)
+func (m *QueryServiceValidatingSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceValidatingSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryPoolDelegationsResponse) GetDelegations() []DelegationResponse {
-	if m != nil {
-		return m.Delegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryServiceValidatingSetResponse proto.InternalMessageInfo
 
-func (m *QueryPoolDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceValidatingSetResponse) GetOperators() []ValidatingOperator {
 	if m != nil {
-		return m.Pagination
+		return m.Operators
 	}
 	return nil
 }
 
-// QueryPoolDelegationRequest is request type for the Query/PoolDelegation RPC
-// method.
-type QueryPoolDelegationRequest struct {
-	// PoolId is the ID of the pool to query
-	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryServiceSnapshotRequest is request type for the Query/ServiceSnapshot
+// RPC method.
+type QueryServiceSnapshotRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Epoch is the epoch of the snapshot to query. If set to 0, the latest
+	// snapshot is returned
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryPoolDelegationRequest) Reset()         { *m = QueryPoolDelegationRequest{} }
-func (m *QueryPoolDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationRequest) ProtoMessage()    {}
-func (*QueryPoolDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceSnapshotRequest) Reset()         { *m = QueryServiceSnapshotRequest{} }
+func (m *QueryServiceSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceSnapshotRequest) ProtoMessage()    {}
+func (*QueryServiceSnapshotRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{10}
 }
-func (m *QueryPoolDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceSnapshotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceSnapshotRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -626,51 +611,51 @@ func (m *QueryPoolDelegationRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationRequest.Merge(m, src)
+func (m *QueryServiceSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceSnapshotRequest.Merge(m, src)
 }
-func (m *QueryPoolDelegationRequest) XXX_Size() int {
+func (m *QueryServiceSnapshotRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationRequest.DiscardUnknown(m)
+func (m *QueryServiceSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceSnapshotRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceSnapshotRequest proto.InternalMessageInfo
 
-func (m *QueryPoolDelegationRequest) GetPoolId() uint32 {
+func (m *QueryServiceSnapshotRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.PoolId
+		return m.ServiceId
 	}
 	return 0
 }
 
-func (m *QueryPoolDelegationRequest) GetDelegatorAddress() string {
+func (m *QueryServiceSnapshotRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-// QueryPoolDelegationResponse is response type for the Query/PoolDelegation RPC
-// method.
-type QueryPoolDelegationResponse struct {
-	// Delegation is the delegation
-	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+// QueryServiceSnapshotResponse is response type for the Query/ServiceSnapshot
+// RPC method.
+type QueryServiceSnapshotResponse struct {
+	// Snapshot is the snapshot of the service's validating set
+	Snapshot ServiceSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
 }
 
-func (m *QueryPoolDelegationResponse) Reset()         { *m = QueryPoolDelegationResponse{} }
-func (m *QueryPoolDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationResponse) ProtoMessage()    {}
-func (*QueryPoolDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceSnapshotResponse) Reset()         { *m = QueryServiceSnapshotResponse{} }
+func (m *QueryServiceSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceSnapshotResponse) ProtoMessage()    {}
+func (*QueryServiceSnapshotResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{11}
 }
-func (m *QueryPoolDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceSnapshotResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceSnapshotResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -680,44 +665,46 @@ func (m *QueryPoolDelegationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationResponse.Merge(m, src)
+func (m *QueryServiceSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceSnapshotResponse.Merge(m, src)
 }
-func (m *QueryPoolDelegationResponse) XXX_Size() int {
+func (m *QueryServiceSnapshotResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationResponse.DiscardUnknown(m)
+func (m *QueryServiceSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceSnapshotResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceSnapshotResponse proto.InternalMessageInfo
 
-func (m *QueryPoolDelegationResponse) GetDelegation() DelegationResponse {
+func (m *QueryServiceSnapshotResponse) GetSnapshot() ServiceSnapshot {
 	if m != nil {
-		return m.Delegation
+		return m.Snapshot
 	}
-	return DelegationResponse{}
+	return ServiceSnapshot{}
 }
 
-// QueryPoolUnbondingDelegationsRequest is request type for the
-// Query/PoolUnbondingDelegations RPC method.
-type QueryPoolUnbondingDelegationsRequest struct {
-	PoolId     uint32             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryOperatorJailRecordRequest is request type for the
+// Query/OperatorJailRecord RPC method.
+type QueryOperatorJailRecordRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) Reset()         { *m = QueryPoolUnbondingDelegationsRequest{} }
-func (m *QueryPoolUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolUnbondingDelegationsRequest) ProtoMessage()    {}
-func (*QueryPoolUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorJailRecordRequest) Reset()         { *m = QueryOperatorJailRecordRequest{} }
+func (m *QueryOperatorJailRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorJailRecordRequest) ProtoMessage()    {}
+func (*QueryOperatorJailRecordRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{12}
 }
-func (m *QueryPoolUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorJailRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorJailRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorJailRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -727,51 +714,51 @@ func (m *QueryPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryOperatorJailRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorJailRecordRequest.Merge(m, src)
 }
-func (m *QueryPoolUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryOperatorJailRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryOperatorJailRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorJailRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorJailRecordRequest proto.InternalMessageInfo
 
-func (m *QueryPoolUnbondingDelegationsRequest) GetPoolId() uint32 {
+func (m *QueryOperatorJailRecordRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.PoolId
+		return m.ServiceId
 	}
 	return 0
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryOperatorJailRecordRequest) GetOperatorId() uint32 {
 	if m != nil {
-		return m.Pagination
+		return m.OperatorId
 	}
-	return nil
+	return 0
 }
 
-// QueryPoolUnbondingDelegationsResponse is response type for the
-// Query/PoolUnbondingDelegations RPC method.
-type QueryPoolUnbondingDelegationsResponse struct {
-	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
-	Pagination           *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryOperatorJailRecordResponse is response type for the
+// Query/OperatorJailRecord RPC method.
+type QueryOperatorJailRecordResponse struct {
+	// JailRecord is the jail record of the operator for the service
+	JailRecord OperatorJailRecord `protobuf:"bytes,1,opt,name=jail_record,json=jailRecord,proto3" json:"jail_record"`
 }
 
-func (m *QueryPoolUnbondingDelegationsResponse) Reset()         { *m = QueryPoolUnbondingDelegationsResponse{} }
-func (m *QueryPoolUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolUnbondingDelegationsResponse) ProtoMessage()    {}
-func (*QueryPoolUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorJailRecordResponse) Reset()         { *m = QueryOperatorJailRecordResponse{} }
+func (m *QueryOperatorJailRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorJailRecordResponse) ProtoMessage()    {}
+func (*QueryOperatorJailRecordResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{13}
 }
-func (m *QueryPoolUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorJailRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorJailRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorJailRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -781,51 +768,44 @@ func (m *QueryPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryOperatorJailRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorJailRecordResponse.Merge(m, src)
 }
-func (m *QueryPoolUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryOperatorJailRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryOperatorJailRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorJailRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolUnbondingDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryPoolUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
-	if m != nil {
-		return m.UnbondingDelegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryOperatorJailRecordResponse proto.InternalMessageInfo
 
-func (m *QueryPoolUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryOperatorJailRecordResponse) GetJailRecord() OperatorJailRecord {
 	if m != nil {
-		return m.Pagination
+		return m.JailRecord
 	}
-	return nil
+	return OperatorJailRecord{}
 }
 
-// QueryPoolUnbondingDelegationRequest is request type for the
-// Query/PoolUnbondingDelegation RPC method.
-type QueryPoolUnbondingDelegationRequest struct {
-	PoolId           uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryPendingSlashRequest is request type for the Query/PendingSlash RPC
+// method.
+type QueryPendingSlashRequest struct {
+	// PendingSlashId is the ID of the pending slash to query
+	PendingSlashId uint64 `protobuf:"varint,1,opt,name=pending_slash_id,json=pendingSlashId,proto3" json:"pending_slash_id,omitempty"`
 }
 
-func (m *QueryPoolUnbondingDelegationRequest) Reset()         { *m = QueryPoolUnbondingDelegationRequest{} }
-func (m *QueryPoolUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolUnbondingDelegationRequest) ProtoMessage()    {}
-func (*QueryPoolUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPendingSlashRequest) Reset()         { *m = QueryPendingSlashRequest{} }
+func (m *QueryPendingSlashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashRequest) ProtoMessage()    {}
+func (*QueryPendingSlashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{14}
 }
-func (m *QueryPoolUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingSlashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingSlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolUnbondingDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingSlashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,50 +815,44 @@ func (m *QueryPoolUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolUnbondingDelegationRequest.Merge(m, src)
+func (m *QueryPendingSlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashRequest.Merge(m, src)
 }
-func (m *QueryPoolUnbondingDelegationRequest) XXX_Size() int {
+func (m *QueryPendingSlashRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolUnbondingDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolUnbondingDelegationRequest.DiscardUnknown(m)
+func (m *QueryPendingSlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolUnbondingDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingSlashRequest proto.InternalMessageInfo
 
-func (m *QueryPoolUnbondingDelegationRequest) GetPoolId() uint32 {
+func (m *QueryPendingSlashRequest) GetPendingSlashId() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.PendingSlashId
 	}
 	return 0
 }
 
-func (m *QueryPoolUnbondingDelegationRequest) GetDelegatorAddress() string {
-	if m != nil {
-		return m.DelegatorAddress
-	}
-	return ""
-}
-
-// QueryPoolUnbondingDelegationResponse is response type for the
-// Query/PoolUnbondingDelegation RPC method.
-type QueryPoolUnbondingDelegationResponse struct {
-	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
+// QueryPendingSlashResponse is response type for the Query/PendingSlash RPC
+// method.
+type QueryPendingSlashResponse struct {
+	// PendingSlash is the queried pending slash
+	PendingSlash PendingSlash `protobuf:"bytes,1,opt,name=pending_slash,json=pendingSlash,proto3" json:"pending_slash"`
 }
 
-func (m *QueryPoolUnbondingDelegationResponse) Reset()         { *m = QueryPoolUnbondingDelegationResponse{} }
-func (m *QueryPoolUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolUnbondingDelegationResponse) ProtoMessage()    {}
-func (*QueryPoolUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPendingSlashResponse) Reset()         { *m = QueryPendingSlashResponse{} }
+func (m *QueryPendingSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashResponse) ProtoMessage()    {}
+func (*QueryPendingSlashResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{15}
 }
-func (m *QueryPoolUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingSlashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolUnbondingDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingSlashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -888,46 +862,44 @@ func (m *QueryPoolUnbondingDelegationResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolUnbondingDelegationResponse.Merge(m, src)
+func (m *QueryPendingSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashResponse.Merge(m, src)
 }
-func (m *QueryPoolUnbondingDelegationResponse) XXX_Size() int {
+func (m *QueryPendingSlashResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolUnbondingDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolUnbondingDelegationResponse.DiscardUnknown(m)
+func (m *QueryPendingSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolUnbondingDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingSlashResponse proto.InternalMessageInfo
 
-func (m *QueryPoolUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
+func (m *QueryPendingSlashResponse) GetPendingSlash() PendingSlash {
 	if m != nil {
-		return m.UnbondingDelegation
+		return m.PendingSlash
 	}
-	return UnbondingDelegation{}
+	return PendingSlash{}
 }
 
-// QueryOperatorDelegationsRequest is request type for the
-// Query/OperatorDelegations RPC method.
-type QueryOperatorDelegationsRequest struct {
-	// OperatorId is the ID of the operator to query
-	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+// QueryPendingSlashesRequest is request type for the Query/PendingSlashes RPC
+// method.
+type QueryPendingSlashesRequest struct {
 	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorDelegationsRequest) Reset()         { *m = QueryOperatorDelegationsRequest{} }
-func (m *QueryOperatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationsRequest) ProtoMessage()    {}
-func (*QueryOperatorDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPendingSlashesRequest) Reset()         { *m = QueryPendingSlashesRequest{} }
+func (m *QueryPendingSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesRequest) ProtoMessage()    {}
+func (*QueryPendingSlashesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{16}
 }
-func (m *QueryOperatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingSlashesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -937,53 +909,46 @@ func (m *QueryOperatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationsRequest.Merge(m, src)
+func (m *QueryPendingSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesRequest.Merge(m, src)
 }
-func (m *QueryOperatorDelegationsRequest) XXX_Size() int {
+func (m *QueryPendingSlashesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationsRequest.DiscardUnknown(m)
+func (m *QueryPendingSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationsRequest proto.InternalMessageInfo
-
-func (m *QueryOperatorDelegationsRequest) GetOperatorId() uint32 {
-	if m != nil {
-		return m.OperatorId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryPendingSlashesRequest proto.InternalMessageInfo
 
-func (m *QueryOperatorDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryPendingSlashesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryOperatorDelegationsResponse is response type for the
-// Query/OperatorDelegations RPC method.
-type QueryOperatorDelegationsResponse struct {
-	// Delegations is the list of delegations
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
+// QueryPendingSlashesResponse is response type for the Query/PendingSlashes
+// RPC method.
+type QueryPendingSlashesResponse struct {
+	// PendingSlashes are the pending slashes waiting to be executed
+	PendingSlashes []PendingSlash `protobuf:"bytes,1,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
+	// Pagination defines the pagination response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorDelegationsResponse) Reset()         { *m = QueryOperatorDelegationsResponse{} }
-func (m *QueryOperatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationsResponse) ProtoMessage()    {}
-func (*QueryOperatorDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPendingSlashesResponse) Reset()         { *m = QueryPendingSlashesResponse{} }
+func (m *QueryPendingSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesResponse) ProtoMessage()    {}
+func (*QueryPendingSlashesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{17}
 }
-func (m *QueryOperatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingSlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingSlashesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -993,53 +958,53 @@ func (m *QueryOperatorDelegationsResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationsResponse.Merge(m, src)
+func (m *QueryPendingSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesResponse.Merge(m, src)
 }
-func (m *QueryOperatorDelegationsResponse) XXX_Size() int {
+func (m *QueryPendingSlashesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationsResponse.DiscardUnknown(m)
+func (m *QueryPendingSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingSlashesResponse proto.InternalMessageInfo
 
-func (m *QueryOperatorDelegationsResponse) GetDelegations() []DelegationResponse {
+func (m *QueryPendingSlashesResponse) GetPendingSlashes() []PendingSlash {
 	if m != nil {
-		return m.Delegations
+		return m.PendingSlashes
 	}
 	return nil
 }
 
-func (m *QueryOperatorDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryPendingSlashesResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryOperatorDelegationRequest is request type for the
-// Query/OperatorDelegation RPC method.
-type QueryOperatorDelegationRequest struct {
+// QueryOperatorServiceKeyRequest is request type for the
+// Query/OperatorServiceKey RPC method.
+type QueryOperatorServiceKeyRequest struct {
 	// OperatorId is the ID of the operator to query
 	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryOperatorDelegationRequest) Reset()         { *m = QueryOperatorDelegationRequest{} }
-func (m *QueryOperatorDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationRequest) ProtoMessage()    {}
-func (*QueryOperatorDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorServiceKeyRequest) Reset()         { *m = QueryOperatorServiceKeyRequest{} }
+func (m *QueryOperatorServiceKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorServiceKeyRequest) ProtoMessage()    {}
+func (*QueryOperatorServiceKeyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{18}
 }
-func (m *QueryOperatorDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorServiceKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorServiceKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorServiceKeyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1049,51 +1014,54 @@ func (m *QueryOperatorDelegationRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationRequest.Merge(m, src)
+func (m *QueryOperatorServiceKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorServiceKeyRequest.Merge(m, src)
 }
-func (m *QueryOperatorDelegationRequest) XXX_Size() int {
+func (m *QueryOperatorServiceKeyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationRequest.DiscardUnknown(m)
+func (m *QueryOperatorServiceKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorServiceKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorServiceKeyRequest proto.InternalMessageInfo
 
-func (m *QueryOperatorDelegationRequest) GetOperatorId() uint32 {
+func (m *QueryOperatorServiceKeyRequest) GetOperatorId() uint32 {
 	if m != nil {
 		return m.OperatorId
 	}
 	return 0
 }
 
-func (m *QueryOperatorDelegationRequest) GetDelegatorAddress() string {
+func (m *QueryOperatorServiceKeyRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.ServiceId
 	}
-	return ""
+	return 0
 }
 
-// QueryOperatorDelegationResponse is response type for the
-// Query/OperatorDelegation RPC method.
-type QueryOperatorDelegationResponse struct {
-	// Delegation is the delegation
-	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+// QueryOperatorServiceKeyResponse is response type for the
+// Query/OperatorServiceKey RPC method.
+type QueryOperatorServiceKeyResponse struct {
+	// Key is the key currently used by the operator for the service
+	Key OperatorServiceKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// PendingKey is the key that will replace the current one once activated,
+	// if any
+	PendingKey *OperatorServiceKey `protobuf:"bytes,2,opt,name=pending_key,json=pendingKey,proto3" json:"pending_key,omitempty"`
 }
 
-func (m *QueryOperatorDelegationResponse) Reset()         { *m = QueryOperatorDelegationResponse{} }
-func (m *QueryOperatorDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationResponse) ProtoMessage()    {}
-func (*QueryOperatorDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorServiceKeyResponse) Reset()         { *m = QueryOperatorServiceKeyResponse{} }
+func (m *QueryOperatorServiceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorServiceKeyResponse) ProtoMessage()    {}
+func (*QueryOperatorServiceKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{19}
 }
-func (m *QueryOperatorDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorServiceKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorServiceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorServiceKeyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1103,48 +1071,53 @@ func (m *QueryOperatorDelegationResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationResponse.Merge(m, src)
+func (m *QueryOperatorServiceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorServiceKeyResponse.Merge(m, src)
 }
-func (m *QueryOperatorDelegationResponse) XXX_Size() int {
+func (m *QueryOperatorServiceKeyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationResponse.DiscardUnknown(m)
+func (m *QueryOperatorServiceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorServiceKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorServiceKeyResponse proto.InternalMessageInfo
 
-func (m *QueryOperatorDelegationResponse) GetDelegation() DelegationResponse {
+func (m *QueryOperatorServiceKeyResponse) GetKey() OperatorServiceKey {
 	if m != nil {
-		return m.Delegation
+		return m.Key
 	}
-	return DelegationResponse{}
+	return OperatorServiceKey{}
 }
 
-// QueryOperatorUnbondingDelegationsRequest is request type for the
-// Query/OperatorUnbondingDelegations RPC method.
-type QueryOperatorUnbondingDelegationsRequest struct {
-	// OperatorId is the ID of the operator to query
-	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+func (m *QueryOperatorServiceKeyResponse) GetPendingKey() *OperatorServiceKey {
+	if m != nil {
+		return m.PendingKey
+	}
+	return nil
+}
+
+// QueryServiceOperatorsRequest is request type for the Query/ServiceOperators
+// RPC method.
+type QueryServiceOperatorsRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) Reset() {
-	*m = QueryOperatorUnbondingDelegationsRequest{}
-}
-func (m *QueryOperatorUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorUnbondingDelegationsRequest) ProtoMessage()    {}
-func (*QueryOperatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceOperatorsRequest) Reset()         { *m = QueryServiceOperatorsRequest{} }
+func (m *QueryServiceOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceOperatorsRequest) ProtoMessage()    {}
+func (*QueryServiceOperatorsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{20}
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceOperatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceOperatorsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1154,57 +1127,53 @@ func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryServiceOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceOperatorsRequest.Merge(m, src)
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryServiceOperatorsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryServiceOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceOperatorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceOperatorsRequest proto.InternalMessageInfo
 
-func (m *QueryOperatorUnbondingDelegationsRequest) GetOperatorId() uint32 {
+func (m *QueryServiceOperatorsRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.OperatorId
+		return m.ServiceId
 	}
 	return 0
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceOperatorsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryOperatorUnbondingDelegationsResponse is response type for the
-// Query/OperatorUnbondingDelegations RPC method.
-type QueryOperatorUnbondingDelegationsResponse struct {
-	// UnbondingDelegations is the list of unbonding delegations
-	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+// QueryServiceOperatorsResponse is response type for the Query/ServiceOperators
+// RPC method.
+type QueryServiceOperatorsResponse struct {
+	// Operators is the list of operators
+	Operators []types.Operator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) Reset() {
-	*m = QueryOperatorUnbondingDelegationsResponse{}
-}
-func (m *QueryOperatorUnbondingDelegationsResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryOperatorUnbondingDelegationsResponse) ProtoMessage() {}
-func (*QueryOperatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceOperatorsResponse) Reset()         { *m = QueryServiceOperatorsResponse{} }
+func (m *QueryServiceOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceOperatorsResponse) ProtoMessage()    {}
+func (*QueryServiceOperatorsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{21}
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceOperatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceOperatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1214,55 +1183,53 @@ func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryServiceOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceOperatorsResponse.Merge(m, src)
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryServiceOperatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryServiceOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceOperatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceOperatorsResponse proto.InternalMessageInfo
 
-func (m *QueryOperatorUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
+func (m *QueryServiceOperatorsResponse) GetOperators() []types.Operator {
 	if m != nil {
-		return m.UnbondingDelegations
+		return m.Operators
 	}
 	return nil
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceOperatorsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryOperatorUnbondingDelegationRequest is request type for the
-// Query/OperatorUnbondingDelegation RPC method.
-type QueryOperatorUnbondingDelegationRequest struct {
-	// OperatorId is the ID of the operator to query
-	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryPoolDelegationsRequest is request type for the Query/PoolDelegations RPC
+// method.
+type QueryPoolDelegationsRequest struct {
+	// PoolId is the ID of the pool to query.
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) Reset() {
-	*m = QueryOperatorUnbondingDelegationRequest{}
-}
-func (m *QueryOperatorUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorUnbondingDelegationRequest) ProtoMessage()    {}
-func (*QueryOperatorUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolDelegationsRequest) Reset()         { *m = QueryPoolDelegationsRequest{} }
+func (m *QueryPoolDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationsRequest) ProtoMessage()    {}
+func (*QueryPoolDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{22}
 }
-func (m *QueryOperatorUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1272,53 +1239,53 @@ func (m *QueryOperatorUnbondingDelegationRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.Merge(m, src)
+func (m *QueryPoolDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationsRequest.Merge(m, src)
 }
-func (m *QueryOperatorUnbondingDelegationRequest) XXX_Size() int {
+func (m *QueryPoolDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorUnbondingDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.DiscardUnknown(m)
+func (m *QueryPoolDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorUnbondingDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryOperatorUnbondingDelegationRequest) GetOperatorId() uint32 {
+func (m *QueryPoolDelegationsRequest) GetPoolId() uint32 {
 	if m != nil {
-		return m.OperatorId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) GetDelegatorAddress() string {
+func (m *QueryPoolDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryOperatorUnbondingDelegationResponse is response type for the
-// Query/OperatorUnbondingDelegation RPC method.
-type QueryOperatorUnbondingDelegationResponse struct {
-	// UnbondingDelegation is the unbonding delegation
-	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
+// QueryPoolDelegationsResponse is response type for the Query/PoolDelegations
+// RPC method.
+type QueryPoolDelegationsResponse struct {
+	// Delegations is the list of delegations.
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOperatorUnbondingDelegationResponse) Reset() {
-	*m = QueryOperatorUnbondingDelegationResponse{}
-}
-func (m *QueryOperatorUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorUnbondingDelegationResponse) ProtoMessage()    {}
-func (*QueryOperatorUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolDelegationsResponse) Reset()         { *m = QueryPoolDelegationsResponse{} }
+func (m *QueryPoolDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationsResponse) ProtoMessage()    {}
+func (*QueryPoolDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{23}
 }
-func (m *QueryOperatorUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1328,46 +1295,53 @@ func (m *QueryOperatorUnbondingDelegationResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.Merge(m, src)
+func (m *QueryPoolDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationsResponse.Merge(m, src)
 }
-func (m *QueryOperatorUnbondingDelegationResponse) XXX_Size() int {
+func (m *QueryPoolDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorUnbondingDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.DiscardUnknown(m)
+func (m *QueryPoolDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorUnbondingDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryOperatorUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
+func (m *QueryPoolDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.UnbondingDelegation
+		return m.Delegations
 	}
-	return UnbondingDelegation{}
+	return nil
 }
 
-// QueryServiceDelegationsRequest is request type for the
-// Query/ServiceDelegations RPC method.
-type QueryServiceDelegationsRequest struct {
-	// ServiceId is the ID of the service to query
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPoolDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryServiceDelegationsRequest) Reset()         { *m = QueryServiceDelegationsRequest{} }
-func (m *QueryServiceDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationsRequest) ProtoMessage()    {}
-func (*QueryServiceDelegationsRequest) Descriptor() ([]byte, []int) {
+// QueryPoolDelegationRequest is request type for the Query/PoolDelegation RPC
+// method.
+type QueryPoolDelegationRequest struct {
+	// PoolId is the ID of the pool to query
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryPoolDelegationRequest) Reset()         { *m = QueryPoolDelegationRequest{} }
+func (m *QueryPoolDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationRequest) ProtoMessage()    {}
+func (*QueryPoolDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{24}
 }
-func (m *QueryServiceDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,53 +1351,51 @@ func (m *QueryServiceDelegationsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationsRequest.Merge(m, src)
+func (m *QueryPoolDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationRequest.Merge(m, src)
 }
-func (m *QueryServiceDelegationsRequest) XXX_Size() int {
+func (m *QueryPoolDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationsRequest.DiscardUnknown(m)
+func (m *QueryPoolDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryServiceDelegationsRequest) GetServiceId() uint32 {
+func (m *QueryPoolDelegationRequest) GetPoolId() uint32 {
 	if m != nil {
-		return m.ServiceId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryServiceDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryPoolDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryServiceDelegationsResponse is response type for the
-// Query/ServiceDelegations RPC method.
-type QueryServiceDelegationsResponse struct {
-	// Delegations is the list of delegations
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryPoolDelegationResponse is response type for the Query/PoolDelegation RPC
+// method.
+type QueryPoolDelegationResponse struct {
+	// Delegation is the delegation
+	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
 }
 
-func (m *QueryServiceDelegationsResponse) Reset()         { *m = QueryServiceDelegationsResponse{} }
-func (m *QueryServiceDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationsResponse) ProtoMessage()    {}
-func (*QueryServiceDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolDelegationResponse) Reset()         { *m = QueryPoolDelegationResponse{} }
+func (m *QueryPoolDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationResponse) ProtoMessage()    {}
+func (*QueryPoolDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{25}
 }
-func (m *QueryServiceDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1433,53 +1405,44 @@ func (m *QueryServiceDelegationsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationsResponse.Merge(m, src)
+func (m *QueryPoolDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationResponse.Merge(m, src)
 }
-func (m *QueryServiceDelegationsResponse) XXX_Size() int {
+func (m *QueryPoolDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationsResponse.DiscardUnknown(m)
+func (m *QueryPoolDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryServiceDelegationsResponse) GetDelegations() []DelegationResponse {
-	if m != nil {
-		return m.Delegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPoolDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryServiceDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryPoolDelegationResponse) GetDelegation() DelegationResponse {
 	if m != nil {
-		return m.Pagination
+		return m.Delegation
 	}
-	return nil
+	return DelegationResponse{}
 }
 
-// QueryServiceDelegationRequest is request type for the Query/ServiceDelegation
-// RPC method.
-type QueryServiceDelegationRequest struct {
-	// ServiceId is the ID of the service to query
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryPoolUnbondingDelegationsRequest is request type for the
+// Query/PoolUnbondingDelegations RPC method.
+type QueryPoolUnbondingDelegationsRequest struct {
+	PoolId     uint32             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceDelegationRequest) Reset()         { *m = QueryServiceDelegationRequest{} }
-func (m *QueryServiceDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationRequest) ProtoMessage()    {}
-func (*QueryServiceDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolUnbondingDelegationsRequest) Reset()         { *m = QueryPoolUnbondingDelegationsRequest{} }
+func (m *QueryPoolUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUnbondingDelegationsRequest) ProtoMessage()    {}
+func (*QueryPoolUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{26}
 }
-func (m *QueryServiceDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1489,51 +1452,51 @@ func (m *QueryServiceDelegationRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationRequest.Merge(m, src)
+func (m *QueryPoolUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryServiceDelegationRequest) XXX_Size() int {
+func (m *QueryPoolUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationRequest.DiscardUnknown(m)
+func (m *QueryPoolUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryServiceDelegationRequest) GetServiceId() uint32 {
+func (m *QueryPoolUnbondingDelegationsRequest) GetPoolId() uint32 {
 	if m != nil {
-		return m.ServiceId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryServiceDelegationRequest) GetDelegatorAddress() string {
+func (m *QueryPoolUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryServiceDelegationResponse is response type for the
-// Query/ServiceDelegation RPC method.
-type QueryServiceDelegationResponse struct {
-	// Delegation is the delegation
-	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+// QueryPoolUnbondingDelegationsResponse is response type for the
+// Query/PoolUnbondingDelegations RPC method.
+type QueryPoolUnbondingDelegationsResponse struct {
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+	Pagination           *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceDelegationResponse) Reset()         { *m = QueryServiceDelegationResponse{} }
-func (m *QueryServiceDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationResponse) ProtoMessage()    {}
-func (*QueryServiceDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolUnbondingDelegationsResponse) Reset()         { *m = QueryPoolUnbondingDelegationsResponse{} }
+func (m *QueryPoolUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUnbondingDelegationsResponse) ProtoMessage()    {}
+func (*QueryPoolUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{27}
 }
-func (m *QueryServiceDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1543,48 +1506,51 @@ func (m *QueryServiceDelegationResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationResponse.Merge(m, src)
+func (m *QueryPoolUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryServiceDelegationResponse) XXX_Size() int {
+func (m *QueryPoolUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationResponse.DiscardUnknown(m)
+func (m *QueryPoolUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryServiceDelegationResponse) GetDelegation() DelegationResponse {
+func (m *QueryPoolUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
-		return m.Delegation
+		return m.UnbondingDelegations
 	}
-	return DelegationResponse{}
+	return nil
 }
 
-// QueryServiceUnbondingDelegationsRequest is request type for the
-// Query/ServiceUnbondingDelegations RPC method.
-type QueryServiceUnbondingDelegationsRequest struct {
-	// ServiceId is the ID of the service to query
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPoolUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryServiceUnbondingDelegationsRequest) Reset() {
-	*m = QueryServiceUnbondingDelegationsRequest{}
-}
-func (m *QueryServiceUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceUnbondingDelegationsRequest) ProtoMessage()    {}
-func (*QueryServiceUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+// QueryPoolUnbondingDelegationRequest is request type for the
+// Query/PoolUnbondingDelegation RPC method.
+type QueryPoolUnbondingDelegationRequest struct {
+	PoolId           uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryPoolUnbondingDelegationRequest) Reset()         { *m = QueryPoolUnbondingDelegationRequest{} }
+func (m *QueryPoolUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUnbondingDelegationRequest) ProtoMessage()    {}
+func (*QueryPoolUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{28}
 }
-func (m *QueryServiceUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolUnbondingDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1594,55 +1560,50 @@ func (m *QueryServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryPoolUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUnbondingDelegationRequest.Merge(m, src)
 }
-func (m *QueryServiceUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryPoolUnbondingDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryPoolUnbondingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUnbondingDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolUnbondingDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryServiceUnbondingDelegationsRequest) GetServiceId() uint32 {
+func (m *QueryPoolUnbondingDelegationRequest) GetPoolId() uint32 {
 	if m != nil {
-		return m.ServiceId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryServiceUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryPoolUnbondingDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryServiceUnbondingDelegationsResponse is response type for the
-// Query/ServiceUnbondingDelegations RPC method.
-type QueryServiceUnbondingDelegationsResponse struct {
-	// UnbondingDelegations is the list of unbonding delegations
-	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryPoolUnbondingDelegationResponse is response type for the
+// Query/PoolUnbondingDelegation RPC method.
+type QueryPoolUnbondingDelegationResponse struct {
+	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
 }
 
-func (m *QueryServiceUnbondingDelegationsResponse) Reset() {
-	*m = QueryServiceUnbondingDelegationsResponse{}
-}
-func (m *QueryServiceUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceUnbondingDelegationsResponse) ProtoMessage()    {}
-func (*QueryServiceUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolUnbondingDelegationResponse) Reset()         { *m = QueryPoolUnbondingDelegationResponse{} }
+func (m *QueryPoolUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolUnbondingDelegationResponse) ProtoMessage()    {}
+func (*QueryPoolUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{29}
 }
-func (m *QueryServiceUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolUnbondingDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,55 +1613,46 @@ func (m *QueryServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryPoolUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolUnbondingDelegationResponse.Merge(m, src)
 }
-func (m *QueryServiceUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryPoolUnbondingDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryPoolUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolUnbondingDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceUnbondingDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryServiceUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
-	if m != nil {
-		return m.UnbondingDelegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPoolUnbondingDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryServiceUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryPoolUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
 	if m != nil {
-		return m.Pagination
+		return m.UnbondingDelegation
 	}
-	return nil
+	return UnbondingDelegation{}
 }
 
-// QueryServiceUnbondingDelegationRequest is request type for the
-// Query/ServiceUnbondingDelegation RPC method.
-type QueryServiceUnbondingDelegationRequest struct {
-	// ServiceId is the ID of the service to query
-	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryOperatorDelegationsRequest is request type for the
+// Query/OperatorDelegations RPC method.
+type QueryOperatorDelegationsRequest struct {
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceUnbondingDelegationRequest) Reset() {
-	*m = QueryServiceUnbondingDelegationRequest{}
-}
-func (m *QueryServiceUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceUnbondingDelegationRequest) ProtoMessage()    {}
-func (*QueryServiceUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorDelegationsRequest) Reset()         { *m = QueryOperatorDelegationsRequest{} }
+func (m *QueryOperatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationsRequest) ProtoMessage()    {}
+func (*QueryOperatorDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{30}
 }
-func (m *QueryServiceUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceUnbondingDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1710,53 +1662,53 @@ func (m *QueryServiceUnbondingDelegationRequest) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceUnbondingDelegationRequest.Merge(m, src)
+func (m *QueryOperatorDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationsRequest.Merge(m, src)
 }
-func (m *QueryServiceUnbondingDelegationRequest) XXX_Size() int {
+func (m *QueryOperatorDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceUnbondingDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceUnbondingDelegationRequest.DiscardUnknown(m)
+func (m *QueryOperatorDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceUnbondingDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryServiceUnbondingDelegationRequest) GetServiceId() uint32 {
+func (m *QueryOperatorDelegationsRequest) GetOperatorId() uint32 {
 	if m != nil {
-		return m.ServiceId
+		return m.OperatorId
 	}
 	return 0
 }
 
-func (m *QueryServiceUnbondingDelegationRequest) GetDelegatorAddress() string {
+func (m *QueryOperatorDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryServiceUnbondingDelegationResponse is response type for the
-// Query/ServiceUnbondingDelegation RPC method.
-type QueryServiceUnbondingDelegationResponse struct {
-	// UnbondingDelegation is the unbonding delegation
-	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
+// QueryOperatorDelegationsResponse is response type for the
+// Query/OperatorDelegations RPC method.
+type QueryOperatorDelegationsResponse struct {
+	// Delegations is the list of delegations
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryServiceUnbondingDelegationResponse) Reset() {
-	*m = QueryServiceUnbondingDelegationResponse{}
-}
-func (m *QueryServiceUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceUnbondingDelegationResponse) ProtoMessage()    {}
-func (*QueryServiceUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorDelegationsResponse) Reset()         { *m = QueryOperatorDelegationsResponse{} }
+func (m *QueryOperatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationsResponse) ProtoMessage()    {}
+func (*QueryOperatorDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{31}
 }
-func (m *QueryServiceUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceUnbondingDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1766,46 +1718,53 @@ func (m *QueryServiceUnbondingDelegationResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceUnbondingDelegationResponse.Merge(m, src)
+func (m *QueryOperatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationsResponse.Merge(m, src)
 }
-func (m *QueryServiceUnbondingDelegationResponse) XXX_Size() int {
+func (m *QueryOperatorDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceUnbondingDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceUnbondingDelegationResponse.DiscardUnknown(m)
+func (m *QueryOperatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceUnbondingDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryServiceUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
+func (m *QueryOperatorDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.UnbondingDelegation
+		return m.Delegations
 	}
-	return UnbondingDelegation{}
+	return nil
 }
 
-// QueryDelegatorPoolDelegationsRequest is request type for the
-// Query/DelegatorPoolDelegations RPC method.
-type QueryDelegatorPoolDelegationsRequest struct {
+func (m *QueryOperatorDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorDelegationRequest is request type for the
+// Query/OperatorDelegation RPC method.
+type QueryOperatorDelegationRequest struct {
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) Reset()         { *m = QueryDelegatorPoolDelegationsRequest{} }
-func (m *QueryDelegatorPoolDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolDelegationsRequest) ProtoMessage()    {}
-func (*QueryDelegatorPoolDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorDelegationRequest) Reset()         { *m = QueryOperatorDelegationRequest{} }
+func (m *QueryOperatorDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationRequest) ProtoMessage()    {}
+func (*QueryOperatorDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{32}
 }
-func (m *QueryDelegatorPoolDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1815,53 +1774,51 @@ func (m *QueryDelegatorPoolDelegationsRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.Merge(m, src)
+func (m *QueryOperatorDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationRequest.Merge(m, src)
 }
-func (m *QueryDelegatorPoolDelegationsRequest) XXX_Size() int {
+func (m *QueryOperatorDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.DiscardUnknown(m)
+func (m *QueryOperatorDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryOperatorDelegationRequest) GetOperatorId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.OperatorId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryOperatorDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
-}
-
-// QueryDelegatorPoolDelegationsResponse is response type for the
-// Query/DelegatorPoolDelegations RPC method.
-type QueryDelegatorPoolDelegationsResponse struct {
-	// Delegations is the list of delegations
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	return ""
 }
 
-func (m *QueryDelegatorPoolDelegationsResponse) Reset()         { *m = QueryDelegatorPoolDelegationsResponse{} }
-func (m *QueryDelegatorPoolDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolDelegationsResponse) ProtoMessage()    {}
-func (*QueryDelegatorPoolDelegationsResponse) Descriptor() ([]byte, []int) {
+// QueryOperatorDelegationResponse is response type for the
+// Query/OperatorDelegation RPC method.
+type QueryOperatorDelegationResponse struct {
+	// Delegation is the delegation
+	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+}
+
+func (m *QueryOperatorDelegationResponse) Reset()         { *m = QueryOperatorDelegationResponse{} }
+func (m *QueryOperatorDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationResponse) ProtoMessage()    {}
+func (*QueryOperatorDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{33}
 }
-func (m *QueryDelegatorPoolDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1871,57 +1828,48 @@ func (m *QueryDelegatorPoolDelegationsResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.Merge(m, src)
+func (m *QueryOperatorDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationResponse.Merge(m, src)
 }
-func (m *QueryDelegatorPoolDelegationsResponse) XXX_Size() int {
+func (m *QueryOperatorDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.DiscardUnknown(m)
+func (m *QueryOperatorDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryDelegatorPoolDelegationsResponse) GetDelegations() []DelegationResponse {
-	if m != nil {
-		return m.Delegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryOperatorDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryOperatorDelegationResponse) GetDelegation() DelegationResponse {
 	if m != nil {
-		return m.Pagination
+		return m.Delegation
 	}
-	return nil
+	return DelegationResponse{}
 }
 
-// QueryDelegatorPoolUnbondingDelegationsRequest is request type for the
-// Query/DelegatorPoolUnbondingDelegations RPC method.
-type QueryDelegatorPoolUnbondingDelegationsRequest struct {
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryOperatorUnbondingDelegationsRequest is request type for the
+// Query/OperatorUnbondingDelegations RPC method.
+type QueryOperatorUnbondingDelegationsRequest struct {
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Reset() {
-	*m = QueryDelegatorPoolUnbondingDelegationsRequest{}
-}
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) String() string {
-	return proto.CompactTextString(m)
+func (m *QueryOperatorUnbondingDelegationsRequest) Reset() {
+	*m = QueryOperatorUnbondingDelegationsRequest{}
 }
-func (*QueryDelegatorPoolUnbondingDelegationsRequest) ProtoMessage() {}
-func (*QueryDelegatorPoolUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorUnbondingDelegationsRequest) ProtoMessage()    {}
+func (*QueryOperatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{34}
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1931,57 +1879,57 @@ func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, de
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryOperatorUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryOperatorUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryOperatorUnbondingDelegationsRequest) GetOperatorId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.OperatorId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryOperatorUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorPoolUnbondingDelegationsResponse is response type for the
-// Query/DelegatorPoolUnbondingDelegations RPC method.
-type QueryDelegatorPoolUnbondingDelegationsResponse struct {
+// QueryOperatorUnbondingDelegationsResponse is response type for the
+// Query/OperatorUnbondingDelegations RPC method.
+type QueryOperatorUnbondingDelegationsResponse struct {
 	// UnbondingDelegations is the list of unbonding delegations
 	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Reset() {
-	*m = QueryDelegatorPoolUnbondingDelegationsResponse{}
+func (m *QueryOperatorUnbondingDelegationsResponse) Reset() {
+	*m = QueryOperatorUnbondingDelegationsResponse{}
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) String() string {
+func (m *QueryOperatorUnbondingDelegationsResponse) String() string {
 	return proto.CompactTextString(m)
 }
-func (*QueryDelegatorPoolUnbondingDelegationsResponse) ProtoMessage() {}
-func (*QueryDelegatorPoolUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (*QueryOperatorUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryOperatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{35}
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1991,55 +1939,55 @@ func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, d
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryOperatorUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryOperatorUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
+func (m *QueryOperatorUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
 		return m.UnbondingDelegations
 	}
 	return nil
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryOperatorUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorOperatorDelegationsRequest is request type for the
-// Query/DelegatorOperatorDelegations RPC method.
-type QueryDelegatorOperatorDelegationsRequest struct {
+// QueryOperatorUnbondingDelegationRequest is request type for the
+// Query/OperatorUnbondingDelegation RPC method.
+type QueryOperatorUnbondingDelegationRequest struct {
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorDelegationsRequest) Reset() {
-	*m = QueryDelegatorOperatorDelegationsRequest{}
+func (m *QueryOperatorUnbondingDelegationRequest) Reset() {
+	*m = QueryOperatorUnbondingDelegationRequest{}
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorOperatorDelegationsRequest) ProtoMessage()    {}
-func (*QueryDelegatorOperatorDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorUnbondingDelegationRequest) ProtoMessage()    {}
+func (*QueryOperatorUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{36}
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2049,57 +1997,53 @@ func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.Merge(m, src)
+func (m *QueryOperatorUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Size() int {
+func (m *QueryOperatorUnbondingDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.DiscardUnknown(m)
+func (m *QueryOperatorUnbondingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorUnbondingDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryOperatorUnbondingDelegationRequest) GetOperatorId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.OperatorId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorOperatorDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryOperatorUnbondingDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryDelegatorOperatorDelegationsResponse is response type for the
-// Query/DelegatorOperatorDelegations RPC method.
-type QueryDelegatorOperatorDelegationsResponse struct {
-	// Delegations is the list of delegations
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryOperatorUnbondingDelegationResponse is response type for the
+// Query/OperatorUnbondingDelegation RPC method.
+type QueryOperatorUnbondingDelegationResponse struct {
+	// UnbondingDelegation is the unbonding delegation
+	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
 }
 
-func (m *QueryDelegatorOperatorDelegationsResponse) Reset() {
-	*m = QueryDelegatorOperatorDelegationsResponse{}
-}
-func (m *QueryDelegatorOperatorDelegationsResponse) String() string {
-	return proto.CompactTextString(m)
+func (m *QueryOperatorUnbondingDelegationResponse) Reset() {
+	*m = QueryOperatorUnbondingDelegationResponse{}
 }
-func (*QueryDelegatorOperatorDelegationsResponse) ProtoMessage() {}
-func (*QueryDelegatorOperatorDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOperatorUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorUnbondingDelegationResponse) ProtoMessage()    {}
+func (*QueryOperatorUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{37}
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2109,57 +2053,46 @@ func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.Merge(m, src)
+func (m *QueryOperatorUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Size() int {
+func (m *QueryOperatorUnbondingDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.DiscardUnknown(m)
+func (m *QueryOperatorUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorUnbondingDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryDelegatorOperatorDelegationsResponse) GetDelegations() []DelegationResponse {
-	if m != nil {
-		return m.Delegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryOperatorUnbondingDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryOperatorUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
 	if m != nil {
-		return m.Pagination
+		return m.UnbondingDelegation
 	}
-	return nil
+	return UnbondingDelegation{}
 }
 
-// QueryDelegatorOperatorUnbondingDelegationsRequest is request type for the
-// Query/DelegatorOperatorUnbondingDelegations RPC method.
-type QueryDelegatorOperatorUnbondingDelegationsRequest struct {
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryServiceDelegationsRequest is request type for the
+// Query/ServiceDelegations RPC method.
+type QueryServiceDelegationsRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Reset() {
-	*m = QueryDelegatorOperatorUnbondingDelegationsRequest{}
+func (m *QueryServiceDelegationsRequest) Reset()         { *m = QueryServiceDelegationsRequest{} }
+func (m *QueryServiceDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationsRequest) ProtoMessage()    {}
+func (*QueryServiceDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{38}
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDelegatorOperatorUnbondingDelegationsRequest) ProtoMessage() {}
-func (*QueryDelegatorOperatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_946984316b0f92c4, []int{38}
-}
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2169,57 +2102,53 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryServiceDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryServiceDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryServiceDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryServiceDelegationsRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.ServiceId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorOperatorUnbondingDelegationsResponse is response type for the
-// Query/DelegatorOperatorUnbondingDelegations RPC method.
-type QueryDelegatorOperatorUnbondingDelegationsResponse struct {
-	// UnbondingDelegations is the list of unbonding delegations
-	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+// QueryServiceDelegationsResponse is response type for the
+// Query/ServiceDelegations RPC method.
+type QueryServiceDelegationsResponse struct {
+	// Delegations is the list of delegations
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Reset() {
-	*m = QueryDelegatorOperatorUnbondingDelegationsResponse{}
-}
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDelegatorOperatorUnbondingDelegationsResponse) ProtoMessage() {}
-func (*QueryDelegatorOperatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceDelegationsResponse) Reset()         { *m = QueryServiceDelegationsResponse{} }
+func (m *QueryServiceDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationsResponse) ProtoMessage()    {}
+func (*QueryServiceDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{39}
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2229,55 +2158,53 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byt
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryServiceDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryServiceDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryServiceDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
+func (m *QueryServiceDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.UnbondingDelegations
+		return m.Delegations
 	}
 	return nil
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorServiceDelegationsRequest is request type for the
-// Query/DelegatorServiceDelegations RPC method.
-type QueryDelegatorServiceDelegationsRequest struct {
+// QueryServiceDelegationRequest is request type for the Query/ServiceDelegation
+// RPC method.
+type QueryServiceDelegationRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) Reset() {
-	*m = QueryDelegatorServiceDelegationsRequest{}
-}
-func (m *QueryDelegatorServiceDelegationsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServiceDelegationsRequest) ProtoMessage()    {}
-func (*QueryDelegatorServiceDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceDelegationRequest) Reset()         { *m = QueryServiceDelegationRequest{} }
+func (m *QueryServiceDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationRequest) ProtoMessage()    {}
+func (*QueryServiceDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{40}
 }
-func (m *QueryDelegatorServiceDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2287,55 +2214,51 @@ func (m *QueryDelegatorServiceDelegationsRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.Merge(m, src)
+func (m *QueryServiceDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationRequest.Merge(m, src)
 }
-func (m *QueryDelegatorServiceDelegationsRequest) XXX_Size() int {
+func (m *QueryServiceDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.DiscardUnknown(m)
+func (m *QueryServiceDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryServiceDelegationRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.ServiceId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryDelegatorServiceDelegationsResponse is response type for the
-// Query/DelegatorServiceDelegations RPC method.
-type QueryDelegatorServiceDelegationsResponse struct {
-	// Delegations is the list of delegations
-	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryServiceDelegationResponse is response type for the
+// Query/ServiceDelegation RPC method.
+type QueryServiceDelegationResponse struct {
+	// Delegation is the delegation
+	Delegation DelegationResponse `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
 }
 
-func (m *QueryDelegatorServiceDelegationsResponse) Reset() {
-	*m = QueryDelegatorServiceDelegationsResponse{}
-}
-func (m *QueryDelegatorServiceDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServiceDelegationsResponse) ProtoMessage()    {}
-func (*QueryDelegatorServiceDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceDelegationResponse) Reset()         { *m = QueryServiceDelegationResponse{} }
+func (m *QueryServiceDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationResponse) ProtoMessage()    {}
+func (*QueryServiceDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{41}
 }
-func (m *QueryDelegatorServiceDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2345,57 +2268,48 @@ func (m *QueryDelegatorServiceDelegationsResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.Merge(m, src)
+func (m *QueryServiceDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationResponse.Merge(m, src)
 }
-func (m *QueryDelegatorServiceDelegationsResponse) XXX_Size() int {
+func (m *QueryServiceDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.DiscardUnknown(m)
+func (m *QueryServiceDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceDelegationsResponse proto.InternalMessageInfo
-
-func (m *QueryDelegatorServiceDelegationsResponse) GetDelegations() []DelegationResponse {
-	if m != nil {
-		return m.Delegations
-	}
-	return nil
-}
+var xxx_messageInfo_QueryServiceDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceDelegationResponse) GetDelegation() DelegationResponse {
 	if m != nil {
-		return m.Pagination
+		return m.Delegation
 	}
-	return nil
+	return DelegationResponse{}
 }
 
-// QueryDelegatorServiceUnbondingDelegationsRequest is request type for the
-// Query/DelegatorServiceUnbondingDelegations RPC method.
-type QueryDelegatorServiceUnbondingDelegationsRequest struct {
-	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryServiceUnbondingDelegationsRequest is request type for the
+// Query/ServiceUnbondingDelegations RPC method.
+type QueryServiceUnbondingDelegationsRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Reset() {
-	*m = QueryDelegatorServiceUnbondingDelegationsRequest{}
-}
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) String() string {
-	return proto.CompactTextString(m)
+func (m *QueryServiceUnbondingDelegationsRequest) Reset() {
+	*m = QueryServiceUnbondingDelegationsRequest{}
 }
-func (*QueryDelegatorServiceUnbondingDelegationsRequest) ProtoMessage() {}
-func (*QueryDelegatorServiceUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceUnbondingDelegationsRequest) ProtoMessage()    {}
+func (*QueryServiceUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{42}
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2405,57 +2319,55 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.Merge(m, src)
+func (m *QueryServiceUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Size() int {
+func (m *QueryServiceUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.DiscardUnknown(m)
+func (m *QueryServiceUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) GetDelegatorAddress() string {
+func (m *QueryServiceUnbondingDelegationsRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.ServiceId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorServiceUnbondingDelegationsResponse is response type for the
-// Query/DelegatorServiceUnbondingDelegations RPC method.
-type QueryDelegatorServiceUnbondingDelegationsResponse struct {
+// QueryServiceUnbondingDelegationsResponse is response type for the
+// Query/ServiceUnbondingDelegations RPC method.
+type QueryServiceUnbondingDelegationsResponse struct {
 	// UnbondingDelegations is the list of unbonding delegations
 	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Reset() {
-	*m = QueryDelegatorServiceUnbondingDelegationsResponse{}
-}
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) String() string {
-	return proto.CompactTextString(m)
+func (m *QueryServiceUnbondingDelegationsResponse) Reset() {
+	*m = QueryServiceUnbondingDelegationsResponse{}
 }
-func (*QueryDelegatorServiceUnbondingDelegationsResponse) ProtoMessage() {}
-func (*QueryDelegatorServiceUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceUnbondingDelegationsResponse) ProtoMessage()    {}
+func (*QueryServiceUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{43}
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2465,53 +2377,55 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.Merge(m, src)
+func (m *QueryServiceUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Size() int {
+func (m *QueryServiceUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.DiscardUnknown(m)
+func (m *QueryServiceUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
+func (m *QueryServiceUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
 		return m.UnbondingDelegations
 	}
 	return nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorPoolsRequest is request type for the Query/DelegatorPools RPC
-// method.
-type QueryDelegatorPoolsRequest struct {
+// QueryServiceUnbondingDelegationRequest is request type for the
+// Query/ServiceUnbondingDelegation RPC method.
+type QueryServiceUnbondingDelegationRequest struct {
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// DelegatorAddress is the address of the delegator to query
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// Pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryDelegatorPoolsRequest) Reset()         { *m = QueryDelegatorPoolsRequest{} }
-func (m *QueryDelegatorPoolsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolsRequest) ProtoMessage()    {}
-func (*QueryDelegatorPoolsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryServiceUnbondingDelegationRequest) Reset() {
+	*m = QueryServiceUnbondingDelegationRequest{}
+}
+func (m *QueryServiceUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceUnbondingDelegationRequest) ProtoMessage()    {}
+func (*QueryServiceUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{44}
 }
-func (m *QueryDelegatorPoolsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceUnbondingDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2521,53 +2435,53 @@ func (m *QueryDelegatorPoolsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolsRequest.Merge(m, src)
+func (m *QueryServiceUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceUnbondingDelegationRequest.Merge(m, src)
 }
-func (m *QueryDelegatorPoolsRequest) XXX_Size() int {
+func (m *QueryServiceUnbondingDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolsRequest.DiscardUnknown(m)
+func (m *QueryServiceUnbondingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceUnbondingDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceUnbondingDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolsRequest) GetDelegatorAddress() string {
+func (m *QueryServiceUnbondingDelegationRequest) GetServiceId() uint32 {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.ServiceId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryDelegatorPoolsRequest) GetPagination() *query.PageRequest {
+func (m *QueryServiceUnbondingDelegationRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryDelegatorPoolsResponse is response type for the Query/DelegatorPools RPC
-// method.
-type QueryDelegatorPoolsResponse struct {
-	// Pools is the list of pools
-	Pools []types1.Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
-	// Pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryServiceUnbondingDelegationResponse is response type for the
+// Query/ServiceUnbondingDelegation RPC method.
+type QueryServiceUnbondingDelegationResponse struct {
+	// UnbondingDelegation is the unbonding delegation
+	UnbondingDelegation UnbondingDelegation `protobuf:"bytes,1,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
 }
 
-func (m *QueryDelegatorPoolsResponse) Reset()         { *m = QueryDelegatorPoolsResponse{} }
-func (m *QueryDelegatorPoolsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolsResponse) ProtoMessage()    {}
-func (*QueryDelegatorPoolsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryServiceUnbondingDelegationResponse) Reset() {
+	*m = QueryServiceUnbondingDelegationResponse{}
+}
+func (m *QueryServiceUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceUnbondingDelegationResponse) ProtoMessage()    {}
+func (*QueryServiceUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{45}
 }
-func (m *QueryDelegatorPoolsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceUnbondingDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2577,53 +2491,46 @@ func (m *QueryDelegatorPoolsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolsResponse.Merge(m, src)
+func (m *QueryServiceUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceUnbondingDelegationResponse.Merge(m, src)
 }
-func (m *QueryDelegatorPoolsResponse) XXX_Size() int {
+func (m *QueryServiceUnbondingDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolsResponse.DiscardUnknown(m)
+func (m *QueryServiceUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceUnbondingDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolsResponse proto.InternalMessageInfo
-
-func (m *QueryDelegatorPoolsResponse) GetPools() []types1.Pool {
-	if m != nil {
-		return m.Pools
-	}
-	return nil
-}
+var xxx_messageInfo_QueryServiceUnbondingDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolsResponse) GetPagination() *query.PageResponse {
+func (m *QueryServiceUnbondingDelegationResponse) GetUnbondingDelegation() UnbondingDelegation {
 	if m != nil {
-		return m.Pagination
+		return m.UnbondingDelegation
 	}
-	return nil
+	return UnbondingDelegation{}
 }
 
-// QueryDelegatorPoolRequest is request type for the Query/DelegatorPool RPC
-// method.
-type QueryDelegatorPoolRequest struct {
+// QueryDelegatorPoolDelegationsRequest is request type for the
+// Query/DelegatorPoolDelegations RPC method.
+type QueryDelegatorPoolDelegationsRequest struct {
 	// DelegatorAddress is the address of the delegator to query
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// PoolId is the ID of the pool to query
-	PoolId uint32 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorPoolRequest) Reset()         { *m = QueryDelegatorPoolRequest{} }
-func (m *QueryDelegatorPoolRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolRequest) ProtoMessage()    {}
-func (*QueryDelegatorPoolRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPoolDelegationsRequest) Reset()         { *m = QueryDelegatorPoolDelegationsRequest{} }
+func (m *QueryDelegatorPoolDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorPoolDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{46}
 }
-func (m *QueryDelegatorPoolRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2633,51 +2540,53 @@ func (m *QueryDelegatorPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolRequest.Merge(m, src)
+func (m *QueryDelegatorPoolDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorPoolRequest) XXX_Size() int {
+func (m *QueryDelegatorPoolDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolRequest.DiscardUnknown(m)
+func (m *QueryDelegatorPoolDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolRequest) GetDelegatorAddress() string {
+func (m *QueryDelegatorPoolDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
 		return m.DelegatorAddress
 	}
 	return ""
 }
 
-func (m *QueryDelegatorPoolRequest) GetPoolId() uint32 {
+func (m *QueryDelegatorPoolDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.PoolId
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryDelegatorPoolResponse is response type for the Query/DelegatorPool RPC
-// method.
-type QueryDelegatorPoolResponse struct {
-	// Pool is the pool
-	Pool types1.Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+// QueryDelegatorPoolDelegationsResponse is response type for the
+// Query/DelegatorPoolDelegations RPC method.
+type QueryDelegatorPoolDelegationsResponse struct {
+	// Delegations is the list of delegations
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorPoolResponse) Reset()         { *m = QueryDelegatorPoolResponse{} }
-func (m *QueryDelegatorPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorPoolResponse) ProtoMessage()    {}
-func (*QueryDelegatorPoolResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPoolDelegationsResponse) Reset()         { *m = QueryDelegatorPoolDelegationsResponse{} }
+func (m *QueryDelegatorPoolDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorPoolDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{47}
 }
-func (m *QueryDelegatorPoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2687,46 +2596,57 @@ func (m *QueryDelegatorPoolResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorPoolResponse.Merge(m, src)
+func (m *QueryDelegatorPoolDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorPoolResponse) XXX_Size() int {
+func (m *QueryDelegatorPoolDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorPoolResponse.DiscardUnknown(m)
+func (m *QueryDelegatorPoolDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorPoolResponse) GetPool() types1.Pool {
+func (m *QueryDelegatorPoolDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.Pool
+		return m.Delegations
 	}
-	return types1.Pool{}
+	return nil
 }
 
-// QueryDelegatorOperatorsRequest is request type for the
-// Query/DelegatorOperators RPC method.
-type QueryDelegatorOperatorsRequest struct {
+func (m *QueryDelegatorPoolDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorPoolUnbondingDelegationsRequest is request type for the
+// Query/DelegatorPoolUnbondingDelegations RPC method.
+type QueryDelegatorPoolUnbondingDelegationsRequest struct {
 	// DelegatorAddress is the address of the delegator to query
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorsRequest) Reset()         { *m = QueryDelegatorOperatorsRequest{} }
-func (m *QueryDelegatorOperatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorOperatorsRequest) ProtoMessage()    {}
-func (*QueryDelegatorOperatorsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Reset() {
+	*m = QueryDelegatorPoolUnbondingDelegationsRequest{}
+}
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorPoolUnbondingDelegationsRequest) ProtoMessage() {}
+func (*QueryDelegatorPoolUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{48}
 }
-func (m *QueryDelegatorOperatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2736,53 +2656,57 @@ func (m *QueryDelegatorOperatorsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorsRequest.Merge(m, src)
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorsRequest) XXX_Size() int {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorsRequest.DiscardUnknown(m)
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorsRequest) GetDelegatorAddress() string {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
 		return m.DelegatorAddress
 	}
 	return ""
 }
 
-func (m *QueryDelegatorOperatorsRequest) GetPagination() *query.PageRequest {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorOperatorsResponse is response type for the
-// Query/DelegatorOperators RPC method.
-type QueryDelegatorOperatorsResponse struct {
-	// Operators is the list of operators
-	Operators []types.Operator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
+// QueryDelegatorPoolUnbondingDelegationsResponse is response type for the
+// Query/DelegatorPoolUnbondingDelegations RPC method.
+type QueryDelegatorPoolUnbondingDelegationsResponse struct {
+	// UnbondingDelegations is the list of unbonding delegations
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorsResponse) Reset()         { *m = QueryDelegatorOperatorsResponse{} }
-func (m *QueryDelegatorOperatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorOperatorsResponse) ProtoMessage()    {}
-func (*QueryDelegatorOperatorsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Reset() {
+	*m = QueryDelegatorPoolUnbondingDelegationsResponse{}
+}
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorPoolUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorPoolUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{49}
 }
-func (m *QueryDelegatorOperatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2792,53 +2716,55 @@ func (m *QueryDelegatorOperatorsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorsResponse.Merge(m, src)
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorsResponse) XXX_Size() int {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorsResponse.DiscardUnknown(m)
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorsResponse) GetOperators() []types.Operator {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
-		return m.Operators
+		return m.UnbondingDelegations
 	}
 	return nil
 }
 
-func (m *QueryDelegatorOperatorsResponse) GetPagination() *query.PageResponse {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorOperatorRequest is request type for the Query/DelegatorOperator
-// RPC method.
-type QueryDelegatorOperatorRequest struct {
+// QueryDelegatorOperatorDelegationsRequest is request type for the
+// Query/DelegatorOperatorDelegations RPC method.
+type QueryDelegatorOperatorDelegationsRequest struct {
 	// DelegatorAddress is the address of the delegator to query
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// OperatorId is the ID of the operator to query
-	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorRequest) Reset()         { *m = QueryDelegatorOperatorRequest{} }
-func (m *QueryDelegatorOperatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorOperatorRequest) ProtoMessage()    {}
-func (*QueryDelegatorOperatorRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorOperatorDelegationsRequest) Reset() {
+	*m = QueryDelegatorOperatorDelegationsRequest{}
+}
+func (m *QueryDelegatorOperatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOperatorDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorOperatorDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{50}
 }
-func (m *QueryDelegatorOperatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2848,51 +2774,57 @@ func (m *QueryDelegatorOperatorRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorRequest.Merge(m, src)
+func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorRequest) XXX_Size() int {
+func (m *QueryDelegatorOperatorDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorRequest.DiscardUnknown(m)
+func (m *QueryDelegatorOperatorDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorOperatorDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorRequest) GetDelegatorAddress() string {
+func (m *QueryDelegatorOperatorDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
 		return m.DelegatorAddress
 	}
 	return ""
 }
 
-func (m *QueryDelegatorOperatorRequest) GetOperatorId() uint32 {
+func (m *QueryDelegatorOperatorDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.OperatorId
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryDelegatorOperatorResponse is response type for the
-// Query/DelegatorOperator RPC method.
-type QueryDelegatorOperatorResponse struct {
-	// Operator is the operator
-	Operator types.Operator `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator"`
+// QueryDelegatorOperatorDelegationsResponse is response type for the
+// Query/DelegatorOperatorDelegations RPC method.
+type QueryDelegatorOperatorDelegationsResponse struct {
+	// Delegations is the list of delegations
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorOperatorResponse) Reset()         { *m = QueryDelegatorOperatorResponse{} }
-func (m *QueryDelegatorOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorOperatorResponse) ProtoMessage()    {}
-func (*QueryDelegatorOperatorResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorOperatorDelegationsResponse) Reset() {
+	*m = QueryDelegatorOperatorDelegationsResponse{}
+}
+func (m *QueryDelegatorOperatorDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorOperatorDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorOperatorDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{51}
 }
-func (m *QueryDelegatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2902,46 +2834,57 @@ func (m *QueryDelegatorOperatorResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorOperatorResponse.Merge(m, src)
+func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorOperatorResponse) XXX_Size() int {
+func (m *QueryDelegatorOperatorDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorOperatorResponse.DiscardUnknown(m)
+func (m *QueryDelegatorOperatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorOperatorDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorOperatorResponse) GetOperator() types.Operator {
+func (m *QueryDelegatorOperatorDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.Operator
+		return m.Delegations
 	}
-	return types.Operator{}
+	return nil
 }
 
-// QueryDelegatorServicesRequest is request type for the Query/DelegatorServices
-// RPC method.
-type QueryDelegatorServicesRequest struct {
+func (m *QueryDelegatorOperatorDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorOperatorUnbondingDelegationsRequest is request type for the
+// Query/DelegatorOperatorUnbondingDelegations RPC method.
+type QueryDelegatorOperatorUnbondingDelegationsRequest struct {
 	// DelegatorAddress is the address of the delegator to query
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	// Pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServicesRequest) Reset()         { *m = QueryDelegatorServicesRequest{} }
-func (m *QueryDelegatorServicesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServicesRequest) ProtoMessage()    {}
-func (*QueryDelegatorServicesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Reset() {
+	*m = QueryDelegatorOperatorUnbondingDelegationsRequest{}
+}
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorOperatorUnbondingDelegationsRequest) ProtoMessage() {}
+func (*QueryDelegatorOperatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{52}
 }
-func (m *QueryDelegatorServicesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServicesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2951,53 +2894,57 @@ func (m *QueryDelegatorServicesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServicesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServicesRequest.Merge(m, src)
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorServicesRequest) XXX_Size() int {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServicesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServicesRequest.DiscardUnknown(m)
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServicesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorServicesRequest) GetDelegatorAddress() string {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
 		return m.DelegatorAddress
 	}
 	return ""
 }
 
-func (m *QueryDelegatorServicesRequest) GetPagination() *query.PageRequest {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorServicesResponse is response type for the
-// Query/DelegatorServices RPC method.
-type QueryDelegatorServicesResponse struct {
-	// Services is the list of services
-	Services []types2.Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services"`
+// QueryDelegatorOperatorUnbondingDelegationsResponse is response type for the
+// Query/DelegatorOperatorUnbondingDelegations RPC method.
+type QueryDelegatorOperatorUnbondingDelegationsResponse struct {
+	// UnbondingDelegations is the list of unbonding delegations
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 	// Pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServicesResponse) Reset()         { *m = QueryDelegatorServicesResponse{} }
-func (m *QueryDelegatorServicesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServicesResponse) ProtoMessage()    {}
-func (*QueryDelegatorServicesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Reset() {
+	*m = QueryDelegatorOperatorUnbondingDelegationsResponse{}
+}
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorOperatorUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorOperatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{53}
 }
-func (m *QueryDelegatorServicesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServicesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3007,53 +2954,55 @@ func (m *QueryDelegatorServicesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServicesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServicesResponse.Merge(m, src)
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorServicesResponse) XXX_Size() int {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServicesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServicesResponse.DiscardUnknown(m)
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServicesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorOperatorUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorServicesResponse) GetServices() []types2.Service {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
-		return m.Services
+		return m.UnbondingDelegations
 	}
 	return nil
 }
 
-func (m *QueryDelegatorServicesResponse) GetPagination() *query.PageResponse {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDelegatorServiceRequest is request type for the Query/DelegatorService
-// RPC method.
-type QueryDelegatorServiceRequest struct {
+// QueryDelegatorServiceDelegationsRequest is request type for the
+// Query/DelegatorServiceDelegations RPC method.
+type QueryDelegatorServiceDelegationsRequest struct {
 	// DelegatorAddress is the address of the delegator to query
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// ServiceId is the ID of the service to query
-	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServiceRequest) Reset()         { *m = QueryDelegatorServiceRequest{} }
-func (m *QueryDelegatorServiceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServiceRequest) ProtoMessage()    {}
-func (*QueryDelegatorServiceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorServiceDelegationsRequest) Reset() {
+	*m = QueryDelegatorServiceDelegationsRequest{}
+}
+func (m *QueryDelegatorServiceDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServiceDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorServiceDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{54}
 }
-func (m *QueryDelegatorServiceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorServiceDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorServiceDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3063,51 +3012,55 @@ func (m *QueryDelegatorServiceRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceRequest.Merge(m, src)
+func (m *QueryDelegatorServiceDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorServiceRequest) XXX_Size() int {
+func (m *QueryDelegatorServiceDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceRequest.DiscardUnknown(m)
+func (m *QueryDelegatorServiceDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorServiceDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceRequest) GetDelegatorAddress() string {
+func (m *QueryDelegatorServiceDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
 		return m.DelegatorAddress
 	}
 	return ""
 }
 
-func (m *QueryDelegatorServiceRequest) GetServiceId() uint32 {
+func (m *QueryDelegatorServiceDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ServiceId
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryDelegatorServiceResponse is response type for the Query/DelegatorService
-// RPC method.
-type QueryDelegatorServiceResponse struct {
-	// Service is the service
-	Service types2.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service"`
+// QueryDelegatorServiceDelegationsResponse is response type for the
+// Query/DelegatorServiceDelegations RPC method.
+type QueryDelegatorServiceDelegationsResponse struct {
+	// Delegations is the list of delegations
+	Delegations []DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorServiceResponse) Reset()         { *m = QueryDelegatorServiceResponse{} }
-func (m *QueryDelegatorServiceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorServiceResponse) ProtoMessage()    {}
-func (*QueryDelegatorServiceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorServiceDelegationsResponse) Reset() {
+	*m = QueryDelegatorServiceDelegationsResponse{}
+}
+func (m *QueryDelegatorServiceDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServiceDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorServiceDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{55}
 }
-func (m *QueryDelegatorServiceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorServiceDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorServiceDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorServiceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3117,44 +3070,57 @@ func (m *QueryDelegatorServiceResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorServiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorServiceResponse.Merge(m, src)
+func (m *QueryDelegatorServiceDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorServiceResponse) XXX_Size() int {
+func (m *QueryDelegatorServiceDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorServiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorServiceResponse.DiscardUnknown(m)
+func (m *QueryDelegatorServiceDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorServiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorServiceDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorServiceResponse) GetService() types2.Service {
+func (m *QueryDelegatorServiceDelegationsResponse) GetDelegations() []DelegationResponse {
 	if m != nil {
-		return m.Service
+		return m.Delegations
 	}
-	return types2.Service{}
+	return nil
 }
 
-// QueryUserPreferences is request type for the Query/UserPreferences RPC
-// method.
-type QueryUserPreferencesRequest struct {
-	// UserAddress is the address of the user to query the preferences for
-	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+func (m *QueryDelegatorServiceDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryUserPreferencesRequest) Reset()         { *m = QueryUserPreferencesRequest{} }
-func (m *QueryUserPreferencesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUserPreferencesRequest) ProtoMessage()    {}
-func (*QueryUserPreferencesRequest) Descriptor() ([]byte, []int) {
+// QueryDelegatorServiceUnbondingDelegationsRequest is request type for the
+// Query/DelegatorServiceUnbondingDelegations RPC method.
+type QueryDelegatorServiceUnbondingDelegationsRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Reset() {
+	*m = QueryDelegatorServiceUnbondingDelegationsRequest{}
+}
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorServiceUnbondingDelegationsRequest) ProtoMessage() {}
+func (*QueryDelegatorServiceUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{56}
 }
-func (m *QueryUserPreferencesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserPreferencesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3164,44 +3130,57 @@ func (m *QueryUserPreferencesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserPreferencesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserPreferencesRequest.Merge(m, src)
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.Merge(m, src)
 }
-func (m *QueryUserPreferencesRequest) XXX_Size() int {
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserPreferencesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserPreferencesRequest.DiscardUnknown(m)
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserPreferencesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryUserPreferencesRequest) GetUserAddress() string {
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) GetDelegatorAddress() string {
 	if m != nil {
-		return m.UserAddress
+		return m.DelegatorAddress
 	}
 	return ""
 }
 
-// QueryUserPreferencesResponse is response type for the Query/UserPreferences
-// RPC method.
-type QueryUserPreferencesResponse struct {
-	// Preferences is the user preferences
-	Preferences UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences"`
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryUserPreferencesResponse) Reset()         { *m = QueryUserPreferencesResponse{} }
-func (m *QueryUserPreferencesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUserPreferencesResponse) ProtoMessage()    {}
-func (*QueryUserPreferencesResponse) Descriptor() ([]byte, []int) {
+// QueryDelegatorServiceUnbondingDelegationsResponse is response type for the
+// Query/DelegatorServiceUnbondingDelegations RPC method.
+type QueryDelegatorServiceUnbondingDelegationsResponse struct {
+	// UnbondingDelegations is the list of unbonding delegations
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,1,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Reset() {
+	*m = QueryDelegatorServiceUnbondingDelegationsResponse{}
+}
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorServiceUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorServiceUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{57}
 }
-func (m *QueryUserPreferencesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserPreferencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserPreferencesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3211,41 +3190,53 @@ func (m *QueryUserPreferencesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserPreferencesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserPreferencesResponse.Merge(m, src)
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.Merge(m, src)
 }
-func (m *QueryUserPreferencesResponse) XXX_Size() int {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserPreferencesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserPreferencesResponse.DiscardUnknown(m)
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserPreferencesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorServiceUnbondingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryUserPreferencesResponse) GetPreferences() UserPreferences {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
 	if m != nil {
-		return m.Preferences
+		return m.UnbondingDelegations
 	}
-	return UserPreferences{}
+	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+// QueryDelegatorPoolsRequest is request type for the Query/DelegatorPools RPC
+// method.
+type QueryDelegatorPoolsRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorPoolsRequest) Reset()         { *m = QueryDelegatorPoolsRequest{} }
+func (m *QueryDelegatorPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolsRequest) ProtoMessage()    {}
+func (*QueryDelegatorPoolsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{58}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3255,36 +3246,53 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryDelegatorPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryDelegatorPoolsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryDelegatorPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryDelegatorPoolsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegatorPoolsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorPoolsResponse is response type for the Query/DelegatorPools RPC
+// method.
+type QueryDelegatorPoolsResponse struct {
+	// Pools is the list of pools
+	Pools []types1.Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorPoolsResponse) Reset()         { *m = QueryDelegatorPoolsResponse{} }
+func (m *QueryDelegatorPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolsResponse) ProtoMessage()    {}
+func (*QueryDelegatorPoolsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_946984316b0f92c4, []int{59}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegatorPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegatorPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegatorPoolsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3294,3055 +3302,2839 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryDelegatorPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryDelegatorPoolsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryDelegatorPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegatorPoolsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryDelegatorPoolsResponse) GetPools() []types1.Pool {
 	if m != nil {
-		return m.Params
+		return m.Pools
 	}
-	return Params{}
-}
-
-func init() {
-	proto.RegisterType((*QueryOperatorJoinedServicesRequest)(nil), "milkyway.restaking.v1.QueryOperatorJoinedServicesRequest")
-	proto.RegisterType((*QueryOperatorJoinedServicesResponse)(nil), "milkyway.restaking.v1.QueryOperatorJoinedServicesResponse")
-	proto.RegisterType((*QueryServiceAllowedOperatorsRequest)(nil), "milkyway.restaking.v1.QueryServiceAllowedOperatorsRequest")
-	proto.RegisterType((*QueryServiceAllowedOperatorsResponse)(nil), "milkyway.restaking.v1.QueryServiceAllowedOperatorsResponse")
-	proto.RegisterType((*QueryServiceSecuringPoolsRequest)(nil), "milkyway.restaking.v1.QueryServiceSecuringPoolsRequest")
-	proto.RegisterType((*QueryServiceSecuringPoolsResponse)(nil), "milkyway.restaking.v1.QueryServiceSecuringPoolsResponse")
-	proto.RegisterType((*QueryServiceOperatorsRequest)(nil), "milkyway.restaking.v1.QueryServiceOperatorsRequest")
-	proto.RegisterType((*QueryServiceOperatorsResponse)(nil), "milkyway.restaking.v1.QueryServiceOperatorsResponse")
-	proto.RegisterType((*QueryPoolDelegationsRequest)(nil), "milkyway.restaking.v1.QueryPoolDelegationsRequest")
-	proto.RegisterType((*QueryPoolDelegationsResponse)(nil), "milkyway.restaking.v1.QueryPoolDelegationsResponse")
-	proto.RegisterType((*QueryPoolDelegationRequest)(nil), "milkyway.restaking.v1.QueryPoolDelegationRequest")
-	proto.RegisterType((*QueryPoolDelegationResponse)(nil), "milkyway.restaking.v1.QueryPoolDelegationResponse")
-	proto.RegisterType((*QueryPoolUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryPoolUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryPoolUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationRequest")
-	proto.RegisterType((*QueryPoolUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationResponse")
-	proto.RegisterType((*QueryOperatorDelegationsRequest)(nil), "milkyway.restaking.v1.QueryOperatorDelegationsRequest")
-	proto.RegisterType((*QueryOperatorDelegationsResponse)(nil), "milkyway.restaking.v1.QueryOperatorDelegationsResponse")
-	proto.RegisterType((*QueryOperatorDelegationRequest)(nil), "milkyway.restaking.v1.QueryOperatorDelegationRequest")
-	proto.RegisterType((*QueryOperatorDelegationResponse)(nil), "milkyway.restaking.v1.QueryOperatorDelegationResponse")
-	proto.RegisterType((*QueryOperatorUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryOperatorUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryOperatorUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationRequest")
-	proto.RegisterType((*QueryOperatorUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationResponse")
-	proto.RegisterType((*QueryServiceDelegationsRequest)(nil), "milkyway.restaking.v1.QueryServiceDelegationsRequest")
-	proto.RegisterType((*QueryServiceDelegationsResponse)(nil), "milkyway.restaking.v1.QueryServiceDelegationsResponse")
-	proto.RegisterType((*QueryServiceDelegationRequest)(nil), "milkyway.restaking.v1.QueryServiceDelegationRequest")
-	proto.RegisterType((*QueryServiceDelegationResponse)(nil), "milkyway.restaking.v1.QueryServiceDelegationResponse")
-	proto.RegisterType((*QueryServiceUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryServiceUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryServiceUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationRequest")
-	proto.RegisterType((*QueryServiceUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationResponse")
-	proto.RegisterType((*QueryDelegatorPoolDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorPoolDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorPoolUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorPoolUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorOperatorDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorOperatorDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorOperatorUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorOperatorUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorServiceDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorServiceDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorServiceUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryDelegatorServiceUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryDelegatorPoolsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolsRequest")
-	proto.RegisterType((*QueryDelegatorPoolsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolsResponse")
-	proto.RegisterType((*QueryDelegatorPoolRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolRequest")
-	proto.RegisterType((*QueryDelegatorPoolResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolResponse")
-	proto.RegisterType((*QueryDelegatorOperatorsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorsRequest")
-	proto.RegisterType((*QueryDelegatorOperatorsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorsResponse")
-	proto.RegisterType((*QueryDelegatorOperatorRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorRequest")
-	proto.RegisterType((*QueryDelegatorOperatorResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorResponse")
-	proto.RegisterType((*QueryDelegatorServicesRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServicesRequest")
-	proto.RegisterType((*QueryDelegatorServicesResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServicesResponse")
-	proto.RegisterType((*QueryDelegatorServiceRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceRequest")
-	proto.RegisterType((*QueryDelegatorServiceResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceResponse")
-	proto.RegisterType((*QueryUserPreferencesRequest)(nil), "milkyway.restaking.v1.QueryUserPreferencesRequest")
-	proto.RegisterType((*QueryUserPreferencesResponse)(nil), "milkyway.restaking.v1.QueryUserPreferencesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.restaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.restaking.v1.QueryParamsResponse")
+	return nil
 }
 
-func init() { proto.RegisterFile("milkyway/restaking/v1/query.proto", fileDescriptor_946984316b0f92c4) }
-
-var fileDescriptor_946984316b0f92c4 = []byte{
-	// 2255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xeb, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0x5d, 0xda, 0x3c, 0x8e, 0xfb, 0x48, 0x6e, 0x5c, 0xe2, 0xac, 0x1d, 0x3b, 0x9e, 0xa6,
-	0x69, 0x62, 0x29, 0x3b, 0x5d, 0xc7, 0x79, 0x34, 0x2f, 0x3b, 0xae, 0xf3, 0x70, 0xf3, 0xb0, 0xb3,
-	0x51, 0xdb, 0x10, 0x04, 0xab, 0xb1, 0x77, 0x58, 0x96, 0xae, 0x77, 0x36, 0x3b, 0xbb, 0x2e, 0x91,
-	0x31, 0x42, 0x88, 0x28, 0x85, 0x42, 0x1f, 0x54, 0x7c, 0xe2, 0x1f, 0x40, 0x20, 0x28, 0x1f, 0x40,
-	0xe2, 0x21, 0x2a, 0x5a, 0x24, 0x88, 0x10, 0x88, 0x96, 0x7e, 0x28, 0xa2, 0x15, 0x44, 0x49, 0x25,
-	0xbe, 0x20, 0x01, 0x12, 0x7f, 0x00, 0x9a, 0x9d, 0x73, 0xe7, 0xce, 0xee, 0xdc, 0xb9, 0xf3, 0xd8,
-	0xe9, 0xc6, 0xcb, 0x97, 0x28, 0x3b, 0x73, 0xcf, 0xbd, 0xbf, 0xdf, 0xef, 0x9c, 0xfb, 0x98, 0x73,
-	0xae, 0x61, 0x74, 0xa9, 0x54, 0x7e, 0xfe, 0xfa, 0x0b, 0xda, 0x75, 0xb5, 0xa6, 0x9b, 0x75, 0xed,
-	0xf9, 0x52, 0xa5, 0xa8, 0x2e, 0x67, 0xd5, 0x6b, 0x0d, 0xbd, 0x76, 0x3d, 0x53, 0xad, 0x19, 0x75,
-	0x83, 0x3e, 0xc2, 0x9a, 0x64, 0x9c, 0x26, 0x99, 0xe5, 0x6c, 0x7a, 0x8b, 0xb6, 0x54, 0xaa, 0x18,
-	0x6a, 0xf3, 0x5f, 0xbb, 0x65, 0x7a, 0x6c, 0xd1, 0x30, 0x97, 0x0c, 0x53, 0x5d, 0xd0, 0x4c, 0xdd,
-	0xee, 0x42, 0x5d, 0xce, 0x2e, 0xe8, 0x75, 0x2d, 0xab, 0x56, 0xb5, 0x62, 0xa9, 0xa2, 0xd5, 0x4b,
-	0x46, 0x05, 0xdb, 0x0e, 0x62, 0x5b, 0xd6, 0xcc, 0x3d, 0x64, 0x7a, 0xbb, 0xfd, 0x32, 0xdf, 0xfc,
-	0xa5, 0xda, 0x3f, 0xf0, 0x55, 0x7f, 0xd1, 0x28, 0x1a, 0xf6, 0x73, 0xeb, 0x7f, 0xf8, 0x74, 0xa8,
-	0x68, 0x18, 0xc5, 0xb2, 0xae, 0x6a, 0xd5, 0x92, 0xaa, 0x55, 0x2a, 0x46, 0xbd, 0x39, 0x14, 0xb3,
-	0x51, 0x1c, 0x92, 0x46, 0x55, 0xaf, 0x69, 0x75, 0xa3, 0x66, 0x5a, 0x23, 0x2e, 0x19, 0x05, 0xbd,
-	0xcc, 0xda, 0x0c, 0x3b, 0x6d, 0xaa, 0x86, 0x51, 0xf6, 0xbe, 0x57, 0xc4, 0x42, 0x85, 0x69, 0x53,
-	0xd5, 0x6a, 0xda, 0x12, 0x6b, 0xc3, 0x05, 0x37, 0xf5, 0xda, 0x72, 0x69, 0x51, 0xf7, 0x0c, 0xa5,
-	0x7c, 0x8b, 0x80, 0x72, 0xc9, 0x52, 0x63, 0x0e, 0xd1, 0x3e, 0x6d, 0x94, 0x2a, 0x7a, 0xe1, 0x32,
-	0xb6, 0xcf, 0xe9, 0xd7, 0x1a, 0xba, 0x59, 0xa7, 0x23, 0xd0, 0xc7, 0xe8, 0xe4, 0x4b, 0x85, 0x01,
-	0xb2, 0x93, 0xec, 0x79, 0x30, 0x07, 0xec, 0xd1, 0x6c, 0x81, 0x9e, 0x06, 0xe0, 0xb2, 0x0f, 0xa4,
-	0x76, 0x92, 0x3d, 0x7d, 0xe3, 0xbb, 0x33, 0xa8, 0xa6, 0xe5, 0xa3, 0x8c, 0xad, 0x39, 0xfa, 0x28,
-	0x33, 0xaf, 0x15, 0x75, 0xec, 0x3c, 0xe7, 0xb2, 0x54, 0x5e, 0x21, 0xf0, 0xa8, 0x14, 0x8f, 0x59,
-	0x35, 0x2a, 0xa6, 0x6e, 0x01, 0x42, 0x4e, 0xf9, 0x52, 0xc1, 0x1c, 0x20, 0x3b, 0x3f, 0x61, 0x01,
-	0xc2, 0x47, 0xb3, 0x05, 0x93, 0x9e, 0x11, 0x00, 0x7a, 0x3c, 0x10, 0x90, 0xdd, 0x7b, 0x0b, 0xa2,
-	0x6f, 0x32, 0x44, 0x88, 0xe1, 0x64, 0xb9, 0x6c, 0xbc, 0xa0, 0x17, 0x18, 0x3e, 0x47, 0xa2, 0x1d,
-	0x00, 0x1c, 0x11, 0x2a, 0xb4, 0xc9, 0x01, 0x94, 0x98, 0x40, 0xdf, 0x26, 0xb0, 0x4b, 0x0e, 0x07,
-	0x15, 0x1a, 0x85, 0x07, 0x5c, 0x2e, 0x63, 0x12, 0xf5, 0x71, 0x9f, 0x25, 0xa8, 0xd1, 0xd7, 0x09,
-	0xec, 0x74, 0x83, 0xba, 0xac, 0x2f, 0x36, 0x6a, 0xa5, 0x4a, 0x71, 0xde, 0x0a, 0xef, 0x2e, 0x0b,
-	0x74, 0x93, 0xc0, 0xa8, 0x04, 0x0b, 0xaa, 0xb3, 0x1d, 0x36, 0x5a, 0x73, 0xcf, 0xa5, 0xcc, 0x06,
-	0xeb, 0x77, 0xa2, 0xaa, 0xdc, 0x20, 0x30, 0xe4, 0x46, 0x72, 0xaf, 0x42, 0xe6, 0x87, 0x04, 0x76,
-	0xf8, 0xe0, 0x40, 0x35, 0x9e, 0x82, 0x4d, 0xce, 0x6a, 0xd5, 0x94, 0xa3, 0x6f, 0x7c, 0x24, 0xe3,
-	0x2c, 0xc5, 0xce, 0xab, 0xcc, 0x72, 0x36, 0xc3, 0x8c, 0xa7, 0xef, 0xbb, 0xf5, 0xb7, 0x91, 0x75,
-	0x39, 0x6e, 0x97, 0x9c, 0x6e, 0x5f, 0x86, 0xc1, 0x26, 0x5c, 0xcb, 0x63, 0x33, 0x7a, 0x59, 0x2f,
-	0xda, 0x0b, 0x2c, 0x53, 0x6d, 0x1b, 0x6c, 0x40, 0xd7, 0xa1, 0x64, 0xeb, 0x6d, 0xcf, 0x25, 0xa6,
-	0xd7, 0x2f, 0x98, 0xdf, 0x3c, 0x00, 0x50, 0xae, 0x4b, 0xd0, 0x57, 0xe0, 0x8f, 0x51, 0xb0, 0xbd,
-	0x19, 0xe1, 0xde, 0x95, 0xe1, 0x1d, 0x30, 0x7b, 0x94, 0xce, 0xdd, 0x47, 0x72, 0xe2, 0x7d, 0x09,
-	0xd2, 0x02, 0xec, 0x81, 0xda, 0x9d, 0x82, 0x2d, 0x08, 0xc7, 0xa8, 0xe5, 0xb5, 0x42, 0xa1, 0xa6,
-	0x9b, 0x66, 0x13, 0xc6, 0xa6, 0xe9, 0x81, 0x3f, 0xff, 0x64, 0x5f, 0x3f, 0x22, 0x39, 0x69, 0xbf,
-	0xb9, 0x5c, 0xb7, 0x66, 0x54, 0x6e, 0xb3, 0x63, 0x82, 0xcf, 0x95, 0x8a, 0xd0, 0x75, 0x8e, 0x70,
-	0x73, 0x00, 0x9c, 0x74, 0x13, 0x41, 0x0c, 0xdd, 0x5c, 0x5d, 0x58, 0x93, 0x7d, 0x97, 0x33, 0xe0,
-	0x33, 0x95, 0x05, 0xa3, 0x52, 0x28, 0x55, 0x8a, 0xf7, 0x22, 0x68, 0xde, 0x27, 0xf0, 0x58, 0x00,
-	0x12, 0x14, 0x41, 0x87, 0x47, 0x1a, 0xec, 0x7d, 0xde, 0x1b, 0x47, 0x63, 0x3e, 0x7a, 0x08, 0xfa,
-	0x44, 0x41, 0xfa, 0x1b, 0x82, 0xe1, 0x12, 0x5d, 0xc6, 0x1e, 0x95, 0x31, 0xeb, 0x56, 0x6c, 0xbd,
-	0x14, 0xe0, 0x6b, 0x47, 0xe0, 0x45, 0xe8, 0x17, 0x09, 0x8c, 0xf1, 0x16, 0x5d, 0xdf, 0xad, 0x02,
-	0x7d, 0x95, 0x6f, 0x10, 0x18, 0x69, 0x39, 0xa8, 0x08, 0x82, 0xae, 0x6b, 0xa7, 0xa6, 0x37, 0xd9,
-	0xfe, 0x2b, 0x04, 0xd3, 0x03, 0xab, 0xd6, 0x8b, 0x04, 0x86, 0x7d, 0x08, 0x84, 0x16, 0x33, 0xa1,
-	0x30, 0xab, 0xf9, 0xfa, 0xf5, 0xe3, 0x5b, 0xc6, 0x5e, 0x27, 0xb0, 0xa7, 0x65, 0x50, 0xd9, 0x52,
-	0xd6, 0xb5, 0xa8, 0xfa, 0x80, 0xc0, 0xde, 0x10, 0xa8, 0x7a, 0x74, 0x59, 0x7b, 0x8d, 0xc0, 0xe3,
-	0x41, 0xec, 0xba, 0x1d, 0x7b, 0xaf, 0x84, 0x88, 0x83, 0xee, 0x2e, 0x73, 0x37, 0xd9, 0xc4, 0xc4,
-	0xb3, 0xa3, 0x20, 0x1e, 0xbb, 0x74, 0x8a, 0xfd, 0x15, 0x5b, 0x70, 0x45, 0x48, 0x7a, 0x60, 0x89,
-	0xbb, 0xd1, 0x76, 0x0a, 0xf7, 0x46, 0x59, 0x80, 0x90, 0x09, 0xc5, 0xd8, 0x35, 0x3f, 0x87, 0x7e,
-	0x7c, 0xcb, 0xdb, 0xab, 0x6c, 0xaa, 0xe1, 0x98, 0xb2, 0xd5, 0xad, 0x4b, 0xd1, 0xf4, 0x57, 0x36,
-	0xd3, 0xa4, 0x90, 0x7a, 0x74, 0x69, 0x7b, 0x99, 0xc0, 0xee, 0x00, 0x72, 0xdd, 0x8d, 0xb9, 0x97,
-	0x83, 0x03, 0xa0, 0xbb, 0xcb, 0xda, 0x4f, 0xd9, 0x59, 0x72, 0x86, 0x41, 0xf5, 0xf9, 0xd8, 0x14,
-	0x0a, 0x40, 0xa2, 0x0a, 0x90, 0x58, 0xd8, 0xfe, 0x86, 0x7d, 0x65, 0xf8, 0xe3, 0xee, 0x81, 0xa5,
-	0xf0, 0x4d, 0x02, 0xfb, 0xbc, 0x2c, 0x64, 0xab, 0xc2, 0x1a, 0x73, 0xc3, 0x6d, 0x02, 0x99, 0xb0,
-	0x04, 0x7a, 0x74, 0x0d, 0xf9, 0x39, 0x5b, 0x20, 0x1d, 0x8a, 0x92, 0x0f, 0x9d, 0x35, 0xe6, 0x9e,
-	0xdf, 0xb1, 0x83, 0xab, 0x1c, 0x7b, 0x0f, 0xcc, 0x94, 0xb7, 0x09, 0x64, 0xc5, 0x4c, 0x7a, 0x68,
-	0xb6, 0x7c, 0x44, 0x60, 0x3c, 0x0a, 0x89, 0x1e, 0x9d, 0x31, 0x3f, 0x63, 0x9b, 0x9c, 0x43, 0xd3,
-	0xff, 0xcc, 0xbc, 0xc6, 0x3c, 0xf4, 0x5b, 0xcf, 0x64, 0xef, 0xd1, 0x43, 0xf6, 0x5b, 0x04, 0x9e,
-	0x10, 0x12, 0xe9, 0xa1, 0xe9, 0x72, 0xd7, 0x33, 0xe7, 0xff, 0x1f, 0xcf, 0xa8, 0x3f, 0x20, 0x98,
-	0xa8, 0x6e, 0xd9, 0x42, 0xd7, 0xaa, 0x4f, 0xbe, 0x4b, 0x30, 0xb1, 0xdd, 0x8e, 0x16, 0xd5, 0xdf,
-	0x0f, 0xf7, 0x37, 0x4b, 0xb9, 0xa8, 0xf6, 0x36, 0xae, 0x76, 0xf3, 0xb1, 0xa5, 0xb4, 0x65, 0x80,
-	0xd2, 0xda, 0x6d, 0x93, 0xd3, 0x72, 0x05, 0xb6, 0x7b, 0xc1, 0x25, 0xac, 0xa4, 0x2b, 0xbb, 0x9b,
-	0x72, 0x67, 0x77, 0x95, 0x39, 0x91, 0x1f, 0x1d, 0x61, 0xb2, 0x70, 0x9f, 0xd5, 0x0e, 0x4f, 0xef,
-	0x01, 0xba, 0x34, 0x9b, 0x2a, 0x6f, 0xb0, 0x94, 0x83, 0x67, 0xbb, 0x58, 0xab, 0xd1, 0xf1, 0x06,
-	0x4b, 0x4d, 0x88, 0x10, 0xaf, 0xc9, 0x12, 0xdb, 0x4d, 0x96, 0x8c, 0xf0, 0x20, 0x4e, 0x58, 0xe2,
-	0xb6, 0xcc, 0x59, 0xaa, 0x3d, 0x73, 0xa6, 0x2c, 0xfa, 0x39, 0xdb, 0x51, 0xee, 0x24, 0x6c, 0x64,
-	0xed, 0x31, 0x8c, 0x42, 0x0a, 0xe7, 0x98, 0x29, 0x3f, 0xf2, 0xd0, 0x6d, 0xbf, 0xe0, 0xb0, 0xc6,
-	0x22, 0xea, 0xfb, 0x9e, 0x39, 0xe0, 0xb9, 0x01, 0x31, 0x09, 0x1b, 0xd9, 0xad, 0x0e, 0x8c, 0xa7,
-	0x1d, 0x5c, 0x16, 0xf6, 0xc6, 0x52, 0x05, 0x2d, 0x99, 0x28, 0xec, 0x55, 0x72, 0xc1, 0xf4, 0x35,
-	0x56, 0x2f, 0x6d, 0x07, 0x9b, 0xb0, 0xb8, 0xad, 0xb9, 0x8a, 0x54, 0x5b, 0xae, 0x42, 0xf9, 0xac,
-	0x8f, 0x8f, 0x1d, 0xc5, 0x8e, 0xc3, 0x06, 0x6c, 0x8d, 0x71, 0x14, 0x4a, 0x30, 0x66, 0xa3, 0x4c,
-	0xe1, 0x16, 0xf0, 0x8c, 0xa9, 0xd7, 0xe6, 0x6b, 0xfa, 0xe7, 0xf4, 0x9a, 0x5e, 0x71, 0x45, 0xd0,
-	0x28, 0x3c, 0xd0, 0x30, 0xf5, 0x36, 0x7e, 0xb9, 0x3e, 0xeb, 0x19, 0xaf, 0x8e, 0x0e, 0x89, 0x7b,
-	0x40, 0x80, 0x17, 0xa1, 0xaf, 0xca, 0x1f, 0x23, 0xc8, 0xdd, 0x7e, 0x3b, 0x77, 0x6b, 0x27, 0xec,
-	0x58, 0xe5, 0xea, 0x40, 0xe9, 0x07, 0x6a, 0x17, 0xcc, 0x9a, 0x97, 0x82, 0x10, 0xa8, 0xf2, 0x1c,
-	0x6c, 0x6d, 0x79, 0x8a, 0x83, 0x4f, 0xc1, 0x7a, 0xfb, 0xf2, 0x90, 0x57, 0x9c, 0x96, 0x71, 0x6d,
-	0xb3, 0xe9, 0x4d, 0xd6, 0x70, 0xdf, 0xfb, 0xc7, 0x8f, 0xc7, 0x48, 0x0e, 0xed, 0xc6, 0xdf, 0x9d,
-	0x80, 0xfb, 0x9b, 0x3d, 0xd3, 0x0f, 0x09, 0x7c, 0x52, 0x7c, 0x81, 0x87, 0x3e, 0xe9, 0xd3, 0x6d,
-	0xf0, 0x25, 0xa4, 0xf4, 0x91, 0x38, 0xa6, 0x36, 0x3b, 0xe5, 0xdc, 0x8b, 0x16, 0xd4, 0xaf, 0xbe,
-	0xf7, 0xd1, 0xeb, 0xa9, 0x29, 0x7a, 0x42, 0x15, 0x5f, 0x9e, 0xe2, 0x37, 0xb6, 0x56, 0x5c, 0x8b,
-	0xd6, 0xaa, 0xfa, 0x85, 0x66, 0x9f, 0x79, 0x67, 0xe6, 0xfc, 0x9d, 0xc0, 0x36, 0x9f, 0xeb, 0x37,
-	0x54, 0x0a, 0x52, 0x7e, 0x85, 0x28, 0x7d, 0x34, 0x96, 0x6d, 0x14, 0x86, 0xce, 0x3d, 0xb0, 0x15,
-	0x3e, 0x93, 0x56, 0x55, 0xcd, 0xee, 0x33, 0xcf, 0x37, 0x9a, 0xf7, 0x08, 0xf4, 0x8b, 0xee, 0xcf,
-	0xd0, 0x43, 0x21, 0x20, 0x8a, 0x6e, 0xff, 0xa4, 0x0f, 0x47, 0x37, 0x44, 0x62, 0x67, 0x39, 0xb1,
-	0xe3, 0xf4, 0x68, 0x24, 0x62, 0x26, 0x76, 0x98, 0xb7, 0x0f, 0x5c, 0x6f, 0x13, 0xd8, 0xdc, 0x7e,
-	0x07, 0x86, 0xee, 0x0f, 0x01, 0xcc, 0xe3, 0xa9, 0x89, 0x68, 0x46, 0xc8, 0xe4, 0x29, 0xce, 0xe4,
-	0x30, 0x3d, 0x18, 0x89, 0x09, 0x77, 0xcd, 0x2f, 0x09, 0x3c, 0xdc, 0x96, 0xf4, 0xa3, 0xe3, 0x32,
-	0x38, 0xe2, 0xcc, 0x66, 0x7a, 0x7f, 0x24, 0x1b, 0x64, 0x30, 0xc9, 0x19, 0x4c, 0xd0, 0x71, 0x1f,
-	0x06, 0xf6, 0xa5, 0xc6, 0x15, 0x3c, 0x2e, 0xae, 0xaa, 0xee, 0x2f, 0xbd, 0x3f, 0x10, 0x78, 0xa8,
-	0xb5, 0x73, 0x9a, 0x0d, 0x0f, 0x84, 0x61, 0x1f, 0x8f, 0x62, 0x82, 0xd0, 0x2f, 0x73, 0xe8, 0x67,
-	0xe9, 0xe9, 0xe8, 0xd0, 0xd5, 0x15, 0xcf, 0x1e, 0xb6, 0x4a, 0x6f, 0x13, 0x18, 0xf0, 0x4b, 0xfd,
-	0xd1, 0xa3, 0x41, 0x28, 0x25, 0x1f, 0xa5, 0xe9, 0x63, 0xf1, 0x8c, 0x91, 0xec, 0x2c, 0x27, 0x7b,
-	0x82, 0x1e, 0x0b, 0x49, 0x56, 0xf8, 0xfd, 0x48, 0xff, 0x43, 0x60, 0x9b, 0xcf, 0x78, 0xf2, 0xc5,
-	0x4e, 0x7e, 0x5d, 0x24, 0x7d, 0x34, 0x96, 0x2d, 0xf2, 0xfb, 0x0c, 0xe7, 0x97, 0xa3, 0xf3, 0x9d,
-	0xf0, 0x13, 0xba, 0xf5, 0x4f, 0x04, 0xb6, 0x0a, 0x52, 0x86, 0xf4, 0x60, 0x98, 0x1d, 0x48, 0xe0,
-	0xcc, 0x43, 0x91, 0xed, 0x90, 0xe7, 0x19, 0xce, 0xf3, 0x18, 0x3d, 0x12, 0x71, 0xdb, 0x72, 0x7b,
-	0xf1, 0x36, 0x01, 0xea, 0x1d, 0x88, 0x1e, 0x88, 0x06, 0x8c, 0xf1, 0x39, 0x18, 0xd5, 0x0c, 0xe9,
-	0x7c, 0x9a, 0xd3, 0x99, 0xa7, 0x17, 0xe3, 0xd3, 0x11, 0x3a, 0xed, 0xbf, 0x04, 0x86, 0x64, 0x89,
-	0x45, 0x3a, 0x19, 0x06, 0xb5, 0x6c, 0x4e, 0x4e, 0xc5, 0xef, 0x00, 0x05, 0xb8, 0xc4, 0x05, 0x38,
-	0x4d, 0x67, 0x22, 0x0a, 0x20, 0x9e, 0x9f, 0x37, 0x52, 0x30, 0x28, 0x19, 0x9b, 0x9e, 0x88, 0x09,
-	0x9a, 0x91, 0x9e, 0x8c, 0x6d, 0x8f, 0x9c, 0x75, 0xce, 0xf9, 0x2a, 0xbd, 0x92, 0x04, 0x67, 0xa1,
-	0xfb, 0x7f, 0x4f, 0x80, 0x7a, 0xb3, 0x96, 0xf2, 0x08, 0xf7, 0x4d, 0xd0, 0xca, 0x23, 0xdc, 0x3f,
-	0x39, 0xaa, 0x9c, 0xe2, 0x64, 0x8f, 0xd0, 0xc3, 0x91, 0xb6, 0x78, 0xb7, 0x53, 0x3f, 0x20, 0xb0,
-	0xc5, 0x33, 0x0a, 0x9d, 0x88, 0x04, 0x8a, 0x51, 0x39, 0x10, 0xd1, 0x0a, 0x99, 0x7c, 0x8a, 0x33,
-	0xb9, 0x48, 0xcf, 0xc7, 0x65, 0x22, 0x74, 0xd5, 0xbf, 0x08, 0x0c, 0x4a, 0x72, 0x9a, 0xf2, 0x90,
-	0x0d, 0x4e, 0xe8, 0xca, 0x43, 0x36, 0x44, 0x32, 0x55, 0x99, 0xe3, 0xdc, 0x67, 0xe8, 0x74, 0x24,
-	0xee, 0xe2, 0x49, 0xfa, 0x95, 0x14, 0xa4, 0xfd, 0x07, 0xa6, 0xc7, 0xe3, 0x01, 0x66, 0x7c, 0x4f,
-	0xc4, 0x35, 0x47, 0xba, 0x8b, 0x9c, 0xee, 0x15, 0xfa, 0x6c, 0xe7, 0x74, 0x7d, 0x8f, 0x4a, 0x7e,
-	0x55, 0x6b, 0xf9, 0x51, 0x29, 0xa0, 0x46, 0x2f, 0x3f, 0x2a, 0x05, 0x15, 0xca, 0xc3, 0x1d, 0x95,
-	0x02, 0xc8, 0xd9, 0x47, 0x0d, 0x6b, 0x29, 0x1e, 0x0d, 0xac, 0x08, 0xd3, 0x99, 0xd0, 0x70, 0x65,
-	0x31, 0x7e, 0xaa, 0xc3, 0x5e, 0xa2, 0x9c, 0x8a, 0x83, 0xd8, 0xf3, 0x20, 0xb0, 0x75, 0xf8, 0x37,
-	0x81, 0x21, 0x59, 0xe9, 0x55, 0xbe, 0x13, 0x87, 0x28, 0x38, 0xcb, 0x77, 0xe2, 0x30, 0x55, 0x5f,
-	0xe5, 0x02, 0x27, 0x3e, 0x4d, 0xa7, 0x62, 0x12, 0xe7, 0x5f, 0x65, 0xdf, 0x49, 0xc1, 0x63, 0xa1,
-	0xca, 0x9b, 0xf4, 0x6c, 0x24, 0xe8, 0xb2, 0x10, 0x98, 0x4d, 0xa0, 0x27, 0x54, 0xe3, 0x39, 0xae,
-	0xc6, 0x79, 0xfa, 0x74, 0xc7, 0x61, 0xc0, 0x75, 0xf9, 0x27, 0x81, 0x41, 0x49, 0x51, 0x51, 0xbe,
-	0xd4, 0x07, 0x17, 0x52, 0xd3, 0x93, 0xb1, 0xed, 0x91, 0xf9, 0x79, 0xce, 0xfc, 0x24, 0x9d, 0x8c,
-	0xc9, 0xdc, 0xc9, 0x0c, 0xbd, 0x96, 0x82, 0x5d, 0x61, 0xca, 0x76, 0xf4, 0x4c, 0x14, 0xdc, 0xb2,
-	0x20, 0x38, 0xdb, 0x79, 0x47, 0xa8, 0xc4, 0xb3, 0x5c, 0x89, 0x73, 0x74, 0xb6, 0xe3, 0x18, 0x70,
-	0x34, 0x79, 0x8b, 0xc0, 0x43, 0xad, 0x65, 0x33, 0xf9, 0x27, 0xbf, 0xb0, 0x20, 0x28, 0xff, 0xe4,
-	0x17, 0x57, 0xe5, 0xc2, 0x65, 0x8e, 0x1c, 0x12, 0xb2, 0x95, 0xfd, 0x16, 0x81, 0x07, 0x5b, 0x06,
-	0xa1, 0x4f, 0x84, 0xc6, 0xc3, 0x18, 0x64, 0x23, 0x58, 0x20, 0x81, 0x1c, 0x27, 0x70, 0x86, 0x9e,
-	0xea, 0x80, 0x00, 0xff, 0x0a, 0xa6, 0xef, 0x12, 0xa0, 0xde, 0x3a, 0x95, 0xfc, 0x9c, 0xec, 0x5b,
-	0x89, 0x93, 0x9f, 0x93, 0xfd, 0xcb, 0x61, 0x91, 0xa6, 0x9d, 0x1f, 0x33, 0xbe, 0xca, 0x7c, 0x48,
-	0x60, 0x8b, 0x67, 0x30, 0xf9, 0x71, 0xd9, 0xaf, 0xf0, 0x95, 0x3e, 0x10, 0xd1, 0x0a, 0x09, 0x5d,
-	0xe5, 0x84, 0xe6, 0xe8, 0x85, 0x0e, 0x09, 0xb5, 0x7e, 0x04, 0xd1, 0x3f, 0xba, 0xe9, 0x39, 0x99,
-	0xf4, 0x89, 0x28, 0x33, 0xdf, 0x8c, 0x46, 0x2f, 0x5e, 0xfe, 0x3c, 0x80, 0x9e, 0xb3, 0x22, 0xbc,
-	0x4f, 0x60, 0x73, 0xfb, 0x50, 0xf2, 0x3c, 0xac, 0x4f, 0x65, 0x29, 0x3d, 0x11, 0xcd, 0x08, 0xc9,
-	0x5c, 0xe1, 0x64, 0x2e, 0xd0, 0x73, 0x9d, 0x91, 0x69, 0x39, 0x0e, 0xd3, 0x5f, 0x13, 0x78, 0xb8,
-	0xad, 0x30, 0x23, 0x4f, 0xce, 0x8a, 0x8b, 0x49, 0xf2, 0xe4, 0xac, 0x4f, 0xf9, 0x48, 0x99, 0xe1,
-	0xb4, 0x9e, 0xa4, 0x87, 0x7c, 0x68, 0x35, 0x4c, 0xdd, 0x62, 0xe4, 0x2e, 0x55, 0xad, 0xaa, 0xae,
-	0xa2, 0x11, 0x7d, 0x89, 0xc0, 0x7a, 0xbb, 0xc6, 0x43, 0xf7, 0x4a, 0x53, 0x74, 0xee, 0xa2, 0x52,
-	0x7a, 0x2c, 0x4c, 0x53, 0xc4, 0x39, 0xc6, 0x71, 0x8e, 0xd0, 0x1d, 0xaa, 0xec, 0x0f, 0xd9, 0xa7,
-	0x2f, 0xdd, 0xba, 0x33, 0x4c, 0xde, 0xb9, 0x33, 0x4c, 0x6e, 0xdf, 0x19, 0x26, 0xaf, 0xde, 0x1d,
-	0x5e, 0xf7, 0xce, 0xdd, 0xe1, 0x75, 0x7f, 0xb9, 0x3b, 0xbc, 0xee, 0xea, 0xa1, 0x62, 0xa9, 0xfe,
-	0xf9, 0xc6, 0x42, 0x66, 0xd1, 0x58, 0x72, 0xba, 0xd8, 0x57, 0xd6, 0x16, 0x4c, 0xde, 0xe1, 0x72,
-	0x76, 0x5c, 0xfd, 0xa2, 0xab, 0xdb, 0xfa, 0xf5, 0xaa, 0x6e, 0x2e, 0xac, 0x6f, 0xfe, 0xe5, 0xfb,
-	0xfe, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xad, 0x8a, 0x15, 0x8f, 0x40, 0x00, 0x00,
+func (m *QueryDelegatorPoolsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// OperatorJoinedServices queries the services that an operator has joined.
-	OperatorJoinedServices(ctx context.Context, in *QueryOperatorJoinedServicesRequest, opts ...grpc.CallOption) (*QueryOperatorJoinedServicesResponse, error)
-	// ServiceAllowedOperators queries the allowed operators for a given service.
-	ServiceAllowedOperators(ctx context.Context, in *QueryServiceAllowedOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceAllowedOperatorsResponse, error)
-	// ServiceSecuringPools queries the pools that are securing a given service.
-	ServiceSecuringPools(ctx context.Context, in *QueryServiceSecuringPoolsRequest, opts ...grpc.CallOption) (*QueryServiceSecuringPoolsResponse, error)
-	// ServiceOperators queries the operators for a given service.
-	ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error)
-	// PoolDelegations queries the delegations info for the given pool.
-	PoolDelegations(ctx context.Context, in *QueryPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationsResponse, error)
-	// PoolDelegation queries the delegation info for the given pool and
-	// delegator.
-	PoolDelegation(ctx context.Context, in *QueryPoolDelegationRequest, opts ...grpc.CallOption) (*QueryPoolDelegationResponse, error)
-	// PoolUnbondingDelegations queries the unbonding delegations info for the
-	// given pool.
-	PoolUnbondingDelegations(ctx context.Context, in *QueryPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationsResponse, error)
-	// PoolUnbondingDelegation queries the unbonding delegation info for the given
-	// pool and delegator.
-	PoolUnbondingDelegation(ctx context.Context, in *QueryPoolUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationResponse, error)
-	// OperatorDelegations queries the delegations info for the given operator.
-	OperatorDelegations(ctx context.Context, in *QueryOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationsResponse, error)
-	// OperatorDelegation queries the delegation info for the given operator and
-	// delegator.
-	OperatorDelegation(ctx context.Context, in *QueryOperatorDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationResponse, error)
-	// OperatorUnbondingDelegations queries the unbonding delegations info for the
-	// given operator.
-	OperatorUnbondingDelegations(ctx context.Context, in *QueryOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationsResponse, error)
-	// OperatorUnbondingDelegation queries the unbonding delegation info for the
-	// given operator and delegator.
-	OperatorUnbondingDelegation(ctx context.Context, in *QueryOperatorUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationResponse, error)
-	// ServiceDelegations queries the delegations info for the given service.
-	ServiceDelegations(ctx context.Context, in *QueryServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationsResponse, error)
-	// ServiceDelegation queries the delegation info for the given service and
-	// delegator.
-	ServiceDelegation(ctx context.Context, in *QueryServiceDelegationRequest, opts ...grpc.CallOption) (*QueryServiceDelegationResponse, error)
-	// ServiceUnbondingDelegations queries the unbonding delegations info for the
-	// given service.
-	ServiceUnbondingDelegations(ctx context.Context, in *QueryServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationsResponse, error)
-	// ServiceUnbondingDelegation queries the unbonding delegation info for the
-	// given service and delegator.
-	ServiceUnbondingDelegation(ctx context.Context, in *QueryServiceUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationResponse, error)
-	// DelegatorPoolDelegations queries all the pool delegations of a given
-	// delegator address.
-	DelegatorPoolDelegations(ctx context.Context, in *QueryDelegatorPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolDelegationsResponse, error)
-	// DelegatorPoolUnbondingDelegations queries all the pool unbonding
-	// delegations of a given delegator address.
-	DelegatorPoolUnbondingDelegations(ctx context.Context, in *QueryDelegatorPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolUnbondingDelegationsResponse, error)
-	// DelegatorOperatorDelegations queries all the operator delegations of a
-	// given delegator address.
-	DelegatorOperatorDelegations(ctx context.Context, in *QueryDelegatorOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorDelegationsResponse, error)
-	// DelegatorOperatorUnbondingDelegations queries all the operator unbonding
-	// delegations of a given delegator address.
-	DelegatorOperatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error)
-	// DelegatorServiceDelegations queries all the service delegations of a given
-	// delegator address.
-	DelegatorServiceDelegations(ctx context.Context, in *QueryDelegatorServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceDelegationsResponse, error)
-	// DelegatorServiceUnbondingDelegations queries all the service unbonding
-	// delegations of a given delegator address.
-	DelegatorServiceUnbondingDelegations(ctx context.Context, in *QueryDelegatorServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceUnbondingDelegationsResponse, error)
-	// DelegatorPools queries all pools info for given delegator
-	// address.
-	DelegatorPools(ctx context.Context, in *QueryDelegatorPoolsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolsResponse, error)
-	// DelegatorPool queries the pool info for given delegator and pool id.
-	DelegatorPool(ctx context.Context, in *QueryDelegatorPoolRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolResponse, error)
-	// DelegatorOperators queries all operators info for given delegator
-	DelegatorOperators(ctx context.Context, in *QueryDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorsResponse, error)
-	// DelegatorOperator queries the operator info for given delegator and
-	// operator id.
-	DelegatorOperator(ctx context.Context, in *QueryDelegatorOperatorRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorResponse, error)
-	// DelegatorServices queries all services info for given delegator
-	DelegatorServices(ctx context.Context, in *QueryDelegatorServicesRequest, opts ...grpc.CallOption) (*QueryDelegatorServicesResponse, error)
-	// DelegatorService queries the service info for given delegator and service
-	// id.
-	DelegatorService(ctx context.Context, in *QueryDelegatorServiceRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceResponse, error)
-	// UserPreferences queries the user preferences.
-	UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error)
-	// Params queries the restaking parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryDelegatorPoolRequest is request type for the Query/DelegatorPool RPC
+// method.
+type QueryDelegatorPoolRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// PoolId is the ID of the pool to query
+	PoolId uint32 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDelegatorPoolRequest) Reset()         { *m = QueryDelegatorPoolRequest{} }
+func (m *QueryDelegatorPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolRequest) ProtoMessage()    {}
+func (*QueryDelegatorPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{60}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDelegatorPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) OperatorJoinedServices(ctx context.Context, in *QueryOperatorJoinedServicesRequest, opts ...grpc.CallOption) (*QueryOperatorJoinedServicesResponse, error) {
-	out := new(QueryOperatorJoinedServicesResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorJoinedServices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ServiceAllowedOperators(ctx context.Context, in *QueryServiceAllowedOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceAllowedOperatorsResponse, error) {
-	out := new(QueryServiceAllowedOperatorsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceAllowedOperators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolRequest.Merge(m, src)
+}
+func (m *QueryDelegatorPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ServiceSecuringPools(ctx context.Context, in *QueryServiceSecuringPoolsRequest, opts ...grpc.CallOption) (*QueryServiceSecuringPoolsResponse, error) {
-	out := new(QueryServiceSecuringPoolsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceSecuringPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorPoolRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorPoolRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error) {
-	out := new(QueryServiceOperatorsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceOperators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPoolRequest) GetPoolId() uint32 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PoolDelegations(ctx context.Context, in *QueryPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationsResponse, error) {
-	out := new(QueryPoolDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegatorPoolResponse is response type for the Query/DelegatorPool RPC
+// method.
+type QueryDelegatorPoolResponse struct {
+	// Pool is the pool
+	Pool types1.Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
 }
 
-func (c *queryClient) PoolDelegation(ctx context.Context, in *QueryPoolDelegationRequest, opts ...grpc.CallOption) (*QueryPoolDelegationResponse, error) {
-	out := new(QueryPoolDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolResponse) Reset()         { *m = QueryDelegatorPoolResponse{} }
+func (m *QueryDelegatorPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPoolResponse) ProtoMessage()    {}
+func (*QueryDelegatorPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{61}
 }
-
-func (c *queryClient) PoolUnbondingDelegations(ctx context.Context, in *QueryPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationsResponse, error) {
-	out := new(QueryPoolUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) PoolUnbondingDelegation(ctx context.Context, in *QueryPoolUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationResponse, error) {
-	out := new(QueryPoolUnbondingDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolUnbondingDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) OperatorDelegations(ctx context.Context, in *QueryOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationsResponse, error) {
-	out := new(QueryOperatorDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPoolResponse.Merge(m, src)
 }
-
-func (c *queryClient) OperatorDelegation(ctx context.Context, in *QueryOperatorDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationResponse, error) {
-	out := new(QueryOperatorDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) OperatorUnbondingDelegations(ctx context.Context, in *QueryOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationsResponse, error) {
-	out := new(QueryOperatorUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPoolResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OperatorUnbondingDelegation(ctx context.Context, in *QueryOperatorUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationResponse, error) {
-	out := new(QueryOperatorUnbondingDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorUnbondingDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryDelegatorPoolResponse proto.InternalMessageInfo
 
-func (c *queryClient) ServiceDelegations(ctx context.Context, in *QueryServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationsResponse, error) {
-	out := new(QueryServiceDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorPoolResponse) GetPool() types1.Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return out, nil
+	return types1.Pool{}
 }
 
-func (c *queryClient) ServiceDelegation(ctx context.Context, in *QueryServiceDelegationRequest, opts ...grpc.CallOption) (*QueryServiceDelegationResponse, error) {
-	out := new(QueryServiceDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegatorOperatorsRequest is request type for the
+// Query/DelegatorOperators RPC method.
+type QueryDelegatorOperatorsRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ServiceUnbondingDelegations(ctx context.Context, in *QueryServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationsResponse, error) {
-	out := new(QueryServiceUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorOperatorsRequest) Reset()         { *m = QueryDelegatorOperatorsRequest{} }
+func (m *QueryDelegatorOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOperatorsRequest) ProtoMessage()    {}
+func (*QueryDelegatorOperatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{62}
 }
-
-func (c *queryClient) ServiceUnbondingDelegation(ctx context.Context, in *QueryServiceUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationResponse, error) {
-	out := new(QueryServiceUnbondingDelegationResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceUnbondingDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorOperatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) DelegatorPoolDelegations(ctx context.Context, in *QueryDelegatorPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolDelegationsResponse, error) {
-	out := new(QueryDelegatorPoolDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPoolDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOperatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) DelegatorPoolUnbondingDelegations(ctx context.Context, in *QueryDelegatorPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolUnbondingDelegationsResponse, error) {
-	out := new(QueryDelegatorPoolUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPoolUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorOperatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorOperatorDelegations(ctx context.Context, in *QueryDelegatorOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorDelegationsResponse, error) {
-	out := new(QueryDelegatorOperatorDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperatorDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorOperatorsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorOperatorsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegatorOperatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error) {
-	out := new(QueryDelegatorOperatorUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperatorUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DelegatorServiceDelegations(ctx context.Context, in *QueryDelegatorServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceDelegationsResponse, error) {
-	out := new(QueryDelegatorServiceDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServiceDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegatorOperatorsResponse is response type for the
+// Query/DelegatorOperators RPC method.
+type QueryDelegatorOperatorsResponse struct {
+	// Operators is the list of operators
+	Operators []types.Operator `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DelegatorServiceUnbondingDelegations(ctx context.Context, in *QueryDelegatorServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceUnbondingDelegationsResponse, error) {
-	out := new(QueryDelegatorServiceUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServiceUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorsResponse) Reset()         { *m = QueryDelegatorOperatorsResponse{} }
+func (m *QueryDelegatorOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOperatorsResponse) ProtoMessage()    {}
+func (*QueryDelegatorOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{63}
+}
+func (m *QueryDelegatorOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegatorOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorOperatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorPools(ctx context.Context, in *QueryDelegatorPoolsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolsResponse, error) {
-	out := new(QueryDelegatorPoolsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorOperatorsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorOperatorsResponse) GetOperators() []types.Operator {
+	if m != nil {
+		return m.Operators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DelegatorPool(ctx context.Context, in *QueryDelegatorPoolRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolResponse, error) {
-	out := new(QueryDelegatorPoolResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DelegatorOperators(ctx context.Context, in *QueryDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorsResponse, error) {
-	out := new(QueryDelegatorOperatorsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegatorOperatorRequest is request type for the Query/DelegatorOperator
+// RPC method.
+type QueryDelegatorOperatorRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// OperatorId is the ID of the operator to query
+	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (c *queryClient) DelegatorOperator(ctx context.Context, in *QueryDelegatorOperatorRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorResponse, error) {
-	out := new(QueryDelegatorOperatorResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorOperatorRequest) Reset()         { *m = QueryDelegatorOperatorRequest{} }
+func (m *QueryDelegatorOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOperatorRequest) ProtoMessage()    {}
+func (*QueryDelegatorOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{64}
 }
-
-func (c *queryClient) DelegatorServices(ctx context.Context, in *QueryDelegatorServicesRequest, opts ...grpc.CallOption) (*QueryDelegatorServicesResponse, error) {
-	out := new(QueryDelegatorServicesResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServices", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDelegatorOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) DelegatorService(ctx context.Context, in *QueryDelegatorServiceRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceResponse, error) {
-	out := new(QueryDelegatorServiceResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegatorOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorRequest.Merge(m, src)
+}
+func (m *QueryDelegatorOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error) {
-	out := new(QueryUserPreferencesResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/UserPreferences", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorOperatorRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorOperatorRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorOperatorRequest) GetOperatorId() uint32 {
+	if m != nil {
+		return m.OperatorId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// OperatorJoinedServices queries the services that an operator has joined.
-	OperatorJoinedServices(context.Context, *QueryOperatorJoinedServicesRequest) (*QueryOperatorJoinedServicesResponse, error)
-	// ServiceAllowedOperators queries the allowed operators for a given service.
-	ServiceAllowedOperators(context.Context, *QueryServiceAllowedOperatorsRequest) (*QueryServiceAllowedOperatorsResponse, error)
-	// ServiceSecuringPools queries the pools that are securing a given service.
-	ServiceSecuringPools(context.Context, *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error)
-	// ServiceOperators queries the operators for a given service.
-	ServiceOperators(context.Context, *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error)
-	// PoolDelegations queries the delegations info for the given pool.
-	PoolDelegations(context.Context, *QueryPoolDelegationsRequest) (*QueryPoolDelegationsResponse, error)
-	// PoolDelegation queries the delegation info for the given pool and
-	// delegator.
-	PoolDelegation(context.Context, *QueryPoolDelegationRequest) (*QueryPoolDelegationResponse, error)
-	// PoolUnbondingDelegations queries the unbonding delegations info for the
-	// given pool.
-	PoolUnbondingDelegations(context.Context, *QueryPoolUnbondingDelegationsRequest) (*QueryPoolUnbondingDelegationsResponse, error)
-	// PoolUnbondingDelegation queries the unbonding delegation info for the given
-	// pool and delegator.
-	PoolUnbondingDelegation(context.Context, *QueryPoolUnbondingDelegationRequest) (*QueryPoolUnbondingDelegationResponse, error)
-	// OperatorDelegations queries the delegations info for the given operator.
-	OperatorDelegations(context.Context, *QueryOperatorDelegationsRequest) (*QueryOperatorDelegationsResponse, error)
-	// OperatorDelegation queries the delegation info for the given operator and
-	// delegator.
-	OperatorDelegation(context.Context, *QueryOperatorDelegationRequest) (*QueryOperatorDelegationResponse, error)
-	// OperatorUnbondingDelegations queries the unbonding delegations info for the
-	// given operator.
-	OperatorUnbondingDelegations(context.Context, *QueryOperatorUnbondingDelegationsRequest) (*QueryOperatorUnbondingDelegationsResponse, error)
-	// OperatorUnbondingDelegation queries the unbonding delegation info for the
-	// given operator and delegator.
-	OperatorUnbondingDelegation(context.Context, *QueryOperatorUnbondingDelegationRequest) (*QueryOperatorUnbondingDelegationResponse, error)
-	// ServiceDelegations queries the delegations info for the given service.
-	ServiceDelegations(context.Context, *QueryServiceDelegationsRequest) (*QueryServiceDelegationsResponse, error)
-	// ServiceDelegation queries the delegation info for the given service and
-	// delegator.
-	ServiceDelegation(context.Context, *QueryServiceDelegationRequest) (*QueryServiceDelegationResponse, error)
-	// ServiceUnbondingDelegations queries the unbonding delegations info for the
-	// given service.
-	ServiceUnbondingDelegations(context.Context, *QueryServiceUnbondingDelegationsRequest) (*QueryServiceUnbondingDelegationsResponse, error)
-	// ServiceUnbondingDelegation queries the unbonding delegation info for the
-	// given service and delegator.
-	ServiceUnbondingDelegation(context.Context, *QueryServiceUnbondingDelegationRequest) (*QueryServiceUnbondingDelegationResponse, error)
-	// DelegatorPoolDelegations queries all the pool delegations of a given
-	// delegator address.
-	DelegatorPoolDelegations(context.Context, *QueryDelegatorPoolDelegationsRequest) (*QueryDelegatorPoolDelegationsResponse, error)
-	// DelegatorPoolUnbondingDelegations queries all the pool unbonding
-	// delegations of a given delegator address.
-	DelegatorPoolUnbondingDelegations(context.Context, *QueryDelegatorPoolUnbondingDelegationsRequest) (*QueryDelegatorPoolUnbondingDelegationsResponse, error)
-	// DelegatorOperatorDelegations queries all the operator delegations of a
-	// given delegator address.
-	DelegatorOperatorDelegations(context.Context, *QueryDelegatorOperatorDelegationsRequest) (*QueryDelegatorOperatorDelegationsResponse, error)
-	// DelegatorOperatorUnbondingDelegations queries all the operator unbonding
-	// delegations of a given delegator address.
-	DelegatorOperatorUnbondingDelegations(context.Context, *QueryDelegatorOperatorUnbondingDelegationsRequest) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error)
-	// DelegatorServiceDelegations queries all the service delegations of a given
-	// delegator address.
-	DelegatorServiceDelegations(context.Context, *QueryDelegatorServiceDelegationsRequest) (*QueryDelegatorServiceDelegationsResponse, error)
-	// DelegatorServiceUnbondingDelegations queries all the service unbonding
-	// delegations of a given delegator address.
-	DelegatorServiceUnbondingDelegations(context.Context, *QueryDelegatorServiceUnbondingDelegationsRequest) (*QueryDelegatorServiceUnbondingDelegationsResponse, error)
-	// DelegatorPools queries all pools info for given delegator
-	// address.
-	DelegatorPools(context.Context, *QueryDelegatorPoolsRequest) (*QueryDelegatorPoolsResponse, error)
-	// DelegatorPool queries the pool info for given delegator and pool id.
-	DelegatorPool(context.Context, *QueryDelegatorPoolRequest) (*QueryDelegatorPoolResponse, error)
-	// DelegatorOperators queries all operators info for given delegator
-	DelegatorOperators(context.Context, *QueryDelegatorOperatorsRequest) (*QueryDelegatorOperatorsResponse, error)
-	// DelegatorOperator queries the operator info for given delegator and
-	// operator id.
-	DelegatorOperator(context.Context, *QueryDelegatorOperatorRequest) (*QueryDelegatorOperatorResponse, error)
-	// DelegatorServices queries all services info for given delegator
-	DelegatorServices(context.Context, *QueryDelegatorServicesRequest) (*QueryDelegatorServicesResponse, error)
-	// DelegatorService queries the service info for given delegator and service
-	// id.
-	DelegatorService(context.Context, *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error)
-	// UserPreferences queries the user preferences.
-	UserPreferences(context.Context, *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error)
-	// Params queries the restaking parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryDelegatorOperatorResponse is response type for the
+// Query/DelegatorOperator RPC method.
+type QueryDelegatorOperatorResponse struct {
+	// Operator is the operator
+	Operator types.Operator `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDelegatorOperatorResponse) Reset()         { *m = QueryDelegatorOperatorResponse{} }
+func (m *QueryDelegatorOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOperatorResponse) ProtoMessage()    {}
+func (*QueryDelegatorOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{65}
 }
-
-func (*UnimplementedQueryServer) OperatorJoinedServices(ctx context.Context, req *QueryOperatorJoinedServicesRequest) (*QueryOperatorJoinedServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorJoinedServices not implemented")
+func (m *QueryDelegatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ServiceAllowedOperators(ctx context.Context, req *QueryServiceAllowedOperatorsRequest) (*QueryServiceAllowedOperatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceAllowedOperators not implemented")
+func (m *QueryDelegatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ServiceSecuringPools(ctx context.Context, req *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceSecuringPools not implemented")
+func (m *QueryDelegatorOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOperatorResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ServiceOperators(ctx context.Context, req *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceOperators not implemented")
+func (m *QueryDelegatorOperatorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PoolDelegations(ctx context.Context, req *QueryPoolDelegationsRequest) (*QueryPoolDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegations not implemented")
+func (m *QueryDelegatorOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOperatorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PoolDelegation(ctx context.Context, req *QueryPoolDelegationRequest) (*QueryPoolDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegation not implemented")
+
+var xxx_messageInfo_QueryDelegatorOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorOperatorResponse) GetOperator() types.Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return types.Operator{}
 }
-func (*UnimplementedQueryServer) PoolUnbondingDelegations(ctx context.Context, req *QueryPoolUnbondingDelegationsRequest) (*QueryPoolUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolUnbondingDelegations not implemented")
+
+// QueryDelegatorServicesRequest is request type for the Query/DelegatorServices
+// RPC method.
+type QueryDelegatorServicesRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) PoolUnbondingDelegation(ctx context.Context, req *QueryPoolUnbondingDelegationRequest) (*QueryPoolUnbondingDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolUnbondingDelegation not implemented")
+
+func (m *QueryDelegatorServicesRequest) Reset()         { *m = QueryDelegatorServicesRequest{} }
+func (m *QueryDelegatorServicesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServicesRequest) ProtoMessage()    {}
+func (*QueryDelegatorServicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{66}
 }
-func (*UnimplementedQueryServer) OperatorDelegations(ctx context.Context, req *QueryOperatorDelegationsRequest) (*QueryOperatorDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegations not implemented")
+func (m *QueryDelegatorServicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OperatorDelegation(ctx context.Context, req *QueryOperatorDelegationRequest) (*QueryOperatorDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegation not implemented")
+func (m *QueryDelegatorServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorServicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OperatorUnbondingDelegations(ctx context.Context, req *QueryOperatorUnbondingDelegationsRequest) (*QueryOperatorUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorUnbondingDelegations not implemented")
+func (m *QueryDelegatorServicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServicesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OperatorUnbondingDelegation(ctx context.Context, req *QueryOperatorUnbondingDelegationRequest) (*QueryOperatorUnbondingDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorUnbondingDelegation not implemented")
+func (m *QueryDelegatorServicesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ServiceDelegations(ctx context.Context, req *QueryServiceDelegationsRequest) (*QueryServiceDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegations not implemented")
+func (m *QueryDelegatorServicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServicesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ServiceDelegation(ctx context.Context, req *QueryServiceDelegationRequest) (*QueryServiceDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegation not implemented")
+
+var xxx_messageInfo_QueryDelegatorServicesRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorServicesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ServiceUnbondingDelegations(ctx context.Context, req *QueryServiceUnbondingDelegationsRequest) (*QueryServiceUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceUnbondingDelegations not implemented")
+
+func (m *QueryDelegatorServicesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ServiceUnbondingDelegation(ctx context.Context, req *QueryServiceUnbondingDelegationRequest) (*QueryServiceUnbondingDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceUnbondingDelegation not implemented")
+
+// QueryDelegatorServicesResponse is response type for the
+// Query/DelegatorServices RPC method.
+type QueryDelegatorServicesResponse struct {
+	// Services is the list of services
+	Services []types2.Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) DelegatorPoolDelegations(ctx context.Context, req *QueryDelegatorPoolDelegationsRequest) (*QueryDelegatorPoolDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPoolDelegations not implemented")
+
+func (m *QueryDelegatorServicesResponse) Reset()         { *m = QueryDelegatorServicesResponse{} }
+func (m *QueryDelegatorServicesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServicesResponse) ProtoMessage()    {}
+func (*QueryDelegatorServicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{67}
 }
-func (*UnimplementedQueryServer) DelegatorPoolUnbondingDelegations(ctx context.Context, req *QueryDelegatorPoolUnbondingDelegationsRequest) (*QueryDelegatorPoolUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPoolUnbondingDelegations not implemented")
+func (m *QueryDelegatorServicesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegatorOperatorDelegations(ctx context.Context, req *QueryDelegatorOperatorDelegationsRequest) (*QueryDelegatorOperatorDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperatorDelegations not implemented")
+func (m *QueryDelegatorServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorServicesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DelegatorOperatorUnbondingDelegations(ctx context.Context, req *QueryDelegatorOperatorUnbondingDelegationsRequest) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperatorUnbondingDelegations not implemented")
+func (m *QueryDelegatorServicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServicesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegatorServiceDelegations(ctx context.Context, req *QueryDelegatorServiceDelegationsRequest) (*QueryDelegatorServiceDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServiceDelegations not implemented")
+func (m *QueryDelegatorServicesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegatorServiceUnbondingDelegations(ctx context.Context, req *QueryDelegatorServiceUnbondingDelegationsRequest) (*QueryDelegatorServiceUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServiceUnbondingDelegations not implemented")
+func (m *QueryDelegatorServicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServicesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DelegatorPools(ctx context.Context, req *QueryDelegatorPoolsRequest) (*QueryDelegatorPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPools not implemented")
+
+var xxx_messageInfo_QueryDelegatorServicesResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorServicesResponse) GetServices() []types2.Service {
+	if m != nil {
+		return m.Services
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) DelegatorPool(ctx context.Context, req *QueryDelegatorPoolRequest) (*QueryDelegatorPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPool not implemented")
+
+func (m *QueryDelegatorServicesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) DelegatorOperators(ctx context.Context, req *QueryDelegatorOperatorsRequest) (*QueryDelegatorOperatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperators not implemented")
+
+// QueryDelegatorServiceRequest is request type for the Query/DelegatorService
+// RPC method.
+type QueryDelegatorServiceRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// ServiceId is the ID of the service to query
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
-func (*UnimplementedQueryServer) DelegatorOperator(ctx context.Context, req *QueryDelegatorOperatorRequest) (*QueryDelegatorOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperator not implemented")
+
+func (m *QueryDelegatorServiceRequest) Reset()         { *m = QueryDelegatorServiceRequest{} }
+func (m *QueryDelegatorServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServiceRequest) ProtoMessage()    {}
+func (*QueryDelegatorServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{68}
 }
-func (*UnimplementedQueryServer) DelegatorServices(ctx context.Context, req *QueryDelegatorServicesRequest) (*QueryDelegatorServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServices not implemented")
+func (m *QueryDelegatorServiceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegatorService(ctx context.Context, req *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorService not implemented")
+func (m *QueryDelegatorServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorServiceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserPreferences(ctx context.Context, req *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPreferences not implemented")
+func (m *QueryDelegatorServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDelegatorServiceRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDelegatorServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceRequest.DiscardUnknown(m)
 }
 
-func _Query_OperatorJoinedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorJoinedServicesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorJoinedServices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/OperatorJoinedServices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorJoinedServices(ctx, req.(*QueryOperatorJoinedServicesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryDelegatorServiceRequest proto.InternalMessageInfo
 
-func _Query_ServiceAllowedOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceAllowedOperatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceAllowedOperators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceAllowedOperators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceAllowedOperators(ctx, req.(*QueryServiceAllowedOperatorsRequest))
+func (m *QueryDelegatorServiceRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ServiceSecuringPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceSecuringPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceSecuringPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceSecuringPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceSecuringPools(ctx, req.(*QueryServiceSecuringPoolsRequest))
+func (m *QueryDelegatorServiceRequest) GetServiceId() uint32 {
+	if m != nil {
+		return m.ServiceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ServiceOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceOperatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceOperators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceOperators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceOperators(ctx, req.(*QueryServiceOperatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorServiceResponse is response type for the Query/DelegatorService
+// RPC method.
+type QueryDelegatorServiceResponse struct {
+	// Service is the service
+	Service types2.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service"`
 }
 
-func _Query_PoolDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/PoolDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolDelegations(ctx, req.(*QueryPoolDelegationsRequest))
+func (m *QueryDelegatorServiceResponse) Reset()         { *m = QueryDelegatorServiceResponse{} }
+func (m *QueryDelegatorServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorServiceResponse) ProtoMessage()    {}
+func (*QueryDelegatorServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{69}
+}
+func (m *QueryDelegatorServiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorServiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorServiceResponse.Merge(m, src)
+}
+func (m *QueryDelegatorServiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorServiceResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/PoolDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolDelegation(ctx, req.(*QueryPoolDelegationRequest))
+var xxx_messageInfo_QueryDelegatorServiceResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorServiceResponse) GetService() types2.Service {
+	if m != nil {
+		return m.Service
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Service{}
 }
 
-func _Query_PoolUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/PoolUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolUnbondingDelegations(ctx, req.(*QueryPoolUnbondingDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorRedelegationsRequest is request type for the
+// Query/DelegatorRedelegations RPC method.
+type QueryDelegatorRedelegationsRequest struct {
+	// DelegatorAddress is the address of the delegator to query
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PoolUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolUnbondingDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolUnbondingDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/PoolUnbondingDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolUnbondingDelegation(ctx, req.(*QueryPoolUnbondingDelegationRequest))
+func (m *QueryDelegatorRedelegationsRequest) Reset()         { *m = QueryDelegatorRedelegationsRequest{} }
+func (m *QueryDelegatorRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRedelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{70}
+}
+func (m *QueryDelegatorRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRedelegationsRequest.DiscardUnknown(m)
 }
 
-func _Query_OperatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/OperatorDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorDelegations(ctx, req.(*QueryOperatorDelegationsRequest))
+var xxx_messageInfo_QueryDelegatorRedelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorRedelegationsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OperatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/OperatorDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorDelegation(ctx, req.(*QueryOperatorDelegationRequest))
+func (m *QueryDelegatorRedelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OperatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/OperatorUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorUnbondingDelegations(ctx, req.(*QueryOperatorUnbondingDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorRedelegationsResponse is response type for the
+// Query/DelegatorRedelegations RPC method.
+type QueryDelegatorRedelegationsResponse struct {
+	// Redelegations is the list of redelegations
+	Redelegations []Redelegation `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OperatorUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorUnbondingDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorUnbondingDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/OperatorUnbondingDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorUnbondingDelegation(ctx, req.(*QueryOperatorUnbondingDelegationRequest))
+func (m *QueryDelegatorRedelegationsResponse) Reset()         { *m = QueryDelegatorRedelegationsResponse{} }
+func (m *QueryDelegatorRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRedelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{71}
+}
+func (m *QueryDelegatorRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRedelegationsResponse.DiscardUnknown(m)
 }
 
-func _Query_ServiceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceDelegations(ctx, req.(*QueryServiceDelegationsRequest))
+var xxx_messageInfo_QueryDelegatorRedelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRedelegationsResponse) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ServiceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceDelegation(ctx, req.(*QueryServiceDelegationRequest))
+func (m *QueryDelegatorRedelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ServiceUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceUnbondingDelegations(ctx, req.(*QueryServiceUnbondingDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTargetRedelegationsRequest is request type for the
+// Query/TargetRedelegations RPC method.
+type QueryTargetRedelegationsRequest struct {
+	// TargetType is the type of the target from which the redelegations have
+	// been made
+	TargetType DelegationType `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"target_type,omitempty"`
+	// TargetID is the ID of the target from which the redelegations have been
+	// made
+	TargetId uint32 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ServiceUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceUnbondingDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceUnbondingDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/ServiceUnbondingDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceUnbondingDelegation(ctx, req.(*QueryServiceUnbondingDelegationRequest))
+func (m *QueryTargetRedelegationsRequest) Reset()         { *m = QueryTargetRedelegationsRequest{} }
+func (m *QueryTargetRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTargetRedelegationsRequest) ProtoMessage()    {}
+func (*QueryTargetRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{72}
+}
+func (m *QueryTargetRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTargetRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTargetRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTargetRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTargetRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryTargetRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTargetRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTargetRedelegationsRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegatorPoolDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorPoolDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorPoolDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPoolDelegations",
+var xxx_messageInfo_QueryTargetRedelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryTargetRedelegationsRequest) GetTargetType() DelegationType {
+	if m != nil {
+		return m.TargetType
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorPoolDelegations(ctx, req.(*QueryDelegatorPoolDelegationsRequest))
+	return DELEGATION_TYPE_UNSPECIFIED
+}
+
+func (m *QueryTargetRedelegationsRequest) GetTargetId() uint32 {
+	if m != nil {
+		return m.TargetId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DelegatorPoolUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorPoolUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorPoolUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPoolUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorPoolUnbondingDelegations(ctx, req.(*QueryDelegatorPoolUnbondingDelegationsRequest))
+func (m *QueryTargetRedelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegatorOperatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorOperatorDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorOperatorDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperatorDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorOperatorDelegations(ctx, req.(*QueryDelegatorOperatorDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTargetRedelegationsResponse is response type for the
+// Query/TargetRedelegations RPC method.
+type QueryTargetRedelegationsResponse struct {
+	// Redelegations is the list of redelegations
+	Redelegations []Redelegation `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
+	// Pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DelegatorOperatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorOperatorUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorOperatorUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperatorUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorOperatorUnbondingDelegations(ctx, req.(*QueryDelegatorOperatorUnbondingDelegationsRequest))
+func (m *QueryTargetRedelegationsResponse) Reset()         { *m = QueryTargetRedelegationsResponse{} }
+func (m *QueryTargetRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTargetRedelegationsResponse) ProtoMessage()    {}
+func (*QueryTargetRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{73}
+}
+func (m *QueryTargetRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTargetRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTargetRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTargetRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTargetRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryTargetRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTargetRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTargetRedelegationsResponse.DiscardUnknown(m)
 }
 
-func _Query_DelegatorServiceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorServiceDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorServiceDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServiceDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorServiceDelegations(ctx, req.(*QueryDelegatorServiceDelegationsRequest))
+var xxx_messageInfo_QueryTargetRedelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryTargetRedelegationsResponse) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegatorServiceUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorServiceUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorServiceUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServiceUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorServiceUnbondingDelegations(ctx, req.(*QueryDelegatorServiceUnbondingDelegationsRequest))
+func (m *QueryTargetRedelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegatorPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorPools(ctx, req.(*QueryDelegatorPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryUserPreferences is request type for the Query/UserPreferences RPC
+// method.
+type QueryUserPreferencesRequest struct {
+	// UserAddress is the address of the user to query the preferences for
+	UserAddress string `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
 }
 
-func _Query_DelegatorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorPool(ctx, req.(*QueryDelegatorPoolRequest))
+func (m *QueryUserPreferencesRequest) Reset()         { *m = QueryUserPreferencesRequest{} }
+func (m *QueryUserPreferencesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserPreferencesRequest) ProtoMessage()    {}
+func (*QueryUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{74}
+}
+func (m *QueryUserPreferencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserPreferencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUserPreferencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserPreferencesRequest.Merge(m, src)
+}
+func (m *QueryUserPreferencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserPreferencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserPreferencesRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegatorOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorOperatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorOperators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorOperators(ctx, req.(*QueryDelegatorOperatorsRequest))
+var xxx_messageInfo_QueryUserPreferencesRequest proto.InternalMessageInfo
+
+func (m *QueryUserPreferencesRequest) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DelegatorOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorOperatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorOperator(ctx, req.(*QueryDelegatorOperatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryUserPreferencesResponse is response type for the Query/UserPreferences
+// RPC method.
+type QueryUserPreferencesResponse struct {
+	// Preferences is the user preferences
+	Preferences UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences"`
 }
 
-func _Query_DelegatorServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorServicesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorServices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServices",
+func (m *QueryUserPreferencesResponse) Reset()         { *m = QueryUserPreferencesResponse{} }
+func (m *QueryUserPreferencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserPreferencesResponse) ProtoMessage()    {}
+func (*QueryUserPreferencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{75}
+}
+func (m *QueryUserPreferencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserPreferencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserPreferencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorServices(ctx, req.(*QueryDelegatorServicesRequest))
+}
+func (m *QueryUserPreferencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserPreferencesResponse.Merge(m, src)
+}
+func (m *QueryUserPreferencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserPreferencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserPreferencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserPreferencesResponse proto.InternalMessageInfo
+
+func (m *QueryUserPreferencesResponse) GetPreferences() UserPreferences {
+	if m != nil {
+		return m.Preferences
 	}
-	return interceptor(ctx, in, info, handler)
+	return UserPreferences{}
 }
 
-func _Query_DelegatorService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorService(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/DelegatorService",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorService(ctx, req.(*QueryDelegatorServiceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func _Query_UserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserPreferencesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPreferences(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/UserPreferences",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPreferences(ctx, req.(*QueryUserPreferencesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{76}
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.restaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "milkyway.restaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "OperatorJoinedServices",
-			Handler:    _Query_OperatorJoinedServices_Handler,
-		},
-		{
-			MethodName: "ServiceAllowedOperators",
-			Handler:    _Query_ServiceAllowedOperators_Handler,
-		},
-		{
-			MethodName: "ServiceSecuringPools",
-			Handler:    _Query_ServiceSecuringPools_Handler,
-		},
-		{
-			MethodName: "ServiceOperators",
-			Handler:    _Query_ServiceOperators_Handler,
-		},
-		{
-			MethodName: "PoolDelegations",
-			Handler:    _Query_PoolDelegations_Handler,
-		},
-		{
-			MethodName: "PoolDelegation",
-			Handler:    _Query_PoolDelegation_Handler,
-		},
-		{
-			MethodName: "PoolUnbondingDelegations",
-			Handler:    _Query_PoolUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "PoolUnbondingDelegation",
-			Handler:    _Query_PoolUnbondingDelegation_Handler,
-		},
-		{
-			MethodName: "OperatorDelegations",
-			Handler:    _Query_OperatorDelegations_Handler,
-		},
-		{
-			MethodName: "OperatorDelegation",
-			Handler:    _Query_OperatorDelegation_Handler,
-		},
-		{
-			MethodName: "OperatorUnbondingDelegations",
-			Handler:    _Query_OperatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "OperatorUnbondingDelegation",
-			Handler:    _Query_OperatorUnbondingDelegation_Handler,
-		},
-		{
-			MethodName: "ServiceDelegations",
-			Handler:    _Query_ServiceDelegations_Handler,
-		},
-		{
-			MethodName: "ServiceDelegation",
-			Handler:    _Query_ServiceDelegation_Handler,
-		},
-		{
-			MethodName: "ServiceUnbondingDelegations",
-			Handler:    _Query_ServiceUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "ServiceUnbondingDelegation",
-			Handler:    _Query_ServiceUnbondingDelegation_Handler,
-		},
-		{
-			MethodName: "DelegatorPoolDelegations",
-			Handler:    _Query_DelegatorPoolDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorPoolUnbondingDelegations",
-			Handler:    _Query_DelegatorPoolUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorOperatorDelegations",
-			Handler:    _Query_DelegatorOperatorDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorOperatorUnbondingDelegations",
-			Handler:    _Query_DelegatorOperatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorServiceDelegations",
-			Handler:    _Query_DelegatorServiceDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorServiceUnbondingDelegations",
-			Handler:    _Query_DelegatorServiceUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorPools",
-			Handler:    _Query_DelegatorPools_Handler,
-		},
-		{
-			MethodName: "DelegatorPool",
-			Handler:    _Query_DelegatorPool_Handler,
-		},
-		{
-			MethodName: "DelegatorOperators",
-			Handler:    _Query_DelegatorOperators_Handler,
-		},
-		{
-			MethodName: "DelegatorOperator",
-			Handler:    _Query_DelegatorOperator_Handler,
-		},
-		{
-			MethodName: "DelegatorServices",
-			Handler:    _Query_DelegatorServices_Handler,
-		},
-		{
-			MethodName: "DelegatorService",
-			Handler:    _Query_DelegatorService_Handler,
-		},
-		{
-			MethodName: "UserPreferences",
-			Handler:    _Query_UserPreferences_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "milkyway/restaking/v1/query.proto",
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryOperatorJoinedServicesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946984316b0f92c4, []int{77}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOperatorJoinedServicesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *QueryOperatorJoinedServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryOperatorJoinedServicesRequest)(nil), "milkyway.restaking.v1.QueryOperatorJoinedServicesRequest")
+	proto.RegisterType((*QueryOperatorJoinedServicesResponse)(nil), "milkyway.restaking.v1.QueryOperatorJoinedServicesResponse")
+	proto.RegisterType((*QueryServiceAllowedOperatorsRequest)(nil), "milkyway.restaking.v1.QueryServiceAllowedOperatorsRequest")
+	proto.RegisterType((*QueryServiceAllowedOperatorsResponse)(nil), "milkyway.restaking.v1.QueryServiceAllowedOperatorsResponse")
+	proto.RegisterType((*QueryServiceSecuringPoolsRequest)(nil), "milkyway.restaking.v1.QueryServiceSecuringPoolsRequest")
+	proto.RegisterType((*QueryServiceSecuringPoolsResponse)(nil), "milkyway.restaking.v1.QueryServiceSecuringPoolsResponse")
+	proto.RegisterType((*QueryServiceActiveOperatorsRequest)(nil), "milkyway.restaking.v1.QueryServiceActiveOperatorsRequest")
+	proto.RegisterType((*QueryServiceActiveOperatorsResponse)(nil), "milkyway.restaking.v1.QueryServiceActiveOperatorsResponse")
+	proto.RegisterType((*QueryServiceValidatingSetRequest)(nil), "milkyway.restaking.v1.QueryServiceValidatingSetRequest")
+	proto.RegisterType((*QueryServiceValidatingSetResponse)(nil), "milkyway.restaking.v1.QueryServiceValidatingSetResponse")
+	proto.RegisterType((*QueryServiceSnapshotRequest)(nil), "milkyway.restaking.v1.QueryServiceSnapshotRequest")
+	proto.RegisterType((*QueryServiceSnapshotResponse)(nil), "milkyway.restaking.v1.QueryServiceSnapshotResponse")
+	proto.RegisterType((*QueryOperatorJailRecordRequest)(nil), "milkyway.restaking.v1.QueryOperatorJailRecordRequest")
+	proto.RegisterType((*QueryOperatorJailRecordResponse)(nil), "milkyway.restaking.v1.QueryOperatorJailRecordResponse")
+	proto.RegisterType((*QueryPendingSlashRequest)(nil), "milkyway.restaking.v1.QueryPendingSlashRequest")
+	proto.RegisterType((*QueryPendingSlashResponse)(nil), "milkyway.restaking.v1.QueryPendingSlashResponse")
+	proto.RegisterType((*QueryPendingSlashesRequest)(nil), "milkyway.restaking.v1.QueryPendingSlashesRequest")
+	proto.RegisterType((*QueryPendingSlashesResponse)(nil), "milkyway.restaking.v1.QueryPendingSlashesResponse")
+	proto.RegisterType((*QueryOperatorServiceKeyRequest)(nil), "milkyway.restaking.v1.QueryOperatorServiceKeyRequest")
+	proto.RegisterType((*QueryOperatorServiceKeyResponse)(nil), "milkyway.restaking.v1.QueryOperatorServiceKeyResponse")
+	proto.RegisterType((*QueryServiceOperatorsRequest)(nil), "milkyway.restaking.v1.QueryServiceOperatorsRequest")
+	proto.RegisterType((*QueryServiceOperatorsResponse)(nil), "milkyway.restaking.v1.QueryServiceOperatorsResponse")
+	proto.RegisterType((*QueryPoolDelegationsRequest)(nil), "milkyway.restaking.v1.QueryPoolDelegationsRequest")
+	proto.RegisterType((*QueryPoolDelegationsResponse)(nil), "milkyway.restaking.v1.QueryPoolDelegationsResponse")
+	proto.RegisterType((*QueryPoolDelegationRequest)(nil), "milkyway.restaking.v1.QueryPoolDelegationRequest")
+	proto.RegisterType((*QueryPoolDelegationResponse)(nil), "milkyway.restaking.v1.QueryPoolDelegationResponse")
+	proto.RegisterType((*QueryPoolUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryPoolUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryPoolUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationRequest")
+	proto.RegisterType((*QueryPoolUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryPoolUnbondingDelegationResponse")
+	proto.RegisterType((*QueryOperatorDelegationsRequest)(nil), "milkyway.restaking.v1.QueryOperatorDelegationsRequest")
+	proto.RegisterType((*QueryOperatorDelegationsResponse)(nil), "milkyway.restaking.v1.QueryOperatorDelegationsResponse")
+	proto.RegisterType((*QueryOperatorDelegationRequest)(nil), "milkyway.restaking.v1.QueryOperatorDelegationRequest")
+	proto.RegisterType((*QueryOperatorDelegationResponse)(nil), "milkyway.restaking.v1.QueryOperatorDelegationResponse")
+	proto.RegisterType((*QueryOperatorUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryOperatorUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryOperatorUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationRequest")
+	proto.RegisterType((*QueryOperatorUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryOperatorUnbondingDelegationResponse")
+	proto.RegisterType((*QueryServiceDelegationsRequest)(nil), "milkyway.restaking.v1.QueryServiceDelegationsRequest")
+	proto.RegisterType((*QueryServiceDelegationsResponse)(nil), "milkyway.restaking.v1.QueryServiceDelegationsResponse")
+	proto.RegisterType((*QueryServiceDelegationRequest)(nil), "milkyway.restaking.v1.QueryServiceDelegationRequest")
+	proto.RegisterType((*QueryServiceDelegationResponse)(nil), "milkyway.restaking.v1.QueryServiceDelegationResponse")
+	proto.RegisterType((*QueryServiceUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryServiceUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryServiceUnbondingDelegationRequest)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationRequest")
+	proto.RegisterType((*QueryServiceUnbondingDelegationResponse)(nil), "milkyway.restaking.v1.QueryServiceUnbondingDelegationResponse")
+	proto.RegisterType((*QueryDelegatorPoolDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorPoolDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorPoolUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorPoolUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorOperatorDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorOperatorDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorOperatorUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorOperatorUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorServiceDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorServiceDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorServiceUnbondingDelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorServiceUnbondingDelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorPoolsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolsRequest")
+	proto.RegisterType((*QueryDelegatorPoolsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolsResponse")
+	proto.RegisterType((*QueryDelegatorPoolRequest)(nil), "milkyway.restaking.v1.QueryDelegatorPoolRequest")
+	proto.RegisterType((*QueryDelegatorPoolResponse)(nil), "milkyway.restaking.v1.QueryDelegatorPoolResponse")
+	proto.RegisterType((*QueryDelegatorOperatorsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorsRequest")
+	proto.RegisterType((*QueryDelegatorOperatorsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorsResponse")
+	proto.RegisterType((*QueryDelegatorOperatorRequest)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorRequest")
+	proto.RegisterType((*QueryDelegatorOperatorResponse)(nil), "milkyway.restaking.v1.QueryDelegatorOperatorResponse")
+	proto.RegisterType((*QueryDelegatorServicesRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServicesRequest")
+	proto.RegisterType((*QueryDelegatorServicesResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServicesResponse")
+	proto.RegisterType((*QueryDelegatorServiceRequest)(nil), "milkyway.restaking.v1.QueryDelegatorServiceRequest")
+	proto.RegisterType((*QueryDelegatorServiceResponse)(nil), "milkyway.restaking.v1.QueryDelegatorServiceResponse")
+	proto.RegisterType((*QueryDelegatorRedelegationsRequest)(nil), "milkyway.restaking.v1.QueryDelegatorRedelegationsRequest")
+	proto.RegisterType((*QueryDelegatorRedelegationsResponse)(nil), "milkyway.restaking.v1.QueryDelegatorRedelegationsResponse")
+	proto.RegisterType((*QueryTargetRedelegationsRequest)(nil), "milkyway.restaking.v1.QueryTargetRedelegationsRequest")
+	proto.RegisterType((*QueryTargetRedelegationsResponse)(nil), "milkyway.restaking.v1.QueryTargetRedelegationsResponse")
+	proto.RegisterType((*QueryUserPreferencesRequest)(nil), "milkyway.restaking.v1.QueryUserPreferencesRequest")
+	proto.RegisterType((*QueryUserPreferencesResponse)(nil), "milkyway.restaking.v1.QueryUserPreferencesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.restaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.restaking.v1.QueryParamsResponse")
 }
 
-func (m *QueryOperatorJoinedServicesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("milkyway/restaking/v1/query.proto", fileDescriptor_946984316b0f92c4) }
+
+var fileDescriptor_946984316b0f92c4 = []byte{
+	// 2899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xfb, 0x6f, 0x14, 0xd7,
+	0xf5, 0xe7, 0x3a, 0x3c, 0x8f, 0x79, 0x5e, 0x1c, 0x30, 0x63, 0xb0, 0xf1, 0x24, 0x10, 0x40, 0xc2,
+	0x83, 0x6d, 0xde, 0x2f, 0x83, 0x31, 0x60, 0xf3, 0x34, 0x4b, 0x20, 0x7c, 0xf9, 0xaa, 0xdd, 0x8e,
+	0xbd, 0xd3, 0x65, 0x61, 0xbd, 0xb3, 0xec, 0xac, 0x9d, 0x5a, 0xae, 0xab, 0xaa, 0x2a, 0x22, 0x2d,
+	0x6d, 0x12, 0x1a, 0xf5, 0xa7, 0xfe, 0x03, 0x55, 0x5f, 0x89, 0xd4, 0x56, 0x6d, 0xd2, 0x36, 0x6d,
+	0x52, 0xa9, 0x41, 0x55, 0x1f, 0x69, 0xf9, 0x21, 0x55, 0x88, 0x5a, 0x04, 0x91, 0xfa, 0x4b, 0xa5,
+	0xb6, 0x52, 0xff, 0x80, 0x6a, 0x66, 0xee, 0x9d, 0x3b, 0x8f, 0x3b, 0x77, 0xe6, 0xae, 0x37, 0xc6,
+	0xdb, 0x5f, 0x22, 0x76, 0x66, 0xce, 0xb9, 0xe7, 0xf3, 0x39, 0xe7, 0x9e, 0x7b, 0x67, 0xee, 0x27,
+	0x86, 0xce, 0xb1, 0x42, 0xf1, 0xc6, 0xe4, 0x8b, 0xfa, 0xa4, 0x56, 0x31, 0xac, 0xaa, 0x7e, 0xa3,
+	0x50, 0xca, 0x6b, 0x13, 0xdd, 0xda, 0xcd, 0x71, 0xa3, 0x32, 0xd9, 0x55, 0xae, 0x98, 0x55, 0x13,
+	0x3f, 0x4d, 0x1f, 0xe9, 0xf2, 0x1e, 0xe9, 0x9a, 0xe8, 0x56, 0x56, 0xe9, 0x63, 0x85, 0x92, 0xa9,
+	0x39, 0xff, 0x75, 0x9f, 0x54, 0xb6, 0x8d, 0x9a, 0xd6, 0x98, 0x69, 0x69, 0x23, 0xba, 0x65, 0xb8,
+	0x2e, 0xb4, 0x89, 0xee, 0x11, 0xa3, 0xaa, 0x77, 0x6b, 0x65, 0x3d, 0x5f, 0x28, 0xe9, 0xd5, 0x82,
+	0x59, 0x22, 0xcf, 0xb6, 0x91, 0x67, 0xe9, 0x63, 0xfe, 0x21, 0x95, 0x75, 0xee, 0xcd, 0xac, 0xf3,
+	0x4b, 0x73, 0x7f, 0x90, 0x5b, 0x2d, 0x79, 0x33, 0x6f, 0xba, 0xd7, 0xed, 0x7f, 0x91, 0xab, 0xeb,
+	0xf3, 0xa6, 0x99, 0x2f, 0x1a, 0x9a, 0x5e, 0x2e, 0x68, 0x7a, 0xa9, 0x64, 0x56, 0x9d, 0xa1, 0xa8,
+	0x8d, 0xea, 0x81, 0x34, 0xcb, 0x46, 0x45, 0xaf, 0x9a, 0x15, 0xcb, 0x1e, 0x71, 0xcc, 0xcc, 0x19,
+	0x45, 0xfa, 0x4c, 0xbb, 0xf7, 0x4c, 0xd9, 0x34, 0x8b, 0xd1, 0xfb, 0x2a, 0x9f, 0xa8, 0x34, 0xcf,
+	0x94, 0xf5, 0x8a, 0x3e, 0x46, 0x9f, 0x61, 0x84, 0x5b, 0x46, 0x65, 0xa2, 0x30, 0x6a, 0x44, 0x86,
+	0x52, 0xbf, 0x8e, 0x40, 0xbd, 0x60, 0xb3, 0x71, 0x9e, 0x44, 0x7b, 0xca, 0x2c, 0x94, 0x8c, 0xdc,
+	0x45, 0xf2, 0x7c, 0xc6, 0xb8, 0x39, 0x6e, 0x58, 0x55, 0xdc, 0x01, 0xcd, 0x14, 0x4e, 0xb6, 0x90,
+	0x6b, 0x45, 0x1b, 0xd1, 0x96, 0x65, 0x19, 0xa0, 0x97, 0x86, 0x72, 0xf8, 0x04, 0x00, 0xa3, 0xbd,
+	0xb5, 0x69, 0x23, 0xda, 0xd2, 0xdc, 0xb3, 0xb9, 0x8b, 0xb0, 0x69, 0xe7, 0xa8, 0xcb, 0xe5, 0x9c,
+	0xe4, 0xa8, 0x6b, 0x58, 0xcf, 0x1b, 0xc4, 0x79, 0xc6, 0x67, 0xa9, 0xbe, 0x82, 0xe0, 0x19, 0x61,
+	0x3c, 0x56, 0xd9, 0x2c, 0x59, 0x86, 0x1d, 0x10, 0xc1, 0x94, 0x2d, 0xe4, 0xac, 0x56, 0xb4, 0xf1,
+	0x29, 0x3b, 0x20, 0x72, 0x69, 0x28, 0x67, 0xe1, 0x93, 0x9c, 0x80, 0x9e, 0x4b, 0x0c, 0xc8, 0xf5,
+	0x1e, 0x88, 0xe8, 0x6b, 0x34, 0x22, 0x12, 0xc3, 0xd1, 0x62, 0xd1, 0x7c, 0xd1, 0xc8, 0xd1, 0xf8,
+	0x3c, 0x8a, 0x36, 0x00, 0xb0, 0x88, 0x08, 0x43, 0x4b, 0xbc, 0x80, 0xea, 0x46, 0xd0, 0x37, 0x10,
+	0x3c, 0x2b, 0x0e, 0x87, 0x30, 0xd4, 0x09, 0x4b, 0x7d, 0x29, 0xa3, 0x14, 0x35, 0xb3, 0x9c, 0xd5,
+	0x91, 0xa3, 0xaf, 0x20, 0xd8, 0xe8, 0x0f, 0xea, 0xa2, 0x31, 0x3a, 0x5e, 0x29, 0x94, 0xf2, 0xc3,
+	0x76, 0x79, 0xcf, 0x32, 0x41, 0xb7, 0x11, 0x74, 0x0a, 0x62, 0x21, 0xec, 0xac, 0x83, 0xc5, 0xf6,
+	0xdc, 0xf3, 0x31, 0xb3, 0xc8, 0xfe, 0x5d, 0x57, 0x56, 0xee, 0xd0, 0xb9, 0x45, 0x53, 0x35, 0x5a,
+	0x2d, 0x4c, 0x18, 0x4f, 0xaa, 0x70, 0xee, 0x86, 0xeb, 0x38, 0x1c, 0xcd, 0x13, 0xa8, 0x9b, 0xa3,
+	0xc1, 0xb2, 0xb9, 0xac, 0x17, 0x0b, 0x39, 0xbd, 0x5a, 0x28, 0xe5, 0x2f, 0x1a, 0xd5, 0x74, 0xf4,
+	0xa8, 0x15, 0xe8, 0x14, 0xb8, 0x20, 0x98, 0xce, 0xc2, 0x12, 0xaf, 0x1b, 0x3b, 0x80, 0x9a, 0x7b,
+	0xb6, 0x76, 0x71, 0x97, 0x9a, 0x2e, 0xe6, 0x80, 0x52, 0xd3, 0x3f, 0xff, 0xde, 0x5f, 0x3b, 0xe6,
+	0x65, 0x98, 0x07, 0x35, 0x03, 0x6d, 0x81, 0x0a, 0x2b, 0xe9, 0x65, 0xeb, 0x9a, 0x99, 0x32, 0x62,
+	0xdc, 0x02, 0x0b, 0x8c, 0xb2, 0x39, 0x7a, 0xcd, 0x21, 0x6e, 0x7e, 0xc6, 0xfd, 0xa1, 0x5e, 0x83,
+	0xf5, 0x7c, 0x9f, 0x04, 0xc2, 0x20, 0x2c, 0xb6, 0xc8, 0xb5, 0x56, 0x44, 0x8a, 0x80, 0x8f, 0x20,
+	0xe4, 0x81, 0x84, 0xef, 0x59, 0xab, 0x9f, 0x81, 0xf6, 0x60, 0x87, 0xd5, 0x0b, 0xc5, 0x8c, 0x31,
+	0x6a, 0x56, 0x72, 0x29, 0x01, 0x84, 0x16, 0x83, 0xa6, 0xf0, 0x62, 0xa0, 0x5a, 0xd0, 0x11, 0x3b,
+	0x02, 0x81, 0x33, 0x0c, 0xcd, 0xd7, 0xf5, 0x42, 0x31, 0x5b, 0x71, 0x2e, 0x13, 0x44, 0x71, 0x39,
+	0x89, 0xfa, 0x21, 0xa0, 0xe0, 0xba, 0x77, 0x45, 0x1d, 0x80, 0x56, 0x67, 0xd0, 0x61, 0xa3, 0x94,
+	0xb3, 0xd3, 0x5f, 0xd4, 0xad, 0x6b, 0x14, 0xd0, 0x16, 0x58, 0x59, 0x76, 0x2f, 0x67, 0x2d, 0xfb,
+	0x3a, 0x85, 0x35, 0x3f, 0xb3, 0xbc, 0xec, 0x7b, 0x7c, 0x28, 0xa7, 0xde, 0x80, 0x75, 0x1c, 0x2f,
+	0x24, 0xe8, 0x73, 0xb0, 0x2c, 0xe0, 0x86, 0x84, 0xfd, 0x4c, 0x4c, 0xd8, 0x7e, 0x1f, 0x24, 0xe0,
+	0xa5, 0xfe, 0xe1, 0xd4, 0x1c, 0x28, 0x91, 0xc1, 0xd8, 0x9a, 0x1b, 0x9c, 0xf8, 0xa8, 0xe6, 0x89,
+	0xff, 0x16, 0x82, 0x36, 0xee, 0x30, 0x04, 0x55, 0x06, 0x56, 0x04, 0x50, 0x19, 0x74, 0x8a, 0x48,
+	0xe0, 0x0a, 0xd0, 0x68, 0xd4, 0xb1, 0x43, 0x84, 0x8b, 0x95, 0x14, 0xf7, 0x69, 0x63, 0x32, 0xf5,
+	0xd6, 0x24, 0x58, 0xcd, 0x4d, 0xe1, 0x06, 0xf2, 0x06, 0x82, 0x8e, 0xd8, 0x21, 0x08, 0x45, 0x47,
+	0xe1, 0xa9, 0x1b, 0xc6, 0x64, 0xca, 0x2a, 0x65, 0xf6, 0x84, 0x1c, 0xdb, 0x16, 0x9f, 0x82, 0x66,
+	0xca, 0xb2, 0xed, 0xaa, 0x49, 0xd2, 0x55, 0x06, 0x88, 0xf5, 0x69, 0x63, 0x52, 0xbd, 0x85, 0x82,
+	0xcd, 0xe2, 0x49, 0x2d, 0x29, 0xdf, 0x47, 0xb0, 0x21, 0x26, 0x0e, 0x42, 0xdc, 0xb1, 0x68, 0xe3,
+	0xed, 0x60, 0x98, 0xbd, 0x5b, 0x7e, 0xcc, 0x91, 0x76, 0x5b, 0xbf, 0x62, 0xfa, 0x02, 0x9d, 0x08,
+	0xa6, 0x59, 0x1c, 0x30, 0x8a, 0x46, 0xde, 0xb9, 0xec, 0xb1, 0xb6, 0x16, 0x16, 0x91, 0x3d, 0x01,
+	0xa1, 0x6c, 0xa1, 0xbb, 0x25, 0xa8, 0x1b, 0x5f, 0x6f, 0xd1, 0xbc, 0x45, 0x02, 0x20, 0x74, 0x5d,
+	0x80, 0xe6, 0x1c, 0xbb, 0x9c, 0xb0, 0x52, 0x31, 0x07, 0xd4, 0x9e, 0x50, 0xe7, 0xf7, 0x51, 0x3f,
+	0xf2, 0x3e, 0x0f, 0x0a, 0x27, 0xf6, 0x44, 0xee, 0x8e, 0xc3, 0x2a, 0x12, 0x8e, 0x59, 0xc9, 0xea,
+	0xb9, 0x5c, 0xc5, 0xb0, 0x2c, 0x27, 0x8c, 0x25, 0xfd, 0xad, 0x7f, 0xfe, 0xd1, 0xf6, 0x16, 0x12,
+	0xc9, 0x51, 0xf7, 0xce, 0xc5, 0xaa, 0xbd, 0x55, 0xcb, 0xac, 0xf4, 0x4c, 0xc8, 0x75, 0xb5, 0xc4,
+	0x4d, 0x9d, 0x47, 0xdc, 0x79, 0x00, 0x06, 0x3a, 0x61, 0x9e, 0xc6, 0xf2, 0xe6, 0x73, 0xa1, 0xde,
+	0xa6, 0xdb, 0x6c, 0x7b, 0xc0, 0x4b, 0xa5, 0x11, 0xd3, 0x99, 0x7c, 0x4f, 0xa2, 0x68, 0x3e, 0x40,
+	0xb0, 0x29, 0x21, 0x12, 0x42, 0x82, 0x01, 0x4f, 0x8f, 0xd3, 0xfb, 0xd9, 0x68, 0x1d, 0x6d, 0x8b,
+	0xe1, 0x83, 0xe3, 0x93, 0x10, 0xd2, 0x32, 0xce, 0x19, 0xae, 0x7e, 0x15, 0x75, 0x8b, 0xee, 0x48,
+	0x63, 0x90, 0xcd, 0x56, 0x6d, 0xdd, 0x49, 0xc8, 0xb5, 0x47, 0xf0, 0x28, 0xb4, 0xf0, 0x08, 0x26,
+	0xf5, 0x26, 0xcf, 0xef, 0x6a, 0x0e, 0xbf, 0xea, 0x57, 0xc3, 0xeb, 0x11, 0xa7, 0xe8, 0x66, 0xed,
+	0x75, 0xfc, 0x6d, 0xfa, 0x62, 0xc7, 0x0d, 0xa6, 0x01, 0xba, 0xd6, 0x4b, 0x28, 0xb4, 0x81, 0x88,
+	0x96, 0x57, 0x22, 0x99, 0x75, 0x2a, 0xb3, 0x4a, 0x6c, 0x5e, 0x3f, 0xb9, 0x36, 0xf6, 0x1a, 0x82,
+	0x2d, 0x81, 0x41, 0x45, 0xad, 0x6c, 0xd6, 0xaa, 0xea, 0x01, 0x82, 0xad, 0x29, 0xa2, 0x6a, 0xd0,
+	0xb6, 0x76, 0x17, 0xc1, 0x73, 0x49, 0xe8, 0x66, 0xbb, 0xf6, 0x5e, 0x49, 0x51, 0x07, 0xb3, 0xdb,
+	0xe6, 0x6e, 0xd3, 0x89, 0x49, 0xf6, 0x8e, 0x9c, 0x7a, 0x9c, 0xa5, 0x5d, 0xec, 0x2f, 0x68, 0xc3,
+	0xe5, 0x45, 0xd2, 0x00, 0x2d, 0xee, 0x56, 0x68, 0x17, 0x1e, 0xad, 0xb2, 0x04, 0x22, 0xeb, 0x54,
+	0x63, 0x37, 0xe3, 0x12, 0xfa, 0xc9, 0xb5, 0xb7, 0x57, 0xe9, 0x54, 0x23, 0x63, 0x8a, 0xba, 0xdb,
+	0x2c, 0x55, 0xd3, 0x87, 0x74, 0xa6, 0x09, 0x43, 0x6a, 0xd0, 0xd6, 0xf6, 0x32, 0x82, 0xcd, 0x09,
+	0xe0, 0x66, 0xb7, 0xe6, 0x5e, 0x4e, 0x2e, 0x80, 0xd9, 0x6d, 0x6b, 0x3f, 0xa6, 0x7b, 0xc9, 0x01,
+	0x1a, 0x6a, 0xcc, 0xcb, 0x26, 0x97, 0x00, 0x24, 0x4b, 0x40, 0xdd, 0xca, 0xf6, 0xd7, 0xf4, 0x2d,
+	0x23, 0x3e, 0xee, 0x06, 0x68, 0x85, 0x6f, 0x23, 0xd8, 0x1e, 0x45, 0x21, 0xea, 0x0a, 0x73, 0x2c,
+	0x0d, 0x0f, 0x11, 0x74, 0xa5, 0x05, 0xd0, 0xa0, 0x3d, 0xe4, 0x4d, 0xda, 0x20, 0x3d, 0x88, 0x82,
+	0x17, 0x9d, 0x39, 0x96, 0x9e, 0xf7, 0xe8, 0xc6, 0x55, 0x1c, 0x7b, 0x03, 0xcc, 0x94, 0x77, 0x11,
+	0x74, 0xf3, 0x91, 0x34, 0xd0, 0x6c, 0xf9, 0x18, 0x41, 0x8f, 0x0c, 0x88, 0x06, 0x9d, 0x31, 0x3f,
+	0xa5, 0x8b, 0x9c, 0x07, 0x33, 0x7e, 0xcf, 0x3c, 0xc7, 0x32, 0xf4, 0x9b, 0xc8, 0x64, 0x6f, 0xd0,
+	0x4d, 0xf6, 0x3b, 0x08, 0x76, 0x70, 0x81, 0x34, 0xd0, 0x74, 0x79, 0x1c, 0x99, 0xf3, 0xff, 0x8b,
+	0x7b, 0xd4, 0xef, 0x22, 0xf2, 0xa1, 0x3a, 0xb0, 0x84, 0xce, 0xd5, 0x9c, 0x7c, 0x8b, 0x1e, 0xce,
+	0x85, 0xa3, 0x25, 0xec, 0xf7, 0xc2, 0x02, 0x47, 0x23, 0x44, 0xd8, 0x5e, 0xcb, 0xd8, 0x76, 0x2e,
+	0x3b, 0xc7, 0x71, 0xa6, 0x59, 0x24, 0xd4, 0xba, 0xcf, 0xd6, 0x8f, 0xcb, 0x29, 0x58, 0x17, 0x0d,
+	0xae, 0xce, 0x4c, 0xfa, 0xbe, 0xee, 0x36, 0xf9, 0xbf, 0xee, 0xaa, 0xe7, 0x79, 0x79, 0xf4, 0x88,
+	0xe9, 0x86, 0xf9, 0xf6, 0x73, 0x64, 0xf7, 0x9e, 0xc0, 0x8b, 0xf3, 0xa8, 0xfa, 0x3a, 0xfd, 0xe4,
+	0x10, 0x59, 0x2e, 0xe6, 0x6a, 0x75, 0xbc, 0x4e, 0x3f, 0x4d, 0xf0, 0x22, 0x9e, 0x93, 0x47, 0x6c,
+	0xb7, 0xe9, 0xc7, 0x88, 0x48, 0xc4, 0x75, 0xa6, 0x38, 0x51, 0x84, 0x30, 0x1a, 0x97, 0x6c, 0xdf,
+	0xa9, 0xee, 0x62, 0xfa, 0x3c, 0x29, 0xa3, 0x94, 0xc4, 0x79, 0x66, 0xea, 0x0f, 0x22, 0x70, 0xc3,
+	0xca, 0xb9, 0x39, 0x56, 0x51, 0xdf, 0x89, 0xcc, 0x81, 0x88, 0xb4, 0xae, 0x0f, 0x16, 0x53, 0xb9,
+	0x20, 0xa9, 0xa7, 0x0d, 0x8c, 0x16, 0x7a, 0xc7, 0x27, 0x34, 0xa1, 0xa4, 0xd0, 0x5b, 0xf5, 0x2b,
+	0xa6, 0x2f, 0xd3, 0xf3, 0xd2, 0x70, 0xb0, 0x75, 0x26, 0x37, 0x41, 0x21, 0xf0, 0xe9, 0x98, 0x1c,
+	0x7b, 0x8c, 0x1d, 0x82, 0x45, 0xe4, 0x69, 0x52, 0x47, 0xa9, 0x08, 0xa3, 0x36, 0xea, 0x0f, 0xa9,
+	0x4e, 0xcc, 0x1b, 0x20, 0x63, 0xe4, 0xe6, 0xfc, 0x6e, 0xe2, 0x97, 0xf4, 0xf4, 0x2e, 0x2e, 0x6a,
+	0xef, 0xa3, 0xdf, 0xb2, 0x8a, 0x11, 0xdd, 0x37, 0xc4, 0x89, 0x4b, 0xfc, 0x4e, 0x08, 0x51, 0x41,
+	0xfb, 0xfa, 0x95, 0xd7, 0x1f, 0x68, 0x77, 0x7d, 0x5e, 0xaf, 0xe4, 0x8d, 0x2a, 0x97, 0xf4, 0x13,
+	0xd0, 0x5c, 0x75, 0xee, 0x66, 0xab, 0x93, 0x65, 0x37, 0xbd, 0xcb, 0x7b, 0x36, 0x25, 0xee, 0x49,
+	0x9f, 0x9f, 0x2c, 0x1b, 0x19, 0x70, 0x2d, 0xed, 0x7f, 0xe3, 0x36, 0x58, 0x42, 0xfc, 0x78, 0x15,
+	0xb6, 0xd8, 0xbd, 0x10, 0xf9, 0xf6, 0xf8, 0x54, 0xcd, 0x29, 0xf9, 0x39, 0x3d, 0xad, 0xe3, 0x02,
+	0x9a, 0xf3, 0xf9, 0x38, 0x42, 0xb6, 0x42, 0x97, 0x2c, 0xa3, 0x32, 0x5c, 0x31, 0x3e, 0x6b, 0x54,
+	0x8c, 0x92, 0xaf, 0x93, 0x76, 0xc2, 0xd2, 0x71, 0xcb, 0x08, 0x95, 0x7e, 0xa6, 0xd9, 0xbe, 0xc6,
+	0x54, 0x02, 0xeb, 0xf9, 0x1e, 0x3c, 0x01, 0x57, 0x73, 0x99, 0x5d, 0x4e, 0xd0, 0xd1, 0x85, 0x9c,
+	0xd0, 0xd7, 0x0b, 0x9f, 0x03, 0xb5, 0x05, 0xb0, 0x7b, 0x70, 0xec, 0xa8, 0xae, 0x49, 0xa0, 0xea,
+	0x0b, 0xb0, 0x3a, 0x70, 0x95, 0x0c, 0x7e, 0x04, 0x16, 0xba, 0xea, 0xec, 0x68, 0x93, 0x08, 0xca,
+	0xab, 0x9c, 0x87, 0xfa, 0x97, 0xd8, 0xc3, 0x7d, 0xfb, 0xef, 0x6f, 0x6c, 0x43, 0x19, 0x62, 0xd7,
+	0xf3, 0x93, 0x63, 0xb0, 0xc0, 0xf1, 0x8c, 0x3f, 0x42, 0xb0, 0x86, 0xaf, 0x90, 0xc6, 0xfb, 0x62,
+	0xdc, 0x26, 0xab, 0xbc, 0x95, 0xfd, 0xb5, 0x98, 0xba, 0xe8, 0xd4, 0xd3, 0x2f, 0xd9, 0xa1, 0x7e,
+	0xe9, 0xfe, 0xc7, 0xaf, 0x35, 0x1d, 0xc1, 0x87, 0x35, 0xbe, 0x3a, 0x9d, 0x49, 0xe2, 0xa7, 0x7c,
+	0x8b, 0xf7, 0xb4, 0x76, 0xdd, 0xf1, 0x99, 0xf5, 0x56, 0x90, 0xbf, 0x21, 0x58, 0x1b, 0xa3, 0x6f,
+	0xc6, 0xc2, 0x20, 0xc5, 0x1a, 0x6d, 0xe5, 0x40, 0x4d, 0xb6, 0x32, 0x08, 0x29, 0x04, 0x6d, 0x8a,
+	0xad, 0x28, 0xd3, 0x9a, 0xee, 0xfa, 0xcc, 0xb2, 0x0d, 0xd7, 0x7d, 0x04, 0x2d, 0x3c, 0x81, 0x32,
+	0xde, 0x93, 0x22, 0x44, 0x9e, 0xbc, 0x5a, 0xd9, 0x2b, 0x6f, 0x48, 0x80, 0x0d, 0x32, 0x60, 0x87,
+	0xf0, 0x01, 0x29, 0x60, 0x16, 0x71, 0x98, 0x75, 0x5f, 0x3c, 0x1e, 0x20, 0x58, 0xc3, 0x97, 0x17,
+	0x8b, 0xcb, 0x52, 0x28, 0x90, 0x56, 0xf6, 0xd7, 0x62, 0x4a, 0xb0, 0x9d, 0x62, 0xd8, 0xfa, 0xf0,
+	0x21, 0xb9, 0xa4, 0x39, 0x2e, 0xf9, 0x39, 0x0b, 0xc8, 0x8c, 0x53, 0xe5, 0x8c, 0xa7, 0x6d, 0x56,
+	0xf6, 0xca, 0x1b, 0xce, 0x3c, 0x67, 0x13, 0x9e, 0xc3, 0xac, 0x65, 0x54, 0xf1, 0x7b, 0x08, 0x56,
+	0x84, 0x24, 0xc3, 0xb8, 0x27, 0x4d, 0x2d, 0x05, 0x55, 0xcf, 0x4a, 0xaf, 0x94, 0xcd, 0xcc, 0xe7,
+	0x14, 0xd5, 0x33, 0x5b, 0xda, 0x94, 0xa3, 0xa0, 0x9e, 0xc6, 0x8f, 0x10, 0xe0, 0xa8, 0x54, 0x18,
+	0xef, 0x4a, 0xd5, 0xd5, 0xc2, 0x22, 0x68, 0x65, 0xb7, 0xac, 0x19, 0x81, 0xf4, 0x29, 0x06, 0x29,
+	0x83, 0x87, 0xa5, 0x20, 0xc5, 0xb6, 0x47, 0xa6, 0x94, 0xc6, 0x6f, 0x22, 0x58, 0xea, 0x17, 0xe0,
+	0x62, 0x4d, 0x14, 0x27, 0x47, 0x0c, 0xad, 0xec, 0x48, 0x6f, 0x40, 0x20, 0x9d, 0x60, 0x90, 0x0e,
+	0xe0, 0x7d, 0x31, 0x90, 0x42, 0x1a, 0x62, 0x6d, 0x2a, 0xac, 0xb8, 0x9e, 0xc6, 0xdf, 0x43, 0xb0,
+	0x7c, 0x38, 0x28, 0x14, 0xee, 0x4e, 0x1b, 0x0c, 0x5b, 0xa5, 0x7a, 0x64, 0x4c, 0x08, 0x82, 0x5e,
+	0x86, 0x60, 0x0b, 0xde, 0x9c, 0x0e, 0x01, 0xfe, 0xd0, 0x57, 0x4f, 0x4c, 0x89, 0x9b, 0xae, 0x9e,
+	0x22, 0x3a, 0x65, 0x65, 0xb7, 0xac, 0x19, 0x09, 0xfd, 0x12, 0x0b, 0xfd, 0x14, 0x1e, 0x94, 0x5c,
+	0x58, 0xf9, 0x75, 0x66, 0xeb, 0x91, 0xdf, 0x45, 0xb0, 0x32, 0x2c, 0xdb, 0xc5, 0x69, 0xe6, 0x70,
+	0xa4, 0x3d, 0xef, 0x94, 0x33, 0x22, 0xb0, 0x8e, 0x31, 0x58, 0x7b, 0xf1, 0xee, 0xda, 0xa6, 0x09,
+	0xfe, 0x19, 0x82, 0x15, 0xa1, 0x73, 0x4a, 0x71, 0xef, 0xe2, 0x1f, 0xc6, 0x2a, 0xbd, 0x52, 0x36,
+	0x04, 0x41, 0x1f, 0x43, 0xb0, 0x13, 0xf7, 0xc4, 0xd5, 0x94, 0xf3, 0x3f, 0xf8, 0x4d, 0x91, 0x2f,
+	0x5c, 0xd3, 0x9a, 0x7f, 0xe3, 0xfc, 0x3b, 0x7b, 0x3a, 0x04, 0x9c, 0x27, 0x4c, 0x07, 0x9e, 0xf2,
+	0x56, 0xe9, 0x91, 0x31, 0x21, 0xa1, 0x5f, 0x64, 0xa1, 0x0f, 0xe2, 0x13, 0xf2, 0xa1, 0x6b, 0x53,
+	0x91, 0x37, 0xd1, 0x69, 0xfc, 0x10, 0x41, 0x6b, 0xdc, 0x69, 0x25, 0x3e, 0x90, 0x14, 0xa5, 0xe0,
+	0x3b, 0xba, 0x72, 0xb0, 0x36, 0x63, 0x02, 0x76, 0x88, 0x81, 0x3d, 0x8c, 0x0f, 0xa6, 0x04, 0xcb,
+	0xfd, 0xe4, 0x8d, 0xff, 0x8d, 0x60, 0x6d, 0xcc, 0x78, 0xe2, 0x7d, 0xa9, 0x58, 0xe1, 0xaa, 0x1c,
+	0xa8, 0xc9, 0x56, 0x66, 0xc1, 0x49, 0x85, 0x8f, 0x9b, 0xd6, 0x3f, 0x22, 0x58, 0xcd, 0x39, 0xe5,
+	0xc4, 0xa9, 0xfa, 0x19, 0x27, 0x99, 0x7b, 0xa4, 0xed, 0x08, 0xce, 0x93, 0x0c, 0xe7, 0x41, 0xbc,
+	0x5f, 0xb2, 0x11, 0xfa, 0xb3, 0xf8, 0xd0, 0xd7, 0xd7, 0x7d, 0x09, 0xdc, 0x25, 0x17, 0x98, 0x54,
+	0x5f, 0xe7, 0xa4, 0xed, 0xff, 0x19, 0x9c, 0x61, 0x7c, 0xae, 0x76, 0x38, 0xdc, 0xa4, 0xfd, 0x07,
+	0xc1, 0x7a, 0xd1, 0x59, 0x28, 0xee, 0x4b, 0x13, 0xb5, 0x68, 0x4e, 0x1e, 0xa9, 0xdd, 0x01, 0x21,
+	0xe0, 0x02, 0x23, 0xe0, 0x04, 0x1e, 0x90, 0x24, 0x80, 0x3f, 0x3f, 0x6f, 0x35, 0x41, 0x9b, 0x60,
+	0x6c, 0x7c, 0xb8, 0xc6, 0xa0, 0x29, 0xe8, 0xbe, 0x9a, 0xed, 0x09, 0x66, 0x83, 0x61, 0xbe, 0x8a,
+	0xaf, 0xd4, 0x03, 0x33, 0x37, 0xfd, 0xbf, 0x45, 0x80, 0xa3, 0x07, 0xad, 0xe2, 0x0a, 0x8f, 0x3d,
+	0x53, 0x56, 0x76, 0xcb, 0x9a, 0x11, 0xb0, 0xc7, 0x19, 0xd8, 0xfd, 0x78, 0xaf, 0xd4, 0x12, 0xef,
+	0x4f, 0xea, 0x03, 0x04, 0xab, 0x22, 0xa3, 0xe0, 0x9d, 0x52, 0x41, 0x51, 0x28, 0xbb, 0x24, 0xad,
+	0x08, 0x92, 0xff, 0x63, 0x48, 0xce, 0xe1, 0x33, 0xb5, 0x22, 0xe1, 0xa6, 0xea, 0x9f, 0x08, 0xda,
+	0x04, 0xc7, 0xb0, 0xe2, 0x92, 0x4d, 0x3e, 0x83, 0x56, 0xfa, 0x6a, 0xb6, 0x27, 0xd8, 0xcf, 0x33,
+	0xec, 0x03, 0xb8, 0x5f, 0x0a, 0x3b, 0x7f, 0x92, 0x7e, 0xb1, 0x09, 0x94, 0xf8, 0x81, 0xf1, 0xa1,
+	0xda, 0x02, 0xa6, 0x78, 0x0f, 0xd7, 0x6a, 0x4e, 0xe0, 0x8e, 0x32, 0xb8, 0x57, 0xf0, 0xe5, 0x99,
+	0xc3, 0x8d, 0xdd, 0x2a, 0xc5, 0x09, 0xed, 0xc4, 0x5b, 0xa5, 0x04, 0x59, 0xa1, 0x78, 0xab, 0x94,
+	0xa4, 0xed, 0x4b, 0xb7, 0x55, 0x4a, 0x00, 0xe7, 0x6e, 0x35, 0xec, 0x56, 0xdc, 0x99, 0x28, 0x62,
+	0xc3, 0x03, 0xa9, 0xc3, 0x15, 0xd5, 0xf8, 0xf1, 0x19, 0x7a, 0x91, 0xd9, 0x15, 0x27, 0xa1, 0x67,
+	0x45, 0xe0, 0xf2, 0xf0, 0x2f, 0x04, 0xeb, 0x45, 0x6a, 0x31, 0xf1, 0x4a, 0x9c, 0x42, 0x23, 0x27,
+	0x5e, 0x89, 0xd3, 0x08, 0xd5, 0xd4, 0xb3, 0x0c, 0x78, 0x3f, 0x3e, 0x52, 0x23, 0x70, 0xf6, 0x56,
+	0xf6, 0xcd, 0x26, 0xd8, 0x94, 0x4a, 0x91, 0x85, 0x07, 0xa5, 0x42, 0x17, 0x95, 0xc0, 0x50, 0x1d,
+	0x3c, 0x11, 0x36, 0x5e, 0x60, 0x6c, 0x9c, 0xc1, 0xa7, 0x66, 0x5c, 0x06, 0x8c, 0x97, 0x7f, 0x20,
+	0x68, 0x13, 0xe8, 0xa0, 0xc4, 0xad, 0x3e, 0x59, 0xfb, 0xa5, 0xf4, 0xd5, 0x6c, 0x4f, 0x90, 0x9f,
+	0x61, 0xc8, 0x8f, 0xe2, 0xbe, 0x1a, 0x91, 0x7b, 0x1f, 0xf1, 0xef, 0x36, 0xc1, 0xb3, 0x69, 0x94,
+	0x46, 0xf8, 0xa4, 0x4c, 0xdc, 0xa2, 0x22, 0x18, 0x9c, 0xb9, 0x23, 0xc2, 0xc4, 0x65, 0xc6, 0xc4,
+	0x69, 0x3c, 0x34, 0xe3, 0x1a, 0xf0, 0x38, 0x79, 0x07, 0xc1, 0xf2, 0xa0, 0xd2, 0x47, 0xfc, 0xca,
+	0xcf, 0xd5, 0x30, 0x29, 0x3d, 0x32, 0x26, 0x32, 0x1f, 0x8c, 0x3d, 0x10, 0xa2, 0xce, 0x7e, 0x0f,
+	0xc1, 0xb2, 0xc0, 0x20, 0x78, 0x47, 0xea, 0x78, 0x28, 0x82, 0x6e, 0x09, 0x0b, 0x02, 0x20, 0xc3,
+	0x00, 0x9c, 0xc4, 0xc7, 0x67, 0x00, 0x80, 0xbd, 0x05, 0xe3, 0x3f, 0x21, 0xc0, 0x51, 0x69, 0x8d,
+	0x78, 0x9f, 0x1c, 0x2b, 0x1e, 0x52, 0x76, 0xcb, 0x9a, 0xd5, 0x30, 0xed, 0xe2, 0x90, 0xb1, 0x2e,
+	0xf3, 0x11, 0x82, 0x55, 0x91, 0xc1, 0xc4, 0xdb, 0xe5, 0x38, 0xad, 0x8e, 0xb2, 0x4b, 0xd2, 0x8a,
+	0x00, 0xba, 0xca, 0x00, 0x9d, 0xc7, 0x67, 0x67, 0x08, 0x28, 0xf8, 0x12, 0x84, 0x7f, 0xef, 0x87,
+	0xe7, 0x1d, 0x7a, 0xee, 0x94, 0x99, 0xf9, 0x96, 0x1c, 0xbc, 0xda, 0x8e, 0x3a, 0x13, 0xe0, 0x79,
+	0x1d, 0xe1, 0x03, 0x04, 0x2b, 0xc3, 0x43, 0x89, 0xbf, 0xc3, 0xc6, 0x88, 0x61, 0x94, 0x9d, 0x72,
+	0x46, 0x04, 0xcc, 0x15, 0x06, 0xe6, 0x2c, 0x3e, 0x3d, 0x33, 0x30, 0x81, 0xed, 0xb0, 0x7d, 0x1c,
+	0xb3, 0x86, 0xaf, 0x0d, 0x11, 0x1f, 0x06, 0x0a, 0x55, 0x30, 0xca, 0xfe, 0x5a, 0x4c, 0x65, 0xbe,
+	0x38, 0x24, 0x75, 0xf5, 0xa0, 0xf8, 0xe1, 0x3e, 0x82, 0xd5, 0x1c, 0xb5, 0x85, 0xf8, 0xeb, 0x58,
+	0xbc, 0xde, 0x44, 0xd9, 0x23, 0x6d, 0x27, 0x53, 0x94, 0x81, 0xd8, 0xb5, 0x29, 0x9f, 0xb2, 0x65,
+	0xda, 0xfb, 0x65, 0xa7, 0xee, 0x57, 0x08, 0x56, 0x84, 0xe4, 0x0f, 0xe2, 0xef, 0xea, 0x7c, 0xc9,
+	0x86, 0xd2, 0x2b, 0x65, 0x43, 0x90, 0x0c, 0x30, 0x24, 0xfb, 0xf0, 0x9e, 0x18, 0x24, 0xe3, 0x96,
+	0x61, 0x17, 0xa3, 0x5f, 0x10, 0x32, 0xad, 0xf9, 0xa4, 0x19, 0xf8, 0x0e, 0x82, 0x85, 0xae, 0x92,
+	0x02, 0x6f, 0x15, 0x7e, 0x5d, 0xf5, 0x4b, 0x37, 0x94, 0x6d, 0x69, 0x1e, 0x25, 0x71, 0x6e, 0x63,
+	0x71, 0x76, 0xe0, 0x0d, 0x9a, 0xe8, 0xef, 0xf1, 0xf5, 0x5f, 0xb8, 0xf7, 0xa8, 0x1d, 0xbd, 0xff,
+	0xa8, 0x1d, 0x3d, 0x7c, 0xd4, 0x8e, 0x5e, 0x7d, 0xdc, 0x3e, 0xef, 0xfd, 0xc7, 0xed, 0xf3, 0xfe,
+	0xf2, 0xb8, 0x7d, 0xde, 0xd5, 0x3d, 0xf9, 0x42, 0xf5, 0xda, 0xf8, 0x48, 0xd7, 0xa8, 0x39, 0xe6,
+	0xb9, 0xd8, 0x5e, 0xd4, 0x47, 0x2c, 0xe6, 0x70, 0xa2, 0xbb, 0x47, 0xfb, 0x9c, 0xcf, 0xad, 0x9d,
+	0x2f, 0x6b, 0x64, 0xa1, 0xf3, 0x07, 0xfc, 0x7a, 0xff, 0x3b, 0x00, 0xe3, 0x9b, 0xbd, 0x8f, 0x56,
+	0x51, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OperatorJoinedServices queries the services that an operator has joined.
+	OperatorJoinedServices(ctx context.Context, in *QueryOperatorJoinedServicesRequest, opts ...grpc.CallOption) (*QueryOperatorJoinedServicesResponse, error)
+	// ServiceAllowedOperators queries the allowed operators for a given service.
+	ServiceAllowedOperators(ctx context.Context, in *QueryServiceAllowedOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceAllowedOperatorsResponse, error)
+	// ServiceSecuringPools queries the pools that are securing a given service.
+	ServiceSecuringPools(ctx context.Context, in *QueryServiceSecuringPoolsRequest, opts ...grpc.CallOption) (*QueryServiceSecuringPoolsResponse, error)
+	// ServiceActiveOperators queries the operators that are part of the active
+	// operators set of a given service.
+	ServiceActiveOperators(ctx context.Context, in *QueryServiceActiveOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceActiveOperatorsResponse, error)
+	// ServiceValidatingSet queries the validating set of a given service, along
+	// with the voting power of each operator. Historical validating sets can be
+	// queried by setting the x-cosmos-block-height header.
+	ServiceValidatingSet(ctx context.Context, in *QueryServiceValidatingSetRequest, opts ...grpc.CallOption) (*QueryServiceValidatingSetResponse, error)
+	// ServiceSnapshot queries the snapshot of a service's validating set taken
+	// at a given epoch. If the epoch is 0, the latest snapshot is returned.
+	ServiceSnapshot(ctx context.Context, in *QueryServiceSnapshotRequest, opts ...grpc.CallOption) (*QueryServiceSnapshotResponse, error)
+	// OperatorJailRecord queries the jail record of an operator for a given
+	// service.
+	OperatorJailRecord(ctx context.Context, in *QueryOperatorJailRecordRequest, opts ...grpc.CallOption) (*QueryOperatorJailRecordResponse, error)
+	// PendingSlash queries the pending slash having the given ID.
+	PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error)
+	// PendingSlashes queries all the pending slashes.
+	PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error)
+	// OperatorServiceKey queries the key that an operator uses for a given
+	// service.
+	OperatorServiceKey(ctx context.Context, in *QueryOperatorServiceKeyRequest, opts ...grpc.CallOption) (*QueryOperatorServiceKeyResponse, error)
+	// ServiceOperators queries the operators for a given service.
+	ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error)
+	// PoolDelegations queries the delegations info for the given pool.
+	PoolDelegations(ctx context.Context, in *QueryPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationsResponse, error)
+	// PoolDelegation queries the delegation info for the given pool and
+	// delegator.
+	PoolDelegation(ctx context.Context, in *QueryPoolDelegationRequest, opts ...grpc.CallOption) (*QueryPoolDelegationResponse, error)
+	// PoolUnbondingDelegations queries the unbonding delegations info for the
+	// given pool.
+	PoolUnbondingDelegations(ctx context.Context, in *QueryPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationsResponse, error)
+	// PoolUnbondingDelegation queries the unbonding delegation info for the given
+	// pool and delegator.
+	PoolUnbondingDelegation(ctx context.Context, in *QueryPoolUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationResponse, error)
+	// OperatorDelegations queries the delegations info for the given operator.
+	OperatorDelegations(ctx context.Context, in *QueryOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationsResponse, error)
+	// OperatorDelegation queries the delegation info for the given operator and
+	// delegator.
+	OperatorDelegation(ctx context.Context, in *QueryOperatorDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationResponse, error)
+	// OperatorUnbondingDelegations queries the unbonding delegations info for the
+	// given operator.
+	OperatorUnbondingDelegations(ctx context.Context, in *QueryOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationsResponse, error)
+	// OperatorUnbondingDelegation queries the unbonding delegation info for the
+	// given operator and delegator.
+	OperatorUnbondingDelegation(ctx context.Context, in *QueryOperatorUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationResponse, error)
+	// ServiceDelegations queries the delegations info for the given service.
+	ServiceDelegations(ctx context.Context, in *QueryServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationsResponse, error)
+	// ServiceDelegation queries the delegation info for the given service and
+	// delegator.
+	ServiceDelegation(ctx context.Context, in *QueryServiceDelegationRequest, opts ...grpc.CallOption) (*QueryServiceDelegationResponse, error)
+	// ServiceUnbondingDelegations queries the unbonding delegations info for the
+	// given service.
+	ServiceUnbondingDelegations(ctx context.Context, in *QueryServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationsResponse, error)
+	// ServiceUnbondingDelegation queries the unbonding delegation info for the
+	// given service and delegator.
+	ServiceUnbondingDelegation(ctx context.Context, in *QueryServiceUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationResponse, error)
+	// DelegatorPoolDelegations queries all the pool delegations of a given
+	// delegator address.
+	DelegatorPoolDelegations(ctx context.Context, in *QueryDelegatorPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolDelegationsResponse, error)
+	// DelegatorPoolUnbondingDelegations queries all the pool unbonding
+	// delegations of a given delegator address.
+	DelegatorPoolUnbondingDelegations(ctx context.Context, in *QueryDelegatorPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolUnbondingDelegationsResponse, error)
+	// DelegatorOperatorDelegations queries all the operator delegations of a
+	// given delegator address.
+	DelegatorOperatorDelegations(ctx context.Context, in *QueryDelegatorOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorDelegationsResponse, error)
+	// DelegatorOperatorUnbondingDelegations queries all the operator unbonding
+	// delegations of a given delegator address.
+	DelegatorOperatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error)
+	// DelegatorServiceDelegations queries all the service delegations of a given
+	// delegator address.
+	DelegatorServiceDelegations(ctx context.Context, in *QueryDelegatorServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceDelegationsResponse, error)
+	// DelegatorServiceUnbondingDelegations queries all the service unbonding
+	// delegations of a given delegator address.
+	DelegatorServiceUnbondingDelegations(ctx context.Context, in *QueryDelegatorServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceUnbondingDelegationsResponse, error)
+	// DelegatorPools queries all pools info for given delegator
+	// address.
+	DelegatorPools(ctx context.Context, in *QueryDelegatorPoolsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolsResponse, error)
+	// DelegatorPool queries the pool info for given delegator and pool id.
+	DelegatorPool(ctx context.Context, in *QueryDelegatorPoolRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolResponse, error)
+	// DelegatorOperators queries all operators info for given delegator
+	DelegatorOperators(ctx context.Context, in *QueryDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorsResponse, error)
+	// DelegatorOperator queries the operator info for given delegator and
+	// operator id.
+	DelegatorOperator(ctx context.Context, in *QueryDelegatorOperatorRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorResponse, error)
+	// DelegatorServices queries all services info for given delegator
+	DelegatorServices(ctx context.Context, in *QueryDelegatorServicesRequest, opts ...grpc.CallOption) (*QueryDelegatorServicesResponse, error)
+	// DelegatorService queries the service info for given delegator and service
+	// id.
+	DelegatorService(ctx context.Context, in *QueryDelegatorServiceRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceResponse, error)
+	// DelegatorRedelegations queries all the redelegations of a given delegator
+	// address.
+	DelegatorRedelegations(ctx context.Context, in *QueryDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorRedelegationsResponse, error)
+	// TargetRedelegations queries all the redelegations from a given target.
+	TargetRedelegations(ctx context.Context, in *QueryTargetRedelegationsRequest, opts ...grpc.CallOption) (*QueryTargetRedelegationsResponse, error)
+	// UserPreferences queries the user preferences.
+	UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error)
+	// Params queries the restaking parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OperatorJoinedServices(ctx context.Context, in *QueryOperatorJoinedServicesRequest, opts ...grpc.CallOption) (*QueryOperatorJoinedServicesResponse, error) {
+	out := new(QueryOperatorJoinedServicesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorJoinedServices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperatorJoinedServicesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceAllowedOperators(ctx context.Context, in *QueryServiceAllowedOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceAllowedOperatorsResponse, error) {
+	out := new(QueryServiceAllowedOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceAllowedOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperatorJoinedServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ServiceIds) > 0 {
-		dAtA4 := make([]byte, len(m.ServiceIds)*10)
-		var j3 int
-		for _, num := range m.ServiceIds {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ServiceSecuringPools(ctx context.Context, in *QueryServiceSecuringPoolsRequest, opts ...grpc.CallOption) (*QueryServiceSecuringPoolsResponse, error) {
+	out := new(QueryServiceSecuringPoolsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceSecuringPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceActiveOperators(ctx context.Context, in *QueryServiceActiveOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceActiveOperatorsResponse, error) {
+	out := new(QueryServiceActiveOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceActiveOperators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceValidatingSet(ctx context.Context, in *QueryServiceValidatingSetRequest, opts ...grpc.CallOption) (*QueryServiceValidatingSetResponse, error) {
+	out := new(QueryServiceValidatingSetResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceValidatingSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ServiceSnapshot(ctx context.Context, in *QueryServiceSnapshotRequest, opts ...grpc.CallOption) (*QueryServiceSnapshotResponse, error) {
+	out := new(QueryServiceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorJailRecord(ctx context.Context, in *QueryOperatorJailRecordRequest, opts ...grpc.CallOption) (*QueryOperatorJailRecordResponse, error) {
+	out := new(QueryOperatorJailRecordResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorJailRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error) {
+	out := new(QueryPendingSlashResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PendingSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceAllowedOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorIds) > 0 {
-		dAtA8 := make([]byte, len(m.OperatorIds)*10)
-		var j7 int
-		for _, num := range m.OperatorIds {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintQuery(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error) {
+	out := new(QueryPendingSlashesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PendingSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorServiceKey(ctx context.Context, in *QueryOperatorServiceKeyRequest, opts ...grpc.CallOption) (*QueryOperatorServiceKeyResponse, error) {
+	out := new(QueryOperatorServiceKeyResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorServiceKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceOperators(ctx context.Context, in *QueryServiceOperatorsRequest, opts ...grpc.CallOption) (*QueryServiceOperatorsResponse, error) {
+	out := new(QueryServiceOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) PoolDelegations(ctx context.Context, in *QueryPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationsResponse, error) {
+	out := new(QueryPoolDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolDelegation(ctx context.Context, in *QueryPoolDelegationRequest, opts ...grpc.CallOption) (*QueryPoolDelegationResponse, error) {
+	out := new(QueryPoolDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolDelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolUnbondingDelegations(ctx context.Context, in *QueryPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationsResponse, error) {
+	out := new(QueryPoolUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceSecuringPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PoolIds) > 0 {
-		dAtA12 := make([]byte, len(m.PoolIds)*10)
-		var j11 int
-		for _, num := range m.PoolIds {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintQuery(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PoolUnbondingDelegation(ctx context.Context, in *QueryPoolUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryPoolUnbondingDelegationResponse, error) {
+	out := new(QueryPoolUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/PoolUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorDelegations(ctx context.Context, in *QueryOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationsResponse, error) {
+	out := new(QueryOperatorDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorDelegations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorDelegation(ctx context.Context, in *QueryOperatorDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationResponse, error) {
+	out := new(QueryOperatorDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) OperatorUnbondingDelegations(ctx context.Context, in *QueryOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationsResponse, error) {
+	out := new(QueryOperatorUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorUnbondingDelegation(ctx context.Context, in *QueryOperatorUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryOperatorUnbondingDelegationResponse, error) {
+	out := new(QueryOperatorUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/OperatorUnbondingDelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceDelegations(ctx context.Context, in *QueryServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationsResponse, error) {
+	out := new(QueryServiceDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryServiceOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operators) > 0 {
-		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ServiceDelegation(ctx context.Context, in *QueryServiceDelegationRequest, opts ...grpc.CallOption) (*QueryServiceDelegationResponse, error) {
+	out := new(QueryServiceDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceUnbondingDelegations(ctx context.Context, in *QueryServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationsResponse, error) {
+	out := new(QueryServiceUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceUnbondingDelegations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceUnbondingDelegation(ctx context.Context, in *QueryServiceUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryServiceUnbondingDelegationResponse, error) {
+	out := new(QueryServiceUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/ServiceUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) DelegatorPoolDelegations(ctx context.Context, in *QueryDelegatorPoolDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolDelegationsResponse, error) {
+	out := new(QueryDelegatorPoolDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPoolDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) DelegatorPoolUnbondingDelegations(ctx context.Context, in *QueryDelegatorPoolUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolUnbondingDelegationsResponse, error) {
+	out := new(QueryDelegatorPoolUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPoolUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorOperatorDelegations(ctx context.Context, in *QueryDelegatorOperatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorDelegationsResponse, error) {
+	out := new(QueryDelegatorOperatorDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperatorDelegations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorOperatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorOperatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error) {
+	out := new(QueryDelegatorOperatorUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperatorUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) DelegatorServiceDelegations(ctx context.Context, in *QueryDelegatorServiceDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceDelegationsResponse, error) {
+	out := new(QueryDelegatorServiceDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServiceDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorServiceUnbondingDelegations(ctx context.Context, in *QueryDelegatorServiceUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceUnbondingDelegationsResponse, error) {
+	out := new(QueryDelegatorServiceUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServiceUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorPools(ctx context.Context, in *QueryDelegatorPoolsRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolsResponse, error) {
+	out := new(QueryDelegatorPoolsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorPool(ctx context.Context, in *QueryDelegatorPoolRequest, opts ...grpc.CallOption) (*QueryDelegatorPoolResponse, error) {
+	out := new(QueryDelegatorPoolResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) DelegatorOperators(ctx context.Context, in *QueryDelegatorOperatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorsResponse, error) {
+	out := new(QueryDelegatorOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) DelegatorOperator(ctx context.Context, in *QueryDelegatorOperatorRequest, opts ...grpc.CallOption) (*QueryDelegatorOperatorResponse, error) {
+	out := new(QueryDelegatorOperatorResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorServices(ctx context.Context, in *QueryDelegatorServicesRequest, opts ...grpc.CallOption) (*QueryDelegatorServicesResponse, error) {
+	out := new(QueryDelegatorServicesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorServices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorService(ctx context.Context, in *QueryDelegatorServiceRequest, opts ...grpc.CallOption) (*QueryDelegatorServiceResponse, error) {
+	out := new(QueryDelegatorServiceResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DelegatorRedelegations(ctx context.Context, in *QueryDelegatorRedelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorRedelegationsResponse, error) {
+	out := new(QueryDelegatorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/DelegatorRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TargetRedelegations(ctx context.Context, in *QueryTargetRedelegationsRequest, opts ...grpc.CallOption) (*QueryTargetRedelegationsResponse, error) {
+	out := new(QueryTargetRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/TargetRedelegations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserPreferences(ctx context.Context, in *QueryUserPreferencesRequest, opts ...grpc.CallOption) (*QueryUserPreferencesResponse, error) {
+	out := new(QueryUserPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/UserPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPoolUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.restaking.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// OperatorJoinedServices queries the services that an operator has joined.
+	OperatorJoinedServices(context.Context, *QueryOperatorJoinedServicesRequest) (*QueryOperatorJoinedServicesResponse, error)
+	// ServiceAllowedOperators queries the allowed operators for a given service.
+	ServiceAllowedOperators(context.Context, *QueryServiceAllowedOperatorsRequest) (*QueryServiceAllowedOperatorsResponse, error)
+	// ServiceSecuringPools queries the pools that are securing a given service.
+	ServiceSecuringPools(context.Context, *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error)
+	// ServiceActiveOperators queries the operators that are part of the active
+	// operators set of a given service.
+	ServiceActiveOperators(context.Context, *QueryServiceActiveOperatorsRequest) (*QueryServiceActiveOperatorsResponse, error)
+	// ServiceValidatingSet queries the validating set of a given service, along
+	// with the voting power of each operator. Historical validating sets can be
+	// queried by setting the x-cosmos-block-height header.
+	ServiceValidatingSet(context.Context, *QueryServiceValidatingSetRequest) (*QueryServiceValidatingSetResponse, error)
+	// ServiceSnapshot queries the snapshot of a service's validating set taken
+	// at a given epoch. If the epoch is 0, the latest snapshot is returned.
+	ServiceSnapshot(context.Context, *QueryServiceSnapshotRequest) (*QueryServiceSnapshotResponse, error)
+	// OperatorJailRecord queries the jail record of an operator for a given
+	// service.
+	OperatorJailRecord(context.Context, *QueryOperatorJailRecordRequest) (*QueryOperatorJailRecordResponse, error)
+	// PendingSlash queries the pending slash having the given ID.
+	PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error)
+	// PendingSlashes queries all the pending slashes.
+	PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error)
+	// OperatorServiceKey queries the key that an operator uses for a given
+	// service.
+	OperatorServiceKey(context.Context, *QueryOperatorServiceKeyRequest) (*QueryOperatorServiceKeyResponse, error)
+	// ServiceOperators queries the operators for a given service.
+	ServiceOperators(context.Context, *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error)
+	// PoolDelegations queries the delegations info for the given pool.
+	PoolDelegations(context.Context, *QueryPoolDelegationsRequest) (*QueryPoolDelegationsResponse, error)
+	// PoolDelegation queries the delegation info for the given pool and
+	// delegator.
+	PoolDelegation(context.Context, *QueryPoolDelegationRequest) (*QueryPoolDelegationResponse, error)
+	// PoolUnbondingDelegations queries the unbonding delegations info for the
+	// given pool.
+	PoolUnbondingDelegations(context.Context, *QueryPoolUnbondingDelegationsRequest) (*QueryPoolUnbondingDelegationsResponse, error)
+	// PoolUnbondingDelegation queries the unbonding delegation info for the given
+	// pool and delegator.
+	PoolUnbondingDelegation(context.Context, *QueryPoolUnbondingDelegationRequest) (*QueryPoolUnbondingDelegationResponse, error)
+	// OperatorDelegations queries the delegations info for the given operator.
+	OperatorDelegations(context.Context, *QueryOperatorDelegationsRequest) (*QueryOperatorDelegationsResponse, error)
+	// OperatorDelegation queries the delegation info for the given operator and
+	// delegator.
+	OperatorDelegation(context.Context, *QueryOperatorDelegationRequest) (*QueryOperatorDelegationResponse, error)
+	// OperatorUnbondingDelegations queries the unbonding delegations info for the
+	// given operator.
+	OperatorUnbondingDelegations(context.Context, *QueryOperatorUnbondingDelegationsRequest) (*QueryOperatorUnbondingDelegationsResponse, error)
+	// OperatorUnbondingDelegation queries the unbonding delegation info for the
+	// given operator and delegator.
+	OperatorUnbondingDelegation(context.Context, *QueryOperatorUnbondingDelegationRequest) (*QueryOperatorUnbondingDelegationResponse, error)
+	// ServiceDelegations queries the delegations info for the given service.
+	ServiceDelegations(context.Context, *QueryServiceDelegationsRequest) (*QueryServiceDelegationsResponse, error)
+	// ServiceDelegation queries the delegation info for the given service and
+	// delegator.
+	ServiceDelegation(context.Context, *QueryServiceDelegationRequest) (*QueryServiceDelegationResponse, error)
+	// ServiceUnbondingDelegations queries the unbonding delegations info for the
+	// given service.
+	ServiceUnbondingDelegations(context.Context, *QueryServiceUnbondingDelegationsRequest) (*QueryServiceUnbondingDelegationsResponse, error)
+	// ServiceUnbondingDelegation queries the unbonding delegation info for the
+	// given service and delegator.
+	ServiceUnbondingDelegation(context.Context, *QueryServiceUnbondingDelegationRequest) (*QueryServiceUnbondingDelegationResponse, error)
+	// DelegatorPoolDelegations queries all the pool delegations of a given
+	// delegator address.
+	DelegatorPoolDelegations(context.Context, *QueryDelegatorPoolDelegationsRequest) (*QueryDelegatorPoolDelegationsResponse, error)
+	// DelegatorPoolUnbondingDelegations queries all the pool unbonding
+	// delegations of a given delegator address.
+	DelegatorPoolUnbondingDelegations(context.Context, *QueryDelegatorPoolUnbondingDelegationsRequest) (*QueryDelegatorPoolUnbondingDelegationsResponse, error)
+	// DelegatorOperatorDelegations queries all the operator delegations of a
+	// given delegator address.
+	DelegatorOperatorDelegations(context.Context, *QueryDelegatorOperatorDelegationsRequest) (*QueryDelegatorOperatorDelegationsResponse, error)
+	// DelegatorOperatorUnbondingDelegations queries all the operator unbonding
+	// delegations of a given delegator address.
+	DelegatorOperatorUnbondingDelegations(context.Context, *QueryDelegatorOperatorUnbondingDelegationsRequest) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error)
+	// DelegatorServiceDelegations queries all the service delegations of a given
+	// delegator address.
+	DelegatorServiceDelegations(context.Context, *QueryDelegatorServiceDelegationsRequest) (*QueryDelegatorServiceDelegationsResponse, error)
+	// DelegatorServiceUnbondingDelegations queries all the service unbonding
+	// delegations of a given delegator address.
+	DelegatorServiceUnbondingDelegations(context.Context, *QueryDelegatorServiceUnbondingDelegationsRequest) (*QueryDelegatorServiceUnbondingDelegationsResponse, error)
+	// DelegatorPools queries all pools info for given delegator
+	// address.
+	DelegatorPools(context.Context, *QueryDelegatorPoolsRequest) (*QueryDelegatorPoolsResponse, error)
+	// DelegatorPool queries the pool info for given delegator and pool id.
+	DelegatorPool(context.Context, *QueryDelegatorPoolRequest) (*QueryDelegatorPoolResponse, error)
+	// DelegatorOperators queries all operators info for given delegator
+	DelegatorOperators(context.Context, *QueryDelegatorOperatorsRequest) (*QueryDelegatorOperatorsResponse, error)
+	// DelegatorOperator queries the operator info for given delegator and
+	// operator id.
+	DelegatorOperator(context.Context, *QueryDelegatorOperatorRequest) (*QueryDelegatorOperatorResponse, error)
+	// DelegatorServices queries all services info for given delegator
+	DelegatorServices(context.Context, *QueryDelegatorServicesRequest) (*QueryDelegatorServicesResponse, error)
+	// DelegatorService queries the service info for given delegator and service
+	// id.
+	DelegatorService(context.Context, *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error)
+	// DelegatorRedelegations queries all the redelegations of a given delegator
+	// address.
+	DelegatorRedelegations(context.Context, *QueryDelegatorRedelegationsRequest) (*QueryDelegatorRedelegationsResponse, error)
+	// TargetRedelegations queries all the redelegations from a given target.
+	TargetRedelegations(context.Context, *QueryTargetRedelegationsRequest) (*QueryTargetRedelegationsResponse, error)
+	// UserPreferences queries the user preferences.
+	UserPreferences(context.Context, *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error)
+	// Params queries the restaking parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryPoolUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPoolUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OperatorJoinedServices(ctx context.Context, req *QueryOperatorJoinedServicesRequest) (*QueryOperatorJoinedServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorJoinedServices not implemented")
 }
-
-func (m *QueryPoolUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ServiceAllowedOperators(ctx context.Context, req *QueryServiceAllowedOperatorsRequest) (*QueryServiceAllowedOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceAllowedOperators not implemented")
 }
-
-func (m *QueryPoolUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ServiceSecuringPools(ctx context.Context, req *QueryServiceSecuringPoolsRequest) (*QueryServiceSecuringPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceSecuringPools not implemented")
 }
-
-func (m *QueryPoolUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ServiceActiveOperators(ctx context.Context, req *QueryServiceActiveOperatorsRequest) (*QueryServiceActiveOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceActiveOperators not implemented")
 }
-
-func (m *QueryPoolUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ServiceValidatingSet(ctx context.Context, req *QueryServiceValidatingSetRequest) (*QueryServiceValidatingSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceValidatingSet not implemented")
 }
-
-func (m *QueryPoolUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ServiceSnapshot(ctx context.Context, req *QueryServiceSnapshotRequest) (*QueryServiceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceSnapshot not implemented")
 }
-
-func (m *QueryOperatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OperatorJailRecord(ctx context.Context, req *QueryOperatorJailRecordRequest) (*QueryOperatorJailRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorJailRecord not implemented")
 }
-
-func (m *QueryOperatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) PendingSlash(ctx context.Context, req *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlash not implemented")
 }
-
-func (m *QueryOperatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) PendingSlashes(ctx context.Context, req *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlashes not implemented")
 }
-
-func (m *QueryOperatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OperatorServiceKey(ctx context.Context, req *QueryOperatorServiceKeyRequest) (*QueryOperatorServiceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorServiceKey not implemented")
+}
+func (*UnimplementedQueryServer) ServiceOperators(ctx context.Context, req *QueryServiceOperatorsRequest) (*QueryServiceOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceOperators not implemented")
+}
+func (*UnimplementedQueryServer) PoolDelegations(ctx context.Context, req *QueryPoolDelegationsRequest) (*QueryPoolDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegations not implemented")
+}
+func (*UnimplementedQueryServer) PoolDelegation(ctx context.Context, req *QueryPoolDelegationRequest) (*QueryPoolDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegation not implemented")
+}
+func (*UnimplementedQueryServer) PoolUnbondingDelegations(ctx context.Context, req *QueryPoolUnbondingDelegationsRequest) (*QueryPoolUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) PoolUnbondingDelegation(ctx context.Context, req *QueryPoolUnbondingDelegationRequest) (*QueryPoolUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUnbondingDelegation not implemented")
+}
+func (*UnimplementedQueryServer) OperatorDelegations(ctx context.Context, req *QueryOperatorDelegationsRequest) (*QueryOperatorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegations not implemented")
+}
+func (*UnimplementedQueryServer) OperatorDelegation(ctx context.Context, req *QueryOperatorDelegationRequest) (*QueryOperatorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegation not implemented")
+}
+func (*UnimplementedQueryServer) OperatorUnbondingDelegations(ctx context.Context, req *QueryOperatorUnbondingDelegationsRequest) (*QueryOperatorUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) OperatorUnbondingDelegation(ctx context.Context, req *QueryOperatorUnbondingDelegationRequest) (*QueryOperatorUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorUnbondingDelegation not implemented")
+}
+func (*UnimplementedQueryServer) ServiceDelegations(ctx context.Context, req *QueryServiceDelegationsRequest) (*QueryServiceDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ServiceDelegation(ctx context.Context, req *QueryServiceDelegationRequest) (*QueryServiceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegation not implemented")
+}
+func (*UnimplementedQueryServer) ServiceUnbondingDelegations(ctx context.Context, req *QueryServiceUnbondingDelegationsRequest) (*QueryServiceUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ServiceUnbondingDelegation(ctx context.Context, req *QueryServiceUnbondingDelegationRequest) (*QueryServiceUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceUnbondingDelegation not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPoolDelegations(ctx context.Context, req *QueryDelegatorPoolDelegationsRequest) (*QueryDelegatorPoolDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPoolDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPoolUnbondingDelegations(ctx context.Context, req *QueryDelegatorPoolUnbondingDelegationsRequest) (*QueryDelegatorPoolUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPoolUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorOperatorDelegations(ctx context.Context, req *QueryDelegatorOperatorDelegationsRequest) (*QueryDelegatorOperatorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperatorDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorOperatorUnbondingDelegations(ctx context.Context, req *QueryDelegatorOperatorUnbondingDelegationsRequest) (*QueryDelegatorOperatorUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperatorUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorServiceDelegations(ctx context.Context, req *QueryDelegatorServiceDelegationsRequest) (*QueryDelegatorServiceDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServiceDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorServiceUnbondingDelegations(ctx context.Context, req *QueryDelegatorServiceUnbondingDelegationsRequest) (*QueryDelegatorServiceUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServiceUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPools(ctx context.Context, req *QueryDelegatorPoolsRequest) (*QueryDelegatorPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPools not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPool(ctx context.Context, req *QueryDelegatorPoolRequest) (*QueryDelegatorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPool not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorOperators(ctx context.Context, req *QueryDelegatorOperatorsRequest) (*QueryDelegatorOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperators not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorOperator(ctx context.Context, req *QueryDelegatorOperatorRequest) (*QueryDelegatorOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOperator not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorServices(ctx context.Context, req *QueryDelegatorServicesRequest) (*QueryDelegatorServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorServices not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorService(ctx context.Context, req *QueryDelegatorServiceRequest) (*QueryDelegatorServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorService not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorRedelegations(ctx context.Context, req *QueryDelegatorRedelegationsRequest) (*QueryDelegatorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRedelegations not implemented")
+}
+func (*UnimplementedQueryServer) TargetRedelegations(ctx context.Context, req *QueryTargetRedelegationsRequest) (*QueryTargetRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TargetRedelegations not implemented")
+}
+func (*UnimplementedQueryServer) UserPreferences(ctx context.Context, req *QueryUserPreferencesRequest) (*QueryUserPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPreferences not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryOperatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryOperatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OperatorJoinedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorJoinedServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorJoinedServices(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorJoinedServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorJoinedServices(ctx, req.(*QueryOperatorJoinedServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceAllowedOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceAllowedOperatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOperatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOperatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceAllowedOperators(ctx, in)
 	}
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceAllowedOperators",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceAllowedOperators(ctx, req.(*QueryServiceAllowedOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceSecuringPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceSecuringPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOperatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceSecuringPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceSecuringPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceSecuringPools(ctx, req.(*QueryServiceSecuringPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_ServiceActiveOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceActiveOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceActiveOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceActiveOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceActiveOperators(ctx, req.(*QueryServiceActiveOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceValidatingSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceValidatingSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceValidatingSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceValidatingSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceValidatingSet(ctx, req.(*QueryServiceValidatingSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ServiceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceSnapshot(ctx, req.(*QueryServiceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OperatorJailRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorJailRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorJailRecord(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorJailRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorJailRecord(ctx, req.(*QueryOperatorJailRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PendingSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlash(ctx, req.(*QueryPendingSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PendingSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PendingSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlashes(ctx, req.(*QueryPendingSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OperatorServiceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorServiceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorServiceKey(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorServiceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorServiceKey(ctx, req.(*QueryOperatorServiceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceOperatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceOperators(ctx, req.(*QueryServiceOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PoolDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolDelegations(ctx, req.(*QueryPoolDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_PoolDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PoolDelegation(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PoolDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolDelegation(ctx, req.(*QueryPoolDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PoolUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolUnbondingDelegations(ctx, req.(*QueryPoolUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolUnbondingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/PoolUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolUnbondingDelegation(ctx, req.(*QueryPoolUnbondingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_OperatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorDelegations(ctx, req.(*QueryOperatorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorDelegationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorDelegation(ctx, req.(*QueryOperatorDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OperatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorUnbondingDelegations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorUnbondingDelegations(ctx, req.(*QueryOperatorUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorUnbondingDelegationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorUnbondingDelegation(ctx, in)
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/OperatorUnbondingDelegation",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorUnbondingDelegation(ctx, req.(*QueryOperatorUnbondingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceDelegations(ctx, in)
 	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceDelegations",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceDelegations(ctx, req.(*QueryServiceDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceDelegationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceDelegation(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceDelegation(ctx, req.(*QueryServiceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceUnbondingDelegations(ctx, req.(*QueryServiceUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ServiceUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceUnbondingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceUnbondingDelegation(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/ServiceUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceUnbondingDelegation(ctx, req.(*QueryServiceUnbondingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorPoolDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPoolDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPoolDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPoolDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPoolDelegations(ctx, req.(*QueryDelegatorPoolDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_DelegatorPoolUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPoolUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPoolUnbondingDelegations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPoolUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPoolUnbondingDelegations(ctx, req.(*QueryDelegatorPoolUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorOperatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorOperatorDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorOperatorDelegations(ctx, in)
 	}
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperatorDelegations",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorOperatorDelegations(ctx, req.(*QueryDelegatorOperatorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorOperatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorOperatorUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorOperatorUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperatorUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorOperatorUnbondingDelegations(ctx, req.(*QueryDelegatorOperatorUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DelegatorServiceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorServiceDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorServiceDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServiceDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorServiceDelegations(ctx, req.(*QueryDelegatorServiceDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_DelegatorServiceUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorServiceUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorServiceUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServiceUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorServiceUnbondingDelegations(ctx, req.(*QueryDelegatorServiceUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPools(ctx, req.(*QueryDelegatorPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DelegatorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPool(ctx, req.(*QueryDelegatorPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_DelegatorOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorOperators(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorOperators(ctx, req.(*QueryDelegatorOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorOperatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorOperator(ctx, req.(*QueryDelegatorOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorPoolDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_DelegatorServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorServices(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorServices(ctx, req.(*QueryDelegatorServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorServiceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorService(ctx, in)
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorService",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorService(ctx, req.(*QueryDelegatorServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRedelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRedelegations(ctx, in)
 	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/DelegatorRedelegations",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRedelegations(ctx, req.(*QueryDelegatorRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorOperatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TargetRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetRedelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorOperatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorOperatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).TargetRedelegations(ctx, in)
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/TargetRedelegations",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TargetRedelegations(ctx, req.(*QueryTargetRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorOperatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserPreferencesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorOperatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorOperatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).UserPreferences(ctx, in)
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/UserPreferences",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPreferences(ctx, req.(*QueryUserPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.restaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milkyway.restaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OperatorJoinedServices",
+			Handler:    _Query_OperatorJoinedServices_Handler,
+		},
+		{
+			MethodName: "ServiceAllowedOperators",
+			Handler:    _Query_ServiceAllowedOperators_Handler,
+		},
+		{
+			MethodName: "ServiceSecuringPools",
+			Handler:    _Query_ServiceSecuringPools_Handler,
+		},
+		{
+			MethodName: "ServiceActiveOperators",
+			Handler:    _Query_ServiceActiveOperators_Handler,
+		},
+		{
+			MethodName: "ServiceValidatingSet",
+			Handler:    _Query_ServiceValidatingSet_Handler,
+		},
+		{
+			MethodName: "ServiceSnapshot",
+			Handler:    _Query_ServiceSnapshot_Handler,
+		},
+		{
+			MethodName: "OperatorJailRecord",
+			Handler:    _Query_OperatorJailRecord_Handler,
+		},
+		{
+			MethodName: "PendingSlash",
+			Handler:    _Query_PendingSlash_Handler,
+		},
+		{
+			MethodName: "PendingSlashes",
+			Handler:    _Query_PendingSlashes_Handler,
+		},
+		{
+			MethodName: "OperatorServiceKey",
+			Handler:    _Query_OperatorServiceKey_Handler,
+		},
+		{
+			MethodName: "ServiceOperators",
+			Handler:    _Query_ServiceOperators_Handler,
+		},
+		{
+			MethodName: "PoolDelegations",
+			Handler:    _Query_PoolDelegations_Handler,
+		},
+		{
+			MethodName: "PoolDelegation",
+			Handler:    _Query_PoolDelegation_Handler,
+		},
+		{
+			MethodName: "PoolUnbondingDelegations",
+			Handler:    _Query_PoolUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "PoolUnbondingDelegation",
+			Handler:    _Query_PoolUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "OperatorDelegations",
+			Handler:    _Query_OperatorDelegations_Handler,
+		},
+		{
+			MethodName: "OperatorDelegation",
+			Handler:    _Query_OperatorDelegation_Handler,
+		},
+		{
+			MethodName: "OperatorUnbondingDelegations",
+			Handler:    _Query_OperatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "OperatorUnbondingDelegation",
+			Handler:    _Query_OperatorUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "ServiceDelegations",
+			Handler:    _Query_ServiceDelegations_Handler,
+		},
+		{
+			MethodName: "ServiceDelegation",
+			Handler:    _Query_ServiceDelegation_Handler,
+		},
+		{
+			MethodName: "ServiceUnbondingDelegations",
+			Handler:    _Query_ServiceUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "ServiceUnbondingDelegation",
+			Handler:    _Query_ServiceUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorPoolDelegations",
+			Handler:    _Query_DelegatorPoolDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorPoolUnbondingDelegations",
+			Handler:    _Query_DelegatorPoolUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorOperatorDelegations",
+			Handler:    _Query_DelegatorOperatorDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorOperatorUnbondingDelegations",
+			Handler:    _Query_DelegatorOperatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorServiceDelegations",
+			Handler:    _Query_DelegatorServiceDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorServiceUnbondingDelegations",
+			Handler:    _Query_DelegatorServiceUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorPools",
+			Handler:    _Query_DelegatorPools_Handler,
+		},
+		{
+			MethodName: "DelegatorPool",
+			Handler:    _Query_DelegatorPool_Handler,
+		},
+		{
+			MethodName: "DelegatorOperators",
+			Handler:    _Query_DelegatorOperators_Handler,
+		},
+		{
+			MethodName: "DelegatorOperator",
+			Handler:    _Query_DelegatorOperator_Handler,
+		},
+		{
+			MethodName: "DelegatorServices",
+			Handler:    _Query_DelegatorServices_Handler,
+		},
+		{
+			MethodName: "DelegatorService",
+			Handler:    _Query_DelegatorService_Handler,
+		},
+		{
+			MethodName: "DelegatorRedelegations",
+			Handler:    _Query_DelegatorRedelegations_Handler,
+		},
+		{
+			MethodName: "TargetRedelegations",
+			Handler:    _Query_TargetRedelegations_Handler,
+		},
+		{
+			MethodName: "UserPreferences",
+			Handler:    _Query_UserPreferences_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milkyway/restaking/v1/query.proto",
+}
+
+func (m *QueryOperatorJoinedServicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorJoinedServicesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorJoinedServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6359,17 +6151,15 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) MarshalToSizedBuffer
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorJoinedServicesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6379,12 +6169,12 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Marshal() (dAtA []b
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorJoinedServicesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorJoinedServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6401,24 +6191,28 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) MarshalToSizedBuffe
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.ServiceIds) > 0 {
+		dAtA4 := make([]byte, len(m.ServiceIds)*10)
+		var j3 int
+		for _, num := range m.ServiceIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceAllowedOperatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6428,12 +6222,12 @@ func (m *QueryDelegatorServiceDelegationsRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceAllowedOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceAllowedOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6450,17 +6244,15 @@ func (m *QueryDelegatorServiceDelegationsRequest) MarshalToSizedBuffer(dAtA []by
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceAllowedOperatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6470,12 +6262,12 @@ func (m *QueryDelegatorServiceDelegationsResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceAllowedOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceAllowedOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6492,24 +6284,28 @@ func (m *QueryDelegatorServiceDelegationsResponse) MarshalToSizedBuffer(dAtA []b
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.OperatorIds) > 0 {
+		dAtA8 := make([]byte, len(m.OperatorIds)*10)
+		var j7 int
+		for _, num := range m.OperatorIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-	}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceSecuringPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6519,12 +6315,12 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Marshal() (dAtA []byt
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceSecuringPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceSecuringPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6541,17 +6337,15 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) MarshalToSizedBuffer(
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceSecuringPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6561,12 +6355,12 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Marshal() (dAtA []by
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceSecuringPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceSecuringPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6583,24 +6377,28 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) MarshalToSizedBuffer
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.UnbondingDelegations) > 0 {
-		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.PoolIds) > 0 {
+		dAtA12 := make([]byte, len(m.PoolIds)*10)
+		var j11 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceActiveOperatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6610,12 +6408,12 @@ func (m *QueryDelegatorPoolsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceActiveOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceActiveOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6632,17 +6430,15 @@ func (m *QueryDelegatorPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceActiveOperatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6652,12 +6448,12 @@ func (m *QueryDelegatorPoolsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceActiveOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceActiveOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6674,10 +6470,79 @@ func (m *QueryDelegatorPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OperatorIds) > 0 {
+		dAtA16 := make([]byte, len(m.OperatorIds)*10)
+		var j15 int
+		for _, num := range m.OperatorIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceValidatingSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceValidatingSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceValidatingSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceValidatingSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceValidatingSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceValidatingSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6691,7 +6556,7 @@ func (m *QueryDelegatorPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceSnapshotRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6701,32 +6566,30 @@ func (m *QueryDelegatorPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceSnapshotResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6736,18 +6599,18 @@ func (m *QueryDelegatorPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6759,7 +6622,7 @@ func (m *QueryDelegatorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorJailRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6769,39 +6632,30 @@ func (m *QueryDelegatorOperatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorJailRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorJailRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorJailRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6811,46 +6665,30 @@ func (m *QueryDelegatorOperatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorJailRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorJailRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operators) > 0 {
-		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.JailRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6860,32 +6698,25 @@ func (m *QueryDelegatorOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.PendingSlashId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingSlashId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6895,18 +6726,18 @@ func (m *QueryDelegatorOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Operator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.PendingSlash.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6918,7 +6749,7 @@ func (m *QueryDelegatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServicesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6928,12 +6759,12 @@ func (m *QueryDelegatorServicesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServicesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6948,19 +6779,12 @@ func (m *QueryDelegatorServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServicesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6970,12 +6794,12 @@ func (m *QueryDelegatorServicesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServicesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6992,10 +6816,10 @@ func (m *QueryDelegatorServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7009,7 +6833,7 @@ func (m *QueryDelegatorServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorServiceKeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7019,12 +6843,12 @@ func (m *QueryDelegatorServiceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorServiceKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorServiceKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7034,17 +6858,15 @@ func (m *QueryDelegatorServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorServiceKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7054,30 +6876,42 @@ func (m *QueryDelegatorServiceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorServiceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorServiceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.PendingKey != nil {
+		{
+			size, err := m.PendingKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserPreferencesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceOperatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7087,27 +6921,37 @@ func (m *QueryUserPreferencesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUserPreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserPreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UserAddress) > 0 {
-		i -= len(m.UserAddress)
-		copy(dAtA[i:], m.UserAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryServiceOperatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7117,30 +6961,46 @@ func (m *QueryUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUserPreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryServiceOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryServiceOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Preferences.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7150,20 +7010,37 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7173,1011 +7050,5282 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryOperatorJoinedServicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPoolDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorJoinedServicesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ServiceIds) > 0 {
-		l = 0
-		for _, e := range m.ServiceIds {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceAllowedOperatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryServiceAllowedOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorIds) > 0 {
-		l = 0
-		for _, e := range m.OperatorIds {
-			l += sovQuery(uint64(e))
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceSecuringPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryServiceSecuringPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolIds) > 0 {
-		l = 0
-		for _, e := range m.PoolIds {
-			l += sovQuery(uint64(e))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceOperatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryServiceOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Operators) > 0 {
-		for _, e := range m.Operators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryOperatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolUnbondingDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolUnbondingDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UnbondingDelegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryOperatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryOperatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorUnbondingDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorUnbondingDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UnbondingDelegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryOperatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryServiceDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryServiceDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryOperatorUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryServiceUnbondingDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceUnbondingDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryServiceDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.UnbondingDelegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryServiceDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorPoolDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryServiceDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryServiceDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServiceDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UnbondingDelegations) > 0 {
-		for _, e := range m.UnbondingDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryServiceUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryServiceUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorOperatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Operators) > 0 {
-		for _, e := range m.Operators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryServiceUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorOperatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
+	{
+		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorPoolDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Operator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDelegatorPoolDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorServicesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorPoolDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+func (m *QueryDelegatorPoolDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Service.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDelegatorPoolDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserPreferencesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorPoolDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserPreferencesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Preferences.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Operator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPreferencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPreferencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPreferencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Preferences.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOperatorJoinedServicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorJoinedServicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ServiceIds) > 0 {
+		l = 0
+		for _, e := range m.ServiceIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceAllowedOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceAllowedOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorIds) > 0 {
+		l = 0
+		for _, e := range m.OperatorIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceSecuringPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceSecuringPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceActiveOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceActiveOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorIds) > 0 {
+		l = 0
+		for _, e := range m.OperatorIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceValidatingSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryServiceValidatingSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryServiceSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorJailRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryOperatorJailRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JailRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingSlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingSlashId != 0 {
+		n += 1 + sovQuery(uint64(m.PendingSlashId))
+	}
+	return n
+}
+
+func (m *QueryPendingSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingSlash.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorServiceKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryOperatorServiceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingKey != nil {
+		l = m.PendingKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolUnbondingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorUnbondingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryServiceDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryServiceUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceUnbondingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorPoolDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorServicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryDelegatorServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Service.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTargetRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetType != 0 {
+		n += 1 + sovQuery(uint64(m.TargetType))
+	}
+	if m.TargetId != 0 {
+		n += 1 + sovQuery(uint64(m.TargetId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTargetRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserPreferencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserPreferencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Preferences.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorJoinedServicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorJoinedServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorJoinedServicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorJoinedServicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorJoinedServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ServiceIds = append(m.ServiceIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ServiceIds) == 0 {
+					m.ServiceIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ServiceIds = append(m.ServiceIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceAllowedOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceAllowedOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceAllowedOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceAllowedOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceAllowedOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceAllowedOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OperatorIds = append(m.OperatorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OperatorIds) == 0 {
+					m.OperatorIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OperatorIds = append(m.OperatorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceSecuringPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceSecuringPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceSecuringPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceSecuringPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceSecuringPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceSecuringPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceActiveOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceActiveOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceActiveOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceActiveOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceActiveOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceActiveOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OperatorIds = append(m.OperatorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OperatorIds) == 0 {
+					m.OperatorIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OperatorIds = append(m.OperatorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceValidatingSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceValidatingSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceValidatingSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceValidatingSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceValidatingSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceValidatingSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, ValidatingOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorJailRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorJailRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorJailRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorJailRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorJailRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorJailRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSlashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashId", wireType)
+			}
+			m.PendingSlashId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSlashId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSlashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryPendingSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8200,17 +12348,17 @@ func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorJoinedServicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorJoinedServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
 			}
-			m.OperatorId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8220,11 +12368,26 @@ func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8255,7 +12418,7 @@ func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8282,7 +12445,7 @@ func (m *QueryOperatorJoinedServicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorJoinedServicesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorServiceKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8305,91 +12468,136 @@ func (m *QueryOperatorJoinedServicesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorJoinedServicesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorServiceKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorJoinedServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorServiceKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.ServiceIds = append(m.ServiceIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorServiceKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorServiceKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorServiceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ServiceIds) == 0 {
-					m.ServiceIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ServiceIds = append(m.ServiceIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8416,10 +12624,10 @@ func (m *QueryOperatorJoinedServicesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.PendingKey == nil {
+				m.PendingKey = &OperatorServiceKey{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8444,7 +12652,7 @@ func (m *QueryOperatorJoinedServicesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceAllowedOperatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8467,10 +12675,10 @@ func (m *QueryServiceAllowedOperatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceAllowedOperatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceOperatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceAllowedOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8549,7 +12757,7 @@ func (m *QueryServiceAllowedOperatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceAllowedOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceOperatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8572,88 +12780,46 @@ func (m *QueryServiceAllowedOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceAllowedOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceOperatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceAllowedOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OperatorIds = append(m.OperatorIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OperatorIds) == 0 {
-					m.OperatorIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OperatorIds = append(m.OperatorIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, types.Operator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8711,7 +12877,7 @@ func (m *QueryServiceAllowedOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceSecuringPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8734,17 +12900,17 @@ func (m *QueryServiceSecuringPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceSecuringPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceSecuringPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.ServiceId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8754,7 +12920,7 @@ func (m *QueryServiceSecuringPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8816,7 +12982,7 @@ func (m *QueryServiceSecuringPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceSecuringPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8839,88 +13005,46 @@ func (m *QueryServiceSecuringPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceSecuringPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceSecuringPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PoolIds = append(m.PoolIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PoolIds) == 0 {
-					m.PoolIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PoolIds = append(m.PoolIds, v)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8978,7 +13102,7 @@ func (m *QueryServiceSecuringPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9001,17 +13125,17 @@ func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOperatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.ServiceId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9021,16 +13145,16 @@ func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9040,27 +13164,23 @@ func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9083,7 +13203,7 @@ func (m *QueryServiceOperatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9106,49 +13226,15 @@ func (m *QueryServiceOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operators = append(m.Operators, types.Operator{})
-			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9175,10 +13261,7 @@ func (m *QueryServiceOperatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9203,7 +13286,7 @@ func (m *QueryServiceOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9226,10 +13309,10 @@ func (m *QueryPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9308,7 +13391,7 @@ func (m *QueryPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9331,15 +13414,15 @@ func (m *QueryPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9366,8 +13449,8 @@ func (m *QueryPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9428,7 +13511,7 @@ func (m *QueryPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9451,10 +13534,10 @@ func (m *QueryPoolDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9529,7 +13612,7 @@ func (m *QueryPoolDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9552,15 +13635,15 @@ func (m *QueryPoolDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9587,7 +13670,7 @@ func (m *QueryPoolDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9612,7 +13695,7 @@ func (m *QueryPoolDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9635,17 +13718,17 @@ func (m *QueryPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.PoolId = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9655,7 +13738,7 @@ func (m *QueryPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint32(b&0x7F) << shift
+				m.OperatorId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9717,7 +13800,7 @@ func (m *QueryPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9740,15 +13823,15 @@ func (m *QueryPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9775,8 +13858,8 @@ func (m *QueryPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
-			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9837,7 +13920,7 @@ func (m *QueryPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9860,17 +13943,17 @@ func (m *QueryPoolUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.PoolId = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9880,7 +13963,7 @@ func (m *QueryPoolUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint32(b&0x7F) << shift
+				m.OperatorId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9938,7 +14021,7 @@ func (m *QueryPoolUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9961,15 +14044,15 @@ func (m *QueryPoolUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9996,7 +14079,7 @@ func (m *QueryPoolUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10021,7 +14104,7 @@ func (m *QueryPoolUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10044,10 +14127,10 @@ func (m *QueryOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10126,7 +14209,7 @@ func (m *QueryOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10149,15 +14232,15 @@ func (m *QueryOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10184,8 +14267,8 @@ func (m *QueryOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10246,7 +14329,7 @@ func (m *QueryOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10269,10 +14352,10 @@ func (m *QueryOperatorDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10347,7 +14430,7 @@ func (m *QueryOperatorDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10370,15 +14453,15 @@ func (m *QueryOperatorDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10405,7 +14488,7 @@ func (m *QueryOperatorDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10430,7 +14513,7 @@ func (m *QueryOperatorDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10453,17 +14536,17 @@ func (m *QueryOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			m.OperatorId = 0
+			m.ServiceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10473,7 +14556,7 @@ func (m *QueryOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				m.ServiceId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10535,7 +14618,7 @@ func (m *QueryOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10558,15 +14641,15 @@ func (m *QueryOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10593,8 +14676,8 @@ func (m *QueryOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
-			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10655,7 +14738,7 @@ func (m *QueryOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryOperatorUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10678,17 +14761,17 @@ func (m *QueryOperatorUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			m.OperatorId = 0
+			m.ServiceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10698,7 +14781,7 @@ func (m *QueryOperatorUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				m.ServiceId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10756,7 +14839,7 @@ func (m *QueryOperatorUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10779,15 +14862,15 @@ func (m *QueryOperatorUnbondingDelegationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10814,7 +14897,7 @@ func (m *QueryOperatorUnbondingDelegationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10839,7 +14922,7 @@ func (m *QueryOperatorUnbondingDelegationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10862,10 +14945,10 @@ func (m *QueryServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10944,7 +15027,7 @@ func (m *QueryServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10967,15 +15050,15 @@ func (m *QueryServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11002,8 +15085,8 @@ func (m *QueryServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11064,7 +15147,7 @@ func (m *QueryServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11087,10 +15170,10 @@ func (m *QueryServiceDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11165,7 +15248,7 @@ func (m *QueryServiceDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11188,15 +15271,15 @@ func (m *QueryServiceDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11223,7 +15306,7 @@ func (m *QueryServiceDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11248,7 +15331,7 @@ func (m *QueryServiceDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11271,17 +15354,17 @@ func (m *QueryServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.ServiceId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11291,11 +15374,24 @@ func (m *QueryServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -11353,7 +15449,7 @@ func (m *QueryServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11376,15 +15472,15 @@ func (m *QueryServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11411,8 +15507,8 @@ func (m *QueryServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
-			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11473,7 +15569,7 @@ func (m *QueryServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11496,17 +15592,17 @@ func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.ServiceId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11516,16 +15612,29 @@ func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11535,23 +15644,27 @@ func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11574,7 +15687,7 @@ func (m *QueryServiceUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11597,15 +15710,15 @@ func (m *QueryServiceUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11632,7 +15745,44 @@ func (m *QueryServiceUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11657,7 +15807,7 @@ func (m *QueryServiceUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11680,10 +15830,10 @@ func (m *QueryDelegatorPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11775,7 +15925,7 @@ func (m *QueryDelegatorPoolDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11798,10 +15948,10 @@ func (m *QueryDelegatorPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11895,7 +16045,7 @@ func (m *QueryDelegatorPoolDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11918,10 +16068,10 @@ func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12013,7 +16163,7 @@ func (m *QueryDelegatorPoolUnbondingDelegationsRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12036,10 +16186,10 @@ func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12133,7 +16283,7 @@ func (m *QueryDelegatorPoolUnbondingDelegationsResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12156,10 +16306,10 @@ func (m *QueryDelegatorOperatorDelegationsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12251,7 +16401,7 @@ func (m *QueryDelegatorOperatorDelegationsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12274,10 +16424,10 @@ func (m *QueryDelegatorOperatorDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12371,7 +16521,7 @@ func (m *QueryDelegatorOperatorDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12394,10 +16544,10 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12489,7 +16639,7 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsRequest) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12512,10 +16662,10 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12609,7 +16759,7 @@ func (m *QueryDelegatorOperatorUnbondingDelegationsResponse) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12632,10 +16782,10 @@ func (m *QueryDelegatorServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12727,7 +16877,7 @@ func (m *QueryDelegatorServiceDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12750,15 +16900,15 @@ func (m *QueryDelegatorServiceDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12785,8 +16935,8 @@ func (m *QueryDelegatorServiceDelegationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, types1.Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12847,7 +16997,7 @@ func (m *QueryDelegatorServiceDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12870,10 +17020,10 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12909,10 +17059,10 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12922,28 +17072,11 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12965,7 +17098,7 @@ func (m *QueryDelegatorServiceUnbondingDelegationsRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12988,49 +17121,15 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
-			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13057,10 +17156,7 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13085,7 +17181,7 @@ func (m *QueryDelegatorServiceUnbondingDelegationsResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13108,10 +17204,10 @@ func (m *QueryDelegatorPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13203,7 +17299,7 @@ func (m *QueryDelegatorPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13226,15 +17322,15 @@ func (m *QueryDelegatorPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13261,8 +17357,8 @@ func (m *QueryDelegatorPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, types1.Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operators = append(m.Operators, types.Operator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13323,7 +17419,7 @@ func (m *QueryDelegatorPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13346,10 +17442,10 @@ func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13386,9 +17482,9 @@ func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.PoolId = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13398,7 +17494,7 @@ func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint32(b&0x7F) << shift
+				m.OperatorId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13424,7 +17520,7 @@ func (m *QueryDelegatorPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13447,15 +17543,15 @@ func (m *QueryDelegatorPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13482,7 +17578,7 @@ func (m *QueryDelegatorPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13507,7 +17603,7 @@ func (m *QueryDelegatorPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServicesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13530,10 +17626,10 @@ func (m *QueryDelegatorOperatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServicesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13625,7 +17721,7 @@ func (m *QueryDelegatorOperatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServicesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13648,15 +17744,15 @@ func (m *QueryDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServicesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13683,8 +17779,8 @@ func (m *QueryDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operators = append(m.Operators, types.Operator{})
-			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Services = append(m.Services, types2.Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13745,7 +17841,7 @@ func (m *QueryDelegatorOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13768,10 +17864,10 @@ func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13808,9 +17904,9 @@ func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			m.OperatorId = 0
+			m.ServiceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13820,7 +17916,7 @@ func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				m.ServiceId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13846,7 +17942,7 @@ func (m *QueryDelegatorOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorServiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13869,15 +17965,15 @@ func (m *QueryDelegatorOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorServiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13904,7 +18000,7 @@ func (m *QueryDelegatorOperatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13929,7 +18025,7 @@ func (m *QueryDelegatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorServicesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRedelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13952,10 +18048,10 @@ func (m *QueryDelegatorServicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRedelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14047,7 +18143,7 @@ func (m *QueryDelegatorServicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorServicesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRedelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14070,15 +18166,15 @@ func (m *QueryDelegatorServicesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServicesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRedelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14105,8 +18201,8 @@ func (m *QueryDelegatorServicesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, types2.Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14167,7 +18263,7 @@ func (m *QueryDelegatorServicesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetRedelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14190,17 +18286,17 @@ func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetRedelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
 			}
-			var stringLen uint64
+			m.TargetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14210,29 +18306,16 @@ func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TargetType |= DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
 			}
-			m.ServiceId = 0
+			m.TargetId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14242,11 +18325,47 @@ func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				m.TargetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14268,7 +18387,7 @@ func (m *QueryDelegatorServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetRedelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14291,15 +18410,15 @@ func (m *QueryDelegatorServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetRedelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14326,7 +18445,44 @@ func (m *QueryDelegatorServiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,11 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashCoverFn is a function that is called once for each delegator whose tokens
+// have been reduced by an operator slash, allowing to cover the slashed amount.
+type SlashCoverFn func(ctx context.Context, serviceID uint32, operatorID uint32, delegator string, slashedAmount sdk.Coins) error
@@ -33,6 +33,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "plan_id"},
 					},
 				},
+				{
+					RpcMethod: "ArchivedRewardsPlans",
+					Use:       "archived-plans",
+					Short:     "Query all archived rewards plans",
+				},
+				{
+					RpcMethod: "ArchivedRewardsPlan",
+					Use:       "archived-plan [plan-id]",
+					Short:     "Query a specific archived rewards plan",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "plan_id"},
+					},
+				},
 				{
 					RpcMethod: "PoolOutstandingRewards",
 					Use:       "pool-outstanding-rewards [pool-id]",
@@ -65,6 +78,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "operator_id"},
 					},
 				},
+				{
+					RpcMethod: "PoolAPR",
+					Use:       "pool-apr [pool-id]",
+					Short:     "Query the projected annualised yield of the delegations to a pool",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+					},
+				},
+				{
+					RpcMethod: "OperatorAPR",
+					Use:       "operator-apr [operator-id]",
+					Short:     "Query the projected annualised yield of the delegations to an operator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_id"},
+					},
+				},
+				{
+					RpcMethod: "ServiceAPR",
+					Use:       "service-apr [service-id]",
+					Short:     "Query the projected annualised yield of the delegations to a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
+				{
+					RpcMethod: "PoolServiceAPR",
+					Use:       "pool-service-apr [pool-id] [service-id]",
+					Short:     "Query the projected annualised yield that the delegations to a pool receive from a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+						{ProtoField: "service_id"},
+					},
+				},
 				{
 					RpcMethod: "PoolDelegationRewards",
 					Use:       "pool-rewards [delegator-address] [pool-id]",
@@ -108,6 +154,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompoundSettings",
+					Use:       "auto-compound-settings [delegator-address]",
+					Short:     "Query the delegation targets for which a delegator has enabled auto-compounding",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -118,6 +172,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "CreateRewardsPlan",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [delegation-type] [target-id] [enabled]",
+					Short:     "Enable or disable the auto-compounding of the rewards of a delegation target",
+					Example:   fmt.Sprintf("%s tx rewards set-auto-compound pool [pool-id] true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegation_type"},
+						{ProtoField: "delegation_target_id"},
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod: "SetWithdrawAddress",
 					Use:       "set-withdraw-addr [withdraw-address]",
@@ -136,6 +201,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegation_target_id"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Skip:      true,
+				},
 				{
 					RpcMethod: "WithdrawOperatorCommission",
 					Use:       "withdraw-operator-commission [operator-id]",
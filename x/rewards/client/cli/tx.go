@@ -26,12 +26,17 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreateRewardsPlan(),
 		GetCmdEditRewardsPlan(),
+		GetCmdWithdrawAllDelegatorRewards(),
 		// The other commands are generated through the auto CLI
 	)
 
 	return txCmd
 }
 
+const (
+	flagDelegationType = "delegation-type"
+)
+
 // GetCmdCreateRewardsPlan returns the command allowing to create a rewards plan
 // for a service.
 func GetCmdCreateRewardsPlan() *cobra.Command {
@@ -52,7 +57,7 @@ Where rewards_plan.json contains:
 {
   "service_id": 1,
   "description": "test plan",
-  "amount_per_day": "1000uinit",
+  "amount_per_day": "1000uinit,500umilk",
   "start_time": "2024-01-01T00:00:00Z",
   "end_time": "2024-12-31T23:59:59Z",
   "pools_distribution": {
@@ -73,8 +78,30 @@ Where rewards_plan.json contains:
           "@type": "/milkyway.rewards.v1.UsersDistributionTypeBasic"
       }
   },
+  "emission_schedule": {
+      "@type": "/milkyway.rewards.v1.EmissionScheduleHalving",
+      "halving_period": "2592000s"
+  },
+  "insufficient_funds_behavior": "INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE",
   "fee_amount: "1000stake"
 }
+
+The emission_schedule field is optional. If omitted, the rewards are
+distributed at a constant rate of amount_per_day.
+
+The insufficient_funds_behavior field is optional. If omitted, the allocation
+of all the denoms is skipped when any of them is not funded enough.
+
+To define the daily budget in USD, amount_per_day must be omitted and the
+usd_budget field must be set instead:
+
+  "usd_budget": {
+      "amount_per_day": "1000",
+      "payout_denom": "umilk",
+      "max_price_age": "60s",
+      "stale_price_behavior": "STALE_PRICE_BEHAVIOR_USE_LAST_PRICE",
+      "stale_price_grace_period": "3600s"
+  }
 `, version.AppName, types.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,6 +120,11 @@ Where rewards_plan.json contains:
 				return fmt.Errorf("invalid rewards plan json: %w", err)
 			}
 
+			emissionSchedule, err := types.GetEmissionSchedule(clientCtx.Codec, rewardsPlan.EmissionSchedule)
+			if err != nil {
+				return fmt.Errorf("invalid emission schedule: %w", err)
+			}
+
 			creator := clientCtx.FromAddress.String()
 			msg := types.NewMsgCreateRewardsPlan(
 				rewardsPlan.ServiceID,
@@ -103,6 +135,9 @@ Where rewards_plan.json contains:
 				rewardsPlan.PoolsDistribution,
 				rewardsPlan.OperatorsDistribution,
 				rewardsPlan.UsersDistribution,
+				emissionSchedule,
+				rewardsPlan.InsufficientFundsBehavior,
+				rewardsPlan.USDBudget,
 				rewardsPlan.FeeAmount,
 				creator,
 			)
@@ -193,6 +228,11 @@ Where rewards_plan.json contains:
 				return fmt.Errorf("invalid rewards plan json: %w", err)
 			}
 
+			emissionSchedule, err := types.GetEmissionSchedule(clientCtx.Codec, rewardsPlan.EmissionSchedule)
+			if err != nil {
+				return fmt.Errorf("invalid emission schedule: %w", err)
+			}
+
 			sender := clientCtx.FromAddress.String()
 			msg := types.NewMsgEditRewardsPlan(
 				planID,
@@ -203,6 +243,9 @@ Where rewards_plan.json contains:
 				rewardsPlan.PoolsDistribution,
 				rewardsPlan.OperatorsDistribution,
 				rewardsPlan.UsersDistribution,
+				emissionSchedule,
+				rewardsPlan.InsufficientFundsBehavior,
+				rewardsPlan.USDBudget,
 				sender,
 			)
 
@@ -220,3 +263,55 @@ Where rewards_plan.json contains:
 
 	return cmd
 }
+
+// GetCmdWithdrawAllDelegatorRewards returns the command allowing to withdraw
+// the rewards from all the delegations of a delegator.
+func GetCmdWithdrawAllDelegatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-all-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards from all the delegations of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards from all the delegations of a delegator.
+The delegations can be filtered by type using the --%s flag.
+
+Example:
+$ %s tx %s withdraw-all-rewards --from alice
+$ %s tx %s withdraw-all-rewards --%s pool --from alice
+`, flagDelegationType, version.AppName, types.ModuleName, version.AppName, types.ModuleName, flagDelegationType),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegationTypeStr, err := cmd.Flags().GetString(flagDelegationType)
+			if err != nil {
+				return err
+			}
+
+			delegationType, err := ParseDelegationType(delegationTypeStr)
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.FromAddress.String()
+			msg := types.NewMsgWithdrawAllDelegatorRewards(delegationType, delegator)
+
+			// Validate the message
+			err = msg.ValidateBasic()
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDelegationType, "", "Type of the delegations to withdraw the rewards from (pool, operator or service)")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,15 +16,18 @@ import (
 )
 
 type rewardsPlanJSON struct {
-	Description           string           `json:"description"`
-	ServiceID             uint32           `json:"service_id"`
-	AmountPerDay          string           `json:"amount_per_day"`
-	StartTime             time.Time        `json:"start_time"`
-	EndTime               time.Time        `json:"end_time"`
-	PoolsDistribution     distributionJSON `json:"pools_distribution"`
-	OperatorsDistribution distributionJSON `json:"operators_distribution"`
-	UsersDistribution     distributionJSON `json:"users_distribution"`
-	FeeAmount             string           `json:"fee_amount"`
+	Description               string           `json:"description"`
+	ServiceID                 uint32           `json:"service_id"`
+	AmountPerDay              string           `json:"amount_per_day"`
+	StartTime                 time.Time        `json:"start_time"`
+	EndTime                   time.Time        `json:"end_time"`
+	PoolsDistribution         distributionJSON `json:"pools_distribution"`
+	OperatorsDistribution     distributionJSON `json:"operators_distribution"`
+	UsersDistribution         distributionJSON `json:"users_distribution"`
+	EmissionSchedule          json.RawMessage  `json:"emission_schedule"`
+	InsufficientFundsBehavior string           `json:"insufficient_funds_behavior"`
+	USDBudget                 json.RawMessage  `json:"usd_budget"`
+	FeeAmount                 string           `json:"fee_amount"`
 }
 
 type distributionJSON struct {
@@ -81,7 +85,25 @@ func ParseRewardsPlan(cdc codec.Codec, path string) (ParsedRewardsPlan, error) {
 		Weight: rewardsPlanJSON.UsersDistribution.Weight,
 	}
 
-	amountPerDay, err := sdk.ParseCoinNormalized(rewardsPlanJSON.AmountPerDay)
+	// Parse the emission schedule
+	emissionSchedule, err := parseEmissionSchedule(cdc, rewardsPlanJSON.EmissionSchedule)
+	if err != nil {
+		return ParsedRewardsPlan{}, fmt.Errorf("invalid emission schedule: %w", err)
+	}
+
+	// Parse the insufficient funds behavior
+	insufficientFundsBehavior, err := parseInsufficientFundsBehavior(rewardsPlanJSON.InsufficientFundsBehavior)
+	if err != nil {
+		return ParsedRewardsPlan{}, err
+	}
+
+	// Parse the USD budget
+	usdBudget, err := parseUSDBudget(cdc, rewardsPlanJSON.USDBudget)
+	if err != nil {
+		return ParsedRewardsPlan{}, fmt.Errorf("invalid USD budget: %w", err)
+	}
+
+	amountPerDay, err := sdk.ParseCoinsNormalized(rewardsPlanJSON.AmountPerDay)
 	if err != nil {
 		return ParsedRewardsPlan{}, fmt.Errorf("invalid amount per day: %w", err)
 	}
@@ -104,6 +126,9 @@ func ParseRewardsPlan(cdc codec.Codec, path string) (ParsedRewardsPlan, error) {
 			poolsDistribution,
 			operatorsDistribution,
 			usersDistribution,
+			emissionSchedule,
+			insufficientFundsBehavior,
+			usdBudget,
 		),
 		FeeAmount: feeAmount,
 	}, nil
@@ -128,3 +153,69 @@ func parseUserDistributionType(cdc codec.Codec, rawJSON json.RawMessage) (*codec
 	}
 	return codectypes.NewAnyWithValue(userDistributionType)
 }
+
+// parseEmissionSchedule parses a types.EmissionSchedule from a json.RawMessage.
+// It returns nil if no emission schedule is specified.
+func parseEmissionSchedule(cdc codec.Codec, rawJSON json.RawMessage) (types.EmissionSchedule, error) {
+	if len(rawJSON) == 0 || string(rawJSON) == "null" {
+		return nil, nil
+	}
+
+	var emissionSchedule types.EmissionSchedule
+	err := cdc.UnmarshalInterfaceJSON(rawJSON, &emissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+	return emissionSchedule, nil
+}
+
+// parseInsufficientFundsBehavior parses a types.InsufficientFundsBehavior from
+// its string representation. It returns INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED
+// if the given value is empty.
+func parseInsufficientFundsBehavior(value string) (types.InsufficientFundsBehavior, error) {
+	if value == "" {
+		return types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED, nil
+	}
+
+	behavior, ok := types.InsufficientFundsBehavior_value[value]
+	if !ok {
+		return 0, fmt.Errorf("invalid insufficient funds behavior: %s", value)
+	}
+	return types.InsufficientFundsBehavior(behavior), nil
+}
+
+// parseUSDBudget parses a types.USDBudget from a json.RawMessage.
+// It returns nil if no USD budget is specified.
+func parseUSDBudget(cdc codec.Codec, rawJSON json.RawMessage) (*types.USDBudget, error) {
+	if len(rawJSON) == 0 || string(rawJSON) == "null" {
+		return nil, nil
+	}
+
+	var usdBudget types.USDBudget
+	err := cdc.UnmarshalJSON(rawJSON, &usdBudget)
+	if err != nil {
+		return nil, err
+	}
+	return &usdBudget, nil
+}
+
+// ParseDelegationType parses a restakingtypes.DelegationType from either its
+// short (e.g. "pool") or its full (e.g. "DELEGATION_TYPE_POOL") string
+// representation. It returns DELEGATION_TYPE_UNSPECIFIED if the given value is
+// empty.
+func ParseDelegationType(value string) (restakingtypes.DelegationType, error) {
+	if value == "" {
+		return restakingtypes.DELEGATION_TYPE_UNSPECIFIED, nil
+	}
+
+	value = strings.ToUpper(value)
+	if !strings.HasPrefix(value, "DELEGATION_TYPE_") {
+		value = "DELEGATION_TYPE_" + value
+	}
+
+	delType, ok := restakingtypes.DelegationType_value[value]
+	if !ok || delType == int32(restakingtypes.DELEGATION_TYPE_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid delegation type: %s", value)
+	}
+	return restakingtypes.DelegationType(delType), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	milkywayapp "github.com/milkyway-labs/milkyway/v12/app"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/client/cli"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
@@ -58,12 +59,15 @@ func TestCLIUtils_parseRewardsPlan(t *testing.T) {
 					1,
 					"test plan",
 					1,
-					sdk.NewCoin("uinit", math.NewInt(1000)),
+					sdk.NewCoins(sdk.NewCoin("uinit", math.NewInt(1000))),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 					types.NewBasicPoolsDistribution(1),
 					types.NewBasicOperatorsDistribution(2),
 					types.NewBasicUsersDistribution(3),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				FeeAmount: sdk.NewCoins(sdk.NewInt64Coin("uinit", 100)),
 			},
@@ -102,12 +106,15 @@ func TestCLIUtils_parseRewardsPlan(t *testing.T) {
 					1,
 					"test plan",
 					1,
-					sdk.NewCoin("uinit", math.NewInt(1000)),
+					sdk.NewCoins(sdk.NewCoin("uinit", math.NewInt(1000))),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 					types.NewBasicPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(2),
 					types.NewBasicUsersDistribution(3),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				FeeAmount: sdk.NewCoins(sdk.NewInt64Coin("uinit", 100)),
 			},
@@ -148,12 +155,15 @@ func TestCLIUtils_parseRewardsPlan(t *testing.T) {
 					1,
 					"test plan",
 					1,
-					sdk.NewCoin("uinit", math.NewInt(1000)),
+					sdk.NewCoins(sdk.NewCoin("uinit", math.NewInt(1000))),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 					types.NewWeightedPoolsDistribution(1, []types.DistributionWeight{types.NewDistributionWeight(1, 1)}),
 					types.NewWeightedOperatorsDistribution(2, []types.DistributionWeight{types.NewDistributionWeight(2, 2)}),
 					types.NewBasicUsersDistribution(3),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				FeeAmount: sdk.NewCoins(sdk.NewInt64Coin("uinit", 100)),
 			},
@@ -190,12 +200,15 @@ func TestCLIUtils_parseRewardsPlan(t *testing.T) {
 					1,
 					"test plan",
 					0,
-					sdk.NewCoin("uinit", math.NewInt(1000)),
+					sdk.NewCoins(sdk.NewCoin("uinit", math.NewInt(1000))),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 					types.NewBasicPoolsDistribution(1),
 					types.NewBasicOperatorsDistribution(2),
 					types.NewBasicUsersDistribution(3),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				FeeAmount: sdk.NewCoins(),
 			},
@@ -215,3 +228,53 @@ func TestCLIUtils_parseRewardsPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestCLIUtils_ParseDelegationType(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		shouldErr bool
+		expected  restakingtypes.DelegationType
+	}{
+		{
+			name:      "empty value returns unspecified type",
+			value:     "",
+			shouldErr: false,
+			expected:  restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+		},
+		{
+			name:      "invalid value returns error",
+			value:     "invalid",
+			shouldErr: true,
+		},
+		{
+			name:      "unspecified value returns error",
+			value:     "unspecified",
+			shouldErr: true,
+		},
+		{
+			name:      "short value is parsed properly",
+			value:     "pool",
+			shouldErr: false,
+			expected:  restakingtypes.DELEGATION_TYPE_POOL,
+		},
+		{
+			name:      "full value is parsed properly",
+			value:     "DELEGATION_TYPE_SERVICE",
+			shouldErr: false,
+			expected:  restakingtypes.DELEGATION_TYPE_SERVICE,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delType, err := cli.ParseDelegationType(tc.value)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, delType)
+			}
+		})
+	}
+}
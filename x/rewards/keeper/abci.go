@@ -4,15 +4,28 @@ import (
 	"context"
 )
 
-// BeginBlocker is called every block and is used to terminate ended rewards
-// plans and allocate restaking rewards for the previous block.
+// BeginBlocker is called every block and is used to allocate the accrued
+// restaking rewards, pay the pending epoch claims, terminate ended rewards
+// plans and process the auto-compounding queue.
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
-	err := k.TerminateEndedRewardsPlans(ctx)
+	// Rewards are allocated before terminating the ended plans, so that the
+	// rewards they have accrued until their end time are not lost
+	err := k.AllocateRewardsIfDue(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = k.AllocateRewards(ctx)
+	err = k.PayPendingEpochClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.TerminateEndedRewardsPlans(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.ProcessAutoCompoundQueue(ctx)
 	if err != nil {
 		return err
 	}
@@ -240,6 +240,12 @@ func (k *Keeper) IncrementPoolServiceTotalDelegatorShares(
 	if err != nil {
 		return err
 	}
+
+	err = k.accumulateDelegatorShares(ctx, restakingtypes.DELEGATION_TYPE_POOL, poolID, serviceID, prevShares)
+	if err != nil {
+		return err
+	}
+
 	return k.SetPoolServiceTotalDelegatorShares(ctx, poolID, serviceID, prevShares.Add(shares...))
 }
 
@@ -252,6 +258,12 @@ func (k *Keeper) DecrementPoolServiceTotalDelegatorShares(
 	if err != nil {
 		return err
 	}
+
+	err = k.accumulateDelegatorShares(ctx, restakingtypes.DELEGATION_TYPE_POOL, poolID, serviceID, prevShares)
+	if err != nil {
+		return err
+	}
+
 	newShares := prevShares.Sub(shares)
 	// Delete the pool-service total delegator shares record if it becomes zero
 	if newShares.IsZero() {
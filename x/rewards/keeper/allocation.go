@@ -56,6 +56,43 @@ func (k *Keeper) SetLastRewardsAllocationTime(ctx context.Context, t time.Time)
 	return k.LastRewardsAllocationTime.Set(ctx, *ts)
 }
 
+// AllocateRewardsIfDue allocates the restaking rewards accrued since the last
+// rewards allocation if their allocation is due. If the allocation epoch
+// duration is zero, rewards are allocated every block. Otherwise, they are
+// allocated once the current epoch has ended, or earlier if a rewards plan has
+// ended so that its accrued rewards are allocated before it gets terminated.
+func (k *Keeper) AllocateRewardsIfDue(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AllocationEpochDuration == 0 {
+		return k.AllocateRewards(ctx)
+	}
+
+	lastAllocationTime, err := k.GetLastRewardsAllocationTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if lastAllocationTime == nil || !sdkCtx.BlockTime().Before(lastAllocationTime.Add(params.AllocationEpochDuration)) {
+		return k.AllocateRewards(ctx)
+	}
+
+	hasEndedPlans, err := k.hasEndedRewardsPlans(ctx)
+	if err != nil {
+		return err
+	}
+
+	if hasEndedPlans {
+		return k.AllocateRewards(ctx)
+	}
+
+	return nil
+}
+
 // AllocateRewards allocates restaking rewards to different entities based on
 // active rewards plans. AllocateRewards skips rewards distribution when
 // there's no last rewards allocation time set. In that case, AllocateRewards
@@ -89,77 +126,113 @@ func (k *Keeper) AllocateRewards(ctx context.Context) error {
 		return nil
 	}
 
-	// Get the list of restakable denoms
-	restakableDenoms, err := k.restakingKeeper.GetRestakableDenoms(ctx)
+	restakableDenoms, pools, err := k.getRestakableDenomsAndPools(ctx)
 	if err != nil {
 		return err
 	}
 
-	pools, err := k.poolsKeeper.GetPools(ctx)
-	if err != nil {
-		return err
-	}
-
-	// Filter out the pools that are not allowed to be restaked
-	// If there are no restakable denoms, all pools are allowed
-	pools = utils.Filter(pools, func(pool poolstypes.Pool) bool {
-		return len(restakableDenoms) == 0 || slices.Contains(restakableDenoms, pool.Denom)
-	})
-
 	// We cache delegation targets to avoid fetching the same delegation target
 	// multiple times inside AllocateRewardsByPlan.
 	delTargetCache := delegationTargetCache{}
 
-	// Iterate all rewards plan stored and allocate rewards by plan if it's
-	// active(plan's start time <= current block time < plans' end time).
-	return k.RewardsPlans.Walk(ctx, nil, func(planID uint64, plan types.RewardsPlan) (stop bool, err error) {
-		// Skip if the plan is not active at the current block time.
-		if !plan.IsActiveAt(sdkCtx.BlockTime()) {
+	// Iterate all rewards plan stored and allocate rewards by plan for the
+	// time it has been active since the last rewards allocation.
+	err = k.RewardsPlans.Walk(ctx, nil, func(planID uint64, plan types.RewardsPlan) (stop bool, err error) {
+		// Skip if the plan has not been active since the last rewards allocation.
+		planActiveDuration := plan.GetActiveDurationWithin(*lastAllocationTime, sdkCtx.BlockTime())
+		if planActiveDuration == 0 {
 			return false, nil
 		}
 
-		// Get the service params to filter out the restakable denoms
-		// that are not allowed by the service
-		serviceParams, err := k.servicesKeeper.GetServiceParams(ctx, plan.ServiceID)
+		serviceRestakableDenoms, ok, err := k.getServiceRestakableDenoms(ctx, plan.ServiceID, restakableDenoms)
 		if err != nil {
 			return false, err
 		}
 
-		var serviceRestakableDenoms []string
-		if len(restakableDenoms) == 0 {
-			// The global restakable denoms are not set: use the one configured
-			// in the service params
-			serviceRestakableDenoms = serviceParams.AllowedDenoms
-		} else if len(serviceParams.AllowedDenoms) > 0 {
-			// We have both the global restakable denoms and the service denoms,
-			// intersect them to have the list of restakable denoms
-			serviceRestakableDenoms = utils.Intersect(restakableDenoms, serviceParams.AllowedDenoms)
-			if len(serviceRestakableDenoms) == 0 {
-				// The intersection between the service's allowed denoms
-				// and the global allowed denoms is empty, skip distribution
-				// for this plan.
-				sdkCtx.Logger().Info(
-					"Skipping rewards plan because none of the service's allowed denoms are allowed to be restaked",
-					"plan_id", plan.ID,
-				)
-				return false, nil
-			}
+		if !ok {
+			sdkCtx.Logger().Info(
+				"Skipping rewards plan because none of the service's allowed denoms are allowed to be restaked",
+				"plan_id", plan.ID,
+			)
+			return false, nil
 		}
 
-		err = k.AllocateRewardsByPlan(ctx, plan, timeSinceLastAllocation, pools, delTargetCache, serviceRestakableDenoms)
+		err = k.AllocateRewardsByPlan(ctx, plan, *lastAllocationTime, sdkCtx.BlockTime(), pools, delTargetCache, serviceRestakableDenoms)
 		if err != nil {
 			return false, err
 		}
 
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// The accrued rewards have been allocated based on the delegations held
+	// since the last allocation, so a new epoch starts
+	return k.endAllocationEpoch(ctx)
+}
+
+// getRestakableDenomsAndPools returns the list of restakable denoms along with
+// the pools whose denom is allowed to be restaked. If there are no restakable
+// denoms, all pools are returned.
+func (k *Keeper) getRestakableDenomsAndPools(ctx context.Context) ([]string, []poolstypes.Pool, error) {
+	// Get the list of restakable denoms
+	restakableDenoms, err := k.restakingKeeper.GetRestakableDenoms(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pools, err := k.poolsKeeper.GetPools(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Filter out the pools that are not allowed to be restaked
+	// If there are no restakable denoms, all pools are allowed
+	pools = utils.Filter(pools, func(pool poolstypes.Pool) bool {
+		return len(restakableDenoms) == 0 || slices.Contains(restakableDenoms, pool.Denom)
+	})
+
+	return restakableDenoms, pools, nil
 }
 
-// AllocateRewardsByPlan allocates rewards by a specific rewards plan.
+// getServiceRestakableDenoms returns the list of denoms that can be restaked
+// toward the given service, taking into account both the given global
+// restakable denoms and the denoms allowed by the service params. If none of
+// the service's allowed denoms can be restaked, false is returned.
+func (k *Keeper) getServiceRestakableDenoms(
+	ctx context.Context, serviceID uint32, restakableDenoms []string,
+) ([]string, bool, error) {
+	// Get the service params to filter out the restakable denoms
+	// that are not allowed by the service
+	serviceParams, err := k.servicesKeeper.GetServiceParams(ctx, serviceID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(restakableDenoms) == 0 {
+		// The global restakable denoms are not set: use the one configured
+		// in the service params
+		return serviceParams.AllowedDenoms, true, nil
+	}
+
+	if len(serviceParams.AllowedDenoms) == 0 {
+		return nil, true, nil
+	}
+
+	// We have both the global restakable denoms and the service denoms,
+	// intersect them to have the list of restakable denoms
+	serviceRestakableDenoms := utils.Intersect(restakableDenoms, serviceParams.AllowedDenoms)
+	return serviceRestakableDenoms, len(serviceRestakableDenoms) > 0, nil
+}
+
+// AllocateRewardsByPlan allocates the rewards emitted by a specific rewards
+// plan within the given time range.
 func (k *Keeper) AllocateRewardsByPlan(
 	ctx context.Context,
 	plan types.RewardsPlan,
-	timeSinceLastAllocation time.Duration,
+	from, to time.Time,
 	pools []poolstypes.Pool,
 	delTargetCache map[delegationTargetKey]DelegationTarget,
 	restakableDenoms []string,
@@ -173,37 +246,78 @@ func (k *Keeper) AllocateRewardsByPlan(
 		return nil
 	}
 
-	// Calculate rewards amount for this block by following formula:
-	// amountPerDay * timeSinceLastAllocation(ms) / 1 day(ms)
-	rewardsAmount := math.LegacyNewDecFromInt(plan.AmountPerDay.Amount).
-		MulTruncate(math.LegacyNewDec(timeSinceLastAllocation.Milliseconds())).
-		QuoTruncate(math.LegacyNewDec((24 * time.Hour).Milliseconds()))
-
-	// Truncate decimal and move the truncated rewards to the global rewards
-	// pool.
-	rewardsTruncated := sdk.NewCoin(plan.AmountPerDay.Denom, rewardsAmount.TruncateInt())
-	rewards := sdk.NewDecCoinsFromCoins(rewardsTruncated)
+	// Get the emission of the plan within the given time range, following
+	// every change of its emission rate
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	emission, err := plan.GetEmissionWithin(k.cdc, from, to)
+	if err != nil {
+		return err
+	}
 
-	// Check if the rewards pool has enough coins to allocate rewards.
-	planRewardsPoolAddr := plan.MustGetRewardsPoolAddress(k.accountKeeper.AddressCodec())
-	balance := k.bankKeeper.GetBalance(ctx, planRewardsPoolAddr, rewardsTruncated.Denom)
+	// Get the amount of rewards to be distributed per day
+	amountPerDay, err := k.GetRewardsPlanAmountPerDay(ctx, plan)
+	if err != nil {
+		return err
+	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	if !balance.IsGTE(rewardsTruncated) {
+	// The USD budget of the plan can't be converted, so the allocation is
+	// paused until a usable price is available.
+	if amountPerDay.IsZero() {
 		sdkCtx.Logger().Info(
-			"Skipping rewards plan because its rewards pool has insufficient balances",
+			"Skipping rewards plan because its USD budget can't be converted into the payout denom",
 			"plan_id", plan.ID,
-			"balance", balance.String(),
-			"rewards", rewardsTruncated.String(),
 		)
 		return nil
 	}
 
+	// Calculate rewards amount for this block by following formula:
+	// amountPerDay * emission(ms) / 1 day(ms)
+	// where emission is the emission rate integrated over the time range.
+	// Decimals are truncated and only the truncated rewards are moved to the
+	// global rewards pool.
+	rewardsTruncated := sdk.NewCoins()
+	for _, coin := range amountPerDay {
+		rewardsAmount := math.LegacyNewDecFromInt(coin.Amount).
+			MulTruncate(emission).
+			QuoTruncate(math.LegacyNewDec((24 * time.Hour).Milliseconds()))
+		rewardsTruncated = rewardsTruncated.Add(sdk.NewCoin(coin.Denom, rewardsAmount.TruncateInt()))
+	}
+
+	// Check if the rewards pool has enough coins to allocate rewards.
+	planRewardsPoolAddr := plan.MustGetRewardsPoolAddress(k.accountKeeper.AddressCodec())
+	balances := sdk.NewCoins()
+	for _, coin := range rewardsTruncated {
+		balances = balances.Add(k.bankKeeper.GetBalance(ctx, planRewardsPoolAddr, coin.Denom))
+	}
+
+	if !balances.IsAllGTE(rewardsTruncated) {
+		switch plan.InsufficientFundsBehavior {
+		case types.INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE:
+			// Distribute only what is available inside the rewards pool
+			rewardsTruncated = rewardsTruncated.Min(balances)
+		default:
+			sdkCtx.Logger().Info(
+				"Skipping rewards plan because its rewards pool has insufficient balances",
+				"plan_id", plan.ID,
+				"balance", balances.String(),
+				"rewards", rewardsTruncated.String(),
+			)
+			return k.incrementRewardsPlanSkippedAllocations(ctx, plan.ID)
+		}
+	}
+
+	// There are no rewards to be distributed, so just skip.
+	if rewardsTruncated.IsZero() {
+		return nil
+	}
+
+	rewards := sdk.NewDecCoinsFromCoins(rewardsTruncated...)
+
 	eligiblePools, err := k.getEligiblePools(ctx, service.ID, pools, delTargetCache)
 	if err != nil {
 		return err
 	}
-	poolDistrInfos, totalPoolsDelValues, err := k.getDistrInfos(ctx, service.ID, eligiblePools, restakableDenoms)
+	poolDistrInfos, totalPoolsDelValues, err := k.getDistrInfos(ctx, service.ID, eligiblePools, restakableDenoms, k.getTimeWeightedTrustedTokens)
 	if err != nil {
 		return err
 	}
@@ -212,16 +326,26 @@ func (k *Keeper) AllocateRewardsByPlan(
 	if err != nil {
 		return err
 	}
-	operatorDistrInfos, totalOperatorsDelValues, err := k.getDistrInfos(ctx, service.ID, eligibleOperators, restakableDenoms)
+	operatorDistrInfos, totalOperatorsDelValues, err := k.getDistrInfos(ctx, service.ID, eligibleOperators, restakableDenoms, k.getTimeWeightedTrustedTokens)
+	if err != nil {
+		return err
+	}
+
+	serviceTarget, err := k.GetDelegationTarget(ctx, restakingtypes.DELEGATION_TYPE_SERVICE, service.ID)
+	if err != nil {
+		return err
+	}
+	tokensDelegatedToService, err := k.getTimeWeightedTrustedTokens(ctx, service.ID, serviceTarget)
 	if err != nil {
 		return err
 	}
 
 	// Filter out the not allowed denoms from the tokens that have been
 	// delegated toward a service.
-	tokensDelegatedToService := service.Tokens
 	if len(restakableDenoms) > 0 {
-		tokensDelegatedToService = service.GetAllowedTokens(restakableDenoms)
+		tokensDelegatedToService = utils.Filter(tokensDelegatedToService, func(coin sdk.Coin) bool {
+			return slices.Contains(restakableDenoms, coin.Denom)
+		})
 	}
 	totalUsersDelValues, err := k.GetCoinsValue(ctx, tokensDelegatedToService)
 	if err != nil {
@@ -236,7 +360,7 @@ func (k *Keeper) AllocateRewardsByPlan(
 	}
 
 	// Send the current block's rewards to the global rewards pool.
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, planRewardsPoolAddr, types.RewardsPoolName, sdk.NewCoins(rewardsTruncated))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, planRewardsPoolAddr, types.RewardsPoolName, rewardsTruncated)
 	if err != nil {
 		return err
 	}
@@ -272,14 +396,20 @@ func (k *Keeper) AllocateRewardsByPlan(
 		usersRewards = rewards.MulDecTruncate(totalUsersDelValues).QuoDecTruncate(totalDelValues)
 	}
 
+	// Get the plan's accounting to keep track of the distributed rewards
+	accounting, err := k.GetRewardsPlanAccounting(ctx, plan.ID)
+	if err != nil {
+		return err
+	}
+
 	if poolsRewards.IsAllPositive() {
-		err = k.allocateRewards(ctx, plan.ServiceID, plan.PoolsDistribution, poolDistrInfos, poolsRewards)
+		err = k.allocateRewards(ctx, plan.ServiceID, plan.PoolsDistribution, poolDistrInfos, poolsRewards, &accounting)
 		if err != nil {
 			return err
 		}
 	}
 	if operatorsRewards.IsAllPositive() {
-		err = k.allocateRewards(ctx, plan.ServiceID, plan.OperatorsDistribution, operatorDistrInfos, operatorsRewards)
+		err = k.allocateRewards(ctx, plan.ServiceID, plan.OperatorsDistribution, operatorDistrInfos, operatorsRewards, &accounting)
 		if err != nil {
 			return err
 		}
@@ -291,12 +421,14 @@ func (k *Keeper) AllocateRewardsByPlan(
 			service,
 			totalUsersDelValues,
 			usersRewards,
+			&accounting,
 		)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return k.SaveRewardsPlanAccounting(ctx, accounting)
 }
 
 // getEligiblePools returns a list of pools that are eligible for rewards
@@ -345,7 +477,8 @@ func (k *Keeper) getEligiblePools(
 // getEligibleOperators returns a list of operators that are eligible for rewards
 // allocation based on the given service's service params. If the service's
 // service params don't have any whitelisted operators, then all operators that
-// have joined the service are eligible for rewards allocation.
+// have joined the service are eligible for rewards allocation. Operators that
+// are jailed, either globally or from the given service, are never eligible.
 func (k *Keeper) getEligibleOperators(
 	ctx context.Context,
 	serviceID uint32,
@@ -371,6 +504,15 @@ func (k *Keeper) getEligibleOperators(
 			return false, nil
 		}
 
+		// Skip the operators that are jailed from the service
+		jailed, err := k.restakingKeeper.IsOperatorJailedFromService(ctx, serviceID, operatorID)
+		if err != nil {
+			return true, err
+		}
+		if jailed {
+			return false, nil
+		}
+
 		// We're already making sure that the operator can join a service only when it's
 		// allowed by the service but here we're double-checking.
 		canValidate, err := k.restakingKeeper.CanOperatorValidateService(ctx, serviceID, operatorID)
@@ -390,18 +532,24 @@ func (k *Keeper) getEligibleOperators(
 	return eligibleOperators, nil
 }
 
+// trustedTokensGetter returns the tokens of a delegation target that are
+// trusted by the given service.
+type trustedTokensGetter func(ctx context.Context, serviceID uint32, target DelegationTarget) (sdk.Coins, error)
+
 // getDistrInfos returns a list of DistributionInfo calculated based on each
-// delegation target's delegation values. getDistrInfos also returns the total
-// delegation values of all targets.
+// delegation target's delegation values, computed from the tokens returned by
+// the given getter. getDistrInfos also returns the total delegation values of
+// all targets.
 func (k *Keeper) getDistrInfos(
 	ctx context.Context,
 	serviceID uint32,
 	targets []DelegationTarget,
 	restakableDenoms []string,
+	getTrustedTokens trustedTokensGetter,
 ) (distrInfos []DistributionInfo, totalDelValues math.LegacyDec, err error) {
 	totalDelValues = math.LegacyZeroDec()
 	for _, target := range targets {
-		tokens, err := k.GetDelegationTargetTrustedTokens(ctx, serviceID, target)
+		tokens, err := getTrustedTokens(ctx, serviceID, target)
 		if err != nil {
 			return nil, math.LegacyDec{}, err
 		}
@@ -439,13 +587,15 @@ func (k *Keeper) getDistrInfos(
 // allocateRewards allocates rewards to each delegation target based on the
 // given distribution type. allocateRewards skips rewards allocation if there's
 // no delegation targets specified in the distribution. If the distribution type
-// is unknown, then an error is returned.
+// is unknown, then an error is returned. The allocated rewards are recorded
+// inside the given accounting.
 func (k *Keeper) allocateRewards(
 	ctx context.Context,
 	serviceID uint32,
 	distr types.Distribution,
 	distrInfos []DistributionInfo,
 	rewards sdk.DecCoins,
+	accounting *types.RewardsPlanAccounting,
 ) error {
 	distrType, err := types.GetDistributionType(k.cdc, distr)
 	if err != nil {
@@ -454,11 +604,11 @@ func (k *Keeper) allocateRewards(
 
 	switch typ := distrType.(type) {
 	case *types.DistributionTypeBasic:
-		return k.allocateRewardsBasic(ctx, serviceID, distrInfos, rewards)
+		return k.allocateRewardsBasic(ctx, serviceID, distrInfos, rewards, accounting)
 	case *types.DistributionTypeWeighted:
-		return k.allocateRewardsWeighted(ctx, serviceID, distrInfos, rewards, typ.Weights)
+		return k.allocateRewardsWeighted(ctx, serviceID, distrInfos, rewards, typ.Weights, accounting)
 	case *types.DistributionTypeEgalitarian:
-		return k.allocateRewardsEgalitarian(ctx, serviceID, distrInfos, rewards)
+		return k.allocateRewardsEgalitarian(ctx, serviceID, distrInfos, rewards, accounting)
 	default:
 		return errors.Wrapf(sdkerrors.ErrInvalidType, "unknown distribution type: %T", typ)
 	}
@@ -469,7 +619,7 @@ func (k *Keeper) allocateRewards(
 // the following formula:
 // targetRewards = rewards * targetDelegationsValue / totalDelegationsValue
 func (k *Keeper) allocateRewardsBasic(
-	ctx context.Context, serviceID uint32, distrInfos []DistributionInfo, rewards sdk.DecCoins,
+	ctx context.Context, serviceID uint32, distrInfos []DistributionInfo, rewards sdk.DecCoins, accounting *types.RewardsPlanAccounting,
 ) error {
 	totalDelValues := math.LegacyZeroDec()
 	for _, distrInfo := range distrInfos {
@@ -478,7 +628,7 @@ func (k *Keeper) allocateRewardsBasic(
 
 	for _, distrInfo := range distrInfos {
 		targetRewards := rewards.MulDecTruncate(distrInfo.DelegationsValue).QuoDecTruncate(totalDelValues)
-		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards)
+		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards, accounting)
 		if err != nil {
 			return err
 		}
@@ -493,6 +643,7 @@ func (k *Keeper) allocateRewardsBasic(
 // targetRewards = rewards * weight / totalWeights
 func (k *Keeper) allocateRewardsWeighted(
 	ctx context.Context, serviceID uint32, distrInfos []DistributionInfo, rewards sdk.DecCoins, weights []types.DistributionWeight,
+	accounting *types.RewardsPlanAccounting,
 ) error {
 	distrInfoByTargetID := map[uint32]DistributionInfo{}
 	for _, distrInfo := range distrInfos {
@@ -515,7 +666,7 @@ func (k *Keeper) allocateRewardsWeighted(
 		}
 
 		targetRewards := rewards.MulDecTruncate(math.LegacyNewDec(int64(weight.Weight))).QuoDecTruncate(totalWeights)
-		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards)
+		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards, accounting)
 		if err != nil {
 			return err
 		}
@@ -528,12 +679,12 @@ func (k *Keeper) allocateRewardsWeighted(
 // Each delegation target receives rewards based on the following formula:
 // targetRewards = rewards / numTargets
 func (k *Keeper) allocateRewardsEgalitarian(
-	ctx context.Context, serviceID uint32, distrInfos []DistributionInfo, rewards sdk.DecCoins,
+	ctx context.Context, serviceID uint32, distrInfos []DistributionInfo, rewards sdk.DecCoins, accounting *types.RewardsPlanAccounting,
 ) error {
 	numTargets := math.LegacyNewDec(int64(len(distrInfos)))
 	for _, distrInfo := range distrInfos {
 		targetRewards := rewards.QuoDecTruncate(numTargets)
-		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards)
+		err := k.allocateDelegationTargetRewards(ctx, serviceID, distrInfo, targetRewards, accounting)
 		if err != nil {
 			return err
 		}
@@ -552,6 +703,7 @@ func (k *Keeper) allocateRewardsToUsers(
 	service servicestypes.Service,
 	totalDelValues math.LegacyDec,
 	rewards sdk.DecCoins,
+	accounting *types.RewardsPlanAccounting,
 ) error {
 	distrType, err := types.GetUsersDistributionType(k.cdc, distr)
 	if err != nil {
@@ -567,21 +719,23 @@ func (k *Keeper) allocateRewardsToUsers(
 		return k.allocateDelegationTargetRewards(ctx, service.ID, DistributionInfo{
 			DelegationTarget: target,
 			DelegationsValue: totalDelValues,
-		}, rewards)
+		}, rewards, accounting)
 	default:
 		panic("unknown operators distribution type")
 	}
 }
 
-// allocateDelegationTargetRewards allocates rewards to a specific delegation target.
+// allocateDelegationTargetRewards allocates rewards to a specific delegation target
+// and records them inside the given accounting.
 func (k *Keeper) allocateDelegationTargetRewards(
-	ctx context.Context, serviceID uint32, distrInfo DistributionInfo, rewards sdk.DecCoins,
+	ctx context.Context, serviceID uint32, distrInfo DistributionInfo, rewards sdk.DecCoins, accounting *types.RewardsPlanAccounting,
 ) error {
-	tokens, err := k.GetDelegationTargetTrustedTokens(ctx, serviceID, distrInfo.DelegationTarget)
+	tokens, err := k.getTimeWeightedTrustedTokens(ctx, serviceID, distrInfo.DelegationTarget)
 	if err != nil {
 		return err
 	}
 
+	distributed := sdk.NewDecCoins()
 	for _, token := range tokens {
 		tokenValue, err := k.GetCoinValue(ctx, token)
 		if err != nil {
@@ -593,26 +747,33 @@ func (k *Keeper) allocateDelegationTargetRewards(
 		}
 
 		tokenRewards := rewards.MulDecTruncate(tokenValue).QuoDecTruncate(distrInfo.DelegationsValue)
-		err = k.allocateRewardsPool(ctx, serviceID, distrInfo.DelegationTarget, token.Denom, tokenRewards)
+		err = k.allocateRewardsPool(ctx, serviceID, distrInfo.DelegationTarget, token, tokenRewards)
 		if err != nil {
 			return err
 		}
+		distributed = distributed.Add(tokenRewards...)
 	}
+
+	accounting.AddDistributedRewards(distrInfo.DelegationTarget.DelegationType, distrInfo.DelegationTarget.GetID(), distributed)
 	return nil
 }
 
-// allocateRewardsPool allocates rewards to a specific delegation target's rewards pool.
+// allocateRewardsPool allocates rewards to a specific delegation target's rewards
+// pool. The given tokens are the target's time-weighted tokens of the pool's
+// denom, based on which the rewards are allocated.
 func (k *Keeper) allocateRewardsPool(
-	ctx context.Context, serviceID uint32, target DelegationTarget, denom string, rewards sdk.DecCoins,
+	ctx context.Context, serviceID uint32, target DelegationTarget, tokens sdk.Coin, rewards sdk.DecCoins,
 ) error {
+	denom := tokens.Denom
 	shared := rewards
 	if target.DelegationType == restakingtypes.DELEGATION_TYPE_OPERATOR {
-		// Split tokens between operator and delegators according to commission
-		operatorParams, err := k.operatorsKeeper.GetOperatorParams(ctx, target.GetID())
+		// Split tokens between operator and delegators according to the
+		// commission rate in effect at the current block time
+		commissionRate, err := k.operatorsKeeper.GetEffectiveCommissionRate(ctx, target.GetID())
 		if err != nil {
 			return err
 		}
-		commission := rewards.MulDec(operatorParams.CommissionRate)
+		commission := rewards.MulDec(commissionRate)
 		shared = rewards.Sub(commission)
 
 		// update current commission
@@ -637,6 +798,13 @@ func (k *Keeper) allocateRewardsPool(
 		}
 	}
 
+	// Split the rewards among the delegators based on the time during which
+	// they have been delegating in the current allocation epoch
+	shared, err := k.splitEpochRewards(ctx, serviceID, target, tokens, shared)
+	if err != nil {
+		return err
+	}
+
 	// Update current rewards
 	currentRewards, err := target.CurrentRewards.Get(ctx, target.GetID())
 	if err != nil {
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/milkyway-labs/milkyway/v12/app/testutil"
@@ -29,7 +31,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_InactivePlan() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service"),
@@ -106,7 +108,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_BasicScenario() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service1.ID,
-		utils.MustParseCoin("1000_000000service1"),
+		utils.MustParseCoins("1000_000000service1"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service1"),
@@ -114,7 +116,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_BasicScenario() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service2.ID,
-		utils.MustParseCoin("5000_000000service2"),
+		utils.MustParseCoins("5000_000000service2"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service2"),
@@ -122,7 +124,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_BasicScenario() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service3.ID,
-		utils.MustParseCoin("10000_000000service3"),
+		utils.MustParseCoins("10000_000000service3"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service3"),
@@ -293,7 +295,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_MovingPrice() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service"),
@@ -358,7 +360,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_ZeroDelegations() {
 	suite.CreateRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		planStartTime,
 		planEndTime,
 		types.NewBasicPoolsDistribution(1),
@@ -442,7 +444,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_TransferRewardsOnlyWhenAllocat
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		utils.MustParseCoins("100000_000000service"),
@@ -503,7 +505,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_TransferRewardsOnlyWhenAllocat
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		utils.MustParseCoins("100000_000000service"),
@@ -573,7 +575,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_TransferRewardsOnlyWhenAllocat
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		utils.MustParseCoins("100000_000000service"),
@@ -615,7 +617,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_NoRewardsForNonTrustedPools()
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("1000_000000service"),
+		utils.MustParseCoins("1000_000000service"),
 		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		utils.MustParseCoins("100000_000000service"),
@@ -724,7 +726,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_WeightedDistributions() {
 	suite.CreateRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		planStartTime,
 		planEndTime,
 		types.NewWeightedPoolsDistribution(3, []types.DistributionWeight{
@@ -817,7 +819,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_EgalitarianDistributions() {
 	suite.CreateRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		planStartTime,
 		planEndTime,
 		types.NewEgalitarianPoolsDistribution(3),
@@ -932,7 +934,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_TrustedServices() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service1.ID,
-		utils.MustParseCoin("1000_000000service1"),
+		utils.MustParseCoins("1000_000000service1"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service1"),
@@ -940,7 +942,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_TrustedServices() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service2.ID,
-		utils.MustParseCoin("5000_000000service2"),
+		utils.MustParseCoins("5000_000000service2"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service2"),
@@ -1049,7 +1051,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_UserTrustedServiceUpdated() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service1.ID,
-		utils.MustParseCoin("1000_000000service1"),
+		utils.MustParseCoins("1000_000000service1"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service1"),
@@ -1057,7 +1059,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_UserTrustedServiceUpdated() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service2.ID,
-		utils.MustParseCoin("5000_000000service2"),
+		utils.MustParseCoins("5000_000000service2"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service2"),
@@ -1140,7 +1142,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_InactiveService() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service1.ID,
-		utils.MustParseCoin("1000_000000service1"),
+		utils.MustParseCoins("1000_000000service1"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service1"),
@@ -1148,7 +1150,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_InactiveService() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service2.ID,
-		utils.MustParseCoin("2000_000000service2"),
+		utils.MustParseCoins("2000_000000service2"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service2"),
@@ -1230,7 +1232,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_InactiveOperator() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("1000_000000service"),
+		utils.MustParseCoins("1000_000000service"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service"),
@@ -1292,6 +1294,69 @@ func (suite *KeeperTestSuite) TestAllocateRewards_InactiveOperator() {
 	suite.Assert().True(rewards.IsEmpty())
 }
 
+func (suite *KeeperTestSuite) TestAllocateRewards_PendingCommissionChange() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	suite.RegisterCurrency(ctx, "umilk", "MILK", 6, utils.MustParseDec("2"))
+
+	// Create a service.
+	serviceAdmin := testutil.TestAddress(10001)
+	service := suite.CreateService(ctx, "Service", serviceAdmin.String())
+
+	// Create an operator which sets its commission rate to 10% and joins the service.
+	operatorAdmin := testutil.TestAddress(10002)
+	operator := suite.CreateOperator(ctx, "Operator", operatorAdmin.String())
+	suite.UpdateOperatorParams(ctx, operator.ID, utils.MustParseDec("0.1"), []uint32{service.ID})
+
+	// Create a rewards plan.
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("1000_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("100000_000000service"),
+	)
+
+	// Call AllocateRewards to set last rewards allocation time.
+	err := suite.keeper.AllocateRewards(ctx)
+	suite.Require().NoError(err)
+
+	// Alice delegates to the operator.
+	aliceAddr := testutil.TestAddress(1)
+	suite.DelegateOperator(ctx, operator.ID, utils.MustParseCoins("300_000000umilk"), aliceAddr.String(), true)
+
+	// The operator announces a commission change to 50% which takes effect in 15 seconds.
+	err = suite.operatorsKeeper.SaveCommissionChange(ctx, operatorstypes.NewCommissionChange(
+		operator.ID,
+		utils.MustParseDec("0.5"),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(15*time.Second),
+	), true)
+	suite.Require().NoError(err)
+
+	// The change is not effective yet, so the previous commission rate is used.
+	// Alice receives 0.115741 * 0.9 ~= 0.104167 $SERVICE.
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+	rewards, err := suite.keeper.GetDelegationRewards(ctx, aliceAddr, restakingtypes.DELEGATION_TYPE_OPERATOR, operator.ID)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("104166.000000000000000000service", rewards.Sum().String())
+
+	// Withdraw rewards to make calculation easier.
+	_, err = keeper.NewMsgServer(suite.keeper).WithdrawDelegatorReward(
+		ctx,
+		types.NewMsgWithdrawDelegatorReward(restakingtypes.DELEGATION_TYPE_OPERATOR, operator.ID, aliceAddr.String()),
+	)
+	suite.Require().NoError(err)
+
+	// The change is now effective even though it has not been applied yet.
+	// Alice receives 0.115741 * 0.5 ~= 0.057870 $SERVICE.
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+	rewards, err = suite.keeper.GetDelegationRewards(ctx, aliceAddr, restakingtypes.DELEGATION_TYPE_OPERATOR, operator.ID)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("57870.000000000000000000service", rewards.Sum().String())
+}
+
 func (suite *KeeperTestSuite) TestAllocateRewards_ExcludeNonTrustedTokens() {
 	// Cache the context to avoid test conflicts
 	ctx, _ := suite.ctx.CacheContext()
@@ -1309,7 +1374,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_ExcludeNonTrustedTokens() {
 	suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("1000_000000service"),
+		utils.MustParseCoins("1000_000000service"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service"),
@@ -1374,7 +1439,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards_NoRewardsAfterUnbonding() {
 	plan := suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("1000_000000service"),
+		utils.MustParseCoins("1000_000000service"),
 		planStartTime,
 		planEndTime,
 		utils.MustParseCoins("100000_000000service"),
@@ -1467,3 +1532,548 @@ func (suite *KeeperTestSuite) TestAllocateRewards_NoRewardsAfterUnbonding() {
 	suite.Require().Equal(moduleBalancesBefore, moduleBalancesAfter)
 	suite.Require().Equal(poolBalancesBefore, poolBalancesAfter)
 }
+
+func (suite *KeeperTestSuite) TestAllocateRewards_EmissionSchedule() {
+	// Cache the context to avoid test conflicts
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+	// Create a rewards plan which distributes 3 times the amount per day
+	// during the first day.
+	plan, err := suite.keeper.CreateRewardsPlan(
+		ctx,
+		"Rewards Plan",
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.NewBasicPoolsDistribution(0),
+		types.NewBasicOperatorsDistribution(0),
+		types.NewBasicUsersDistribution(0),
+		types.NewEmissionScheduleCliff(24*time.Hour, utils.MustParseDec("3")),
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+	)
+	suite.Require().NoError(err)
+	suite.FundAccount(ctx, plan.RewardsPool, utils.MustParseCoins("100000_000000service"))
+
+	delAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+	// Allocate the rewards during the cliff.
+	// 300_000000 * 10s / 1 day = 34722
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+
+	rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("34722.000000000000000000service", rewards.Sum().String())
+
+	// Allocate the rewards across the end of the cliff.
+	// 100_000000 * (3 * (1 day - 10s) + 10s) / 1 day = 299976851
+	ctx = suite.allocateRewards(ctx, 24*time.Hour)
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("300011573.000000000000000000service", rewards.Sum().String())
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_EmissionScheduleChangeWithinEpoch() {
+	// Cache the context to avoid test conflicts
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Allocate rewards once per hour
+	err := suite.keeper.Params.Set(ctx, types.NewParams(nil, 0, 0, time.Hour))
+	suite.Require().NoError(err)
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+	// Create a rewards plan whose emission rate is halved in the middle of
+	// the first epoch
+	plan, err := suite.keeper.CreateRewardsPlan(
+		ctx,
+		"Rewards Plan",
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.NewBasicPoolsDistribution(0),
+		types.NewBasicOperatorsDistribution(0),
+		types.NewBasicUsersDistribution(0),
+		types.NewEmissionScheduleStep([]types.EmissionStep{
+			types.NewEmissionStep(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC), utils.MustParseDec("0.5")),
+		}),
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+	)
+	suite.Require().NoError(err)
+	suite.FundAccount(ctx, plan.RewardsPool, utils.MustParseCoins("100000_000000service"))
+
+	delAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+	// At the end of the epoch each half of the epoch is weighted by its own rate.
+	// 100_000000 * (30m * 1 + 30m * 0.5) / 1 day = 3125000
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("3125000.000000000000000000service", rewards.Sum().String())
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_MultipleDenoms() {
+	// Cache the context to avoid test conflicts
+	ctx, _ := suite.ctx.CacheContext()
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+	// Create a rewards plan which distributes two different denoms
+	plan := suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000service,50_000000uinit"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("100000_000000service,100000_000000uinit"),
+	)
+	suite.Require().NotZero(plan.ID)
+
+	delAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+	// 100_000000service * 10s / 1 day = 11574
+	// 50_000000uinit * 10s / 1 day = 5787
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+
+	rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("11574.000000000000000000service,5787.000000000000000000uinit", rewards.Sum().String())
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_InsufficientFunds() {
+	testCases := []struct {
+		name       string
+		behavior   types.InsufficientFundsBehavior
+		expRewards string
+		expSkipped uint64
+	}{
+		{
+			name:       "skip all does not distribute anything",
+			behavior:   types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+			expRewards: "",
+			expSkipped: 1,
+		},
+		{
+			name:       "unspecified behaves like skip all",
+			behavior:   types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+			expRewards: "",
+			expSkipped: 1,
+		},
+		{
+			name:       "pay available distributes what is inside the rewards pool",
+			behavior:   types.INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE,
+			expRewards: "11574.000000000000000000service,1000.000000000000000000uinit",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Cache the context to avoid test conflicts
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+			plan, err := suite.keeper.CreateRewardsPlan(
+				ctx,
+				"Rewards Plan",
+				service.ID,
+				utils.MustParseCoins("100_000000service,50_000000uinit"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				tc.behavior,
+				nil,
+			)
+			suite.Require().NoError(err)
+
+			// Fund the rewards pool with less uinit than what should be distributed
+			suite.FundAccount(ctx, plan.RewardsPool, utils.MustParseCoins("100000_000000service,1000uinit"))
+
+			delAddr := testutil.TestAddress(1)
+			suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+			ctx = suite.allocateRewards(ctx, 10*time.Second)
+
+			rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRewards, rewards.Sum().String())
+
+			accounting, err := suite.keeper.GetRewardsPlanAccounting(ctx, plan.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSkipped, accounting.SkippedAllocations)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_USDBudget() {
+	testCases := []struct {
+		name       string
+		usdBudget  *types.USDBudget
+		elapsed    time.Duration
+		expRewards string
+	}{
+		{
+			name: "budget is converted using a fresh price",
+			usdBudget: types.NewUSDBudget(
+				utils.MustParseDec("300"),
+				"uinit",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_PAUSE,
+				0,
+			),
+			// 300 USD / 3 USD = 100_000000uinit per day
+			// 100_000000 * 10s / 1 day = 11574
+			elapsed:    10 * time.Second,
+			expRewards: "11574.000000000000000000uinit",
+		},
+		{
+			name: "allocation is paused when the price is missing",
+			usdBudget: types.NewUSDBudget(
+				utils.MustParseDec("300"),
+				"uusd",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_USE_LAST_PRICE,
+				time.Hour,
+			),
+			elapsed:    10 * time.Second,
+			expRewards: "",
+		},
+		{
+			name: "allocation is paused when the price is stale",
+			usdBudget: types.NewUSDBudget(
+				utils.MustParseDec("300"),
+				"uinit",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_PAUSE,
+				time.Hour,
+			),
+			elapsed:    2 * time.Minute,
+			expRewards: "",
+		},
+		{
+			name: "last price is used within the grace period",
+			usdBudget: types.NewUSDBudget(
+				utils.MustParseDec("300"),
+				"uinit",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_USE_LAST_PRICE,
+				time.Hour,
+			),
+			// 100_000000 * 120s / 1 day = 138888
+			elapsed:    2 * time.Minute,
+			expRewards: "138888.000000000000000000uinit",
+		},
+		{
+			name: "allocation is paused after the grace period",
+			usdBudget: types.NewUSDBudget(
+				utils.MustParseDec("300"),
+				"uinit",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_USE_LAST_PRICE,
+				time.Hour,
+			),
+			elapsed:    2 * time.Hour,
+			expRewards: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Cache the context to avoid test conflicts
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+			plan, err := suite.keeper.CreateRewardsPlan(
+				ctx,
+				"Rewards Plan",
+				service.ID,
+				nil,
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				tc.usdBudget,
+			)
+			suite.Require().NoError(err)
+			suite.FundAccount(ctx, plan.RewardsPool, utils.MustParseCoins("100000_000000uinit,100000_000000uusd"))
+
+			delAddr := testutil.TestAddress(1)
+			suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+			ctx = suite.allocateRewards(ctx, tc.elapsed)
+
+			rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delAddr, service.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRewards, rewards.Sum().String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewardsIfDue() {
+	delegator := testutil.TestAddress(1)
+
+	testCases := []struct {
+		name       string
+		epoch      time.Duration
+		planEnd    time.Time
+		elapsed    time.Duration
+		expRewards string
+	}{
+		{
+			name:       "rewards are allocated every block without epochs",
+			epoch:      0,
+			planEnd:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			elapsed:    10 * time.Minute,
+			expRewards: "694444.000000000000000000service",
+		},
+		{
+			name:       "rewards are not allocated before the epoch ends",
+			epoch:      time.Hour,
+			planEnd:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			elapsed:    10 * time.Minute,
+			expRewards: "",
+		},
+		{
+			name:       "accrued rewards are allocated when the epoch ends",
+			epoch:      time.Hour,
+			planEnd:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			elapsed:    time.Hour,
+			expRewards: "4166666.000000000000000000service",
+		},
+		{
+			name:       "accrued rewards are allocated when a plan ends",
+			epoch:      time.Hour,
+			planEnd:    time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC),
+			elapsed:    10 * time.Minute,
+			expRewards: "347222.000000000000000000service",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			err := suite.keeper.Params.Set(ctx, types.NewParams(nil, 0, 0, tc.epoch))
+			suite.Require().NoError(err)
+
+			service, _ := suite.setupSampleServiceAndOperator(ctx)
+			suite.CreateBasicRewardsPlan(
+				ctx,
+				service.ID,
+				utils.MustParseCoins("100_000000service"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				tc.planEnd,
+				utils.MustParseCoins("10000_000000service"),
+			)
+			suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delegator.String(), true)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed)).WithBlockHeight(ctx.BlockHeight() + 1)
+			err = suite.keeper.AllocateRewardsIfDue(ctx)
+			suite.Require().NoError(err)
+
+			rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, delegator, service.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRewards, rewards.Sum().String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_EpochDelegationChanges() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Allocate rewards once per hour
+	err := suite.keeper.Params.Set(ctx, types.NewParams(nil, 0, 0, time.Hour))
+	suite.Require().NoError(err)
+
+	service, operator := suite.setupSampleServiceAndOperator(ctx)
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+
+	// Alice delegates to the service at the beginning of the epoch.
+	aliceAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), aliceAddr.String(), true)
+
+	// Bob delegates the same amount to the operator in the middle of the
+	// epoch. Nothing is allocated until the epoch ends.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	bobAddr := testutil.TestAddress(2)
+	suite.DelegateOperator(ctx, operator.ID, utils.MustParseCoins("100_000000umilk"), bobAddr.String(), true)
+
+	rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, aliceAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("", rewards.Sum().String())
+
+	// At the end of the epoch Bob's delegation only counts for the half of the
+	// epoch during which it existed, so the service delegators receive 2/3 of
+	// the rewards and the operator delegators receive 1/3 of them.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, aliceAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("2777777.333333333300000000service", rewards.Sum().String())
+
+	rewards, err = suite.keeper.GetOperatorDelegationRewards(ctx, bobAddr, operator.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("1249999.799999999900000000service", rewards.Sum().String())
+
+	// The accumulators are cleared once the rewards are allocated, so during
+	// the next epoch both delegations are weighted equally.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, aliceAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("4861110.333333333300000000service", rewards.Sum().String())
+
+	rewards, err = suite.keeper.GetOperatorDelegationRewards(ctx, bobAddr, operator.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("3124999.499999999900000000service", rewards.Sum().String())
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_EpochSameTargetDelegationChanges() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Allocate rewards once per hour
+	err := suite.keeper.Params.Set(ctx, types.NewParams(nil, 0, 0, time.Hour))
+	suite.Require().NoError(err)
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+
+	// Alice and Carol delegate to the service at the beginning of the epoch.
+	aliceAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), aliceAddr.String(), true)
+	carolAddr := testutil.TestAddress(3)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), carolAddr.String(), true)
+
+	// In the middle of the epoch Carol undelegates and Bob delegates the same
+	// amount to the same service.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	_, err = suite.restakingKeeper.UndelegateFromService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), carolAddr.String())
+	suite.Require().NoError(err)
+
+	bobAddr := testutil.TestAddress(2)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), bobAddr.String(), true)
+
+	// At the end of the epoch the rewards are split based on the time during
+	// which each delegator has been delegating: Alice receives half of them,
+	// while Bob and Carol receive a quarter each.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	rewards, err := suite.keeper.GetServiceDelegationRewards(ctx, aliceAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("2083333.000000000000000000service", rewards.Sum().String())
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, bobAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("1041666.500000000000000000service", rewards.Sum().String())
+
+	// Carol has undelegated during the epoch, so her rewards are queued once
+	// the epoch ends and sent to her with the pending epoch claims.
+	balance := suite.bankKeeper.GetBalance(ctx, carolAddr, "service")
+	suite.Require().Equal("0service", balance.String())
+
+	err = suite.keeper.PayPendingEpochClaims(ctx)
+	suite.Require().NoError(err)
+
+	balance = suite.bankKeeper.GetBalance(ctx, carolAddr, "service")
+	suite.Require().Equal("1041666service", balance.String())
+
+	// During the next epoch Alice and Bob are weighted equally.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(ctx.BlockHeight() + 1)
+	err = suite.keeper.AllocateRewardsIfDue(ctx)
+	suite.Require().NoError(err)
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, aliceAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("4166666.000000000000000000service", rewards.Sum().String())
+
+	rewards, err = suite.keeper.GetServiceDelegationRewards(ctx, bobAddr, service.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("3124999.500000000000000000service", rewards.Sum().String())
+}
+
+func (suite *KeeperTestSuite) TestPayPendingEpochClaims() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+	// Queue more claims than the ones that can be paid in a single block
+	for i := uint64(1); i <= 150; i++ {
+		delAddr := testutil.TestAddress(i)
+		claim := types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, service.ID, delAddr.String())
+		key := collections.Join3(int32(restakingtypes.DELEGATION_TYPE_SERVICE), service.ID, delAddr)
+		err := suite.keeper.PendingEpochClaimPayouts.Set(ctx, key, claim)
+		suite.Require().NoError(err)
+	}
+
+	countPendingClaims := func() int {
+		count := 0
+		err := suite.keeper.PendingEpochClaimPayouts.Walk(ctx, nil, func(_ collections.Triple[int32, uint32, sdk.AccAddress], _ types.DelegatorEpochClaim) (stop bool, err error) {
+			count++
+			return false, nil
+		})
+		suite.Require().NoError(err)
+		return count
+	}
+
+	// At most 100 claims are paid in each block
+	err := suite.keeper.PayPendingEpochClaims(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(50, countPendingClaims())
+
+	err = suite.keeper.PayPendingEpochClaims(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, countPendingClaims())
+}
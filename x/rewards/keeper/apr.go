@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	poolstypes "github.com/milkyway-labs/milkyway/v12/x/pools/types"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+// daysPerYear is the number of days used to annualise the daily rewards
+const daysPerYear = 365
+
+// GetDelegationTargetAPR returns the projected annualised yield of the
+// delegations to the given target, along with the yield of each active rewards
+// plan. In the case of pools, the yield of each plan is the one received by
+// the delegators that trust the plan's service, so the total represents the
+// yield of a delegator trusting all the services.
+func (k *Keeper) GetDelegationTargetAPR(
+	ctx context.Context, delType restakingtypes.DelegationType, targetID uint32,
+) (math.LegacyDec, []types.PlanAPR, error) {
+	// Make sure the delegation target exists
+	_, err := k.GetDelegationTarget(ctx, delType, targetID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	return k.getAPR(ctx, delegationTargetKey{delType, targetID}, func(types.RewardsPlan) bool {
+		return true
+	})
+}
+
+// GetPoolServiceAPR returns the projected annualised yield that the
+// delegations to the given pool receive from the active rewards plans of the
+// given service, along with the yield of each plan.
+func (k *Keeper) GetPoolServiceAPR(ctx context.Context, poolID, serviceID uint32) (math.LegacyDec, []types.PlanAPR, error) {
+	// Make sure both the pool and the service exist
+	_, err := k.GetDelegationTarget(ctx, restakingtypes.DELEGATION_TYPE_POOL, poolID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	_, err = k.GetDelegationTarget(ctx, restakingtypes.DELEGATION_TYPE_SERVICE, serviceID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	return k.getAPR(ctx, delegationTargetKey{restakingtypes.DELEGATION_TYPE_POOL, poolID}, func(plan types.RewardsPlan) bool {
+		return plan.ServiceID == serviceID
+	})
+}
+
+// getAPR returns the projected annualised yield of the delegations to the
+// target with the given key, taking into account only the active rewards plans
+// for which shouldInclude returns true.
+func (k *Keeper) getAPR(
+	ctx context.Context, targetKey delegationTargetKey, shouldInclude func(plan types.RewardsPlan) bool,
+) (math.LegacyDec, []types.PlanAPR, error) {
+	restakableDenoms, pools, err := k.getRestakableDenomsAndPools(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delTargetCache := delegationTargetCache{}
+
+	total := math.LegacyZeroDec()
+	var plansAPRs []types.PlanAPR
+	err = k.RewardsPlans.Walk(ctx, nil, func(_ uint64, plan types.RewardsPlan) (stop bool, err error) {
+		if !plan.IsActiveAt(sdkCtx.BlockTime()) || !shouldInclude(plan) {
+			return false, nil
+		}
+
+		serviceRestakableDenoms, ok, err := k.getServiceRestakableDenoms(ctx, plan.ServiceID, restakableDenoms)
+		if err != nil {
+			return true, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+
+		aprs, err := k.getPlanAPRs(ctx, plan, pools, delTargetCache, serviceRestakableDenoms)
+		if err != nil {
+			return true, err
+		}
+
+		apr, found := aprs[targetKey]
+		if !found {
+			return false, nil
+		}
+
+		plansAPRs = append(plansAPRs, types.NewPlanAPR(plan.ID, plan.ServiceID, apr))
+		total = total.Add(apr)
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	return total, plansAPRs, nil
+}
+
+// getPlanAPRs returns the projected annualised yield that the delegators of
+// each delegation target eligible for the given plan receive from it. The
+// rewards are split among the targets the same way AllocateRewardsByPlan does,
+// assuming the plan keeps emitting rewards at the current rate for a year.
+func (k *Keeper) getPlanAPRs(
+	ctx context.Context,
+	plan types.RewardsPlan,
+	pools []poolstypes.Pool,
+	delTargetCache delegationTargetCache,
+	restakableDenoms []string,
+) (map[delegationTargetKey]math.LegacyDec, error) {
+	aprs := map[delegationTargetKey]math.LegacyDec{}
+
+	service, err := k.servicesKeeper.GetService(ctx, plan.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inactive services don't distribute any rewards
+	if !service.IsActive() {
+		return aprs, nil
+	}
+
+	// Get the USD value of the rewards distributed in a year
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	emissionRate, err := plan.GetEmissionRateAt(k.cdc, sdkCtx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	amountPerDay, err := k.GetRewardsPlanAmountPerDay(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	valuePerDay, err := k.GetCoinsValue(ctx, amountPerDay)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsValue := valuePerDay.Mul(emissionRate).MulInt64(daysPerYear)
+	if !rewardsValue.IsPositive() {
+		return aprs, nil
+	}
+
+	eligiblePools, err := k.getEligiblePools(ctx, service.ID, pools, delTargetCache)
+	if err != nil {
+		return nil, err
+	}
+	poolDistrInfos, totalPoolsDelValues, err := k.getDistrInfos(ctx, service.ID, eligiblePools, restakableDenoms, k.GetDelegationTargetTrustedTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleOperators, err := k.getEligibleOperators(ctx, service.ID, delTargetCache)
+	if err != nil {
+		return nil, err
+	}
+	operatorDistrInfos, totalOperatorsDelValues, err := k.getDistrInfos(ctx, service.ID, eligibleOperators, restakableDenoms, k.GetDelegationTargetTrustedTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensDelegatedToService := service.Tokens
+	if len(restakableDenoms) > 0 {
+		tokensDelegatedToService = service.GetAllowedTokens(restakableDenoms)
+	}
+	totalUsersDelValues, err := k.GetCoinsValue(ctx, tokensDelegatedToService)
+	if err != nil {
+		return nil, err
+	}
+
+	totalDelValues := totalPoolsDelValues.Add(totalOperatorsDelValues).Add(totalUsersDelValues)
+	if totalDelValues.IsZero() {
+		return aprs, nil
+	}
+
+	// Split the rewards among the delegation types
+	totalWeights := uint32(0)
+	if totalPoolsDelValues.IsPositive() {
+		totalWeights += plan.PoolsDistribution.Weight
+	}
+	if totalOperatorsDelValues.IsPositive() {
+		totalWeights += plan.OperatorsDistribution.Weight
+	}
+	if totalUsersDelValues.IsPositive() {
+		totalWeights += plan.UsersDistribution.Weight
+	}
+
+	var poolsRewardsValue, operatorsRewardsValue, usersRewardsValue math.LegacyDec
+	if totalWeights > 0 {
+		totalWeightsDec := math.LegacyNewDec(int64(totalWeights))
+		poolsRewardsValue = rewardsValue.MulInt64(int64(plan.PoolsDistribution.Weight)).Quo(totalWeightsDec)
+		operatorsRewardsValue = rewardsValue.MulInt64(int64(plan.OperatorsDistribution.Weight)).Quo(totalWeightsDec)
+		usersRewardsValue = rewardsValue.MulInt64(int64(plan.UsersDistribution.Weight)).Quo(totalWeightsDec)
+	} else {
+		poolsRewardsValue = rewardsValue.Mul(totalPoolsDelValues).Quo(totalDelValues)
+		operatorsRewardsValue = rewardsValue.Mul(totalOperatorsDelValues).Quo(totalDelValues)
+		usersRewardsValue = rewardsValue.Mul(totalUsersDelValues).Quo(totalDelValues)
+	}
+
+	// Compute the yield of the pools
+	if poolsRewardsValue.IsPositive() {
+		targetsRewardsValues, err := k.getTargetsRewardsValues(plan.PoolsDistribution, poolDistrInfos, poolsRewardsValue)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, distrInfo := range poolDistrInfos {
+			targetRewardsValue, ok := targetsRewardsValues[distrInfo.DelegationTarget.GetID()]
+			if !ok {
+				continue
+			}
+
+			key := delegationTargetKey{restakingtypes.DELEGATION_TYPE_POOL, distrInfo.DelegationTarget.GetID()}
+			aprs[key] = targetRewardsValue.Quo(distrInfo.DelegationsValue)
+		}
+	}
+
+	// Compute the yield of the operators, excluding their commission
+	if operatorsRewardsValue.IsPositive() {
+		targetsRewardsValues, err := k.getTargetsRewardsValues(plan.OperatorsDistribution, operatorDistrInfos, operatorsRewardsValue)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, distrInfo := range operatorDistrInfos {
+			targetRewardsValue, ok := targetsRewardsValues[distrInfo.DelegationTarget.GetID()]
+			if !ok {
+				continue
+			}
+
+			commissionRate, err := k.operatorsKeeper.GetEffectiveCommissionRate(ctx, distrInfo.DelegationTarget.GetID())
+			if err != nil {
+				return nil, err
+			}
+
+			key := delegationTargetKey{restakingtypes.DELEGATION_TYPE_OPERATOR, distrInfo.DelegationTarget.GetID()}
+			aprs[key] = targetRewardsValue.Mul(math.LegacyOneDec().Sub(commissionRate)).Quo(distrInfo.DelegationsValue)
+		}
+	}
+
+	// Compute the yield of the service's users
+	if usersRewardsValue.IsPositive() {
+		key := delegationTargetKey{restakingtypes.DELEGATION_TYPE_SERVICE, service.ID}
+		aprs[key] = usersRewardsValue.Quo(totalUsersDelValues)
+	}
+
+	return aprs, nil
+}
+
+// getTargetsRewardsValues splits the given rewards value among the delegation
+// targets following the given distribution, the same way allocateRewards
+// does. The returned map is indexed by the delegation target ID.
+func (k *Keeper) getTargetsRewardsValues(
+	distr types.Distribution, distrInfos []DistributionInfo, rewardsValue math.LegacyDec,
+) (map[uint32]math.LegacyDec, error) {
+	distrType, err := types.GetDistributionType(k.cdc, distr)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[uint32]math.LegacyDec{}
+	switch typ := distrType.(type) {
+	case *types.DistributionTypeBasic:
+		totalDelValues := math.LegacyZeroDec()
+		for _, distrInfo := range distrInfos {
+			totalDelValues = totalDelValues.Add(distrInfo.DelegationsValue)
+		}
+
+		for _, distrInfo := range distrInfos {
+			values[distrInfo.DelegationTarget.GetID()] = rewardsValue.Mul(distrInfo.DelegationsValue).Quo(totalDelValues)
+		}
+
+	case *types.DistributionTypeWeighted:
+		eligible := map[uint32]bool{}
+		for _, distrInfo := range distrInfos {
+			eligible[distrInfo.DelegationTarget.GetID()] = true
+		}
+
+		totalWeights := math.LegacyZeroDec()
+		for _, weight := range typ.Weights {
+			if eligible[weight.DelegationTargetID] {
+				totalWeights = totalWeights.Add(math.LegacyNewDec(int64(weight.Weight)))
+			}
+		}
+
+		for _, weight := range typ.Weights {
+			if eligible[weight.DelegationTargetID] {
+				values[weight.DelegationTargetID] = rewardsValue.MulInt64(int64(weight.Weight)).Quo(totalWeights)
+			}
+		}
+
+	case *types.DistributionTypeEgalitarian:
+		numTargets := math.LegacyNewDec(int64(len(distrInfos)))
+		for _, distrInfo := range distrInfos {
+			values[distrInfo.DelegationTarget.GetID()] = rewardsValue.Quo(numTargets)
+		}
+
+	default:
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "unknown distribution type: %T", typ)
+	}
+
+	return values, nil
+}
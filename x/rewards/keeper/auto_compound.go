@@ -0,0 +1,391 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards that
+// the given delegator receives from the given delegation target.
+func (k *Keeper) SetAutoCompound(
+	ctx context.Context,
+	delAddr sdk.AccAddress,
+	delType restakingtypes.DelegationType,
+	targetID uint32,
+	enabled bool,
+) error {
+	key := collections.Join3(delAddr, int32(delType), targetID)
+	if !enabled {
+		found, err := k.AutoCompoundSettings.Has(ctx, key)
+		if err != nil || !found {
+			return err
+		}
+		return k.AutoCompoundSettings.Remove(ctx, key)
+	}
+
+	delegator, err := k.accountKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundSettings.Set(ctx, key, types.NewAutoCompoundSetting(delegator, delType, targetID))
+}
+
+// IsAutoCompoundEnabled returns true if the given delegator has enabled the
+// auto-compounding of the rewards received from the given delegation target.
+func (k *Keeper) IsAutoCompoundEnabled(
+	ctx context.Context,
+	delAddr sdk.AccAddress,
+	delType restakingtypes.DelegationType,
+	targetID uint32,
+) (bool, error) {
+	return k.AutoCompoundSettings.Has(ctx, collections.Join3(delAddr, int32(delType), targetID))
+}
+
+// RemoveDelegationTargetAutoCompoundSettings removes the auto-compound settings
+// of all the delegators of the given delegation target.
+func (k *Keeper) RemoveDelegationTargetAutoCompoundSettings(
+	ctx context.Context,
+	delType restakingtypes.DelegationType,
+	targetID uint32,
+) error {
+	iterator, err := k.AutoCompoundSettings.Indexes.Target.MatchExact(ctx, collections.Join(int32(delType), targetID))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	keys, err := iterator.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = k.AutoCompoundSettings.Remove(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetNextAutoCompoundTime returns the time at which the next auto-compounding
+// epoch starts. If there's no next auto-compound time set yet, nil is returned.
+func (k *Keeper) GetNextAutoCompoundTime(ctx context.Context) (*time.Time, error) {
+	ts, err := k.NextAutoCompoundTime.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	t, err := gogotypes.TimestampFromProto(&ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// SetNextAutoCompoundTime sets the time at which the next auto-compounding
+// epoch starts.
+func (k *Keeper) SetNextAutoCompoundTime(ctx context.Context, t time.Time) error {
+	ts, err := gogotypes.TimestampProto(t)
+	if err != nil {
+		return err
+	}
+	return k.NextAutoCompoundTime.Set(ctx, *ts)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProcessAutoCompoundQueue compounds the rewards of all the delegators that
+// have enabled the auto-compounding. Once an epoch starts, the auto-compound
+// settings are processed in order until the max gas per block has been
+// consumed. The position inside the queue is then stored so that the
+// processing can be resumed in the next block, until the whole queue has been
+// processed and the next epoch is scheduled.
+func (k *Keeper) ProcessAutoCompoundQueue(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Auto-compounding is disabled
+	if params.AutoCompoundInterval == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	ranger := new(collections.Range[collections.Triple[sdk.AccAddress, int32, uint32]])
+	cursor, err := k.AutoCompoundQueueCursor.Get(ctx)
+	if err != nil {
+		if !errors.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+
+		// No epoch is in progress, check if a new one should be started
+		nextTime, err := k.GetNextAutoCompoundTime(ctx)
+		if err != nil {
+			return err
+		}
+
+		if nextTime == nil {
+			return k.SetNextAutoCompoundTime(ctx, blockTime.Add(params.AutoCompoundInterval))
+		}
+
+		if blockTime.Before(*nextTime) {
+			return nil
+		}
+	} else {
+		// Resume the processing from where it was left
+		ranger = ranger.StartExclusive(cursor)
+	}
+
+	startGas := sdkCtx.GasMeter().GasConsumed()
+	for sdkCtx.GasMeter().GasConsumed()-startGas < params.AutoCompoundMaxGasPerBlock {
+		key, setting, found, err := k.getFirstAutoCompoundSetting(ctx, ranger)
+		if err != nil {
+			return err
+		}
+
+		// The whole queue has been processed, schedule the next epoch
+		if !found {
+			err = k.AutoCompoundQueueCursor.Remove(ctx)
+			if err != nil {
+				return err
+			}
+			return k.SetNextAutoCompoundTime(ctx, blockTime.Add(params.AutoCompoundInterval))
+		}
+
+		// Compound the rewards using a cached context so that a failure does
+		// not affect the state nor the processing of the other settings
+		cachedCtx, writeCache := sdkCtx.CacheContext()
+		err = k.compoundDelegationRewards(cachedCtx, setting)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to compound delegation rewards",
+				"delegator", setting.DelegatorAddress,
+				"delegation_type", setting.DelegationType.String(),
+				"delegation_target_id", setting.DelegationTargetID,
+				"error", err,
+			)
+		} else {
+			writeCache()
+		}
+
+		ranger = new(collections.Range[collections.Triple[sdk.AccAddress, int32, uint32]]).StartExclusive(key)
+		err = k.AutoCompoundQueueCursor.Set(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getFirstAutoCompoundSetting returns the first auto-compound setting within
+// the given range, if any
+func (k *Keeper) getFirstAutoCompoundSetting(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Triple[sdk.AccAddress, int32, uint32]],
+) (collections.Triple[sdk.AccAddress, int32, uint32], types.AutoCompoundSetting, bool, error) {
+	iterator, err := k.AutoCompoundSettings.Iterate(ctx, ranger)
+	if err != nil {
+		return collections.Triple[sdk.AccAddress, int32, uint32]{}, types.AutoCompoundSetting{}, false, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return collections.Triple[sdk.AccAddress, int32, uint32]{}, types.AutoCompoundSetting{}, false, nil
+	}
+
+	kv, err := iterator.KeyValue()
+	if err != nil {
+		return collections.Triple[sdk.AccAddress, int32, uint32]{}, types.AutoCompoundSetting{}, false, err
+	}
+
+	return kv.Key, kv.Value, true, nil
+}
+
+// compoundDelegationRewards withdraws the rewards of the delegation associated
+// with the given setting and delegates them again to the same target. The
+// rewards that cannot be delegated to the target are sent to the delegator's
+// withdraw address instead.
+func (k *Keeper) compoundDelegationRewards(ctx context.Context, setting types.AutoCompoundSetting) error {
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(setting.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	target, err := k.GetDelegationTarget(ctx, setting.DelegationType, setting.DelegationTargetID)
+	if err != nil {
+		return err
+	}
+
+	delegation, found, err := k.restakingKeeper.GetDelegationForTarget(ctx, target.DelegationTarget, setting.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// There's nothing to compound if the delegation does not exist
+	if !found {
+		return nil
+	}
+
+	// Withdraw the rewards to the delegator's account so that they can be
+	// delegated again
+	rewards, err := k.withdrawDelegationRewardsTo(ctx, target, delegation, delAddr)
+	if err != nil {
+		return err
+	}
+
+	err = k.initializeDelegation(ctx, target, delAddr)
+	if err != nil {
+		return err
+	}
+
+	rewardsCoins := rewards.Sum()
+	if rewardsCoins.IsZero() {
+		return nil
+	}
+
+	compounded, err := k.getCompoundableCoins(ctx, target, rewardsCoins)
+	if err != nil {
+		return err
+	}
+
+	if !compounded.IsZero() {
+		err = k.delegateToTarget(ctx, target, compounded, setting.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Send the rewards that have not been compounded to the withdraw address
+	withdrawn := rewardsCoins.Sub(compounded...)
+	if !withdrawn.IsZero() {
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		if !withdrawAddr.Equals(sdk.AccAddress(delAddr)) {
+			err = k.bankKeeper.SendCoins(ctx, delAddr, withdrawAddr, withdrawn)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = k.sendRewardsOverIBC(ctx, delAddr, withdrawAddr, withdrawn)
+		if err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegationType, setting.DelegationType.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegationTargetID, fmt.Sprint(setting.DelegationTargetID)),
+			sdk.NewAttribute(restakingtypes.AttributeKeyDelegator, setting.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCompoundedAmount, compounded.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnAmount, withdrawn.String()),
+		),
+	)
+
+	return nil
+}
+
+// getCompoundableCoins returns the coins, among the given ones, that can be
+// delegated to the given target
+func (k *Keeper) getCompoundableCoins(ctx context.Context, target DelegationTarget, coins sdk.Coins) (sdk.Coins, error) {
+	allowedDenoms, err := k.restakingKeeper.GetRestakableDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch target.DelegationType {
+	case restakingtypes.DELEGATION_TYPE_POOL:
+		pool, err := k.poolsKeeper.GetPool(ctx, target.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		// Pools only accept their own denom
+		if len(allowedDenoms) > 0 && !slices.Contains(allowedDenoms, pool.Denom) {
+			return sdk.NewCoins(), nil
+		}
+		allowedDenoms = []string{pool.Denom}
+
+	case restakingtypes.DELEGATION_TYPE_SERVICE:
+		serviceParams, err := k.servicesKeeper.GetServiceParams(ctx, target.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		// Services can further restrict the denoms that they accept
+		if len(serviceParams.AllowedDenoms) > 0 {
+			if len(allowedDenoms) == 0 {
+				allowedDenoms = serviceParams.AllowedDenoms
+			} else {
+				allowedDenoms = utils.Intersect(allowedDenoms, serviceParams.AllowedDenoms)
+				if len(allowedDenoms) == 0 {
+					return sdk.NewCoins(), nil
+				}
+			}
+		}
+	}
+
+	// No denoms restriction, all the coins can be compounded
+	if len(allowedDenoms) == 0 {
+		return coins, nil
+	}
+
+	compoundable := sdk.NewCoins()
+	for _, coin := range coins {
+		if slices.Contains(allowedDenoms, coin.Denom) {
+			compoundable = compoundable.Add(coin)
+		}
+	}
+	return compoundable, nil
+}
+
+// delegateToTarget delegates the given amount from the delegator to the given target
+func (k *Keeper) delegateToTarget(ctx context.Context, target DelegationTarget, amount sdk.Coins, delegator string) error {
+	switch target.DelegationType {
+	case restakingtypes.DELEGATION_TYPE_POOL:
+		for _, coin := range amount {
+			_, err := k.restakingKeeper.DelegateToPool(ctx, coin, delegator)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case restakingtypes.DELEGATION_TYPE_OPERATOR:
+		_, err := k.restakingKeeper.DelegateToOperator(ctx, target.GetID(), amount, delegator)
+		return err
+	case restakingtypes.DELEGATION_TYPE_SERVICE:
+		_, err := k.restakingKeeper.DelegateToService(ctx, target.GetID(), amount, delegator)
+		return err
+	default:
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "unknown delegation type: %s", target.DelegationType)
+	}
+}
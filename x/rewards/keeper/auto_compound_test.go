@@ -0,0 +1,310 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_ProcessAutoCompoundQueue() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		updateCtx func(ctx sdk.Context) sdk.Context
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "disabled auto-compounding does nothing",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.Params.Set(ctx, types.NewParams(nil, 0, 0, 0))
+				suite.Require().NoError(err)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				nextTime, err := suite.keeper.GetNextAutoCompoundTime(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Nil(nextTime)
+			},
+		},
+		{
+			name: "first epoch is scheduled properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.Params.Set(ctx, types.NewParams(nil, time.Hour, types.DefaultAutoCompoundMaxGasPerBlock, 0))
+				suite.Require().NoError(err)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				nextTime, err := suite.keeper.GetNextAutoCompoundTime(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ctx.BlockTime().Add(time.Hour), *nextTime)
+			},
+		},
+		{
+			name: "rewards are not compounded before the epoch starts",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				err := suite.keeper.SetNextAutoCompoundTime(ctx, ctx.BlockTime().Add(time.Hour))
+				suite.Require().NoError(err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				service, err := suite.servicesKeeper.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(service.Tokens.AmountOf("service").IsZero())
+			},
+		},
+		{
+			name: "rewards are compounded properly",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				err := suite.keeper.SetNextAutoCompoundTime(ctx, ctx.BlockTime())
+				suite.Require().NoError(err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				// Make sure the rewards have been delegated to the service
+				service, err := suite.servicesKeeper.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkmath.NewInt(11574), service.Tokens.AmountOf("service"))
+
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				suite.Require().True(suite.bankKeeper.GetAllBalances(ctx, delAddr).IsZero())
+
+				// Make sure the next epoch has been scheduled
+				_, err = suite.keeper.AutoCompoundQueueCursor.Get(ctx)
+				suite.Require().Error(err)
+
+				nextTime, err := suite.keeper.GetNextAutoCompoundTime(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ctx.BlockTime().Add(time.Hour), *nextTime)
+			},
+		},
+		{
+			name: "rewards that cannot be delegated are sent to the withdraw address",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				// Only allow $MILK to be delegated to the service
+				params := servicestypes.DefaultServiceParams()
+				params.AllowedDenoms = []string{"umilk"}
+				err := suite.servicesKeeper.SetServiceParams(ctx, 1, params)
+				suite.Require().NoError(err)
+
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				withdrawAddr, err := sdk.AccAddressFromBech32("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				err = suite.keeper.SetWithdrawAddress(ctx, delAddr, withdrawAddr)
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetNextAutoCompoundTime(ctx, ctx.BlockTime())
+				suite.Require().NoError(err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				service, err := suite.servicesKeeper.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(service.Tokens.AmountOf("service").IsZero())
+
+				withdrawAddr, err := sdk.AccAddressFromBech32("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				suite.Require().Equal("11574service", suite.bankKeeper.GetAllBalances(ctx, withdrawAddr).String())
+			},
+		},
+		{
+			name: "processing is resumed in the next block when the max gas is reached",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				// Enable the auto-compounding for a second delegator as well
+				suite.DelegateService(
+					ctx,
+					1,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					true,
+				)
+				delAddr, err := sdk.AccAddressFromBech32("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAutoCompound(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 1, true)
+				suite.Require().NoError(err)
+
+				// Allow only one setting to be processed per block
+				err = suite.keeper.Params.Set(ctx, types.NewParams(nil, time.Hour, 1, 0))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetNextAutoCompoundTime(ctx, ctx.BlockTime())
+				suite.Require().NoError(err)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				// Only the first setting has been processed
+				_, err := suite.keeper.AutoCompoundQueueCursor.Get(ctx)
+				suite.Require().NoError(err)
+
+				service, err := suite.servicesKeeper.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(service.Tokens.AmountOf("service").IsPositive())
+				firstAmount := service.Tokens.AmountOf("service")
+
+				// Process the second setting
+				err = suite.keeper.ProcessAutoCompoundQueue(ctx)
+				suite.Require().NoError(err)
+
+				service, err = suite.servicesKeeper.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(service.Tokens.AmountOf("service").GT(firstAmount))
+
+				// The queue is now empty, the next epoch gets scheduled
+				err = suite.keeper.ProcessAutoCompoundQueue(ctx)
+				suite.Require().NoError(err)
+
+				_, err = suite.keeper.AutoCompoundQueueCursor.Get(ctx)
+				suite.Require().Error(err)
+
+				nextTime, err := suite.keeper.GetNextAutoCompoundTime(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ctx.BlockTime().Add(time.Hour), *nextTime)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+			if tc.updateCtx != nil {
+				ctx = tc.updateCtx(ctx)
+			}
+
+			err := suite.keeper.ProcessAutoCompoundQueue(ctx)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_AutoCompoundSettingsRemoval() {
+	testCases := []struct {
+		name  string
+		store func(ctx sdk.Context)
+		check func(ctx sdk.Context)
+	}{
+		{
+			name: "setting is removed when the delegation is fully removed",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				_, err := suite.restakingKeeper.UndelegateFromService(
+					ctx,
+					1,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			name: "setting is removed when the delegation target is deleted",
+			store: func(ctx sdk.Context) {
+				suite.setupAutoCompoundDelegation(ctx)
+
+				err := suite.keeper.ServicesHooks().BeforeServiceDeleted(ctx, 1)
+				suite.Require().NoError(err)
+			},
+			check: func(ctx sdk.Context) {
+				// Make sure the target index has been cleared as well
+				iter, err := suite.keeper.AutoCompoundSettings.Indexes.Target.MatchExact(
+					ctx,
+					collections.Join(int32(restakingtypes.DELEGATION_TYPE_SERVICE), uint32(1)),
+				)
+				suite.Require().NoError(err)
+				defer iter.Close()
+				suite.Require().False(iter.Valid())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+			suite.Require().NoError(err)
+
+			enabled, err := suite.keeper.IsAutoCompoundEnabled(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 1)
+			suite.Require().NoError(err)
+			suite.Require().False(enabled)
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
+// setupAutoCompoundDelegation creates a service with a rewards plan, delegates
+// to it and enables the auto-compounding of the delegation rewards
+func (suite *KeeperTestSuite) setupAutoCompoundDelegation(ctx sdk.Context) {
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+
+	suite.DelegateService(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000umilk"),
+		"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+		true,
+	)
+
+	delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+	suite.Require().NoError(err)
+	err = suite.keeper.SetAutoCompound(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, service.ID, true)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Params.Set(ctx, types.NewParams(nil, time.Hour, types.DefaultAutoCompoundMaxGasPerBlock, 0))
+	suite.Require().NoError(err)
+}
@@ -99,7 +99,7 @@ func (suite *KeeperTestSuite) FundAccount(ctx sdk.Context, addr string, amt sdk.
 // and price. RegisterCurrency creates a market for the currency if not exists.
 func (suite *KeeperTestSuite) RegisterCurrency(ctx sdk.Context, denom string, ticker string, exponent uint32, price math.LegacyDec) {
 	// Create the market only if it doesn't exist.
-	mmTicker := marketmaptypes.NewTicker(ticker, rewardstypes.USDTicker, math.LegacyPrecision, 0, true)
+	mmTicker := marketmaptypes.NewTicker(ticker, assetstypes.USDTicker, math.LegacyPrecision, 0, true)
 	hasMarket, err := suite.marketMapKeeper.HasMarket(ctx, mmTicker.String())
 	suite.Require().NoError(err)
 
@@ -111,7 +111,7 @@ func (suite *KeeperTestSuite) RegisterCurrency(ctx sdk.Context, denom string, ti
 	// Set the price for the currency pair.
 	err = suite.oracleKeeper.SetPriceForCurrencyPair(
 		ctx,
-		connecttypes.NewCurrencyPair(ticker, rewardstypes.USDTicker),
+		connecttypes.NewCurrencyPair(ticker, assetstypes.USDTicker),
 		oracletypes.QuotePrice{
 			Price:          math.NewIntFromBigInt(price.BigInt()),
 			BlockTimestamp: ctx.BlockTime(),
@@ -184,7 +184,7 @@ func (suite *KeeperTestSuite) UpdateOperatorParams(
 	suite.Require().NoError(err)
 
 	// Sets the operator commission rate
-	err = suite.operatorsKeeper.SaveOperatorParams(ctx, operatorID, operatorstypes.NewOperatorParams(commissionRate))
+	err = suite.operatorsKeeper.SaveOperatorParams(ctx, operatorID, operatorstypes.NewOperatorParams(commissionRate, math.LegacyOneDec(), math.LegacyOneDec()))
 	suite.Require().NoError(err)
 
 	// Make the operator join the service.
@@ -246,7 +246,7 @@ func (suite *KeeperTestSuite) AddOperatorsToServiceAllowList(
 func (suite *KeeperTestSuite) CreateRewardsPlan(
 	ctx sdk.Context,
 	serviceID uint32,
-	amtPerDay sdk.Coin,
+	amtPerDay sdk.Coins,
 	startTime time.Time,
 	endTime time.Time,
 	poolsDistr rewardstypes.Distribution,
@@ -268,6 +268,9 @@ func (suite *KeeperTestSuite) CreateRewardsPlan(
 		operatorsDistr,
 		usersDistr,
 		nil,
+		rewardstypes.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+		nil,
 		service.Admin,
 	))
 	suite.Require().NoError(err)
@@ -290,7 +293,7 @@ func (suite *KeeperTestSuite) CreateRewardsPlan(
 func (suite *KeeperTestSuite) CreateBasicRewardsPlan(
 	ctx sdk.Context,
 	serviceID uint32,
-	amtPerDay sdk.Coin,
+	amtPerDay sdk.Coins,
 	startTime,
 	endTime time.Time,
 	initialRewards sdk.Coins,
@@ -203,7 +203,8 @@ func (k *Keeper) CalculateDelegationRewards(
 	return delRewards, nil
 }
 
-// withdrawDelegationRewards withdraws the rewards from the delegation and reinitializes it
+// withdrawDelegationRewards withdraws the rewards from the delegation and
+// sends them to the delegator's withdraw address
 func (k *Keeper) withdrawDelegationRewards(
 	ctx context.Context, target DelegationTarget, del restakingtypes.Delegation,
 ) (types.Pools, error) {
@@ -212,6 +213,36 @@ func (k *Keeper) withdrawDelegationRewards(
 		return nil, err
 	}
 
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.withdrawDelegationRewardsTo(ctx, target, del, withdrawAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the rewards to the other chain, if the delegator has set an IBC
+	// withdraw destination
+	err = k.sendRewardsOverIBC(ctx, delAddr, withdrawAddr, rewards.Sum())
+	if err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// withdrawDelegationRewardsTo withdraws the rewards from the delegation and
+// sends them to the given recipient
+func (k *Keeper) withdrawDelegationRewardsTo(
+	ctx context.Context, target DelegationTarget, del restakingtypes.Delegation, recipient sdk.AccAddress,
+) (types.Pools, error) {
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(del.UserAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check the existence of delegator starting info
 	hasInfo, err := target.DelegatorStartingInfos.Has(ctx, collections.Join(target.GetID(), sdk.AccAddress(delAddr)))
 	if err != nil {
@@ -257,14 +288,9 @@ func (k *Keeper) withdrawDelegationRewards(
 	pools, _ := rewards.TruncateDecimal()
 	coins := pools.Sum()
 
-	// Add pools to user account
+	// Add pools to the recipient account
 	if !pools.IsEmpty() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, withdrawAddr, coins)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, recipient, coins)
 		if err != nil {
 			return nil, err
 		}
@@ -283,6 +309,13 @@ func (k *Keeper) withdrawDelegationRewards(
 		return nil, err
 	}
 
+	// Keep track of the stakes held during the current allocation epoch, since
+	// their rewards will only be allocated once the epoch ends
+	err = k.addDelegatorEpochClaim(ctx, target, delAddr, startingInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	startingPeriod := startingInfo.PreviousPeriod
 	err = k.decrementReferenceCount(ctx, target, startingPeriod)
 	if err != nil {
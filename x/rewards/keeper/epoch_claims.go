@@ -0,0 +1,458 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+// getEpochStartTime returns the time at which the current allocation epoch has
+// started. If rewards are allocated every block, or if no time has elapsed
+// since the last rewards allocation, nil is returned instead.
+func (k *Keeper) getEpochStartTime(ctx context.Context) (*time.Time, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.AllocationEpochDuration == 0 {
+		return nil, nil
+	}
+
+	lastAllocationTime, err := k.GetLastRewardsAllocationTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if lastAllocationTime == nil || !sdkCtx.BlockTime().After(*lastAllocationTime) {
+		return nil, nil
+	}
+
+	return lastAllocationTime, nil
+}
+
+// recordPeriodStartTime stores the current block time as the start time of the
+// period that follows the given one, if it has ended during the current
+// allocation epoch.
+func (k *Keeper) recordPeriodStartTime(ctx context.Context, target DelegationTarget, period uint64) error {
+	epochStartTime, err := k.getEpochStartTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if epochStartTime == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	startTime := types.NewPeriodStartTime(target.DelegationType, target.GetID(), period, sdkCtx.BlockTime())
+	return k.PeriodStartTimes.Set(ctx, collections.Join3(int32(target.DelegationType), target.GetID(), period), startTime)
+}
+
+// getDelegatorEpochStartTime returns the time from which a delegator that has
+// started delegating at the end of the given period has been delegating during
+// the current allocation epoch.
+func (k *Keeper) getDelegatorEpochStartTime(
+	ctx context.Context, target DelegationTarget, period uint64, epochStartTime time.Time,
+) (time.Time, error) {
+	startTime, err := k.PeriodStartTimes.Get(ctx, collections.Join3(int32(target.DelegationType), target.GetID(), period))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			// The delegator has started delegating before the epoch
+			return epochStartTime, nil
+		}
+		return time.Time{}, err
+	}
+	return startTime.StartTime, nil
+}
+
+// addDelegatorEpochClaim records the stakes that a delegator has held during
+// the current allocation epoch, so that the delegator receives the related
+// rewards once the epoch ends. It must be called when the delegator's rewards
+// are withdrawn, before the starting info is removed.
+func (k *Keeper) addDelegatorEpochClaim(
+	ctx context.Context, target DelegationTarget, delAddr sdk.AccAddress, startingInfo types.DelegatorStartingInfo,
+) error {
+	epochStartTime, err := k.getEpochStartTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if epochStartTime == nil {
+		return nil
+	}
+
+	startTime, err := k.getDelegatorEpochStartTime(ctx, target, startingInfo.PreviousPeriod, *epochStartTime)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	elapsed := sdkCtx.BlockTime().Sub(startTime).Milliseconds()
+	if elapsed <= 0 {
+		return nil
+	}
+
+	key := collections.Join3(int32(target.DelegationType), target.GetID(), delAddr)
+	claim, err := k.DelegatorEpochClaims.Get(ctx, key)
+	if err != nil {
+		if !errors.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+
+		delegator, err := k.accountKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return err
+		}
+		claim = types.NewDelegatorEpochClaim(target.DelegationType, target.GetID(), delegator)
+	}
+
+	return k.DelegatorEpochClaims.Set(ctx, key, claim.AddStakes(startingInfo.Stakes, elapsed))
+}
+
+// splitEpochRewards splits the rewards allocated to a delegation target for the
+// given denom among the periods of the current allocation epoch, based on the
+// time during which each delegator has been delegating. The rewards owed to
+// the delegators that have withdrawn during the epoch are added to their
+// claims, and the ratios of the periods that have ended during the epoch are
+// increased so that each delegator only receives the rewards accrued since it
+// has started delegating. The returned rewards are the ones accrued by the
+// current delegators since the last period has started, which must be added to
+// the target's current rewards.
+func (k *Keeper) splitEpochRewards(
+	ctx context.Context, serviceID uint32, target DelegationTarget, timeWeightedTokens sdk.Coin, rewards sdk.DecCoins,
+) (sdk.DecCoins, error) {
+	accumulatorServiceID := uint32(0)
+	if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL {
+		accumulatorServiceID = serviceID
+	}
+
+	// If the delegator shares have not changed during the epoch, all the
+	// delegators have been delegating during the whole epoch
+	accumulator, found, err := k.GetDelegatorSharesAccumulator(ctx, target.DelegationType, target.GetID(), accumulatorServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochDuration := sdkCtx.BlockTime().Sub(accumulator.StartTime).Milliseconds()
+	if !found || epochDuration <= 0 || !timeWeightedTokens.Amount.IsPositive() {
+		return rewards, nil
+	}
+
+	epochStartTime := accumulator.StartTime
+	epochMilliseconds := math.LegacyNewDec(epochDuration)
+	rewardsPerToken := rewards.QuoDecTruncate(math.LegacyNewDecFromInt(timeWeightedTokens.Amount))
+
+	// Increase the reward ratios of the periods that have ended during the
+	// epoch by the rewards accrued before each of them has ended, so that the
+	// delegators that have started delegating at the end of a period do not
+	// receive them
+	lastPeriodStartTime := epochStartTime
+	targetRange := collections.NewSuperPrefixedTripleRange[int32, uint32, uint64](int32(target.DelegationType), target.GetID())
+	err = k.PeriodStartTimes.Walk(ctx, targetRange, func(_ collections.Triple[int32, uint32, uint64], startTime types.PeriodStartTime) (stop bool, err error) {
+		if startTime.StartTime.After(lastPeriodStartTime) {
+			lastPeriodStartTime = startTime.StartTime
+		}
+
+		elapsed := startTime.StartTime.Sub(epochStartTime).Milliseconds()
+		if elapsed <= 0 {
+			return false, nil
+		}
+
+		// The historical rewards are removed once no delegator references them
+		historicalKey := collections.Join(target.GetID(), startTime.Period)
+		historical, err := target.HistoricalRewards.Get(ctx, historicalKey)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return false, nil
+			}
+			return true, err
+		}
+
+		ratio := rewardsPerToken.MulDecTruncate(math.LegacyNewDec(elapsed)).QuoDecTruncate(epochMilliseconds)
+		historical.CumulativeRewardRatios = historical.CumulativeRewardRatios.Add(
+			types.NewServicePool(serviceID, types.DecPools{types.NewDecPool(timeWeightedTokens.Denom, ratio)}),
+		)
+		return false, target.HistoricalRewards.Set(ctx, historicalKey, historical)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the rewards owed to the delegators that have withdrawn during the
+	// epoch to their claims
+	var claims []types.DelegatorEpochClaim
+	claimsRange := collections.NewSuperPrefixedTripleRange[int32, uint32, sdk.AccAddress](int32(target.DelegationType), target.GetID())
+	err = k.DelegatorEpochClaims.Walk(ctx, claimsRange, func(_ collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim) (stop bool, err error) {
+		claims = append(claims, claim)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, claim := range claims {
+		cumulativeStake := claim.CumulativeStakes.AmountOf(timeWeightedTokens.Denom)
+		if cumulativeStake.IsZero() {
+			continue
+		}
+
+		claimRewards := rewardsPerToken.MulDecTruncate(cumulativeStake).QuoDecTruncate(epochMilliseconds)
+		claim.Rewards = claim.Rewards.Add(
+			types.NewServicePool(serviceID, types.DecPools{types.NewDecPool(timeWeightedTokens.Denom, claimRewards)}),
+		)
+
+		delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(claim.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		err = k.DelegatorEpochClaims.Set(ctx, collections.Join3(int32(target.DelegationType), target.GetID(), sdk.AccAddress(delAddr)), claim)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The current delegators have been delegating with their current stakes
+	// since the last period has started
+	currentTokens := sdk.NewDecCoinsFromCoins(target.GetTokens()...)
+	if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL {
+		totalShares, err := k.GetPoolServiceTotalDelegatorShares(ctx, target.GetID(), serviceID)
+		if err != nil {
+			return nil, err
+		}
+		currentTokens = target.TokensFromSharesTruncated(totalShares)
+	}
+
+	currentElapsed := sdkCtx.BlockTime().Sub(lastPeriodStartTime).Milliseconds()
+	return rewardsPerToken.
+		MulDecTruncate(currentTokens.AmountOf(timeWeightedTokens.Denom)).
+		MulDecTruncate(math.LegacyNewDec(currentElapsed)).
+		QuoDecTruncate(epochMilliseconds), nil
+}
+
+// maxEpochClaimPayoutsPerBlock represents the maximum number of pending epoch
+// claims that are paid in a single block
+const maxEpochClaimPayoutsPerBlock = 100
+
+// queueDelegatorEpochClaimPayouts moves the claims of the allocation epoch
+// that has just ended to the queue of the claims waiting to be paid. If a
+// delegator's claim from a previous epoch is still waiting to be paid, the
+// rewards of the two claims are merged.
+func (k *Keeper) queueDelegatorEpochClaimPayouts(ctx context.Context) error {
+	return k.DelegatorEpochClaims.Walk(ctx, nil, func(key collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim) (stop bool, err error) {
+		if len(claim.Rewards) == 0 {
+			return false, nil
+		}
+
+		pending, err := k.PendingEpochClaimPayouts.Get(ctx, key)
+		if err != nil {
+			if !errors.IsOf(err, collections.ErrNotFound) {
+				return true, err
+			}
+			pending = types.NewDelegatorEpochClaim(claim.DelegationType, claim.DelegationTargetID, claim.DelegatorAddress)
+		}
+
+		pending.Rewards = pending.Rewards.Add(claim.Rewards...)
+		return false, k.PendingEpochClaimPayouts.Set(ctx, key, pending)
+	})
+}
+
+// PayPendingEpochClaims pays the claims of the ended allocation epochs that are
+// waiting to be paid. At most maxEpochClaimPayoutsPerBlock claims are paid in
+// each block, so that the payouts of large epochs are spread over multiple
+// blocks.
+func (k *Keeper) PayPendingEpochClaims(ctx context.Context) error {
+	type pendingPayout struct {
+		key   collections.Triple[int32, uint32, sdk.AccAddress]
+		claim types.DelegatorEpochClaim
+	}
+
+	var payouts []pendingPayout
+	err := k.PendingEpochClaimPayouts.Walk(ctx, nil, func(key collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim) (stop bool, err error) {
+		payouts = append(payouts, pendingPayout{key: key, claim: claim})
+		return len(payouts) >= maxEpochClaimPayoutsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, payout := range payouts {
+		err = k.payPendingEpochClaim(ctx, payout.key, payout.claim)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payDelegationTargetPendingEpochClaims pays all the pending epoch claims of
+// the given delegation target.
+func (k *Keeper) payDelegationTargetPendingEpochClaims(ctx context.Context, target DelegationTarget) error {
+	claimsRange := collections.NewSuperPrefixedTripleRange[int32, uint32, sdk.AccAddress](int32(target.DelegationType), target.GetID())
+	iterator, err := k.PendingEpochClaimPayouts.Iterate(ctx, claimsRange)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	payouts, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, payout := range payouts {
+		err = k.payPendingEpochClaim(ctx, payout.Key, payout.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payPendingEpochClaim pays the given pending epoch claim and removes it from
+// the queue. The payout is performed using a cached context so that a failure
+// does not affect the state nor the payouts of the other claims.
+func (k *Keeper) payPendingEpochClaim(
+	ctx context.Context, key collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+	err := k.payDelegatorEpochClaim(cachedCtx, claim)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to pay delegator epoch claim",
+			"delegator", claim.DelegatorAddress,
+			"delegation_type", claim.DelegationType.String(),
+			"delegation_target_id", claim.DelegationTargetID,
+			"error", err,
+		)
+	} else {
+		writeCache()
+	}
+
+	return k.PendingEpochClaimPayouts.Remove(ctx, key)
+}
+
+// payDelegatorEpochClaim sends the rewards of the given claim to the
+// delegator's withdraw address.
+func (k *Keeper) payDelegatorEpochClaim(ctx context.Context, claim types.DelegatorEpochClaim) error {
+	target, err := k.GetDelegationTarget(ctx, claim.DelegationType, claim.DelegationTargetID)
+	if err != nil {
+		return err
+	}
+
+	var preferences restakingtypes.UserPreferences
+	if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL {
+		preferences, err = k.restakingKeeper.GetUserPreferences(ctx, claim.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	var rewardsRaw types.DecPools
+	for _, reward := range claim.Rewards {
+		// Pool delegators only receive the rewards of the services they trust
+		if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL &&
+			!preferences.IsServiceTrustedWithPool(reward.ServiceID, target.GetID()) {
+			continue
+		}
+		rewardsRaw = rewardsRaw.Add(reward.DecPools...)
+	}
+
+	outstanding, err := k.GetOutstandingRewardsCoins(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	rewards := rewardsRaw.Intersect(outstanding)
+	pools, _ := rewards.TruncateDecimal()
+	coins := pools.Sum()
+
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(claim.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !pools.IsEmpty() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, withdrawAddr, coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = target.OutstandingRewards.Set(ctx, target.GetID(), types.OutstandingRewards{Rewards: outstanding.Sub(rewards)})
+	if err != nil {
+		return err
+	}
+
+	err = k.sendRewardsOverIBC(ctx, delAddr, withdrawAddr, coins)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(types.AttributeKeyDelegationType, claim.DelegationType.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegationTargetID, fmt.Sprint(claim.DelegationTargetID)),
+			sdk.NewAttribute(restakingtypes.AttributeKeyDelegator, claim.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountPerPool, pools.String()),
+		),
+	)
+
+	return nil
+}
+
+// endAllocationEpoch queues the payouts of the delegator claims of the
+// allocation epoch that has just ended and clears the data that has been
+// tracked during it, so that a new epoch starts.
+func (k *Keeper) endAllocationEpoch(ctx context.Context) error {
+	err := k.queueDelegatorEpochClaimPayouts(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.DelegatorEpochClaims.Clear(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = k.PeriodStartTimes.Clear(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return k.DelegatorSharesAccumulators.Clear(ctx, nil)
+}
+
+// clearDelegationTargetEpochData removes the data tracked during the current
+// allocation epoch for the given delegation target.
+func (k *Keeper) clearDelegationTargetEpochData(ctx context.Context, target DelegationTarget) error {
+	periodsRange := collections.NewSuperPrefixedTripleRange[int32, uint32, uint64](int32(target.DelegationType), target.GetID())
+	err := k.PeriodStartTimes.Clear(ctx, periodsRange)
+	if err != nil {
+		return err
+	}
+
+	claimsRange := collections.NewSuperPrefixedTripleRange[int32, uint32, sdk.AccAddress](int32(target.DelegationType), target.GetID())
+	return k.DelegatorEpochClaims.Clear(ctx, claimsRange)
+}
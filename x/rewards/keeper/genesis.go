@@ -47,9 +47,15 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 			return false, err
 		}
 
+		ibcDestination, err := k.GetIBCWithdrawDestination(ctx, delAddr)
+		if err != nil {
+			return false, err
+		}
+
 		delegatorWithdrawInfos = append(delegatorWithdrawInfos, types.DelegatorWithdrawInfo{
 			DelegatorAddress: delegator,
 			WithdrawAddress:  withdraw,
+			IBCDestination:   ibcDestination,
 		})
 
 		return false, nil
@@ -115,6 +121,74 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		return nil, err
 	}
 
+	var rewardsPlansAccounting []types.RewardsPlanAccounting
+	err = k.RewardsPlansAccounting.Walk(ctx, nil, func(_ uint64, accounting types.RewardsPlanAccounting) (stop bool, err error) {
+		rewardsPlansAccounting = append(rewardsPlansAccounting, accounting)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var archivedRewardsPlans []types.ArchivedRewardsPlan
+	err = k.ArchivedRewardsPlans.Walk(ctx, nil, func(_ uint64, plan types.ArchivedRewardsPlan) (stop bool, err error) {
+		archivedRewardsPlans = append(archivedRewardsPlans, plan)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var autoCompoundSettings []types.AutoCompoundSetting
+	err = k.AutoCompoundSettings.Walk(ctx, nil, func(_ collections.Triple[sdk.AccAddress, int32, uint32], setting types.AutoCompoundSetting) (stop bool, err error) {
+		autoCompoundSettings = append(autoCompoundSettings, setting)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextAutoCompoundTime, err := k.GetNextAutoCompoundTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegatorSharesAccumulators []types.DelegatorSharesAccumulator
+	err = k.DelegatorSharesAccumulators.Walk(ctx, nil, func(_ collections.Triple[int32, uint32, uint32], accumulator types.DelegatorSharesAccumulator) (stop bool, err error) {
+		delegatorSharesAccumulators = append(delegatorSharesAccumulators, accumulator)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var periodStartTimes []types.PeriodStartTime
+	err = k.PeriodStartTimes.Walk(ctx, nil, func(_ collections.Triple[int32, uint32, uint64], startTime types.PeriodStartTime) (stop bool, err error) {
+		periodStartTimes = append(periodStartTimes, startTime)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var delegatorEpochClaims []types.DelegatorEpochClaim
+	err = k.DelegatorEpochClaims.Walk(ctx, nil, func(_ collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim) (stop bool, err error) {
+		delegatorEpochClaims = append(delegatorEpochClaims, claim)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingEpochClaimPayouts []types.DelegatorEpochClaim
+	err = k.PendingEpochClaimPayouts.Walk(ctx, nil, func(_ collections.Triple[int32, uint32, sdk.AccAddress], claim types.DelegatorEpochClaim) (stop bool, err error) {
+		pendingEpochClaimPayouts = append(pendingEpochClaimPayouts, claim)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return types.NewGenesisState(
 		params,
 		nextRewardsPlanID,
@@ -126,6 +200,14 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		serviceRecords,
 		operatorAccumulatedCommissions,
 		totalShares,
+		rewardsPlansAccounting,
+		archivedRewardsPlans,
+		autoCompoundSettings,
+		nextAutoCompoundTime,
+		delegatorSharesAccumulators,
+		periodStartTimes,
+		delegatorEpochClaims,
+		pendingEpochClaimPayouts,
 	), nil
 }
 
@@ -254,6 +336,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		if err != nil {
 			return err
 		}
+		err = k.SetIBCWithdrawDestination(ctx, delAddr, info.IBCDestination)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Initialize the pool records
@@ -343,6 +429,80 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		}
 	}
 
+	for _, accounting := range state.RewardsPlansAccounting {
+		err = k.SaveRewardsPlanAccounting(ctx, accounting)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, archived := range state.ArchivedRewardsPlans {
+		err = k.ArchivedRewardsPlans.Set(ctx, archived.Plan.ID, archived)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, setting := range state.AutoCompoundSettings {
+		delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(setting.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		err = k.SetAutoCompound(ctx, delAddr, setting.DelegationType, setting.DelegationTargetID, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.NextAutoCompoundTime != nil {
+		err = k.SetNextAutoCompoundTime(ctx, *state.NextAutoCompoundTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, accumulator := range state.DelegatorSharesAccumulators {
+		err = k.SetDelegatorSharesAccumulator(ctx, accumulator)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, startTime := range state.PeriodStartTimes {
+		key := collections.Join3(int32(startTime.DelegationType), startTime.DelegationTargetID, startTime.Period)
+		err = k.PeriodStartTimes.Set(ctx, key, startTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range state.DelegatorEpochClaims {
+		delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(claim.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		key := collections.Join3(int32(claim.DelegationType), claim.DelegationTargetID, sdk.AccAddress(delAddr))
+		err = k.DelegatorEpochClaims.Set(ctx, key, claim)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range state.PendingEpochClaimPayouts {
+		delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(claim.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		key := collections.Join3(int32(claim.DelegationType), claim.DelegationTargetID, sdk.AccAddress(delAddr))
+		err = k.PendingEpochClaimPayouts.Set(ctx, key, claim)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
@@ -67,6 +68,41 @@ func (q queryServer) RewardsPlan(ctx context.Context, req *types.QueryRewardsPla
 	return &types.QueryRewardsPlanResponse{RewardsPlan: plan}, nil
 }
 
+func (q queryServer) ArchivedRewardsPlans(ctx context.Context, req *types.QueryArchivedRewardsPlansRequest) (*types.QueryArchivedRewardsPlansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	plans, pageRes, err := query.CollectionPaginate(ctx, q.k.ArchivedRewardsPlans, req.Pagination, func(_ uint64, plan types.ArchivedRewardsPlan) (types.ArchivedRewardsPlan, error) {
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArchivedRewardsPlansResponse{ArchivedRewardsPlans: plans, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ArchivedRewardsPlan(ctx context.Context, req *types.QueryArchivedRewardsPlanRequest) (*types.QueryArchivedRewardsPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PlanId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid plan id")
+	}
+
+	plan, err := q.k.ArchivedRewardsPlans.Get(ctx, req.PlanId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "archived plan with id %d not found", req.PlanId)
+		}
+		return nil, err
+	}
+
+	return &types.QueryArchivedRewardsPlanResponse{ArchivedRewardsPlan: plan}, nil
+}
+
 func (q queryServer) PoolOutstandingRewards(ctx context.Context, req *types.QueryPoolOutstandingRewardsRequest) (*types.QueryPoolOutstandingRewardsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -170,6 +206,77 @@ func (q queryServer) PoolServiceTotalDelegatorShares(ctx context.Context, req *t
 	return &types.QueryPoolServiceTotalDelegatorSharesResponse{Shares: shares}, nil
 }
 
+func (q queryServer) PoolAPR(ctx context.Context, req *types.QueryPoolAPRRequest) (*types.QueryPoolAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid pool id")
+	}
+
+	apr, plans, err := q.k.GetDelegationTargetAPR(ctx, restakingtypes.DELEGATION_TYPE_POOL, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolAPRResponse{APR: apr, Plans: plans}, nil
+}
+
+func (q queryServer) OperatorAPR(ctx context.Context, req *types.QueryOperatorAPRRequest) (*types.QueryOperatorAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid operator id")
+	}
+
+	apr, plans, err := q.k.GetDelegationTargetAPR(ctx, restakingtypes.DELEGATION_TYPE_OPERATOR, req.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOperatorAPRResponse{APR: apr, Plans: plans}, nil
+}
+
+func (q queryServer) ServiceAPR(ctx context.Context, req *types.QueryServiceAPRRequest) (*types.QueryServiceAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid service id")
+	}
+
+	apr, plans, err := q.k.GetDelegationTargetAPR(ctx, restakingtypes.DELEGATION_TYPE_SERVICE, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryServiceAPRResponse{APR: apr, Plans: plans}, nil
+}
+
+func (q queryServer) PoolServiceAPR(ctx context.Context, req *types.QueryPoolServiceAPRRequest) (*types.QueryPoolServiceAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid pool id")
+	}
+	if req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid service id")
+	}
+
+	apr, plans, err := q.k.GetPoolServiceAPR(ctx, req.PoolId, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolServiceAPRResponse{APR: apr, Plans: plans}, nil
+}
+
 func (q queryServer) PoolDelegationRewards(ctx context.Context, req *types.QueryPoolDelegationRewardsRequest) (*types.QueryPoolDelegationRewardsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -313,3 +420,29 @@ func (q queryServer) DelegatorWithdrawAddress(ctx context.Context, req *types.Qu
 
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddrStr}, nil
 }
+
+func (q queryServer) DelegatorAutoCompoundSettings(ctx context.Context, req *types.QueryDelegatorAutoCompoundSettingsRequest) (*types.QueryDelegatorAutoCompoundSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := q.k.accountKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+
+	settings, pageRes, err := query.CollectionPaginate(ctx, q.k.AutoCompoundSettings, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, int32, uint32], setting types.AutoCompoundSetting) (types.AutoCompoundSetting, error) {
+			return setting, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, int32, uint32]]) {
+			prefix := collections.TriplePrefix[sdk.AccAddress, int32, uint32](delAddr)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundSettingsResponse{AutoCompoundSettings: settings, Pagination: pageRes}, nil
+}
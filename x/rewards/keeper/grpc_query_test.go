@@ -3,11 +3,13 @@ package keeper_test
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/milkyway-labs/milkyway/v12/app/testutil"
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
 	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/keeper"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
@@ -28,12 +30,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					1,
 					"Plan 1",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				))
 				suite.Require().NoError(err)
 
@@ -41,12 +46,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				))
 				suite.Require().NoError(err)
 			},
@@ -57,23 +65,29 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					1,
 					"Plan 1",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				),
 				types.NewRewardsPlan(
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				),
 			},
 		},
@@ -84,12 +98,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					1,
 					"Plan 1",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				))
 				suite.Require().NoError(err)
 
@@ -97,12 +114,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				))
 				suite.Require().NoError(err)
 			},
@@ -116,12 +136,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlans() {
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				),
 			},
 		},
@@ -173,12 +196,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlan() {
 					1,
 					"Plan 1",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				))
 				suite.Require().NoError(err)
 			},
@@ -188,12 +214,15 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlan() {
 				1,
 				"Plan 1",
 				1,
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewEgalitarianPoolsDistribution(1),
 				types.NewEgalitarianOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 		},
 	}
@@ -217,6 +246,137 @@ func (suite *KeeperTestSuite) TestQuerier_RewardsPlan() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQuerier_ArchivedRewardsPlans() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryArchivedRewardsPlansRequest
+		shouldErr bool
+		expPlans  []types.ArchivedRewardsPlan
+	}{
+		{
+			name:      "empty archive returns no plans",
+			req:       types.NewQueryArchivedRewardsPlansRequest(nil),
+			shouldErr: false,
+			expPlans:  nil,
+		},
+		{
+			name: "archived plans are returned properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.ArchivedRewardsPlans.Set(ctx, 1, types.NewArchivedRewardsPlan(
+					types.RewardsPlan{ID: 1, Description: "Plan 1"},
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					types.NewRewardsPlanAccounting(1, nil, 2),
+				))
+				suite.Require().NoError(err)
+
+				err = suite.keeper.ArchivedRewardsPlans.Set(ctx, 2, types.NewArchivedRewardsPlan(
+					types.RewardsPlan{ID: 2, Description: "Plan 2"},
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					types.NewRewardsPlanAccounting(2, []types.DelegationTargetDistributedRewards{
+						types.NewDelegationTargetDistributedRewards(
+							restakingtypes.DELEGATION_TYPE_POOL,
+							1,
+							utils.MustParseDecCoins("100service"),
+						),
+					}, 0),
+				))
+				suite.Require().NoError(err)
+			},
+			req:       types.NewQueryArchivedRewardsPlansRequest(&query.PageRequest{Offset: 1, Limit: 1}),
+			shouldErr: false,
+			expPlans: []types.ArchivedRewardsPlan{
+				types.NewArchivedRewardsPlan(
+					types.RewardsPlan{ID: 2, Description: "Plan 2"},
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					types.NewRewardsPlanAccounting(2, []types.DelegationTargetDistributedRewards{
+						types.NewDelegationTargetDistributedRewards(
+							restakingtypes.DELEGATION_TYPE_POOL,
+							1,
+							utils.MustParseDecCoins("100service"),
+						),
+					}, 0),
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.ArchivedRewardsPlans(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPlans, res.ArchivedRewardsPlans)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_ArchivedRewardsPlan() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryArchivedRewardsPlanRequest
+		shouldErr bool
+		expPlan   types.ArchivedRewardsPlan
+	}{
+		{
+			name:      "invalid plan ID returns error",
+			req:       types.NewQueryArchivedRewardsPlanRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found plan returns error",
+			req:       types.NewQueryArchivedRewardsPlanRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "found plan returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.keeper.ArchivedRewardsPlans.Set(ctx, 1, types.NewArchivedRewardsPlan(
+					types.RewardsPlan{ID: 1, Description: "Plan 1"},
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					types.NewRewardsPlanAccounting(1, nil, 2),
+				))
+				suite.Require().NoError(err)
+			},
+			req:       types.NewQueryArchivedRewardsPlanRequest(1),
+			shouldErr: false,
+			expPlan: types.NewArchivedRewardsPlan(
+				types.RewardsPlan{ID: 1, Description: "Plan 1"},
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewRewardsPlanAccounting(1, nil, 2),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.ArchivedRewardsPlan(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPlan, res.ArchivedRewardsPlan)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQuerier_PoolOutstandingRewards() {
 	testCases := []struct {
 		name       string
@@ -244,7 +404,7 @@ func (suite *KeeperTestSuite) TestQuerier_PoolOutstandingRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -323,7 +483,7 @@ func (suite *KeeperTestSuite) TestQuerier_OperatorOutstandingRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -399,7 +559,7 @@ func (suite *KeeperTestSuite) TestQuerier_ServiceOutstandingRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -476,7 +636,7 @@ func (suite *KeeperTestSuite) TestQuerier_OperatorCommission() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -590,6 +750,338 @@ func (suite *KeeperTestSuite) TestQuerier_PoolServiceTotalShares() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQuerier_PoolAPR() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryPoolAPRRequest
+		shouldErr bool
+		expAPR    sdkmath.LegacyDec
+		expPlans  []types.PlanAPR
+	}{
+		{
+			name:      "invalid pool ID returns error",
+			req:       types.NewQueryPoolAPRRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found pool returns error",
+			req:       types.NewQueryPoolAPRRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "pool without active plans returns zero",
+			store: func(ctx sdk.Context) {
+				suite.setupSampleServiceAndOperator(ctx)
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), testutil.TestAddress(1).String(), true)
+			},
+			req:       types.NewQueryPoolAPRRequest(1),
+			shouldErr: false,
+			expAPR:    sdkmath.LegacyZeroDec(),
+			expPlans:  nil,
+		},
+		{
+			name: "apr is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				// Delegate $200 to the pool, trusting both services
+				delAddr := testutil.TestAddress(1)
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), delAddr.String(), true)
+				suite.SetUserPreferences(ctx, delAddr.String(), []restakingtypes.TrustedServiceEntry{
+					restakingtypes.NewTrustedServiceEntry(1, []uint32{1}),
+					restakingtypes.NewTrustedServiceEntry(2, []uint32{1}),
+				})
+			},
+			req:       types.NewQueryPoolAPRRequest(1),
+			shouldErr: false,
+			// The pool receives $36,500 and $73,000 per year from the plans
+			expAPR: utils.MustParseDec("547.5"),
+			expPlans: []types.PlanAPR{
+				types.NewPlanAPR(1, 1, utils.MustParseDec("182.5")),
+				types.NewPlanAPR(2, 2, utils.MustParseDec("365")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.PoolAPR(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAPR, res.APR)
+				suite.Require().Equal(tc.expPlans, res.Plans)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_OperatorAPR() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryOperatorAPRRequest
+		shouldErr bool
+		expAPR    sdkmath.LegacyDec
+		expPlans  []types.PlanAPR
+	}{
+		{
+			name:      "invalid operator ID returns error",
+			req:       types.NewQueryOperatorAPRRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found operator returns error",
+			req:       types.NewQueryOperatorAPRRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "apr is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				// Delegate $200 to both the pool and the operator
+				delAddr := testutil.TestAddress(1)
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), delAddr.String(), true)
+				suite.SetUserPreferences(ctx, delAddr.String(), []restakingtypes.TrustedServiceEntry{
+					restakingtypes.NewTrustedServiceEntry(1, []uint32{1}),
+				})
+				suite.DelegateOperator(ctx, 1, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+			},
+			req:       types.NewQueryOperatorAPRRequest(1),
+			shouldErr: false,
+			// The operator only joined the first service and receives half of
+			// its $36,500 per year, minus the 10% commission
+			expAPR: utils.MustParseDec("82.125"),
+			expPlans: []types.PlanAPR{
+				types.NewPlanAPR(1, 1, utils.MustParseDec("82.125")),
+			},
+		},
+		{
+			name: "pending commission change in effect is used",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				delAddr := testutil.TestAddress(1)
+				suite.DelegateOperator(ctx, 1, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+				err := suite.operatorsKeeper.SaveCommissionChange(ctx, operatorstypes.NewCommissionChange(
+					1,
+					utils.MustParseDec("0.5"),
+					ctx.BlockTime().Add(-time.Hour),
+					ctx.BlockTime(),
+				), true)
+				suite.Require().NoError(err)
+			},
+			req:       types.NewQueryOperatorAPRRequest(1),
+			shouldErr: false,
+			expAPR:    utils.MustParseDec("91.25"),
+			expPlans: []types.PlanAPR{
+				types.NewPlanAPR(1, 1, utils.MustParseDec("91.25")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.OperatorAPR(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAPR, res.APR)
+				suite.Require().Equal(tc.expPlans, res.Plans)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_ServiceAPR() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryServiceAPRRequest
+		shouldErr bool
+		expAPR    sdkmath.LegacyDec
+		expPlans  []types.PlanAPR
+	}{
+		{
+			name:      "invalid service ID returns error",
+			req:       types.NewQueryServiceAPRRequest(0),
+			shouldErr: true,
+		},
+		{
+			name:      "not found service returns error",
+			req:       types.NewQueryServiceAPRRequest(1),
+			shouldErr: true,
+		},
+		{
+			name: "apr is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				// Delegate $200 to both the operator and the second service
+				delAddr := testutil.TestAddress(1)
+				suite.DelegateOperator(ctx, 1, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+				suite.DelegateService(ctx, 2, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+			},
+			req:       types.NewQueryServiceAPRRequest(2),
+			shouldErr: false,
+			// The service users receive all the $73,000 per year of the plan
+			expAPR: utils.MustParseDec("365"),
+			expPlans: []types.PlanAPR{
+				types.NewPlanAPR(2, 2, utils.MustParseDec("365")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.ServiceAPR(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAPR, res.APR)
+				suite.Require().Equal(tc.expPlans, res.Plans)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerier_PoolServiceAPR() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		req       *types.QueryPoolServiceAPRRequest
+		shouldErr bool
+		expAPR    sdkmath.LegacyDec
+		expPlans  []types.PlanAPR
+	}{
+		{
+			name:      "invalid pool ID returns error",
+			req:       types.NewQueryPoolServiceAPRRequest(0, 1),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid service ID returns error",
+			req:       types.NewQueryPoolServiceAPRRequest(1, 0),
+			shouldErr: true,
+		},
+		{
+			name: "not found service returns error",
+			store: func(ctx sdk.Context) {
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), testutil.TestAddress(1).String(), true)
+			},
+			req:       types.NewQueryPoolServiceAPRRequest(1, 1),
+			shouldErr: true,
+		},
+		{
+			name: "service not trusted by any delegator returns zero",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				delAddr := testutil.TestAddress(1)
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), delAddr.String(), true)
+				suite.SetUserPreferences(ctx, delAddr.String(), []restakingtypes.TrustedServiceEntry{
+					restakingtypes.NewTrustedServiceEntry(1, []uint32{1}),
+				})
+			},
+			req:       types.NewQueryPoolServiceAPRRequest(1, 2),
+			shouldErr: false,
+			expAPR:    sdkmath.LegacyZeroDec(),
+			expPlans:  nil,
+		},
+		{
+			name: "apr is returned properly",
+			store: func(ctx sdk.Context) {
+				suite.setupAPRServices(ctx)
+
+				delAddr := testutil.TestAddress(1)
+				suite.DelegatePool(ctx, utils.MustParseCoin("100_000000umilk"), delAddr.String(), true)
+				suite.SetUserPreferences(ctx, delAddr.String(), []restakingtypes.TrustedServiceEntry{
+					restakingtypes.NewTrustedServiceEntry(1, []uint32{1}),
+					restakingtypes.NewTrustedServiceEntry(2, []uint32{1}),
+				})
+			},
+			req:       types.NewQueryPoolServiceAPRRequest(1, 2),
+			shouldErr: false,
+			expAPR:    utils.MustParseDec("365"),
+			expPlans: []types.PlanAPR{
+				types.NewPlanAPR(2, 2, utils.MustParseDec("365")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.PoolServiceAPR(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAPR, res.APR)
+				suite.Require().Equal(tc.expPlans, res.Plans)
+			}
+		})
+	}
+}
+
+// setupAPRServices creates two services secured by the $MILK pool, each one
+// with a rewards plan distributing $SERVICE, which is worth $1. The first
+// service distributes 100 $SERVICE per day, while the second one distributes
+// 200 $SERVICE per day. The operator only joins the first service.
+func (suite *KeeperTestSuite) setupAPRServices(ctx sdk.Context) {
+	service1, _ := suite.setupSampleServiceAndOperator(ctx)
+	suite.RegisterCurrency(ctx, "service", "SERVICE", 6, utils.MustParseDec("1"))
+
+	service2 := suite.CreateService(ctx, "Service2", testutil.TestAddress(10002).String())
+	suite.AddPoolsToServiceSecuringPools(ctx, service2.ID, []uint32{1})
+
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service1.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+	suite.CreateBasicRewardsPlan(
+		ctx,
+		service2.ID,
+		utils.MustParseCoins("200_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+}
+
 func (suite *KeeperTestSuite) TestQuerier_PoolDelegationRewards() {
 	testCases := []struct {
 		name       string
@@ -622,7 +1114,7 @@ func (suite *KeeperTestSuite) TestQuerier_PoolDelegationRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -706,7 +1198,7 @@ func (suite *KeeperTestSuite) TestQuerier_OperatorDelegationRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -785,7 +1277,7 @@ func (suite *KeeperTestSuite) TestQuerier_ServiceDelegationRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -863,7 +1355,7 @@ func (suite *KeeperTestSuite) TestQuerier_DelegatorTotalRewards() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -973,6 +1465,7 @@ func (suite *KeeperTestSuite) TestQuerier_DelegatorWithdrawAddress() {
 				msgServer := keeper.NewMsgServer(suite.keeper)
 				_, err := msgServer.SetWithdrawAddress(ctx, types.NewMsgSetWithdrawAddress(
 					"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+					nil,
 					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 				))
 				suite.Require().NoError(err)
@@ -1003,3 +1496,71 @@ func (suite *KeeperTestSuite) TestQuerier_DelegatorWithdrawAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQuerier_DelegatorAutoCompoundSettings() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		req         *types.QueryDelegatorAutoCompoundSettingsRequest
+		shouldErr   bool
+		expSettings []types.AutoCompoundSetting
+	}{
+		{
+			name:      "invalid delegator address returns error",
+			req:       types.NewQueryDelegatorAutoCompoundSettingsRequest("invalid", nil),
+			shouldErr: true,
+		},
+		{
+			name: "delegator without settings returns empty list",
+			req: types.NewQueryDelegatorAutoCompoundSettingsRequest(
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
+			),
+			shouldErr:   false,
+			expSettings: nil,
+		},
+		{
+			name: "only the settings of the given delegator are returned",
+			store: func(ctx sdk.Context) {
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAutoCompound(ctx, delAddr, restakingtypes.DELEGATION_TYPE_POOL, 1, true)
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAutoCompound(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 2, true)
+				suite.Require().NoError(err)
+
+				otherAddr, err := sdk.AccAddressFromBech32("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4")
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAutoCompound(ctx, otherAddr, restakingtypes.DELEGATION_TYPE_POOL, 1, true)
+				suite.Require().NoError(err)
+			},
+			req: types.NewQueryDelegatorAutoCompoundSettingsRequest(
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
+			),
+			shouldErr: false,
+			expSettings: []types.AutoCompoundSetting{
+				types.NewAutoCompoundSetting("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", restakingtypes.DELEGATION_TYPE_POOL, 1),
+				types.NewAutoCompoundSetting("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", restakingtypes.DELEGATION_TYPE_SERVICE, 2),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			queryServer := keeper.NewQueryServer(suite.keeper)
+			res, err := queryServer.DelegatorAutoCompoundSettings(ctx, tc.req)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSettings, res.AutoCompoundSettings)
+			}
+		})
+	}
+}
@@ -27,6 +27,23 @@ func (k *Keeper) BeforeDelegationTargetRemoved(ctx context.Context, delType rest
 		return err
 	}
 
+	// Pay the claims of the ended epochs before the target's outstanding
+	// rewards are removed
+	err = k.payDelegationTargetPendingEpochClaims(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	err = k.clearDelegationTargetEpochData(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	err = k.RemoveDelegationTargetAutoCompoundSettings(ctx, delType, targetID)
+	if err != nil {
+		return err
+	}
+
 	return k.clearDelegationTarget(ctx, target)
 }
 
@@ -37,6 +54,11 @@ func (k *Keeper) BeforeDelegationCreated(ctx context.Context, delType restakingt
 		return err
 	}
 
+	err = k.accumulateDelegationTargetShares(ctx, target)
+	if err != nil {
+		return err
+	}
+
 	_, err = k.IncrementDelegationTargetPeriod(ctx, target)
 	return err
 }
@@ -48,6 +70,11 @@ func (k *Keeper) BeforeDelegationSharesModified(ctx context.Context, delType res
 		return err
 	}
 
+	err = k.accumulateDelegationTargetShares(ctx, target)
+	if err != nil {
+		return err
+	}
+
 	// We don't have to initialize target here because we can assume BeforeDelegationCreated
 	// has already been called when delegation shares are being modified.
 	del, found, err := k.restakingKeeper.GetDelegationForTarget(ctx, target.DelegationTarget, delegator)
@@ -136,6 +163,17 @@ func (k *Keeper) AfterDelegationModified(ctx context.Context, delType restakingt
 	return nil
 }
 
+// BeforeDelegationRemoved is called before a delegation to a target is removed
+func (k *Keeper) BeforeDelegationRemoved(ctx context.Context, delType restakingtypes.DelegationType, targetID uint32, delegator string) error {
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(delegator)
+	if err != nil {
+		return err
+	}
+
+	// Remove the auto-compound setting since there's nothing left to compound
+	return k.SetAutoCompound(ctx, delAddr, delType, targetID, false)
+}
+
 // BeforeServiceDeleted is called before a service is deleted
 func (k *Keeper) BeforeServiceDeleted(ctx context.Context, serviceID uint32) error {
 	err := k.BeforeDelegationTargetRemoved(ctx, restakingtypes.DELEGATION_TYPE_SERVICE, serviceID)
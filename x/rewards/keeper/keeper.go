@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,19 +24,37 @@ type Keeper struct {
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
-	oracleKeeper        types.OracleKeeper
 	poolsKeeper         types.PoolsKeeper
 	operatorsKeeper     types.OperatorsKeeper
 	servicesKeeper      types.ServicesKeeper
 	restakingKeeper     types.RestakingKeeper
 	assetsKeeper        types.AssetsKeeper
+	transferKeeper      types.TransferKeeper
 
 	Schema                    collections.Schema
 	Params                    collections.Item[types.Params]
 	NextRewardsPlanID         collections.Item[uint64]
-	RewardsPlans              collections.Map[uint64, types.RewardsPlan]
+	RewardsPlans              *collections.IndexedMap[uint64, types.RewardsPlan, rewardsPlanIndexes]
 	LastRewardsAllocationTime collections.Item[gogotypes.Timestamp]
 	DelegatorWithdrawAddrs    collections.Map[sdk.AccAddress, sdk.AccAddress]
+	RewardsPlansAccounting    collections.Map[uint64, types.RewardsPlanAccounting]
+	ArchivedRewardsPlans      collections.Map[uint64, types.ArchivedRewardsPlan]
+
+	// delegator -> destination on another chain to send the withdrawn rewards to
+	DelegatorIBCWithdrawDestinations collections.Map[sdk.AccAddress, types.IBCWithdrawDestination]
+
+	// (delegator, delegation type, target ID) -> auto-compound setting
+	AutoCompoundSettings    *collections.IndexedMap[collections.Triple[sdk.AccAddress, int32, uint32], types.AutoCompoundSetting, autoCompoundSettingIndexes]
+	NextAutoCompoundTime    collections.Item[gogotypes.Timestamp]
+	AutoCompoundQueueCursor collections.Item[collections.Triple[sdk.AccAddress, int32, uint32]]
+
+	// (delegation type, target ID, service ID) -> time-weighted delegator shares
+	// accumulated since the last rewards allocation
+	DelegatorSharesAccumulators collections.Map[collections.Triple[int32, uint32, uint32], types.DelegatorSharesAccumulator]
+	PeriodStartTimes            collections.Map[collections.Triple[int32, uint32, uint64], types.PeriodStartTime]
+	DelegatorEpochClaims        collections.Map[collections.Triple[int32, uint32, sdk.AccAddress], types.DelegatorEpochClaim]
+	// (delegation type, target ID, delegator) -> claim of the ended epochs waiting to be paid
+	PendingEpochClaimPayouts collections.Map[collections.Triple[int32, uint32, sdk.AccAddress], types.DelegatorEpochClaim]
 
 	PoolDelegatorStartingInfos collections.Map[collections.Pair[uint32, sdk.AccAddress], types.DelegatorStartingInfo]
 	PoolHistoricalRewards      collections.Map[collections.Pair[uint32, uint64], types.HistoricalRewards]
@@ -63,7 +83,6 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
-	oracleKeeper types.OracleKeeper,
 	poolsKeeper types.PoolsKeeper,
 	operatorsKeeper types.OperatorsKeeper,
 	servicesKeeper types.ServicesKeeper,
@@ -79,7 +98,6 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
-		oracleKeeper:        oracleKeeper,
 		poolsKeeper:         poolsKeeper,
 		operatorsKeeper:     operatorsKeeper,
 		servicesKeeper:      servicesKeeper,
@@ -97,12 +115,13 @@ func NewKeeper(
 			"next_rewards_plan_id",
 			collections.Uint64Value,
 		),
-		RewardsPlans: collections.NewMap(
+		RewardsPlans: collections.NewIndexedMap(
 			sb,
 			types.RewardsPlanKeyPrefix,
 			"rewards_plans",
 			collections.Uint64Key,
 			codec.CollValue[types.RewardsPlan](cdc),
+			newRewardsPlanIndexes(sb),
 		),
 		LastRewardsAllocationTime: collections.NewItem(
 			sb,
@@ -117,6 +136,75 @@ func NewKeeper(
 			sdk.AccAddressKey,
 			collcodec.KeyToValueCodec(sdk.AccAddressKey),
 		),
+		RewardsPlansAccounting: collections.NewMap(
+			sb,
+			types.RewardsPlanAccountingKeyPrefix,
+			"rewards_plans_accounting",
+			collections.Uint64Key,
+			codec.CollValue[types.RewardsPlanAccounting](cdc),
+		),
+		ArchivedRewardsPlans: collections.NewMap(
+			sb,
+			types.ArchivedRewardsPlanKeyPrefix,
+			"archived_rewards_plans",
+			collections.Uint64Key,
+			codec.CollValue[types.ArchivedRewardsPlan](cdc),
+		),
+		DelegatorIBCWithdrawDestinations: collections.NewMap(
+			sb,
+			types.DelegatorIBCWithdrawDestinationKeyPrefix,
+			"delegator_ibc_withdraw_destinations",
+			sdk.AccAddressKey,
+			codec.CollValue[types.IBCWithdrawDestination](cdc),
+		),
+		AutoCompoundSettings: collections.NewIndexedMap(
+			sb,
+			types.AutoCompoundSettingKeyPrefix,
+			"auto_compound_settings",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.Int32Key, collections.Uint32Key),
+			codec.CollValue[types.AutoCompoundSetting](cdc),
+			newAutoCompoundSettingIndexes(sb),
+		),
+		NextAutoCompoundTime: collections.NewItem(
+			sb,
+			types.NextAutoCompoundTimeKey,
+			"next_auto_compound_time",
+			codec.CollValue[gogotypes.Timestamp](cdc),
+		),
+		AutoCompoundQueueCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundQueueCursorKey,
+			"auto_compound_queue_cursor",
+			collcodec.KeyToValueCodec(collections.TripleKeyCodec(sdk.AccAddressKey, collections.Int32Key, collections.Uint32Key)),
+		),
+		DelegatorSharesAccumulators: collections.NewMap(
+			sb,
+			types.DelegatorSharesAccumulatorKeyPrefix,
+			"delegator_shares_accumulators",
+			collections.TripleKeyCodec(collections.Int32Key, collections.Uint32Key, collections.Uint32Key),
+			codec.CollValue[types.DelegatorSharesAccumulator](cdc),
+		),
+		PeriodStartTimes: collections.NewMap(
+			sb,
+			types.PeriodStartTimeKeyPrefix,
+			"period_start_times",
+			collections.TripleKeyCodec(collections.Int32Key, collections.Uint32Key, collections.Uint64Key),
+			codec.CollValue[types.PeriodStartTime](cdc),
+		),
+		DelegatorEpochClaims: collections.NewMap(
+			sb,
+			types.DelegatorEpochClaimKeyPrefix,
+			"delegator_epoch_claims",
+			collections.TripleKeyCodec(collections.Int32Key, collections.Uint32Key, sdk.AccAddressKey),
+			codec.CollValue[types.DelegatorEpochClaim](cdc),
+		),
+		PendingEpochClaimPayouts: collections.NewMap(
+			sb,
+			types.PendingEpochClaimPayoutKeyPrefix,
+			"pending_epoch_claim_payouts",
+			collections.TripleKeyCodec(collections.Int32Key, collections.Uint32Key, sdk.AccAddressKey),
+			codec.CollValue[types.DelegatorEpochClaim](cdc),
+		),
 		PoolDelegatorStartingInfos: collections.NewMap(
 			sb,
 			types.PoolDelegatorStartingInfoKeyPrefix,
@@ -226,6 +314,12 @@ func NewKeeper(
 	return k
 }
 
+// SetTransferKeeper sets the keeper used to send the withdrawn rewards to
+// other chains through ICS-20 transfers
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
 // GetAuthority returns the module's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
@@ -244,3 +338,53 @@ func (k *Keeper) createAccountIfNotExists(ctx context.Context, address sdk.AccAd
 		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, address))
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type rewardsPlanIndexes struct {
+	// Index that allows to retrieve the rewards plans sorted by their end time
+	EndTime *indexes.Multi[time.Time, uint64, types.RewardsPlan]
+}
+
+func (i rewardsPlanIndexes) IndexesList() []collections.Index[uint64, types.RewardsPlan] {
+	return []collections.Index[uint64, types.RewardsPlan]{i.EndTime}
+}
+
+func newRewardsPlanIndexes(sb *collections.SchemaBuilder) rewardsPlanIndexes {
+	return rewardsPlanIndexes{
+		EndTime: indexes.NewMulti(
+			sb,
+			types.RewardsPlanEndTimeIndexKeyPrefix,
+			"rewards_plans_by_end_time",
+			sdk.TimeKey,
+			collections.Uint64Key,
+			func(_ uint64, plan types.RewardsPlan) (time.Time, error) {
+				return plan.EndTime, nil
+			},
+		),
+	}
+}
+
+type autoCompoundSettingIndexes struct {
+	// Index that allows to retrieve all the auto-compound settings of a given delegation target
+	Target *indexes.Multi[collections.Pair[int32, uint32], collections.Triple[sdk.AccAddress, int32, uint32], types.AutoCompoundSetting]
+}
+
+func (i autoCompoundSettingIndexes) IndexesList() []collections.Index[collections.Triple[sdk.AccAddress, int32, uint32], types.AutoCompoundSetting] {
+	return []collections.Index[collections.Triple[sdk.AccAddress, int32, uint32], types.AutoCompoundSetting]{i.Target}
+}
+
+func newAutoCompoundSettingIndexes(sb *collections.SchemaBuilder) autoCompoundSettingIndexes {
+	return autoCompoundSettingIndexes{
+		Target: indexes.NewMulti(
+			sb,
+			types.AutoCompoundSettingTargetIndexKeyPrefix,
+			"auto_compound_settings_by_target",
+			collections.PairKeyCodec(collections.Int32Key, collections.Uint32Key),
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.Int32Key, collections.Uint32Key),
+			func(key collections.Triple[sdk.AccAddress, int32, uint32], _ types.AutoCompoundSetting) (collections.Pair[int32, uint32], error) {
+				return collections.Join(key.K2(), key.K3()), nil
+			},
+		),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/milkyway-labs/milkyway/v12/x/rewards/migrations/v2"
+	v3 "github.com/milkyway-labs/milkyway/v12/x/rewards/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +23,8 @@ func NewMigrator(keeper *Keeper) Migrator {
 func (m Migrator) Migrate1To2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate2To3 migrates from version 2 to 3.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper)
+}
@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 	servicestypes "github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
@@ -25,14 +26,20 @@ func NewMsgServer(k *Keeper) types.MsgServer {
 
 // CreateRewardsPlan defines the rpc method for Msg/CreateRewardsPlan
 func (k msgServer) CreateRewardsPlan(ctx context.Context, msg *types.MsgCreateRewardsPlan) (*types.MsgCreateRewardsPlanResponse, error) {
-	// Make sure the creator is the admin of the service
+	// Get the service to which the rewards plan is associated
 	service, err := k.servicesKeeper.GetService(ctx, msg.ServiceID)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != service.Admin {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only service admin can create rewards plan")
+	// Make sure the creator is the admin of the service or has the rewards plans role
+	hasPermission, err := k.servicesKeeper.HasServicePermission(ctx, service, msg.Sender, servicestypes.SERVICE_ROLE_REWARDS_PLANS)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin or an account having the %s role can create a rewards plan", servicestypes.SERVICE_ROLE_REWARDS_PLANS)
 	}
 
 	// Charge a scaling gas consumption fee
@@ -44,6 +51,11 @@ func (k msgServer) CreateRewardsPlan(ctx context.Context, msg *types.MsgCreateRe
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.GasMeter().ConsumeGas(types.BaseGasFeeForNewPlan*uint64(len(rewardsPlans)), "create rewards plan gas cost")
 
+	emissionSchedule, err := types.GetEmissionSchedule(k.cdc, msg.EmissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the plan
 	plan, err := k.Keeper.CreateRewardsPlan(
 		ctx,
@@ -55,6 +67,9 @@ func (k msgServer) CreateRewardsPlan(ctx context.Context, msg *types.MsgCreateRe
 		msg.PoolsDistribution,
 		msg.OperatorsDistribution,
 		msg.UsersDistribution,
+		emissionSchedule,
+		msg.InsufficientFundsBehavior,
+		msg.USDBudget,
 	)
 	if err != nil {
 		return nil, err
@@ -112,9 +127,19 @@ func (k msgServer) EditRewardsPlan(ctx context.Context, msg *types.MsgEditReward
 		return nil, err
 	}
 
-	// Make sure the editor is the admin of the service
-	if msg.Sender != service.Admin {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only service admin can create rewards plan")
+	// Make sure the editor is the admin of the service or has the rewards plans role
+	hasPermission, err := k.servicesKeeper.HasServicePermission(ctx, service, msg.Sender, servicestypes.SERVICE_ROLE_REWARDS_PLANS)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the service admin or an account having the %s role can edit a rewards plan", servicestypes.SERVICE_ROLE_REWARDS_PLANS)
+	}
+
+	emissionSchedule, err := types.GetEmissionSchedule(k.cdc, msg.EmissionSchedule)
+	if err != nil {
+		return nil, err
 	}
 
 	// Edit the rewards plan
@@ -128,6 +153,9 @@ func (k msgServer) EditRewardsPlan(ctx context.Context, msg *types.MsgEditReward
 		msg.PoolsDistribution,
 		msg.OperatorsDistribution,
 		msg.UsersDistribution,
+		emissionSchedule,
+		msg.InsufficientFundsBehavior,
+		msg.USDBudget,
 	)
 	if err != nil {
 		return nil, err
@@ -168,14 +196,35 @@ func (k msgServer) SetWithdrawAddress(ctx context.Context, msg *types.MsgSetWith
 		return nil, err
 	}
 
+	// Set the IBC withdraw destination. If it's not specified, any previously
+	// set destination is removed
+	if msg.IBCDestination != nil {
+		err = msg.IBCDestination.Validate()
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid ibc destination: %s", err)
+		}
+	}
+
+	err = k.Keeper.SetIBCWithdrawDestination(ctx, senderAddr, msg.IBCDestination)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.WithdrawAddress),
+	}
+	if msg.IBCDestination != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeySourceChannel, msg.IBCDestination.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.IBCDestination.Receiver),
+		)
+	}
+
 	// Emit the event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSetWithdrawAddress,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.WithdrawAddress),
-		),
+		sdk.NewEvent(types.EventTypeSetWithdrawAddress, attributes...),
 	)
 
 	return &types.MsgSetWithdrawAddressResponse{}, nil
@@ -205,6 +254,30 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: rewards.Sum()}, nil
 }
 
+// WithdrawAllDelegatorRewards defines the rpc method Msg/WithdrawAllDelegatorRewards
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, ok := restakingtypes.DelegationType_name[int32(msg.DelegationType)]; !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid delegation type: %v", msg.DelegationType)
+	}
+
+	rewards, err := k.WithdrawAllDelegationRewards(ctx, delAddr, msg.DelegationType)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, reward := range rewards {
+		total = total.Add(reward.Amount...)
+	}
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Rewards: rewards, Total: total}, nil
+}
+
 // WithdrawOperatorCommission defines the rpc method Msg/WithdrawOperatorCommission
 func (k msgServer) WithdrawOperatorCommission(ctx context.Context, msg *types.MsgWithdrawOperatorCommission) (*types.MsgWithdrawOperatorCommissionResponse, error) {
 	_, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sender)
@@ -229,6 +302,55 @@ func (k msgServer) WithdrawOperatorCommission(ctx context.Context, msg *types.Ms
 	return &types.MsgWithdrawOperatorCommissionResponse{Amount: commissions.Sum()}, nil
 }
 
+// SetAutoCompound defines the rpc method for Msg/SetAutoCompound
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if msg.DelegationTargetID == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid delegation target ID: %d", msg.DelegationTargetID)
+	}
+
+	// Make sure the delegation target exists
+	target, err := k.GetDelegationTarget(ctx, msg.DelegationType, msg.DelegationTargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the delegator has a delegation to compound the rewards of
+	if msg.Enabled {
+		_, found, err := k.restakingKeeper.GetDelegationForTarget(ctx, target.DelegationTarget, msg.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, sdkerrors.ErrNotFound.Wrapf("delegation not found: %d, %s", msg.DelegationTargetID, msg.DelegatorAddress)
+		}
+	}
+
+	err = k.Keeper.SetAutoCompound(ctx, delAddr, msg.DelegationType, msg.DelegationTargetID, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(restakingtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegationType, msg.DelegationType.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegationTargetID, fmt.Sprint(msg.DelegationTargetID)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprint(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 // UpdateParams defines the rpc method for Msg/UpdateParams
 func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
@@ -34,12 +34,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 			msg: types.NewMsgCreateRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
@@ -57,6 +60,9 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 				// Change rewards plan creation fee to 100 $MILK.
 				err := suite.keeper.Params.Set(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000_000)),
+					types.DefaultAutoCompoundInterval,
+					types.DefaultAutoCompoundMaxGasPerBlock,
+					0,
 				))
 				suite.Require().NoError(err)
 
@@ -70,12 +76,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 			msg: types.NewMsgCreateRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
@@ -90,6 +99,9 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 				// Change rewards plan creation fee to 100 $MILK.
 				err := suite.keeper.Params.Set(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000_000)),
+					types.DefaultAutoCompoundInterval,
+					types.DefaultAutoCompoundMaxGasPerBlock,
+					0,
 				))
 				suite.Require().NoError(err)
 
@@ -98,12 +110,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 					ctx,
 					"Rewards Plan",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewBasicPoolsDistribution(0),
 					types.NewBasicOperatorsDistribution(0),
 					types.NewBasicUsersDistribution(0),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				)
 				suite.Require().NoError(err)
 
@@ -121,12 +136,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 			msg: types.NewMsgCreateRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
 				testutil.TestAddress(10000).String(),
 			),
@@ -174,6 +192,9 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 				// Change rewards plan creation fee to 100 $MILK.
 				err := suite.keeper.Params.Set(ctx, types.NewParams(
 					sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000_000)),
+					types.DefaultAutoCompoundInterval,
+					types.DefaultAutoCompoundMaxGasPerBlock,
+					0,
 				))
 				suite.Require().NoError(err)
 
@@ -191,12 +212,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 			msg: types.NewMsgCreateRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
 				testutil.TestAddress(10000).String(),
 			),
@@ -231,6 +255,67 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 				suite.Require().Equal("400000000umilk", balances.String())
 			},
 		},
+		{
+			name: "invalid emission schedule returns error",
+			store: func(ctx sdk.Context) {
+				// Create a service
+				_, _ = suite.setupSampleServiceAndOperator(ctx)
+			},
+			msg: types.NewMsgCreateRewardsPlan(
+				1,
+				"Rewards Plan",
+				utils.MustParseCoins("100_000000service"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				types.NewEmissionScheduleHalving(0),
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+				nil,
+				testutil.TestAddress(10000).String(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "plan with emission schedule is created properly",
+			store: func(ctx sdk.Context) {
+				// Create a service
+				_, _ = suite.setupSampleServiceAndOperator(ctx)
+
+				// Set the next plan id
+				err := suite.keeper.NextRewardsPlanID.Set(ctx, 1)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgCreateRewardsPlan(
+				1,
+				"Rewards Plan",
+				utils.MustParseCoins("100_000000service"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				types.NewEmissionScheduleLinearDecay(utils.MustParseDec("0.1")),
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+				nil,
+				testutil.TestAddress(10000).String(),
+			),
+			shouldErr: false,
+			expResponse: &types.MsgCreateRewardsPlanResponse{
+				NewRewardsPlanID: 1,
+			},
+			check: func(ctx sdk.Context) {
+				plan, err := suite.keeper.GetRewardsPlan(ctx, 1)
+				suite.Require().NoError(err)
+
+				emissionSchedule, err := types.GetEmissionSchedule(suite.cdc, plan.EmissionSchedule)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewEmissionScheduleLinearDecay(utils.MustParseDec("0.1")), emissionSchedule)
+			},
+		},
 		{
 			name: "plan is created and fee is charged - one of many fees denoms",
 			store: func(ctx sdk.Context) {
@@ -244,6 +329,9 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 						sdk.NewCoin("utia", sdkmath.NewInt(30_000_000)),
 						sdk.NewCoin("milktia", sdkmath.NewInt(80_000_000)),
 					),
+					types.DefaultAutoCompoundInterval,
+					types.DefaultAutoCompoundMaxGasPerBlock,
+					0,
 				))
 				suite.Require().NoError(err)
 
@@ -265,12 +353,15 @@ func (suite *KeeperTestSuite) TestMsgCreateRewardsPlan() {
 			msg: types.NewMsgCreateRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.NewCoins(
 					sdk.NewCoin("uatom", sdkmath.NewInt(20_000_000)),
 					sdk.NewCoin("utia", sdkmath.NewInt(15_000_000)),
@@ -372,12 +463,15 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 			msg: types.NewMsgEditRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr: true,
@@ -397,24 +491,30 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 					ctx,
 					"Rewards Plan",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewBasicPoolsDistribution(0),
 					types.NewBasicOperatorsDistribution(0),
 					types.NewBasicUsersDistribution(0),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				)
 				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgEditRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr: true,
@@ -437,24 +537,30 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 					ctx,
 					"Rewards Plan",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewBasicPoolsDistribution(0),
 					types.NewBasicOperatorsDistribution(0),
 					types.NewBasicUsersDistribution(0),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				)
 				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgEditRewardsPlan(
 				1,
 				"Rewards Plan",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				testutil.TestAddress(10000).String(),
 			),
 			shouldErr: true,
@@ -477,24 +583,30 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 					ctx,
 					"Rewards Plan",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewBasicPoolsDistribution(0),
 					types.NewBasicOperatorsDistribution(0),
 					types.NewBasicUsersDistribution(0),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				)
 				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgEditRewardsPlan(
 				1,
 				"Rewards Plan - Edited",
-				utils.MustParseCoin("100_000000service"),
+				utils.MustParseCoins("100_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				testutil.TestAddress(10000).String(),
 			),
 			shouldErr: true,
@@ -517,24 +629,30 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 					ctx,
 					"Rewards Plan",
 					1,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					types.NewBasicPoolsDistribution(0),
 					types.NewBasicOperatorsDistribution(0),
 					types.NewBasicUsersDistribution(0),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+					nil,
 				)
 				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgEditRewardsPlan(
 				1,
 				"Rewards Plan - Edited",
-				utils.MustParseCoin("200_000000service"),
+				utils.MustParseCoins("200_000000service"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(2),
 				types.NewBasicUsersDistribution(3),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				testutil.TestAddress(10000).String(),
 			),
 			shouldErr:   false,
@@ -551,7 +669,7 @@ func (suite *KeeperTestSuite) TestMsgEditRewardsPlan() {
 				// Make sure the rewards plan has been edited
 				plan, err := suite.keeper.GetRewardsPlan(ctx, 1)
 				suite.Require().NoError(err)
-				suite.Require().Equal(utils.MustParseCoin("200_000000service"), plan.AmountPerDay)
+				suite.Require().Equal(utils.MustParseCoins("200_000000service"), plan.AmountPerDay)
 				suite.Require().Equal(
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					plan.StartTime,
@@ -626,6 +744,7 @@ func (suite *KeeperTestSuite) TestMsgSetWithdrawAddress() {
 			name: "invalid sender address returns error",
 			msg: types.NewMsgSetWithdrawAddress(
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+				nil,
 				"invalid",
 			),
 			shouldErr: true,
@@ -634,6 +753,7 @@ func (suite *KeeperTestSuite) TestMsgSetWithdrawAddress() {
 			name: "invalid withdraw address returns error",
 			msg: types.NewMsgSetWithdrawAddress(
 				"invalid",
+				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr: true,
@@ -642,6 +762,7 @@ func (suite *KeeperTestSuite) TestMsgSetWithdrawAddress() {
 			name: "success",
 			msg: types.NewMsgSetWithdrawAddress(
 				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				nil,
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr:   false,
@@ -663,6 +784,72 @@ func (suite *KeeperTestSuite) TestMsgSetWithdrawAddress() {
 				suite.Require().Equal("cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", withdrawAddr.String())
 			},
 		},
+		{
+			name: "invalid ibc destination returns error",
+			msg: types.NewMsgSetWithdrawAddress(
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				&types.IBCWithdrawDestination{SourceChannel: "channel-0", Receiver: "", Timeout: time.Hour},
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "ibc destination is set properly",
+			msg: types.NewMsgSetWithdrawAddress(
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				&types.IBCWithdrawDestination{SourceChannel: "channel-0", Receiver: "osmo1receiver", Timeout: time.Hour},
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgSetWithdrawAddressResponse{},
+			expEvents: []sdk.Event{
+				sdk.NewEvent(
+					types.EventTypeSetWithdrawAddress,
+					sdk.NewAttribute(sdk.AttributeKeySender, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyWithdrawAddress, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+					sdk.NewAttribute(types.AttributeKeySourceChannel, "channel-0"),
+					sdk.NewAttribute(types.AttributeKeyReceiver, "osmo1receiver"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				delegatorAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				destination, err := suite.keeper.GetIBCWithdrawDestination(ctx, delegatorAddr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.IBCWithdrawDestination{
+					SourceChannel: "channel-0",
+					Receiver:      "osmo1receiver",
+					Timeout:       time.Hour,
+				}, destination)
+			},
+		},
+		{
+			name: "ibc destination is removed when not specified",
+			store: func(ctx sdk.Context) {
+				delegatorAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				destination := types.NewIBCWithdrawDestination("channel-0", "osmo1receiver", time.Hour)
+				err = suite.keeper.SetIBCWithdrawDestination(ctx, delegatorAddr, &destination)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetWithdrawAddress(
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				nil,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgSetWithdrawAddressResponse{},
+			check: func(ctx sdk.Context) {
+				delegatorAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				destination, err := suite.keeper.GetIBCWithdrawDestination(ctx, delegatorAddr)
+				suite.Require().NoError(err)
+				suite.Require().Nil(destination)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -764,7 +951,7 @@ func (suite *KeeperTestSuite) TestMsgWithdrawDelegatorReward() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -848,6 +1035,332 @@ func (suite *KeeperTestSuite) TestMsgWithdrawDelegatorReward() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgWithdrawAllDelegatorRewards() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		updateCtx   func(ctx sdk.Context) sdk.Context
+		msg         *types.MsgWithdrawAllDelegatorRewards
+		shouldErr   bool
+		expResponse *types.MsgWithdrawAllDelegatorRewardsResponse
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegation type returns error",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DelegationType(5),
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "delegator without delegations returns empty response",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			expResponse: &types.MsgWithdrawAllDelegatorRewardsResponse{
+				Rewards: []types.WithdrawnDelegationReward{},
+				Total:   sdk.NewCoins(),
+			},
+		},
+		{
+			name: "rewards are withdrawn from all the delegations",
+			store: func(ctx sdk.Context) {
+				service, operator := suite.setupSampleServiceAndOperator(ctx)
+				suite.CreateBasicRewardsPlan(
+					ctx,
+					service.ID,
+					utils.MustParseCoins("100_000000service"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					utils.MustParseCoins("10000_000000service"),
+				)
+
+				suite.DelegateOperator(
+					ctx,
+					operator.ID,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				)
+				suite.DelegateService(
+					ctx,
+					service.ID,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			expResponse: &types.MsgWithdrawAllDelegatorRewardsResponse{
+				Rewards: []types.WithdrawnDelegationReward{
+					types.NewWithdrawnDelegationReward(
+						restakingtypes.DELEGATION_TYPE_SERVICE,
+						1,
+						sdk.NewCoins(sdk.NewCoin("service", sdkmath.NewInt(5787))),
+					),
+					types.NewWithdrawnDelegationReward(
+						restakingtypes.DELEGATION_TYPE_OPERATOR,
+						1,
+						sdk.NewCoins(sdk.NewCoin("service", sdkmath.NewInt(5208))),
+					),
+				},
+				Total: sdk.NewCoins(sdk.NewCoin("service", sdkmath.NewInt(10995))),
+			},
+			check: func(ctx sdk.Context) {
+				delegatorAddress, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				balances := suite.bankKeeper.GetAllBalances(ctx, delegatorAddress)
+				suite.Require().Equal("10995service", balances.String())
+			},
+		},
+		{
+			name: "rewards are withdrawn only from the delegations of the given type",
+			store: func(ctx sdk.Context) {
+				service, operator := suite.setupSampleServiceAndOperator(ctx)
+				suite.CreateBasicRewardsPlan(
+					ctx,
+					service.ID,
+					utils.MustParseCoins("100_000000service"),
+					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					utils.MustParseCoins("10000_000000service"),
+				)
+
+				suite.DelegateOperator(
+					ctx,
+					operator.ID,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				)
+				suite.DelegateService(
+					ctx,
+					service.ID,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				)
+			},
+			updateCtx: func(ctx sdk.Context) sdk.Context {
+				return suite.allocateRewards(ctx, 10*time.Second)
+			},
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			expResponse: &types.MsgWithdrawAllDelegatorRewardsResponse{
+				Rewards: []types.WithdrawnDelegationReward{
+					types.NewWithdrawnDelegationReward(
+						restakingtypes.DELEGATION_TYPE_SERVICE,
+						1,
+						sdk.NewCoins(sdk.NewCoin("service", sdkmath.NewInt(5787))),
+					),
+				},
+				Total: sdk.NewCoins(sdk.NewCoin("service", sdkmath.NewInt(5787))),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+			if tc.updateCtx != nil {
+				ctx = tc.updateCtx(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.keeper)
+			res, err := msgServer.WithdrawAllDelegatorRewards(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetAutoCompound() {
+	testCases := []struct {
+		name      string
+		store     func(ctx sdk.Context)
+		msg       *types.MsgSetAutoCompound
+		shouldErr bool
+		expEvents sdk.Events
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				1,
+				true,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid target ID returns error",
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				0,
+				true,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing delegation target returns error",
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				1,
+				true,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non existing delegation returns error",
+			store: func(ctx sdk.Context) {
+				suite.setupSampleServiceAndOperator(ctx)
+			},
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				1,
+				true,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "auto-compound is enabled properly",
+			store: func(ctx sdk.Context) {
+				suite.setupSampleServiceAndOperator(ctx)
+				suite.DelegateService(
+					ctx,
+					1,
+					utils.MustParseCoins("100_000000umilk"),
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				)
+			},
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				1,
+				true,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetAutoCompound,
+					sdk.NewAttribute(restakingtypes.AttributeKeyDelegator, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyDelegationType, restakingtypes.DELEGATION_TYPE_SERVICE.String()),
+					sdk.NewAttribute(types.AttributeKeyDelegationTargetID, "1"),
+					sdk.NewAttribute(types.AttributeKeyEnabled, "true"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				enabled, err := suite.keeper.IsAutoCompoundEnabled(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 1)
+				suite.Require().NoError(err)
+				suite.Require().True(enabled)
+			},
+		},
+		{
+			name: "auto-compound is disabled properly",
+			store: func(ctx sdk.Context) {
+				suite.setupSampleServiceAndOperator(ctx)
+
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				err = suite.keeper.SetAutoCompound(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 1, true)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_SERVICE,
+				1,
+				false,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeSetAutoCompound,
+					sdk.NewAttribute(restakingtypes.AttributeKeyDelegator, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+					sdk.NewAttribute(types.AttributeKeyDelegationType, restakingtypes.DELEGATION_TYPE_SERVICE.String()),
+					sdk.NewAttribute(types.AttributeKeyDelegationTargetID, "1"),
+					sdk.NewAttribute(types.AttributeKeyEnabled, "false"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				delAddr, err := sdk.AccAddressFromBech32("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd")
+				suite.Require().NoError(err)
+
+				enabled, err := suite.keeper.IsAutoCompoundEnabled(ctx, delAddr, restakingtypes.DELEGATION_TYPE_SERVICE, 1)
+				suite.Require().NoError(err)
+				suite.Require().False(enabled)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.keeper)
+			_, err := msgServer.SetAutoCompound(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgWithdrawOperatorCommission() {
 	testCases := []struct {
 		name        string
@@ -879,7 +1392,7 @@ func (suite *KeeperTestSuite) TestMsgWithdrawOperatorCommission() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
@@ -913,7 +1426,7 @@ func (suite *KeeperTestSuite) TestMsgWithdrawOperatorCommission() {
 				suite.CreateBasicRewardsPlan(
 					ctx,
 					service.ID,
-					utils.MustParseCoin("100_000000service"),
+					utils.MustParseCoins("100_000000service"),
 					time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 					utils.MustParseCoins("10000_000000service"),
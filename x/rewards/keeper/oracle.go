@@ -3,46 +3,19 @@ package keeper
 import (
 	"context"
 	stdmath "math"
+	"time"
 
-	"cosmossdk.io/collections"
-	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 
 	assetstypes "github.com/milkyway-labs/milkyway/v12/x/assets/types"
-	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
 
+// GetAssetAndPrice returns the asset and the USD price of the given denom.
+// If either the asset or the price is not found, 0 is returned as price.
 func (k *Keeper) GetAssetAndPrice(ctx context.Context, denom string) (assetstypes.Asset, math.LegacyDec, error) {
-	asset, err := k.assetsKeeper.GetAsset(ctx, denom)
-	if err != nil {
-		// If asset is not found, then we return 0 as price.
-		if errors.IsOf(err, collections.ErrNotFound) {
-			return assetstypes.Asset{}, math.LegacyZeroDec(), nil
-		}
-		return assetstypes.Asset{}, math.LegacyDec{}, err
-	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	cp := connecttypes.NewCurrencyPair(asset.Ticker, types.USDTicker)
-	qpn, err := k.oracleKeeper.GetPriceWithNonceForCurrencyPair(sdkCtx, cp)
-	if err != nil {
-		// If currency pair is not found return 0 as well.
-		if errors.IsOf(err, collections.ErrNotFound) {
-			return asset, math.LegacyZeroDec(), nil
-		}
-		return asset, math.LegacyDec{}, err
-	}
-
-	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(sdkCtx, cp)
-	if err != nil {
-		return asset, math.LegacyDec{}, err
-	}
-
-	// Divide returned quote price by 10^{decimals} gives us the real price in
-	// decimal number.
-	return asset, math.LegacyNewDecFromIntWithPrec(qpn.Price, int64(decimals)), nil
+	asset, price, _, err := k.assetsKeeper.GetAssetPrice(ctx, denom)
+	return asset, price, err
 }
 
 func (k *Keeper) GetCoinValue(ctx context.Context, coin sdk.Coin) (math.LegacyDec, error) {
@@ -69,3 +42,27 @@ func (k *Keeper) GetCoinsValue(ctx context.Context, coins sdk.Coins) (math.Legac
 	}
 	return totalValue, nil
 }
+
+// GetUSDValueAmount converts the given USD value into an amount of the given
+// denom using the oracle price. The price is only used if it has been updated
+// within maxPriceAge, otherwise false is returned.
+func (k *Keeper) GetUSDValueAmount(ctx context.Context, value math.LegacyDec, denom string, maxPriceAge time.Duration) (sdk.Coin, bool, error) {
+	asset, price, priceTime, err := k.assetsKeeper.GetAssetPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, false, err
+	}
+
+	// The price is missing
+	if price.IsZero() {
+		return sdk.Coin{}, false, nil
+	}
+
+	// The price is too old to be used
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().Sub(priceTime) > maxPriceAge {
+		return sdk.Coin{}, false, nil
+	}
+
+	amount := value.MulInt64(int64(stdmath.Pow10(int(asset.Exponent)))).Quo(price).TruncateInt()
+	return sdk.NewCoin(denom, amount), true, nil
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 )
 
@@ -62,17 +65,27 @@ func (h RestakingHooks) AfterServiceDelegationModified(ctx context.Context, serv
 }
 
 // BeforePoolDelegationRemoved implements restakingtypes.RestakingHooks
-func (h RestakingHooks) BeforePoolDelegationRemoved(_ context.Context, _ uint32, _ string) error {
-	return nil
+func (h RestakingHooks) BeforePoolDelegationRemoved(ctx context.Context, poolID uint32, delegator string) error {
+	return h.k.BeforeDelegationRemoved(ctx, restakingtypes.DELEGATION_TYPE_POOL, poolID, delegator)
 }
 
 // BeforeOperatorDelegationRemoved implements restakingtypes.RestakingHooks
-func (h RestakingHooks) BeforeOperatorDelegationRemoved(_ context.Context, _ uint32, _ string) error {
-	return nil
+func (h RestakingHooks) BeforeOperatorDelegationRemoved(ctx context.Context, operatorID uint32, delegator string) error {
+	return h.k.BeforeDelegationRemoved(ctx, restakingtypes.DELEGATION_TYPE_OPERATOR, operatorID, delegator)
 }
 
 // BeforeServiceDelegationRemoved implements restakingtypes.RestakingHooks
-func (h RestakingHooks) BeforeServiceDelegationRemoved(_ context.Context, _ uint32, _ string) error {
+func (h RestakingHooks) BeforeServiceDelegationRemoved(ctx context.Context, serviceID uint32, delegator string) error {
+	return h.k.BeforeDelegationRemoved(ctx, restakingtypes.DELEGATION_TYPE_SERVICE, serviceID, delegator)
+}
+
+// BeforeOperatorSlashed implements restakingtypes.RestakingHooks
+func (h RestakingHooks) BeforeOperatorSlashed(_ context.Context, _ uint32, _ uint32, _ math.LegacyDec) error {
+	return nil
+}
+
+// AfterOperatorSlashed implements restakingtypes.RestakingHooks
+func (h RestakingHooks) AfterOperatorSlashed(_ context.Context, _ uint32, _ uint32, _ math.LegacyDec, _ sdk.Coins) error {
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,12 +17,15 @@ func (k *Keeper) CreateRewardsPlan(
 	ctx context.Context,
 	description string,
 	serviceID uint32,
-	amt sdk.Coin,
+	amt sdk.Coins,
 	startTime,
 	endTime time.Time,
 	poolsDistribution types.Distribution,
 	operatorsDistribution types.Distribution,
 	usersDistribution types.UsersDistribution,
+	emissionSchedule types.EmissionSchedule,
+	insufficientFundsBehavior types.InsufficientFundsBehavior,
+	usdBudget *types.USDBudget,
 ) (types.RewardsPlan, error) {
 	_, err := k.servicesKeeper.GetService(ctx, serviceID)
 	if err != nil {
@@ -51,6 +55,9 @@ func (k *Keeper) CreateRewardsPlan(
 		poolsDistribution,
 		operatorsDistribution,
 		usersDistribution,
+		emissionSchedule,
+		insufficientFundsBehavior,
+		usdBudget,
 	)
 
 	// Validate the plan
@@ -116,6 +123,11 @@ func (k *Keeper) GetRewardsPlan(ctx context.Context, planID uint64) (types.Rewar
 	return k.RewardsPlans.Get(ctx, planID)
 }
 
+// SaveRewardsPlan stores the given rewards plan, updating its indexes.
+func (k *Keeper) SaveRewardsPlan(ctx context.Context, plan types.RewardsPlan) error {
+	return k.RewardsPlans.Set(ctx, plan.ID, plan)
+}
+
 // terminateRewardsPlan removes a rewards plan and transfers the remaining
 // rewards in the plan's rewards pool to the service's address.
 func (k *Keeper) terminateRewardsPlan(ctx context.Context, plan types.RewardsPlan) error {
@@ -148,7 +160,13 @@ func (k *Keeper) terminateRewardsPlan(ctx context.Context, plan types.RewardsPla
 		return err
 	}
 
+	// Archive the plan along with its accounting
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = k.archiveRewardsPlan(ctx, plan, sdkCtx.BlockTime())
+	if err != nil {
+		return err
+	}
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTerminateRewardsPlan,
@@ -166,17 +184,127 @@ func (k *Keeper) TerminateEndedRewardsPlans(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
 
-	// Iterate over all rewards plans
-	return k.RewardsPlans.Walk(ctx, nil, func(planID uint64, plan types.RewardsPlan) (stop bool, err error) {
-		// If the plan has already ended, terminate it
-		if !blockTime.Before(plan.EndTime) {
-			err = k.terminateRewardsPlan(ctx, plan)
-			if err != nil {
-				return false, err
-			}
+	// Collect the IDs of the plans that have ended. The plans are sorted by
+	// their end time, so we can stop at the first one that is still running
+	var endedPlansIDs []uint64
+	err := k.RewardsPlans.Indexes.EndTime.Walk(ctx, nil, func(endTime time.Time, planID uint64) (stop bool, err error) {
+		if endTime.After(blockTime) {
+			return true, nil
 		}
+		endedPlansIDs = append(endedPlansIDs, planID)
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Terminate the ended plans
+	for _, planID := range endedPlansIDs {
+		plan, err := k.RewardsPlans.Get(ctx, planID)
+		if err != nil {
+			return err
+		}
+
+		err = k.terminateRewardsPlan(ctx, plan)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasEndedRewardsPlans returns true if there's at least one rewards plan that
+// has ended and is waiting to be terminated.
+func (k *Keeper) hasEndedRewardsPlans(ctx context.Context) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	// Only the plan with the smallest end time needs to be checked
+	hasEndedPlans := false
+	err := k.RewardsPlans.Indexes.EndTime.Walk(ctx, nil, func(endTime time.Time, _ uint64) (stop bool, err error) {
+		hasEndedPlans = !endTime.After(blockTime)
+		return true, nil
+	})
+	return hasEndedPlans, err
+}
+
+// GetRewardsPlanAmountPerDay returns the amount of rewards that the given plan
+// should distribute per day. For plans with a USD budget, the budget is
+// converted to the payout denom using the oracle price. If the price can't be
+// used (e.g. it's missing or stale), an empty amount is returned.
+func (k *Keeper) GetRewardsPlanAmountPerDay(ctx context.Context, plan types.RewardsPlan) (sdk.Coins, error) {
+	if plan.USDBudget == nil {
+		return plan.AmountPerDay, nil
+	}
+
+	amount, found, err := k.GetUSDValueAmount(
+		ctx,
+		plan.USDBudget.AmountPerDay,
+		plan.USDBudget.PayoutDenom,
+		plan.USDBudget.GetMaxUsablePriceAge(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	return sdk.NewCoins(amount), nil
+}
+
+// GetRewardsPlanAccounting returns the accounting of the rewards plan with
+// the given ID. If the plan has not distributed anything yet, an empty
+// accounting is returned.
+func (k *Keeper) GetRewardsPlanAccounting(ctx context.Context, planID uint64) (types.RewardsPlanAccounting, error) {
+	accounting, err := k.RewardsPlansAccounting.Get(ctx, planID)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.NewRewardsPlanAccounting(planID, nil, 0), nil
+		}
+		return types.RewardsPlanAccounting{}, err
+	}
+	return accounting, nil
+}
+
+// SaveRewardsPlanAccounting stores the given rewards plan accounting.
+func (k *Keeper) SaveRewardsPlanAccounting(ctx context.Context, accounting types.RewardsPlanAccounting) error {
+	return k.RewardsPlansAccounting.Set(ctx, accounting.PlanID, accounting)
+}
+
+// incrementRewardsPlanSkippedAllocations increments the number of allocations
+// that have been skipped by the rewards plan with the given ID.
+func (k *Keeper) incrementRewardsPlanSkippedAllocations(ctx context.Context, planID uint64) error {
+	accounting, err := k.GetRewardsPlanAccounting(ctx, planID)
+	if err != nil {
+		return err
+	}
+
+	accounting.SkippedAllocations++
+	return k.SaveRewardsPlanAccounting(ctx, accounting)
+}
+
+// archiveRewardsPlan stores the given plan along with its accounting inside
+// the archived rewards plans, and removes its accounting.
+func (k *Keeper) archiveRewardsPlan(ctx context.Context, plan types.RewardsPlan, terminationTime time.Time) error {
+	accounting, err := k.GetRewardsPlanAccounting(ctx, plan.ID)
+	if err != nil {
+		return err
+	}
+
+	err = k.ArchivedRewardsPlans.Set(ctx, plan.ID, types.NewArchivedRewardsPlan(plan, terminationTime, accounting))
+	if err != nil {
+		return err
+	}
+
+	return k.RewardsPlansAccounting.Remove(ctx, plan.ID)
+}
+
+// GetArchivedRewardsPlan returns an archived rewards plan by ID.
+func (k *Keeper) GetArchivedRewardsPlan(ctx context.Context, planID uint64) (types.ArchivedRewardsPlan, error) {
+	return k.ArchivedRewardsPlans.Get(ctx, planID)
 }
 
 // EditRewardsPlan edits an existing rewards plan.
@@ -184,12 +312,15 @@ func (k *Keeper) EditRewardsPlan(
 	ctx context.Context,
 	planID uint64,
 	newDescription string,
-	newAmountPerDay sdk.Coin,
+	newAmountPerDay sdk.Coins,
 	newStartTime,
 	newEndTime time.Time,
 	newPoolsDistribution types.Distribution,
 	newOperatorsDistribution types.Distribution,
 	newUsersDistribution types.UsersDistribution,
+	newEmissionSchedule types.EmissionSchedule,
+	newInsufficientFundsBehavior types.InsufficientFundsBehavior,
+	newUSDBudget *types.USDBudget,
 ) error {
 	// Ensure the plan ID is valid
 	if planID == 0 {
@@ -233,6 +364,9 @@ func (k *Keeper) EditRewardsPlan(
 		newPoolsDistribution,
 		newOperatorsDistribution,
 		newUsersDistribution,
+		newEmissionSchedule,
+		newInsufficientFundsBehavior,
+		newUSDBudget,
 	)
 
 	// Validate the plan
@@ -7,6 +7,7 @@ import (
 
 	"github.com/milkyway-labs/milkyway/v12/app/testutil"
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	rewardskeeper "github.com/milkyway-labs/milkyway/v12/x/rewards/keeper"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
@@ -18,7 +19,7 @@ func (suite *KeeperTestSuite) TestCreateRewardsPlan_PoolOrOperatorNotFound() {
 	service, _ := suite.setupSampleServiceAndOperator(ctx)
 
 	// Create an active rewards plan.
-	amtPerDay := utils.MustParseCoin("100_000000service")
+	amtPerDay := utils.MustParseCoins("100_000000service")
 	planStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	planEndTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -41,6 +42,9 @@ func (suite *KeeperTestSuite) TestCreateRewardsPlan_PoolOrOperatorNotFound() {
 		}),
 		types.NewBasicUsersDistribution(1),
 		nil,
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+		nil,
 		service.Admin,
 	))
 	suite.Require().ErrorIs(err, collections.ErrNotFound)
@@ -66,6 +70,9 @@ func (suite *KeeperTestSuite) TestCreateRewardsPlan_PoolOrOperatorNotFound() {
 		}),
 		types.NewBasicUsersDistribution(1),
 		nil,
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+		nil,
 		service.Admin,
 	))
 	suite.Require().ErrorIs(err, collections.ErrNotFound)
@@ -81,7 +88,7 @@ func (suite *KeeperTestSuite) TestTerminateEndedRewardsPlans() {
 	plan := suite.CreateBasicRewardsPlan(
 		ctx,
 		service.ID,
-		utils.MustParseCoin("100_000000service"),
+		utils.MustParseCoins("100_000000service"),
 		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		utils.MustParseCoins("10000_000000service"),
@@ -112,3 +119,62 @@ func (suite *KeeperTestSuite) TestTerminateEndedRewardsPlans() {
 	serviceBalances := suite.bankKeeper.GetAllBalances(ctx, serviceAddr)
 	suite.Require().Equal("10000000000service", serviceBalances.String())
 }
+
+func (suite *KeeperTestSuite) TestTerminateEndedRewardsPlans_ArchivesPlan() {
+	// Cache the context to avoid errors
+	ctx, _ := suite.ctx.CacheContext()
+
+	service, _ := suite.setupSampleServiceAndOperator(ctx)
+
+	// Create an active rewards plan.
+	plan := suite.CreateBasicRewardsPlan(
+		ctx,
+		service.ID,
+		utils.MustParseCoins("100_000000service"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		utils.MustParseCoins("10000_000000service"),
+	)
+
+	delAddr := testutil.TestAddress(1)
+	suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delAddr.String(), true)
+
+	// Allocate the rewards twice, which should be tracked inside the accounting
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+	ctx = suite.allocateRewards(ctx, 10*time.Second)
+
+	accounting, err := suite.keeper.GetRewardsPlanAccounting(ctx, plan.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewRewardsPlanAccounting(plan.ID, []types.DelegationTargetDistributedRewards{
+		types.NewDelegationTargetDistributedRewards(
+			restakingtypes.DELEGATION_TYPE_SERVICE,
+			service.ID,
+			utils.MustParseDecCoins("23148service"),
+		),
+	}, 0), accounting)
+
+	// Change the block time so that the plan becomes no more active.
+	ctx = ctx.WithBlockTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	// Terminate the ended rewards plans
+	err = suite.keeper.TerminateEndedRewardsPlans(ctx)
+	suite.Require().NoError(err)
+
+	// The plan is archived along with its accounting
+	archived, err := suite.keeper.GetArchivedRewardsPlan(ctx, plan.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(plan.ID, archived.Plan.ID)
+	suite.Require().Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), archived.TerminationTime)
+	suite.Require().Equal([]types.DelegationTypeDistributedRewards{
+		types.NewDelegationTypeDistributedRewards(
+			restakingtypes.DELEGATION_TYPE_SERVICE,
+			utils.MustParseDecCoins("23148service"),
+		),
+	}, archived.DistributedPerDelegationType)
+	suite.Require().Equal(accounting.DistributedPerTarget, archived.DistributedPerTarget)
+	suite.Require().Zero(archived.SkippedAllocations)
+
+	// The accounting of the active plan is removed
+	_, err = suite.keeper.RewardsPlansAccounting.Get(ctx, plan.ID)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+}
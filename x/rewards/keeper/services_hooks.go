@@ -41,3 +41,8 @@ func (h ServicesHooks) BeforeServiceDeleted(ctx context.Context, serviceID uint3
 func (h ServicesHooks) AfterServiceAccreditationModified(context.Context, uint32) error {
 	return nil
 }
+
+// AfterServiceParamsModified implements servicestypes.ServicesHooks
+func (h ServicesHooks) AfterServiceParamsModified(context.Context, uint32) error {
+	return nil
+}
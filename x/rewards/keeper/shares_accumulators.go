@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+// GetDelegatorSharesAccumulator returns the delegator shares accumulator of the
+// given delegation target. The service ID must be set only for pools, since
+// their shares are accumulated separately for each service. If the accumulator
+// does not exist, false is returned instead.
+func (k *Keeper) GetDelegatorSharesAccumulator(
+	ctx context.Context, delType restakingtypes.DelegationType, targetID uint32, serviceID uint32,
+) (types.DelegatorSharesAccumulator, bool, error) {
+	accumulator, err := k.DelegatorSharesAccumulators.Get(ctx, collections.Join3(int32(delType), targetID, serviceID))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.DelegatorSharesAccumulator{}, false, nil
+		}
+		return types.DelegatorSharesAccumulator{}, false, err
+	}
+	return accumulator, true, nil
+}
+
+// SetDelegatorSharesAccumulator stores the given delegator shares accumulator.
+func (k *Keeper) SetDelegatorSharesAccumulator(ctx context.Context, accumulator types.DelegatorSharesAccumulator) error {
+	key := collections.Join3(int32(accumulator.DelegationType), accumulator.DelegationTargetID, accumulator.ServiceID)
+	return k.DelegatorSharesAccumulators.Set(ctx, key, accumulator)
+}
+
+// accumulateDelegatorShares accumulates the given delegator shares for the time
+// elapsed since they have last changed. It must be called before the shares
+// change. The shares are accumulated only when rewards are allocated in
+// epochs, and the accumulators are cleared each time rewards are allocated.
+func (k *Keeper) accumulateDelegatorShares(
+	ctx context.Context, delType restakingtypes.DelegationType, targetID uint32, serviceID uint32, shares sdk.DecCoins,
+) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// When rewards are allocated every block there's nothing to accumulate
+	if params.AllocationEpochDuration == 0 {
+		return nil
+	}
+
+	accumulator, found, err := k.GetDelegatorSharesAccumulator(ctx, delType, targetID, serviceID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		lastAllocationTime, err := k.GetLastRewardsAllocationTime(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Rewards have never been allocated, so the first allocation will not
+		// distribute anything
+		if lastAllocationTime == nil {
+			return nil
+		}
+
+		// The shares have not changed since the last rewards allocation
+		accumulator = types.NewDelegatorSharesAccumulator(delType, targetID, serviceID, *lastAllocationTime)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.SetDelegatorSharesAccumulator(ctx, accumulator.Accumulate(shares, sdkCtx.BlockTime()))
+}
+
+// accumulateDelegationTargetShares accumulates the current delegator shares of
+// the given delegation target. The shares of pools are not accumulated here,
+// since they are accumulated for each service when the pool-service total
+// delegator shares change.
+func (k *Keeper) accumulateDelegationTargetShares(ctx context.Context, target DelegationTarget) error {
+	if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL {
+		return nil
+	}
+	return k.accumulateDelegatorShares(ctx, target.DelegationType, target.GetID(), 0, target.GetDelegatorShares())
+}
+
+// getTimeWeightedTrustedTokens returns the tokens of a delegation target that
+// are trusted by the given service, weighted by the time during which they have
+// been delegated since the last rewards allocation. If the delegations have not
+// changed since then, the current trusted tokens are returned instead.
+func (k *Keeper) getTimeWeightedTrustedTokens(ctx context.Context, serviceID uint32, target DelegationTarget) (sdk.Coins, error) {
+	accumulatorServiceID := uint32(0)
+	shares := target.GetDelegatorShares()
+	if target.DelegationType == restakingtypes.DELEGATION_TYPE_POOL {
+		var err error
+		accumulatorServiceID = serviceID
+		shares, err = k.GetPoolServiceTotalDelegatorShares(ctx, target.GetID(), serviceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accumulator, found, err := k.GetDelegatorSharesAccumulator(ctx, target.DelegationType, target.GetID(), accumulatorServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return k.GetDelegationTargetTrustedTokens(ctx, serviceID, target)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeWeightedShares := accumulator.GetTimeWeightedShares(shares, sdkCtx.BlockTime())
+
+	// Only keep the denoms that are still delegated, since the rewards are
+	// distributed among the current delegators
+	timeWeightedShares = utils.FilterDecCoinsByDenom(timeWeightedShares, shares)
+
+	tokens, _ := target.TokensFromSharesTruncated(timeWeightedShares).TruncateDecimal()
+	return tokens, nil
+}
@@ -209,6 +209,13 @@ func (k *Keeper) IncrementDelegationTargetPeriod(ctx context.Context, target Del
 		return 0, err
 	}
 
+	// record when the new period has started, so that the rewards of the
+	// current allocation epoch can be split based on it
+	err = k.recordPeriodStartTime(ctx, target, rewards.Period)
+	if err != nil {
+		return 0, err
+	}
+
 	return rewards.Period, nil
 }
 
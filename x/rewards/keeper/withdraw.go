@@ -9,8 +9,11 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
 
@@ -29,6 +32,109 @@ func (k *Keeper) SetWithdrawAddress(ctx context.Context, addr, withdrawAddr sdk.
 	return nil
 }
 
+// SetIBCWithdrawDestination sets the destination on another chain to which the
+// rewards of the given delegator are sent. If the given destination is nil,
+// the rewards are no longer sent to another chain.
+func (k *Keeper) SetIBCWithdrawDestination(
+	ctx context.Context, addr sdk.AccAddress, destination *types.IBCWithdrawDestination,
+) error {
+	if destination == nil {
+		return k.DelegatorIBCWithdrawDestinations.Remove(ctx, addr)
+	}
+	return k.DelegatorIBCWithdrawDestinations.Set(ctx, addr, *destination)
+}
+
+// GetIBCWithdrawDestination returns the destination on another chain to which
+// the rewards of the given delegator are sent. If the delegator has not set
+// any destination, nil is returned instead.
+func (k *Keeper) GetIBCWithdrawDestination(ctx context.Context, addr sdk.AccAddress) (*types.IBCWithdrawDestination, error) {
+	destination, err := k.DelegatorIBCWithdrawDestinations.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &destination, nil
+}
+
+// sendRewardsOverIBC sends the given rewards, which have already been withdrawn
+// to the fallback address, to the IBC withdraw destination of the given
+// delegator, if any. The transfers are performed on behalf of the fallback
+// address so that, if a packet is acknowledged with an error or times out,
+// the transfer module refunds the rewards to it. If a transfer cannot be
+// sent, the rewards are left to the fallback address.
+func (k *Keeper) sendRewardsOverIBC(
+	ctx context.Context, delAddr sdk.AccAddress, fallbackAddr sdk.AccAddress, rewards sdk.Coins,
+) error {
+	if k.transferKeeper == nil || rewards.IsZero() {
+		return nil
+	}
+
+	destination, err := k.GetIBCWithdrawDestination(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if destination == nil {
+		return nil
+	}
+
+	sender, err := k.accountKeeper.AddressCodec().BytesToString(fallbackAddr)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeoutTimestamp := uint64(sdkCtx.BlockTime().Add(destination.Timeout).UnixNano())
+
+	// Send the transfers using a cached context so that nothing is sent if
+	// any of them fails
+	cachedCtx, writeCache := sdkCtx.CacheContext()
+	for _, coin := range rewards {
+		msg := ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID,
+			destination.SourceChannel,
+			coin,
+			sender,
+			destination.Receiver,
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+			"",
+		)
+		_, err = k.transferKeeper.Transfer(cachedCtx, msg)
+		if err != nil {
+			break
+		}
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to send rewards over IBC",
+			"sender", sender,
+			"source_channel", destination.SourceChannel,
+			"receiver", destination.Receiver,
+			"amount", rewards.String(),
+			"error", err,
+		)
+	} else {
+		writeCache()
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, destination.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyReceiver, destination.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprint(err == nil)),
+		),
+	)
+
+	return nil
+}
+
 // WithdrawDelegationRewards withdraws the rewards from the delegation and reinitializes it
 func (k *Keeper) WithdrawDelegationRewards(
 	ctx context.Context, delAddr sdk.AccAddress, target DelegationTarget,
@@ -63,6 +169,49 @@ func (k *Keeper) WithdrawDelegationRewards(
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards from all the delegations
+// of the given delegator. If the given delegation type is not
+// DELEGATION_TYPE_UNSPECIFIED, only the delegations of that type are
+// considered. The rewards withdrawn from each delegation target are returned.
+func (k *Keeper) WithdrawAllDelegationRewards(
+	ctx context.Context, delAddr sdk.AccAddress, delType restakingtypes.DelegationType,
+) ([]types.WithdrawnDelegationReward, error) {
+	delegator, err := k.accountKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the delegations first so that the rewards are not withdrawn
+	// while iterating over the store
+	var delegations []restakingtypes.Delegation
+	err = k.restakingKeeper.IterateUserDelegations(ctx, delegator, func(del restakingtypes.Delegation) (stop bool, err error) {
+		if delType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED || del.Type == delType {
+			delegations = append(delegations, del)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawn := make([]types.WithdrawnDelegationReward, 0, len(delegations))
+	for _, delegation := range delegations {
+		target, err := k.GetDelegationTarget(ctx, delegation.Type, delegation.TargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, target)
+		if err != nil {
+			return nil, err
+		}
+
+		withdrawn = append(withdrawn, types.NewWithdrawnDelegationReward(delegation.Type, delegation.TargetID, rewards.Sum()))
+	}
+
+	return withdrawn, nil
+}
+
 // WithdrawOperatorCommission withdraws the operator's accumulated commission
 func (k *Keeper) WithdrawOperatorCommission(ctx context.Context, operatorID uint32) (types.Pools, error) {
 	operator, err := k.operatorsKeeper.GetOperator(ctx, operatorID)
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/keeper"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+// mockTransferKeeper is a types.TransferKeeper that escrows the transferred
+// tokens without sending any packet
+type mockTransferKeeper struct {
+	bankKeeper bankkeeper.BaseKeeper
+	err        error
+	msgs       []*ibctransfertypes.MsgTransfer
+}
+
+func (m *mockTransferKeeper) Transfer(
+	ctx context.Context, msg *ibctransfertypes.MsgTransfer,
+) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAddr := ibctransfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+	err = m.bankKeeper.SendCoins(ctx, sender, escrowAddr, sdk.NewCoins(msg.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	m.msgs = append(m.msgs, msg)
+	return &ibctransfertypes.MsgTransferResponse{}, nil
+}
+
+func (suite *KeeperTestSuite) TestKeeper_WithdrawDelegationRewards_IBCDestination() {
+	delegator := "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"
+	withdrawer := "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"
+
+	testCases := []struct {
+		name        string
+		transferErr error
+		setupIBC    bool
+		check       func(ctx sdk.Context, transferKeeper *mockTransferKeeper)
+	}{
+		{
+			name:     "rewards are sent to the withdraw address without ibc destination",
+			setupIBC: false,
+			check: func(ctx sdk.Context, transferKeeper *mockTransferKeeper) {
+				suite.Require().Empty(transferKeeper.msgs)
+
+				withdrawAddr, err := sdk.AccAddressFromBech32(withdrawer)
+				suite.Require().NoError(err)
+				suite.Require().Equal("11574service", suite.bankKeeper.GetAllBalances(ctx, withdrawAddr).String())
+			},
+		},
+		{
+			name:     "rewards are sent over ibc properly",
+			setupIBC: true,
+			check: func(ctx sdk.Context, transferKeeper *mockTransferKeeper) {
+				suite.Require().Len(transferKeeper.msgs, 1)
+
+				msg := transferKeeper.msgs[0]
+				suite.Require().Equal(ibctransfertypes.PortID, msg.SourcePort)
+				suite.Require().Equal("channel-0", msg.SourceChannel)
+				suite.Require().Equal(withdrawer, msg.Sender)
+				suite.Require().Equal("osmo1receiver", msg.Receiver)
+				suite.Require().Equal("11574service", msg.Token.String())
+				suite.Require().Equal(uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), msg.TimeoutTimestamp)
+
+				// The fallback address should not hold the rewards
+				withdrawAddr, err := sdk.AccAddressFromBech32(withdrawer)
+				suite.Require().NoError(err)
+				suite.Require().True(suite.bankKeeper.GetAllBalances(ctx, withdrawAddr).IsZero())
+
+				escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+				suite.Require().Equal("11574service", suite.bankKeeper.GetAllBalances(ctx, escrowAddr).String())
+			},
+		},
+		{
+			name:        "rewards are left to the fallback address if the transfer fails",
+			setupIBC:    true,
+			transferErr: fmt.Errorf("channel not found"),
+			check: func(ctx sdk.Context, transferKeeper *mockTransferKeeper) {
+				suite.Require().Empty(transferKeeper.msgs)
+
+				withdrawAddr, err := sdk.AccAddressFromBech32(withdrawer)
+				suite.Require().NoError(err)
+				suite.Require().Equal("11574service", suite.bankKeeper.GetAllBalances(ctx, withdrawAddr).String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			transferKeeper := &mockTransferKeeper{bankKeeper: suite.bankKeeper, err: tc.transferErr}
+			suite.keeper.SetTransferKeeper(transferKeeper)
+			defer suite.keeper.SetTransferKeeper(nil)
+
+			service, _ := suite.setupSampleServiceAndOperator(ctx)
+			suite.CreateBasicRewardsPlan(
+				ctx,
+				service.ID,
+				utils.MustParseCoins("100_000000service"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				utils.MustParseCoins("10000_000000service"),
+			)
+			suite.DelegateService(ctx, service.ID, utils.MustParseCoins("100_000000umilk"), delegator, true)
+
+			var ibcDestination *types.IBCWithdrawDestination
+			if tc.setupIBC {
+				destination := types.NewIBCWithdrawDestination("channel-0", "osmo1receiver", time.Hour)
+				ibcDestination = &destination
+			}
+
+			msgServer := keeper.NewMsgServer(suite.keeper)
+			_, err := msgServer.SetWithdrawAddress(ctx, types.NewMsgSetWithdrawAddress(withdrawer, ibcDestination, delegator))
+			suite.Require().NoError(err)
+
+			ctx = suite.allocateRewards(ctx, 10*time.Second)
+
+			_, err = msgServer.WithdrawDelegatorReward(
+				ctx,
+				types.NewMsgWithdrawDelegatorReward(restakingtypes.DELEGATION_TYPE_SERVICE, service.ID, delegator),
+			)
+			suite.Require().NoError(err)
+
+			tc.check(ctx, transferKeeper)
+		})
+	}
+}
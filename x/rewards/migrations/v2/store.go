@@ -115,11 +115,14 @@ func mapRewardsPlan(plan RewardsPlan, id uint64, amountPerDay sdk.Coin) types.Re
 		id,
 		plan.Description,
 		plan.ServiceID,
-		amountPerDay,
+		sdk.NewCoins(amountPerDay),
 		plan.StartTime,
 		plan.EndTime,
 		plan.PoolsDistribution,
 		plan.OperatorsDistribution,
 		plan.UsersDistribution,
+		nil,
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+		nil,
 	)
 }
@@ -51,23 +51,29 @@ func Test_MigratePlan(t *testing.T) {
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service1"),
+					utils.MustParseCoins("100_000000service1"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				types.NewRewardsPlan(
 					3,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service2"),
+					utils.MustParseCoins("100_000000service2"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 			},
 			check: func(ctx sdk.Context) {
@@ -116,34 +122,43 @@ func Test_MigratePlan(t *testing.T) {
 					2,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service1"),
+					utils.MustParseCoins("100_000000service1"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				types.NewRewardsPlan(
 					10,
 					"Plan 10",
 					10,
-					utils.MustParseCoin("100_000000service10"),
+					utils.MustParseCoins("100_000000service10"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 				types.NewRewardsPlan(
 					11,
 					"Plan 2",
 					2,
-					utils.MustParseCoin("100_000000service2"),
+					utils.MustParseCoins("100_000000service2"),
 					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
 					types.NewEgalitarianPoolsDistribution(1),
 					types.NewEgalitarianOperatorsDistribution(1),
 					types.NewBasicUsersDistribution(1),
+					nil,
+					types.INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+					nil,
 				),
 			},
 			check: func(ctx sdk.Context) {
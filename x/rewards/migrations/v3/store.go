@@ -0,0 +1,60 @@
+package v3
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+type Keeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+	SetParams(ctx context.Context, params types.Params) error
+	GetRewardsPlans(ctx context.Context) ([]types.RewardsPlan, error)
+	SaveRewardsPlan(ctx context.Context, plan types.RewardsPlan) error
+}
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migrations include:
+// - Set the default values of the auto-compound and allocation epoch params
+// - Index the existing rewards plans by their end time
+func MigrateStore(ctx sdk.Context, k Keeper) error {
+	err := setNewParams(ctx, k)
+	if err != nil {
+		return err
+	}
+
+	return indexRewardsPlans(ctx, k)
+}
+
+// setNewParams sets the auto-compound interval, auto-compound max gas per block and
+// allocation epoch duration params, which did not exist in v2, to their default values
+func setNewParams(ctx sdk.Context, k Keeper) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.AutoCompoundInterval = types.DefaultAutoCompoundInterval
+	params.AutoCompoundMaxGasPerBlock = types.DefaultAutoCompoundMaxGasPerBlock
+	params.AllocationEpochDuration = types.DefaultAllocationEpochDuration
+	return k.SetParams(ctx, params)
+}
+
+// indexRewardsPlans stores again all the existing rewards plans so that they
+// get indexed by their end time
+func indexRewardsPlans(ctx sdk.Context, k Keeper) error {
+	plans, err := k.GetRewardsPlans(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		err = k.SaveRewardsPlan(ctx, plan)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/milkyway-labs/milkyway/v12/x/rewards/migrations/v3"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/testutils"
+	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
+)
+
+func TestMigrateStore_setNewParams(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+	ctx, _ := testData.Context.CacheContext()
+
+	// Store the params as they were stored before the migration
+	err := testData.Keeper.SetParams(ctx, types.Params{
+		RewardsPlanCreationFee: sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+	})
+	require.NoError(t, err)
+
+	err = v3.MigrateStore(ctx, testData.Keeper)
+	require.NoError(t, err)
+
+	params, err := testData.Keeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// Make sure the existing params have been kept
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))), params.RewardsPlanCreationFee)
+
+	// Make sure the new params have been set to their default values
+	require.Equal(t, types.DefaultAutoCompoundInterval, params.AutoCompoundInterval)
+	require.Equal(t, uint64(types.DefaultAutoCompoundMaxGasPerBlock), params.AutoCompoundMaxGasPerBlock)
+	require.Equal(t, time.Duration(types.DefaultAllocationEpochDuration), params.AllocationEpochDuration)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrateStore_indexRewardsPlans(t *testing.T) {
+	testData := testutils.NewKeeperTestData(t)
+	ctx, _ := testData.Context.CacheContext()
+
+	err := testData.Keeper.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, err)
+
+	// Store the plan as it was stored before the migration, without any index
+	plan := types.NewRewardsPlan(
+		1,
+		"Plan",
+		1,
+		sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(100_000_000))),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.NewBasicPoolsDistribution(1),
+		types.NewBasicOperatorsDistribution(1),
+		types.NewBasicUsersDistribution(1),
+		nil,
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+	)
+	store := testData.StoreService.OpenKVStore(ctx)
+	err = store.Set(append(types.RewardsPlanKeyPrefix, sdk.Uint64ToBigEndian(plan.ID)...), testData.Cdc.MustMarshal(&plan))
+	require.NoError(t, err)
+
+	err = v3.MigrateStore(ctx, testData.Keeper)
+	require.NoError(t, err)
+
+	// Make sure the plan has been indexed by its end time
+	var indexedPlansIDs []uint64
+	err = testData.Keeper.RewardsPlans.Indexes.EndTime.Walk(ctx, nil, func(endTime time.Time, planID uint64) (stop bool, err error) {
+		require.Equal(t, plan.EndTime, endTime)
+		indexedPlansIDs = append(indexedPlansIDs, planID)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{plan.ID}, indexedPlansIDs)
+}
@@ -169,12 +169,15 @@ func SimulateMsgCreateRewardsPlan(
 		msg = types.NewMsgCreateRewardsPlan(
 			service.ID,
 			simtypes.RandStringOfLength(r, 32),
-			amount[0],
+			amount,
 			rewardsStart,
 			rewardsEnd,
 			RandomDistribution(r, restakingtypes.DELEGATION_TYPE_POOL, pool),
 			RandomDistribution(r, restakingtypes.DELEGATION_TYPE_OPERATOR, operator),
 			RandomUsersDistribution(r),
+			RandomEmissionSchedule(r),
+			RandomInsufficientFundsBehavior(r),
+			nil,
 			rewardsParams.RewardsPlanCreationFee,
 			service.Admin,
 		)
@@ -247,12 +250,15 @@ func SimulateMsgEditRewardsPlan(
 		msg = types.NewMsgEditRewardsPlan(
 			plan.ID,
 			simtypes.RandStringOfLength(r, 32),
-			amount[0],
+			amount,
 			rewardsStart,
 			rewardsEnd,
 			RandomDistribution(r, restakingtypes.DELEGATION_TYPE_POOL, pool),
 			RandomDistribution(r, restakingtypes.DELEGATION_TYPE_OPERATOR, operator),
 			RandomUsersDistribution(r),
+			RandomEmissionSchedule(r),
+			RandomInsufficientFundsBehavior(r),
+			nil,
 			service.Admin,
 		)
 		return simtesting.SendMsg(r, types.ModuleName, app, ak, bk, msg, ctx, signer)
@@ -270,7 +276,7 @@ func SimulateMsgSetWithdrawAddress(ak authkeeper.AccountKeeper, bk bankkeeper.Ke
 		}
 
 		sender, _ := simtypes.RandomAcc(r, accs)
-		msg = types.NewMsgSetWithdrawAddress(sender.Address.String(), sender.Address.String())
+		msg = types.NewMsgSetWithdrawAddress(sender.Address.String(), nil, sender.Address.String())
 		return simtesting.SendMsg(r, types.ModuleName, app, ak, bk, msg, ctx, sender)
 	}
 }
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,7 +25,12 @@ func RandomParams(r *rand.Rand, rewardsPlanCreationFeeDenoms []string) types.Par
 			sdk.NewInt64Coin(denom, r.Int63()))
 	}
 
-	return types.NewParams(rewardsPlanCreationFees)
+	return types.NewParams(
+		rewardsPlanCreationFees,
+		time.Duration(r.Int63n(int64(7*24*time.Hour))),
+		uint64(r.Int63n(10_000_000)+1),
+		time.Duration(r.Int63n(int64(time.Hour))),
+	)
 }
 
 func RandomDistributionType(r *rand.Rand) types.DistributionType {
@@ -69,6 +75,26 @@ func RandomUsersDistribution(r *rand.Rand) types.UsersDistribution {
 	return types.NewUsersDistribution(r.Uint32(), RandomUsersDistributionType(r))
 }
 
+func RandomEmissionSchedule(r *rand.Rand) types.EmissionSchedule {
+	switch r.Intn(4) {
+	case 0:
+		return types.NewEmissionScheduleLinearDecay(simtypes.RandomDecAmount(r, math.LegacyOneDec()))
+	case 1:
+		return types.NewEmissionScheduleHalving(time.Duration(simtypes.RandIntBetween(r, 1, 365)) * 24 * time.Hour)
+	case 2:
+		return types.NewEmissionScheduleCliff(
+			time.Duration(simtypes.RandIntBetween(r, 1, 365))*24*time.Hour,
+			simtypes.RandomDecAmount(r, math.LegacyNewDec(10)),
+		)
+	default:
+		return nil
+	}
+}
+
+func RandomInsufficientFundsBehavior(r *rand.Rand) types.InsufficientFundsBehavior {
+	return types.InsufficientFundsBehavior(r.Intn(len(types.InsufficientFundsBehavior_name)))
+}
+
 func RandomRewardsPlan(
 	r *rand.Rand,
 	serviceID uint32,
@@ -76,7 +102,7 @@ func RandomRewardsPlan(
 	operators []operatorstypes.Operator,
 	amtPerDeyDenom string,
 ) types.RewardsPlan {
-	randomAmountPerDays := sdk.NewInt64Coin(amtPerDeyDenom, r.Int63())
+	randomAmountPerDays := sdk.NewCoins(sdk.NewInt64Coin(amtPerDeyDenom, r.Int63()))
 	randomPool := simtesting.RandomSliceElement(r, pools)
 	randomOperator := simtesting.RandomSliceElement(r, operators)
 
@@ -90,6 +116,9 @@ func RandomRewardsPlan(
 		RandomDistribution(r, restakingtypes.DELEGATION_TYPE_POOL, randomPool),
 		RandomDistribution(r, restakingtypes.DELEGATION_TYPE_OPERATOR, randomOperator),
 		RandomUsersDistribution(r),
+		RandomEmissionSchedule(r),
+		RandomInsufficientFundsBehavior(r),
+		nil,
 	)
 }
 
@@ -97,6 +97,8 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 	data.AssetsKeeper = assetskeeper.NewKeeper(
 		data.Cdc,
 		runtime.NewKVStoreService(data.Keys[assetstypes.StoreKey]),
+		data.AccountKeeper,
+		&data.OracleKeeper,
 		data.AuthorityAddress,
 	)
 	data.RestakingKeeper = restakingkeeper.NewKeeper(
@@ -107,7 +109,6 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 		data.PoolsKeeper,
 		data.OperatorsKeeper,
 		data.ServicesKeeper,
-		&data.OracleKeeper,
 		data.AssetsKeeper,
 		data.AuthorityAddress,
 	)
@@ -119,7 +120,6 @@ func NewKeeperTestData(t *testing.T) KeeperTestData {
 		data.AccountKeeper,
 		data.BankKeeper,
 		data.DistributionKeeper,
-		&data.OracleKeeper,
 		data.PoolsKeeper,
 		data.OperatorsKeeper,
 		data.ServicesKeeper,
@@ -14,7 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEditRewardsPlan{}, "milkyway/MsgEditRewardsPlan")
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "milkyway/MsgSetWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawDelegatorReward{}, "milkyway/MsgWithdrawDelegatorReward")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "milkyway/MsgWithdrawAllDelegatorRewards")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawOperatorCommission{}, "milkyway/MsgWithdrawOperatorCommission")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "milkyway/MsgSetAutoCompound")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "milkyway/rewards/MsgUpdateParams")
 
 	cdc.RegisterInterface((*DistributionType)(nil), nil)
@@ -24,6 +26,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterInterface((*UsersDistributionType)(nil), nil)
 	cdc.RegisterConcrete(&UsersDistributionTypeBasic{}, "milkyway/UsersDistributionTypeBasic", nil)
+
+	cdc.RegisterInterface((*EmissionSchedule)(nil), nil)
+	cdc.RegisterConcrete(&EmissionScheduleLinearDecay{}, "milkyway/EmissionScheduleLinearDecay", nil)
+	cdc.RegisterConcrete(&EmissionScheduleHalving{}, "milkyway/EmissionScheduleHalving", nil)
+	cdc.RegisterConcrete(&EmissionScheduleStep{}, "milkyway/EmissionScheduleStep", nil)
+	cdc.RegisterConcrete(&EmissionScheduleCliff{}, "milkyway/EmissionScheduleCliff", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -33,7 +41,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditRewardsPlan{},
 		&MsgSetWithdrawAddress{},
 		&MsgWithdrawDelegatorReward{},
+		&MsgWithdrawAllDelegatorRewards{},
 		&MsgWithdrawOperatorCommission{},
+		&MsgSetAutoCompound{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterInterface(
@@ -48,6 +58,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*UsersDistributionType)(nil),
 		&UsersDistributionTypeBasic{},
 	)
+	registry.RegisterInterface(
+		"milkyway.rewards.v1.EmissionSchedule",
+		(*EmissionSchedule)(nil),
+		&EmissionScheduleLinearDecay{},
+		&EmissionScheduleHalving{},
+		&EmissionScheduleStep{},
+		&EmissionScheduleCliff{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
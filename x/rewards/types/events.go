@@ -9,12 +9,21 @@ const (
 	EventTypeWithdrawRewards      = "withdraw_rewards"
 	EventTypeWithdrawCommission   = "withdraw_commission"
 	EventTypeTerminateRewardsPlan = "terminate_rewards_plan"
+	EventTypeSetAutoCompound      = "set_auto_compound"
+	EventTypeAutoCompound         = "auto_compound"
+	EventTypeIBCWithdrawRewards   = "ibc_withdraw_rewards"
 
 	AttributeKeyRewardsPlanID      = "rewards_plan_id"
 	AttributeKeyWithdrawAddress    = "withdraw_address"
 	AttributeKeyDelegationType     = "delegation_type"
 	AttributeKeyDelegationTargetID = "delegation_target_id"
 	AttributeKeyRemainingRewards   = "remaining_rewards"
+	AttributeKeyEnabled            = "enabled"
+	AttributeKeyCompoundedAmount   = "compounded_amount"
+	AttributeKeyWithdrawnAmount    = "withdrawn_amount"
+	AttributeKeySourceChannel      = "source_channel"
+	AttributeKeyReceiver           = "receiver"
+	AttributeKeySuccess            = "success"
 
 	// AttributeKeyAmountPerPool represents the amount of rewards per pool (per denom).
 	// See https://github.com/initia-labs/initia/blob/v0.2.10/x/distribution/types/events.go#L3-L6
@@ -2,11 +2,12 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
-	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	assetstypes "github.com/milkyway-labs/milkyway/v12/x/assets/types"
 	operatorstypes "github.com/milkyway-labs/milkyway/v12/x/operators/types"
@@ -38,11 +39,6 @@ type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
-type OracleKeeper interface {
-	GetPriceWithNonceForCurrencyPair(ctx sdk.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePriceWithNonce, error)
-	GetDecimalsForCurrencyPair(ctx sdk.Context, cp connecttypes.CurrencyPair) (decimals uint64, err error)
-}
-
 type PoolsKeeper interface {
 	GetPool(ctx context.Context, poolID uint32) (poolstypes.Pool, error)
 	GetPools(ctx context.Context) ([]poolstypes.Pool, error)
@@ -53,6 +49,7 @@ type OperatorsKeeper interface {
 	GetOperator(ctx context.Context, operatorID uint32) (operatorstypes.Operator, error)
 	IterateOperators(ctx context.Context, cb func(operator operatorstypes.Operator) (stop bool, err error)) error
 	GetOperatorParams(ctx context.Context, operatorID uint32) (operatorstypes.OperatorParams, error)
+	GetEffectiveCommissionRate(ctx context.Context, operatorID uint32) (math.LegacyDec, error)
 }
 
 type ServicesKeeper interface {
@@ -60,16 +57,20 @@ type ServicesKeeper interface {
 	GetServiceParams(ctx context.Context, serviceID uint32) (servicestypes.ServiceParams, error)
 	GetServices(ctx context.Context) ([]servicestypes.Service, error)
 	IterateServices(ctx context.Context, cb func(service servicestypes.Service) (stop bool, err error)) error
+	HasServicePermission(ctx context.Context, service servicestypes.Service, address string, role servicestypes.ServiceRole) (bool, error)
 }
 
 type RestakingKeeper interface {
 	IterateServiceValidatingOperators(ctx context.Context, serviceID uint32, action func(operatorID uint32) (stop bool, err error)) error
 	CanOperatorValidateService(ctx context.Context, serviceID uint32, operatorID uint32) (bool, error)
+	IsOperatorJailedFromService(ctx context.Context, serviceID uint32, operatorID uint32) (bool, error)
 	IsServiceSecuredByPool(ctx context.Context, serviceID uint32, poolID uint32) (bool, error)
 	GetRestakableDenoms(ctx context.Context) ([]string, error)
 	GetPoolDelegation(ctx context.Context, poolID uint32, userAddress string) (restakingtypes.Delegation, bool, error)
 	GetDelegationForTarget(ctx context.Context, target restakingtypes.DelegationTarget, delegator string) (restakingtypes.Delegation, bool, error)
 
+	IterateUserDelegations(ctx context.Context, userAddress string, cb func(del restakingtypes.Delegation) (stop bool, err error)) error
+
 	IterateUserPoolDelegations(ctx context.Context, userAddress string, cb func(del restakingtypes.Delegation) (stop bool, err error)) error
 	IterateAllPoolDelegations(ctx context.Context, cb func(del restakingtypes.Delegation) (stop bool, err error)) error
 
@@ -81,8 +82,16 @@ type RestakingKeeper interface {
 	IterateAllServiceDelegations(ctx context.Context, cb func(del restakingtypes.Delegation) (stop bool, err error)) error
 
 	GetUserPreferences(ctx context.Context, userAddress string) (restakingtypes.UserPreferences, error)
+
+	DelegateToPool(ctx context.Context, amount sdk.Coin, delegator string) (sdk.DecCoins, error)
+	DelegateToOperator(ctx context.Context, operatorID uint32, amount sdk.Coins, delegator string) (sdk.DecCoins, error)
+	DelegateToService(ctx context.Context, serviceID uint32, amount sdk.Coins, delegator string) (sdk.DecCoins, error)
+}
+
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
 }
 
 type AssetsKeeper interface {
-	GetAsset(ctx context.Context, denom string) (assetstypes.Asset, error)
+	GetAssetPrice(ctx context.Context, denom string) (assetstypes.Asset, math.LegacyDec, time.Time, error)
 }
@@ -20,6 +20,14 @@ func NewGenesisState(
 	servicesRecords DelegationTypeRecords,
 	operatorAccumulatedCommissionRecords []OperatorAccumulatedCommissionRecord,
 	poolServiceTotalDelShares []PoolServiceTotalDelegatorShares,
+	rewardsPlansAccounting []RewardsPlanAccounting,
+	archivedRewardsPlans []ArchivedRewardsPlan,
+	autoCompoundSettings []AutoCompoundSetting,
+	nextAutoCompoundTime *time.Time,
+	delegatorSharesAccumulators []DelegatorSharesAccumulator,
+	periodStartTimes []PeriodStartTime,
+	delegatorEpochClaims []DelegatorEpochClaim,
+	pendingEpochClaimPayouts []DelegatorEpochClaim,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -32,6 +40,14 @@ func NewGenesisState(
 		ServicesRecords:                 servicesRecords,
 		OperatorAccumulatedCommissions:  operatorAccumulatedCommissionRecords,
 		PoolServiceTotalDelegatorShares: poolServiceTotalDelShares,
+		RewardsPlansAccounting:          rewardsPlansAccounting,
+		ArchivedRewardsPlans:            archivedRewardsPlans,
+		AutoCompoundSettings:            autoCompoundSettings,
+		NextAutoCompoundTime:            nextAutoCompoundTime,
+		DelegatorSharesAccumulators:     delegatorSharesAccumulators,
+		PeriodStartTimes:                periodStartTimes,
+		DelegatorEpochClaims:            delegatorEpochClaims,
+		PendingEpochClaimPayouts:        pendingEpochClaimPayouts,
 	}
 }
 
@@ -50,6 +66,14 @@ func DefaultGenesis() *GenesisState {
 			[]DelegatorStartingInfoRecord{}),
 		[]OperatorAccumulatedCommissionRecord{},
 		[]PoolServiceTotalDelegatorShares{},
+		[]RewardsPlanAccounting{},
+		[]ArchivedRewardsPlan{},
+		[]AutoCompoundSetting{},
+		nil,
+		[]DelegatorSharesAccumulator{},
+		[]PeriodStartTime{},
+		[]DelegatorEpochClaim{},
+		[]DelegatorEpochClaim{},
 	)
 }
 
@@ -77,12 +101,111 @@ func (genState *GenesisState) Validate(unpacker codectypes.AnyUnpacker) error {
 		}
 	}
 
+	for i, info := range genState.DelegatorWithdrawInfos {
+		if info.IBCDestination != nil {
+			err = info.IBCDestination.Validate()
+			if err != nil {
+				return fmt.Errorf("invalid ibc withdraw destination at index %d: %w", i, err)
+			}
+		}
+	}
+
 	for _, shares := range genState.PoolServiceTotalDelegatorShares {
 		err = shares.Validate()
 		if err != nil {
 			return fmt.Errorf("invalid pool service total delegator shares: %w", err)
 		}
 	}
+
+	// Check for duplicate rewards plans accounting
+	if duplicate := findDuplicateRewardsPlansAccounting(genState.RewardsPlansAccounting); duplicate != nil {
+		return fmt.Errorf("duplicated rewards plan accounting: %d", duplicate.PlanID)
+	}
+
+	for i, accounting := range genState.RewardsPlansAccounting {
+		err = accounting.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid rewards plan accounting at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate archived rewards plans
+	if duplicate := findDuplicateArchivedRewardsPlans(genState.ArchivedRewardsPlans); duplicate != nil {
+		return fmt.Errorf("duplicated archived rewards plan: %d", duplicate.Plan.ID)
+	}
+
+	for i, archived := range genState.ArchivedRewardsPlans {
+		err = archived.Validate(unpacker)
+		if err != nil {
+			return fmt.Errorf("invalid archived rewards plan at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate auto-compound settings
+	if duplicate := findDuplicateAutoCompoundSettings(genState.AutoCompoundSettings); duplicate != nil {
+		return fmt.Errorf("duplicated auto-compound setting: %s, %d, %d",
+			duplicate.DelegatorAddress, duplicate.DelegationType, duplicate.DelegationTargetID)
+	}
+
+	for i, setting := range genState.AutoCompoundSettings {
+		err = setting.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid auto-compound setting at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate delegator shares accumulators
+	if duplicate := findDuplicateDelegatorSharesAccumulators(genState.DelegatorSharesAccumulators); duplicate != nil {
+		return fmt.Errorf("duplicated delegator shares accumulator: %d, %d, %d",
+			duplicate.DelegationType, duplicate.DelegationTargetID, duplicate.ServiceID)
+	}
+
+	for i, accumulator := range genState.DelegatorSharesAccumulators {
+		err = accumulator.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid delegator shares accumulator at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate period start times
+	if duplicate := findDuplicatePeriodStartTimes(genState.PeriodStartTimes); duplicate != nil {
+		return fmt.Errorf("duplicated period start time: %d, %d, %d",
+			duplicate.DelegationType, duplicate.DelegationTargetID, duplicate.Period)
+	}
+
+	for i, startTime := range genState.PeriodStartTimes {
+		err = startTime.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid period start time at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate delegator epoch claims
+	if duplicate := findDuplicateDelegatorEpochClaims(genState.DelegatorEpochClaims); duplicate != nil {
+		return fmt.Errorf("duplicated delegator epoch claim: %d, %d, %s",
+			duplicate.DelegationType, duplicate.DelegationTargetID, duplicate.DelegatorAddress)
+	}
+
+	for i, claim := range genState.DelegatorEpochClaims {
+		err = claim.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid delegator epoch claim at index %d: %w", i, err)
+		}
+	}
+
+	// Check for duplicate pending epoch claim payouts
+	if duplicate := findDuplicateDelegatorEpochClaims(genState.PendingEpochClaimPayouts); duplicate != nil {
+		return fmt.Errorf("duplicated pending epoch claim payout: %d, %d, %s",
+			duplicate.DelegationType, duplicate.DelegationTargetID, duplicate.DelegatorAddress)
+	}
+
+	for i, claim := range genState.PendingEpochClaimPayouts {
+		err = claim.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid pending epoch claim payout at index %d: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -105,3 +228,59 @@ func findDuplicateRewardsPlans(plans []RewardsPlan) *RewardsPlan {
 		return a.ID == b.ID
 	})
 }
+
+// findDuplicateRewardsPlansAccounting returns the first duplicated rewards plan
+// accounting in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicateRewardsPlansAccounting(accountings []RewardsPlanAccounting) *RewardsPlanAccounting {
+	return utils.FindDuplicateFunc(accountings, func(a, b RewardsPlanAccounting) bool {
+		return a.PlanID == b.PlanID
+	})
+}
+
+// findDuplicateArchivedRewardsPlans returns the first duplicated archived rewards
+// plan in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicateArchivedRewardsPlans(plans []ArchivedRewardsPlan) *ArchivedRewardsPlan {
+	return utils.FindDuplicateFunc(plans, func(a, b ArchivedRewardsPlan) bool {
+		return a.Plan.ID == b.Plan.ID
+	})
+}
+
+// findDuplicateAutoCompoundSettings returns the first duplicated auto-compound
+// setting in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicateAutoCompoundSettings(settings []AutoCompoundSetting) *AutoCompoundSetting {
+	return utils.FindDuplicateFunc(settings, func(a, b AutoCompoundSetting) bool {
+		return a.DelegatorAddress == b.DelegatorAddress &&
+			a.DelegationType == b.DelegationType &&
+			a.DelegationTargetID == b.DelegationTargetID
+	})
+}
+
+// findDuplicateDelegatorSharesAccumulators returns the first duplicated delegator
+// shares accumulator in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicateDelegatorSharesAccumulators(accumulators []DelegatorSharesAccumulator) *DelegatorSharesAccumulator {
+	return utils.FindDuplicateFunc(accumulators, func(a, b DelegatorSharesAccumulator) bool {
+		return a.DelegationType == b.DelegationType &&
+			a.DelegationTargetID == b.DelegationTargetID &&
+			a.ServiceID == b.ServiceID
+	})
+}
+
+// findDuplicatePeriodStartTimes returns the first duplicated period start time
+// in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicatePeriodStartTimes(startTimes []PeriodStartTime) *PeriodStartTime {
+	return utils.FindDuplicateFunc(startTimes, func(a, b PeriodStartTime) bool {
+		return a.DelegationType == b.DelegationType &&
+			a.DelegationTargetID == b.DelegationTargetID &&
+			a.Period == b.Period
+	})
+}
+
+// findDuplicateDelegatorEpochClaims returns the first duplicated delegator
+// epoch claim in the slice. If no duplicates are found, it returns nil instead.
+func findDuplicateDelegatorEpochClaims(claims []DelegatorEpochClaim) *DelegatorEpochClaim {
+	return utils.FindDuplicateFunc(claims, func(a, b DelegatorEpochClaim) bool {
+		return a.DelegationType == b.DelegationType &&
+			a.DelegationTargetID == b.DelegationTargetID &&
+			a.DelegatorAddress == b.DelegatorAddress
+	})
+}
@@ -37,6 +37,9 @@ type DelegatorWithdrawInfo struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	// withdraw_address is the address to withdraw the delegation rewards to.
 	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// ibc_destination is the optional destination on another chain to which
+	// the delegation rewards are sent.
+	IBCDestination *IBCWithdrawDestination `protobuf:"bytes,3,opt,name=ibc_destination,json=ibcDestination,proto3" json:"ibc_destination,omitempty"`
 }
 
 func (m *DelegatorWithdrawInfo) Reset()         { *m = DelegatorWithdrawInfo{} }
@@ -387,6 +390,30 @@ type GenesisState struct {
 	// pool_service_total_delegator_shares defines the total delegator shares at
 	// genesis.
 	PoolServiceTotalDelegatorShares []PoolServiceTotalDelegatorShares `protobuf:"bytes,10,rep,name=pool_service_total_delegator_shares,json=poolServiceTotalDelegatorShares,proto3" json:"pool_service_total_delegator_shares"`
+	// rewards_plans_accounting defines the accounting of the active rewards
+	// plans at genesis.
+	RewardsPlansAccounting []RewardsPlanAccounting `protobuf:"bytes,11,rep,name=rewards_plans_accounting,json=rewardsPlansAccounting,proto3" json:"rewards_plans_accounting"`
+	// archived_rewards_plans defines the rewards plans that have been
+	// terminated at genesis.
+	ArchivedRewardsPlans []ArchivedRewardsPlan `protobuf:"bytes,12,rep,name=archived_rewards_plans,json=archivedRewardsPlans,proto3" json:"archived_rewards_plans"`
+	// auto_compound_settings defines the auto-compounding settings of the
+	// delegators at genesis.
+	AutoCompoundSettings []AutoCompoundSetting `protobuf:"bytes,13,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings"`
+	// next_auto_compound_time defines the time at which the next
+	// auto-compounding epoch starts.
+	NextAutoCompoundTime *time.Time `protobuf:"bytes,14,opt,name=next_auto_compound_time,json=nextAutoCompoundTime,proto3,stdtime" json:"next_auto_compound_time,omitempty"`
+	// delegator_shares_accumulators defines the time-weighted delegator shares
+	// accumulated since the last rewards allocation at genesis.
+	DelegatorSharesAccumulators []DelegatorSharesAccumulator `protobuf:"bytes,15,rep,name=delegator_shares_accumulators,json=delegatorSharesAccumulators,proto3" json:"delegator_shares_accumulators"`
+	// period_start_times defines the start times of the rewards periods of the
+	// current allocation epoch at genesis.
+	PeriodStartTimes []PeriodStartTime `protobuf:"bytes,16,rep,name=period_start_times,json=periodStartTimes,proto3" json:"period_start_times"`
+	// delegator_epoch_claims defines the claims on the current allocation epoch
+	// rewards at genesis.
+	DelegatorEpochClaims []DelegatorEpochClaim `protobuf:"bytes,17,rep,name=delegator_epoch_claims,json=delegatorEpochClaims,proto3" json:"delegator_epoch_claims"`
+	// pending_epoch_claim_payouts defines the claims of the ended allocation
+	// epochs that are waiting to be paid at genesis.
+	PendingEpochClaimPayouts []DelegatorEpochClaim `protobuf:"bytes,18,rep,name=pending_epoch_claim_payouts,json=pendingEpochClaimPayouts,proto3" json:"pending_epoch_claim_payouts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -492,6 +519,62 @@ func (m *GenesisState) GetPoolServiceTotalDelegatorShares() []PoolServiceTotalDe
 	return nil
 }
 
+func (m *GenesisState) GetRewardsPlansAccounting() []RewardsPlanAccounting {
+	if m != nil {
+		return m.RewardsPlansAccounting
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedRewardsPlans() []ArchivedRewardsPlan {
+	if m != nil {
+		return m.ArchivedRewardsPlans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundSettings() []AutoCompoundSetting {
+	if m != nil {
+		return m.AutoCompoundSettings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAutoCompoundTime() *time.Time {
+	if m != nil {
+		return m.NextAutoCompoundTime
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorSharesAccumulators() []DelegatorSharesAccumulator {
+	if m != nil {
+		return m.DelegatorSharesAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodStartTimes() []PeriodStartTime {
+	if m != nil {
+		return m.PeriodStartTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorEpochClaims() []DelegatorEpochClaim {
+	if m != nil {
+		return m.DelegatorEpochClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingEpochClaimPayouts() []DelegatorEpochClaim {
+	if m != nil {
+		return m.PendingEpochClaimPayouts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "milkyway.rewards.v1.DelegatorWithdrawInfo")
 	proto.RegisterType((*OutstandingRewardsRecord)(nil), "milkyway.rewards.v1.OutstandingRewardsRecord")
@@ -506,72 +589,88 @@ func init() {
 func init() { proto.RegisterFile("milkyway/rewards/v1/genesis.proto", fileDescriptor_95f74caade7824f2) }
 
 var fileDescriptor_95f74caade7824f2 = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x26, 0xfe, 0xe6, 0xc7, 0x38, 0x69, 0xe2, 0xa9, 0x93, 0xef, 0x36, 0xad, 0xbc, 0x26,
-	0x91, 0x50, 0xa8, 0x88, 0x97, 0x04, 0x90, 0x80, 0x5b, 0x1d, 0x03, 0xb1, 0x90, 0x48, 0xe4, 0x44,
-	0xaa, 0x54, 0x09, 0x2d, 0xe3, 0xdd, 0xc9, 0x7a, 0xc4, 0xee, 0xce, 0x6a, 0x66, 0x9c, 0x1f, 0xff,
-	0x01, 0x17, 0xa4, 0x1e, 0xf8, 0x03, 0x7a, 0x44, 0x48, 0x48, 0x1c, 0x7a, 0xe1, 0xc6, 0xb1, 0xc7,
-	0xaa, 0xe2, 0xc0, 0x29, 0x45, 0xce, 0x01, 0xfe, 0x00, 0xfe, 0x00, 0xb4, 0xb3, 0xb3, 0x3f, 0x1c,
-	0x6f, 0xea, 0xa0, 0xd2, 0x8b, 0xe5, 0x99, 0xf9, 0xbc, 0xf7, 0x3e, 0xef, 0xbd, 0xf9, 0xbc, 0x1d,
-	0xf0, 0x96, 0x4f, 0xbc, 0x6f, 0xce, 0x4f, 0xd1, 0xb9, 0xc9, 0xf0, 0x29, 0x62, 0x0e, 0x37, 0x4f,
-	0xb6, 0x4d, 0x17, 0x07, 0x98, 0x13, 0xde, 0x0c, 0x19, 0x15, 0x14, 0xde, 0x4e, 0x20, 0x4d, 0x05,
-	0x69, 0x9e, 0x6c, 0xaf, 0x55, 0x91, 0x4f, 0x02, 0x6a, 0xca, 0xdf, 0x18, 0xb7, 0x76, 0xc7, 0xa6,
-	0xdc, 0xa7, 0xdc, 0x92, 0x2b, 0x33, 0x5e, 0xa8, 0xa3, 0x9a, 0x4b, 0x5d, 0x1a, 0xef, 0x47, 0xff,
-	0xd4, 0xae, 0xe1, 0x52, 0xea, 0x7a, 0xd8, 0x94, 0xab, 0xde, 0xe0, 0xd8, 0x14, 0xc4, 0xc7, 0x5c,
-	0x20, 0x3f, 0x54, 0x80, 0x46, 0x11, 0x39, 0x9f, 0x3a, 0xd8, 0xe3, 0xaf, 0x42, 0x84, 0x88, 0x21,
-	0x5f, 0x21, 0xd6, 0x7f, 0xd2, 0xc0, 0x4a, 0x1b, 0x7b, 0xd8, 0x45, 0x82, 0xb2, 0x87, 0x44, 0xf4,
-	0x1d, 0x86, 0x4e, 0x3b, 0xc1, 0x31, 0x85, 0x9f, 0x82, 0xaa, 0x93, 0x1c, 0x58, 0xc8, 0x71, 0x18,
-	0xe6, 0x5c, 0xd7, 0x1a, 0xda, 0xe6, 0x7c, 0x4b, 0x7f, 0xf1, 0x74, 0xab, 0xa6, 0x32, 0x78, 0x10,
-	0x9f, 0x1c, 0x0a, 0x46, 0x02, 0xb7, 0xbb, 0x9c, 0x9a, 0xa8, 0x7d, 0xb8, 0x0b, 0x96, 0x4f, 0x95,
-	0xdb, 0xd4, 0xcb, 0xd4, 0x04, 0x2f, 0x4b, 0x89, 0x85, 0xda, 0xfe, 0x64, 0xee, 0xdb, 0x27, 0x46,
-	0xe9, 0xaf, 0x27, 0x46, 0x69, 0xfd, 0x37, 0x0d, 0xe8, 0xfb, 0x03, 0xc1, 0x05, 0x0a, 0x9c, 0x08,
-	0x1a, 0xa7, 0xd5, 0xc5, 0x36, 0x65, 0x0e, 0xdc, 0x03, 0x35, 0x15, 0x9f, 0xd0, 0xc0, 0x12, 0x88,
-	0xb9, 0x58, 0x58, 0xc4, 0x91, 0xac, 0x17, 0x5b, 0xab, 0xc3, 0x0b, 0x03, 0xb6, 0xd3, 0xf3, 0x23,
-	0x79, 0xdc, 0x69, 0x77, 0xa1, 0x73, 0x75, 0xcf, 0x81, 0x0e, 0xb8, 0x4d, 0xb3, 0x28, 0x96, 0xaa,
-	0x9e, 0x3e, 0xd5, 0x98, 0xde, 0xac, 0xec, 0xdc, 0x6b, 0x16, 0xb4, 0xbc, 0xd9, 0xc6, 0xf6, 0x01,
-	0xa5, 0x5e, 0x6b, 0xe5, 0xd9, 0x85, 0x51, 0xfa, 0xf1, 0xa5, 0x31, 0xa7, 0x36, 0xf8, 0x0f, 0x7f,
-	0xfe, 0x7c, 0x5f, 0xeb, 0x42, 0x3a, 0xc6, 0x3a, 0x97, 0xd6, 0x0b, 0x0d, 0xfc, 0x7f, 0x8f, 0x70,
-	0x41, 0x19, 0xb1, 0x91, 0xf7, 0xa6, 0xb2, 0x5a, 0x05, 0x33, 0x21, 0x66, 0x84, 0x3a, 0xb2, 0x03,
-	0xe5, 0xae, 0x5a, 0xc1, 0x2f, 0xc0, 0x6c, 0x92, 0xe1, 0x74, 0x43, 0xdb, 0xac, 0xec, 0xbc, 0x5d,
-	0x98, 0xe1, 0x18, 0xc1, 0xd6, 0x7c, 0x94, 0x6b, 0x9c, 0x5f, 0xe2, 0x21, 0x97, 0xd4, 0x53, 0x0d,
-	0xd4, 0x76, 0x07, 0x8c, 0xe1, 0x40, 0xbc, 0xa9, 0x8c, 0xf6, 0x32, 0xe6, 0x53, 0x92, 0xf9, 0x46,
-	0x21, 0xf3, 0x51, 0x16, 0x13, 0x68, 0x7f, 0x3f, 0x05, 0xee, 0xa6, 0x92, 0x38, 0x14, 0x88, 0x09,
-	0x12, 0xb8, 0x91, 0x24, 0x14, 0xfb, 0xff, 0x48, 0x18, 0xd7, 0x15, 0x61, 0xea, 0x5f, 0x17, 0xe1,
-	0x11, 0x58, 0xe4, 0x8a, 0xa6, 0x45, 0x82, 0x63, 0xaa, 0x9a, 0x78, 0xff, 0x9a, 0x6b, 0x5a, 0x90,
-	0x59, 0xbe, 0x22, 0x0b, 0x3c, 0x77, 0x90, 0x2b, 0xcb, 0x2f, 0x1a, 0xd8, 0xd8, 0x0f, 0x31, 0x93,
-	0x39, 0xd8, 0xf6, 0xc0, 0x1f, 0x78, 0x48, 0x60, 0x67, 0x97, 0xfa, 0x3e, 0xe1, 0x9c, 0xd0, 0x40,
-	0x95, 0xc7, 0x04, 0x15, 0xaa, 0x60, 0x59, 0x4f, 0x6f, 0x0d, 0x2f, 0x0c, 0x90, 0x58, 0x77, 0xda,
-	0x5d, 0x90, 0x40, 0x3a, 0x0e, 0x7c, 0x08, 0x2a, 0x28, 0xf3, 0xa7, 0xfa, 0x58, 0x4c, 0xbe, 0x30,
-	0x6e, 0x9e, 0x7c, 0xde, 0x53, 0x8e, 0xfb, 0xaf, 0xd3, 0xe9, 0x94, 0x8b, 0x0a, 0x77, 0x1e, 0xe2,
-	0x98, 0x2c, 0x87, 0xa4, 0x58, 0xe8, 0x9a, 0x14, 0xfa, 0x56, 0x21, 0x89, 0xeb, 0xc6, 0x4f, 0x9e,
-	0x47, 0x81, 0xda, 0xe1, 0x31, 0x80, 0xfd, 0x54, 0x41, 0x57, 0x46, 0xca, 0xbb, 0x37, 0x13, 0xdc,
-	0x78, 0xa0, 0x6a, 0xff, 0x2a, 0x06, 0x7e, 0x05, 0x96, 0xec, 0xf8, 0xbe, 0x5b, 0x99, 0xaa, 0xa3,
-	0x20, 0xef, 0xdc, 0x40, 0x1b, 0xe3, 0x11, 0x6e, 0xd9, 0x23, 0x00, 0x38, 0x00, 0x7a, 0x76, 0xfd,
-	0x47, 0xee, 0x1d, 0xd7, 0xcb, 0x32, 0xce, 0x7b, 0x37, 0xbf, 0x78, 0xe3, 0xe1, 0x56, 0x9d, 0x22,
-	0x1c, 0x5f, 0xff, 0x7b, 0x16, 0x2c, 0x7c, 0x1e, 0x7f, 0x78, 0x0f, 0x05, 0x12, 0x18, 0x7e, 0x0c,
-	0x66, 0xe2, 0x2f, 0x99, 0xbc, 0x62, 0x95, 0x9d, 0xbb, 0x85, 0x51, 0x0f, 0x24, 0xa4, 0x55, 0x8e,
-	0x02, 0x74, 0x95, 0x01, 0xfc, 0x0c, 0xd4, 0x02, 0x7c, 0x96, 0x96, 0xc7, 0x0a, 0x3d, 0x14, 0x24,
-	0xd2, 0x2b, 0xb7, 0x56, 0x86, 0x17, 0x46, 0xf5, 0x4b, 0x7c, 0x96, 0x64, 0x7c, 0xe0, 0xa1, 0xa0,
-	0xd3, 0xee, 0x56, 0x83, 0x2b, 0x5b, 0xd1, 0xdc, 0x5c, 0xcc, 0xbb, 0x48, 0xea, 0xdc, 0x28, 0x64,
-	0x92, 0x33, 0x55, 0x74, 0x16, 0x58, 0xb6, 0xc5, 0x21, 0x02, 0xf7, 0x3c, 0xc4, 0x33, 0x52, 0xc8,
-	0xf3, 0xa8, 0xad, 0x86, 0x03, 0xf1, 0xb1, 0x5e, 0x96, 0x59, 0xae, 0x35, 0xe3, 0x57, 0x41, 0x33,
-	0x79, 0x15, 0x34, 0x8f, 0x92, 0x57, 0x41, 0xab, 0xfc, 0xf8, 0xa5, 0xa1, 0x75, 0xef, 0x44, 0x5e,
-	0x54, 0xb0, 0x07, 0xa9, 0x8f, 0x08, 0x05, 0x69, 0xbe, 0x75, 0xe9, 0x57, 0x39, 0x6e, 0xdd, 0xff,
-	0x24, 0xf5, 0x09, 0x33, 0x23, 0xff, 0x40, 0x28, 0x6e, 0x5a, 0x1e, 0xc1, 0xa3, 0xc9, 0x14, 0x46,
-	0x9f, 0x40, 0x8b, 0xc5, 0x72, 0xd3, 0x67, 0x26, 0x4f, 0xa6, 0x51, 0x81, 0x8e, 0x4c, 0x26, 0xe9,
-	0x2b, 0x51, 0x6e, 0x0f, 0x54, 0x93, 0x21, 0x92, 0xf9, 0x9f, 0x7d, 0x1d, 0xff, 0xcb, 0xa9, 0xbf,
-	0x24, 0xc6, 0xd7, 0x60, 0x99, 0x63, 0x76, 0x42, 0x6c, 0x9c, 0x85, 0x98, 0x7b, 0x9d, 0x10, 0x4b,
-	0x89, 0xbb, 0x24, 0xc2, 0x77, 0x1a, 0x68, 0xa4, 0xe3, 0x32, 0x37, 0xbc, 0x2c, 0x3b, 0x9d, 0x6f,
-	0x5c, 0x9f, 0x97, 0xbd, 0xf9, 0xa8, 0x78, 0x1a, 0x4d, 0x1e, 0xc9, 0x79, 0x02, 0x75, 0xfa, 0x2a,
-	0xbc, 0xe4, 0xb3, 0x11, 0x95, 0xd9, 0x52, 0x44, 0x2d, 0x41, 0x05, 0xf2, 0xac, 0x9c, 0xe2, 0xfb,
-	0x88, 0x61, 0xae, 0x03, 0x49, 0xe9, 0x83, 0x62, 0xcd, 0x51, 0xea, 0x1d, 0xc6, 0xe6, 0x47, 0x91,
-	0x75, 0xa6, 0x7c, 0x69, 0x9b, 0xa7, 0x63, 0x84, 0x13, 0xb0, 0xfb, 0xcf, 0x86, 0x75, 0xed, 0xf9,
-	0xb0, 0xae, 0xfd, 0x31, 0xac, 0x6b, 0x8f, 0x2f, 0xeb, 0xa5, 0xe7, 0x97, 0xf5, 0xd2, 0xef, 0x97,
-	0xf5, 0xd2, 0xa3, 0x0f, 0x5d, 0x22, 0xfa, 0x83, 0x5e, 0xd3, 0xa6, 0xbe, 0x99, 0xb0, 0xd8, 0xf2,
-	0x50, 0x8f, 0xa7, 0x2b, 0xf3, 0x64, 0x7b, 0xc7, 0x3c, 0x4b, 0x9f, 0xbe, 0xe2, 0x3c, 0xc4, 0xbc,
-	0x37, 0x23, 0x85, 0xf3, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0x4f, 0xc3, 0x3a, 0xda,
-	0x0b, 0x00, 0x00,
+	// 1289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xce, 0xa6, 0xfe, 0xa5, 0xed, 0x38, 0x71, 0xe2, 0xa9, 0x9b, 0x6e, 0x9b, 0xfe, 0x6c, 0xd3,
+	0x22, 0x14, 0x0a, 0xf5, 0xd2, 0x00, 0x12, 0x70, 0x8b, 0xed, 0x42, 0x2d, 0x24, 0x1a, 0x39, 0x95,
+	0x2a, 0x55, 0x42, 0xcb, 0x78, 0x77, 0x62, 0x8f, 0xd8, 0xdd, 0x59, 0xed, 0x8c, 0x93, 0xe6, 0xc2,
+	0x99, 0x0b, 0x52, 0x0f, 0x48, 0x5c, 0x7b, 0x44, 0x9c, 0x38, 0xf4, 0xc2, 0x8d, 0x63, 0x8f, 0x55,
+	0xc5, 0x81, 0x53, 0x8a, 0xdc, 0x03, 0xfc, 0x19, 0x68, 0x66, 0x67, 0x77, 0x67, 0xe3, 0x8d, 0x1d,
+	0x28, 0xbd, 0x44, 0xd9, 0x99, 0xf7, 0xde, 0xf7, 0xbd, 0x79, 0xef, 0x7b, 0x33, 0x06, 0x6f, 0xf8,
+	0xc4, 0xfb, 0xfa, 0xf0, 0x00, 0x1d, 0x5a, 0x11, 0x3e, 0x40, 0x91, 0xcb, 0xac, 0xfd, 0x5b, 0xd6,
+	0x10, 0x07, 0x98, 0x11, 0xd6, 0x0a, 0x23, 0xca, 0x29, 0xbc, 0x90, 0x98, 0xb4, 0x94, 0x49, 0x6b,
+	0xff, 0xd6, 0x95, 0x2a, 0xf2, 0x49, 0x40, 0x2d, 0xf9, 0x37, 0xb6, 0xbb, 0x72, 0xd9, 0xa1, 0xcc,
+	0xa7, 0xcc, 0x96, 0x5f, 0x56, 0xfc, 0xa1, 0xb6, 0x6a, 0x43, 0x3a, 0xa4, 0xf1, 0xba, 0xf8, 0x4f,
+	0xad, 0x36, 0x86, 0x94, 0x0e, 0x3d, 0x6c, 0xc9, 0xaf, 0xc1, 0x78, 0xcf, 0xe2, 0xc4, 0xc7, 0x8c,
+	0x23, 0x3f, 0x54, 0x06, 0xcd, 0x22, 0x72, 0x3e, 0x75, 0xb1, 0xc7, 0x66, 0x59, 0x84, 0x28, 0x42,
+	0xbe, 0xb2, 0xb8, 0xf6, 0xc3, 0x22, 0xb8, 0xd8, 0xc5, 0x1e, 0x1e, 0x22, 0x4e, 0xa3, 0xfb, 0x84,
+	0x8f, 0xdc, 0x08, 0x1d, 0xf4, 0x82, 0x3d, 0x0a, 0x6f, 0x83, 0xaa, 0x9b, 0x6c, 0xd8, 0xc8, 0x75,
+	0x23, 0xcc, 0x98, 0x69, 0x34, 0x8d, 0xcd, 0xf3, 0x6d, 0xf3, 0xf9, 0x93, 0x9b, 0x35, 0x95, 0xc1,
+	0x76, 0xbc, 0xb3, 0xcb, 0x23, 0x12, 0x0c, 0xfb, 0x6b, 0xa9, 0x8b, 0x5a, 0x87, 0x1d, 0xb0, 0x76,
+	0xa0, 0xc2, 0xa6, 0x51, 0x16, 0xe7, 0x44, 0x59, 0x4d, 0x3c, 0x92, 0x20, 0x23, 0xb0, 0x4a, 0x06,
+	0x8e, 0xed, 0x62, 0xc6, 0x49, 0x80, 0x38, 0xa1, 0x81, 0x79, 0xa6, 0x69, 0x6c, 0x96, 0xb7, 0xde,
+	0x69, 0x15, 0x9c, 0x7e, 0xab, 0xd7, 0xee, 0x24, 0xa9, 0x74, 0x33, 0x97, 0x36, 0x9c, 0x1c, 0x35,
+	0x2a, 0xbd, 0x76, 0x47, 0x5b, 0xeb, 0x57, 0xc8, 0xc0, 0xd1, 0xbe, 0x3f, 0x39, 0xf7, 0xed, 0xe3,
+	0xc6, 0xc2, 0x5f, 0x8f, 0x1b, 0x0b, 0xd7, 0x7e, 0x33, 0x80, 0x79, 0x77, 0xcc, 0x19, 0x47, 0x81,
+	0x2b, 0x48, 0xc5, 0xe1, 0xfb, 0xd8, 0xa1, 0x91, 0x0b, 0xef, 0x80, 0x9a, 0xca, 0x94, 0xd0, 0xc0,
+	0xe6, 0x28, 0x1a, 0x62, 0x6e, 0x13, 0x57, 0x9e, 0xcf, 0x4a, 0x7b, 0x7d, 0x72, 0xd4, 0x80, 0xdd,
+	0x74, 0xff, 0x9e, 0xdc, 0xee, 0x75, 0xfb, 0xd0, 0x3d, 0xbe, 0xe6, 0x42, 0x17, 0x5c, 0xa0, 0x19,
+	0x8a, 0xad, 0xb2, 0x30, 0x17, 0x9b, 0x67, 0x36, 0xcb, 0x5b, 0x57, 0x0b, 0xd3, 0xeb, 0x62, 0x67,
+	0x87, 0x52, 0xaf, 0x7d, 0xf1, 0xe9, 0x51, 0x63, 0xe1, 0xa7, 0x17, 0x8d, 0x73, 0x6a, 0x81, 0xfd,
+	0xf8, 0xe7, 0xcf, 0x37, 0x8c, 0x3e, 0xa4, 0x53, 0xac, 0xb5, 0xb4, 0x9e, 0x1b, 0xe0, 0xd2, 0x1d,
+	0xc2, 0x38, 0x8d, 0x88, 0x83, 0xbc, 0xd7, 0x95, 0xd5, 0x3a, 0x58, 0x0a, 0x71, 0x44, 0xa8, 0x2b,
+	0x6b, 0x5d, 0xea, 0xab, 0x2f, 0xf8, 0x39, 0x38, 0x9b, 0x64, 0x18, 0x17, 0xf0, 0xad, 0xc2, 0x0c,
+	0xa7, 0x08, 0xb6, 0xcf, 0x8b, 0x5c, 0xe3, 0xfc, 0x92, 0x08, 0x5a, 0x52, 0x4f, 0x0c, 0x50, 0xeb,
+	0x8c, 0xa3, 0x08, 0x07, 0xfc, 0x75, 0x65, 0x74, 0x27, 0x63, 0xbe, 0x28, 0x99, 0x5f, 0x2f, 0x64,
+	0x9e, 0x67, 0x31, 0x87, 0xf6, 0xf7, 0x8b, 0x60, 0x23, 0x15, 0xdf, 0x2e, 0x47, 0x11, 0x27, 0xc1,
+	0x50, 0x88, 0x4f, 0xb1, 0xff, 0x8f, 0x24, 0x78, 0xd2, 0x21, 0x2c, 0xfe, 0xe3, 0x43, 0x78, 0x00,
+	0x56, 0x98, 0xa2, 0x69, 0x93, 0x60, 0x8f, 0xaa, 0x22, 0xde, 0x38, 0xa1, 0x4d, 0x0b, 0x32, 0xd3,
+	0x4f, 0x64, 0x99, 0x69, 0x1b, 0xda, 0xb1, 0xfc, 0x62, 0x80, 0xeb, 0x77, 0x43, 0x1c, 0xc9, 0x1c,
+	0x1c, 0x67, 0xec, 0x8f, 0x3d, 0xc4, 0xb1, 0xdb, 0xa1, 0xbe, 0x4f, 0x18, 0x13, 0xaa, 0x8d, 0x8f,
+	0xc7, 0x02, 0x65, 0xaa, 0xcc, 0xb2, 0x9a, 0x56, 0x26, 0x47, 0x0d, 0x90, 0x78, 0xf7, 0xba, 0x7d,
+	0x90, 0x98, 0xf4, 0x5c, 0x78, 0x1f, 0x94, 0x51, 0x16, 0x4f, 0xd5, 0xb1, 0x98, 0x7c, 0x21, 0xae,
+	0x4e, 0x5e, 0x8f, 0xa4, 0x71, 0xff, 0xf5, 0x4c, 0x3a, 0x4f, 0xc5, 0xc1, 0x1d, 0x86, 0x38, 0x26,
+	0xcb, 0x20, 0x29, 0x16, 0xba, 0x21, 0x85, 0x7e, 0xb3, 0x90, 0xc4, 0x49, 0xe3, 0x47, 0xe7, 0x51,
+	0xa0, 0x76, 0xb8, 0x07, 0xe0, 0x28, 0x55, 0xd0, 0xb1, 0x91, 0xf2, 0xee, 0xe9, 0x04, 0x37, 0x0d,
+	0x54, 0x1d, 0x1d, 0xb7, 0x81, 0x5f, 0x82, 0x55, 0x27, 0xee, 0x77, 0x3b, 0x53, 0xb5, 0x00, 0x79,
+	0xfb, 0x14, 0xda, 0x98, 0x46, 0xa8, 0x38, 0x39, 0x03, 0x38, 0x06, 0x66, 0xd6, 0xfe, 0xb9, 0xbe,
+	0x63, 0x66, 0x49, 0xe2, 0xbc, 0x77, 0xfa, 0xc6, 0x9b, 0x86, 0x5b, 0x77, 0x8b, 0xec, 0xd8, 0xb5,
+	0xe7, 0x15, 0xb0, 0xfc, 0x59, 0x7c, 0xc5, 0xef, 0x72, 0xc4, 0x31, 0xfc, 0x18, 0x2c, 0xc5, 0x77,
+	0xa6, 0x6c, 0xb1, 0xf2, 0xd6, 0x46, 0x21, 0xea, 0x8e, 0x34, 0x69, 0x97, 0x04, 0x40, 0x5f, 0x39,
+	0xc0, 0x4f, 0x41, 0x2d, 0xc0, 0x0f, 0xd3, 0xe3, 0xb1, 0x43, 0x0f, 0x05, 0x89, 0xf4, 0x4a, 0xed,
+	0x8b, 0x93, 0xa3, 0x46, 0xf5, 0x0b, 0xfc, 0x30, 0xc9, 0x78, 0xc7, 0x43, 0x41, 0xaf, 0xdb, 0xaf,
+	0x06, 0xc7, 0x96, 0xc4, 0xdc, 0x5c, 0xd1, 0x43, 0x24, 0xe7, 0xdc, 0x2c, 0x64, 0xa2, 0xb9, 0x2a,
+	0x3a, 0xcb, 0x51, 0xb6, 0xc4, 0x20, 0x02, 0x57, 0x3d, 0xc4, 0x32, 0x52, 0xc8, 0xf3, 0xa8, 0xa3,
+	0x86, 0x03, 0xf1, 0xb1, 0x59, 0x92, 0x59, 0x5e, 0x69, 0xc5, 0xef, 0x8f, 0x56, 0xf2, 0xfe, 0x68,
+	0xdd, 0x4b, 0xde, 0x1f, 0xed, 0xd2, 0xa3, 0x17, 0x0d, 0xa3, 0x7f, 0x59, 0x44, 0x51, 0x60, 0xdb,
+	0x69, 0x0c, 0x61, 0x05, 0xa9, 0x5e, 0xba, 0xf4, 0xfe, 0x8f, 0x4b, 0xf7, 0x3f, 0x49, 0x7d, 0xce,
+	0xcc, 0xd0, 0x9f, 0x22, 0xc5, 0x45, 0xd3, 0x2d, 0x98, 0x98, 0x4c, 0xa1, 0xb8, 0x02, 0xed, 0x28,
+	0x96, 0x9b, 0xb9, 0x34, 0x7f, 0x32, 0xe5, 0x05, 0x9a, 0x9b, 0x4c, 0x32, 0x56, 0xa2, 0xdc, 0x01,
+	0xa8, 0x26, 0x43, 0x24, 0x8b, 0x7f, 0xf6, 0x55, 0xe2, 0xaf, 0xa5, 0xf1, 0x12, 0x8c, 0xaf, 0xc0,
+	0x1a, 0xc3, 0xd1, 0x3e, 0x71, 0x70, 0x06, 0x71, 0xee, 0x55, 0x20, 0x56, 0x93, 0x70, 0x09, 0xc2,
+	0x77, 0x06, 0x68, 0xa6, 0xe3, 0x52, 0x1b, 0x5e, 0xb6, 0x93, 0xce, 0x37, 0x66, 0x9e, 0x97, 0xb5,
+	0xf9, 0xa8, 0x78, 0x1a, 0xcd, 0x1f, 0xc9, 0x3a, 0x81, 0x3a, 0x9d, 0x65, 0x2f, 0xf9, 0x5c, 0x17,
+	0xc7, 0x6c, 0x2b, 0xa2, 0x36, 0xa7, 0x1c, 0x79, 0xb6, 0xa6, 0xf8, 0x11, 0x8a, 0x30, 0x33, 0x81,
+	0xa4, 0xf4, 0x41, 0xb1, 0xe6, 0x28, 0xf5, 0x76, 0x63, 0xf7, 0x7b, 0xc2, 0x3b, 0x53, 0xbe, 0xf4,
+	0xd5, 0xe9, 0x34, 0xc2, 0xd9, 0xb6, 0xa2, 0x65, 0x73, 0x12, 0x13, 0x67, 0x44, 0xc7, 0x81, 0x98,
+	0x0b, 0x66, 0x79, 0x46, 0xcb, 0x6a, 0x6a, 0xdb, 0x4e, 0x3d, 0x72, 0x2d, 0xab, 0x8b, 0x2f, 0x33,
+	0x81, 0x04, 0xac, 0xa3, 0xc8, 0x19, 0x91, 0x7d, 0xec, 0xda, 0x79, 0x71, 0x2f, 0x4b, 0xb8, 0xcd,
+	0xe2, 0x8b, 0x49, 0xb9, 0xe8, 0x22, 0xd7, 0xc0, 0x6a, 0x68, 0x7a, 0x9f, 0x49, 0xa8, 0x31, 0xa7,
+	0xa2, 0xd4, 0x21, 0x1d, 0x07, 0xae, 0xcd, 0x30, 0x17, 0x1c, 0x98, 0xb9, 0x32, 0x0b, 0x6a, 0xcc,
+	0x69, 0x47, 0x79, 0xec, 0xc6, 0x0e, 0x79, 0xa8, 0xe9, 0x7d, 0x06, 0xef, 0x83, 0x4b, 0x72, 0xe2,
+	0xe5, 0xf1, 0xe4, 0x5c, 0xa9, 0x9c, 0x72, 0xae, 0xc8, 0x91, 0xa9, 0x83, 0xcb, 0x91, 0xf2, 0x0d,
+	0xf8, 0xff, 0xf1, 0xde, 0xc8, 0xda, 0x98, 0x46, 0xcc, 0x5c, 0x95, 0xa9, 0x58, 0x73, 0xae, 0x04,
+	0xe9, 0xb8, 0x9d, 0xf9, 0xe9, 0x19, 0x6d, 0xb8, 0x27, 0x9a, 0x89, 0xcb, 0x0e, 0xc6, 0x8f, 0xd8,
+	0xf8, 0x2a, 0x92, 0x29, 0x31, 0x73, 0x4d, 0x82, 0xbe, 0x59, 0xdc, 0x9d, 0xd2, 0x5c, 0x5e, 0x2e,
+	0x22, 0x83, 0xdc, 0x00, 0x08, 0xf3, 0x7b, 0xb2, 0x44, 0x59, 0x7a, 0x38, 0xa4, 0xce, 0xc8, 0x76,
+	0x3c, 0x44, 0x7c, 0x66, 0x56, 0x67, 0x94, 0x28, 0xcd, 0xeb, 0xb6, 0xf0, 0xe8, 0x08, 0x87, 0x5c,
+	0x89, 0xdc, 0xe9, 0x7d, 0x06, 0x23, 0xb0, 0x11, 0xe2, 0xf8, 0x15, 0xa2, 0x01, 0xd9, 0x21, 0x3a,
+	0x14, 0x6f, 0x09, 0x13, 0xfe, 0x7b, 0x3c, 0x53, 0xc5, 0xcd, 0x76, 0x77, 0xe2, 0xa0, 0xed, 0xbb,
+	0x4f, 0x27, 0x75, 0xe3, 0xd9, 0xa4, 0x6e, 0xfc, 0x31, 0xa9, 0x1b, 0x8f, 0x5e, 0xd6, 0x17, 0x9e,
+	0xbd, 0xac, 0x2f, 0xfc, 0xfe, 0xb2, 0xbe, 0xf0, 0xe0, 0xc3, 0x21, 0xe1, 0xa3, 0xf1, 0xa0, 0xe5,
+	0x50, 0xdf, 0x4a, 0x20, 0x6f, 0x7a, 0x68, 0xc0, 0xd2, 0x2f, 0x6b, 0xff, 0xd6, 0x96, 0xf5, 0x30,
+	0xfd, 0x09, 0xcb, 0x0f, 0x43, 0xcc, 0x06, 0x4b, 0xb2, 0x7d, 0xde, 0xff, 0x3b, 0x00, 0x00, 0xff,
+	0xff, 0xa5, 0xe7, 0x33, 0xc2, 0xa2, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -594,6 +693,18 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IBCDestination != nil {
+		{
+			size, err := m.IBCDestination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.WithdrawAddress) > 0 {
 		i -= len(m.WithdrawAddress)
 		copy(dAtA[i:], m.WithdrawAddress)
@@ -916,6 +1027,120 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingEpochClaimPayouts) > 0 {
+		for iNdEx := len(m.PendingEpochClaimPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingEpochClaimPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.DelegatorEpochClaims) > 0 {
+		for iNdEx := len(m.DelegatorEpochClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorEpochClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PeriodStartTimes) > 0 {
+		for iNdEx := len(m.PeriodStartTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodStartTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DelegatorSharesAccumulators) > 0 {
+		for iNdEx := len(m.DelegatorSharesAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorSharesAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextAutoCompoundTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextAutoCompoundTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextAutoCompoundTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGenesis(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ArchivedRewardsPlans) > 0 {
+		for iNdEx := len(m.ArchivedRewardsPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedRewardsPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RewardsPlansAccounting) > 0 {
+		for iNdEx := len(m.RewardsPlansAccounting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPlansAccounting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.PoolServiceTotalDelegatorShares) > 0 {
 		for iNdEx := len(m.PoolServiceTotalDelegatorShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -989,12 +1214,12 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastRewardsAllocationTime != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRewardsAllocationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRewardsAllocationTime):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRewardsAllocationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRewardsAllocationTime):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGenesis(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGenesis(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1055,6 +1280,10 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.IBCDestination != nil {
+		l = m.IBCDestination.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1217,6 +1446,52 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RewardsPlansAccounting) > 0 {
+		for _, e := range m.RewardsPlansAccounting {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedRewardsPlans) > 0 {
+		for _, e := range m.ArchivedRewardsPlans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAutoCompoundTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextAutoCompoundTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegatorSharesAccumulators) > 0 {
+		for _, e := range m.DelegatorSharesAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodStartTimes) > 0 {
+		for _, e := range m.PeriodStartTimes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorEpochClaims) > 0 {
+		for _, e := range m.DelegatorEpochClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingEpochClaimPayouts) > 0 {
+		for _, e := range m.PendingEpochClaimPayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1319,6 +1594,42 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCDestination == nil {
+				m.IBCDestination = &IBCWithdrawDestination{}
+			}
+			if err := m.IBCDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2440,6 +2751,280 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPlansAccounting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPlansAccounting = append(m.RewardsPlansAccounting, RewardsPlanAccounting{})
+			if err := m.RewardsPlansAccounting[len(m.RewardsPlansAccounting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedRewardsPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedRewardsPlans = append(m.ArchivedRewardsPlans, ArchivedRewardsPlan{})
+			if err := m.ArchivedRewardsPlans[len(m.ArchivedRewardsPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAutoCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAutoCompoundTime == nil {
+				m.NextAutoCompoundTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextAutoCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSharesAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSharesAccumulators = append(m.DelegatorSharesAccumulators, DelegatorSharesAccumulator{})
+			if err := m.DelegatorSharesAccumulators[len(m.DelegatorSharesAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodStartTimes = append(m.PeriodStartTimes, PeriodStartTime{})
+			if err := m.PeriodStartTimes[len(m.PeriodStartTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorEpochClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorEpochClaims = append(m.DelegatorEpochClaims, DelegatorEpochClaim{})
+			if err := m.DelegatorEpochClaims[len(m.DelegatorEpochClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochClaimPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingEpochClaimPayouts = append(m.PendingEpochClaimPayouts, DelegatorEpochClaim{})
+			if err := m.PendingEpochClaimPayouts[len(m.PendingEpochClaimPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
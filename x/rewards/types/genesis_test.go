@@ -8,6 +8,7 @@ import (
 
 	"github.com/milkyway-labs/milkyway/v12/testutils"
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
 
@@ -33,11 +34,14 @@ func TestGenesisState_Validate(t *testing.T) {
 						1,
 						"Rewards plan",
 						0,
-						utils.MustParseCoin("10_000000umilk"),
+						utils.MustParseCoins("10_000000umilk"),
 						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 						time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 						types.NewBasicPoolsDistribution(0), types.NewBasicOperatorsDistribution(0),
 						types.NewBasicUsersDistribution(0),
+						nil,
+						types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+						nil,
 					),
 				},
 			},
@@ -63,6 +67,187 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			name: "duplicated rewards plan accounting returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				RewardsPlansAccounting: []types.RewardsPlanAccounting{
+					types.NewRewardsPlanAccounting(1, nil, 0),
+					types.NewRewardsPlanAccounting(1, nil, 1),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid rewards plan accounting returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				RewardsPlansAccounting: []types.RewardsPlanAccounting{
+					types.NewRewardsPlanAccounting(1, []types.DelegationTargetDistributedRewards{
+						types.NewDelegationTargetDistributedRewards(
+							restakingtypes.DELEGATION_TYPE_POOL,
+							0,
+							utils.MustParseDecCoins("10_000000umilk"),
+						),
+					}, 0),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated archived rewards plan returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				ArchivedRewardsPlans: []types.ArchivedRewardsPlan{
+					types.NewArchivedRewardsPlan(
+						types.RewardsPlan{ID: 1},
+						time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						types.NewRewardsPlanAccounting(1, nil, 0),
+					),
+					types.NewArchivedRewardsPlan(
+						types.RewardsPlan{ID: 1},
+						time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						types.NewRewardsPlanAccounting(1, nil, 0),
+					),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid archived rewards plan returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				ArchivedRewardsPlans: []types.ArchivedRewardsPlan{
+					types.NewArchivedRewardsPlan(
+						types.NewRewardsPlan(
+							1,
+							"Rewards plan",
+							0,
+							utils.MustParseCoins("10_000000umilk"),
+							time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+							time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+							types.NewBasicPoolsDistribution(0), types.NewBasicOperatorsDistribution(0),
+							types.NewBasicUsersDistribution(0),
+							nil,
+							types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+							nil,
+						),
+						time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						types.NewRewardsPlanAccounting(1, nil, 0),
+					),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid ibc withdraw destination returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				DelegatorWithdrawInfos: []types.DelegatorWithdrawInfo{
+					{
+						DelegatorAddress: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+						WithdrawAddress:  "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+						IBCDestination:   &types.IBCWithdrawDestination{SourceChannel: "channel-0", Receiver: "osmo1receiver"},
+					},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated auto-compound setting returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				AutoCompoundSettings: []types.AutoCompoundSetting{
+					types.NewAutoCompoundSetting("cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", restakingtypes.DELEGATION_TYPE_POOL, 1),
+					types.NewAutoCompoundSetting("cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", restakingtypes.DELEGATION_TYPE_POOL, 1),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid auto-compound setting returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				AutoCompoundSettings: []types.AutoCompoundSetting{
+					types.NewAutoCompoundSetting("cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", restakingtypes.DELEGATION_TYPE_UNSPECIFIED, 1),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated delegator shares accumulator returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				DelegatorSharesAccumulators: []types.DelegatorSharesAccumulator{
+					types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_POOL, 1, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_POOL, 1, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator shares accumulator returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				DelegatorSharesAccumulators: []types.DelegatorSharesAccumulator{
+					types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_POOL, 1, 0, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated period start time returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				PeriodStartTimes: []types.PeriodStartTime{
+					types.NewPeriodStartTime(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 2, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					types.NewPeriodStartTime(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 2, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid period start time returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				PeriodStartTimes: []types.PeriodStartTime{
+					types.NewPeriodStartTime(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 2, time.Time{}),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated delegator epoch claim returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				DelegatorEpochClaims: []types.DelegatorEpochClaim{
+					types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+					types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator epoch claim returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				DelegatorEpochClaims: []types.DelegatorEpochClaim{
+					types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, 1, "invalid"),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated pending epoch claim payout returns error",
+			genesis: &types.GenesisState{
+				NextRewardsPlanID: 1,
+				PendingEpochClaimPayouts: []types.DelegatorEpochClaim{
+					types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+					types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_SERVICE, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+				},
+			},
+			shouldErr: true,
+		},
 		{
 			name:      "default genesis returns no error",
 			genesis:   types.DefaultGenesis(),
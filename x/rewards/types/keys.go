@@ -21,6 +21,18 @@ var (
 	RewardsPlanKeyPrefix           = collections.NewPrefix(0xa2)
 	LastRewardsAllocationTimeKey   = collections.NewPrefix(0xa3)
 	DelegatorWithdrawAddrKeyPrefix = collections.NewPrefix(0xa4)
+	RewardsPlanAccountingKeyPrefix = collections.NewPrefix(0xa5)
+	ArchivedRewardsPlanKeyPrefix   = collections.NewPrefix(0xa6)
+	AutoCompoundSettingKeyPrefix   = collections.NewPrefix(0xa7)
+	NextAutoCompoundTimeKey        = collections.NewPrefix(0xa8)
+	AutoCompoundQueueCursorKey     = collections.NewPrefix(0xa9)
+
+	DelegatorIBCWithdrawDestinationKeyPrefix = collections.NewPrefix(0xaa)
+	DelegatorSharesAccumulatorKeyPrefix      = collections.NewPrefix(0xab)
+	PeriodStartTimeKeyPrefix                 = collections.NewPrefix(0xac)
+	DelegatorEpochClaimKeyPrefix             = collections.NewPrefix(0xad)
+	AutoCompoundSettingTargetIndexKeyPrefix  = collections.NewPrefix(0xae)
+	RewardsPlanEndTimeIndexKeyPrefix         = collections.NewPrefix(0xaf)
 
 	PoolDelegatorStartingInfoKeyPrefix       = collections.NewPrefix(0xb1)
 	PoolHistoricalRewardsKeyPrefix           = collections.NewPrefix(0xb2)
@@ -38,4 +50,6 @@ var (
 	ServiceHistoricalRewardsKeyPrefix     = collections.NewPrefix(0xd2)
 	ServiceCurrentRewardsKeyPrefix        = collections.NewPrefix(0xd3)
 	ServiceOutstandingRewardsKeyPrefix    = collections.NewPrefix(0xd4)
+
+	PendingEpochClaimPayoutKeyPrefix = collections.NewPrefix(0xe1)
 )
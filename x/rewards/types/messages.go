@@ -14,26 +14,32 @@ import (
 func NewMsgCreateRewardsPlan(
 	serviceID uint32,
 	description string,
-	amt sdk.Coin,
+	amt sdk.Coins,
 	startTime,
 	endTime time.Time,
 	poolsDistribution Distribution,
 	operatorsDistribution Distribution,
 	usersDistribution UsersDistribution,
+	emissionSchedule EmissionSchedule,
+	insufficientFundsBehavior InsufficientFundsBehavior,
+	usdBudget *USDBudget,
 	feeAmount sdk.Coins,
 	sender string,
 ) *MsgCreateRewardsPlan {
 	return &MsgCreateRewardsPlan{
-		Sender:                sender,
-		Description:           description,
-		ServiceID:             serviceID,
-		Amount:                amt,
-		StartTime:             startTime,
-		EndTime:               endTime,
-		PoolsDistribution:     poolsDistribution,
-		OperatorsDistribution: operatorsDistribution,
-		UsersDistribution:     usersDistribution,
-		FeeAmount:             feeAmount,
+		Sender:                    sender,
+		Description:               description,
+		ServiceID:                 serviceID,
+		Amount:                    amt,
+		StartTime:                 startTime,
+		EndTime:                   endTime,
+		PoolsDistribution:         poolsDistribution,
+		OperatorsDistribution:     operatorsDistribution,
+		UsersDistribution:         usersDistribution,
+		EmissionSchedule:          NewEmissionScheduleAny(emissionSchedule),
+		InsufficientFundsBehavior: insufficientFundsBehavior,
+		USDBudget:                 usdBudget,
+		FeeAmount:                 feeAmount,
 	}
 }
 
@@ -60,6 +66,22 @@ func (m *MsgCreateRewardsPlan) ValidateBasic() error {
 		)
 	}
 
+	err = ValidateInsufficientFundsBehavior(m.InsufficientFundsBehavior)
+	if err != nil {
+		return err
+	}
+
+	if m.USDBudget != nil {
+		if !m.Amount.IsZero() {
+			return fmt.Errorf("amount must be empty when a USD budget is set: %s", m.Amount)
+		}
+
+		err = m.USDBudget.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid USD budget: %w", err)
+		}
+	}
+
 	if m.PoolsDistribution.DelegationType != restakingtypes.DELEGATION_TYPE_POOL {
 		return fmt.Errorf("pools distribution has invalid delegation type: %v", m.PoolsDistribution.DelegationType)
 	}
@@ -113,6 +135,11 @@ func (m *MsgCreateRewardsPlan) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 		return nil
 	}
 
+	if m.EmissionSchedule != nil {
+		var emissionSchedule EmissionSchedule
+		return unpacker.UnpackAny(m.EmissionSchedule, &emissionSchedule)
+	}
+
 	return nil
 }
 
@@ -122,24 +149,30 @@ func (m *MsgCreateRewardsPlan) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 func NewMsgEditRewardsPlan(
 	id uint64,
 	description string,
-	amount sdk.Coin,
+	amount sdk.Coins,
 	startTime,
 	endTime time.Time,
 	poolsDistribution Distribution,
 	operatorsDistribution Distribution,
 	usersDistribution UsersDistribution,
+	emissionSchedule EmissionSchedule,
+	insufficientFundsBehavior InsufficientFundsBehavior,
+	usdBudget *USDBudget,
 	sender string,
 ) *MsgEditRewardsPlan {
 	return &MsgEditRewardsPlan{
-		ID:                    id,
-		Sender:                sender,
-		Description:           description,
-		Amount:                amount,
-		StartTime:             startTime,
-		EndTime:               endTime,
-		PoolsDistribution:     poolsDistribution,
-		OperatorsDistribution: operatorsDistribution,
-		UsersDistribution:     usersDistribution,
+		ID:                        id,
+		Sender:                    sender,
+		Description:               description,
+		Amount:                    amount,
+		StartTime:                 startTime,
+		EndTime:                   endTime,
+		PoolsDistribution:         poolsDistribution,
+		OperatorsDistribution:     operatorsDistribution,
+		UsersDistribution:         usersDistribution,
+		EmissionSchedule:          NewEmissionScheduleAny(emissionSchedule),
+		InsufficientFundsBehavior: insufficientFundsBehavior,
+		USDBudget:                 usdBudget,
 	}
 }
 
@@ -162,6 +195,22 @@ func (m *MsgEditRewardsPlan) ValidateBasic() error {
 		)
 	}
 
+	err = ValidateInsufficientFundsBehavior(m.InsufficientFundsBehavior)
+	if err != nil {
+		return err
+	}
+
+	if m.USDBudget != nil {
+		if !m.Amount.IsZero() {
+			return fmt.Errorf("amount must be empty when a USD budget is set: %s", m.Amount)
+		}
+
+		err = m.USDBudget.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid USD budget: %w", err)
+		}
+	}
+
 	if m.PoolsDistribution.DelegationType != restakingtypes.DELEGATION_TYPE_POOL {
 		return fmt.Errorf("pools distribution has invalid delegation type: %v", m.PoolsDistribution.DelegationType)
 	}
@@ -213,16 +262,26 @@ func (m *MsgEditRewardsPlan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) e
 		return nil
 	}
 
+	if m.EmissionSchedule != nil {
+		var emissionSchedule EmissionSchedule
+		return unpacker.UnpackAny(m.EmissionSchedule, &emissionSchedule)
+	}
+
 	return nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgSetWithdrawAddress creates a new NewMsgSetWithdrawAddress instance
-func NewMsgSetWithdrawAddress(withdrawAddress string, sender string) *MsgSetWithdrawAddress {
+func NewMsgSetWithdrawAddress(
+	withdrawAddress string,
+	ibcDestination *IBCWithdrawDestination,
+	sender string,
+) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
 		Sender:          sender,
 		WithdrawAddress: withdrawAddress,
+		IBCDestination:  ibcDestination,
 	}
 }
 
@@ -238,6 +297,13 @@ func (m *MsgSetWithdrawAddress) ValidateBasic() error {
 		return fmt.Errorf("invalid withdraw address: %s", m.WithdrawAddress)
 	}
 
+	if m.IBCDestination != nil {
+		err = m.IBCDestination.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid ibc destination: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -306,6 +372,98 @@ func (m *MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgWithdrawAllDelegatorRewards creates a new MsgWithdrawAllDelegatorRewards instance
+func NewMsgWithdrawAllDelegatorRewards(
+	delegationType restakingtypes.DelegationType,
+	delegatorAddress string,
+) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delegatorAddress,
+		DelegationType:   delegationType,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, ok := restakingtypes.DelegationType_name[int32(m.DelegationType)]; !ok {
+		return fmt.Errorf("invalid delegation type: %v", m.DelegationType)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address: %s", m.DelegatorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound instance
+func NewMsgSetAutoCompound(
+	delegationType restakingtypes.DelegationType,
+	targetID uint32,
+	enabled bool,
+	delegatorAddress string,
+) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress:   delegatorAddress,
+		DelegationType:     delegationType,
+		DelegationTargetID: targetID,
+		Enabled:            enabled,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetAutoCompound) ValidateBasic() error {
+	if m.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", m.DelegationType)
+	}
+
+	if m.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", m.DelegationTargetID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address: %s", m.DelegatorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // NewMsgWithdrawOperatorCommission creates a new MsgWithdrawOperatorCommission instance
 func NewMsgWithdrawOperatorCommission(operatorID uint32, senderAddress string) *MsgWithdrawOperatorCommission {
 	return &MsgWithdrawOperatorCommission{
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -15,7 +16,7 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
-	types1 "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	types2 "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -47,8 +48,8 @@ type MsgCreateRewardsPlan struct {
 	Sender      string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	ServiceID   uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Amount is the amount of rewards to be distributed.
-	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// Amount is the amount of rewards to be distributed, per day.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	// StartTime is the starting time of the plan.
 	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// EndTime is the ending time of the plan.
@@ -66,6 +67,16 @@ type MsgCreateRewardsPlan struct {
 	// If no fees are specified inside the module parameters, this field can be
 	// omitted.
 	FeeAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=fee_amount,json=feeAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_amount"`
+	// EmissionSchedule is the schedule that defines how the emission rate of
+	// the plan changes over time. If not set, the rewards are distributed at a
+	// constant rate.
+	EmissionSchedule *types1.Any `protobuf:"bytes,11,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// InsufficientFundsBehavior defines what happens when the rewards pool
+	// doesn't have enough funds to distribute all the denoms of Amount.
+	InsufficientFundsBehavior InsufficientFundsBehavior `protobuf:"varint,12,opt,name=insufficient_funds_behavior,json=insufficientFundsBehavior,proto3,enum=milkyway.rewards.v1.InsufficientFundsBehavior" json:"insufficient_funds_behavior,omitempty"`
+	// USDBudget is the optional USD denominated budget of the plan. If set,
+	// Amount must be empty.
+	USDBudget *USDBudget `protobuf:"bytes,13,opt,name=usd_budget,json=usdBudget,proto3" json:"usd_budget,omitempty"`
 }
 
 func (m *MsgCreateRewardsPlan) Reset()         { *m = MsgCreateRewardsPlan{} }
@@ -157,8 +168,8 @@ type MsgEditRewardsPlan struct {
 	// ID is the ID of the rewards plan to be edited.
 	ID          uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Amount is the amount of rewards to be distributed.
-	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// Amount is the amount of rewards to be distributed, per day.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	// StartTime is the starting time of the plan.
 	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// EndTime is the ending time of the plan.
@@ -170,6 +181,16 @@ type MsgEditRewardsPlan struct {
 	// UsersDistribution is the rewards distribution parameters for users who
 	// delegated directly to the service.
 	UsersDistribution UsersDistribution `protobuf:"bytes,9,opt,name=users_distribution,json=usersDistribution,proto3" json:"users_distribution"`
+	// EmissionSchedule is the schedule that defines how the emission rate of
+	// the plan changes over time. If not set, the rewards are distributed at a
+	// constant rate.
+	EmissionSchedule *types1.Any `protobuf:"bytes,10,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// InsufficientFundsBehavior defines what happens when the rewards pool
+	// doesn't have enough funds to distribute all the denoms of Amount.
+	InsufficientFundsBehavior InsufficientFundsBehavior `protobuf:"varint,11,opt,name=insufficient_funds_behavior,json=insufficientFundsBehavior,proto3,enum=milkyway.rewards.v1.InsufficientFundsBehavior" json:"insufficient_funds_behavior,omitempty"`
+	// USDBudget is the optional USD denominated budget of the plan. If set,
+	// Amount must be empty.
+	USDBudget *USDBudget `protobuf:"bytes,12,opt,name=usd_budget,json=usdBudget,proto3" json:"usd_budget,omitempty"`
 }
 
 func (m *MsgEditRewardsPlan) Reset()         { *m = MsgEditRewardsPlan{} }
@@ -248,6 +269,10 @@ var xxx_messageInfo_MsgEditRewardsPlanResponse proto.InternalMessageInfo
 type MsgSetWithdrawAddress struct {
 	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// IBCDestination is the optional destination on another chain to which the
+	// rewards are sent. If set, the withdraw address is used as a fallback
+	// address that receives the rewards when the transfer fails.
+	IBCDestination *IBCWithdrawDestination `protobuf:"bytes,3,opt,name=ibc_destination,json=ibcDestination,proto3" json:"ibc_destination,omitempty"`
 }
 
 func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
@@ -325,7 +350,7 @@ var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
 // from a single delegation target.
 type MsgWithdrawDelegatorReward struct {
 	DelegatorAddress   string                `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	DelegationType     types1.DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	DelegationType     types2.DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
 	DelegationTargetID uint32                `protobuf:"varint,3,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
 }
 
@@ -408,6 +433,107 @@ func (m *MsgWithdrawDelegatorRewardResponse) GetAmount() github_com_cosmos_cosmo
 	return nil
 }
 
+// MsgWithdrawAllDelegatorRewards represents delegation withdrawal to a
+// delegator from all the delegation targets.
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// DelegationType is the type of the delegations to withdraw the rewards
+	// from. If not specified, the rewards are withdrawn from all the
+	// delegations.
+	DelegationType types2.DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bde47e6c27c581f, []int{8}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+// MsgWithdrawAllDelegatorRewardsResponse defines the
+// Msg/WithdrawAllDelegatorRewards response type.
+type MsgWithdrawAllDelegatorRewardsResponse struct {
+	// Rewards contains the rewards withdrawn from each delegation target
+	Rewards []WithdrawnDelegationReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// Total is the total amount of rewards withdrawn
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Reset() {
+	*m = MsgWithdrawAllDelegatorRewardsResponse{}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bde47e6c27c581f, []int{9}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetRewards() []WithdrawnDelegationReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 // MsgWithdrawOperatorCommission withdraws the full commission to the operator.
 type MsgWithdrawOperatorCommission struct {
 	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -418,7 +544,7 @@ func (m *MsgWithdrawOperatorCommission) Reset()         { *m = MsgWithdrawOperat
 func (m *MsgWithdrawOperatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawOperatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawOperatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bde47e6c27c581f, []int{8}
+	return fileDescriptor_1bde47e6c27c581f, []int{10}
 }
 func (m *MsgWithdrawOperatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +584,7 @@ func (m *MsgWithdrawOperatorCommissionResponse) Reset()         { *m = MsgWithdr
 func (m *MsgWithdrawOperatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawOperatorCommissionResponse) ProtoMessage()    {}
 func (*MsgWithdrawOperatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bde47e6c27c581f, []int{9}
+	return fileDescriptor_1bde47e6c27c581f, []int{11}
 }
 func (m *MsgWithdrawOperatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,6 +620,86 @@ func (m *MsgWithdrawOperatorCommissionResponse) GetAmount() github_com_cosmos_co
 	return nil
 }
 
+// MsgSetAutoCompound enables or disables the auto-compounding of the rewards
+// that a delegator receives from a single delegation target.
+type MsgSetAutoCompound struct {
+	DelegatorAddress   string                `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DelegationType     types2.DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	DelegationTargetID uint32                `protobuf:"varint,3,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	// Enabled tells whether the auto-compounding should be enabled or disabled
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bde47e6c27c581f, []int{12}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bde47e6c27c581f, []int{13}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines the message structure for the UpdateParams gRPC
 // service method. It allows the authority to update the module parameters.
 type MsgUpdateParams struct {
@@ -510,7 +716,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bde47e6c27c581f, []int{10}
+	return fileDescriptor_1bde47e6c27c581f, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +753,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bde47e6c27c581f, []int{11}
+	return fileDescriptor_1bde47e6c27c581f, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,8 +791,12 @@ func init() {
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "milkyway.rewards.v1.MsgSetWithdrawAddressResponse")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "milkyway.rewards.v1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "milkyway.rewards.v1.MsgWithdrawDelegatorRewardResponse")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "milkyway.rewards.v1.MsgWithdrawAllDelegatorRewards")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewardsResponse)(nil), "milkyway.rewards.v1.MsgWithdrawAllDelegatorRewardsResponse")
 	proto.RegisterType((*MsgWithdrawOperatorCommission)(nil), "milkyway.rewards.v1.MsgWithdrawOperatorCommission")
 	proto.RegisterType((*MsgWithdrawOperatorCommissionResponse)(nil), "milkyway.rewards.v1.MsgWithdrawOperatorCommissionResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "milkyway.rewards.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "milkyway.rewards.v1.MsgSetAutoCompoundResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "milkyway.rewards.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "milkyway.rewards.v1.MsgUpdateParamsResponse")
 }
@@ -596,82 +806,104 @@ func init() {
 }
 
 var fileDescriptor_1bde47e6c27c581f = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe6, 0x87, 0x1b, 0x4f, 0x9a, 0x5f, 0xdb, 0x34, 0x71, 0xb6, 0xad, 0xd7, 0x2c, 0xb4,
-	0x84, 0xa8, 0xd9, 0xad, 0x5d, 0xb5, 0x15, 0x46, 0x02, 0xd5, 0x76, 0x2b, 0x7c, 0x48, 0x5b, 0x6d,
-	0x5a, 0x90, 0x40, 0xc2, 0x5a, 0x7b, 0x26, 0x9b, 0x25, 0xde, 0x1d, 0xb3, 0x33, 0x8e, 0xf1, 0x0d,
-	0xc1, 0x05, 0x10, 0x12, 0x3d, 0x73, 0xa1, 0xe2, 0x84, 0x38, 0xe5, 0xc0, 0x3f, 0x00, 0x12, 0xa8,
-	0xc7, 0x8a, 0x13, 0x12, 0x92, 0x8b, 0x9c, 0x43, 0xf8, 0x33, 0xd0, 0xce, 0xce, 0x6e, 0x36, 0xf6,
-	0x3a, 0x89, 0xe1, 0x82, 0x50, 0x2f, 0x89, 0xf7, 0xbd, 0xef, 0x7d, 0x33, 0xef, 0x9b, 0xf7, 0xde,
-	0x0c, 0x50, 0x6c, 0xab, 0xb1, 0xd3, 0x69, 0x1b, 0x1d, 0xcd, 0x45, 0x6d, 0xc3, 0x85, 0x44, 0xdb,
-	0xcd, 0x69, 0x36, 0x22, 0xc4, 0x30, 0x11, 0x51, 0x9b, 0x2e, 0xa6, 0x58, 0x3c, 0x17, 0x60, 0x54,
-	0x8e, 0x51, 0x77, 0x73, 0xd2, 0x82, 0x61, 0x5b, 0x0e, 0xd6, 0xd8, 0x5f, 0x1f, 0x27, 0x65, 0xea,
-	0x98, 0xd8, 0x98, 0x68, 0x35, 0x83, 0x20, 0x6d, 0x37, 0x57, 0x43, 0xd4, 0xc8, 0x69, 0x75, 0x6c,
-	0x39, 0xdc, 0xbf, 0xcc, 0xfd, 0x36, 0x31, 0xd9, 0x2a, 0xc4, 0xe4, 0x8e, 0x15, 0xdf, 0x51, 0x65,
-	0x5f, 0x9a, 0xff, 0xc1, 0x5d, 0x8b, 0x26, 0x36, 0xb1, 0x6f, 0xf7, 0x7e, 0x71, 0xab, 0x6c, 0x62,
-	0x6c, 0x36, 0x90, 0xc6, 0xbe, 0x6a, 0xad, 0x2d, 0x8d, 0x5a, 0x36, 0x22, 0xd4, 0xb0, 0x9b, 0x1c,
-	0x10, 0x4d, 0x8b, 0x50, 0x63, 0xc7, 0x72, 0xfc, 0x25, 0x31, 0x44, 0x8d, 0x80, 0x3a, 0x1b, 0x9b,
-	0xfa, 0x89, 0x88, 0xa6, 0xe1, 0x1a, 0x36, 0x47, 0x28, 0x5f, 0x27, 0xc1, 0xe2, 0x06, 0x31, 0x4b,
-	0x2e, 0x32, 0x28, 0xd2, 0x7d, 0xd0, 0x83, 0x86, 0xe1, 0x88, 0xd7, 0x40, 0x92, 0x20, 0x07, 0x22,
-	0x37, 0x2d, 0x64, 0x85, 0xd5, 0x54, 0x31, 0xfd, 0xdb, 0x8f, 0xeb, 0x8b, 0x3c, 0xb3, 0xdb, 0x10,
-	0xba, 0x88, 0x90, 0x4d, 0xea, 0x5a, 0x8e, 0xa9, 0x73, 0x9c, 0x98, 0x05, 0xd3, 0x10, 0x91, 0xba,
-	0x6b, 0x35, 0xa9, 0x85, 0x9d, 0xf4, 0x98, 0x17, 0xa6, 0x47, 0x4d, 0xe2, 0x55, 0x00, 0x08, 0x72,
-	0x77, 0xad, 0x3a, 0xaa, 0x5a, 0x30, 0x3d, 0x9e, 0x15, 0x56, 0x67, 0x8a, 0x33, 0xbd, 0xae, 0x9c,
-	0xda, 0xf4, 0xad, 0x95, 0xb2, 0x9e, 0xe2, 0x80, 0x0a, 0x14, 0x6f, 0x81, 0xa4, 0x61, 0xe3, 0x96,
-	0x43, 0xd3, 0x13, 0x59, 0x61, 0x75, 0x3a, 0xbf, 0xa2, 0xf2, 0xe5, 0xbd, 0xe3, 0x51, 0xf9, 0xf1,
-	0xa8, 0x25, 0x6c, 0x39, 0xc5, 0x89, 0xa7, 0x5d, 0x39, 0xa1, 0x73, 0xb8, 0x58, 0x02, 0x80, 0x50,
-	0xc3, 0xa5, 0x55, 0x4f, 0xd4, 0xf4, 0x24, 0x0b, 0x96, 0x54, 0x5f, 0x71, 0x35, 0x50, 0x5c, 0x7d,
-	0x18, 0x28, 0x5e, 0x9c, 0xf2, 0xa2, 0x1f, 0x3f, 0x97, 0x05, 0x3d, 0xc5, 0xe2, 0x3c, 0x8f, 0xf8,
-	0x16, 0x98, 0x42, 0x0e, 0xf4, 0x29, 0x92, 0x23, 0x50, 0x9c, 0x41, 0x0e, 0x64, 0x04, 0xef, 0x00,
-	0xb1, 0x89, 0x71, 0x83, 0x54, 0xa1, 0x45, 0xa8, 0x6b, 0xd5, 0x5a, 0x4c, 0x95, 0x33, 0x8c, 0xea,
-	0x25, 0x35, 0xa6, 0x22, 0xd5, 0x72, 0x04, 0xc8, 0x53, 0x5a, 0x60, 0x14, 0x51, 0x87, 0xf8, 0x01,
-	0x58, 0xc2, 0x4d, 0xe4, 0x1a, 0x14, 0xbb, 0x7d, 0xdc, 0x53, 0xa3, 0x71, 0x9f, 0x0f, 0x69, 0x8e,
-	0xf0, 0xbf, 0x0f, 0xc4, 0x16, 0x41, 0xfd, 0xdc, 0x29, 0xc6, 0x7d, 0x25, 0x96, 0xfb, 0x91, 0x07,
-	0x8f, 0xdb, 0x7c, 0xab, 0xdf, 0x21, 0x7e, 0x08, 0xc0, 0x16, 0x42, 0x55, 0x7e, 0xae, 0x20, 0x3b,
-	0x7e, 0xfc, 0xb9, 0x5e, 0xf3, 0x78, 0x7e, 0x78, 0x2e, 0xaf, 0x9a, 0x16, 0xdd, 0x6e, 0xd5, 0xd4,
-	0x3a, 0xb6, 0x79, 0x77, 0xf1, 0x7f, 0xeb, 0x04, 0xee, 0x68, 0xb4, 0xd3, 0x44, 0x84, 0x05, 0x10,
-	0x3d, 0xb5, 0x85, 0xd0, 0x6d, 0xc6, 0x5e, 0xb8, 0xf1, 0xf9, 0x13, 0x39, 0xf1, 0xd7, 0x13, 0x39,
-	0xf1, 0xe9, 0xc1, 0xde, 0x1a, 0x2f, 0xd2, 0x2f, 0x0f, 0xf6, 0xd6, 0x2e, 0x85, 0x4d, 0x11, 0x57,
-	0xf8, 0x4a, 0x1d, 0x5c, 0x8c, 0xb3, 0xeb, 0x88, 0x34, 0xb1, 0x43, 0x90, 0x58, 0x02, 0xe7, 0x1c,
-	0xd4, 0xae, 0xf2, 0xfc, 0xab, 0xcd, 0x86, 0xe1, 0x78, 0xd5, 0xec, 0x75, 0xc9, 0x44, 0x71, 0xb1,
-	0xd7, 0x95, 0xe7, 0xef, 0xa1, 0x76, 0x24, 0xb0, 0x52, 0xd6, 0xe7, 0x9d, 0xa3, 0x16, 0xa8, 0x7c,
-	0x31, 0x09, 0xc4, 0x0d, 0x62, 0xde, 0x81, 0x16, 0xfd, 0x77, 0x4d, 0xb7, 0x04, 0xc6, 0x2c, 0xc8,
-	0x7a, 0x6d, 0xa2, 0x98, 0xec, 0x75, 0xe5, 0xb1, 0x4a, 0x59, 0x1f, 0xb3, 0x60, 0x7f, 0x33, 0x8e,
-	0x0f, 0x36, 0xe3, 0x8b, 0xf6, 0xfa, 0xdf, 0xb5, 0x57, 0xe1, 0xfa, 0x90, 0x92, 0xbf, 0x10, 0x2d,
-	0xf9, 0xbe, 0xa2, 0x53, 0x2e, 0x02, 0x69, 0xd0, 0x1a, 0x94, 0xbb, 0xf2, 0xab, 0x00, 0xce, 0x6f,
-	0x10, 0x73, 0x13, 0xd1, 0x77, 0x2d, 0xba, 0x0d, 0x5d, 0xa3, 0xcd, 0x0b, 0xf1, 0x1f, 0x14, 0x6b,
-	0x09, 0xcc, 0xb7, 0x39, 0x49, 0xd5, 0xf0, 0x11, 0xfe, 0x35, 0x71, 0x4c, 0xec, 0x5c, 0xfb, 0xe8,
-	0xb2, 0x85, 0x9b, 0x43, 0x72, 0xcc, 0x44, 0x73, 0x1c, 0xdc, 0xae, 0x22, 0x83, 0x4b, 0xb1, 0x8e,
-	0x30, 0xd3, 0x5f, 0xc6, 0x98, 0x10, 0x81, 0xbb, 0x8c, 0x1a, 0xc8, 0xf4, 0xce, 0xcf, 0x57, 0x45,
-	0xbc, 0x03, 0x16, 0x60, 0x60, 0x0a, 0x77, 0x7f, 0x52, 0xe6, 0xf3, 0x61, 0x48, 0xa0, 0xda, 0x3d,
-	0x30, 0xc7, 0x6d, 0x16, 0x76, 0xaa, 0xde, 0xe8, 0x62, 0x12, 0xcc, 0xe6, 0x2f, 0x47, 0x0f, 0x9f,
-	0x5f, 0xf9, 0xac, 0xb4, 0x42, 0xf4, 0xc3, 0x4e, 0x13, 0xe9, 0xb3, 0xf0, 0xc8, 0xb7, 0xf8, 0x36,
-	0x58, 0x8c, 0xf2, 0x19, 0xae, 0x89, 0xe8, 0xe1, 0xed, 0xba, 0xd4, 0xeb, 0xca, 0x62, 0x84, 0x81,
-	0xb9, 0x2b, 0x65, 0x5d, 0x84, 0xfd, 0x36, 0x58, 0xb8, 0x1b, 0x15, 0x76, 0x30, 0x57, 0x4f, 0xe3,
-	0x97, 0xa3, 0x1a, 0x0f, 0x11, 0x4a, 0xf9, 0x56, 0x00, 0xca, 0x70, 0x77, 0x38, 0x47, 0x3b, 0xe1,
-	0xfc, 0x11, 0x4e, 0xba, 0x06, 0xee, 0x8e, 0x7a, 0x0d, 0x7c, 0x73, 0xb0, 0xb7, 0x76, 0xd6, 0x5b,
-	0xb6, 0xde, 0xa9, 0x7a, 0xef, 0x37, 0xf2, 0xfd, 0xc1, 0xde, 0x9a, 0x10, 0x4c, 0x30, 0xe5, 0x67,
-	0x81, 0xd5, 0x42, 0xb0, 0xc3, 0xfb, 0xbc, 0x51, 0x4b, 0xd8, 0xb6, 0x2d, 0x42, 0xbc, 0x2e, 0x1d,
-	0xbd, 0xb6, 0x35, 0x30, 0x1d, 0x34, 0x7c, 0x95, 0x4f, 0xe4, 0x99, 0xe2, 0x6c, 0xaf, 0x2b, 0x83,
-	0x80, 0xbe, 0x52, 0xd6, 0x41, 0x00, 0xa9, 0xc0, 0xc2, 0x9b, 0x43, 0xea, 0xf8, 0x4a, 0x9c, 0xc6,
-	0x83, 0x5b, 0x54, 0xbe, 0x13, 0xc0, 0xe5, 0x63, 0x11, 0xff, 0x05, 0xa5, 0x7f, 0x12, 0xc0, 0xdc,
-	0x06, 0x31, 0x1f, 0x35, 0xa1, 0x41, 0xd1, 0x03, 0xf6, 0xf0, 0x14, 0x6f, 0x82, 0x94, 0xd1, 0xa2,
-	0xdb, 0xd8, 0xb5, 0x68, 0xe7, 0x44, 0x79, 0x0f, 0xa1, 0xe2, 0xeb, 0x20, 0xe9, 0x3f, 0x5d, 0x99,
-	0xb8, 0xd3, 0xf9, 0x0b, 0xb1, 0xd3, 0xd2, 0x5f, 0x24, 0xb8, 0xb2, 0xfc, 0x80, 0xc2, 0x1b, 0x51,
-	0xad, 0x0f, 0x29, 0x3d, 0xb9, 0x07, 0x9f, 0xc8, 0x7d, 0xfb, 0x55, 0x56, 0xc0, 0x72, 0x9f, 0x29,
-	0x50, 0x36, 0xff, 0xc7, 0x24, 0x18, 0xdf, 0x20, 0xa6, 0xf8, 0x11, 0x58, 0x18, 0x7c, 0x41, 0xbf,
-	0x16, 0xbb, 0xbf, 0xb8, 0xb7, 0x85, 0x94, 0x3b, 0x35, 0x34, 0x3c, 0xd4, 0x1d, 0x30, 0xd7, 0xff,
-	0x7a, 0x78, 0x75, 0x18, 0x4b, 0x1f, 0x50, 0xd2, 0x4e, 0x09, 0x0c, 0x17, 0xa3, 0x40, 0x8c, 0xb9,
-	0x00, 0xd6, 0x86, 0xd1, 0x0c, 0x62, 0xa5, 0xfc, 0xe9, 0xb1, 0xe1, 0xaa, 0x9f, 0x09, 0x60, 0x79,
-	0xd8, 0x34, 0x1e, 0x9a, 0xc2, 0x90, 0x00, 0xe9, 0xd6, 0x88, 0x01, 0xe1, 0x2e, 0xbe, 0x12, 0x80,
-	0x74, 0xcc, 0xa4, 0xc8, 0x9f, 0xc4, 0x3b, 0x18, 0x23, 0x15, 0x46, 0x8f, 0x09, 0xb7, 0x53, 0x03,
-	0x67, 0x8f, 0x74, 0xd3, 0x2b, 0xc3, 0xb8, 0xa2, 0x28, 0xe9, 0xea, 0x69, 0x50, 0xc1, 0x1a, 0xd2,
-	0xe4, 0x27, 0x5e, 0x13, 0x17, 0xef, 0x3f, 0xed, 0x65, 0x84, 0x67, 0xbd, 0x8c, 0xf0, 0x67, 0x2f,
-	0x23, 0x3c, 0xde, 0xcf, 0x24, 0x9e, 0xed, 0x67, 0x12, 0xbf, 0xef, 0x67, 0x12, 0xef, 0xdd, 0x88,
-	0x8c, 0x87, 0x80, 0x78, 0xbd, 0x61, 0xd4, 0x48, 0xf8, 0xa5, 0xed, 0xe6, 0xf2, 0xda, 0xc7, 0x61,
-	0x4f, 0xb1, 0x89, 0x51, 0x4b, 0xb2, 0xa7, 0xdd, 0xf5, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7a,
-	0x6f, 0xd0, 0x65, 0xb4, 0x0f, 0x00, 0x00,
+	// 1539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x69, 0x12, 0x8f, 0xf3, 0x73, 0x9b, 0xb6, 0x8e, 0xd3, 0xda, 0xfe, 0xfa, 0xdb,
+	0x1f, 0x26, 0x34, 0xde, 0xc6, 0x55, 0x8b, 0x48, 0x25, 0x50, 0xd6, 0x6e, 0x85, 0x11, 0x69, 0xab,
+	0x4d, 0x0b, 0x12, 0x48, 0xac, 0xd6, 0x9e, 0xc9, 0x66, 0x88, 0xbd, 0x63, 0x76, 0x66, 0x63, 0x7c,
+	0x43, 0xf4, 0x82, 0x10, 0x12, 0x3d, 0x73, 0xa1, 0xe2, 0x84, 0x38, 0xe5, 0xd0, 0x7f, 0x00, 0x24,
+	0xa4, 0x8a, 0x0b, 0x15, 0x27, 0xc4, 0x21, 0xad, 0x5c, 0x89, 0xf0, 0x17, 0x70, 0x46, 0xbb, 0x3b,
+	0xbb, 0xd9, 0xac, 0xd7, 0x71, 0xd2, 0xf2, 0xa3, 0x02, 0x2e, 0x89, 0x67, 0xde, 0xe7, 0x7d, 0xe6,
+	0xcd, 0x7b, 0x3b, 0x9f, 0x37, 0xbb, 0x20, 0xd7, 0xc0, 0xf5, 0x8d, 0x76, 0x4b, 0x6b, 0x4b, 0x26,
+	0x6a, 0x69, 0x26, 0xa4, 0xd2, 0xe6, 0xa2, 0xd4, 0x40, 0x94, 0x6a, 0x3a, 0xa2, 0x85, 0xa6, 0x49,
+	0x18, 0x11, 0x8f, 0x7a, 0x98, 0x02, 0xc7, 0x14, 0x36, 0x17, 0x53, 0xd3, 0x5a, 0x03, 0x1b, 0x44,
+	0x72, 0xfe, 0xba, 0xb8, 0x54, 0xba, 0x46, 0x68, 0x83, 0x50, 0xa9, 0xaa, 0x51, 0x24, 0x6d, 0x2e,
+	0x56, 0x11, 0xd3, 0x16, 0xa5, 0x1a, 0xc1, 0x06, 0xb7, 0x9f, 0xe0, 0xf6, 0x06, 0xd5, 0x9d, 0x55,
+	0xa8, 0xce, 0x0d, 0xb3, 0xae, 0x41, 0x75, 0x46, 0x92, 0x3b, 0xe0, 0xa6, 0x19, 0x9d, 0xe8, 0xc4,
+	0x9d, 0xb7, 0x7f, 0x79, 0x0e, 0x3a, 0x21, 0x7a, 0x1d, 0x49, 0xce, 0xa8, 0x6a, 0xad, 0x49, 0x9a,
+	0xd1, 0xe6, 0xa6, 0x4c, 0xd8, 0xc4, 0x70, 0x03, 0x51, 0xa6, 0x35, 0x9a, 0x1c, 0x10, 0xdc, 0x31,
+	0x65, 0xda, 0x06, 0x36, 0xdc, 0x68, 0x08, 0x44, 0x75, 0x6f, 0xd5, 0x6c, 0x64, 0x56, 0xfa, 0x22,
+	0x9a, 0x9a, 0xa9, 0x35, 0x38, 0x22, 0xf7, 0xcb, 0x28, 0x98, 0x59, 0xa1, 0x7a, 0xc9, 0x44, 0x1a,
+	0x43, 0x8a, 0x0b, 0xba, 0x59, 0xd7, 0x0c, 0xf1, 0x02, 0x18, 0xa6, 0xc8, 0x80, 0xc8, 0x4c, 0x0a,
+	0x59, 0x21, 0x1f, 0x97, 0x93, 0x3f, 0xde, 0x5f, 0x98, 0xe1, 0x9b, 0x5e, 0x86, 0xd0, 0x44, 0x94,
+	0xae, 0x32, 0x13, 0x1b, 0xba, 0xc2, 0x71, 0x62, 0x16, 0x24, 0x20, 0xa2, 0x35, 0x13, 0x37, 0x19,
+	0x26, 0x46, 0x32, 0x66, 0xbb, 0x29, 0xc1, 0x29, 0xf1, 0x3c, 0x00, 0x14, 0x99, 0x9b, 0xb8, 0x86,
+	0x54, 0x0c, 0x93, 0x83, 0x59, 0x21, 0x3f, 0x2e, 0x8f, 0x77, 0xb6, 0x33, 0xf1, 0x55, 0x77, 0xb6,
+	0x52, 0x56, 0xe2, 0x1c, 0x50, 0x81, 0x62, 0x0d, 0x0c, 0x6b, 0x0d, 0x62, 0x19, 0x2c, 0x39, 0x94,
+	0x1d, 0xcc, 0x27, 0x8a, 0xb3, 0x05, 0xbe, 0xbc, 0x5d, 0xb9, 0x02, 0xaf, 0x5c, 0xa1, 0x44, 0xb0,
+	0x21, 0x5f, 0x78, 0xb0, 0x9d, 0x19, 0xf8, 0xfa, 0x51, 0x26, 0xaf, 0x63, 0xb6, 0x6e, 0x55, 0x0b,
+	0x35, 0xd2, 0xe0, 0x05, 0xe2, 0xff, 0x16, 0x28, 0xdc, 0x90, 0x58, 0xbb, 0x89, 0xa8, 0xe3, 0x40,
+	0x15, 0x4e, 0x2d, 0x96, 0x00, 0xa0, 0x4c, 0x33, 0x99, 0x6a, 0x17, 0x20, 0x79, 0x24, 0x2b, 0xe4,
+	0x13, 0xc5, 0x54, 0xc1, 0xad, 0x4e, 0xc1, 0xab, 0x4e, 0xe1, 0x96, 0x57, 0x1d, 0x79, 0xd4, 0x5e,
+	0xe9, 0xee, 0xa3, 0x8c, 0xa0, 0xc4, 0x1d, 0x3f, 0xdb, 0x22, 0xbe, 0x0a, 0x46, 0x91, 0x01, 0x5d,
+	0x8a, 0xe1, 0x43, 0x50, 0x8c, 0x20, 0x03, 0x3a, 0x04, 0x6f, 0x02, 0xb1, 0x49, 0x48, 0x9d, 0xaa,
+	0x10, 0x53, 0x66, 0xe2, 0xaa, 0xe5, 0x64, 0x70, 0xc4, 0xa1, 0xfa, 0x5f, 0x21, 0xe2, 0xc1, 0x2e,
+	0x94, 0x03, 0x40, 0x79, 0xc8, 0x66, 0x54, 0xa6, 0x1d, 0x8a, 0xa0, 0x41, 0x7c, 0x17, 0x1c, 0x27,
+	0x4d, 0x64, 0x6a, 0x8c, 0x98, 0x21, 0xee, 0xd1, 0xc3, 0x71, 0x1f, 0xf3, 0x69, 0xf6, 0xf0, 0xbf,
+	0x03, 0x44, 0x8b, 0xa2, 0x30, 0x77, 0xdc, 0xe1, 0x3e, 0x1b, 0xc9, 0x7d, 0x9b, 0xa2, 0xbd, 0x1c,
+	0x5e, 0xf0, 0x56, 0xd8, 0x20, 0xbe, 0x07, 0xc0, 0x1a, 0x42, 0x2a, 0x7f, 0x06, 0xc0, 0x1f, 0xff,
+	0x0c, 0xc4, 0xd7, 0x10, 0x5a, 0x76, 0x1f, 0x83, 0x0d, 0x30, 0x8d, 0x1a, 0x98, 0x52, 0x4c, 0x0c,
+	0x95, 0xd6, 0xd6, 0x11, 0xb4, 0xea, 0x28, 0x99, 0x70, 0xf6, 0x31, 0xd3, 0x55, 0xca, 0x65, 0xa3,
+	0x2d, 0xe7, 0xbf, 0xbf, 0xbf, 0x70, 0x3a, 0x6a, 0x83, 0x57, 0x39, 0xcd, 0x2a, 0x67, 0x51, 0xa6,
+	0x50, 0x68, 0x46, 0x34, 0xc0, 0x1c, 0x36, 0xa8, 0xb5, 0xb6, 0x86, 0x6b, 0x18, 0x19, 0x4c, 0x5d,
+	0xb3, 0x0c, 0x48, 0xd5, 0x2a, 0x5a, 0xd7, 0x36, 0x31, 0x31, 0x93, 0x63, 0x59, 0x21, 0x3f, 0x51,
+	0x2c, 0x44, 0xa6, 0xaf, 0x12, 0xf0, 0xbb, 0x66, 0xbb, 0xc9, 0xdc, 0x4b, 0x99, 0xc5, 0xbd, 0x4c,
+	0xe2, 0x1b, 0x00, 0x58, 0x14, 0xaa, 0x55, 0x0b, 0xea, 0x88, 0x25, 0xc7, 0x9d, 0x5d, 0xa5, 0xa3,
+	0xab, 0xb3, 0x5a, 0x96, 0x1d, 0x94, 0x7b, 0x2c, 0xfd, 0xa1, 0x12, 0xb7, 0x28, 0x74, 0x7f, 0x2e,
+	0x5d, 0xfa, 0xf8, 0x5e, 0x66, 0xe0, 0xd7, 0x7b, 0x99, 0x81, 0x8f, 0x76, 0xb6, 0xe6, 0xf9, 0xd9,
+	0xff, 0x64, 0x67, 0x6b, 0xfe, 0x94, 0xaf, 0x35, 0x51, 0x7a, 0x92, 0xab, 0x81, 0x93, 0x51, 0xf3,
+	0x0a, 0xa2, 0x4d, 0x62, 0x50, 0x24, 0x96, 0xc0, 0x51, 0x03, 0xb5, 0x54, 0x1e, 0x8c, 0xda, 0xac,
+	0x6b, 0x86, 0x2d, 0x12, 0xb6, 0xf8, 0x0c, 0xc9, 0x33, 0x9d, 0xed, 0xcc, 0xd4, 0x75, 0xd4, 0x0a,
+	0x38, 0x56, 0xca, 0xca, 0x94, 0xb1, 0x77, 0x06, 0xe6, 0x1e, 0x8f, 0x00, 0x71, 0x85, 0xea, 0x57,
+	0x21, 0x66, 0xcf, 0xa6, 0x65, 0xc7, 0x41, 0x0c, 0x43, 0x47, 0xc2, 0x86, 0xe4, 0xe1, 0xce, 0x76,
+	0x26, 0x56, 0x29, 0x2b, 0x31, 0x0c, 0xc3, 0x1a, 0x37, 0xd8, 0xad, 0x71, 0xff, 0xa9, 0xd6, 0xbf,
+	0x5b, 0xb5, 0x22, 0x95, 0x04, 0xfc, 0x3d, 0x4a, 0x92, 0xf8, 0x73, 0x95, 0x64, 0xec, 0x19, 0x95,
+	0xe4, 0x62, 0x0f, 0x25, 0x99, 0x0b, 0x2a, 0x49, 0xe8, 0x2c, 0xe7, 0x4e, 0x82, 0x54, 0xf7, 0xac,
+	0xa7, 0x22, 0xb9, 0xad, 0x18, 0x38, 0xb6, 0x42, 0xf5, 0x55, 0xc4, 0xde, 0xc2, 0x6c, 0x1d, 0x9a,
+	0x5a, 0x8b, 0x9f, 0xef, 0xa7, 0xd0, 0x80, 0x12, 0x98, 0x6a, 0x71, 0x12, 0x55, 0x73, 0x11, 0xc9,
+	0x58, 0x1f, 0xdf, 0xc9, 0x56, 0x68, 0xd9, 0x75, 0x30, 0x89, 0xab, 0x35, 0x15, 0x22, 0xca, 0xb0,
+	0xa1, 0xf9, 0xa2, 0x91, 0x28, 0xbe, 0x18, 0x5d, 0x15, 0xb9, 0xe4, 0x05, 0x5e, 0xde, 0x75, 0x91,
+	0xc5, 0xce, 0x76, 0x66, 0xa2, 0x22, 0x97, 0x02, 0x73, 0xca, 0x04, 0xae, 0xd6, 0x02, 0xe3, 0xa5,
+	0xcb, 0x3d, 0xb2, 0x99, 0x0e, 0x66, 0xb3, 0x3b, 0x31, 0xb9, 0x0c, 0x38, 0x15, 0x69, 0xf0, 0x73,
+	0xfa, 0x5d, 0xcc, 0x49, 0xf9, 0x6e, 0x5c, 0x75, 0xa4, 0xdb, 0x87, 0xca, 0xcd, 0xbf, 0x78, 0x15,
+	0x4c, 0x43, 0x6f, 0xca, 0xcf, 0x53, 0xbf, 0x1c, 0x4f, 0xf9, 0x2e, 0x5e, 0xa2, 0xae, 0x83, 0x49,
+	0x3e, 0x67, 0x9f, 0x1c, 0x5b, 0xf4, 0x9c, 0x64, 0x4f, 0x14, 0xcf, 0x04, 0x13, 0xc5, 0xaf, 0xc2,
+	0xce, 0x79, 0xf7, 0xd1, 0xb7, 0xda, 0x4d, 0xa4, 0x4c, 0xc0, 0x3d, 0x63, 0xf1, 0x35, 0x30, 0x13,
+	0xe4, 0xd3, 0x4c, 0x1d, 0xb1, 0xdd, 0x5b, 0xe7, 0xf1, 0xce, 0x76, 0x46, 0x0c, 0x30, 0x38, 0xe6,
+	0x4a, 0x59, 0x11, 0x61, 0x78, 0x0e, 0x2e, 0x5d, 0x0b, 0x26, 0xb6, 0x7b, 0xaf, 0x76, 0x8e, 0xff,
+	0x1f, 0xcc, 0x71, 0x8f, 0x44, 0xe5, 0xbe, 0x10, 0x40, 0xae, 0xb7, 0xd9, 0x6f, 0x84, 0x6d, 0xbf,
+	0x81, 0x08, 0xfd, 0x1a, 0xc8, 0xb5, 0xc3, 0x36, 0x90, 0xcf, 0x77, 0xb6, 0xe6, 0xc7, 0xec, 0x65,
+	0x6b, 0x6d, 0xd5, 0x7e, 0xe5, 0xa1, 0x5f, 0xed, 0x6c, 0xcd, 0x0b, 0x5e, 0x5b, 0xc9, 0xdd, 0x89,
+	0x81, 0x74, 0x20, 0xc2, 0xe5, 0x7a, 0x3d, 0x14, 0x24, 0x7d, 0x4e, 0xab, 0xbd, 0xf4, 0x7a, 0xff,
+	0x1a, 0x9d, 0x8b, 0xaa, 0x51, 0xc4, 0x16, 0x73, 0xbf, 0x09, 0xe0, 0xec, 0xfe, 0x10, 0xbf, 0x56,
+	0xab, 0x60, 0x84, 0x1f, 0x5e, 0x5e, 0xac, 0x68, 0xad, 0xf5, 0xa8, 0x8c, 0xdd, 0x5d, 0xb8, 0x4c,
+	0x72, 0xdc, 0xae, 0xa0, 0x5b, 0x04, 0x8f, 0x49, 0x6c, 0x81, 0x23, 0x8c, 0x30, 0xad, 0x9e, 0x8c,
+	0xfd, 0x55, 0xf5, 0x77, 0xd7, 0xcb, 0x7d, 0x2b, 0x38, 0x52, 0xe0, 0x45, 0x7b, 0x83, 0x37, 0xcf,
+	0x12, 0x69, 0xf0, 0xbe, 0xf3, 0x14, 0x22, 0x2a, 0x81, 0x84, 0xd7, 0x84, 0x55, 0x7e, 0xa3, 0x1a,
+	0x97, 0x27, 0x3a, 0xdb, 0x19, 0xe0, 0xd1, 0x57, 0xca, 0x0a, 0xf0, 0x20, 0x15, 0xb8, 0xf4, 0x4a,
+	0x0f, 0x19, 0x3b, 0x1b, 0x55, 0xbe, 0xee, 0x10, 0x73, 0x5f, 0x0a, 0xe0, 0xcc, 0xbe, 0x88, 0xe7,
+	0xe1, 0xa0, 0xfd, 0x10, 0x73, 0xee, 0xa9, 0xab, 0x88, 0x2d, 0x5b, 0x8c, 0x94, 0x48, 0xa3, 0x49,
+	0x2c, 0xe3, 0x9f, 0x2f, 0xa5, 0x62, 0x12, 0x8c, 0x20, 0x43, 0xab, 0xd6, 0x11, 0x4c, 0x0e, 0x65,
+	0x85, 0xfc, 0xa8, 0xe2, 0x0d, 0xf7, 0x96, 0x3d, 0xfa, 0x00, 0xcf, 0x85, 0x1a, 0x59, 0x30, 0x75,
+	0xfc, 0x5a, 0x10, 0x9a, 0xf5, 0x5b, 0xd8, 0x37, 0x02, 0x98, 0x5c, 0xa1, 0xfa, 0xed, 0x26, 0xd4,
+	0x18, 0xba, 0xe9, 0x7c, 0xff, 0x10, 0x2f, 0x83, 0xb8, 0x66, 0xb1, 0x75, 0x62, 0x62, 0xd6, 0xee,
+	0x9b, 0xe4, 0x5d, 0xa8, 0xf8, 0x32, 0x18, 0x76, 0xbf, 0xa0, 0x38, 0x49, 0x4d, 0x14, 0xe7, 0x22,
+	0x8f, 0xbc, 0xbb, 0x08, 0xbf, 0x26, 0x72, 0x87, 0xa5, 0x2b, 0xc1, 0x4d, 0xee, 0x52, 0xda, 0x9b,
+	0xeb, 0xfe, 0x52, 0x13, 0x8a, 0x37, 0x37, 0x0b, 0x4e, 0x84, 0xa6, 0xbc, 0xed, 0x15, 0x7f, 0x1e,
+	0x01, 0x83, 0x2b, 0x54, 0x17, 0xdf, 0x07, 0xd3, 0xdd, 0x1f, 0x72, 0x5e, 0x88, 0x8c, 0x2f, 0xea,
+	0x5d, 0x2c, 0xb5, 0x78, 0x60, 0xa8, 0x7f, 0x88, 0x36, 0xc0, 0x64, 0xf8, 0x6d, 0xeb, 0x5c, 0x2f,
+	0x96, 0x10, 0x30, 0x25, 0x1d, 0x10, 0xe8, 0x2f, 0xc6, 0x80, 0x18, 0x71, 0xb3, 0x9b, 0xef, 0x45,
+	0xd3, 0x8d, 0x4d, 0x15, 0x0f, 0x8e, 0xf5, 0x57, 0xbd, 0x23, 0x80, 0x13, 0xbd, 0x2e, 0x3f, 0x3d,
+	0xb7, 0xd0, 0xc3, 0x21, 0xf5, 0xd2, 0x21, 0x1d, 0xfc, 0x28, 0x3e, 0x13, 0xc0, 0xdc, 0x7e, 0x8d,
+	0xf9, 0x62, 0x3f, 0xe2, 0x08, 0xa7, 0xd4, 0x95, 0xa7, 0x70, 0xf2, 0x23, 0xfa, 0x54, 0x00, 0xa9,
+	0x7d, 0x7a, 0x45, 0xb1, 0x1f, 0x77, 0xb7, 0x4f, 0x6a, 0xe9, 0xf0, 0x3e, 0xc1, 0x27, 0x31, 0xac,
+	0xa7, 0xe7, 0xf6, 0xa9, 0x76, 0x10, 0x98, 0x92, 0x0e, 0x08, 0xf4, 0x17, 0xab, 0x82, 0xb1, 0x3d,
+	0x62, 0x72, 0xba, 0x17, 0x41, 0x10, 0x95, 0x3a, 0x7f, 0x10, 0x94, 0xb7, 0x46, 0xea, 0xc8, 0x87,
+	0x76, 0xcf, 0x90, 0x6f, 0x3c, 0xe8, 0xa4, 0x85, 0x87, 0x9d, 0xb4, 0xf0, 0xb8, 0x93, 0x16, 0xee,
+	0x3e, 0x49, 0x0f, 0x3c, 0x7c, 0x92, 0x1e, 0xf8, 0xe9, 0x49, 0x7a, 0xe0, 0xed, 0x4b, 0x81, 0x6e,
+	0xe4, 0x11, 0x2f, 0xd4, 0xb5, 0x2a, 0xf5, 0x47, 0xd2, 0xe6, 0x62, 0x51, 0xfa, 0xc0, 0x97, 0x14,
+	0xa7, 0x41, 0x55, 0x87, 0x9d, 0xd7, 0xcf, 0x8b, 0xbf, 0x0f, 0x00, 0x73, 0x26, 0x53, 0x0f, 0x55,
+	0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -698,9 +930,16 @@ type MsgClient interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single delegation target.
 	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all the delegation targets, optionally filtered by
+	// delegation type.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawOperatorCommission defines a method to withdraw the
 	// full commission to the operator.
 	WithdrawOperatorCommission(ctx context.Context, in *MsgWithdrawOperatorCommission, opts ...grpc.CallOption) (*MsgWithdrawOperatorCommissionResponse, error)
+	// SetAutoCompound defines a method to enable or disable the
+	// auto-compounding of the rewards of a single delegation target.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -751,6 +990,15 @@ func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdraw
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Msg/WithdrawAllDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawOperatorCommission(ctx context.Context, in *MsgWithdrawOperatorCommission, opts ...grpc.CallOption) (*MsgWithdrawOperatorCommissionResponse, error) {
 	out := new(MsgWithdrawOperatorCommissionResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Msg/WithdrawOperatorCommission", in, out, opts...)
@@ -760,6 +1008,15 @@ func (c *msgClient) WithdrawOperatorCommission(ctx context.Context, in *MsgWithd
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Msg/UpdateParams", in, out, opts...)
@@ -783,9 +1040,16 @@ type MsgServer interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single delegation target.
 	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of
+	// delegator from all the delegation targets, optionally filtered by
+	// delegation type.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawOperatorCommission defines a method to withdraw the
 	// full commission to the operator.
 	WithdrawOperatorCommission(context.Context, *MsgWithdrawOperatorCommission) (*MsgWithdrawOperatorCommissionResponse, error)
+	// SetAutoCompound defines a method to enable or disable the
+	// auto-compounding of the rewards of a single delegation target.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -808,9 +1072,15 @@ func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, req *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawOperatorCommission(ctx context.Context, req *MsgWithdrawOperatorCommission) (*MsgWithdrawOperatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOperatorCommission not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -891,6 +1161,24 @@ func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Msg/WithdrawAllDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawOperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawOperatorCommission)
 	if err := dec(in); err != nil {
@@ -909,6 +1197,24 @@ func _Msg_WithdrawOperatorCommission_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -948,10 +1254,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawDelegatorReward",
 			Handler:    _Msg_WithdrawDelegatorReward_Handler,
 		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
 		{
 			MethodName: "WithdrawOperatorCommission",
 			Handler:    _Msg_WithdrawOperatorCommission_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -981,6 +1295,35 @@ func (m *MsgCreateRewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.USDBudget != nil {
+		{
+			size, err := m.USDBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.InsufficientFundsBehavior))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.FeeAmount) > 0 {
 		for iNdEx := len(m.FeeAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1025,32 +1368,36 @@ func (m *MsgCreateRewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintMessages(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintMessages(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x32
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintMessages(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintMessages(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
 	if m.ServiceID != 0 {
 		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
 		i--
@@ -1121,6 +1468,35 @@ func (m *MsgEditRewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.USDBudget != nil {
+		{
+			size, err := m.USDBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.InsufficientFundsBehavior))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size, err := m.UsersDistribution.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1151,32 +1527,36 @@ func (m *MsgEditRewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintMessages(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintMessages(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x32
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err11 != nil {
-		return 0, err11
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n11
-	i = encodeVarintMessages(dAtA, i, uint64(n11))
+	i -= n14
+	i = encodeVarintMessages(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -1242,6 +1622,18 @@ func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IBCDestination != nil {
+		{
+			size, err := m.IBCDestination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.WithdrawAddress) > 0 {
 		i -= len(m.WithdrawAddress)
 		copy(dAtA[i:], m.WithdrawAddress)
@@ -1359,7 +1751,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawOperatorCommission) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1369,32 +1761,32 @@ func (m *MsgWithdrawOperatorCommission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawOperatorCommission) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawOperatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OperatorID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+	if m.DelegationType != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DelegationType))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1404,20 +1796,34 @@ func (m *MsgWithdrawOperatorCommissionResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1431,7 +1837,7 @@ func (m *MsgWithdrawOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawOperatorCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1441,17 +1847,162 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawOperatorCommission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawOperatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.OperatorID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.OperatorID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -1522,8 +2073,12 @@ func (m *MsgCreateRewardsPlan) Size() (n int) {
 	if m.ServiceID != 0 {
 		n += 1 + sovMessages(uint64(m.ServiceID))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMessages(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovMessages(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
@@ -1540,6 +2095,17 @@ func (m *MsgCreateRewardsPlan) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		n += 1 + sovMessages(uint64(m.InsufficientFundsBehavior))
+	}
+	if m.USDBudget != nil {
+		l = m.USDBudget.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -1572,8 +2138,12 @@ func (m *MsgEditRewardsPlan) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMessages(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovMessages(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
@@ -1584,6 +2154,17 @@ func (m *MsgEditRewardsPlan) Size() (n int) {
 	n += 1 + l + sovMessages(uint64(l))
 	l = m.UsersDistribution.Size()
 	n += 1 + l + sovMessages(uint64(l))
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		n += 1 + sovMessages(uint64(m.InsufficientFundsBehavior))
+	}
+	if m.USDBudget != nil {
+		l = m.USDBudget.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -1610,6 +2191,10 @@ func (m *MsgSetWithdrawAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if m.IBCDestination != nil {
+		l = m.IBCDestination.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -1656,6 +2241,43 @@ func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.DelegationType != 0 {
+		n += 1 + sovMessages(uint64(m.DelegationType))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgWithdrawOperatorCommission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1687,6 +2309,37 @@ func (m *MsgWithdrawOperatorCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.DelegationType != 0 {
+		n += 1 + sovMessages(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovMessages(uint64(m.DelegationTargetID))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1858,7 +2511,8 @@ func (m *MsgCreateRewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2061,25 +2715,116 @@ func (m *MsgCreateRewardsPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &types1.Any{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientFundsBehavior", wireType)
+			}
+			m.InsufficientFundsBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsufficientFundsBehavior |= InsufficientFundsBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.USDBudget == nil {
+				m.USDBudget = &USDBudget{}
+			}
+			if err := m.USDBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *MsgCreateRewardsPlanResponse) Unmarshal(dAtA []byte) error {
@@ -2292,7 +3037,8 @@ func (m *MsgEditRewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2461,6 +3207,97 @@ func (m *MsgEditRewardsPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &types1.Any{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientFundsBehavior", wireType)
+			}
+			m.InsufficientFundsBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsufficientFundsBehavior |= InsufficientFundsBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.USDBudget == nil {
+				m.USDBudget = &USDBudget{}
+			}
+			if err := m.USDBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -2625,6 +3462,42 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCDestination == nil {
+				m.IBCDestination = &IBCWithdrawDestination{}
+			}
+			if err := m.IBCDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -2771,7 +3644,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationType |= types1.DelegationType(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2900,7 +3773,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2923,15 +3796,15 @@ func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawOperatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawOperatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,13 +3832,13 @@ func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			m.OperatorID = 0
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -2975,7 +3848,7 @@ func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorID |= uint32(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3001,7 +3874,7 @@ func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3024,15 +3897,15 @@ func (m *MsgWithdrawOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawOperatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3059,8 +3932,42 @@ func (m *MsgWithdrawOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, WithdrawnDelegationReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3085,6 +3992,381 @@ func (m *MsgWithdrawOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawOperatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorID", wireType)
+			}
+			m.OperatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			}
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,12 +15,15 @@ import (
 var msgCreateRewardsPlan = types.NewMsgCreateRewardsPlan(
 	1,
 	"Test rewards plan",
-	sdk.NewCoin("stake", sdkmath.NewInt(1000)),
+	sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000))),
 	time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 	time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 	types.NewBasicPoolsDistribution(1),
 	types.NewBasicOperatorsDistribution(1),
 	types.NewBasicUsersDistribution(1),
+	nil,
+	types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+	nil,
 	sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
 	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
 )
@@ -42,6 +45,9 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 				msgCreateRewardsPlan.PoolsDistribution,
 				msgCreateRewardsPlan.OperatorsDistribution,
 				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgCreateRewardsPlan.FeeAmount,
 				msgCreateRewardsPlan.Sender,
 			),
@@ -52,12 +58,15 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 			msg: types.NewMsgCreateRewardsPlan(
 				msgCreateRewardsPlan.ServiceID,
 				msgCreateRewardsPlan.Description,
-				sdk.Coin{Denom: "invalid", Amount: sdkmath.NewInt(-100)},
+				sdk.Coins{sdk.Coin{Denom: "invalid", Amount: sdkmath.NewInt(-100)}},
 				msgCreateRewardsPlan.StartTime,
 				msgCreateRewardsPlan.EndTime,
 				msgCreateRewardsPlan.PoolsDistribution,
 				msgCreateRewardsPlan.OperatorsDistribution,
 				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgCreateRewardsPlan.FeeAmount,
 				msgCreateRewardsPlan.Sender,
 			),
@@ -74,6 +83,47 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 				msgCreateRewardsPlan.PoolsDistribution,
 				msgCreateRewardsPlan.OperatorsDistribution,
 				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+				msgCreateRewardsPlan.FeeAmount,
+				msgCreateRewardsPlan.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "amount with USD budget returns error",
+			msg: types.NewMsgCreateRewardsPlan(
+				msgCreateRewardsPlan.ServiceID,
+				msgCreateRewardsPlan.Description,
+				msgCreateRewardsPlan.Amount,
+				msgCreateRewardsPlan.StartTime,
+				msgCreateRewardsPlan.EndTime,
+				msgCreateRewardsPlan.PoolsDistribution,
+				msgCreateRewardsPlan.OperatorsDistribution,
+				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				types.NewUSDBudget(sdkmath.LegacyNewDec(100), "stake", time.Minute, types.STALE_PRICE_BEHAVIOR_PAUSE, 0),
+				msgCreateRewardsPlan.FeeAmount,
+				msgCreateRewardsPlan.Sender,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid USD budget returns error",
+			msg: types.NewMsgCreateRewardsPlan(
+				msgCreateRewardsPlan.ServiceID,
+				msgCreateRewardsPlan.Description,
+				nil,
+				msgCreateRewardsPlan.StartTime,
+				msgCreateRewardsPlan.EndTime,
+				msgCreateRewardsPlan.PoolsDistribution,
+				msgCreateRewardsPlan.OperatorsDistribution,
+				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				types.NewUSDBudget(sdkmath.LegacyNewDec(100), "stake", 0, types.STALE_PRICE_BEHAVIOR_PAUSE, 0),
 				msgCreateRewardsPlan.FeeAmount,
 				msgCreateRewardsPlan.Sender,
 			),
@@ -90,6 +140,9 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 				msgCreateRewardsPlan.PoolsDistribution,
 				msgCreateRewardsPlan.OperatorsDistribution,
 				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				sdk.Coins{sdk.Coin{Denom: "umilk", Amount: sdkmath.NewInt(-100)}},
 				msgCreateRewardsPlan.Sender,
 			),
@@ -106,6 +159,9 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 				msgCreateRewardsPlan.PoolsDistribution,
 				msgCreateRewardsPlan.OperatorsDistribution,
 				msgCreateRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgCreateRewardsPlan.FeeAmount,
 				"invalid",
 			),
@@ -132,7 +188,7 @@ func TestMsgCreateRewardsPlan_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgCreateRewardsPlan_GetSignBytes(t *testing.T) {
-	expected := `{"type":"milkyway/MsgCreateRewardsPlan","value":{"amount":{"amount":"1000","denom":"stake"},"description":"Test rewards plan","end_time":"2024-12-31T23:59:59Z","fee_amount":[{"amount":"100","denom":"stake"}],"operators_distribution":{"delegation_type":2,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"pools_distribution":{"delegation_type":1,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"sender":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn","service_id":1,"start_time":"2024-01-01T00:00:00Z","users_distribution":{"type":{"type":"milkyway/UsersDistributionTypeBasic","value":{}},"weight":1}}}`
+	expected := `{"type":"milkyway/MsgCreateRewardsPlan","value":{"amount":[{"amount":"1000","denom":"stake"}],"description":"Test rewards plan","end_time":"2024-12-31T23:59:59Z","fee_amount":[{"amount":"100","denom":"stake"}],"insufficient_funds_behavior":1,"operators_distribution":{"delegation_type":2,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"pools_distribution":{"delegation_type":1,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"sender":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn","service_id":1,"start_time":"2024-01-01T00:00:00Z","users_distribution":{"type":{"type":"milkyway/UsersDistributionTypeBasic","value":{}},"weight":1}}}`
 	require.Equal(t, expected, string(msgCreateRewardsPlan.GetSignBytes()))
 }
 
@@ -146,12 +202,15 @@ func TestMsgCreateRewardsPlan_GetSigners(t *testing.T) {
 var msgEditRewardsPlan = types.NewMsgEditRewardsPlan(
 	1,
 	"Test rewards plan",
-	sdk.NewCoin("stake", sdkmath.NewInt(1000)),
+	sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000))),
 	time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 	time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
 	types.NewBasicPoolsDistribution(1),
 	types.NewBasicOperatorsDistribution(1),
 	types.NewBasicUsersDistribution(1),
+	nil,
+	types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+	nil,
 	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
 )
 
@@ -172,6 +231,9 @@ func TestMsgEditRewardsPlan_ValidateBasic(t *testing.T) {
 				msgEditRewardsPlan.PoolsDistribution,
 				msgEditRewardsPlan.OperatorsDistribution,
 				msgEditRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgEditRewardsPlan.Sender,
 			),
 			shouldErr: true,
@@ -181,12 +243,15 @@ func TestMsgEditRewardsPlan_ValidateBasic(t *testing.T) {
 			msg: types.NewMsgEditRewardsPlan(
 				msgEditRewardsPlan.ID,
 				msgEditRewardsPlan.Description,
-				sdk.Coin{Denom: "invalid", Amount: sdkmath.NewInt(-100)},
+				sdk.Coins{sdk.Coin{Denom: "invalid", Amount: sdkmath.NewInt(-100)}},
 				msgEditRewardsPlan.StartTime,
 				msgEditRewardsPlan.EndTime,
 				msgEditRewardsPlan.PoolsDistribution,
 				msgEditRewardsPlan.OperatorsDistribution,
 				msgEditRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgEditRewardsPlan.Sender,
 			),
 			shouldErr: true,
@@ -202,6 +267,9 @@ func TestMsgEditRewardsPlan_ValidateBasic(t *testing.T) {
 				msgEditRewardsPlan.PoolsDistribution,
 				msgEditRewardsPlan.OperatorsDistribution,
 				msgEditRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				msgEditRewardsPlan.Sender,
 			),
 			shouldErr: true,
@@ -217,6 +285,9 @@ func TestMsgEditRewardsPlan_ValidateBasic(t *testing.T) {
 				msgEditRewardsPlan.PoolsDistribution,
 				msgEditRewardsPlan.OperatorsDistribution,
 				msgEditRewardsPlan.UsersDistribution,
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 				"invalid",
 			),
 			shouldErr: true,
@@ -242,7 +313,7 @@ func TestMsgEditRewardsPlan_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgEditRewardsPlan_GetSignBytes(t *testing.T) {
-	expected := `{"type":"milkyway/MsgEditRewardsPlan","value":{"amount":{"amount":"1000","denom":"stake"},"description":"Test rewards plan","end_time":"2024-12-31T23:59:59Z","id":"1","operators_distribution":{"delegation_type":2,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"pools_distribution":{"delegation_type":1,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"sender":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn","start_time":"2024-01-01T00:00:00Z","users_distribution":{"type":{"type":"milkyway/UsersDistributionTypeBasic","value":{}},"weight":1}}}`
+	expected := `{"type":"milkyway/MsgEditRewardsPlan","value":{"amount":[{"amount":"1000","denom":"stake"}],"description":"Test rewards plan","end_time":"2024-12-31T23:59:59Z","id":"1","insufficient_funds_behavior":1,"operators_distribution":{"delegation_type":2,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"pools_distribution":{"delegation_type":1,"type":{"type":"milkyway/DistributionTypeBasic","value":{}},"weight":1},"sender":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn","start_time":"2024-01-01T00:00:00Z","users_distribution":{"type":{"type":"milkyway/UsersDistributionTypeBasic","value":{}},"weight":1}}}`
 	require.Equal(t, expected, string(msgEditRewardsPlan.GetSignBytes()))
 }
 
@@ -255,6 +326,7 @@ func TestMsgEditRewardsPlan_GetSigners(t *testing.T) {
 
 var msgSetWithdrawAddress = types.NewMsgSetWithdrawAddress(
 	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	nil,
 	"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
 )
 
@@ -264,10 +336,20 @@ func TestMsgSetWithdrawAddress_ValidateBasic(t *testing.T) {
 		msg       *types.MsgSetWithdrawAddress
 		shouldErr bool
 	}{
+		{
+			name: "invalid ibc destination returns error",
+			msg: types.NewMsgSetWithdrawAddress(
+				msgSetWithdrawAddress.WithdrawAddress,
+				&types.IBCWithdrawDestination{SourceChannel: "channel-0", Receiver: "osmo1receiver"},
+				msgSetWithdrawAddress.Sender,
+			),
+			shouldErr: true,
+		},
 		{
 			name: "invalid withdraw address returns error",
 			msg: types.NewMsgSetWithdrawAddress(
 				"invalid",
+				nil,
 				msgSetWithdrawAddress.WithdrawAddress,
 			),
 			shouldErr: true,
@@ -276,6 +358,7 @@ func TestMsgSetWithdrawAddress_ValidateBasic(t *testing.T) {
 			name: "invalid sender address returns error",
 			msg: types.NewMsgSetWithdrawAddress(
 				msgSetWithdrawAddress.Sender,
+				nil,
 				"invalid",
 			),
 			shouldErr: true,
@@ -383,6 +466,158 @@ func TestMsgWithdrawDelegatorReward_GetSigners(t *testing.T) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+var msgWithdrawAllDelegatorRewards = types.NewMsgWithdrawAllDelegatorRewards(
+	restakingtypes.DELEGATION_TYPE_POOL,
+	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+)
+
+func TestMsgWithdrawAllDelegatorRewards_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgWithdrawAllDelegatorRewards
+		shouldErr bool
+	}{
+		{
+			name: "invalid delegation type returns error",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DelegationType(5),
+				msgWithdrawAllDelegatorRewards.DelegatorAddress,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				msgWithdrawAllDelegatorRewards.DelegationType,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "unspecified delegation type returns no error",
+			msg: types.NewMsgWithdrawAllDelegatorRewards(
+				restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+				msgWithdrawAllDelegatorRewards.DelegatorAddress,
+			),
+			shouldErr: false,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgWithdrawAllDelegatorRewards,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewards_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgWithdrawAllDelegatorRewards","value":{"delegation_type":1,"delegator_address":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"}}`
+	require.Equal(t, expected, string(msgWithdrawAllDelegatorRewards.GetSignBytes()))
+}
+
+func TestMsgWithdrawAllDelegatorRewards_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgWithdrawAllDelegatorRewards.DelegatorAddress)
+	require.Equal(t, []sdk.AccAddress{addr}, msgWithdrawAllDelegatorRewards.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var msgSetAutoCompound = types.NewMsgSetAutoCompound(
+	restakingtypes.DELEGATION_TYPE_POOL,
+	1,
+	true,
+	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+)
+
+func TestMsgSetAutoCompound_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetAutoCompound
+		shouldErr bool
+	}{
+		{
+			name: "invalid delegation type returns error",
+			msg: types.NewMsgSetAutoCompound(
+				restakingtypes.DELEGATION_TYPE_UNSPECIFIED,
+				msgSetAutoCompound.DelegationTargetID,
+				msgSetAutoCompound.Enabled,
+				msgSetAutoCompound.DelegatorAddress,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegation target ID returns error",
+			msg: types.NewMsgSetAutoCompound(
+				msgSetAutoCompound.DelegationType,
+				0,
+				msgSetAutoCompound.Enabled,
+				msgSetAutoCompound.DelegatorAddress,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid delegator address returns error",
+			msg: types.NewMsgSetAutoCompound(
+				msgSetAutoCompound.DelegationType,
+				msgSetAutoCompound.DelegationTargetID,
+				msgSetAutoCompound.Enabled,
+				"invalid",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "disabling message returns no error",
+			msg: types.NewMsgSetAutoCompound(
+				msgSetAutoCompound.DelegationType,
+				msgSetAutoCompound.DelegationTargetID,
+				false,
+				msgSetAutoCompound.DelegatorAddress,
+			),
+			shouldErr: false,
+		},
+		{
+			name:      "valid message returns no error",
+			msg:       msgSetAutoCompound,
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetAutoCompound_GetSignBytes(t *testing.T) {
+	expected := `{"type":"milkyway/MsgSetAutoCompound","value":{"delegation_target_id":1,"delegation_type":1,"delegator_address":"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn","enabled":true}}`
+	require.Equal(t, expected, string(msgSetAutoCompound.GetSignBytes()))
+}
+
+func TestMsgSetAutoCompound_GetSigners(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32(msgSetAutoCompound.DelegatorAddress)
+	require.Equal(t, []sdk.AccAddress{addr}, msgSetAutoCompound.GetSigners())
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 var msgWithdrawOperatorCommission = types.NewMsgWithdrawOperatorCommission(
 	1,
 	"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
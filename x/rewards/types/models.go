@@ -3,13 +3,16 @@ package types
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	coreaddress "cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/gogoproto/proto"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	"github.com/milkyway-labs/milkyway/v12/utils"
 	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
@@ -26,6 +29,10 @@ var (
 	_ DistributionType      = (*DistributionTypeWeighted)(nil)
 	_ DistributionType      = (*DistributionTypeEgalitarian)(nil)
 	_ UsersDistributionType = (*UsersDistributionTypeBasic)(nil)
+	_ EmissionSchedule      = (*EmissionScheduleLinearDecay)(nil)
+	_ EmissionSchedule      = (*EmissionScheduleHalving)(nil)
+	_ EmissionSchedule      = (*EmissionScheduleStep)(nil)
+	_ EmissionSchedule      = (*EmissionScheduleCliff)(nil)
 )
 
 // GetRewardsPoolAddress generates a rewards pool address from plan id.
@@ -38,24 +45,30 @@ func NewRewardsPlan(
 	id uint64,
 	description string,
 	serviceID uint32,
-	amtPerDay sdk.Coin,
+	amtPerDay sdk.Coins,
 	startTime,
 	endTime time.Time,
 	poolsDistribution Distribution,
 	operatorsDistribution Distribution,
 	usersDistribution UsersDistribution,
+	emissionSchedule EmissionSchedule,
+	insufficientFundsBehavior InsufficientFundsBehavior,
+	usdBudget *USDBudget,
 ) RewardsPlan {
 	return RewardsPlan{
-		ID:                    id,
-		Description:           description,
-		ServiceID:             serviceID,
-		AmountPerDay:          amtPerDay,
-		StartTime:             startTime,
-		EndTime:               endTime,
-		RewardsPool:           GetRewardsPoolAddress(id).String(),
-		PoolsDistribution:     poolsDistribution,
-		OperatorsDistribution: operatorsDistribution,
-		UsersDistribution:     usersDistribution,
+		ID:                        id,
+		Description:               description,
+		ServiceID:                 serviceID,
+		AmountPerDay:              amtPerDay,
+		StartTime:                 startTime,
+		EndTime:                   endTime,
+		RewardsPool:               GetRewardsPoolAddress(id).String(),
+		PoolsDistribution:         poolsDistribution,
+		OperatorsDistribution:     operatorsDistribution,
+		UsersDistribution:         usersDistribution,
+		EmissionSchedule:          NewEmissionScheduleAny(emissionSchedule),
+		InsufficientFundsBehavior: insufficientFundsBehavior,
+		USDBudget:                 usdBudget,
 	}
 }
 
@@ -64,6 +77,22 @@ func (plan RewardsPlan) IsActiveAt(t time.Time) bool {
 	return !plan.StartTime.After(t) && plan.EndTime.After(t)
 }
 
+// GetActiveDurationWithin returns for how long the plan has been active within
+// the given time range.
+func (plan RewardsPlan) GetActiveDurationWithin(from, to time.Time) time.Duration {
+	if plan.StartTime.After(from) {
+		from = plan.StartTime
+	}
+	if plan.EndTime.Before(to) {
+		to = plan.EndTime
+	}
+
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from)
+}
+
 // MustGetRewardsPoolAddress returns the rewards pool address.
 func (plan RewardsPlan) MustGetRewardsPoolAddress(addressCodec coreaddress.Codec) sdk.AccAddress {
 	addr, err := addressCodec.StringToBytes(plan.RewardsPool)
@@ -92,7 +121,16 @@ func (plan RewardsPlan) Validate(unpacker codectypes.AnyUnpacker) error {
 		return fmt.Errorf("invalid amount per day: %w", err)
 	}
 
-	if plan.AmountPerDay.IsZero() {
+	if plan.USDBudget != nil {
+		if !plan.AmountPerDay.IsZero() {
+			return fmt.Errorf("amount per day must be empty when a USD budget is set: %s", plan.AmountPerDay)
+		}
+
+		err = plan.USDBudget.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid USD budget: %w", err)
+		}
+	} else if plan.AmountPerDay.IsZero() {
 		return fmt.Errorf("amount per day must be positive: %s", plan.AmountPerDay)
 	}
 
@@ -104,6 +142,11 @@ func (plan RewardsPlan) Validate(unpacker codectypes.AnyUnpacker) error {
 		)
 	}
 
+	err = ValidateInsufficientFundsBehavior(plan.InsufficientFundsBehavior)
+	if err != nil {
+		return err
+	}
+
 	_, err = sdk.AccAddressFromBech32(plan.RewardsPool)
 	if err != nil {
 		return fmt.Errorf("invalid rewards pool: %w", err)
@@ -147,9 +190,163 @@ func (plan RewardsPlan) Validate(unpacker codectypes.AnyUnpacker) error {
 		return fmt.Errorf("invalid users distribution type: %w", err)
 	}
 
+	emissionSchedule, err := GetEmissionSchedule(unpacker, plan.EmissionSchedule)
+	if err != nil {
+		return fmt.Errorf("get emission schedule: %w", err)
+	}
+
+	if emissionSchedule != nil {
+		err = emissionSchedule.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid emission schedule: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// GetEmissionRateAt returns the fraction of AmountPerDay that should be
+// distributed at the given time, based on the plan's emission schedule.
+// If the plan has no emission schedule, the rate is always 1.
+func (plan RewardsPlan) GetEmissionRateAt(unpacker codectypes.AnyUnpacker, t time.Time) (math.LegacyDec, error) {
+	emissionSchedule, err := GetEmissionSchedule(unpacker, plan.EmissionSchedule)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if emissionSchedule == nil {
+		return math.LegacyOneDec(), nil
+	}
+
+	return emissionSchedule.RateAt(plan.StartTime, plan.EndTime, t), nil
+}
+
+// GetEmissionWithin returns the plan's emission rate integrated over the time
+// the plan has been active within the given time range, expressed in
+// milliseconds. If the plan has no emission schedule, the rate is always 1 and
+// the returned value equals the plan's active duration.
+func (plan RewardsPlan) GetEmissionWithin(unpacker codectypes.AnyUnpacker, from, to time.Time) (math.LegacyDec, error) {
+	if plan.StartTime.After(from) {
+		from = plan.StartTime
+	}
+	if plan.EndTime.Before(to) {
+		to = plan.EndTime
+	}
+
+	if !to.After(from) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	emissionSchedule, err := GetEmissionSchedule(unpacker, plan.EmissionSchedule)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if emissionSchedule == nil {
+		return math.LegacyNewDec(to.Sub(from).Milliseconds()), nil
+	}
+
+	// Split the time range at each rate change of the schedule and sum the
+	// emission of every segment
+	emission := math.LegacyZeroDec()
+	for from.Before(to) {
+		segmentEnd := to
+		nextChange, ok := emissionSchedule.NextRateChange(plan.StartTime, plan.EndTime, from)
+		if ok && nextChange.Before(to) {
+			segmentEnd = nextChange
+		}
+
+		// Within a segment the rate is either constant or linear, so the rate
+		// at the middle of the segment is its average rate
+		segmentDuration := segmentEnd.Sub(from)
+		rate := emissionSchedule.RateAt(plan.StartTime, plan.EndTime, from.Add(segmentDuration/2))
+		emission = emission.Add(rate.MulInt64(segmentDuration.Milliseconds()))
+
+		from = segmentEnd
+	}
+
+	return emission, nil
+}
+
+// ValidateInsufficientFundsBehavior checks that the given insufficient funds
+// behavior is a known one
+func ValidateInsufficientFundsBehavior(behavior InsufficientFundsBehavior) error {
+	switch behavior {
+	case INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED,
+		INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE:
+		return nil
+	default:
+		return fmt.Errorf("invalid insufficient funds behavior: %v", behavior)
+	}
+}
+
+// NewUSDBudget creates a new USDBudget instance.
+func NewUSDBudget(
+	amountPerDay math.LegacyDec,
+	payoutDenom string,
+	maxPriceAge time.Duration,
+	stalePriceBehavior StalePriceBehavior,
+	stalePriceGracePeriod time.Duration,
+) *USDBudget {
+	return &USDBudget{
+		AmountPerDay:          amountPerDay,
+		PayoutDenom:           payoutDenom,
+		MaxPriceAge:           maxPriceAge,
+		StalePriceBehavior:    stalePriceBehavior,
+		StalePriceGracePeriod: stalePriceGracePeriod,
+	}
+}
+
+// Validate checks the USD budget for validity.
+func (b USDBudget) Validate() error {
+	if b.AmountPerDay.IsNil() || !b.AmountPerDay.IsPositive() {
+		return fmt.Errorf("amount per day must be positive: %s", b.AmountPerDay)
+	}
+
+	err := sdk.ValidateDenom(b.PayoutDenom)
+	if err != nil {
+		return fmt.Errorf("invalid payout denom: %w", err)
+	}
+
+	if b.MaxPriceAge <= 0 {
+		return fmt.Errorf("max price age must be positive: %s", b.MaxPriceAge)
+	}
+
+	err = ValidateStalePriceBehavior(b.StalePriceBehavior)
+	if err != nil {
+		return err
+	}
+
+	if b.StalePriceGracePeriod < 0 {
+		return fmt.Errorf("stale price grace period cannot be negative: %s", b.StalePriceGracePeriod)
+	}
+
+	return nil
+}
+
+// GetMaxUsablePriceAge returns the maximum age that a price can have in order
+// to be used to convert the budget into the payout denom.
+func (b USDBudget) GetMaxUsablePriceAge() time.Duration {
+	if b.StalePriceBehavior == STALE_PRICE_BEHAVIOR_USE_LAST_PRICE {
+		return b.MaxPriceAge + b.StalePriceGracePeriod
+	}
+	return b.MaxPriceAge
+}
+
+// ValidateStalePriceBehavior checks that the given stale price behavior is a
+// known one
+func ValidateStalePriceBehavior(behavior StalePriceBehavior) error {
+	switch behavior {
+	case STALE_PRICE_BEHAVIOR_UNSPECIFIED,
+		STALE_PRICE_BEHAVIOR_PAUSE,
+		STALE_PRICE_BEHAVIOR_USE_LAST_PRICE:
+		return nil
+	default:
+		return fmt.Errorf("invalid stale price behavior: %v", behavior)
+	}
+}
+
 // ParseRewardsPlanID tries parsing the given value as a rewards plan id
 func ParseRewardsPlanID(value string) (uint64, error) {
 	planID, err := strconv.ParseUint(value, 10, 64)
@@ -356,6 +553,436 @@ func (t UsersDistributionTypeBasic) isUsersDistributionType() {}
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// EmissionSchedule represents a generic emission schedule
+type EmissionSchedule interface {
+	proto.Message
+	isEmissionSchedule()
+	Validate() error
+
+	// RateAt returns the fraction of the plan's AmountPerDay that should be
+	// distributed at the given time, for a plan that runs between the given
+	// start and end times.
+	RateAt(startTime, endTime, t time.Time) math.LegacyDec
+
+	// NextRateChange returns the first time after t at which the rate stops
+	// following its current trend, for a plan that runs between the given
+	// start and end times. Between two consecutive rate changes the rate is
+	// either constant or varies linearly. If the rate never changes after t,
+	// false is returned.
+	NextRateChange(startTime, endTime, t time.Time) (time.Time, bool)
+}
+
+// NewEmissionScheduleAny packs the given emission schedule into an Any.
+// It returns nil if the given schedule is nil.
+func NewEmissionScheduleAny(emissionSchedule EmissionSchedule) *codectypes.Any {
+	if emissionSchedule == nil {
+		return nil
+	}
+
+	emissionScheduleAny, err := codectypes.NewAnyWithValue(emissionSchedule)
+	if err != nil {
+		panic(err)
+	}
+	return emissionScheduleAny
+}
+
+// GetEmissionSchedule returns the emission schedule packed inside the given
+// Any. It returns nil if no emission schedule is set.
+func GetEmissionSchedule(unpacker codectypes.AnyUnpacker, emissionScheduleAny *codectypes.Any) (EmissionSchedule, error) {
+	var emissionSchedule EmissionSchedule
+	err := unpacker.UnpackAny(emissionScheduleAny, &emissionSchedule)
+	if err != nil {
+		return nil, err
+	}
+	return emissionSchedule, nil
+}
+
+// validateRate checks that the given rate is not nil nor negative
+func validateRate(rate math.LegacyDec) error {
+	if rate.IsNil() || rate.IsNegative() {
+		return fmt.Errorf("rate must be non negative: %s", rate)
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewEmissionScheduleLinearDecay creates a new linear decay emission schedule
+func NewEmissionScheduleLinearDecay(finalRate math.LegacyDec) *EmissionScheduleLinearDecay {
+	return &EmissionScheduleLinearDecay{
+		FinalRate: finalRate,
+	}
+}
+
+// Validate checks the emission schedule for validity
+func (s EmissionScheduleLinearDecay) Validate() error {
+	err := validateRate(s.FinalRate)
+	if err != nil {
+		return fmt.Errorf("invalid final rate: %w", err)
+	}
+
+	if s.FinalRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("final rate cannot be greater than 1: %s", s.FinalRate)
+	}
+
+	return nil
+}
+
+// RateAt implements EmissionSchedule
+func (s EmissionScheduleLinearDecay) RateAt(startTime, endTime, t time.Time) math.LegacyDec {
+	if !t.After(startTime) {
+		return math.LegacyOneDec()
+	}
+	if !t.Before(endTime) {
+		return s.FinalRate
+	}
+
+	// rate = 1 - (1 - finalRate) * elapsed / duration
+	elapsed := math.LegacyNewDec(t.Sub(startTime).Milliseconds())
+	duration := math.LegacyNewDec(endTime.Sub(startTime).Milliseconds())
+	decay := math.LegacyOneDec().Sub(s.FinalRate).Mul(elapsed).Quo(duration)
+	return math.LegacyOneDec().Sub(decay)
+}
+
+// NextRateChange implements EmissionSchedule
+func (s EmissionScheduleLinearDecay) NextRateChange(startTime, endTime, t time.Time) (time.Time, bool) {
+	if t.Before(startTime) {
+		return startTime, true
+	}
+	if t.Before(endTime) {
+		return endTime, true
+	}
+	return time.Time{}, false
+}
+
+// isEmissionSchedule is a marker function
+func (s EmissionScheduleLinearDecay) isEmissionSchedule() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// maxDuration represents the longest representable duration
+const maxDuration = time.Duration(1<<63 - 1)
+
+// maxHalvings represents the number of halvings after which the emission rate
+// is considered to be zero, since it can't be represented with 18 decimals
+const maxHalvings = 64
+
+// NewEmissionScheduleHalving creates a new halving emission schedule
+func NewEmissionScheduleHalving(halvingPeriod time.Duration) *EmissionScheduleHalving {
+	return &EmissionScheduleHalving{
+		HalvingPeriod: halvingPeriod,
+	}
+}
+
+// Validate checks the emission schedule for validity
+func (s EmissionScheduleHalving) Validate() error {
+	if s.HalvingPeriod <= 0 {
+		return fmt.Errorf("halving period must be positive: %s", s.HalvingPeriod)
+	}
+	return nil
+}
+
+// RateAt implements EmissionSchedule
+func (s EmissionScheduleHalving) RateAt(startTime, _, t time.Time) math.LegacyDec {
+	if !t.After(startTime) {
+		return math.LegacyOneDec()
+	}
+
+	halvings := uint64(t.Sub(startTime) / s.HalvingPeriod)
+	if halvings >= maxHalvings {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyOneDec().Quo(math.LegacyNewDec(2).Power(halvings))
+}
+
+// NextRateChange implements EmissionSchedule
+func (s EmissionScheduleHalving) NextRateChange(startTime, _, t time.Time) (time.Time, bool) {
+	if t.Before(startTime) {
+		return startTime, true
+	}
+
+	nextHalving := int64(t.Sub(startTime)/s.HalvingPeriod) + 1
+	if nextHalving > maxHalvings || int64(s.HalvingPeriod) > int64(maxDuration)/nextHalving {
+		return time.Time{}, false
+	}
+	return startTime.Add(s.HalvingPeriod * time.Duration(nextHalving)), true
+}
+
+// isEmissionSchedule is a marker function
+func (s EmissionScheduleHalving) isEmissionSchedule() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewEmissionStep creates a new emission step
+func NewEmissionStep(startTime time.Time, rate math.LegacyDec) EmissionStep {
+	return EmissionStep{
+		StartTime: startTime,
+		Rate:      rate,
+	}
+}
+
+// NewEmissionScheduleStep creates a new step emission schedule
+func NewEmissionScheduleStep(steps []EmissionStep) *EmissionScheduleStep {
+	return &EmissionScheduleStep{
+		Steps: steps,
+	}
+}
+
+// Validate checks the emission schedule for validity
+func (s EmissionScheduleStep) Validate() error {
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("steps cannot be empty")
+	}
+
+	for i, step := range s.Steps {
+		err := validateRate(step.Rate)
+		if err != nil {
+			return fmt.Errorf("invalid step %d rate: %w", i, err)
+		}
+
+		if i > 0 && !step.StartTime.After(s.Steps[i-1].StartTime) {
+			return fmt.Errorf("steps must be sorted by start time: %s <= %s",
+				step.StartTime.Format(time.RFC3339),
+				s.Steps[i-1].StartTime.Format(time.RFC3339),
+			)
+		}
+	}
+
+	return nil
+}
+
+// RateAt implements EmissionSchedule
+func (s EmissionScheduleStep) RateAt(_, _, t time.Time) math.LegacyDec {
+	rate := math.LegacyOneDec()
+	for _, step := range s.Steps {
+		if t.Before(step.StartTime) {
+			break
+		}
+		rate = step.Rate
+	}
+	return rate
+}
+
+// NextRateChange implements EmissionSchedule
+func (s EmissionScheduleStep) NextRateChange(_, _, t time.Time) (time.Time, bool) {
+	for _, step := range s.Steps {
+		if step.StartTime.After(t) {
+			return step.StartTime, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// isEmissionSchedule is a marker function
+func (s EmissionScheduleStep) isEmissionSchedule() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewEmissionScheduleCliff creates a new cliff emission schedule
+func NewEmissionScheduleCliff(cliffDuration time.Duration, cliffRate math.LegacyDec) *EmissionScheduleCliff {
+	return &EmissionScheduleCliff{
+		CliffDuration: cliffDuration,
+		CliffRate:     cliffRate,
+	}
+}
+
+// Validate checks the emission schedule for validity
+func (s EmissionScheduleCliff) Validate() error {
+	if s.CliffDuration <= 0 {
+		return fmt.Errorf("cliff duration must be positive: %s", s.CliffDuration)
+	}
+
+	err := validateRate(s.CliffRate)
+	if err != nil {
+		return fmt.Errorf("invalid cliff rate: %w", err)
+	}
+
+	return nil
+}
+
+// RateAt implements EmissionSchedule
+func (s EmissionScheduleCliff) RateAt(startTime, _, t time.Time) math.LegacyDec {
+	if t.Before(startTime.Add(s.CliffDuration)) {
+		return s.CliffRate
+	}
+	return math.LegacyOneDec()
+}
+
+// NextRateChange implements EmissionSchedule
+func (s EmissionScheduleCliff) NextRateChange(startTime, _, t time.Time) (time.Time, bool) {
+	cliffEnd := startTime.Add(s.CliffDuration)
+	if t.Before(cliffEnd) {
+		return cliffEnd, true
+	}
+	return time.Time{}, false
+}
+
+// isEmissionSchedule is a marker function
+func (s EmissionScheduleCliff) isEmissionSchedule() {}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewDelegationTargetDistributedRewards creates a new DelegationTargetDistributedRewards instance
+func NewDelegationTargetDistributedRewards(
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+	amount sdk.DecCoins,
+) DelegationTargetDistributedRewards {
+	return DelegationTargetDistributedRewards{
+		DelegationType:     delegationType,
+		DelegationTargetID: delegationTargetID,
+		Amount:             amount,
+	}
+}
+
+// NewDelegationTypeDistributedRewards creates a new DelegationTypeDistributedRewards instance
+func NewDelegationTypeDistributedRewards(
+	delegationType restakingtypes.DelegationType,
+	amount sdk.DecCoins,
+) DelegationTypeDistributedRewards {
+	return DelegationTypeDistributedRewards{
+		DelegationType: delegationType,
+		Amount:         amount,
+	}
+}
+
+// NewRewardsPlanAccounting creates a new RewardsPlanAccounting instance
+func NewRewardsPlanAccounting(
+	planID uint64,
+	distributedPerTarget []DelegationTargetDistributedRewards,
+	skippedAllocations uint64,
+) RewardsPlanAccounting {
+	return RewardsPlanAccounting{
+		PlanID:               planID,
+		DistributedPerTarget: distributedPerTarget,
+		SkippedAllocations:   skippedAllocations,
+	}
+}
+
+// AddDistributedRewards adds the given amount to the rewards that have been
+// distributed to the given delegation target
+func (a *RewardsPlanAccounting) AddDistributedRewards(
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+	amount sdk.DecCoins,
+) {
+	if amount.IsZero() {
+		return
+	}
+
+	for i, distributed := range a.DistributedPerTarget {
+		if distributed.DelegationType == delegationType && distributed.DelegationTargetID == delegationTargetID {
+			a.DistributedPerTarget[i].Amount = distributed.Amount.Add(amount...)
+			return
+		}
+	}
+
+	a.DistributedPerTarget = append(
+		a.DistributedPerTarget,
+		NewDelegationTargetDistributedRewards(delegationType, delegationTargetID, amount),
+	)
+}
+
+// GetDistributedPerDelegationType returns the rewards that have been
+// distributed to each delegation type
+func (a RewardsPlanAccounting) GetDistributedPerDelegationType() []DelegationTypeDistributedRewards {
+	var distributedPerType []DelegationTypeDistributedRewards
+	for _, delegationType := range []restakingtypes.DelegationType{
+		restakingtypes.DELEGATION_TYPE_POOL,
+		restakingtypes.DELEGATION_TYPE_OPERATOR,
+		restakingtypes.DELEGATION_TYPE_SERVICE,
+	} {
+		amount := sdk.NewDecCoins()
+		for _, distributed := range a.DistributedPerTarget {
+			if distributed.DelegationType == delegationType {
+				amount = amount.Add(distributed.Amount...)
+			}
+		}
+
+		if !amount.IsZero() {
+			distributedPerType = append(distributedPerType, NewDelegationTypeDistributedRewards(delegationType, amount))
+		}
+	}
+	return distributedPerType
+}
+
+// Validate checks the rewards plan accounting for validity
+func (a RewardsPlanAccounting) Validate() error {
+	if a.PlanID == 0 {
+		return fmt.Errorf("invalid plan ID: %d", a.PlanID)
+	}
+
+	for _, distributed := range a.DistributedPerTarget {
+		err := distributed.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid distributed rewards: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the distributed rewards for validity
+func (r DelegationTargetDistributedRewards) Validate() error {
+	if r.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", r.DelegationType)
+	}
+
+	if r.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", r.DelegationTargetID)
+	}
+
+	err := r.Amount.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+
+	return nil
+}
+
+// NewArchivedRewardsPlan creates a new ArchivedRewardsPlan instance from the
+// given plan and its accounting
+func NewArchivedRewardsPlan(
+	plan RewardsPlan,
+	terminationTime time.Time,
+	accounting RewardsPlanAccounting,
+) ArchivedRewardsPlan {
+	return ArchivedRewardsPlan{
+		Plan:                         plan,
+		TerminationTime:              terminationTime,
+		DistributedPerDelegationType: accounting.GetDistributedPerDelegationType(),
+		DistributedPerTarget:         accounting.DistributedPerTarget,
+		SkippedAllocations:           accounting.SkippedAllocations,
+	}
+}
+
+// Validate checks the archived rewards plan for validity
+func (p ArchivedRewardsPlan) Validate(unpacker codectypes.AnyUnpacker) error {
+	err := p.Plan.Validate(unpacker)
+	if err != nil {
+		return fmt.Errorf("invalid plan: %w", err)
+	}
+
+	for _, distributed := range p.DistributedPerDelegationType {
+		err = distributed.Amount.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid distributed rewards: %w", err)
+		}
+	}
+
+	for _, distributed := range p.DistributedPerTarget {
+		err = distributed.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid distributed rewards: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // InitialAccumulatedCommission returns the initial accumulated commission (zero)
 func InitialAccumulatedCommission() AccumulatedCommission {
 	return AccumulatedCommission{}
@@ -397,6 +1024,17 @@ func NewDelegationDelegatorReward(
 	}
 }
 
+// NewWithdrawnDelegationReward creates a new withdrawn delegation reward
+func NewWithdrawnDelegationReward(
+	delType restakingtypes.DelegationType, targetID uint32, amount sdk.Coins,
+) WithdrawnDelegationReward {
+	return WithdrawnDelegationReward{
+		DelegationType:     delType,
+		DelegationTargetID: targetID,
+		Amount:             amount,
+	}
+}
+
 // NewPoolServiceTotalDelegatorShares creates a new pool service total delegator shares
 func NewPoolServiceTotalDelegatorShares(poolID, serviceID uint32, shares sdk.DecCoins) PoolServiceTotalDelegatorShares {
 	return PoolServiceTotalDelegatorShares{
@@ -423,6 +1061,239 @@ func (shares PoolServiceTotalDelegatorShares) Validate() error {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewDelegatorSharesAccumulator creates a new DelegatorSharesAccumulator instance
+// with no accumulated shares, starting at the given time
+func NewDelegatorSharesAccumulator(
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+	serviceID uint32,
+	startTime time.Time,
+) DelegatorSharesAccumulator {
+	return DelegatorSharesAccumulator{
+		DelegationType:     delegationType,
+		DelegationTargetID: delegationTargetID,
+		ServiceID:          serviceID,
+		CumulativeShares:   sdk.NewDecCoins(),
+		StartTime:          startTime,
+		LastUpdateTime:     startTime,
+	}
+}
+
+// Accumulate adds to the accumulator the given shares, weighted by the time
+// elapsed between the last update time and the given time
+func (a DelegatorSharesAccumulator) Accumulate(shares sdk.DecCoins, t time.Time) DelegatorSharesAccumulator {
+	elapsed := t.Sub(a.LastUpdateTime).Milliseconds()
+	if elapsed > 0 {
+		a.CumulativeShares = a.CumulativeShares.Add(shares.MulDecTruncate(math.LegacyNewDec(elapsed))...)
+		a.LastUpdateTime = t
+	}
+	return a
+}
+
+// GetTimeWeightedShares returns the average of the shares accumulated from the
+// start time until the given time, assuming that the current shares have not
+// changed since the last update time. If no time has elapsed since the start
+// time, the current shares are returned
+func (a DelegatorSharesAccumulator) GetTimeWeightedShares(currentShares sdk.DecCoins, t time.Time) sdk.DecCoins {
+	duration := t.Sub(a.StartTime).Milliseconds()
+	if duration <= 0 {
+		return currentShares
+	}
+	return a.Accumulate(currentShares, t).CumulativeShares.QuoDecTruncate(math.LegacyNewDec(duration))
+}
+
+// Validate checks the delegator shares accumulator for validity
+func (a DelegatorSharesAccumulator) Validate() error {
+	if a.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", a.DelegationType)
+	}
+
+	if a.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", a.DelegationTargetID)
+	}
+
+	isPool := a.DelegationType == restakingtypes.DELEGATION_TYPE_POOL
+	if isPool && a.ServiceID == 0 {
+		return fmt.Errorf("service ID must not be 0 for pools")
+	}
+	if !isPool && a.ServiceID != 0 {
+		return fmt.Errorf("service ID must be 0 for non-pool delegation targets: %d", a.ServiceID)
+	}
+
+	err := a.CumulativeShares.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid cumulative shares: %w", err)
+	}
+
+	if a.LastUpdateTime.Before(a.StartTime) {
+		return fmt.Errorf("last update time cannot be before start time: %s < %s", a.LastUpdateTime, a.StartTime)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewPeriodStartTime creates a new PeriodStartTime instance
+func NewPeriodStartTime(
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+	period uint64,
+	startTime time.Time,
+) PeriodStartTime {
+	return PeriodStartTime{
+		DelegationType:     delegationType,
+		DelegationTargetID: delegationTargetID,
+		Period:             period,
+		StartTime:          startTime,
+	}
+}
+
+// Validate checks the period start time for validity
+func (p PeriodStartTime) Validate() error {
+	if p.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", p.DelegationType)
+	}
+
+	if p.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", p.DelegationTargetID)
+	}
+
+	if p.StartTime.IsZero() {
+		return fmt.Errorf("invalid start time: %s", p.StartTime)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewDelegatorEpochClaim creates a new DelegatorEpochClaim instance
+func NewDelegatorEpochClaim(
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+	delegatorAddress string,
+) DelegatorEpochClaim {
+	return DelegatorEpochClaim{
+		DelegationType:     delegationType,
+		DelegationTargetID: delegationTargetID,
+		DelegatorAddress:   delegatorAddress,
+		CumulativeStakes:   sdk.NewDecCoins(),
+		Rewards:            ServicePools{},
+	}
+}
+
+// AddStakes adds to the claim the given stakes, weighted by the given number
+// of milliseconds during which they have been delegated
+func (c DelegatorEpochClaim) AddStakes(stakes sdk.DecCoins, milliseconds int64) DelegatorEpochClaim {
+	if milliseconds > 0 {
+		c.CumulativeStakes = c.CumulativeStakes.Add(stakes.MulDecTruncate(math.LegacyNewDec(milliseconds))...)
+	}
+	return c
+}
+
+// Validate checks the delegator epoch claim for validity
+func (c DelegatorEpochClaim) Validate() error {
+	if c.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", c.DelegationType)
+	}
+
+	if c.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", c.DelegationTargetID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(c.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address: %s", c.DelegatorAddress)
+	}
+
+	err = c.CumulativeStakes.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid cumulative stakes: %w", err)
+	}
+
+	if c.Rewards.IsAnyNegative() {
+		return fmt.Errorf("invalid rewards: %s", c.Rewards)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewIBCWithdrawDestination creates a new IBCWithdrawDestination instance
+func NewIBCWithdrawDestination(sourceChannel string, receiver string, timeout time.Duration) IBCWithdrawDestination {
+	return IBCWithdrawDestination{
+		SourceChannel: sourceChannel,
+		Receiver:      receiver,
+		Timeout:       timeout,
+	}
+}
+
+// Validate checks the IBC withdraw destination for validity
+func (d IBCWithdrawDestination) Validate() error {
+	err := host.ChannelIdentifierValidator(d.SourceChannel)
+	if err != nil {
+		return fmt.Errorf("invalid source channel: %w", err)
+	}
+
+	if strings.TrimSpace(d.Receiver) == "" {
+		return fmt.Errorf("invalid receiver: %s", d.Receiver)
+	}
+
+	if d.Timeout <= 0 {
+		return fmt.Errorf("invalid timeout: %s", d.Timeout)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewAutoCompoundSetting creates a new AutoCompoundSetting instance
+func NewAutoCompoundSetting(
+	delegatorAddress string,
+	delegationType restakingtypes.DelegationType,
+	delegationTargetID uint32,
+) AutoCompoundSetting {
+	return AutoCompoundSetting{
+		DelegatorAddress:   delegatorAddress,
+		DelegationType:     delegationType,
+		DelegationTargetID: delegationTargetID,
+	}
+}
+
+// Validate checks the auto-compound setting for validity
+func (s AutoCompoundSetting) Validate() error {
+	_, err := sdk.AccAddressFromBech32(s.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address: %s", s.DelegatorAddress)
+	}
+
+	if s.DelegationType == restakingtypes.DELEGATION_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid delegation type: %v", s.DelegationType)
+	}
+
+	if s.DelegationTargetID == 0 {
+		return fmt.Errorf("invalid delegation target ID: %d", s.DelegationTargetID)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewPlanAPR creates a new PlanAPR instance
+func NewPlanAPR(planID uint64, serviceID uint32, apr math.LegacyDec) PlanAPR {
+	return PlanAPR{
+		PlanID:    planID,
+		ServiceID: serviceID,
+		APR:       apr,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // NewOutstandingRewards creates a new OutstandingRewards instance.
 func NewOutstandingRewards(rewards DecPools) OutstandingRewards {
 	return OutstandingRewards{
@@ -4,16 +4,18 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types2 "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
-	types1 "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	types2 "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,6 +35,81 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InsufficientFundsBehavior defines the behavior of a rewards plan when its
+// rewards pool doesn't have enough funds to distribute all the denoms of the
+// plan's amount per day.
+type InsufficientFundsBehavior int32
+
+const (
+	// INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED defines an unspecified behavior.
+	// It is treated as INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL.
+	INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED InsufficientFundsBehavior = 0
+	// INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL defines that the allocation of all
+	// the denoms is skipped if any of them is not funded enough.
+	INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL InsufficientFundsBehavior = 1
+	// INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE defines that the denoms that
+	// are not funded enough are distributed up to the rewards pool balance,
+	// while the other denoms are distributed normally.
+	INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE InsufficientFundsBehavior = 2
+)
+
+var InsufficientFundsBehavior_name = map[int32]string{
+	0: "INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED",
+	1: "INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL",
+	2: "INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE",
+}
+
+var InsufficientFundsBehavior_value = map[string]int32{
+	"INSUFFICIENT_FUNDS_BEHAVIOR_UNSPECIFIED":   0,
+	"INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL":      1,
+	"INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE": 2,
+}
+
+func (x InsufficientFundsBehavior) String() string {
+	return proto.EnumName(InsufficientFundsBehavior_name, int32(x))
+}
+
+func (InsufficientFundsBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{0}
+}
+
+// StalePriceBehavior defines the behavior of a USD denominated rewards plan
+// when the price of its payout denom is missing or stale.
+type StalePriceBehavior int32
+
+const (
+	// STALE_PRICE_BEHAVIOR_UNSPECIFIED defines an unspecified behavior.
+	// It is treated as STALE_PRICE_BEHAVIOR_PAUSE.
+	STALE_PRICE_BEHAVIOR_UNSPECIFIED StalePriceBehavior = 0
+	// STALE_PRICE_BEHAVIOR_PAUSE defines that the rewards allocation is paused
+	// until a fresh price is available.
+	STALE_PRICE_BEHAVIOR_PAUSE StalePriceBehavior = 1
+	// STALE_PRICE_BEHAVIOR_USE_LAST_PRICE defines that the last known price is
+	// used for up to StalePriceGracePeriod after it has become stale. After
+	// that, the rewards allocation is paused.
+	STALE_PRICE_BEHAVIOR_USE_LAST_PRICE StalePriceBehavior = 2
+)
+
+var StalePriceBehavior_name = map[int32]string{
+	0: "STALE_PRICE_BEHAVIOR_UNSPECIFIED",
+	1: "STALE_PRICE_BEHAVIOR_PAUSE",
+	2: "STALE_PRICE_BEHAVIOR_USE_LAST_PRICE",
+}
+
+var StalePriceBehavior_value = map[string]int32{
+	"STALE_PRICE_BEHAVIOR_UNSPECIFIED":    0,
+	"STALE_PRICE_BEHAVIOR_PAUSE":          1,
+	"STALE_PRICE_BEHAVIOR_USE_LAST_PRICE": 2,
+}
+
+func (x StalePriceBehavior) String() string {
+	return proto.EnumName(StalePriceBehavior_name, int32(x))
+}
+
+func (StalePriceBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{1}
+}
+
 // RewardsPlan represents a rewards allocation plan.
 type RewardsPlan struct {
 	// ID is the unique identifier of the plan.
@@ -43,7 +120,7 @@ type RewardsPlan struct {
 	ServiceID uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// AmountPerDay is the amount of rewards to be distributed, per day.
 	// The rewards amount for every block will be calculated based on this.
-	AmountPerDay types.Coin `protobuf:"bytes,11,opt,name=amount_per_day,json=amountPerDay,proto3" json:"amount_per_day"`
+	AmountPerDay github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=amount_per_day,json=amountPerDay,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_day"`
 	// StartTime is the starting time of the plan.
 	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// EndTime is the ending time of the plan.
@@ -58,6 +135,18 @@ type RewardsPlan struct {
 	OperatorsDistribution Distribution `protobuf:"bytes,9,opt,name=operators_distribution,json=operatorsDistribution,proto3" json:"operators_distribution"`
 	// UsersDistribution is the rewards distribution parameters for users.
 	UsersDistribution UsersDistribution `protobuf:"bytes,10,opt,name=users_distribution,json=usersDistribution,proto3" json:"users_distribution"`
+	// EmissionSchedule is the schedule that defines how the emission rate of
+	// the plan changes over time. If not set, the rewards are distributed at a
+	// constant rate of AmountPerDay.
+	EmissionSchedule *types1.Any `protobuf:"bytes,12,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// InsufficientFundsBehavior defines what happens when the rewards pool
+	// doesn't have enough funds to distribute all the denoms of AmountPerDay.
+	InsufficientFundsBehavior InsufficientFundsBehavior `protobuf:"varint,13,opt,name=insufficient_funds_behavior,json=insufficientFundsBehavior,proto3,enum=milkyway.rewards.v1.InsufficientFundsBehavior" json:"insufficient_funds_behavior,omitempty"`
+	// USDBudget is the optional USD denominated budget of the plan. If set, the
+	// rewards to be distributed per day are computed by converting the budget
+	// to the payout denom using the oracle price, and AmountPerDay must be
+	// empty.
+	USDBudget *USDBudget `protobuf:"bytes,14,opt,name=usd_budget,json=usdBudget,proto3" json:"usd_budget,omitempty"`
 }
 
 func (m *RewardsPlan) Reset()         { *m = RewardsPlan{} }
@@ -93,25 +182,263 @@ func (m *RewardsPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RewardsPlan proto.InternalMessageInfo
 
+// USDBudget represents the USD denominated daily budget of a rewards plan.
+// At every allocation, the budget is converted to the payout denom using the
+// price provided by the oracle.
+type USDBudget struct {
+	// AmountPerDay is the USD value of the rewards to be distributed per day.
+	AmountPerDay cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=amount_per_day,json=amountPerDay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"amount_per_day"`
+	// PayoutDenom is the denom in which the rewards are paid. It must be
+	// registered inside the assets module in order to have a price.
+	PayoutDenom string `protobuf:"bytes,2,opt,name=payout_denom,json=payoutDenom,proto3" json:"payout_denom,omitempty"`
+	// MaxPriceAge is the maximum age of the oracle price. Prices that have
+	// been updated before this period are considered stale.
+	MaxPriceAge time.Duration `protobuf:"bytes,3,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// StalePriceBehavior defines what happens when the price of the payout
+	// denom is missing or stale.
+	StalePriceBehavior StalePriceBehavior `protobuf:"varint,4,opt,name=stale_price_behavior,json=stalePriceBehavior,proto3,enum=milkyway.rewards.v1.StalePriceBehavior" json:"stale_price_behavior,omitempty"`
+	// StalePriceGracePeriod is the period, after the price has become stale,
+	// during which the last known price can still be used. It's only used
+	// when StalePriceBehavior is STALE_PRICE_BEHAVIOR_USE_LAST_PRICE.
+	StalePriceGracePeriod time.Duration `protobuf:"bytes,5,opt,name=stale_price_grace_period,json=stalePriceGracePeriod,proto3,stdduration" json:"stale_price_grace_period"`
+}
+
+func (m *USDBudget) Reset()         { *m = USDBudget{} }
+func (m *USDBudget) String() string { return proto.CompactTextString(m) }
+func (*USDBudget) ProtoMessage()    {}
+func (*USDBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{1}
+}
+func (m *USDBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *USDBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_USDBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *USDBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_USDBudget.Merge(m, src)
+}
+func (m *USDBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *USDBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_USDBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_USDBudget proto.InternalMessageInfo
+
+// DelegationTypeDistributedRewards represents the rewards that have been
+// distributed by a rewards plan to all the delegation targets of a given
+// delegation type.
+type DelegationTypeDistributedRewards struct {
+	// DelegationType is the type of the delegation targets.
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// Amount is the amount of rewards that have been distributed.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *DelegationTypeDistributedRewards) Reset()         { *m = DelegationTypeDistributedRewards{} }
+func (m *DelegationTypeDistributedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegationTypeDistributedRewards) ProtoMessage()    {}
+func (*DelegationTypeDistributedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{2}
+}
+func (m *DelegationTypeDistributedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationTypeDistributedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationTypeDistributedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationTypeDistributedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationTypeDistributedRewards.Merge(m, src)
+}
+func (m *DelegationTypeDistributedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationTypeDistributedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationTypeDistributedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationTypeDistributedRewards proto.InternalMessageInfo
+
+// DelegationTargetDistributedRewards represents the rewards that have been
+// distributed by a rewards plan to a single delegation target.
+type DelegationTargetDistributedRewards struct {
+	// DelegationType is the type of the delegation target.
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// DelegationTargetID is the ID of the delegation target.
+	DelegationTargetID uint32 `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	// Amount is the amount of rewards that have been distributed.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *DelegationTargetDistributedRewards) Reset()         { *m = DelegationTargetDistributedRewards{} }
+func (m *DelegationTargetDistributedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegationTargetDistributedRewards) ProtoMessage()    {}
+func (*DelegationTargetDistributedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{3}
+}
+func (m *DelegationTargetDistributedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationTargetDistributedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationTargetDistributedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationTargetDistributedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationTargetDistributedRewards.Merge(m, src)
+}
+func (m *DelegationTargetDistributedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationTargetDistributedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationTargetDistributedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationTargetDistributedRewards proto.InternalMessageInfo
+
+// RewardsPlanAccounting contains the cumulative accounting of the rewards
+// that have been distributed by a rewards plan.
+type RewardsPlanAccounting struct {
+	// PlanID is the ID of the rewards plan.
+	PlanID uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// DistributedPerTarget contains the rewards that have been distributed to
+	// each delegation target.
+	DistributedPerTarget []DelegationTargetDistributedRewards `protobuf:"bytes,2,rep,name=distributed_per_target,json=distributedPerTarget,proto3" json:"distributed_per_target"`
+	// SkippedAllocations is the number of allocations that have been skipped
+	// because the plan's rewards pool didn't have enough funds.
+	SkippedAllocations uint64 `protobuf:"varint,3,opt,name=skipped_allocations,json=skippedAllocations,proto3" json:"skipped_allocations,omitempty"`
+}
+
+func (m *RewardsPlanAccounting) Reset()         { *m = RewardsPlanAccounting{} }
+func (m *RewardsPlanAccounting) String() string { return proto.CompactTextString(m) }
+func (*RewardsPlanAccounting) ProtoMessage()    {}
+func (*RewardsPlanAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{4}
+}
+func (m *RewardsPlanAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsPlanAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsPlanAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsPlanAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsPlanAccounting.Merge(m, src)
+}
+func (m *RewardsPlanAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsPlanAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsPlanAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsPlanAccounting proto.InternalMessageInfo
+
+// ArchivedRewardsPlan represents a rewards plan that has been terminated,
+// along with the accounting of the rewards it has distributed.
+type ArchivedRewardsPlan struct {
+	// Plan is the rewards plan that has been terminated.
+	Plan RewardsPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+	// TerminationTime is the time at which the plan has been terminated.
+	TerminationTime time.Time `protobuf:"bytes,2,opt,name=termination_time,json=terminationTime,proto3,stdtime" json:"termination_time"`
+	// DistributedPerDelegationType contains the rewards that have been
+	// distributed to each delegation type.
+	DistributedPerDelegationType []DelegationTypeDistributedRewards `protobuf:"bytes,3,rep,name=distributed_per_delegation_type,json=distributedPerDelegationType,proto3" json:"distributed_per_delegation_type"`
+	// DistributedPerTarget contains the rewards that have been distributed to
+	// each delegation target.
+	DistributedPerTarget []DelegationTargetDistributedRewards `protobuf:"bytes,4,rep,name=distributed_per_target,json=distributedPerTarget,proto3" json:"distributed_per_target"`
+	// SkippedAllocations is the number of allocations that have been skipped
+	// because the plan's rewards pool didn't have enough funds.
+	SkippedAllocations uint64 `protobuf:"varint,5,opt,name=skipped_allocations,json=skippedAllocations,proto3" json:"skipped_allocations,omitempty"`
+}
+
+func (m *ArchivedRewardsPlan) Reset()         { *m = ArchivedRewardsPlan{} }
+func (m *ArchivedRewardsPlan) String() string { return proto.CompactTextString(m) }
+func (*ArchivedRewardsPlan) ProtoMessage()    {}
+func (*ArchivedRewardsPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{5}
+}
+func (m *ArchivedRewardsPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedRewardsPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedRewardsPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedRewardsPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedRewardsPlan.Merge(m, src)
+}
+func (m *ArchivedRewardsPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedRewardsPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedRewardsPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedRewardsPlan proto.InternalMessageInfo
+
 // Distribution represents distribution parameters for restaking
 // pools/operators.
 type Distribution struct {
 	// DelegationType is the type of delegation target which this distribution
 	// parameters are for. It can be one of DELEGATION_TYPE_POOL and
 	// DELEGATION_TYPE_OPERATOR.
-	DelegationType types1.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
 	// Weight is the rewards distribution weight among other types of delegation
 	// targets.
 	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	// Type is one of basic/weighted/egalitarian distributions.
-	Type *types2.Any `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Type *types1.Any `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 }
 
 func (m *Distribution) Reset()         { *m = Distribution{} }
 func (m *Distribution) String() string { return proto.CompactTextString(m) }
 func (*Distribution) ProtoMessage()    {}
 func (*Distribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{1}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{6}
 }
 func (m *Distribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +479,7 @@ func (m *DistributionTypeBasic) Reset()         { *m = DistributionTypeBasic{} }
 func (m *DistributionTypeBasic) String() string { return proto.CompactTextString(m) }
 func (*DistributionTypeBasic) ProtoMessage()    {}
 func (*DistributionTypeBasic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{2}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{7}
 }
 func (m *DistributionTypeBasic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +519,7 @@ func (m *DistributionTypeWeighted) Reset()         { *m = DistributionTypeWeight
 func (m *DistributionTypeWeighted) String() string { return proto.CompactTextString(m) }
 func (*DistributionTypeWeighted) ProtoMessage()    {}
 func (*DistributionTypeWeighted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{3}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{8}
 }
 func (m *DistributionTypeWeighted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +558,7 @@ func (m *DistributionWeight) Reset()         { *m = DistributionWeight{} }
 func (m *DistributionWeight) String() string { return proto.CompactTextString(m) }
 func (*DistributionWeight) ProtoMessage()    {}
 func (*DistributionWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{4}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{9}
 }
 func (m *DistributionWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +596,7 @@ func (m *DistributionTypeEgalitarian) Reset()         { *m = DistributionTypeEga
 func (m *DistributionTypeEgalitarian) String() string { return proto.CompactTextString(m) }
 func (*DistributionTypeEgalitarian) ProtoMessage()    {}
 func (*DistributionTypeEgalitarian) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{5}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{10}
 }
 func (m *DistributionTypeEgalitarian) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,14 +633,14 @@ type UsersDistribution struct {
 	Weight uint32 `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
 	// Type defines the rewards distribution method. Currently only the basic
 	// distribution is allowed.
-	Type *types2.Any `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Type *types1.Any `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 }
 
 func (m *UsersDistribution) Reset()         { *m = UsersDistribution{} }
 func (m *UsersDistribution) String() string { return proto.CompactTextString(m) }
 func (*UsersDistribution) ProtoMessage()    {}
 func (*UsersDistribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{6}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{11}
 }
 func (m *UsersDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +681,7 @@ func (m *UsersDistributionTypeBasic) Reset()         { *m = UsersDistributionTyp
 func (m *UsersDistributionTypeBasic) String() string { return proto.CompactTextString(m) }
 func (*UsersDistributionTypeBasic) ProtoMessage()    {}
 func (*UsersDistributionTypeBasic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{7}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{12}
 }
 func (m *UsersDistributionTypeBasic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,6 +710,217 @@ func (m *UsersDistributionTypeBasic) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UsersDistributionTypeBasic proto.InternalMessageInfo
 
+// EmissionScheduleLinearDecay represents an emission schedule where the
+// emission rate decreases linearly from the plan's AmountPerDay at the start
+// time to FinalRate * AmountPerDay at the end time.
+type EmissionScheduleLinearDecay struct {
+	// FinalRate is the fraction of AmountPerDay that is distributed at the end
+	// of the plan. It must be between 0 and 1.
+	FinalRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=final_rate,json=finalRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"final_rate"`
+}
+
+func (m *EmissionScheduleLinearDecay) Reset()         { *m = EmissionScheduleLinearDecay{} }
+func (m *EmissionScheduleLinearDecay) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleLinearDecay) ProtoMessage()    {}
+func (*EmissionScheduleLinearDecay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{13}
+}
+func (m *EmissionScheduleLinearDecay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleLinearDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleLinearDecay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleLinearDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleLinearDecay.Merge(m, src)
+}
+func (m *EmissionScheduleLinearDecay) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleLinearDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleLinearDecay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleLinearDecay proto.InternalMessageInfo
+
+// EmissionScheduleHalving represents an emission schedule where the emission
+// rate is halved every HalvingPeriod, starting from the plan's start time.
+type EmissionScheduleHalving struct {
+	// HalvingPeriod is the time after which the emission rate is halved.
+	HalvingPeriod time.Duration `protobuf:"varint,1,opt,name=halving_period,json=halvingPeriod,proto3,stdduration" json:"halving_period,omitempty"`
+}
+
+func (m *EmissionScheduleHalving) Reset()         { *m = EmissionScheduleHalving{} }
+func (m *EmissionScheduleHalving) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleHalving) ProtoMessage()    {}
+func (*EmissionScheduleHalving) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{14}
+}
+func (m *EmissionScheduleHalving) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleHalving) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleHalving.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleHalving) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleHalving.Merge(m, src)
+}
+func (m *EmissionScheduleHalving) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleHalving) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleHalving.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleHalving proto.InternalMessageInfo
+
+// EmissionScheduleStep represents an emission schedule made of breakpoints.
+// Starting from each breakpoint's time, the emission rate becomes the
+// breakpoint's rate. Before the first breakpoint, the plan's AmountPerDay is
+// distributed.
+type EmissionScheduleStep struct {
+	// Steps are the breakpoints of the schedule, sorted by time.
+	Steps []EmissionStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *EmissionScheduleStep) Reset()         { *m = EmissionScheduleStep{} }
+func (m *EmissionScheduleStep) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleStep) ProtoMessage()    {}
+func (*EmissionScheduleStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{15}
+}
+func (m *EmissionScheduleStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleStep.Merge(m, src)
+}
+func (m *EmissionScheduleStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleStep proto.InternalMessageInfo
+
+// EmissionStep represents a single breakpoint of a step emission schedule.
+type EmissionStep struct {
+	// StartTime is the time from which the rate is applied.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Rate is the fraction of AmountPerDay that is distributed starting from
+	// StartTime.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *EmissionStep) Reset()         { *m = EmissionStep{} }
+func (m *EmissionStep) String() string { return proto.CompactTextString(m) }
+func (*EmissionStep) ProtoMessage()    {}
+func (*EmissionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{16}
+}
+func (m *EmissionStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionStep.Merge(m, src)
+}
+func (m *EmissionStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionStep proto.InternalMessageInfo
+
+// EmissionScheduleCliff represents a front-loaded emission schedule where
+// CliffRate * AmountPerDay is distributed during the first CliffDuration of
+// the plan, and AmountPerDay is distributed afterwards.
+type EmissionScheduleCliff struct {
+	// CliffDuration is the duration of the cliff, starting from the plan's
+	// start time.
+	CliffDuration time.Duration `protobuf:"varint,1,opt,name=cliff_duration,json=cliffDuration,proto3,stdduration" json:"cliff_duration,omitempty"`
+	// CliffRate is the multiplier of AmountPerDay that is applied during the
+	// cliff.
+	CliffRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cliff_rate,json=cliffRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cliff_rate"`
+}
+
+func (m *EmissionScheduleCliff) Reset()         { *m = EmissionScheduleCliff{} }
+func (m *EmissionScheduleCliff) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleCliff) ProtoMessage()    {}
+func (*EmissionScheduleCliff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{17}
+}
+func (m *EmissionScheduleCliff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleCliff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleCliff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleCliff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleCliff.Merge(m, src)
+}
+func (m *EmissionScheduleCliff) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleCliff) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleCliff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleCliff proto.InternalMessageInfo
+
 // HistoricalRewards represents historical rewards for a delegation target.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -405,7 +943,7 @@ func (m *HistoricalRewards) Reset()         { *m = HistoricalRewards{} }
 func (m *HistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewards) ProtoMessage()    {}
 func (*HistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{8}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{18}
 }
 func (m *HistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +984,7 @@ func (m *CurrentRewards) Reset()         { *m = CurrentRewards{} }
 func (m *CurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*CurrentRewards) ProtoMessage()    {}
 func (*CurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{9}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{19}
 }
 func (m *CurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +1023,7 @@ func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
 func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*OutstandingRewards) ProtoMessage()    {}
 func (*OutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{10}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{20}
 }
 func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +1063,7 @@ func (m *AccumulatedCommission) Reset()         { *m = AccumulatedCommission{} }
 func (m *AccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*AccumulatedCommission) ProtoMessage()    {}
 func (*AccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{11}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{21}
 }
 func (m *AccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +1108,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{12}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{22}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +1141,7 @@ var xxx_messageInfo_DelegatorStartingInfo proto.InternalMessageInfo
 // delegation reward. The delegator address implicit in the within the
 // query request.
 type DelegationDelegatorReward struct {
-	DelegationType     types1.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	DelegationType     types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
 	DelegationTargetID uint32                `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
 	Reward             DecPools              `protobuf:"bytes,3,rep,name=reward,proto3,castrepeated=DecPools" json:"reward"`
 }
@@ -612,7 +1150,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{13}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{23}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +1179,93 @@ func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
-// PoolServiceTotalDelegatorShares represents the total delegator shares for a
+// IBCWithdrawDestination represents an account on another chain to which the
+// withdrawn rewards are sent through an ICS-20 transfer.
+type IBCWithdrawDestination struct {
+	// SourceChannel is the channel used to send the rewards to the other chain
+	SourceChannel string `protobuf:"bytes,1,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// Receiver is the address that receives the rewards on the other chain
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timeout is the duration after which the transfer times out, starting
+	// from the time at which the rewards are withdrawn
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *IBCWithdrawDestination) Reset()         { *m = IBCWithdrawDestination{} }
+func (m *IBCWithdrawDestination) String() string { return proto.CompactTextString(m) }
+func (*IBCWithdrawDestination) ProtoMessage()    {}
+func (*IBCWithdrawDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{24}
+}
+func (m *IBCWithdrawDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCWithdrawDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCWithdrawDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCWithdrawDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCWithdrawDestination.Merge(m, src)
+}
+func (m *IBCWithdrawDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCWithdrawDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCWithdrawDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCWithdrawDestination proto.InternalMessageInfo
+
+// WithdrawnDelegationReward represents the rewards that have been withdrawn
+// from a single delegation target.
+type WithdrawnDelegationReward struct {
+	DelegationType     types2.DelegationType                    `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	DelegationTargetID uint32                                   `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	Amount             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *WithdrawnDelegationReward) Reset()         { *m = WithdrawnDelegationReward{} }
+func (m *WithdrawnDelegationReward) String() string { return proto.CompactTextString(m) }
+func (*WithdrawnDelegationReward) ProtoMessage()    {}
+func (*WithdrawnDelegationReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{25}
+}
+func (m *WithdrawnDelegationReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawnDelegationReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawnDelegationReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawnDelegationReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawnDelegationReward.Merge(m, src)
+}
+func (m *WithdrawnDelegationReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawnDelegationReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawnDelegationReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawnDelegationReward proto.InternalMessageInfo
+
+// PoolServiceTotalDelegatorShares represents the total delegator shares for a
 // pool-service pair.
 type PoolServiceTotalDelegatorShares struct {
 	PoolID    uint32                                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -653,7 +1277,7 @@ func (m *PoolServiceTotalDelegatorShares) Reset()         { *m = PoolServiceTota
 func (m *PoolServiceTotalDelegatorShares) String() string { return proto.CompactTextString(m) }
 func (*PoolServiceTotalDelegatorShares) ProtoMessage()    {}
 func (*PoolServiceTotalDelegatorShares) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{14}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{26}
 }
 func (m *PoolServiceTotalDelegatorShares) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,6 +1306,252 @@ func (m *PoolServiceTotalDelegatorShares) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolServiceTotalDelegatorShares proto.InternalMessageInfo
 
+// DelegatorSharesAccumulator keeps track of the delegator shares that a
+// delegation target has had since the last rewards allocation, weighted by the
+// time during which they have been delegated. It is used when rewards are
+// allocated in epochs, so that the rewards accrued during an epoch are split
+// among the delegation targets based on their time-weighted delegations.
+type DelegatorSharesAccumulator struct {
+	// DelegationType is the type of the delegation target
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// DelegationTargetID is the ID of the delegation target
+	DelegationTargetID uint32 `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	// ServiceID is the ID of the service toward which the pool shares are
+	// trusted. It is set only for pools, and is 0 otherwise.
+	ServiceID uint32 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// CumulativeShares is the sum of the delegator shares multiplied by the
+	// number of milliseconds during which they have been delegated, from the
+	// start time until the last update time
+	CumulativeShares github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=cumulative_shares,json=cumulativeShares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_shares"`
+	// StartTime is the time from which the shares have been accumulated
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// LastUpdateTime is the time at which the accumulator has been updated
+	// for the last time
+	LastUpdateTime time.Time `protobuf:"bytes,6,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time"`
+}
+
+func (m *DelegatorSharesAccumulator) Reset()         { *m = DelegatorSharesAccumulator{} }
+func (m *DelegatorSharesAccumulator) String() string { return proto.CompactTextString(m) }
+func (*DelegatorSharesAccumulator) ProtoMessage()    {}
+func (*DelegatorSharesAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{27}
+}
+func (m *DelegatorSharesAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorSharesAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorSharesAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorSharesAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorSharesAccumulator.Merge(m, src)
+}
+func (m *DelegatorSharesAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorSharesAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorSharesAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorSharesAccumulator proto.InternalMessageInfo
+
+// PeriodStartTime represents the time at which a rewards period of a
+// delegation target has started during the current allocation epoch. It is
+// used to split the rewards of the epoch among the delegators based on the
+// time during which they have been delegating.
+type PeriodStartTime struct {
+	// DelegationType is the type of the delegation target
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// DelegationTargetID is the ID of the delegation target
+	DelegationTargetID uint32 `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	// Period is the rewards period that has ended at the start time
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// StartTime is the time at which the period following the one above has
+	// started
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *PeriodStartTime) Reset()         { *m = PeriodStartTime{} }
+func (m *PeriodStartTime) String() string { return proto.CompactTextString(m) }
+func (*PeriodStartTime) ProtoMessage()    {}
+func (*PeriodStartTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{28}
+}
+func (m *PeriodStartTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodStartTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodStartTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodStartTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodStartTime.Merge(m, src)
+}
+func (m *PeriodStartTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodStartTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodStartTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodStartTime proto.InternalMessageInfo
+
+// DelegatorEpochClaim represents the part of the current allocation epoch
+// rewards that is owed to a delegator whose rewards have been withdrawn during
+// the epoch. The rewards are sent to the delegator once the epoch ends.
+type DelegatorEpochClaim struct {
+	// DelegationType is the type of the delegation target
+	DelegationType types2.DelegationType `protobuf:"varint,1,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// DelegationTargetID is the ID of the delegation target
+	DelegationTargetID uint32 `protobuf:"varint,2,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+	// DelegatorAddress is the address of the delegator
+	DelegatorAddress string `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// CumulativeStakes is the sum of the delegator stakes multiplied by the
+	// number of milliseconds during which they have been delegated since the
+	// start of the epoch
+	CumulativeStakes github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=cumulative_stakes,json=cumulativeStakes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_stakes"`
+	// Rewards are the rewards owed to the delegator
+	Rewards ServicePools `protobuf:"bytes,5,rep,name=rewards,proto3,castrepeated=ServicePools" json:"rewards"`
+}
+
+func (m *DelegatorEpochClaim) Reset()         { *m = DelegatorEpochClaim{} }
+func (m *DelegatorEpochClaim) String() string { return proto.CompactTextString(m) }
+func (*DelegatorEpochClaim) ProtoMessage()    {}
+func (*DelegatorEpochClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{29}
+}
+func (m *DelegatorEpochClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorEpochClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorEpochClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorEpochClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorEpochClaim.Merge(m, src)
+}
+func (m *DelegatorEpochClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorEpochClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorEpochClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorEpochClaim proto.InternalMessageInfo
+
+// AutoCompoundSetting represents the opt-in of a delegator to the
+// auto-compounding of the rewards received from a single delegation target.
+type AutoCompoundSetting struct {
+	// DelegatorAddress is the address of the delegator
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// DelegationType is the type of the delegation target
+	DelegationType types2.DelegationType `protobuf:"varint,2,opt,name=delegation_type,json=delegationType,proto3,enum=milkyway.restaking.v1.DelegationType" json:"delegation_type,omitempty"`
+	// DelegationTargetID is the ID of the delegation target
+	DelegationTargetID uint32 `protobuf:"varint,3,opt,name=delegation_target_id,json=delegationTargetId,proto3" json:"delegation_target_id,omitempty"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{30}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+// PlanAPR represents the projected annualised yield that the delegators of a
+// delegation target receive from a single rewards plan.
+type PlanAPR struct {
+	// PlanID is the ID of the rewards plan
+	PlanID uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// ServiceID is the ID of the service that created the rewards plan
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// APR is the projected annualised yield, expressed as a fraction of the
+	// USD value of the delegations (e.g. 0.05 means 5%)
+	APR cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *PlanAPR) Reset()         { *m = PlanAPR{} }
+func (m *PlanAPR) String() string { return proto.CompactTextString(m) }
+func (*PlanAPR) ProtoMessage()    {}
+func (*PlanAPR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcf66d1f3415f5b6, []int{31}
+}
+func (m *PlanAPR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanAPR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanAPR.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanAPR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAPR.Merge(m, src)
+}
+func (m *PlanAPR) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanAPR) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAPR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanAPR proto.InternalMessageInfo
+
 // Pool is a Coins wrapper with denom which represents the rewards pool for the
 // given denom. It is used to represent the rewards associated with the denom.
 type Pool struct {
@@ -693,7 +1563,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{15}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{32}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +1604,7 @@ func (m *DecPool) Reset()         { *m = DecPool{} }
 func (m *DecPool) String() string { return proto.CompactTextString(m) }
 func (*DecPool) ProtoMessage()    {}
 func (*DecPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{16}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{33}
 }
 func (m *DecPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +1643,7 @@ func (m *ServicePool) Reset()         { *m = ServicePool{} }
 func (m *ServicePool) String() string { return proto.CompactTextString(m) }
 func (*ServicePool) ProtoMessage()    {}
 func (*ServicePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcf66d1f3415f5b6, []int{17}
+	return fileDescriptor_fcf66d1f3415f5b6, []int{34}
 }
 func (m *ServicePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +1673,14 @@ func (m *ServicePool) XXX_DiscardUnknown() {
 var xxx_messageInfo_ServicePool proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("milkyway.rewards.v1.InsufficientFundsBehavior", InsufficientFundsBehavior_name, InsufficientFundsBehavior_value)
+	proto.RegisterEnum("milkyway.rewards.v1.StalePriceBehavior", StalePriceBehavior_name, StalePriceBehavior_value)
 	proto.RegisterType((*RewardsPlan)(nil), "milkyway.rewards.v1.RewardsPlan")
+	proto.RegisterType((*USDBudget)(nil), "milkyway.rewards.v1.USDBudget")
+	proto.RegisterType((*DelegationTypeDistributedRewards)(nil), "milkyway.rewards.v1.DelegationTypeDistributedRewards")
+	proto.RegisterType((*DelegationTargetDistributedRewards)(nil), "milkyway.rewards.v1.DelegationTargetDistributedRewards")
+	proto.RegisterType((*RewardsPlanAccounting)(nil), "milkyway.rewards.v1.RewardsPlanAccounting")
+	proto.RegisterType((*ArchivedRewardsPlan)(nil), "milkyway.rewards.v1.ArchivedRewardsPlan")
 	proto.RegisterType((*Distribution)(nil), "milkyway.rewards.v1.Distribution")
 	proto.RegisterType((*DistributionTypeBasic)(nil), "milkyway.rewards.v1.DistributionTypeBasic")
 	proto.RegisterType((*DistributionTypeWeighted)(nil), "milkyway.rewards.v1.DistributionTypeWeighted")
@@ -811,13 +1688,25 @@ func init() {
 	proto.RegisterType((*DistributionTypeEgalitarian)(nil), "milkyway.rewards.v1.DistributionTypeEgalitarian")
 	proto.RegisterType((*UsersDistribution)(nil), "milkyway.rewards.v1.UsersDistribution")
 	proto.RegisterType((*UsersDistributionTypeBasic)(nil), "milkyway.rewards.v1.UsersDistributionTypeBasic")
+	proto.RegisterType((*EmissionScheduleLinearDecay)(nil), "milkyway.rewards.v1.EmissionScheduleLinearDecay")
+	proto.RegisterType((*EmissionScheduleHalving)(nil), "milkyway.rewards.v1.EmissionScheduleHalving")
+	proto.RegisterType((*EmissionScheduleStep)(nil), "milkyway.rewards.v1.EmissionScheduleStep")
+	proto.RegisterType((*EmissionStep)(nil), "milkyway.rewards.v1.EmissionStep")
+	proto.RegisterType((*EmissionScheduleCliff)(nil), "milkyway.rewards.v1.EmissionScheduleCliff")
 	proto.RegisterType((*HistoricalRewards)(nil), "milkyway.rewards.v1.HistoricalRewards")
 	proto.RegisterType((*CurrentRewards)(nil), "milkyway.rewards.v1.CurrentRewards")
 	proto.RegisterType((*OutstandingRewards)(nil), "milkyway.rewards.v1.OutstandingRewards")
 	proto.RegisterType((*AccumulatedCommission)(nil), "milkyway.rewards.v1.AccumulatedCommission")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "milkyway.rewards.v1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "milkyway.rewards.v1.DelegationDelegatorReward")
+	proto.RegisterType((*IBCWithdrawDestination)(nil), "milkyway.rewards.v1.IBCWithdrawDestination")
+	proto.RegisterType((*WithdrawnDelegationReward)(nil), "milkyway.rewards.v1.WithdrawnDelegationReward")
 	proto.RegisterType((*PoolServiceTotalDelegatorShares)(nil), "milkyway.rewards.v1.PoolServiceTotalDelegatorShares")
+	proto.RegisterType((*DelegatorSharesAccumulator)(nil), "milkyway.rewards.v1.DelegatorSharesAccumulator")
+	proto.RegisterType((*PeriodStartTime)(nil), "milkyway.rewards.v1.PeriodStartTime")
+	proto.RegisterType((*DelegatorEpochClaim)(nil), "milkyway.rewards.v1.DelegatorEpochClaim")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "milkyway.rewards.v1.AutoCompoundSetting")
+	proto.RegisterType((*PlanAPR)(nil), "milkyway.rewards.v1.PlanAPR")
 	proto.RegisterType((*Pool)(nil), "milkyway.rewards.v1.Pool")
 	proto.RegisterType((*DecPool)(nil), "milkyway.rewards.v1.DecPool")
 	proto.RegisterType((*ServicePool)(nil), "milkyway.rewards.v1.ServicePool")
@@ -826,92 +1715,164 @@ func init() {
 func init() { proto.RegisterFile("milkyway/rewards/v1/models.proto", fileDescriptor_fcf66d1f3415f5b6) }
 
 var fileDescriptor_fcf66d1f3415f5b6 = []byte{
-	// 1356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x8e, 0x13, 0x8f, 0x13, 0x37, 0x59, 0x92, 0xc8, 0x0d, 0xc8, 0x6b, 0x16, 0x28,
-	0xe6, 0x8f, 0x6d, 0x25, 0x15, 0x42, 0x2a, 0x07, 0x88, 0xe3, 0xaa, 0x35, 0x95, 0x20, 0xda, 0xb4,
-	0x54, 0x2a, 0x12, 0xab, 0xf1, 0xce, 0xd4, 0x19, 0xd5, 0xbb, 0x63, 0x66, 0xd6, 0x2e, 0xe6, 0xd8,
-	0x13, 0x42, 0x20, 0x55, 0xe2, 0x03, 0x50, 0x6e, 0x88, 0x13, 0x87, 0x8a, 0xcf, 0x50, 0xf5, 0x54,
-	0x71, 0xe2, 0xe4, 0x82, 0x7b, 0x00, 0x71, 0xe0, 0xd0, 0x4f, 0x80, 0xe6, 0xcf, 0x3a, 0x9b, 0x8d,
-	0xf3, 0xc7, 0x88, 0x4b, 0xe2, 0x99, 0xf7, 0xde, 0xef, 0xbd, 0xdf, 0x9b, 0xdf, 0x3c, 0x8f, 0x41,
-	0xc9, 0x27, 0x9d, 0x3b, 0x83, 0xbb, 0x70, 0x50, 0x63, 0xf8, 0x2e, 0x64, 0x88, 0xd7, 0xfa, 0x9b,
-	0x35, 0x9f, 0x22, 0xdc, 0xe1, 0xd5, 0x2e, 0xa3, 0x21, 0x35, 0x5f, 0x88, 0x3c, 0xaa, 0xda, 0xa3,
-	0xda, 0xdf, 0xdc, 0x58, 0x81, 0x3e, 0x09, 0x68, 0x4d, 0xfe, 0x55, 0x7e, 0x1b, 0x45, 0x8f, 0x72,
-	0x9f, 0xf2, 0x5a, 0x0b, 0x72, 0x5c, 0xeb, 0x6f, 0xb6, 0x70, 0x08, 0x37, 0x6b, 0x1e, 0x25, 0x81,
-	0xb6, 0x9f, 0x57, 0x76, 0x57, 0xae, 0x6a, 0x6a, 0xa1, 0x4d, 0xab, 0x6d, 0xda, 0xa6, 0x6a, 0x5f,
-	0x7c, 0x8a, 0x02, 0xda, 0x94, 0xb6, 0x3b, 0xb8, 0x26, 0x57, 0xad, 0xde, 0xed, 0x1a, 0x0c, 0x06,
-	0xda, 0x64, 0x25, 0x4d, 0x21, 0xf1, 0x31, 0x0f, 0xa1, 0xdf, 0xd5, 0x0e, 0x76, 0x8c, 0x16, 0x0f,
-	0xe1, 0x1d, 0x12, 0xb4, 0x93, 0xc4, 0xec, 0x07, 0x73, 0x20, 0xe7, 0x28, 0x4a, 0xbb, 0x1d, 0x18,
-	0x98, 0xeb, 0x20, 0x45, 0x50, 0xc1, 0x28, 0x19, 0xe5, 0x74, 0x3d, 0x33, 0x1a, 0x5a, 0xa9, 0x66,
-	0xc3, 0x49, 0x11, 0x64, 0x96, 0x40, 0x0e, 0x61, 0xee, 0x31, 0xd2, 0x0d, 0x09, 0x0d, 0x0a, 0xa9,
-	0x92, 0x51, 0xce, 0x3a, 0xf1, 0x2d, 0xf3, 0x6d, 0x00, 0x38, 0x66, 0x7d, 0xe2, 0x61, 0x97, 0xa0,
-	0xc2, 0x6c, 0xc9, 0x28, 0x2f, 0xd5, 0x97, 0x46, 0x43, 0x2b, 0xbb, 0xa7, 0x76, 0x9b, 0x0d, 0x27,
-	0xab, 0x1d, 0x9a, 0xc8, 0xbc, 0x0c, 0xf2, 0xd0, 0xa7, 0xbd, 0x20, 0x74, 0xbb, 0x98, 0xb9, 0x08,
-	0x0e, 0x0a, 0xb9, 0x92, 0x51, 0xce, 0x6d, 0x9d, 0xaf, 0xea, 0xa6, 0x88, 0x0e, 0x56, 0x75, 0x07,
-	0xab, 0x3b, 0x94, 0x04, 0xf5, 0xf4, 0xa3, 0xa1, 0x35, 0xe3, 0x2c, 0xaa, 0xb0, 0x5d, 0xcc, 0x1a,
-	0x70, 0x60, 0xee, 0x00, 0xc0, 0x43, 0xc8, 0x42, 0x57, 0x70, 0x2f, 0xcc, 0x49, 0x88, 0x8d, 0xaa,
-	0x6a, 0x4c, 0x35, 0x6a, 0x4c, 0xf5, 0x7a, 0xd4, 0x98, 0xfa, 0x82, 0xc0, 0xb8, 0xff, 0xd4, 0x32,
-	0x9c, 0xac, 0x8c, 0x13, 0x16, 0xf3, 0x7d, 0xb0, 0x80, 0x03, 0xa4, 0x20, 0x32, 0x53, 0x40, 0xcc,
-	0xe3, 0x00, 0x49, 0x80, 0xf7, 0xc0, 0xa2, 0x96, 0x85, 0xdb, 0xa5, 0xb4, 0x53, 0x98, 0x17, 0xdd,
-	0xa9, 0x17, 0x7e, 0x7d, 0x58, 0x59, 0xd5, 0x6c, 0xb6, 0x11, 0x62, 0x98, 0xf3, 0xbd, 0x90, 0x91,
-	0xa0, 0xed, 0xe4, 0xb4, 0xf7, 0x2e, 0xa5, 0x1d, 0xf3, 0x13, 0x60, 0x8a, 0x20, 0xee, 0x22, 0xc2,
-	0x43, 0x46, 0x5a, 0x3d, 0xd9, 0xe0, 0x05, 0x59, 0xc7, 0xcb, 0xd5, 0x09, 0xba, 0xab, 0x36, 0x62,
-	0x8e, 0xba, 0x2b, 0x2b, 0x12, 0x22, 0x6e, 0x30, 0x3f, 0x03, 0xeb, 0xb4, 0x8b, 0x19, 0x0c, 0x29,
-	0x4b, 0x60, 0x67, 0xa7, 0xc3, 0x5e, 0x1b, 0xc3, 0x1c, 0xc2, 0xff, 0x14, 0x98, 0x3d, 0x8e, 0x93,
-	0xd8, 0x40, 0x62, 0x5f, 0x98, 0x88, 0x7d, 0x43, 0xb8, 0x4f, 0x2a, 0xbe, 0x97, 0x34, 0x5c, 0x4a,
-	0xff, 0xf5, 0xc0, 0x32, 0x3e, 0x4c, 0x2f, 0xa4, 0x97, 0xe7, 0xec, 0x27, 0x06, 0x58, 0x3c, 0x94,
-	0xf9, 0x23, 0x70, 0x0e, 0xe1, 0x0e, 0x6e, 0x43, 0xb1, 0x72, 0xc3, 0x41, 0x17, 0x4b, 0xc1, 0xe6,
-	0xb7, 0x5e, 0x8b, 0xa7, 0xd5, 0x8a, 0x97, 0xa4, 0xc6, 0xde, 0xd7, 0x07, 0x5d, 0xec, 0xe4, 0xd1,
-	0xa1, 0xb5, 0xb9, 0x0e, 0x32, 0x77, 0x31, 0x69, 0xef, 0x87, 0x52, 0xd6, 0x4b, 0x8e, 0x5e, 0x99,
-	0x0e, 0x48, 0x4b, 0xf0, 0x59, 0xc9, 0x69, 0xf5, 0x88, 0x26, 0xb6, 0x83, 0x41, 0xbd, 0xfc, 0xf8,
-	0x61, 0xe5, 0xd5, 0xd3, 0x1a, 0x29, 0xb3, 0x4a, 0x2c, 0x45, 0xcc, 0xde, 0x06, 0x6b, 0x49, 0x7b,
-	0x1d, 0x72, 0xe2, 0x5d, 0x3a, 0x33, 0x9c, 0xfd, 0xad, 0x01, 0x0a, 0xc9, 0xcd, 0x9b, 0xb2, 0x6e,
-	0x8c, 0xcc, 0x2b, 0x60, 0x5e, 0x71, 0xe0, 0x05, 0xa3, 0x34, 0x5b, 0xce, 0x6d, 0xbd, 0x7e, 0xea,
-	0x61, 0xab, 0x58, 0x7d, 0x22, 0x51, 0xf4, 0x14, 0xf5, 0xf4, 0x81, 0x79, 0x14, 0xce, 0xbc, 0x0a,
-	0x56, 0xe3, 0x47, 0x05, 0x59, 0x1b, 0x87, 0xae, 0x1e, 0x30, 0x4b, 0xf5, 0xf5, 0xd1, 0xd0, 0x32,
-	0x63, 0x87, 0x23, 0xcd, 0xcd, 0x86, 0x63, 0xa2, 0xe4, 0x1e, 0x3a, 0xee, 0x90, 0xec, 0x2b, 0xe0,
-	0xc5, 0x64, 0x2d, 0x97, 0xdb, 0xb0, 0x43, 0x42, 0xc8, 0x08, 0x0c, 0xa6, 0x20, 0xf0, 0xb5, 0x01,
-	0x56, 0x8e, 0x28, 0x34, 0x96, 0xd6, 0x38, 0xa4, 0x8d, 0x9b, 0x5a, 0x1b, 0xa9, 0x13, 0xb4, 0x51,
-	0x79, 0xfc, 0xb0, 0xf2, 0xc6, 0x99, 0x2e, 0xc2, 0x11, 0x81, 0x5c, 0x03, 0x1b, 0x13, 0x9d, 0x94,
-	0x4a, 0xa6, 0x03, 0xb6, 0xff, 0x31, 0xc0, 0xca, 0x55, 0xc2, 0x43, 0xca, 0x88, 0x07, 0x3b, 0x7a,
-	0xda, 0x9b, 0xdf, 0x19, 0xa0, 0xe0, 0xf5, 0xfc, 0x5e, 0x07, 0x86, 0xa4, 0x8f, 0x5d, 0x85, 0xe2,
-	0x32, 0xd1, 0xf5, 0x48, 0x35, 0xa5, 0x89, 0xaa, 0xd1, 0xf3, 0x5c, 0x0c, 0xaf, 0xfa, 0xbb, 0x42,
-	0x2e, 0xcf, 0x87, 0x96, 0x35, 0x80, 0x7e, 0xe7, 0x92, 0x7d, 0x1c, 0x9e, 0xfd, 0xd3, 0x53, 0x6b,
-	0x31, 0x16, 0xc7, 0x9d, 0xf5, 0x03, 0x57, 0x55, 0x90, 0x23, 0x1d, 0xcd, 0x1d, 0x70, 0x8e, 0xe1,
-	0xdb, 0x98, 0xe1, 0xc0, 0xc3, 0xae, 0x27, 0x26, 0xbd, 0x3a, 0xef, 0xfa, 0xc6, 0xf3, 0xa1, 0xb5,
-	0xae, 0xb2, 0x24, 0x1c, 0x6c, 0x27, 0x3f, 0xde, 0xd9, 0x91, 0x1b, 0xdf, 0x18, 0x20, 0xbf, 0xd3,
-	0x63, 0x0c, 0x07, 0x61, 0xc4, 0xb6, 0x05, 0xe6, 0x35, 0x85, 0x33, 0x73, 0xbb, 0xa0, 0xb9, 0xe5,
-	0xa3, 0xac, 0xd2, 0xeb, 0x28, 0x95, 0x08, 0x58, 0x68, 0xa5, 0x8b, 0x19, 0xa1, 0x48, 0x96, 0x9c,
-	0x76, 0xf4, 0xca, 0xee, 0x02, 0xf3, 0xe3, 0x5e, 0xc8, 0x43, 0x18, 0x20, 0x31, 0xfd, 0xb5, 0xf7,
-	0xad, 0x64, 0x45, 0x2f, 0x4d, 0xbe, 0xa3, 0xd8, 0x93, 0xd5, 0xd8, 0xc7, 0x56, 0xb3, 0xa0, 0x5d,
-	0x0e, 0x2a, 0xb1, 0x03, 0xb0, 0xb6, 0xed, 0xe9, 0x0e, 0x63, 0xb4, 0x43, 0x7d, 0x9f, 0x70, 0x2e,
-	0xe4, 0x7c, 0x03, 0xe4, 0xbc, 0xf1, 0xea, 0x6c, 0x89, 0xd7, 0x44, 0xe2, 0x78, 0x9a, 0x1f, 0xff,
-	0xfc, 0xf9, 0x4d, 0xc3, 0x89, 0xe3, 0xd8, 0xdf, 0xa7, 0xc0, 0x9a, 0xbe, 0xc7, 0x94, 0xed, 0x89,
-	0x2f, 0x56, 0x12, 0xb4, 0x9b, 0xc1, 0x6d, 0x2a, 0xce, 0xb3, 0xcb, 0x70, 0x9f, 0xd0, 0x1e, 0x77,
-	0x75, 0x73, 0xd4, 0xe3, 0x22, 0x76, 0x9e, 0x09, 0x07, 0xdb, 0xc9, 0x47, 0x3b, 0xbb, 0x72, 0xc3,
-	0xfc, 0x12, 0x64, 0xc4, 0x34, 0xc7, 0xbc, 0x90, 0xd2, 0x05, 0x4f, 0x7a, 0x24, 0x34, 0xb0, 0x27,
-	0xdf, 0x09, 0x0d, 0xdd, 0xa9, 0x25, 0x85, 0xae, 0x22, 0x45, 0xa3, 0xde, 0x6a, 0x93, 0x70, 0xbf,
-	0xd7, 0xaa, 0x7a, 0xd4, 0xd7, 0x4f, 0x2f, 0xfd, 0xaf, 0xc2, 0xd1, 0x9d, 0x9a, 0xb8, 0x7d, 0x3c,
-	0x02, 0xe1, 0x8e, 0xce, 0x68, 0x5e, 0x06, 0x99, 0x7d, 0x35, 0x00, 0x66, 0x65, 0xdd, 0x95, 0xbf,
-	0x87, 0xd6, 0x39, 0x8f, 0x61, 0x35, 0xd1, 0x94, 0xe9, 0x80, 0x4a, 0xc2, 0x60, 0x3b, 0x3a, 0xd8,
-	0xbe, 0x97, 0x02, 0xe7, 0x0f, 0x26, 0xdd, 0xb8, 0x57, 0x4a, 0x0c, 0xff, 0xfb, 0x37, 0xda, 0x71,
-	0x63, 0x37, 0x35, 0xf5, 0xd8, 0xbd, 0x06, 0x32, 0x4a, 0x13, 0x85, 0xd9, 0xff, 0xae, 0x15, 0x0d,
-	0x61, 0x3f, 0x35, 0x80, 0x25, 0xb6, 0xf5, 0xf5, 0xb9, 0x4e, 0x43, 0xd8, 0x39, 0x90, 0xcd, 0x3e,
-	0x64, 0x98, 0x9b, 0xaf, 0x80, 0x79, 0xf1, 0x96, 0x39, 0xf8, 0x92, 0x00, 0xa3, 0xa1, 0x95, 0x11,
-	0x51, 0xcd, 0x86, 0x93, 0x11, 0xa6, 0x26, 0x4a, 0xbc, 0x35, 0x53, 0xa7, 0xbc, 0x35, 0x09, 0xc8,
-	0x70, 0x09, 0x3e, 0xe6, 0x70, 0x92, 0x7c, 0x2e, 0x6a, 0x0e, 0x53, 0xaa, 0x45, 0x26, 0xb0, 0x7f,
-	0x30, 0x40, 0x5a, 0xbe, 0xea, 0x2e, 0x80, 0x39, 0x84, 0x03, 0xea, 0x4b, 0x12, 0xd9, 0xfa, 0xf2,
-	0xf3, 0xa1, 0xb5, 0xa8, 0x24, 0x22, 0xb7, 0x6d, 0x47, 0x99, 0xcd, 0xcf, 0xc1, 0x9c, 0xf8, 0x79,
-	0x10, 0x29, 0xfb, 0x84, 0xe7, 0xef, 0x07, 0x5a, 0xd6, 0x1a, 0x46, 0x46, 0x09, 0x55, 0x97, 0xcf,
-	0x50, 0xa7, 0x2a, 0x52, 0x65, 0xb2, 0x7f, 0x31, 0xc0, 0xbc, 0x3e, 0x9f, 0x33, 0x97, 0x79, 0xcf,
-	0x00, 0x59, 0x84, 0x3d, 0x37, 0x5e, 0xeb, 0xc9, 0x6d, 0xbc, 0xa2, 0xcb, 0x5d, 0x8e, 0xe0, 0x74,
-	0xf0, 0xd4, 0x17, 0x71, 0x01, 0xe9, 0x4f, 0xf6, 0x57, 0x06, 0xc8, 0xc5, 0x26, 0x6f, 0x42, 0x05,
-	0xc6, 0x29, 0x2a, 0xb8, 0xa6, 0x18, 0xc8, 0x87, 0xf2, 0x98, 0xc1, 0x49, 0x62, 0x5e, 0x4e, 0x8a,
-	0x59, 0x96, 0x22, 0x3f, 0xd5, 0xf7, 0x1e, 0xfd, 0x51, 0x9c, 0x79, 0x34, 0x2a, 0x1a, 0x4f, 0x46,
-	0x45, 0xe3, 0xf7, 0x51, 0xd1, 0xb8, 0xff, 0xac, 0x38, 0xf3, 0xe4, 0x59, 0x71, 0xe6, 0xb7, 0x67,
-	0xc5, 0x99, 0x5b, 0xef, 0xc4, 0xf8, 0x45, 0x19, 0x2a, 0x1d, 0xd8, 0xe2, 0xe3, 0x55, 0xad, 0xbf,
-	0xb9, 0x55, 0xfb, 0x62, 0xfc, 0x73, 0x53, 0x52, 0x6e, 0x65, 0xe4, 0xeb, 0xe1, 0xe2, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0x37, 0x9e, 0x84, 0x8f, 0x0e, 0x00, 0x00,
+	// 2512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xdd, 0x5a, 0x92, 0x7a, 0xfd, 0x24, 0xd1, 0xd4, 0x58, 0xd2, 0x47, 0xc9, 0x81, 0xa8, 0x6c, 0x1e,
+	0x56, 0x9c, 0x4f, 0x64, 0xad, 0x20, 0x4d, 0xe1, 0x22, 0x48, 0xf9, 0x92, 0xcd, 0x58, 0x90, 0x89,
+	0xa5, 0x94, 0x20, 0x69, 0xd0, 0xc5, 0x68, 0x77, 0x44, 0x2e, 0xb4, 0xdc, 0x65, 0xf6, 0x41, 0x9b,
+	0x3d, 0xb4, 0x40, 0x2e, 0x75, 0x8b, 0x16, 0x08, 0xd0, 0x1e, 0x8a, 0x1c, 0x9a, 0x16, 0x3d, 0xb4,
+	0x08, 0xd0, 0xc2, 0x07, 0xa3, 0xff, 0x41, 0x01, 0x23, 0x27, 0x23, 0xa7, 0xa2, 0x28, 0xe4, 0x56,
+	0x3e, 0xb8, 0xe8, 0xa1, 0x07, 0x1f, 0x5a, 0xf4, 0x56, 0xcc, 0x63, 0xc9, 0xd5, 0x8a, 0x92, 0x49,
+	0xc7, 0x71, 0x7d, 0xb1, 0xb8, 0x33, 0xbf, 0xf7, 0x7b, 0x66, 0x0c, 0x2b, 0x4d, 0xc3, 0xdc, 0xef,
+	0x5c, 0xc7, 0x9d, 0x9c, 0x43, 0xae, 0x63, 0x47, 0x77, 0x73, 0xed, 0x8b, 0xb9, 0xa6, 0xad, 0x13,
+	0xd3, 0xcd, 0xb6, 0x1c, 0xdb, 0xb3, 0xd1, 0xd9, 0x00, 0x22, 0x2b, 0x20, 0xb2, 0xed, 0x8b, 0x4b,
+	0xb3, 0xb8, 0x69, 0x58, 0x76, 0x8e, 0xfd, 0xcb, 0xe1, 0x96, 0x96, 0x35, 0xdb, 0x6d, 0xda, 0x6e,
+	0x6e, 0x17, 0xbb, 0x24, 0xd7, 0xbe, 0xb8, 0x4b, 0x3c, 0x7c, 0x31, 0xa7, 0xd9, 0x86, 0x25, 0xf6,
+	0x17, 0xf9, 0xbe, 0xca, 0xbe, 0x72, 0xfc, 0x43, 0x6c, 0xcd, 0xd5, 0xed, 0xba, 0xcd, 0xd7, 0xe9,
+	0xaf, 0x00, 0xa1, 0x6e, 0xdb, 0x75, 0x93, 0xe4, 0xd8, 0xd7, 0xae, 0xbf, 0x97, 0xc3, 0x56, 0x27,
+	0xe0, 0x15, 0xdd, 0xd2, 0x7d, 0x07, 0x7b, 0x86, 0x1d, 0xf0, 0xca, 0x44, 0xf7, 0x3d, 0xa3, 0x49,
+	0x5c, 0x0f, 0x37, 0x5b, 0x02, 0x40, 0x0e, 0xa9, 0xed, 0x7a, 0x78, 0xdf, 0xb0, 0xea, 0x51, 0xc5,
+	0xe5, 0x7f, 0x8f, 0xc3, 0x94, 0xc2, 0x55, 0xae, 0x9a, 0xd8, 0x42, 0x0b, 0x10, 0x33, 0xf4, 0xb4,
+	0xb4, 0x22, 0xad, 0x26, 0x0a, 0x63, 0x87, 0x07, 0x99, 0x58, 0xa5, 0xa4, 0xc4, 0x0c, 0x1d, 0xad,
+	0xc0, 0x94, 0x4e, 0x5c, 0xcd, 0x31, 0x5a, 0x54, 0x82, 0x74, 0x6c, 0x45, 0x5a, 0x9d, 0x54, 0xc2,
+	0x4b, 0xe8, 0xff, 0x01, 0x5c, 0xe2, 0xb4, 0x0d, 0x8d, 0xa8, 0x86, 0x9e, 0x8e, 0xaf, 0x48, 0xab,
+	0x33, 0x85, 0x99, 0xc3, 0x83, 0xcc, 0x64, 0x8d, 0xaf, 0x56, 0x4a, 0xca, 0xa4, 0x00, 0xa8, 0xe8,
+	0xe8, 0x43, 0x48, 0xe2, 0xa6, 0xed, 0x5b, 0x9e, 0xda, 0x22, 0x8e, 0xaa, 0xe3, 0x4e, 0x7a, 0x6a,
+	0x25, 0xbe, 0x3a, 0xb5, 0xbe, 0x98, 0x15, 0x46, 0xa3, 0x16, 0xce, 0x0a, 0x0b, 0x67, 0x8b, 0xb6,
+	0x61, 0x15, 0xbe, 0x76, 0xe7, 0x20, 0x33, 0xf2, 0xd9, 0xbd, 0xcc, 0x6a, 0xdd, 0xf0, 0x1a, 0xfe,
+	0x6e, 0x56, 0xb3, 0x9b, 0xc2, 0xc2, 0xe2, 0xcf, 0x9a, 0xab, 0xef, 0xe7, 0xbc, 0x4e, 0x8b, 0xb8,
+	0x0c, 0xc1, 0x55, 0xa6, 0x39, 0x8b, 0x2a, 0x71, 0x4a, 0xb8, 0x83, 0x8a, 0x00, 0xae, 0x87, 0x1d,
+	0x4f, 0xa5, 0x76, 0x4a, 0x8f, 0xae, 0x48, 0xab, 0x53, 0xeb, 0x4b, 0x59, 0x6e, 0xc4, 0x6c, 0x60,
+	0xc4, 0xec, 0x76, 0x60, 0xc4, 0xc2, 0x04, 0xe5, 0xf7, 0xf1, 0xbd, 0x8c, 0xa4, 0x4c, 0x32, 0x3c,
+	0xba, 0x83, 0xde, 0x82, 0x09, 0x62, 0xe9, 0x9c, 0xc4, 0xd8, 0x10, 0x24, 0xc6, 0x89, 0xa5, 0x33,
+	0x02, 0xdf, 0x84, 0x69, 0x11, 0x62, 0x6a, 0xcb, 0xb6, 0xcd, 0xf4, 0x38, 0xb5, 0x64, 0x21, 0xfd,
+	0xc5, 0xed, 0xb5, 0x39, 0xa1, 0x79, 0x5e, 0xd7, 0x1d, 0xe2, 0xba, 0x35, 0xcf, 0x31, 0xac, 0xba,
+	0x32, 0x25, 0xa0, 0xab, 0xb6, 0x6d, 0xa2, 0x77, 0x00, 0x51, 0x24, 0x57, 0xd5, 0x0d, 0xd7, 0x73,
+	0x8c, 0x5d, 0x9f, 0x39, 0x63, 0x82, 0xc9, 0xf1, 0x7c, 0xb6, 0x4f, 0x0c, 0x67, 0x4b, 0x21, 0xc0,
+	0x42, 0x82, 0x8a, 0xa3, 0xcc, 0x32, 0x12, 0xe1, 0x0d, 0xf4, 0x1d, 0x58, 0xb0, 0x5b, 0xc4, 0xc1,
+	0x9e, 0xed, 0x44, 0x68, 0x4f, 0x0e, 0x47, 0x7b, 0xbe, 0x4b, 0xe6, 0x08, 0xfd, 0x6f, 0x03, 0xf2,
+	0x5d, 0x12, 0xa5, 0x0d, 0x8c, 0xf6, 0xcb, 0x7d, 0x69, 0xef, 0x50, 0xf0, 0x7e, 0xc2, 0xfb, 0xd1,
+	0x0d, 0xb4, 0x0f, 0xb3, 0xa4, 0x69, 0xb8, 0xae, 0x61, 0x5b, 0xaa, 0xab, 0x35, 0x88, 0xee, 0x9b,
+	0x24, 0x3d, 0xcd, 0x68, 0xcf, 0x1d, 0xf3, 0x4d, 0xde, 0xea, 0x14, 0x56, 0x3f, 0xbf, 0xbd, 0xf6,
+	0x62, 0x3f, 0xa6, 0x65, 0x41, 0xa6, 0x26, 0xa8, 0x28, 0x29, 0x12, 0x59, 0x41, 0x16, 0x9c, 0x33,
+	0x2c, 0xd7, 0xdf, 0xdb, 0x33, 0x34, 0x83, 0x58, 0x9e, 0xba, 0xe7, 0x5b, 0xba, 0xab, 0xee, 0x92,
+	0x06, 0x6e, 0x1b, 0xb6, 0x93, 0x9e, 0x59, 0x91, 0x56, 0x93, 0xeb, 0xd9, 0xbe, 0x2a, 0x55, 0x42,
+	0x78, 0x1b, 0x14, 0xad, 0x20, 0xb0, 0x94, 0x45, 0xe3, 0xa4, 0x2d, 0xb4, 0x09, 0xe0, 0xbb, 0xba,
+	0xba, 0xeb, 0xeb, 0x75, 0xe2, 0xa5, 0x93, 0x4c, 0xab, 0xe5, 0xfe, 0x16, 0xab, 0x95, 0x0a, 0x0c,
+	0x8a, 0x67, 0x5d, 0xf7, 0x53, 0x99, 0xf4, 0x5d, 0x9d, 0xff, 0xbc, 0x94, 0xf8, 0xfb, 0x2f, 0x33,
+	0xd2, 0xdb, 0x89, 0x89, 0x44, 0x6a, 0x54, 0xfe, 0x59, 0x1c, 0x7a, 0x40, 0xe8, 0x83, 0x63, 0xf9,
+	0x28, 0xb1, 0xc0, 0xfc, 0x3a, 0xb5, 0xfa, 0x9f, 0x0f, 0x32, 0xe7, 0x78, 0x70, 0xba, 0xfa, 0x7e,
+	0xd6, 0xb0, 0x73, 0x4d, 0xec, 0x35, 0xb2, 0x9b, 0xa4, 0x8e, 0xb5, 0x4e, 0x89, 0x68, 0x5f, 0xdc,
+	0x5e, 0x03, 0x11, 0xbb, 0x25, 0xa2, 0xfd, 0xf6, 0xc1, 0xad, 0x0b, 0x52, 0x24, 0xf5, 0x9e, 0x87,
+	0xe9, 0x16, 0xee, 0xd8, 0xbe, 0xa7, 0xea, 0xc4, 0xb2, 0x9b, 0x41, 0xf9, 0xe0, 0x6b, 0x25, 0xba,
+	0x84, 0x2e, 0xc3, 0x4c, 0x13, 0xdf, 0x50, 0x5b, 0x0e, 0x2d, 0x20, 0xb8, 0x4e, 0x58, 0x05, 0xa1,
+	0xf5, 0x20, 0xea, 0xc1, 0x92, 0xa8, 0x82, 0x3c, 0xb9, 0x7e, 0x4e, 0x93, 0x6b, 0xaa, 0x89, 0x6f,
+	0x54, 0x29, 0x62, 0xbe, 0x4e, 0xd0, 0x7b, 0x30, 0xe7, 0x7a, 0xd8, 0x24, 0x82, 0x54, 0xd7, 0x35,
+	0x09, 0xe6, 0x9a, 0xf3, 0x7d, 0x6d, 0x57, 0xa3, 0x08, 0x8c, 0x42, 0xd7, 0x27, 0xc8, 0x3d, 0xb6,
+	0x86, 0x3e, 0x80, 0x74, 0x98, 0x74, 0xdd, 0xc1, 0x1a, 0xa1, 0xf6, 0x32, 0x6c, 0x5d, 0xd4, 0x93,
+	0x81, 0xc4, 0x9d, 0xef, 0x91, 0xbe, 0x4c, 0x49, 0x54, 0x19, 0x05, 0xee, 0x1c, 0xf9, 0x50, 0x82,
+	0x95, 0x12, 0x31, 0x49, 0x9d, 0x61, 0x6d, 0x77, 0x5a, 0xa4, 0x1b, 0xec, 0x44, 0x17, 0x95, 0x1a,
+	0x6d, 0xc1, 0x19, 0xbd, 0x0b, 0xa3, 0xd2, 0x92, 0xc7, 0xdc, 0x95, 0x5c, 0x7f, 0x29, 0xac, 0x9e,
+	0xa8, 0xf9, 0x2c, 0x55, 0x8f, 0x50, 0x54, 0x92, 0xfa, 0x91, 0x6f, 0x64, 0xc1, 0x18, 0xf7, 0x57,
+	0x3a, 0xc6, 0xaa, 0xf0, 0x73, 0x7d, 0xab, 0x70, 0x89, 0x68, 0xac, 0x10, 0x7f, 0x43, 0x14, 0xe2,
+	0x57, 0x07, 0x28, 0xc4, 0x02, 0xc7, 0xe5, 0x51, 0x21, 0xb8, 0xc8, 0xbf, 0x8f, 0x81, 0x1c, 0x12,
+	0x09, 0x3b, 0x75, 0xe2, 0x3d, 0x05, 0x35, 0xaf, 0xc0, 0x5c, 0x98, 0x1e, 0x63, 0x4b, 0x9b, 0x55,
+	0x8c, 0x35, 0xab, 0x85, 0xc3, 0x83, 0x0c, 0x8a, 0x4a, 0x55, 0x29, 0x29, 0x48, 0x8f, 0xae, 0xe9,
+	0x21, 0x83, 0xc5, 0x9f, 0x8a, 0xc1, 0x1e, 0x48, 0x30, 0x1f, 0x6a, 0xd3, 0x79, 0x4d, 0xa3, 0xcb,
+	0x86, 0x55, 0x47, 0x2f, 0xc0, 0x78, 0xcb, 0xc4, 0x96, 0xda, 0xed, 0xda, 0x70, 0x78, 0x90, 0x19,
+	0xa3, 0x40, 0x95, 0x92, 0x32, 0x46, 0xb7, 0x2a, 0x3a, 0xba, 0x01, 0x0b, 0x7a, 0xcf, 0xbc, 0x2c,
+	0xc5, 0xb9, 0xf6, 0xc2, 0xdf, 0x6f, 0xf4, 0xaf, 0xef, 0x8f, 0xf4, 0x50, 0x61, 0x92, 0x6a, 0xc6,
+	0x45, 0x9d, 0x0b, 0x71, 0xa8, 0x12, 0x87, 0xa3, 0xa0, 0x1c, 0x9c, 0x75, 0xf7, 0x8d, 0x56, 0x8b,
+	0xe8, 0x2a, 0x36, 0x4d, 0x5b, 0x63, 0xe4, 0x5c, 0x96, 0xdc, 0x09, 0x05, 0x89, 0xad, 0x7c, 0x6f,
+	0x47, 0xfe, 0x4b, 0x1c, 0xce, 0xe6, 0x1d, 0xad, 0x61, 0xb4, 0xbb, 0x5c, 0xd8, 0x60, 0xf2, 0x16,
+	0x24, 0xa8, 0x32, 0x4c, 0xc9, 0xa9, 0xf5, 0x95, 0xbe, 0x02, 0x87, 0xe0, 0xc3, 0x92, 0x31, 0x44,
+	0x74, 0x0d, 0x52, 0x1e, 0x71, 0x9a, 0x86, 0x25, 0xbc, 0x4f, 0x3b, 0x78, 0x6c, 0x88, 0x0e, 0x7e,
+	0x26, 0x84, 0xcd, 0x3a, 0xf9, 0x0f, 0x24, 0xc8, 0x44, 0xad, 0x1a, 0x0d, 0x57, 0x1e, 0x1d, 0xaf,
+	0x3f, 0xca, 0xbc, 0x7d, 0xb3, 0x3c, 0xac, 0xc2, 0x73, 0x47, 0x8d, 0x7b, 0x14, 0xf5, 0x14, 0xf7,
+	0x26, 0xfe, 0x37, 0xee, 0x1d, 0x3d, 0xd1, 0xbd, 0x77, 0x25, 0x98, 0x3e, 0xd2, 0xbd, 0x9f, 0x74,
+	0x8e, 0x2f, 0xc0, 0xd8, 0x75, 0x62, 0xd4, 0x1b, 0x1e, 0xcf, 0x6a, 0x45, 0x7c, 0x21, 0x05, 0x12,
+	0xc2, 0x23, 0x8f, 0x31, 0x18, 0x84, 0x25, 0x66, 0x5c, 0x19, 0x2d, 0x51, 0xb1, 0xf3, 0x30, 0x1f,
+	0xdd, 0x2f, 0x60, 0xd7, 0xd0, 0x2e, 0x0d, 0x4c, 0x4e, 0xfe, 0x89, 0x04, 0xe9, 0xe8, 0xe2, 0xbb,
+	0x4c, 0x6e, 0xa2, 0xa3, 0xcb, 0x30, 0xce, 0x75, 0x70, 0xd3, 0x12, 0x73, 0xe7, 0xf9, 0x47, 0x4e,
+	0x63, 0x1c, 0x57, 0x8c, 0x4c, 0x01, 0xf6, 0x10, 0xf2, 0xb4, 0x01, 0x1d, 0x27, 0x77, 0x62, 0xf9,
+	0x94, 0x86, 0x2e, 0x9f, 0x27, 0x38, 0x49, 0xbe, 0x0c, 0xe7, 0xa2, 0xb2, 0x94, 0xeb, 0xd8, 0x34,
+	0x3c, 0xec, 0x18, 0xd8, 0x1a, 0x42, 0x81, 0x1f, 0x49, 0x30, 0x7b, 0x6c, 0x84, 0x0c, 0xb1, 0x95,
+	0x8e, 0xc4, 0xc6, 0xbb, 0x22, 0x36, 0x62, 0xa7, 0xc4, 0xc6, 0xda, 0xe7, 0xb7, 0xd7, 0x5e, 0x19,
+	0x68, 0x52, 0x3d, 0x16, 0x20, 0x57, 0x61, 0xa9, 0x2f, 0x10, 0x8f, 0x92, 0xe1, 0x08, 0xcb, 0xbf,
+	0x90, 0xe0, 0x5c, 0x74, 0x4e, 0xdd, 0x34, 0x2c, 0x82, 0x9d, 0x12, 0xd1, 0x70, 0x07, 0xed, 0x00,
+	0xec, 0x19, 0x16, 0x36, 0x55, 0x07, 0x7b, 0xe4, 0x4b, 0x0e, 0x71, 0x93, 0x8c, 0x92, 0x82, 0x3d,
+	0x72, 0x69, 0xe0, 0x99, 0x59, 0x6e, 0xc1, 0xff, 0x45, 0xd7, 0xae, 0x60, 0xb3, 0x4d, 0x7b, 0xd5,
+	0xab, 0x90, 0x6c, 0xf0, 0x9f, 0xc1, 0xd4, 0x44, 0xe5, 0x8b, 0x17, 0x12, 0x6c, 0x2c, 0x9a, 0x11,
+	0x7b, 0x62, 0x1c, 0x1a, 0x9c, 0xe3, 0xf7, 0x61, 0x2e, 0xba, 0x56, 0xf3, 0x48, 0x0b, 0xbd, 0x09,
+	0xa3, 0xae, 0x47, 0x5a, 0x41, 0xda, 0xf4, 0x3f, 0xc4, 0x74, 0x31, 0x3d, 0xd2, 0x12, 0x09, 0xc3,
+	0xb1, 0x86, 0x10, 0xe0, 0x53, 0x09, 0xa6, 0xc3, 0x74, 0x22, 0x47, 0x4d, 0xe9, 0xf1, 0x8e, 0x9a,
+	0x6f, 0x43, 0x82, 0xf9, 0x30, 0xf6, 0xa5, 0x7c, 0xc8, 0x68, 0xc8, 0x7f, 0x94, 0x60, 0x3e, 0x2a,
+	0x76, 0xd1, 0x34, 0xf6, 0xf6, 0xa8, 0x4f, 0x34, 0xfa, 0x43, 0x0d, 0x6e, 0x17, 0x8e, 0xfa, 0x84,
+	0xed, 0x05, 0x33, 0x2c, 0x0d, 0x2e, 0x0e, 0xfc, 0x04, 0x04, 0x9b, 0x64, 0x94, 0x86, 0x0c, 0xae,
+	0x7f, 0x4a, 0x30, 0x7b, 0xc5, 0x70, 0x3d, 0xdb, 0x31, 0x34, 0x6c, 0x06, 0x73, 0xe2, 0x4f, 0x25,
+	0x48, 0x6b, 0x7e, 0xd3, 0x37, 0xb1, 0x67, 0xb4, 0x89, 0xca, 0x09, 0xa8, 0x4c, 0xe6, 0xc0, 0xf9,
+	0xfd, 0x07, 0x06, 0x71, 0x35, 0x41, 0xcf, 0xd6, 0x85, 0x37, 0xa8, 0x1e, 0x0f, 0x0f, 0x32, 0x99,
+	0x0e, 0x6e, 0x9a, 0x97, 0xe4, 0x93, 0xe8, 0xc9, 0x9f, 0xdd, 0xcb, 0x4c, 0x87, 0xf0, 0x5c, 0x65,
+	0xa1, 0x07, 0xca, 0x05, 0x52, 0x18, 0x20, 0x2a, 0xc2, 0x19, 0x87, 0xec, 0x11, 0x87, 0x58, 0x1a,
+	0x51, 0x35, 0x31, 0x5d, 0xd3, 0x4a, 0xb9, 0xf4, 0xf0, 0x20, 0xb3, 0xc0, 0xb9, 0x44, 0x00, 0x64,
+	0x25, 0xd9, 0x5d, 0x29, 0xb2, 0x85, 0x1f, 0x4b, 0x90, 0x2c, 0xfa, 0x8e, 0x43, 0x2c, 0x2f, 0xd0,
+	0x76, 0x17, 0xc6, 0x85, 0x0a, 0x03, 0xeb, 0xf6, 0xb2, 0xd0, 0x2d, 0x19, 0x70, 0x65, 0x50, 0xc7,
+	0x55, 0x09, 0x08, 0xd3, 0x4a, 0x29, 0x32, 0x34, 0xc6, 0x5a, 0xb9, 0xf8, 0x92, 0x5b, 0x80, 0xae,
+	0xf9, 0x9e, 0xeb, 0x61, 0x4b, 0x37, 0xac, 0x7a, 0x20, 0xd1, 0xfb, 0x51, 0x89, 0x9e, 0x3b, 0x61,
+	0xe0, 0xd0, 0x98, 0x34, 0xf2, 0x89, 0xd2, 0x4c, 0x08, 0x90, 0x9e, 0x24, 0xb2, 0x05, 0xf3, 0x79,
+	0x4d, 0x58, 0x98, 0xe8, 0x45, 0xbb, 0x29, 0x42, 0x02, 0xed, 0xc0, 0x94, 0xd6, 0xfd, 0x1a, 0x8c,
+	0xf1, 0xbc, 0x98, 0xc3, 0xbb, 0x6c, 0x78, 0x24, 0x86, 0xe9, 0xc8, 0x9f, 0xc6, 0x60, 0x5e, 0x74,
+	0x31, 0xdb, 0xa9, 0xd1, 0x64, 0x34, 0xac, 0x7a, 0xc5, 0xda, 0xb3, 0xa9, 0x3f, 0x5b, 0x0e, 0x69,
+	0x1b, 0xb6, 0xef, 0x86, 0xcb, 0x57, 0x22, 0xec, 0xcf, 0x08, 0x80, 0xac, 0x24, 0x83, 0x15, 0x5e,
+	0xd5, 0xd0, 0x77, 0x61, 0x8c, 0xce, 0x32, 0xc4, 0x1d, 0xe8, 0xa4, 0x55, 0x12, 0x96, 0x9a, 0xe1,
+	0xd4, 0x39, 0xa6, 0x3c, 0xe4, 0x49, 0x42, 0x11, 0x1c, 0x51, 0x19, 0xc6, 0x1a, 0xbc, 0xfd, 0xb1,
+	0xf1, 0xbb, 0xb0, 0xf6, 0x8f, 0x83, 0xcc, 0x19, 0xcd, 0x21, 0xbc, 0x9f, 0xf3, 0xad, 0x9e, 0x2a,
+	0x91, 0x0d, 0x59, 0x11, 0xc8, 0xf2, 0x47, 0x31, 0x58, 0xec, 0xf5, 0xf9, 0xae, 0xad, 0x78, 0x30,
+	0x3c, 0xc3, 0x67, 0xb6, 0xab, 0x30, 0xc6, 0x63, 0xa2, 0x7b, 0x66, 0x7b, 0x8c, 0x58, 0x11, 0x24,
+	0xe4, 0x4f, 0x24, 0x58, 0xa8, 0x14, 0x8a, 0xef, 0x1a, 0x5e, 0x43, 0x77, 0xf0, 0xf5, 0x12, 0x71,
+	0x3d, 0x71, 0x36, 0x40, 0x2f, 0x41, 0xd2, 0xb5, 0x7d, 0x87, 0xe6, 0x74, 0x03, 0x5b, 0x16, 0x31,
+	0x79, 0x17, 0x56, 0x66, 0xf8, 0x6a, 0x91, 0x2f, 0xa2, 0x25, 0x98, 0x70, 0x88, 0x46, 0x8c, 0x36,
+	0x71, 0xc4, 0x7d, 0x48, 0xf7, 0x1b, 0xbd, 0x09, 0xe3, 0xb4, 0x73, 0xd8, 0xbe, 0x37, 0xcc, 0x35,
+	0x48, 0x80, 0x23, 0xdf, 0x8a, 0xc1, 0x62, 0x20, 0x99, 0xd5, 0xb3, 0xce, 0x33, 0xef, 0xa1, 0x4e,
+	0xe4, 0x54, 0x7d, 0xca, 0x65, 0xf0, 0xc6, 0xb0, 0x97, 0xc1, 0x9f, 0x3c, 0xb8, 0x75, 0x61, 0xda,
+	0x64, 0x0d, 0x49, 0xd5, 0x8e, 0x1f, 0xb0, 0xef, 0x49, 0x90, 0xa1, 0x6e, 0x16, 0xe5, 0x70, 0xdb,
+	0xf6, 0xb0, 0xd9, 0x2b, 0x03, 0x0d, 0xec, 0x10, 0x97, 0x1d, 0xb5, 0x6d, 0xdb, 0xec, 0x8d, 0xbc,
+	0xfc, 0xa8, 0x6d, 0xdb, 0x26, 0x3b, 0x6a, 0xd3, 0xbf, 0x7a, 0xe4, 0x1a, 0x3c, 0xf6, 0x88, 0x6b,
+	0x70, 0x03, 0xc6, 0x5c, 0x46, 0x7c, 0xa0, 0x7b, 0x84, 0xd7, 0x1e, 0xe3, 0x1e, 0x41, 0x11, 0x0c,
+	0xe4, 0xff, 0xc4, 0x61, 0x29, 0xa2, 0x51, 0xb7, 0xb0, 0xda, 0xce, 0x33, 0x1c, 0x15, 0xc3, 0x3d,
+	0x2c, 0x7c, 0x0f, 0x66, 0x43, 0xad, 0x5b, 0x18, 0x37, 0xf1, 0x55, 0x19, 0x37, 0xd5, 0xe3, 0x25,
+	0x82, 0xe4, 0x89, 0xbc, 0x32, 0x6c, 0x41, 0xca, 0xc4, 0xae, 0xa7, 0xfa, 0x2d, 0x1d, 0x7b, 0x64,
+	0xf8, 0xd7, 0x86, 0x24, 0xc5, 0xde, 0x61, 0xc8, 0x74, 0x5b, 0xbe, 0x19, 0x83, 0x33, 0xbc, 0x01,
+	0xd5, 0x42, 0x3c, 0x9e, 0x55, 0x87, 0xf7, 0x86, 0x8f, 0x78, 0x78, 0xf8, 0x88, 0x98, 0x36, 0xf1,
+	0x58, 0xa6, 0x95, 0xef, 0xc4, 0xe1, 0x6c, 0x37, 0x0d, 0xca, 0x2d, 0x5b, 0x6b, 0x14, 0x4d, 0x6c,
+	0x34, 0x9f, 0x61, 0x73, 0x94, 0x61, 0x56, 0x0f, 0x04, 0x56, 0x31, 0x7f, 0x1c, 0x62, 0x96, 0x39,
+	0xed, 0xd9, 0x28, 0xd5, 0x45, 0x11, 0xeb, 0xd1, 0xc4, 0xe0, 0x43, 0xc8, 0x53, 0x49, 0x0c, 0x3e,
+	0x7d, 0x54, 0x7b, 0x43, 0xe2, 0xe8, 0x80, 0x63, 0xeb, 0x9c, 0xe0, 0xdc, 0x7f, 0x48, 0x95, 0xff,
+	0x25, 0xc1, 0xd9, 0xbc, 0xef, 0xd9, 0x45, 0xbb, 0xd9, 0xb2, 0x7d, 0x4b, 0xaf, 0x11, 0x8f, 0x5d,
+	0x89, 0xf6, 0x35, 0x98, 0x34, 0xb4, 0xc1, 0xfa, 0x44, 0x44, 0xec, 0xab, 0x88, 0x88, 0xf8, 0xb0,
+	0x11, 0x21, 0xff, 0x4e, 0x82, 0x71, 0x76, 0x0d, 0x5c, 0x55, 0x06, 0xbb, 0xff, 0x1d, 0xae, 0x29,
+	0x5d, 0x83, 0x38, 0x6e, 0x39, 0x22, 0xc4, 0xde, 0x1c, 0xe0, 0x78, 0x77, 0x78, 0x90, 0x89, 0xe7,
+	0xab, 0x4a, 0xbf, 0x53, 0x1e, 0xa5, 0x24, 0xff, 0x4a, 0x82, 0x04, 0x7b, 0xbf, 0x7c, 0x19, 0x46,
+	0xf9, 0x03, 0x10, 0xf7, 0x46, 0xea, 0xe1, 0x41, 0x66, 0x9a, 0x4f, 0x9b, 0x6c, 0x59, 0x56, 0xf8,
+	0x36, 0xfa, 0x10, 0x46, 0x59, 0x8f, 0x16, 0x43, 0xf2, 0x29, 0x73, 0xc0, 0xb7, 0xc4, 0x84, 0x2c,
+	0xc8, 0x30, 0x2c, 0x79, 0xa8, 0x47, 0x62, 0xce, 0x49, 0xfe, 0x83, 0x04, 0xe3, 0x62, 0xd4, 0x1b,
+	0x58, 0xcc, 0x8f, 0x24, 0x98, 0xd4, 0x89, 0xa6, 0x86, 0x65, 0x3d, 0x3d, 0x97, 0x2e, 0x0b, 0x71,
+	0x53, 0x01, 0x39, 0x81, 0x3c, 0xf4, 0x4c, 0x3f, 0xa1, 0x8b, 0x5f, 0xf2, 0x4d, 0x09, 0xa6, 0x42,
+	0xf9, 0x11, 0xf1, 0xb5, 0xf4, 0x08, 0x5f, 0x5f, 0xe5, 0x1a, 0xb0, 0x27, 0xe1, 0xae, 0x06, 0xa7,
+	0xcd, 0xc5, 0xa9, 0xe8, 0x5c, 0xcc, 0x44, 0x61, 0xbf, 0x2e, 0xfc, 0x46, 0x82, 0xc5, 0x13, 0x5f,
+	0x39, 0xd1, 0xab, 0x70, 0xbe, 0xb2, 0x55, 0xdb, 0xd9, 0xd8, 0xa8, 0x14, 0x2b, 0xe5, 0xad, 0x6d,
+	0x75, 0x63, 0x67, 0xab, 0x54, 0x53, 0x0b, 0xe5, 0x2b, 0xf9, 0x77, 0x2a, 0xd7, 0x14, 0x75, 0x67,
+	0xab, 0x56, 0x2d, 0x17, 0x2b, 0x1b, 0x95, 0x72, 0x29, 0x35, 0x82, 0x56, 0xe1, 0xc5, 0xd3, 0x80,
+	0x6b, 0x57, 0x2b, 0x55, 0x35, 0xbf, 0xb9, 0x99, 0x92, 0xd0, 0x1a, 0xbc, 0x72, 0x1a, 0x64, 0x35,
+	0xff, 0x9e, 0x9a, 0x7f, 0x27, 0x5f, 0xd9, 0xcc, 0x17, 0x36, 0xcb, 0xa9, 0xd8, 0x52, 0xe2, 0xe6,
+	0xaf, 0x97, 0x47, 0x2e, 0xfc, 0x50, 0x02, 0x74, 0xfc, 0xd1, 0x0f, 0xbd, 0x08, 0x2b, 0xb5, 0xed,
+	0xfc, 0x66, 0x59, 0xad, 0x2a, 0x95, 0x62, 0xf9, 0x24, 0xd9, 0x96, 0x61, 0xa9, 0x2f, 0x54, 0x35,
+	0xbf, 0x53, 0x2b, 0xa7, 0x24, 0x74, 0x1e, 0x5e, 0xe8, 0x4f, 0xa5, 0x56, 0x56, 0x37, 0xf3, 0xb5,
+	0x6d, 0xbe, 0x1e, 0xc8, 0x52, 0xa8, 0xdd, 0xf9, 0xdb, 0xf2, 0xc8, 0x9d, 0xc3, 0x65, 0xe9, 0xee,
+	0xe1, 0xb2, 0xf4, 0xd7, 0xc3, 0x65, 0xe9, 0xe3, 0xfb, 0xcb, 0x23, 0x77, 0xef, 0x2f, 0x8f, 0xfc,
+	0xe9, 0xfe, 0xf2, 0xc8, 0xfb, 0xaf, 0x87, 0xa2, 0x22, 0xf0, 0xcb, 0x9a, 0x89, 0x77, 0xdd, 0xee,
+	0x57, 0xae, 0x7d, 0x71, 0x3d, 0x77, 0xa3, 0xfb, 0x5f, 0x5b, 0x58, 0xa0, 0xec, 0x8e, 0xb1, 0x7e,
+	0xf8, 0xda, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xb0, 0xd5, 0xce, 0xfb, 0x22, 0x00, 0x00,
 }
 
 func (this *RewardsPlan) Equal(that interface{}) bool {
@@ -942,9 +1903,14 @@ func (this *RewardsPlan) Equal(that interface{}) bool {
 	if this.ServiceID != that1.ServiceID {
 		return false
 	}
-	if !this.AmountPerDay.Equal(&that1.AmountPerDay) {
+	if len(this.AmountPerDay) != len(that1.AmountPerDay) {
 		return false
 	}
+	for i := range this.AmountPerDay {
+		if !this.AmountPerDay[i].Equal(&that1.AmountPerDay[i]) {
+			return false
+		}
+	}
 	if !this.StartTime.Equal(that1.StartTime) {
 		return false
 	}
@@ -963,6 +1929,51 @@ func (this *RewardsPlan) Equal(that interface{}) bool {
 	if !this.UsersDistribution.Equal(&that1.UsersDistribution) {
 		return false
 	}
+	if !this.EmissionSchedule.Equal(that1.EmissionSchedule) {
+		return false
+	}
+	if this.InsufficientFundsBehavior != that1.InsufficientFundsBehavior {
+		return false
+	}
+	if !this.USDBudget.Equal(that1.USDBudget) {
+		return false
+	}
+	return true
+}
+func (this *USDBudget) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*USDBudget)
+	if !ok {
+		that2, ok := that.(USDBudget)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AmountPerDay.Equal(that1.AmountPerDay) {
+		return false
+	}
+	if this.PayoutDenom != that1.PayoutDenom {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if this.StalePriceBehavior != that1.StalePriceBehavior {
+		return false
+	}
+	if this.StalePriceGracePeriod != that1.StalePriceGracePeriod {
+		return false
+	}
 	return true
 }
 func (this *Distribution) Equal(that interface{}) bool {
@@ -1042,16 +2053,49 @@ func (m *RewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AmountPerDay.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.USDBudget != nil {
+		{
+			size, err := m.USDBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.InsufficientFundsBehavior))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AmountPerDay) > 0 {
+		for iNdEx := len(m.AmountPerDay) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
-		i -= size
-		i = encodeVarintModels(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x5a
 	{
 		size, err := m.UsersDistribution.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1089,21 +2133,21 @@ func (m *RewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintModels(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x32
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintModels(dAtA, i, uint64(n6))
 	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintModels(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0x2a
 	if m.ServiceID != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
@@ -1125,7 +2169,7 @@ func (m *RewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Distribution) Marshal() (dAtA []byte, err error) {
+func (m *USDBudget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1135,42 +2179,58 @@ func (m *Distribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *USDBudget) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *USDBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != nil {
-		{
-			size, err := m.Type.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModels(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StalePriceGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StalePriceGracePeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if m.Weight != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Weight))
+	i -= n8
+	i = encodeVarintModels(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.StalePriceBehavior != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.StalePriceBehavior))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.DelegationType != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintModels(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PayoutDenom) > 0 {
+		i -= len(m.PayoutDenom)
+		copy(dAtA[i:], m.PayoutDenom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.PayoutDenom)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.AmountPerDay.Size()
+		i -= size
+		if _, err := m.AmountPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionTypeBasic) Marshal() (dAtA []byte, err error) {
+func (m *DelegationTypeDistributedRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1180,20 +2240,39 @@ func (m *DistributionTypeBasic) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionTypeBasic) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegationTypeDistributedRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionTypeBasic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegationTypeDistributedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionTypeWeighted) Marshal() (dAtA []byte, err error) {
+func (m *DelegationTargetDistributedRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1203,20 +2282,20 @@ func (m *DistributionTypeWeighted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionTypeWeighted) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegationTargetDistributedRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionTypeWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegationTargetDistributedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Weights) > 0 {
-		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1224,13 +2303,23 @@ func (m *DistributionTypeWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionWeight) Marshal() (dAtA []byte, err error) {
+func (m *RewardsPlanAccounting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1240,30 +2329,44 @@ func (m *DistributionWeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionWeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardsPlanAccounting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardsPlanAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Weight != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Weight))
+	if m.SkippedAllocations != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SkippedAllocations))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.DelegationTargetID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+	if len(m.DistributedPerTarget) > 0 {
+		for iNdEx := len(m.DistributedPerTarget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedPerTarget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlanID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PlanID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionTypeEgalitarian) Marshal() (dAtA []byte, err error) {
+func (m *ArchivedRewardsPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1273,20 +2376,71 @@ func (m *DistributionTypeEgalitarian) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionTypeEgalitarian) MarshalTo(dAtA []byte) (int, error) {
+func (m *ArchivedRewardsPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionTypeEgalitarian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ArchivedRewardsPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SkippedAllocations != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SkippedAllocations))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DistributedPerTarget) > 0 {
+		for iNdEx := len(m.DistributedPerTarget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedPerTarget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DistributedPerDelegationType) > 0 {
+		for iNdEx := len(m.DistributedPerDelegationType) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedPerDelegationType[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TerminationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintModels(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UsersDistribution) Marshal() (dAtA []byte, err error) {
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1296,12 +2450,12 @@ func (m *UsersDistribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UsersDistribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UsersDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1316,17 +2470,22 @@ func (m *UsersDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.Weight != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.Weight))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UsersDistributionTypeBasic) Marshal() (dAtA []byte, err error) {
+func (m *DistributionTypeBasic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1336,12 +2495,12 @@ func (m *UsersDistributionTypeBasic) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UsersDistributionTypeBasic) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionTypeBasic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UsersDistributionTypeBasic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionTypeBasic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1349,7 +2508,7 @@ func (m *UsersDistributionTypeBasic) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+func (m *DistributionTypeWeighted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1359,25 +2518,20 @@ func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionTypeWeighted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionTypeWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReferenceCount != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ReferenceCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CumulativeRewardRatios) > 0 {
-		for iNdEx := len(m.CumulativeRewardRatios) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CumulativeRewardRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1391,7 +2545,7 @@ func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrentRewards) Marshal() (dAtA []byte, err error) {
+func (m *DistributionWeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1401,39 +2555,30 @@ func (m *CurrentRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionWeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Period))
+	if m.Weight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Weight))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+func (m *DistributionTypeEgalitarian) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1443,34 +2588,20 @@ func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionTypeEgalitarian) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionTypeEgalitarian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccumulatedCommission) Marshal() (dAtA []byte, err error) {
+func (m *UsersDistribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1480,34 +2611,37 @@ func (m *AccumulatedCommission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+func (m *UsersDistribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UsersDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commissions) > 0 {
-		for iNdEx := len(m.Commissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
+	if m.Type != nil {
+		{
+			size, err := m.Type.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Weight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *UsersDistributionTypeBasic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1517,44 +2651,20 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *UsersDistributionTypeBasic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UsersDistributionTypeBasic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Stakes) > 0 {
-		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.PreviousPeriod))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+func (m *EmissionScheduleLinearDecay) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1564,44 +2674,58 @@ func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *EmissionScheduleLinearDecay) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EmissionScheduleLinearDecay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.FinalRate.Size()
+		i -= size
+		if _, err := m.FinalRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
 	}
-	if m.DelegationTargetID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionScheduleHalving) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DelegationType != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+	return dAtA[:n], nil
+}
+
+func (m *EmissionScheduleHalving) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionScheduleHalving) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HalvingPeriod != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.HalvingPeriod))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolServiceTotalDelegatorShares) Marshal() (dAtA []byte, err error) {
+func (m *EmissionScheduleStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1611,20 +2735,20 @@ func (m *PoolServiceTotalDelegatorShares) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolServiceTotalDelegatorShares) MarshalTo(dAtA []byte) (int, error) {
+func (m *EmissionScheduleStep) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolServiceTotalDelegatorShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EmissionScheduleStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1632,23 +2756,92 @@ func (m *PoolServiceTotalDelegatorShares) MarshalToSizedBuffer(dAtA []byte) (int
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ServiceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.PoolID))
+	return dAtA[:n], nil
+}
+
+func (m *EmissionStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintModels(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionScheduleCliff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionScheduleCliff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionScheduleCliff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CliffRate.Size()
+		i -= size
+		if _, err := m.CliffRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CliffDuration != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CliffDuration))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1658,20 +2851,25 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ReferenceCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardRatios) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatios) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CumulativeRewardRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1679,20 +2877,13 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DecPool) Marshal() (dAtA []byte, err error) {
+func (m *CurrentRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1702,20 +2893,25 @@ func (m *DecPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *CurrentRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DecCoins) > 0 {
-		for iNdEx := len(m.DecCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Period != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DecCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1723,20 +2919,13 @@ func (m *DecPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ServicePool) Marshal() (dAtA []byte, err error) {
+func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1746,20 +2935,20 @@ func (m *ServicePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServicePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ServicePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DecPools) > 0 {
-		for iNdEx := len(m.DecPools) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DecPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1767,347 +2956,3333 @@ func (m *ServicePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintModels(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ServiceID != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccumulatedCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RewardsPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovModels(uint64(m.ID))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovModels(uint64(m.ServiceID))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovModels(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovModels(uint64(l))
-	l = len(m.RewardsPool)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	l = m.PoolsDistribution.Size()
-	n += 1 + l + sovModels(uint64(l))
-	l = m.OperatorsDistribution.Size()
-	n += 1 + l + sovModels(uint64(l))
-	l = m.UsersDistribution.Size()
-	n += 1 + l + sovModels(uint64(l))
-	l = m.AmountPerDay.Size()
-	n += 1 + l + sovModels(uint64(l))
-	return n
+
+func (m *AccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Distribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DelegationType != 0 {
-		n += 1 + sovModels(uint64(m.DelegationType))
-	}
-	if m.Weight != 0 {
-		n += 1 + sovModels(uint64(m.Weight))
+	if len(m.Commissions) > 0 {
+		for iNdEx := len(m.Commissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Type != nil {
-		l = m.Type.Size()
-		n += 1 + l + sovModels(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DistributionTypeBasic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionTypeWeighted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Weights) > 0 {
-		for _, e := range m.Weights {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DistributionWeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DelegationTargetID != 0 {
-		n += 1 + sovModels(uint64(m.DelegationTargetID))
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Weight != 0 {
-		n += 1 + sovModels(uint64(m.Weight))
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DistributionTypeEgalitarian) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IBCWithdrawDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UsersDistribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IBCWithdrawDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCWithdrawDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Weight != 0 {
-		n += 1 + sovModels(uint64(m.Weight))
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err15 != nil {
+		return 0, err15
 	}
-	if m.Type != nil {
-		l = m.Type.Size()
-		n += 1 + l + sovModels(uint64(l))
+	i -= n15
+	i = encodeVarintModels(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UsersDistributionTypeBasic) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawnDelegationReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WithdrawnDelegationReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawnDelegationReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatios) > 0 {
-		for _, e := range m.CumulativeRewardRatios {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovModels(uint64(m.ReferenceCount))
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolServiceTotalDelegatorShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolServiceTotalDelegatorShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolServiceTotalDelegatorShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Period != 0 {
-		n += 1 + sovModels(uint64(m.Period))
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+func (m *DelegatorSharesAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AccumulatedCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegatorSharesAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorSharesAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commissions) > 0 {
-		for _, e := range m.Commissions {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	return n
+	i -= n16
+	i = encodeVarintModels(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintModels(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CumulativeShares) > 0 {
+		for iNdEx := len(m.CumulativeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeriodStartTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodStartTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodStartTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovModels(uint64(m.PreviousPeriod))
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	if len(m.Stakes) > 0 {
-		for _, e := range m.Stakes {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	i -= n18
+	i = encodeVarintModels(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Height != 0 {
-		n += 1 + sovModels(uint64(m.Height))
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatorEpochClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorEpochClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorEpochClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CumulativeStakes) > 0 {
+		for iNdEx := len(m.CumulativeStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DelegationTargetID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.DelegationType != 0 {
-		n += 1 + sovModels(uint64(m.DelegationType))
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.DelegationTargetID != 0 {
-		n += 1 + sovModels(uint64(m.DelegationTargetID))
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationTargetID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	if m.DelegationType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DelegationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanAPR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanAPR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanAPR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.APR.Size()
+		i -= size
+		if _, err := m.APR.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PlanID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PlanID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecCoins) > 0 {
+		for iNdEx := len(m.DecCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServicePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServicePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecPools) > 0 {
+		for iNdEx := len(m.DecPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardsPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovModels(uint64(m.ID))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = len(m.RewardsPool)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.PoolsDistribution.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.OperatorsDistribution.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.UsersDistribution.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.AmountPerDay) > 0 {
+		for _, e := range m.AmountPerDay {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.InsufficientFundsBehavior != 0 {
+		n += 1 + sovModels(uint64(m.InsufficientFundsBehavior))
+	}
+	if m.USDBudget != nil {
+		l = m.USDBudget.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *USDBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountPerDay.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = len(m.PayoutDenom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovModels(uint64(l))
+	if m.StalePriceBehavior != 0 {
+		n += 1 + sovModels(uint64(m.StalePriceBehavior))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StalePriceGracePeriod)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *DelegationTypeDistributedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationTargetDistributedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardsPlanAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanID != 0 {
+		n += 1 + sovModels(uint64(m.PlanID))
+	}
+	if len(m.DistributedPerTarget) > 0 {
+		for _, e := range m.DistributedPerTarget {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.SkippedAllocations != 0 {
+		n += 1 + sovModels(uint64(m.SkippedAllocations))
+	}
+	return n
+}
+
+func (m *ArchivedRewardsPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.DistributedPerDelegationType) > 0 {
+		for _, e := range m.DistributedPerDelegationType {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.DistributedPerTarget) > 0 {
+		for _, e := range m.DistributedPerTarget {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.SkippedAllocations != 0 {
+		n += 1 + sovModels(uint64(m.SkippedAllocations))
+	}
+	return n
+}
+
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovModels(uint64(m.Weight))
+	}
+	if m.Type != nil {
+		l = m.Type.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionTypeBasic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DistributionTypeWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovModels(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *DistributionTypeEgalitarian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UsersDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		n += 1 + sovModels(uint64(m.Weight))
+	}
+	if m.Type != nil {
+		l = m.Type.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *UsersDistributionTypeBasic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EmissionScheduleLinearDecay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalRate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *EmissionScheduleHalving) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HalvingPeriod != 0 {
+		n += 1 + sovModels(uint64(m.HalvingPeriod))
+	}
+	return n
+}
+
+func (m *EmissionScheduleStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *EmissionScheduleCliff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CliffDuration != 0 {
+		n += 1 + sovModels(uint64(m.CliffDuration))
+	}
+	l = m.CliffRate.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *HistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatios) > 0 {
+		for _, e := range m.CumulativeRewardRatios {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovModels(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *CurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovModels(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *OutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccumulatedCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commissions) > 0 {
+		for _, e := range m.Commissions {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovModels(uint64(m.PreviousPeriod))
+	}
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovModels(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCWithdrawDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *WithdrawnDelegationReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolServiceTotalDelegatorShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovModels(uint64(m.PoolID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorSharesAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if len(m.CumulativeShares) > 0 {
+		for _, e := range m.CumulativeShares {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *PeriodStartTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	if m.Period != 0 {
+		n += 1 + sovModels(uint64(m.Period))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *DelegatorEpochClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.CumulativeStakes) > 0 {
+		for _, e := range m.CumulativeStakes {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.DelegationType != 0 {
+		n += 1 + sovModels(uint64(m.DelegationType))
+	}
+	if m.DelegationTargetID != 0 {
+		n += 1 + sovModels(uint64(m.DelegationTargetID))
+	}
+	return n
+}
+
+func (m *PlanAPR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanID != 0 {
+		n += 1 + sovModels(uint64(m.PlanID))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	l = m.APR.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.DecCoins) > 0 {
+		for _, e := range m.DecCoins {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServicePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	if len(m.DecPools) > 0 {
+		for _, e := range m.DecPools {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolsDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorsDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorsDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsersDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsersDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerDay = append(m.AmountPerDay, types.Coin{})
+			if err := m.AmountPerDay[len(m.AmountPerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &types1.Any{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientFundsBehavior", wireType)
+			}
+			m.InsufficientFundsBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsufficientFundsBehavior |= InsufficientFundsBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.USDBudget == nil {
+				m.USDBudget = &USDBudget{}
+			}
+			if err := m.USDBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *USDBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: USDBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: USDBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalePriceBehavior", wireType)
+			}
+			m.StalePriceBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalePriceBehavior |= StalePriceBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalePriceGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StalePriceGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationTypeDistributedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationTypeDistributedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationTypeDistributedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			}
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationTargetDistributedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationTargetDistributedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationTargetDistributedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			}
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsPlanAccounting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsPlanAccounting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsPlanAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanID", wireType)
+			}
+			m.PlanID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedPerTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedPerTarget = append(m.DistributedPerTarget, DelegationTargetDistributedRewards{})
+			if err := m.DistributedPerTarget[len(m.DistributedPerTarget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedAllocations", wireType)
+			}
+			m.SkippedAllocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedAllocations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedRewardsPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedRewardsPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedRewardsPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TerminationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedPerDelegationType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedPerDelegationType = append(m.DistributedPerDelegationType, DelegationTypeDistributedRewards{})
+			if err := m.DistributedPerDelegationType[len(m.DistributedPerDelegationType)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedPerTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedPerTarget = append(m.DistributedPerTarget, DelegationTargetDistributedRewards{})
+			if err := m.DistributedPerTarget[len(m.DistributedPerTarget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedAllocations", wireType)
+			}
+			m.SkippedAllocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedAllocations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			}
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Type == nil {
+				m.Type = &types1.Any{}
+			}
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTypeBasic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTypeBasic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTypeBasic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTypeWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTypeWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTypeWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, DistributionWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTypeEgalitarian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTypeEgalitarian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTypeEgalitarian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsersDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsersDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Type == nil {
+				m.Type = &types1.Any{}
+			}
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsersDistributionTypeBasic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsersDistributionTypeBasic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsersDistributionTypeBasic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionScheduleLinearDecay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionScheduleLinearDecay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionScheduleLinearDecay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionScheduleHalving) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionScheduleHalving: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionScheduleHalving: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingPeriod", wireType)
+			}
+			m.HalvingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingPeriod |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *PoolServiceTotalDelegatorShares) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovModels(uint64(m.PoolID))
-	}
-	if m.ServiceID != 0 {
-		n += 1 + sovModels(uint64(m.ServiceID))
-	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+func (m *EmissionScheduleStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *DecPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if len(m.DecCoins) > 0 {
-		for _, e := range m.DecCoins {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionScheduleStep: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *ServicePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ServiceID != 0 {
-		n += 1 + sovModels(uint64(m.ServiceID))
-	}
-	if len(m.DecPools) > 0 {
-		for _, e := range m.DecPools {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionScheduleStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, EmissionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
+func (m *EmissionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,17 +6305,17 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2150,14 +6325,28 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2185,13 +6374,65 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionScheduleCliff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionScheduleCliff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionScheduleCliff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDuration", wireType)
 			}
-			m.ServiceID = 0
+			m.CliffDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2201,16 +6442,16 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceID |= uint32(b&0x7F) << shift
+				m.CliffDuration |= time.Duration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2220,28 +6461,79 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CliffRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,15 +6560,16 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatios = append(m.CumulativeRewardRatios, ServicePool{})
+			if err := m.CumulativeRewardRatios[len(m.CumulativeRewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPool", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
 			}
-			var stringLen uint64
+			m.ReferenceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2286,27 +6579,64 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReferenceCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsPool = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolsDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2333,15 +6663,16 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolsDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, ServicePool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorsDistribution", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var msglen int
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2351,28 +6682,64 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperatorsDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsersDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2399,13 +6766,64 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UsersDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DecPool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2432,7 +6850,8 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commissions = append(m.Commissions, DecPool{})
+			if err := m.Commissions[len(m.Commissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2457,7 +6876,7 @@ func (m *RewardsPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Distribution) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2480,17 +6899,17 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
 			}
-			m.DelegationType = 0
+			m.PreviousPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2500,33 +6919,14 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationType |= types1.DelegationType(b&0x7F) << shift
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			m.Weight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Weight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2553,13 +6953,30 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Type == nil {
-				m.Type = &types2.Any{}
-			}
-			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stakes = append(m.Stakes, types.DecCoin{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2581,7 +6998,7 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionTypeBasic) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2604,65 +7021,53 @@ func (m *DistributionTypeBasic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionTypeBasic: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionTypeBasic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DistributionTypeWeighted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionTypeWeighted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionTypeWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2689,8 +7094,8 @@ func (m *DistributionTypeWeighted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weights = append(m.Weights, DistributionWeight{})
-			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, DecPool{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2715,7 +7120,7 @@ func (m *DistributionTypeWeighted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionWeight) Unmarshal(dAtA []byte) error {
+func (m *IBCWithdrawDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2738,17 +7143,17 @@ func (m *DistributionWeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionWeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCWithdrawDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCWithdrawDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
-			m.DelegationTargetID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2758,16 +7163,29 @@ func (m *DistributionWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.Weight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2777,61 +7195,57 @@ func (m *DistributionWeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DistributionTypeEgalitarian) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionTypeEgalitarian: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionTypeEgalitarian: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2853,7 +7267,7 @@ func (m *DistributionTypeEgalitarian) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
+func (m *WithdrawnDelegationReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2876,17 +7290,17 @@ func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsersDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawnDelegationReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsersDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawnDelegationReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			m.Weight = 0
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2896,14 +7310,33 @@ func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint32(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2930,10 +7363,8 @@ func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Type == nil {
-				m.Type = &types2.Any{}
-			}
-			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2958,7 +7389,7 @@ func (m *UsersDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsersDistributionTypeBasic) Unmarshal(dAtA []byte) error {
+func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,65 +7412,53 @@ func (m *UsersDistributionTypeBasic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsersDistributionTypeBasic: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolServiceTotalDelegatorShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsersDistributionTypeBasic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolServiceTotalDelegatorShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatios", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3066,30 +7485,11 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatios = append(m.CumulativeRewardRatios, ServicePool{})
-			if err := m.CumulativeRewardRatios[len(m.CumulativeRewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, types.DecCoin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
-			}
-			m.ReferenceCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -3111,7 +7511,7 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegatorSharesAccumulator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3134,17 +7534,17 @@ func (m *CurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorSharesAccumulator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorSharesAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			var msglen int
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3154,31 +7554,54 @@ func (m *CurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
-			m.Rewards = append(m.Rewards, ServicePool{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeShares", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3188,64 +7611,62 @@ func (m *CurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.CumulativeShares = append(m.CumulativeShares, types.DecCoin{})
+			if err := m.CumulativeShares[len(m.CumulativeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3272,8 +7693,7 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DecPool{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3298,7 +7718,7 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *PeriodStartTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,15 +7741,72 @@ func (m *AccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodStartTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodStartTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			}
+			m.DelegationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,8 +7833,7 @@ func (m *AccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commissions = append(m.Commissions, DecPool{})
-			if err := m.Commissions[len(m.Commissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3382,7 +7858,7 @@ func (m *AccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegatorEpochClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3405,17 +7881,17 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorEpochClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorEpochClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			m.PreviousPeriod = 0
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3425,14 +7901,65 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+			}
+			m.DelegationTargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeStakes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3459,16 +7986,16 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakes = append(m.Stakes, types.DecCoin{})
-			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeStakes = append(m.CumulativeStakes, types.DecCoin{})
+			if err := m.CumulativeStakes[len(m.CumulativeStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3478,11 +8005,26 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, ServicePool{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -3504,7 +8046,7 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3527,17 +8069,17 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.DelegationType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3547,16 +8089,29 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationType |= types1.DelegationType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationType", wireType)
 			}
-			m.DelegationTargetID = 0
+			m.DelegationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3566,16 +8121,16 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationTargetID |= uint32(b&0x7F) << shift
+				m.DelegationType |= types2.DelegationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTargetID", wireType)
 			}
-			var msglen int
+			m.DelegationTargetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3585,26 +8140,11 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DelegationTargetID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reward = append(m.Reward, DecPool{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -3626,7 +8166,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
+func (m *PlanAPR) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3649,17 +8189,17 @@ func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolServiceTotalDelegatorShares: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanAPR: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolServiceTotalDelegatorShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanAPR: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanID", wireType)
 			}
-			m.PoolID = 0
+			m.PlanID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3669,7 +8209,7 @@ func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint32(b&0x7F) << shift
+				m.PlanID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3695,9 +8235,9 @@ func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APR", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3707,23 +8247,23 @@ func (m *PoolServiceTotalDelegatorShares) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, types.DecCoin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/milkyway-labs/milkyway/v12/utils"
+	restakingtypes "github.com/milkyway-labs/milkyway/v12/x/restaking/types"
 	"github.com/milkyway-labs/milkyway/v12/x/rewards/types"
 )
 
@@ -28,12 +29,15 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				utils.MustParseCoin("100_000000umilk"),
+				utils.MustParseCoins("100_000000umilk"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: false,
 		},
@@ -43,12 +47,15 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				0,
 				"Plan",
 				1,
-				utils.MustParseCoin("100_000000umilk"),
+				utils.MustParseCoins("100_000000umilk"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -58,12 +65,15 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				1,
 				strings.Repeat("A", types.MaxRewardsPlanDescriptionLength+1),
 				1,
-				utils.MustParseCoin("100_000000umilk"),
+				utils.MustParseCoins("100_000000umilk"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -73,12 +83,15 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				1,
 				"Plan",
 				0,
-				utils.MustParseCoin("100_000000umilk"),
+				utils.MustParseCoins("100_000000umilk"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -88,12 +101,15 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.Coin{Denom: "umilk", Amount: math.ZeroInt()},
+				sdk.NewCoins(sdk.Coin{Denom: "umilk", Amount: math.ZeroInt()}),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: true,
 		},
@@ -103,22 +119,133 @@ func TestRewardsPlan_Validate(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				utils.MustParseCoin("100_000000umilk"),
+				utils.MustParseCoins("100_000000umilk"),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(0),
 				types.NewBasicOperatorsDistribution(0),
 				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			shouldErr: true,
 		},
+		{
+			name: "invalid emission schedule returns error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				utils.MustParseCoins("100_000000umilk"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				types.NewEmissionScheduleHalving(0),
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid plan with emission schedule returns no error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				utils.MustParseCoins("100_000000umilk"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				types.NewEmissionScheduleHalving(30*24*time.Hour),
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+			),
+			shouldErr: false,
+		},
+		{
+			name: "valid plan with multiple denoms returns no error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				utils.MustParseCoins("100_000000umilk,50_000000uinit"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_PAY_AVAILABLE,
+				nil,
+			),
+			shouldErr: false,
+		},
+		{
+			name: "invalid insufficient funds behavior returns error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				utils.MustParseCoins("100_000000umilk"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				types.InsufficientFundsBehavior(10),
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "plan with both amount per day and USD budget returns error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				utils.MustParseCoins("100_000000umilk"),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				types.NewUSDBudget(math.LegacyNewDec(100), "umilk", time.Minute, types.STALE_PRICE_BEHAVIOR_PAUSE, 0),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid plan with USD budget returns no error",
+			plan: types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				nil,
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				types.NewBasicPoolsDistribution(0),
+				types.NewBasicOperatorsDistribution(0),
+				types.NewBasicUsersDistribution(0),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				types.NewUSDBudget(math.LegacyNewDec(100), "umilk", time.Minute, types.STALE_PRICE_BEHAVIOR_PAUSE, 0),
+			),
+			shouldErr: false,
+		},
 		{
 			name: "invalid rewards pool returns error",
 			plan: types.RewardsPlan{
 				ID:                    1,
 				Description:           "Plan",
 				ServiceID:             1,
-				AmountPerDay:          utils.MustParseCoin("100_000000umilk"),
+				AmountPerDay:          utils.MustParseCoins("100_000000umilk"),
 				StartTime:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				EndTime:               time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				RewardsPool:           "invalid",
@@ -158,12 +285,15 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.NewInt64Coin("umilk", 100_000000),
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			date:      time.Date(2023, 12, 31, 23, 59, 59, 999, time.UTC),
 			expActive: false,
@@ -174,12 +304,15 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.NewInt64Coin("umilk", 100_000000),
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			date:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 			expActive: true,
@@ -190,12 +323,15 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.NewInt64Coin("umilk", 100_000000),
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			date:      time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
 			expActive: true,
@@ -206,12 +342,15 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.NewInt64Coin("umilk", 100_000000),
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			date:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 			expActive: false,
@@ -222,12 +361,15 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 				1,
 				"Plan",
 				1,
-				sdk.NewInt64Coin("umilk", 100_000000),
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
 				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 				types.NewBasicPoolsDistribution(1),
 				types.NewBasicOperatorsDistribution(1),
 				types.NewBasicUsersDistribution(1),
+				nil,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
 			),
 			date:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
 			expActive: false,
@@ -242,6 +384,68 @@ func TestRewardsPlan_IsActiveAt(t *testing.T) {
 	}
 }
 
+func TestRewardsPlan_GetActiveDurationWithin(t *testing.T) {
+	plan := types.NewRewardsPlan(
+		1,
+		"Plan",
+		1,
+		sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		types.NewBasicPoolsDistribution(1),
+		types.NewBasicOperatorsDistribution(1),
+		types.NewBasicUsersDistribution(1),
+		nil,
+		types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+		nil,
+	)
+
+	testCases := []struct {
+		name        string
+		from        time.Time
+		to          time.Time
+		expDuration time.Duration
+	}{
+		{
+			name:        "range before start time returns zero",
+			from:        time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expDuration: 0,
+		},
+		{
+			name:        "range across start time returns the active part",
+			from:        time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+			expDuration: 6 * time.Hour,
+		},
+		{
+			name:        "range between start time and end time returns the whole range",
+			from:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			expDuration: 24 * time.Hour,
+		},
+		{
+			name:        "range across end time returns the active part",
+			from:        time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC),
+			to:          time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			expDuration: 12 * time.Hour,
+		},
+		{
+			name:        "range after end time returns zero",
+			from:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:          time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			expDuration: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			duration := plan.GetActiveDurationWithin(tc.from, tc.to)
+			require.Equal(t, tc.expDuration, duration)
+		})
+	}
+}
+
 func TestDistribution_Validate(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -324,3 +528,546 @@ func TestUsersDistribution_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestUSDBudget_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		usdBudget *types.USDBudget
+		shouldErr bool
+	}{
+		{
+			name: "zero amount per day returns error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyZeroDec(),
+				"umilk",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_PAUSE,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid payout denom returns error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyNewDec(100),
+				"",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_PAUSE,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero max price age returns error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyNewDec(100),
+				"umilk",
+				0,
+				types.STALE_PRICE_BEHAVIOR_PAUSE,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid stale price behavior returns error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyNewDec(100),
+				"umilk",
+				time.Minute,
+				types.StalePriceBehavior(10),
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "negative stale price grace period returns error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyNewDec(100),
+				"umilk",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_USE_LAST_PRICE,
+				-time.Minute,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid USD budget returns no error",
+			usdBudget: types.NewUSDBudget(
+				math.LegacyNewDec(100),
+				"umilk",
+				time.Minute,
+				types.STALE_PRICE_BEHAVIOR_USE_LAST_PRICE,
+				time.Hour,
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usdBudget.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionSchedule_Validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		emissionSchedule types.EmissionSchedule
+		shouldErr        bool
+	}{
+		{
+			name:             "linear decay with negative final rate returns error",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDec(-1)),
+			shouldErr:        true,
+		},
+		{
+			name:             "linear decay with final rate greater than 1 returns error",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDec(2)),
+			shouldErr:        true,
+		},
+		{
+			name:             "valid linear decay returns no error",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(1, 1)),
+			shouldErr:        false,
+		},
+		{
+			name:             "halving with zero halving period returns error",
+			emissionSchedule: types.NewEmissionScheduleHalving(0),
+			shouldErr:        true,
+		},
+		{
+			name:             "valid halving returns no error",
+			emissionSchedule: types.NewEmissionScheduleHalving(24 * time.Hour),
+			shouldErr:        false,
+		},
+		{
+			name:             "step with no steps returns error",
+			emissionSchedule: types.NewEmissionScheduleStep(nil),
+			shouldErr:        true,
+		},
+		{
+			name: "step with negative rate returns error",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), math.LegacyNewDec(-1)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "step with unsorted steps returns error",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewEmissionStep(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(2, 1)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "valid step returns no error",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewEmissionStep(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(2, 1)),
+			}),
+			shouldErr: false,
+		},
+		{
+			name:             "cliff with zero duration returns error",
+			emissionSchedule: types.NewEmissionScheduleCliff(0, math.LegacyNewDec(2)),
+			shouldErr:        true,
+		},
+		{
+			name:             "cliff with negative rate returns error",
+			emissionSchedule: types.NewEmissionScheduleCliff(24*time.Hour, math.LegacyNewDec(-2)),
+			shouldErr:        true,
+		},
+		{
+			name:             "valid cliff returns no error",
+			emissionSchedule: types.NewEmissionScheduleCliff(24*time.Hour, math.LegacyNewDec(2)),
+			shouldErr:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.emissionSchedule.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionSchedule_RateAt(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		emissionSchedule types.EmissionSchedule
+		time             time.Time
+		expRate          math.LegacyDec
+	}{
+		{
+			name:             "linear decay returns 1 at start time",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(2, 1)),
+			time:             startTime,
+			expRate:          math.LegacyOneDec(),
+		},
+		{
+			name:             "linear decay returns the interpolated rate",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(2, 1)),
+			time:             time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			expRate:          math.LegacyNewDecWithPrec(6, 1),
+		},
+		{
+			name:             "linear decay returns the final rate at end time",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(2, 1)),
+			time:             endTime,
+			expRate:          math.LegacyNewDecWithPrec(2, 1),
+		},
+		{
+			name:             "halving returns 1 before the first halving",
+			emissionSchedule: types.NewEmissionScheduleHalving(2 * 24 * time.Hour),
+			time:             time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			expRate:          math.LegacyOneDec(),
+		},
+		{
+			name:             "halving returns the halved rate",
+			emissionSchedule: types.NewEmissionScheduleHalving(2 * 24 * time.Hour),
+			time:             time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			expRate:          math.LegacyNewDecWithPrec(25, 2),
+		},
+		{
+			name:             "halving returns zero after too many halvings",
+			emissionSchedule: types.NewEmissionScheduleHalving(time.Second),
+			time:             endTime,
+			expRate:          math.LegacyZeroDec(),
+		},
+		{
+			name: "step returns 1 before the first step",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewEmissionStep(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(2, 1)),
+			}),
+			time:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			expRate: math.LegacyOneDec(),
+		},
+		{
+			name: "step returns the rate of the latest started step",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewEmissionStep(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(2, 1)),
+			}),
+			time:    time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			expRate: math.LegacyNewDecWithPrec(2, 1),
+		},
+		{
+			name:             "cliff returns the cliff rate during the cliff",
+			emissionSchedule: types.NewEmissionScheduleCliff(2*24*time.Hour, math.LegacyNewDec(3)),
+			time:             time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			expRate:          math.LegacyNewDec(3),
+		},
+		{
+			name:             "cliff returns 1 after the cliff",
+			emissionSchedule: types.NewEmissionScheduleCliff(2*24*time.Hour, math.LegacyNewDec(3)),
+			time:             time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			expRate:          math.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rate := tc.emissionSchedule.RateAt(startTime, endTime, tc.time)
+			require.Truef(t, tc.expRate.Equal(rate), "expected %s, got %s", tc.expRate, rate)
+		})
+	}
+}
+
+func TestRewardsPlan_GetEmissionWithin(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)
+	day := (24 * time.Hour).Milliseconds()
+
+	testCases := []struct {
+		name             string
+		emissionSchedule types.EmissionSchedule
+		from             time.Time
+		to               time.Time
+		expEmission      math.LegacyDec
+	}{
+		{
+			name:             "no emission schedule returns the active duration",
+			emissionSchedule: nil,
+			from:             time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			to:               time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			expEmission:      math.LegacyNewDec(day),
+		},
+		{
+			name:             "range outside the plan returns zero",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(2, 1)),
+			from:             time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+			to:               time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+			expEmission:      math.LegacyZeroDec(),
+		},
+		{
+			name:             "linear decay returns the average rate over the range",
+			emissionSchedule: types.NewEmissionScheduleLinearDecay(math.LegacyNewDecWithPrec(2, 1)),
+			from:             time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			to:               time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+			expEmission:      math.LegacyNewDecWithPrec(6, 1).MulInt64(10 * day),
+		},
+		{
+			name:             "halving splits the range at each halving",
+			emissionSchedule: types.NewEmissionScheduleHalving(2 * 24 * time.Hour),
+			from:             time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			to:               time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			// 1 day * 1 + 2 days * 0.5 + 1 day * 0.25
+			expEmission: math.LegacyNewDecWithPrec(225, 2).MulInt64(day),
+		},
+		{
+			name: "step splits the range at each step",
+			emissionSchedule: types.NewEmissionScheduleStep([]types.EmissionStep{
+				types.NewEmissionStep(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewEmissionStep(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), math.LegacyNewDecWithPrec(2, 1)),
+			}),
+			from: time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC),
+			// 0.5 days * 1 + 3 days * 0.5 + 0.5 days * 0.2
+			expEmission: math.LegacyNewDecWithPrec(21, 1).MulInt64(day),
+		},
+		{
+			name:             "cliff splits the range at the end of the cliff",
+			emissionSchedule: types.NewEmissionScheduleCliff(2*24*time.Hour, math.LegacyNewDec(3)),
+			from:             time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			to:               time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+			// 1 day * 3 + 1 day * 1
+			expEmission: math.LegacyNewDec(4 * day),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interfaceRegistry := codectestutil.CodecOptions{AccAddressPrefix: "cosmo", ValAddressPrefix: "cosmovaloper"}.NewInterfaceRegistry()
+			types.RegisterInterfaces(interfaceRegistry)
+			cdc := codec.NewProtoCodec(interfaceRegistry)
+
+			plan := types.NewRewardsPlan(
+				1,
+				"Plan",
+				1,
+				sdk.NewCoins(sdk.NewInt64Coin("umilk", 100_000000)),
+				startTime,
+				endTime,
+				types.NewBasicPoolsDistribution(1),
+				types.NewBasicOperatorsDistribution(1),
+				types.NewBasicUsersDistribution(1),
+				tc.emissionSchedule,
+				types.INSUFFICIENT_FUNDS_BEHAVIOR_SKIP_ALL,
+				nil,
+			)
+
+			emission, err := plan.GetEmissionWithin(cdc, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Truef(t, tc.expEmission.Equal(emission), "expected %s, got %s", tc.expEmission, emission)
+		})
+	}
+}
+
+func TestDelegatorSharesAccumulator_GetTimeWeightedShares(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		accumulator   func() types.DelegatorSharesAccumulator
+		currentShares sdk.DecCoins
+		time          time.Time
+		expShares     sdk.DecCoins
+	}{
+		{
+			name: "no elapsed time returns the current shares",
+			accumulator: func() types.DelegatorSharesAccumulator {
+				return types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 0, startTime)
+			},
+			currentShares: utils.MustParseDecCoins("100operator/1/umilk"),
+			time:          startTime,
+			expShares:     utils.MustParseDecCoins("100operator/1/umilk"),
+		},
+		{
+			name: "unchanged shares return the current shares",
+			accumulator: func() types.DelegatorSharesAccumulator {
+				return types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 0, startTime)
+			},
+			currentShares: utils.MustParseDecCoins("100operator/1/umilk"),
+			time:          startTime.Add(time.Hour),
+			expShares:     utils.MustParseDecCoins("100operator/1/umilk"),
+		},
+		{
+			name: "shares are weighted by the time they have been delegated",
+			accumulator: func() types.DelegatorSharesAccumulator {
+				accumulator := types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 0, startTime)
+				return accumulator.Accumulate(utils.MustParseDecCoins("100operator/1/umilk"), startTime.Add(15*time.Minute))
+			},
+			currentShares: utils.MustParseDecCoins("200operator/1/umilk,100operator/1/uinit"),
+			time:          startTime.Add(time.Hour),
+			expShares:     utils.MustParseDecCoins("175operator/1/umilk,75operator/1/uinit"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shares := tc.accumulator().GetTimeWeightedShares(tc.currentShares, tc.time)
+			require.Equal(t, tc.expShares, shares)
+		})
+	}
+}
+
+func TestDelegatorSharesAccumulator_Validate(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		accumulator types.DelegatorSharesAccumulator
+		shouldErr   bool
+	}{
+		{
+			name:        "unspecified delegation type returns error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_UNSPECIFIED, 1, 0, startTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "invalid delegation target ID returns error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_OPERATOR, 0, 0, startTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "pool accumulator without service ID returns error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_POOL, 1, 0, startTime),
+			shouldErr:   true,
+		},
+		{
+			name:        "operator accumulator with service ID returns error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, 1, startTime),
+			shouldErr:   true,
+		},
+		{
+			name: "last update time before start time returns error",
+			accumulator: types.DelegatorSharesAccumulator{
+				DelegationType:     restakingtypes.DELEGATION_TYPE_SERVICE,
+				DelegationTargetID: 1,
+				CumulativeShares:   sdk.NewDecCoins(),
+				StartTime:          startTime,
+				LastUpdateTime:     startTime.Add(-time.Hour),
+			},
+			shouldErr: true,
+		},
+		{
+			name:        "valid pool accumulator returns no error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_POOL, 1, 1, startTime),
+			shouldErr:   false,
+		},
+		{
+			name: "valid service accumulator returns no error",
+			accumulator: types.NewDelegatorSharesAccumulator(restakingtypes.DELEGATION_TYPE_SERVICE, 1, 0, startTime).
+				Accumulate(utils.MustParseDecCoins("100service/1/umilk"), startTime.Add(time.Hour)),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.accumulator.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDelegatorEpochClaim_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		claim     types.DelegatorEpochClaim
+		shouldErr bool
+	}{
+		{
+			name:      "unspecified delegation type returns error",
+			claim:     types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_UNSPECIFIED, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid delegation target ID returns error",
+			claim:     types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_OPERATOR, 0, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid delegator address returns error",
+			claim:     types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, "invalid"),
+			shouldErr: true,
+		},
+		{
+			name: "valid claim returns no error",
+			claim: types.NewDelegatorEpochClaim(restakingtypes.DELEGATION_TYPE_OPERATOR, 1, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn").
+				AddStakes(utils.MustParseDecCoins("100umilk"), 1000),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.claim.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIBCWithdrawDestination_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		destination types.IBCWithdrawDestination
+		shouldErr   bool
+	}{
+		{
+			name:        "invalid source channel returns error",
+			destination: types.NewIBCWithdrawDestination("invalid channel", "osmo1receiver", time.Hour),
+			shouldErr:   true,
+		},
+		{
+			name:        "empty receiver returns error",
+			destination: types.NewIBCWithdrawDestination("channel-0", " ", time.Hour),
+			shouldErr:   true,
+		},
+		{
+			name:        "zero timeout returns error",
+			destination: types.NewIBCWithdrawDestination("channel-0", "osmo1receiver", 0),
+			shouldErr:   true,
+		},
+		{
+			name:        "valid destination returns no error",
+			destination: types.NewIBCWithdrawDestination("channel-0", "osmo1receiver", time.Hour),
+			shouldErr:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.destination.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
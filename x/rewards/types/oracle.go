@@ -1,3 +0,0 @@
-package types
-
-const USDTicker = "USD"
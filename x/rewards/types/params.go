@@ -2,20 +2,40 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	DefaultAutoCompoundInterval       = 24 * time.Hour
+	DefaultAutoCompoundMaxGasPerBlock = 10_000_000
+	DefaultAllocationEpochDuration    = 0
+)
+
 // NewParams creates a new Params object
-func NewParams(rewardsPlanCreationFee sdk.Coins) Params {
+func NewParams(
+	rewardsPlanCreationFee sdk.Coins,
+	autoCompoundInterval time.Duration,
+	autoCompoundMaxGasPerBlock uint64,
+	allocationEpochDuration time.Duration,
+) Params {
 	return Params{
-		RewardsPlanCreationFee: rewardsPlanCreationFee,
+		RewardsPlanCreationFee:     rewardsPlanCreationFee,
+		AutoCompoundInterval:       autoCompoundInterval,
+		AutoCompoundMaxGasPerBlock: autoCompoundMaxGasPerBlock,
+		AllocationEpochDuration:    allocationEpochDuration,
 	}
 }
 
 // DefaultParams returns default Params
 func DefaultParams() Params {
-	return NewParams(nil)
+	return NewParams(
+		nil,
+		DefaultAutoCompoundInterval,
+		DefaultAutoCompoundMaxGasPerBlock,
+		DefaultAllocationEpochDuration,
+	)
 }
 
 // Validate checks that the parameters have valid values.
@@ -24,5 +44,18 @@ func (p *Params) Validate() error {
 	if err != nil {
 		return fmt.Errorf("invalid rewards plan creation fee: %w", err)
 	}
+
+	if p.AutoCompoundInterval < 0 {
+		return fmt.Errorf("invalid auto-compound interval: %s", p.AutoCompoundInterval)
+	}
+
+	if p.AutoCompoundInterval > 0 && p.AutoCompoundMaxGasPerBlock == 0 {
+		return fmt.Errorf("invalid auto-compound max gas per block: %d", p.AutoCompoundMaxGasPerBlock)
+	}
+
+	if p.AllocationEpochDuration < 0 {
+		return fmt.Errorf("invalid allocation epoch duration: %s", p.AllocationEpochDuration)
+	}
+
 	return nil
 }
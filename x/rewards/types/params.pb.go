@@ -12,12 +12,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +34,17 @@ type Params struct {
 	// The fee is drawn from the MsgCreateRewardsPlan sender's account and
 	// transferred to the community pool.
 	RewardsPlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards_plan_creation_fee,json=rewardsPlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_plan_creation_fee"`
+	// AutoCompoundInterval represents the interval between two auto-compounding
+	// epochs. If set to 0, auto-compounding is disabled.
+	AutoCompoundInterval time.Duration `protobuf:"varint,2,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3,stdduration" json:"auto_compound_interval,omitempty"`
+	// AutoCompoundMaxGasPerBlock represents the maximum amount of gas that can
+	// be consumed in each block while processing the auto-compounding queue.
+	AutoCompoundMaxGasPerBlock uint64 `protobuf:"varint,3,opt,name=auto_compound_max_gas_per_block,json=autoCompoundMaxGasPerBlock,proto3" json:"auto_compound_max_gas_per_block,omitempty"`
+	// AllocationEpochDuration represents the duration of the rewards allocation
+	// epochs. Rewards accrue every block, but they are only distributed to the
+	// delegation targets once per epoch. If set to 0, rewards are distributed
+	// every block.
+	AllocationEpochDuration time.Duration `protobuf:"varint,4,opt,name=allocation_epoch_duration,json=allocationEpochDuration,proto3,stdduration" json:"allocation_epoch_duration,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,25 +87,32 @@ func init() {
 func init() { proto.RegisterFile("milkyway/rewards/v1/params.proto", fileDescriptor_d0e2cca7ddea4eaf) }
 
 var fileDescriptor_d0e2cca7ddea4eaf = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x63, 0x21, 0x75, 0x08, 0x5b, 0x41, 0x88, 0x76, 0x70, 0x2b, 0xa6, 0x2e, 0xf5, 0x25,
-	0x45, 0xbc, 0x40, 0x2b, 0x31, 0x57, 0xb0, 0xb1, 0x44, 0x37, 0xa9, 0x09, 0x51, 0x93, 0xdc, 0x28,
-	0x36, 0x29, 0x79, 0x01, 0x66, 0x78, 0x0d, 0x9e, 0x24, 0x63, 0x47, 0x26, 0x7e, 0x92, 0x17, 0x41,
-	0x75, 0xdc, 0xaa, 0x93, 0xaf, 0xad, 0x73, 0xce, 0xe7, 0x7b, 0xdc, 0x71, 0x1a, 0x27, 0xeb, 0x6a,
-	0x83, 0x15, 0x14, 0x72, 0x83, 0xc5, 0x4a, 0x41, 0xe9, 0x41, 0x8e, 0x05, 0xa6, 0x4a, 0xe4, 0x05,
-	0x69, 0xea, 0x9f, 0xed, 0x15, 0xc2, 0x2a, 0x44, 0xe9, 0x0d, 0x79, 0x48, 0x2a, 0x25, 0x05, 0x01,
-	0x2a, 0x09, 0xa5, 0x17, 0x48, 0x8d, 0x1e, 0x84, 0x14, 0x67, 0x9d, 0x69, 0x78, 0x1e, 0x51, 0x44,
-	0x66, 0x84, 0xdd, 0xd4, 0xbd, 0x5e, 0x7d, 0x30, 0xb7, 0xb7, 0x34, 0xd9, 0xfd, 0x37, 0xe6, 0x0e,
-	0x6c, 0x9e, 0x9f, 0x27, 0x98, 0xf9, 0x61, 0x21, 0x51, 0xc7, 0x94, 0xf9, 0x4f, 0x52, 0x5e, 0xb2,
-	0xf1, 0xc9, 0xe4, 0x74, 0x36, 0x10, 0x1d, 0x45, 0xec, 0x28, 0xc2, 0x52, 0xc4, 0x82, 0xe2, 0x6c,
-	0x7e, 0x5d, 0x7f, 0x8f, 0x9c, 0xcf, 0x9f, 0xd1, 0x24, 0x8a, 0xf5, 0xf3, 0x4b, 0x20, 0x42, 0x4a,
-	0xc1, 0x7e, 0xa9, 0x3b, 0xa6, 0x6a, 0xb5, 0x06, 0x5d, 0xe5, 0x52, 0x19, 0x83, 0xba, 0xbf, 0xb0,
-	0xb4, 0x65, 0x82, 0xd9, 0xc2, 0xb2, 0xee, 0xa4, 0x9c, 0x3f, 0xd4, 0x7f, 0xdc, 0xa9, 0x1b, 0xce,
-	0xb6, 0x0d, 0x67, 0xbf, 0x0d, 0x67, 0xef, 0x2d, 0x77, 0xb6, 0x2d, 0x77, 0xbe, 0x5a, 0xee, 0x3c,
-	0xde, 0x1e, 0xe5, 0xef, 0x7b, 0x98, 0x26, 0x18, 0xa8, 0xc3, 0x0d, 0x4a, 0x6f, 0x06, 0xaf, 0x87,
-	0xf6, 0x0c, 0x32, 0xe8, 0x99, 0x7d, 0x6f, 0xfe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x54, 0xa9,
-	0x82, 0x5e, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xbf, 0x92, 0xd3, 0x30,
+	0x10, 0xc6, 0xad, 0x4b, 0xe6, 0x0a, 0xd3, 0x99, 0x9b, 0x23, 0x49, 0xa1, 0x64, 0xa8, 0xd2, 0x9c,
+	0x84, 0x8f, 0xa1, 0xa1, 0x62, 0x62, 0xfe, 0x0c, 0x05, 0x33, 0x99, 0xd0, 0xd1, 0x68, 0xd6, 0xb2,
+	0xf0, 0x79, 0x22, 0x7b, 0x3d, 0x92, 0xed, 0x4b, 0x5e, 0x80, 0x9a, 0x92, 0x67, 0xe0, 0x49, 0x52,
+	0x5e, 0x41, 0x41, 0xc5, 0x41, 0xf2, 0x22, 0x8c, 0x6d, 0x5d, 0x08, 0x95, 0x76, 0x35, 0xbf, 0x6f,
+	0xbf, 0xd5, 0xae, 0xfc, 0x59, 0x9e, 0xe9, 0xf5, 0xf6, 0x16, 0xb6, 0xdc, 0xa8, 0x5b, 0x30, 0x89,
+	0xe5, 0x4d, 0xc8, 0x4b, 0x30, 0x90, 0x5b, 0x56, 0x1a, 0xac, 0x30, 0x78, 0xfc, 0x40, 0x30, 0x47,
+	0xb0, 0x26, 0x9c, 0x50, 0x89, 0x36, 0x47, 0xcb, 0x63, 0xb0, 0x8a, 0x37, 0x61, 0xac, 0x2a, 0x08,
+	0xb9, 0xc4, 0xac, 0xe8, 0x45, 0x93, 0x8b, 0x14, 0x53, 0xec, 0x42, 0xde, 0x46, 0xfd, 0xed, 0xd3,
+	0x1f, 0x67, 0xfe, 0xf9, 0xb2, 0xab, 0x1d, 0x7c, 0x21, 0xfe, 0xd8, 0xd5, 0x13, 0xa5, 0x86, 0x42,
+	0x48, 0xa3, 0xa0, 0xca, 0xb0, 0x10, 0x9f, 0x95, 0x1a, 0x91, 0xd9, 0x60, 0xfe, 0xe8, 0x7a, 0xcc,
+	0x7a, 0x17, 0xd6, 0xba, 0x30, 0xe7, 0xc2, 0x22, 0xcc, 0x8a, 0xc5, 0xb3, 0xdd, 0xaf, 0xa9, 0xf7,
+	0xfd, 0x7e, 0x3a, 0x4f, 0xb3, 0xea, 0xa6, 0x8e, 0x99, 0xc4, 0x9c, 0xbb, 0x96, 0xfa, 0xe3, 0xca,
+	0x26, 0x6b, 0x5e, 0x6d, 0x4b, 0x65, 0x3b, 0x81, 0x5d, 0x5d, 0x3a, 0xb7, 0xa5, 0x86, 0x22, 0x72,
+	0x5e, 0x6f, 0x95, 0x0a, 0x5e, 0xfa, 0x97, 0x50, 0x57, 0x28, 0x24, 0xe6, 0x25, 0xd6, 0x45, 0x22,
+	0xb2, 0xa2, 0x52, 0xa6, 0x01, 0x3d, 0x3a, 0x9b, 0x91, 0xf9, 0x60, 0x31, 0xfc, 0x76, 0x3f, 0x25,
+	0xab, 0x8b, 0x96, 0x89, 0x1c, 0xf2, 0xde, 0x11, 0x41, 0xe4, 0x4f, 0xff, 0xd7, 0xe6, 0xb0, 0x11,
+	0x29, 0x58, 0x51, 0x2a, 0x23, 0x62, 0x8d, 0x72, 0x3d, 0x1a, 0xcc, 0xc8, 0x7c, 0xb8, 0x9a, 0x9c,
+	0xca, 0x3f, 0xc0, 0xe6, 0x1d, 0xd8, 0xa5, 0x32, 0x8b, 0x96, 0x08, 0x5e, 0xf9, 0x63, 0xd0, 0x1a,
+	0x65, 0xff, 0x7a, 0x55, 0xa2, 0xbc, 0x11, 0x49, 0x6d, 0xba, 0x74, 0x34, 0x3c, 0xe9, 0xe1, 0xc9,
+	0x3f, 0xec, 0x4d, 0x4b, 0xbd, 0x76, 0xd0, 0xe2, 0xe3, 0xee, 0x0f, 0xf5, 0x76, 0x7b, 0x4a, 0xee,
+	0xf6, 0x94, 0xfc, 0xde, 0x53, 0xf2, 0xf5, 0x40, 0xbd, 0xbb, 0x03, 0xf5, 0x7e, 0x1e, 0xa8, 0xf7,
+	0xe9, 0xc5, 0xc9, 0x88, 0x1e, 0x56, 0x79, 0xa5, 0x21, 0xb6, 0xc7, 0x8c, 0x37, 0xe1, 0x35, 0xdf,
+	0x1c, 0x3f, 0x40, 0x37, 0xb5, 0xf8, 0xbc, 0x5b, 0xd9, 0xf3, 0xbf, 0x03, 0x00, 0x04, 0xa2, 0xc5,
+	0x03, 0x21, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +135,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllocationEpochDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AllocationEpochDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AutoCompoundMaxGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundMaxGasPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.RewardsPlanCreationFee) > 0 {
 		for iNdEx := len(m.RewardsPlanCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +190,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundMaxGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundMaxGasPerBlock))
+	}
+	if m.AllocationEpochDuration != 0 {
+		n += 1 + sovParams(uint64(m.AllocationEpochDuration))
+	}
 	return n
 }
 
@@ -227,6 +271,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGasPerBlock", wireType)
+			}
+			m.AutoCompoundMaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationEpochDuration", wireType)
+			}
+			m.AllocationEpochDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocationEpochDuration |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -21,10 +22,35 @@ func TestParams_Validate(t *testing.T) {
 			shouldErr: false,
 		},
 		{
-			name:      "invalid rewards plan creation fee returns error",
-			params:    types.NewParams(sdk.Coins{sdk.NewInt64Coin("umilk", 0)}),
+			name: "invalid rewards plan creation fee returns error",
+			params: types.NewParams(
+				sdk.Coins{sdk.NewInt64Coin("umilk", 0)},
+				types.DefaultAutoCompoundInterval,
+				types.DefaultAutoCompoundMaxGasPerBlock,
+				0,
+			),
 			shouldErr: true,
 		},
+		{
+			name:      "negative auto-compound interval returns error",
+			params:    types.NewParams(nil, -time.Hour, types.DefaultAutoCompoundMaxGasPerBlock, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "zero auto-compound max gas per block returns error",
+			params:    types.NewParams(nil, types.DefaultAutoCompoundInterval, 0, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "negative allocation epoch duration returns error",
+			params:    types.NewParams(nil, types.DefaultAutoCompoundInterval, types.DefaultAutoCompoundMaxGasPerBlock, -time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "disabled auto-compound with zero max gas per block returns no error",
+			params:    types.NewParams(nil, 0, 0, 0),
+			shouldErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
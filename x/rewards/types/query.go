@@ -18,6 +18,20 @@ func NewQueryRewardsPlanRequest(planID uint64) *QueryRewardsPlanRequest {
 	}
 }
 
+// NewQueryArchivedRewardsPlansRequest creates a new instance of QueryArchivedRewardsPlansRequest.
+func NewQueryArchivedRewardsPlansRequest(pagination *query.PageRequest) *QueryArchivedRewardsPlansRequest {
+	return &QueryArchivedRewardsPlansRequest{
+		Pagination: pagination,
+	}
+}
+
+// NewQueryArchivedRewardsPlanRequest creates a new instance of QueryArchivedRewardsPlanRequest.
+func NewQueryArchivedRewardsPlanRequest(planID uint64) *QueryArchivedRewardsPlanRequest {
+	return &QueryArchivedRewardsPlanRequest{
+		PlanId: planID,
+	}
+}
+
 // NewQueryPoolOutstandingRewardsRequest creates a new instance of QueryPoolOutstandingRewardsRequest.
 func NewQueryPoolOutstandingRewardsRequest(poolID uint32) *QueryPoolOutstandingRewardsRequest {
 	return &QueryPoolOutstandingRewardsRequest{
@@ -54,6 +68,35 @@ func NewQueryPoolServiceTotalDelegatorSharesRequest(poolID, serviceID uint32) *Q
 	}
 }
 
+// NewQueryPoolAPRRequest creates a new instance of QueryPoolAPRRequest.
+func NewQueryPoolAPRRequest(poolID uint32) *QueryPoolAPRRequest {
+	return &QueryPoolAPRRequest{
+		PoolId: poolID,
+	}
+}
+
+// NewQueryOperatorAPRRequest creates a new instance of QueryOperatorAPRRequest.
+func NewQueryOperatorAPRRequest(operatorID uint32) *QueryOperatorAPRRequest {
+	return &QueryOperatorAPRRequest{
+		OperatorId: operatorID,
+	}
+}
+
+// NewQueryServiceAPRRequest creates a new instance of QueryServiceAPRRequest.
+func NewQueryServiceAPRRequest(serviceID uint32) *QueryServiceAPRRequest {
+	return &QueryServiceAPRRequest{
+		ServiceId: serviceID,
+	}
+}
+
+// NewQueryPoolServiceAPRRequest creates a new instance of QueryPoolServiceAPRRequest.
+func NewQueryPoolServiceAPRRequest(poolID, serviceID uint32) *QueryPoolServiceAPRRequest {
+	return &QueryPoolServiceAPRRequest{
+		PoolId:    poolID,
+		ServiceId: serviceID,
+	}
+}
+
 // NewQueryPoolDelegationRewardsRequest creates a new instance of QueryPoolDelegationRewardsRequest.
 func NewQueryPoolDelegationRewardsRequest(poolID uint32, delegator string) *QueryPoolDelegationRewardsRequest {
 	return &QueryPoolDelegationRewardsRequest{
@@ -89,3 +132,10 @@ func NewQueryDelegatorWithdrawAddressRequest(delegator string) *QueryDelegatorWi
 		DelegatorAddress: delegator,
 	}
 }
+
+func NewQueryDelegatorAutoCompoundSettingsRequest(delegator string, pagination *query.PageRequest) *QueryDelegatorAutoCompoundSettingsRequest {
+	return &QueryDelegatorAutoCompoundSettingsRequest{
+		DelegatorAddress: delegator,
+		Pagination:       pagination,
+	}
+}
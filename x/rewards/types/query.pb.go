@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -268,6 +269,165 @@ func (m *QueryRewardsPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRewardsPlanResponse proto.InternalMessageInfo
 
+// QueryArchivedRewardsPlansRequest is the request type for the
+// Query/ArchivedRewardsPlans RPC method.
+type QueryArchivedRewardsPlansRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryArchivedRewardsPlansRequest) Reset()         { *m = QueryArchivedRewardsPlansRequest{} }
+func (m *QueryArchivedRewardsPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedRewardsPlansRequest) ProtoMessage()    {}
+func (*QueryArchivedRewardsPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{6}
+}
+func (m *QueryArchivedRewardsPlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedRewardsPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedRewardsPlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedRewardsPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedRewardsPlansRequest.Merge(m, src)
+}
+func (m *QueryArchivedRewardsPlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedRewardsPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedRewardsPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedRewardsPlansRequest proto.InternalMessageInfo
+
+// QueryArchivedRewardsPlansResponse is the response type for the
+// Query/ArchivedRewardsPlans RPC method.
+type QueryArchivedRewardsPlansResponse struct {
+	ArchivedRewardsPlans []ArchivedRewardsPlan `protobuf:"bytes,1,rep,name=archived_rewards_plans,json=archivedRewardsPlans,proto3" json:"archived_rewards_plans"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryArchivedRewardsPlansResponse) Reset()         { *m = QueryArchivedRewardsPlansResponse{} }
+func (m *QueryArchivedRewardsPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedRewardsPlansResponse) ProtoMessage()    {}
+func (*QueryArchivedRewardsPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{7}
+}
+func (m *QueryArchivedRewardsPlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedRewardsPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedRewardsPlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedRewardsPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedRewardsPlansResponse.Merge(m, src)
+}
+func (m *QueryArchivedRewardsPlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedRewardsPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedRewardsPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedRewardsPlansResponse proto.InternalMessageInfo
+
+// QueryArchivedRewardsPlanRequest is the request type for the
+// Query/ArchivedRewardsPlan RPC method.
+type QueryArchivedRewardsPlanRequest struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryArchivedRewardsPlanRequest) Reset()         { *m = QueryArchivedRewardsPlanRequest{} }
+func (m *QueryArchivedRewardsPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedRewardsPlanRequest) ProtoMessage()    {}
+func (*QueryArchivedRewardsPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{8}
+}
+func (m *QueryArchivedRewardsPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedRewardsPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedRewardsPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedRewardsPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedRewardsPlanRequest.Merge(m, src)
+}
+func (m *QueryArchivedRewardsPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedRewardsPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedRewardsPlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedRewardsPlanRequest proto.InternalMessageInfo
+
+// QueryArchivedRewardsPlanResponse is the response type for the
+// Query/ArchivedRewardsPlan RPC method.
+type QueryArchivedRewardsPlanResponse struct {
+	ArchivedRewardsPlan ArchivedRewardsPlan `protobuf:"bytes,1,opt,name=archived_rewards_plan,json=archivedRewardsPlan,proto3" json:"archived_rewards_plan"`
+}
+
+func (m *QueryArchivedRewardsPlanResponse) Reset()         { *m = QueryArchivedRewardsPlanResponse{} }
+func (m *QueryArchivedRewardsPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedRewardsPlanResponse) ProtoMessage()    {}
+func (*QueryArchivedRewardsPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{9}
+}
+func (m *QueryArchivedRewardsPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedRewardsPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedRewardsPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedRewardsPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedRewardsPlanResponse.Merge(m, src)
+}
+func (m *QueryArchivedRewardsPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedRewardsPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedRewardsPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedRewardsPlanResponse proto.InternalMessageInfo
+
 // QueryPoolOutstandingRewardsRequest is the request type for the
 // Query/PoolOutstandingRewards RPC method.
 type QueryPoolOutstandingRewardsRequest struct {
@@ -279,7 +439,7 @@ func (m *QueryPoolOutstandingRewardsRequest) Reset()         { *m = QueryPoolOut
 func (m *QueryPoolOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolOutstandingRewardsRequest) ProtoMessage()    {}
 func (*QueryPoolOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{6}
+	return fileDescriptor_f900c2389df7beb1, []int{10}
 }
 func (m *QueryPoolOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +478,7 @@ func (m *QueryPoolOutstandingRewardsResponse) Reset()         { *m = QueryPoolOu
 func (m *QueryPoolOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolOutstandingRewardsResponse) ProtoMessage()    {}
 func (*QueryPoolOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{7}
+	return fileDescriptor_f900c2389df7beb1, []int{11}
 }
 func (m *QueryPoolOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +520,7 @@ func (m *QueryOperatorOutstandingRewardsRequest) Reset() {
 func (m *QueryOperatorOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorOutstandingRewardsRequest) ProtoMessage()    {}
 func (*QueryOperatorOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{8}
+	return fileDescriptor_f900c2389df7beb1, []int{12}
 }
 func (m *QueryOperatorOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +561,7 @@ func (m *QueryOperatorOutstandingRewardsResponse) Reset() {
 func (m *QueryOperatorOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorOutstandingRewardsResponse) ProtoMessage()    {}
 func (*QueryOperatorOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{9}
+	return fileDescriptor_f900c2389df7beb1, []int{13}
 }
 func (m *QueryOperatorOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +601,7 @@ func (m *QueryServiceOutstandingRewardsRequest) Reset()         { *m = QueryServ
 func (m *QueryServiceOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryServiceOutstandingRewardsRequest) ProtoMessage()    {}
 func (*QueryServiceOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{10}
+	return fileDescriptor_f900c2389df7beb1, []int{14}
 }
 func (m *QueryServiceOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +642,7 @@ func (m *QueryServiceOutstandingRewardsResponse) Reset() {
 func (m *QueryServiceOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryServiceOutstandingRewardsResponse) ProtoMessage()    {}
 func (*QueryServiceOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{11}
+	return fileDescriptor_f900c2389df7beb1, []int{15}
 }
 func (m *QueryServiceOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +682,7 @@ func (m *QueryOperatorCommissionRequest) Reset()         { *m = QueryOperatorCom
 func (m *QueryOperatorCommissionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorCommissionRequest) ProtoMessage()    {}
 func (*QueryOperatorCommissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{12}
+	return fileDescriptor_f900c2389df7beb1, []int{16}
 }
 func (m *QueryOperatorCommissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +722,7 @@ func (m *QueryOperatorCommissionResponse) Reset()         { *m = QueryOperatorCo
 func (m *QueryOperatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorCommissionResponse) ProtoMessage()    {}
 func (*QueryOperatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{13}
+	return fileDescriptor_f900c2389df7beb1, []int{17}
 }
 func (m *QueryOperatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +768,7 @@ func (m *QueryPoolServiceTotalDelegatorSharesRequest) String() string {
 }
 func (*QueryPoolServiceTotalDelegatorSharesRequest) ProtoMessage() {}
 func (*QueryPoolServiceTotalDelegatorSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{14}
+	return fileDescriptor_f900c2389df7beb1, []int{18}
 }
 func (m *QueryPoolServiceTotalDelegatorSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +813,7 @@ func (m *QueryPoolServiceTotalDelegatorSharesResponse) String() string {
 }
 func (*QueryPoolServiceTotalDelegatorSharesResponse) ProtoMessage() {}
 func (*QueryPoolServiceTotalDelegatorSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{15}
+	return fileDescriptor_f900c2389df7beb1, []int{19}
 }
 func (m *QueryPoolServiceTotalDelegatorSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,27 +842,25 @@ func (m *QueryPoolServiceTotalDelegatorSharesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPoolServiceTotalDelegatorSharesResponse proto.InternalMessageInfo
 
-// QueryPoolDelegationRewardsRequest is the request type for the
-// Query/PoolDelegationRewards RPC method.
-type QueryPoolDelegationRewardsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryPoolAPRRequest is the request type for the Query/PoolAPR RPC
+// method.
+type QueryPoolAPRRequest struct {
 	// pool_id defines the pool ID to query for.
-	PoolId uint32 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryPoolDelegationRewardsRequest) Reset()         { *m = QueryPoolDelegationRewardsRequest{} }
-func (m *QueryPoolDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationRewardsRequest) ProtoMessage()    {}
-func (*QueryPoolDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{16}
+func (m *QueryPoolAPRRequest) Reset()         { *m = QueryPoolAPRRequest{} }
+func (m *QueryPoolAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRRequest) ProtoMessage()    {}
+func (*QueryPoolAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{20}
 }
-func (m *QueryPoolDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolAPRRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolAPRRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -712,37 +870,39 @@ func (m *QueryPoolDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationRewardsRequest.Merge(m, src)
+func (m *QueryPoolAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRRequest.Merge(m, src)
 }
-func (m *QueryPoolDelegationRewardsRequest) XXX_Size() int {
+func (m *QueryPoolAPRRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationRewardsRequest.DiscardUnknown(m)
+func (m *QueryPoolAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolAPRRequest proto.InternalMessageInfo
 
-// QueryPoolDelegationRewardsResponse is the response type for the
-// Query/PoolDelegationRewards RPC method.
-type QueryPoolDelegationRewardsResponse struct {
-	// rewards defines the rewards accrued by a delegation.
-	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
+// QueryPoolAPRResponse is the response type for the Query/PoolAPR RPC
+// method.
+type QueryPoolAPRResponse struct {
+	// apr defines the total projected annualised yield.
+	APR cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
 }
 
-func (m *QueryPoolDelegationRewardsResponse) Reset()         { *m = QueryPoolDelegationRewardsResponse{} }
-func (m *QueryPoolDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolDelegationRewardsResponse) ProtoMessage()    {}
-func (*QueryPoolDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{17}
+func (m *QueryPoolAPRResponse) Reset()         { *m = QueryPoolAPRResponse{} }
+func (m *QueryPoolAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolAPRResponse) ProtoMessage()    {}
+func (*QueryPoolAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{21}
 }
-func (m *QueryPoolDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolAPRResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolDelegationRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolAPRResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -752,39 +912,37 @@ func (m *QueryPoolDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolDelegationRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolDelegationRewardsResponse.Merge(m, src)
+func (m *QueryPoolAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolAPRResponse.Merge(m, src)
 }
-func (m *QueryPoolDelegationRewardsResponse) XXX_Size() int {
+func (m *QueryPoolAPRResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolDelegationRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolDelegationRewardsResponse.DiscardUnknown(m)
+func (m *QueryPoolAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolAPRResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolDelegationRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolAPRResponse proto.InternalMessageInfo
 
-// QueryOperatorDelegationRewardsRequest is the request type for the
-// Query/OperatorDelegationRewards RPC method.
-type QueryOperatorDelegationRewardsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryOperatorAPRRequest is the request type for the Query/OperatorAPR RPC
+// method.
+type QueryOperatorAPRRequest struct {
 	// operator_id defines the operator ID to query for.
-	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	OperatorId uint32 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (m *QueryOperatorDelegationRewardsRequest) Reset()         { *m = QueryOperatorDelegationRewardsRequest{} }
-func (m *QueryOperatorDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationRewardsRequest) ProtoMessage()    {}
-func (*QueryOperatorDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{18}
+func (m *QueryOperatorAPRRequest) Reset()         { *m = QueryOperatorAPRRequest{} }
+func (m *QueryOperatorAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAPRRequest) ProtoMessage()    {}
+func (*QueryOperatorAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{22}
 }
-func (m *QueryOperatorDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorAPRRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorAPRRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,39 +952,39 @@ func (m *QueryOperatorDelegationRewardsRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationRewardsRequest.Merge(m, src)
+func (m *QueryOperatorAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAPRRequest.Merge(m, src)
 }
-func (m *QueryOperatorDelegationRewardsRequest) XXX_Size() int {
+func (m *QueryOperatorAPRRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationRewardsRequest.DiscardUnknown(m)
+func (m *QueryOperatorAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAPRRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorAPRRequest proto.InternalMessageInfo
 
-// QueryOperatorDelegationRewardsResponse is the response type for the
-// Query/OperatorDelegationRewards RPC method.
-type QueryOperatorDelegationRewardsResponse struct {
-	// rewards defines the rewards accrued by a delegation.
-	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
+// QueryOperatorAPRResponse is the response type for the Query/OperatorAPR RPC
+// method.
+type QueryOperatorAPRResponse struct {
+	// apr defines the total projected annualised yield.
+	APR cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
 }
 
-func (m *QueryOperatorDelegationRewardsResponse) Reset() {
-	*m = QueryOperatorDelegationRewardsResponse{}
-}
-func (m *QueryOperatorDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOperatorDelegationRewardsResponse) ProtoMessage()    {}
-func (*QueryOperatorDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{19}
+func (m *QueryOperatorAPRResponse) Reset()         { *m = QueryOperatorAPRResponse{} }
+func (m *QueryOperatorAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAPRResponse) ProtoMessage()    {}
+func (*QueryOperatorAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{23}
 }
-func (m *QueryOperatorDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOperatorAPRResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOperatorDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOperatorAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOperatorDelegationRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOperatorAPRResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -836,39 +994,37 @@ func (m *QueryOperatorDelegationRewardsResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryOperatorDelegationRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOperatorDelegationRewardsResponse.Merge(m, src)
+func (m *QueryOperatorAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAPRResponse.Merge(m, src)
 }
-func (m *QueryOperatorDelegationRewardsResponse) XXX_Size() int {
+func (m *QueryOperatorAPRResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOperatorDelegationRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOperatorDelegationRewardsResponse.DiscardUnknown(m)
+func (m *QueryOperatorAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAPRResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOperatorDelegationRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOperatorAPRResponse proto.InternalMessageInfo
 
-// QueryServiceDelegationRewardsRequest is the request type for the
-// Query/ServiceDelegationRewards RPC method.
-type QueryServiceDelegationRewardsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+// QueryServiceAPRRequest is the request type for the Query/ServiceAPR RPC
+// method.
+type QueryServiceAPRRequest struct {
 	// service_id defines the service ID to query for.
-	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (m *QueryServiceDelegationRewardsRequest) Reset()         { *m = QueryServiceDelegationRewardsRequest{} }
-func (m *QueryServiceDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationRewardsRequest) ProtoMessage()    {}
-func (*QueryServiceDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{20}
+func (m *QueryServiceAPRRequest) Reset()         { *m = QueryServiceAPRRequest{} }
+func (m *QueryServiceAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceAPRRequest) ProtoMessage()    {}
+func (*QueryServiceAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{24}
 }
-func (m *QueryServiceDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceAPRRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceAPRRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -878,37 +1034,39 @@ func (m *QueryServiceDelegationRewardsRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationRewardsRequest.Merge(m, src)
+func (m *QueryServiceAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceAPRRequest.Merge(m, src)
 }
-func (m *QueryServiceDelegationRewardsRequest) XXX_Size() int {
+func (m *QueryServiceAPRRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationRewardsRequest.DiscardUnknown(m)
+func (m *QueryServiceAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceAPRRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryServiceAPRRequest proto.InternalMessageInfo
 
-// QueryServiceDelegationRewardsResponse is the response type for the
-// Query/ServiceDelegationRewards RPC method.
-type QueryServiceDelegationRewardsResponse struct {
-	// rewards defines the rewards accrued by a delegation.
-	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
+// QueryServiceAPRResponse is the response type for the Query/ServiceAPR RPC
+// method.
+type QueryServiceAPRResponse struct {
+	// apr defines the total projected annualised yield.
+	APR cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
 }
 
-func (m *QueryServiceDelegationRewardsResponse) Reset()         { *m = QueryServiceDelegationRewardsResponse{} }
-func (m *QueryServiceDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryServiceDelegationRewardsResponse) ProtoMessage()    {}
-func (*QueryServiceDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{21}
+func (m *QueryServiceAPRResponse) Reset()         { *m = QueryServiceAPRResponse{} }
+func (m *QueryServiceAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceAPRResponse) ProtoMessage()    {}
+func (*QueryServiceAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{25}
 }
-func (m *QueryServiceDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryServiceAPRResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryServiceDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryServiceAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryServiceDelegationRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryServiceAPRResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,37 +1076,39 @@ func (m *QueryServiceDelegationRewardsResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryServiceDelegationRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryServiceDelegationRewardsResponse.Merge(m, src)
+func (m *QueryServiceAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceAPRResponse.Merge(m, src)
 }
-func (m *QueryServiceDelegationRewardsResponse) XXX_Size() int {
+func (m *QueryServiceAPRResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryServiceDelegationRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryServiceDelegationRewardsResponse.DiscardUnknown(m)
+func (m *QueryServiceAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceAPRResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryServiceDelegationRewardsResponse proto.InternalMessageInfo
-
-// QueryDelegatorTotalRewardsRequest is the request type for the
-// Query/DelegatorTotalRewards RPC method.
-type QueryDelegatorTotalRewardsRequest struct {
-	// delegator_address defines the delegator address to query for.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-}
+var xxx_messageInfo_QueryServiceAPRResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorTotalRewardsRequest) Reset()         { *m = QueryDelegatorTotalRewardsRequest{} }
-func (m *QueryDelegatorTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorTotalRewardsRequest) ProtoMessage()    {}
-func (*QueryDelegatorTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{22}
+// QueryPoolServiceAPRRequest is the request type for the Query/PoolServiceAPR RPC
+// method.
+type QueryPoolServiceAPRRequest struct {
+	// pool_id defines the pool ID to query for.
+	PoolId uint32 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// service_id defines the service ID to query for.
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
-func (m *QueryDelegatorTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPoolServiceAPRRequest) Reset()         { *m = QueryPoolServiceAPRRequest{} }
+func (m *QueryPoolServiceAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolServiceAPRRequest) ProtoMessage()    {}
+func (*QueryPoolServiceAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{26}
+}
+func (m *QueryPoolServiceAPRRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorTotalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolServiceAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorTotalRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolServiceAPRRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -958,39 +1118,39 @@ func (m *QueryDelegatorTotalRewardsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorTotalRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorTotalRewardsRequest.Merge(m, src)
+func (m *QueryPoolServiceAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolServiceAPRRequest.Merge(m, src)
 }
-func (m *QueryDelegatorTotalRewardsRequest) XXX_Size() int {
+func (m *QueryPoolServiceAPRRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorTotalRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorTotalRewardsRequest.DiscardUnknown(m)
+func (m *QueryPoolServiceAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolServiceAPRRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorTotalRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolServiceAPRRequest proto.InternalMessageInfo
 
-// QueryDelegatorTotalRewardsResponse is the response type for the
-// Query/DelegatorTotalRewards RPC method.
-type QueryDelegatorTotalRewardsResponse struct {
-	// rewards defines all the rewards accrued by a delegator.
-	Rewards []DelegationDelegatorReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
-	// total defines the sum of all the rewards.
-	Total DecPools `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=DecPools" json:"total"`
+// QueryPoolServiceAPRResponse is the response type for the Query/PoolServiceAPR RPC
+// method.
+type QueryPoolServiceAPRResponse struct {
+	// apr defines the total projected annualised yield.
+	APR cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// plans defines the projected annualised yield of each rewards plan.
+	Plans []PlanAPR `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
 }
 
-func (m *QueryDelegatorTotalRewardsResponse) Reset()         { *m = QueryDelegatorTotalRewardsResponse{} }
-func (m *QueryDelegatorTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorTotalRewardsResponse) ProtoMessage()    {}
-func (*QueryDelegatorTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{23}
+func (m *QueryPoolServiceAPRResponse) Reset()         { *m = QueryPoolServiceAPRResponse{} }
+func (m *QueryPoolServiceAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolServiceAPRResponse) ProtoMessage()    {}
+func (*QueryPoolServiceAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{27}
 }
-func (m *QueryDelegatorTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolServiceAPRResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorTotalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolServiceAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorTotalRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolServiceAPRResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1000,37 +1160,39 @@ func (m *QueryDelegatorTotalRewardsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorTotalRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorTotalRewardsResponse.Merge(m, src)
+func (m *QueryPoolServiceAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolServiceAPRResponse.Merge(m, src)
 }
-func (m *QueryDelegatorTotalRewardsResponse) XXX_Size() int {
+func (m *QueryPoolServiceAPRResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorTotalRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorTotalRewardsResponse.DiscardUnknown(m)
+func (m *QueryPoolServiceAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolServiceAPRResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorTotalRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolServiceAPRResponse proto.InternalMessageInfo
 
-// QueryDelegatorWithdrawAddressRequest is the request type for the
-// Query/DelegatorWithdrawAddress RPC method.
-type QueryDelegatorWithdrawAddressRequest struct {
+// QueryPoolDelegationRewardsRequest is the request type for the
+// Query/PoolDelegationRewards RPC method.
+type QueryPoolDelegationRewardsRequest struct {
 	// delegator_address defines the delegator address to query for.
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pool_id defines the pool ID to query for.
+	PoolId uint32 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDelegatorWithdrawAddressRequest{} }
-func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
-func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{24}
+func (m *QueryPoolDelegationRewardsRequest) Reset()         { *m = QueryPoolDelegationRewardsRequest{} }
+func (m *QueryPoolDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationRewardsRequest) ProtoMessage()    {}
+func (*QueryPoolDelegationRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{28}
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1040,37 +1202,37 @@ func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Merge(m, src)
+func (m *QueryPoolDelegationRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationRewardsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_Size() int {
+func (m *QueryPoolDelegationRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorWithdrawAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.DiscardUnknown(m)
+func (m *QueryPoolDelegationRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorWithdrawAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolDelegationRewardsRequest proto.InternalMessageInfo
 
-// QueryDelegatorWithdrawAddressResponse is the response type for the
-// Query/DelegatorWithdrawAddress RPC method.
-type QueryDelegatorWithdrawAddressResponse struct {
-	// withdraw_address defines the delegator address to query for.
-	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+// QueryPoolDelegationRewardsResponse is the response type for the
+// Query/PoolDelegationRewards RPC method.
+type QueryPoolDelegationRewardsResponse struct {
+	// rewards defines the rewards accrued by a delegation.
+	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDelegatorWithdrawAddressResponse{} }
-func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
-func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f900c2389df7beb1, []int{25}
+func (m *QueryPoolDelegationRewardsResponse) Reset()         { *m = QueryPoolDelegationRewardsResponse{} }
+func (m *QueryPoolDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDelegationRewardsResponse) ProtoMessage()    {}
+func (*QueryPoolDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{29}
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolDelegationRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1080,1207 +1242,1434 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Merge(m, src)
+func (m *QueryPoolDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDelegationRewardsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_Size() int {
+func (m *QueryPoolDelegationRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.DiscardUnknown(m)
+func (m *QueryPoolDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDelegationRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolDelegationRewardsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.rewards.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.rewards.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardsPlansRequest)(nil), "milkyway.rewards.v1.QueryRewardsPlansRequest")
-	proto.RegisterType((*QueryRewardsPlansResponse)(nil), "milkyway.rewards.v1.QueryRewardsPlansResponse")
-	proto.RegisterType((*QueryRewardsPlanRequest)(nil), "milkyway.rewards.v1.QueryRewardsPlanRequest")
-	proto.RegisterType((*QueryRewardsPlanResponse)(nil), "milkyway.rewards.v1.QueryRewardsPlanResponse")
-	proto.RegisterType((*QueryPoolOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest")
-	proto.RegisterType((*QueryPoolOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse")
-	proto.RegisterType((*QueryOperatorOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest")
-	proto.RegisterType((*QueryOperatorOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse")
-	proto.RegisterType((*QueryServiceOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest")
-	proto.RegisterType((*QueryServiceOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse")
-	proto.RegisterType((*QueryOperatorCommissionRequest)(nil), "milkyway.rewards.v1.QueryOperatorCommissionRequest")
-	proto.RegisterType((*QueryOperatorCommissionResponse)(nil), "milkyway.rewards.v1.QueryOperatorCommissionResponse")
-	proto.RegisterType((*QueryPoolServiceTotalDelegatorSharesRequest)(nil), "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest")
-	proto.RegisterType((*QueryPoolServiceTotalDelegatorSharesResponse)(nil), "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse")
-	proto.RegisterType((*QueryPoolDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest")
-	proto.RegisterType((*QueryPoolDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse")
-	proto.RegisterType((*QueryOperatorDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest")
-	proto.RegisterType((*QueryOperatorDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse")
-	proto.RegisterType((*QueryServiceDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest")
-	proto.RegisterType((*QueryServiceDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse")
-	proto.RegisterType((*QueryDelegatorTotalRewardsRequest)(nil), "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest")
-	proto.RegisterType((*QueryDelegatorTotalRewardsResponse)(nil), "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest")
-	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse")
+// QueryOperatorDelegationRewardsRequest is the request type for the
+// Query/OperatorDelegationRewards RPC method.
+type QueryOperatorDelegationRewardsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// operator_id defines the operator ID to query for.
+	OperatorId uint32 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("milkyway/rewards/v1/query.proto", fileDescriptor_f900c2389df7beb1) }
-
-var fileDescriptor_f900c2389df7beb1 = []byte{
-	// 1381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xf8, 0xd7, 0xba, 0xbf, 0xbe, 0x69, 0x45, 0x3b, 0x6d, 0xa8, 0xb3, 0x6d, 0xed, 0xb0,
-	0x2d, 0x6d, 0x95, 0x36, 0xbb, 0x4a, 0x5a, 0xe8, 0x47, 0x28, 0x24, 0x4e, 0x08, 0x58, 0xb4, 0x6a,
-	0x6a, 0x53, 0x21, 0xe0, 0x60, 0xad, 0xbd, 0x2b, 0x67, 0xa9, 0xbd, 0xe3, 0xee, 0xae, 0xed, 0x46,
-	0x51, 0x24, 0x3e, 0xae, 0x48, 0x20, 0x71, 0x81, 0x0b, 0xe2, 0x88, 0x38, 0x71, 0x40, 0x9c, 0x39,
-	0x70, 0xc8, 0x05, 0xa9, 0x82, 0x0b, 0x07, 0xa0, 0x34, 0x41, 0xe2, 0xc2, 0x85, 0xff, 0x00, 0xed,
-	0xec, 0xbb, 0xf6, 0xda, 0xde, 0x0f, 0xc7, 0xc1, 0x5c, 0xda, 0xec, 0xec, 0xfb, 0xf1, 0x3c, 0xcf,
-	0xbc, 0xb3, 0xf3, 0xc8, 0x90, 0xa9, 0xe9, 0xd5, 0xfb, 0xeb, 0x2d, 0x65, 0x5d, 0x36, 0xb5, 0x96,
-	0x62, 0xaa, 0x96, 0xdc, 0x9c, 0x95, 0x1f, 0x34, 0x34, 0x73, 0x5d, 0xaa, 0x9b, 0xcc, 0x66, 0xf4,
-	0x98, 0x17, 0x20, 0x61, 0x80, 0xd4, 0x9c, 0x15, 0x8e, 0x2a, 0x35, 0xdd, 0x60, 0x32, 0xff, 0xd7,
-	0x8d, 0x13, 0xa6, 0xcb, 0xcc, 0xaa, 0x31, 0x4b, 0x2e, 0x29, 0x96, 0xe6, 0x16, 0x90, 0x9b, 0xb3,
-	0x25, 0xcd, 0x56, 0x66, 0xe5, 0xba, 0x52, 0xd1, 0x0d, 0xc5, 0xd6, 0x99, 0x81, 0xb1, 0x69, 0x7f,
-	0xac, 0x17, 0x55, 0x66, 0xba, 0xf7, 0x7e, 0xd2, 0x7d, 0x5f, 0xe4, 0x4f, 0xb2, 0xfb, 0x80, 0xaf,
-	0x8e, 0x57, 0x58, 0x85, 0xb9, 0xeb, 0xce, 0x5f, 0xb8, 0x7a, 0xaa, 0xc2, 0x58, 0xa5, 0xaa, 0xc9,
-	0x4a, 0x5d, 0x97, 0x15, 0xc3, 0x60, 0x36, 0xef, 0xe6, 0xe5, 0x4c, 0x05, 0x71, 0xac, 0x31, 0x55,
-	0xab, 0x46, 0x46, 0xd4, 0x15, 0x53, 0xa9, 0x61, 0x84, 0x78, 0x1c, 0xe8, 0x5d, 0x87, 0xd4, 0x2a,
-	0x5f, 0xcc, 0x6b, 0x0f, 0x1a, 0x9a, 0x65, 0x8b, 0xab, 0x70, 0xac, 0x6b, 0xd5, 0xaa, 0x33, 0xc3,
-	0xd2, 0xe8, 0x75, 0x48, 0xba, 0xc9, 0x29, 0x32, 0x45, 0x2e, 0x8c, 0xcf, 0x9d, 0x94, 0x02, 0x44,
-	0x94, 0xdc, 0xa4, 0xec, 0xbe, 0xad, 0xdf, 0x32, 0x63, 0x79, 0x4c, 0x10, 0x4b, 0x90, 0xe2, 0x15,
-	0xf3, 0x6e, 0xdc, 0x6a, 0x55, 0x31, 0xbc, 0x6e, 0x74, 0x05, 0xa0, 0x23, 0x25, 0x96, 0x3e, 0x27,
-	0xa1, 0x3c, 0x8e, 0x96, 0x92, 0xbb, 0x71, 0xa8, 0xa8, 0xb4, 0xaa, 0x54, 0x34, 0xcc, 0xcd, 0xfb,
-	0x32, 0xc5, 0x6f, 0x09, 0x4c, 0x06, 0x34, 0x41, 0xf0, 0xab, 0x70, 0x18, 0x41, 0x16, 0xeb, 0xce,
-	0x8b, 0x14, 0x99, 0xfa, 0xdf, 0x85, 0xf1, 0xb9, 0xa9, 0x40, 0x0e, 0xbe, 0x0a, 0xd9, 0x83, 0x0e,
-	0x91, 0x2f, 0xff, 0xfc, 0x7a, 0x9a, 0xe4, 0x0f, 0x99, 0xbe, 0xca, 0xf4, 0x95, 0x2e, 0xdc, 0x09,
-	0x8e, 0xfb, 0x7c, 0x2c, 0x6e, 0x17, 0x4e, 0x17, 0xf0, 0x39, 0x38, 0xd1, 0x8b, 0xdb, 0xd3, 0xe6,
-	0x04, 0x1c, 0x70, 0xd0, 0x16, 0x75, 0x95, 0x0b, 0xb3, 0x2f, 0x9f, 0x74, 0x1e, 0x73, 0xaa, 0xa8,
-	0xf5, 0x0b, 0xda, 0xa6, 0x9a, 0x83, 0x43, 0x7e, 0xaa, 0x08, 0x2d, 0x9e, 0xa9, 0xbb, 0x65, 0xe3,
-	0x3e, 0x92, 0xe2, 0x4d, 0x10, 0xdd, 0x49, 0x60, 0xac, 0x7a, 0xa7, 0x61, 0x5b, 0xb6, 0x62, 0xa8,
-	0xba, 0x51, 0xc1, 0x2c, 0x3f, 0x4a, 0xc6, 0xaa, 0x1e, 0xca, 0xc3, 0xf9, 0xa4, 0xf3, 0x98, 0x53,
-	0x45, 0x0b, 0xce, 0x44, 0xa6, 0x23, 0xe0, 0x5b, 0x70, 0x00, 0x9b, 0xe2, 0xf6, 0x9f, 0x0f, 0xc4,
-	0xda, 0x5f, 0xc1, 0xbf, 0x39, 0x5e, 0x09, 0x31, 0x07, 0xe7, 0x78, 0xd3, 0x3b, 0x75, 0xcd, 0x54,
-	0x6c, 0x66, 0x86, 0xe3, 0xce, 0xc0, 0x38, 0xc3, 0xa0, 0x0e, 0x76, 0xf0, 0x96, 0x72, 0xaa, 0xd8,
-	0x82, 0xf3, 0xb1, 0xa5, 0x46, 0xc2, 0x61, 0x05, 0x9e, 0xe5, 0x8d, 0x0b, 0x9a, 0xd9, 0xd4, 0xcb,
-	0x5a, 0x38, 0x85, 0xd3, 0x00, 0x96, 0x1b, 0xd3, 0x61, 0x70, 0x10, 0x57, 0x72, 0xaa, 0xd8, 0x44,
-	0x2d, 0x22, 0xea, 0x8c, 0x04, 0xff, 0x22, 0xa4, 0xbb, 0x84, 0x5b, 0x62, 0xb5, 0x9a, 0x6e, 0x59,
-	0x3a, 0x33, 0x06, 0xd6, 0xfe, 0x21, 0x64, 0x42, 0x4b, 0x20, 0xe6, 0x7b, 0x00, 0xe5, 0xf6, 0x2a,
-	0xc2, 0x9e, 0x0e, 0x84, 0xbd, 0x58, 0x2e, 0x37, 0x6a, 0x8d, 0xaa, 0x62, 0x6b, 0x6a, 0xa7, 0x8e,
-	0x1f, 0xb9, 0xaf, 0x90, 0xa8, 0xc1, 0xc5, 0xf6, 0xd4, 0xa2, 0x70, 0xaf, 0x33, 0x5b, 0xa9, 0x2e,
-	0x6b, 0x55, 0xad, 0xe2, 0x40, 0x29, 0xac, 0x29, 0xa6, 0x16, 0x3b, 0xfd, 0x3d, 0x7b, 0x93, 0xe8,
-	0xdd, 0x9b, 0xcf, 0x09, 0x5c, 0x1a, 0xac, 0x0f, 0xd2, 0x35, 0x20, 0x69, 0xf1, 0x15, 0xfc, 0x76,
-	0x9d, 0xea, 0xfa, 0xd8, 0x78, 0x9f, 0x99, 0x65, 0xad, 0xbc, 0xc4, 0x74, 0x23, 0x7b, 0xcd, 0x21,
-	0xf7, 0xd5, 0xe3, 0xcc, 0xc5, 0x8a, 0x6e, 0xaf, 0x35, 0x4a, 0x52, 0x99, 0xd5, 0xf0, 0xce, 0xc1,
-	0xff, 0x66, 0x2c, 0xf5, 0xbe, 0x6c, 0xaf, 0xd7, 0x35, 0xcb, 0xcb, 0xb1, 0x5c, 0x2d, 0xb0, 0x8b,
-	0xf8, 0x01, 0x81, 0x67, 0xda, 0x00, 0x11, 0x14, 0x97, 0xbf, 0x6b, 0x02, 0x5f, 0x86, 0xa3, 0xaa,
-	0x07, 0xb8, 0xa8, 0xa8, 0xaa, 0xa9, 0x59, 0xee, 0x08, 0x1d, 0xcc, 0xa6, 0x7e, 0xfc, 0x66, 0xe6,
-	0x38, 0x62, 0x5c, 0x74, 0xdf, 0x14, 0x6c, 0xd3, 0x19, 0x9d, 0x23, 0xed, 0x14, 0x5c, 0xf7, 0xab,
-	0x98, 0xe8, 0xf9, 0x86, 0x88, 0x51, 0x20, 0x50, 0x9b, 0xdb, 0xfe, 0xf1, 0x75, 0xc5, 0x09, 0x9a,
-	0x83, 0x65, 0xad, 0xec, 0xd4, 0xc9, 0x4e, 0xa0, 0x38, 0xff, 0xc7, 0x05, 0xab, 0x67, 0x7e, 0x3f,
-	0x22, 0x78, 0x00, 0xbd, 0xe9, 0x1b, 0x35, 0xfd, 0x9e, 0xe3, 0x90, 0x08, 0xf8, 0x14, 0x9d, 0x8b,
-	0x03, 0x34, 0x1a, 0x29, 0x3e, 0x24, 0x70, 0xd6, 0xff, 0x0d, 0x19, 0xb5, 0x12, 0x31, 0xa7, 0xa6,
-	0xd9, 0xfd, 0x65, 0xfc, 0xcf, 0x64, 0x78, 0x07, 0xcf, 0x42, 0xfb, 0x70, 0xf2, 0xa3, 0x3a, 0x12,
-	0x09, 0xc4, 0xef, 0x09, 0xce, 0x7c, 0x48, 0x33, 0x64, 0x58, 0xe8, 0x65, 0x28, 0x85, 0x30, 0xf4,
-	0x24, 0x6a, 0x97, 0x73, 0x2b, 0x05, 0x7d, 0xb9, 0x69, 0x0e, 0xf6, 0xdb, 0x4e, 0xb3, 0x54, 0x62,
-	0x78, 0xd1, 0xdc, 0x0a, 0x62, 0x0d, 0x07, 0xa7, 0xdd, 0xf6, 0x0d, 0xdd, 0x5e, 0x53, 0x4d, 0xa5,
-	0x85, 0x3c, 0xff, 0x65, 0xd5, 0xaa, 0x38, 0x19, 0xe1, 0xed, 0x50, 0xb7, 0x25, 0x38, 0xd2, 0xc2,
-	0x57, 0x03, 0xb7, 0x7b, 0xaa, 0xd5, 0x5d, 0x6c, 0xee, 0xd3, 0x09, 0xd8, 0xcf, 0xdb, 0xd1, 0x77,
-	0x09, 0x24, 0x5d, 0xd3, 0x4b, 0x83, 0xef, 0xcc, 0x7e, 0x87, 0x2d, 0x5c, 0x88, 0x0f, 0x74, 0xc1,
-	0x8a, 0x67, 0xde, 0xff, 0xe9, 0x8f, 0x4f, 0x12, 0xa7, 0xe9, 0x49, 0x39, 0xdc, 0xcc, 0xd3, 0xcf,
-	0x08, 0x1c, 0xf2, 0xbb, 0x5e, 0x3a, 0x13, 0x5e, 0x3f, 0xc0, 0x82, 0x0b, 0xd2, 0xa0, 0xe1, 0x08,
-	0x6a, 0x9a, 0x83, 0x3a, 0x4b, 0xc5, 0x40, 0x50, 0x5d, 0x3e, 0x9b, 0x7e, 0x41, 0x60, 0xdc, 0x57,
-	0x84, 0x5e, 0x1a, 0xa8, 0x97, 0x87, 0x6c, 0x66, 0xc0, 0x68, 0x04, 0x76, 0x85, 0x03, 0x93, 0xe8,
-	0xa5, 0x78, 0x60, 0xf2, 0x06, 0x3a, 0xeb, 0x4d, 0xfa, 0x03, 0x81, 0xa7, 0x83, 0x2d, 0x2a, 0xbd,
-	0x1a, 0xb1, 0x51, 0x51, 0x9e, 0x58, 0xb8, 0xb6, 0xfb, 0x44, 0xe4, 0xb0, 0xc0, 0x39, 0xdc, 0xa0,
-	0xd7, 0x82, 0x77, 0xdc, 0x39, 0x66, 0xf2, 0x06, 0xde, 0x95, 0x9b, 0x32, 0xeb, 0x14, 0x2a, 0x7a,
-	0x67, 0xf8, 0x09, 0x01, 0x21, 0xdc, 0xb2, 0xd2, 0xf9, 0x70, 0x68, 0xb1, 0x9e, 0x59, 0x78, 0x61,
-	0xb8, 0x64, 0xe4, 0xf6, 0x2a, 0xe7, 0x96, 0xa5, 0x0b, 0x81, 0xdc, 0xbc, 0xeb, 0xce, 0x92, 0x37,
-	0x7c, 0x97, 0x61, 0x30, 0xc7, 0x5f, 0x08, 0x4c, 0x86, 0xba, 0x5a, 0x7a, 0x23, 0x1c, 0x65, 0x9c,
-	0xa5, 0x16, 0xe6, 0x87, 0xca, 0x45, 0x82, 0x2b, 0x9c, 0xe0, 0x02, 0x7d, 0x31, 0x90, 0x20, 0x5e,
-	0x63, 0x96, 0xbc, 0xd1, 0xb9, 0xe2, 0x82, 0xe9, 0x7d, 0x47, 0x80, 0xf6, 0x3b, 0x5f, 0x7a, 0x39,
-	0x5e, 0xfd, 0x3e, 0xab, 0x2d, 0x5c, 0xd9, 0x5d, 0x12, 0x32, 0x79, 0x89, 0x33, 0xb9, 0x4e, 0xaf,
-	0xee, 0x6a, 0xab, 0x3a, 0x36, 0x9a, 0xbe, 0x97, 0x80, 0x4c, 0x8c, 0xb5, 0xa5, 0x0b, 0xd1, 0xa7,
-	0x24, 0xde, 0x7d, 0x0b, 0x8b, 0x7b, 0xa8, 0x80, 0x4c, 0xdf, 0xe4, 0x4c, 0x0b, 0xf4, 0xee, 0x40,
-	0x07, 0x2e, 0x78, 0x0f, 0xf9, 0x8d, 0x57, 0xec, 0x5c, 0x63, 0xae, 0x85, 0xa6, 0xbf, 0x12, 0x98,
-	0x08, 0x34, 0xae, 0xf4, 0xf9, 0x68, 0xdc, 0x61, 0x2e, 0x4b, 0xb8, 0xba, 0xeb, 0x3c, 0x64, 0x59,
-	0xe0, 0x2c, 0x6f, 0xd3, 0xd7, 0x02, 0x59, 0xb6, 0x91, 0x5b, 0xf2, 0x46, 0xdf, 0x65, 0xbc, 0xd9,
-	0x8e, 0xec, 0x11, 0x83, 0xfe, 0x4d, 0x60, 0x32, 0xd4, 0x91, 0x46, 0x9d, 0xc2, 0x38, 0x5f, 0x2d,
-	0xcc, 0x0f, 0x95, 0x8b, 0x5c, 0xdf, 0xe6, 0x5c, 0xef, 0xd1, 0xc2, 0x5e, 0xb8, 0x86, 0x8c, 0x38,
-	0xfd, 0x8b, 0x40, 0x2a, 0xcc, 0x7d, 0xd2, 0xeb, 0xb1, 0x1f, 0x8f, 0x50, 0xc6, 0x37, 0x86, 0x49,
-	0x1d, 0x68, 0x84, 0x07, 0x24, 0x1c, 0x38, 0xd9, 0xce, 0xe5, 0x38, 0x11, 0xe8, 0x43, 0xa3, 0x46,
-	0x38, 0xca, 0x25, 0x47, 0x8d, 0x70, 0xa4, 0xe1, 0x15, 0x97, 0x38, 0xcb, 0x9b, 0x74, 0x7e, 0x0f,
-	0x2c, 0xe9, 0x63, 0x02, 0xa9, 0x30, 0x8b, 0x18, 0xb5, 0x7d, 0x31, 0x2e, 0x36, 0x6a, 0xfb, 0xe2,
-	0x1c, 0xa9, 0x78, 0x8b, 0x13, 0x5b, 0xa1, 0xcb, 0xc3, 0x10, 0xeb, 0xf5, 0xb2, 0xd9, 0xc2, 0xd6,
-	0x93, 0xf4, 0xd8, 0xd6, 0x76, 0x9a, 0x3c, 0xda, 0x4e, 0x93, 0xdf, 0xb7, 0xd3, 0xe4, 0xe3, 0x9d,
-	0xf4, 0xd8, 0xa3, 0x9d, 0xf4, 0xd8, 0xcf, 0x3b, 0xe9, 0xb1, 0xb7, 0x9e, 0xf3, 0xfd, 0x1e, 0xe0,
-	0x75, 0x9b, 0xa9, 0x2a, 0x25, 0xab, 0xd3, 0xbb, 0x39, 0x3b, 0x27, 0x3f, 0x6c, 0x23, 0xe0, 0x3f,
-	0x11, 0x94, 0x92, 0xfc, 0x07, 0xe3, 0xcb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x76, 0x66,
-	0xe2, 0x5a, 0x17, 0x00, 0x00,
+func (m *QueryOperatorDelegationRewardsRequest) Reset()         { *m = QueryOperatorDelegationRewardsRequest{} }
+func (m *QueryOperatorDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationRewardsRequest) ProtoMessage()    {}
+func (*QueryOperatorDelegationRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{30}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the parameters of the
-	// module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// RewardsPlans queries all rewards plans.
-	RewardsPlans(ctx context.Context, in *QueryRewardsPlansRequest, opts ...grpc.CallOption) (*QueryRewardsPlansResponse, error)
-	// RewardsPlan queries a specific rewards plan by its ID.
-	RewardsPlan(ctx context.Context, in *QueryRewardsPlanRequest, opts ...grpc.CallOption) (*QueryRewardsPlanResponse, error)
-	// PoolOutstandingRewards queries rewards of a pool.
-	PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error)
-	// OperatorOutstandingRewards queries rewards of an operator.
-	OperatorOutstandingRewards(ctx context.Context, in *QueryOperatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorOutstandingRewardsResponse, error)
-	// ServiceOutstandingRewards queries rewards of a service.
-	ServiceOutstandingRewards(ctx context.Context, in *QueryServiceOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryServiceOutstandingRewardsResponse, error)
-	// OperatorCommission queries accumulated commission for an operator.
-	OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error)
-	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
-	// pool-service pair.
-	PoolServiceTotalDelegatorShares(ctx context.Context, in *QueryPoolServiceTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
-	// PoolDelegationRewards queries the total rewards accrued by a pool
-	// delegation.
-	PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error)
-	// OperatorDelegationRewards queries the total rewards accrued by a operator
-	// delegation.
-	OperatorDelegationRewards(ctx context.Context, in *QueryOperatorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationRewardsResponse, error)
-	// ServiceDelegationRewards queries the total rewards accrued by a service
-	// delegation.
-	ServiceDelegationRewards(ctx context.Context, in *QueryServiceDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationRewardsResponse, error)
-	// DelegatorTotalRewards queries the total rewards accrued by a single
-	// delegator
-	DelegatorTotalRewards(ctx context.Context, in *QueryDelegatorTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalRewardsResponse, error)
-	// DelegatorWithdrawAddress queries withdraw address of a delegator.
-	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+func (m *QueryOperatorDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryOperatorDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorDelegationRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryOperatorDelegationRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationRewardsRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) RewardsPlans(ctx context.Context, in *QueryRewardsPlansRequest, opts ...grpc.CallOption) (*QueryRewardsPlansResponse, error) {
-	out := new(QueryRewardsPlansResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/RewardsPlans", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationRewardsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RewardsPlan(ctx context.Context, in *QueryRewardsPlanRequest, opts ...grpc.CallOption) (*QueryRewardsPlanResponse, error) {
-	out := new(QueryRewardsPlanResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/RewardsPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryOperatorDelegationRewardsRequest proto.InternalMessageInfo
+
+// QueryOperatorDelegationRewardsResponse is the response type for the
+// Query/OperatorDelegationRewards RPC method.
+type QueryOperatorDelegationRewardsResponse struct {
+	// rewards defines the rewards accrued by a delegation.
+	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
 }
 
-func (c *queryClient) PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error) {
-	out := new(QueryPoolOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) Reset() {
+	*m = QueryOperatorDelegationRewardsResponse{}
 }
-
-func (c *queryClient) OperatorOutstandingRewards(ctx context.Context, in *QueryOperatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorOutstandingRewardsResponse, error) {
-	out := new(QueryOperatorOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegationRewardsResponse) ProtoMessage()    {}
+func (*QueryOperatorDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{31}
 }
-
-func (c *queryClient) ServiceOutstandingRewards(ctx context.Context, in *QueryServiceOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryServiceOutstandingRewardsResponse, error) {
-	out := new(QueryServiceOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ServiceOutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error) {
-	out := new(QueryOperatorCommissionResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorCommission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOperatorDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorDelegationRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) PoolServiceTotalDelegatorShares(ctx context.Context, in *QueryPoolServiceTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryPoolServiceTotalDelegatorSharesResponse, error) {
-	out := new(QueryPoolServiceTotalDelegatorSharesResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolServiceTotalDelegatorShares", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegationRewardsResponse.Merge(m, src)
 }
-
-func (c *queryClient) PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error) {
-	out := new(QueryPoolDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) OperatorDelegationRewards(ctx context.Context, in *QueryOperatorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationRewardsResponse, error) {
-	out := new(QueryOperatorDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOperatorDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegationRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ServiceDelegationRewards(ctx context.Context, in *QueryServiceDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationRewardsResponse, error) {
-	out := new(QueryServiceDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ServiceDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryOperatorDelegationRewardsResponse proto.InternalMessageInfo
 
-func (c *queryClient) DelegatorTotalRewards(ctx context.Context, in *QueryDelegatorTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalRewardsResponse, error) {
-	out := new(QueryDelegatorTotalRewardsResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/DelegatorTotalRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryServiceDelegationRewardsRequest is the request type for the
+// Query/ServiceDelegationRewards RPC method.
+type QueryServiceDelegationRewardsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// service_id defines the service ID to query for.
+	ServiceId uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 }
 
-func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
-	out := new(QueryDelegatorWithdrawAddressResponse)
-	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/DelegatorWithdrawAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryServiceDelegationRewardsRequest) Reset()         { *m = QueryServiceDelegationRewardsRequest{} }
+func (m *QueryServiceDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationRewardsRequest) ProtoMessage()    {}
+func (*QueryServiceDelegationRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{32}
+}
+func (m *QueryServiceDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceDelegationRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the parameters of the
-	// module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// RewardsPlans queries all rewards plans.
-	RewardsPlans(context.Context, *QueryRewardsPlansRequest) (*QueryRewardsPlansResponse, error)
-	// RewardsPlan queries a specific rewards plan by its ID.
-	RewardsPlan(context.Context, *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error)
-	// PoolOutstandingRewards queries rewards of a pool.
-	PoolOutstandingRewards(context.Context, *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error)
-	// OperatorOutstandingRewards queries rewards of an operator.
-	OperatorOutstandingRewards(context.Context, *QueryOperatorOutstandingRewardsRequest) (*QueryOperatorOutstandingRewardsResponse, error)
-	// ServiceOutstandingRewards queries rewards of a service.
-	ServiceOutstandingRewards(context.Context, *QueryServiceOutstandingRewardsRequest) (*QueryServiceOutstandingRewardsResponse, error)
-	// OperatorCommission queries accumulated commission for an operator.
-	OperatorCommission(context.Context, *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error)
-	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
-	// pool-service pair.
-	PoolServiceTotalDelegatorShares(context.Context, *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
-	// PoolDelegationRewards queries the total rewards accrued by a pool
-	// delegation.
-	PoolDelegationRewards(context.Context, *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error)
-	// OperatorDelegationRewards queries the total rewards accrued by a operator
-	// delegation.
-	OperatorDelegationRewards(context.Context, *QueryOperatorDelegationRewardsRequest) (*QueryOperatorDelegationRewardsResponse, error)
-	// ServiceDelegationRewards queries the total rewards accrued by a service
-	// delegation.
-	ServiceDelegationRewards(context.Context, *QueryServiceDelegationRewardsRequest) (*QueryServiceDelegationRewardsResponse, error)
-	// DelegatorTotalRewards queries the total rewards accrued by a single
-	// delegator
-	DelegatorTotalRewards(context.Context, *QueryDelegatorTotalRewardsRequest) (*QueryDelegatorTotalRewardsResponse, error)
-	// DelegatorWithdrawAddress queries withdraw address of a delegator.
-	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+func (m *QueryServiceDelegationRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationRewardsRequest.Merge(m, src)
+}
+func (m *QueryServiceDelegationRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceDelegationRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationRewardsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryServiceDelegationRewardsRequest proto.InternalMessageInfo
+
+// QueryServiceDelegationRewardsResponse is the response type for the
+// Query/ServiceDelegationRewards RPC method.
+type QueryServiceDelegationRewardsResponse struct {
+	// rewards defines the rewards accrued by a delegation.
+	Rewards DecPools `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=DecPools" json:"rewards"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryServiceDelegationRewardsResponse) Reset()         { *m = QueryServiceDelegationRewardsResponse{} }
+func (m *QueryServiceDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceDelegationRewardsResponse) ProtoMessage()    {}
+func (*QueryServiceDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{33}
 }
-func (*UnimplementedQueryServer) RewardsPlans(ctx context.Context, req *QueryRewardsPlansRequest) (*QueryRewardsPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsPlans not implemented")
+func (m *QueryServiceDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RewardsPlan(ctx context.Context, req *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsPlan not implemented")
+func (m *QueryServiceDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceDelegationRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolOutstandingRewards(ctx context.Context, req *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolOutstandingRewards not implemented")
+func (m *QueryServiceDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceDelegationRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OperatorOutstandingRewards(ctx context.Context, req *QueryOperatorOutstandingRewardsRequest) (*QueryOperatorOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorOutstandingRewards not implemented")
+func (m *QueryServiceDelegationRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ServiceOutstandingRewards(ctx context.Context, req *QueryServiceOutstandingRewardsRequest) (*QueryServiceOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceOutstandingRewards not implemented")
+func (m *QueryServiceDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceDelegationRewardsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) OperatorCommission(ctx context.Context, req *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorCommission not implemented")
+
+var xxx_messageInfo_QueryServiceDelegationRewardsResponse proto.InternalMessageInfo
+
+// QueryDelegatorTotalRewardsRequest is the request type for the
+// Query/DelegatorTotalRewards RPC method.
+type QueryDelegatorTotalRewardsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
-func (*UnimplementedQueryServer) PoolServiceTotalDelegatorShares(ctx context.Context, req *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolServiceTotalDelegatorShares not implemented")
+
+func (m *QueryDelegatorTotalRewardsRequest) Reset()         { *m = QueryDelegatorTotalRewardsRequest{} }
+func (m *QueryDelegatorTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorTotalRewardsRequest) ProtoMessage()    {}
+func (*QueryDelegatorTotalRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{34}
 }
-func (*UnimplementedQueryServer) PoolDelegationRewards(ctx context.Context, req *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegationRewards not implemented")
+func (m *QueryDelegatorTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OperatorDelegationRewards(ctx context.Context, req *QueryOperatorDelegationRewardsRequest) (*QueryOperatorDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegationRewards not implemented")
+func (m *QueryDelegatorTotalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorTotalRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ServiceDelegationRewards(ctx context.Context, req *QueryServiceDelegationRewardsRequest) (*QueryServiceDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegationRewards not implemented")
+func (m *QueryDelegatorTotalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorTotalRewardsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegatorTotalRewards(ctx context.Context, req *QueryDelegatorTotalRewardsRequest) (*QueryDelegatorTotalRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorTotalRewards not implemented")
+func (m *QueryDelegatorTotalRewardsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
+func (m *QueryDelegatorTotalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorTotalRewardsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDelegatorTotalRewardsRequest proto.InternalMessageInfo
+
+// QueryDelegatorTotalRewardsResponse is the response type for the
+// Query/DelegatorTotalRewards RPC method.
+type QueryDelegatorTotalRewardsResponse struct {
+	// rewards defines all the rewards accrued by a delegator.
+	Rewards []DelegationDelegatorReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of all the rewards.
+	Total DecPools `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=DecPools" json:"total"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDelegatorTotalRewardsResponse) Reset()         { *m = QueryDelegatorTotalRewardsResponse{} }
+func (m *QueryDelegatorTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorTotalRewardsResponse) ProtoMessage()    {}
+func (*QueryDelegatorTotalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{35}
+}
+func (m *QueryDelegatorTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorTotalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorTotalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorTotalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorTotalRewardsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorTotalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorTotalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorTotalRewardsResponse.DiscardUnknown(m)
 }
 
-func _Query_RewardsPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsPlans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/RewardsPlans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsPlans(ctx, req.(*QueryRewardsPlansRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDelegatorTotalRewardsResponse proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawAddressRequest is the request type for the
+// Query/DelegatorWithdrawAddress RPC method.
+type QueryDelegatorWithdrawAddressRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func _Query_RewardsPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/RewardsPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsPlan(ctx, req.(*QueryRewardsPlanRequest))
+func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDelegatorWithdrawAddressRequest{} }
+func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{36}
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_PoolOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolOutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/PoolOutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolOutstandingRewards(ctx, req.(*QueryPoolOutstandingRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDelegatorWithdrawAddressRequest proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawAddressResponse is the response type for the
+// Query/DelegatorWithdrawAddress RPC method.
+type QueryDelegatorWithdrawAddressResponse struct {
+	// withdraw_address defines the delegator address to query for.
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func _Query_OperatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorOutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/OperatorOutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorOutstandingRewards(ctx, req.(*QueryOperatorOutstandingRewardsRequest))
+func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDelegatorWithdrawAddressResponse{} }
+func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{37}
+}
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func _Query_ServiceOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceOutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/ServiceOutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceOutstandingRewards(ctx, req.(*QueryServiceOutstandingRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundSettingsRequest is the request type for the
+// Query/DelegatorAutoCompoundSettings RPC method.
+type QueryDelegatorAutoCompoundSettingsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorCommissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorCommission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/OperatorCommission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorCommission(ctx, req.(*QueryOperatorCommissionRequest))
+func (m *QueryDelegatorAutoCompoundSettingsRequest) Reset() {
+	*m = QueryDelegatorAutoCompoundSettingsRequest{}
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundSettingsRequest) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{38}
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundSettingsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundSettingsRequest.DiscardUnknown(m)
 }
 
-func _Query_PoolServiceTotalDelegatorShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolServiceTotalDelegatorSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolServiceTotalDelegatorShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/PoolServiceTotalDelegatorShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolServiceTotalDelegatorShares(ctx, req.(*QueryPoolServiceTotalDelegatorSharesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDelegatorAutoCompoundSettingsRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundSettingsResponse is the response type for the
+// Query/DelegatorAutoCompoundSettings RPC method.
+type QueryDelegatorAutoCompoundSettingsResponse struct {
+	AutoCompoundSettings []AutoCompoundSetting `protobuf:"bytes,1,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PoolDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/PoolDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolDelegationRewards(ctx, req.(*QueryPoolDelegationRewardsRequest))
+func (m *QueryDelegatorAutoCompoundSettingsResponse) Reset() {
+	*m = QueryDelegatorAutoCompoundSettingsResponse{}
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundSettingsResponse) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f900c2389df7beb1, []int{39}
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundSettingsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundSettingsResponse.DiscardUnknown(m)
 }
 
-func _Query_OperatorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorDelegationRewardsRequest)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_QueryDelegatorAutoCompoundSettingsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.rewards.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.rewards.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardsPlansRequest)(nil), "milkyway.rewards.v1.QueryRewardsPlansRequest")
+	proto.RegisterType((*QueryRewardsPlansResponse)(nil), "milkyway.rewards.v1.QueryRewardsPlansResponse")
+	proto.RegisterType((*QueryRewardsPlanRequest)(nil), "milkyway.rewards.v1.QueryRewardsPlanRequest")
+	proto.RegisterType((*QueryRewardsPlanResponse)(nil), "milkyway.rewards.v1.QueryRewardsPlanResponse")
+	proto.RegisterType((*QueryArchivedRewardsPlansRequest)(nil), "milkyway.rewards.v1.QueryArchivedRewardsPlansRequest")
+	proto.RegisterType((*QueryArchivedRewardsPlansResponse)(nil), "milkyway.rewards.v1.QueryArchivedRewardsPlansResponse")
+	proto.RegisterType((*QueryArchivedRewardsPlanRequest)(nil), "milkyway.rewards.v1.QueryArchivedRewardsPlanRequest")
+	proto.RegisterType((*QueryArchivedRewardsPlanResponse)(nil), "milkyway.rewards.v1.QueryArchivedRewardsPlanResponse")
+	proto.RegisterType((*QueryPoolOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryPoolOutstandingRewardsRequest")
+	proto.RegisterType((*QueryPoolOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryPoolOutstandingRewardsResponse")
+	proto.RegisterType((*QueryOperatorOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryOperatorOutstandingRewardsRequest")
+	proto.RegisterType((*QueryOperatorOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryOperatorOutstandingRewardsResponse")
+	proto.RegisterType((*QueryServiceOutstandingRewardsRequest)(nil), "milkyway.rewards.v1.QueryServiceOutstandingRewardsRequest")
+	proto.RegisterType((*QueryServiceOutstandingRewardsResponse)(nil), "milkyway.rewards.v1.QueryServiceOutstandingRewardsResponse")
+	proto.RegisterType((*QueryOperatorCommissionRequest)(nil), "milkyway.rewards.v1.QueryOperatorCommissionRequest")
+	proto.RegisterType((*QueryOperatorCommissionResponse)(nil), "milkyway.rewards.v1.QueryOperatorCommissionResponse")
+	proto.RegisterType((*QueryPoolServiceTotalDelegatorSharesRequest)(nil), "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesRequest")
+	proto.RegisterType((*QueryPoolServiceTotalDelegatorSharesResponse)(nil), "milkyway.rewards.v1.QueryPoolServiceTotalDelegatorSharesResponse")
+	proto.RegisterType((*QueryPoolAPRRequest)(nil), "milkyway.rewards.v1.QueryPoolAPRRequest")
+	proto.RegisterType((*QueryPoolAPRResponse)(nil), "milkyway.rewards.v1.QueryPoolAPRResponse")
+	proto.RegisterType((*QueryOperatorAPRRequest)(nil), "milkyway.rewards.v1.QueryOperatorAPRRequest")
+	proto.RegisterType((*QueryOperatorAPRResponse)(nil), "milkyway.rewards.v1.QueryOperatorAPRResponse")
+	proto.RegisterType((*QueryServiceAPRRequest)(nil), "milkyway.rewards.v1.QueryServiceAPRRequest")
+	proto.RegisterType((*QueryServiceAPRResponse)(nil), "milkyway.rewards.v1.QueryServiceAPRResponse")
+	proto.RegisterType((*QueryPoolServiceAPRRequest)(nil), "milkyway.rewards.v1.QueryPoolServiceAPRRequest")
+	proto.RegisterType((*QueryPoolServiceAPRResponse)(nil), "milkyway.rewards.v1.QueryPoolServiceAPRResponse")
+	proto.RegisterType((*QueryPoolDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryPoolDelegationRewardsRequest")
+	proto.RegisterType((*QueryPoolDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryPoolDelegationRewardsResponse")
+	proto.RegisterType((*QueryOperatorDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryOperatorDelegationRewardsRequest")
+	proto.RegisterType((*QueryOperatorDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryOperatorDelegationRewardsResponse")
+	proto.RegisterType((*QueryServiceDelegationRewardsRequest)(nil), "milkyway.rewards.v1.QueryServiceDelegationRewardsRequest")
+	proto.RegisterType((*QueryServiceDelegationRewardsResponse)(nil), "milkyway.rewards.v1.QueryServiceDelegationRewardsResponse")
+	proto.RegisterType((*QueryDelegatorTotalRewardsRequest)(nil), "milkyway.rewards.v1.QueryDelegatorTotalRewardsRequest")
+	proto.RegisterType((*QueryDelegatorTotalRewardsResponse)(nil), "milkyway.rewards.v1.QueryDelegatorTotalRewardsResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "milkyway.rewards.v1.QueryDelegatorWithdrawAddressRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "milkyway.rewards.v1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundSettingsRequest)(nil), "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundSettingsResponse)(nil), "milkyway.rewards.v1.QueryDelegatorAutoCompoundSettingsResponse")
+}
+
+func init() { proto.RegisterFile("milkyway/rewards/v1/query.proto", fileDescriptor_f900c2389df7beb1) }
+
+var fileDescriptor_f900c2389df7beb1 = []byte{
+	// 1880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xc8, 0xb1, 0x5c, 0x3f, 0x39, 0x6d, 0x32, 0x96, 0x6d, 0x79, 0x15, 0x93, 0xea, 0x26,
+	0x92, 0x15, 0x49, 0xdc, 0x95, 0x64, 0xcb, 0x92, 0xa5, 0x2a, 0x11, 0x25, 0x55, 0xad, 0x5a, 0x05,
+	0x66, 0xc8, 0x04, 0x45, 0xdb, 0x03, 0xb1, 0xe2, 0x2e, 0xa8, 0x8d, 0x49, 0x0e, 0xc3, 0x5d, 0x52,
+	0x11, 0x04, 0x03, 0xfd, 0x00, 0x7a, 0x2a, 0xd0, 0x00, 0x3d, 0xf5, 0xd2, 0xf6, 0x50, 0x14, 0xad,
+	0x81, 0x02, 0x3d, 0x04, 0x3d, 0xa4, 0x97, 0x16, 0xe8, 0xc1, 0x97, 0x02, 0x41, 0x7a, 0x68, 0x51,
+	0xb4, 0x4e, 0x22, 0x17, 0xe8, 0xa5, 0x97, 0x02, 0xfd, 0x03, 0x8a, 0x9d, 0x7d, 0x4b, 0x2e, 0xc9,
+	0xd9, 0x0f, 0xd2, 0x22, 0xe0, 0x8b, 0xed, 0x9d, 0x7d, 0x1f, 0xbf, 0xdf, 0x9b, 0x79, 0xb3, 0x33,
+	0x3f, 0x1a, 0x92, 0x65, 0xb3, 0x74, 0xff, 0xf8, 0x48, 0x3b, 0x56, 0x6b, 0xc6, 0x91, 0x56, 0xd3,
+	0x2d, 0xb5, 0xb1, 0xa8, 0xbe, 0x5b, 0x37, 0x6a, 0xc7, 0x4a, 0xb5, 0xc6, 0x6c, 0x46, 0x2f, 0x7b,
+	0x06, 0x0a, 0x1a, 0x28, 0x8d, 0x45, 0xe9, 0x45, 0xad, 0x6c, 0x56, 0x98, 0xca, 0xff, 0x74, 0xed,
+	0xa4, 0xd9, 0x02, 0xb3, 0xca, 0xcc, 0x52, 0x0f, 0x34, 0xcb, 0x70, 0x03, 0xa8, 0x8d, 0xc5, 0x03,
+	0xc3, 0xd6, 0x16, 0xd5, 0xaa, 0x56, 0x34, 0x2b, 0x9a, 0x6d, 0xb2, 0x0a, 0xda, 0x26, 0xfc, 0xb6,
+	0x9e, 0x55, 0x81, 0x99, 0xde, 0xfb, 0xeb, 0xee, 0xfb, 0x3c, 0x7f, 0x52, 0xdd, 0x07, 0x7c, 0x35,
+	0x56, 0x64, 0x45, 0xe6, 0x8e, 0x3b, 0xff, 0xc2, 0xd1, 0x97, 0x8a, 0x8c, 0x15, 0x4b, 0x86, 0xaa,
+	0x55, 0x4d, 0x55, 0xab, 0x54, 0x98, 0xcd, 0xb3, 0x79, 0x3e, 0x93, 0x22, 0x8e, 0x65, 0xa6, 0x1b,
+	0xa5, 0x50, 0x8b, 0xaa, 0x56, 0xd3, 0xca, 0x68, 0x21, 0x8f, 0x01, 0x7d, 0xd3, 0x21, 0x95, 0xe1,
+	0x83, 0x59, 0xe3, 0xdd, 0xba, 0x61, 0xd9, 0x72, 0x06, 0x2e, 0xb7, 0x8d, 0x5a, 0x55, 0x56, 0xb1,
+	0x0c, 0x7a, 0x17, 0x46, 0x5c, 0xe7, 0x71, 0x32, 0x49, 0x66, 0x46, 0x97, 0x26, 0x14, 0x41, 0x11,
+	0x15, 0xd7, 0x69, 0xeb, 0xb9, 0x47, 0x8f, 0x93, 0x43, 0x59, 0x74, 0x90, 0x0f, 0x60, 0x9c, 0x47,
+	0xcc, 0xba, 0x76, 0x99, 0x92, 0x56, 0xf1, 0xb2, 0xd1, 0x5d, 0x80, 0x56, 0x29, 0x31, 0xf4, 0xb4,
+	0x82, 0xe5, 0x71, 0x6a, 0xa9, 0xb8, 0x13, 0x87, 0x15, 0x55, 0x32, 0x5a, 0xd1, 0x40, 0xdf, 0xac,
+	0xcf, 0x53, 0xfe, 0x1d, 0x81, 0xeb, 0x82, 0x24, 0x08, 0x3e, 0x03, 0xcf, 0x23, 0xc8, 0x7c, 0xd5,
+	0x79, 0x31, 0x4e, 0x26, 0xcf, 0xcd, 0x8c, 0x2e, 0x4d, 0x0a, 0x39, 0xf8, 0x22, 0x6c, 0x5d, 0x74,
+	0x88, 0xfc, 0xea, 0xdf, 0xbf, 0x9d, 0x25, 0xd9, 0x4b, 0x35, 0x5f, 0x64, 0xfa, 0x95, 0x36, 0xdc,
+	0xc3, 0x1c, 0xf7, 0xcd, 0x48, 0xdc, 0x2e, 0x9c, 0x36, 0xe0, 0x4b, 0x70, 0xad, 0x13, 0xb7, 0x57,
+	0x9b, 0x6b, 0x70, 0xc1, 0x41, 0x9b, 0x37, 0x75, 0x5e, 0x98, 0xe7, 0xb2, 0x23, 0xce, 0xe3, 0x9e,
+	0x2e, 0x1b, 0xdd, 0x05, 0x6d, 0x52, 0xdd, 0x83, 0x4b, 0x7e, 0xaa, 0x08, 0x2d, 0x9a, 0xa9, 0x3b,
+	0x65, 0xa3, 0x3e, 0x92, 0xf2, 0x3b, 0x30, 0xc9, 0xd3, 0xa4, 0x6b, 0x85, 0x43, 0xb3, 0x61, 0xe8,
+	0x83, 0x9c, 0xbf, 0xbf, 0x12, 0xf8, 0x62, 0x48, 0x32, 0x24, 0x67, 0xc2, 0x55, 0x0d, 0xdf, 0xe7,
+	0x45, 0x13, 0x3a, 0x23, 0xa4, 0x29, 0x08, 0xe9, 0x9f, 0xd8, 0x31, 0x4d, 0x90, 0xf2, 0xec, 0x26,
+	0x78, 0x0d, 0x92, 0x41, 0xc4, 0x22, 0x27, 0xfa, 0x07, 0x24, 0x78, 0x0a, 0x9a, 0x45, 0x39, 0x80,
+	0x2b, 0xc2, 0xa2, 0xe0, 0x6c, 0xc4, 0xaf, 0x89, 0xbb, 0x04, 0x2e, 0x0b, 0xca, 0x21, 0x6f, 0x80,
+	0xec, 0x6e, 0x0a, 0x8c, 0x95, 0xee, 0xd5, 0x6d, 0xcb, 0xd6, 0x2a, 0xba, 0x59, 0x29, 0xa2, 0x89,
+	0x9f, 0x07, 0x63, 0x25, 0x8f, 0xc7, 0xf3, 0xd9, 0x11, 0xe7, 0x71, 0x4f, 0x97, 0x2d, 0x78, 0x39,
+	0xd4, 0x1d, 0x99, 0xec, 0xc3, 0x05, 0x84, 0x88, 0xd8, 0x6f, 0x0a, 0xb1, 0x77, 0x47, 0xf0, 0x4f,
+	0xa7, 0x17, 0x42, 0xde, 0x83, 0x69, 0x9e, 0xf4, 0x5e, 0xd5, 0xa8, 0x69, 0x36, 0xab, 0x05, 0xe3,
+	0x4e, 0xc2, 0x28, 0x43, 0xa3, 0x16, 0x76, 0xf0, 0x86, 0xf6, 0x74, 0xf9, 0x08, 0x6e, 0x46, 0x86,
+	0x1a, 0x08, 0x87, 0x5d, 0x98, 0xe2, 0x89, 0x73, 0x46, 0xad, 0x61, 0x16, 0x8c, 0x60, 0x0a, 0x37,
+	0x00, 0x2c, 0xd7, 0xa6, 0xc5, 0xe0, 0x22, 0x8e, 0xec, 0xe9, 0x72, 0x03, 0xa6, 0xa3, 0xe2, 0x0c,
+	0x04, 0x7f, 0x1a, 0x12, 0x6d, 0x85, 0xdb, 0x66, 0xe5, 0xb2, 0x69, 0x59, 0x26, 0xab, 0xc4, 0xae,
+	0xfd, 0x7b, 0x90, 0x0c, 0x0c, 0x81, 0x98, 0xdf, 0x06, 0x28, 0x34, 0x47, 0x11, 0xf6, 0xac, 0x78,
+	0xd9, 0x17, 0x0a, 0xf5, 0x72, 0xbd, 0xa4, 0xd9, 0x86, 0xde, 0x8a, 0xe3, 0x47, 0xee, 0x0b, 0x24,
+	0x1b, 0x30, 0xd7, 0x5c, 0xb5, 0x58, 0xb8, 0xb7, 0x98, 0xad, 0x95, 0x76, 0x8c, 0x92, 0x51, 0x74,
+	0xa0, 0xe4, 0x0e, 0xb5, 0x9a, 0x11, 0xb9, 0xfa, 0x3b, 0xe6, 0x66, 0xb8, 0x73, 0x6e, 0x7e, 0x4a,
+	0x60, 0x3e, 0x5e, 0x1e, 0xa4, 0x5b, 0x81, 0x11, 0x8b, 0x8f, 0xe0, 0xae, 0xf7, 0x52, 0xdb, 0xb6,
+	0xe4, 0x6d, 0x48, 0x3b, 0x46, 0x61, 0x9b, 0x99, 0x95, 0xad, 0x55, 0x87, 0xdc, 0xc3, 0x4f, 0x92,
+	0x73, 0x45, 0xd3, 0x3e, 0xac, 0x1f, 0x28, 0x05, 0x56, 0xc6, 0xe3, 0x07, 0xfe, 0x95, 0xb2, 0xf4,
+	0xfb, 0xaa, 0x7d, 0x5c, 0x35, 0x2c, 0xcf, 0xc7, 0x72, 0x6b, 0x81, 0x59, 0x64, 0xc5, 0x3b, 0x11,
+	0x30, 0x56, 0x4a, 0x67, 0xb2, 0x91, 0xdd, 0xfe, 0x4b, 0x02, 0x63, 0xed, 0x0e, 0x08, 0xfc, 0x1e,
+	0x9c, 0xd3, 0xaa, 0x35, 0x6e, 0x7d, 0x71, 0x6b, 0xc3, 0xc1, 0xf5, 0xf7, 0xc7, 0xc9, 0x09, 0x17,
+	0x85, 0xa5, 0xdf, 0x57, 0x4c, 0xa6, 0x96, 0x35, 0xfb, 0x50, 0xd9, 0x37, 0x8a, 0x5a, 0xe1, 0x78,
+	0xc7, 0x28, 0x9c, 0x3e, 0x4e, 0x9e, 0x4b, 0x67, 0xb2, 0x1f, 0x7f, 0x90, 0x02, 0xa4, 0xb8, 0x63,
+	0x14, 0x5c, 0x70, 0x4e, 0x24, 0xba, 0x01, 0xe7, 0xdd, 0xed, 0x7f, 0x18, 0x0b, 0x21, 0x3c, 0x93,
+	0x94, 0xb4, 0x4a, 0x3a, 0x93, 0xf5, 0xcf, 0xb2, 0xeb, 0x25, 0xaf, 0xc1, 0xb5, 0xb6, 0xa5, 0xe5,
+	0x23, 0x17, 0xb9, 0x2c, 0x1f, 0x12, 0x18, 0xef, 0x76, 0x7e, 0x46, 0x89, 0xae, 0xc0, 0x55, 0x7f,
+	0xfb, 0xfb, 0x78, 0x46, 0xec, 0x1b, 0xbf, 0x26, 0x70, 0xad, 0xcb, 0xf3, 0x19, 0x25, 0xf9, 0x16,
+	0x48, 0x9d, 0x6d, 0x14, 0x63, 0xb5, 0x46, 0x75, 0xe7, 0x6f, 0x08, 0x4c, 0x08, 0xc3, 0x3e, 0xa3,
+	0x55, 0xf8, 0xbe, 0x77, 0x90, 0x72, 0xf0, 0xe2, 0x0e, 0xc2, 0xf7, 0xca, 0xb6, 0xcf, 0xc5, 0x97,
+	0xe1, 0x45, 0xdd, 0xdb, 0x5d, 0xf2, 0x9a, 0xae, 0xd7, 0x0c, 0xcb, 0x42, 0x0e, 0xe3, 0x1f, 0x7f,
+	0x90, 0x1a, 0x43, 0x64, 0x69, 0xf7, 0x4d, 0xce, 0xae, 0x39, 0xfb, 0xfc, 0x0b, 0x4d, 0x17, 0x1c,
+	0xf7, 0x17, 0x75, 0xb8, 0xe3, 0x83, 0x2f, 0x87, 0x81, 0xc0, 0xda, 0xbd, 0xe1, 0xff, 0xd6, 0x04,
+	0x93, 0xdd, 0x31, 0x0a, 0x4e, 0x9c, 0xad, 0x2b, 0xb8, 0x93, 0x7d, 0x0e, 0x07, 0xac, 0x8e, 0x8f,
+	0xcd, 0x8f, 0x08, 0x4c, 0xb5, 0xb5, 0xe4, 0xa0, 0xe9, 0x77, 0x6c, 0x12, 0xc3, 0x82, 0x73, 0xc3,
+	0x74, 0x14, 0xa0, 0xc1, 0x94, 0xe2, 0x87, 0x04, 0x5e, 0xf1, 0xf7, 0xed, 0xa0, 0x2b, 0x11, 0xd1,
+	0x44, 0x0d, 0x98, 0x8a, 0x40, 0x33, 0x98, 0x32, 0xbc, 0x83, 0xbd, 0xd0, 0xfc, 0x92, 0xf2, 0xef,
+	0xea, 0x40, 0x4a, 0x20, 0xff, 0x89, 0x80, 0x1c, 0x96, 0x0c, 0x19, 0xe6, 0x3a, 0x19, 0x2a, 0x01,
+	0x0c, 0xbd, 0x12, 0x35, 0xc3, 0xb9, 0x91, 0x44, 0xc7, 0x2c, 0xba, 0x07, 0xe7, 0x6d, 0x27, 0x59,
+	0xe8, 0x9e, 0x11, 0x51, 0x34, 0x37, 0x82, 0x5c, 0xc6, 0x85, 0xd3, 0x4c, 0xfb, 0x0d, 0xd3, 0x3e,
+	0xd4, 0x6b, 0xda, 0x11, 0xf2, 0x3c, 0xe3, 0xaa, 0x95, 0x60, 0x2a, 0x22, 0x1d, 0xd6, 0x6d, 0x1b,
+	0x5e, 0x38, 0xc2, 0x57, 0xb1, 0xd3, 0x7d, 0xe1, 0xa8, 0x3d, 0x98, 0xfc, 0x21, 0x81, 0x57, 0xdb,
+	0xd3, 0xa5, 0xeb, 0x36, 0xdb, 0x66, 0xe5, 0x2a, 0xab, 0x57, 0xf4, 0x9c, 0x61, 0xdb, 0x66, 0xa5,
+	0x78, 0xd6, 0xbd, 0xb1, 0x2b, 0xb8, 0x49, 0xf6, 0x73, 0x45, 0xfe, 0x94, 0xc0, 0x6c, 0x1c, 0xf0,
+	0xbe, 0xbb, 0x72, 0xdd, 0x66, 0xf9, 0x02, 0x1a, 0xe4, 0x2d, 0xb4, 0x08, 0xbf, 0x2b, 0x77, 0x87,
+	0x6c, 0xbf, 0x2b, 0x0b, 0x52, 0x9e, 0xd9, 0x5d, 0x79, 0xe9, 0x61, 0x12, 0xce, 0x73, 0x8a, 0xf4,
+	0x3b, 0x04, 0x46, 0x5c, 0x31, 0x89, 0x8a, 0x2f, 0x20, 0xdd, 0xca, 0x95, 0x34, 0x13, 0x6d, 0xe8,
+	0xe6, 0x94, 0x5f, 0xfe, 0xde, 0x5f, 0xfe, 0xf5, 0xe3, 0xe1, 0x1b, 0x74, 0x42, 0x0d, 0x16, 0xc9,
+	0xe8, 0x4f, 0x08, 0x5c, 0x6a, 0x93, 0x04, 0x52, 0xc1, 0xf1, 0x05, 0xd2, 0x88, 0xa4, 0xc4, 0x35,
+	0x47, 0x50, 0xb3, 0x1c, 0xd4, 0x2b, 0x54, 0x16, 0x82, 0x6a, 0x93, 0x3b, 0xe8, 0xcf, 0x09, 0x8c,
+	0xfa, 0x82, 0xd0, 0xf9, 0x58, 0xb9, 0x3c, 0x64, 0xa9, 0x98, 0xd6, 0x08, 0xec, 0x36, 0x07, 0xa6,
+	0xd0, 0xf9, 0x68, 0x60, 0xea, 0x09, 0x0a, 0x19, 0x0f, 0xe8, 0x87, 0x04, 0xc6, 0x44, 0x62, 0x0e,
+	0x5d, 0x0e, 0xce, 0x1e, 0xa2, 0x34, 0x49, 0x77, 0x7a, 0x75, 0x43, 0xf4, 0xb7, 0x38, 0xfa, 0x14,
+	0x9d, 0x13, 0xa2, 0x17, 0xcb, 0x49, 0xf4, 0x8f, 0x04, 0x2e, 0x0b, 0xa2, 0xd2, 0xdb, 0x3d, 0x81,
+	0xf0, 0xa0, 0x2f, 0xf7, 0xe8, 0x85, 0xc8, 0x37, 0x38, 0xf2, 0x15, 0xba, 0xdc, 0x03, 0x72, 0xdf,
+	0x04, 0xfc, 0x99, 0xc0, 0x55, 0xb1, 0xe0, 0x42, 0x57, 0x42, 0x3a, 0x25, 0x4c, 0xe1, 0x91, 0x56,
+	0x7b, 0x77, 0x44, 0x32, 0x9b, 0x9c, 0xcc, 0x1a, 0x5d, 0x15, 0xb7, 0x9c, 0xf3, 0x1d, 0x52, 0x4f,
+	0xf0, 0x30, 0xf9, 0x40, 0x65, 0xad, 0x40, 0x1e, 0x3f, 0xfa, 0x19, 0x01, 0x29, 0x58, 0x80, 0xa1,
+	0xeb, 0xc1, 0xd0, 0x22, 0x15, 0x20, 0xe9, 0x4b, 0xfd, 0x39, 0x23, 0xb7, 0xaf, 0x72, 0x6e, 0x5b,
+	0x74, 0x53, 0xc8, 0xcd, 0x3b, 0x0f, 0x5a, 0xea, 0x89, 0xef, 0xb4, 0x28, 0xe6, 0xf8, 0x0f, 0x02,
+	0xd7, 0x03, 0x35, 0x1a, 0xba, 0x16, 0x8c, 0x32, 0x4a, 0x20, 0x92, 0xd6, 0xfb, 0xf2, 0x45, 0x82,
+	0xbb, 0x9c, 0xe0, 0x26, 0x7d, 0x4d, 0x48, 0x10, 0xcf, 0x79, 0x96, 0x7a, 0xd2, 0x3a, 0x03, 0x8a,
+	0xe9, 0xfd, 0x81, 0x00, 0xed, 0xd6, 0x71, 0xe8, 0xad, 0xe8, 0xea, 0x77, 0x09, 0x47, 0xd2, 0xed,
+	0xde, 0x9c, 0x90, 0xc9, 0xeb, 0x9c, 0xc9, 0x5d, 0xba, 0xd2, 0xd3, 0x54, 0xb5, 0x44, 0x21, 0xfa,
+	0xdd, 0x61, 0x48, 0x46, 0x08, 0x35, 0x74, 0x33, 0xbc, 0x4b, 0xa2, 0xb5, 0x24, 0x29, 0xfd, 0x14,
+	0x11, 0x90, 0xe9, 0x37, 0x39, 0xd3, 0x1c, 0x7d, 0x33, 0x56, 0xc3, 0x89, 0xe7, 0x90, 0x1f, 0x09,
+	0xf3, 0xad, 0x43, 0x90, 0x2b, 0x08, 0xd1, 0xf7, 0x09, 0x5c, 0x40, 0x6d, 0x87, 0xce, 0x84, 0x23,
+	0x6d, 0xdd, 0xc0, 0xa5, 0x57, 0x63, 0x58, 0x22, 0xf6, 0x05, 0x8e, 0x7d, 0x96, 0xce, 0xc4, 0xc2,
+	0xee, 0xdc, 0x9a, 0x7f, 0x41, 0x60, 0xd4, 0xa7, 0xc4, 0x84, 0x7d, 0x10, 0xbb, 0xd5, 0x1e, 0x29,
+	0x15, 0xd3, 0x1a, 0xe1, 0xad, 0x72, 0x78, 0x4b, 0x74, 0xa1, 0xa7, 0x45, 0xe4, 0xc0, 0xfc, 0x19,
+	0x01, 0x68, 0x89, 0x08, 0x74, 0x2e, 0xb2, 0x29, 0x7d, 0x20, 0xe7, 0xe3, 0x19, 0x23, 0xc6, 0x3b,
+	0x1c, 0xe3, 0x02, 0x55, 0x7a, 0x68, 0x59, 0x07, 0xe1, 0xef, 0x09, 0x7c, 0xbe, 0x5d, 0xea, 0xa0,
+	0x6a, 0xac, 0xc5, 0xe8, 0x43, 0xba, 0x10, 0xdf, 0x21, 0xd6, 0x06, 0x13, 0x6f, 0xb1, 0x3a, 0xe8,
+	0xff, 0x49, 0xe0, 0x8a, 0x50, 0x73, 0xa0, 0x77, 0xc2, 0x31, 0x05, 0x5d, 0x90, 0xa5, 0x95, 0x9e,
+	0xfd, 0x90, 0x52, 0x8e, 0x53, 0x7a, 0x83, 0x7e, 0x5d, 0x48, 0xa9, 0xd9, 0x53, 0x96, 0x7a, 0xd2,
+	0x75, 0xc9, 0x78, 0xd0, 0xb4, 0xec, 0x60, 0x4e, 0xff, 0x4b, 0xe0, 0x7a, 0xa0, 0x98, 0x10, 0xf6,
+	0x7d, 0x88, 0x92, 0x44, 0xa4, 0xf5, 0xbe, 0x7c, 0x91, 0xeb, 0xb7, 0x39, 0xd7, 0xb7, 0x69, 0xee,
+	0x69, 0xb8, 0x06, 0xf4, 0x0d, 0xfd, 0x0f, 0x81, 0xf1, 0x20, 0xe1, 0x80, 0xde, 0x8d, 0x6c, 0x8a,
+	0x40, 0xc6, 0x6b, 0xfd, 0xb8, 0xc6, 0xda, 0x5c, 0x63, 0x12, 0x16, 0x2e, 0x63, 0xe7, 0xd8, 0x76,
+	0x45, 0x28, 0x21, 0x84, 0x2d, 0xe1, 0x30, 0x81, 0x43, 0x5a, 0xe9, 0xd9, 0x0f, 0x59, 0x6e, 0x73,
+	0x96, 0x1b, 0x74, 0xfd, 0x29, 0x58, 0xd2, 0x4f, 0x08, 0x8c, 0x07, 0xdd, 0xee, 0xc3, 0xa6, 0x2f,
+	0x42, 0x80, 0x90, 0xd6, 0xfa, 0x71, 0x45, 0x62, 0xfb, 0x9c, 0xd8, 0x2e, 0xdd, 0xe9, 0x87, 0x58,
+	0xa7, 0x0c, 0x41, 0xff, 0x47, 0xe0, 0x46, 0xe8, 0x9d, 0x9c, 0xbe, 0x16, 0x03, 0x6b, 0x88, 0x12,
+	0x21, 0xbd, 0xde, 0xb7, 0x3f, 0x12, 0xce, 0x72, 0xc2, 0xfb, 0xf4, 0x6b, 0xfd, 0x10, 0x16, 0xcb,
+	0x08, 0x5b, 0xb9, 0x47, 0x9f, 0x25, 0x86, 0x1e, 0x9d, 0x26, 0xc8, 0x47, 0xa7, 0x09, 0xf2, 0xe9,
+	0x69, 0x82, 0xbc, 0xff, 0x24, 0x31, 0xf4, 0xd1, 0x93, 0xc4, 0xd0, 0xdf, 0x9e, 0x24, 0x86, 0xbe,
+	0xb5, 0xec, 0xfb, 0xb9, 0xc9, 0xcb, 0x99, 0x2a, 0x69, 0x07, 0x56, 0x0b, 0x41, 0x63, 0x71, 0x49,
+	0x7d, 0xaf, 0x89, 0x83, 0xff, 0x02, 0x75, 0x30, 0xc2, 0xff, 0x6b, 0xca, 0xad, 0xff, 0x0f, 0x00,
+	0xf7, 0x1b, 0x1d, 0x9c, 0xc4, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the parameters of the
+	// module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RewardsPlans queries all rewards plans.
+	RewardsPlans(ctx context.Context, in *QueryRewardsPlansRequest, opts ...grpc.CallOption) (*QueryRewardsPlansResponse, error)
+	// RewardsPlan queries a specific rewards plan by its ID.
+	RewardsPlan(ctx context.Context, in *QueryRewardsPlanRequest, opts ...grpc.CallOption) (*QueryRewardsPlanResponse, error)
+	// ArchivedRewardsPlans queries all the rewards plans that have been
+	// terminated.
+	ArchivedRewardsPlans(ctx context.Context, in *QueryArchivedRewardsPlansRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlansResponse, error)
+	// ArchivedRewardsPlan queries a specific terminated rewards plan by its ID.
+	ArchivedRewardsPlan(ctx context.Context, in *QueryArchivedRewardsPlanRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlanResponse, error)
+	// PoolOutstandingRewards queries rewards of a pool.
+	PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error)
+	// OperatorOutstandingRewards queries rewards of an operator.
+	OperatorOutstandingRewards(ctx context.Context, in *QueryOperatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorOutstandingRewardsResponse, error)
+	// ServiceOutstandingRewards queries rewards of a service.
+	ServiceOutstandingRewards(ctx context.Context, in *QueryServiceOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryServiceOutstandingRewardsResponse, error)
+	// OperatorCommission queries accumulated commission for an operator.
+	OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error)
+	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
+	// pool-service pair.
+	PoolServiceTotalDelegatorShares(ctx context.Context, in *QueryPoolServiceTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
+	// PoolAPR queries the projected annualised yield of the delegations to a
+	// pool, derived from the currently active rewards plans.
+	PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error)
+	// OperatorAPR queries the projected annualised yield of the delegations to
+	// an operator, derived from the currently active rewards plans.
+	OperatorAPR(ctx context.Context, in *QueryOperatorAPRRequest, opts ...grpc.CallOption) (*QueryOperatorAPRResponse, error)
+	// ServiceAPR queries the projected annualised yield of the delegations to
+	// a service, derived from the currently active rewards plans.
+	ServiceAPR(ctx context.Context, in *QueryServiceAPRRequest, opts ...grpc.CallOption) (*QueryServiceAPRResponse, error)
+	// PoolServiceAPR queries the projected annualised yield that the
+	// delegations to a pool receive from the rewards plans of a service.
+	PoolServiceAPR(ctx context.Context, in *QueryPoolServiceAPRRequest, opts ...grpc.CallOption) (*QueryPoolServiceAPRResponse, error)
+	// PoolDelegationRewards queries the total rewards accrued by a pool
+	// delegation.
+	PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error)
+	// OperatorDelegationRewards queries the total rewards accrued by a operator
+	// delegation.
+	OperatorDelegationRewards(ctx context.Context, in *QueryOperatorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationRewardsResponse, error)
+	// ServiceDelegationRewards queries the total rewards accrued by a service
+	// delegation.
+	ServiceDelegationRewards(ctx context.Context, in *QueryServiceDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationRewardsResponse, error)
+	// DelegatorTotalRewards queries the total rewards accrued by a single
+	// delegator
+	DelegatorTotalRewards(ctx context.Context, in *QueryDelegatorTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalRewardsResponse, error)
+	// DelegatorWithdrawAddress queries withdraw address of a delegator.
+	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegation targets for which a
+	// delegator has enabled the auto-compounding of the rewards.
+	DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/OperatorDelegationRewards",
+	return out, nil
+}
+
+func (c *queryClient) RewardsPlans(ctx context.Context, in *QueryRewardsPlansRequest, opts ...grpc.CallOption) (*QueryRewardsPlansResponse, error) {
+	out := new(QueryRewardsPlansResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/RewardsPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorDelegationRewards(ctx, req.(*QueryOperatorDelegationRewardsRequest))
+	return out, nil
+}
+
+func (c *queryClient) RewardsPlan(ctx context.Context, in *QueryRewardsPlanRequest, opts ...grpc.CallOption) (*QueryRewardsPlanResponse, error) {
+	out := new(QueryRewardsPlanResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/RewardsPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ServiceDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceDelegationRewardsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ArchivedRewardsPlans(ctx context.Context, in *QueryArchivedRewardsPlansRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlansResponse, error) {
+	out := new(QueryArchivedRewardsPlansResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ArchivedRewardsPlans", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ServiceDelegationRewards(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ArchivedRewardsPlan(ctx context.Context, in *QueryArchivedRewardsPlanRequest, opts ...grpc.CallOption) (*QueryArchivedRewardsPlanResponse, error) {
+	out := new(QueryArchivedRewardsPlanResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ArchivedRewardsPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/ServiceDelegationRewards",
+	return out, nil
+}
+
+func (c *queryClient) PoolOutstandingRewards(ctx context.Context, in *QueryPoolOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryPoolOutstandingRewardsResponse, error) {
+	out := new(QueryPoolOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolOutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ServiceDelegationRewards(ctx, req.(*QueryServiceDelegationRewardsRequest))
+	return out, nil
+}
+
+func (c *queryClient) OperatorOutstandingRewards(ctx context.Context, in *QueryOperatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorOutstandingRewardsResponse, error) {
+	out := new(QueryOperatorOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorOutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DelegatorTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorTotalRewardsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ServiceOutstandingRewards(ctx context.Context, in *QueryServiceOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryServiceOutstandingRewardsResponse, error) {
+	out := new(QueryServiceOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ServiceOutstandingRewards", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorTotalRewards(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error) {
+	out := new(QueryOperatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/DelegatorTotalRewards",
+	return out, nil
+}
+
+func (c *queryClient) PoolServiceTotalDelegatorShares(ctx context.Context, in *QueryPoolServiceTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryPoolServiceTotalDelegatorSharesResponse, error) {
+	out := new(QueryPoolServiceTotalDelegatorSharesResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolServiceTotalDelegatorShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorTotalRewards(ctx, req.(*QueryDelegatorTotalRewardsRequest))
+	return out, nil
+}
+
+func (c *queryClient) PoolAPR(ctx context.Context, in *QueryPoolAPRRequest, opts ...grpc.CallOption) (*QueryPoolAPRResponse, error) {
+	out := new(QueryPoolAPRResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorWithdrawAddressRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OperatorAPR(ctx context.Context, in *QueryOperatorAPRRequest, opts ...grpc.CallOption) (*QueryOperatorAPRResponse, error) {
+	out := new(QueryOperatorAPRResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorAPR", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ServiceAPR(ctx context.Context, in *QueryServiceAPRRequest, opts ...grpc.CallOption) (*QueryServiceAPRResponse, error) {
+	out := new(QueryServiceAPRResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ServiceAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/milkyway.rewards.v1.Query/DelegatorWithdrawAddress",
+	return out, nil
+}
+
+func (c *queryClient) PoolServiceAPR(ctx context.Context, in *QueryPoolServiceAPRRequest, opts ...grpc.CallOption) (*QueryPoolServiceAPRResponse, error) {
+	out := new(QueryPoolServiceAPRResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolServiceAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+	return out, nil
+}
+
+func (c *queryClient) PoolDelegationRewards(ctx context.Context, in *QueryPoolDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPoolDelegationRewardsResponse, error) {
+	out := new(QueryPoolDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/PoolDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "milkyway.rewards.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RewardsPlans",
-			Handler:    _Query_RewardsPlans_Handler,
-		},
-		{
-			MethodName: "RewardsPlan",
-			Handler:    _Query_RewardsPlan_Handler,
-		},
-		{
-			MethodName: "PoolOutstandingRewards",
-			Handler:    _Query_PoolOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "OperatorOutstandingRewards",
-			Handler:    _Query_OperatorOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "ServiceOutstandingRewards",
-			Handler:    _Query_ServiceOutstandingRewards_Handler,
-		},
-		{
-			MethodName: "OperatorCommission",
-			Handler:    _Query_OperatorCommission_Handler,
-		},
-		{
-			MethodName: "PoolServiceTotalDelegatorShares",
-			Handler:    _Query_PoolServiceTotalDelegatorShares_Handler,
-		},
-		{
-			MethodName: "PoolDelegationRewards",
-			Handler:    _Query_PoolDelegationRewards_Handler,
-		},
-		{
-			MethodName: "OperatorDelegationRewards",
-			Handler:    _Query_OperatorDelegationRewards_Handler,
-		},
-		{
-			MethodName: "ServiceDelegationRewards",
-			Handler:    _Query_ServiceDelegationRewards_Handler,
-		},
-		{
-			MethodName: "DelegatorTotalRewards",
-			Handler:    _Query_DelegatorTotalRewards_Handler,
-		},
-		{
-			MethodName: "DelegatorWithdrawAddress",
-			Handler:    _Query_DelegatorWithdrawAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "milkyway/rewards/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperatorDelegationRewards(ctx context.Context, in *QueryOperatorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorDelegationRewardsResponse, error) {
+	out := new(QueryOperatorDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/OperatorDelegationRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ServiceDelegationRewards(ctx context.Context, in *QueryServiceDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryServiceDelegationRewardsResponse, error) {
+	out := new(QueryServiceDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/ServiceDelegationRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorTotalRewards(ctx context.Context, in *QueryDelegatorTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalRewardsResponse, error) {
+	out := new(QueryDelegatorTotalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/DelegatorTotalRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRewardsPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRewardsPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
+	out := new(QueryDelegatorWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/DelegatorWithdrawAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRewardsPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRewardsPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RewardsPlans) > 0 {
-		for iNdEx := len(m.RewardsPlans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardsPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundSettingsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.rewards.v1.Query/DelegatorAutoCompoundSettings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the parameters of the
+	// module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RewardsPlans queries all rewards plans.
+	RewardsPlans(context.Context, *QueryRewardsPlansRequest) (*QueryRewardsPlansResponse, error)
+	// RewardsPlan queries a specific rewards plan by its ID.
+	RewardsPlan(context.Context, *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error)
+	// ArchivedRewardsPlans queries all the rewards plans that have been
+	// terminated.
+	ArchivedRewardsPlans(context.Context, *QueryArchivedRewardsPlansRequest) (*QueryArchivedRewardsPlansResponse, error)
+	// ArchivedRewardsPlan queries a specific terminated rewards plan by its ID.
+	ArchivedRewardsPlan(context.Context, *QueryArchivedRewardsPlanRequest) (*QueryArchivedRewardsPlanResponse, error)
+	// PoolOutstandingRewards queries rewards of a pool.
+	PoolOutstandingRewards(context.Context, *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error)
+	// OperatorOutstandingRewards queries rewards of an operator.
+	OperatorOutstandingRewards(context.Context, *QueryOperatorOutstandingRewardsRequest) (*QueryOperatorOutstandingRewardsResponse, error)
+	// ServiceOutstandingRewards queries rewards of a service.
+	ServiceOutstandingRewards(context.Context, *QueryServiceOutstandingRewardsRequest) (*QueryServiceOutstandingRewardsResponse, error)
+	// OperatorCommission queries accumulated commission for an operator.
+	OperatorCommission(context.Context, *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error)
+	// PoolServiceTotalDelegatorShares queries the total delegator shares for a
+	// pool-service pair.
+	PoolServiceTotalDelegatorShares(context.Context, *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error)
+	// PoolAPR queries the projected annualised yield of the delegations to a
+	// pool, derived from the currently active rewards plans.
+	PoolAPR(context.Context, *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error)
+	// OperatorAPR queries the projected annualised yield of the delegations to
+	// an operator, derived from the currently active rewards plans.
+	OperatorAPR(context.Context, *QueryOperatorAPRRequest) (*QueryOperatorAPRResponse, error)
+	// ServiceAPR queries the projected annualised yield of the delegations to
+	// a service, derived from the currently active rewards plans.
+	ServiceAPR(context.Context, *QueryServiceAPRRequest) (*QueryServiceAPRResponse, error)
+	// PoolServiceAPR queries the projected annualised yield that the
+	// delegations to a pool receive from the rewards plans of a service.
+	PoolServiceAPR(context.Context, *QueryPoolServiceAPRRequest) (*QueryPoolServiceAPRResponse, error)
+	// PoolDelegationRewards queries the total rewards accrued by a pool
+	// delegation.
+	PoolDelegationRewards(context.Context, *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error)
+	// OperatorDelegationRewards queries the total rewards accrued by a operator
+	// delegation.
+	OperatorDelegationRewards(context.Context, *QueryOperatorDelegationRewardsRequest) (*QueryOperatorDelegationRewardsResponse, error)
+	// ServiceDelegationRewards queries the total rewards accrued by a service
+	// delegation.
+	ServiceDelegationRewards(context.Context, *QueryServiceDelegationRewardsRequest) (*QueryServiceDelegationRewardsResponse, error)
+	// DelegatorTotalRewards queries the total rewards accrued by a single
+	// delegator
+	DelegatorTotalRewards(context.Context, *QueryDelegatorTotalRewardsRequest) (*QueryDelegatorTotalRewardsResponse, error)
+	// DelegatorWithdrawAddress queries withdraw address of a delegator.
+	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegation targets for which a
+	// delegator has enabled the auto-compounding of the rewards.
+	DelegatorAutoCompoundSettings(context.Context, *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error)
 }
 
-func (m *QueryRewardsPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryRewardsPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryRewardsPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) RewardsPlans(ctx context.Context, req *QueryRewardsPlansRequest) (*QueryRewardsPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPlans not implemented")
 }
-
-func (m *QueryRewardsPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RewardsPlan.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) RewardsPlan(ctx context.Context, req *QueryRewardsPlanRequest) (*QueryRewardsPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPlan not implemented")
 }
-
-func (m *QueryPoolOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ArchivedRewardsPlans(ctx context.Context, req *QueryArchivedRewardsPlansRequest) (*QueryArchivedRewardsPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedRewardsPlans not implemented")
 }
-
-func (m *QueryPoolOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ArchivedRewardsPlan(ctx context.Context, req *QueryArchivedRewardsPlanRequest) (*QueryArchivedRewardsPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedRewardsPlan not implemented")
+}
+func (*UnimplementedQueryServer) PoolOutstandingRewards(ctx context.Context, req *QueryPoolOutstandingRewardsRequest) (*QueryPoolOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) OperatorOutstandingRewards(ctx context.Context, req *QueryOperatorOutstandingRewardsRequest) (*QueryOperatorOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) ServiceOutstandingRewards(ctx context.Context, req *QueryServiceOutstandingRewardsRequest) (*QueryServiceOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceOutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) OperatorCommission(ctx context.Context, req *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorCommission not implemented")
+}
+func (*UnimplementedQueryServer) PoolServiceTotalDelegatorShares(ctx context.Context, req *QueryPoolServiceTotalDelegatorSharesRequest) (*QueryPoolServiceTotalDelegatorSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolServiceTotalDelegatorShares not implemented")
+}
+func (*UnimplementedQueryServer) PoolAPR(ctx context.Context, req *QueryPoolAPRRequest) (*QueryPoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
+func (*UnimplementedQueryServer) OperatorAPR(ctx context.Context, req *QueryOperatorAPRRequest) (*QueryOperatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorAPR not implemented")
+}
+func (*UnimplementedQueryServer) ServiceAPR(ctx context.Context, req *QueryServiceAPRRequest) (*QueryServiceAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceAPR not implemented")
+}
+func (*UnimplementedQueryServer) PoolServiceAPR(ctx context.Context, req *QueryPoolServiceAPRRequest) (*QueryPoolServiceAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolServiceAPR not implemented")
+}
+func (*UnimplementedQueryServer) PoolDelegationRewards(ctx context.Context, req *QueryPoolDelegationRewardsRequest) (*QueryPoolDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) OperatorDelegationRewards(ctx context.Context, req *QueryOperatorDelegationRewardsRequest) (*QueryOperatorDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) ServiceDelegationRewards(ctx context.Context, req *QueryServiceDelegationRewardsRequest) (*QueryServiceDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorTotalRewards(ctx context.Context, req *QueryDelegatorTotalRewardsRequest) (*QueryDelegatorTotalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorTotalRewards not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAutoCompoundSettings(ctx context.Context, req *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundSettings not implemented")
 }
 
-func (m *QueryPoolOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPoolOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RewardsPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/RewardsPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPlans(ctx, req.(*QueryRewardsPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_RewardsPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/RewardsPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPlan(ctx, req.(*QueryRewardsPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ArchivedRewardsPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedRewardsPlansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedRewardsPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/ArchivedRewardsPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedRewardsPlans(ctx, req.(*QueryArchivedRewardsPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ArchivedRewardsPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedRewardsPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedRewardsPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/ArchivedRewardsPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedRewardsPlan(ctx, req.(*QueryArchivedRewardsPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
+func _Query_PoolOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/PoolOutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolOutstandingRewards(ctx, req.(*QueryPoolOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/OperatorOutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorOutstandingRewards(ctx, req.(*QueryOperatorOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ServiceOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/ServiceOutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceOutstandingRewards(ctx, req.(*QueryServiceOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_OperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/OperatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorCommission(ctx, req.(*QueryOperatorCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolServiceTotalDelegatorShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolServiceTotalDelegatorSharesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PoolServiceTotalDelegatorShares(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/PoolServiceTotalDelegatorShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolServiceTotalDelegatorShares(ctx, req.(*QueryPoolServiceTotalDelegatorSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryServiceOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolAPRRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryServiceOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryServiceOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/PoolAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*QueryPoolAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorCommissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAPRRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOperatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOperatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorAPR(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/OperatorAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorAPR(ctx, req.(*QueryOperatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ServiceAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceAPRRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceAPR(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/ServiceAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceAPR(ctx, req.(*QueryServiceAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolServiceAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolServiceAPRRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolServiceTotalDelegatorSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).PoolServiceAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/PoolServiceAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolServiceAPR(ctx, req.(*QueryPoolServiceAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x10
+func _Query_PoolDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PoolDelegationRewards(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/PoolDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolDelegationRewards(ctx, req.(*QueryPoolDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorDelegationRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/OperatorDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorDelegationRewards(ctx, req.(*QueryOperatorDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ServiceDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/ServiceDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceDelegationRewards(ctx, req.(*QueryServiceDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_DelegatorTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorTotalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorTotalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/DelegatorTotalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorTotalRewards(ctx, req.(*QueryDelegatorTotalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/DelegatorWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoCompoundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.rewards.v1.Query/DelegatorAutoCompoundSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, req.(*QueryDelegatorAutoCompoundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milkyway.rewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RewardsPlans",
+			Handler:    _Query_RewardsPlans_Handler,
+		},
+		{
+			MethodName: "RewardsPlan",
+			Handler:    _Query_RewardsPlan_Handler,
+		},
+		{
+			MethodName: "ArchivedRewardsPlans",
+			Handler:    _Query_ArchivedRewardsPlans_Handler,
+		},
+		{
+			MethodName: "ArchivedRewardsPlan",
+			Handler:    _Query_ArchivedRewardsPlan_Handler,
+		},
+		{
+			MethodName: "PoolOutstandingRewards",
+			Handler:    _Query_PoolOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "OperatorOutstandingRewards",
+			Handler:    _Query_OperatorOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "ServiceOutstandingRewards",
+			Handler:    _Query_ServiceOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "OperatorCommission",
+			Handler:    _Query_OperatorCommission_Handler,
+		},
+		{
+			MethodName: "PoolServiceTotalDelegatorShares",
+			Handler:    _Query_PoolServiceTotalDelegatorShares_Handler,
+		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
+		{
+			MethodName: "OperatorAPR",
+			Handler:    _Query_OperatorAPR_Handler,
+		},
+		{
+			MethodName: "ServiceAPR",
+			Handler:    _Query_ServiceAPR_Handler,
+		},
+		{
+			MethodName: "PoolServiceAPR",
+			Handler:    _Query_PoolServiceAPR_Handler,
+		},
+		{
+			MethodName: "PoolDelegationRewards",
+			Handler:    _Query_PoolDelegationRewards_Handler,
+		},
+		{
+			MethodName: "OperatorDelegationRewards",
+			Handler:    _Query_OperatorDelegationRewards_Handler,
+		},
+		{
+			MethodName: "ServiceDelegationRewards",
+			Handler:    _Query_ServiceDelegationRewards_Handler,
+		},
+		{
+			MethodName: "DelegatorTotalRewards",
+			Handler:    _Query_DelegatorTotalRewards_Handler,
+		},
+		{
+			MethodName: "DelegatorWithdrawAddress",
+			Handler:    _Query_DelegatorWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoCompoundSettings",
+			Handler:    _Query_DelegatorAutoCompoundSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milkyway/rewards/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2290,32 +2679,20 @@ func (m *QueryPoolDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2325,34 +2702,30 @@ func (m *QueryPoolDelegationRewardsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRewardsPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2362,32 +2735,32 @@ func (m *QueryOperatorDelegationRewardsRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OperatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRewardsPlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2397,20 +2770,32 @@ func (m *QueryOperatorDelegationRewardsResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardsPlans) > 0 {
+		for iNdEx := len(m.RewardsPlans) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardsPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2424,7 +2809,7 @@ func (m *QueryOperatorDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRewardsPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2434,32 +2819,25 @@ func (m *QueryServiceDelegationRewardsRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryServiceDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlanRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ServiceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRewardsPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2469,34 +2847,30 @@ func (m *QueryServiceDelegationRewardsResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryServiceDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRewardsPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.RewardsPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryArchivedRewardsPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2506,27 +2880,32 @@ func (m *QueryDelegatorTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryArchivedRewardsPlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2536,34 +2915,32 @@ func (m *QueryDelegatorTotalRewardsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ArchivedRewardsPlans) > 0 {
+		for iNdEx := len(m.ArchivedRewardsPlans) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ArchivedRewardsPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2577,7 +2954,7 @@ func (m *QueryDelegatorTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryArchivedRewardsPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2587,27 +2964,25 @@ func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlanRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryArchivedRewardsPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2617,394 +2992,2523 @@ func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryArchivedRewardsPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.ArchivedRewardsPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRewardsPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RewardsPlans) > 0 {
-		for _, e := range m.RewardsPlans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		n += 1 + sovQuery(uint64(m.PlanId))
+func (m *QueryOperatorOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRewardsPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RewardsPlan.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryOperatorOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
-	}
-	return n
+func (m *QueryOperatorOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryServiceOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryServiceOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryServiceOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolServiceTotalDelegatorSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolServiceTotalDelegatorSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolServiceTotalDelegatorSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OperatorId != 0 {
-		n += 1 + sovQuery(uint64(m.OperatorId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.APR.Size()
+		i -= size
+		if _, err := m.APR.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOperatorAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x8
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.APR.Size()
+		i -= size
+		if _, err := m.APR.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.ServiceId != 0 {
-		n += 1 + sovQuery(uint64(m.ServiceId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryServiceAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.APR.Size()
+		i -= size
+		if _, err := m.APR.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorTotalRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolServiceAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolServiceAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolServiceAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorTotalRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolServiceAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolServiceAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolServiceAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.APR.Size()
+		i -= size
+		if _, err := m.APR.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsPlans) > 0 {
+		for _, e := range m.RewardsPlans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryRewardsPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardsPlan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryArchivedRewardsPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedRewardsPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArchivedRewardsPlans) > 0 {
+		for _, e := range m.ArchivedRewardsPlans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedRewardsPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryArchivedRewardsPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArchivedRewardsPlan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryOperatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryServiceOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryServiceOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryOperatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryPoolServiceTotalDelegatorSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.APR.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOperatorAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryOperatorAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.APR.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryServiceAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.APR.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolServiceAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryPoolServiceAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.APR.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovQuery(uint64(m.OperatorId))
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	return n
+}
+
+func (m *QueryServiceDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPlans = append(m.RewardsPlans, RewardsPlan{})
+			if err := m.RewardsPlans[len(m.RewardsPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardsPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedRewardsPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedRewardsPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedRewardsPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedRewardsPlans = append(m.ArchivedRewardsPlans, ArchivedRewardsPlan{})
+			if err := m.ArchivedRewardsPlans[len(m.ArchivedRewardsPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedRewardsPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedRewardsPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedRewardsPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedRewardsPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArchivedRewardsPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3027,12 +5531,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3054,7 +5577,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3077,15 +5600,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3112,7 +5635,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3137,7 +5660,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3160,15 +5683,84 @@ func (m *QueryRewardsPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3195,10 +5787,7 @@ func (m *QueryRewardsPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3223,7 +5812,7 @@ func (m *QueryRewardsPlansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3246,17 +5835,17 @@ func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPlansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPlans", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			m.ServiceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3266,29 +5855,64 @@ func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServiceId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsPlans = append(m.RewardsPlans, RewardsPlan{})
-			if err := m.RewardsPlans[len(m.RewardsPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3315,10 +5939,7 @@ func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3343,7 +5964,7 @@ func (m *QueryRewardsPlansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3366,17 +5987,17 @@ func (m *QueryRewardsPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorCommissionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.PlanId = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3386,7 +6007,7 @@ func (m *QueryRewardsPlanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlanId |= uint64(b&0x7F) << shift
+				m.OperatorId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3412,7 +6033,7 @@ func (m *QueryRewardsPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3435,15 +6056,15 @@ func (m *QueryRewardsPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3470,7 +6091,7 @@ func (m *QueryRewardsPlanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardsPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3495,7 +6116,7 @@ func (m *QueryRewardsPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolServiceTotalDelegatorSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3518,10 +6139,10 @@ func (m *QueryPoolOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3543,6 +6164,25 @@ func (m *QueryPoolOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3564,7 +6204,7 @@ func (m *QueryPoolOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolServiceTotalDelegatorSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3587,15 +6227,15 @@ func (m *QueryPoolOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3622,7 +6262,8 @@ func (m *QueryPoolOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, types.DecCoin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3647,7 +6288,7 @@ func (m *QueryPoolOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3670,17 +6311,17 @@ func (m *QueryOperatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.OperatorId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3690,7 +6331,7 @@ func (m *QueryOperatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3716,7 +6357,7 @@ func (m *QueryOperatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3739,15 +6380,49 @@ func (m *QueryOperatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APR", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.APR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3774,7 +6449,8 @@ func (m *QueryOperatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plans = append(m.Plans, PlanAPR{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3799,7 +6475,7 @@ func (m *QueryOperatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3822,17 +6498,17 @@ func (m *QueryServiceOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.ServiceId = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3842,7 +6518,7 @@ func (m *QueryServiceOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServiceId |= uint32(b&0x7F) << shift
+				m.OperatorId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3868,7 +6544,7 @@ func (m *QueryServiceOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3891,15 +6567,49 @@ func (m *QueryServiceOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APR", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.APR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3926,7 +6636,8 @@ func (m *QueryServiceOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plans = append(m.Plans, PlanAPR{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3951,7 +6662,7 @@ func (m *QueryServiceOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3974,17 +6685,17 @@ func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			m.OperatorId = 0
+			m.ServiceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3994,7 +6705,7 @@ func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatorId |= uint32(b&0x7F) << shift
+				m.ServiceId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4020,7 +6731,7 @@ func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4043,15 +6754,49 @@ func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APR", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.APR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4078,7 +6823,8 @@ func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plans = append(m.Plans, PlanAPR{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4103,7 +6849,7 @@ func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolServiceTotalDelegatorSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolServiceAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4126,10 +6872,10 @@ func (m *QueryPoolServiceTotalDelegatorSharesRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolServiceAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolServiceAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4191,7 +6937,7 @@ func (m *QueryPoolServiceTotalDelegatorSharesRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryPoolServiceTotalDelegatorSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolServiceAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4201,28 +6947,62 @@ func (m *QueryPoolServiceTotalDelegatorSharesResponse) Unmarshal(dAtA []byte) er
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolServiceAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolServiceAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APR", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.APR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolServiceTotalDelegatorSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4249,8 +7029,8 @@ func (m *QueryPoolServiceTotalDelegatorSharesResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, types.DecCoin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plans = append(m.Plans, PlanAPR{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5194,6 +7974,244 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundSettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
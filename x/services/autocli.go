@@ -40,6 +40,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "service_id"},
 					},
 				},
+				{
+					RpcMethod: "ServiceRoleGrants",
+					Use:       "role-grants [service-id]",
+					Short:     "Query the roles granted by the admin of the service with the given id",
+					Example:   fmt.Sprintf(`%s query %s role-grants 1 --grantee cosmos1example`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -149,6 +158,38 @@ Only the fields that you provide will be updated`,
 					RpcMethod: "SetServiceParams",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "GrantServiceRole",
+					Use:       "grant-role [service-id] [grantee] [role]",
+					Short:     "Grant one of the service roles to another account",
+					Long: `Grant one of the service roles to another account.
+
+The available roles are:
+- SERVICE_ROLE_METADATA: allows to update the service details
+- SERVICE_ROLE_ALLOW_LIST: allows to manage the list of operators allowed to secure the service
+- SERVICE_ROLE_REWARDS_PLANS: allows to create and edit the rewards plans of the service`,
+					Example: fmt.Sprintf(`%s tx %s grant-role 1 cosmos1example SERVICE_ROLE_METADATA --from alice`,
+						version.AppName, types.ModuleName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "grantee"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "RevokeServiceRole",
+					Use:       "revoke-role [service-id] [grantee] [role]",
+					Short:     "Revoke a service role previously granted to another account",
+					Example: fmt.Sprintf(`%s tx %s revoke-role 1 cosmos1example SERVICE_ROLE_METADATA --from alice`,
+						version.AppName, types.ModuleName,
+					),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "grantee"},
+						{ProtoField: "role"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true,
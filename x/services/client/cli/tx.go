@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -31,6 +32,15 @@ func GetTxCmd() *cobra.Command {
 	return txCmd
 }
 
+const (
+	flagMinOperatorStakeUSD = "min-operator-stake-usd"
+	flagMaxActiveOperators  = "max-active-operators"
+	flagSnapshotInterval    = "snapshot-interval"
+	flagSnapshotRetention   = "snapshot-retention"
+	flagEvidenceVerifier    = "evidence-verifier"
+	flagUnbondingTime       = "unbonding-time"
+)
+
 // GetCmdSetServiceParams returns the command allowing to set an existing service's
 // parameters
 func GetCmdSetServiceParams() *cobra.Command {
@@ -38,7 +48,7 @@ func GetCmdSetServiceParams() *cobra.Command {
 		Use:     "set-params [service-id] [allowed-denoms]",
 		Args:    cobra.ExactArgs(2),
 		Short:   "Set the parameters of the service with the given id",
-		Example: fmt.Sprintf(`%s tx %s set-params 1 utia,umilk --from alice`, version.AppName, types.ModuleName),
+		Example: fmt.Sprintf(`%s tx %s set-params 1 utia,umilk --min-operator-stake-usd 1000 --max-active-operators 50 --from alice`, version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -52,8 +62,51 @@ func GetCmdSetServiceParams() *cobra.Command {
 
 			allowedDenoms := strings.Split(args[1], ",")
 
+			minOperatorStakeUSDStr, err := cmd.Flags().GetString(flagMinOperatorStakeUSD)
+			if err != nil {
+				return err
+			}
+
+			minOperatorStakeUSD, err := math.LegacyNewDecFromStr(minOperatorStakeUSDStr)
+			if err != nil {
+				return fmt.Errorf("invalid min operator stake usd: %w", err)
+			}
+
+			maxActiveOperators, err := cmd.Flags().GetUint32(flagMaxActiveOperators)
+			if err != nil {
+				return err
+			}
+
+			snapshotInterval, err := cmd.Flags().GetUint64(flagSnapshotInterval)
+			if err != nil {
+				return err
+			}
+
+			snapshotRetention, err := cmd.Flags().GetUint32(flagSnapshotRetention)
+			if err != nil {
+				return err
+			}
+
+			evidenceVerifier, err := cmd.Flags().GetString(flagEvidenceVerifier)
+			if err != nil {
+				return err
+			}
+
+			unbondingTime, err := cmd.Flags().GetDuration(flagUnbondingTime)
+			if err != nil {
+				return err
+			}
+
 			// Create the service params
-			serviceParams := types.NewServiceParams(allowedDenoms)
+			serviceParams := types.NewServiceParams(
+				allowedDenoms,
+				minOperatorStakeUSD,
+				maxActiveOperators,
+				snapshotInterval,
+				snapshotRetention,
+				evidenceVerifier,
+				unbondingTime,
+			)
 
 			sender := clientCtx.FromAddress.String()
 
@@ -68,6 +121,12 @@ func GetCmdSetServiceParams() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagMinOperatorStakeUSD, "0", "Minimum USD value of the stake an operator must have to join the service")
+	cmd.Flags().Uint32(flagMaxActiveOperators, 0, "Maximum number of active operators of the service (0 means unlimited)")
+	cmd.Flags().Uint64(flagSnapshotInterval, 0, "Number of blocks between two snapshots of the service validating set (0 disables snapshots)")
+	cmd.Flags().Uint32(flagSnapshotRetention, 0, "Number of most recent snapshots of the service validating set to keep")
+	cmd.Flags().String(flagEvidenceVerifier, "", "Address of the CosmWasm contract used to verify the evidences of the operators misbehaviors")
+	cmd.Flags().Duration(flagUnbondingTime, 0, "Time it takes for the assets securing the service to be unbonded (0 means the restaking module default)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/milkyway-labs/milkyway/v12/x/services/types"
@@ -23,6 +25,11 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	roleGrants, err := k.GetAllServiceRoleGrants(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -32,6 +39,7 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		nextServiceID,
 		services,
 		servicesParams,
+		roleGrants,
 		params,
 	)
 }
@@ -61,6 +69,23 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) error {
 		}
 	}
 
+	// Store the role grants
+	for _, grant := range state.RoleGrants {
+		// Ensure that the service is present
+		_, err = k.GetService(ctx, grant.ServiceID)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return errors.Wrapf(types.ErrServiceNotFound, "service %d not found", grant.ServiceID)
+			}
+			return err
+		}
+
+		err = k.GrantServiceRole(ctx, grant.ServiceID, grant.Grantee, grant.Role)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Store params
 	err = k.SetParams(ctx, state.Params)
 	if err != nil {
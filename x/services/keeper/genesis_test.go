@@ -95,10 +95,10 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				err := suite.k.SetNextServiceID(ctx, 1)
 				suite.Require().NoError(err)
 
-				err = suite.k.SetServiceParams(ctx, 1, types.NewServiceParams([]string{"umilk"}))
+				err = suite.k.SetServiceParams(ctx, 1, types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
-				err = suite.k.SetServiceParams(ctx, 2, types.NewServiceParams([]string{"uinit"}))
+				err = suite.k.SetServiceParams(ctx, 2, types.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 
 				err = suite.k.SetParams(ctx, types.DefaultParams())
@@ -108,8 +108,33 @@ func (suite *KeeperTestSuite) TestKeeper_ExportGenesis() {
 				NextServiceID: 1,
 				Services:      nil,
 				ServicesParams: []types.ServiceParamsRecord{
-					types.NewServiceParamsRecord(1, types.NewServiceParams([]string{"umilk"})),
-					types.NewServiceParamsRecord(2, types.NewServiceParams([]string{"uinit"})),
+					types.NewServiceParamsRecord(1, types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0)),
+					types.NewServiceParamsRecord(2, types.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0)),
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		{
+			name: "role grants are exported properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SetNextServiceID(ctx, 1)
+				suite.Require().NoError(err)
+
+				err = suite.k.SetParams(ctx, types.DefaultParams())
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 2, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_REWARDS_PLANS)
+				suite.Require().NoError(err)
+			},
+			expGenesis: &types.GenesisState{
+				NextServiceID: 1,
+				Services:      nil,
+				RoleGrants: []types.ServiceRoleGrant{
+					types.NewServiceRoleGrant(1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_ALLOW_LIST),
+					types.NewServiceRoleGrant(2, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_REWARDS_PLANS),
 				},
 				Params: types.DefaultParams(),
 			},
@@ -167,6 +192,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				10,
 				nil,
 				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: false,
@@ -193,6 +219,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 					),
 				},
 				nil,
+				nil,
 				types.DefaultParams(),
 			),
 			shouldErr: false,
@@ -219,6 +246,7 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				1,
 				nil,
 				nil,
+				nil,
 				types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(1_000_000_000))),
 				),
@@ -258,9 +286,10 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 					),
 				},
 				[]types.ServiceParamsRecord{
-					types.NewServiceParamsRecord(1, types.NewServiceParams([]string{"umilk"})),
-					types.NewServiceParamsRecord(2, types.NewServiceParams([]string{"uinit"})),
+					types.NewServiceParamsRecord(1, types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0)),
+					types.NewServiceParamsRecord(2, types.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0)),
 				},
+				nil,
 				types.NewParams(
 					sdk.NewCoins(sdk.NewCoin("umilk", sdkmath.NewInt(1_000_000_000))),
 				),
@@ -269,12 +298,53 @@ func (suite *KeeperTestSuite) TestKeeper_InitGenesis() {
 				// Check the first one
 				serviceParams, err := suite.k.GetServiceParams(ctx, 1)
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.NewServiceParams([]string{"umilk"}), serviceParams)
+				suite.Require().Equal(types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0), serviceParams)
 
 				// Check the second one
 				serviceParams, err = suite.k.GetServiceParams(ctx, 2)
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.NewServiceParams([]string{"uinit"}), serviceParams)
+				suite.Require().Equal(types.NewServiceParams([]string{"uinit"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0), serviceParams)
+			},
+		},
+		{
+			name: "role grant without associated service returns error",
+			genesis: types.NewGenesisState(
+				1,
+				nil,
+				nil,
+				[]types.ServiceRoleGrant{
+					types.NewServiceRoleGrant(1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_METADATA),
+				},
+				types.DefaultParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "role grants are initialized properly",
+			genesis: types.NewGenesisState(
+				1,
+				[]types.Service{
+					types.NewService(
+						1,
+						types.SERVICE_STATUS_ACTIVE,
+						"MilkyWay",
+						"MilkyWay is an AVS of a restaking platform",
+						"https://milkyway.com",
+						"https://milkyway.com/logo.png",
+						"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+						false,
+					),
+				},
+				nil,
+				[]types.ServiceRoleGrant{
+					types.NewServiceRoleGrant(1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_METADATA),
+				},
+				types.DefaultParams(),
+			),
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasServiceRole(ctx, 1, "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
 			},
 		},
 	}
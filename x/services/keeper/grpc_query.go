@@ -5,10 +5,12 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milkyway-labs/milkyway/v12/utils"
 	"github.com/milkyway-labs/milkyway/v12/x/services/types"
 )
 
@@ -33,11 +35,59 @@ func (k *Keeper) Service(ctx context.Context, request *types.QueryServiceRequest
 
 // Services implements the Query/Services gRPC method
 func (k *Keeper) Services(ctx context.Context, request *types.QueryServicesRequest) (*types.QueryServicesResponse, error) {
-	services, pageRes, err := query.CollectionPaginate(ctx, k.services, request.Pagination,
-		func(key uint32, value types.Service) (types.Service, error) {
-			return value, nil
-		},
-	)
+	if request.Admin != "" {
+		_, err := sdk.AccAddressFromBech32(request.Admin)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin address")
+		}
+	}
+
+	// Filter the services that do not match the requested criteria
+	predicateFunc := func(_ uint32, service types.Service) (bool, error) {
+		if request.Status != types.SERVICE_STATUS_UNSPECIFIED && service.Status != request.Status {
+			return false, nil
+		}
+
+		if request.Admin != "" && service.Admin != request.Admin {
+			return false, nil
+		}
+
+		if request.AccreditedOnly && !service.Accredited {
+			return false, nil
+		}
+
+		return true, nil
+	}
+
+	transformFunc := func(_ uint32, service types.Service) (types.Service, error) {
+		return service, nil
+	}
+
+	var services []types.Service
+	var pageRes *query.PageResponse
+	var err error
+
+	switch {
+	case request.Admin != "":
+		services, pageRes, err = utils.CollectionIndexPaginate(ctx, k.services.Indexes.Admin,
+			collections.NewPrefixedPairRange[string, uint32](request.Admin),
+			k.services.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	case request.Status != types.SERVICE_STATUS_UNSPECIFIED:
+		services, pageRes, err = utils.CollectionIndexPaginate(ctx, k.services.Indexes.Status,
+			collections.NewPrefixedPairRange[int32, uint32](int32(request.Status)),
+			k.services.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	case request.AccreditedOnly:
+		services, pageRes, err = utils.CollectionIndexPaginate(ctx, k.services.Indexes.Accredited,
+			collections.NewPrefixedPairRange[bool, uint32](true),
+			k.services.Get, request.Pagination, predicateFunc, transformFunc,
+		)
+	default:
+		services, pageRes, err = query.CollectionFilteredPaginate(ctx, k.services, request.Pagination,
+			predicateFunc, transformFunc,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +122,40 @@ func (k *Keeper) ServiceParams(ctx context.Context, request *types.QueryServiceP
 	return &types.QueryServiceParamsResponse{ServiceParams: serviceParams}, nil
 }
 
+// ServiceRoleGrants implements the Query/ServiceRoleGrants gRPC method
+func (k *Keeper) ServiceRoleGrants(ctx context.Context, request *types.QueryServiceRoleGrantsRequest) (*types.QueryServiceRoleGrantsResponse, error) {
+	if request.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid service ID")
+	}
+
+	// Filter the grants of the given grantee, if any
+	prefix := collections.TriplePrefix[uint32, string, int32](request.ServiceId)
+	if request.Grantee != "" {
+		_, err := sdk.AccAddressFromBech32(request.Grantee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid grantee address")
+		}
+		prefix = collections.TripleSuperPrefix[uint32, string, int32](request.ServiceId, request.Grantee)
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(ctx, k.roleGrants, request.Pagination,
+		func(key collections.Triple[uint32, string, int32], _ collections.NoValue) (types.ServiceRoleGrant, error) {
+			return types.NewServiceRoleGrant(key.K1(), key.K2(), types.ServiceRole(key.K3())), nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint32, string, int32]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryServiceRoleGrantsResponse{
+		RoleGrants: grants,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k *Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params, err := k.GetParams(ctx)
@@ -113,6 +113,363 @@ func (suite *KeeperTestSuite) TestKeeper_Services() {
 				),
 			},
 		},
+		{
+			name: "query with invalid admin returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				Admin: "invalid",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "query with status filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				Status: types.SERVICE_STATUS_ACTIVE,
+			},
+			shouldErr: false,
+			expServices: []types.Service{
+				types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				),
+				types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				),
+			},
+		},
+		{
+			name: "query with admin filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				Admin: "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+			},
+			shouldErr: false,
+			expServices: []types.Service{
+				types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				),
+				types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				),
+			},
+		},
+		{
+			name: "query with accredited filter returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				AccreditedOnly: true,
+			},
+			shouldErr: false,
+			expServices: []types.Service{
+				types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				),
+				types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				),
+			},
+		},
+		{
+			name: "query with accredited filter and pagination returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				Pagination:     &query.PageRequest{Offset: 1, Limit: 1},
+				AccreditedOnly: true,
+			},
+			shouldErr: false,
+			expServices: []types.Service{
+				types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				),
+			},
+		},
+		{
+			name: "query with multiple filters returns data properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.CreateService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay service",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					true,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					2,
+					types.SERVICE_STATUS_INACTIVE,
+					"Inertia",
+					"Inertia service",
+					"https://inertia.com",
+					"https://inertia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.CreateService(ctx, types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				))
+				suite.Require().NoError(err)
+			},
+			request: &types.QueryServicesRequest{
+				Status:         types.SERVICE_STATUS_ACTIVE,
+				Admin:          "cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+				AccreditedOnly: true,
+			},
+			shouldErr: false,
+			expServices: []types.Service{
+				types.NewService(
+					3,
+					types.SERVICE_STATUS_ACTIVE,
+					"Celestia",
+					"Celestia service",
+					"https://celestia.com",
+					"https://celestia.com/logo.png",
+					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
+					true,
+				),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -253,12 +610,12 @@ func (suite *KeeperTestSuite) TestKeeper_ServiceParams() {
 				))
 				suite.Require().NoError(err)
 				// Set some custom parameters
-				err = suite.k.SetServiceParams(ctx, 1, types.NewServiceParams([]string{"umilk"}))
+				err = suite.k.SetServiceParams(ctx, 1, types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0))
 				suite.Require().NoError(err)
 			},
 			request:   types.NewQueryServiceParamsRequest(1),
 			shouldErr: false,
-			expParams: types.NewServiceParams([]string{"umilk"}),
+			expParams: types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
 		},
 	}
 
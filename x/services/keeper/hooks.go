@@ -48,3 +48,11 @@ func (k *Keeper) AfterServiceAccreditationModified(ctx context.Context, serviceI
 	}
 	return nil
 }
+
+// AfterServiceParamsModified implements ServicesHooks
+func (k *Keeper) AfterServiceParamsModified(ctx context.Context, serviceID uint32) error {
+	if k.hooks != nil {
+		return k.hooks.AfterServiceParamsModified(ctx, serviceID)
+	}
+	return nil
+}
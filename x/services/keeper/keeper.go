@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,12 +22,14 @@ type Keeper struct {
 
 	Schema collections.Schema
 
-	nextServiceID     collections.Sequence                         // Next service ID
-	services          collections.Map[uint32, types.Service]       // service ID -> service
-	serviceAddressSet collections.KeySet[string]                   // Set of service addresses
-	serviceParams     collections.Map[uint32, types.ServiceParams] // service ID -> parameters
+	nextServiceID     collections.Sequence                                           // Next service ID
+	services          *collections.IndexedMap[uint32, types.Service, serviceIndexes] // service ID -> service
+	serviceAddressSet collections.KeySet[string]                                     // Set of service addresses
+	serviceParams     collections.Map[uint32, types.ServiceParams]                   // service ID -> parameters
 	params            collections.Item[types.Params]
 
+	roleGrants collections.KeySet[collections.Triple[uint32, string, int32]] // (service ID, grantee, role)
+
 	// authority represents the address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority string
@@ -53,12 +56,13 @@ func NewKeeper(
 			types.NextServiceIDKey,
 			"next_service_id",
 		),
-		services: collections.NewMap(
+		services: collections.NewIndexedMap(
 			sb,
 			types.ServicePrefix,
 			"services",
 			collections.Uint32Key,
 			codec.CollValue[types.Service](cdc),
+			newServiceIndexes(sb),
 		),
 		serviceAddressSet: collections.NewKeySet(
 			sb,
@@ -79,6 +83,12 @@ func NewKeeper(
 			"params",
 			codec.CollValue[types.Params](cdc),
 		),
+		roleGrants: collections.NewKeySet(
+			sb,
+			types.ServiceRoleGrantPrefix,
+			"services_role_grants",
+			collections.TripleKeyCodec(collections.Uint32Key, collections.StringKey, collections.Int32Key),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -105,3 +115,55 @@ func (k *Keeper) SetHooks(rs types.ServicesHooks) *Keeper {
 	k.hooks = rs
 	return k
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type serviceIndexes struct {
+	// Index that allows to retrieve all the services having a given status
+	Status *indexes.Multi[int32, uint32, types.Service]
+
+	// Index that allows to retrieve all the services managed by a given admin
+	Admin *indexes.Multi[string, uint32, types.Service]
+
+	// Index that allows to retrieve all the services based on their accreditation
+	Accredited *indexes.Multi[bool, uint32, types.Service]
+}
+
+func (i serviceIndexes) IndexesList() []collections.Index[uint32, types.Service] {
+	return []collections.Index[uint32, types.Service]{i.Status, i.Admin, i.Accredited}
+}
+
+func newServiceIndexes(sb *collections.SchemaBuilder) serviceIndexes {
+	return serviceIndexes{
+		Status: indexes.NewMulti(
+			sb,
+			types.ServiceStatusIndexPrefix,
+			"services_by_status",
+			collections.Int32Key,
+			collections.Uint32Key,
+			func(_ uint32, service types.Service) (int32, error) {
+				return int32(service.Status), nil
+			},
+		),
+		Admin: indexes.NewMulti(
+			sb,
+			types.ServiceAdminIndexPrefix,
+			"services_by_admin",
+			collections.StringKey,
+			collections.Uint32Key,
+			func(_ uint32, service types.Service) (string, error) {
+				return service.Admin, nil
+			},
+		),
+		Accredited: indexes.NewMulti(
+			sb,
+			types.ServiceAccreditedIndexPrefix,
+			"services_by_accreditation",
+			collections.BoolKey,
+			collections.Uint32Key,
+			func(_ uint32, service types.Service) (bool, error) {
+				return service.Accredited, nil
+			},
+		),
+	}
+}
@@ -110,9 +110,14 @@ func (k msgServer) UpdateService(ctx context.Context, msg *types.MsgUpdateServic
 		return nil, err
 	}
 
-	// Make sure the user that is updating the service is the admin
-	if service.Admin != msg.Sender {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can update the service")
+	// Make sure only the admin or an account having the metadata role can update the service
+	hasPermission, err := k.HasServicePermission(ctx, service, msg.Sender, types.SERVICE_ROLE_METADATA)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin or an account having the %s role can update the service", types.SERVICE_ROLE_METADATA)
 	}
 
 	// Update the service
@@ -255,6 +260,11 @@ func (k msgServer) TransferServiceOwnership(ctx context.Context, msg *types.MsgT
 		return nil, err
 	}
 
+	// Remove the roles granted by the previous admin
+	if err := k.DeleteServiceRoleGrants(ctx, service.ID); err != nil {
+		return nil, err
+	}
+
 	// Emit the event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
@@ -277,9 +287,9 @@ func (k msgServer) SetServiceParams(ctx context.Context, msg *types.MsgSetServic
 		return nil, err
 	}
 
-	// Ensure the sender is the service admin
+	// Make sure only the admin can set the service params, as no role grants this permission
 	if msg.Sender != service.Admin {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "sender must be the service admin")
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can set the service params, no role grants this permission")
 	}
 
 	// Set the service params
@@ -301,6 +311,82 @@ func (k msgServer) SetServiceParams(ctx context.Context, msg *types.MsgSetServic
 	return &types.MsgSetServiceParamsResponse{}, nil
 }
 
+// GrantServiceRole defines the rpc method for Msg/GrantServiceRole
+func (k msgServer) GrantServiceRole(ctx context.Context, msg *types.MsgGrantServiceRole) (*types.MsgGrantServiceRoleResponse, error) {
+	service, err := k.GetService(ctx, msg.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure only the admin can grant the service roles
+	if service.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can grant the service roles")
+	}
+
+	// Grant the role
+	err = k.Keeper.GrantServiceRole(ctx, msg.ServiceID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantServiceRole,
+			sdk.NewAttribute(types.AttributeKeyServiceID, fmt.Sprintf("%d", msg.ServiceID)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgGrantServiceRoleResponse{}, nil
+}
+
+// RevokeServiceRole defines the rpc method for Msg/RevokeServiceRole
+func (k msgServer) RevokeServiceRole(ctx context.Context, msg *types.MsgRevokeServiceRole) (*types.MsgRevokeServiceRoleResponse, error) {
+	service, err := k.GetService(ctx, msg.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure only the admin can revoke the service roles
+	if service.Admin != msg.Sender {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the admin can revoke the service roles")
+	}
+
+	// Make sure the role has been granted
+	hasRole, err := k.HasServiceRole(ctx, msg.ServiceID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasRole {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "role %s not granted to %s", msg.Role, msg.Grantee)
+	}
+
+	// Revoke the role
+	err = k.Keeper.RevokeServiceRole(ctx, msg.ServiceID, msg.Grantee, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeServiceRole,
+			sdk.NewAttribute(types.AttributeKeyServiceID, fmt.Sprintf("%d", msg.ServiceID)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRevokeServiceRoleResponse{}, nil
+}
+
 // UpdateParams defines the rpc method for Msg/UpdateParams
 func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	// Check the authority
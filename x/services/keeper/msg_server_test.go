@@ -354,6 +354,67 @@ func (suite *KeeperTestSuite) TestMsgServer_UpdateService() {
 			),
 			shouldErr: true,
 		},
+		{
+			name: "sender with the metadata role updates the service properly",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgUpdateService(
+				1,
+				"MilkyWay Modular Restaking",
+				types.DoNotModify,
+				types.DoNotModify,
+				types.DoNotModify,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgUpdateServiceResponse{},
+			check: func(ctx sdk.Context) {
+				stored, err := suite.k.GetService(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal("MilkyWay Modular Restaking", stored.Name)
+				suite.Require().Equal("cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd", stored.Admin)
+			},
+		},
+		{
+			name: "sender with another role returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgUpdateService(
+				1,
+				"MilkyWay Modular Restaking",
+				types.DoNotModify,
+				types.DoNotModify,
+				types.DoNotModify,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
 		{
 			name: "valid service is updated properly",
 			store: func(ctx sdk.Context) {
@@ -899,6 +960,9 @@ func (suite *KeeperTestSuite) TestMsgServer_TransferServiceOwnership() {
 					false,
 				))
 				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
 			},
 			msg: types.NewMsgTransferServiceOwnership(
 				1,
@@ -929,6 +993,11 @@ func (suite *KeeperTestSuite) TestMsgServer_TransferServiceOwnership() {
 					"cosmos1d03wa9qd8flfjtvldndw5csv94tvg5hzfcmcgn",
 					false,
 				), stored)
+
+				// Make sure the roles granted by the previous admin were removed
+				hasRole, err := suite.k.HasServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
 			},
 		},
 	}
@@ -1026,7 +1095,29 @@ func (suite *KeeperTestSuite) TestMsgServer_SetServiceParams() {
 			},
 			msg: types.NewMsgSetServiceParams(
 				1,
-				types.NewServiceParams([]string{"1stake"}),
+				types.NewServiceParams([]string{"1stake"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid evidence verifier returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_ACTIVE,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgSetServiceParams(
+				1,
+				types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "invalid", 0),
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr: true,
@@ -1048,7 +1139,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetServiceParams() {
 			},
 			msg: types.NewMsgSetServiceParams(
 				1,
-				types.NewServiceParams([]string{"umilk"}),
+				types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
 				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
 			),
 			shouldErr:   false,
@@ -1065,7 +1156,7 @@ func (suite *KeeperTestSuite) TestMsgServer_SetServiceParams() {
 				stored, err := suite.k.GetServiceParams(ctx, 1)
 				suite.Require().NoError(err)
 				suite.Require().Equal(
-					types.NewServiceParams([]string{"umilk"}),
+					types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
 					stored,
 				)
 			},
@@ -1105,6 +1196,271 @@ func (suite *KeeperTestSuite) TestMsgServer_SetServiceParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgServer_GrantServiceRole() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgGrantServiceRole
+		shouldErr   bool
+		expResponse *types.MsgGrantServiceRoleResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "not found service returns error",
+			msg: types.NewMsgGrantServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non admin sender returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgGrantServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "role granted successfully",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgGrantServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgGrantServiceRoleResponse{},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeGrantServiceRole,
+					sdk.NewAttribute(types.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+					sdk.NewAttribute(types.AttributeKeyRole, types.SERVICE_ROLE_METADATA.String()),
+					sdk.NewAttribute(sdk.AttributeKeySender, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
+
+				// Make sure the other roles are not affected
+				hasRole, err = suite.k.HasServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.GrantServiceRole(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_RevokeServiceRole() {
+	testCases := []struct {
+		name        string
+		store       func(ctx sdk.Context)
+		msg         *types.MsgRevokeServiceRole
+		shouldErr   bool
+		expResponse *types.MsgRevokeServiceRoleResponse
+		expEvents   sdk.Events
+		check       func(ctx sdk.Context)
+	}{
+		{
+			name: "not found service returns error",
+			msg: types.NewMsgRevokeServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "non admin sender returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "not granted role returns error",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr: true,
+		},
+		{
+			name: "role revoked successfully",
+			store: func(ctx sdk.Context) {
+				err := suite.k.SaveService(ctx, types.NewService(
+					1,
+					types.SERVICE_STATUS_CREATED,
+					"MilkyWay",
+					"MilkyWay is a restaking platform",
+					"https://milkyway.com",
+					"https://milkyway.com/logo.png",
+					"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+					false,
+				))
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+
+				err = suite.k.GrantServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+			},
+			msg: types.NewMsgRevokeServiceRole(
+				1,
+				"cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4",
+				types.SERVICE_ROLE_METADATA,
+				"cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd",
+			),
+			shouldErr:   false,
+			expResponse: &types.MsgRevokeServiceRoleResponse{},
+			expEvents: sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRevokeServiceRole,
+					sdk.NewAttribute(types.AttributeKeyServiceID, "1"),
+					sdk.NewAttribute(types.AttributeKeyGrantee, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4"),
+					sdk.NewAttribute(types.AttributeKeyRole, types.SERVICE_ROLE_METADATA.String()),
+					sdk.NewAttribute(sdk.AttributeKeySender, "cosmos13t6y2nnugtshwuy0zkrq287a95lyy8vzleaxmd"),
+				),
+			},
+			check: func(ctx sdk.Context) {
+				hasRole, err := suite.k.HasServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_METADATA)
+				suite.Require().NoError(err)
+				suite.Require().False(hasRole)
+
+				// Make sure the other roles are not affected
+				hasRole, err = suite.k.HasServiceRole(ctx, 1, "cosmos167x6ehhple8gwz5ezy9x0464jltvdpzl6qfdt4", types.SERVICE_ROLE_ALLOW_LIST)
+				suite.Require().NoError(err)
+				suite.Require().True(hasRole)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.store != nil {
+				tc.store(ctx)
+			}
+
+			msgServer := keeper.NewMsgServer(suite.k)
+			res, err := msgServer.RevokeServiceRole(ctx, tc.msg)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResponse, res)
+				for _, event := range tc.expEvents {
+					suite.Require().Contains(ctx.EventManager().Events(), event)
+				}
+
+				if tc.check != nil {
+					tc.check(ctx)
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgServer_UpdateParams() {
 	testCases := []struct {
 		name        string
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+// GrantServiceRole grants the given role of the service with the given ID
+// to the provided grantee
+func (k *Keeper) GrantServiceRole(ctx context.Context, serviceID uint32, grantee string, role types.ServiceRole) error {
+	return k.roleGrants.Set(ctx, collections.Join3(serviceID, grantee, int32(role)))
+}
+
+// RevokeServiceRole revokes the given role of the service with the given ID
+// from the provided grantee
+func (k *Keeper) RevokeServiceRole(ctx context.Context, serviceID uint32, grantee string, role types.ServiceRole) error {
+	return k.roleGrants.Remove(ctx, collections.Join3(serviceID, grantee, int32(role)))
+}
+
+// HasServiceRole returns true if the given role of the service with the
+// given ID has been granted to the provided grantee
+func (k *Keeper) HasServiceRole(ctx context.Context, serviceID uint32, grantee string, role types.ServiceRole) (bool, error) {
+	return k.roleGrants.Has(ctx, collections.Join3(serviceID, grantee, int32(role)))
+}
+
+// HasServicePermission returns true if the given address is allowed to
+// perform the actions associated with the given role on behalf of the
+// service. The service admin is always allowed to perform any action
+func (k *Keeper) HasServicePermission(ctx context.Context, service types.Service, address string, role types.ServiceRole) (bool, error) {
+	if service.Admin == address {
+		return true, nil
+	}
+
+	return k.HasServiceRole(ctx, service.ID, address, role)
+}
+
+// IterateServiceRoleGrants iterates over all the role grants of the service
+// with the given ID and performs the given callback function
+func (k *Keeper) IterateServiceRoleGrants(
+	ctx context.Context, serviceID uint32, cb func(grant types.ServiceRoleGrant) (stop bool, err error),
+) error {
+	ranger := collections.NewPrefixedTripleRange[uint32, string, int32](serviceID)
+	return k.roleGrants.Walk(ctx, ranger, func(key collections.Triple[uint32, string, int32]) (stop bool, err error) {
+		return cb(types.NewServiceRoleGrant(key.K1(), key.K2(), types.ServiceRole(key.K3())))
+	})
+}
+
+// GetAllServiceRoleGrants returns all the role grants stored in the KVStore
+func (k *Keeper) GetAllServiceRoleGrants(ctx context.Context) ([]types.ServiceRoleGrant, error) {
+	var grants []types.ServiceRoleGrant
+	err := k.roleGrants.Walk(ctx, nil, func(key collections.Triple[uint32, string, int32]) (stop bool, err error) {
+		grants = append(grants, types.NewServiceRoleGrant(key.K1(), key.K2(), types.ServiceRole(key.K3())))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// DeleteServiceRoleGrants removes all the role grants of the service with
+// the given ID
+func (k *Keeper) DeleteServiceRoleGrants(ctx context.Context, serviceID uint32) error {
+	ranger := collections.NewPrefixedTripleRange[uint32, string, int32](serviceID)
+	return k.roleGrants.Clear(ctx, ranger)
+}
@@ -135,6 +135,12 @@ func (k *Keeper) DeleteService(ctx context.Context, serviceID uint32) error {
 		return err
 	}
 
+	// Delete the roles granted by the service admin
+	err = k.DeleteServiceRoleGrants(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
 	return k.serviceAddressSet.Remove(ctx, service.Address)
 }
 
@@ -192,5 +198,18 @@ func (k *Keeper) SetServiceParams(ctx context.Context, serviceID uint32, params
 		return err
 	}
 
-	return k.serviceParams.Set(ctx, serviceID, params)
+	// Make sure the evidence verifier is a valid address for this chain
+	if params.EvidenceVerifier != "" {
+		_, err = k.accountKeeper.AddressCodec().StringToBytes(params.EvidenceVerifier)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid evidence verifier address: %s", params.EvidenceVerifier)
+		}
+	}
+
+	err = k.serviceParams.Set(ctx, serviceID, params)
+	if err != nil {
+		return err
+	}
+
+	return k.AfterServiceParamsModified(ctx, serviceID)
 }
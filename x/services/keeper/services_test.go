@@ -476,6 +476,63 @@ func (suite *KeeperTestSuite) TestKeeper_SetServiceAccreditation() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_SetServiceParams() {
+	testCases := []struct {
+		name      string
+		serviceID uint32
+		params    types.ServiceParams
+		shouldErr bool
+		check     func(ctx sdk.Context)
+	}{
+		{
+			name:      "invalid params return error",
+			serviceID: 1,
+			params: func() types.ServiceParams {
+				params := types.DefaultServiceParams()
+				params.AllowedDenoms = []string{"1denom"}
+				return params
+			}(),
+			shouldErr: true,
+			check: func(ctx sdk.Context) {
+				// Make sure the hook wasn't called
+				suite.Require().False(suite.hooks.CalledMap["AfterServiceParamsModified"])
+			},
+		},
+		{
+			name:      "params are set properly",
+			serviceID: 1,
+			params:    types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
+			shouldErr: false,
+			check: func(ctx sdk.Context) {
+				params, err := suite.k.GetServiceParams(ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0), params)
+
+				// Make sure the hook was called
+				suite.Require().True(suite.hooks.CalledMap["AfterServiceParamsModified"])
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			err := suite.k.SetServiceParams(ctx, tc.serviceID, tc.params)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.check != nil {
+				tc.check(ctx)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_GetService() {
 	testCases := []struct {
 		name       string
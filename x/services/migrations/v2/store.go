@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/milkyway-labs/milkyway/v12/x/services/types"
+)
+
+type Keeper interface {
+	GetServices(ctx context.Context) ([]types.Service, error)
+	SaveService(ctx context.Context, service types.Service) error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migrations include:
+// - Populate the services status, admin and accreditation indexes by storing again all the existing services
+func MigrateStore(ctx sdk.Context, k Keeper) error {
+	services, err := k.GetServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		err = k.SaveService(ctx, service)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -39,7 +39,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// Generate random params
 	params := RandomParams(simState.Rand, simState.BondDenom)
 
-	servicesGenesis := types.NewGenesisState(nextServiceID, services, servicesParams, params)
+	servicesGenesis := types.NewGenesisState(nextServiceID, services, servicesParams, nil, params)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(servicesGenesis)
 }
 
@@ -2,7 +2,9 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -47,7 +49,16 @@ func RandomServiceParams(r *rand.Rand) types.ServiceParams {
 		}
 	}
 
-	return types.NewServiceParams(allowedDenom)
+	return types.NewServiceParams(
+		allowedDenom,
+		sdkmath.LegacyNewDec(int64(r.Intn(1000))),
+		uint32(r.Intn(100)),
+		uint64(r.Intn(100)),
+		uint32(r.Intn(10)+1),
+		"",
+		// Start from 3 days so that it's never lower than the slash veto window
+		time.Duration(r.Intn(18*24)+72)*time.Hour,
+	)
 }
 
 // RandomParams returns a random params
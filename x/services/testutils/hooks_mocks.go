@@ -40,3 +40,8 @@ func (m MockHooks) AfterServiceAccreditationModified(_ context.Context, _ uint32
 	m.CalledMap["AfterServiceAccreditationModified"] = true
 	return nil
 }
+
+func (m MockHooks) AfterServiceParamsModified(_ context.Context, _ uint32) error {
+	m.CalledMap["AfterServiceParamsModified"] = true
+	return nil
+}
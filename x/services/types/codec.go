@@ -19,6 +19,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "milkyway/services/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgAccreditService{}, "milkyway/MsgAccreditService")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeServiceAccreditation{}, "milkyway/MsgRevokeServiceAccreditation")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantServiceRole{}, "milkyway/MsgGrantServiceRole")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeServiceRole{}, "milkyway/MsgRevokeServiceRole")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -32,6 +34,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgAccreditService{},
 		&MsgRevokeServiceAccreditation{},
+		&MsgGrantServiceRole{},
+		&MsgRevokeServiceRole{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -12,7 +12,11 @@ const (
 	EventTypeAccreditService            = "accredit_service"
 	EventTypeRevokeServiceAccreditation = "revoke_service_accreditation"
 	EventTypeSetServiceParams           = "set_service_params"
+	EventTypeGrantServiceRole           = "grant_service_role"
+	EventTypeRevokeServiceRole          = "revoke_service_role"
 
 	AttributeKeyServiceID = "service_id"
 	AttributeKeyNewAdmin  = "new_admin"
+	AttributeKeyGrantee   = "grantee"
+	AttributeKeyRole      = "role"
 )
@@ -7,18 +7,25 @@ import (
 )
 
 // NewGenesisState returns a new GenesisState instance
-func NewGenesisState(nextServiceID uint32, services []Service, servicesParams []ServiceParamsRecord, params Params) *GenesisState {
+func NewGenesisState(
+	nextServiceID uint32,
+	services []Service,
+	servicesParams []ServiceParamsRecord,
+	roleGrants []ServiceRoleGrant,
+	params Params,
+) *GenesisState {
 	return &GenesisState{
 		NextServiceID:  nextServiceID,
 		Services:       services,
 		ServicesParams: servicesParams,
+		RoleGrants:     roleGrants,
 		Params:         params,
 	}
 }
 
 // DefaultGenesis returns a default GenesisState
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(1, nil, nil, DefaultParams())
+	return NewGenesisState(1, nil, nil, nil, DefaultParams())
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -56,6 +63,19 @@ func (data *GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated role grants
+	if duplicate := findDuplicatedRoleGrant(data.RoleGrants); duplicate != nil {
+		return fmt.Errorf("duplicated role grant for service %d: %s", duplicate.ServiceID, duplicate.Role)
+	}
+
+	// Validate the role grants
+	for _, grant := range data.RoleGrants {
+		err := grant.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid role grant for service %d: %w", grant.ServiceID, err)
+		}
+	}
+
 	// Validate params
 	err := data.Params.Validate()
 	if err != nil {
@@ -81,6 +101,14 @@ func findDuplicatedServiceParamsRecord(records []ServiceParamsRecord) *ServicePa
 	})
 }
 
+// findDuplicatedRoleGrant returns the first duplicated ServiceRoleGrant in the slice.
+// If no duplicates are found, it returns nil instead.
+func findDuplicatedRoleGrant(grants []ServiceRoleGrant) *ServiceRoleGrant {
+	return utils.FindDuplicateFunc(grants, func(a, b ServiceRoleGrant) bool {
+		return a.ServiceID == b.ServiceID && a.Grantee == b.Grantee && a.Role == b.Role
+	})
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewServiceParamsRecord returns a new ServiceParamsRecord instance.
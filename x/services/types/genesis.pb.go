@@ -34,6 +34,8 @@ type GenesisState struct {
 	NextServiceID uint32 `protobuf:"varint,3,opt,name=next_service_id,json=nextServiceId,proto3" json:"next_service_id,omitempty"`
 	// ServicesParams defines the list of service parameters.
 	ServicesParams []ServiceParamsRecord `protobuf:"bytes,4,rep,name=services_params,json=servicesParams,proto3" json:"services_params"`
+	// RoleGrants defines the list of roles granted by the services admins.
+	RoleGrants []ServiceRoleGrant `protobuf:"bytes,5,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +99,13 @@ func (m *GenesisState) GetServicesParams() []ServiceParamsRecord {
 	return nil
 }
 
+func (m *GenesisState) GetRoleGrants() []ServiceRoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
 // ServiceParamsRecord represents the parameters that have been set for
 // a specific service.
 type ServiceParamsRecord struct {
@@ -165,30 +174,33 @@ func init() {
 }
 
 var fileDescriptor_6ecc30a12329c1a6 = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
+	// 404 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcd, 0xcc, 0xc9,
 	0xae, 0x2c, 0x4f, 0xac, 0xd4, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x2f, 0x33,
 	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0x81, 0xa9, 0xd1, 0x83, 0xa9, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
 	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x97, 0x9b, 0x9f, 0x92, 0x9a, 0x53, 0x8c,
-	0x57, 0x49, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x89, 0xd2, 0x66, 0x26, 0x2e, 0x1e, 0x77, 0x88,
-	0x1b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xac, 0xb8, 0xd8, 0x20, 0x0a, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0xb9, 0x49, 0x2f, 0x00, 0xac, 0xc6, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x0e, 0xa1, 0x20, 0x2e, 0x0e, 0x98, 0x1a, 0x09, 0x26, 0x05, 0x66, 0x0d,
-	0x6e, 0x23, 0x59, 0xec, 0xba, 0x83, 0x21, 0x6c, 0x27, 0x71, 0x90, 0xf6, 0x4f, 0xf7, 0xe4, 0xf9,
-	0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x60, 0x4a, 0x94, 0x82, 0xe0, 0xe6, 0x08, 0x59, 0x72, 0xf1,
-	0xe7, 0xa5, 0x56, 0x94, 0xc4, 0x43, 0x05, 0xe2, 0x33, 0x53, 0x24, 0x98, 0x15, 0x18, 0x35, 0x78,
-	0x9d, 0x04, 0x1f, 0xdd, 0x93, 0xe7, 0xf5, 0x4b, 0xad, 0x28, 0x81, 0x1a, 0xe6, 0xe9, 0x12, 0xc4,
-	0x9b, 0x87, 0xc4, 0x4d, 0x11, 0x8a, 0xe0, 0xe2, 0x87, 0x19, 0x13, 0x0f, 0xf5, 0x13, 0x0b, 0xd8,
-	0x55, 0x9a, 0x78, 0x5d, 0x05, 0xf1, 0x5a, 0x50, 0x6a, 0x72, 0x7e, 0x51, 0x0a, 0xd4, 0x83, 0x7c,
-	0x30, 0x65, 0x10, 0x39, 0xa5, 0x36, 0x46, 0x2e, 0x61, 0x2c, 0xaa, 0x85, 0x74, 0xb8, 0xb8, 0x90,
-	0xdc, 0xc9, 0x08, 0x76, 0x27, 0xef, 0xa3, 0x7b, 0xf2, 0x9c, 0x08, 0x37, 0x72, 0x16, 0xc3, 0xdd,
-	0xe7, 0x08, 0x0f, 0x6a, 0x26, 0x70, 0x50, 0x2b, 0x13, 0xe1, 0x2c, 0xd4, 0x10, 0x77, 0x0a, 0x38,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x98, 0xb1, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x70, 0x9e, 0x7e,
-	0x99, 0xa1, 0x91, 0x7e, 0x05, 0x22, 0x69, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xd3,
-	0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x65, 0xe2, 0x19, 0x19, 0xaf, 0x02, 0x00, 0x00,
+	0x57, 0x49, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x89, 0x52, 0x13, 0x33, 0x17, 0x8f, 0x3b, 0xc4,
+	0x0d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x56, 0x5c, 0x6c, 0x10, 0x05, 0x12, 0x8c, 0x0a, 0x8c,
+	0x1a, 0xdc, 0x46, 0x32, 0x7a, 0xd8, 0xdc, 0xa4, 0x17, 0x00, 0x56, 0xe3, 0xc4, 0x72, 0xe2, 0x9e,
+	0x3c, 0x43, 0x10, 0x54, 0x87, 0x50, 0x10, 0x17, 0x07, 0x4c, 0x8d, 0x04, 0x93, 0x02, 0xb3, 0x06,
+	0xb7, 0x91, 0x2c, 0x76, 0xdd, 0xc1, 0x10, 0xb6, 0x93, 0x38, 0x48, 0xfb, 0xa7, 0x7b, 0xf2, 0xfc,
+	0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0x30, 0x25, 0x4a, 0x41, 0x70, 0x73, 0x84, 0x2c, 0xb9, 0xf8,
+	0xf3, 0x52, 0x2b, 0x4a, 0xe2, 0xa1, 0x02, 0xf1, 0x99, 0x29, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xbc,
+	0x4e, 0x82, 0x8f, 0xee, 0xc9, 0xf3, 0xfa, 0xa5, 0x56, 0x94, 0x40, 0x0d, 0xf3, 0x74, 0x09, 0xe2,
+	0xcd, 0x43, 0xe2, 0xa6, 0x08, 0x45, 0x70, 0xf1, 0xc3, 0x8c, 0x89, 0x87, 0xfa, 0x89, 0x05, 0xec,
+	0x2a, 0x4d, 0xbc, 0xae, 0x82, 0x78, 0x2d, 0x28, 0x35, 0x39, 0xbf, 0x28, 0x05, 0xea, 0x41, 0x3e,
+	0x98, 0x32, 0x88, 0x9c, 0x90, 0x2f, 0x17, 0x77, 0x51, 0x7e, 0x4e, 0x6a, 0x7c, 0x7a, 0x51, 0x62,
+	0x5e, 0x49, 0xb1, 0x04, 0x2b, 0xd8, 0x54, 0x35, 0xbc, 0xa6, 0x06, 0xe5, 0xe7, 0xa4, 0xba, 0x83,
+	0x94, 0x43, 0x8d, 0xe4, 0x2a, 0x82, 0x09, 0x14, 0x2b, 0xb5, 0x31, 0x72, 0x09, 0x63, 0xb1, 0x5c,
+	0x48, 0x87, 0x8b, 0x0b, 0xc9, 0xdb, 0x8c, 0x60, 0x6f, 0xf3, 0x3e, 0xba, 0x27, 0xcf, 0x89, 0xf0,
+	0x32, 0x67, 0x31, 0xdc, 0xbb, 0x8e, 0xf0, 0x98, 0x63, 0x02, 0xc7, 0x9c, 0x32, 0x11, 0xbe, 0x44,
+	0x8d, 0x40, 0xa7, 0x80, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
+	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4b,
+	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x19, 0xab, 0x9b, 0x93, 0x98,
+	0x54, 0x0c, 0xe7, 0xe9, 0x97, 0x19, 0x1a, 0xe9, 0x57, 0x20, 0x52, 0x5a, 0x49, 0x65, 0x41, 0x6a,
+	0x71, 0x12, 0x1b, 0x38, 0x99, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xb2, 0xdc, 0xe7,
+	0xfe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -211,6 +223,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ServicesParams) > 0 {
 		for iNdEx := len(m.ServicesParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +355,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +533,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, ServiceRoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
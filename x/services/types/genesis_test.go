@@ -91,7 +91,7 @@ func TestValidateGenesis(t *testing.T) {
 				NextServiceID: 1,
 				Services:      nil,
 				ServicesParams: []types.ServiceParamsRecord{
-					types.NewServiceParamsRecord(0, types.NewServiceParams([]string{"umilk"})),
+					types.NewServiceParamsRecord(0, types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0)),
 				},
 				Params: types.DefaultParams(),
 			},
@@ -18,6 +18,7 @@ type ServicesHooks interface {
 	AfterServiceActivated(ctx context.Context, serviceID uint32) error             // Must be called after a service is registered
 	AfterServiceDeactivated(ctx context.Context, serviceID uint32) error           // Must be called after a service is deregistered
 	AfterServiceAccreditationModified(ctx context.Context, serviceID uint32) error // Must be called after a service accreditation is changed
+	AfterServiceParamsModified(ctx context.Context, serviceID uint32) error        // Must be called after a service params are changed
 	BeforeServiceDeleted(ctx context.Context, serviceID uint32) error              // Must be called before a service is deleted
 }
 
@@ -73,6 +74,16 @@ func (m MultiServicesHooks) AfterServiceAccreditationModified(ctx context.Contex
 	return nil
 }
 
+// AfterServiceParamsModified implements ServicesHooks
+func (m MultiServicesHooks) AfterServiceParamsModified(ctx context.Context, serviceID uint32) error {
+	for _, hook := range m {
+		if err := hook.AfterServiceParamsModified(ctx, serviceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BeforeServiceDeleted implements ServicesHooks
 func (m MultiServicesHooks) BeforeServiceDeleted(ctx context.Context, serviceID uint32) error {
 	for _, hook := range m {
@@ -14,10 +14,14 @@ const (
 var (
 	ParamsKey = []byte{0x01}
 
-	NextServiceIDKey        = []byte{0xa1}
-	ServicePrefix           = []byte{0xa2}
-	ServiceAddressSetPrefix = []byte{0xa3}
-	ServiceParamsPrefix     = []byte{0xa4}
+	NextServiceIDKey             = []byte{0xa1}
+	ServicePrefix                = []byte{0xa2}
+	ServiceAddressSetPrefix      = []byte{0xa3}
+	ServiceParamsPrefix          = []byte{0xa4}
+	ServiceStatusIndexPrefix     = []byte{0xa5}
+	ServiceAdminIndexPrefix      = []byte{0xa6}
+	ServiceAccreditedIndexPrefix = []byte{0xa7}
+	ServiceRoleGrantPrefix       = []byte{0xa8}
 )
 
 // GetServiceIDBytes returns the byte representation of the service ID
@@ -260,6 +260,84 @@ func (msg *MsgDeleteService) GetSigners() []sdk.AccAddress {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgGrantServiceRole creates a new MsgGrantServiceRole instance
+func NewMsgGrantServiceRole(serviceID uint32, grantee string, role ServiceRole, sender string) *MsgGrantServiceRole {
+	return &MsgGrantServiceRole{
+		ServiceID: serviceID,
+		Grantee:   grantee,
+		Role:      role,
+		Sender:    sender,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgGrantServiceRole) ValidateBasic() error {
+	grant := NewServiceRoleGrant(msg.ServiceID, msg.Grantee, msg.Role)
+	err := grant.Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgGrantServiceRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgGrantServiceRole) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRevokeServiceRole creates a new MsgRevokeServiceRole instance
+func NewMsgRevokeServiceRole(serviceID uint32, grantee string, role ServiceRole, sender string) *MsgRevokeServiceRole {
+	return &MsgRevokeServiceRole{
+		ServiceID: serviceID,
+		Grantee:   grantee,
+		Role:      role,
+		Sender:    sender,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRevokeServiceRole) ValidateBasic() error {
+	grant := NewServiceRoleGrant(msg.ServiceID, msg.Grantee, msg.Role)
+	err := grant.Validate()
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRevokeServiceRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRevokeServiceRole) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
 	return &MsgUpdateParams{
 		Authority: authority,
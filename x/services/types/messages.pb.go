@@ -799,6 +799,230 @@ func (m *MsgSetServiceParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetServiceParamsResponse proto.InternalMessageInfo
 
+// MsgGrantServiceRole defines the message structure for the GrantServiceRole
+// gRPC service method. It allows a service admin to grant one of the service
+// roles to another account.
+type MsgGrantServiceRole struct {
+	// Sender is the address of the service admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ServiceID represents the ID of the service
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Grantee is the address of the account that will be granted the role
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role to be granted
+	Role ServiceRole `protobuf:"varint,4,opt,name=role,proto3,enum=milkyway.services.v1.ServiceRole" json:"role,omitempty"`
+}
+
+func (m *MsgGrantServiceRole) Reset()         { *m = MsgGrantServiceRole{} }
+func (m *MsgGrantServiceRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantServiceRole) ProtoMessage()    {}
+func (*MsgGrantServiceRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47bb30a8b53f4868, []int{14}
+}
+func (m *MsgGrantServiceRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantServiceRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantServiceRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantServiceRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantServiceRole.Merge(m, src)
+}
+func (m *MsgGrantServiceRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantServiceRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantServiceRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantServiceRole proto.InternalMessageInfo
+
+func (m *MsgGrantServiceRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgGrantServiceRole) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
+}
+
+func (m *MsgGrantServiceRole) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgGrantServiceRole) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return SERVICE_ROLE_UNSPECIFIED
+}
+
+// MsgGrantServiceRoleResponse is the return value of MsgGrantServiceRole.
+type MsgGrantServiceRoleResponse struct {
+}
+
+func (m *MsgGrantServiceRoleResponse) Reset()         { *m = MsgGrantServiceRoleResponse{} }
+func (m *MsgGrantServiceRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantServiceRoleResponse) ProtoMessage()    {}
+func (*MsgGrantServiceRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47bb30a8b53f4868, []int{15}
+}
+func (m *MsgGrantServiceRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantServiceRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantServiceRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantServiceRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantServiceRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantServiceRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantServiceRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantServiceRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantServiceRoleResponse proto.InternalMessageInfo
+
+// MsgRevokeServiceRole defines the message structure for the
+// RevokeServiceRole gRPC service method. It allows a service admin to revoke a
+// role previously granted to another account.
+type MsgRevokeServiceRole struct {
+	// Sender is the address of the service admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ServiceID represents the ID of the service
+	ServiceID uint32 `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Grantee is the address of the account whose role will be revoked
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role to be revoked
+	Role ServiceRole `protobuf:"varint,4,opt,name=role,proto3,enum=milkyway.services.v1.ServiceRole" json:"role,omitempty"`
+}
+
+func (m *MsgRevokeServiceRole) Reset()         { *m = MsgRevokeServiceRole{} }
+func (m *MsgRevokeServiceRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeServiceRole) ProtoMessage()    {}
+func (*MsgRevokeServiceRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47bb30a8b53f4868, []int{16}
+}
+func (m *MsgRevokeServiceRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeServiceRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeServiceRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeServiceRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeServiceRole.Merge(m, src)
+}
+func (m *MsgRevokeServiceRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeServiceRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeServiceRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeServiceRole proto.InternalMessageInfo
+
+func (m *MsgRevokeServiceRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRevokeServiceRole) GetServiceID() uint32 {
+	if m != nil {
+		return m.ServiceID
+	}
+	return 0
+}
+
+func (m *MsgRevokeServiceRole) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgRevokeServiceRole) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return SERVICE_ROLE_UNSPECIFIED
+}
+
+// MsgRevokeServiceRoleResponse is the return value of MsgRevokeServiceRole.
+type MsgRevokeServiceRoleResponse struct {
+}
+
+func (m *MsgRevokeServiceRoleResponse) Reset()         { *m = MsgRevokeServiceRoleResponse{} }
+func (m *MsgRevokeServiceRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeServiceRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeServiceRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47bb30a8b53f4868, []int{17}
+}
+func (m *MsgRevokeServiceRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeServiceRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeServiceRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeServiceRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeServiceRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeServiceRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeServiceRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeServiceRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeServiceRoleResponse proto.InternalMessageInfo
+
 // MsgDeactivateService defines the message structure for the UpdateParams gRPC
 // service method. It allows the authority to update the module parameters.
 type MsgUpdateParams struct {
@@ -815,7 +1039,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{14}
+	return fileDescriptor_47bb30a8b53f4868, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +1090,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{15}
+	return fileDescriptor_47bb30a8b53f4868, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1133,7 @@ func (m *MsgAccreditService) Reset()         { *m = MsgAccreditService{} }
 func (m *MsgAccreditService) String() string { return proto.CompactTextString(m) }
 func (*MsgAccreditService) ProtoMessage()    {}
 func (*MsgAccreditService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{16}
+	return fileDescriptor_47bb30a8b53f4868, []int{20}
 }
 func (m *MsgAccreditService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,7 +1184,7 @@ func (m *MsgAccreditServiceResponse) Reset()         { *m = MsgAccreditServiceRe
 func (m *MsgAccreditServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAccreditServiceResponse) ProtoMessage()    {}
 func (*MsgAccreditServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{17}
+	return fileDescriptor_47bb30a8b53f4868, []int{21}
 }
 func (m *MsgAccreditServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1229,7 @@ func (m *MsgRevokeServiceAccreditation) Reset()         { *m = MsgRevokeServiceA
 func (m *MsgRevokeServiceAccreditation) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeServiceAccreditation) ProtoMessage()    {}
 func (*MsgRevokeServiceAccreditation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{18}
+	return fileDescriptor_47bb30a8b53f4868, []int{22}
 }
 func (m *MsgRevokeServiceAccreditation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1281,7 @@ func (m *MsgRevokeServiceAccreditationResponse) Reset()         { *m = MsgRevoke
 func (m *MsgRevokeServiceAccreditationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeServiceAccreditationResponse) ProtoMessage()    {}
 func (*MsgRevokeServiceAccreditationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47bb30a8b53f4868, []int{19}
+	return fileDescriptor_47bb30a8b53f4868, []int{23}
 }
 func (m *MsgRevokeServiceAccreditationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,6 +1325,10 @@ func init() {
 	proto.RegisterType((*MsgTransferServiceOwnershipResponse)(nil), "milkyway.services.v1.MsgTransferServiceOwnershipResponse")
 	proto.RegisterType((*MsgSetServiceParams)(nil), "milkyway.services.v1.MsgSetServiceParams")
 	proto.RegisterType((*MsgSetServiceParamsResponse)(nil), "milkyway.services.v1.MsgSetServiceParamsResponse")
+	proto.RegisterType((*MsgGrantServiceRole)(nil), "milkyway.services.v1.MsgGrantServiceRole")
+	proto.RegisterType((*MsgGrantServiceRoleResponse)(nil), "milkyway.services.v1.MsgGrantServiceRoleResponse")
+	proto.RegisterType((*MsgRevokeServiceRole)(nil), "milkyway.services.v1.MsgRevokeServiceRole")
+	proto.RegisterType((*MsgRevokeServiceRoleResponse)(nil), "milkyway.services.v1.MsgRevokeServiceRoleResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "milkyway.services.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "milkyway.services.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgAccreditService)(nil), "milkyway.services.v1.MsgAccreditService")
@@ -1114,78 +1342,85 @@ func init() {
 }
 
 var fileDescriptor_47bb30a8b53f4868 = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbf, 0x73, 0xe3, 0x54,
-	0x10, 0x8e, 0xf2, 0xc3, 0xe0, 0x75, 0x9c, 0xe4, 0x44, 0x86, 0x73, 0x74, 0x89, 0x7d, 0xa7, 0x5c,
-	0x42, 0x2e, 0xc4, 0x52, 0xec, 0x83, 0x1b, 0xce, 0x54, 0xf1, 0x85, 0x02, 0x06, 0x43, 0x46, 0x21,
-	0x0d, 0x8d, 0x47, 0xb6, 0x5e, 0x14, 0x11, 0x4b, 0xf2, 0xe8, 0xc9, 0x36, 0xee, 0x18, 0x2a, 0x86,
-	0xe2, 0x86, 0x7f, 0x80, 0x82, 0x82, 0x86, 0x2a, 0x05, 0x05, 0x15, 0xf5, 0x75, 0xdc, 0x30, 0x14,
-	0x54, 0x86, 0x71, 0x8a, 0xcc, 0x50, 0x66, 0x86, 0x86, 0x8a, 0xf1, 0xd3, 0x93, 0x2c, 0xc9, 0x96,
-	0x70, 0x02, 0x17, 0x9a, 0xc4, 0xda, 0xfd, 0xf6, 0xed, 0x7e, 0xbb, 0xab, 0xdd, 0x27, 0x58, 0xd7,
-	0xb5, 0xc6, 0x69, 0xb7, 0x23, 0x77, 0x45, 0x8c, 0xac, 0xb6, 0x56, 0x47, 0x58, 0x6c, 0x17, 0x44,
-	0x1d, 0x61, 0x2c, 0xab, 0x08, 0x0b, 0x4d, 0xcb, 0xb4, 0x4d, 0x76, 0xd9, 0x05, 0x09, 0x2e, 0x48,
-	0x68, 0x17, 0xb8, 0x5b, 0xb2, 0xae, 0x19, 0xa6, 0x48, 0xfe, 0x3a, 0x40, 0x2e, 0x5b, 0x37, 0xb1,
-	0x6e, 0x62, 0xb1, 0x26, 0x63, 0x24, 0xb6, 0x0b, 0x35, 0x64, 0xcb, 0x05, 0xb1, 0x6e, 0x6a, 0x06,
-	0xd5, 0xdf, 0xa6, 0x7a, 0x1d, 0xab, 0xc4, 0x0d, 0x56, 0xa9, 0x62, 0xc5, 0x51, 0x54, 0xc9, 0x93,
-	0xe8, 0x3c, 0x50, 0xd5, 0xb2, 0x6a, 0xaa, 0xa6, 0x23, 0x1f, 0xfc, 0xa2, 0xd2, 0x7b, 0xe3, 0xe3,
-	0x36, 0x15, 0xd4, 0xc0, 0xb1, 0x90, 0xa6, 0x6c, 0xc9, 0x3a, 0x85, 0xf0, 0x3f, 0xcc, 0xc0, 0x52,
-	0x05, 0xab, 0x4f, 0x2c, 0x24, 0xdb, 0xe8, 0xd0, 0x41, 0xb1, 0xbb, 0x90, 0xc0, 0xc8, 0x50, 0x90,
-	0x95, 0x61, 0xee, 0x32, 0x5b, 0xc9, 0x72, 0xe6, 0xe7, 0xef, 0xf3, 0xcb, 0x34, 0xa4, 0x3d, 0x45,
-	0xb1, 0x10, 0xc6, 0x87, 0xb6, 0xa5, 0x19, 0xaa, 0x44, 0x71, 0xec, 0x3a, 0xcc, 0x1a, 0xb2, 0x8e,
-	0x32, 0xd3, 0x04, 0xbf, 0x78, 0xd9, 0xcb, 0xa5, 0xba, 0xb2, 0xde, 0x28, 0xf1, 0x03, 0x29, 0x2f,
-	0x11, 0x25, 0xfb, 0x16, 0xa4, 0x14, 0x84, 0xeb, 0x96, 0xd6, 0xb4, 0x35, 0xd3, 0xc8, 0xcc, 0x10,
-	0xec, 0xab, 0x97, 0xbd, 0x1c, 0xeb, 0x60, 0x7d, 0x4a, 0x5e, 0xf2, 0x43, 0xd9, 0x1d, 0x78, 0xa9,
-	0x83, 0x6a, 0x58, 0xb3, 0x51, 0x66, 0x96, 0x58, 0xb1, 0x97, 0xbd, 0xdc, 0x82, 0x63, 0x45, 0x15,
-	0xbc, 0xe4, 0x42, 0xd8, 0x77, 0x20, 0xd5, 0xd4, 0xea, 0x76, 0xcb, 0x42, 0xd5, 0x96, 0xd5, 0xc8,
-	0xcc, 0x11, 0x8b, 0xfb, 0xfd, 0x5e, 0x0e, 0x0e, 0x1c, 0xf1, 0x91, 0xf4, 0xfe, 0xd0, 0xab, 0x0f,
-	0xca, 0x4b, 0x40, 0x9f, 0x8e, 0xac, 0x06, 0xfb, 0x09, 0xc0, 0x31, 0x42, 0x55, 0x59, 0x37, 0x5b,
-	0x86, 0x9d, 0x49, 0xdc, 0x9d, 0xd9, 0x4a, 0x15, 0x57, 0x04, 0x9a, 0x86, 0x41, 0x7d, 0x05, 0x5a,
-	0x5f, 0xe1, 0x89, 0xa9, 0x19, 0xe5, 0xdd, 0x67, 0xbd, 0xdc, 0xd4, 0x77, 0xbf, 0xe5, 0xb6, 0x54,
-	0xcd, 0x3e, 0x69, 0xd5, 0x84, 0xba, 0xa9, 0xd3, 0x32, 0xd2, 0x7f, 0x79, 0xac, 0x9c, 0x8a, 0x76,
-	0xb7, 0x89, 0x30, 0x31, 0xc0, 0x52, 0xf2, 0x18, 0xa1, 0x3d, 0x72, 0x7a, 0xa9, 0xf8, 0xf9, 0xc5,
-	0xd9, 0x36, 0x4d, 0xe6, 0x97, 0x17, 0x67, 0xdb, 0xfc, 0x68, 0xe5, 0xc2, 0x55, 0xe2, 0x25, 0xc8,
-	0x84, 0x65, 0x12, 0xc2, 0x4d, 0xd3, 0xc0, 0x88, 0x7d, 0x04, 0x0b, 0x06, 0xea, 0x54, 0xa9, 0x71,
-	0x55, 0x53, 0x48, 0x25, 0xd3, 0xe5, 0xa5, 0x7e, 0x2f, 0x37, 0xff, 0x01, 0xea, 0x50, 0xfc, 0xbb,
-	0xfb, 0xd2, 0xbc, 0x31, 0x7c, 0x52, 0xf8, 0xbf, 0xa6, 0x49, 0x3b, 0x1c, 0x35, 0x95, 0x7f, 0xd5,
-	0x0e, 0x3b, 0x00, 0x3e, 0xd7, 0xd3, 0xc4, 0x75, 0xba, 0xdf, 0xcb, 0x25, 0x87, 0x7e, 0x93, 0xd8,
-	0x75, 0xea, 0x35, 0xcf, 0xcc, 0x15, 0x9a, 0x67, 0xf6, 0x5a, 0xcd, 0x33, 0x77, 0xe5, 0xe6, 0x49,
-	0x5c, 0xaf, 0x79, 0x26, 0x2c, 0x68, 0x20, 0xcf, 0x3c, 0x47, 0x0a, 0x1a, 0x90, 0xb9, 0x05, 0xe5,
-	0xbf, 0x61, 0x80, 0xad, 0x60, 0x75, 0xaf, 0x6e, 0x6b, 0xed, 0x9b, 0x2b, 0x4d, 0xe9, 0xf5, 0x10,
-	0x8d, 0x3b, 0x1e, 0x8d, 0xd1, 0x60, 0xf8, 0x55, 0xe0, 0x46, 0xa5, 0x1e, 0x83, 0x6f, 0x19, 0x58,
-	0xae, 0x60, 0x75, 0x1f, 0xc9, 0x37, 0xcc, 0x21, 0x1f, 0xe2, 0xb0, 0xe6, 0xe7, 0x30, 0x12, 0x0e,
-	0x9f, 0x85, 0xd5, 0x71, 0x72, 0x8f, 0xc7, 0xd7, 0x0c, 0x79, 0x45, 0xf6, 0x51, 0x03, 0xdd, 0x1c,
-	0x87, 0x07, 0x21, 0x0e, 0x2b, 0x41, 0x0e, 0xbe, 0x50, 0x68, 0x17, 0x05, 0x64, 0x5e, 0xec, 0x7f,
-	0x30, 0x70, 0xa7, 0x82, 0xd5, 0x8f, 0x2c, 0xd9, 0xc0, 0xc7, 0xc8, 0xa2, 0xea, 0x0f, 0x3b, 0x06,
-	0xb2, 0xf0, 0x89, 0xd6, 0x7c, 0xe1, 0x6f, 0xfa, 0x9b, 0x90, 0x1c, 0x8c, 0x25, 0x59, 0xd1, 0x35,
-	0x77, 0xfe, 0x47, 0xbb, 0x78, 0xd9, 0x40, 0x9d, 0xbd, 0x01, 0xb2, 0xf4, 0x46, 0x88, 0xfd, 0x7d,
-	0x3f, 0xfb, 0x28, 0x32, 0xfc, 0x06, 0xac, 0xc7, 0xa8, 0xbd, 0x9c, 0xfc, 0xc9, 0xc0, 0x2b, 0x15,
-	0xac, 0x1e, 0x22, 0x9b, 0x42, 0x0e, 0xc8, 0x7e, 0x7c, 0xe1, 0xb9, 0x38, 0x80, 0x05, 0x17, 0xed,
-	0x6c, 0x64, 0x92, 0x90, 0x54, 0x71, 0x5d, 0x18, 0x77, 0xd7, 0x10, 0x02, 0xc1, 0x95, 0x67, 0x07,
-	0xcb, 0x46, 0x4a, 0x63, 0xbf, 0xb0, 0xb4, 0x13, 0x4a, 0xd3, 0xaa, 0x3f, 0x4d, 0x61, 0x7e, 0xfc,
-	0x1a, 0x69, 0x85, 0xb0, 0xd8, 0x4b, 0xcb, 0x4f, 0x0c, 0x2c, 0x7a, 0xd3, 0x88, 0xa6, 0xe4, 0x3d,
-	0x48, 0xca, 0x2d, 0xfb, 0xc4, 0xb4, 0x34, 0xbb, 0x4b, 0xb3, 0xb2, 0x73, 0xd9, 0xcb, 0x2d, 0x39,
-	0xb3, 0xd0, 0x53, 0xf1, 0x91, 0x99, 0x1a, 0x9a, 0xb3, 0x25, 0x48, 0x50, 0xda, 0xd3, 0x84, 0xf6,
-	0xea, 0x78, 0xda, 0x01, 0xbe, 0xd4, 0xc2, 0xe9, 0x87, 0xe1, 0x59, 0x03, 0xae, 0xf7, 0x62, 0xe6,
-	0x2b, 0x25, 0xbc, 0x02, 0xb7, 0x43, 0x22, 0x8f, 0xec, 0x8f, 0xee, 0x74, 0xad, 0x5b, 0x48, 0xd1,
-	0xdc, 0x8c, 0xfc, 0xa7, 0x7c, 0xaf, 0xf6, 0xbe, 0x0b, 0xa3, 0x0c, 0x43, 0xa3, 0x37, 0x10, 0xa9,
-	0x37, 0x7a, 0x03, 0x52, 0x8f, 0xde, 0x2f, 0x0c, 0xac, 0x55, 0xb0, 0x2a, 0xa1, 0xb6, 0x79, 0xea,
-	0xce, 0x04, 0x17, 0x2b, 0x93, 0x1d, 0xf9, 0xff, 0x31, 0x7d, 0x3c, 0xca, 0x74, 0xd3, 0xcf, 0x34,
-	0x3a, 0x68, 0xfe, 0x35, 0xd8, 0x88, 0x05, 0xb8, 0xfc, 0x8b, 0x4f, 0x93, 0x30, 0x53, 0xc1, 0x2a,
-	0xab, 0x42, 0x3a, 0x78, 0xd1, 0xdd, 0x1c, 0xdf, 0x74, 0xe1, 0x6b, 0x15, 0x27, 0x4c, 0x86, 0xf3,
-	0xae, 0x5f, 0x2a, 0xa4, 0x83, 0x57, 0xa8, 0x68, 0x47, 0x01, 0x5c, 0x8c, 0xa3, 0xb1, 0xd7, 0x02,
-	0x56, 0x87, 0xc5, 0xf0, 0x95, 0x60, 0x2b, 0xf2, 0x88, 0x10, 0x92, 0xdb, 0x9d, 0x14, 0xe9, 0xb9,
-	0xc3, 0x70, 0x6b, 0x74, 0x7f, 0x6f, 0x47, 0x1e, 0x33, 0x82, 0xe5, 0x8a, 0x93, 0x63, 0xfd, 0xc9,
-	0x0c, 0x2e, 0xdb, 0xcd, 0x98, 0x43, 0x7c, 0xb8, 0x98, 0x64, 0x8e, 0xdd, 0x8e, 0xec, 0x17, 0x0c,
-	0x64, 0x22, 0x57, 0x63, 0x21, 0xf2, 0xb0, 0x28, 0x13, 0xee, 0xf1, 0x95, 0x4d, 0xbc, 0x50, 0x9a,
-	0xb0, 0x34, 0xb2, 0x90, 0x1e, 0x44, 0x1e, 0x17, 0x86, 0x72, 0x85, 0x89, 0xa1, 0x9e, 0x47, 0x05,
-	0xe6, 0x03, 0xb3, 0x7e, 0xe3, 0x1f, 0x3a, 0x91, 0x7a, 0xca, 0x4f, 0x04, 0x0b, 0xf6, 0x6b, 0x70,
-	0xc8, 0xc6, 0xf5, 0x6b, 0x00, 0x19, 0xdb, 0xaf, 0x63, 0x07, 0x1f, 0xfb, 0x94, 0x01, 0x2e, 0x66,
-	0xea, 0x3d, 0x8c, 0x3c, 0x30, 0xda, 0x88, 0x7b, 0xfb, 0x1a, 0x46, 0x6e, 0x40, 0xdc, 0xdc, 0x67,
-	0x17, 0x67, 0xdb, 0x4c, 0xf9, 0xe0, 0x59, 0x3f, 0xcb, 0x3c, 0xef, 0x67, 0x99, 0xdf, 0xfb, 0x59,
-	0xe6, 0xab, 0xf3, 0xec, 0xd4, 0xf3, 0xf3, 0xec, 0xd4, 0xaf, 0xe7, 0xd9, 0xa9, 0x8f, 0x1f, 0xf9,
-	0xbe, 0x1e, 0x5d, 0x3f, 0xf9, 0x86, 0x5c, 0xc3, 0xde, 0x93, 0xd8, 0x2e, 0x14, 0xc5, 0x4f, 0x87,
-	0x6b, 0x8e, 0x7c, 0x51, 0xd6, 0x12, 0xe4, 0x73, 0xfe, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe5, 0x7a, 0xa9, 0x8e, 0xce, 0x10, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xbd, 0x6f, 0xe4, 0x44,
+	0x14, 0x8f, 0x37, 0x1f, 0xc7, 0xbe, 0x7c, 0x9e, 0x89, 0xb8, 0x8d, 0x2f, 0xd9, 0xbd, 0x38, 0x97,
+	0x90, 0x0b, 0x89, 0x9d, 0xdd, 0xe3, 0x4e, 0xdc, 0x52, 0x25, 0x17, 0x84, 0x40, 0x2c, 0x44, 0x0e,
+	0x69, 0x68, 0x22, 0xef, 0x7a, 0xe2, 0x98, 0xac, 0xed, 0x95, 0xc7, 0xd9, 0x25, 0x1d, 0xa2, 0x42,
+	0x14, 0xa7, 0xfb, 0x07, 0x28, 0x28, 0x68, 0xa8, 0x52, 0x50, 0x50, 0x51, 0x5f, 0xc7, 0x09, 0x51,
+	0x50, 0x2d, 0x68, 0x53, 0x44, 0xa2, 0x8c, 0x74, 0x0d, 0x15, 0xf2, 0x78, 0xec, 0xf5, 0xc7, 0xda,
+	0xb7, 0x09, 0x24, 0x48, 0xd7, 0x24, 0x99, 0x37, 0xbf, 0x99, 0xf7, 0x7e, 0xef, 0x63, 0xde, 0x8b,
+	0x61, 0x41, 0xd7, 0xea, 0x87, 0xc7, 0x2d, 0xf9, 0x58, 0xc4, 0xc8, 0x6a, 0x6a, 0x35, 0x84, 0xc5,
+	0x66, 0x51, 0xd4, 0x11, 0xc6, 0xb2, 0x8a, 0xb0, 0xd0, 0xb0, 0x4c, 0xdb, 0x64, 0xa7, 0x3d, 0x90,
+	0xe0, 0x81, 0x84, 0x66, 0x91, 0xbb, 0x29, 0xeb, 0x9a, 0x61, 0x8a, 0xe4, 0xa7, 0x0b, 0xe4, 0xf2,
+	0x35, 0x13, 0xeb, 0x26, 0x16, 0xab, 0x32, 0x46, 0x62, 0xb3, 0x58, 0x45, 0xb6, 0x5c, 0x14, 0x6b,
+	0xa6, 0x66, 0xd0, 0xfd, 0x5b, 0x74, 0x5f, 0xc7, 0x2a, 0x51, 0x83, 0x55, 0xba, 0x31, 0xe3, 0x6e,
+	0xec, 0x91, 0x95, 0xe8, 0x2e, 0xe8, 0xd6, 0xb4, 0x6a, 0xaa, 0xa6, 0x2b, 0x77, 0xfe, 0xa2, 0xd2,
+	0xf9, 0xde, 0x76, 0x9b, 0x0a, 0xaa, 0xe3, 0x54, 0x48, 0x43, 0xb6, 0x64, 0x9d, 0x42, 0xf8, 0x9f,
+	0x06, 0x61, 0xaa, 0x82, 0xd5, 0xc7, 0x16, 0x92, 0x6d, 0xb4, 0xe3, 0xa2, 0xd8, 0x75, 0x18, 0xc1,
+	0xc8, 0x50, 0x90, 0x95, 0x63, 0xee, 0x30, 0xcb, 0xd9, 0xcd, 0xdc, 0xaf, 0x3f, 0xae, 0x4d, 0x53,
+	0x93, 0x36, 0x14, 0xc5, 0x42, 0x18, 0xef, 0xd8, 0x96, 0x66, 0xa8, 0x12, 0xc5, 0xb1, 0x0b, 0x30,
+	0x64, 0xc8, 0x3a, 0xca, 0x65, 0x08, 0x7e, 0xf2, 0xbc, 0x5d, 0x18, 0x3d, 0x96, 0xf5, 0x7a, 0x99,
+	0x77, 0xa4, 0xbc, 0x44, 0x36, 0xd9, 0x77, 0x60, 0x54, 0x41, 0xb8, 0x66, 0x69, 0x0d, 0x5b, 0x33,
+	0x8d, 0xdc, 0x20, 0xc1, 0xbe, 0x71, 0xde, 0x2e, 0xb0, 0x2e, 0x36, 0xb0, 0xc9, 0x4b, 0x41, 0x28,
+	0xbb, 0x0a, 0x37, 0x5a, 0xa8, 0x8a, 0x35, 0x1b, 0xe5, 0x86, 0xc8, 0x29, 0xf6, 0xbc, 0x5d, 0x98,
+	0x70, 0x4f, 0xd1, 0x0d, 0x5e, 0xf2, 0x20, 0xec, 0x7b, 0x30, 0xda, 0xd0, 0x6a, 0xf6, 0x91, 0x85,
+	0xf6, 0x8e, 0xac, 0x7a, 0x6e, 0x98, 0x9c, 0xb8, 0xdb, 0x69, 0x17, 0x60, 0xdb, 0x15, 0xef, 0x4a,
+	0x1f, 0x75, 0xb5, 0x06, 0xa0, 0xbc, 0x04, 0x74, 0xb5, 0x6b, 0xd5, 0xd9, 0xcf, 0x01, 0xf6, 0x11,
+	0xda, 0x93, 0x75, 0xf3, 0xc8, 0xb0, 0x73, 0x23, 0x77, 0x06, 0x97, 0x47, 0x4b, 0x33, 0x02, 0x75,
+	0x83, 0x13, 0x5f, 0x81, 0xc6, 0x57, 0x78, 0x6c, 0x6a, 0xc6, 0xe6, 0xfa, 0xb3, 0x76, 0x61, 0xe0,
+	0x87, 0x3f, 0x0a, 0xcb, 0xaa, 0x66, 0x1f, 0x1c, 0x55, 0x85, 0x9a, 0xa9, 0xd3, 0x30, 0xd2, 0x5f,
+	0x6b, 0x58, 0x39, 0x14, 0xed, 0xe3, 0x06, 0xc2, 0xe4, 0x00, 0x96, 0xb2, 0xfb, 0x08, 0x6d, 0x90,
+	0xdb, 0xcb, 0xa5, 0xaf, 0xce, 0x4e, 0x56, 0xa8, 0x33, 0xbf, 0x39, 0x3b, 0x59, 0xe1, 0xe3, 0x91,
+	0x8b, 0x46, 0x89, 0x97, 0x20, 0x17, 0x95, 0x49, 0x08, 0x37, 0x4c, 0x03, 0x23, 0xf6, 0x21, 0x4c,
+	0x18, 0xa8, 0xb5, 0x47, 0x0f, 0xef, 0x69, 0x0a, 0x89, 0xe4, 0xf8, 0xe6, 0x54, 0xa7, 0x5d, 0x18,
+	0xfb, 0x18, 0xb5, 0x28, 0xfe, 0x83, 0x2d, 0x69, 0xcc, 0xe8, 0xae, 0x14, 0xfe, 0xef, 0x0c, 0x49,
+	0x87, 0xdd, 0x86, 0xf2, 0xaf, 0xd2, 0x61, 0x15, 0x20, 0xa0, 0x3a, 0x43, 0x54, 0x8f, 0x77, 0xda,
+	0x85, 0x6c, 0x57, 0x6f, 0x16, 0x7b, 0x4a, 0xfd, 0xe4, 0x19, 0xbc, 0x40, 0xf2, 0x0c, 0x5d, 0x2a,
+	0x79, 0x86, 0x2f, 0x9c, 0x3c, 0x23, 0x97, 0x4b, 0x9e, 0x3e, 0x03, 0x1a, 0xf2, 0x33, 0xcf, 0x91,
+	0x80, 0x86, 0x64, 0x5e, 0x40, 0xf9, 0xef, 0x18, 0x60, 0x2b, 0x58, 0xdd, 0xa8, 0xd9, 0x5a, 0xf3,
+	0xfa, 0x42, 0x53, 0x7e, 0x2b, 0x42, 0xe3, 0xb6, 0x4f, 0x23, 0x6e, 0x0c, 0x3f, 0x0b, 0x5c, 0x5c,
+	0xea, 0x33, 0xf8, 0x9e, 0x81, 0xe9, 0x0a, 0x56, 0xb7, 0x90, 0x7c, 0xcd, 0x1c, 0xd6, 0x22, 0x1c,
+	0xe6, 0x82, 0x1c, 0x62, 0xe6, 0xf0, 0x79, 0x98, 0xed, 0x25, 0xf7, 0x79, 0x7c, 0xcb, 0x90, 0x12,
+	0xd9, 0x42, 0x75, 0x74, 0x7d, 0x1c, 0xee, 0x45, 0x38, 0xcc, 0x84, 0x39, 0x04, 0x4c, 0xa1, 0x59,
+	0x14, 0x92, 0xf9, 0xb6, 0xff, 0xc5, 0xc0, 0xed, 0x0a, 0x56, 0x3f, 0xb5, 0x64, 0x03, 0xef, 0x23,
+	0x8b, 0x6e, 0x7f, 0xd2, 0x32, 0x90, 0x85, 0x0f, 0xb4, 0xc6, 0x95, 0x57, 0xfa, 0x03, 0xc8, 0x3a,
+	0xcf, 0x92, 0xac, 0xe8, 0x9a, 0xf7, 0xfe, 0x27, 0xab, 0x78, 0xcd, 0x40, 0xad, 0x0d, 0x07, 0x59,
+	0x7e, 0x3b, 0xc2, 0xfe, 0x6e, 0x90, 0x7d, 0x12, 0x19, 0x7e, 0x11, 0x16, 0x52, 0xb6, 0x7d, 0x9f,
+	0xbc, 0x60, 0xe0, 0xf5, 0x0a, 0x56, 0x77, 0x90, 0x4d, 0x21, 0xdb, 0xa4, 0x3f, 0x5e, 0xb9, 0x2f,
+	0xb6, 0x61, 0xc2, 0x43, 0xbb, 0x1d, 0x99, 0x38, 0x64, 0xb4, 0xb4, 0x20, 0xf4, 0x9a, 0x35, 0x84,
+	0x90, 0x71, 0x9b, 0x43, 0x4e, 0xb3, 0x91, 0xc6, 0x71, 0x50, 0x58, 0x5e, 0x8d, 0xb8, 0x69, 0x36,
+	0xe8, 0xa6, 0x28, 0x3f, 0x7e, 0x8e, 0xa4, 0x42, 0x54, 0xec, 0xbb, 0xe5, 0x49, 0x86, 0xb8, 0xe5,
+	0x7d, 0x4b, 0x36, 0x3c, 0x84, 0x64, 0xd6, 0xaf, 0xbe, 0x19, 0x94, 0xe0, 0x86, 0xea, 0xe8, 0x44,
+	0xe8, 0xa5, 0x09, 0xe2, 0x01, 0xd9, 0x07, 0x30, 0x64, 0x99, 0x75, 0x77, 0x36, 0x98, 0x28, 0xcd,
+	0xa7, 0x3a, 0xd0, 0x21, 0x21, 0x11, 0x78, 0xba, 0xbf, 0xa2, 0xc4, 0xa9, 0xbf, 0xa2, 0x62, 0xdf,
+	0x5f, 0x4f, 0x33, 0xe4, 0x79, 0x93, 0x50, 0xd3, 0x3c, 0x44, 0xaf, 0xa8, 0xc3, 0x52, 0x5f, 0xd2,
+	0x18, 0x73, 0xfa, 0x92, 0xc6, 0xe4, 0xbe, 0xcb, 0x7e, 0x61, 0x60, 0xd2, 0x6f, 0x78, 0xb4, 0xea,
+	0x3e, 0x84, 0xac, 0x7c, 0x64, 0x1f, 0x98, 0x96, 0x66, 0x1f, 0x53, 0x87, 0xad, 0x9e, 0xb7, 0x0b,
+	0x53, 0x6e, 0xbb, 0xf5, 0xb7, 0xf8, 0x44, 0x8e, 0xdd, 0xe3, 0x6c, 0x19, 0x46, 0x68, 0x65, 0x65,
+	0x48, 0x65, 0xcd, 0xf6, 0xe6, 0x19, 0x2a, 0x29, 0x7a, 0xc2, 0x7d, 0x72, 0xba, 0x77, 0x39, 0x6c,
+	0xe7, 0x53, 0x5a, 0x38, 0xad, 0xa9, 0x19, 0xb8, 0x15, 0x11, 0xf9, 0x64, 0x7f, 0xf6, 0x1a, 0x78,
+	0xcd, 0x42, 0x8a, 0xe6, 0xa5, 0xd0, 0x7f, 0xca, 0xf7, 0x62, 0x2d, 0x45, 0x88, 0x33, 0x8c, 0x74,
+	0xf7, 0x90, 0xa5, 0x7e, 0x77, 0x0f, 0x49, 0x7d, 0x7a, 0xbf, 0x31, 0x30, 0x17, 0x0d, 0xb6, 0x87,
+	0x95, 0xc9, 0x18, 0xf6, 0xff, 0x31, 0x7d, 0x14, 0x67, 0xba, 0x94, 0x98, 0xb9, 0x21, 0xa3, 0xf9,
+	0x37, 0x61, 0x31, 0x15, 0xe0, 0xf1, 0x2f, 0xbd, 0x00, 0x18, 0xac, 0x60, 0x95, 0x55, 0x61, 0x3c,
+	0xfc, 0xbf, 0xd4, 0x52, 0xef, 0xa4, 0x8b, 0x4e, 0xee, 0x9c, 0xd0, 0x1f, 0xce, 0x9f, 0xf0, 0x55,
+	0x18, 0x0f, 0x4f, 0xe9, 0xc9, 0x8a, 0x42, 0xb8, 0x14, 0x45, 0x3d, 0x27, 0x4f, 0x56, 0x87, 0xc9,
+	0xe8, 0xd4, 0xb9, 0x9c, 0x78, 0x45, 0x04, 0xc9, 0xad, 0xf7, 0x8b, 0xf4, 0xd5, 0x61, 0xb8, 0x19,
+	0x1f, 0x11, 0x57, 0x12, 0xaf, 0x89, 0x61, 0xb9, 0x52, 0xff, 0xd8, 0xa0, 0x33, 0xc3, 0xf3, 0xdc,
+	0x52, 0xca, 0x25, 0x01, 0x5c, 0x8a, 0x33, 0x7b, 0x0e, 0x60, 0xec, 0xd7, 0x0c, 0xe4, 0x12, 0xa7,
+	0xaf, 0x62, 0xe2, 0x65, 0x49, 0x47, 0xb8, 0x47, 0x17, 0x3e, 0xe2, 0x9b, 0xd2, 0x80, 0xa9, 0xd8,
+	0xcc, 0x73, 0x2f, 0xf1, 0xba, 0x28, 0x94, 0x2b, 0xf6, 0x0d, 0x0d, 0x6a, 0x8c, 0x8d, 0x13, 0xc9,
+	0x1a, 0xa3, 0xd0, 0x14, 0x8d, 0x49, 0x4d, 0xd9, 0x49, 0xa6, 0x78, 0x43, 0x4e, 0x4e, 0xa6, 0x18,
+	0x36, 0x25, 0x99, 0x12, 0xdb, 0x1a, 0xab, 0xc0, 0x58, 0xa8, 0xa5, 0x2d, 0xbe, 0xa4, 0xe0, 0xa8,
+	0x43, 0xd7, 0xfa, 0x82, 0x85, 0xcb, 0x32, 0xdc, 0x4b, 0xd2, 0xca, 0x32, 0x84, 0x4c, 0x2d, 0xcb,
+	0x9e, 0xef, 0x3b, 0xfb, 0x84, 0x01, 0x2e, 0xe5, 0x71, 0xbf, 0xdf, 0x9f, 0x9f, 0x42, 0x87, 0xb8,
+	0x77, 0x2f, 0x71, 0xc8, 0x33, 0x88, 0x1b, 0xfe, 0xf2, 0xec, 0x64, 0x85, 0xd9, 0xdc, 0x7e, 0xd6,
+	0xc9, 0x33, 0xcf, 0x3b, 0x79, 0xe6, 0xcf, 0x4e, 0x9e, 0x79, 0x7a, 0x9a, 0x1f, 0x78, 0x7e, 0x9a,
+	0x1f, 0xf8, 0xfd, 0x34, 0x3f, 0xf0, 0xd9, 0xc3, 0xc0, 0x77, 0x18, 0x4f, 0xcf, 0x5a, 0x5d, 0xae,
+	0x62, 0x7f, 0x25, 0x36, 0x8b, 0x25, 0xf1, 0x8b, 0x6e, 0x37, 0x27, 0xdf, 0x66, 0xaa, 0x23, 0xe4,
+	0xc3, 0xd8, 0xfd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x76, 0xca, 0xcc, 0x6f, 0x18, 0x14, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1219,6 +1454,12 @@ type MsgClient interface {
 	// SetServiceParams defines the operation for setting a service's
 	// parameters.
 	SetServiceParams(ctx context.Context, in *MsgSetServiceParams, opts ...grpc.CallOption) (*MsgSetServiceParamsResponse, error)
+	// GrantServiceRole defines the operation for granting a role of a service
+	// to another account.
+	GrantServiceRole(ctx context.Context, in *MsgGrantServiceRole, opts ...grpc.CallOption) (*MsgGrantServiceRoleResponse, error)
+	// RevokeServiceRole defines the operation for revoking a role of a service
+	// previously granted to another account.
+	RevokeServiceRole(ctx context.Context, in *MsgRevokeServiceRole, opts ...grpc.CallOption) (*MsgRevokeServiceRoleResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -1302,6 +1543,24 @@ func (c *msgClient) SetServiceParams(ctx context.Context, in *MsgSetServiceParam
 	return out, nil
 }
 
+func (c *msgClient) GrantServiceRole(ctx context.Context, in *MsgGrantServiceRole, opts ...grpc.CallOption) (*MsgGrantServiceRoleResponse, error) {
+	out := new(MsgGrantServiceRoleResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.services.v1.Msg/GrantServiceRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeServiceRole(ctx context.Context, in *MsgRevokeServiceRole, opts ...grpc.CallOption) (*MsgRevokeServiceRoleResponse, error) {
+	out := new(MsgRevokeServiceRoleResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.services.v1.Msg/RevokeServiceRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.services.v1.Msg/UpdateParams", in, out, opts...)
@@ -1350,6 +1609,12 @@ type MsgServer interface {
 	// SetServiceParams defines the operation for setting a service's
 	// parameters.
 	SetServiceParams(context.Context, *MsgSetServiceParams) (*MsgSetServiceParamsResponse, error)
+	// GrantServiceRole defines the operation for granting a role of a service
+	// to another account.
+	GrantServiceRole(context.Context, *MsgGrantServiceRole) (*MsgGrantServiceRoleResponse, error)
+	// RevokeServiceRole defines the operation for revoking a role of a service
+	// previously granted to another account.
+	RevokeServiceRole(context.Context, *MsgRevokeServiceRole) (*MsgRevokeServiceRoleResponse, error)
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters.
 	// The authority defaults to the x/gov module account.
@@ -1387,6 +1652,12 @@ func (*UnimplementedMsgServer) TransferServiceOwnership(ctx context.Context, req
 func (*UnimplementedMsgServer) SetServiceParams(ctx context.Context, req *MsgSetServiceParams) (*MsgSetServiceParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetServiceParams not implemented")
 }
+func (*UnimplementedMsgServer) GrantServiceRole(ctx context.Context, req *MsgGrantServiceRole) (*MsgGrantServiceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantServiceRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeServiceRole(ctx context.Context, req *MsgRevokeServiceRole) (*MsgRevokeServiceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceRole not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1527,6 +1798,42 @@ func _Msg_SetServiceParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantServiceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantServiceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantServiceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.services.v1.Msg/GrantServiceRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantServiceRole(ctx, req.(*MsgGrantServiceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeServiceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeServiceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeServiceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.services.v1.Msg/RevokeServiceRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeServiceRole(ctx, req.(*MsgRevokeServiceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1614,6 +1921,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetServiceParams",
 			Handler:    _Msg_SetServiceParams_Handler,
 		},
+		{
+			MethodName: "GrantServiceRole",
+			Handler:    _Msg_GrantServiceRole_Handler,
+		},
+		{
+			MethodName: "RevokeServiceRole",
+			Handler:    _Msg_RevokeServiceRole_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -2124,7 +2439,7 @@ func (m *MsgSetServiceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantServiceRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2134,37 +2449,44 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantServiceRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantServiceRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMessages(dAtA, i, uint64(size))
+	if m.Role != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantServiceRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2174,12 +2496,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantServiceRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantServiceRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2187,7 +2509,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAccreditService) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokeServiceRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2197,32 +2519,44 @@ func (m *MsgAccreditService) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAccreditService) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokeServiceRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAccreditService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokeServiceRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Role != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ServiceID != 0 {
 		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAccreditServiceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokeServiceRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2232,12 +2566,12 @@ func (m *MsgAccreditServiceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAccreditServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokeServiceRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAccreditServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokeServiceRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2245,7 +2579,7 @@ func (m *MsgAccreditServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeServiceAccreditation) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2255,20 +2589,141 @@ func (m *MsgRevokeServiceAccreditation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeServiceAccreditation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeServiceAccreditation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ServiceID != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccreditService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccreditService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccreditService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccreditServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccreditServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccreditServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeServiceAccreditation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeServiceAccreditation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeServiceAccreditation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -2533,6 +2988,70 @@ func (m *MsgSetServiceParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgGrantServiceRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovMessages(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgGrantServiceRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeServiceRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ServiceID != 0 {
+		n += 1 + sovMessages(uint64(m.ServiceID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovMessages(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRevokeServiceRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4025,6 +4544,410 @@ func (m *MsgSetServiceParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGrantServiceRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantServiceRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantServiceRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantServiceRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantServiceRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantServiceRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeServiceRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeServiceRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeServiceRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeServiceRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeServiceRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeServiceRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,9 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -247,15 +249,29 @@ func (s *Service) Update(update ServiceUpdate) Service {
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewServiceParams returns a new ServiceParams instance.
-func NewServiceParams(allowedDenoms []string) ServiceParams {
+func NewServiceParams(
+	allowedDenoms []string,
+	minOperatorStakeUSD math.LegacyDec,
+	maxActiveOperators uint32,
+	snapshotInterval uint64,
+	snapshotRetention uint32,
+	evidenceVerifier string,
+	unbondingTime time.Duration,
+) ServiceParams {
 	return ServiceParams{
-		AllowedDenoms: allowedDenoms,
+		AllowedDenoms:       allowedDenoms,
+		MinOperatorStakeUSD: minOperatorStakeUSD,
+		MaxActiveOperators:  maxActiveOperators,
+		SnapshotInterval:    snapshotInterval,
+		SnapshotRetention:   snapshotRetention,
+		EvidenceVerifier:    evidenceVerifier,
+		UnbondingTime:       unbondingTime,
 	}
 }
 
 // DefaultServiceParams returns the default ServiceParams instance.
 func DefaultServiceParams() ServiceParams {
-	return NewServiceParams(nil)
+	return NewServiceParams(nil, math.LegacyZeroDec(), 0, 0, 0, "", 0)
 }
 
 func (p *ServiceParams) Validate() error {
@@ -266,5 +282,66 @@ func (p *ServiceParams) Validate() error {
 		}
 	}
 
+	// A nil value is allowed for params stored before the field was introduced,
+	// and it is treated as zero
+	if !p.MinOperatorStakeUSD.IsNil() && p.MinOperatorStakeUSD.IsNegative() {
+		return fmt.Errorf("invalid min operator stake usd: %s", p.MinOperatorStakeUSD)
+	}
+
+	if p.SnapshotInterval > 0 && p.SnapshotRetention == 0 {
+		return fmt.Errorf("snapshot retention must be positive when snapshots are enabled")
+	}
+
+	if p.EvidenceVerifier != "" {
+		_, err := sdk.AccAddressFromBech32(p.EvidenceVerifier)
+		if err != nil {
+			return fmt.Errorf("invalid evidence verifier address: %s, %w", p.EvidenceVerifier, err)
+		}
+	}
+
+	if p.UnbondingTime < 0 {
+		return fmt.Errorf("invalid unbonding time: %s", p.UnbondingTime)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IsValidServiceRole returns true if the given role is one of the roles that
+// can be granted by a service admin
+func IsValidServiceRole(role ServiceRole) bool {
+	switch role {
+	case SERVICE_ROLE_METADATA, SERVICE_ROLE_ALLOW_LIST, SERVICE_ROLE_REWARDS_PLANS:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewServiceRoleGrant creates a new ServiceRoleGrant instance
+func NewServiceRoleGrant(serviceID uint32, grantee string, role ServiceRole) ServiceRoleGrant {
+	return ServiceRoleGrant{
+		ServiceID: serviceID,
+		Grantee:   grantee,
+		Role:      role,
+	}
+}
+
+// Validate checks that the ServiceRoleGrant has valid values.
+func (g *ServiceRoleGrant) Validate() error {
+	if g.ServiceID == 0 {
+		return fmt.Errorf("invalid service ID: %d", g.ServiceID)
+	}
+
+	_, err := sdk.AccAddressFromBech32(g.Grantee)
+	if err != nil {
+		return fmt.Errorf("invalid grantee address: %s", g.Grantee)
+	}
+
+	if !IsValidServiceRole(g.Role) {
+		return fmt.Errorf("invalid role: %s", g.Role)
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -13,12 +14,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -63,6 +66,45 @@ func (ServiceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4411e719afee9a70, []int{0}
 }
 
+// ServiceRole defines the permissions that the admin of a service can grant
+// to other accounts
+type ServiceRole int32
+
+const (
+	// SERVICE_ROLE_UNSPECIFIED defines an unspecified role
+	SERVICE_ROLE_UNSPECIFIED ServiceRole = 0
+	// SERVICE_ROLE_METADATA allows to update the service details
+	SERVICE_ROLE_METADATA ServiceRole = 1
+	// SERVICE_ROLE_ALLOW_LIST allows to manage the list of operators allowed to
+	// join the service
+	SERVICE_ROLE_ALLOW_LIST ServiceRole = 2
+	// SERVICE_ROLE_REWARDS_PLANS allows to create and edit the rewards plans of
+	// the service
+	SERVICE_ROLE_REWARDS_PLANS ServiceRole = 3
+)
+
+var ServiceRole_name = map[int32]string{
+	0: "SERVICE_ROLE_UNSPECIFIED",
+	1: "SERVICE_ROLE_METADATA",
+	2: "SERVICE_ROLE_ALLOW_LIST",
+	3: "SERVICE_ROLE_REWARDS_PLANS",
+}
+
+var ServiceRole_value = map[string]int32{
+	"SERVICE_ROLE_UNSPECIFIED":   0,
+	"SERVICE_ROLE_METADATA":      1,
+	"SERVICE_ROLE_ALLOW_LIST":    2,
+	"SERVICE_ROLE_REWARDS_PLANS": 3,
+}
+
+func (x ServiceRole) String() string {
+	return proto.EnumName(ServiceRole_name, int32(x))
+}
+
+func (ServiceRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4411e719afee9a70, []int{1}
+}
+
 // Service defines the fields of a service
 type Service struct {
 	// ID is the unique identifier of the service
@@ -136,6 +178,31 @@ type ServiceParams struct {
 	// AllowedDenoms defines the list of denoms that can be restaked toward
 	// the service. If the list is empty, any denom can be used.
 	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// MinOperatorStakeUSD defines the minimum USD value of the stake (self plus
+	// delegated) that an operator must have in order to join the service and to
+	// be part of its active operators set. If set to 0, no minimum is required.
+	MinOperatorStakeUSD cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_operator_stake_usd,json=minOperatorStakeUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_operator_stake_usd"`
+	// MaxActiveOperators defines the maximum number of operators that can be
+	// part of the service's active operators set. If set to 0, there is no
+	// limit to the number of active operators.
+	MaxActiveOperators uint32 `protobuf:"varint,3,opt,name=max_active_operators,json=maxActiveOperators,proto3" json:"max_active_operators,omitempty"`
+	// SnapshotInterval defines the number of blocks between two consecutive
+	// snapshots of the service's validating set. If set to 0, no snapshots are
+	// taken.
+	SnapshotInterval uint64 `protobuf:"varint,4,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// SnapshotRetention defines the number of most recent snapshots of the
+	// service's validating set that are kept. Older snapshots are pruned.
+	SnapshotRetention uint32 `protobuf:"varint,5,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// EvidenceVerifier defines the address of the CosmWasm contract used to
+	// verify the evidences of the operators' misbehaviors submitted for the
+	// service. If empty, the evidences are verified by the handlers registered
+	// inside the restaking module.
+	EvidenceVerifier string `protobuf:"bytes,6,opt,name=evidence_verifier,json=evidenceVerifier,proto3" json:"evidence_verifier,omitempty"`
+	// UnbondingTime defines the time that it takes for the assets securing the
+	// service to be unbonded. When a delegation secures multiple services, the
+	// longest unbonding time among them is used. If set to 0, the unbonding time
+	// set inside the restaking module params is used.
+	UnbondingTime time.Duration `protobuf:"varint,7,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time,omitempty"`
 }
 
 func (m *ServiceParams) Reset()         { *m = ServiceParams{} }
@@ -171,55 +238,122 @@ func (m *ServiceParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ServiceParams proto.InternalMessageInfo
 
+// ServiceRoleGrant represents a role that has been granted by the admin of a
+// service to another account.
+type ServiceRoleGrant struct {
+	// ServiceID is the ID of the service.
+	ServiceID uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Grantee is the address of the account that has been granted the role.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Role is the role that has been granted.
+	Role ServiceRole `protobuf:"varint,3,opt,name=role,proto3,enum=milkyway.services.v1.ServiceRole" json:"role,omitempty"`
+}
+
+func (m *ServiceRoleGrant) Reset()         { *m = ServiceRoleGrant{} }
+func (m *ServiceRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*ServiceRoleGrant) ProtoMessage()    {}
+func (*ServiceRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4411e719afee9a70, []int{2}
+}
+func (m *ServiceRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceRoleGrant.Merge(m, src)
+}
+func (m *ServiceRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceRoleGrant proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("milkyway.services.v1.ServiceStatus", ServiceStatus_name, ServiceStatus_value)
+	proto.RegisterEnum("milkyway.services.v1.ServiceRole", ServiceRole_name, ServiceRole_value)
 	proto.RegisterType((*Service)(nil), "milkyway.services.v1.Service")
 	proto.RegisterType((*ServiceParams)(nil), "milkyway.services.v1.ServiceParams")
+	proto.RegisterType((*ServiceRoleGrant)(nil), "milkyway.services.v1.ServiceRoleGrant")
 }
 
 func init() { proto.RegisterFile("milkyway/services/v1/models.proto", fileDescriptor_4411e719afee9a70) }
 
 var fileDescriptor_4411e719afee9a70 = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0x95, 0x6c, 0xc7, 0x4e, 0xc6, 0x24, 0x9f, 0x19, 0xf2, 0xa5, 0x13, 0xb7, 0xc8, 0x6a, 0x4a,
-	0x41, 0xb4, 0x44, 0xaa, 0x1d, 0xc8, 0xa6, 0x2b, 0xff, 0xa8, 0x60, 0x28, 0x21, 0x48, 0x76, 0x16,
-	0xdd, 0x98, 0xb1, 0x66, 0x70, 0x86, 0x48, 0x1a, 0xa3, 0x19, 0x3b, 0x0d, 0xf4, 0x01, 0xda, 0x5d,
-	0xdf, 0xa1, 0xbb, 0xae, 0xfb, 0x0c, 0x25, 0xcb, 0xd0, 0x55, 0x57, 0x69, 0xeb, 0xbc, 0x48, 0xc9,
-	0x68, 0x9c, 0x86, 0x10, 0x68, 0x57, 0xd2, 0x3d, 0xe7, 0xdc, 0x39, 0x87, 0xb9, 0x73, 0xc1, 0xe3,
-	0x84, 0xc5, 0x27, 0x67, 0xa7, 0xf8, 0xcc, 0x13, 0x34, 0x9b, 0xb3, 0x88, 0x0a, 0x6f, 0xde, 0xf4,
-	0x12, 0x4e, 0x68, 0x2c, 0xdc, 0x69, 0xc6, 0x25, 0x87, 0x9b, 0x4b, 0x89, 0xbb, 0x94, 0xb8, 0xf3,
-	0x66, 0xdd, 0x8a, 0xb8, 0x48, 0xb8, 0xf0, 0xc6, 0x58, 0x50, 0x6f, 0xde, 0x1c, 0x53, 0x89, 0x9b,
-	0x5e, 0xc4, 0x59, 0x9a, 0x77, 0xd5, 0xb7, 0x73, 0x7e, 0xa4, 0x2a, 0x2f, 0x2f, 0x34, 0xb5, 0x39,
-	0xe1, 0x13, 0x9e, 0xe3, 0xd7, 0x7f, 0x39, 0xba, 0xf3, 0xb5, 0x04, 0x2a, 0x61, 0x6e, 0x00, 0xb7,
-	0x40, 0x81, 0x11, 0x64, 0xda, 0xa6, 0xb3, 0xde, 0x29, 0x2f, 0x2e, 0x1b, 0x85, 0x7e, 0x2f, 0x28,
-	0x30, 0x02, 0x5f, 0x82, 0xb2, 0x90, 0x58, 0xce, 0x04, 0x2a, 0xd8, 0xa6, 0xb3, 0xd1, 0x7a, 0xe2,
-	0xde, 0x97, 0xcd, 0xd5, 0xc7, 0x84, 0x4a, 0x1a, 0xe8, 0x16, 0xe8, 0x82, 0x15, 0x4c, 0x12, 0x96,
-	0xa2, 0xa2, 0x6d, 0x3a, 0x6b, 0x1d, 0xf4, 0xed, 0xcb, 0xee, 0xa6, 0xce, 0xd5, 0x26, 0x24, 0xa3,
-	0x42, 0x84, 0x32, 0x63, 0xe9, 0x24, 0xc8, 0x65, 0x10, 0x82, 0x52, 0x8a, 0x13, 0x8a, 0x4a, 0xd7,
-	0xf2, 0x40, 0xfd, 0x43, 0x1b, 0x54, 0x09, 0x15, 0x51, 0xc6, 0xa6, 0x92, 0xf1, 0x14, 0xad, 0x28,
-	0xea, 0x36, 0x04, 0x11, 0xa8, 0x9c, 0xd2, 0xb1, 0x60, 0x92, 0xa2, 0xb2, 0x62, 0x97, 0x25, 0xf4,
-	0x40, 0x75, 0xca, 0x22, 0x39, 0xcb, 0xe8, 0x68, 0x96, 0xc5, 0xa8, 0xa2, 0x52, 0x6c, 0x2c, 0x2e,
-	0x1b, 0xe0, 0x30, 0x87, 0x87, 0xc1, 0xeb, 0x00, 0x68, 0xc9, 0x30, 0x8b, 0x61, 0x0b, 0x54, 0x70,
-	0x1e, 0x0c, 0xad, 0xfe, 0x25, 0xf2, 0x52, 0x08, 0x23, 0x50, 0x96, 0xfc, 0x84, 0xa6, 0x02, 0xad,
-	0xd9, 0x45, 0xa7, 0xda, 0xda, 0x76, 0xb5, 0xfe, 0x7a, 0x4e, 0xae, 0x9e, 0x93, 0xdb, 0xe5, 0x2c,
-	0xed, 0xbc, 0x38, 0xbf, 0x6c, 0x18, 0x9f, 0x7f, 0x34, 0x9c, 0x09, 0x93, 0xc7, 0xb3, 0xb1, 0x1b,
-	0xf1, 0x44, 0xcf, 0x49, 0x7f, 0x76, 0x05, 0x39, 0xf1, 0xe4, 0xd9, 0x94, 0x0a, 0xd5, 0x20, 0x02,
-	0x7d, 0x34, 0x7c, 0x07, 0x6a, 0x84, 0xc6, 0x74, 0x82, 0x25, 0xcf, 0x46, 0xe2, 0x18, 0x67, 0x54,
-	0x20, 0xa0, 0xec, 0x1e, 0xdd, 0x6b, 0xd7, 0xa3, 0x91, 0x72, 0xdc, 0xd3, 0x8e, 0xcf, 0xff, 0xc1,
-	0x51, 0xf7, 0x88, 0xe0, 0xbf, 0x1b, 0xab, 0x50, 0x39, 0x41, 0x0b, 0x00, 0x1c, 0x45, 0x19, 0x25,
-	0x4c, 0x52, 0x82, 0xaa, 0xb6, 0xe9, 0xac, 0x06, 0xb7, 0x90, 0x9d, 0x7d, 0xb0, 0xae, 0x1f, 0xc0,
-	0x21, 0xce, 0x70, 0x22, 0xe0, 0x53, 0xb0, 0x81, 0xe3, 0x98, 0x9f, 0x52, 0x32, 0x22, 0x34, 0xe5,
-	0x89, 0x40, 0xa6, 0x5d, 0x74, 0xd6, 0x82, 0x75, 0x8d, 0xf6, 0x14, 0xf8, 0xec, 0x83, 0x79, 0xd3,
-	0x98, 0xbf, 0x1c, 0x68, 0x81, 0x7a, 0xe8, 0x07, 0x47, 0xfd, 0xae, 0x3f, 0x0a, 0x07, 0xed, 0xc1,
-	0x30, 0x1c, 0x0d, 0x0f, 0xc2, 0x43, 0xbf, 0xdb, 0x7f, 0xd5, 0xf7, 0x7b, 0x35, 0x03, 0xd6, 0xc1,
-	0xd6, 0x1d, 0xbe, 0x1b, 0xf8, 0xed, 0x81, 0xdf, 0xab, 0x99, 0x70, 0x1b, 0xfc, 0x7f, 0x87, 0x6b,
-	0x77, 0x07, 0xfd, 0x23, 0xbf, 0x56, 0x80, 0x0f, 0xc1, 0x83, 0x3b, 0x54, 0xff, 0x40, 0x93, 0xc5,
-	0x7a, 0xe9, 0xfd, 0x27, 0xcb, 0xe8, 0x0c, 0xce, 0x7f, 0x59, 0xc6, 0xf9, 0xc2, 0x32, 0x2f, 0x16,
-	0x96, 0xf9, 0x73, 0x61, 0x99, 0x1f, 0xaf, 0x2c, 0xe3, 0xe2, 0xca, 0x32, 0xbe, 0x5f, 0x59, 0xc6,
-	0x9b, 0xfd, 0x5b, 0xf7, 0xb7, 0x5c, 0x80, 0xdd, 0x18, 0x8f, 0xc5, 0x4d, 0xe5, 0xcd, 0x9b, 0x2d,
-	0xef, 0xed, 0x9f, 0x9d, 0x56, 0x77, 0x3a, 0x2e, 0xab, 0x4d, 0xdb, 0xfb, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0xf6, 0x42, 0xb2, 0xb4, 0xf5, 0x03, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x6c, 0xbb, 0x99, 0x2a, 0x25, 0x3b, 0x5b, 0x16, 0xb7, 0xbb, 0x72, 0xb2, 0x45,
+	0x48, 0x11, 0xa5, 0xf6, 0xb6, 0x2b, 0xb8, 0x70, 0x72, 0x6a, 0x03, 0x91, 0xb2, 0x6d, 0x35, 0x4e,
+	0xbb, 0x12, 0x17, 0x6b, 0x62, 0x3f, 0xd2, 0x51, 0xfd, 0x23, 0xf2, 0x4c, 0xd2, 0x56, 0x82, 0x3b,
+	0xdc, 0x38, 0x72, 0xe7, 0x06, 0xd7, 0x95, 0xf8, 0x0f, 0x50, 0x8f, 0xab, 0x3d, 0x21, 0x0e, 0x59,
+	0x48, 0xff, 0x11, 0x94, 0xf1, 0x38, 0x44, 0xd5, 0xaa, 0x70, 0xb2, 0xe7, 0x7d, 0xdf, 0x7b, 0xdf,
+	0xe7, 0x79, 0x6f, 0xc6, 0xe8, 0x69, 0xcc, 0xa2, 0xf3, 0xab, 0x0b, 0x7a, 0x65, 0x71, 0xc8, 0x26,
+	0x2c, 0x00, 0x6e, 0x4d, 0xf6, 0xac, 0x38, 0x0d, 0x21, 0xe2, 0xe6, 0x28, 0x4b, 0x45, 0x8a, 0x37,
+	0x0a, 0x8a, 0x59, 0x50, 0xcc, 0xc9, 0xde, 0x96, 0x11, 0xa4, 0x3c, 0x4e, 0xb9, 0x35, 0xa0, 0x1c,
+	0xac, 0xc9, 0xde, 0x00, 0x04, 0xdd, 0xb3, 0x82, 0x94, 0x25, 0x79, 0xd6, 0xd6, 0x66, 0x8e, 0xfb,
+	0x72, 0x65, 0xe5, 0x0b, 0x05, 0x6d, 0x0c, 0xd3, 0x61, 0x9a, 0xc7, 0xe7, 0x6f, 0x79, 0x74, 0xfb,
+	0xf7, 0x2a, 0x5a, 0xf5, 0x72, 0x01, 0xfc, 0x08, 0x95, 0x59, 0xa8, 0x6b, 0x2d, 0xad, 0x5d, 0xef,
+	0xac, 0xcc, 0xa6, 0xcd, 0x72, 0xd7, 0x21, 0x65, 0x16, 0xe2, 0xcf, 0xd1, 0x0a, 0x17, 0x54, 0x8c,
+	0xb9, 0x5e, 0x6e, 0x69, 0xed, 0xf5, 0xfd, 0x0f, 0xcd, 0x77, 0x79, 0x33, 0x55, 0x19, 0x4f, 0x52,
+	0x89, 0x4a, 0xc1, 0x26, 0xba, 0x47, 0xc3, 0x98, 0x25, 0x7a, 0xa5, 0xa5, 0xb5, 0x6b, 0x1d, 0xfd,
+	0xcd, 0xab, 0xdd, 0x0d, 0xe5, 0xcb, 0x0e, 0xc3, 0x0c, 0x38, 0xf7, 0x44, 0xc6, 0x92, 0x21, 0xc9,
+	0x69, 0x18, 0xa3, 0x6a, 0x42, 0x63, 0xd0, 0xab, 0x73, 0x3a, 0x91, 0xef, 0xb8, 0x85, 0xd6, 0x42,
+	0xe0, 0x41, 0xc6, 0x46, 0x82, 0xa5, 0x89, 0x7e, 0x4f, 0x42, 0xcb, 0x21, 0xac, 0xa3, 0xd5, 0x0b,
+	0x18, 0x70, 0x26, 0x40, 0x5f, 0x91, 0x68, 0xb1, 0xc4, 0x16, 0x5a, 0x1b, 0xb1, 0x40, 0x8c, 0x33,
+	0xf0, 0xc7, 0x59, 0xa4, 0xaf, 0x4a, 0x17, 0xeb, 0xb3, 0x69, 0x13, 0x1d, 0xe7, 0xe1, 0x13, 0xd2,
+	0x23, 0x48, 0x51, 0x4e, 0xb2, 0x08, 0xef, 0xa3, 0x55, 0x9a, 0x1b, 0xd3, 0xef, 0xff, 0x87, 0xe5,
+	0x82, 0x88, 0x03, 0xb4, 0x22, 0xd2, 0x73, 0x48, 0xb8, 0x5e, 0x6b, 0x55, 0xda, 0x6b, 0xfb, 0x9b,
+	0xa6, 0xe2, 0xcf, 0xfb, 0x64, 0xaa, 0x3e, 0x99, 0x07, 0x29, 0x4b, 0x3a, 0xcf, 0xae, 0xa7, 0xcd,
+	0xd2, 0x2f, 0x6f, 0x9b, 0xed, 0x21, 0x13, 0x67, 0xe3, 0x81, 0x19, 0xa4, 0xb1, 0xea, 0x93, 0x7a,
+	0xec, 0xf2, 0xf0, 0xdc, 0x12, 0x57, 0x23, 0xe0, 0x32, 0x81, 0x13, 0x55, 0x1a, 0x7f, 0x8b, 0x1a,
+	0x21, 0x44, 0x30, 0xa4, 0x22, 0xcd, 0x7c, 0x7e, 0x46, 0x33, 0xe0, 0x3a, 0x92, 0x72, 0x4f, 0xde,
+	0x29, 0xe7, 0x40, 0x20, 0x15, 0x9f, 0x2b, 0xc5, 0x9d, 0xff, 0xa1, 0xa8, 0x72, 0x38, 0x79, 0x6f,
+	0x21, 0xe5, 0x49, 0x25, 0x6c, 0x20, 0x44, 0x83, 0x20, 0x83, 0x90, 0x09, 0x08, 0xf5, 0xb5, 0x96,
+	0xd6, 0xbe, 0x4f, 0x96, 0x22, 0xdb, 0xbf, 0x55, 0x50, 0x5d, 0x4d, 0xc0, 0x31, 0xcd, 0x68, 0xcc,
+	0xf1, 0x47, 0x68, 0x9d, 0x46, 0x51, 0x7a, 0x01, 0xa1, 0x1f, 0x42, 0x92, 0xc6, 0x5c, 0xd7, 0x5a,
+	0x95, 0x76, 0x8d, 0xd4, 0x55, 0xd4, 0x91, 0x41, 0xfc, 0x1d, 0x7a, 0x14, 0xb3, 0xc4, 0x4f, 0x47,
+	0x90, 0xe5, 0x5f, 0x26, 0xe8, 0x39, 0xf8, 0x63, 0x1e, 0xca, 0x69, 0xab, 0x75, 0xbe, 0x9a, 0xdb,
+	0xff, 0x73, 0xda, 0x7c, 0x9c, 0x9b, 0xe5, 0xe1, 0xb9, 0xc9, 0x52, 0x2b, 0xa6, 0xe2, 0xcc, 0xec,
+	0xc1, 0x90, 0x06, 0x57, 0x0e, 0x04, 0xb3, 0x69, 0xf3, 0xe1, 0x0b, 0x96, 0x1c, 0xa9, 0x1a, 0xde,
+	0xbc, 0xc4, 0x89, 0xe7, 0xbc, 0x79, 0xb5, 0x8b, 0xd4, 0xce, 0x38, 0x10, 0x90, 0x87, 0xf1, 0x6d,
+	0x0a, 0x0f, 0xf1, 0x33, 0xb4, 0x11, 0xd3, 0x4b, 0x9f, 0x06, 0x82, 0x4d, 0x60, 0xe1, 0x82, 0xcb,
+	0x71, 0xad, 0x13, 0x1c, 0xd3, 0x4b, 0x5b, 0x42, 0x45, 0x22, 0xc7, 0x3b, 0xe8, 0x01, 0x4f, 0xe8,
+	0x88, 0x9f, 0xa5, 0xc2, 0x67, 0x89, 0x80, 0x6c, 0x42, 0x23, 0x39, 0xae, 0x55, 0xd2, 0x28, 0x80,
+	0xae, 0x8a, 0xe3, 0x5d, 0x84, 0x17, 0xe4, 0x0c, 0x04, 0x24, 0x8b, 0x09, 0xae, 0x93, 0x45, 0x19,
+	0x52, 0x00, 0xd8, 0x45, 0x0f, 0x60, 0xc2, 0x42, 0x48, 0x02, 0xf0, 0x27, 0x90, 0xb1, 0x6f, 0x18,
+	0x64, 0xf9, 0x44, 0xdf, 0x31, 0x86, 0x8d, 0x22, 0xe5, 0x54, 0x65, 0xe0, 0x1d, 0xb4, 0x3e, 0x4e,
+	0x06, 0x69, 0x12, 0xb2, 0x64, 0xe8, 0x0b, 0x16, 0x83, 0x9c, 0xfb, 0x4a, 0xa7, 0xfa, 0xd3, 0xdb,
+	0xa6, 0x46, 0xea, 0x0b, 0xac, 0xcf, 0x62, 0xd8, 0xfe, 0x55, 0x43, 0x0d, 0xd5, 0x39, 0x92, 0x46,
+	0xf0, 0x65, 0x46, 0x13, 0x81, 0x3f, 0x41, 0x48, 0x9d, 0x6d, 0x7f, 0x71, 0x27, 0xd4, 0x67, 0xd3,
+	0x66, 0x4d, 0x31, 0xbb, 0x0e, 0xa9, 0x29, 0x42, 0x37, 0x9c, 0x9f, 0x99, 0xe1, 0x3c, 0x0d, 0x40,
+	0x35, 0xed, 0x8e, 0x33, 0xa3, 0x88, 0xf8, 0x53, 0x54, 0xcd, 0xd2, 0x08, 0xe4, 0x46, 0xaf, 0xef,
+	0x3f, 0xbd, 0xf3, 0x4e, 0x99, 0xfb, 0x22, 0x92, 0xfe, 0xf1, 0x0f, 0xda, 0x62, 0xce, 0xf2, 0x9b,
+	0x06, 0x1b, 0x68, 0xcb, 0x73, 0xc9, 0x69, 0xf7, 0xc0, 0xf5, 0xbd, 0xbe, 0xdd, 0x3f, 0xf1, 0xfc,
+	0x93, 0x43, 0xef, 0xd8, 0x3d, 0xe8, 0x7e, 0xd1, 0x75, 0x9d, 0x46, 0x09, 0x6f, 0xa1, 0x47, 0xb7,
+	0xf0, 0x03, 0xe2, 0xda, 0x7d, 0xd7, 0x69, 0x68, 0x78, 0x13, 0xbd, 0x7f, 0x0b, 0xb3, 0x0f, 0xfa,
+	0xdd, 0x53, 0xb7, 0x51, 0xc6, 0x8f, 0xd1, 0x07, 0xb7, 0xa0, 0xee, 0xa1, 0x02, 0x2b, 0x5b, 0xd5,
+	0xef, 0x7f, 0x36, 0x4a, 0x73, 0x2f, 0x6b, 0x4b, 0x0e, 0xf1, 0x13, 0xa4, 0x17, 0x29, 0xe4, 0xa8,
+	0xe7, 0xde, 0xf2, 0xb1, 0xa4, 0x25, 0xd1, 0x17, 0x6e, 0xdf, 0x76, 0xec, 0xbe, 0xdd, 0xd0, 0x96,
+	0xb5, 0x24, 0x64, 0xf7, 0x7a, 0x47, 0x2f, 0xfd, 0x5e, 0xd7, 0xeb, 0x37, 0xca, 0xcb, 0xdf, 0x27,
+	0x41, 0xe2, 0xbe, 0xb4, 0x89, 0xe3, 0xf9, 0xc7, 0x3d, 0xfb, 0xd0, 0x2b, 0xbc, 0x74, 0xfa, 0xd7,
+	0x7f, 0x1b, 0xa5, 0xeb, 0x99, 0xa1, 0xbd, 0x9e, 0x19, 0xda, 0x5f, 0x33, 0x43, 0xfb, 0xf1, 0xc6,
+	0x28, 0xbd, 0xbe, 0x31, 0x4a, 0x7f, 0xdc, 0x18, 0xa5, 0xaf, 0x3f, 0x5b, 0x3a, 0xfb, 0xc5, 0x46,
+	0xef, 0x46, 0x74, 0xc0, 0x17, 0x2b, 0x6b, 0xb2, 0xb7, 0x6f, 0x5d, 0xfe, 0xfb, 0x3f, 0x92, 0xf7,
+	0xc1, 0x60, 0x45, 0xfe, 0x25, 0x9e, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x71, 0x9e, 0x2c,
+	0xb1, 0x06, 0x00, 0x00,
 }
 
 func (m *Service) Marshal() (dAtA []byte, err error) {
@@ -355,6 +489,43 @@ func (m *ServiceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingTime != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EvidenceVerifier) > 0 {
+		i -= len(m.EvidenceVerifier)
+		copy(dAtA[i:], m.EvidenceVerifier)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.EvidenceVerifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SnapshotRetention != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SnapshotRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxActiveOperators != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxActiveOperators))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinOperatorStakeUSD.Size()
+		i -= size
+		if _, err := m.MinOperatorStakeUSD.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedDenoms) > 0 {
 		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDenoms[iNdEx])
@@ -367,6 +538,46 @@ func (m *ServiceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServiceRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceID != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ServiceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -444,6 +655,43 @@ func (m *ServiceParams) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	l = m.MinOperatorStakeUSD.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if m.MaxActiveOperators != 0 {
+		n += 1 + sovModels(uint64(m.MaxActiveOperators))
+	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovModels(uint64(m.SnapshotInterval))
+	}
+	if m.SnapshotRetention != 0 {
+		n += 1 + sovModels(uint64(m.SnapshotRetention))
+	}
+	l = len(m.EvidenceVerifier)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovModels(uint64(m.UnbondingTime))
+	}
+	return n
+}
+
+func (m *ServiceRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceID != 0 {
+		n += 1 + sovModels(uint64(m.ServiceID))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovModels(uint64(m.Role))
+	}
 	return n
 }
 
@@ -882,6 +1130,268 @@ func (m *ServiceParams) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOperatorStakeUSD", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOperatorStakeUSD.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveOperators", wireType)
+			}
+			m.MaxActiveOperators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveOperators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			m.SnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceVerifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceVerifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			m.ServiceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -394,3 +395,54 @@ func TestService_TokensFromShares(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceParams_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.ServiceParams
+		shouldErr bool
+	}{
+		{
+			name:      "invalid allowed denom returns error",
+			params:    types.NewServiceParams([]string{"1umilk"}, sdkmath.LegacyZeroDec(), 0, 0, 0, "", 0),
+			shouldErr: true,
+		},
+		{
+			name:      "negative min operator stake returns error",
+			params:    types.NewServiceParams(nil, sdkmath.LegacyNewDec(-1), 0, 0, 0, "", 0),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid evidence verifier returns error",
+			params:    types.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 0, 0, "invalid", 0),
+			shouldErr: true,
+		},
+		{
+			name:      "negative unbonding time returns error",
+			params:    types.NewServiceParams(nil, sdkmath.LegacyZeroDec(), 0, 0, 0, "", -time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "default params return no error",
+			params:    types.DefaultServiceParams(),
+			shouldErr: false,
+		},
+		{
+			name:      "valid params return no error",
+			params:    types.NewServiceParams([]string{"umilk"}, sdkmath.LegacyNewDec(1000), 50, 0, 0, "", 3*24*time.Hour),
+			shouldErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -115,6 +116,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryServicesRequest is the request type for the Query/Services RPC method.
 type QueryServicesRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Status allows to return only the services having the given status.
+	// If unspecified, the services are not filtered by status
+	Status ServiceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=milkyway.services.v1.ServiceStatus" json:"status,omitempty"`
+	// Admin allows to return only the services managed by the given address
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// AccreditedOnly allows to return only the accredited services
+	AccreditedOnly bool `protobuf:"varint,4,opt,name=accredited_only,json=accreditedOnly,proto3" json:"accredited_only,omitempty"`
 }
 
 func (m *QueryServicesRequest) Reset()         { *m = QueryServicesRequest{} }
@@ -157,6 +165,27 @@ func (m *QueryServicesRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryServicesRequest) GetStatus() ServiceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SERVICE_STATUS_UNSPECIFIED
+}
+
+func (m *QueryServicesRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *QueryServicesRequest) GetAccreditedOnly() bool {
+	if m != nil {
+		return m.AccreditedOnly
+	}
+	return false
+}
+
 // QueryServicesResponse is the response type for the Query/Services RPC method.
 type QueryServicesResponse struct {
 	// Services services defines the list of actively validates services
@@ -395,6 +424,126 @@ func (m *QueryServiceParamsResponse) GetServiceParams() ServiceParams {
 	return ServiceParams{}
 }
 
+// QueryServiceRoleGrantsRequest is the request type for the
+// Query/ServiceRoleGrants RPC method.
+type QueryServiceRoleGrantsRequest struct {
+	// ServiceID is the ID of the service for which to query the role grants
+	ServiceId uint32 `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Grantee allows to return only the roles granted to the given address
+	Grantee    string             `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryServiceRoleGrantsRequest) Reset()         { *m = QueryServiceRoleGrantsRequest{} }
+func (m *QueryServiceRoleGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceRoleGrantsRequest) ProtoMessage()    {}
+func (*QueryServiceRoleGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb3d1e27b44d645, []int{8}
+}
+func (m *QueryServiceRoleGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceRoleGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceRoleGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServiceRoleGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceRoleGrantsRequest.Merge(m, src)
+}
+func (m *QueryServiceRoleGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceRoleGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceRoleGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceRoleGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryServiceRoleGrantsRequest) GetServiceId() uint32 {
+	if m != nil {
+		return m.ServiceId
+	}
+	return 0
+}
+
+func (m *QueryServiceRoleGrantsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryServiceRoleGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryServiceRoleGrantsResponse is the response type for the
+// Query/ServiceRoleGrants RPC method.
+type QueryServiceRoleGrantsResponse struct {
+	// RoleGrants is the list of roles granted by the service admin
+	RoleGrants []ServiceRoleGrant `protobuf:"bytes,1,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	// Pagination defines the pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryServiceRoleGrantsResponse) Reset()         { *m = QueryServiceRoleGrantsResponse{} }
+func (m *QueryServiceRoleGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceRoleGrantsResponse) ProtoMessage()    {}
+func (*QueryServiceRoleGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb3d1e27b44d645, []int{9}
+}
+func (m *QueryServiceRoleGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceRoleGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceRoleGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServiceRoleGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceRoleGrantsResponse.Merge(m, src)
+}
+func (m *QueryServiceRoleGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceRoleGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceRoleGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceRoleGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryServiceRoleGrantsResponse) GetRoleGrants() []ServiceRoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *QueryServiceRoleGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "milkyway.services.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "milkyway.services.v1.QueryParamsResponse")
@@ -404,48 +553,63 @@ func init() {
 	proto.RegisterType((*QueryServiceResponse)(nil), "milkyway.services.v1.QueryServiceResponse")
 	proto.RegisterType((*QueryServiceParamsRequest)(nil), "milkyway.services.v1.QueryServiceParamsRequest")
 	proto.RegisterType((*QueryServiceParamsResponse)(nil), "milkyway.services.v1.QueryServiceParamsResponse")
+	proto.RegisterType((*QueryServiceRoleGrantsRequest)(nil), "milkyway.services.v1.QueryServiceRoleGrantsRequest")
+	proto.RegisterType((*QueryServiceRoleGrantsResponse)(nil), "milkyway.services.v1.QueryServiceRoleGrantsResponse")
 }
 
 func init() { proto.RegisterFile("milkyway/services/v1/query.proto", fileDescriptor_fbb3d1e27b44d645) }
 
 var fileDescriptor_fbb3d1e27b44d645 = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x33, 0xfd, 0xf7, 0x9f, 0xd6, 0xb7, 0xc4, 0xc3, 0x18, 0xa1, 0x2e, 0xe9, 0x1a, 0x57,
-	0xa9, 0x69, 0x4b, 0x77, 0x4c, 0x5a, 0x3c, 0x04, 0x44, 0xe8, 0x41, 0xf1, 0x96, 0x46, 0xbc, 0x78,
-	0x50, 0x26, 0xc9, 0xb0, 0x2e, 0x26, 0x3b, 0xdb, 0xcc, 0x26, 0x1a, 0xc4, 0x8b, 0x1e, 0xbd, 0x08,
-	0xe2, 0x07, 0xf0, 0x0b, 0xf8, 0x39, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf4, 0x83, 0x48, 0x66,
-	0xdf, 0x4d, 0x32, 0xb2, 0x24, 0xeb, 0x6d, 0x78, 0xf7, 0x79, 0xde, 0xf7, 0x37, 0x0f, 0xef, 0x0e,
-	0x94, 0x7b, 0x7e, 0xf7, 0xf5, 0xe8, 0x0d, 0x1f, 0x31, 0x25, 0xfa, 0x43, 0xbf, 0x2d, 0x14, 0x1b,
-	0x56, 0xd9, 0xd9, 0x40, 0xf4, 0x47, 0x6e, 0xd8, 0x97, 0x91, 0xa4, 0xc5, 0x44, 0xe1, 0x26, 0x0a,
-	0x77, 0x58, 0xb5, 0xf6, 0xdb, 0x52, 0xf5, 0xa4, 0x62, 0x2d, 0xae, 0x44, 0x2c, 0x67, 0xc3, 0x6a,
-	0x4b, 0x44, 0xbc, 0xca, 0x42, 0xee, 0xf9, 0x01, 0x8f, 0x7c, 0x19, 0xc4, 0x1d, 0xac, 0xa2, 0x27,
-	0x3d, 0xa9, 0x8f, 0x6c, 0x7a, 0xc2, 0x6a, 0xc9, 0x93, 0xd2, 0xeb, 0x0a, 0xc6, 0x43, 0x9f, 0xf1,
-	0x20, 0x90, 0x91, 0xb6, 0x28, 0xfc, 0x7a, 0x2b, 0x95, 0xab, 0x27, 0x3b, 0xa2, 0xbb, 0x5c, 0x12,
-	0xf2, 0x3e, 0xef, 0xa1, 0xc4, 0x29, 0x02, 0x3d, 0x9d, 0xb2, 0x35, 0x74, 0xb1, 0x29, 0xce, 0x06,
-	0x42, 0x45, 0xce, 0x29, 0x5c, 0x33, 0xaa, 0x2a, 0x94, 0x81, 0x12, 0xb4, 0x0e, 0xf9, 0xd8, 0xbc,
-	0x4d, 0xca, 0xa4, 0xb2, 0x55, 0x2b, 0xb9, 0x69, 0x37, 0x77, 0x63, 0xd7, 0xc9, 0xfa, 0xf9, 0xaf,
-	0x9b, 0xb9, 0x26, 0x3a, 0x9c, 0x17, 0x50, 0xd4, 0x2d, 0x9f, 0xa2, 0x10, 0x47, 0xd1, 0x47, 0x00,
-	0xf3, 0x38, 0xb0, 0xef, 0xae, 0x1b, 0x67, 0xe7, 0x4e, 0xb3, 0x73, 0xe3, 0xa8, 0x31, 0x3b, 0xb7,
-	0xc1, 0x3d, 0x81, 0xde, 0xe6, 0x82, 0xd3, 0xf9, 0x46, 0xe0, 0xfa, 0x5f, 0x03, 0x90, 0xfa, 0x21,
-	0x6c, 0x26, 0x74, 0xdb, 0xa4, 0xfc, 0x5f, 0x65, 0xab, 0xb6, 0x93, 0xce, 0x8d, 0x4e, 0x04, 0x9f,
-	0x99, 0xe8, 0x63, 0x03, 0x71, 0x4d, 0x23, 0xde, 0x5d, 0x89, 0x18, 0x4f, 0x37, 0x18, 0x8f, 0x31,
-	0x56, 0x1c, 0x94, 0x44, 0xb0, 0x03, 0x80, 0xb3, 0x5e, 0xfa, 0x1d, 0x1d, 0x41, 0xa1, 0x79, 0x05,
-	0x2b, 0x4f, 0x3a, 0xce, 0x33, 0x33, 0xb9, 0xd9, 0xbd, 0x1e, 0xc0, 0x06, 0x8a, 0x30, 0xb6, 0x4c,
-	0xd7, 0x4a, 0x3c, 0x4e, 0x1d, 0x6e, 0x2c, 0xb6, 0x35, 0x16, 0x60, 0x15, 0x52, 0x00, 0x56, 0x9a,
-	0x17, 0xc1, 0x1a, 0x70, 0x35, 0x31, 0x1b, 0xeb, 0x72, 0x7b, 0x29, 0x9f, 0xb1, 0x35, 0x05, 0xb5,
-	0x58, 0xac, 0x5d, 0xae, 0xc3, 0xff, 0x7a, 0x20, 0xfd, 0x4a, 0x60, 0x03, 0x0d, 0x74, 0x2f, 0xbd,
-	0x5f, 0x4a, 0xc4, 0xd6, 0x7e, 0x16, 0x69, 0x8c, 0xef, 0x1c, 0x7d, 0xf8, 0x71, 0xf9, 0x65, 0xed,
-	0x90, 0x1e, 0xb0, 0xd4, 0xdf, 0x67, 0x76, 0x7e, 0x37, 0x4f, 0xe8, 0x3d, 0xfd, 0x44, 0x60, 0x33,
-	0xd9, 0x3c, 0x9a, 0x61, 0x5a, 0x92, 0xb4, 0x75, 0x90, 0x49, 0x8b, 0x68, 0xbb, 0x1a, 0xad, 0x4c,
-	0xed, 0xe5, 0x68, 0xf4, 0x3b, 0x81, 0x82, 0x11, 0x2b, 0x65, 0xab, 0xc7, 0x18, 0x1b, 0x60, 0xdd,
-	0xcb, 0x6e, 0x40, 0xb8, 0xba, 0x86, 0x3b, 0xa6, 0xb5, 0x7f, 0xc8, 0x0d, 0x1f, 0x23, 0xfa, 0x91,
-	0x40, 0x1e, 0x49, 0x2b, 0x4b, 0x06, 0x9b, 0x88, 0x7b, 0x19, 0x94, 0xc8, 0x76, 0x47, 0xb3, 0xd9,
-	0xb4, 0xc4, 0x96, 0x3c, 0x89, 0x27, 0x8d, 0xf3, 0xb1, 0x4d, 0x2e, 0xc6, 0x36, 0xf9, 0x3d, 0xb6,
-	0xc9, 0xe7, 0x89, 0x9d, 0xbb, 0x98, 0xd8, 0xb9, 0x9f, 0x13, 0x3b, 0xf7, 0xfc, 0xbe, 0xe7, 0x47,
-	0xaf, 0x06, 0x2d, 0xb7, 0x2d, 0x7b, 0xb3, 0x0e, 0x87, 0x5d, 0xde, 0x52, 0xf3, 0x7e, 0xc3, 0x6a,
-	0x8d, 0xbd, 0x9d, 0x77, 0x8d, 0x46, 0xa1, 0x50, 0xad, 0xbc, 0x7e, 0x65, 0x8f, 0xfe, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x41, 0x77, 0xaa, 0xec, 0x45, 0x06, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x6f, 0xd3, 0x5c,
+	0x14, 0xcd, 0xeb, 0x8f, 0xb4, 0xbd, 0x55, 0xfb, 0xe9, 0x7b, 0x5f, 0x3e, 0x29, 0x8d, 0x5a, 0x13,
+	0x0c, 0x6a, 0xd3, 0x56, 0xb5, 0x49, 0x5a, 0x81, 0x28, 0x42, 0x15, 0x1d, 0xa8, 0x18, 0x10, 0x69,
+	0x2a, 0x16, 0x96, 0xc8, 0x89, 0x9f, 0x8c, 0x85, 0xe3, 0x97, 0xfa, 0x39, 0x81, 0x08, 0xb1, 0xc0,
+	0xc8, 0x82, 0x84, 0xf8, 0x03, 0x58, 0x18, 0x99, 0x18, 0x10, 0x0b, 0x6b, 0xc7, 0x0a, 0x16, 0x26,
+	0x84, 0x5a, 0xfe, 0x04, 0xfe, 0x00, 0x94, 0xe7, 0xeb, 0x24, 0x2e, 0xc1, 0x75, 0x11, 0x9b, 0xfd,
+	0x74, 0xce, 0xbd, 0xe7, 0x9d, 0x7b, 0x6e, 0x1c, 0xc8, 0x37, 0x6c, 0xe7, 0x41, 0xe7, 0xa1, 0xd1,
+	0xd1, 0x05, 0xf3, 0xda, 0x76, 0x9d, 0x09, 0xbd, 0x5d, 0xd4, 0xf7, 0x5b, 0xcc, 0xeb, 0x68, 0x4d,
+	0x8f, 0xfb, 0x9c, 0x66, 0x42, 0x84, 0x16, 0x22, 0xb4, 0x76, 0x31, 0x37, 0x57, 0xe7, 0xa2, 0xc1,
+	0x45, 0x55, 0x62, 0xf4, 0xe0, 0x25, 0x20, 0xe4, 0x56, 0x82, 0x37, 0xbd, 0x66, 0x08, 0x16, 0x54,
+	0xd2, 0xdb, 0xc5, 0x1a, 0xf3, 0x8d, 0xa2, 0xde, 0x34, 0x2c, 0xdb, 0x35, 0x7c, 0x9b, 0xbb, 0x88,
+	0xcd, 0x58, 0xdc, 0xe2, 0x41, 0x8d, 0xee, 0x13, 0x9e, 0xce, 0x5b, 0x9c, 0x5b, 0x0e, 0xd3, 0x8d,
+	0xa6, 0xad, 0x1b, 0xae, 0xcb, 0x7d, 0x49, 0x09, 0xeb, 0x9f, 0x1f, 0x2a, 0xb9, 0xc1, 0x4d, 0xe6,
+	0xc4, 0x43, 0x9a, 0x86, 0x67, 0x34, 0x10, 0xa2, 0x66, 0x80, 0xee, 0x76, 0xb5, 0x95, 0xe5, 0x61,
+	0x85, 0xed, 0xb7, 0x98, 0xf0, 0xd5, 0x5d, 0xf8, 0x2f, 0x72, 0x2a, 0x9a, 0xdc, 0x15, 0x8c, 0x6e,
+	0x42, 0x3a, 0x20, 0x67, 0x49, 0x9e, 0x14, 0xa6, 0x4b, 0xf3, 0xda, 0x30, 0x53, 0xb4, 0x80, 0xb5,
+	0x3d, 0x76, 0xf0, 0xf5, 0x5c, 0xaa, 0x82, 0x0c, 0xf5, 0x07, 0x81, 0x8c, 0xac, 0xb9, 0x87, 0x48,
+	0xec, 0x45, 0x6f, 0x02, 0xf4, 0xfd, 0xc0, 0xc2, 0x8b, 0x1a, 0x5a, 0xd9, 0x35, 0x4f, 0x0b, 0xc6,
+	0x80, 0xe6, 0x69, 0x65, 0xc3, 0x62, 0xc8, 0xad, 0x0c, 0x30, 0xe9, 0x35, 0x48, 0x0b, 0xdf, 0xf0,
+	0x5b, 0x22, 0x3b, 0x92, 0x27, 0x85, 0xd9, 0xd2, 0x85, 0xe1, 0xe2, 0xb0, 0xfd, 0x9e, 0x84, 0x56,
+	0x90, 0x42, 0x35, 0x18, 0x37, 0xcc, 0x86, 0xed, 0x66, 0x47, 0xf3, 0xa4, 0x30, 0xb5, 0x9d, 0xfd,
+	0xf4, 0x6e, 0x2d, 0x83, 0x12, 0x6e, 0x98, 0xa6, 0xc7, 0x84, 0xd8, 0xf3, 0x3d, 0xdb, 0xb5, 0x2a,
+	0x01, 0x8c, 0x2e, 0xc1, 0x3f, 0x46, 0xbd, 0xee, 0x31, 0xd3, 0xf6, 0x99, 0x59, 0xe5, 0xae, 0xd3,
+	0xc9, 0x8e, 0xe5, 0x49, 0x61, 0xb2, 0x32, 0xdb, 0x3f, 0xbe, 0xe3, 0x3a, 0x1d, 0xf5, 0x35, 0x81,
+	0xff, 0x4f, 0x5c, 0x1b, 0xcd, 0xdc, 0x82, 0xc9, 0x50, 0x57, 0x96, 0xe4, 0x47, 0x0b, 0xd3, 0xa5,
+	0x85, 0x58, 0xc5, 0xe8, 0x67, 0x8f, 0x44, 0x77, 0x22, 0xc6, 0x8d, 0x48, 0xe3, 0x96, 0x4e, 0x35,
+	0x2e, 0xe8, 0x3e, 0xe8, 0x9c, 0xba, 0x81, 0xd3, 0xc6, 0x46, 0xe1, 0x60, 0x16, 0x00, 0xb0, 0x57,
+	0xd5, 0x36, 0xe5, 0x60, 0x66, 0x2a, 0x53, 0x78, 0x72, 0xcb, 0x54, 0xef, 0x46, 0xe7, 0xd9, 0xbb,
+	0xd7, 0x75, 0x98, 0x40, 0x10, 0x0e, 0x33, 0xd1, 0xb5, 0x42, 0x8e, 0xba, 0x09, 0x73, 0x83, 0x65,
+	0x23, 0xb9, 0x3c, 0x4d, 0x92, 0x0b, 0xb9, 0x61, 0x5c, 0x14, 0x56, 0x86, 0xd9, 0x90, 0x1c, 0x49,
+	0x71, 0x7c, 0x50, 0x22, 0x61, 0x9e, 0x11, 0x83, 0x87, 0xea, 0x07, 0x02, 0x0b, 0x11, 0x0f, 0xb8,
+	0xc3, 0x76, 0x3c, 0xc3, 0xf5, 0x13, 0x0a, 0xa6, 0x25, 0x98, 0xb0, 0xba, 0x78, 0xc6, 0xe4, 0xfc,
+	0xe2, 0x82, 0x17, 0x02, 0x4f, 0xec, 0xcb, 0xe8, 0x9f, 0xee, 0x8b, 0xfa, 0x9e, 0x80, 0xf2, 0x3b,
+	0xf1, 0xe8, 0xd8, 0x6d, 0x98, 0xf6, 0xb8, 0xc3, 0xaa, 0xb2, 0x75, 0x98, 0xd2, 0xc5, 0x58, 0xbb,
+	0x7a, 0x55, 0xd0, 0x31, 0xf0, 0x7a, 0x65, 0xff, 0x5a, 0x60, 0x4b, 0x6f, 0xd2, 0x30, 0x2e, 0xa5,
+	0xd3, 0x57, 0x04, 0x26, 0xb0, 0x33, 0x5d, 0x1e, 0x2e, 0x6c, 0x48, 0xb4, 0x73, 0x2b, 0x49, 0xa0,
+	0x41, 0x63, 0x75, 0xfd, 0xe9, 0xe7, 0xef, 0x2f, 0x47, 0xd6, 0xe8, 0xaa, 0x3e, 0xf4, 0xd7, 0xb4,
+	0xf7, 0xfc, 0xb8, 0x3f, 0xe8, 0x27, 0xf4, 0x39, 0x81, 0xc9, 0x70, 0xe3, 0x69, 0x82, 0x6e, 0x61,
+	0x60, 0x72, 0xab, 0x89, 0xb0, 0x28, 0x6d, 0x51, 0x4a, 0xcb, 0x53, 0x25, 0x5e, 0x1a, 0x7d, 0x4b,
+	0x60, 0x26, 0x12, 0x67, 0xaa, 0x9f, 0xde, 0x26, 0xb2, 0x79, 0xb9, 0x4b, 0xc9, 0x09, 0x28, 0x6e,
+	0x53, 0x8a, 0xdb, 0xa0, 0xa5, 0x33, 0xf8, 0x86, 0xdf, 0x26, 0xfa, 0x91, 0xc0, 0xbf, 0xbf, 0xc4,
+	0x92, 0xae, 0x27, 0x98, 0xda, 0xc9, 0x0d, 0xcc, 0x6d, 0x9c, 0x8d, 0x84, 0xe2, 0xb7, 0xa4, 0xf8,
+	0xab, 0xf4, 0xca, 0x59, 0xc4, 0x0f, 0xec, 0x0a, 0x7d, 0x46, 0x20, 0x8d, 0x5e, 0x17, 0x62, 0x14,
+	0x44, 0x4d, 0x5e, 0x4e, 0x80, 0x44, 0x81, 0x17, 0xa5, 0x40, 0x85, 0xce, 0xeb, 0x31, 0xdf, 0xf8,
+	0xed, 0xf2, 0xc1, 0x91, 0x42, 0x0e, 0x8f, 0x14, 0xf2, 0xed, 0x48, 0x21, 0x2f, 0x8e, 0x95, 0xd4,
+	0xe1, 0xb1, 0x92, 0xfa, 0x72, 0xac, 0xa4, 0xee, 0x5d, 0xb6, 0x6c, 0xff, 0x7e, 0xab, 0xa6, 0xd5,
+	0x79, 0xa3, 0x57, 0x61, 0xcd, 0x31, 0x6a, 0xa2, 0x5f, 0xaf, 0x5d, 0x2c, 0xe9, 0x8f, 0xfa, 0x55,
+	0xfd, 0x4e, 0x93, 0x89, 0x5a, 0x5a, 0xfe, 0x6d, 0x58, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0xae,
+	0x8b, 0xbb, 0x60, 0x31, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -469,6 +633,9 @@ type QueryClient interface {
 	// ServiceParams defines a gRPC query method that returns the parameters of
 	// service.
 	ServiceParams(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error)
+	// ServiceRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the service with the given id.
+	ServiceRoleGrants(ctx context.Context, in *QueryServiceRoleGrantsRequest, opts ...grpc.CallOption) (*QueryServiceRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -509,6 +676,15 @@ func (c *queryClient) ServiceParams(ctx context.Context, in *QueryServiceParamsR
 	return out, nil
 }
 
+func (c *queryClient) ServiceRoleGrants(ctx context.Context, in *QueryServiceRoleGrantsRequest, opts ...grpc.CallOption) (*QueryServiceRoleGrantsResponse, error) {
+	out := new(QueryServiceRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milkyway.services.v1.Query/ServiceRoleGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/milkyway.services.v1.Query/Params", in, out, opts...)
@@ -529,6 +705,9 @@ type QueryServer interface {
 	// ServiceParams defines a gRPC query method that returns the parameters of
 	// service.
 	ServiceParams(context.Context, *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error)
+	// ServiceRoleGrants defines a gRPC query method that returns the roles
+	// granted by the admin of the service with the given id.
+	ServiceRoleGrants(context.Context, *QueryServiceRoleGrantsRequest) (*QueryServiceRoleGrantsResponse, error)
 	// Params defines a gRPC query method that returns the parameters of the
 	// module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -547,6 +726,9 @@ func (*UnimplementedQueryServer) Services(ctx context.Context, req *QueryService
 func (*UnimplementedQueryServer) ServiceParams(ctx context.Context, req *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServiceParams not implemented")
 }
+func (*UnimplementedQueryServer) ServiceRoleGrants(ctx context.Context, req *QueryServiceRoleGrantsRequest) (*QueryServiceRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceRoleGrants not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -609,6 +791,24 @@ func _Query_ServiceParams_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ServiceRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milkyway.services.v1.Query/ServiceRoleGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceRoleGrants(ctx, req.(*QueryServiceRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -644,6 +844,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ServiceParams",
 			Handler:    _Query_ServiceParams_Handler,
 		},
+		{
+			MethodName: "ServiceRoleGrants",
+			Handler:    _Query_ServiceRoleGrants_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -729,6 +933,28 @@ func (m *QueryServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccreditedOnly {
+		i--
+		if m.AccreditedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -915,6 +1141,102 @@ func (m *QueryServiceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryServiceRoleGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceRoleGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceRoleGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ServiceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceRoleGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceRoleGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceRoleGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -956,6 +1278,16 @@ func (m *QueryServicesRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccreditedOnly {
+		n += 2
+	}
 	return n
 }
 
@@ -1024,6 +1356,45 @@ func (m *QueryServiceParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryServiceRoleGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceId != 0 {
+		n += 1 + sovQuery(uint64(m.ServiceId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceRoleGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1228,17 +1599,88 @@ func (m *QueryServicesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ServiceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccreditedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1673,6 +2115,263 @@ func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryServiceRoleGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceRoleGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceRoleGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			m.ServiceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceRoleGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceRoleGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceRoleGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, ServiceRoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0